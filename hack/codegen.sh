@@ -107,6 +107,8 @@ cat config/internal/manager/crd/bases/catalog.kubecarrier.io_catalogentries.yaml
 mv config/internal/manager/crd/bases/catalog.kubecarrier.io_catalogentries.yaml.tmp config/internal/manager/crd/bases/catalog.kubecarrier.io_catalogentries.yaml
 cat config/internal/manager/crd/bases/catalog.kubecarrier.io_offerings.yaml | yq -Y 'del(.spec.versions[].schema.openAPIV3Schema.properties.spec.properties.crd.properties.versions.items.properties.schema.properties)' > config/internal/manager/crd/bases/catalog.kubecarrier.io_offerings.yaml.tmp
 mv config/internal/manager/crd/bases/catalog.kubecarrier.io_offerings.yaml.tmp config/internal/manager/crd/bases/catalog.kubecarrier.io_offerings.yaml
+cat config/internal/manager/crd/bases/catalog.kubecarrier.io_catalogentrysets.yaml | yq -Y 'del(.spec.versions[].schema.openAPIV3Schema.properties.status.properties.aggregatedCRD.properties.versions.items.properties.schema.properties)' > config/internal/manager/crd/bases/catalog.kubecarrier.io_catalogentrysets.yaml.tmp
+mv config/internal/manager/crd/bases/catalog.kubecarrier.io_catalogentrysets.yaml.tmp config/internal/manager/crd/bases/catalog.kubecarrier.io_catalogentrysets.yaml
 statik-gen manager config/internal/manager
 
 # Ferry
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"k8c.io/utils/pkg/util"
+
+	"k8c.io/kubecarrier/pkg/internal/tunnel"
+)
+
+type flags struct {
+	tunnelURL        string
+	tokenFile        string
+	tunnelCAFile     string
+	tunnelServerName string
+}
+
+func NewAgentCommand(log logr.Logger) *cobra.Command {
+	flags := &flags{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "agent",
+		Short: "Agent connecting a ServiceCluster to KubeCarrier",
+		Long: `The agent runs in a ServiceCluster and dials out to the tunnel endpoint of the Ferry in the management cluster.
+KubeCarrier components talk to the ServiceCluster API Server through this tunnel.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runE(flags, log)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.tunnelURL, "tunnel-url", "", "URL of the Ferry tunnel endpoint in the management cluster.")
+	cmd.Flags().StringVar(&flags.tokenFile, "token-file", "", "Path to the file containing the token to authenticate at the tunnel endpoint.")
+	cmd.Flags().StringVar(&flags.tunnelCAFile, "tunnel-ca-file", "", "Path to a CA bundle to verify the tunnel endpoint, system CAs are used if empty.")
+	cmd.Flags().StringVar(&flags.tunnelServerName, "tunnel-server-name", "", "Name to verify the certificate of the tunnel endpoint against, the host of the tunnel url is used if empty.")
+	for _, flagName := range []string{
+		"tunnel-url",
+		"token-file",
+	} {
+		if err := cmd.MarkFlagRequired(flagName); err != nil {
+			panic(fmt.Errorf("req flag %s: %w", flagName, err))
+		}
+	}
+	return util.CmdLogMixin(cmd)
+}
+
+func runE(flags *flags, log logr.Logger) error {
+	token, err := ioutil.ReadFile(flags.tokenFile)
+	if err != nil {
+		return fmt.Errorf("reading token: %w", err)
+	}
+
+	tlsConfig := &tls.Config{ServerName: flags.tunnelServerName}
+	if flags.tunnelCAFile != "" {
+		caBundle, err := ioutil.ReadFile(flags.tunnelCAFile)
+		if err != nil {
+			return fmt.Errorf("reading tunnel CA bundle: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+			return fmt.Errorf("no certificates found in tunnel CA bundle %s", flags.tunnelCAFile)
+		}
+	}
+
+	handler, err := NewAPIServerProxy(ctrl.GetConfigOrDie())
+	if err != nil {
+		return fmt.Errorf("creating API Server proxy: %w", err)
+	}
+
+	a := &tunnel.Agent{
+		Log:       log.WithName("tunnel"),
+		URL:       flags.tunnelURL,
+		Token:     strings.TrimSpace(string(token)),
+		TLSConfig: tlsConfig,
+		Handler:   handler,
+	}
+	if err := a.Run(ctrl.SetupSignalHandler()); err != nil {
+		return fmt.Errorf("error running component: %w", err)
+	}
+	return nil
+}
+
+// NewAPIServerProxy returns a http.Handler, forwarding requests to the API Server using the credentials of the given config.
+// Credentials sent by the client are removed, so requests are always authenticated as the agent.
+func NewAPIServerProxy(cfg *rest.Config) (http.Handler, error) {
+	target, err := url.Parse(cfg.Host)
+	if err != nil {
+		return nil, fmt.Errorf("parsing API Server url: %w", err)
+	}
+	if target.Scheme == "" {
+		target.Scheme = "https"
+	}
+	transport, err := rest.TransportFor(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating transport: %w", err)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = target.Host
+		req.Header.Del("Authorization")
+		req.Header.Del("Impersonate-User")
+		req.Header.Del("Impersonate-Group")
+	}
+	proxy.Transport = transport
+	// flush immediately to support watches.
+	proxy.FlushInterval = -1
+	return proxy, nil
+}
@@ -31,7 +31,68 @@ type AccountSpec struct {
 	Roles []AccountRole `json:"roles"`
 	// Subjects holds references to the objects that manged RBAC roles should apply to.
 	// +kubebuilder:validation:MinItems=1
-	Subjects []rbacv1.Subject `json:"subjects"`
+	Subjects []AccountSubject `json:"subjects"`
+}
+
+// AccountSubject references an object that managed RBAC roles should apply to,
+// together with its role within the Account.
+type AccountSubject struct {
+	rbacv1.Subject `json:",inline"`
+	// Role of the subject within a tenant Account, one of Viewer, Editor or Admin.
+	// Viewers can read Offerings and instances, Editors can also create and update instances
+	// and Admins can delete instances and manage APIKeys.
+	// Subjects without a role are Admins.
+	// +optional
+	Role AccountSubjectRole `json:"role,omitempty"`
+}
+
+// AccountSubjectRole type represents the role of a subject within an Account.
+// +kubebuilder:validation:Enum=Viewer;Editor;Admin
+type AccountSubjectRole string
+
+const (
+	SubjectRoleViewer AccountSubjectRole = "Viewer"
+	SubjectRoleEditor AccountSubjectRole = "Editor"
+	SubjectRoleAdmin  AccountSubjectRole = "Admin"
+)
+
+var subjectRoleLevels = map[AccountSubjectRole]int{
+	SubjectRoleViewer: 1,
+	SubjectRoleEditor: 2,
+	SubjectRoleAdmin:  3,
+}
+
+// Includes returns true if the role grants at least the permissions of the other role.
+func (r AccountSubjectRole) Includes(other AccountSubjectRole) bool {
+	return subjectRoleLevels[r] >= subjectRoleLevels[other]
+}
+
+// GetRole returns the role of the subject, subjects without a role are Admins.
+func (s AccountSubject) GetRole() AccountSubjectRole {
+	if s.Role == "" {
+		return SubjectRoleAdmin
+	}
+	return s.Role
+}
+
+// NewAccountSubjects returns AccountSubjects without an explicit role for the given subjects.
+func NewAccountSubjects(subjects ...rbacv1.Subject) []AccountSubject {
+	out := make([]AccountSubject, len(subjects))
+	for i, subject := range subjects {
+		out[i] = AccountSubject{Subject: subject}
+	}
+	return out
+}
+
+// RBACSubjects returns the subjects of the Account with at least the given role.
+func (a *Account) RBACSubjects(role AccountSubjectRole) []rbacv1.Subject {
+	out := []rbacv1.Subject{}
+	for _, subject := range a.Spec.Subjects {
+		if subject.GetRole().Includes(role) {
+			out = append(out, subject.Subject)
+		}
+	}
+	return out
 }
 
 // AccountMetadata contains the metadata of the Account.
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIKeySpec defines the desired state of APIKey.
+type APIKeySpec struct {
+	// Description of what the APIKey is used for.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// ExpiresAt is the time after which the APIKey is no longer accepted.
+	// APIKeys without expiry stay valid until they are revoked.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+	// ReadOnly restricts the APIKey to get, list and watch calls.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// SecretHash is the hex encoded SHA-256 hash of the secret of the APIKey.
+	// The secret itself is only returned once, when the APIKey is created via the KubeCarrier API.
+	// +kubebuilder:validation:Pattern=`^[0-9a-f]{64}$`
+	SecretHash string `json:"secretHash"`
+}
+
+// IsExpired returns true if the APIKey is expired at the given time.
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.Spec.ExpiresAt != nil && !now.Before(k.Spec.ExpiresAt.Time)
+}
+
+// UserName returns the name of the user, that calls authenticated by the APIKey act as.
+func (k *APIKey) UserName() string {
+	return "kubecarrier:apikey:" + k.Namespace + ":" + k.Name
+}
+
+// SubjectRole returns the role of the APIKey within its Account.
+// APIKeys never act as Admins, so they can't be used to manage other APIKeys.
+func (k *APIKey) SubjectRole() AccountSubjectRole {
+	if k.Spec.ReadOnly {
+		return SubjectRoleViewer
+	}
+	return SubjectRoleEditor
+}
+
+// APIKey is a long-lived credential for the KubeCarrier API, scoped to the Account it is created in.
+//
+// Calls authenticated by an APIKey act as the user kubecarrier:apikey:<namespace>:<name>,
+// that is bound to the Roles of the Account as Viewer for read-only APIKeys and as Editor otherwise.
+// Deleting the APIKey revokes it.
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Read Only",type="boolean",JSONPath=".spec.readOnly"
+// +kubebuilder:printcolumn:name="Expires At",type="date",JSONPath=".spec.expiresAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:categories=all;kubecarrier-provider;kubecarrier-tenant,shortName=ak
+type APIKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec APIKeySpec `json:"spec,omitempty"`
+}
+
+// APIKeyList contains a list of APIKey.
+// +kubebuilder:object:root=true
+type APIKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&APIKey{}, &APIKeyList{})
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,6 +30,162 @@ type CatalogEntrySpec struct {
 	BaseCRD ObjectReference `json:"baseCRD"`
 	// Derive contains the configuration to generate DerivedCustomResource from the BaseCRD of this CatalogEntry.
 	Derive *DerivedConfig `json:"derive,omitempty"`
+	// ReferencedObjects lists Secrets and ConfigMaps referenced by instances of the BaseCRD,
+	// that should be propagated from the ServiceCluster to the Tenant.
+	// +optional
+	ReferencedObjects []ReferencedObject `json:"referencedObjects,omitempty"`
+	// Bundle lists further CatalogEntries in the same namespace, that are offered together with this CatalogEntry,
+	// e.g. Backups and Snapshots of a database.
+	// Bundled CatalogEntries have to be selected by the same Catalogs,
+	// they are listed in the Offering of this CatalogEntry and don't get an Offering of their own.
+	// Bundled CatalogEntries can't have a Bundle themselves and can only be part of a single Bundle.
+	// +optional
+	Bundle []ObjectReference `json:"bundle,omitempty"`
+	// ObjectReferences lists fields of instances, that reference instances of another CatalogEntry,
+	// e.g. the database a Backup belongs to.
+	// +optional
+	ObjectReferences []ObjectReferenceField `json:"objectReferences,omitempty"`
+	// Metering configures the UsageRecords that are created for instances of this CatalogEntry.
+	// +optional
+	Metering *MeteringConfig `json:"metering,omitempty"`
+	// Migration configures hooks that are called when instances of this CatalogEntry are migrated to another ServiceCluster.
+	// +optional
+	Migration *MigrationConfig `json:"migration,omitempty"`
+	// Lifecycle describes whether this CatalogEntry is active, deprecated or retired.
+	// The Lifecycle is propagated to the Offerings of this CatalogEntry.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+}
+
+// LifecycleState is the lifecycle state of a CatalogEntry and its Offerings.
+// +kubebuilder:validation:Enum=Active;Deprecated;Retired
+type LifecycleState string
+
+const (
+	// LifecycleStateActive means new instances can be created.
+	LifecycleStateActive LifecycleState = "Active"
+	// LifecycleStateDeprecated means new instances can still be created until the SunsetDate,
+	// but Tenants should migrate away.
+	LifecycleStateDeprecated LifecycleState = "Deprecated"
+	// LifecycleStateRetired means no new instances can be created, existing instances keep running.
+	LifecycleStateRetired LifecycleState = "Retired"
+)
+
+// Lifecycle describes the lifecycle of a CatalogEntry and its Offerings.
+type Lifecycle struct {
+	// State of the CatalogEntry, defaults to Active.
+	// +kubebuilder:default=Active
+	// +optional
+	State LifecycleState `json:"state,omitempty"`
+	// Message is shown to Tenants, e.g. to explain which Offering to use instead.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// SunsetDate is the time a Deprecated CatalogEntry is considered Retired.
+	// +optional
+	SunsetDate *metav1.Time `json:"sunsetDate,omitempty"`
+}
+
+// GetState returns the effective LifecycleState at the given time.
+// A Deprecated Lifecycle with a SunsetDate in the past is Retired.
+func (l *Lifecycle) GetState(now time.Time) LifecycleState {
+	if l == nil || l.State == "" {
+		return LifecycleStateActive
+	}
+	if l.State == LifecycleStateDeprecated &&
+		l.SunsetDate != nil && !now.Before(l.SunsetDate.Time) {
+		return LifecycleStateRetired
+	}
+	return l.State
+}
+
+// AllowsNewInstances returns true, if new instances can be created at the given time.
+func (l *Lifecycle) AllowsNewInstances(now time.Time) bool {
+	return l.GetState(now) != LifecycleStateRetired
+}
+
+// MeteringConfig configures the metering of instances.
+type MeteringConfig struct {
+	// Dimensions are numeric fields of the instance, that are recorded in UsageRecords.
+	// +optional
+	Dimensions []MeteringDimension `json:"dimensions,omitempty"`
+	// Sink is the URL usage events are exported to, as CloudEvents.
+	// Supported schemes are http, https (structured CloudEvents over HTTP)
+	// and file (one JSON encoded CloudEvent per line, appended to a file of the Elevator).
+	// +optional
+	Sink string `json:"sink,omitempty"`
+}
+
+// MeteringDimension is a numeric field of an instance.
+type MeteringDimension struct {
+	// Name of the dimension, e.g. storage.
+	Name string `json:"name"`
+	// JSONPath of the field in the provider object, e.g. .spec.storage
+	// The field can either hold a number or a quantity like "10Gi".
+	JSONPath string `json:"jsonPath"`
+}
+
+// MigrationConfig configures the hooks of InstanceMigrations.
+type MigrationConfig struct {
+	// PreHook is called before the instance is created in the target ServiceCluster, e.g. to create a backup.
+	// +optional
+	PreHook *MigrationHook `json:"preHook,omitempty"`
+	// PostHook is called when the migrated instance is ready, before the original instance is removed,
+	// e.g. to restore a backup.
+	// +optional
+	PostHook *MigrationHook `json:"postHook,omitempty"`
+}
+
+// MigrationHook is a HTTP endpoint that is called during an InstanceMigration.
+// The name of the hook and the InstanceMigration are posted as JSON to the URL,
+// the migration is rolled back, if the hook does not respond with a 2xx status code.
+type MigrationHook struct {
+	// URL of the hook, it has to be a https URL that doesn't point to an internal address.
+	URL string `json:"url"`
+	// Timeout of the hook call, 30s by default.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ReferencedObjectKind is the kind of object that can be propagated from a ServiceCluster.
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+type ReferencedObjectKind string
+
+// Values of ReferencedObjectKind.
+const (
+	ReferencedSecret    ReferencedObjectKind = "Secret"
+	ReferencedConfigMap ReferencedObjectKind = "ConfigMap"
+)
+
+// ReferencedObject describes a Secret or ConfigMap referenced by an instance, either by a static name or by a field of the instance.
+// Exactly one of Name or JSONPath has to be set.
+type ReferencedObject struct {
+	// Kind of the referenced object.
+	Kind ReferencedObjectKind `json:"kind"`
+	// Name of the referenced object in the namespace of the instance.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// JSONPath of the field containing the name of the referenced object, e.g. .status.connection.secretName.
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+}
+
+// ObjectReferenceField describes a field of an instance, that references an instance of another CatalogEntry.
+//
+// Instances keep their name when they are copied between Tenant, Provider and ServiceCluster,
+// so the name of the referenced instance is never changed.
+// The API group and namespace of the reference are rewritten to match the copy of the referenced instance.
+// References are only supported between instances on the same ServiceCluster.
+type ObjectReferenceField struct {
+	// CatalogEntry of the referenced instance, in the same namespace.
+	CatalogEntry ObjectReference `json:"catalogEntry"`
+	// JSONPath of the field containing the name of the referenced instance, e.g. .spec.dbName
+	JSONPath string `json:"jsonPath"`
+	// NamespaceJSONPath of the field containing the namespace of the referenced instance, if any.
+	// +optional
+	NamespaceJSONPath string `json:"namespaceJSONPath,omitempty"`
+	// APIGroupJSONPath of the field containing the API group or apiVersion of the referenced instance, if any.
+	// +optional
+	APIGroupJSONPath string `json:"apiGroupJSONPath,omitempty"`
 }
 
 // DerivedConfig can be used to limit fields that should be exposed to a Tenant.
@@ -209,11 +367,43 @@ func (s *CatalogEntryStatus) SetCondition(condition CatalogEntryCondition) {
 //       - jsonPath: .status.address
 //       - jsonPath: .status.observedGeneration
 // ```
+//
+// **Example with a bundle**
+// ```yaml
+// apiVersion: catalog.kubecarrier.io/v1alpha1
+// kind: CatalogEntry
+// metadata:
+//   name: dbs.eu-west-1
+// spec:
+//   metadata:
+//     displayName: DB
+//     description: Databases with backups
+//   baseCRD:
+//     name: dbs.eu-west-1.loodse
+//   bundle:
+//   - name: backups.eu-west-1
+// ---
+// apiVersion: catalog.kubecarrier.io/v1alpha1
+// kind: CatalogEntry
+// metadata:
+//   name: backups.eu-west-1
+// spec:
+//   metadata:
+//     displayName: Backup
+//     description: Backups of a DB
+//   baseCRD:
+//     name: backups.eu-west-1.loodse
+//   objectReferences:
+//   - catalogEntry:
+//       name: dbs.eu-west-1
+//     jsonPath: .spec.dbName
+// ```
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="Base CRD",type="string",JSONPath=".spec.baseCRD.name"
 // +kubebuilder:printcolumn:name="Tenant CRD",type="string",JSONPath=".status.tenantCRD.name"
+// +kubebuilder:printcolumn:name="Lifecycle",type="string",JSONPath=".spec.lifecycle.state"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:categories=all;kubecarrier-provider,shortName=ce
 type CatalogEntry struct {
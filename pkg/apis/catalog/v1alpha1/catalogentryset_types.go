@@ -28,8 +28,20 @@ type CatalogEntrySetSpec struct {
 	Metadata CatalogEntrySetMetadata `json:"metadata"`
 	// Derive contains the configuration to generate DerivedCustomResources from the BaseCRDs that are selected by this CatalogEntrySet.
 	Derive *DerivedConfig `json:"derive,omitempty"`
+	// ReferencedObjects lists Secrets and ConfigMaps referenced by instances, that should be propagated to the Tenant.
+	// +optional
+	ReferencedObjects []ReferencedObject `json:"referencedObjects,omitempty"`
+	// Metering configures the UsageRecords that are created for instances.
+	// +optional
+	Metering *MeteringConfig `json:"metering,omitempty"`
+	// Migration configures hooks that are called when instances are migrated to another ServiceCluster.
+	// +optional
+	Migration *MigrationConfig `json:"migration,omitempty"`
 	// Discover contains the configuration to create a CustomResourceDiscoverySet.
 	Discover CustomResourceDiscoverySetConfig `json:"discover"`
+	// Scheduling enables an aggregated CRD, that lets KubeCarrier select the ServiceCluster for new instances.
+	// +optional
+	Scheduling *SchedulingConfig `json:"scheduling,omitempty"`
 }
 
 type CustomResourceDiscoverySetConfig struct {
@@ -56,6 +68,8 @@ type CatalogEntrySetStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Conditions represents the latest available observations of a CatalogEntrySet's current state.
 	Conditions []CatalogEntrySetCondition `json:"conditions,omitempty"`
+	// AggregatedCRD holds the information about the aggregated CRD, if scheduling is enabled.
+	AggregatedCRD *CRDInformation `json:"aggregatedCRD,omitempty"`
 	// DEPRECATED.
 	// Phase represents the current lifecycle state of this object.
 	// Consider this field DEPRECATED, it will be removed as soon as there
@@ -16,7 +16,10 @@ limitations under the License.
 
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // DerivedCustomResourceSpec defines the desired state of DerivedCustomResource.
 type DerivedCustomResourceSpec struct {
@@ -25,6 +28,13 @@ type DerivedCustomResourceSpec struct {
 	// controls which fields will be present in the derived CRD.
 	// +kubebuilder:validation:MinItems=1
 	Expose []VersionExposeConfig `json:"expose"`
+	// ReferencedObjects lists Secrets and ConfigMaps referenced by instances of the BaseCRD,
+	// that should be re-exposed to Tenants together with the derived instances.
+	// +optional
+	ReferencedObjects []ReferencedObject `json:"referencedObjects,omitempty"`
+	// ObjectReferences lists fields of instances of the BaseCRD, that reference instances of another CatalogEntry.
+	// +optional
+	ObjectReferences []ObjectReferenceField `json:"objectReferences,omitempty"`
 }
 
 // VersionExposeConfig specifies which fields to expose in the derived CRD.
@@ -36,12 +46,30 @@ type VersionExposeConfig struct {
 	// specifies the fields that should be present in the derived CRD.
 	// +kubebuilder:validation:MinItems=1
 	Fields []FieldPath `json:"fields"`
+	// specifies default values for fields of the provider object that are not exposed in the derived CRD.
+	// Defaults are applied when the tenant object is created.
+	// +optional
+	Defaults []FieldDefault `json:"defaults,omitempty"`
 }
 
 // FieldPath is specifying how to address a certain field.
 type FieldPath struct {
 	// JSONPath e.g. .spec.somefield.somesubfield
+	// Array elements can be selected by index or wildcard, e.g. .status.endpoints[0].host or .spec.containers[*].image,
+	// keys containing dots can be quoted, e.g. .metadata.annotations['kubecarrier.io/some.key'].
+	JSONPath string `json:"jsonPath"`
+	// TenantPath is the path of the field in the derived CRD, if it should differ from the JSONPath in the base CRD.
+	// e.g. .spec.storageGB to present .spec.size of the base CRD as .spec.storageGB to tenants.
+	// +optional
+	TenantPath string `json:"tenantPath,omitempty"`
+}
+
+// FieldDefault is specifying the default value of a field.
+type FieldDefault struct {
+	// JSONPath of the field in the base CRD.
 	JSONPath string `json:"jsonPath"`
+	// Value is the default value of the field, it can be any valid JSON value.
+	Value apiextensionsv1.JSON `json:"value"`
 }
 
 // DerivedCustomResourceStatus defines the observed state of DerivedCustomResource.
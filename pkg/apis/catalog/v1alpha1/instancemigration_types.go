@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// InstanceMigrationLabel is set on instances that are created by an InstanceMigration.
+	InstanceMigrationLabel = "catalog.kubecarrier.io/instance-migration"
+)
+
+// InstanceMigrationSpec describes the desired state of InstanceMigration.
+type InstanceMigrationSpec struct {
+	// Offering of the instance that is migrated.
+	Offering ObjectReference `json:"offering"`
+	// Instance references the instance in the namespace of the InstanceMigration.
+	Instance ObjectReference `json:"instance"`
+	// TargetOffering is the Offering of the same Provider in another region, the instance is migrated to.
+	TargetOffering ObjectReference `json:"targetOffering"`
+	// ReadinessTimeout is the time to wait for the migrated instance to become ready,
+	// before the migration is rolled back, 30m by default.
+	// +optional
+	ReadinessTimeout *metav1.Duration `json:"readinessTimeout,omitempty"`
+}
+
+// InstanceMigrationStatus represents the observed state of InstanceMigration.
+type InstanceMigrationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this InstanceMigration by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represents the latest available observations of a InstanceMigration's current state.
+	Conditions []InstanceMigrationCondition `json:"conditions,omitempty"`
+	// Phase is the current step of the migration.
+	Phase InstanceMigrationPhaseType `json:"phase,omitempty"`
+	// StartTime is the time the migration was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the migration was completed or rolled back.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// InstanceMigrationPhaseType represents the steps of an InstanceMigration.
+type InstanceMigrationPhaseType string
+
+// Values of InstanceMigrationPhaseType.
+const (
+	// InstanceMigrationPhasePending means the migration has not been started yet.
+	InstanceMigrationPhasePending InstanceMigrationPhaseType = "Pending"
+	// InstanceMigrationPhasePreHook means the pre migration hook of the Provider is called.
+	InstanceMigrationPhasePreHook InstanceMigrationPhaseType = "PreHook"
+	// InstanceMigrationPhaseMigrating means the instance was created in the target region
+	// and the migration waits for the instance to become ready.
+	InstanceMigrationPhaseMigrating InstanceMigrationPhaseType = "Migrating"
+	// InstanceMigrationPhasePostHook means the post migration hook of the Provider is called.
+	InstanceMigrationPhasePostHook InstanceMigrationPhaseType = "PostHook"
+	// InstanceMigrationPhaseSwitchingOver means the original instance is replaced by the migrated instance.
+	InstanceMigrationPhaseSwitchingOver InstanceMigrationPhaseType = "SwitchingOver"
+	// InstanceMigrationPhaseCompleted means the instance was migrated and the original instance is removed.
+	InstanceMigrationPhaseCompleted InstanceMigrationPhaseType = "Completed"
+	// InstanceMigrationPhaseRollingBack means the migration failed and the migrated instance is removed.
+	InstanceMigrationPhaseRollingBack InstanceMigrationPhaseType = "RollingBack"
+	// InstanceMigrationPhaseFailed means the migration failed and was rolled back.
+	InstanceMigrationPhaseFailed InstanceMigrationPhaseType = "Failed"
+)
+
+// IsFinished returns true, if the migration has been completed or has failed.
+func (s *InstanceMigrationStatus) IsFinished() bool {
+	return s.Phase == InstanceMigrationPhaseCompleted || s.Phase == InstanceMigrationPhaseFailed
+}
+
+// InstanceMigrationConditionType represents a InstanceMigrationCondition value.
+type InstanceMigrationConditionType string
+
+const (
+	// InstanceMigrationSucceeded represents a InstanceMigration condition, whether the migration has been successful.
+	InstanceMigrationSucceeded InstanceMigrationConditionType = "Succeeded"
+)
+
+// InstanceMigrationCondition contains details for the current condition of this InstanceMigration.
+type InstanceMigrationCondition struct {
+	// Type is the type of the InstanceMigration condition, currently ('Succeeded').
+	Type InstanceMigrationConditionType `json:"type"`
+	// Status is the status of the condition, one of ('True', 'False', 'Unknown').
+	Status ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition transits from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// Reason is the (brief) reason for the condition's last transition.
+	Reason string `json:"reason"`
+	// Message is the human readable message indicating details about last transition.
+	Message string `json:"message"`
+}
+
+// True returns whether .Status == "True"
+func (c InstanceMigrationCondition) True() bool {
+	return c.Status == ConditionTrue
+}
+
+// GetCondition returns the Condition of the given condition type, if it exists.
+func (s *InstanceMigrationStatus) GetCondition(t InstanceMigrationConditionType) (condition InstanceMigrationCondition, exists bool) {
+	for _, cond := range s.Conditions {
+		if cond.Type == t {
+			condition = cond
+			exists = true
+			return
+		}
+	}
+	return
+}
+
+// SetCondition replaces or adds the given condition.
+func (s *InstanceMigrationStatus) SetCondition(condition InstanceMigrationCondition) {
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = metav1.Now()
+	}
+
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == condition.Type {
+
+			// Only update the LastTransitionTime when the Status is changed.
+			if s.Conditions[i].Status != condition.Status {
+				s.Conditions[i].LastTransitionTime = condition.LastTransitionTime
+			}
+
+			s.Conditions[i].Status = condition.Status
+			s.Conditions[i].Reason = condition.Reason
+			s.Conditions[i].Message = condition.Message
+
+			return
+		}
+	}
+
+	s.Conditions = append(s.Conditions, condition)
+}
+
+// InstanceMigration migrates an instance to the ServiceCluster of another region.
+//
+// The instance is recreated with the CRD of the target Offering. When the migrated instance is ready,
+// it replaces the original instance, which is removed afterwards. Providers can configure hooks on the CatalogEntry,
+// that are called before the instance is created and before the original instance is removed.
+// If a hook fails or the migrated instance does not become ready in time, the migration is rolled back.
+//
+// **Example**
+// ```yaml
+// apiVersion: catalog.kubecarrier.io/v1alpha1
+// kind: InstanceMigration
+// metadata:
+//   name: db1-to-eu-west-2
+// spec:
+//   offering:
+//     name: couchdbs.eu-west-1.example-provider
+//   instance:
+//     name: db1
+//   targetOffering:
+//     name: couchdbs.eu-west-2.example-provider
+// ```
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.instance.name"
+// +kubebuilder:printcolumn:name="Offering",type="string",JSONPath=".spec.offering.name"
+// +kubebuilder:printcolumn:name="Target Offering",type="string",JSONPath=".spec.targetOffering.name"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:categories=all;kubecarrier-tenant,shortName=im
+type InstanceMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceMigrationSpec   `json:"spec,omitempty"`
+	Status InstanceMigrationStatus `json:"status,omitempty"`
+}
+
+// InstanceMigrationList contains a list of InstanceMigration.
+// +kubebuilder:object:root=true
+type InstanceMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceMigration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InstanceMigration{}, &InstanceMigrationList{})
+}
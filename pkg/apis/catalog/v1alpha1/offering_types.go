@@ -27,6 +27,13 @@ type OfferingSpec struct {
 	Provider ObjectReference `json:"provider"`
 	// CRD holds the information about the underlying CRD that is offered by this offering.
 	CRD CRDInformation `json:"crd,omitempty"`
+	// BundledCRDs holds the information about further CRDs that are offered together with the CRD,
+	// e.g. Backups of a database.
+	// +optional
+	BundledCRDs []CRDInformation `json:"bundledCRDs,omitempty"`
+	// Lifecycle is propagated from the CatalogEntry of this Offering.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
 }
 
 // OfferingMetadata contains the metadata (display name, description, etc) of the Offering.
@@ -40,6 +47,7 @@ type OfferingMetadata struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.metadata.displayName"
 // +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider.name"
+// +kubebuilder:printcolumn:name="Lifecycle",type="string",JSONPath=".spec.lifecycle.state"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:categories=all;kubecarrier-tenant,shortName=off
 type Offering struct {
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuotaSpec describes the limits enforced by a Quota.
+type QuotaSpec struct {
+	// Catalog references the Catalog this Quota applies to.
+	// The Quota is enforced for all Tenants selected by the Catalog.
+	Catalog ObjectReference `json:"catalog"`
+	// Tenant limits this Quota to a single Tenant of the Catalog.
+	// +optional
+	Tenant *ObjectReference `json:"tenant,omitempty"`
+	// Limits per CatalogEntry.
+	// +kubebuilder:validation:MinItems=1
+	Limits []CatalogEntryLimit `json:"limits"`
+}
+
+// CatalogEntryLimit describes the limits of a Tenant for a single CatalogEntry.
+type CatalogEntryLimit struct {
+	// CatalogEntry references the CatalogEntry that is limited.
+	CatalogEntry ObjectReference `json:"catalogEntry"`
+	// MaxInstances is the maximum number of instances a Tenant can create.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxInstances *int64 `json:"maxInstances,omitempty"`
+	// Fields limits the sum of numeric fields over all instances of a Tenant.
+	// +optional
+	Fields []FieldLimit `json:"fields,omitempty"`
+}
+
+// FieldLimit limits the sum of a numeric field.
+type FieldLimit struct {
+	// JSONPath of the field in the provider object, e.g. .spec.storage
+	// The field can either hold a number or a quantity like "10Gi".
+	JSONPath string `json:"jsonPath"`
+	// Max is the maximum sum of the field over all instances of a Tenant.
+	Max resource.Quantity `json:"max"`
+}
+
+// QuotaStatus represents the observed state of Quota.
+type QuotaStatus struct {
+	// Usage is the current usage per Tenant and CatalogEntry.
+	Usage []QuotaUsage `json:"usage,omitempty"`
+}
+
+// QuotaUsage is the current usage of a Tenant for a single CatalogEntry.
+type QuotaUsage struct {
+	// Tenant references the Tenant.
+	Tenant ObjectReference `json:"tenant"`
+	// CatalogEntry references the CatalogEntry.
+	CatalogEntry ObjectReference `json:"catalogEntry"`
+	// Instances is the number of instances the Tenant has created.
+	Instances int64 `json:"instances"`
+	// Fields is the current sum of each limited field.
+	Fields []FieldUsage `json:"fields,omitempty"`
+}
+
+// FieldUsage is the current sum of a numeric field.
+type FieldUsage struct {
+	// JSONPath of the field in the provider object.
+	JSONPath string `json:"jsonPath"`
+	// Used is the sum of the field over all instances of a Tenant.
+	Used resource.Quantity `json:"used"`
+}
+
+// GetLimit returns the limits for the given CatalogEntry, if they exist.
+func (s *QuotaSpec) GetLimit(catalogEntry string) (limit CatalogEntryLimit, exists bool) {
+	for _, l := range s.Limits {
+		if l.CatalogEntry.Name == catalogEntry {
+			return l, true
+		}
+	}
+	return
+}
+
+// AppliesToTenant returns true if the Quota is not limited to a single Tenant or if it's limited to the given Tenant.
+func (s *QuotaSpec) AppliesToTenant(tenant string) bool {
+	return s.Tenant == nil || s.Tenant.Name == tenant
+}
+
+// Quota limits the number of instances and the sum of numeric fields, that Tenants can create from a CatalogEntry.
+//
+// Quotas are enforced by the Elevator when Tenants create or update instances.
+// If multiple Quotas apply to a Tenant, all of them have to be satisfied.
+//
+// **Example**
+// ```yaml
+// apiVersion: catalog.kubecarrier.io/v1alpha1
+// kind: Quota
+// metadata:
+//   name: free-tier
+// spec:
+//   catalog:
+//     name: free-tier
+//   limits:
+//   - catalogEntry:
+//       name: couchdbs.eu-west-1
+//     maxInstances: 2
+//     fields:
+//     - jsonPath: .spec.storage
+//       max: 10Gi
+// ```
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Catalog",type="string",JSONPath=".spec.catalog.name"
+// +kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".spec.tenant.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:path=quotas,categories=all;kubecarrier-provider,shortName=qt
+type Quota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuotaSpec   `json:"spec,omitempty"`
+	Status QuotaStatus `json:"status,omitempty"`
+}
+
+// QuotaList contains a list of Quota.
+// +kubebuilder:object:root=true
+type QuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Quota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Quota{}, &QuotaList{})
+}
@@ -29,6 +29,44 @@ type RegionSpec struct {
 
 	// Provider references the Provider that this ServiceCluster belongs to.
 	Provider ObjectReference `json:"provider"`
+
+	// Health is propagated from the conditions of the ServiceCluster.
+	// +optional
+	Health *RegionHealth `json:"health,omitempty"`
+
+	// Capacity is propagated from the status of the ServiceCluster.
+	// +optional
+	Capacity *corev1alpha1.ServiceClusterCapacity `json:"capacity,omitempty"`
+
+	// Maintenance is propagated from the ServiceCluster.
+	// No new instances can be created in this Region, while the maintenance is in effect.
+	// +optional
+	Maintenance *corev1alpha1.ServiceClusterMaintenance `json:"maintenance,omitempty"`
+}
+
+// RegionHealthState describes whether the ServiceCluster of a Region is operational.
+type RegionHealthState string
+
+// Values of RegionHealthState.
+const (
+	// RegionHealthy means that the ServiceCluster is ready.
+	RegionHealthy RegionHealthState = "Healthy"
+	// RegionDegraded means that the ServiceCluster is not ready, e.g. unreachable or failing its health checks.
+	RegionDegraded RegionHealthState = "Degraded"
+	// RegionHealthUnknown means that the ServiceCluster did not report its readiness yet.
+	RegionHealthUnknown RegionHealthState = "Unknown"
+)
+
+// RegionHealth describes the health of the ServiceCluster of a Region.
+type RegionHealth struct {
+	// State of the Region, one of (Healthy, Degraded, Unknown).
+	State RegionHealthState `json:"state"`
+	// Reason is the (brief) reason for the current state.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is the human readable message indicating details about the current state.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // Region exposes information about a Providers Cluster.
@@ -37,6 +75,7 @@ type RegionSpec struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider.name"
 // +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.metadata.displayName"
+// +kubebuilder:printcolumn:name="Health",type="string",JSONPath=".spec.health.state"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:categories=all;kubecarrier-tenant,shortName=scr
 type Region struct {
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SchedulingServiceClusterSelectorAnnotation can be set on instances of an aggregated CRD
+	// to restrict the ServiceClusters the instance may be scheduled to by a label selector.
+	SchedulingServiceClusterSelectorAnnotation = "scheduling.kubecarrier.io/service-cluster-selector"
+	// SchedulingPreferredServiceClusterAnnotation can be set on instances of an aggregated CRD
+	// to request a specific ServiceCluster, if it is available.
+	SchedulingPreferredServiceClusterAnnotation = "scheduling.kubecarrier.io/preferred-service-cluster"
+)
+
+// SchedulingConfig enables an aggregated CRD for a CatalogEntrySet.
+// Instances of the aggregated CRD are scheduled to one of the ServiceClusters of the CatalogEntrySet,
+// and are created as instances of the region specific CRD of the selected ServiceCluster.
+type SchedulingConfig struct {
+	// Policy to select a ServiceCluster for new instances.
+	// LeastLoaded (by default): selects the ServiceCluster with the least instances of the CatalogEntrySet.
+	// Spread: selects the ServiceCluster with the least instances of the Tenant, to spread instances of a Tenant.
+	// Preferred: selects the first available ServiceCluster of PreferredServiceClusters.
+	// +kubebuilder:default:=LeastLoaded
+	// +kubebuilder:validation:Enum=LeastLoaded;Spread;Preferred
+	Policy SchedulingPolicy `json:"policy,omitempty"`
+	// PreferredServiceClusters is an ordered list of ServiceClusters used by the Preferred policy.
+	// +optional
+	PreferredServiceClusters []ObjectReference `json:"preferredServiceClusters,omitempty"`
+}
+
+// SchedulingPolicy is the policy to select a ServiceCluster for an instance.
+type SchedulingPolicy string
+
+// Values of SchedulingPolicy.
+const (
+	SchedulingPolicyLeastLoaded SchedulingPolicy = "LeastLoaded"
+	SchedulingPolicySpread      SchedulingPolicy = "Spread"
+	SchedulingPolicyPreferred   SchedulingPolicy = "Preferred"
+)
+
+// InstanceScheduling records the scheduling decision for an instance of an aggregated CRD.
+// It is reported in the .status.scheduling field of the instance.
+type InstanceScheduling struct {
+	// ServiceCluster the instance is scheduled to.
+	ServiceCluster ObjectReference `json:"serviceCluster,omitempty"`
+	// Offering is the region specific Offering, the instance was created with.
+	Offering ObjectReference `json:"offering,omitempty"`
+	// Policy that was used to select the ServiceCluster.
+	Policy SchedulingPolicy `json:"policy,omitempty"`
+	// Reason is the (brief) reason for the scheduling decision.
+	Reason InstanceSchedulingReason `json:"reason"`
+	// Message is the human readable message indicating details about the scheduling decision.
+	Message string `json:"message,omitempty"`
+	// ScheduledAt is the time the instance was scheduled.
+	ScheduledAt *metav1.Time `json:"scheduledAt,omitempty"`
+}
+
+// InstanceSchedulingReason is the reason of a scheduling decision.
+type InstanceSchedulingReason string
+
+// Values of InstanceSchedulingReason.
+const (
+	// InstanceScheduled means the ServiceCluster was selected by the SchedulingPolicy.
+	InstanceScheduled InstanceSchedulingReason = "Scheduled"
+	// InstanceScheduledPreferred means the ServiceCluster was requested by the instance.
+	InstanceScheduledPreferred InstanceSchedulingReason = "Preferred"
+	// InstanceUnschedulable means no ServiceCluster satisfies the constraints of the instance.
+	InstanceUnschedulable InstanceSchedulingReason = "Unschedulable"
+	// InstanceSchedulingConflict means the region specific instance already exists and is not owned by the instance.
+	InstanceSchedulingConflict InstanceSchedulingReason = "Conflict"
+	// InstanceMigrated means the instance was moved to another ServiceCluster by an InstanceMigration.
+	InstanceMigrated InstanceSchedulingReason = "Migrated"
+)
+
+// IsScheduled returns true, if a ServiceCluster has been selected for the instance.
+func (s *InstanceScheduling) IsScheduled() bool {
+	return s.ServiceCluster.Name != ""
+}
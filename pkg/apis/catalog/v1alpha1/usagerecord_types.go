@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// UsageRecordOpenLabel is set on UsageRecords that have no EndTime yet.
+	UsageRecordOpenLabel = "catalog.kubecarrier.io/usage-record-open"
+)
+
+// UsageRecordSpec describes the usage of a single instance over a period of time.
+type UsageRecordSpec struct {
+	// Tenant references the Tenant owning the instance.
+	Tenant ObjectReference `json:"tenant"`
+	// CatalogEntry references the CatalogEntry, that the instance was created from.
+	CatalogEntry ObjectReference `json:"catalogEntry"`
+	// Offering references the Offering in the Tenant namespace.
+	Offering ObjectReference `json:"offering"`
+	// Region references the Region of the instance.
+	Region ObjectReference `json:"region"`
+	// Instance references the instance in the Tenant namespace.
+	Instance ObjectReference `json:"instance"`
+	// Generation of the instance.
+	Generation int64 `json:"generation"`
+	// Ready is true, if the instance was ready during this period.
+	Ready bool `json:"ready"`
+	// Reason describes the instance lifecycle event that started this period.
+	Reason UsageRecordReason `json:"reason"`
+	// Dimensions are the values of the metering dimensions configured on the CatalogEntry.
+	// +optional
+	Dimensions []UsageDimension `json:"dimensions,omitempty"`
+	// StartTime is the start of the period.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the end of the period, unset while the period is still open.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+// UsageRecordStatus represents the observed state of a UsageRecord.
+type UsageRecordStatus struct {
+	// StartedExported is true, once the started event of the UsageRecord was exported to the metering sink.
+	// +optional
+	StartedExported bool `json:"startedExported,omitempty"`
+	// EndedExported is true, once the ended event of the UsageRecord was exported to the metering sink.
+	// +optional
+	EndedExported bool `json:"endedExported,omitempty"`
+}
+
+// UsageRecordReason describes the instance lifecycle event that started a UsageRecord.
+type UsageRecordReason string
+
+// Values of UsageRecordReason.
+const (
+	UsageRecordReasonCreated  UsageRecordReason = "Created"
+	UsageRecordReasonUpdated  UsageRecordReason = "Updated"
+	UsageRecordReasonReady    UsageRecordReason = "Ready"
+	UsageRecordReasonNotReady UsageRecordReason = "NotReady"
+)
+
+// UsageDimension is the value of a metering dimension.
+type UsageDimension struct {
+	// Name of the dimension.
+	Name string `json:"name"`
+	// Value of the dimension.
+	Value resource.Quantity `json:"value"`
+}
+
+// IsOpen returns true, if the UsageRecord has not ended yet.
+func (r *UsageRecord) IsOpen() bool {
+	return r.Spec.EndTime == nil
+}
+
+// IsExported returns true, if all events of the UsageRecord have been exported.
+func (r *UsageRecord) IsExported() bool {
+	return r.Status.StartedExported && (r.IsOpen() || r.Status.EndedExported)
+}
+
+// UsageRecord describes the usage of a Tenant instance over a period of time, for billing.
+//
+// UsageRecords are created by KubeCarrier in the Provider namespace for all instances of CatalogEntries with metering configured.
+// A new UsageRecord is started and the previous one is ended, when the instance is created, changed or deleted
+// and when it becomes ready or not ready.
+// The started and ended events of UsageRecords are exported to the metering sink of the CatalogEntry,
+// until the export succeeded.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".spec.tenant.name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.instance.name"
+// +kubebuilder:printcolumn:name="CatalogEntry",type="string",JSONPath=".spec.catalogEntry.name"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".spec.ready"
+// +kubebuilder:printcolumn:name="Start",type="date",JSONPath=".spec.startTime"
+// +kubebuilder:printcolumn:name="End",type="date",JSONPath=".spec.endTime"
+// +kubebuilder:resource:categories=kubecarrier-provider,shortName=ur
+type UsageRecord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UsageRecordSpec   `json:"spec,omitempty"`
+	Status UsageRecordStatus `json:"status,omitempty"`
+}
+
+// UsageRecordList contains a list of UsageRecord.
+// +kubebuilder:object:root=true
+type UsageRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UsageRecord `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&UsageRecord{}, &UsageRecordList{})
+}
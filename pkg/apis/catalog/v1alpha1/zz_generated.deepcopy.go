@@ -21,12 +21,89 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"k8s.io/api/rbac/v1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKey) DeepCopyInto(out *APIKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKey.
+func (in *APIKey) DeepCopy() *APIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyList) DeepCopyInto(out *APIKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyList.
+func (in *APIKeyList) DeepCopy() *APIKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeySpec) DeepCopyInto(out *APIKeySpec) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeySpec.
+func (in *APIKeySpec) DeepCopy() *APIKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Account) DeepCopyInto(out *Account) {
 	*out = *in
@@ -129,7 +206,7 @@ func (in *AccountSpec) DeepCopyInto(out *AccountSpec) {
 	}
 	if in.Subjects != nil {
 		in, out := &in.Subjects, &out.Subjects
-		*out = make([]v1.Subject, len(*in))
+		*out = make([]AccountSubject, len(*in))
 		copy(*out, *in)
 	}
 }
@@ -171,6 +248,22 @@ func (in *AccountStatus) DeepCopy() *AccountStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSubject) DeepCopyInto(out *AccountSubject) {
+	*out = *in
+	out.Subject = in.Subject
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSubject.
+func (in *AccountSubject) DeepCopy() *AccountSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CRDInformation) DeepCopyInto(out *CRDInformation) {
 	*out = *in
@@ -300,6 +393,34 @@ func (in *CatalogEntryCondition) DeepCopy() *CatalogEntryCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogEntryLimit) DeepCopyInto(out *CatalogEntryLimit) {
+	*out = *in
+	out.CatalogEntry = in.CatalogEntry
+	if in.MaxInstances != nil {
+		in, out := &in.MaxInstances, &out.MaxInstances
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntryLimit.
+func (in *CatalogEntryLimit) DeepCopy() *CatalogEntryLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogEntryLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatalogEntryList) DeepCopyInto(out *CatalogEntryList) {
 	*out = *in
@@ -448,7 +569,27 @@ func (in *CatalogEntrySetSpec) DeepCopyInto(out *CatalogEntrySetSpec) {
 		*out = new(DerivedConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReferencedObjects != nil {
+		in, out := &in.ReferencedObjects, &out.ReferencedObjects
+		*out = make([]ReferencedObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metering != nil {
+		in, out := &in.Metering, &out.Metering
+		*out = new(MeteringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Discover.DeepCopyInto(&out.Discover)
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntrySetSpec.
@@ -471,6 +612,11 @@ func (in *CatalogEntrySetStatus) DeepCopyInto(out *CatalogEntrySetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AggregatedCRD != nil {
+		in, out := &in.AggregatedCRD, &out.AggregatedCRD
+		*out = new(CRDInformation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntrySetStatus.
@@ -493,6 +639,36 @@ func (in *CatalogEntrySpec) DeepCopyInto(out *CatalogEntrySpec) {
 		*out = new(DerivedConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReferencedObjects != nil {
+		in, out := &in.ReferencedObjects, &out.ReferencedObjects
+		*out = make([]ReferencedObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bundle != nil {
+		in, out := &in.Bundle, &out.Bundle
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectReferences != nil {
+		in, out := &in.ObjectReferences, &out.ObjectReferences
+		*out = make([]ObjectReferenceField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metering != nil {
+		in, out := &in.Metering, &out.Metering
+		*out = new(MeteringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntrySpec.
@@ -574,12 +750,12 @@ func (in *CatalogSpec) DeepCopyInto(out *CatalogSpec) {
 	*out = *in
 	if in.CatalogEntrySelector != nil {
 		in, out := &in.CatalogEntrySelector, &out.CatalogEntrySelector
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TenantSelector != nil {
 		in, out := &in.TenantSelector, &out.TenantSelector
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -776,6 +952,16 @@ func (in *DerivedCustomResourceSpec) DeepCopyInto(out *DerivedCustomResourceSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReferencedObjects != nil {
+		in, out := &in.ReferencedObjects, &out.ReferencedObjects
+		*out = make([]ReferencedObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectReferences != nil {
+		in, out := &in.ObjectReferences, &out.ObjectReferences
+		*out = make([]ObjectReferenceField, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DerivedCustomResourceSpec.
@@ -815,6 +1001,38 @@ func (in *DerivedCustomResourceStatus) DeepCopy() *DerivedCustomResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldDefault) DeepCopyInto(out *FieldDefault) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldDefault.
+func (in *FieldDefault) DeepCopy() *FieldDefault {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldDefault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldLimit) DeepCopyInto(out *FieldLimit) {
+	*out = *in
+	out.Max = in.Max.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldLimit.
+func (in *FieldLimit) DeepCopy() *FieldLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FieldPath) DeepCopyInto(out *FieldPath) {
 	*out = *in
@@ -831,60 +1049,62 @@ func (in *FieldPath) DeepCopy() *FieldPath {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *FieldUsage) DeepCopyInto(out *FieldUsage) {
 	*out = *in
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
+	out.Used = in.Used.DeepCopy()
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldUsage.
+func (in *FieldUsage) DeepCopy() *FieldUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(Image)
+	out := new(FieldUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
-func (in *ObjectReference) DeepCopy() *ObjectReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectReference)
+	out := new(Image)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Offering) DeepCopyInto(out *Offering) {
+func (in *InstanceMigration) DeepCopyInto(out *InstanceMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Offering.
-func (in *Offering) DeepCopy() *Offering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMigration.
+func (in *InstanceMigration) DeepCopy() *InstanceMigration {
 	if in == nil {
 		return nil
 	}
-	out := new(Offering)
+	out := new(InstanceMigration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Offering) DeepCopyObject() runtime.Object {
+func (in *InstanceMigration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -892,31 +1112,47 @@ func (in *Offering) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OfferingList) DeepCopyInto(out *OfferingList) {
+func (in *InstanceMigrationCondition) DeepCopyInto(out *InstanceMigrationCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMigrationCondition.
+func (in *InstanceMigrationCondition) DeepCopy() *InstanceMigrationCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMigrationCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMigrationList) DeepCopyInto(out *InstanceMigrationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Offering, len(*in))
+		*out = make([]InstanceMigration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OfferingList.
-func (in *OfferingList) DeepCopy() *OfferingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMigrationList.
+func (in *InstanceMigrationList) DeepCopy() *InstanceMigrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(OfferingList)
+	out := new(InstanceMigrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OfferingList) DeepCopyObject() runtime.Object {
+func (in *InstanceMigrationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -924,123 +1160,545 @@ func (in *OfferingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OfferingMetadata) DeepCopyInto(out *OfferingMetadata) {
+func (in *InstanceMigrationSpec) DeepCopyInto(out *InstanceMigrationSpec) {
 	*out = *in
-	in.CommonMetadata.DeepCopyInto(&out.CommonMetadata)
+	out.Offering = in.Offering
+	out.Instance = in.Instance
+	out.TargetOffering = in.TargetOffering
+	if in.ReadinessTimeout != nil {
+		in, out := &in.ReadinessTimeout, &out.ReadinessTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OfferingMetadata.
-func (in *OfferingMetadata) DeepCopy() *OfferingMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMigrationSpec.
+func (in *InstanceMigrationSpec) DeepCopy() *InstanceMigrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OfferingMetadata)
+	out := new(InstanceMigrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OfferingSpec) DeepCopyInto(out *OfferingSpec) {
+func (in *InstanceMigrationStatus) DeepCopyInto(out *InstanceMigrationStatus) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	out.Provider = in.Provider
-	in.CRD.DeepCopyInto(&out.CRD)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]InstanceMigrationCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OfferingSpec.
-func (in *OfferingSpec) DeepCopy() *OfferingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMigrationStatus.
+func (in *InstanceMigrationStatus) DeepCopy() *InstanceMigrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OfferingSpec)
+	out := new(InstanceMigrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Provider) DeepCopyInto(out *Provider) {
+func (in *InstanceScheduling) DeepCopyInto(out *InstanceScheduling) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.ServiceCluster = in.ServiceCluster
+	out.Offering = in.Offering
+	if in.ScheduledAt != nil {
+		in, out := &in.ScheduledAt, &out.ScheduledAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
-func (in *Provider) DeepCopy() *Provider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceScheduling.
+func (in *InstanceScheduling) DeepCopy() *InstanceScheduling {
 	if in == nil {
 		return nil
 	}
-	out := new(Provider)
+	out := new(InstanceScheduling)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Provider) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderList) DeepCopyInto(out *ProviderList) {
+func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Provider, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.SunsetDate != nil {
+		in, out := &in.SunsetDate, &out.SunsetDate
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderList.
-func (in *ProviderList) DeepCopy() *ProviderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lifecycle.
+func (in *Lifecycle) DeepCopy() *Lifecycle {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderList)
+	out := new(Lifecycle)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderSpec) DeepCopyInto(out *ProviderSpec) {
+func (in *MeteringConfig) DeepCopyInto(out *MeteringConfig) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]MeteringDimension, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderSpec.
-func (in *ProviderSpec) DeepCopy() *ProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeteringConfig.
+func (in *MeteringConfig) DeepCopy() *MeteringConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderSpec)
+	out := new(MeteringConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Region) DeepCopyInto(out *Region) {
+func (in *MeteringDimension) DeepCopyInto(out *MeteringDimension) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
-func (in *Region) DeepCopy() *Region {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeteringDimension.
+func (in *MeteringDimension) DeepCopy() *MeteringDimension {
+	if in == nil {
+		return nil
+	}
+	out := new(MeteringDimension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationConfig) DeepCopyInto(out *MigrationConfig) {
+	*out = *in
+	if in.PreHook != nil {
+		in, out := &in.PreHook, &out.PreHook
+		*out = new(MigrationHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostHook != nil {
+		in, out := &in.PostHook, &out.PostHook
+		*out = new(MigrationHook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationConfig.
+func (in *MigrationConfig) DeepCopy() *MigrationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationHook) DeepCopyInto(out *MigrationHook) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationHook.
+func (in *MigrationHook) DeepCopy() *MigrationHook {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
+func (in *ObjectReference) DeepCopy() *ObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReferenceField) DeepCopyInto(out *ObjectReferenceField) {
+	*out = *in
+	out.CatalogEntry = in.CatalogEntry
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReferenceField.
+func (in *ObjectReferenceField) DeepCopy() *ObjectReferenceField {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReferenceField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Offering) DeepCopyInto(out *Offering) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Offering.
+func (in *Offering) DeepCopy() *Offering {
+	if in == nil {
+		return nil
+	}
+	out := new(Offering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Offering) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OfferingList) DeepCopyInto(out *OfferingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Offering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OfferingList.
+func (in *OfferingList) DeepCopy() *OfferingList {
+	if in == nil {
+		return nil
+	}
+	out := new(OfferingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OfferingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OfferingMetadata) DeepCopyInto(out *OfferingMetadata) {
+	*out = *in
+	in.CommonMetadata.DeepCopyInto(&out.CommonMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OfferingMetadata.
+func (in *OfferingMetadata) DeepCopy() *OfferingMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(OfferingMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OfferingSpec) DeepCopyInto(out *OfferingSpec) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	out.Provider = in.Provider
+	in.CRD.DeepCopyInto(&out.CRD)
+	if in.BundledCRDs != nil {
+		in, out := &in.BundledCRDs, &out.BundledCRDs
+		*out = make([]CRDInformation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OfferingSpec.
+func (in *OfferingSpec) DeepCopy() *OfferingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OfferingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Provider) DeepCopyInto(out *Provider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
+func (in *Provider) DeepCopy() *Provider {
+	if in == nil {
+		return nil
+	}
+	out := new(Provider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Provider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderList) DeepCopyInto(out *ProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Provider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderList.
+func (in *ProviderList) DeepCopy() *ProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderSpec) DeepCopyInto(out *ProviderSpec) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderSpec.
+func (in *ProviderSpec) DeepCopy() *ProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Quota) DeepCopyInto(out *Quota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quota.
+func (in *Quota) DeepCopy() *Quota {
+	if in == nil {
+		return nil
+	}
+	out := new(Quota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Quota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaList) DeepCopyInto(out *QuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Quota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaList.
+func (in *QuotaList) DeepCopy() *QuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
+	*out = *in
+	out.Catalog = in.Catalog
+	if in.Tenant != nil {
+		in, out := &in.Tenant, &out.Tenant
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]CatalogEntryLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSpec.
+func (in *QuotaSpec) DeepCopy() *QuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaStatus) DeepCopyInto(out *QuotaStatus) {
+	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make([]QuotaUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaStatus.
+func (in *QuotaStatus) DeepCopy() *QuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
+	*out = *in
+	out.Tenant = in.Tenant
+	out.CatalogEntry = in.CatalogEntry
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferencedObject) DeepCopyInto(out *ReferencedObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferencedObject.
+func (in *ReferencedObject) DeepCopy() *ReferencedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferencedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Region) DeepCopyInto(out *Region) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
+func (in *Region) DeepCopy() *Region {
 	if in == nil {
 		return nil
 	}
@@ -1057,6 +1715,21 @@ func (in *Region) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionHealth) DeepCopyInto(out *RegionHealth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionHealth.
+func (in *RegionHealth) DeepCopy() *RegionHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegionList) DeepCopyInto(out *RegionList) {
 	*out = *in
@@ -1094,6 +1767,21 @@ func (in *RegionSpec) DeepCopyInto(out *RegionSpec) {
 	*out = *in
 	out.Metadata = in.Metadata
 	out.Provider = in.Provider
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(RegionHealth)
+		**out = **in
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(corev1alpha1.ServiceClusterCapacity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(corev1alpha1.ServiceClusterMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionSpec.
@@ -1106,6 +1794,26 @@ func (in *RegionSpec) DeepCopy() *RegionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingConfig) DeepCopyInto(out *SchedulingConfig) {
+	*out = *in
+	if in.PreferredServiceClusters != nil {
+		in, out := &in.PreferredServiceClusters, &out.PreferredServiceClusters
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConfig.
+func (in *SchedulingConfig) DeepCopy() *SchedulingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tenant) DeepCopyInto(out *Tenant) {
 	*out = *in
@@ -1179,6 +1887,128 @@ func (in *TenantSpec) DeepCopy() *TenantSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageDimension) DeepCopyInto(out *UsageDimension) {
+	*out = *in
+	out.Value = in.Value.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageDimension.
+func (in *UsageDimension) DeepCopy() *UsageDimension {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageDimension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageRecord) DeepCopyInto(out *UsageRecord) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageRecord.
+func (in *UsageRecord) DeepCopy() *UsageRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UsageRecord) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageRecordList) DeepCopyInto(out *UsageRecordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UsageRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageRecordList.
+func (in *UsageRecordList) DeepCopy() *UsageRecordList {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageRecordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UsageRecordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageRecordSpec) DeepCopyInto(out *UsageRecordSpec) {
+	*out = *in
+	out.Tenant = in.Tenant
+	out.CatalogEntry = in.CatalogEntry
+	out.Offering = in.Offering
+	out.Region = in.Region
+	out.Instance = in.Instance
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]UsageDimension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageRecordSpec.
+func (in *UsageRecordSpec) DeepCopy() *UsageRecordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageRecordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageRecordStatus) DeepCopyInto(out *UsageRecordStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageRecordStatus.
+func (in *UsageRecordStatus) DeepCopy() *UsageRecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageRecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VersionExposeConfig) DeepCopyInto(out *VersionExposeConfig) {
 	*out = *in
@@ -1192,6 +2022,13 @@ func (in *VersionExposeConfig) DeepCopyInto(out *VersionExposeConfig) {
 		*out = make([]FieldPath, len(*in))
 		copy(*out, *in)
 	}
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = make([]FieldDefault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionExposeConfig.
@@ -17,6 +17,10 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/version"
 )
@@ -25,8 +29,149 @@ import (
 type ServiceClusterSpec struct {
 	// Metadata for display in the Service Catalog.
 	Metadata ServiceClusterMetadata `json:"metadata,omitempty"`
+	// Connection specifies how KubeCarrier connects to the ServiceCluster.
+	// +kubebuilder:default:=Kubeconfig
+	// +optional
+	Connection ServiceClusterConnectionType `json:"connection,omitempty"`
 	// KubeconfigSecret specifies the Kubeconfig to use when connecting to the ServiceCluster.
-	KubeconfigSecret ObjectReference `json:"kubeconfigSecret"`
+	// Required when the Kubeconfig connection is used.
+	// +optional
+	KubeconfigSecret *ObjectReference `json:"kubeconfigSecret,omitempty"`
+	// NamespaceTemplate describes isolation policies,
+	// that are applied to every Namespace assigned to a tenant in this ServiceCluster.
+	// +optional
+	NamespaceTemplate *ServiceClusterNamespaceTemplate `json:"namespaceTemplate,omitempty"`
+	// HealthProbes configures the checks, that decide whether the ServiceCluster is healthy,
+	// beyond the reachability of its API Server.
+	// +optional
+	HealthProbes *ServiceClusterHealthProbes `json:"healthProbes,omitempty"`
+	// Maintenance cordons the ServiceCluster, so no new instances are created in it.
+	// Existing instances are still kept in sync.
+	// +optional
+	Maintenance *ServiceClusterMaintenance `json:"maintenance,omitempty"`
+}
+
+// ServiceClusterMaintenance describes a maintenance of a ServiceCluster.
+type ServiceClusterMaintenance struct {
+	// Reason is the human readable reason for the maintenance.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Start of the maintenance window.
+	// The maintenance is in effect immediately, if not set.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+	// End of the maintenance window.
+	// The maintenance is in effect until it's removed, if not set.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// InEffect returns whether the given time is within the maintenance window.
+func (m *ServiceClusterMaintenance) InEffect(now time.Time) bool {
+	if m == nil {
+		return false
+	}
+	if m.Start != nil && now.Before(m.Start.Time) {
+		return false
+	}
+	if m.End != nil && !now.Before(m.End.Time) {
+		return false
+	}
+	return true
+}
+
+const (
+	// DefaultMinReadyNodesPercent is used when ServiceClusterHealthProbes.MinReadyNodesPercent is not set.
+	DefaultMinReadyNodesPercent int32 = 50
+	// DefaultMaxAPILatency is used when ServiceClusterHealthProbes.MaxAPILatency is not set.
+	DefaultMaxAPILatency = 5 * time.Second
+)
+
+// ServiceClusterHealthProbes configures the health checks of a ServiceCluster.
+type ServiceClusterHealthProbes struct {
+	// MinReadyNodesPercent is the minimum percentage of Nodes, that need to be Ready.
+	// Defaults to 50.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinReadyNodesPercent *int32 `json:"minReadyNodesPercent,omitempty"`
+	// MaxAPILatency is the maximum time the API Server may take to respond.
+	// Defaults to 5s.
+	// +optional
+	MaxAPILatency *metav1.Duration `json:"maxAPILatency,omitempty"`
+	// Operators lists the Deployments of the operators backing CustomResourceDefinitions in the ServiceCluster.
+	// The Deployment of an operator needs to be present and available, when its CustomResourceDefinition is discovered.
+	// +optional
+	Operators []ServiceClusterOperatorProbe `json:"operators,omitempty"`
+}
+
+// ServiceClusterOperatorProbe references the Deployment of an operator in the ServiceCluster.
+type ServiceClusterOperatorProbe struct {
+	// CRD references the CustomResourceDefinition the operator is reconciling.
+	CRD ObjectReference `json:"crd"`
+	// Deployment references the Deployment of the operator.
+	Deployment NamespacedObjectReference `json:"deployment"`
+}
+
+// MinReadyNodesPercentOrDefault returns the configured minimum percentage of Ready Nodes or its default.
+func (p *ServiceClusterHealthProbes) MinReadyNodesPercentOrDefault() int32 {
+	if p == nil || p.MinReadyNodesPercent == nil {
+		return DefaultMinReadyNodesPercent
+	}
+	return *p.MinReadyNodesPercent
+}
+
+// MaxAPILatencyOrDefault returns the configured maximum API Server latency or its default.
+func (p *ServiceClusterHealthProbes) MaxAPILatencyOrDefault() time.Duration {
+	if p == nil || p.MaxAPILatency == nil {
+		return DefaultMaxAPILatency
+	}
+	return p.MaxAPILatency.Duration
+}
+
+// ServiceClusterNamespaceTemplate describes the isolation policies of tenant Namespaces in a ServiceCluster.
+//
+// String values may reference the tenant using Go templates:
+// `{{.Tenant.Name}}` is the name of the tenant Account and
+// `{{index .Tenant.Labels "example.com/team"}}` is the value of a label of the tenant Account.
+type ServiceClusterNamespaceTemplate struct {
+	// Labels are added to the Namespace.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the Namespace.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ResourceQuota limits the aggregate resource consumption of the Namespace.
+	// +optional
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	// LimitRange constrains the resources of single objects in the Namespace.
+	// +optional
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty"`
+	// NetworkPolicy restricts the traffic to and from Pods in the Namespace.
+	// +optional
+	NetworkPolicy *networkingv1.NetworkPolicySpec `json:"networkPolicy,omitempty"`
+}
+
+// ServiceClusterConnectionType describes how KubeCarrier connects to a ServiceCluster.
+// +kubebuilder:validation:Enum=Kubeconfig;Agent
+type ServiceClusterConnectionType string
+
+const (
+	// ServiceClusterConnectionKubeconfig connects to the ServiceCluster API Server directly,
+	// using the Kubeconfig from the referenced KubeconfigSecret.
+	ServiceClusterConnectionKubeconfig ServiceClusterConnectionType = "Kubeconfig"
+	// ServiceClusterConnectionAgent connects to the ServiceCluster through an agent,
+	// that is running in the ServiceCluster and dials out to the management cluster.
+	// This mode is suitable for ServiceClusters that are not reachable from the management cluster.
+	ServiceClusterConnectionAgent ServiceClusterConnectionType = "Agent"
+)
+
+// ConnectionOrDefault returns the configured connection type, defaulting to Kubeconfig.
+func (s ServiceClusterSpec) ConnectionOrDefault() ServiceClusterConnectionType {
+	if s.Connection == "" {
+		return ServiceClusterConnectionKubeconfig
+	}
+	return s.Connection
 }
 
 // ServiceClusterMetadata describes metadata of the ServiceCluster for the Service Catalog.
@@ -51,6 +196,55 @@ type ServiceClusterStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// KubernetesVersion of the service cluster API Server
 	KubernetesVersion *version.Info `json:"kubernetesVersion,omitempty"`
+	// AgentSecret references the Secret holding the token the agent uses to connect
+	// and the CA certificate the agent has to trust, only present when the Agent connection is used.
+	AgentSecret *ObjectReference `json:"agentSecret,omitempty"`
+	// Health reports the results of the last health checks.
+	Health *ServiceClusterHealth `json:"health,omitempty"`
+	// Capacity summarizes the resources and instances of the ServiceCluster.
+	Capacity *ServiceClusterCapacity `json:"capacity,omitempty"`
+}
+
+// ServiceClusterHealth reports the results of the health checks of a ServiceCluster.
+type ServiceClusterHealth struct {
+	// Nodes is the number of Nodes in the ServiceCluster.
+	Nodes int32 `json:"nodes"`
+	// ReadyNodes is the number of Ready Nodes in the ServiceCluster.
+	ReadyNodes int32 `json:"readyNodes"`
+	// APILatency is the time the API Server took to respond.
+	APILatency metav1.Duration `json:"apiLatency"`
+	// Operators reports the state of the probed operator Deployments.
+	Operators []ServiceClusterOperatorHealth `json:"operators,omitempty"`
+}
+
+// ServiceClusterOperatorHealth reports the state of an operator Deployment.
+type ServiceClusterOperatorHealth struct {
+	// CRD references the CustomResourceDefinition the operator is reconciling.
+	CRD ObjectReference `json:"crd"`
+	// Deployment references the Deployment of the operator.
+	Deployment NamespacedObjectReference `json:"deployment"`
+	// Ready is true, when the Deployment is present and available.
+	Ready bool `json:"ready"`
+	// Message is the human readable message indicating why the operator is not ready.
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceClusterCapacity summarizes the resources and instances of a ServiceCluster.
+type ServiceClusterCapacity struct {
+	// Allocatable is the sum of the CPU and memory, that is allocatable on Ready Nodes.
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+	// Requested is the sum of the CPU and memory, that is requested by scheduled Pods.
+	Requested corev1.ResourceList `json:"requested,omitempty"`
+	// Instances is the number of instances per discovered CustomResourceDefinition.
+	Instances []ServiceClusterInstanceCount `json:"instances,omitempty"`
+}
+
+// ServiceClusterInstanceCount is the number of instances of a CustomResourceDefinition.
+type ServiceClusterInstanceCount struct {
+	// CRD references the CustomResourceDefinition of the instances.
+	CRD ObjectReference `json:"crd"`
+	// Count is the number of instances.
+	Count int32 `json:"count"`
 }
 
 // ServiceClusterPhaseType represents all conditions as a single string for printing in kubectl.
@@ -78,6 +272,8 @@ const (
 	ServiceClusterControllerReady ServiceClusterConditionType = "ControllerReady"
 	// ServiceClusterControllerReachable is True if the Remote Service Cluster is Reachable.
 	ServiceClusterReachable ServiceClusterConditionType = "ServiceClusterReachable"
+	// ServiceClusterHealthy is True if the ServiceCluster passes its health checks.
+	ServiceClusterHealthy ServiceClusterConditionType = "Healthy"
 )
 
 // ServiceClusterCondition contains details for the current condition of this ServiceCluster.
@@ -192,10 +388,22 @@ func (s *ServiceClusterStatus) GetCondition(t ServiceClusterConditionType) (cond
 //   kubeconfigSecret:
 //     name: eu-west-1-kubeconfig
 // ```
+//
+// ServiceClusters that are not reachable from the management cluster can be connected via an agent instead:
+// ```yaml
+// apiVersion: kubecarrier.io/v1alpha1
+// kind: ServiceCluster
+// metadata:
+//   name: edge-1
+// spec:
+//   connection: Agent
+// ```
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Connection",type="string",JSONPath=".spec.connection"
 // +kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.metadata.displayName"
+// +kubebuilder:printcolumn:name="Maintenance",type="string",JSONPath=".spec.maintenance.reason"
 // +kubebuilder:printcolumn:name="Kubernetes Version",type="string",JSONPath=".status.kubernetesVersion.gitVersion"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:categories=all;kubecarrier-provider,shortName=sc
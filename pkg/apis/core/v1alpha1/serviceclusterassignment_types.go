@@ -26,6 +26,19 @@ type ServiceClusterAssignmentSpec struct {
 	ServiceCluster ObjectReference `json:"serviceCluster"`
 	// References the source namespace in the management cluster.
 	ManagementClusterNamespace ObjectReference `json:"managementNamespace"`
+	// Tenant owning the ManagementClusterNamespace.
+	// Its values are available to the NamespaceTemplate of the ServiceCluster.
+	// +optional
+	Tenant *ServiceClusterAssignmentTenant `json:"tenant,omitempty"`
+}
+
+// ServiceClusterAssignmentTenant describes the tenant Account of a ServiceClusterAssignment.
+type ServiceClusterAssignmentTenant struct {
+	// Name of the tenant Account.
+	Name string `json:"name"`
+	// Labels of the tenant Account.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // ServiceClusterAssignmentStatus represents the observed state of ServiceClusterAssignment.
@@ -42,6 +55,24 @@ type ServiceClusterAssignmentStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// ServiceClusterNamespace references the Namespace on the ServiceCluster that was assigned.
 	ServiceClusterNamespace *ObjectReference `json:"serviceClusterNamespace,omitempty"`
+	// NamespaceTemplate reports the isolation policies applied to the ServiceClusterNamespace.
+	NamespaceTemplate *AppliedNamespaceTemplate `json:"namespaceTemplate,omitempty"`
+}
+
+// AppliedNamespaceTemplate describes the NamespaceTemplate of a ServiceCluster applied to an assigned Namespace.
+type AppliedNamespaceTemplate struct {
+	// ServiceClusterGeneration is the generation of the ServiceCluster, which NamespaceTemplate was applied.
+	ServiceClusterGeneration int64 `json:"serviceClusterGeneration"`
+	// Objects lists the policy objects created in the ServiceClusterNamespace.
+	Objects []AppliedNamespaceTemplateObject `json:"objects,omitempty"`
+}
+
+// AppliedNamespaceTemplateObject references a policy object created from a NamespaceTemplate.
+type AppliedNamespaceTemplateObject struct {
+	// Kind of the object, i.e. ResourceQuota.
+	Kind string `json:"kind"`
+	// Name of the object.
+	Name string `json:"name"`
 }
 
 // ServiceClusterAssignmentPhaseType represents all conditions as a single string for printing in kubectl.
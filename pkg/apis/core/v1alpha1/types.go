@@ -42,3 +42,11 @@ type ObjectReference struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 }
+
+// NamespacedObjectReference describes the link to another object in a specific Namespace.
+type NamespacedObjectReference struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
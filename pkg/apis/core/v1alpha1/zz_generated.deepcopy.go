@@ -21,11 +21,49 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/version"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedNamespaceTemplate) DeepCopyInto(out *AppliedNamespaceTemplate) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]AppliedNamespaceTemplateObject, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedNamespaceTemplate.
+func (in *AppliedNamespaceTemplate) DeepCopy() *AppliedNamespaceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedNamespaceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedNamespaceTemplateObject) DeepCopyInto(out *AppliedNamespaceTemplateObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedNamespaceTemplateObject.
+func (in *AppliedNamespaceTemplateObject) DeepCopy() *AppliedNamespaceTemplateObject {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedNamespaceTemplateObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResourceDiscovery) DeepCopyInto(out *CustomResourceDiscovery) {
 	*out = *in
@@ -286,6 +324,21 @@ func (in *CustomResourceDiscoveryStatus) DeepCopy() *CustomResourceDiscoveryStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedObjectReference) DeepCopyInto(out *NamespacedObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedObjectReference.
+func (in *NamespacedObjectReference) DeepCopy() *NamespacedObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
 	*out = *in
@@ -306,7 +359,7 @@ func (in *ServiceCluster) DeepCopyInto(out *ServiceCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -333,7 +386,7 @@ func (in *ServiceClusterAssignment) DeepCopyInto(out *ServiceClusterAssignment)
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -408,6 +461,11 @@ func (in *ServiceClusterAssignmentSpec) DeepCopyInto(out *ServiceClusterAssignme
 	*out = *in
 	out.ServiceCluster = in.ServiceCluster
 	out.ManagementClusterNamespace = in.ManagementClusterNamespace
+	if in.Tenant != nil {
+		in, out := &in.Tenant, &out.Tenant
+		*out = new(ServiceClusterAssignmentTenant)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterAssignmentSpec.
@@ -435,6 +493,11 @@ func (in *ServiceClusterAssignmentStatus) DeepCopyInto(out *ServiceClusterAssign
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.NamespaceTemplate != nil {
+		in, out := &in.NamespaceTemplate, &out.NamespaceTemplate
+		*out = new(AppliedNamespaceTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterAssignmentStatus.
@@ -447,6 +510,62 @@ func (in *ServiceClusterAssignmentStatus) DeepCopy() *ServiceClusterAssignmentSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterAssignmentTenant) DeepCopyInto(out *ServiceClusterAssignmentTenant) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterAssignmentTenant.
+func (in *ServiceClusterAssignmentTenant) DeepCopy() *ServiceClusterAssignmentTenant {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterAssignmentTenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterCapacity) DeepCopyInto(out *ServiceClusterCapacity) {
+	*out = *in
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]ServiceClusterInstanceCount, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterCapacity.
+func (in *ServiceClusterCapacity) DeepCopy() *ServiceClusterCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClusterCondition) DeepCopyInto(out *ServiceClusterCondition) {
 	*out = *in
@@ -464,6 +583,73 @@ func (in *ServiceClusterCondition) DeepCopy() *ServiceClusterCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterHealth) DeepCopyInto(out *ServiceClusterHealth) {
+	*out = *in
+	out.APILatency = in.APILatency
+	if in.Operators != nil {
+		in, out := &in.Operators, &out.Operators
+		*out = make([]ServiceClusterOperatorHealth, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterHealth.
+func (in *ServiceClusterHealth) DeepCopy() *ServiceClusterHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterHealthProbes) DeepCopyInto(out *ServiceClusterHealthProbes) {
+	*out = *in
+	if in.MinReadyNodesPercent != nil {
+		in, out := &in.MinReadyNodesPercent, &out.MinReadyNodesPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAPILatency != nil {
+		in, out := &in.MaxAPILatency, &out.MaxAPILatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Operators != nil {
+		in, out := &in.Operators, &out.Operators
+		*out = make([]ServiceClusterOperatorProbe, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterHealthProbes.
+func (in *ServiceClusterHealthProbes) DeepCopy() *ServiceClusterHealthProbes {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterHealthProbes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterInstanceCount) DeepCopyInto(out *ServiceClusterInstanceCount) {
+	*out = *in
+	out.CRD = in.CRD
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterInstanceCount.
+func (in *ServiceClusterInstanceCount) DeepCopy() *ServiceClusterInstanceCount {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterInstanceCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClusterList) DeepCopyInto(out *ServiceClusterList) {
 	*out = *in
@@ -496,6 +682,29 @@ func (in *ServiceClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterMaintenance) DeepCopyInto(out *ServiceClusterMaintenance) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterMaintenance.
+func (in *ServiceClusterMaintenance) DeepCopy() *ServiceClusterMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClusterMetadata) DeepCopyInto(out *ServiceClusterMetadata) {
 	*out = *in
@@ -511,11 +720,108 @@ func (in *ServiceClusterMetadata) DeepCopy() *ServiceClusterMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterNamespaceTemplate) DeepCopyInto(out *ServiceClusterNamespaceTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(corev1.ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRange != nil {
+		in, out := &in.LimitRange, &out.LimitRange
+		*out = new(corev1.LimitRangeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(networkingv1.NetworkPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterNamespaceTemplate.
+func (in *ServiceClusterNamespaceTemplate) DeepCopy() *ServiceClusterNamespaceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterNamespaceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterOperatorHealth) DeepCopyInto(out *ServiceClusterOperatorHealth) {
+	*out = *in
+	out.CRD = in.CRD
+	out.Deployment = in.Deployment
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterOperatorHealth.
+func (in *ServiceClusterOperatorHealth) DeepCopy() *ServiceClusterOperatorHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterOperatorHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClusterOperatorProbe) DeepCopyInto(out *ServiceClusterOperatorProbe) {
+	*out = *in
+	out.CRD = in.CRD
+	out.Deployment = in.Deployment
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterOperatorProbe.
+func (in *ServiceClusterOperatorProbe) DeepCopy() *ServiceClusterOperatorProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClusterOperatorProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClusterSpec) DeepCopyInto(out *ServiceClusterSpec) {
 	*out = *in
 	out.Metadata = in.Metadata
-	out.KubeconfigSecret = in.KubeconfigSecret
+	if in.KubeconfigSecret != nil {
+		in, out := &in.KubeconfigSecret, &out.KubeconfigSecret
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.NamespaceTemplate != nil {
+		in, out := &in.NamespaceTemplate, &out.NamespaceTemplate
+		*out = new(ServiceClusterNamespaceTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthProbes != nil {
+		in, out := &in.HealthProbes, &out.HealthProbes
+		*out = new(ServiceClusterHealthProbes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ServiceClusterMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterSpec.
@@ -543,6 +849,21 @@ func (in *ServiceClusterStatus) DeepCopyInto(out *ServiceClusterStatus) {
 		*out = new(version.Info)
 		**out = **in
 	}
+	if in.AgentSecret != nil {
+		in, out := &in.AgentSecret, &out.AgentSecret
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(ServiceClusterHealth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(ServiceClusterCapacity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClusterStatus.
@@ -68,6 +68,33 @@ type APIServerSpec struct {
 	// LogLevel
 	// +optional
 	LogLevel *int `json:"logLevel,omitempty"`
+	// RateLimit limits the calls per user and Account, to protect the management cluster from single tenants.
+	// +optional
+	RateLimit *APIServerRateLimit `json:"rateLimit,omitempty"`
+}
+
+// APIServerRateLimit configures the limits per user and Account of the KubeCarrier API.
+// Calls exceeding the limits are rejected with RESOURCE_EXHAUSTED and a retry hint.
+type APIServerRateLimit struct {
+	// QPS is the number of unary calls per second allowed per user and Account, 0 disables rate limiting.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	QPS int32 `json:"qps,omitempty"`
+	// Burst is the number of unary calls allowed at once per user and Account, defaults to QPS.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+	// MaxConcurrentStreams is the number of concurrently open streams (e.g. Watch) per user and Account, 0 disables the limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentStreams int32 `json:"maxConcurrentStreams,omitempty"`
+}
+
+func (r APIServerRateLimit) Validate() error {
+	if r.QPS < 0 || r.Burst < 0 || r.MaxConcurrentStreams < 0 {
+		return errors.New("RateLimit qps, burst and maxConcurrentStreams should not be negative")
+	}
+	return nil
 }
 
 func (a *APIServerSpec) SetLogLevel(logLevel int) {
@@ -75,6 +102,11 @@ func (a *APIServerSpec) SetLogLevel(logLevel int) {
 }
 
 func (a APIServerSpec) Validate() error {
+	if a.RateLimit != nil {
+		if err := a.RateLimit.Validate(); err != nil {
+			return err
+		}
+	}
 	return a.Authentication.Validate()
 }
 
@@ -95,10 +127,24 @@ type AuthenticationConfig struct {
 	// Anonymous specifies whether anonymous auth provider enabled
 	// +optional
 	Anonymous *Anonymous `json:"anonymous,omitempty"`
+	// ClientCertificate specifies X.509 client certificate configuration for API Server authentication
+	// +optional
+	ClientCertificate *ClientCertificate `json:"clientCertificate,omitempty"`
+	// APIKey specifies whether APIKeys created via the KubeCarrier API are accepted
+	// +optional
+	APIKey *APIKey `json:"apiKey,omitempty"`
 }
 
 type ServiceAccount struct{}
 type Anonymous struct{}
+type APIKey struct{}
+
+// ClientCertificate authenticates users by X.509 client certificates,
+// the CommonName of the certificate is used as user name and the Organizations as groups.
+type ClientCertificate struct {
+	// CertificateAuthority references the secret containing the PEM encoded CA bundle (ca.crt) to verify client certificates against.
+	CertificateAuthority ObjectReference `json:"certificateAuthority"`
+}
 
 func (s AuthenticationConfig) GetEnabledProvider() string {
 	if s.OIDC != nil {
@@ -113,6 +159,12 @@ func (s AuthenticationConfig) GetEnabledProvider() string {
 	if s.Anonymous != nil {
 		return auth.ProviderAnynymous
 	}
+	if s.ClientCertificate != nil {
+		return auth.ProviderX509
+	}
+	if s.APIKey != nil {
+		return auth.ProviderAPIKey
+	}
 	return ""
 }
 
@@ -130,6 +182,12 @@ func (s AuthenticationConfig) Validate() error {
 	if s.Anonymous != nil {
 		enabled++
 	}
+	if s.ClientCertificate != nil {
+		enabled++
+	}
+	if s.APIKey != nil {
+		enabled++
+	}
 	if enabled != 1 {
 		return errors.New("Authentication should have one and only one configuration")
 	}
@@ -23,7 +23,12 @@ import (
 // FerrySpec defines the desired state of Ferry.
 type FerrySpec struct {
 	// KubeconfigSecret specifies the Kubeconfig to use when connecting to the ServiceCluster.
+	// For the Agent connection, this Secret also holds the tokens and the serving certificate used by the tunnel.
 	KubeconfigSecret ObjectReference `json:"kubeconfigSecret"`
+	// Connection specifies how the Ferry connects to the ServiceCluster.
+	// +kubebuilder:default:=Kubeconfig
+	// +optional
+	Connection FerryConnectionType `json:"connection,omitempty"`
 	// Paused tell controller to pause reconciliation process and assume that Ferry is ready
 	Paused PausedFlagType `json:"paused,omitempty"`
 	// LogLevel
@@ -31,6 +36,18 @@ type FerrySpec struct {
 	LogLevel *int `json:"logLevel,omitempty"`
 }
 
+// FerryConnectionType describes how the Ferry connects to the ServiceCluster.
+// +kubebuilder:validation:Enum=Kubeconfig;Agent
+type FerryConnectionType string
+
+const (
+	// FerryConnectionKubeconfig connects to the ServiceCluster API Server directly.
+	FerryConnectionKubeconfig FerryConnectionType = "Kubeconfig"
+	// FerryConnectionAgent serves a tunnel endpoint for the agent running in the ServiceCluster
+	// and proxies requests of other KubeCarrier components through it.
+	FerryConnectionAgent FerryConnectionType = "Agent"
+)
+
 func (a *FerrySpec) SetLogLevel(logLevel int) {
 	a.LogLevel = &logLevel
 }
@@ -30,8 +30,32 @@ type KubeCarrierSpec struct {
 	// LogLevel
 	// +optional
 	LogLevel int `json:"logLevel,omitempty"`
+	// Components configures how the Catapult and Elevator components of KubeCarrier are deployed.
+	// +optional
+	Components ComponentsSpec `json:"components,omitempty"`
+}
+
+// ComponentsSpec configures the deployment of the Catapult and Elevator components.
+type ComponentsSpec struct {
+	// Mode selects how Catapults and Elevators are deployed.
+	// PerCRD (by default): every Catapult and Elevator object gets its own Deployment.
+	// Consolidated: a single Catapult Deployment per ServiceCluster and a single Elevator Deployment per provider namespace
+	// serve all Catapult and Elevator objects.
+	// +kubebuilder:default:=PerCRD
+	// +optional
+	Mode ComponentDeploymentMode `json:"mode,omitempty"`
 }
 
+// ComponentDeploymentMode describes how Catapults and Elevators are deployed.
+// +kubebuilder:validation:Enum=PerCRD;Consolidated
+type ComponentDeploymentMode string
+
+// Values of ComponentDeploymentMode.
+const (
+	ComponentDeploymentModePerCRD       ComponentDeploymentMode = "PerCRD"
+	ComponentDeploymentModeConsolidated ComponentDeploymentMode = "Consolidated"
+)
+
 func (a *KubeCarrierSpec) SetLogLevel(logLevel int) {
 	a.LogLevel = logLevel
 }
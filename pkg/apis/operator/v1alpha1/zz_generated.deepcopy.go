@@ -24,6 +24,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKey) DeepCopyInto(out *APIKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKey.
+func (in *APIKey) DeepCopy() *APIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServer) DeepCopyInto(out *APIServer) {
 	*out = *in
@@ -127,6 +142,21 @@ func (in *APIServerOIDCConfig) DeepCopy() *APIServerOIDCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerRateLimit) DeepCopyInto(out *APIServerRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerRateLimit.
+func (in *APIServerRateLimit) DeepCopy() *APIServerRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerSpec) DeepCopyInto(out *APIServerSpec) {
 	*out = *in
@@ -147,6 +177,11 @@ func (in *APIServerSpec) DeepCopyInto(out *APIServerSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(APIServerRateLimit)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerSpec.
@@ -240,6 +275,16 @@ func (in *AuthenticationConfig) DeepCopyInto(out *AuthenticationConfig) {
 		*out = new(Anonymous)
 		**out = **in
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(ClientCertificate)
+		**out = **in
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(APIKey)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationConfig.
@@ -387,6 +432,37 @@ func (in *CatapultStatus) DeepCopy() *CatapultStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertificate) DeepCopyInto(out *ClientCertificate) {
+	*out = *in
+	out.CertificateAuthority = in.CertificateAuthority
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificate.
+func (in *ClientCertificate) DeepCopy() *ClientCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentsSpec) DeepCopyInto(out *ComponentsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentsSpec.
+func (in *ComponentsSpec) DeepCopy() *ComponentsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Elevator) DeepCopyInto(out *Elevator) {
 	*out = *in
@@ -704,6 +780,7 @@ func (in *KubeCarrierList) DeepCopyObject() runtime.Object {
 func (in *KubeCarrierSpec) DeepCopyInto(out *KubeCarrierSpec) {
 	*out = *in
 	in.API.DeepCopyInto(&out.API)
+	out.Components = in.Components
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeCarrierSpec.
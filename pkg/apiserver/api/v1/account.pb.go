@@ -263,10 +263,12 @@ func (m *AccountMetadata) GetIcon() *Image {
 }
 
 type Subject struct {
-	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
-	ApiGroup             string   `protobuf:"bytes,2,opt,name=apiGroup,proto3" json:"apiGroup,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Kind      string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ApiGroup  string `protobuf:"bytes,2,opt,name=apiGroup,proto3" json:"apiGroup,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Role of the subject within the Account, one of (Viewer, Editor, Admin).
+	Role                 string   `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -325,6 +327,13 @@ func (m *Subject) GetNamespace() string {
 	return ""
 }
 
+func (m *Subject) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
 type AccountStatus struct {
 	Conditions           []*AccountCondition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -523,6 +532,11 @@ func (m *AccountList) GetItems() []*Account {
 
 type AccountListRequest struct {
 	LabelSelector        string   `protobuf:"bytes,1,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Continue             string   `protobuf:"bytes,3,opt,name=continue,proto3" json:"continue,omitempty"`
+	FieldSelector        string   `protobuf:"bytes,4,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	OrderBy              string   `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	Search               string   `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -560,6 +574,41 @@ func (m *AccountListRequest) GetLabelSelector() string {
 	return ""
 }
 
+func (m *AccountListRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *AccountListRequest) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
+func (m *AccountListRequest) GetFieldSelector() string {
+	if m != nil {
+		return m.FieldSelector
+	}
+	return ""
+}
+
+func (m *AccountListRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *AccountListRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "kubecarrier.api.v1.Account")
 	proto.RegisterType((*AccountSpec)(nil), "kubecarrier.api.v1.AccountSpec")
@@ -578,48 +627,53 @@ func init() {
 }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 656 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc7, 0x95, 0x34, 0xbd, 0xe4, 0xe4, 0x6b, 0xbf, 0xea, 0xa8, 0x42, 0x26, 0xad, 0x68, 0x6b,
-	0x2a, 0x54, 0x55, 0xc2, 0x51, 0x52, 0x21, 0xa0, 0x20, 0x21, 0xa0, 0x12, 0x02, 0x51, 0x90, 0xdc,
-	0xb2, 0x61, 0x37, 0x71, 0x0e, 0x61, 0xa8, 0xed, 0x31, 0x9e, 0x71, 0x50, 0x59, 0xb2, 0x65, 0xc9,
-	0xb3, 0xb0, 0xe5, 0x25, 0x78, 0x00, 0x36, 0x3c, 0x08, 0x9a, 0xf1, 0xd8, 0x75, 0x1b, 0x93, 0xb2,
-	0xca, 0xcc, 0xe4, 0xf7, 0x9f, 0x73, 0x1f, 0xc3, 0x32, 0x0b, 0x02, 0x91, 0xc5, 0xca, 0x4b, 0x52,
-	0xa1, 0x04, 0xe2, 0x69, 0x36, 0xa4, 0x80, 0xa5, 0x29, 0xa7, 0xd4, 0x63, 0x09, 0xf7, 0x26, 0xfd,
-	0xee, 0xc6, 0x58, 0x88, 0x71, 0x48, 0x3d, 0x96, 0xf0, 0x1e, 0x8b, 0x63, 0xa1, 0x98, 0xe2, 0x22,
-	0x96, 0xb9, 0xa2, 0xbb, 0x69, 0xff, 0x35, 0xbb, 0x61, 0xf6, 0xae, 0xa7, 0x78, 0x44, 0x52, 0xb1,
-	0x28, 0xb1, 0x00, 0x44, 0xa4, 0x98, 0x5d, 0x77, 0xd4, 0x59, 0x42, 0x56, 0xe9, 0x7e, 0x6f, 0xc0,
-	0xe2, 0xe3, 0xdc, 0x3a, 0x1e, 0xc0, 0x92, 0xc6, 0x46, 0x4c, 0x31, 0xa7, 0xb1, 0xd5, 0xd8, 0xed,
-	0x0c, 0x6e, 0x78, 0xd3, 0xae, 0x78, 0xaf, 0x87, 0x1f, 0x28, 0x50, 0x47, 0xa4, 0x98, 0x5f, 0xf2,
-	0xb8, 0x0f, 0x2d, 0x99, 0x50, 0xe0, 0x34, 0x8d, 0x6e, 0xb3, 0x4e, 0x67, 0xcd, 0x1c, 0x27, 0x14,
-	0xf8, 0x06, 0xc6, 0xfb, 0xb0, 0x20, 0x15, 0x53, 0x99, 0x74, 0xe6, 0x8c, 0x6c, 0x7b, 0x96, 0xcc,
-	0x80, 0xbe, 0x15, 0xb8, 0x3f, 0x1a, 0xd0, 0xa9, 0x5c, 0x88, 0x8f, 0xa6, 0x7c, 0xbf, 0x39, 0xe3,
-	0xb2, 0x23, 0x8b, 0x56, 0x02, 0xb8, 0x03, 0xf3, 0xa9, 0x08, 0x49, 0x3a, 0xcd, 0xad, 0xb9, 0x2b,
-	0x22, 0xf0, 0x45, 0x48, 0x7e, 0x4e, 0xe3, 0x5d, 0x58, 0x92, 0x99, 0x49, 0x88, 0x0e, 0x42, 0x2b,
-	0xd7, 0xeb, 0x94, 0xc7, 0x39, 0xe3, 0x97, 0xb0, 0xbb, 0x5d, 0xfa, 0xaf, 0xaf, 0x43, 0x84, 0x96,
-	0x2e, 0x8b, 0xf1, 0xbd, 0xed, 0x9b, 0xb5, 0xfb, 0xab, 0x01, 0xff, 0x5f, 0x72, 0x18, 0xb7, 0xa0,
-	0x33, 0xe2, 0x32, 0x09, 0xd9, 0xd9, 0x2b, 0x16, 0x15, 0x78, 0xf5, 0xc8, 0x10, 0x24, 0x83, 0x94,
-	0x27, 0xba, 0x43, 0x4c, 0x41, 0x34, 0x71, 0x7e, 0x84, 0x7b, 0xb0, 0x2a, 0xdf, 0x8b, 0x54, 0x1d,
-	0x56, 0xb0, 0x39, 0x83, 0x4d, 0x9d, 0xe3, 0x6d, 0x68, 0x85, 0x62, 0x2c, 0x9c, 0x96, 0xc9, 0xe9,
-	0xf5, 0xba, 0xd8, 0x9e, 0x47, 0x6c, 0x4c, 0xbe, 0xc1, 0x34, 0xce, 0x03, 0x11, 0x3b, 0xf3, 0x57,
-	0xe2, 0x1a, 0x73, 0x4f, 0x61, 0xd1, 0x66, 0x46, 0x27, 0xe0, 0x94, 0xc7, 0xa3, 0x22, 0x01, 0x7a,
-	0x8d, 0x5d, 0x58, 0x62, 0x09, 0x7f, 0x96, 0x8a, 0x2c, 0xb1, 0x71, 0x94, 0x7b, 0xcd, 0xc7, 0x3a,
-	0x03, 0xb9, 0xe3, 0x66, 0x8d, 0x1b, 0xd0, 0xd6, 0xbf, 0x32, 0x61, 0x01, 0x19, 0x8f, 0xdb, 0xfe,
-	0xf9, 0x81, 0xfb, 0x06, 0x96, 0x2f, 0xf4, 0x12, 0x1e, 0x02, 0x04, 0x22, 0x1e, 0x71, 0x33, 0x49,
-	0xb6, 0xee, 0x3b, 0x33, 0xea, 0xfe, 0xb4, 0x80, 0xfd, 0x8a, 0xce, 0xfd, 0xda, 0x84, 0xd5, 0xcb,
-	0x00, 0x3e, 0xac, 0x94, 0xb3, 0x33, 0xd8, 0xfd, 0x97, 0x4b, 0x4f, 0xce, 0x12, 0xca, 0x0b, 0x8f,
-	0x0f, 0xca, 0xb9, 0x68, 0xfe, 0xbd, 0x95, 0x4b, 0xe1, 0xc5, 0xc9, 0xc0, 0x17, 0x80, 0x21, 0x93,
-	0xea, 0x24, 0x65, 0xb1, 0xcc, 0x2f, 0xe6, 0x36, 0x4d, 0x9d, 0x41, 0xd7, 0xcb, 0x1f, 0x0a, 0xaf,
-	0x78, 0x28, 0xbc, 0x93, 0xe2, 0xa1, 0xf0, 0x6b, 0x54, 0x78, 0x0d, 0x16, 0x52, 0x62, 0x52, 0xc4,
-	0x36, 0x9b, 0x76, 0x87, 0x0e, 0x2c, 0x46, 0x24, 0x25, 0x1b, 0x93, 0xa9, 0x74, 0xdb, 0x2f, 0xb6,
-	0xee, 0x1e, 0xac, 0xd5, 0x05, 0x56, 0xdb, 0xdf, 0x9f, 0xcb, 0x11, 0x78, 0xc9, 0xa5, 0xc2, 0x7b,
-	0x53, 0x23, 0xbc, 0x51, 0x17, 0xb7, 0x66, 0x2f, 0x3d, 0x3e, 0x7d, 0x98, 0xe7, 0x8a, 0xa2, 0xa2,
-	0x86, 0xeb, 0xb3, 0x66, 0x37, 0x27, 0xdd, 0x03, 0xc0, 0x8a, 0x6d, 0x9f, 0x3e, 0x66, 0x24, 0x15,
-	0xee, 0xc0, 0x72, 0xc8, 0x86, 0x14, 0x1e, 0x53, 0x48, 0x81, 0x12, 0xa9, 0x75, 0xf7, 0xe2, 0xe1,
-	0xe0, 0x13, 0xac, 0x14, 0x8d, 0x44, 0xe9, 0x84, 0x07, 0x84, 0x04, 0x2d, 0x13, 0xc2, 0xad, 0x19,
-	0x96, 0x2b, 0x76, 0xba, 0x9b, 0x57, 0x70, 0xee, 0xda, 0x97, 0x9f, 0xbf, 0xbf, 0x35, 0x57, 0xf0,
-	0xbf, 0xde, 0xa4, 0xdf, 0xb3, 0x5f, 0x07, 0xf9, 0xa4, 0xf5, 0xb6, 0x39, 0xe9, 0x0f, 0x17, 0x4c,
-	0xf1, 0xf6, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x86, 0x04, 0x2a, 0x36, 0x06, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xd3, 0x4a,
+	0x10, 0x57, 0xfe, 0xb6, 0x99, 0xbc, 0xf6, 0x55, 0xab, 0xea, 0xc9, 0x2f, 0xad, 0x5e, 0x5b, 0xbf,
+	0x0a, 0x55, 0x95, 0x70, 0x94, 0x54, 0x88, 0xbf, 0x12, 0xa2, 0x54, 0x42, 0x20, 0x0a, 0x92, 0x5b,
+	0x2e, 0xdc, 0x36, 0xce, 0x34, 0x5d, 0x6a, 0x7b, 0xcd, 0xee, 0x3a, 0x28, 0x5c, 0x90, 0xb8, 0x72,
+	0xe4, 0xb3, 0x70, 0xe5, 0xc4, 0x37, 0xe0, 0x03, 0x70, 0xe1, 0x83, 0xa0, 0x5d, 0xaf, 0x5d, 0xa7,
+	0x09, 0x29, 0xa7, 0xec, 0x4c, 0x7e, 0xbf, 0xd9, 0x99, 0xdf, 0xcc, 0xac, 0x61, 0x85, 0x06, 0x01,
+	0x4f, 0x63, 0xe5, 0x25, 0x82, 0x2b, 0x4e, 0xc8, 0x45, 0x3a, 0xc0, 0x80, 0x0a, 0xc1, 0x50, 0x78,
+	0x34, 0x61, 0xde, 0xb8, 0xd7, 0xd9, 0x1c, 0x71, 0x3e, 0x0a, 0xb1, 0x4b, 0x13, 0xd6, 0xa5, 0x71,
+	0xcc, 0x15, 0x55, 0x8c, 0xc7, 0x32, 0x63, 0x74, 0xb6, 0xec, 0xbf, 0xc6, 0x1a, 0xa4, 0x67, 0x5d,
+	0xc5, 0x22, 0x94, 0x8a, 0x46, 0x89, 0x05, 0x40, 0x84, 0x8a, 0xda, 0x73, 0x5b, 0x4d, 0x12, 0xb4,
+	0x4c, 0xf7, 0x4b, 0x05, 0x96, 0x1e, 0x65, 0xb7, 0x93, 0x7b, 0xb0, 0xac, 0x61, 0x43, 0xaa, 0xa8,
+	0x53, 0xd9, 0xae, 0xec, 0xb5, 0xfb, 0xff, 0x79, 0xb3, 0xa9, 0x78, 0x2f, 0x07, 0x6f, 0x30, 0x50,
+	0xc7, 0xa8, 0xa8, 0x5f, 0xe0, 0xc9, 0x01, 0xd4, 0x65, 0x82, 0x81, 0x53, 0x35, 0xbc, 0xad, 0x79,
+	0x3c, 0x7b, 0xcd, 0x49, 0x82, 0x81, 0x6f, 0xc0, 0xe4, 0x2e, 0x34, 0xa5, 0xa2, 0x2a, 0x95, 0x4e,
+	0xcd, 0xd0, 0x76, 0x16, 0xd1, 0x0c, 0xd0, 0xb7, 0x04, 0xf7, 0x6b, 0x05, 0xda, 0xa5, 0x80, 0xe4,
+	0xe1, 0x4c, 0xee, 0xff, 0x2f, 0x08, 0x76, 0x6c, 0xa1, 0xa5, 0x02, 0x6e, 0x41, 0x43, 0xf0, 0x10,
+	0xa5, 0x53, 0xdd, 0xae, 0x5d, 0x53, 0x81, 0xcf, 0x43, 0xf4, 0x33, 0x34, 0xb9, 0x0d, 0xcb, 0x32,
+	0x35, 0x82, 0xe8, 0x22, 0x34, 0x73, 0x63, 0x1e, 0xf3, 0x24, 0xc3, 0xf8, 0x05, 0xd8, 0xdd, 0x81,
+	0x76, 0x29, 0x1c, 0x21, 0x50, 0xd7, 0x6d, 0x31, 0xb9, 0xb7, 0x7c, 0x73, 0x76, 0x7f, 0x54, 0xe0,
+	0xef, 0x2b, 0x09, 0x93, 0x6d, 0x68, 0x0f, 0x99, 0x4c, 0x42, 0x3a, 0x79, 0x41, 0xa3, 0x1c, 0x5e,
+	0x76, 0x19, 0x04, 0xca, 0x40, 0xb0, 0x44, 0x4f, 0x88, 0x53, 0xb5, 0x88, 0x4b, 0x17, 0xd9, 0x87,
+	0x35, 0x79, 0xce, 0x85, 0x3a, 0x2a, 0xc1, 0x6a, 0x06, 0x36, 0xe3, 0x27, 0x37, 0xa1, 0x1e, 0xf2,
+	0x11, 0x77, 0xea, 0x46, 0xd3, 0x7f, 0xe7, 0xd5, 0xf6, 0x34, 0xa2, 0x23, 0xf4, 0x0d, 0x4c, 0xc3,
+	0x59, 0xc0, 0x63, 0xa7, 0x71, 0x2d, 0x5c, 0xc3, 0xdc, 0x0f, 0xb0, 0x64, 0x95, 0xd1, 0x02, 0x5c,
+	0xb0, 0x78, 0x98, 0x0b, 0xa0, 0xcf, 0xa4, 0x03, 0xcb, 0x34, 0x61, 0x4f, 0x04, 0x4f, 0x13, 0x5b,
+	0x47, 0x61, 0x6b, 0x7c, 0xac, 0x15, 0xc8, 0x12, 0x37, 0x67, 0xb2, 0x09, 0x2d, 0xfd, 0x2b, 0x13,
+	0x1a, 0xa0, 0xc9, 0xb8, 0xe5, 0x5f, 0x3a, 0x34, 0x43, 0xf7, 0xcc, 0xe4, 0xd6, 0xf2, 0xcd, 0xd9,
+	0x7d, 0x05, 0x2b, 0x53, 0xf3, 0x45, 0x8e, 0x00, 0x02, 0x1e, 0x0f, 0x99, 0xd9, 0x2e, 0x3b, 0x0b,
+	0xbb, 0x0b, 0x66, 0xe1, 0x71, 0x0e, 0xf6, 0x4b, 0x3c, 0xf7, 0x53, 0x15, 0xd6, 0xae, 0x02, 0xc8,
+	0x83, 0x52, 0x8b, 0xdb, 0xfd, 0xbd, 0x3f, 0x09, 0x7a, 0x3a, 0x49, 0x30, 0x1b, 0x06, 0x72, 0xbf,
+	0xd8, 0x95, 0xea, 0xef, 0xc7, 0xbb, 0x20, 0x4e, 0x6f, 0x0b, 0x79, 0x06, 0x24, 0xa4, 0x52, 0x9d,
+	0x0a, 0x1a, 0xcb, 0x2c, 0x30, 0xb3, 0xd2, 0xb5, 0xfb, 0x1d, 0x2f, 0x7b, 0x3c, 0xbc, 0xfc, 0xf1,
+	0xf0, 0x4e, 0xf3, 0xc7, 0xc3, 0x9f, 0xc3, 0x22, 0xff, 0x40, 0x53, 0x20, 0x95, 0x3c, 0xb6, 0x0a,
+	0x5b, 0x8b, 0x38, 0xb0, 0x14, 0xa1, 0x94, 0x74, 0x94, 0x2b, 0x9c, 0x9b, 0xee, 0x3e, 0xac, 0xcf,
+	0x2b, 0x6c, 0xee, 0xcc, 0xbf, 0x2f, 0xd6, 0xe2, 0x39, 0x93, 0x8a, 0xdc, 0x99, 0x59, 0xeb, 0xcd,
+	0x79, 0x75, 0x6b, 0xec, 0x95, 0x07, 0xa9, 0x07, 0x0d, 0xa6, 0x30, 0xca, 0x7b, 0xb8, 0xb1, 0x68,
+	0x9f, 0x33, 0xa4, 0xfb, 0xad, 0x02, 0xa4, 0x74, 0xb9, 0x8f, 0x6f, 0x53, 0x94, 0x8a, 0xec, 0xc2,
+	0x4a, 0x48, 0x07, 0x18, 0x9e, 0x60, 0x88, 0x81, 0xe2, 0xc2, 0xe6, 0x3b, 0xed, 0x24, 0xeb, 0xd0,
+	0x08, 0x59, 0xc4, 0x94, 0x69, 0x4f, 0xcd, 0xcf, 0x0c, 0x3d, 0xc1, 0x01, 0x8f, 0x15, 0x8b, 0xd3,
+	0x7c, 0x52, 0x0b, 0x5b, 0xc7, 0x3d, 0x63, 0x18, 0x0e, 0x8b, 0xb8, 0x99, 0x9e, 0xd3, 0x4e, 0x2d,
+	0x2b, 0x17, 0x43, 0x14, 0x87, 0x93, 0x5c, 0x56, 0x6b, 0xea, 0x46, 0x48, 0xa4, 0x22, 0x38, 0x77,
+	0x9a, 0x59, 0x23, 0x32, 0xab, 0xff, 0x0e, 0x56, 0xf3, 0x99, 0x46, 0x31, 0x66, 0x01, 0x12, 0x84,
+	0xba, 0x51, 0xf3, 0xc6, 0x02, 0x11, 0x4a, 0x15, 0x77, 0xb6, 0xae, 0xc1, 0xb9, 0xeb, 0x1f, 0xbf,
+	0xff, 0xfc, 0x5c, 0x5d, 0x25, 0x7f, 0x75, 0xc7, 0xbd, 0xae, 0xfd, 0x78, 0xc9, 0xc3, 0xfa, 0xeb,
+	0xea, 0xb8, 0x37, 0x68, 0x9a, 0x39, 0x3a, 0xf8, 0x35, 0x00, 0xef, 0x2b, 0x41, 0x6b, 0xd5, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,493 @@
+/*
+Copyright 2021 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: apikey.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type APIKey struct {
+	Metadata             *ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec                 *APIKeySpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *APIKey) Reset()         { *m = APIKey{} }
+func (m *APIKey) String() string { return proto.CompactTextString(m) }
+func (*APIKey) ProtoMessage()    {}
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c99fd356877382bd, []int{0}
+}
+
+func (m *APIKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKey.Unmarshal(m, b)
+}
+func (m *APIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKey.Marshal(b, m, deterministic)
+}
+func (m *APIKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKey.Merge(m, src)
+}
+func (m *APIKey) XXX_Size() int {
+	return xxx_messageInfo_APIKey.Size(m)
+}
+func (m *APIKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKey proto.InternalMessageInfo
+
+func (m *APIKey) GetMetadata() *ObjectMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *APIKey) GetSpec() *APIKeySpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type APIKeySpec struct {
+	// Description of what the APIKey is used for.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// ExpiresAt is the time after which the APIKey is no longer accepted.
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// ReadOnly restricts the APIKey to get, list and watch calls.
+	ReadOnly             bool     `protobuf:"varint,3,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIKeySpec) Reset()         { *m = APIKeySpec{} }
+func (m *APIKeySpec) String() string { return proto.CompactTextString(m) }
+func (*APIKeySpec) ProtoMessage()    {}
+func (*APIKeySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c99fd356877382bd, []int{1}
+}
+
+func (m *APIKeySpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeySpec.Unmarshal(m, b)
+}
+func (m *APIKeySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeySpec.Marshal(b, m, deterministic)
+}
+func (m *APIKeySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeySpec.Merge(m, src)
+}
+func (m *APIKeySpec) XXX_Size() int {
+	return xxx_messageInfo_APIKeySpec.Size(m)
+}
+func (m *APIKeySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeySpec proto.InternalMessageInfo
+
+func (m *APIKeySpec) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *APIKeySpec) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKeySpec) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+type APIKeyList struct {
+	Metadata             *ListMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items                []*APIKey `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *APIKeyList) Reset()         { *m = APIKeyList{} }
+func (m *APIKeyList) String() string { return proto.CompactTextString(m) }
+func (*APIKeyList) ProtoMessage()    {}
+func (*APIKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c99fd356877382bd, []int{2}
+}
+
+func (m *APIKeyList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyList.Unmarshal(m, b)
+}
+func (m *APIKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyList.Marshal(b, m, deterministic)
+}
+func (m *APIKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyList.Merge(m, src)
+}
+func (m *APIKeyList) XXX_Size() int {
+	return xxx_messageInfo_APIKeyList.Size(m)
+}
+func (m *APIKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyList proto.InternalMessageInfo
+
+func (m *APIKeyList) GetMetadata() *ListMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *APIKeyList) GetItems() []*APIKey {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type APIKeyCreateRequest struct {
+	Spec *APIKey `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the account
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIKeyCreateRequest) Reset()         { *m = APIKeyCreateRequest{} }
+func (m *APIKeyCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*APIKeyCreateRequest) ProtoMessage()    {}
+func (*APIKeyCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c99fd356877382bd, []int{3}
+}
+
+func (m *APIKeyCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyCreateRequest.Unmarshal(m, b)
+}
+func (m *APIKeyCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *APIKeyCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyCreateRequest.Merge(m, src)
+}
+func (m *APIKeyCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_APIKeyCreateRequest.Size(m)
+}
+func (m *APIKeyCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyCreateRequest proto.InternalMessageInfo
+
+func (m *APIKeyCreateRequest) GetSpec() *APIKey {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *APIKeyCreateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type APIKeyCreateResponse struct {
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	// Key is the bearer token to authenticate with,
+	// it is only returned once and can't be retrieved later.
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIKeyCreateResponse) Reset()         { *m = APIKeyCreateResponse{} }
+func (m *APIKeyCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*APIKeyCreateResponse) ProtoMessage()    {}
+func (*APIKeyCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c99fd356877382bd, []int{4}
+}
+
+func (m *APIKeyCreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyCreateResponse.Unmarshal(m, b)
+}
+func (m *APIKeyCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyCreateResponse.Marshal(b, m, deterministic)
+}
+func (m *APIKeyCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyCreateResponse.Merge(m, src)
+}
+func (m *APIKeyCreateResponse) XXX_Size() int {
+	return xxx_messageInfo_APIKeyCreateResponse.Size(m)
+}
+func (m *APIKeyCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyCreateResponse proto.InternalMessageInfo
+
+func (m *APIKeyCreateResponse) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *APIKeyCreateResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*APIKey)(nil), "kubecarrier.api.v1.APIKey")
+	proto.RegisterType((*APIKeySpec)(nil), "kubecarrier.api.v1.APIKeySpec")
+	proto.RegisterType((*APIKeyList)(nil), "kubecarrier.api.v1.APIKeyList")
+	proto.RegisterType((*APIKeyCreateRequest)(nil), "kubecarrier.api.v1.APIKeyCreateRequest")
+	proto.RegisterType((*APIKeyCreateResponse)(nil), "kubecarrier.api.v1.APIKeyCreateResponse")
+}
+
+func init() {
+	proto.RegisterFile("apikey.proto", fileDescriptor_c99fd356877382bd)
+}
+
+var fileDescriptor_c99fd356877382bd = []byte{
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x57, 0xda, 0x51, 0xda, 0xd7, 0x4e, 0x42, 0x06, 0xa1, 0x28, 0x4c, 0x5b, 0x88, 0xc4, 0x56,
+	0x21, 0x48, 0x68, 0xb9, 0x4c, 0xbb, 0x8d, 0x3f, 0x07, 0x34, 0xd0, 0x50, 0xe0, 0x84, 0x90, 0x90,
+	0x9b, 0x3e, 0x26, 0xd3, 0x26, 0x36, 0xb6, 0x1b, 0x35, 0x9a, 0x76, 0x41, 0xdc, 0x39, 0xec, 0xa3,
+	0xf1, 0x15, 0xf8, 0x20, 0x28, 0xb1, 0xb3, 0xb2, 0x75, 0x5d, 0xb9, 0xf9, 0xd9, 0x3f, 0xbf, 0xdf,
+	0x9f, 0x67, 0x43, 0x8f, 0x0a, 0x36, 0xc1, 0x22, 0x14, 0x92, 0x6b, 0x4e, 0xc8, 0x64, 0x36, 0xc2,
+	0x84, 0x4a, 0xc9, 0x50, 0x86, 0x54, 0xb0, 0x30, 0x1f, 0x78, 0x5b, 0x27, 0x9c, 0x9f, 0x4c, 0x31,
+	0xa2, 0x82, 0x45, 0x34, 0xcb, 0xb8, 0xa6, 0x9a, 0xf1, 0x4c, 0x99, 0x1b, 0xde, 0x03, 0x7b, 0x5a,
+	0x55, 0xa3, 0xd9, 0xd7, 0x08, 0x53, 0xa1, 0x6d, 0x3b, 0x6f, 0xe7, 0xea, 0xa1, 0x66, 0x29, 0x2a,
+	0x4d, 0x53, 0x61, 0x01, 0x90, 0xa2, 0xa6, 0x76, 0xbd, 0x29, 0xf1, 0xfb, 0x0c, 0x95, 0x36, 0x65,
+	0x30, 0x87, 0xd6, 0xe1, 0xfb, 0x37, 0x47, 0x58, 0x90, 0x03, 0x68, 0x97, 0xb0, 0x31, 0xd5, 0xd4,
+	0x75, 0x7c, 0xa7, 0xdf, 0x1d, 0x6e, 0x87, 0xcb, 0x3a, 0xc3, 0xe3, 0xd1, 0x37, 0x4c, 0xf4, 0x3b,
+	0xd4, 0x34, 0xbe, 0xc0, 0x93, 0x21, 0x6c, 0x28, 0x81, 0x89, 0xdb, 0x58, 0x7d, 0xcf, 0xb0, 0x7c,
+	0x10, 0x98, 0xc4, 0x15, 0x36, 0xf8, 0xe9, 0x00, 0x2c, 0x36, 0x89, 0x0f, 0xdd, 0x31, 0xaa, 0x44,
+	0x32, 0x51, 0xfa, 0xae, 0x14, 0x74, 0xe2, 0x7f, 0xb7, 0xc8, 0x3e, 0x74, 0x70, 0x2e, 0x98, 0x44,
+	0x75, 0xa8, 0x2d, 0x93, 0x17, 0x1a, 0xeb, 0x61, 0x6d, 0x3d, 0xfc, 0x58, 0x5b, 0x8f, 0x17, 0x60,
+	0xe2, 0x41, 0x5b, 0x22, 0x1d, 0x1f, 0x67, 0xd3, 0xc2, 0x6d, 0xfa, 0x4e, 0xbf, 0x1d, 0x5f, 0xd4,
+	0xc1, 0xbc, 0x56, 0xf1, 0x96, 0x29, 0x4d, 0xf6, 0x97, 0x42, 0xd8, 0xba, 0xce, 0x4c, 0x89, 0xbd,
+	0x12, 0xc1, 0x33, 0xb8, 0xc5, 0x34, 0xa6, 0xca, 0x6d, 0xf8, 0xcd, 0x4a, 0xd9, 0xca, 0x0c, 0x62,
+	0x03, 0x0c, 0xbe, 0xc0, 0x5d, 0xb3, 0xf1, 0x52, 0x22, 0xd5, 0x18, 0x9b, 0xb9, 0x90, 0xd0, 0x66,
+	0xe9, 0xf8, 0xce, 0x9a, 0x3e, 0x15, 0x8e, 0xb8, 0x70, 0x9b, 0x26, 0x09, 0x9f, 0x65, 0x26, 0x94,
+	0x4e, 0x5c, 0x97, 0xc1, 0x67, 0xb8, 0x77, 0x99, 0x40, 0x09, 0x9e, 0x29, 0x24, 0x43, 0x68, 0x51,
+	0xc1, 0x8e, 0xb0, 0xf8, 0x0f, 0x0e, 0x8b, 0x24, 0x77, 0xa0, 0x39, 0xc1, 0xc2, 0x32, 0x94, 0xcb,
+	0xe1, 0x79, 0x13, 0x36, 0xed, 0xfc, 0x50, 0xe6, 0x2c, 0x41, 0xf2, 0xcb, 0x81, 0x9e, 0xa1, 0xb2,
+	0x4f, 0x6a, 0x6f, 0x75, 0xe3, 0x4b, 0x9e, 0xbd, 0xfe, 0x7a, 0xa0, 0xd1, 0x1e, 0x3c, 0xf9, 0xf1,
+	0xfb, 0xcf, 0x79, 0x63, 0x37, 0xd8, 0x8e, 0xf2, 0x41, 0x64, 0x8d, 0xaa, 0xe8, 0xd4, 0xae, 0xce,
+	0x22, 0xf3, 0xcf, 0xd4, 0x81, 0xc9, 0x26, 0x87, 0x6e, 0x39, 0x2a, 0xd3, 0x49, 0x91, 0x9d, 0x55,
+	0xb3, 0xac, 0x75, 0xdc, 0xf0, 0x72, 0x4b, 0x58, 0xb0, 0x5b, 0xb1, 0xfb, 0x64, 0x0d, 0x3b, 0x99,
+	0x43, 0x2f, 0xc6, 0x9c, 0x4f, 0xea, 0x20, 0x1e, 0x5e, 0xd7, 0xf7, 0x15, 0x4e, 0x71, 0x11, 0xc1,
+	0xfd, 0xa5, 0xa7, 0xfc, 0xba, 0xfc, 0xe2, 0xc1, 0xd3, 0x8a, 0x72, 0xef, 0xf1, 0xa3, 0x9b, 0x29,
+	0xa3, 0xd3, 0x8c, 0xa6, 0x78, 0xf6, 0x62, 0xe3, 0x53, 0x23, 0x1f, 0x8c, 0x5a, 0x55, 0x93, 0xe7,
+	0x7f, 0x07, 0x00, 0xf1, 0xa1, 0xc0, 0x03, 0x77, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// APIKeyServiceClient is the client API for APIKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIKeyServiceClient interface {
+	CreateAPIKey(ctx context.Context, in *APIKeyCreateRequest, opts ...grpc.CallOption) (*APIKeyCreateResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*APIKeyList, error)
+	RevokeAPIKey(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type aPIKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIKeyServiceClient(cc grpc.ClientConnInterface) APIKeyServiceClient {
+	return &aPIKeyServiceClient{cc}
+}
+
+func (c *aPIKeyServiceClient) CreateAPIKey(ctx context.Context, in *APIKeyCreateRequest, opts ...grpc.CallOption) (*APIKeyCreateResponse, error) {
+	out := new(APIKeyCreateResponse)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.APIKeyService/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) ListAPIKeys(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*APIKeyList, error) {
+	out := new(APIKeyList)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.APIKeyService/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) RevokeAPIKey(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.APIKeyService/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKeyServiceServer is the server API for APIKeyService service.
+type APIKeyServiceServer interface {
+	CreateAPIKey(context.Context, *APIKeyCreateRequest) (*APIKeyCreateResponse, error)
+	ListAPIKeys(context.Context, *ListRequest) (*APIKeyList, error)
+	RevokeAPIKey(context.Context, *DeleteRequest) (*empty.Empty, error)
+}
+
+// UnimplementedAPIKeyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIKeyServiceServer struct {
+}
+
+func (*UnimplementedAPIKeyServiceServer) CreateAPIKey(ctx context.Context, req *APIKeyCreateRequest) (*APIKeyCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedAPIKeyServiceServer) ListAPIKeys(ctx context.Context, req *ListRequest) (*APIKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (*UnimplementedAPIKeyServiceServer) RevokeAPIKey(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+
+func RegisterAPIKeyServiceServer(s *grpc.Server, srv APIKeyServiceServer) {
+	s.RegisterService(&_APIKeyService_serviceDesc, srv)
+}
+
+func _APIKeyService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.APIKeyService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, req.(*APIKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.APIKeyService/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.APIKeyService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APIKeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubecarrier.api.v1.APIKeyService",
+	HandlerType: (*APIKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _APIKeyService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _APIKeyService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _APIKeyService_RevokeAPIKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apikey.proto",
+}
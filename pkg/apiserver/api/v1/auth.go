@@ -26,11 +26,27 @@ var (
 	_ authorizer.AuthRequest = (*ListRequest)(nil)
 	_ authorizer.AuthRequest = (*GetRequest)(nil)
 	_ authorizer.AuthRequest = (*WatchRequest)(nil)
+	_ authorizer.AuthRequest = (*DeleteRequest)(nil)
 	_ authorizer.AuthRequest = (*InstanceListRequest)(nil)
 	_ authorizer.AuthRequest = (*InstanceGetRequest)(nil)
 	_ authorizer.AuthRequest = (*InstanceCreateRequest)(nil)
 	_ authorizer.AuthRequest = (*InstanceDeleteRequest)(nil)
 	_ authorizer.AuthRequest = (*InstanceWatchRequest)(nil)
+	_ authorizer.AuthRequest = (*InstanceUpdateRequest)(nil)
+	_ authorizer.AuthRequest = (*InstancePatchRequest)(nil)
+	_ authorizer.AuthRequest = (*EventListRequest)(nil)
+	_ authorizer.AuthRequest = (*EventWatchRequest)(nil)
+	_ authorizer.AuthRequest = (*CatalogCreateRequest)(nil)
+	_ authorizer.AuthRequest = (*CatalogUpdateRequest)(nil)
+	_ authorizer.AuthRequest = (*CatalogEntryCreateRequest)(nil)
+	_ authorizer.AuthRequest = (*CatalogEntryUpdateRequest)(nil)
+	_ authorizer.AuthRequest = (*CatalogEntrySetCreateRequest)(nil)
+	_ authorizer.AuthRequest = (*CatalogEntrySetUpdateRequest)(nil)
+	_ authorizer.AuthRequest = (*ServiceClusterCreateRequest)(nil)
+	_ authorizer.AuthRequest = (*ServiceClusterUpdateRequest)(nil)
+	_ authorizer.AuthRequest = (*DerivedCustomResourceCreateRequest)(nil)
+	_ authorizer.AuthRequest = (*DerivedCustomResourceUpdateRequest)(nil)
+	_ authorizer.AuthRequest = (*APIKeyCreateRequest)(nil)
 )
 
 type ServerGVRGetter interface {
@@ -80,6 +96,21 @@ func (req *WatchRequest) GetGVR(server interface{}) schema.GroupVersionResource
 	return schema.GroupVersionResource{}
 }
 
+func (req *DeleteRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Name:      req.Name,
+		Namespace: req.Account,
+		Verb:      authorizer.RequestDelete,
+	}
+}
+
+func (req *DeleteRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
 func (req *InstanceCreateRequest) GetAuthOption() authorizer.AuthorizationOption {
 	return authorizer.AuthorizationOption{
 		Namespace: req.Account,
@@ -134,3 +165,211 @@ func (req *InstanceWatchRequest) GetAuthOption() authorizer.AuthorizationOption
 func (req *InstanceWatchRequest) GetGVR(server interface{}) schema.GroupVersionResource {
 	return GetOfferingGVR(req)
 }
+
+func (req *InstanceUpdateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Name:      req.Name,
+		Verb:      authorizer.RequestUpdate,
+	}
+}
+
+func (req *InstanceUpdateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	return GetOfferingGVR(req)
+}
+
+func (req *InstancePatchRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Name:      req.Name,
+		Verb:      authorizer.RequestPatch,
+	}
+}
+
+func (req *InstancePatchRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	return GetOfferingGVR(req)
+}
+
+// Events of an instance are visible to everybody allowed to get the instance.
+func (req *EventListRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Name:      req.Name,
+		Verb:      authorizer.RequestGet,
+	}
+}
+
+func (req *EventListRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	return GetOfferingGVR(req)
+}
+
+func (req *EventWatchRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Name:      req.Name,
+		Verb:      authorizer.RequestGet,
+	}
+}
+
+func (req *EventWatchRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	return GetOfferingGVR(req)
+}
+
+func (req *CatalogCreateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Verb:      authorizer.RequestCreate,
+	}
+}
+
+func (req *CatalogCreateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *CatalogUpdateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Name:      req.Name,
+		Namespace: req.Account,
+		Verb:      authorizer.RequestUpdate,
+	}
+}
+
+func (req *CatalogUpdateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *CatalogEntryCreateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Verb:      authorizer.RequestCreate,
+	}
+}
+
+func (req *CatalogEntryCreateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *CatalogEntryUpdateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Name:      req.Name,
+		Namespace: req.Account,
+		Verb:      authorizer.RequestUpdate,
+	}
+}
+
+func (req *CatalogEntryUpdateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *CatalogEntrySetCreateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Verb:      authorizer.RequestCreate,
+	}
+}
+
+func (req *CatalogEntrySetCreateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *CatalogEntrySetUpdateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Name:      req.Name,
+		Namespace: req.Account,
+		Verb:      authorizer.RequestUpdate,
+	}
+}
+
+func (req *CatalogEntrySetUpdateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *ServiceClusterCreateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Verb:      authorizer.RequestCreate,
+	}
+}
+
+func (req *ServiceClusterCreateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *ServiceClusterUpdateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Name:      req.Name,
+		Namespace: req.Account,
+		Verb:      authorizer.RequestUpdate,
+	}
+}
+
+func (req *ServiceClusterUpdateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *DerivedCustomResourceCreateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Verb:      authorizer.RequestCreate,
+	}
+}
+
+func (req *DerivedCustomResourceCreateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *DerivedCustomResourceUpdateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Name:      req.Name,
+		Namespace: req.Account,
+		Verb:      authorizer.RequestUpdate,
+	}
+}
+
+func (req *DerivedCustomResourceUpdateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
+
+func (req *APIKeyCreateRequest) GetAuthOption() authorizer.AuthorizationOption {
+	return authorizer.AuthorizationOption{
+		Namespace: req.Account,
+		Verb:      authorizer.RequestCreate,
+	}
+}
+
+func (req *APIKeyCreateRequest) GetGVR(server interface{}) schema.GroupVersionResource {
+	if gvrSrv, ok := server.(ServerGVRGetter); ok {
+		return gvrSrv.GetGVR()
+	}
+	return schema.GroupVersionResource{}
+}
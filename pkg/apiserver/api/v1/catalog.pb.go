@@ -0,0 +1,718 @@
+/*
+Copyright 2021 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: catalog.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Catalog struct {
+	Metadata             *ObjectMeta    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec                 *CatalogSpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status               *CatalogStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Catalog) Reset()         { *m = Catalog{} }
+func (m *Catalog) String() string { return proto.CompactTextString(m) }
+func (*Catalog) ProtoMessage()    {}
+func (*Catalog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0abbfcf058acdf89, []int{0}
+}
+
+func (m *Catalog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Catalog.Unmarshal(m, b)
+}
+func (m *Catalog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Catalog.Marshal(b, m, deterministic)
+}
+func (m *Catalog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Catalog.Merge(m, src)
+}
+func (m *Catalog) XXX_Size() int {
+	return xxx_messageInfo_Catalog.Size(m)
+}
+func (m *Catalog) XXX_DiscardUnknown() {
+	xxx_messageInfo_Catalog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Catalog proto.InternalMessageInfo
+
+func (m *Catalog) GetMetadata() *ObjectMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Catalog) GetSpec() *CatalogSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *Catalog) GetStatus() *CatalogStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type CatalogSpec struct {
+	// CatalogEntrySelector selects CatalogEntry objects that should be part of this catalog.
+	CatalogEntrySelector *LabelSelector `protobuf:"bytes,1,opt,name=catalogEntrySelector,proto3" json:"catalogEntrySelector,omitempty"`
+	// TenantSelector selects Tenant objects that the catalog should be published to.
+	TenantSelector       *LabelSelector `protobuf:"bytes,2,opt,name=tenantSelector,proto3" json:"tenantSelector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CatalogSpec) Reset()         { *m = CatalogSpec{} }
+func (m *CatalogSpec) String() string { return proto.CompactTextString(m) }
+func (*CatalogSpec) ProtoMessage()    {}
+func (*CatalogSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0abbfcf058acdf89, []int{1}
+}
+
+func (m *CatalogSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogSpec.Unmarshal(m, b)
+}
+func (m *CatalogSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogSpec.Marshal(b, m, deterministic)
+}
+func (m *CatalogSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogSpec.Merge(m, src)
+}
+func (m *CatalogSpec) XXX_Size() int {
+	return xxx_messageInfo_CatalogSpec.Size(m)
+}
+func (m *CatalogSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogSpec proto.InternalMessageInfo
+
+func (m *CatalogSpec) GetCatalogEntrySelector() *LabelSelector {
+	if m != nil {
+		return m.CatalogEntrySelector
+	}
+	return nil
+}
+
+func (m *CatalogSpec) GetTenantSelector() *LabelSelector {
+	if m != nil {
+		return m.TenantSelector
+	}
+	return nil
+}
+
+type CatalogStatus struct {
+	Tenants              []*ObjectReference `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Entries              []*ObjectReference `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	ObservedGeneration   int64              `protobuf:"varint,3,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions           []*Condition       `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Phase                string             `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CatalogStatus) Reset()         { *m = CatalogStatus{} }
+func (m *CatalogStatus) String() string { return proto.CompactTextString(m) }
+func (*CatalogStatus) ProtoMessage()    {}
+func (*CatalogStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0abbfcf058acdf89, []int{2}
+}
+
+func (m *CatalogStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogStatus.Unmarshal(m, b)
+}
+func (m *CatalogStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogStatus.Marshal(b, m, deterministic)
+}
+func (m *CatalogStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogStatus.Merge(m, src)
+}
+func (m *CatalogStatus) XXX_Size() int {
+	return xxx_messageInfo_CatalogStatus.Size(m)
+}
+func (m *CatalogStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogStatus proto.InternalMessageInfo
+
+func (m *CatalogStatus) GetTenants() []*ObjectReference {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+func (m *CatalogStatus) GetEntries() []*ObjectReference {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *CatalogStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func (m *CatalogStatus) GetConditions() []*Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *CatalogStatus) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+type CatalogList struct {
+	Metadata             *ListMeta  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items                []*Catalog `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CatalogList) Reset()         { *m = CatalogList{} }
+func (m *CatalogList) String() string { return proto.CompactTextString(m) }
+func (*CatalogList) ProtoMessage()    {}
+func (*CatalogList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0abbfcf058acdf89, []int{3}
+}
+
+func (m *CatalogList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogList.Unmarshal(m, b)
+}
+func (m *CatalogList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogList.Marshal(b, m, deterministic)
+}
+func (m *CatalogList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogList.Merge(m, src)
+}
+func (m *CatalogList) XXX_Size() int {
+	return xxx_messageInfo_CatalogList.Size(m)
+}
+func (m *CatalogList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogList proto.InternalMessageInfo
+
+func (m *CatalogList) GetMetadata() *ListMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CatalogList) GetItems() []*Catalog {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CatalogCreateRequest struct {
+	Spec *Catalog `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogCreateRequest) Reset()         { *m = CatalogCreateRequest{} }
+func (m *CatalogCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CatalogCreateRequest) ProtoMessage()    {}
+func (*CatalogCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0abbfcf058acdf89, []int{4}
+}
+
+func (m *CatalogCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogCreateRequest.Unmarshal(m, b)
+}
+func (m *CatalogCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *CatalogCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogCreateRequest.Merge(m, src)
+}
+func (m *CatalogCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CatalogCreateRequest.Size(m)
+}
+func (m *CatalogCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogCreateRequest proto.InternalMessageInfo
+
+func (m *CatalogCreateRequest) GetSpec() *Catalog {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogCreateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type CatalogUpdateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Spec - the full catalog, metadata.resourceVersion is required for optimistic locking
+	Spec *Catalog `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogUpdateRequest) Reset()         { *m = CatalogUpdateRequest{} }
+func (m *CatalogUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*CatalogUpdateRequest) ProtoMessage()    {}
+func (*CatalogUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0abbfcf058acdf89, []int{5}
+}
+
+func (m *CatalogUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogUpdateRequest.Unmarshal(m, b)
+}
+func (m *CatalogUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *CatalogUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogUpdateRequest.Merge(m, src)
+}
+func (m *CatalogUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_CatalogUpdateRequest.Size(m)
+}
+func (m *CatalogUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogUpdateRequest proto.InternalMessageInfo
+
+func (m *CatalogUpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CatalogUpdateRequest) GetSpec() *Catalog {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogUpdateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Catalog)(nil), "kubecarrier.api.v1.Catalog")
+	proto.RegisterType((*CatalogSpec)(nil), "kubecarrier.api.v1.CatalogSpec")
+	proto.RegisterType((*CatalogStatus)(nil), "kubecarrier.api.v1.CatalogStatus")
+	proto.RegisterType((*CatalogList)(nil), "kubecarrier.api.v1.CatalogList")
+	proto.RegisterType((*CatalogCreateRequest)(nil), "kubecarrier.api.v1.CatalogCreateRequest")
+	proto.RegisterType((*CatalogUpdateRequest)(nil), "kubecarrier.api.v1.CatalogUpdateRequest")
+}
+
+func init() {
+	proto.RegisterFile("catalog.proto", fileDescriptor_0abbfcf058acdf89)
+}
+
+var fileDescriptor_0abbfcf058acdf89 = []byte{
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdf, 0x4e, 0xd4, 0x4e,
+	0x14, 0x4e, 0xbb, 0x7f, 0xf8, 0x71, 0x36, 0x70, 0x31, 0x21, 0xbf, 0x34, 0x0b, 0xc2, 0x52, 0xa3,
+	0x6c, 0x4c, 0x6c, 0x5d, 0xf0, 0x42, 0x49, 0xb8, 0x11, 0x09, 0x31, 0xc1, 0x98, 0x94, 0x10, 0x13,
+	0xef, 0x66, 0xbb, 0x07, 0xa8, 0xee, 0x4e, 0xcb, 0xcc, 0xec, 0x1a, 0x20, 0x78, 0x61, 0xe2, 0x0b,
+	0xe8, 0x83, 0x78, 0xe5, 0x93, 0xf8, 0x0a, 0x3e, 0x80, 0x8f, 0x60, 0x3a, 0x7f, 0x36, 0x2c, 0x74,
+	0xcb, 0xea, 0x5d, 0x4f, 0xcf, 0xf9, 0xce, 0xf7, 0xcd, 0x77, 0x4e, 0x3b, 0xb0, 0x10, 0x53, 0x49,
+	0xfb, 0xe9, 0x49, 0x90, 0xf1, 0x54, 0xa6, 0x84, 0x7c, 0x18, 0x76, 0x31, 0xa6, 0x9c, 0x27, 0xc8,
+	0x03, 0x9a, 0x25, 0xc1, 0xa8, 0xd3, 0x5c, 0x39, 0x49, 0xd3, 0x93, 0x3e, 0x86, 0x34, 0x4b, 0x42,
+	0xca, 0x58, 0x2a, 0xa9, 0x4c, 0x52, 0x26, 0x34, 0xa2, 0xb9, 0x6c, 0xb2, 0x2a, 0xea, 0x0e, 0x8f,
+	0x43, 0x1c, 0x64, 0xf2, 0xdc, 0x24, 0x1b, 0xf2, 0x3c, 0x43, 0x5b, 0x09, 0x03, 0x94, 0xd4, 0x26,
+	0x70, 0x84, 0x4c, 0x9a, 0x60, 0x81, 0xe3, 0xd9, 0x10, 0x85, 0x09, 0xfd, 0x1f, 0x0e, 0xcc, 0xed,
+	0x6a, 0x55, 0x64, 0x1b, 0xfe, 0xcb, 0x51, 0x3d, 0x2a, 0xa9, 0xe7, 0xb4, 0x9c, 0x76, 0x63, 0x73,
+	0x35, 0xb8, 0x2d, 0x31, 0x78, 0xd3, 0x7d, 0x8f, 0xb1, 0x7c, 0x8d, 0x92, 0x46, 0xe3, 0x7a, 0xb2,
+	0x05, 0x55, 0x91, 0x61, 0xec, 0xb9, 0x0a, 0xb7, 0x56, 0x84, 0x33, 0x34, 0x87, 0x19, 0xc6, 0x91,
+	0x2a, 0x26, 0xcf, 0xa1, 0x2e, 0x24, 0x95, 0x43, 0xe1, 0x55, 0x14, 0x6c, 0xbd, 0x0c, 0xa6, 0x0a,
+	0x23, 0x03, 0xf0, 0xbf, 0x3b, 0xd0, 0xb8, 0xd6, 0x90, 0x1c, 0xc1, 0x92, 0x31, 0x77, 0x8f, 0x49,
+	0x7e, 0x7e, 0x88, 0x7d, 0x8c, 0x65, 0xca, 0x3d, 0x67, 0x7a, 0xe3, 0x03, 0xda, 0xc5, 0xbe, 0x2d,
+	0x8c, 0x0a, 0xe1, 0xe4, 0x15, 0x2c, 0x4a, 0x64, 0x94, 0xc9, 0x71, 0x43, 0x77, 0xd6, 0x86, 0x37,
+	0x80, 0xfe, 0x57, 0x17, 0x16, 0x26, 0xce, 0x42, 0x76, 0x60, 0x4e, 0xd7, 0x08, 0xcf, 0x69, 0x55,
+	0xda, 0x8d, 0xcd, 0xfb, 0xd3, 0xed, 0x8e, 0xf0, 0x18, 0x39, 0xb2, 0x18, 0x23, 0x8b, 0xc9, 0xe1,
+	0xc8, 0x24, 0x4f, 0x50, 0x78, 0xee, 0x5f, 0xc0, 0x0d, 0x86, 0x04, 0x40, 0xd2, 0xae, 0x40, 0x3e,
+	0xc2, 0xde, 0x3e, 0x32, 0xe4, 0x6a, 0xd1, 0xd4, 0x20, 0x2a, 0x51, 0x41, 0x86, 0xec, 0x00, 0xc4,
+	0x29, 0xeb, 0x25, 0x79, 0x20, 0xbc, 0xaa, 0x62, 0xbc, 0x57, 0x38, 0x30, 0x5b, 0x15, 0x5d, 0x03,
+	0x90, 0x25, 0xa8, 0x65, 0xa7, 0x54, 0xa0, 0x57, 0x6b, 0x39, 0xed, 0xf9, 0x48, 0x07, 0xfe, 0xc5,
+	0x78, 0x8a, 0x07, 0x89, 0x90, 0xe4, 0xd9, 0xad, 0x0d, 0x5c, 0x29, 0x34, 0x3a, 0x11, 0x37, 0xf7,
+	0xaf, 0x03, 0xb5, 0x44, 0xe2, 0xc0, 0x5a, 0xb1, 0x5c, 0xb2, 0x49, 0x91, 0xae, 0xf4, 0x29, 0x2c,
+	0x99, 0x37, 0xbb, 0x1c, 0xa9, 0xc4, 0x48, 0x7f, 0x18, 0x24, 0x34, 0xab, 0xac, 0x05, 0x94, 0x76,
+	0xd2, 0x6b, 0xec, 0xc1, 0x1c, 0x8d, 0xe3, 0x74, 0xc8, 0xa4, 0xda, 0x8e, 0xf9, 0xc8, 0x86, 0xfe,
+	0x70, 0x4c, 0x71, 0x94, 0xf5, 0xae, 0x51, 0x10, 0xa8, 0x32, 0x3a, 0x40, 0x45, 0x31, 0x1f, 0xa9,
+	0xe7, 0x31, 0xad, 0xfb, 0x0f, 0xb4, 0x95, 0x09, 0xda, 0xcd, 0xdf, 0x35, 0x58, 0xb4, 0xab, 0x86,
+	0x7c, 0x94, 0xc4, 0x48, 0xce, 0xa0, 0xaa, 0x1c, 0x5e, 0x9b, 0xe6, 0xa7, 0x91, 0xd6, 0x2c, 0xfb,
+	0x74, 0xf3, 0x3a, 0x7f, 0xe3, 0xf3, 0xcf, 0x5f, 0xdf, 0xdc, 0x75, 0xb2, 0x16, 0x8e, 0x3a, 0xa1,
+	0x61, 0x14, 0xe1, 0xa5, 0x79, 0xba, 0x0a, 0xcd, 0x77, 0x24, 0x08, 0x87, 0xca, 0x3e, 0x4a, 0x52,
+	0xf8, 0x0f, 0xd9, 0xc7, 0x31, 0x61, 0xd9, 0x49, 0xfd, 0x40, 0x91, 0xb5, 0xc9, 0xc3, 0x3b, 0xc8,
+	0xc2, 0xcb, 0xdc, 0xc3, 0x2b, 0xf2, 0x09, 0xea, 0x7a, 0x98, 0xa4, 0x5d, 0xd2, 0x76, 0x62, 0xde,
+	0xe5, 0x02, 0x1e, 0x2b, 0x01, 0x1b, 0xfe, 0x5d, 0xa7, 0xdd, 0xd6, 0x33, 0xf9, 0xe2, 0x40, 0x5d,
+	0x8f, 0xba, 0x54, 0xc0, 0xc4, 0x36, 0x94, 0x0b, 0x78, 0xaa, 0x04, 0x04, 0xcd, 0x19, 0x1d, 0x30,
+	0x3a, 0x04, 0xd4, 0x5f, 0x62, 0x1f, 0x25, 0x92, 0xc2, 0x3f, 0x95, 0xce, 0x59, 0xfe, 0xff, 0x03,
+	0x7d, 0xad, 0x04, 0xf6, 0x5a, 0x09, 0xf6, 0xf2, 0x6b, 0xc5, 0x9a, 0xff, 0x68, 0x56, 0xf3, 0x2f,
+	0xa0, 0xf6, 0x96, 0xca, 0xf8, 0x94, 0xb4, 0x8a, 0x38, 0x55, 0xca, 0x52, 0xae, 0x4e, 0xad, 0xd8,
+	0xcb, 0xef, 0x2a, 0x6b, 0x3b, 0x79, 0x90, 0x53, 0x7f, 0xcc, 0xdf, 0x97, 0x09, 0x78, 0xe2, 0xbc,
+	0xa8, 0xbe, 0x73, 0x47, 0x9d, 0x6e, 0x5d, 0x9d, 0x60, 0xeb, 0xcf, 0x00, 0xc2, 0xe9, 0xf2, 0x1d,
+	0x69, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CatalogServiceClient is the client API for CatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CatalogServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CatalogList, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Catalog, error)
+	Create(ctx context.Context, in *CatalogCreateRequest, opts ...grpc.CallOption) (*Catalog, error)
+	Update(ctx context.Context, in *CatalogUpdateRequest, opts ...grpc.CallOption) (*Catalog, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CatalogService_WatchClient, error)
+}
+
+type catalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogServiceClient(cc grpc.ClientConnInterface) CatalogServiceClient {
+	return &catalogServiceClient{cc}
+}
+
+func (c *catalogServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CatalogList, error) {
+	out := new(CatalogList)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Catalog, error) {
+	out := new(Catalog)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) Create(ctx context.Context, in *CatalogCreateRequest, opts ...grpc.CallOption) (*Catalog, error) {
+	out := new(Catalog)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) Update(ctx context.Context, in *CatalogUpdateRequest, opts ...grpc.CallOption) (*Catalog, error) {
+	out := new(Catalog)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CatalogService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CatalogService_serviceDesc.Streams[0], "/kubecarrier.api.v1.CatalogService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &catalogServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CatalogService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type catalogServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *catalogServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CatalogServiceServer is the server API for CatalogService service.
+type CatalogServiceServer interface {
+	List(context.Context, *ListRequest) (*CatalogList, error)
+	Get(context.Context, *GetRequest) (*Catalog, error)
+	Create(context.Context, *CatalogCreateRequest) (*Catalog, error)
+	Update(context.Context, *CatalogUpdateRequest) (*Catalog, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	Watch(*WatchRequest, CatalogService_WatchServer) error
+}
+
+// UnimplementedCatalogServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCatalogServiceServer struct {
+}
+
+func (*UnimplementedCatalogServiceServer) List(ctx context.Context, req *ListRequest) (*CatalogList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedCatalogServiceServer) Get(ctx context.Context, req *GetRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedCatalogServiceServer) Create(ctx context.Context, req *CatalogCreateRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedCatalogServiceServer) Update(ctx context.Context, req *CatalogUpdateRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedCatalogServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedCatalogServiceServer) Watch(req *WatchRequest, srv CatalogService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterCatalogServiceServer(s *grpc.Server, srv CatalogServiceServer) {
+	s.RegisterService(&_CatalogService_serviceDesc, srv)
+}
+
+func _CatalogService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).Create(ctx, req.(*CatalogCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).Update(ctx, req.(*CatalogUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CatalogServiceServer).Watch(m, &catalogServiceWatchServer{stream})
+}
+
+type CatalogService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type catalogServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *catalogServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _CatalogService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubecarrier.api.v1.CatalogService",
+	HandlerType: (*CatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _CatalogService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CatalogService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _CatalogService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CatalogService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CatalogService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _CatalogService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "catalog.proto",
+}
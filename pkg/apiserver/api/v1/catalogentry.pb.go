@@ -0,0 +1,1028 @@
+/*
+Copyright 2021 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: catalogentry.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CatalogEntry struct {
+	Metadata             *ObjectMeta         `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec                 *CatalogEntrySpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status               *CatalogEntryStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CatalogEntry) Reset()         { *m = CatalogEntry{} }
+func (m *CatalogEntry) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntry) ProtoMessage()    {}
+func (*CatalogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{0}
+}
+
+func (m *CatalogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntry.Unmarshal(m, b)
+}
+func (m *CatalogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntry.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntry.Merge(m, src)
+}
+func (m *CatalogEntry) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntry.Size(m)
+}
+func (m *CatalogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntry proto.InternalMessageInfo
+
+func (m *CatalogEntry) GetMetadata() *ObjectMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CatalogEntry) GetSpec() *CatalogEntrySpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogEntry) GetStatus() *CatalogEntryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type CatalogEntrySpec struct {
+	Metadata *CommonMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// BaseCRD is the underlying ProviderCRD objects that this CatalogEntry refers to.
+	BaseCRD *ObjectReference `protobuf:"bytes,2,opt,name=baseCRD,proto3" json:"baseCRD,omitempty"`
+	// Derive contains the configuration to generate DerivedCustomResources from the BaseCRD of this CatalogEntry.
+	Derive *DerivedConfig `protobuf:"bytes,3,opt,name=derive,proto3" json:"derive,omitempty"`
+	// ReferencedObjects lists Secrets and ConfigMaps referenced by instances of the BaseCRD, that should be propagated to the Tenant.
+	ReferencedObjects []*ReferencedObject `protobuf:"bytes,4,rep,name=referencedObjects,proto3" json:"referencedObjects,omitempty"`
+	// Metering configures the usage metering of instances of this CatalogEntry.
+	Metering  *MeteringConfig  `protobuf:"bytes,5,opt,name=metering,proto3" json:"metering,omitempty"`
+	Migration *MigrationConfig `protobuf:"bytes,6,opt,name=migration,proto3" json:"migration,omitempty"`
+	// Bundle lists further CatalogEntries, that are offered together with this CatalogEntry.
+	Bundle []*ObjectReference `protobuf:"bytes,7,rep,name=bundle,proto3" json:"bundle,omitempty"`
+	// ObjectReferences lists fields of instances, that reference instances of another CatalogEntry.
+	ObjectReferences     []*ObjectReferenceField `protobuf:"bytes,8,rep,name=objectReferences,proto3" json:"objectReferences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CatalogEntrySpec) Reset()         { *m = CatalogEntrySpec{} }
+func (m *CatalogEntrySpec) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntrySpec) ProtoMessage()    {}
+func (*CatalogEntrySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{1}
+}
+
+func (m *CatalogEntrySpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntrySpec.Unmarshal(m, b)
+}
+func (m *CatalogEntrySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntrySpec.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntrySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntrySpec.Merge(m, src)
+}
+func (m *CatalogEntrySpec) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntrySpec.Size(m)
+}
+func (m *CatalogEntrySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntrySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntrySpec proto.InternalMessageInfo
+
+func (m *CatalogEntrySpec) GetMetadata() *CommonMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CatalogEntrySpec) GetBaseCRD() *ObjectReference {
+	if m != nil {
+		return m.BaseCRD
+	}
+	return nil
+}
+
+func (m *CatalogEntrySpec) GetDerive() *DerivedConfig {
+	if m != nil {
+		return m.Derive
+	}
+	return nil
+}
+
+func (m *CatalogEntrySpec) GetReferencedObjects() []*ReferencedObject {
+	if m != nil {
+		return m.ReferencedObjects
+	}
+	return nil
+}
+
+func (m *CatalogEntrySpec) GetMetering() *MeteringConfig {
+	if m != nil {
+		return m.Metering
+	}
+	return nil
+}
+
+func (m *CatalogEntrySpec) GetMigration() *MigrationConfig {
+	if m != nil {
+		return m.Migration
+	}
+	return nil
+}
+
+func (m *CatalogEntrySpec) GetBundle() []*ObjectReference {
+	if m != nil {
+		return m.Bundle
+	}
+	return nil
+}
+
+func (m *CatalogEntrySpec) GetObjectReferences() []*ObjectReferenceField {
+	if m != nil {
+		return m.ObjectReferences
+	}
+	return nil
+}
+
+type DerivedConfig struct {
+	Expose               []*VersionExposeConfig `protobuf:"bytes,1,rep,name=expose,proto3" json:"expose,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DerivedConfig) Reset()         { *m = DerivedConfig{} }
+func (m *DerivedConfig) String() string { return proto.CompactTextString(m) }
+func (*DerivedConfig) ProtoMessage()    {}
+func (*DerivedConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{2}
+}
+
+func (m *DerivedConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedConfig.Unmarshal(m, b)
+}
+func (m *DerivedConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedConfig.Marshal(b, m, deterministic)
+}
+func (m *DerivedConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedConfig.Merge(m, src)
+}
+func (m *DerivedConfig) XXX_Size() int {
+	return xxx_messageInfo_DerivedConfig.Size(m)
+}
+func (m *DerivedConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedConfig proto.InternalMessageInfo
+
+func (m *DerivedConfig) GetExpose() []*VersionExposeConfig {
+	if m != nil {
+		return m.Expose
+	}
+	return nil
+}
+
+type MeteringConfig struct {
+	// Dimensions are numeric fields of instances that are recorded in UsageRecords.
+	Dimensions []*MeteringDimension `protobuf:"bytes,1,rep,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Sink is the URL usage events are exported to, supported schemes are http, https and file.
+	Sink                 string   `protobuf:"bytes,2,opt,name=sink,proto3" json:"sink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeteringConfig) Reset()         { *m = MeteringConfig{} }
+func (m *MeteringConfig) String() string { return proto.CompactTextString(m) }
+func (*MeteringConfig) ProtoMessage()    {}
+func (*MeteringConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{3}
+}
+
+func (m *MeteringConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteringConfig.Unmarshal(m, b)
+}
+func (m *MeteringConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteringConfig.Marshal(b, m, deterministic)
+}
+func (m *MeteringConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteringConfig.Merge(m, src)
+}
+func (m *MeteringConfig) XXX_Size() int {
+	return xxx_messageInfo_MeteringConfig.Size(m)
+}
+func (m *MeteringConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteringConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteringConfig proto.InternalMessageInfo
+
+func (m *MeteringConfig) GetDimensions() []*MeteringDimension {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+func (m *MeteringConfig) GetSink() string {
+	if m != nil {
+		return m.Sink
+	}
+	return ""
+}
+
+type MeteringDimension struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	JsonPath             string   `protobuf:"bytes,2,opt,name=jsonPath,proto3" json:"jsonPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeteringDimension) Reset()         { *m = MeteringDimension{} }
+func (m *MeteringDimension) String() string { return proto.CompactTextString(m) }
+func (*MeteringDimension) ProtoMessage()    {}
+func (*MeteringDimension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{4}
+}
+
+func (m *MeteringDimension) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteringDimension.Unmarshal(m, b)
+}
+func (m *MeteringDimension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteringDimension.Marshal(b, m, deterministic)
+}
+func (m *MeteringDimension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteringDimension.Merge(m, src)
+}
+func (m *MeteringDimension) XXX_Size() int {
+	return xxx_messageInfo_MeteringDimension.Size(m)
+}
+func (m *MeteringDimension) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteringDimension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteringDimension proto.InternalMessageInfo
+
+func (m *MeteringDimension) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MeteringDimension) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
+type MigrationConfig struct {
+	// PreHook is called before the instance is created in the target ServiceCluster.
+	PreHook *MigrationHook `protobuf:"bytes,1,opt,name=preHook,proto3" json:"preHook,omitempty"`
+	// PostHook is called when the migrated instance is ready, before the original instance is removed.
+	PostHook             *MigrationHook `protobuf:"bytes,2,opt,name=postHook,proto3" json:"postHook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MigrationConfig) Reset()         { *m = MigrationConfig{} }
+func (m *MigrationConfig) String() string { return proto.CompactTextString(m) }
+func (*MigrationConfig) ProtoMessage()    {}
+func (*MigrationConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{5}
+}
+
+func (m *MigrationConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrationConfig.Unmarshal(m, b)
+}
+func (m *MigrationConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrationConfig.Marshal(b, m, deterministic)
+}
+func (m *MigrationConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationConfig.Merge(m, src)
+}
+func (m *MigrationConfig) XXX_Size() int {
+	return xxx_messageInfo_MigrationConfig.Size(m)
+}
+func (m *MigrationConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationConfig proto.InternalMessageInfo
+
+func (m *MigrationConfig) GetPreHook() *MigrationHook {
+	if m != nil {
+		return m.PreHook
+	}
+	return nil
+}
+
+func (m *MigrationConfig) GetPostHook() *MigrationHook {
+	if m != nil {
+		return m.PostHook
+	}
+	return nil
+}
+
+type MigrationHook struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// TimeoutSeconds is the timeout of the hook call, 30s if not set.
+	TimeoutSeconds       int64    `protobuf:"varint,2,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrationHook) Reset()         { *m = MigrationHook{} }
+func (m *MigrationHook) String() string { return proto.CompactTextString(m) }
+func (*MigrationHook) ProtoMessage()    {}
+func (*MigrationHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{6}
+}
+
+func (m *MigrationHook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrationHook.Unmarshal(m, b)
+}
+func (m *MigrationHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrationHook.Marshal(b, m, deterministic)
+}
+func (m *MigrationHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationHook.Merge(m, src)
+}
+func (m *MigrationHook) XXX_Size() int {
+	return xxx_messageInfo_MigrationHook.Size(m)
+}
+func (m *MigrationHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationHook proto.InternalMessageInfo
+
+func (m *MigrationHook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *MigrationHook) GetTimeoutSeconds() int64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type CatalogEntryStatus struct {
+	// TenantCRD holds the information about the Tenant facing CRD that is offered by this CatalogEntry.
+	TenantCRD *CRDInformation `protobuf:"bytes,1,opt,name=tenantCRD,proto3" json:"tenantCRD,omitempty"`
+	// ProviderCRD holds the information about the Provider facing CRD that is offered by this CatalogEntry.
+	ProviderCRD          *CRDInformation `protobuf:"bytes,2,opt,name=providerCRD,proto3" json:"providerCRD,omitempty"`
+	ObservedGeneration   int64           `protobuf:"varint,3,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions           []*Condition    `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Phase                string          `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CatalogEntryStatus) Reset()         { *m = CatalogEntryStatus{} }
+func (m *CatalogEntryStatus) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntryStatus) ProtoMessage()    {}
+func (*CatalogEntryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{7}
+}
+
+func (m *CatalogEntryStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntryStatus.Unmarshal(m, b)
+}
+func (m *CatalogEntryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntryStatus.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntryStatus.Merge(m, src)
+}
+func (m *CatalogEntryStatus) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntryStatus.Size(m)
+}
+func (m *CatalogEntryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntryStatus proto.InternalMessageInfo
+
+func (m *CatalogEntryStatus) GetTenantCRD() *CRDInformation {
+	if m != nil {
+		return m.TenantCRD
+	}
+	return nil
+}
+
+func (m *CatalogEntryStatus) GetProviderCRD() *CRDInformation {
+	if m != nil {
+		return m.ProviderCRD
+	}
+	return nil
+}
+
+func (m *CatalogEntryStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func (m *CatalogEntryStatus) GetConditions() []*Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *CatalogEntryStatus) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+type CatalogEntryList struct {
+	Metadata             *ListMeta       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items                []*CatalogEntry `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CatalogEntryList) Reset()         { *m = CatalogEntryList{} }
+func (m *CatalogEntryList) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntryList) ProtoMessage()    {}
+func (*CatalogEntryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{8}
+}
+
+func (m *CatalogEntryList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntryList.Unmarshal(m, b)
+}
+func (m *CatalogEntryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntryList.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntryList.Merge(m, src)
+}
+func (m *CatalogEntryList) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntryList.Size(m)
+}
+func (m *CatalogEntryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntryList proto.InternalMessageInfo
+
+func (m *CatalogEntryList) GetMetadata() *ListMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CatalogEntryList) GetItems() []*CatalogEntry {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CatalogEntryCreateRequest struct {
+	Spec *CatalogEntry `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogEntryCreateRequest) Reset()         { *m = CatalogEntryCreateRequest{} }
+func (m *CatalogEntryCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntryCreateRequest) ProtoMessage()    {}
+func (*CatalogEntryCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{9}
+}
+
+func (m *CatalogEntryCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntryCreateRequest.Unmarshal(m, b)
+}
+func (m *CatalogEntryCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntryCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntryCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntryCreateRequest.Merge(m, src)
+}
+func (m *CatalogEntryCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntryCreateRequest.Size(m)
+}
+func (m *CatalogEntryCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntryCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntryCreateRequest proto.InternalMessageInfo
+
+func (m *CatalogEntryCreateRequest) GetSpec() *CatalogEntry {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogEntryCreateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type CatalogEntryUpdateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Spec - the full catalog entry, metadata.resourceVersion is required for optimistic locking
+	Spec *CatalogEntry `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogEntryUpdateRequest) Reset()         { *m = CatalogEntryUpdateRequest{} }
+func (m *CatalogEntryUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntryUpdateRequest) ProtoMessage()    {}
+func (*CatalogEntryUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4110bc51d9abb2e, []int{10}
+}
+
+func (m *CatalogEntryUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntryUpdateRequest.Unmarshal(m, b)
+}
+func (m *CatalogEntryUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntryUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntryUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntryUpdateRequest.Merge(m, src)
+}
+func (m *CatalogEntryUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntryUpdateRequest.Size(m)
+}
+func (m *CatalogEntryUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntryUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntryUpdateRequest proto.InternalMessageInfo
+
+func (m *CatalogEntryUpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CatalogEntryUpdateRequest) GetSpec() *CatalogEntry {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogEntryUpdateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CatalogEntry)(nil), "kubecarrier.api.v1.CatalogEntry")
+	proto.RegisterType((*CatalogEntrySpec)(nil), "kubecarrier.api.v1.CatalogEntrySpec")
+	proto.RegisterType((*DerivedConfig)(nil), "kubecarrier.api.v1.DerivedConfig")
+	proto.RegisterType((*MeteringConfig)(nil), "kubecarrier.api.v1.MeteringConfig")
+	proto.RegisterType((*MeteringDimension)(nil), "kubecarrier.api.v1.MeteringDimension")
+	proto.RegisterType((*MigrationConfig)(nil), "kubecarrier.api.v1.MigrationConfig")
+	proto.RegisterType((*MigrationHook)(nil), "kubecarrier.api.v1.MigrationHook")
+	proto.RegisterType((*CatalogEntryStatus)(nil), "kubecarrier.api.v1.CatalogEntryStatus")
+	proto.RegisterType((*CatalogEntryList)(nil), "kubecarrier.api.v1.CatalogEntryList")
+	proto.RegisterType((*CatalogEntryCreateRequest)(nil), "kubecarrier.api.v1.CatalogEntryCreateRequest")
+	proto.RegisterType((*CatalogEntryUpdateRequest)(nil), "kubecarrier.api.v1.CatalogEntryUpdateRequest")
+}
+
+func init() {
+	proto.RegisterFile("catalogentry.proto", fileDescriptor_d4110bc51d9abb2e)
+}
+
+var fileDescriptor_d4110bc51d9abb2e = []byte{
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xc6, 0x8e, 0x93, 0x9c, 0x90, 0x92, 0x1e, 0x10, 0x5a, 0xdc, 0x52, 0xc2, 0x42, 0x7f,
+	0x04, 0xc4, 0x26, 0x6d, 0x84, 0x4a, 0xaa, 0x94, 0x9f, 0x38, 0x84, 0x4a, 0x44, 0x54, 0x53, 0x7e,
+	0x24, 0xee, 0xc6, 0xbb, 0x27, 0xce, 0xd6, 0xde, 0x99, 0x65, 0x66, 0x6c, 0x88, 0x4a, 0x04, 0x42,
+	0xdc, 0xc2, 0x0d, 0xe2, 0x01, 0x78, 0x09, 0x24, 0xee, 0x79, 0x03, 0x5e, 0x81, 0x07, 0x41, 0x3b,
+	0x3b, 0xeb, 0x78, 0xfd, 0x53, 0xbb, 0xdc, 0xed, 0xec, 0x7c, 0xdf, 0x39, 0xdf, 0xf9, 0x99, 0x39,
+	0x03, 0x18, 0x72, 0xc3, 0x7b, 0xb2, 0x43, 0xc2, 0xa8, 0xb3, 0x46, 0xaa, 0xa4, 0x91, 0x88, 0xdd,
+	0x7e, 0x9b, 0x42, 0xae, 0x54, 0x4c, 0xaa, 0xc1, 0xd3, 0xb8, 0x31, 0xd8, 0xa9, 0x5f, 0xed, 0x48,
+	0xd9, 0xe9, 0x51, 0x93, 0xa7, 0x71, 0x93, 0x0b, 0x21, 0x0d, 0x37, 0xb1, 0x14, 0x3a, 0x67, 0xd4,
+	0xaf, 0xb8, 0x5d, 0xbb, 0x6a, 0xf7, 0x4f, 0x9a, 0x94, 0xa4, 0xc6, 0x99, 0xab, 0xaf, 0x9b, 0xb3,
+	0x94, 0x0a, 0x24, 0x24, 0x64, 0x78, 0xb1, 0x41, 0x03, 0x12, 0xc6, 0x2d, 0x36, 0x14, 0x7d, 0xd3,
+	0x27, 0x5d, 0x2c, 0xaf, 0x44, 0xa4, 0xe2, 0x01, 0x45, 0x61, 0x5f, 0x1b, 0x99, 0x28, 0xd2, 0xb2,
+	0xaf, 0x42, 0xca, 0x37, 0x83, 0xbf, 0x3d, 0x78, 0xee, 0x20, 0xd7, 0x7d, 0x98, 0xe9, 0xc6, 0x3d,
+	0x58, 0xcd, 0xec, 0x46, 0xdc, 0x70, 0xdf, 0xdb, 0xf2, 0x6e, 0xad, 0xdf, 0xbe, 0xd6, 0x98, 0x0c,
+	0xa2, 0xf1, 0x59, 0xfb, 0x31, 0x85, 0xe6, 0x98, 0x0c, 0x67, 0x43, 0x3c, 0xde, 0x85, 0xaa, 0x4e,
+	0x29, 0xf4, 0x97, 0x2c, 0xef, 0x8d, 0x69, 0xbc, 0x51, 0x5f, 0x8f, 0x52, 0x0a, 0x99, 0x65, 0xe0,
+	0x7d, 0xa8, 0x69, 0xc3, 0x4d, 0x5f, 0xfb, 0x15, 0xcb, 0xbd, 0x31, 0x97, 0x6b, 0xd1, 0xcc, 0xb1,
+	0x82, 0x3f, 0xab, 0xb0, 0x39, 0x6e, 0x1a, 0xef, 0x4f, 0x84, 0x12, 0x4c, 0x35, 0x2b, 0x93, 0x44,
+	0x8a, 0x63, 0x87, 0x1c, 0x09, 0x67, 0x1f, 0x56, 0xda, 0x5c, 0xd3, 0x01, 0x6b, 0xb9, 0x88, 0x5e,
+	0x9f, 0x9d, 0x09, 0x46, 0x27, 0xa4, 0x48, 0x84, 0xc4, 0x0a, 0x0e, 0xbe, 0x07, 0xb5, 0x3c, 0xf3,
+	0x2e, 0xa6, 0xd7, 0xa6, 0xb1, 0x5b, 0x79, 0x6d, 0x0e, 0xa4, 0x38, 0x89, 0x3b, 0xcc, 0x11, 0x90,
+	0xc1, 0x65, 0x55, 0x18, 0x8c, 0x72, 0x07, 0xda, 0xaf, 0x6e, 0x55, 0x66, 0x65, 0x95, 0x8d, 0x81,
+	0xd9, 0x24, 0xdd, 0x65, 0x83, 0x54, 0x2c, 0x3a, 0xfe, 0xf2, 0xec, 0x6c, 0x1c, 0x3b, 0x8c, 0x53,
+	0x34, 0xe4, 0xe0, 0x87, 0xb0, 0x96, 0xc4, 0x1d, 0x65, 0x9b, 0xd5, 0xaf, 0xcd, 0xce, 0xc7, 0x71,
+	0x01, 0x72, 0x16, 0x2e, 0x58, 0x78, 0x0f, 0x6a, 0xed, 0xbe, 0x88, 0x7a, 0xe4, 0xaf, 0x6c, 0x55,
+	0x66, 0xf1, 0xc7, 0xf3, 0xe9, 0x28, 0xf8, 0x39, 0x6c, 0xca, 0xf2, 0x96, 0xf6, 0x57, 0xad, 0x99,
+	0x5b, 0x0b, 0x98, 0xf9, 0x38, 0xa6, 0x5e, 0xc4, 0x26, 0x2c, 0x04, 0x0f, 0x61, 0xa3, 0x54, 0x02,
+	0x7c, 0x1f, 0x6a, 0xf4, 0x5d, 0x2a, 0x35, 0xf9, 0x9e, 0x35, 0x7e, 0x73, 0x9a, 0xf1, 0x2f, 0x49,
+	0xe9, 0x58, 0x8a, 0x43, 0x0b, 0x2c, 0x6a, 0x97, 0xd3, 0x82, 0x2e, 0x5c, 0x2a, 0xe7, 0x10, 0x0f,
+	0x01, 0xa2, 0x38, 0x21, 0x91, 0x51, 0xb4, 0x33, 0x7b, 0xfd, 0x69, 0xb9, 0x6f, 0x15, 0x68, 0x36,
+	0x42, 0x44, 0x84, 0xaa, 0x8e, 0x45, 0xd7, 0xf6, 0xe2, 0x1a, 0xb3, 0xdf, 0xc1, 0x01, 0x5c, 0x9e,
+	0x20, 0x65, 0x40, 0xc1, 0x13, 0xb2, 0x3d, 0xbf, 0xc6, 0xec, 0x37, 0xd6, 0x61, 0xf5, 0xb1, 0x96,
+	0xe2, 0x21, 0x37, 0xa7, 0xce, 0xc0, 0x70, 0x1d, 0xfc, 0xe2, 0xc1, 0xf3, 0x63, 0x55, 0xc3, 0x7b,
+	0xb0, 0x92, 0x2a, 0xfa, 0x44, 0xca, 0xae, 0xef, 0xcd, 0xee, 0xde, 0x21, 0x2b, 0x03, 0xb2, 0x82,
+	0x81, 0xfb, 0xb0, 0x9a, 0x4a, 0x6d, 0x2c, 0x7b, 0x69, 0x51, 0xf6, 0x90, 0x12, 0x3c, 0x80, 0x8d,
+	0xd2, 0x16, 0x6e, 0x42, 0xa5, 0xaf, 0x7a, 0x2e, 0x9e, 0xec, 0x13, 0x6f, 0xc0, 0x25, 0x13, 0x27,
+	0x24, 0xfb, 0xe6, 0x11, 0x85, 0x52, 0x44, 0xda, 0xfa, 0xa9, 0xb0, 0xb1, 0xbf, 0xc1, 0x1f, 0x4b,
+	0x80, 0x93, 0xd7, 0x06, 0x7e, 0x00, 0x6b, 0x86, 0x04, 0x17, 0x26, 0x3b, 0xdb, 0x4f, 0xbb, 0x1a,
+	0x58, 0xeb, 0x81, 0x38, 0x91, 0x2a, 0xb1, 0x5a, 0xd8, 0x05, 0x09, 0x5b, 0xb0, 0x9e, 0x2a, 0x39,
+	0x88, 0x23, 0x52, 0x17, 0xf7, 0xc3, 0x22, 0x36, 0x46, 0x69, 0xd8, 0x00, 0x94, 0x6d, 0x4d, 0x6a,
+	0x40, 0xd1, 0x11, 0x09, 0x72, 0x87, 0xab, 0x62, 0x43, 0x99, 0xb2, 0x83, 0xfb, 0x00, 0x59, 0x5c,
+	0xb1, 0xb1, 0x9d, 0x94, 0x5f, 0x08, 0xaf, 0x4c, 0x75, 0x5a, 0xa0, 0xd8, 0x08, 0x01, 0x5f, 0x84,
+	0xe5, 0xf4, 0x94, 0x6b, 0xb2, 0xe7, 0x7f, 0x8d, 0xe5, 0x8b, 0xe0, 0x67, 0xaf, 0x7c, 0x77, 0x7e,
+	0x1a, 0x6b, 0x83, 0x77, 0x27, 0xee, 0xce, 0xab, 0xd3, 0xfc, 0x64, 0xd8, 0xb1, 0x21, 0xf0, 0x2e,
+	0x2c, 0xc7, 0x86, 0x92, 0xac, 0x22, 0x99, 0xbc, 0xad, 0x79, 0x37, 0x39, 0xcb, 0xe1, 0x41, 0x17,
+	0x5e, 0x1e, 0xfd, 0x7d, 0xa0, 0x88, 0x1b, 0x62, 0xf9, 0x24, 0xc3, 0x5d, 0x37, 0x59, 0x72, 0x29,
+	0xf3, 0x6d, 0xe6, 0x53, 0xc5, 0x87, 0x15, 0x1e, 0x86, 0xb2, 0x2f, 0x8c, 0xeb, 0xf9, 0x62, 0x19,
+	0xfc, 0x50, 0x76, 0xf6, 0x45, 0x1a, 0x8d, 0x38, 0x9b, 0x76, 0x7e, 0x76, 0x4b, 0xa3, 0xed, 0x7f,
+	0x08, 0xa8, 0x94, 0x04, 0xdc, 0xfe, 0xab, 0x06, 0x2f, 0x94, 0x1a, 0x93, 0xd4, 0x20, 0x0e, 0x09,
+	0xbf, 0x87, 0xaa, 0xcd, 0xff, 0xab, 0xb3, 0xb2, 0xed, 0x44, 0xd6, 0xe7, 0x4e, 0xd7, 0x0c, 0x1c,
+	0x6c, 0xff, 0xf4, 0xcf, 0xbf, 0xbf, 0x2d, 0xdd, 0xc4, 0xeb, 0xcd, 0xc1, 0x4e, 0xd3, 0xf9, 0xd6,
+	0xcd, 0x27, 0xee, 0xeb, 0xbc, 0x39, 0xf2, 0x64, 0x89, 0x49, 0xe3, 0x39, 0x54, 0x8e, 0xc8, 0xe0,
+	0xd4, 0x89, 0x7f, 0x44, 0x43, 0xdf, 0x73, 0xc3, 0x0f, 0x76, 0xad, 0xdf, 0x06, 0xbe, 0xbd, 0x90,
+	0xdf, 0xe6, 0x93, 0x2c, 0xc7, 0xe7, 0xf8, 0xab, 0x07, 0xb5, 0xbc, 0xee, 0xb8, 0x3d, 0xcf, 0x45,
+	0xa9, 0x3f, 0x16, 0x50, 0x74, 0xc7, 0x2a, 0xda, 0x0e, 0x16, 0xcb, 0xc4, 0x5e, 0x5e, 0xbf, 0xdf,
+	0x3d, 0xa8, 0xe5, 0xbd, 0x31, 0x5f, 0x50, 0xa9, 0x87, 0x16, 0x10, 0xb4, 0x67, 0x05, 0xed, 0xd6,
+	0x9f, 0x29, 0x45, 0x4e, 0xd7, 0x19, 0xd4, 0x5a, 0xd4, 0x23, 0x43, 0x38, 0xe3, 0x51, 0xd1, 0xa3,
+	0x0b, 0x29, 0x2f, 0x35, 0xf2, 0x27, 0x65, 0xa3, 0x78, 0x52, 0x36, 0x0e, 0xb3, 0x27, 0x65, 0x51,
+	0xa3, 0x37, 0x9f, 0xad, 0x46, 0x3f, 0x7a, 0xb0, 0xfc, 0x15, 0x37, 0xe1, 0x29, 0x4e, 0x0d, 0xd1,
+	0x6e, 0x15, 0x9e, 0xaf, 0xcd, 0x44, 0x1c, 0x66, 0xcf, 0xd5, 0xa2, 0x26, 0xf8, 0x56, 0xa6, 0xe0,
+	0xdb, 0xec, 0xff, 0x7c, 0x1d, 0xef, 0x78, 0x1f, 0x55, 0xbf, 0x5e, 0x1a, 0xec, 0xb4, 0x6b, 0x36,
+	0x9c, 0x3b, 0xff, 0x0d, 0x00, 0x71, 0x85, 0x60, 0x6e, 0x77, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CatalogEntryServiceClient is the client API for CatalogEntryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CatalogEntryServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CatalogEntryList, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	Create(ctx context.Context, in *CatalogEntryCreateRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	Update(ctx context.Context, in *CatalogEntryUpdateRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CatalogEntryService_WatchClient, error)
+}
+
+type catalogEntryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogEntryServiceClient(cc grpc.ClientConnInterface) CatalogEntryServiceClient {
+	return &catalogEntryServiceClient{cc}
+}
+
+func (c *catalogEntryServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CatalogEntryList, error) {
+	out := new(CatalogEntryList)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntryService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntryServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntryService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntryServiceClient) Create(ctx context.Context, in *CatalogEntryCreateRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntryService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntryServiceClient) Update(ctx context.Context, in *CatalogEntryUpdateRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntryService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntryServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntryService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntryServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CatalogEntryService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CatalogEntryService_serviceDesc.Streams[0], "/kubecarrier.api.v1.CatalogEntryService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &catalogEntryServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CatalogEntryService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type catalogEntryServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *catalogEntryServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CatalogEntryServiceServer is the server API for CatalogEntryService service.
+type CatalogEntryServiceServer interface {
+	List(context.Context, *ListRequest) (*CatalogEntryList, error)
+	Get(context.Context, *GetRequest) (*CatalogEntry, error)
+	Create(context.Context, *CatalogEntryCreateRequest) (*CatalogEntry, error)
+	Update(context.Context, *CatalogEntryUpdateRequest) (*CatalogEntry, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	Watch(*WatchRequest, CatalogEntryService_WatchServer) error
+}
+
+// UnimplementedCatalogEntryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCatalogEntryServiceServer struct {
+}
+
+func (*UnimplementedCatalogEntryServiceServer) List(ctx context.Context, req *ListRequest) (*CatalogEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedCatalogEntryServiceServer) Get(ctx context.Context, req *GetRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedCatalogEntryServiceServer) Create(ctx context.Context, req *CatalogEntryCreateRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedCatalogEntryServiceServer) Update(ctx context.Context, req *CatalogEntryUpdateRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedCatalogEntryServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedCatalogEntryServiceServer) Watch(req *WatchRequest, srv CatalogEntryService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterCatalogEntryServiceServer(s *grpc.Server, srv CatalogEntryServiceServer) {
+	s.RegisterService(&_CatalogEntryService_serviceDesc, srv)
+}
+
+func _CatalogEntryService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntryServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntryService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntryServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntryService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntryServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntryService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntryServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntryService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntryServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntryService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntryServiceServer).Create(ctx, req.(*CatalogEntryCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntryService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntryServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntryService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntryServiceServer).Update(ctx, req.(*CatalogEntryUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntryService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntryServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntryService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntryServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntryService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CatalogEntryServiceServer).Watch(m, &catalogEntryServiceWatchServer{stream})
+}
+
+type CatalogEntryService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type catalogEntryServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *catalogEntryServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _CatalogEntryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubecarrier.api.v1.CatalogEntryService",
+	HandlerType: (*CatalogEntryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _CatalogEntryService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CatalogEntryService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _CatalogEntryService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CatalogEntryService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CatalogEntryService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _CatalogEntryService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "catalogentry.proto",
+}
@@ -0,0 +1,874 @@
+/*
+Copyright 2021 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: catalogentryset.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CatalogEntrySet struct {
+	Metadata             *ObjectMeta            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec                 *CatalogEntrySetSpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status               *CatalogEntrySetStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CatalogEntrySet) Reset()         { *m = CatalogEntrySet{} }
+func (m *CatalogEntrySet) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntrySet) ProtoMessage()    {}
+func (*CatalogEntrySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{0}
+}
+
+func (m *CatalogEntrySet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntrySet.Unmarshal(m, b)
+}
+func (m *CatalogEntrySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntrySet.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntrySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntrySet.Merge(m, src)
+}
+func (m *CatalogEntrySet) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntrySet.Size(m)
+}
+func (m *CatalogEntrySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntrySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntrySet proto.InternalMessageInfo
+
+func (m *CatalogEntrySet) GetMetadata() *ObjectMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CatalogEntrySet) GetSpec() *CatalogEntrySetSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogEntrySet) GetStatus() *CatalogEntrySetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type CatalogEntrySetSpec struct {
+	Metadata             *CommonMetadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Derive               *DerivedConfig                    `protobuf:"bytes,2,opt,name=derive,proto3" json:"derive,omitempty"`
+	Discover             *CustomResourceDiscoverySetConfig `protobuf:"bytes,3,opt,name=discover,proto3" json:"discover,omitempty"`
+	ReferencedObjects    []*ReferencedObject               `protobuf:"bytes,4,rep,name=referencedObjects,proto3" json:"referencedObjects,omitempty"`
+	Metering             *MeteringConfig                   `protobuf:"bytes,5,opt,name=metering,proto3" json:"metering,omitempty"`
+	Scheduling           *SchedulingConfig                 `protobuf:"bytes,6,opt,name=scheduling,proto3" json:"scheduling,omitempty"`
+	Migration            *MigrationConfig                  `protobuf:"bytes,7,opt,name=migration,proto3" json:"migration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *CatalogEntrySetSpec) Reset()         { *m = CatalogEntrySetSpec{} }
+func (m *CatalogEntrySetSpec) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntrySetSpec) ProtoMessage()    {}
+func (*CatalogEntrySetSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{1}
+}
+
+func (m *CatalogEntrySetSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntrySetSpec.Unmarshal(m, b)
+}
+func (m *CatalogEntrySetSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntrySetSpec.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntrySetSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntrySetSpec.Merge(m, src)
+}
+func (m *CatalogEntrySetSpec) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntrySetSpec.Size(m)
+}
+func (m *CatalogEntrySetSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntrySetSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntrySetSpec proto.InternalMessageInfo
+
+func (m *CatalogEntrySetSpec) GetMetadata() *CommonMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetSpec) GetDerive() *DerivedConfig {
+	if m != nil {
+		return m.Derive
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetSpec) GetDiscover() *CustomResourceDiscoverySetConfig {
+	if m != nil {
+		return m.Discover
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetSpec) GetReferencedObjects() []*ReferencedObject {
+	if m != nil {
+		return m.ReferencedObjects
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetSpec) GetMetering() *MeteringConfig {
+	if m != nil {
+		return m.Metering
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetSpec) GetScheduling() *SchedulingConfig {
+	if m != nil {
+		return m.Scheduling
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetSpec) GetMigration() *MigrationConfig {
+	if m != nil {
+		return m.Migration
+	}
+	return nil
+}
+
+type SchedulingConfig struct {
+	// Policy to select a ServiceCluster for new instances, one of LeastLoaded, Spread and Preferred.
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// PreferredServiceClusters is an ordered list of ServiceClusters used by the Preferred policy.
+	PreferredServiceClusters []*ObjectReference `protobuf:"bytes,2,rep,name=preferredServiceClusters,proto3" json:"preferredServiceClusters,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}           `json:"-"`
+	XXX_unrecognized         []byte             `json:"-"`
+	XXX_sizecache            int32              `json:"-"`
+}
+
+func (m *SchedulingConfig) Reset()         { *m = SchedulingConfig{} }
+func (m *SchedulingConfig) String() string { return proto.CompactTextString(m) }
+func (*SchedulingConfig) ProtoMessage()    {}
+func (*SchedulingConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{2}
+}
+
+func (m *SchedulingConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchedulingConfig.Unmarshal(m, b)
+}
+func (m *SchedulingConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchedulingConfig.Marshal(b, m, deterministic)
+}
+func (m *SchedulingConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulingConfig.Merge(m, src)
+}
+func (m *SchedulingConfig) XXX_Size() int {
+	return xxx_messageInfo_SchedulingConfig.Size(m)
+}
+func (m *SchedulingConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulingConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulingConfig proto.InternalMessageInfo
+
+func (m *SchedulingConfig) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *SchedulingConfig) GetPreferredServiceClusters() []*ObjectReference {
+	if m != nil {
+		return m.PreferredServiceClusters
+	}
+	return nil
+}
+
+type CustomResourceDiscoverySetConfig struct {
+	// CRD references a CustomResourceDefinition within the ServiceCluster.
+	Crd *ObjectReference `protobuf:"bytes,1,opt,name=crd,proto3" json:"crd,omitempty"`
+	// ServiceClusterSelector references a set of ServiceClusters to search the CustomResourceDefinition on.
+	ServiceClusterSelector *LabelSelector `protobuf:"bytes,2,opt,name=serviceClusterSelector,proto3" json:"serviceClusterSelector,omitempty"`
+	// WebhookStrategy configures the webhook of the CRD which is registered in the management cluster.
+	WebhookStrategy      string   `protobuf:"bytes,3,opt,name=webhookStrategy,proto3" json:"webhookStrategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CustomResourceDiscoverySetConfig) Reset()         { *m = CustomResourceDiscoverySetConfig{} }
+func (m *CustomResourceDiscoverySetConfig) String() string { return proto.CompactTextString(m) }
+func (*CustomResourceDiscoverySetConfig) ProtoMessage()    {}
+func (*CustomResourceDiscoverySetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{3}
+}
+
+func (m *CustomResourceDiscoverySetConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomResourceDiscoverySetConfig.Unmarshal(m, b)
+}
+func (m *CustomResourceDiscoverySetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomResourceDiscoverySetConfig.Marshal(b, m, deterministic)
+}
+func (m *CustomResourceDiscoverySetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomResourceDiscoverySetConfig.Merge(m, src)
+}
+func (m *CustomResourceDiscoverySetConfig) XXX_Size() int {
+	return xxx_messageInfo_CustomResourceDiscoverySetConfig.Size(m)
+}
+func (m *CustomResourceDiscoverySetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomResourceDiscoverySetConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomResourceDiscoverySetConfig proto.InternalMessageInfo
+
+func (m *CustomResourceDiscoverySetConfig) GetCrd() *ObjectReference {
+	if m != nil {
+		return m.Crd
+	}
+	return nil
+}
+
+func (m *CustomResourceDiscoverySetConfig) GetServiceClusterSelector() *LabelSelector {
+	if m != nil {
+		return m.ServiceClusterSelector
+	}
+	return nil
+}
+
+func (m *CustomResourceDiscoverySetConfig) GetWebhookStrategy() string {
+	if m != nil {
+		return m.WebhookStrategy
+	}
+	return ""
+}
+
+type CatalogEntrySetStatus struct {
+	ObservedGeneration int64        `protobuf:"varint,1,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions         []*Condition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Phase              string       `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	// AggregatedCRD holds the information about the region independent CRD, if scheduling is enabled.
+	AggregatedCRD        *CRDInformation `protobuf:"bytes,4,opt,name=aggregatedCRD,proto3" json:"aggregatedCRD,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CatalogEntrySetStatus) Reset()         { *m = CatalogEntrySetStatus{} }
+func (m *CatalogEntrySetStatus) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntrySetStatus) ProtoMessage()    {}
+func (*CatalogEntrySetStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{4}
+}
+
+func (m *CatalogEntrySetStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntrySetStatus.Unmarshal(m, b)
+}
+func (m *CatalogEntrySetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntrySetStatus.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntrySetStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntrySetStatus.Merge(m, src)
+}
+func (m *CatalogEntrySetStatus) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntrySetStatus.Size(m)
+}
+func (m *CatalogEntrySetStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntrySetStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntrySetStatus proto.InternalMessageInfo
+
+func (m *CatalogEntrySetStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func (m *CatalogEntrySetStatus) GetConditions() []*Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetStatus) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *CatalogEntrySetStatus) GetAggregatedCRD() *CRDInformation {
+	if m != nil {
+		return m.AggregatedCRD
+	}
+	return nil
+}
+
+type CatalogEntrySetList struct {
+	Metadata             *ListMeta          `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items                []*CatalogEntrySet `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CatalogEntrySetList) Reset()         { *m = CatalogEntrySetList{} }
+func (m *CatalogEntrySetList) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntrySetList) ProtoMessage()    {}
+func (*CatalogEntrySetList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{5}
+}
+
+func (m *CatalogEntrySetList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntrySetList.Unmarshal(m, b)
+}
+func (m *CatalogEntrySetList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntrySetList.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntrySetList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntrySetList.Merge(m, src)
+}
+func (m *CatalogEntrySetList) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntrySetList.Size(m)
+}
+func (m *CatalogEntrySetList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntrySetList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntrySetList proto.InternalMessageInfo
+
+func (m *CatalogEntrySetList) GetMetadata() *ListMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetList) GetItems() []*CatalogEntrySet {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CatalogEntrySetCreateRequest struct {
+	Spec *CatalogEntrySet `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogEntrySetCreateRequest) Reset()         { *m = CatalogEntrySetCreateRequest{} }
+func (m *CatalogEntrySetCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntrySetCreateRequest) ProtoMessage()    {}
+func (*CatalogEntrySetCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{6}
+}
+
+func (m *CatalogEntrySetCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntrySetCreateRequest.Unmarshal(m, b)
+}
+func (m *CatalogEntrySetCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntrySetCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntrySetCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntrySetCreateRequest.Merge(m, src)
+}
+func (m *CatalogEntrySetCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntrySetCreateRequest.Size(m)
+}
+func (m *CatalogEntrySetCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntrySetCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntrySetCreateRequest proto.InternalMessageInfo
+
+func (m *CatalogEntrySetCreateRequest) GetSpec() *CatalogEntrySet {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetCreateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type CatalogEntrySetUpdateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Spec - the full catalog entry set, metadata.resourceVersion is required for optimistic locking
+	Spec *CatalogEntrySet `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogEntrySetUpdateRequest) Reset()         { *m = CatalogEntrySetUpdateRequest{} }
+func (m *CatalogEntrySetUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntrySetUpdateRequest) ProtoMessage()    {}
+func (*CatalogEntrySetUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2a2a35711cfc7, []int{7}
+}
+
+func (m *CatalogEntrySetUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntrySetUpdateRequest.Unmarshal(m, b)
+}
+func (m *CatalogEntrySetUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntrySetUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntrySetUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntrySetUpdateRequest.Merge(m, src)
+}
+func (m *CatalogEntrySetUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntrySetUpdateRequest.Size(m)
+}
+func (m *CatalogEntrySetUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntrySetUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntrySetUpdateRequest proto.InternalMessageInfo
+
+func (m *CatalogEntrySetUpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CatalogEntrySetUpdateRequest) GetSpec() *CatalogEntrySet {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CatalogEntrySetUpdateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CatalogEntrySet)(nil), "kubecarrier.api.v1.CatalogEntrySet")
+	proto.RegisterType((*CatalogEntrySetSpec)(nil), "kubecarrier.api.v1.CatalogEntrySetSpec")
+	proto.RegisterType((*SchedulingConfig)(nil), "kubecarrier.api.v1.SchedulingConfig")
+	proto.RegisterType((*CustomResourceDiscoverySetConfig)(nil), "kubecarrier.api.v1.CustomResourceDiscoverySetConfig")
+	proto.RegisterType((*CatalogEntrySetStatus)(nil), "kubecarrier.api.v1.CatalogEntrySetStatus")
+	proto.RegisterType((*CatalogEntrySetList)(nil), "kubecarrier.api.v1.CatalogEntrySetList")
+	proto.RegisterType((*CatalogEntrySetCreateRequest)(nil), "kubecarrier.api.v1.CatalogEntrySetCreateRequest")
+	proto.RegisterType((*CatalogEntrySetUpdateRequest)(nil), "kubecarrier.api.v1.CatalogEntrySetUpdateRequest")
+}
+
+func init() {
+	proto.RegisterFile("catalogentryset.proto", fileDescriptor_f7e2a2a35711cfc7)
+}
+
+var fileDescriptor_f7e2a2a35711cfc7 = []byte{
+	// 931 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x97, 0xb3, 0x9b, 0x6d, 0xf3, 0xa2, 0xaa, 0x30, 0xd0, 0xc8, 0x5a, 0x42, 0x59, 0x0c, 0x52,
+	0x53, 0x24, 0xec, 0x24, 0xd0, 0x42, 0xcb, 0x87, 0x54, 0x76, 0xa3, 0x80, 0xd4, 0x08, 0x34, 0x2b,
+	0x84, 0xe0, 0x82, 0x66, 0xc7, 0x2f, 0x8e, 0xe9, 0xae, 0xc7, 0x9d, 0x19, 0x6f, 0xb5, 0x8a, 0x7a,
+	0x20, 0x12, 0x17, 0x38, 0x22, 0x71, 0xe3, 0x4f, 0xe2, 0xc4, 0x15, 0x71, 0x42, 0xfc, 0x1b, 0x20,
+	0x8f, 0xc7, 0xdb, 0x5d, 0xaf, 0x37, 0xb8, 0xe2, 0xe6, 0xf1, 0x7b, 0xbf, 0xf7, 0xfb, 0xbd, 0x8f,
+	0xf9, 0x80, 0x1b, 0x9c, 0x69, 0x36, 0x16, 0x11, 0x26, 0x5a, 0xce, 0x14, 0x6a, 0x3f, 0x95, 0x42,
+	0x0b, 0x42, 0x1e, 0x65, 0x23, 0xe4, 0x4c, 0xca, 0x18, 0xa5, 0xcf, 0xd2, 0xd8, 0x9f, 0x1e, 0x74,
+	0x77, 0x23, 0x21, 0xa2, 0x31, 0x06, 0x2c, 0x8d, 0x03, 0x96, 0x24, 0x42, 0x33, 0x1d, 0x8b, 0x44,
+	0x15, 0x88, 0xee, 0x2b, 0xd6, 0x6a, 0x56, 0xa3, 0xec, 0x34, 0xc0, 0x49, 0xaa, 0x67, 0xd6, 0xb8,
+	0xad, 0x67, 0x29, 0x96, 0x9e, 0x30, 0x41, 0xcd, 0x4a, 0x03, 0x4e, 0x31, 0xb1, 0xa4, 0xdd, 0x6b,
+	0x12, 0x1f, 0x67, 0xa8, 0xca, 0x25, 0x59, 0x94, 0x56, 0xb2, 0x84, 0x28, 0xe3, 0x29, 0x86, 0x3c,
+	0x53, 0x5a, 0x4c, 0x24, 0x2a, 0x91, 0x49, 0x8e, 0x85, 0xd1, 0xfb, 0xcd, 0x81, 0xeb, 0xfd, 0x02,
+	0x73, 0x94, 0x63, 0x86, 0xa8, 0xc9, 0x7d, 0xb8, 0x9a, 0xd3, 0x85, 0x4c, 0x33, 0xd7, 0xe9, 0x39,
+	0x7b, 0xdb, 0x87, 0x37, 0xfd, 0xd5, 0xdc, 0xfc, 0xcf, 0x47, 0xdf, 0x21, 0xd7, 0x27, 0xa8, 0x19,
+	0x9d, 0xfb, 0x93, 0x0f, 0xa0, 0xad, 0x52, 0xe4, 0xee, 0x86, 0xc1, 0xdd, 0xaa, 0xc3, 0x55, 0xe8,
+	0x86, 0x29, 0x72, 0x6a, 0x40, 0xe4, 0x01, 0x74, 0x94, 0x66, 0x3a, 0x53, 0x6e, 0xcb, 0xc0, 0x6f,
+	0x37, 0x81, 0x1b, 0x00, 0xb5, 0x40, 0xef, 0x9f, 0x16, 0xbc, 0x54, 0x43, 0x40, 0x3e, 0x5e, 0xc9,
+	0xc9, 0xab, 0x0d, 0x2e, 0x26, 0x13, 0x91, 0x9c, 0x58, 0xcf, 0x85, 0xbc, 0xee, 0x41, 0xa7, 0x28,
+	0xa3, 0xcd, 0xec, 0xf5, 0x3a, 0xf4, 0xa0, 0x28, 0x74, 0x5f, 0x24, 0xa7, 0x71, 0x44, 0x2d, 0x80,
+	0x7c, 0x01, 0x57, 0xc3, 0x58, 0x71, 0x31, 0x45, 0x69, 0xf3, 0x7a, 0xb7, 0x96, 0xda, 0xb4, 0x87,
+	0xda, 0xf6, 0x0c, 0x2c, 0x22, 0x4f, 0xc0, 0xc6, 0x9b, 0x47, 0x21, 0x14, 0x5e, 0x94, 0x78, 0x8a,
+	0x12, 0x13, 0x8e, 0x61, 0xd1, 0x06, 0xe5, 0xb6, 0x7b, 0xad, 0xbd, 0xed, 0xc3, 0x37, 0xeb, 0x42,
+	0xd3, 0x8a, 0x33, 0x5d, 0x85, 0xdb, 0x02, 0xa1, 0x8c, 0x93, 0xc8, 0xdd, 0x5c, 0x5f, 0xa0, 0x13,
+	0xeb, 0x53, 0x6a, 0x2a, 0x31, 0x64, 0x00, 0xa0, 0xf8, 0x19, 0x86, 0xd9, 0x38, 0x8f, 0xd0, 0xe9,
+	0x39, 0xeb, 0xc4, 0x0c, 0xe7, 0x5e, 0x36, 0xc6, 0x02, 0x8e, 0x3c, 0x80, 0xad, 0x49, 0x1c, 0x49,
+	0xb3, 0x4b, 0xdc, 0x2b, 0x26, 0xc8, 0x1b, 0xb5, 0x32, 0x4a, 0x27, 0x1b, 0xe3, 0x19, 0xca, 0xfb,
+	0xc9, 0x81, 0x17, 0xaa, 0x1c, 0x64, 0x07, 0x3a, 0xa9, 0x18, 0xc7, 0x7c, 0x66, 0x9a, 0xbf, 0x45,
+	0xed, 0x8a, 0x7c, 0x0b, 0x6e, 0x6a, 0x6a, 0x21, 0x31, 0x1c, 0xa2, 0x9c, 0xc6, 0x1c, 0xfb, 0xe3,
+	0x4c, 0x69, 0x94, 0xca, 0xdd, 0xe8, 0xb5, 0xd6, 0xd1, 0xdb, 0x32, 0x96, 0x45, 0xa4, 0x6b, 0x83,
+	0x78, 0x7f, 0x3a, 0xd0, 0xfb, 0xaf, 0xce, 0x92, 0x3b, 0xd0, 0xe2, 0x32, 0xb4, 0x73, 0xd9, 0x88,
+	0x30, 0xf7, 0x27, 0x5f, 0xc3, 0x8e, 0x5a, 0xa2, 0x1b, 0xe2, 0x18, 0xb9, 0x16, 0xf2, 0xb2, 0x19,
+	0x7d, 0xc8, 0x46, 0x38, 0x2e, 0x1d, 0xe9, 0x9a, 0x00, 0x64, 0x0f, 0xae, 0x3f, 0xc1, 0xd1, 0x99,
+	0x10, 0x8f, 0x86, 0x5a, 0x32, 0x8d, 0xd1, 0xcc, 0x8c, 0xee, 0x16, 0xad, 0xfe, 0xf6, 0xfe, 0x76,
+	0xe0, 0x46, 0xed, 0x96, 0x24, 0x3e, 0x10, 0x31, 0xca, 0xe3, 0x63, 0x78, 0x8c, 0x09, 0xda, 0xa6,
+	0xe6, 0x49, 0xb6, 0x68, 0x8d, 0x85, 0x7c, 0x04, 0xc0, 0x45, 0x12, 0xc6, 0xf9, 0xa2, 0xac, 0xfe,
+	0xab, 0xf5, 0x9b, 0xd4, 0x7a, 0xd1, 0x05, 0x00, 0x79, 0x19, 0x36, 0xd3, 0x33, 0xa6, 0xd0, 0x0a,
+	0x2d, 0x16, 0xe4, 0x53, 0xb8, 0xc6, 0xa2, 0x48, 0x62, 0xc4, 0x34, 0x86, 0x7d, 0x3a, 0x70, 0xdb,
+	0x97, 0x6c, 0x7e, 0x3a, 0xf8, 0x2c, 0x39, 0x15, 0x72, 0x62, 0xf4, 0xd0, 0x65, 0xa0, 0xf7, 0xa3,
+	0xb3, 0x72, 0xb2, 0x3c, 0x8c, 0x95, 0x26, 0xef, 0xaf, 0x9c, 0x2c, 0xbb, 0xb5, 0x75, 0x8f, 0x55,
+	0xf5, 0xac, 0xbc, 0x07, 0x9b, 0xb1, 0xc6, 0xc9, 0xa5, 0x93, 0x56, 0x61, 0xa4, 0x05, 0xc2, 0x7b,
+	0x0c, 0xbb, 0x15, 0x4b, 0x5f, 0x22, 0xd3, 0x48, 0x8b, 0xdb, 0x80, 0xbc, 0x67, 0x8f, 0xe1, 0x4b,
+	0x46, 0xaa, 0x1a, 0xb9, 0x38, 0x82, 0x5d, 0xb8, 0xc2, 0x38, 0x17, 0x59, 0xa2, 0xcd, 0x10, 0x6d,
+	0xd1, 0x72, 0xe9, 0xfd, 0xe0, 0xac, 0x70, 0x7e, 0x99, 0x86, 0x0b, 0x9c, 0x04, 0xda, 0x09, 0x9b,
+	0xa0, 0xdd, 0x61, 0xe6, 0x7b, 0xae, 0x63, 0xe3, 0x7f, 0xe8, 0x68, 0x2d, 0xe9, 0x38, 0xfc, 0xa3,
+	0x03, 0x3b, 0xd5, 0x81, 0x2b, 0x86, 0x98, 0x7c, 0xef, 0x40, 0xdb, 0xf4, 0xe4, 0xb5, 0x75, 0x1d,
+	0xb0, 0x5a, 0xbb, 0x4d, 0x2e, 0xa6, 0xdc, 0xdf, 0x0b, 0x2e, 0x7e, 0xff, 0xeb, 0xe7, 0x8d, 0xdb,
+	0xe4, 0x56, 0x30, 0x3d, 0x08, 0xac, 0x04, 0x15, 0x9c, 0xdb, 0xaf, 0xa7, 0x41, 0xe5, 0x29, 0xa0,
+	0xc8, 0x85, 0x03, 0xad, 0x63, 0xd4, 0xa4, 0xf6, 0xca, 0x3c, 0xc6, 0xb9, 0x82, 0x26, 0xb5, 0xf0,
+	0xee, 0x1a, 0xf6, 0x7d, 0xe2, 0x37, 0x64, 0x0f, 0xce, 0xf3, 0xaa, 0x3f, 0x25, 0xbf, 0x38, 0xd0,
+	0x29, 0x06, 0x82, 0xec, 0x37, 0xe0, 0x59, 0x9a, 0x9d, 0x66, 0xca, 0xee, 0x18, 0x65, 0x81, 0xd7,
+	0xb4, 0x2e, 0xf7, 0x8b, 0xb6, 0xfe, 0xea, 0x40, 0xa7, 0x98, 0x9a, 0x46, 0xc2, 0x96, 0x06, 0xac,
+	0x99, 0xb0, 0x0f, 0x8d, 0xb0, 0xbb, 0xdd, 0xe7, 0x2c, 0x99, 0xd5, 0x77, 0x0e, 0x9d, 0x01, 0x8e,
+	0x51, 0x23, 0x59, 0x73, 0xc1, 0x8f, 0xf1, 0x99, 0x9e, 0x1d, 0xbf, 0x78, 0xbf, 0xf9, 0xe5, 0xfb,
+	0xcd, 0x3f, 0xca, 0xdf, 0x6f, 0x65, 0xd7, 0xde, 0x7a, 0xde, 0xae, 0x5d, 0x38, 0xb0, 0xf9, 0x15,
+	0xd3, 0xfc, 0x8c, 0xf4, 0xea, 0xc8, 0x8d, 0xa9, 0xe4, 0xbe, 0xb9, 0xd6, 0xe3, 0x28, 0x7f, 0x1d,
+	0x96, 0xfd, 0x21, 0x6f, 0xe7, 0x1a, 0x9e, 0xe4, 0xff, 0x9b, 0x28, 0xd9, 0x77, 0x3e, 0x69, 0x7f,
+	0xb3, 0x31, 0x3d, 0x18, 0x75, 0x4c, 0x4a, 0xef, 0xfc, 0x3b, 0x00, 0x28, 0x2f, 0xbb, 0xa8, 0xeb,
+	0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CatalogEntrySetServiceClient is the client API for CatalogEntrySetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CatalogEntrySetServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CatalogEntrySetList, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*CatalogEntrySet, error)
+	Create(ctx context.Context, in *CatalogEntrySetCreateRequest, opts ...grpc.CallOption) (*CatalogEntrySet, error)
+	Update(ctx context.Context, in *CatalogEntrySetUpdateRequest, opts ...grpc.CallOption) (*CatalogEntrySet, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CatalogEntrySetService_WatchClient, error)
+}
+
+type catalogEntrySetServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogEntrySetServiceClient(cc grpc.ClientConnInterface) CatalogEntrySetServiceClient {
+	return &catalogEntrySetServiceClient{cc}
+}
+
+func (c *catalogEntrySetServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CatalogEntrySetList, error) {
+	out := new(CatalogEntrySetList)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntrySetService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntrySetServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*CatalogEntrySet, error) {
+	out := new(CatalogEntrySet)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntrySetService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntrySetServiceClient) Create(ctx context.Context, in *CatalogEntrySetCreateRequest, opts ...grpc.CallOption) (*CatalogEntrySet, error) {
+	out := new(CatalogEntrySet)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntrySetService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntrySetServiceClient) Update(ctx context.Context, in *CatalogEntrySetUpdateRequest, opts ...grpc.CallOption) (*CatalogEntrySet, error) {
+	out := new(CatalogEntrySet)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntrySetService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntrySetServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.CatalogEntrySetService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogEntrySetServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CatalogEntrySetService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CatalogEntrySetService_serviceDesc.Streams[0], "/kubecarrier.api.v1.CatalogEntrySetService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &catalogEntrySetServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CatalogEntrySetService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type catalogEntrySetServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *catalogEntrySetServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CatalogEntrySetServiceServer is the server API for CatalogEntrySetService service.
+type CatalogEntrySetServiceServer interface {
+	List(context.Context, *ListRequest) (*CatalogEntrySetList, error)
+	Get(context.Context, *GetRequest) (*CatalogEntrySet, error)
+	Create(context.Context, *CatalogEntrySetCreateRequest) (*CatalogEntrySet, error)
+	Update(context.Context, *CatalogEntrySetUpdateRequest) (*CatalogEntrySet, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	Watch(*WatchRequest, CatalogEntrySetService_WatchServer) error
+}
+
+// UnimplementedCatalogEntrySetServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCatalogEntrySetServiceServer struct {
+}
+
+func (*UnimplementedCatalogEntrySetServiceServer) List(ctx context.Context, req *ListRequest) (*CatalogEntrySetList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedCatalogEntrySetServiceServer) Get(ctx context.Context, req *GetRequest) (*CatalogEntrySet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedCatalogEntrySetServiceServer) Create(ctx context.Context, req *CatalogEntrySetCreateRequest) (*CatalogEntrySet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedCatalogEntrySetServiceServer) Update(ctx context.Context, req *CatalogEntrySetUpdateRequest) (*CatalogEntrySet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedCatalogEntrySetServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedCatalogEntrySetServiceServer) Watch(req *WatchRequest, srv CatalogEntrySetService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterCatalogEntrySetServiceServer(s *grpc.Server, srv CatalogEntrySetServiceServer) {
+	s.RegisterService(&_CatalogEntrySetService_serviceDesc, srv)
+}
+
+func _CatalogEntrySetService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntrySetServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntrySetService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntrySetServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntrySetService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntrySetServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntrySetService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntrySetServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntrySetService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntrySetCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntrySetServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntrySetService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntrySetServiceServer).Create(ctx, req.(*CatalogEntrySetCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntrySetService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntrySetUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntrySetServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntrySetService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntrySetServiceServer).Update(ctx, req.(*CatalogEntrySetUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntrySetService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogEntrySetServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.CatalogEntrySetService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogEntrySetServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogEntrySetService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CatalogEntrySetServiceServer).Watch(m, &catalogEntrySetServiceWatchServer{stream})
+}
+
+type CatalogEntrySetService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type catalogEntrySetServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *catalogEntrySetServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _CatalogEntrySetService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubecarrier.api.v1.CatalogEntrySetService",
+	HandlerType: (*CatalogEntrySetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _CatalogEntrySetService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CatalogEntrySetService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _CatalogEntrySetService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CatalogEntrySetService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CatalogEntrySetService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _CatalogEntrySetService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "catalogentryset.proto",
+}
@@ -0,0 +1,1028 @@
+/*
+Copyright 2021 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: derivedcustomresource.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type DerivedCustomResource struct {
+	Metadata             *ObjectMeta                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec                 *DerivedCustomResourceSpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status               *DerivedCustomResourceStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *DerivedCustomResource) Reset()         { *m = DerivedCustomResource{} }
+func (m *DerivedCustomResource) String() string { return proto.CompactTextString(m) }
+func (*DerivedCustomResource) ProtoMessage()    {}
+func (*DerivedCustomResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{0}
+}
+
+func (m *DerivedCustomResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedCustomResource.Unmarshal(m, b)
+}
+func (m *DerivedCustomResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedCustomResource.Marshal(b, m, deterministic)
+}
+func (m *DerivedCustomResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedCustomResource.Merge(m, src)
+}
+func (m *DerivedCustomResource) XXX_Size() int {
+	return xxx_messageInfo_DerivedCustomResource.Size(m)
+}
+func (m *DerivedCustomResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedCustomResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedCustomResource proto.InternalMessageInfo
+
+func (m *DerivedCustomResource) GetMetadata() *ObjectMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DerivedCustomResource) GetSpec() *DerivedCustomResourceSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *DerivedCustomResource) GetStatus() *DerivedCustomResourceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type DerivedCustomResourceSpec struct {
+	// BaseCRD references the CustomResourceDefinition to derive from.
+	BaseCRD *ObjectReference `protobuf:"bytes,1,opt,name=baseCRD,proto3" json:"baseCRD,omitempty"`
+	// Expose lists the fields exposed to the tenant, per CRD version.
+	Expose []*VersionExposeConfig `protobuf:"bytes,2,rep,name=expose,proto3" json:"expose,omitempty"`
+	// ReferencedObjects lists Secrets and ConfigMaps that are propagated to the tenant.
+	ReferencedObjects []*ReferencedObject `protobuf:"bytes,3,rep,name=referencedObjects,proto3" json:"referencedObjects,omitempty"`
+	// ObjectReferences lists fields of instances, that reference instances of another CatalogEntry.
+	ObjectReferences     []*ObjectReferenceField `protobuf:"bytes,4,rep,name=objectReferences,proto3" json:"objectReferences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DerivedCustomResourceSpec) Reset()         { *m = DerivedCustomResourceSpec{} }
+func (m *DerivedCustomResourceSpec) String() string { return proto.CompactTextString(m) }
+func (*DerivedCustomResourceSpec) ProtoMessage()    {}
+func (*DerivedCustomResourceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{1}
+}
+
+func (m *DerivedCustomResourceSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedCustomResourceSpec.Unmarshal(m, b)
+}
+func (m *DerivedCustomResourceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedCustomResourceSpec.Marshal(b, m, deterministic)
+}
+func (m *DerivedCustomResourceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedCustomResourceSpec.Merge(m, src)
+}
+func (m *DerivedCustomResourceSpec) XXX_Size() int {
+	return xxx_messageInfo_DerivedCustomResourceSpec.Size(m)
+}
+func (m *DerivedCustomResourceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedCustomResourceSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedCustomResourceSpec proto.InternalMessageInfo
+
+func (m *DerivedCustomResourceSpec) GetBaseCRD() *ObjectReference {
+	if m != nil {
+		return m.BaseCRD
+	}
+	return nil
+}
+
+func (m *DerivedCustomResourceSpec) GetExpose() []*VersionExposeConfig {
+	if m != nil {
+		return m.Expose
+	}
+	return nil
+}
+
+func (m *DerivedCustomResourceSpec) GetReferencedObjects() []*ReferencedObject {
+	if m != nil {
+		return m.ReferencedObjects
+	}
+	return nil
+}
+
+func (m *DerivedCustomResourceSpec) GetObjectReferences() []*ObjectReferenceField {
+	if m != nil {
+		return m.ObjectReferences
+	}
+	return nil
+}
+
+type VersionExposeConfig struct {
+	Versions []string     `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Fields   []*FieldPath `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Defaults are applied to the provider object, when the field is not set.
+	Defaults             []*FieldDefault `protobuf:"bytes,3,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *VersionExposeConfig) Reset()         { *m = VersionExposeConfig{} }
+func (m *VersionExposeConfig) String() string { return proto.CompactTextString(m) }
+func (*VersionExposeConfig) ProtoMessage()    {}
+func (*VersionExposeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{2}
+}
+
+func (m *VersionExposeConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionExposeConfig.Unmarshal(m, b)
+}
+func (m *VersionExposeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionExposeConfig.Marshal(b, m, deterministic)
+}
+func (m *VersionExposeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionExposeConfig.Merge(m, src)
+}
+func (m *VersionExposeConfig) XXX_Size() int {
+	return xxx_messageInfo_VersionExposeConfig.Size(m)
+}
+func (m *VersionExposeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionExposeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionExposeConfig proto.InternalMessageInfo
+
+func (m *VersionExposeConfig) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *VersionExposeConfig) GetFields() []*FieldPath {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *VersionExposeConfig) GetDefaults() []*FieldDefault {
+	if m != nil {
+		return m.Defaults
+	}
+	return nil
+}
+
+type FieldPath struct {
+	// JSONPath of the field, e.g. .spec.version
+	JsonPath string `protobuf:"bytes,1,opt,name=jsonPath,proto3" json:"jsonPath,omitempty"`
+	// TenantPath is the JSONPath of the field in the tenant object, defaults to jsonPath.
+	TenantPath           string   `protobuf:"bytes,2,opt,name=tenantPath,proto3" json:"tenantPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldPath) Reset()         { *m = FieldPath{} }
+func (m *FieldPath) String() string { return proto.CompactTextString(m) }
+func (*FieldPath) ProtoMessage()    {}
+func (*FieldPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{3}
+}
+
+func (m *FieldPath) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldPath.Unmarshal(m, b)
+}
+func (m *FieldPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldPath.Marshal(b, m, deterministic)
+}
+func (m *FieldPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldPath.Merge(m, src)
+}
+func (m *FieldPath) XXX_Size() int {
+	return xxx_messageInfo_FieldPath.Size(m)
+}
+func (m *FieldPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldPath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldPath proto.InternalMessageInfo
+
+func (m *FieldPath) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
+func (m *FieldPath) GetTenantPath() string {
+	if m != nil {
+		return m.TenantPath
+	}
+	return ""
+}
+
+type FieldDefault struct {
+	// JSONPath of the field in the provider object, e.g. .spec.replicas
+	JsonPath string `protobuf:"bytes,1,opt,name=jsonPath,proto3" json:"jsonPath,omitempty"`
+	// Value is the JSON encoded default value, e.g. 3 or "small".
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldDefault) Reset()         { *m = FieldDefault{} }
+func (m *FieldDefault) String() string { return proto.CompactTextString(m) }
+func (*FieldDefault) ProtoMessage()    {}
+func (*FieldDefault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{4}
+}
+
+func (m *FieldDefault) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldDefault.Unmarshal(m, b)
+}
+func (m *FieldDefault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldDefault.Marshal(b, m, deterministic)
+}
+func (m *FieldDefault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldDefault.Merge(m, src)
+}
+func (m *FieldDefault) XXX_Size() int {
+	return xxx_messageInfo_FieldDefault.Size(m)
+}
+func (m *FieldDefault) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldDefault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldDefault proto.InternalMessageInfo
+
+func (m *FieldDefault) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
+func (m *FieldDefault) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type ReferencedObject struct {
+	// Kind of the referenced object, one of Secret, ConfigMap.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Name of the referenced object in the namespace of the instance.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// JSONPath of the field containing the name of the referenced object, e.g. .status.connection.secretName
+	JsonPath             string   `protobuf:"bytes,3,opt,name=jsonPath,proto3" json:"jsonPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReferencedObject) Reset()         { *m = ReferencedObject{} }
+func (m *ReferencedObject) String() string { return proto.CompactTextString(m) }
+func (*ReferencedObject) ProtoMessage()    {}
+func (*ReferencedObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{5}
+}
+
+func (m *ReferencedObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferencedObject.Unmarshal(m, b)
+}
+func (m *ReferencedObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferencedObject.Marshal(b, m, deterministic)
+}
+func (m *ReferencedObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferencedObject.Merge(m, src)
+}
+func (m *ReferencedObject) XXX_Size() int {
+	return xxx_messageInfo_ReferencedObject.Size(m)
+}
+func (m *ReferencedObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferencedObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferencedObject proto.InternalMessageInfo
+
+func (m *ReferencedObject) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ReferencedObject) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReferencedObject) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
+type ObjectReferenceField struct {
+	// CatalogEntry of the referenced instance.
+	CatalogEntry *ObjectReference `protobuf:"bytes,1,opt,name=catalogEntry,proto3" json:"catalogEntry,omitempty"`
+	// JSONPath of the field containing the name of the referenced instance, e.g. .spec.dbName
+	JsonPath string `protobuf:"bytes,2,opt,name=jsonPath,proto3" json:"jsonPath,omitempty"`
+	// NamespaceJSONPath of the field containing the namespace of the referenced instance.
+	NamespaceJSONPath string `protobuf:"bytes,3,opt,name=namespaceJSONPath,proto3" json:"namespaceJSONPath,omitempty"`
+	// APIGroupJSONPath of the field containing the API group or apiVersion of the referenced instance.
+	ApiGroupJSONPath     string   `protobuf:"bytes,4,opt,name=apiGroupJSONPath,proto3" json:"apiGroupJSONPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectReferenceField) Reset()         { *m = ObjectReferenceField{} }
+func (m *ObjectReferenceField) String() string { return proto.CompactTextString(m) }
+func (*ObjectReferenceField) ProtoMessage()    {}
+func (*ObjectReferenceField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{6}
+}
+
+func (m *ObjectReferenceField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectReferenceField.Unmarshal(m, b)
+}
+func (m *ObjectReferenceField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectReferenceField.Marshal(b, m, deterministic)
+}
+func (m *ObjectReferenceField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectReferenceField.Merge(m, src)
+}
+func (m *ObjectReferenceField) XXX_Size() int {
+	return xxx_messageInfo_ObjectReferenceField.Size(m)
+}
+func (m *ObjectReferenceField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectReferenceField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectReferenceField proto.InternalMessageInfo
+
+func (m *ObjectReferenceField) GetCatalogEntry() *ObjectReference {
+	if m != nil {
+		return m.CatalogEntry
+	}
+	return nil
+}
+
+func (m *ObjectReferenceField) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
+func (m *ObjectReferenceField) GetNamespaceJSONPath() string {
+	if m != nil {
+		return m.NamespaceJSONPath
+	}
+	return ""
+}
+
+func (m *ObjectReferenceField) GetApiGroupJSONPath() string {
+	if m != nil {
+		return m.ApiGroupJSONPath
+	}
+	return ""
+}
+
+type DerivedCustomResourceStatus struct {
+	ObservedGeneration   int64            `protobuf:"varint,1,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions           []*Condition     `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Phase                string           `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	DerivedCR            *ObjectReference `protobuf:"bytes,4,opt,name=derivedCR,proto3" json:"derivedCR,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DerivedCustomResourceStatus) Reset()         { *m = DerivedCustomResourceStatus{} }
+func (m *DerivedCustomResourceStatus) String() string { return proto.CompactTextString(m) }
+func (*DerivedCustomResourceStatus) ProtoMessage()    {}
+func (*DerivedCustomResourceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{7}
+}
+
+func (m *DerivedCustomResourceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedCustomResourceStatus.Unmarshal(m, b)
+}
+func (m *DerivedCustomResourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedCustomResourceStatus.Marshal(b, m, deterministic)
+}
+func (m *DerivedCustomResourceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedCustomResourceStatus.Merge(m, src)
+}
+func (m *DerivedCustomResourceStatus) XXX_Size() int {
+	return xxx_messageInfo_DerivedCustomResourceStatus.Size(m)
+}
+func (m *DerivedCustomResourceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedCustomResourceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedCustomResourceStatus proto.InternalMessageInfo
+
+func (m *DerivedCustomResourceStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func (m *DerivedCustomResourceStatus) GetConditions() []*Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *DerivedCustomResourceStatus) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *DerivedCustomResourceStatus) GetDerivedCR() *ObjectReference {
+	if m != nil {
+		return m.DerivedCR
+	}
+	return nil
+}
+
+type DerivedCustomResourceList struct {
+	Metadata             *ListMeta                `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items                []*DerivedCustomResource `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DerivedCustomResourceList) Reset()         { *m = DerivedCustomResourceList{} }
+func (m *DerivedCustomResourceList) String() string { return proto.CompactTextString(m) }
+func (*DerivedCustomResourceList) ProtoMessage()    {}
+func (*DerivedCustomResourceList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{8}
+}
+
+func (m *DerivedCustomResourceList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedCustomResourceList.Unmarshal(m, b)
+}
+func (m *DerivedCustomResourceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedCustomResourceList.Marshal(b, m, deterministic)
+}
+func (m *DerivedCustomResourceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedCustomResourceList.Merge(m, src)
+}
+func (m *DerivedCustomResourceList) XXX_Size() int {
+	return xxx_messageInfo_DerivedCustomResourceList.Size(m)
+}
+func (m *DerivedCustomResourceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedCustomResourceList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedCustomResourceList proto.InternalMessageInfo
+
+func (m *DerivedCustomResourceList) GetMetadata() *ListMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DerivedCustomResourceList) GetItems() []*DerivedCustomResource {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type DerivedCustomResourceCreateRequest struct {
+	Spec *DerivedCustomResource `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DerivedCustomResourceCreateRequest) Reset()         { *m = DerivedCustomResourceCreateRequest{} }
+func (m *DerivedCustomResourceCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*DerivedCustomResourceCreateRequest) ProtoMessage()    {}
+func (*DerivedCustomResourceCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{9}
+}
+
+func (m *DerivedCustomResourceCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedCustomResourceCreateRequest.Unmarshal(m, b)
+}
+func (m *DerivedCustomResourceCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedCustomResourceCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *DerivedCustomResourceCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedCustomResourceCreateRequest.Merge(m, src)
+}
+func (m *DerivedCustomResourceCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_DerivedCustomResourceCreateRequest.Size(m)
+}
+func (m *DerivedCustomResourceCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedCustomResourceCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedCustomResourceCreateRequest proto.InternalMessageInfo
+
+func (m *DerivedCustomResourceCreateRequest) GetSpec() *DerivedCustomResource {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *DerivedCustomResourceCreateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type DerivedCustomResourceUpdateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Spec - the full DerivedCustomResource, metadata.resourceVersion is required for optimistic locking
+	Spec *DerivedCustomResource `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DerivedCustomResourceUpdateRequest) Reset()         { *m = DerivedCustomResourceUpdateRequest{} }
+func (m *DerivedCustomResourceUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*DerivedCustomResourceUpdateRequest) ProtoMessage()    {}
+func (*DerivedCustomResourceUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6216539c30ef0aff, []int{10}
+}
+
+func (m *DerivedCustomResourceUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedCustomResourceUpdateRequest.Unmarshal(m, b)
+}
+func (m *DerivedCustomResourceUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedCustomResourceUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *DerivedCustomResourceUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedCustomResourceUpdateRequest.Merge(m, src)
+}
+func (m *DerivedCustomResourceUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_DerivedCustomResourceUpdateRequest.Size(m)
+}
+func (m *DerivedCustomResourceUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedCustomResourceUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedCustomResourceUpdateRequest proto.InternalMessageInfo
+
+func (m *DerivedCustomResourceUpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DerivedCustomResourceUpdateRequest) GetSpec() *DerivedCustomResource {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *DerivedCustomResourceUpdateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DerivedCustomResource)(nil), "kubecarrier.api.v1.DerivedCustomResource")
+	proto.RegisterType((*DerivedCustomResourceSpec)(nil), "kubecarrier.api.v1.DerivedCustomResourceSpec")
+	proto.RegisterType((*VersionExposeConfig)(nil), "kubecarrier.api.v1.VersionExposeConfig")
+	proto.RegisterType((*FieldPath)(nil), "kubecarrier.api.v1.FieldPath")
+	proto.RegisterType((*FieldDefault)(nil), "kubecarrier.api.v1.FieldDefault")
+	proto.RegisterType((*ReferencedObject)(nil), "kubecarrier.api.v1.ReferencedObject")
+	proto.RegisterType((*ObjectReferenceField)(nil), "kubecarrier.api.v1.ObjectReferenceField")
+	proto.RegisterType((*DerivedCustomResourceStatus)(nil), "kubecarrier.api.v1.DerivedCustomResourceStatus")
+	proto.RegisterType((*DerivedCustomResourceList)(nil), "kubecarrier.api.v1.DerivedCustomResourceList")
+	proto.RegisterType((*DerivedCustomResourceCreateRequest)(nil), "kubecarrier.api.v1.DerivedCustomResourceCreateRequest")
+	proto.RegisterType((*DerivedCustomResourceUpdateRequest)(nil), "kubecarrier.api.v1.DerivedCustomResourceUpdateRequest")
+}
+
+func init() {
+	proto.RegisterFile("derivedcustomresource.proto", fileDescriptor_6216539c30ef0aff)
+}
+
+var fileDescriptor_6216539c30ef0aff = []byte{
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x51, 0x6f, 0x1b, 0x45,
+	0x10, 0xd6, 0xd9, 0x8e, 0x93, 0x4c, 0x8a, 0x94, 0x2e, 0x05, 0x19, 0x27, 0x84, 0x70, 0x20, 0x11,
+	0x2a, 0x7a, 0x47, 0x52, 0x52, 0xa1, 0x92, 0xa8, 0xb4, 0x4e, 0xb0, 0x84, 0x80, 0xa2, 0x2d, 0x14,
+	0x89, 0xb7, 0xf5, 0xdd, 0x38, 0xb9, 0xd6, 0xde, 0x3d, 0x76, 0xf7, 0x0e, 0xa2, 0x2a, 0x0f, 0xf0,
+	0xc4, 0x0b, 0x48, 0x08, 0x89, 0x9f, 0x80, 0x90, 0x78, 0xe0, 0x57, 0xf0, 0x0b, 0x10, 0xcf, 0xbc,
+	0xc0, 0xff, 0x40, 0xb7, 0xb7, 0xe7, 0xfa, 0xec, 0x73, 0x64, 0xa7, 0x6f, 0x3b, 0x3b, 0xf3, 0xcd,
+	0x7c, 0xb3, 0x33, 0x3b, 0xbb, 0xb0, 0x11, 0xa2, 0x8c, 0x52, 0x0c, 0x83, 0x44, 0x69, 0x31, 0x94,
+	0xa8, 0x44, 0x22, 0x03, 0xf4, 0x62, 0x29, 0xb4, 0x20, 0xe4, 0x71, 0xd2, 0xc3, 0x80, 0x49, 0x19,
+	0xa1, 0xf4, 0x58, 0x1c, 0x79, 0xe9, 0x6e, 0x7b, 0xf3, 0x44, 0x88, 0x93, 0x01, 0xfa, 0x2c, 0x8e,
+	0x7c, 0xc6, 0xb9, 0xd0, 0x4c, 0x47, 0x82, 0xab, 0x1c, 0xd1, 0xde, 0xb0, 0x5a, 0x23, 0xf5, 0x92,
+	0xbe, 0x8f, 0xc3, 0x58, 0x9f, 0x59, 0xe5, 0x9a, 0x3e, 0x8b, 0xb1, 0xb0, 0x84, 0x21, 0x6a, 0x56,
+	0x28, 0x30, 0x45, 0xae, 0xad, 0xf0, 0x9c, 0xc4, 0xaf, 0x12, 0x54, 0x56, 0x74, 0xff, 0x71, 0xe0,
+	0x85, 0xa3, 0x9c, 0x63, 0xc7, 0x70, 0xa4, 0x96, 0x23, 0xb9, 0x0d, 0x2b, 0x99, 0x8f, 0x90, 0x69,
+	0xd6, 0x72, 0xb6, 0x9d, 0x9d, 0xb5, 0xbd, 0x2d, 0x6f, 0x9a, 0xb0, 0x77, 0xbf, 0xf7, 0x08, 0x03,
+	0xfd, 0x31, 0x6a, 0x46, 0x47, 0xf6, 0xe4, 0x2e, 0x34, 0x54, 0x8c, 0x41, 0xab, 0x66, 0x70, 0x37,
+	0xaa, 0x70, 0x95, 0x41, 0x1f, 0xc4, 0x18, 0x50, 0x03, 0x25, 0x5d, 0x68, 0x2a, 0xcd, 0x74, 0xa2,
+	0x5a, 0x75, 0xe3, 0xc4, 0x9f, 0xdf, 0x89, 0x81, 0x51, 0x0b, 0x77, 0xff, 0xac, 0xc1, 0x4b, 0x33,
+	0x83, 0x91, 0x43, 0x58, 0xee, 0x31, 0x85, 0x1d, 0x7a, 0x64, 0x93, 0x7c, 0x6d, 0x76, 0x92, 0x14,
+	0xfb, 0x28, 0x91, 0x07, 0x48, 0x0b, 0x0c, 0xb9, 0x03, 0x4d, 0xfc, 0x26, 0x16, 0x0a, 0x5b, 0xb5,
+	0xed, 0xfa, 0xce, 0xda, 0xde, 0x1b, 0x55, 0xe8, 0x87, 0x28, 0x55, 0x24, 0xf8, 0xb1, 0x31, 0xec,
+	0x08, 0xde, 0x8f, 0x4e, 0xa8, 0x85, 0x11, 0x0a, 0x57, 0x65, 0xe1, 0x36, 0xcc, 0xc3, 0x64, 0x19,
+	0x67, 0xbe, 0x5e, 0xaf, 0xf2, 0x45, 0x27, 0x8c, 0xe9, 0x34, 0x9c, 0x7c, 0x06, 0xeb, 0xa2, 0x4c,
+	0x58, 0xb5, 0x1a, 0xc6, 0xe5, 0xce, 0x1c, 0xc9, 0x7d, 0x10, 0xe1, 0x20, 0xa4, 0x53, 0x1e, 0xdc,
+	0x5f, 0x1d, 0x78, 0xbe, 0x22, 0x13, 0xd2, 0x86, 0x95, 0x34, 0xdf, 0x56, 0x2d, 0x67, 0xbb, 0xbe,
+	0xb3, 0x4a, 0x47, 0x32, 0xd9, 0x87, 0x66, 0x3f, 0x73, 0xa7, 0xec, 0xf1, 0xbc, 0x5c, 0x15, 0xdf,
+	0x04, 0xfc, 0x94, 0xe9, 0x53, 0x6a, 0x8d, 0xc9, 0x01, 0xac, 0x84, 0xd8, 0x67, 0xc9, 0x60, 0x74,
+	0x16, 0xdb, 0x33, 0x81, 0x47, 0xb9, 0x21, 0x1d, 0x21, 0xdc, 0x2e, 0xac, 0x8e, 0x5c, 0x66, 0xec,
+	0x1e, 0x29, 0xc1, 0xb3, 0xb5, 0x29, 0xf0, 0x2a, 0x1d, 0xc9, 0x64, 0x0b, 0x40, 0x23, 0x67, 0x5c,
+	0x1b, 0x6d, 0xcd, 0x68, 0xc7, 0x76, 0xdc, 0xf7, 0xe1, 0xca, 0x78, 0x88, 0x0b, 0x7d, 0x5d, 0x83,
+	0xa5, 0x94, 0x0d, 0x12, 0xb4, 0x6e, 0x72, 0xc1, 0x7d, 0x08, 0xeb, 0x93, 0x05, 0x23, 0x04, 0x1a,
+	0x8f, 0x23, 0x1e, 0x5a, 0x0f, 0x66, 0x9d, 0xed, 0x71, 0x36, 0x2c, 0xc0, 0x66, 0x5d, 0x8a, 0x56,
+	0x2f, 0x47, 0x73, 0xff, 0x76, 0xe0, 0x5a, 0x55, 0xd9, 0x48, 0x17, 0xae, 0x04, 0x4c, 0xb3, 0x81,
+	0x38, 0x39, 0xe6, 0x5a, 0x9e, 0x2d, 0xd2, 0xd3, 0x25, 0x60, 0x29, 0x7a, 0x6d, 0x22, 0xd7, 0xb7,
+	0xe0, 0x6a, 0xc6, 0x50, 0xc5, 0x2c, 0xc0, 0x0f, 0x1f, 0xdc, 0xff, 0x64, 0x8c, 0xe2, 0xb4, 0x82,
+	0x5c, 0x87, 0x75, 0x16, 0x47, 0x5d, 0x29, 0x92, 0x78, 0x64, 0xdc, 0x30, 0xc6, 0x53, 0xfb, 0xee,
+	0x7f, 0x0e, 0x6c, 0x5c, 0x70, 0xa7, 0x89, 0x07, 0x44, 0xf4, 0x14, 0xca, 0x14, 0xc3, 0x2e, 0x72,
+	0x94, 0x66, 0x38, 0x9a, 0x24, 0xeb, 0xb4, 0x42, 0x43, 0x0e, 0x01, 0x02, 0xc1, 0xc3, 0x48, 0x9b,
+	0xee, 0xbc, 0xa0, 0x07, 0x3b, 0x85, 0x15, 0x1d, 0x03, 0x64, 0x45, 0x8d, 0x4f, 0x99, 0x42, 0x9b,
+	0x5c, 0x2e, 0x90, 0xbb, 0xb0, 0x6a, 0xa7, 0x7a, 0x87, 0xb6, 0x1a, 0xf3, 0x1f, 0xf0, 0x53, 0x94,
+	0xfb, 0x8b, 0x33, 0x63, 0x26, 0x7d, 0x14, 0x29, 0x4d, 0xde, 0x9d, 0x9a, 0xbc, 0x9b, 0x55, 0xfe,
+	0x33, 0xdb, 0x89, 0xb9, 0x7b, 0x07, 0x96, 0x22, 0x8d, 0xc3, 0x22, 0xd5, 0x37, 0xe7, 0x9e, 0x99,
+	0x34, 0xc7, 0xb9, 0xe7, 0xe0, 0x56, 0xea, 0x3b, 0x12, 0x99, 0x46, 0x9a, 0x3f, 0x1d, 0xe4, 0xd0,
+	0x8e, 0xf7, 0x9c, 0xdc, 0x02, 0x51, 0xf2, 0xd1, 0xde, 0x82, 0x65, 0x16, 0x04, 0x22, 0xe1, 0xda,
+	0xb6, 0x56, 0x21, 0xba, 0x3f, 0x39, 0x33, 0xe2, 0x7f, 0x1e, 0x87, 0x63, 0xf1, 0x8b, 0xeb, 0xe2,
+	0x8c, 0x5d, 0x97, 0xc3, 0xd2, 0x93, 0xf3, 0x2c, 0x9c, 0xea, 0x25, 0x4e, 0x7b, 0xbf, 0x2f, 0xc3,
+	0x66, 0x75, 0x4f, 0xa2, 0x4c, 0xa3, 0x00, 0xc9, 0x0f, 0x0e, 0x34, 0x4c, 0xdd, 0x5e, 0x99, 0x55,
+	0x25, 0xcb, 0xbb, 0x3d, 0xff, 0x43, 0x98, 0xa1, 0xdc, 0xfd, 0xef, 0xfe, 0xfa, 0xf7, 0xe7, 0x9a,
+	0x4f, 0x6e, 0xf8, 0xe9, 0xae, 0x6f, 0xe9, 0x28, 0xff, 0x89, 0x5d, 0x9d, 0xfb, 0x95, 0x7f, 0x0b,
+	0x45, 0x7e, 0x74, 0xa0, 0xde, 0x45, 0x4d, 0x2a, 0x9f, 0xeb, 0x2e, 0x8e, 0xd8, 0xcc, 0x7f, 0x46,
+	0xee, 0x81, 0x61, 0x72, 0x8b, 0xbc, 0xb3, 0x10, 0x13, 0xff, 0x49, 0x56, 0x99, 0x73, 0xf2, 0x9b,
+	0x03, 0xcd, 0xbc, 0x81, 0xc8, 0xad, 0xb9, 0x63, 0x96, 0x3a, 0x6e, 0x11, 0xae, 0xef, 0x19, 0xae,
+	0xfb, 0xee, 0x62, 0xa7, 0x76, 0x3b, 0x6f, 0x83, 0x3f, 0x1c, 0x68, 0xe6, 0xbd, 0xb6, 0x00, 0xd5,
+	0x52, 0x73, 0x2e, 0x42, 0xf5, 0x9e, 0xa1, 0x7a, 0xd0, 0xbe, 0xd4, 0xb1, 0x5a, 0xc6, 0xdf, 0x3a,
+	0xd0, 0x3c, 0xc2, 0x01, 0x6a, 0x24, 0xaf, 0x56, 0x47, 0x1e, 0xe0, 0x53, 0x72, 0x2f, 0x7a, 0xf9,
+	0x0f, 0xd2, 0x2b, 0x7e, 0x90, 0xde, 0x71, 0xf6, 0x83, 0x2c, 0x0a, 0x7c, 0xfd, 0x72, 0x05, 0xfe,
+	0xde, 0x81, 0xa5, 0x2f, 0x98, 0x0e, 0x4e, 0x49, 0xe5, 0x3b, 0x6d, 0x54, 0x05, 0x83, 0xad, 0x99,
+	0x16, 0xc7, 0x29, 0x72, 0x5d, 0x94, 0x8f, 0xdc, 0xcc, 0x98, 0x7c, 0x9d, 0xed, 0xcf, 0xcf, 0xe7,
+	0x6d, 0xe7, 0x5e, 0xe3, 0xcb, 0x5a, 0xba, 0xdb, 0x6b, 0x9a, 0xf4, 0x6e, 0xfe, 0x3f, 0x00, 0x9f,
+	0xea, 0x34, 0x10, 0x7f, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DerivedCustomResourceServiceClient is the client API for DerivedCustomResourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DerivedCustomResourceServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*DerivedCustomResourceList, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*DerivedCustomResource, error)
+	Create(ctx context.Context, in *DerivedCustomResourceCreateRequest, opts ...grpc.CallOption) (*DerivedCustomResource, error)
+	Update(ctx context.Context, in *DerivedCustomResourceUpdateRequest, opts ...grpc.CallOption) (*DerivedCustomResource, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (DerivedCustomResourceService_WatchClient, error)
+}
+
+type derivedCustomResourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDerivedCustomResourceServiceClient(cc grpc.ClientConnInterface) DerivedCustomResourceServiceClient {
+	return &derivedCustomResourceServiceClient{cc}
+}
+
+func (c *derivedCustomResourceServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*DerivedCustomResourceList, error) {
+	out := new(DerivedCustomResourceList)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.DerivedCustomResourceService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *derivedCustomResourceServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*DerivedCustomResource, error) {
+	out := new(DerivedCustomResource)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.DerivedCustomResourceService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *derivedCustomResourceServiceClient) Create(ctx context.Context, in *DerivedCustomResourceCreateRequest, opts ...grpc.CallOption) (*DerivedCustomResource, error) {
+	out := new(DerivedCustomResource)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.DerivedCustomResourceService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *derivedCustomResourceServiceClient) Update(ctx context.Context, in *DerivedCustomResourceUpdateRequest, opts ...grpc.CallOption) (*DerivedCustomResource, error) {
+	out := new(DerivedCustomResource)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.DerivedCustomResourceService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *derivedCustomResourceServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.DerivedCustomResourceService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *derivedCustomResourceServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (DerivedCustomResourceService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DerivedCustomResourceService_serviceDesc.Streams[0], "/kubecarrier.api.v1.DerivedCustomResourceService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &derivedCustomResourceServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DerivedCustomResourceService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type derivedCustomResourceServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *derivedCustomResourceServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DerivedCustomResourceServiceServer is the server API for DerivedCustomResourceService service.
+type DerivedCustomResourceServiceServer interface {
+	List(context.Context, *ListRequest) (*DerivedCustomResourceList, error)
+	Get(context.Context, *GetRequest) (*DerivedCustomResource, error)
+	Create(context.Context, *DerivedCustomResourceCreateRequest) (*DerivedCustomResource, error)
+	Update(context.Context, *DerivedCustomResourceUpdateRequest) (*DerivedCustomResource, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	Watch(*WatchRequest, DerivedCustomResourceService_WatchServer) error
+}
+
+// UnimplementedDerivedCustomResourceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDerivedCustomResourceServiceServer struct {
+}
+
+func (*UnimplementedDerivedCustomResourceServiceServer) List(ctx context.Context, req *ListRequest) (*DerivedCustomResourceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedDerivedCustomResourceServiceServer) Get(ctx context.Context, req *GetRequest) (*DerivedCustomResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedDerivedCustomResourceServiceServer) Create(ctx context.Context, req *DerivedCustomResourceCreateRequest) (*DerivedCustomResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedDerivedCustomResourceServiceServer) Update(ctx context.Context, req *DerivedCustomResourceUpdateRequest) (*DerivedCustomResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedDerivedCustomResourceServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedDerivedCustomResourceServiceServer) Watch(req *WatchRequest, srv DerivedCustomResourceService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterDerivedCustomResourceServiceServer(s *grpc.Server, srv DerivedCustomResourceServiceServer) {
+	s.RegisterService(&_DerivedCustomResourceService_serviceDesc, srv)
+}
+
+func _DerivedCustomResourceService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DerivedCustomResourceServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.DerivedCustomResourceService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DerivedCustomResourceServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DerivedCustomResourceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DerivedCustomResourceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.DerivedCustomResourceService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DerivedCustomResourceServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DerivedCustomResourceService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DerivedCustomResourceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DerivedCustomResourceServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.DerivedCustomResourceService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DerivedCustomResourceServiceServer).Create(ctx, req.(*DerivedCustomResourceCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DerivedCustomResourceService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DerivedCustomResourceUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DerivedCustomResourceServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.DerivedCustomResourceService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DerivedCustomResourceServiceServer).Update(ctx, req.(*DerivedCustomResourceUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DerivedCustomResourceService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DerivedCustomResourceServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.DerivedCustomResourceService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DerivedCustomResourceServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DerivedCustomResourceService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DerivedCustomResourceServiceServer).Watch(m, &derivedCustomResourceServiceWatchServer{stream})
+}
+
+type DerivedCustomResourceService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type derivedCustomResourceServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *derivedCustomResourceServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _DerivedCustomResourceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubecarrier.api.v1.DerivedCustomResourceService",
+	HandlerType: (*DerivedCustomResourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _DerivedCustomResourceService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DerivedCustomResourceService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _DerivedCustomResourceService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DerivedCustomResourceService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DerivedCustomResourceService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _DerivedCustomResourceService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "derivedcustomresource.proto",
+}
@@ -19,11 +19,17 @@ limitations under the License.
 package v1
 
 import (
+	context "context"
 	fmt "fmt"
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
 	any "github.com/golang/protobuf/ptypes/any"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -38,8 +44,12 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type WatchEvent struct {
-	Object               *any.Any `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Object is empty for BOOKMARK events.
+	Object *any.Any `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// Type is one of ADDED, MODIFIED, DELETED or BOOKMARK.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// ResourceVersion of the event, a watch can be resumed from it.
+	ResourceVersion      string   `protobuf:"bytes,3,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -84,8 +94,358 @@ func (m *WatchEvent) GetType() string {
 	return ""
 }
 
+func (m *WatchEvent) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type Event struct {
+	Metadata *ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reason is a short, machine understandable string, i.e. Provisioned.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Message is a human readable description of what happened.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Type is one of Normal or Warning.
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Count is the number of times this event has occurred.
+	Count int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// Source is the component reporting this event, i.e. catapult.
+	Source               string               `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	FirstTimestamp       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=firstTimestamp,proto3" json:"firstTimestamp,omitempty"`
+	LastTimestamp        *timestamp.Timestamp `protobuf:"bytes,8,opt,name=lastTimestamp,proto3" json:"lastTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{1}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetMetadata() *ObjectMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Event) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Event) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Event) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Event) GetFirstTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.FirstTimestamp
+	}
+	return nil
+}
+
+func (m *Event) GetLastTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastTimestamp
+	}
+	return nil
+}
+
+type EventList struct {
+	Metadata             *ListMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items                []*Event  `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *EventList) Reset()         { *m = EventList{} }
+func (m *EventList) String() string { return proto.CompactTextString(m) }
+func (*EventList) ProtoMessage()    {}
+func (*EventList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{2}
+}
+
+func (m *EventList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventList.Unmarshal(m, b)
+}
+func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventList.Marshal(b, m, deterministic)
+}
+func (m *EventList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventList.Merge(m, src)
+}
+func (m *EventList) XXX_Size() int {
+	return xxx_messageInfo_EventList.Size(m)
+}
+func (m *EventList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventList proto.InternalMessageInfo
+
+func (m *EventList) GetMetadata() *ListMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *EventList) GetItems() []*Event {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type EventListRequest struct {
+	// Offering name, i.e. couchdb.eu-west-1.team-a
+	Offering string `protobuf:"bytes,1,opt,name=offering,proto3" json:"offering,omitempty"`
+	// Version of the resource
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Name of the offering instance
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Account indicate namespace of the project/account
+	Account              string   `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Continue             string   `protobuf:"bytes,6,opt,name=continue,proto3" json:"continue,omitempty"`
+	FieldSelector        string   `protobuf:"bytes,7,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	OrderBy              string   `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	Search               string   `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventListRequest) Reset()         { *m = EventListRequest{} }
+func (m *EventListRequest) String() string { return proto.CompactTextString(m) }
+func (*EventListRequest) ProtoMessage()    {}
+func (*EventListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{3}
+}
+
+func (m *EventListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventListRequest.Unmarshal(m, b)
+}
+func (m *EventListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventListRequest.Marshal(b, m, deterministic)
+}
+func (m *EventListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventListRequest.Merge(m, src)
+}
+func (m *EventListRequest) XXX_Size() int {
+	return xxx_messageInfo_EventListRequest.Size(m)
+}
+func (m *EventListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventListRequest proto.InternalMessageInfo
+
+func (m *EventListRequest) GetOffering() string {
+	if m != nil {
+		return m.Offering
+	}
+	return ""
+}
+
+func (m *EventListRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *EventListRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventListRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventListRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *EventListRequest) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
+func (m *EventListRequest) GetFieldSelector() string {
+	if m != nil {
+		return m.FieldSelector
+	}
+	return ""
+}
+
+func (m *EventListRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *EventListRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+type EventWatchRequest struct {
+	// Offering name, i.e. couchdb.eu-west-1.team-a
+	Offering string `protobuf:"bytes,1,opt,name=offering,proto3" json:"offering,omitempty"`
+	// Version of the resource
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Name of the offering instance
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Account indicate namespace of the project/account
+	Account         string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	ResourceVersion string `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	// AllowWatchBookmarks requests BOOKMARK events, that are sent periodically
+	// with the latest resourceVersion to resume the watch from.
+	AllowWatchBookmarks  bool     `protobuf:"varint,6,opt,name=allowWatchBookmarks,proto3" json:"allowWatchBookmarks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventWatchRequest) Reset()         { *m = EventWatchRequest{} }
+func (m *EventWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*EventWatchRequest) ProtoMessage()    {}
+func (*EventWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{4}
+}
+
+func (m *EventWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventWatchRequest.Unmarshal(m, b)
+}
+func (m *EventWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventWatchRequest.Marshal(b, m, deterministic)
+}
+func (m *EventWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWatchRequest.Merge(m, src)
+}
+func (m *EventWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_EventWatchRequest.Size(m)
+}
+func (m *EventWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWatchRequest proto.InternalMessageInfo
+
+func (m *EventWatchRequest) GetOffering() string {
+	if m != nil {
+		return m.Offering
+	}
+	return ""
+}
+
+func (m *EventWatchRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *EventWatchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventWatchRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventWatchRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *EventWatchRequest) GetAllowWatchBookmarks() bool {
+	if m != nil {
+		return m.AllowWatchBookmarks
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*WatchEvent)(nil), "kubecarrier.api.v1.WatchEvent")
+	proto.RegisterType((*Event)(nil), "kubecarrier.api.v1.Event")
+	proto.RegisterType((*EventList)(nil), "kubecarrier.api.v1.EventList")
+	proto.RegisterType((*EventListRequest)(nil), "kubecarrier.api.v1.EventListRequest")
+	proto.RegisterType((*EventWatchRequest)(nil), "kubecarrier.api.v1.EventWatchRequest")
 }
 
 func init() {
@@ -93,15 +453,190 @@ func init() {
 }
 
 var fileDescriptor_2d17a9d3f0ddf27e = []byte{
-	// 148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0x2d, 0x4b, 0xcd,
-	0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x2e, 0x4d, 0x4a, 0x4d, 0x4e, 0x2c,
-	0x2a, 0xca, 0x4c, 0x2d, 0xd2, 0x4b, 0x2c, 0xc8, 0xd4, 0x2b, 0x33, 0x94, 0x92, 0x4c, 0xcf, 0xcf,
-	0x4f, 0xcf, 0x49, 0xd5, 0x07, 0xab, 0x48, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0x28, 0x57,
-	0xf2, 0xe3, 0xe2, 0x0a, 0x4f, 0x2c, 0x49, 0xce, 0x70, 0x05, 0x19, 0x21, 0xa4, 0xc3, 0xc5, 0x96,
-	0x9f, 0x94, 0x95, 0x9a, 0x5c, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2, 0x07, 0xd1,
-	0xa9, 0x07, 0xd3, 0xa9, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x55, 0x23, 0x24, 0xc4, 0xc5, 0x52, 0x52,
-	0x59, 0x90, 0x2a, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0x66, 0x3b, 0xb1, 0x44, 0x31, 0x95,
-	0x19, 0x26, 0xb1, 0x81, 0xd5, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xce, 0x1b, 0x0c, 0xcd,
-	0x9a, 0x00, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x95, 0xdd, 0x38, 0x4d, 0xa6, 0xea, 0xf7, 0xc1, 0x50, 0x21, 0x37, 0x2a, 0x10, 0x59, 0x45,
+	0xca, 0x02, 0xd9, 0x6d, 0xd9, 0x20, 0x56, 0x10, 0xd4, 0x05, 0x2a, 0x08, 0xe1, 0x22, 0x90, 0xd8,
+	0x4d, 0xdc, 0x9b, 0x74, 0xa8, 0x3d, 0x13, 0x66, 0x26, 0x2e, 0x51, 0x14, 0x09, 0xf1, 0x0a, 0x6c,
+	0xd8, 0xf3, 0x1c, 0xbc, 0x03, 0x52, 0x5f, 0x81, 0x07, 0x41, 0xf3, 0xe3, 0x90, 0x26, 0x29, 0x2c,
+	0x90, 0xd8, 0xcd, 0xf1, 0xdc, 0xb9, 0xe7, 0xf8, 0x9c, 0x3b, 0x83, 0x36, 0xa0, 0x04, 0xa6, 0xe2,
+	0xa1, 0xe0, 0x8a, 0x63, 0x7c, 0x36, 0xea, 0x41, 0x46, 0x84, 0xa0, 0x20, 0x62, 0x32, 0xa4, 0x71,
+	0xb9, 0xdf, 0xda, 0x19, 0x70, 0x3e, 0xc8, 0x21, 0x21, 0x43, 0x9a, 0x10, 0xc6, 0xb8, 0x22, 0x8a,
+	0x72, 0x26, 0xed, 0x89, 0xd6, 0xb6, 0xdb, 0x35, 0xa8, 0x37, 0xea, 0x27, 0x84, 0x8d, 0xdd, 0xd6,
+	0x9d, 0xc5, 0x2d, 0x45, 0x0b, 0x90, 0x8a, 0x14, 0x43, 0x57, 0x80, 0x0a, 0x50, 0xc4, 0xae, 0xa3,
+	0x0f, 0x08, 0xbd, 0x21, 0x2a, 0x3b, 0x3d, 0xd4, 0x6a, 0xf0, 0x3d, 0x54, 0xe7, 0xbd, 0x77, 0x90,
+	0xa9, 0xd0, 0x6b, 0x7b, 0x9d, 0x8d, 0x83, 0xad, 0xd8, 0xf6, 0x8a, 0xab, 0x5e, 0xf1, 0x63, 0x36,
+	0x4e, 0x5d, 0x0d, 0xc6, 0xa8, 0xa6, 0xc6, 0x43, 0x08, 0xfd, 0xb6, 0xd7, 0x69, 0xa6, 0x66, 0x8d,
+	0x3b, 0xe8, 0x7f, 0x01, 0x92, 0x8f, 0x44, 0x06, 0xaf, 0x41, 0x48, 0xca, 0x59, 0xb8, 0x66, 0xb6,
+	0x17, 0x3f, 0x47, 0xdf, 0x7c, 0x14, 0x58, 0xd6, 0x87, 0xa8, 0xa1, 0x15, 0x9d, 0x10, 0x45, 0x1c,
+	0xef, 0xed, 0x78, 0xd9, 0x90, 0xf8, 0x85, 0x61, 0x7d, 0x0e, 0x8a, 0xa4, 0xb3, 0x7a, 0x7c, 0x13,
+	0xd5, 0x05, 0x10, 0xc9, 0x99, 0x53, 0xe1, 0x10, 0x0e, 0xd1, 0x7a, 0x01, 0x52, 0x92, 0x01, 0x38,
+	0xfe, 0x0a, 0xce, 0x54, 0xd7, 0xe6, 0x54, 0x6f, 0xa1, 0x20, 0xe3, 0x23, 0xa6, 0xc2, 0xa0, 0xed,
+	0x75, 0x82, 0xd4, 0x02, 0xdd, 0xdb, 0x4a, 0x0e, 0xeb, 0xb6, 0xb7, 0x45, 0xb8, 0x8b, 0xfe, 0xeb,
+	0x53, 0x21, 0xd5, 0xab, 0xca, 0xd7, 0x70, 0xdd, 0xa8, 0x6e, 0x2d, 0xb9, 0x35, 0xab, 0x48, 0x17,
+	0x4e, 0xe0, 0x47, 0x68, 0x33, 0x27, 0xf3, 0x2d, 0x1a, 0x7f, 0x6c, 0x71, 0xf9, 0x40, 0x54, 0xa2,
+	0xa6, 0xb1, 0xef, 0x19, 0x95, 0x0a, 0x3f, 0x58, 0xb2, 0x70, 0x67, 0x95, 0x85, 0xba, 0x76, 0xc1,
+	0xc0, 0x04, 0x05, 0x54, 0x41, 0x21, 0x43, 0xbf, 0xbd, 0xd6, 0xd9, 0x38, 0xd8, 0x5e, 0x75, 0xcc,
+	0xf0, 0xa4, 0xb6, 0x2e, 0xfa, 0xe8, 0xa3, 0x6b, 0x33, 0xe2, 0x14, 0xde, 0x8f, 0x40, 0x2a, 0xdc,
+	0x42, 0x0d, 0xde, 0xef, 0x83, 0xa0, 0x6c, 0x60, 0xf8, 0x9b, 0xe9, 0x0c, 0xeb, 0x28, 0x4a, 0x37,
+	0x0a, 0x36, 0xa3, 0x0a, 0xea, 0x28, 0x18, 0x29, 0xaa, 0x84, 0xcc, 0x5a, 0x57, 0x93, 0xcc, 0x86,
+	0x61, 0x13, 0xaa, 0xa0, 0x0e, 0x29, 0xa7, 0x05, 0xb5, 0x21, 0xad, 0xa5, 0x16, 0x68, 0xe6, 0x8c,
+	0x33, 0x45, 0xd9, 0xa8, 0x8a, 0x69, 0x86, 0xf1, 0x2e, 0xda, 0xec, 0x53, 0xc8, 0x4f, 0x8e, 0x21,
+	0x87, 0x4c, 0x71, 0x61, 0x72, 0x6a, 0xa6, 0x97, 0x3f, 0x6a, 0x46, 0x2e, 0x4e, 0x40, 0x74, 0xc7,
+	0x26, 0x84, 0x66, 0x5a, 0x41, 0x33, 0x00, 0x40, 0x44, 0x76, 0x1a, 0x36, 0xdd, 0x00, 0x18, 0x14,
+	0x5d, 0x78, 0xe8, 0xba, 0xb1, 0xc0, 0x5c, 0x9d, 0x7f, 0xe9, 0xc1, 0x8a, 0xeb, 0x15, 0xac, 0xbc,
+	0x5e, 0x78, 0x0f, 0xdd, 0x20, 0x79, 0xce, 0xcf, 0x8d, 0xc4, 0x2e, 0xe7, 0x67, 0x05, 0x11, 0x67,
+	0xd2, 0x58, 0xd4, 0x48, 0x57, 0x6d, 0x1d, 0x7c, 0xf7, 0xd1, 0xa6, 0xf9, 0x2b, 0x79, 0x0c, 0xa2,
+	0xa4, 0x19, 0xe0, 0x2f, 0x1e, 0xaa, 0x99, 0xf1, 0xda, 0xbd, 0x72, 0x2a, 0xe6, 0x86, 0xa0, 0x75,
+	0xeb, 0xb7, 0x55, 0xd1, 0xd1, 0xa7, 0x8b, 0x1f, 0x9f, 0xfd, 0x43, 0xfc, 0x24, 0x29, 0xf7, 0x13,
+	0xf7, 0x43, 0x32, 0x99, 0xb8, 0xd5, 0x34, 0xa1, 0x4c, 0x2a, 0xc2, 0x32, 0x90, 0xc9, 0xa4, 0xf2,
+	0x6d, 0x9a, 0x4c, 0x9c, 0x4f, 0xd3, 0x64, 0xa2, 0xad, 0x99, 0x26, 0xe6, 0xd9, 0x94, 0xf8, 0xab,
+	0x87, 0x02, 0xa3, 0x1f, 0xdf, 0xbd, 0x92, 0x75, 0x3e, 0x9d, 0xd6, 0xca, 0x27, 0xe5, 0xd7, 0xd3,
+	0x17, 0xbd, 0x34, 0xea, 0x8e, 0xf0, 0x53, 0xad, 0xee, 0x5c, 0x7f, 0xff, 0x5b, 0x8d, 0x7b, 0x5e,
+	0xb7, 0xf6, 0xd6, 0x2f, 0xf7, 0x7b, 0x75, 0x73, 0x99, 0xef, 0xff, 0x1c, 0x00, 0x7f, 0x34, 0xa1,
+	0xd0, 0xf3, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EventsServiceClient is the client API for EventsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventsServiceClient interface {
+	List(ctx context.Context, in *EventListRequest, opts ...grpc.CallOption) (*EventList, error)
+	Watch(ctx context.Context, in *EventWatchRequest, opts ...grpc.CallOption) (EventsService_WatchClient, error)
+}
+
+type eventsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsServiceClient(cc grpc.ClientConnInterface) EventsServiceClient {
+	return &eventsServiceClient{cc}
+}
+
+func (c *eventsServiceClient) List(ctx context.Context, in *EventListRequest, opts ...grpc.CallOption) (*EventList, error) {
+	out := new(EventList)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.EventsService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) Watch(ctx context.Context, in *EventWatchRequest, opts ...grpc.CallOption) (EventsService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventsService_serviceDesc.Streams[0], "/kubecarrier.api.v1.EventsService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventsService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type eventsServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventsServiceServer is the server API for EventsService service.
+type EventsServiceServer interface {
+	List(context.Context, *EventListRequest) (*EventList, error)
+	Watch(*EventWatchRequest, EventsService_WatchServer) error
+}
+
+// UnimplementedEventsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEventsServiceServer struct {
+}
+
+func (*UnimplementedEventsServiceServer) List(ctx context.Context, req *EventListRequest) (*EventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedEventsServiceServer) Watch(req *EventWatchRequest, srv EventsService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterEventsServiceServer(s *grpc.Server, srv EventsServiceServer) {
+	s.RegisterService(&_EventsService_serviceDesc, srv)
+}
+
+func _EventsService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.EventsService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).List(ctx, req.(*EventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServiceServer).Watch(m, &eventsServiceWatchServer{stream})
+}
+
+type EventsService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type eventsServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _EventsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubecarrier.api.v1.EventsService",
+	HandlerType: (*EventsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _EventsService_List_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _EventsService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "event.proto",
 }
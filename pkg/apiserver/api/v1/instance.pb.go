@@ -300,6 +300,9 @@ type InstanceListRequest struct {
 	LabelSelector        string   `protobuf:"bytes,4,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	Continue             string   `protobuf:"bytes,6,opt,name=continue,proto3" json:"continue,omitempty"`
+	FieldSelector        string   `protobuf:"bytes,7,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	OrderBy              string   `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	Search               string   `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -372,6 +375,27 @@ func (m *InstanceListRequest) GetContinue() string {
 	return ""
 }
 
+func (m *InstanceListRequest) GetFieldSelector() string {
+	if m != nil {
+		return m.FieldSelector
+	}
+	return ""
+}
+
+func (m *InstanceListRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *InstanceListRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
 type InstanceCreateRequest struct {
 	// Offering name, i.e. couchdb.eu-west-1.team-a
 	Offering string `protobuf:"bytes,1,opt,name=offering,proto3" json:"offering,omitempty"`
@@ -438,6 +462,167 @@ func (m *InstanceCreateRequest) GetAccount() string {
 	return ""
 }
 
+type InstanceUpdateRequest struct {
+	// Offering name, i.e. couchdb.eu-west-1.team-a
+	Offering string `protobuf:"bytes,1,opt,name=offering,proto3" json:"offering,omitempty"`
+	// Version of the resource
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Name of the offering instance
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Spec - the full instance, metadata.resourceVersion is required for optimistic locking
+	Spec *Instance `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the project/account
+	Account              string   `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstanceUpdateRequest) Reset()         { *m = InstanceUpdateRequest{} }
+func (m *InstanceUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*InstanceUpdateRequest) ProtoMessage()    {}
+func (*InstanceUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd22322185b2070b, []int{6}
+}
+
+func (m *InstanceUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstanceUpdateRequest.Unmarshal(m, b)
+}
+func (m *InstanceUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstanceUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *InstanceUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceUpdateRequest.Merge(m, src)
+}
+func (m *InstanceUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_InstanceUpdateRequest.Size(m)
+}
+func (m *InstanceUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstanceUpdateRequest proto.InternalMessageInfo
+
+func (m *InstanceUpdateRequest) GetOffering() string {
+	if m != nil {
+		return m.Offering
+	}
+	return ""
+}
+
+func (m *InstanceUpdateRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *InstanceUpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InstanceUpdateRequest) GetSpec() *Instance {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *InstanceUpdateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type InstancePatchRequest struct {
+	// Offering name, i.e. couchdb.eu-west-1.team-a
+	Offering string `protobuf:"bytes,1,opt,name=offering,proto3" json:"offering,omitempty"`
+	// Version of the resource
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Name of the offering instance
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Account indicate namespace of the project/account
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	// PatchType - type of the patch, "merge" for JSON merge patch or "json" for JSON patch
+	PatchType string `protobuf:"bytes,5,opt,name=patchType,proto3" json:"patchType,omitempty"`
+	// Patch - patch document, applied to the whole instance object
+	Patch                *RawObject `protobuf:"bytes,6,opt,name=patch,proto3" json:"patch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *InstancePatchRequest) Reset()         { *m = InstancePatchRequest{} }
+func (m *InstancePatchRequest) String() string { return proto.CompactTextString(m) }
+func (*InstancePatchRequest) ProtoMessage()    {}
+func (*InstancePatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd22322185b2070b, []int{7}
+}
+
+func (m *InstancePatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstancePatchRequest.Unmarshal(m, b)
+}
+func (m *InstancePatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstancePatchRequest.Marshal(b, m, deterministic)
+}
+func (m *InstancePatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstancePatchRequest.Merge(m, src)
+}
+func (m *InstancePatchRequest) XXX_Size() int {
+	return xxx_messageInfo_InstancePatchRequest.Size(m)
+}
+func (m *InstancePatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstancePatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstancePatchRequest proto.InternalMessageInfo
+
+func (m *InstancePatchRequest) GetOffering() string {
+	if m != nil {
+		return m.Offering
+	}
+	return ""
+}
+
+func (m *InstancePatchRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *InstancePatchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InstancePatchRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *InstancePatchRequest) GetPatchType() string {
+	if m != nil {
+		return m.PatchType
+	}
+	return ""
+}
+
+func (m *InstancePatchRequest) GetPatch() *RawObject {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 type RawObject struct {
 	// Encoding of the object data
 	Encoding string `protobuf:"bytes,1,opt,name=encoding,proto3" json:"encoding,omitempty"`
@@ -452,7 +637,7 @@ func (m *RawObject) Reset()         { *m = RawObject{} }
 func (m *RawObject) String() string { return proto.CompactTextString(m) }
 func (*RawObject) ProtoMessage()    {}
 func (*RawObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd22322185b2070b, []int{6}
+	return fileDescriptor_fd22322185b2070b, []int{8}
 }
 
 func (m *RawObject) XXX_Unmarshal(b []byte) error {
@@ -491,10 +676,13 @@ type InstanceWatchRequest struct {
 	// Offering name, i.e. couchdb.eu-west-1.team-a
 	Offering string `protobuf:"bytes,1,opt,name=offering,proto3" json:"offering,omitempty"`
 	// Version of the resource
-	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	LabelSelector        string   `protobuf:"bytes,4,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
-	ResourceVersion      string   `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	Version         string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Account         string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	LabelSelector   string `protobuf:"bytes,4,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	ResourceVersion string `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	// AllowWatchBookmarks requests BOOKMARK events, that are sent periodically
+	// with the latest resourceVersion to resume the watch from.
+	AllowWatchBookmarks  bool     `protobuf:"varint,6,opt,name=allowWatchBookmarks,proto3" json:"allowWatchBookmarks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -504,7 +692,7 @@ func (m *InstanceWatchRequest) Reset()         { *m = InstanceWatchRequest{} }
 func (m *InstanceWatchRequest) String() string { return proto.CompactTextString(m) }
 func (*InstanceWatchRequest) ProtoMessage()    {}
 func (*InstanceWatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd22322185b2070b, []int{7}
+	return fileDescriptor_fd22322185b2070b, []int{9}
 }
 
 func (m *InstanceWatchRequest) XXX_Unmarshal(b []byte) error {
@@ -560,6 +748,13 @@ func (m *InstanceWatchRequest) GetResourceVersion() string {
 	return ""
 }
 
+func (m *InstanceWatchRequest) GetAllowWatchBookmarks() bool {
+	if m != nil {
+		return m.AllowWatchBookmarks
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Instance)(nil), "kubecarrier.api.v1.Instance")
 	proto.RegisterType((*InstanceList)(nil), "kubecarrier.api.v1.InstanceList")
@@ -567,6 +762,8 @@ func init() {
 	proto.RegisterType((*InstanceDeleteRequest)(nil), "kubecarrier.api.v1.InstanceDeleteRequest")
 	proto.RegisterType((*InstanceListRequest)(nil), "kubecarrier.api.v1.InstanceListRequest")
 	proto.RegisterType((*InstanceCreateRequest)(nil), "kubecarrier.api.v1.InstanceCreateRequest")
+	proto.RegisterType((*InstanceUpdateRequest)(nil), "kubecarrier.api.v1.InstanceUpdateRequest")
+	proto.RegisterType((*InstancePatchRequest)(nil), "kubecarrier.api.v1.InstancePatchRequest")
 	proto.RegisterType((*RawObject)(nil), "kubecarrier.api.v1.RawObject")
 	proto.RegisterType((*InstanceWatchRequest)(nil), "kubecarrier.api.v1.InstanceWatchRequest")
 }
@@ -576,49 +773,60 @@ func init() {
 }
 
 var fileDescriptor_fd22322185b2070b = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xdd, 0x6a, 0x14, 0x31,
-	0x14, 0x26, 0xfb, 0x67, 0xf7, 0xb4, 0xfe, 0x10, 0xab, 0x0c, 0x63, 0x95, 0x65, 0x10, 0x5d, 0xbd,
-	0x98, 0xe9, 0x56, 0x8a, 0xd2, 0x5a, 0x2f, 0x6c, 0x4b, 0x11, 0x14, 0x61, 0x0a, 0x0a, 0xde, 0x65,
-	0xa7, 0xa7, 0x35, 0xba, 0x9b, 0xac, 0x93, 0xcc, 0x56, 0x59, 0x7b, 0xa1, 0x4f, 0xa0, 0x08, 0xa2,
-	0x2f, 0xe2, 0x9d, 0x6f, 0xe0, 0x9d, 0xaf, 0xe0, 0x83, 0xc8, 0x64, 0x26, 0xed, 0x6e, 0x7f, 0xa6,
-	0xda, 0x05, 0xbd, 0xcb, 0x49, 0xbe, 0xe4, 0xfb, 0xf2, 0xe5, 0x9c, 0x1c, 0x38, 0xc3, 0x85, 0xd2,
-	0x4c, 0x44, 0xe8, 0xf7, 0x62, 0xa9, 0x25, 0xa5, 0x2f, 0x93, 0x36, 0x46, 0x2c, 0x8e, 0x39, 0xc6,
-	0x3e, 0xeb, 0x71, 0xbf, 0xdf, 0x72, 0x67, 0xb6, 0xa4, 0xdc, 0xea, 0x60, 0xc0, 0x7a, 0x3c, 0x60,
-	0x42, 0x48, 0xcd, 0x34, 0x97, 0x42, 0x65, 0x3b, 0xdc, 0x4b, 0xf9, 0xaa, 0x89, 0xda, 0xc9, 0x66,
-	0x80, 0xdd, 0x9e, 0x7e, 0x93, 0x2f, 0x42, 0x17, 0x35, 0xcb, 0xc7, 0x93, 0xd8, 0x47, 0xa1, 0xb3,
-	0xc0, 0xfb, 0x41, 0x60, 0xe2, 0x41, 0x4e, 0x4d, 0x17, 0x60, 0x22, 0xc5, 0x6d, 0x30, 0xcd, 0x1c,
-	0xd2, 0x20, 0xcd, 0xc9, 0xb9, 0x2b, 0xfe, 0x41, 0x1d, 0xfe, 0xe3, 0xf6, 0x0b, 0x8c, 0xf4, 0x23,
-	0xd4, 0x2c, 0xdc, 0xc5, 0x53, 0x17, 0x26, 0xe4, 0xe6, 0x26, 0xc6, 0x5c, 0x6c, 0x39, 0xa5, 0x06,
-	0x69, 0xd6, 0xc3, 0xdd, 0x98, 0xb6, 0xa0, 0xa2, 0x7a, 0x18, 0x39, 0x65, 0x73, 0xe6, 0xe5, 0xc3,
-	0xce, 0x0c, 0xd9, 0x76, 0x76, 0x6c, 0x68, 0xa0, 0x74, 0x1e, 0x6a, 0x4a, 0x33, 0x9d, 0x28, 0xa7,
-	0xf2, 0x27, 0x9b, 0x72, 0xb0, 0xf7, 0x16, 0xa6, 0xec, 0x6d, 0x1e, 0x72, 0xa5, 0xe9, 0x9d, 0x03,
-	0x37, 0x9a, 0x39, 0xec, 0xa0, 0x14, 0xbb, 0xef, 0x3e, 0x73, 0x50, 0xe5, 0x1a, 0xbb, 0xca, 0x29,
-	0x35, 0xca, 0x47, 0x6d, 0xb3, 0x54, 0x61, 0x06, 0xf5, 0x5e, 0x03, 0xb5, 0x53, 0x6b, 0xa8, 0x43,
-	0x7c, 0x95, 0xa0, 0xd2, 0x23, 0xce, 0x90, 0x7d, 0xce, 0x38, 0x70, 0xaa, 0x8f, 0xb1, 0xe2, 0x52,
-	0xe4, 0xa6, 0xd9, 0x90, 0x52, 0xa8, 0x08, 0xd6, 0x45, 0xe3, 0x59, 0x3d, 0x34, 0xe3, 0x14, 0xcd,
-	0xa2, 0x48, 0x26, 0x42, 0x1b, 0x57, 0xea, 0xa1, 0x0d, 0xbd, 0x01, 0x5c, 0xb0, 0xcc, 0x2b, 0xd8,
-	0x41, 0x8d, 0xff, 0x92, 0xfc, 0x3b, 0x81, 0xf3, 0xc3, 0xae, 0x8f, 0xc7, 0x3d, 0xc4, 0x53, 0x1e,
-	0xe1, 0xa1, 0x57, 0xe1, 0x74, 0x87, 0xb5, 0xb1, 0xb3, 0x8e, 0x1d, 0x8c, 0xb4, 0x8c, 0x73, 0x1d,
-	0xa3, 0x93, 0x74, 0x1a, 0xaa, 0x1d, 0xde, 0xe5, 0xda, 0xa9, 0x36, 0x48, 0xb3, 0x1c, 0x66, 0x41,
-	0xaa, 0x25, 0x92, 0x42, 0x73, 0x91, 0xa0, 0x53, 0xcb, 0xb4, 0xd8, 0xd8, 0xfb, 0x4a, 0xf6, 0xdc,
-	0x5b, 0x8e, 0x91, 0x8d, 0xeb, 0xde, 0xec, 0x48, 0xba, 0x17, 0x67, 0x4e, 0x96, 0xed, 0x47, 0x7b,
-	0xbb, 0x08, 0xf5, 0xdd, 0x2c, 0x4f, 0xe5, 0xa0, 0x88, 0xe4, 0xc6, 0x90, 0x1c, 0x1b, 0xa7, 0x4f,
-	0x66, 0xb2, 0x3c, 0xd5, 0x32, 0x15, 0x9a, 0xb1, 0xf7, 0x8d, 0xc0, 0xb4, 0x65, 0x7a, 0xca, 0x74,
-	0xf4, 0xfc, 0xff, 0xbe, 0x4c, 0x13, 0xce, 0xc6, 0xa8, 0x64, 0x12, 0x47, 0xf8, 0x24, 0x67, 0xa8,
-	0x1a, 0xdc, 0xfe, 0xe9, 0xb9, 0x77, 0x35, 0x38, 0x67, 0x85, 0xab, 0x75, 0x8c, 0xfb, 0x3c, 0x42,
-	0xfa, 0x81, 0x40, 0xc5, 0x14, 0xf5, 0xf5, 0x22, 0x47, 0x87, 0x12, 0xd0, 0x6d, 0x1c, 0x07, 0xf4,
-	0x96, 0xde, 0xff, 0xfc, 0xf5, 0xa9, 0x74, 0x9b, 0xce, 0x07, 0xfd, 0x56, 0x90, 0xdf, 0x46, 0x05,
-	0x83, 0x7c, 0xb4, 0x13, 0xd8, 0x5f, 0x59, 0x05, 0x03, 0x6b, 0xd0, 0x4e, 0x30, 0xc8, 0x0d, 0xd9,
-	0xa1, 0x1f, 0x09, 0x94, 0xd7, 0x50, 0xd3, 0x6b, 0x45, 0x44, 0x7b, 0x5f, 0x81, 0x5b, 0x98, 0x0b,
-	0xde, 0x8a, 0x11, 0x73, 0x8f, 0xde, 0x3d, 0x91, 0x98, 0x60, 0x90, 0x96, 0xa9, 0xd1, 0x54, 0xcb,
-	0xfe, 0x00, 0x7a, 0xa3, 0x88, 0x6e, 0xe4, 0x9f, 0x70, 0x2f, 0xfa, 0x59, 0xfb, 0xf0, 0x6d, 0xfb,
-	0xf0, 0x57, 0xd3, 0xf6, 0x61, 0x35, 0xdd, 0x1c, 0x4f, 0xd3, 0x67, 0x02, 0xb5, 0xac, 0xb2, 0x8a,
-	0x35, 0x8d, 0x54, 0xdf, 0x31, 0x6e, 0x2d, 0x1b, 0x65, 0x4b, 0xde, 0xc9, 0x9e, 0x6e, 0x21, 0x2b,
-	0xbc, 0x2f, 0x04, 0xaa, 0xa6, 0x32, 0x68, 0xb3, 0x88, 0x6c, 0xb8, 0x78, 0xdc, 0x43, 0x7b, 0xa2,
-	0x41, 0xac, 0xa6, 0x8d, 0xd5, 0x0a, 0xa3, 0x8b, 0xa9, 0xb0, 0xed, 0x74, 0xfe, 0xef, 0xe5, 0xcd,
-	0x92, 0xfb, 0x95, 0x67, 0xa5, 0x7e, 0xab, 0x5d, 0x33, 0xef, 0x71, 0xeb, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xb8, 0x10, 0x91, 0x0c, 0x20, 0x08, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xdd, 0x6e, 0xd3, 0x48,
+	0x14, 0xc7, 0xe5, 0x7c, 0xb8, 0xc9, 0xb4, 0xfb, 0xa1, 0x69, 0xb7, 0xb2, 0xbc, 0xdd, 0x55, 0x64,
+	0xad, 0x76, 0xb3, 0x7b, 0x61, 0x37, 0xa9, 0xaa, 0x5d, 0xb5, 0xdb, 0xbd, 0xe8, 0x87, 0xaa, 0xdd,
+	0x05, 0x81, 0x5c, 0xa0, 0x12, 0x77, 0x13, 0xe7, 0xa4, 0x35, 0x75, 0x3c, 0xc6, 0x9e, 0xa4, 0x54,
+	0xa1, 0x37, 0x3c, 0x01, 0x08, 0x81, 0x40, 0x82, 0x47, 0xe0, 0x1d, 0x78, 0x00, 0xb8, 0xe2, 0x15,
+	0xb8, 0xe0, 0x31, 0x90, 0x67, 0x3c, 0xa9, 0xdd, 0xa6, 0x4e, 0x69, 0x44, 0xef, 0x7c, 0x66, 0x8e,
+	0xcf, 0xf9, 0x9d, 0xff, 0xcc, 0x9c, 0x19, 0xf4, 0xad, 0xeb, 0x47, 0x8c, 0xf8, 0x0e, 0x98, 0x41,
+	0x48, 0x19, 0xc5, 0xf8, 0xa0, 0xd7, 0x02, 0x87, 0x84, 0xa1, 0x0b, 0xa1, 0x49, 0x02, 0xd7, 0xec,
+	0x37, 0xf4, 0x85, 0x3d, 0x4a, 0xf7, 0x3c, 0xb0, 0x48, 0xe0, 0x5a, 0xc4, 0xf7, 0x29, 0x23, 0xcc,
+	0xa5, 0x7e, 0x24, 0xfe, 0xd0, 0x7f, 0x4c, 0x66, 0xb9, 0xd5, 0xea, 0x75, 0x2c, 0xe8, 0x06, 0xec,
+	0x28, 0x99, 0x44, 0x5d, 0x60, 0x24, 0xf9, 0x9e, 0x86, 0x3e, 0xf8, 0x4c, 0x18, 0xc6, 0x3b, 0x05,
+	0x55, 0xfe, 0x4d, 0x52, 0xe3, 0x15, 0x54, 0x89, 0xfd, 0xda, 0x84, 0x11, 0x4d, 0xa9, 0x29, 0xf5,
+	0xe9, 0xe6, 0xcf, 0xe6, 0x59, 0x0e, 0xf3, 0x46, 0xeb, 0x1e, 0x38, 0xec, 0x3a, 0x30, 0x62, 0x0f,
+	0xfd, 0xb1, 0x8e, 0x2a, 0xb4, 0xd3, 0x81, 0xd0, 0xf5, 0xf7, 0xb4, 0x42, 0x4d, 0xa9, 0x57, 0xed,
+	0xa1, 0x8d, 0x1b, 0xa8, 0x14, 0x05, 0xe0, 0x68, 0x45, 0x1e, 0xf3, 0xa7, 0x51, 0x31, 0x6d, 0x72,
+	0x28, 0xc2, 0xda, 0xdc, 0x15, 0x2f, 0x23, 0x35, 0x62, 0x84, 0xf5, 0x22, 0xad, 0x74, 0x91, 0x9f,
+	0x12, 0x67, 0xe3, 0x21, 0x9a, 0x91, 0xd5, 0x5c, 0x73, 0x23, 0x86, 0xff, 0x3a, 0x53, 0xd1, 0xc2,
+	0xa8, 0x40, 0xb1, 0xef, 0xa9, 0x7a, 0x9a, 0xa8, 0xec, 0x32, 0xe8, 0x46, 0x5a, 0xa1, 0x56, 0x3c,
+	0xef, 0x37, 0x99, 0xca, 0x16, 0xae, 0xc6, 0x03, 0x84, 0xe5, 0xd0, 0x36, 0x30, 0x1b, 0xee, 0xf7,
+	0x20, 0x62, 0x19, 0x65, 0x94, 0x53, 0xca, 0x68, 0x68, 0xaa, 0x0f, 0x61, 0xe4, 0x52, 0x3f, 0x11,
+	0x4d, 0x9a, 0x18, 0xa3, 0x92, 0x4f, 0xba, 0xc0, 0x35, 0xab, 0xda, 0xfc, 0x3b, 0xf6, 0x26, 0x8e,
+	0x43, 0x7b, 0x3e, 0xe3, 0xaa, 0x54, 0x6d, 0x69, 0x1a, 0x03, 0xf4, 0x83, 0xcc, 0xbc, 0x09, 0x1e,
+	0x30, 0xb8, 0xca, 0xe4, 0xcf, 0x0a, 0x68, 0x36, 0xad, 0xfa, 0x64, 0xb9, 0x53, 0x79, 0x8a, 0x99,
+	0x3c, 0xf8, 0x17, 0xf4, 0x8d, 0x47, 0x5a, 0xe0, 0xed, 0x80, 0x07, 0x0e, 0xa3, 0x61, 0xc2, 0x91,
+	0x1d, 0xc4, 0x73, 0xa8, 0xec, 0xb9, 0x5d, 0x97, 0x69, 0xe5, 0x9a, 0x52, 0x2f, 0xda, 0xc2, 0x88,
+	0x59, 0x1c, 0xea, 0x33, 0xd7, 0xef, 0x81, 0xa6, 0x0a, 0x16, 0x69, 0xc7, 0x71, 0x3b, 0x2e, 0x78,
+	0xed, 0x61, 0xdc, 0x29, 0x11, 0x37, 0x33, 0x18, 0x73, 0xd1, 0xb0, 0x0d, 0xe1, 0xfa, 0x91, 0x56,
+	0x11, 0x5c, 0x89, 0x89, 0xe7, 0x91, 0x1a, 0x01, 0x09, 0x9d, 0x7d, 0xad, 0xca, 0x27, 0x12, 0xcb,
+	0x78, 0xa9, 0x9c, 0xac, 0xca, 0x46, 0x08, 0x64, 0xd2, 0x55, 0x59, 0xcc, 0x1c, 0xa3, 0xfc, 0x1d,
+	0x29, 0x4e, 0xd1, 0xf9, 0x6b, 0xf6, 0x26, 0xc5, 0x76, 0x3b, 0x68, 0x93, 0xaf, 0xb1, 0x63, 0x24,
+	0x6f, 0xe9, 0x32, 0xbc, 0xe5, 0x2c, 0xef, 0x7b, 0x05, 0xcd, 0x49, 0xe7, 0x9b, 0x84, 0x39, 0xfb,
+	0x57, 0xb8, 0xc1, 0xf1, 0x02, 0xaa, 0x06, 0x71, 0xce, 0x5b, 0x47, 0x01, 0x24, 0x60, 0x27, 0x03,
+	0x78, 0x09, 0x95, 0xb9, 0xa1, 0xa9, 0x17, 0xe9, 0x54, 0xc2, 0xd7, 0x58, 0x45, 0xd5, 0xe1, 0x58,
+	0x5c, 0x03, 0xf8, 0x0e, 0x6d, 0xa7, 0x6a, 0x90, 0x76, 0x4c, 0xca, 0xbb, 0x57, 0x5c, 0xc0, 0x8c,
+	0xcd, 0xbf, 0x8d, 0x4f, 0x29, 0x31, 0x76, 0x27, 0x17, 0x63, 0xd2, 0x13, 0x57, 0x47, 0xdf, 0x85,
+	0x10, 0xd1, 0x5e, 0xe8, 0xc0, 0x9d, 0x24, 0x83, 0x10, 0xe9, 0xf4, 0x30, 0x5e, 0x44, 0xb3, 0xc4,
+	0xf3, 0xe8, 0x21, 0x87, 0x5e, 0xa7, 0xf4, 0xa0, 0x4b, 0xc2, 0x83, 0x88, 0x0b, 0x57, 0xb1, 0x47,
+	0x4d, 0x35, 0xdf, 0x56, 0xd0, 0xf7, 0xb2, 0xd4, 0x68, 0x07, 0xc2, 0xbe, 0xeb, 0x00, 0x7e, 0xac,
+	0xa0, 0x12, 0x6f, 0xef, 0xbf, 0xe5, 0xed, 0xa9, 0x54, 0x2b, 0xd2, 0x6b, 0xe3, 0x1c, 0x8d, 0xb5,
+	0x47, 0x1f, 0x3e, 0x3e, 0x2d, 0xfc, 0x89, 0x97, 0xad, 0x7e, 0xc3, 0x4a, 0xea, 0x8f, 0xac, 0x41,
+	0xf2, 0x75, 0x6c, 0xc9, 0xfb, 0x39, 0xb2, 0x06, 0x52, 0xd2, 0x63, 0x6b, 0x90, 0x48, 0x78, 0x8c,
+	0x9f, 0x28, 0xa8, 0xb8, 0x0d, 0x0c, 0xff, 0x9a, 0x97, 0xe8, 0xe4, 0x52, 0xd0, 0x73, 0x4f, 0x83,
+	0xb1, 0xc9, 0x61, 0xfe, 0xc1, 0x7f, 0x5f, 0x0a, 0xc6, 0x1a, 0xc4, 0xfb, 0x99, 0x33, 0xa9, 0xe2,
+	0x36, 0xc0, 0xbf, 0xe7, 0xa5, 0xcb, 0xdc, 0x18, 0xfa, 0xbc, 0x29, 0x1e, 0x12, 0xa6, 0x7c, 0x48,
+	0x98, 0x5b, 0xf1, 0x43, 0x42, 0x32, 0xfd, 0x31, 0x19, 0xd3, 0x73, 0x05, 0xa9, 0xa2, 0x17, 0xe6,
+	0x33, 0x65, 0xfa, 0xe5, 0x18, 0xb5, 0x36, 0x38, 0xd9, 0x9a, 0x71, 0xb9, 0xa5, 0x5b, 0x11, 0xad,
+	0xe7, 0xb5, 0x82, 0x54, 0xd1, 0x08, 0xf3, 0xc1, 0x32, 0xcd, 0x72, 0x0c, 0xd8, 0x7f, 0x1c, 0x6c,
+	0x53, 0x9f, 0x48, 0xb2, 0x84, 0xef, 0x95, 0x82, 0xca, 0xbc, 0xf1, 0xe1, 0x7a, 0x5e, 0xce, 0x74,
+	0x6f, 0x1c, 0x43, 0xf7, 0x3f, 0xa7, 0xdb, 0x6a, 0x4e, 0x46, 0x27, 0xfa, 0x19, 0x7e, 0xa1, 0xa0,
+	0xf2, 0xee, 0x78, 0xbc, 0x74, 0xb7, 0xd2, 0x47, 0x3e, 0x2e, 0xb9, 0xc7, 0x56, 0xfc, 0x42, 0x95,
+	0xeb, 0x8a, 0x57, 0x63, 0xc0, 0xc3, 0x78, 0xfc, 0xcb, 0x31, 0x17, 0x95, 0xf5, 0xd2, 0xdd, 0x42,
+	0xbf, 0xd1, 0x52, 0xf9, 0x76, 0x5e, 0xfa, 0x3c, 0x00, 0x62, 0xad, 0x92, 0x23, 0x69, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -637,6 +845,8 @@ type InstancesServiceClient interface {
 	Get(ctx context.Context, in *InstanceGetRequest, opts ...grpc.CallOption) (*Instance, error)
 	Delete(ctx context.Context, in *InstanceDeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	Create(ctx context.Context, in *InstanceCreateRequest, opts ...grpc.CallOption) (*Instance, error)
+	Update(ctx context.Context, in *InstanceUpdateRequest, opts ...grpc.CallOption) (*Instance, error)
+	Patch(ctx context.Context, in *InstancePatchRequest, opts ...grpc.CallOption) (*Instance, error)
 	Watch(ctx context.Context, in *InstanceWatchRequest, opts ...grpc.CallOption) (InstancesService_WatchClient, error)
 }
 
@@ -684,6 +894,24 @@ func (c *instancesServiceClient) Create(ctx context.Context, in *InstanceCreateR
 	return out, nil
 }
 
+func (c *instancesServiceClient) Update(ctx context.Context, in *InstanceUpdateRequest, opts ...grpc.CallOption) (*Instance, error) {
+	out := new(Instance)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.InstancesService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instancesServiceClient) Patch(ctx context.Context, in *InstancePatchRequest, opts ...grpc.CallOption) (*Instance, error) {
+	out := new(Instance)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.InstancesService/Patch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *instancesServiceClient) Watch(ctx context.Context, in *InstanceWatchRequest, opts ...grpc.CallOption) (InstancesService_WatchClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_InstancesService_serviceDesc.Streams[0], "/kubecarrier.api.v1.InstancesService/Watch", opts...)
 	if err != nil {
@@ -722,6 +950,8 @@ type InstancesServiceServer interface {
 	Get(context.Context, *InstanceGetRequest) (*Instance, error)
 	Delete(context.Context, *InstanceDeleteRequest) (*empty.Empty, error)
 	Create(context.Context, *InstanceCreateRequest) (*Instance, error)
+	Update(context.Context, *InstanceUpdateRequest) (*Instance, error)
+	Patch(context.Context, *InstancePatchRequest) (*Instance, error)
 	Watch(*InstanceWatchRequest, InstancesService_WatchServer) error
 }
 
@@ -741,6 +971,12 @@ func (*UnimplementedInstancesServiceServer) Delete(ctx context.Context, req *Ins
 func (*UnimplementedInstancesServiceServer) Create(ctx context.Context, req *InstanceCreateRequest) (*Instance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (*UnimplementedInstancesServiceServer) Update(ctx context.Context, req *InstanceUpdateRequest) (*Instance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedInstancesServiceServer) Patch(ctx context.Context, req *InstancePatchRequest) (*Instance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Patch not implemented")
+}
 func (*UnimplementedInstancesServiceServer) Watch(req *InstanceWatchRequest, srv InstancesService_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
@@ -821,6 +1057,42 @@ func _InstancesService_Create_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstancesService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstancesServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.InstancesService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstancesServiceServer).Update(ctx, req.(*InstanceUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstancesService_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstancePatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstancesServiceServer).Patch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.InstancesService/Patch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstancesServiceServer).Patch(ctx, req.(*InstancePatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InstancesService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(InstanceWatchRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -862,6 +1134,14 @@ var _InstancesService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _InstancesService_Create_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _InstancesService_Update_Handler,
+		},
+		{
+			MethodName: "Patch",
+			Handler:    _InstancesService_Patch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -148,11 +148,13 @@ func (m *VersionRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_VersionRequest proto.InternalMessageInfo
 
 type UserInfo struct {
-	User                 string   `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
-	Groups               []string `protobuf:"bytes,2,rep,name=Groups,proto3" json:"Groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	User   string   `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Groups []string `protobuf:"bytes,2,rep,name=Groups,proto3" json:"Groups,omitempty"`
+	// Accounts the user is a member of, directly or via one of its groups.
+	Accounts             []*UserAccount `protobuf:"bytes,3,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *UserInfo) Reset()         { *m = UserInfo{} }
@@ -194,10 +196,67 @@ func (m *UserInfo) GetGroups() []string {
 	return nil
 }
 
+func (m *UserInfo) GetAccounts() []*UserAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type UserAccount struct {
+	// Account is the name of the Account and its namespace.
+	Account string `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
+	// Roles of the Account, e.g. Provider or Tenant.
+	Roles                []string `protobuf:"bytes,2,rep,name=Roles,proto3" json:"Roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserAccount) Reset()         { *m = UserAccount{} }
+func (m *UserAccount) String() string { return proto.CompactTextString(m) }
+func (*UserAccount) ProtoMessage()    {}
+func (*UserAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_046afb50055b7632, []int{3}
+}
+
+func (m *UserAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserAccount.Unmarshal(m, b)
+}
+func (m *UserAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserAccount.Marshal(b, m, deterministic)
+}
+func (m *UserAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserAccount.Merge(m, src)
+}
+func (m *UserAccount) XXX_Size() int {
+	return xxx_messageInfo_UserAccount.Size(m)
+}
+func (m *UserAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserAccount proto.InternalMessageInfo
+
+func (m *UserAccount) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *UserAccount) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*APIVersion)(nil), "kubecarrier.api.v1.APIVersion")
 	proto.RegisterType((*VersionRequest)(nil), "kubecarrier.api.v1.VersionRequest")
 	proto.RegisterType((*UserInfo)(nil), "kubecarrier.api.v1.UserInfo")
+	proto.RegisterType((*UserAccount)(nil), "kubecarrier.api.v1.UserAccount")
 }
 
 func init() {
@@ -205,30 +264,33 @@ func init() {
 }
 
 var fileDescriptor_046afb50055b7632 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x4b, 0x4e, 0xeb, 0x30,
-	0x14, 0x55, 0xd2, 0xbe, 0x7e, 0x6e, 0xdf, 0x7b, 0x7a, 0xef, 0x22, 0x55, 0x51, 0xa8, 0xa0, 0xca,
-	0xa8, 0xa3, 0x44, 0x2d, 0x12, 0x62, 0x5a, 0x3e, 0x42, 0x15, 0x13, 0x14, 0xf1, 0x91, 0x98, 0xd9,
-	0xc5, 0x6d, 0x2d, 0x9a, 0xd8, 0x38, 0x4e, 0x10, 0x53, 0xb6, 0xc0, 0x4e, 0xd8, 0x03, 0x2b, 0x60,
-	0x0b, 0x2c, 0x04, 0xc5, 0x49, 0x28, 0xd0, 0xce, 0xee, 0xf1, 0x39, 0x3e, 0xf7, 0xf8, 0x18, 0xfe,
-	0xdf, 0xa5, 0x94, 0x4d, 0x89, 0x52, 0x9c, 0x29, 0x5f, 0x2a, 0xa1, 0x05, 0xe2, 0xd7, 0x23, 0x22,
-	0xb9, 0x9f, 0x0d, 0xdd, 0xdd, 0xb9, 0x10, 0xf3, 0x25, 0x0b, 0x8c, 0x82, 0xa6, 0xb3, 0x40, 0xf3,
-	0x88, 0x25, 0x9a, 0x44, 0xb2, 0xb8, 0xe4, 0x6e, 0xff, 0x14, 0xb0, 0x48, 0xea, 0xc7, 0x92, 0xec,
-	0x95, 0x24, 0x91, 0x3c, 0x20, 0x71, 0x2c, 0x34, 0xd1, 0x5c, 0xc4, 0x49, 0xc1, 0x7a, 0x2f, 0x16,
-	0xc0, 0xf8, 0x7c, 0x72, 0xc5, 0x54, 0xc2, 0x45, 0x8c, 0x0e, 0x34, 0xb3, 0x62, 0x74, 0xac, 0xbe,
-	0x35, 0x68, 0x87, 0x15, 0xc4, 0x2e, 0x34, 0xa8, 0x22, 0xf1, 0x74, 0xe1, 0xd8, 0x86, 0x28, 0x11,
-	0x1e, 0x40, 0x9b, 0xa6, 0x7c, 0x79, 0x7b, 0x4c, 0x34, 0x73, 0x6a, 0x7d, 0x6b, 0xd0, 0x19, 0xb9,
-	0x7e, 0xb1, 0xd2, 0xaf, 0xf2, 0xf8, 0x17, 0x55, 0xe0, 0x70, 0x25, 0xc6, 0x1e, 0xb4, 0xe7, 0xa2,
-	0x5c, 0xec, 0xd4, 0x8d, 0xe9, 0xea, 0x00, 0x5d, 0x68, 0xc9, 0x25, 0xd1, 0x33, 0xa1, 0x22, 0xe7,
-	0x97, 0x21, 0x3f, 0xb1, 0xf7, 0x0f, 0xfe, 0x96, 0xb2, 0x90, 0xdd, 0xa7, 0x2c, 0xd1, 0xde, 0x3e,
-	0xb4, 0x2e, 0x13, 0xa6, 0x26, 0xf1, 0x4c, 0x20, 0x42, 0x3d, 0x9f, 0xcb, 0x07, 0x98, 0x39, 0x4f,
-	0x7f, 0xaa, 0x44, 0x2a, 0x13, 0xc7, 0xee, 0xd7, 0xf2, 0xf4, 0x05, 0x1a, 0xbd, 0x5a, 0xd0, 0x39,
-	0x4b, 0x29, 0x3b, 0x2a, 0x1a, 0x47, 0x0a, 0xcd, 0x2a, 0x80, 0xe7, 0xaf, 0x7f, 0x85, 0xff, 0x7d,
-	0xad, 0xbb, 0xb3, 0x49, 0xb3, 0xaa, 0xd3, 0xdb, 0x7a, 0x7a, 0x7b, 0x7f, 0xb6, 0xff, 0x60, 0x27,
-	0xc8, 0x86, 0x41, 0xd5, 0x64, 0x08, 0x8d, 0xeb, 0x85, 0x18, 0x47, 0x13, 0xec, 0xae, 0x15, 0x75,
-	0x92, 0x7f, 0x9c, 0xdb, 0xdb, 0x64, 0x5b, 0xbd, 0xcf, 0x43, 0x63, 0xfa, 0x1b, 0x21, 0x37, 0x7d,
-	0x58, 0x08, 0x12, 0xf1, 0xc3, 0xfa, 0x8d, 0x9d, 0x0d, 0x69, 0xc3, 0xf8, 0xec, 0x7d, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0x9e, 0x52, 0x92, 0xf8, 0x58, 0x02, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4b, 0x0e, 0xd3, 0x30,
+	0x10, 0x55, 0x92, 0x7e, 0x27, 0x80, 0xc0, 0xa0, 0xca, 0x0a, 0x15, 0x8d, 0xb2, 0xea, 0x2a, 0x51,
+	0xcb, 0x06, 0x09, 0xb1, 0x28, 0x1f, 0xa1, 0x8a, 0x0d, 0x8a, 0xf8, 0x48, 0xec, 0x9c, 0xe0, 0xb6,
+	0x16, 0x49, 0x1c, 0x6c, 0x27, 0x88, 0x2d, 0x57, 0xe0, 0x26, 0xdc, 0x81, 0x13, 0x70, 0x05, 0x0e,
+	0x82, 0x9c, 0xd8, 0x6d, 0xa1, 0x65, 0x37, 0x2f, 0xef, 0xf9, 0xcd, 0x9b, 0x99, 0xc0, 0x9d, 0x4f,
+	0x4d, 0x46, 0x73, 0x22, 0x04, 0xa3, 0x22, 0xae, 0x05, 0x57, 0x1c, 0xa1, 0xf3, 0x4f, 0xa4, 0x66,
+	0x71, 0xbb, 0x0a, 0x16, 0x7b, 0xce, 0xf7, 0x05, 0x4d, 0x3a, 0x45, 0xd6, 0xec, 0x12, 0xc5, 0x4a,
+	0x2a, 0x15, 0x29, 0xeb, 0xfe, 0x51, 0x70, 0xff, 0x5f, 0x01, 0x2d, 0x6b, 0xf5, 0xd5, 0x90, 0x73,
+	0x43, 0x92, 0x9a, 0x25, 0xa4, 0xaa, 0xb8, 0x22, 0x8a, 0xf1, 0x4a, 0xf6, 0x6c, 0xf4, 0xc3, 0x01,
+	0xd8, 0xbc, 0xde, 0xbe, 0xa3, 0x42, 0x32, 0x5e, 0x21, 0x0c, 0xe3, 0xb6, 0x2f, 0xb1, 0x13, 0x3a,
+	0xcb, 0x69, 0x6a, 0x21, 0x9a, 0xc1, 0x28, 0x13, 0xa4, 0xca, 0x0f, 0xd8, 0xed, 0x08, 0x83, 0xd0,
+	0x23, 0x98, 0x66, 0x0d, 0x2b, 0x3e, 0x3e, 0x27, 0x8a, 0x62, 0x2f, 0x74, 0x96, 0xfe, 0x3a, 0x88,
+	0xfb, 0x96, 0xb1, 0xcd, 0x13, 0xbf, 0xb1, 0x81, 0xd3, 0x93, 0x18, 0xcd, 0x61, 0xba, 0xe7, 0xa6,
+	0x31, 0x1e, 0x74, 0xa6, 0xa7, 0x0f, 0x28, 0x80, 0x49, 0x5d, 0x10, 0xb5, 0xe3, 0xa2, 0xc4, 0xc3,
+	0x8e, 0x3c, 0xe2, 0xe8, 0x36, 0xdc, 0x32, 0xb2, 0x94, 0x7e, 0x6e, 0xa8, 0x54, 0x91, 0x84, 0xc9,
+	0x5b, 0x49, 0xc5, 0xb6, 0xda, 0x71, 0x84, 0x60, 0xa0, 0x6b, 0x33, 0x40, 0x57, 0xeb, 0xf4, 0x2f,
+	0x05, 0x6f, 0x6a, 0x89, 0xdd, 0xd0, 0xd3, 0xe9, 0x7b, 0x84, 0x1e, 0xc3, 0x64, 0x93, 0xe7, 0xbc,
+	0xa9, 0x94, 0xc4, 0x5e, 0xe8, 0x2d, 0xfd, 0xf5, 0x22, 0xbe, 0xbc, 0x40, 0xac, 0x3d, 0x8c, 0x2e,
+	0x3d, 0x3e, 0x88, 0x9e, 0x80, 0x7f, 0x46, 0xe8, 0xdd, 0x99, 0xd2, 0xee, 0xce, 0x32, 0xf7, 0x60,
+	0x98, 0xf2, 0x82, 0xda, 0xe6, 0x3d, 0x58, 0xff, 0x74, 0xc0, 0x7f, 0xd5, 0x64, 0xf4, 0x59, 0xdf,
+	0x0b, 0x65, 0x30, 0xb6, 0xc3, 0x47, 0xd7, 0x42, 0xfc, 0x3d, 0x72, 0xf0, 0xe0, 0x9a, 0xe6, 0x74,
+	0xca, 0xe8, 0xee, 0xb7, 0x5f, 0xbf, 0xbf, 0xbb, 0x37, 0x91, 0x9f, 0xb4, 0xab, 0xc4, 0x5e, 0x31,
+	0x85, 0xd1, 0xfb, 0x03, 0xdf, 0x94, 0x5b, 0x34, 0xbb, 0x38, 0xd2, 0x0b, 0xfd, 0xd3, 0x04, 0xf3,
+	0xff, 0xcd, 0xaf, 0x77, 0x1b, 0xa1, 0xce, 0xf4, 0x06, 0x02, 0x6d, 0xfa, 0xe5, 0xc0, 0x49, 0xc9,
+	0x9e, 0x0e, 0x3e, 0xb8, 0xed, 0x2a, 0x1b, 0x75, 0x3e, 0x0f, 0xff, 0x0c, 0x00, 0x90, 0x52, 0x77,
+	0x0b, 0xd4, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -141,8 +141,10 @@ func (m *ObjectMeta) GetGeneration() int64 {
 }
 
 type ListMeta struct {
-	Continue             string   `protobuf:"bytes,1,opt,name=continue,proto3" json:"continue,omitempty"`
-	ResourceVersion      string   `protobuf:"bytes,2,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	Continue        string `protobuf:"bytes,1,opt,name=continue,proto3" json:"continue,omitempty"`
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	// TotalCount is the number of items matching the request across all pages.
+	TotalCount           int64    `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -187,6 +189,13 @@ func (m *ListMeta) GetResourceVersion() string {
 	return ""
 }
 
+func (m *ListMeta) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ObjectMeta)(nil), "kubecarrier.api.v1.ObjectMeta")
 	proto.RegisterMapType((map[string]string)(nil), "kubecarrier.api.v1.ObjectMeta.AnnotationsEntry")
@@ -199,28 +208,29 @@ func init() {
 }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3d, 0x6b, 0xe3, 0x40,
-	0x14, 0x44, 0x1f, 0xfe, 0x7a, 0x2a, 0xce, 0xb7, 0x5c, 0xb1, 0xa8, 0xb8, 0x13, 0xae, 0xc4, 0x15,
-	0x6b, 0xec, 0x6b, 0xee, 0xae, 0x08, 0xc4, 0x10, 0x48, 0xe1, 0x90, 0x44, 0x84, 0x14, 0xe9, 0x56,
-	0xf2, 0x8b, 0xd9, 0x58, 0xda, 0x35, 0xab, 0x95, 0xc1, 0xff, 0x2d, 0x3f, 0x2e, 0x78, 0x65, 0xd9,
-	0x8e, 0x6d, 0x30, 0xe9, 0x76, 0x86, 0x37, 0xf3, 0x46, 0x6f, 0x04, 0x50, 0xa0, 0xe1, 0x6c, 0xa9,
-	0x95, 0x51, 0x84, 0x2c, 0xaa, 0x14, 0x33, 0xae, 0xb5, 0x40, 0xcd, 0xf8, 0x52, 0xb0, 0xd5, 0x28,
-	0xfc, 0x35, 0x57, 0x6a, 0x9e, 0xe3, 0xd0, 0x4e, 0xa4, 0xd5, 0xeb, 0xd0, 0x88, 0x02, 0x4b, 0xc3,
-	0x8b, 0x65, 0x2d, 0x1a, 0xbc, 0xfb, 0x00, 0xf7, 0xe9, 0x1b, 0x66, 0xe6, 0x0e, 0x0d, 0x27, 0x7d,
-	0xf0, 0x2a, 0x31, 0xa3, 0x4e, 0xe4, 0xc4, 0xbd, 0x64, 0xf3, 0x24, 0x04, 0x7c, 0xc9, 0x0b, 0xa4,
-	0xae, 0xa5, 0xec, 0x9b, 0x50, 0xe8, 0xf0, 0x2c, 0x53, 0x95, 0x34, 0xd4, 0xb3, 0x74, 0x03, 0xc9,
-	0x2d, 0x7c, 0xcf, 0x34, 0x72, 0x23, 0x94, 0x7c, 0x6a, 0x36, 0x51, 0x3f, 0x72, 0xe2, 0x60, 0x1c,
-	0xb2, 0x3a, 0x0b, 0x6b, 0xb2, 0xb0, 0xdd, 0x44, 0x72, 0x2a, 0xda, 0x38, 0xcd, 0x30, 0xc7, 0xcf,
-	0x4e, 0xad, 0xcb, 0x4e, 0x27, 0x22, 0x12, 0xc3, 0x37, 0x8d, 0xa5, 0xaa, 0x74, 0x86, 0xcf, 0xa8,
-	0x4b, 0xa1, 0x24, 0x6d, 0xdb, 0xd4, 0xc7, 0x34, 0x99, 0x40, 0x3b, 0xe7, 0x29, 0xe6, 0x25, 0xed,
-	0x44, 0x5e, 0x1c, 0x8c, 0x7f, 0xb3, 0xd3, 0x93, 0xb2, 0xfd, 0xb5, 0xd8, 0xd4, 0x0e, 0xdf, 0x48,
-	0xa3, 0xd7, 0xc9, 0x56, 0x49, 0x1e, 0x21, 0xe0, 0x52, 0x2a, 0x63, 0x3f, 0xa7, 0xa4, 0x5d, 0x6b,
-	0x34, 0xbc, 0x60, 0x74, 0xbd, 0x57, 0xd4, 0x6e, 0x87, 0x1e, 0xe4, 0x27, 0xc0, 0x1c, 0x25, 0x6a,
-	0x0b, 0x69, 0x2f, 0x72, 0x62, 0x2f, 0x39, 0x60, 0xc2, 0x7f, 0x10, 0x1c, 0x24, 0xd9, 0x74, 0xb8,
-	0xc0, 0x75, 0xd3, 0xe1, 0x02, 0xd7, 0xe4, 0x07, 0xb4, 0x56, 0x3c, 0xaf, 0x9a, 0x12, 0x6b, 0xf0,
-	0xdf, 0xfd, 0xeb, 0x84, 0x57, 0xd0, 0x3f, 0xde, 0xfd, 0x15, 0xfd, 0xe0, 0x01, 0xba, 0x53, 0x51,
-	0xd6, 0xff, 0x4e, 0x08, 0xdd, 0x4c, 0x49, 0x23, 0x64, 0x85, 0x5b, 0xf1, 0x0e, 0x9f, 0xeb, 0xc0,
-	0x3d, 0xdb, 0xc1, 0xc4, 0x7f, 0x71, 0x57, 0xa3, 0xb4, 0x6d, 0xab, 0xfd, 0xf3, 0x11, 0x00, 0x00,
-	0xff, 0xff, 0xb9, 0xac, 0xd2, 0x7b, 0xe0, 0x02, 0x00, 0x00,
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x8b, 0xdb, 0x30,
+	0x14, 0xc4, 0x1f, 0xf9, 0x7a, 0x3e, 0x34, 0x15, 0x3d, 0x08, 0x1f, 0x52, 0x93, 0x93, 0xe9, 0x41,
+	0x21, 0xe9, 0xa5, 0xed, 0xa1, 0xd0, 0x94, 0x42, 0x0f, 0x29, 0xa5, 0xa6, 0xf4, 0xd0, 0x9b, 0xec,
+	0xbc, 0x0d, 0xda, 0xd8, 0x92, 0x91, 0xe5, 0x40, 0xfe, 0xdb, 0xfe, 0xb8, 0x25, 0x72, 0x9c, 0x78,
+	0x93, 0x40, 0xd8, 0x9b, 0x66, 0x78, 0x33, 0x1a, 0x69, 0x1e, 0x40, 0x81, 0x86, 0xb3, 0x52, 0x2b,
+	0xa3, 0x08, 0xd9, 0xd6, 0x29, 0x66, 0x5c, 0x6b, 0x81, 0x9a, 0xf1, 0x52, 0xb0, 0xdd, 0x3c, 0x7c,
+	0xbf, 0x51, 0x6a, 0x93, 0xe3, 0xcc, 0x4e, 0xa4, 0xf5, 0xc3, 0xcc, 0x88, 0x02, 0x2b, 0xc3, 0x8b,
+	0xb2, 0x11, 0x4d, 0x9f, 0x7c, 0x80, 0xdf, 0xe9, 0x23, 0x66, 0xe6, 0x17, 0x1a, 0x4e, 0xc6, 0xe0,
+	0xd5, 0x62, 0x4d, 0x9d, 0xc8, 0x89, 0x47, 0xc9, 0xe1, 0x48, 0x08, 0xf8, 0x92, 0x17, 0x48, 0x5d,
+	0x4b, 0xd9, 0x33, 0xa1, 0x30, 0xe0, 0x59, 0xa6, 0x6a, 0x69, 0xa8, 0x67, 0xe9, 0x16, 0x92, 0x9f,
+	0xf0, 0x36, 0xd3, 0xc8, 0x8d, 0x50, 0xf2, 0x6f, 0x7b, 0x13, 0xf5, 0x23, 0x27, 0x0e, 0x16, 0x21,
+	0x6b, 0xb2, 0xb0, 0x36, 0x0b, 0x3b, 0x4d, 0x24, 0xd7, 0xa2, 0x83, 0xd3, 0x1a, 0x73, 0x7c, 0xe9,
+	0xd4, 0xbb, 0xef, 0x74, 0x25, 0x22, 0x31, 0xbc, 0xd1, 0x58, 0xa9, 0x5a, 0x67, 0xf8, 0x0f, 0x75,
+	0x25, 0x94, 0xa4, 0x7d, 0x9b, 0xfa, 0x92, 0x26, 0x4b, 0xe8, 0xe7, 0x3c, 0xc5, 0xbc, 0xa2, 0x83,
+	0xc8, 0x8b, 0x83, 0xc5, 0x07, 0x76, 0xfd, 0xa5, 0xec, 0xfc, 0x5b, 0x6c, 0x65, 0x87, 0x7f, 0x48,
+	0xa3, 0xf7, 0xc9, 0x51, 0x49, 0xfe, 0x40, 0xc0, 0xa5, 0x54, 0xc6, 0x3e, 0xa7, 0xa2, 0x43, 0x6b,
+	0x34, 0xbb, 0x63, 0xf4, 0xed, 0xac, 0x68, 0xdc, 0xba, 0x1e, 0x64, 0x02, 0xb0, 0x41, 0x89, 0xda,
+	0x42, 0x3a, 0x8a, 0x9c, 0xd8, 0x4b, 0x3a, 0x4c, 0xf8, 0x19, 0x82, 0x4e, 0x92, 0x43, 0x87, 0x5b,
+	0xdc, 0xb7, 0x1d, 0x6e, 0x71, 0x4f, 0xde, 0x41, 0x6f, 0xc7, 0xf3, 0xba, 0x2d, 0xb1, 0x01, 0x5f,
+	0xdc, 0x4f, 0x4e, 0xf8, 0x15, 0xc6, 0x97, 0x77, 0xbf, 0x46, 0x3f, 0x2d, 0x61, 0xb8, 0x12, 0x55,
+	0xb3, 0x3b, 0x21, 0x0c, 0x33, 0x25, 0x8d, 0x90, 0x35, 0x1e, 0xc5, 0x27, 0x7c, 0xab, 0x03, 0xf7,
+	0x76, 0x07, 0x13, 0x00, 0xa3, 0x0c, 0xcf, 0xbf, 0x9f, 0xd6, 0xcb, 0x4b, 0x3a, 0xcc, 0xd2, 0xff,
+	0xef, 0xee, 0xe6, 0x69, 0xdf, 0x56, 0xff, 0xf1, 0x79, 0x00, 0xa3, 0x47, 0x2a, 0x9e, 0x00, 0x03,
+	0x00, 0x00,
 }
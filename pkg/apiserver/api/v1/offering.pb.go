@@ -24,6 +24,7 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -89,12 +90,15 @@ func (m *Offering) GetSpec() *OfferingSpec {
 }
 
 type OfferingSpec struct {
-	Metadata             *OfferingMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Provider             *ObjectReference  `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Crd                  *CRDInformation   `protobuf:"bytes,3,opt,name=crd,proto3" json:"crd,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Metadata *OfferingMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Provider *ObjectReference  `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Crd      *CRDInformation   `protobuf:"bytes,3,opt,name=crd,proto3" json:"crd,omitempty"`
+	// BundledCRDs lists further CRDs, that are offered together with the CRD.
+	BundledCRDs          []*CRDInformation  `protobuf:"bytes,4,rep,name=bundledCRDs,proto3" json:"bundledCRDs,omitempty"`
+	Lifecycle            *OfferingLifecycle `protobuf:"bytes,5,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *OfferingSpec) Reset()         { *m = OfferingSpec{} }
@@ -143,6 +147,78 @@ func (m *OfferingSpec) GetCrd() *CRDInformation {
 	return nil
 }
 
+func (m *OfferingSpec) GetBundledCRDs() []*CRDInformation {
+	if m != nil {
+		return m.BundledCRDs
+	}
+	return nil
+}
+
+func (m *OfferingSpec) GetLifecycle() *OfferingLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+// OfferingLifecycle describes whether new instances of the Offering can be created.
+type OfferingLifecycle struct {
+	// State is one of Active, Deprecated or Retired.
+	// Deprecated Offerings are reported as Retired after their sunsetDate.
+	State                string               `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Message              string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SunsetDate           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=sunsetDate,proto3" json:"sunsetDate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OfferingLifecycle) Reset()         { *m = OfferingLifecycle{} }
+func (m *OfferingLifecycle) String() string { return proto.CompactTextString(m) }
+func (*OfferingLifecycle) ProtoMessage()    {}
+func (*OfferingLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d876d3a4bab4c43a, []int{2}
+}
+
+func (m *OfferingLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OfferingLifecycle.Unmarshal(m, b)
+}
+func (m *OfferingLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OfferingLifecycle.Marshal(b, m, deterministic)
+}
+func (m *OfferingLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfferingLifecycle.Merge(m, src)
+}
+func (m *OfferingLifecycle) XXX_Size() int {
+	return xxx_messageInfo_OfferingLifecycle.Size(m)
+}
+func (m *OfferingLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfferingLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfferingLifecycle proto.InternalMessageInfo
+
+func (m *OfferingLifecycle) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *OfferingLifecycle) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *OfferingLifecycle) GetSunsetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.SunsetDate
+	}
+	return nil
+}
+
 type OfferingMetadata struct {
 	DisplayName          string   `protobuf:"bytes,1,opt,name=displayName,proto3" json:"displayName,omitempty"`
 	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -158,7 +234,7 @@ func (m *OfferingMetadata) Reset()         { *m = OfferingMetadata{} }
 func (m *OfferingMetadata) String() string { return proto.CompactTextString(m) }
 func (*OfferingMetadata) ProtoMessage()    {}
 func (*OfferingMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d876d3a4bab4c43a, []int{2}
+	return fileDescriptor_d876d3a4bab4c43a, []int{3}
 }
 
 func (m *OfferingMetadata) XXX_Unmarshal(b []byte) error {
@@ -226,7 +302,7 @@ func (m *OfferingList) Reset()         { *m = OfferingList{} }
 func (m *OfferingList) String() string { return proto.CompactTextString(m) }
 func (*OfferingList) ProtoMessage()    {}
 func (*OfferingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d876d3a4bab4c43a, []int{3}
+	return fileDescriptor_d876d3a4bab4c43a, []int{4}
 }
 
 func (m *OfferingList) XXX_Unmarshal(b []byte) error {
@@ -264,6 +340,7 @@ func (m *OfferingList) GetItems() []*Offering {
 func init() {
 	proto.RegisterType((*Offering)(nil), "kubecarrier.api.v1.Offering")
 	proto.RegisterType((*OfferingSpec)(nil), "kubecarrier.api.v1.OfferingSpec")
+	proto.RegisterType((*OfferingLifecycle)(nil), "kubecarrier.api.v1.OfferingLifecycle")
 	proto.RegisterType((*OfferingMetadata)(nil), "kubecarrier.api.v1.OfferingMetadata")
 	proto.RegisterType((*OfferingList)(nil), "kubecarrier.api.v1.OfferingList")
 }
@@ -273,40 +350,46 @@ func init() {
 }
 
 var fileDescriptor_d876d3a4bab4c43a = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdf, 0x6e, 0xd3, 0x3c,
-	0x18, 0xc6, 0xd5, 0x24, 0xfd, 0xd4, 0xcf, 0xe5, 0xcf, 0xe4, 0xa3, 0x50, 0x4d, 0x23, 0x0a, 0x08,
-	0x0a, 0x12, 0x09, 0x2d, 0x3b, 0x40, 0x9c, 0x80, 0x60, 0x68, 0x9a, 0xc4, 0x98, 0x64, 0x0e, 0x90,
-	0x38, 0x73, 0xdd, 0xb7, 0x99, 0xa1, 0xb1, 0x83, 0xed, 0x06, 0x4d, 0xdd, 0x4e, 0xb8, 0x05, 0x2e,
-	0x8a, 0x0b, 0xe0, 0x06, 0x38, 0xe0, 0x26, 0x38, 0x43, 0x76, 0x93, 0xae, 0x5a, 0xd3, 0xee, 0xac,
-	0x7e, 0xf3, 0x7b, 0xfc, 0x3c, 0x6f, 0xfd, 0xda, 0xe8, 0x96, 0x9c, 0x4c, 0x40, 0x71, 0x91, 0x25,
-	0x85, 0x92, 0x46, 0x62, 0xfc, 0x65, 0x36, 0x02, 0x46, 0x95, 0xe2, 0xa0, 0x12, 0x5a, 0xf0, 0xa4,
-	0x1c, 0xf4, 0x76, 0x33, 0x29, 0xb3, 0x29, 0xa4, 0xb4, 0xe0, 0x29, 0x15, 0x42, 0x1a, 0x6a, 0xb8,
-	0x14, 0x7a, 0xa1, 0xe8, 0x75, 0xcd, 0x59, 0x01, 0xf5, 0x02, 0xe5, 0x60, 0x68, 0xfd, 0x01, 0x4a,
-	0x10, 0xa6, 0x5a, 0xdc, 0x54, 0xf0, 0x75, 0x06, 0xba, 0x5a, 0xc6, 0xe7, 0xa8, 0x73, 0x52, 0x19,
-	0xe3, 0x17, 0xa8, 0x63, 0x55, 0x63, 0x6a, 0x68, 0xd8, 0x8a, 0x5a, 0xfd, 0xee, 0x70, 0x2f, 0x59,
-	0x4f, 0x91, 0x9c, 0x8c, 0x3e, 0x03, 0x33, 0xc7, 0x60, 0x28, 0x59, 0xf2, 0x78, 0x1f, 0x05, 0xba,
-	0x00, 0x16, 0x7a, 0x4e, 0x17, 0x35, 0xea, 0x2a, 0x9f, 0x0f, 0x05, 0x30, 0xe2, 0xe8, 0xf8, 0x67,
-	0x0b, 0xdd, 0x58, 0x2d, 0xe3, 0x57, 0x6b, 0x11, 0xee, 0x6f, 0xdb, 0xea, 0xb8, 0x62, 0x57, 0x82,
-	0xbc, 0x44, 0x9d, 0x42, 0xc9, 0x92, 0x8f, 0x41, 0x55, 0x61, 0xee, 0x6d, 0x6e, 0x82, 0xc0, 0x04,
-	0x14, 0x08, 0x06, 0x64, 0x29, 0xc2, 0xfb, 0xc8, 0x67, 0x6a, 0x1c, 0xfa, 0x4e, 0x1b, 0x37, 0x69,
-	0xdf, 0x90, 0x83, 0x23, 0x31, 0x91, 0x2a, 0x77, 0x7f, 0x3f, 0xb1, 0x78, 0xfc, 0xbb, 0x85, 0x76,
-	0xae, 0xa6, 0xc2, 0x11, 0xea, 0x8e, 0xb9, 0x2e, 0xa6, 0xf4, 0xec, 0x3d, 0xcd, 0xc1, 0x35, 0xf4,
-	0x3f, 0x59, 0x2d, 0x39, 0x02, 0x34, 0x53, 0xbc, 0xb0, 0x5b, 0xb9, 0xc0, 0x96, 0xb8, 0x2c, 0xe1,
-	0xc7, 0x68, 0x47, 0x9f, 0x4a, 0x65, 0x0e, 0x56, 0x30, 0xdf, 0x61, 0x6b, 0x75, 0xfc, 0x04, 0x05,
-	0x53, 0x99, 0xc9, 0x30, 0x70, 0xd9, 0xef, 0x34, 0x65, 0x3f, 0xca, 0x69, 0x06, 0xc4, 0x61, 0x16,
-	0xe7, 0x4c, 0x8a, 0xb0, 0x7d, 0x2d, 0x6e, 0xb1, 0xf8, 0xfc, 0xf2, 0xac, 0xde, 0x71, 0x6d, 0xf0,
-	0xf3, 0xb5, 0xb3, 0xda, 0x6d, 0xda, 0xc2, 0xb2, 0x57, 0x86, 0x65, 0x88, 0xda, 0xdc, 0x40, 0xae,
-	0x43, 0x2f, 0xf2, 0x37, 0xc9, 0x6a, 0x2b, 0xb2, 0x40, 0x87, 0x7f, 0x3d, 0x74, 0x7b, 0x39, 0x2a,
-	0xa0, 0x4a, 0xce, 0x00, 0x6b, 0x14, 0xb8, 0x24, 0x77, 0x37, 0xf9, 0x92, 0xc5, 0xac, 0xf7, 0xb6,
-	0xce, 0xa3, 0x05, 0xe3, 0xfe, 0xf7, 0x5f, 0x7f, 0x7e, 0x78, 0x31, 0x8e, 0xd2, 0x72, 0x90, 0x52,
-	0xc6, 0xe4, 0x4c, 0x18, 0x9d, 0xce, 0xab, 0x5f, 0x17, 0x69, 0x7d, 0x3b, 0x35, 0x36, 0xc8, 0x3f,
-	0x04, 0x83, 0x1b, 0xaf, 0xc6, 0x21, 0x2c, 0x2d, 0xb7, 0x36, 0x15, 0xa7, 0xce, 0xee, 0x11, 0x7e,
-	0x78, 0x9d, 0x5d, 0x3a, 0x17, 0x34, 0x87, 0x0b, 0x3c, 0x47, 0xed, 0x8f, 0xd4, 0xb0, 0x53, 0xdc,
-	0xd8, 0x8a, 0xfb, 0x54, 0x3b, 0xef, 0x6d, 0x24, 0xde, 0xda, 0x77, 0x20, 0x4e, 0x9c, 0x77, 0x1f,
-	0x3f, 0xb0, 0xde, 0xdf, 0x6c, 0x7d, 0x6b, 0x82, 0xa7, 0xad, 0xd7, 0xc1, 0x27, 0xaf, 0x1c, 0x8c,
-	0xfe, 0x73, 0x2f, 0xc6, 0xb3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x91, 0xd7, 0x9c, 0x7e, 0xaa,
-	0x04, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5d, 0x6f, 0xd3, 0x3c,
+	0x14, 0xc7, 0xd5, 0xb7, 0xe7, 0xd9, 0x4e, 0x79, 0x19, 0x16, 0x17, 0xa1, 0x9a, 0xb6, 0x28, 0xbc,
+	0x0d, 0x24, 0x12, 0x36, 0x76, 0x81, 0x76, 0x03, 0x62, 0x45, 0xd3, 0xa4, 0x8d, 0x49, 0x06, 0x09,
+	0x89, 0x3b, 0xd7, 0x3d, 0xed, 0x0c, 0x8d, 0x1d, 0x6c, 0xb7, 0x68, 0xda, 0x26, 0x24, 0xbe, 0x02,
+	0xdf, 0x0c, 0xbe, 0x00, 0x17, 0x7c, 0x09, 0xee, 0x50, 0x9c, 0xa4, 0x8b, 0xd6, 0xb4, 0xe3, 0x2e,
+	0xe7, 0xf8, 0xf7, 0xcf, 0xf9, 0xfb, 0xf8, 0xd8, 0x70, 0x43, 0x0d, 0x06, 0xa8, 0x85, 0x1c, 0x86,
+	0x89, 0x56, 0x56, 0x11, 0xf2, 0x69, 0xdc, 0x43, 0xce, 0xb4, 0x16, 0xa8, 0x43, 0x96, 0x88, 0x70,
+	0xb2, 0xd9, 0x59, 0x1d, 0x2a, 0x35, 0x1c, 0x61, 0xc4, 0x12, 0x11, 0x31, 0x29, 0x95, 0x65, 0x56,
+	0x28, 0x69, 0x32, 0x45, 0x67, 0x3d, 0x5f, 0x75, 0x51, 0x6f, 0x3c, 0x88, 0xac, 0x88, 0xd1, 0x58,
+	0x16, 0x27, 0x39, 0xd0, 0xb6, 0x27, 0x09, 0x16, 0x34, 0xc4, 0x68, 0x59, 0xb1, 0x80, 0x13, 0x94,
+	0x36, 0x0f, 0xae, 0x6b, 0xfc, 0x3c, 0x46, 0x93, 0x87, 0xc1, 0x19, 0x2c, 0x1d, 0xe5, 0xce, 0xc8,
+	0x0e, 0x2c, 0xa5, 0xaa, 0x3e, 0xb3, 0xcc, 0xab, 0xf9, 0xb5, 0x8d, 0xf6, 0xd6, 0x5a, 0x38, 0x6b,
+	0x33, 0x3c, 0xea, 0x7d, 0x44, 0x6e, 0x0f, 0xd1, 0x32, 0x3a, 0xe5, 0xc9, 0x36, 0x34, 0x4d, 0x82,
+	0xdc, 0xab, 0x3b, 0x9d, 0x5f, 0xa9, 0xcb, 0xeb, 0xbc, 0x4d, 0x90, 0x53, 0x47, 0x07, 0x3f, 0xea,
+	0x70, 0xad, 0x9c, 0x26, 0x2f, 0x67, 0x2c, 0xdc, 0x5b, 0xf4, 0xab, 0xc3, 0x9c, 0x2d, 0x19, 0x79,
+	0x01, 0x4b, 0x89, 0x56, 0x13, 0xd1, 0x47, 0x9d, 0x9b, 0xb9, 0x3b, 0x7f, 0x13, 0x14, 0x07, 0xa8,
+	0x51, 0x72, 0xa4, 0x53, 0x11, 0xd9, 0x86, 0x06, 0xd7, 0x7d, 0xaf, 0xe1, 0xb4, 0x41, 0x95, 0x76,
+	0x97, 0x76, 0xf7, 0xe5, 0x40, 0xe9, 0xd8, 0x9d, 0x0f, 0x4d, 0x71, 0xd2, 0x85, 0x76, 0x6f, 0x2c,
+	0xfb, 0x23, 0xec, 0xef, 0xd2, 0xae, 0xf1, 0x9a, 0x7e, 0xe3, 0x1f, 0xd5, 0x65, 0x19, 0xd9, 0x85,
+	0xe5, 0x91, 0x18, 0x20, 0x3f, 0xe1, 0x23, 0xf4, 0x5a, 0xce, 0xc1, 0xfd, 0x45, 0xfb, 0x3f, 0x28,
+	0x60, 0x7a, 0xa1, 0x0b, 0xbe, 0xc2, 0xad, 0x99, 0x75, 0x72, 0x1b, 0x5a, 0xc6, 0x32, 0x8b, 0xae,
+	0xab, 0xcb, 0x34, 0x0b, 0x88, 0x07, 0xff, 0xc7, 0x68, 0x0c, 0x1b, 0xa2, 0xeb, 0xd5, 0x32, 0x2d,
+	0x42, 0xb2, 0x03, 0x60, 0xc6, 0xd2, 0xa0, 0xed, 0xa6, 0xa2, 0xac, 0x19, 0x9d, 0x30, 0x1b, 0xc1,
+	0xb0, 0x18, 0xc1, 0xf0, 0x5d, 0x31, 0x82, 0xb4, 0x44, 0x07, 0xbf, 0x6a, 0xb0, 0x72, 0xf9, 0x84,
+	0x88, 0x0f, 0xed, 0xbe, 0x30, 0xc9, 0x88, 0x9d, 0xbc, 0x61, 0x71, 0x61, 0xa3, 0x9c, 0x72, 0x04,
+	0x1a, 0xae, 0x45, 0x92, 0x36, 0x26, 0x37, 0x54, 0x4e, 0x91, 0xc7, 0xb0, 0x62, 0x8e, 0x95, 0xb6,
+	0xdd, 0x12, 0xd6, 0x70, 0xd8, 0x4c, 0x9e, 0x3c, 0x81, 0xe6, 0x48, 0x0d, 0x95, 0xd7, 0x74, 0xd6,
+	0xef, 0x54, 0x75, 0x71, 0x3f, 0x66, 0x43, 0xa4, 0x0e, 0x4b, 0x71, 0xc1, 0x95, 0xf4, 0x5a, 0x57,
+	0xe2, 0x29, 0x16, 0x9c, 0x5d, 0xcc, 0xed, 0x81, 0x30, 0x96, 0x3c, 0x9f, 0x99, 0xdb, 0xd5, 0xaa,
+	0x5f, 0xa4, 0xec, 0xa5, 0x8b, 0xb3, 0x05, 0x2d, 0x61, 0x31, 0x36, 0x5e, 0xdd, 0x6f, 0xcc, 0x93,
+	0x15, 0xa5, 0x68, 0x86, 0x6e, 0xfd, 0xa9, 0xc3, 0xcd, 0xe9, 0xb5, 0x41, 0x3d, 0x11, 0x1c, 0x89,
+	0x81, 0xa6, 0x73, 0xb2, 0x3e, 0xaf, 0x2e, 0xcd, 0xee, 0x7d, 0xc7, 0x5f, 0x3c, 0x50, 0xc6, 0x06,
+	0x1b, 0xdf, 0x7e, 0xfe, 0xfe, 0x5e, 0x0f, 0x88, 0x1f, 0x4d, 0x36, 0x23, 0xc6, 0xb9, 0x1a, 0x4b,
+	0x6b, 0xa2, 0xd3, 0xfc, 0xeb, 0x3c, 0x2a, 0x9e, 0x32, 0x43, 0x2c, 0x34, 0xf6, 0xd0, 0x92, 0xca,
+	0x67, 0x62, 0x0f, 0xa7, 0x25, 0x17, 0x6e, 0x2a, 0x88, 0x5c, 0xb9, 0x47, 0xe4, 0xe1, 0x55, 0xe5,
+	0xa2, 0x53, 0xc9, 0x62, 0x3c, 0x27, 0xa7, 0xd0, 0x7a, 0xcf, 0x2c, 0x3f, 0x26, 0x95, 0x5b, 0x71,
+	0x4b, 0x45, 0xe5, 0xb5, 0xb9, 0xc4, 0xeb, 0xf4, 0x4d, 0x0c, 0x42, 0x57, 0x7b, 0x83, 0x3c, 0x48,
+	0x6b, 0x7f, 0x49, 0xf3, 0x0b, 0x1d, 0x3c, 0xad, 0xbd, 0x6a, 0x7e, 0xa8, 0x4f, 0x36, 0x7b, 0xff,
+	0xb9, 0x2b, 0xf0, 0xec, 0xef, 0x00, 0x2e, 0x8c, 0xc0, 0x8b, 0xd7, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,6 +24,7 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -89,11 +90,18 @@ func (m *Region) GetSpec() *RegionSpec {
 }
 
 type RegionSpec struct {
-	Metadata             *RegionMetadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Provider             *ObjectReference `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Metadata *RegionMetadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Provider *ObjectReference `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Health of the ServiceCluster of this Region.
+	Health *RegionHealth `protobuf:"bytes,3,opt,name=health,proto3" json:"health,omitempty"`
+	// Capacity of the ServiceCluster of this Region.
+	Capacity *RegionCapacity `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Maintenance of the ServiceCluster of this Region,
+	// no new instances can be created while the maintenance is in effect.
+	Maintenance          *RegionMaintenance `protobuf:"bytes,5,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *RegionSpec) Reset()         { *m = RegionSpec{} }
@@ -135,6 +143,245 @@ func (m *RegionSpec) GetProvider() *ObjectReference {
 	return nil
 }
 
+func (m *RegionSpec) GetHealth() *RegionHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+func (m *RegionSpec) GetCapacity() *RegionCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *RegionSpec) GetMaintenance() *RegionMaintenance {
+	if m != nil {
+		return m.Maintenance
+	}
+	return nil
+}
+
+type RegionMaintenance struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Start of the maintenance window, the maintenance is in effect immediately if not set.
+	Start *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the maintenance window, the maintenance is in effect until it's removed if not set.
+	End                  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RegionMaintenance) Reset()         { *m = RegionMaintenance{} }
+func (m *RegionMaintenance) String() string { return proto.CompactTextString(m) }
+func (*RegionMaintenance) ProtoMessage()    {}
+func (*RegionMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{2}
+}
+
+func (m *RegionMaintenance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionMaintenance.Unmarshal(m, b)
+}
+func (m *RegionMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionMaintenance.Marshal(b, m, deterministic)
+}
+func (m *RegionMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionMaintenance.Merge(m, src)
+}
+func (m *RegionMaintenance) XXX_Size() int {
+	return xxx_messageInfo_RegionMaintenance.Size(m)
+}
+func (m *RegionMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionMaintenance proto.InternalMessageInfo
+
+func (m *RegionMaintenance) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RegionMaintenance) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *RegionMaintenance) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+type RegionHealth struct {
+	// State of the Region, one of (Healthy, Degraded, Unknown).
+	State                string   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegionHealth) Reset()         { *m = RegionHealth{} }
+func (m *RegionHealth) String() string { return proto.CompactTextString(m) }
+func (*RegionHealth) ProtoMessage()    {}
+func (*RegionHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{3}
+}
+
+func (m *RegionHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionHealth.Unmarshal(m, b)
+}
+func (m *RegionHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionHealth.Marshal(b, m, deterministic)
+}
+func (m *RegionHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionHealth.Merge(m, src)
+}
+func (m *RegionHealth) XXX_Size() int {
+	return xxx_messageInfo_RegionHealth.Size(m)
+}
+func (m *RegionHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionHealth proto.InternalMessageInfo
+
+func (m *RegionHealth) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *RegionHealth) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RegionHealth) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type RegionCapacity struct {
+	// Allocatable CPU and memory of Ready Nodes.
+	Allocatable map[string]string `protobuf:"bytes,1,rep,name=allocatable,proto3" json:"allocatable,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Requested CPU and memory of scheduled Pods.
+	Requested map[string]string `protobuf:"bytes,2,rep,name=requested,proto3" json:"requested,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Instances per discovered CustomResourceDefinition.
+	Instances            []*RegionInstanceCount `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *RegionCapacity) Reset()         { *m = RegionCapacity{} }
+func (m *RegionCapacity) String() string { return proto.CompactTextString(m) }
+func (*RegionCapacity) ProtoMessage()    {}
+func (*RegionCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{4}
+}
+
+func (m *RegionCapacity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionCapacity.Unmarshal(m, b)
+}
+func (m *RegionCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionCapacity.Marshal(b, m, deterministic)
+}
+func (m *RegionCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionCapacity.Merge(m, src)
+}
+func (m *RegionCapacity) XXX_Size() int {
+	return xxx_messageInfo_RegionCapacity.Size(m)
+}
+func (m *RegionCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionCapacity proto.InternalMessageInfo
+
+func (m *RegionCapacity) GetAllocatable() map[string]string {
+	if m != nil {
+		return m.Allocatable
+	}
+	return nil
+}
+
+func (m *RegionCapacity) GetRequested() map[string]string {
+	if m != nil {
+		return m.Requested
+	}
+	return nil
+}
+
+func (m *RegionCapacity) GetInstances() []*RegionInstanceCount {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+type RegionInstanceCount struct {
+	Crd                  *ObjectReference `protobuf:"bytes,1,opt,name=crd,proto3" json:"crd,omitempty"`
+	Count                int32            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RegionInstanceCount) Reset()         { *m = RegionInstanceCount{} }
+func (m *RegionInstanceCount) String() string { return proto.CompactTextString(m) }
+func (*RegionInstanceCount) ProtoMessage()    {}
+func (*RegionInstanceCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{5}
+}
+
+func (m *RegionInstanceCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionInstanceCount.Unmarshal(m, b)
+}
+func (m *RegionInstanceCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionInstanceCount.Marshal(b, m, deterministic)
+}
+func (m *RegionInstanceCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionInstanceCount.Merge(m, src)
+}
+func (m *RegionInstanceCount) XXX_Size() int {
+	return xxx_messageInfo_RegionInstanceCount.Size(m)
+}
+func (m *RegionInstanceCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionInstanceCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionInstanceCount proto.InternalMessageInfo
+
+func (m *RegionInstanceCount) GetCrd() *ObjectReference {
+	if m != nil {
+		return m.Crd
+	}
+	return nil
+}
+
+func (m *RegionInstanceCount) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type RegionMetadata struct {
 	DisplayName          string   `protobuf:"bytes,1,opt,name=displayName,proto3" json:"displayName,omitempty"`
 	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -147,7 +394,7 @@ func (m *RegionMetadata) Reset()         { *m = RegionMetadata{} }
 func (m *RegionMetadata) String() string { return proto.CompactTextString(m) }
 func (*RegionMetadata) ProtoMessage()    {}
 func (*RegionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{2}
+	return fileDescriptor_6eef30384a8831dd, []int{6}
 }
 
 func (m *RegionMetadata) XXX_Unmarshal(b []byte) error {
@@ -194,7 +441,7 @@ func (m *RegionList) Reset()         { *m = RegionList{} }
 func (m *RegionList) String() string { return proto.CompactTextString(m) }
 func (*RegionList) ProtoMessage()    {}
 func (*RegionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eef30384a8831dd, []int{3}
+	return fileDescriptor_6eef30384a8831dd, []int{7}
 }
 
 func (m *RegionList) XXX_Unmarshal(b []byte) error {
@@ -232,6 +479,12 @@ func (m *RegionList) GetItems() []*Region {
 func init() {
 	proto.RegisterType((*Region)(nil), "kubecarrier.api.v1.Region")
 	proto.RegisterType((*RegionSpec)(nil), "kubecarrier.api.v1.RegionSpec")
+	proto.RegisterType((*RegionMaintenance)(nil), "kubecarrier.api.v1.RegionMaintenance")
+	proto.RegisterType((*RegionHealth)(nil), "kubecarrier.api.v1.RegionHealth")
+	proto.RegisterType((*RegionCapacity)(nil), "kubecarrier.api.v1.RegionCapacity")
+	proto.RegisterMapType((map[string]string)(nil), "kubecarrier.api.v1.RegionCapacity.AllocatableEntry")
+	proto.RegisterMapType((map[string]string)(nil), "kubecarrier.api.v1.RegionCapacity.RequestedEntry")
+	proto.RegisterType((*RegionInstanceCount)(nil), "kubecarrier.api.v1.RegionInstanceCount")
 	proto.RegisterType((*RegionMetadata)(nil), "kubecarrier.api.v1.RegionMetadata")
 	proto.RegisterType((*RegionList)(nil), "kubecarrier.api.v1.RegionList")
 }
@@ -241,35 +494,54 @@ func init() {
 }
 
 var fileDescriptor_6eef30384a8831dd = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0xd4, 0x30,
-	0x10, 0xc6, 0x95, 0x74, 0x5b, 0x15, 0x87, 0x72, 0xf0, 0x69, 0x15, 0x55, 0x25, 0x32, 0x50, 0x7a,
-	0x80, 0xa4, 0x1b, 0x2e, 0x88, 0x03, 0x48, 0x48, 0xa8, 0x17, 0x0a, 0x92, 0x41, 0x42, 0xe2, 0xe6,
-	0xf5, 0x0e, 0x8b, 0xa1, 0xb1, 0x8d, 0xed, 0x0d, 0x5d, 0x55, 0xbd, 0x70, 0xe7, 0xc4, 0xa3, 0xf1,
-	0x0a, 0x5c, 0x79, 0x07, 0x64, 0x27, 0xfb, 0x87, 0xee, 0x26, 0xb7, 0xcc, 0xe4, 0x9b, 0xf9, 0x7d,
-	0x33, 0xb6, 0xd1, 0x6d, 0x03, 0x53, 0xa1, 0x64, 0xae, 0x8d, 0x72, 0x0a, 0xe3, 0xaf, 0xb3, 0x31,
-	0x70, 0x66, 0x8c, 0x00, 0x93, 0x33, 0x2d, 0xf2, 0x7a, 0x94, 0x1e, 0x4e, 0x95, 0x9a, 0x5e, 0x40,
-	0xc1, 0xb4, 0x28, 0x98, 0x94, 0xca, 0x31, 0x27, 0x94, 0xb4, 0x4d, 0x45, 0x9a, 0xb8, 0xb9, 0x86,
-	0x45, 0x80, 0x2a, 0x70, 0x6c, 0xf1, 0x03, 0x6a, 0x90, 0xae, 0x0d, 0x0e, 0x0c, 0x7c, 0x9b, 0x81,
-	0x6d, 0x43, 0x72, 0x89, 0xf6, 0x68, 0xc0, 0xe2, 0x67, 0x68, 0xdf, 0xd7, 0x4c, 0x98, 0x63, 0xc3,
-	0x28, 0x8b, 0x4e, 0x92, 0xf2, 0x28, 0xdf, 0xf4, 0x90, 0xbf, 0x1d, 0x7f, 0x01, 0xee, 0xce, 0xc1,
-	0x31, 0xba, 0xd4, 0xe3, 0x12, 0x0d, 0xac, 0x06, 0x3e, 0x8c, 0xbb, 0xeb, 0x1a, 0xca, 0x3b, 0x0d,
-	0x9c, 0x06, 0x2d, 0xf9, 0x19, 0x21, 0xb4, 0x4a, 0xe2, 0xe7, 0x1b, 0x78, 0xd2, 0xdd, 0xe6, 0xbc,
-	0x55, 0xae, 0x59, 0x78, 0x81, 0xf6, 0xb5, 0x51, 0xb5, 0x98, 0x80, 0x69, 0x6d, 0xdc, 0xeb, 0xb6,
-	0x4f, 0xe1, 0x13, 0x18, 0x90, 0x1c, 0xe8, 0xb2, 0x88, 0xbc, 0x47, 0x77, 0xfe, 0x6f, 0x8e, 0x33,
-	0x94, 0x4c, 0x84, 0xd5, 0x17, 0x6c, 0xfe, 0x86, 0x55, 0x10, 0x5c, 0xdd, 0xa2, 0xeb, 0xa9, 0xa0,
-	0x00, 0xcb, 0x8d, 0xd0, 0xfe, 0x20, 0x02, 0xd7, 0x2b, 0x56, 0x29, 0x72, 0xb9, 0x18, 0xf2, 0xb5,
-	0xb0, 0x0e, 0x3f, 0xdd, 0x18, 0xf2, 0x70, 0x9b, 0x49, 0xaf, 0xbd, 0xb1, 0xe1, 0x53, 0xb4, 0x2b,
-	0x1c, 0x54, 0x76, 0x18, 0x67, 0x3b, 0x27, 0x49, 0x99, 0x76, 0xef, 0x86, 0x36, 0xc2, 0xf2, 0x6f,
-	0x8c, 0x0e, 0xda, 0xfd, 0x82, 0xa9, 0x05, 0x07, 0xac, 0xd0, 0x20, 0xb8, 0xb8, 0xdb, 0xc5, 0xa4,
-	0xcd, 0xd5, 0x48, 0x7b, 0x0e, 0xd0, 0xcb, 0xc8, 0xf1, 0x8f, 0xdf, 0x7f, 0x7e, 0xc5, 0x19, 0x3e,
-	0x2a, 0xea, 0x51, 0xc1, 0x38, 0x57, 0x33, 0xe9, 0x6c, 0x71, 0xd5, 0x7e, 0x5d, 0x17, 0xcd, 0x45,
-	0xb6, 0x58, 0xa3, 0x9d, 0x33, 0x70, 0x78, 0x6b, 0xbb, 0x33, 0x58, 0xe2, 0x7a, 0x86, 0x21, 0x8f,
-	0x03, 0xea, 0x21, 0x7e, 0xd0, 0x8f, 0x2a, 0xae, 0x24, 0xab, 0xe0, 0x1a, 0xcf, 0xd1, 0xee, 0x07,
-	0xe6, 0xf8, 0x67, 0x9c, 0x6d, 0xeb, 0x19, 0x7e, 0xf5, 0x0e, 0x19, 0x14, 0xaf, 0xfc, 0x73, 0x21,
-	0x8f, 0x02, 0xf9, 0x18, 0xdf, 0xf7, 0xe4, 0xef, 0x3e, 0xdf, 0xc3, 0x3f, 0x8d, 0x5e, 0x0e, 0x3e,
-	0xc6, 0xf5, 0x68, 0xbc, 0x17, 0x9e, 0xd5, 0x93, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x67, 0x03,
-	0x44, 0xbb, 0xcd, 0x03, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x55, 0xec, 0x24, 0x8f, 0xdc, 0x00, 0xe2, 0xcd, 0x7b, 0x7a, 0xb2, 0x22, 0x04, 0x91, 0x5f,
+	0xf9, 0x58, 0x50, 0x9b, 0x04, 0x55, 0x42, 0xa8, 0xa2, 0x6a, 0x11, 0xa2, 0x95, 0x4a, 0x91, 0xa6,
+	0xb4, 0x95, 0xba, 0x9b, 0x38, 0x97, 0xe0, 0x12, 0x7f, 0xd4, 0x33, 0x49, 0x89, 0x10, 0x9b, 0x6e,
+	0xbb, 0xec, 0x1f, 0xab, 0xd4, 0xbf, 0xd0, 0x6d, 0x7f, 0x42, 0xa5, 0x6a, 0xc6, 0xe3, 0xc4, 0x90,
+	0x0f, 0xe8, 0xce, 0x77, 0xe6, 0x9c, 0x7b, 0xce, 0xdc, 0xb9, 0x73, 0x0d, 0xf3, 0x09, 0x76, 0xfc,
+	0x28, 0x74, 0xe2, 0x24, 0x12, 0x11, 0x21, 0x17, 0xbd, 0x16, 0x7a, 0x2c, 0x49, 0x7c, 0x4c, 0x1c,
+	0x16, 0xfb, 0x4e, 0xbf, 0x51, 0x5b, 0xee, 0x44, 0x51, 0xa7, 0x8b, 0x2e, 0x8b, 0x7d, 0x97, 0x85,
+	0x61, 0x24, 0x98, 0xf0, 0xa3, 0x90, 0xa7, 0x8c, 0xda, 0xaa, 0xde, 0x55, 0x51, 0xab, 0x77, 0xe6,
+	0x0a, 0x3f, 0x40, 0x2e, 0x58, 0x10, 0x6b, 0x40, 0x55, 0x0c, 0x62, 0xcc, 0xd0, 0x10, 0xa0, 0x60,
+	0xd9, 0x06, 0xf6, 0x31, 0x14, 0x3a, 0x58, 0x48, 0xf0, 0x63, 0x0f, 0xb9, 0x0e, 0xed, 0x4b, 0x28,
+	0x53, 0xe5, 0x8b, 0xec, 0xc1, 0x9c, 0xe4, 0xb4, 0x99, 0x60, 0x56, 0xa1, 0x5e, 0xd8, 0xac, 0x36,
+	0x57, 0x9c, 0x71, 0x93, 0xce, 0x49, 0xeb, 0x03, 0x7a, 0xe2, 0x18, 0x05, 0xa3, 0x43, 0x3c, 0x69,
+	0x42, 0x91, 0xc7, 0xe8, 0x59, 0xc6, 0x74, 0x5e, 0xaa, 0xf2, 0x3a, 0x46, 0x8f, 0x2a, 0xac, 0xfd,
+	0xcd, 0x00, 0x18, 0x2d, 0x92, 0xfd, 0x31, 0x79, 0x7b, 0x7a, 0x9a, 0x63, 0x8d, 0xcc, 0x59, 0x78,
+	0x02, 0x73, 0x71, 0x12, 0xf5, 0xfd, 0x36, 0x26, 0xda, 0xc6, 0xff, 0xd3, 0xed, 0x53, 0x3c, 0xc3,
+	0x04, 0x43, 0x0f, 0xe9, 0x90, 0x44, 0x76, 0xa1, 0x7c, 0x8e, 0xac, 0x2b, 0xce, 0x2d, 0x53, 0xd1,
+	0xeb, 0xd3, 0xe5, 0x9f, 0x2b, 0x1c, 0xd5, 0x78, 0x69, 0xdd, 0x63, 0x31, 0xf3, 0x7c, 0x31, 0xb0,
+	0x8a, 0x77, 0x59, 0x3f, 0xd0, 0x48, 0x3a, 0xe4, 0x90, 0x23, 0xa8, 0x06, 0xcc, 0x0f, 0x05, 0x86,
+	0x2c, 0xf4, 0xd0, 0x2a, 0xa9, 0x14, 0x6b, 0x33, 0x4e, 0x3f, 0x02, 0xd3, 0x3c, 0xd3, 0xfe, 0x52,
+	0x80, 0xbf, 0xc7, 0x20, 0xe4, 0x3f, 0x28, 0x27, 0xc8, 0x78, 0x14, 0xaa, 0xba, 0x56, 0xa8, 0x8e,
+	0xc8, 0x36, 0x94, 0xb8, 0x60, 0x89, 0xd0, 0xe5, 0xaa, 0x39, 0x69, 0x83, 0x39, 0x59, 0x83, 0x39,
+	0xa7, 0x59, 0x83, 0xd1, 0x14, 0x48, 0xb6, 0xc0, 0xc4, 0xb0, 0x6d, 0x99, 0x77, 0xe2, 0x25, 0xcc,
+	0x7e, 0x0b, 0xf3, 0xf9, 0x72, 0x91, 0x7f, 0x95, 0x9e, 0x40, 0x6d, 0x23, 0x0d, 0x72, 0xee, 0x8c,
+	0x1b, 0xee, 0x2c, 0xf8, 0x2b, 0x40, 0xce, 0x59, 0x07, 0x95, 0x5e, 0x85, 0x66, 0xa1, 0xfd, 0xcb,
+	0x80, 0xc5, 0x9b, 0xb5, 0x24, 0x6f, 0xa0, 0xca, 0xba, 0xdd, 0xc8, 0x63, 0x82, 0xb5, 0xba, 0x52,
+	0xc0, 0xdc, 0xac, 0x36, 0x77, 0xee, 0xbe, 0x04, 0xe7, 0xe9, 0x88, 0x75, 0x18, 0x8a, 0x64, 0x40,
+	0xf3, 0x79, 0xc8, 0x09, 0x54, 0xf4, 0x6b, 0xc1, 0xb6, 0x65, 0xa8, 0xa4, 0x8d, 0x7b, 0x24, 0xa5,
+	0x19, 0x27, 0x4d, 0x39, 0xca, 0x41, 0x0e, 0xa1, 0xe2, 0x87, 0x5c, 0xc8, 0x6b, 0xe1, 0x96, 0xa9,
+	0x12, 0x6e, 0x4c, 0x4f, 0xf8, 0x42, 0x43, 0x0f, 0xa2, 0x5e, 0x28, 0xe8, 0x88, 0x59, 0xdb, 0x87,
+	0xa5, 0xdb, 0xc6, 0xc9, 0x12, 0x98, 0x17, 0x38, 0xd0, 0xb5, 0x95, 0x9f, 0xb2, 0xde, 0x7d, 0xd6,
+	0xed, 0xa1, 0x2e, 0x6c, 0x1a, 0xec, 0x19, 0xbb, 0x85, 0xda, 0x63, 0x59, 0xc0, 0xbc, 0xc7, 0x3f,
+	0x61, 0xdb, 0x2d, 0xf8, 0x67, 0x82, 0x3f, 0xf2, 0x08, 0x4c, 0x2f, 0x69, 0x5b, 0x85, 0xfb, 0xbf,
+	0x3d, 0x89, 0x97, 0x3a, 0x9e, 0xe4, 0x2b, 0x9d, 0x12, 0x4d, 0x03, 0xfb, 0x14, 0x16, 0x6f, 0xbe,
+	0x74, 0x52, 0x87, 0x6a, 0xdb, 0xe7, 0x71, 0x97, 0x0d, 0x5e, 0xb1, 0x20, 0xeb, 0xa1, 0xfc, 0x92,
+	0x42, 0x20, 0xf7, 0x12, 0x3f, 0x96, 0x63, 0x53, 0xfb, 0xce, 0x2f, 0xd9, 0x97, 0xd9, 0xc4, 0x79,
+	0xe9, 0x73, 0x41, 0x76, 0xc7, 0x26, 0xce, 0xf2, 0x24, 0xd7, 0x12, 0x7b, 0x6b, 0xdc, 0x6d, 0x43,
+	0xc9, 0x17, 0x18, 0x70, 0xdd, 0x13, 0xb5, 0xe9, 0x57, 0x48, 0x53, 0x60, 0xf3, 0xa7, 0x01, 0x0b,
+	0x7a, 0xd8, 0x61, 0xd2, 0xf7, 0x3d, 0x24, 0x11, 0x14, 0x95, 0x8b, 0xd5, 0x69, 0x9a, 0xfa, 0x86,
+	0x6a, 0x33, 0xa6, 0xa9, 0x84, 0xd9, 0xeb, 0x9f, 0xbf, 0xff, 0xf8, 0x6a, 0xd4, 0xc9, 0x8a, 0xdb,
+	0x6f, 0xb8, 0xcc, 0x53, 0xf5, 0xe3, 0xee, 0x95, 0xfe, 0xba, 0x76, 0xd3, 0xdf, 0x0e, 0x27, 0x31,
+	0x98, 0x47, 0x28, 0xc8, 0xc4, 0x74, 0x47, 0x38, 0x94, 0x9b, 0x71, 0x18, 0xfb, 0xa1, 0x92, 0xda,
+	0x20, 0x6b, 0xb3, 0xa5, 0xdc, 0xab, 0x90, 0x05, 0x78, 0x4d, 0x06, 0x50, 0x7a, 0xc7, 0x84, 0x77,
+	0x4e, 0x26, 0x8e, 0x52, 0xb5, 0x35, 0xf3, 0x90, 0x0a, 0x71, 0x28, 0xff, 0x5d, 0xf6, 0x96, 0x52,
+	0x5e, 0x27, 0x0f, 0xa4, 0xf2, 0x27, 0xb9, 0x3e, 0x43, 0x7f, 0xbb, 0xf0, 0xac, 0xf8, 0xde, 0xe8,
+	0x37, 0x5a, 0x65, 0x35, 0x9a, 0x76, 0x7e, 0x0f, 0x00, 0x23, 0x3f, 0x11, 0x91, 0x7b, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -84,10 +84,19 @@ func (m *GetRequest) GetAccount() string {
 }
 
 type ListRequest struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	LabelSelector        string   `protobuf:"bytes,2,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
-	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Continue             string   `protobuf:"bytes,4,opt,name=continue,proto3" json:"continue,omitempty"`
+	Account       string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	LabelSelector string `protobuf:"bytes,2,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// Limit is the maximum number of items to return, all items are returned if 0.
+	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Continue is the token returned in the ListMeta of the previous page.
+	Continue string `protobuf:"bytes,4,opt,name=continue,proto3" json:"continue,omitempty"`
+	// FieldSelector filters items by their fields, e.g. spec.provider.name=team-a
+	FieldSelector string `protobuf:"bytes,5,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	// OrderBy is a comma separated list of fields to sort items by,
+	// fields prefixed with "-" are sorted in descending order, e.g. -metadata.creationTimestamp
+	OrderBy string `protobuf:"bytes,6,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	// Search filters items, whose name, display name or description contains the given text, ignoring case.
+	Search               string   `protobuf:"bytes,7,opt,name=search,proto3" json:"search,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -146,10 +155,34 @@ func (m *ListRequest) GetContinue() string {
 	return ""
 }
 
+func (m *ListRequest) GetFieldSelector() string {
+	if m != nil {
+		return m.FieldSelector
+	}
+	return ""
+}
+
+func (m *ListRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *ListRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
 type WatchRequest struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	LabelSelector        string   `protobuf:"bytes,2,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
-	ResourceVersion      string   `protobuf:"bytes,3,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	LabelSelector   string `protobuf:"bytes,2,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	ResourceVersion string `protobuf:"bytes,3,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	// AllowWatchBookmarks requests BOOKMARK events, that are sent periodically
+	// with the latest resourceVersion to resume the watch from.
+	AllowWatchBookmarks  bool     `protobuf:"varint,4,opt,name=allowWatchBookmarks,proto3" json:"allowWatchBookmarks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -201,10 +234,65 @@ func (m *WatchRequest) GetResourceVersion() string {
 	return ""
 }
 
+func (m *WatchRequest) GetAllowWatchBookmarks() bool {
+	if m != nil {
+		return m.AllowWatchBookmarks
+	}
+	return false
+}
+
+type DeleteRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f73548e33e655fe, []int{3}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "kubecarrier.api.v1.GetRequest")
 	proto.RegisterType((*ListRequest)(nil), "kubecarrier.api.v1.ListRequest")
 	proto.RegisterType((*WatchRequest)(nil), "kubecarrier.api.v1.WatchRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "kubecarrier.api.v1.DeleteRequest")
 }
 
 func init() {
@@ -212,19 +300,23 @@ func init() {
 }
 
 var fileDescriptor_7f73548e33e655fe = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x90, 0xbf, 0x4b, 0x04, 0x31,
-	0x10, 0x85, 0xc9, 0xdd, 0xfa, 0xe3, 0x46, 0x0f, 0x61, 0xb0, 0x08, 0x56, 0xc7, 0x62, 0xb1, 0xd5,
-	0xc2, 0x61, 0x67, 0x69, 0x63, 0x63, 0x15, 0x41, 0xc1, 0x2e, 0x1b, 0x06, 0x0c, 0xe6, 0x92, 0x73,
-	0x32, 0x39, 0xec, 0xfc, 0xd7, 0x85, 0xac, 0x2e, 0xae, 0xb5, 0x5d, 0xde, 0xc7, 0xf7, 0xc2, 0x63,
-	0x60, 0xcd, 0xf4, 0x5e, 0x28, 0x4b, 0xbf, 0xe7, 0x24, 0x09, 0xf1, 0xad, 0x0c, 0xe4, 0x2c, 0xb3,
-	0x27, 0xee, 0xed, 0xde, 0xf7, 0x87, 0x6d, 0x7b, 0x0b, 0x70, 0x4f, 0x62, 0x46, 0x0f, 0x11, 0x9a,
-	0x68, 0x77, 0xa4, 0xd5, 0x46, 0x75, 0x2b, 0x53, 0xdf, 0xa8, 0xe1, 0xc4, 0x3a, 0x97, 0x4a, 0x14,
-	0xbd, 0xa8, 0xf8, 0x27, 0xb6, 0x9f, 0x70, 0xf6, 0xe0, 0xf3, 0x54, 0xfe, 0x25, 0xaa, 0x99, 0x88,
-	0xd7, 0xb0, 0x0e, 0x76, 0xa0, 0xf0, 0x48, 0x81, 0x9c, 0x24, 0xfe, 0xfe, 0x68, 0x0e, 0xf1, 0x12,
-	0x8e, 0x82, 0xdf, 0x79, 0xd1, 0xcb, 0x8d, 0xea, 0x96, 0x66, 0x0c, 0x78, 0x05, 0xa7, 0x2e, 0x45,
-	0xf1, 0xb1, 0x90, 0x6e, 0x6a, 0x6d, 0xca, 0xed, 0x07, 0x9c, 0x3f, 0x5b, 0x71, 0xaf, 0xff, 0xb5,
-	0xa0, 0x83, 0x0b, 0xa6, 0x9c, 0x0a, 0x3b, 0x7a, 0x22, 0xce, 0x3e, 0xc5, 0xba, 0x65, 0x65, 0xfe,
-	0xe2, 0xbb, 0xe6, 0x65, 0x71, 0xd8, 0x0e, 0xc7, 0xf5, 0xae, 0x37, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x5a, 0xab, 0x95, 0x85, 0x68, 0x01, 0x00, 0x00,
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcf, 0x4a, 0xc4, 0x30,
+	0x10, 0xc6, 0xc9, 0xfe, 0xdf, 0xd1, 0x45, 0x88, 0x22, 0xc1, 0xd3, 0x52, 0x3c, 0xf4, 0x54, 0x5c,
+	0xbc, 0x09, 0x5e, 0x8a, 0xe0, 0xc5, 0x53, 0x05, 0x05, 0x6f, 0x69, 0x76, 0x64, 0x43, 0xd3, 0x66,
+	0x9d, 0xa4, 0x2b, 0xbe, 0x92, 0x2f, 0xe4, 0xeb, 0xc8, 0xa6, 0xb5, 0x58, 0xf1, 0x24, 0xde, 0xf2,
+	0xfb, 0x66, 0xe6, 0xcb, 0x7c, 0x24, 0xb0, 0x20, 0x7c, 0xa9, 0xd1, 0xf9, 0x64, 0x4b, 0xd6, 0x5b,
+	0xce, 0x8b, 0x3a, 0x47, 0x25, 0x89, 0x34, 0x52, 0x22, 0xb7, 0x3a, 0xd9, 0xad, 0xa2, 0x2b, 0x80,
+	0x5b, 0xf4, 0x59, 0xd3, 0xc7, 0x39, 0x8c, 0x2a, 0x59, 0xa2, 0x60, 0x4b, 0x16, 0xcf, 0xb3, 0x70,
+	0xe6, 0x02, 0xa6, 0x52, 0x29, 0x5b, 0x57, 0x5e, 0x0c, 0x82, 0xfc, 0x85, 0xd1, 0x07, 0x83, 0x83,
+	0x3b, 0xed, 0xba, 0xe9, 0x6f, 0x9d, 0xac, 0xd7, 0xc9, 0xcf, 0x61, 0x61, 0x64, 0x8e, 0xe6, 0x1e,
+	0x0d, 0x2a, 0x6f, 0xa9, 0x75, 0xea, 0x8b, 0xfc, 0x04, 0xc6, 0x46, 0x97, 0xda, 0x8b, 0xe1, 0x92,
+	0xc5, 0xc3, 0xac, 0x01, 0x7e, 0x06, 0x33, 0x65, 0x2b, 0xaf, 0xab, 0x1a, 0xc5, 0x28, 0x8c, 0x75,
+	0xbc, 0xf7, 0x7d, 0xd6, 0x68, 0xd6, 0x9d, 0xef, 0xb8, 0xf1, 0xed, 0x89, 0xfb, 0xbd, 0x2c, 0xad,
+	0x91, 0xd2, 0x37, 0x31, 0x69, 0xf6, 0x6a, 0x91, 0x9f, 0xc2, 0xc4, 0xa1, 0x24, 0xb5, 0x11, 0xd3,
+	0x50, 0x68, 0x29, 0x7a, 0x67, 0x70, 0xf8, 0x28, 0xbd, 0xda, 0xfc, 0x57, 0xb4, 0x18, 0x8e, 0x08,
+	0x9d, 0xad, 0x49, 0xe1, 0x03, 0x92, 0xd3, 0xb6, 0x0a, 0x21, 0xe7, 0xd9, 0x4f, 0x99, 0x5f, 0xc0,
+	0xb1, 0x34, 0xc6, 0xbe, 0x86, 0xeb, 0x53, 0x6b, 0x8b, 0x52, 0x52, 0xe1, 0x42, 0xf2, 0x59, 0xf6,
+	0x5b, 0x29, 0xba, 0x86, 0xc5, 0x0d, 0x1a, 0xf4, 0xf8, 0xa7, 0x57, 0x4c, 0x47, 0x4f, 0x83, 0xdd,
+	0x2a, 0x9f, 0x84, 0x2f, 0x72, 0xf9, 0x39, 0x00, 0x87, 0x56, 0xe1, 0xf5, 0x33, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,976 @@
+/*
+Copyright 2021 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: servicecluster.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ServiceCluster struct {
+	Metadata             *ObjectMeta           `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec                 *ServiceClusterSpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status               *ServiceClusterStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ServiceCluster) Reset()         { *m = ServiceCluster{} }
+func (m *ServiceCluster) String() string { return proto.CompactTextString(m) }
+func (*ServiceCluster) ProtoMessage()    {}
+func (*ServiceCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{0}
+}
+
+func (m *ServiceCluster) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceCluster.Unmarshal(m, b)
+}
+func (m *ServiceCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceCluster.Marshal(b, m, deterministic)
+}
+func (m *ServiceCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceCluster.Merge(m, src)
+}
+func (m *ServiceCluster) XXX_Size() int {
+	return xxx_messageInfo_ServiceCluster.Size(m)
+}
+func (m *ServiceCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceCluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceCluster proto.InternalMessageInfo
+
+func (m *ServiceCluster) GetMetadata() *ObjectMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ServiceCluster) GetSpec() *ServiceClusterSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *ServiceCluster) GetStatus() *ServiceClusterStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type ServiceClusterSpec struct {
+	Metadata *ServiceClusterMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// KubeconfigSecret specifies the Kubeconfig to use when connecting to the ServiceCluster.
+	KubeconfigSecret *ObjectReference `protobuf:"bytes,2,opt,name=kubeconfigSecret,proto3" json:"kubeconfigSecret,omitempty"`
+	// Connection specifies how KubeCarrier connects to the ServiceCluster, one of (Kubeconfig, Agent).
+	Connection           string   `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceClusterSpec) Reset()         { *m = ServiceClusterSpec{} }
+func (m *ServiceClusterSpec) String() string { return proto.CompactTextString(m) }
+func (*ServiceClusterSpec) ProtoMessage()    {}
+func (*ServiceClusterSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{1}
+}
+
+func (m *ServiceClusterSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceClusterSpec.Unmarshal(m, b)
+}
+func (m *ServiceClusterSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceClusterSpec.Marshal(b, m, deterministic)
+}
+func (m *ServiceClusterSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceClusterSpec.Merge(m, src)
+}
+func (m *ServiceClusterSpec) XXX_Size() int {
+	return xxx_messageInfo_ServiceClusterSpec.Size(m)
+}
+func (m *ServiceClusterSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceClusterSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceClusterSpec proto.InternalMessageInfo
+
+func (m *ServiceClusterSpec) GetMetadata() *ServiceClusterMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ServiceClusterSpec) GetKubeconfigSecret() *ObjectReference {
+	if m != nil {
+		return m.KubeconfigSecret
+	}
+	return nil
+}
+
+func (m *ServiceClusterSpec) GetConnection() string {
+	if m != nil {
+		return m.Connection
+	}
+	return ""
+}
+
+type ServiceClusterMetadata struct {
+	DisplayName          string   `protobuf:"bytes,1,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceClusterMetadata) Reset()         { *m = ServiceClusterMetadata{} }
+func (m *ServiceClusterMetadata) String() string { return proto.CompactTextString(m) }
+func (*ServiceClusterMetadata) ProtoMessage()    {}
+func (*ServiceClusterMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{2}
+}
+
+func (m *ServiceClusterMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceClusterMetadata.Unmarshal(m, b)
+}
+func (m *ServiceClusterMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceClusterMetadata.Marshal(b, m, deterministic)
+}
+func (m *ServiceClusterMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceClusterMetadata.Merge(m, src)
+}
+func (m *ServiceClusterMetadata) XXX_Size() int {
+	return xxx_messageInfo_ServiceClusterMetadata.Size(m)
+}
+func (m *ServiceClusterMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceClusterMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceClusterMetadata proto.InternalMessageInfo
+
+func (m *ServiceClusterMetadata) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *ServiceClusterMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type ServiceClusterStatus struct {
+	Phase                string                     `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Conditions           []*ServiceClusterCondition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	ObservedGeneration   int64                      `protobuf:"varint,3,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	KubernetesVersion    *KubernetesVersion         `protobuf:"bytes,4,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	AgentSecret          *ObjectReference           `protobuf:"bytes,5,opt,name=agentSecret,proto3" json:"agentSecret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ServiceClusterStatus) Reset()         { *m = ServiceClusterStatus{} }
+func (m *ServiceClusterStatus) String() string { return proto.CompactTextString(m) }
+func (*ServiceClusterStatus) ProtoMessage()    {}
+func (*ServiceClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{3}
+}
+
+func (m *ServiceClusterStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceClusterStatus.Unmarshal(m, b)
+}
+func (m *ServiceClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceClusterStatus.Marshal(b, m, deterministic)
+}
+func (m *ServiceClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceClusterStatus.Merge(m, src)
+}
+func (m *ServiceClusterStatus) XXX_Size() int {
+	return xxx_messageInfo_ServiceClusterStatus.Size(m)
+}
+func (m *ServiceClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceClusterStatus proto.InternalMessageInfo
+
+func (m *ServiceClusterStatus) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *ServiceClusterStatus) GetConditions() []*ServiceClusterCondition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *ServiceClusterStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func (m *ServiceClusterStatus) GetKubernetesVersion() *KubernetesVersion {
+	if m != nil {
+		return m.KubernetesVersion
+	}
+	return nil
+}
+
+func (m *ServiceClusterStatus) GetAgentSecret() *ObjectReference {
+	if m != nil {
+		return m.AgentSecret
+	}
+	return nil
+}
+
+type ServiceClusterCondition struct {
+	Type                 string               `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status               string               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	LastTransitionTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=lastTransitionTime,proto3" json:"lastTransitionTime,omitempty"`
+	Reason               string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string               `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	LastHeartbeatTime    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastHeartbeatTime,proto3" json:"lastHeartbeatTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ServiceClusterCondition) Reset()         { *m = ServiceClusterCondition{} }
+func (m *ServiceClusterCondition) String() string { return proto.CompactTextString(m) }
+func (*ServiceClusterCondition) ProtoMessage()    {}
+func (*ServiceClusterCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{4}
+}
+
+func (m *ServiceClusterCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceClusterCondition.Unmarshal(m, b)
+}
+func (m *ServiceClusterCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceClusterCondition.Marshal(b, m, deterministic)
+}
+func (m *ServiceClusterCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceClusterCondition.Merge(m, src)
+}
+func (m *ServiceClusterCondition) XXX_Size() int {
+	return xxx_messageInfo_ServiceClusterCondition.Size(m)
+}
+func (m *ServiceClusterCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceClusterCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceClusterCondition proto.InternalMessageInfo
+
+func (m *ServiceClusterCondition) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ServiceClusterCondition) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ServiceClusterCondition) GetLastTransitionTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastTransitionTime
+	}
+	return nil
+}
+
+func (m *ServiceClusterCondition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ServiceClusterCondition) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ServiceClusterCondition) GetLastHeartbeatTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastHeartbeatTime
+	}
+	return nil
+}
+
+type KubernetesVersion struct {
+	Major                string   `protobuf:"bytes,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor                string   `protobuf:"bytes,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	GitVersion           string   `protobuf:"bytes,3,opt,name=gitVersion,proto3" json:"gitVersion,omitempty"`
+	GitCommit            string   `protobuf:"bytes,4,opt,name=gitCommit,proto3" json:"gitCommit,omitempty"`
+	GitTreeState         string   `protobuf:"bytes,5,opt,name=gitTreeState,proto3" json:"gitTreeState,omitempty"`
+	BuildDate            string   `protobuf:"bytes,6,opt,name=buildDate,proto3" json:"buildDate,omitempty"`
+	GoVersion            string   `protobuf:"bytes,7,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
+	Compiler             string   `protobuf:"bytes,8,opt,name=compiler,proto3" json:"compiler,omitempty"`
+	Platform             string   `protobuf:"bytes,9,opt,name=platform,proto3" json:"platform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KubernetesVersion) Reset()         { *m = KubernetesVersion{} }
+func (m *KubernetesVersion) String() string { return proto.CompactTextString(m) }
+func (*KubernetesVersion) ProtoMessage()    {}
+func (*KubernetesVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{5}
+}
+
+func (m *KubernetesVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KubernetesVersion.Unmarshal(m, b)
+}
+func (m *KubernetesVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KubernetesVersion.Marshal(b, m, deterministic)
+}
+func (m *KubernetesVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubernetesVersion.Merge(m, src)
+}
+func (m *KubernetesVersion) XXX_Size() int {
+	return xxx_messageInfo_KubernetesVersion.Size(m)
+}
+func (m *KubernetesVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubernetesVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubernetesVersion proto.InternalMessageInfo
+
+func (m *KubernetesVersion) GetMajor() string {
+	if m != nil {
+		return m.Major
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetMinor() string {
+	if m != nil {
+		return m.Minor
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetGitVersion() string {
+	if m != nil {
+		return m.GitVersion
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetGitCommit() string {
+	if m != nil {
+		return m.GitCommit
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetGitTreeState() string {
+	if m != nil {
+		return m.GitTreeState
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetBuildDate() string {
+	if m != nil {
+		return m.BuildDate
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetCompiler() string {
+	if m != nil {
+		return m.Compiler
+	}
+	return ""
+}
+
+func (m *KubernetesVersion) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+type ServiceClusterList struct {
+	Metadata             *ListMeta         `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items                []*ServiceCluster `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ServiceClusterList) Reset()         { *m = ServiceClusterList{} }
+func (m *ServiceClusterList) String() string { return proto.CompactTextString(m) }
+func (*ServiceClusterList) ProtoMessage()    {}
+func (*ServiceClusterList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{6}
+}
+
+func (m *ServiceClusterList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceClusterList.Unmarshal(m, b)
+}
+func (m *ServiceClusterList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceClusterList.Marshal(b, m, deterministic)
+}
+func (m *ServiceClusterList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceClusterList.Merge(m, src)
+}
+func (m *ServiceClusterList) XXX_Size() int {
+	return xxx_messageInfo_ServiceClusterList.Size(m)
+}
+func (m *ServiceClusterList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceClusterList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceClusterList proto.InternalMessageInfo
+
+func (m *ServiceClusterList) GetMetadata() *ListMeta {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ServiceClusterList) GetItems() []*ServiceCluster {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ServiceClusterCreateRequest struct {
+	Spec *ServiceCluster `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceClusterCreateRequest) Reset()         { *m = ServiceClusterCreateRequest{} }
+func (m *ServiceClusterCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*ServiceClusterCreateRequest) ProtoMessage()    {}
+func (*ServiceClusterCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{7}
+}
+
+func (m *ServiceClusterCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceClusterCreateRequest.Unmarshal(m, b)
+}
+func (m *ServiceClusterCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceClusterCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *ServiceClusterCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceClusterCreateRequest.Merge(m, src)
+}
+func (m *ServiceClusterCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_ServiceClusterCreateRequest.Size(m)
+}
+func (m *ServiceClusterCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceClusterCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceClusterCreateRequest proto.InternalMessageInfo
+
+func (m *ServiceClusterCreateRequest) GetSpec() *ServiceCluster {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *ServiceClusterCreateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type ServiceClusterUpdateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Spec - the full service cluster, metadata.resourceVersion is required for optimistic locking
+	Spec *ServiceCluster `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Account indicate namespace of the provider account
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceClusterUpdateRequest) Reset()         { *m = ServiceClusterUpdateRequest{} }
+func (m *ServiceClusterUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*ServiceClusterUpdateRequest) ProtoMessage()    {}
+func (*ServiceClusterUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee613c659a5c12e4, []int{8}
+}
+
+func (m *ServiceClusterUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceClusterUpdateRequest.Unmarshal(m, b)
+}
+func (m *ServiceClusterUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceClusterUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *ServiceClusterUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceClusterUpdateRequest.Merge(m, src)
+}
+func (m *ServiceClusterUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_ServiceClusterUpdateRequest.Size(m)
+}
+func (m *ServiceClusterUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceClusterUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceClusterUpdateRequest proto.InternalMessageInfo
+
+func (m *ServiceClusterUpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceClusterUpdateRequest) GetSpec() *ServiceCluster {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *ServiceClusterUpdateRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ServiceCluster)(nil), "kubecarrier.api.v1.ServiceCluster")
+	proto.RegisterType((*ServiceClusterSpec)(nil), "kubecarrier.api.v1.ServiceClusterSpec")
+	proto.RegisterType((*ServiceClusterMetadata)(nil), "kubecarrier.api.v1.ServiceClusterMetadata")
+	proto.RegisterType((*ServiceClusterStatus)(nil), "kubecarrier.api.v1.ServiceClusterStatus")
+	proto.RegisterType((*ServiceClusterCondition)(nil), "kubecarrier.api.v1.ServiceClusterCondition")
+	proto.RegisterType((*KubernetesVersion)(nil), "kubecarrier.api.v1.KubernetesVersion")
+	proto.RegisterType((*ServiceClusterList)(nil), "kubecarrier.api.v1.ServiceClusterList")
+	proto.RegisterType((*ServiceClusterCreateRequest)(nil), "kubecarrier.api.v1.ServiceClusterCreateRequest")
+	proto.RegisterType((*ServiceClusterUpdateRequest)(nil), "kubecarrier.api.v1.ServiceClusterUpdateRequest")
+}
+
+func init() {
+	proto.RegisterFile("servicecluster.proto", fileDescriptor_ee613c659a5c12e4)
+}
+
+var fileDescriptor_ee613c659a5c12e4 = []byte{
+	// 952 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5b, 0x6f, 0x1b, 0x45,
+	0x14, 0xd6, 0x3a, 0x8e, 0x13, 0x1f, 0x03, 0x22, 0x47, 0x21, 0x58, 0x6e, 0x94, 0x86, 0x45, 0x54,
+	0x51, 0x81, 0x5d, 0x12, 0x28, 0xaa, 0xc2, 0x0b, 0x22, 0x0d, 0xa9, 0x28, 0xa5, 0xd2, 0x26, 0x80,
+	0x84, 0x78, 0x19, 0xaf, 0x4f, 0xdc, 0x29, 0xde, 0x0b, 0x33, 0x63, 0xa3, 0x50, 0x55, 0xdc, 0x5e,
+	0x78, 0xe1, 0xa9, 0xe2, 0x85, 0xff, 0xc3, 0x0b, 0x12, 0xbc, 0xf0, 0x17, 0xf8, 0x21, 0x68, 0x66,
+	0x67, 0x7c, 0xdb, 0x8d, 0xb2, 0xed, 0x9b, 0xcf, 0xe5, 0x3b, 0xe7, 0x3b, 0xb7, 0x59, 0xc3, 0xa6,
+	0x24, 0x31, 0xe1, 0x31, 0xc5, 0xa3, 0xb1, 0x54, 0x24, 0x82, 0x5c, 0x64, 0x2a, 0x43, 0xfc, 0x66,
+	0xdc, 0xa7, 0x98, 0x09, 0xc1, 0x49, 0x04, 0x2c, 0xe7, 0xc1, 0x64, 0xbf, 0xb7, 0x3d, 0xcc, 0xb2,
+	0xe1, 0x88, 0x42, 0x96, 0xf3, 0x90, 0xa5, 0x69, 0xa6, 0x98, 0xe2, 0x59, 0x2a, 0x0b, 0x44, 0xef,
+	0x9a, 0xb5, 0x1a, 0xa9, 0x3f, 0x3e, 0x0f, 0x29, 0xc9, 0xd5, 0x85, 0x35, 0x5e, 0x5f, 0x36, 0x2a,
+	0x9e, 0x90, 0x54, 0x2c, 0xc9, 0xad, 0x43, 0x47, 0x5d, 0xe4, 0xe4, 0x42, 0x41, 0x42, 0x8a, 0x39,
+	0x03, 0x4d, 0x28, 0x55, 0x56, 0x78, 0x51, 0xd0, 0xb7, 0x63, 0x92, 0x56, 0xf4, 0xff, 0xf2, 0xe0,
+	0xa5, 0xd3, 0x82, 0xfd, 0x51, 0xc1, 0x1e, 0x0f, 0x61, 0x5d, 0x83, 0x07, 0x4c, 0xb1, 0xae, 0xb7,
+	0xeb, 0xed, 0x75, 0x0e, 0x76, 0x82, 0x72, 0x29, 0xc1, 0x83, 0xfe, 0x23, 0x8a, 0xd5, 0x7d, 0x52,
+	0x2c, 0x9a, 0xfa, 0xe3, 0x21, 0x34, 0x65, 0x4e, 0x71, 0xb7, 0x61, 0x70, 0x37, 0xaa, 0x70, 0x8b,
+	0xd9, 0x4e, 0x73, 0x8a, 0x23, 0x83, 0xc1, 0x0f, 0xa1, 0x25, 0x15, 0x53, 0x63, 0xd9, 0x5d, 0x31,
+	0xe8, 0xbd, 0x1a, 0x68, 0xe3, 0x1f, 0x59, 0x9c, 0xff, 0xb7, 0x07, 0x58, 0x0e, 0x8f, 0x1f, 0x97,
+	0x0a, 0xba, 0x79, 0x75, 0xe8, 0xfb, 0x16, 0x31, 0x57, 0xdc, 0x03, 0x78, 0xd9, 0xc0, 0xb2, 0xf4,
+	0x9c, 0x0f, 0x4f, 0x29, 0x16, 0xa4, 0x6c, 0xa1, 0xaf, 0x5f, 0xde, 0xa0, 0x88, 0xce, 0x49, 0x50,
+	0x1a, 0x53, 0x54, 0x02, 0xe3, 0x0e, 0x40, 0x9c, 0xa5, 0x29, 0xc5, 0x7a, 0x09, 0x4c, 0xd5, 0xed,
+	0x68, 0x4e, 0xe3, 0x7f, 0x0d, 0x5b, 0xd5, 0xa4, 0x70, 0x17, 0x3a, 0x03, 0x2e, 0xf3, 0x11, 0xbb,
+	0xf8, 0x8c, 0x25, 0x64, 0xaa, 0x6a, 0x47, 0xf3, 0x2a, 0xe3, 0x41, 0x32, 0x16, 0x3c, 0x37, 0xc1,
+	0x1b, 0xd6, 0x63, 0xa6, 0xf2, 0xff, 0x6c, 0xc0, 0x66, 0x55, 0x3b, 0x71, 0x13, 0x56, 0xf3, 0x87,
+	0x4c, 0xba, 0xb0, 0x85, 0x80, 0xf7, 0x0c, 0xd9, 0x01, 0x37, 0x0b, 0xdb, 0x6d, 0xec, 0xae, 0xec,
+	0x75, 0x0e, 0xde, 0xbc, 0xba, 0x8f, 0x47, 0x0e, 0x13, 0xcd, 0xc1, 0x31, 0x00, 0xcc, 0xfa, 0xfa,
+	0x6a, 0x68, 0x70, 0x42, 0x29, 0x09, 0x36, 0xed, 0xc0, 0x4a, 0x54, 0x61, 0xc1, 0x53, 0xd8, 0xd0,
+	0x99, 0x44, 0x4a, 0x8a, 0xe4, 0x17, 0x24, 0xa4, 0x76, 0x6f, 0x9a, 0xde, 0xbf, 0x51, 0xc5, 0xe1,
+	0xde, 0xb2, 0x73, 0x54, 0xc6, 0xe3, 0x31, 0x74, 0xd8, 0x90, 0x52, 0x65, 0x47, 0xb9, 0x5a, 0x7f,
+	0x94, 0xf3, 0x38, 0xff, 0xb7, 0x06, 0xbc, 0x7a, 0x49, 0xcd, 0x88, 0xd0, 0xd4, 0x57, 0x69, 0x3b,
+	0x69, 0x7e, 0xe3, 0xd6, 0x74, 0xcf, 0x8b, 0xa1, 0x58, 0x09, 0x3f, 0x01, 0x1c, 0x31, 0xa9, 0xce,
+	0x04, 0x4b, 0xa5, 0x41, 0x9f, 0xf1, 0x84, 0xec, 0x2d, 0xf4, 0x82, 0xe2, 0xfa, 0x03, 0x77, 0xfd,
+	0xc1, 0x99, 0xbb, 0xfe, 0xa8, 0x02, 0xa5, 0x73, 0x08, 0x62, 0xd2, 0x36, 0xa9, 0x1d, 0x59, 0x09,
+	0xbb, 0xb0, 0x96, 0x90, 0x94, 0x6c, 0x48, 0xa6, 0xdc, 0x76, 0xe4, 0x44, 0xbc, 0x0b, 0x1b, 0x3a,
+	0xce, 0x5d, 0x62, 0x42, 0xf5, 0x89, 0x29, 0x93, 0xbc, 0x75, 0x65, 0xf2, 0x32, 0xc8, 0xff, 0xbd,
+	0x01, 0x1b, 0xa5, 0xfe, 0xeb, 0xa5, 0x4a, 0xd8, 0xa3, 0x4c, 0xb8, 0xa5, 0x32, 0x82, 0xd1, 0xf2,
+	0x34, 0x13, 0xb6, 0x15, 0x85, 0xa0, 0xef, 0x62, 0xc8, 0x95, 0x1b, 0xb3, 0xbd, 0x8b, 0x99, 0x06,
+	0xb7, 0xa1, 0x3d, 0xe4, 0xea, 0x28, 0x4b, 0x12, 0xae, 0x6c, 0x81, 0x33, 0x05, 0xfa, 0xf0, 0xc2,
+	0x90, 0xab, 0x33, 0x41, 0xa4, 0xf7, 0xd9, 0x15, 0xba, 0xa0, 0xd3, 0x11, 0xfa, 0x63, 0x3e, 0x1a,
+	0xdc, 0x61, 0xaa, 0xa8, 0xb2, 0x1d, 0xcd, 0x14, 0x26, 0x7e, 0xe6, 0xd2, 0xaf, 0xd9, 0xf8, 0x4e,
+	0x81, 0x3d, 0x58, 0x8f, 0xb3, 0x24, 0xe7, 0x23, 0x12, 0xdd, 0x75, 0x63, 0x9c, 0xca, 0xda, 0x96,
+	0x8f, 0x98, 0x3a, 0xcf, 0x44, 0xd2, 0x6d, 0x17, 0x36, 0x27, 0xfb, 0xbf, 0x96, 0x5e, 0xa7, 0x4f,
+	0xb9, 0x54, 0x78, 0xbb, 0xf4, 0x3a, 0x6d, 0x57, 0xad, 0xa0, 0xf6, 0x5d, 0x7a, 0x6c, 0x6f, 0xc3,
+	0x2a, 0x57, 0x94, 0xb8, 0x63, 0xf4, 0xaf, 0x3e, 0xc6, 0xa8, 0x00, 0xf8, 0x19, 0x5c, 0x5b, 0xda,
+	0x58, 0x41, 0x4c, 0x51, 0x54, 0x7c, 0x1a, 0xf0, 0x7d, 0xfb, 0x8a, 0x17, 0x74, 0xea, 0xc4, 0x2d,
+	0x5e, 0xf0, 0x2e, 0xac, 0xb1, 0x38, 0xce, 0xc6, 0xa9, 0xb2, 0xf3, 0x74, 0xa2, 0xff, 0x8b, 0xb7,
+	0x9c, 0xf1, 0xf3, 0x7c, 0x30, 0x97, 0x11, 0xa1, 0x99, 0xce, 0x1e, 0x32, 0xf3, 0x7b, 0xca, 0xa2,
+	0xf1, 0xfc, 0x2c, 0x56, 0x16, 0x58, 0x1c, 0xfc, 0xd3, 0x82, 0x57, 0x96, 0x5e, 0xbc, 0x42, 0xc2,
+	0x1f, 0xa0, 0x69, 0x86, 0x71, 0xfd, 0xb2, 0xd6, 0x5b, 0xa2, 0xbd, 0x1a, 0x9f, 0x34, 0xed, 0xee,
+	0x07, 0x3f, 0xff, 0xfb, 0xdf, 0xd3, 0xc6, 0x1e, 0xde, 0x08, 0x27, 0xfb, 0xa1, 0xcd, 0x2f, 0xc3,
+	0xc7, 0xf6, 0xd7, 0x93, 0x70, 0xf1, 0x1f, 0x83, 0xc4, 0x1f, 0x3d, 0x58, 0x39, 0xd1, 0x9f, 0x84,
+	0xaa, 0xf8, 0x27, 0x34, 0xcd, 0x5f, 0xa3, 0x0d, 0xfe, 0x2d, 0x93, 0x3b, 0xc4, 0xb7, 0xeb, 0xe5,
+	0x0e, 0x1f, 0xeb, 0x76, 0x3f, 0xc1, 0xa7, 0x1e, 0xb4, 0x8a, 0x3d, 0xc0, 0xb0, 0xc6, 0xbb, 0x3e,
+	0xbf, 0x31, 0xb5, 0x68, 0xbd, 0x67, 0x68, 0x05, 0x7e, 0xcd, 0x96, 0x1c, 0x16, 0xd3, 0xfc, 0xc3,
+	0x83, 0x56, 0xb1, 0x2b, 0x75, 0x58, 0x2d, 0x6c, 0x55, 0x2d, 0x56, 0x1f, 0x18, 0x56, 0xb7, 0x7a,
+	0xcf, 0xd6, 0x2c, 0x4b, 0xee, 0x7b, 0x68, 0xdd, 0xa1, 0x11, 0x29, 0xc2, 0xd7, 0xaa, 0x52, 0x15,
+	0x36, 0xc7, 0x66, 0xab, 0xf4, 0x8c, 0x1e, 0xeb, 0xbf, 0x77, 0x6e, 0x5c, 0x37, 0x9f, 0x71, 0x5c,
+	0x3f, 0x79, 0xb0, 0xfa, 0x25, 0x53, 0xf1, 0x43, 0xdc, 0xad, 0xca, 0x6d, 0x4c, 0x2e, 0xf5, 0xce,
+	0xa5, 0x1e, 0xc7, 0x13, 0x4a, 0x95, 0x1b, 0x0d, 0xbe, 0xa5, 0x29, 0x7c, 0xa7, 0xf5, 0x35, 0x88,
+	0xbc, 0xe3, 0x7d, 0xd4, 0xfc, 0xaa, 0x31, 0xd9, 0xef, 0xb7, 0x4c, 0x41, 0xef, 0xfe, 0x3f, 0x00,
+	0xd1, 0x69, 0xe5, 0x78, 0x07, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ServiceClusterServiceClient is the client API for ServiceClusterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClusterServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ServiceClusterList, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ServiceCluster, error)
+	Create(ctx context.Context, in *ServiceClusterCreateRequest, opts ...grpc.CallOption) (*ServiceCluster, error)
+	Update(ctx context.Context, in *ServiceClusterUpdateRequest, opts ...grpc.CallOption) (*ServiceCluster, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ServiceClusterService_WatchClient, error)
+}
+
+type serviceClusterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClusterServiceClient(cc grpc.ClientConnInterface) ServiceClusterServiceClient {
+	return &serviceClusterServiceClient{cc}
+}
+
+func (c *serviceClusterServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ServiceClusterList, error) {
+	out := new(ServiceClusterList)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.ServiceClusterService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClusterServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ServiceCluster, error) {
+	out := new(ServiceCluster)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.ServiceClusterService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClusterServiceClient) Create(ctx context.Context, in *ServiceClusterCreateRequest, opts ...grpc.CallOption) (*ServiceCluster, error) {
+	out := new(ServiceCluster)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.ServiceClusterService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClusterServiceClient) Update(ctx context.Context, in *ServiceClusterUpdateRequest, opts ...grpc.CallOption) (*ServiceCluster, error) {
+	out := new(ServiceCluster)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.ServiceClusterService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClusterServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/kubecarrier.api.v1.ServiceClusterService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClusterServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ServiceClusterService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ServiceClusterService_serviceDesc.Streams[0], "/kubecarrier.api.v1.ServiceClusterService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceClusterServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ServiceClusterService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type serviceClusterServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceClusterServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServiceClusterServiceServer is the server API for ServiceClusterService service.
+type ServiceClusterServiceServer interface {
+	List(context.Context, *ListRequest) (*ServiceClusterList, error)
+	Get(context.Context, *GetRequest) (*ServiceCluster, error)
+	Create(context.Context, *ServiceClusterCreateRequest) (*ServiceCluster, error)
+	Update(context.Context, *ServiceClusterUpdateRequest) (*ServiceCluster, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	Watch(*WatchRequest, ServiceClusterService_WatchServer) error
+}
+
+// UnimplementedServiceClusterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceClusterServiceServer struct {
+}
+
+func (*UnimplementedServiceClusterServiceServer) List(ctx context.Context, req *ListRequest) (*ServiceClusterList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedServiceClusterServiceServer) Get(ctx context.Context, req *GetRequest) (*ServiceCluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedServiceClusterServiceServer) Create(ctx context.Context, req *ServiceClusterCreateRequest) (*ServiceCluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedServiceClusterServiceServer) Update(ctx context.Context, req *ServiceClusterUpdateRequest) (*ServiceCluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedServiceClusterServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedServiceClusterServiceServer) Watch(req *WatchRequest, srv ServiceClusterService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterServiceClusterServiceServer(s *grpc.Server, srv ServiceClusterServiceServer) {
+	s.RegisterService(&_ServiceClusterService_serviceDesc, srv)
+}
+
+func _ServiceClusterService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceClusterServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.ServiceClusterService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceClusterServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceClusterService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceClusterServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.ServiceClusterService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceClusterServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceClusterService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceClusterCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceClusterServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.ServiceClusterService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceClusterServiceServer).Create(ctx, req.(*ServiceClusterCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceClusterService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceClusterUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceClusterServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.ServiceClusterService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceClusterServiceServer).Update(ctx, req.(*ServiceClusterUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceClusterService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceClusterServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubecarrier.api.v1.ServiceClusterService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceClusterServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceClusterService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceClusterServiceServer).Watch(m, &serviceClusterServiceWatchServer{stream})
+}
+
+type ServiceClusterService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type serviceClusterServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceClusterServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ServiceClusterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubecarrier.api.v1.ServiceClusterService",
+	HandlerType: (*ServiceClusterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ServiceClusterService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ServiceClusterService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ServiceClusterService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ServiceClusterService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ServiceClusterService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ServiceClusterService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "servicecluster.proto",
+}
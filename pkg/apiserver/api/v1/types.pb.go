@@ -23,6 +23,7 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -299,12 +300,263 @@ func (m *ConditionStatus) GetStatus() string {
 	return ""
 }
 
+type Condition struct {
+	Type                 string               `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status               string               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	LastTransitionTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=lastTransitionTime,proto3" json:"lastTransitionTime,omitempty"`
+	Reason               string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string               `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+func (*Condition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{5}
+}
+
+func (m *Condition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Condition.Unmarshal(m, b)
+}
+func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Condition.Marshal(b, m, deterministic)
+}
+func (m *Condition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Condition.Merge(m, src)
+}
+func (m *Condition) XXX_Size() int {
+	return xxx_messageInfo_Condition.Size(m)
+}
+func (m *Condition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Condition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Condition proto.InternalMessageInfo
+
+func (m *Condition) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Condition) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Condition) GetLastTransitionTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastTransitionTime
+	}
+	return nil
+}
+
+func (m *Condition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Condition) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type CommonMetadata struct {
+	DisplayName          string   `protobuf:"bytes,1,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ShortDescription     string   `protobuf:"bytes,3,opt,name=shortDescription,proto3" json:"shortDescription,omitempty"`
+	Logo                 *Image   `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"`
+	Icon                 *Image   `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommonMetadata) Reset()         { *m = CommonMetadata{} }
+func (m *CommonMetadata) String() string { return proto.CompactTextString(m) }
+func (*CommonMetadata) ProtoMessage()    {}
+func (*CommonMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{6}
+}
+
+func (m *CommonMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommonMetadata.Unmarshal(m, b)
+}
+func (m *CommonMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommonMetadata.Marshal(b, m, deterministic)
+}
+func (m *CommonMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommonMetadata.Merge(m, src)
+}
+func (m *CommonMetadata) XXX_Size() int {
+	return xxx_messageInfo_CommonMetadata.Size(m)
+}
+func (m *CommonMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommonMetadata proto.InternalMessageInfo
+
+func (m *CommonMetadata) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *CommonMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CommonMetadata) GetShortDescription() string {
+	if m != nil {
+		return m.ShortDescription
+	}
+	return ""
+}
+
+func (m *CommonMetadata) GetLogo() *Image {
+	if m != nil {
+		return m.Logo
+	}
+	return nil
+}
+
+func (m *CommonMetadata) GetIcon() *Image {
+	if m != nil {
+		return m.Icon
+	}
+	return nil
+}
+
+type LabelSelector struct {
+	MatchLabels          map[string]string           `protobuf:"bytes,1,rep,name=matchLabels,proto3" json:"matchLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MatchExpressions     []*LabelSelectorRequirement `protobuf:"bytes,2,rep,name=matchExpressions,proto3" json:"matchExpressions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *LabelSelector) Reset()         { *m = LabelSelector{} }
+func (m *LabelSelector) String() string { return proto.CompactTextString(m) }
+func (*LabelSelector) ProtoMessage()    {}
+func (*LabelSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{7}
+}
+
+func (m *LabelSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelSelector.Unmarshal(m, b)
+}
+func (m *LabelSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelSelector.Marshal(b, m, deterministic)
+}
+func (m *LabelSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelSelector.Merge(m, src)
+}
+func (m *LabelSelector) XXX_Size() int {
+	return xxx_messageInfo_LabelSelector.Size(m)
+}
+func (m *LabelSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelSelector proto.InternalMessageInfo
+
+func (m *LabelSelector) GetMatchLabels() map[string]string {
+	if m != nil {
+		return m.MatchLabels
+	}
+	return nil
+}
+
+func (m *LabelSelector) GetMatchExpressions() []*LabelSelectorRequirement {
+	if m != nil {
+		return m.MatchExpressions
+	}
+	return nil
+}
+
+type LabelSelectorRequirement struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// operator represents a key's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists and DoesNotExist.
+	Operator             string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Values               []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelSelectorRequirement) Reset()         { *m = LabelSelectorRequirement{} }
+func (m *LabelSelectorRequirement) String() string { return proto.CompactTextString(m) }
+func (*LabelSelectorRequirement) ProtoMessage()    {}
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{8}
+}
+
+func (m *LabelSelectorRequirement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelSelectorRequirement.Unmarshal(m, b)
+}
+func (m *LabelSelectorRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelSelectorRequirement.Marshal(b, m, deterministic)
+}
+func (m *LabelSelectorRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelSelectorRequirement.Merge(m, src)
+}
+func (m *LabelSelectorRequirement) XXX_Size() int {
+	return xxx_messageInfo_LabelSelectorRequirement.Size(m)
+}
+func (m *LabelSelectorRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelSelectorRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelSelectorRequirement proto.InternalMessageInfo
+
+func (m *LabelSelectorRequirement) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LabelSelectorRequirement) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *LabelSelectorRequirement) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ObjectReference)(nil), "kubecarrier.api.v1.ObjectReference")
 	proto.RegisterType((*CRDInformation)(nil), "kubecarrier.api.v1.CRDInformation")
 	proto.RegisterType((*CRDVersion)(nil), "kubecarrier.api.v1.CRDVersion")
 	proto.RegisterType((*Image)(nil), "kubecarrier.api.v1.Image")
 	proto.RegisterType((*ConditionStatus)(nil), "kubecarrier.api.v1.ConditionStatus")
+	proto.RegisterType((*Condition)(nil), "kubecarrier.api.v1.Condition")
+	proto.RegisterType((*CommonMetadata)(nil), "kubecarrier.api.v1.CommonMetadata")
+	proto.RegisterType((*LabelSelector)(nil), "kubecarrier.api.v1.LabelSelector")
+	proto.RegisterMapType((map[string]string)(nil), "kubecarrier.api.v1.LabelSelector.MatchLabelsEntry")
+	proto.RegisterType((*LabelSelectorRequirement)(nil), "kubecarrier.api.v1.LabelSelectorRequirement")
 }
 
 func init() {
@@ -312,25 +564,44 @@ func init() {
 }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x4d, 0x4b, 0xc3, 0x40,
-	0x10, 0x25, 0xfd, 0x88, 0xed, 0x54, 0x2c, 0xec, 0xa1, 0x2c, 0x22, 0x52, 0x22, 0x42, 0xbd, 0x04,
-	0x5a, 0x4f, 0xea, 0xcd, 0x16, 0xa4, 0x27, 0x61, 0x15, 0x0f, 0xde, 0xa6, 0xc9, 0xb4, 0xae, 0x6d,
-	0x76, 0x97, 0xcd, 0xa6, 0xd0, 0x1f, 0xec, 0xff, 0x90, 0x6c, 0xd3, 0x16, 0x34, 0xb7, 0xf7, 0x1e,
-	0x6f, 0xde, 0xec, 0xdb, 0x81, 0x9e, 0xdb, 0x19, 0xca, 0x63, 0x63, 0xb5, 0xd3, 0x8c, 0xad, 0x8b,
-	0x05, 0x25, 0x68, 0xad, 0x24, 0x1b, 0xa3, 0x91, 0xf1, 0x76, 0x1c, 0xdd, 0x42, 0xff, 0x75, 0xf1,
-	0x4d, 0x89, 0x13, 0xb4, 0x24, 0x4b, 0x2a, 0x21, 0xc6, 0xa0, 0xa5, 0x30, 0x23, 0x1e, 0x0c, 0x83,
-	0x51, 0x57, 0x78, 0x1c, 0xfd, 0x04, 0x70, 0x31, 0x15, 0xb3, 0xb9, 0x5a, 0x6a, 0x9b, 0xa1, 0x93,
-	0x5a, 0xd5, 0xd9, 0xd8, 0x25, 0x74, 0xd0, 0xc8, 0x17, 0xab, 0x0b, 0xc3, 0x1b, 0x5e, 0x3f, 0xf2,
-	0xd2, 0xbf, 0x96, 0x2a, 0xe5, 0xcd, 0xbd, 0xbf, 0xc4, 0x6c, 0x00, 0xa1, 0xd9, 0x14, 0x16, 0x37,
-	0xbc, 0xe5, 0xd5, 0x8a, 0xb1, 0x47, 0xe8, 0x6c, 0xc9, 0xe6, 0x52, 0xab, 0x9c, 0xb7, 0x87, 0xcd,
-	0x51, 0x6f, 0x72, 0x1d, 0xff, 0x7f, 0x7c, 0x3c, 0x15, 0xb3, 0x8f, 0xbd, 0x4d, 0x1c, 0xfd, 0xec,
-	0x09, 0x42, 0x4b, 0x2b, 0xa9, 0x15, 0x0f, 0x87, 0xc1, 0xa8, 0x37, 0xb9, 0xa9, 0x9b, 0xfc, 0xd3,
-	0x59, 0x54, 0x23, 0x91, 0x00, 0x38, 0x85, 0xd6, 0x56, 0x1c, 0x40, 0x98, 0x27, 0x5f, 0x94, 0x61,
-	0x55, 0xb0, 0x62, 0x8c, 0xc3, 0x59, 0xee, 0xb4, 0xc5, 0x15, 0xf9, 0x86, 0x1d, 0x71, 0xa0, 0xd1,
-	0x03, 0xb4, 0xe7, 0x19, 0xae, 0x88, 0x5d, 0x41, 0x37, 0xa3, 0x54, 0xe2, 0xfb, 0xce, 0x1c, 0x32,
-	0x4f, 0x42, 0xb9, 0x2c, 0x45, 0xb7, 0x8f, 0x3d, 0x17, 0x1e, 0x47, 0x77, 0xd0, 0x9f, 0x6a, 0x95,
-	0xca, 0xf2, 0xc3, 0xdf, 0x1c, 0xba, 0x22, 0xf7, 0xfb, 0x3d, 0xaa, 0x12, 0x2a, 0xf6, 0xdc, 0xfa,
-	0x6c, 0x6c, 0xc7, 0x8b, 0xd0, 0x5f, 0xfa, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xa0, 0xcc,
-	0xcd, 0xf8, 0x01, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5d, 0x6b, 0x13, 0x41,
+	0x14, 0x65, 0xf3, 0x65, 0x7a, 0x57, 0x6d, 0x18, 0xa4, 0xac, 0x41, 0x34, 0xac, 0x08, 0x55, 0x74,
+	0x4b, 0xe3, 0x8b, 0x56, 0xf0, 0xc1, 0xb6, 0x48, 0xc5, 0x2a, 0x4c, 0x83, 0x88, 0x4f, 0x4e, 0x36,
+	0xb7, 0xe9, 0xd8, 0x9d, 0x99, 0x75, 0x66, 0x36, 0x98, 0x1f, 0xe6, 0xdf, 0xf1, 0xcd, 0xdf, 0xa1,
+	0xcc, 0xec, 0x6e, 0x13, 0xdb, 0x95, 0xbe, 0xdd, 0x73, 0x73, 0xce, 0xb9, 0xb9, 0x73, 0x4f, 0x02,
+	0xa1, 0x5d, 0xe6, 0x68, 0x92, 0x5c, 0x2b, 0xab, 0x08, 0x39, 0x2f, 0xa6, 0x98, 0x32, 0xad, 0x39,
+	0xea, 0x84, 0xe5, 0x3c, 0x59, 0xec, 0x0e, 0x1f, 0xcc, 0x95, 0x9a, 0x67, 0xb8, 0xe3, 0x19, 0xd3,
+	0xe2, 0x74, 0xc7, 0x72, 0x81, 0xc6, 0x32, 0x91, 0x97, 0xa2, 0xf8, 0x11, 0x6c, 0x7e, 0x9c, 0x7e,
+	0xc3, 0xd4, 0x52, 0x3c, 0x45, 0x8d, 0x32, 0x45, 0x42, 0xa0, 0x23, 0x99, 0xc0, 0x28, 0x18, 0x05,
+	0xdb, 0x1b, 0xd4, 0xd7, 0xf1, 0xef, 0x00, 0x6e, 0xef, 0xd3, 0x83, 0x23, 0x79, 0xaa, 0xb4, 0x60,
+	0x96, 0x2b, 0xd9, 0x44, 0x23, 0x43, 0xe8, 0xb3, 0x9c, 0xbf, 0xd5, 0xaa, 0xc8, 0xa3, 0x96, 0xef,
+	0x5f, 0x60, 0xc7, 0x3f, 0xe7, 0x72, 0x16, 0xb5, 0x4b, 0xbe, 0xab, 0xc9, 0x16, 0xf4, 0xf2, 0xac,
+	0xd0, 0x2c, 0x8b, 0x3a, 0xbe, 0x5b, 0x21, 0xb2, 0x07, 0xfd, 0x05, 0x6a, 0xc3, 0x95, 0x34, 0x51,
+	0x77, 0xd4, 0xde, 0x0e, 0xc7, 0xf7, 0x93, 0xab, 0xdb, 0x25, 0xfb, 0xf4, 0xe0, 0x53, 0x49, 0xa3,
+	0x17, 0x7c, 0xf2, 0x0a, 0x7a, 0x1a, 0xe7, 0x5c, 0xc9, 0xa8, 0x37, 0x0a, 0xb6, 0xc3, 0xf1, 0xc3,
+	0x26, 0xe5, 0xa5, 0x9d, 0x69, 0x25, 0x89, 0x29, 0xc0, 0xca, 0xb4, 0x71, 0xc5, 0x2d, 0xe8, 0x99,
+	0xf4, 0x0c, 0x05, 0xab, 0x16, 0xac, 0x10, 0x89, 0xe0, 0x86, 0xb1, 0x4a, 0xb3, 0x39, 0xfa, 0x0d,
+	0xfb, 0xb4, 0x86, 0xf1, 0x4b, 0xe8, 0x1e, 0x09, 0x36, 0x47, 0x72, 0x0f, 0x36, 0x04, 0xce, 0x38,
+	0x9b, 0x2c, 0xf3, 0xda, 0x73, 0xd5, 0x70, 0xc3, 0x66, 0xcc, 0x96, 0xb6, 0x37, 0xa9, 0xaf, 0xe3,
+	0xc7, 0xb0, 0xb9, 0xaf, 0xe4, 0x8c, 0xbb, 0x07, 0x3f, 0xb1, 0xcc, 0x16, 0xc6, 0xcf, 0xf7, 0x55,
+	0xe5, 0x50, 0xa1, 0xf8, 0x67, 0x00, 0x1b, 0x17, 0x5c, 0x67, 0x66, 0x57, 0x53, 0x7c, 0xbd, 0xa6,
+	0x6c, 0xad, 0x2b, 0xc9, 0x3b, 0x20, 0x19, 0x33, 0x76, 0xa2, 0x99, 0x34, 0x5e, 0x3d, 0xe1, 0xa2,
+	0x5c, 0x22, 0x1c, 0x0f, 0x93, 0x32, 0x40, 0x49, 0x1d, 0xa0, 0x64, 0x52, 0x07, 0x88, 0x36, 0xa8,
+	0xdc, 0x0c, 0x8d, 0xcc, 0x28, 0x59, 0x1f, 0xb4, 0x44, 0xee, 0x75, 0x04, 0x1a, 0xe3, 0x5e, 0xa7,
+	0xeb, 0x3f, 0xa8, 0x61, 0xfc, 0xcb, 0x25, 0x4b, 0x09, 0xa1, 0xe4, 0x31, 0x5a, 0xe6, 0xb6, 0x26,
+	0x23, 0x08, 0x67, 0xdc, 0xe4, 0x19, 0x5b, 0x7e, 0x58, 0xbd, 0xfe, 0x7a, 0xcb, 0x33, 0xd0, 0xa4,
+	0x9a, 0xe7, 0x6e, 0x72, 0xb5, 0xcf, 0x7a, 0x8b, 0x3c, 0x81, 0x81, 0x39, 0x53, 0xda, 0x1e, 0xac,
+	0xd1, 0xca, 0xe4, 0x5d, 0xe9, 0x93, 0x67, 0xd0, 0xc9, 0xd4, 0x5c, 0xf9, 0xaf, 0x1c, 0x8e, 0xef,
+	0x36, 0xe5, 0xc5, 0x1f, 0x90, 0x7a, 0x9a, 0xa3, 0xf3, 0x54, 0xc9, 0xa8, 0x7b, 0x2d, 0xdd, 0xd1,
+	0xe2, 0x3f, 0x01, 0xdc, 0x7a, 0xcf, 0xa6, 0x98, 0x9d, 0x60, 0x86, 0xa9, 0x55, 0x9a, 0x4c, 0x20,
+	0x14, 0xcc, 0xa6, 0x67, 0xbe, 0xeb, 0xee, 0xe8, 0x02, 0x3e, 0x6e, 0xf2, 0xf9, 0x47, 0x97, 0x1c,
+	0xaf, 0x44, 0x87, 0xd2, 0xea, 0x25, 0x5d, 0xb7, 0x21, 0x9f, 0x61, 0xe0, 0xe1, 0xe1, 0x8f, 0x5c,
+	0xa3, 0x29, 0x7f, 0x3b, 0x2d, 0x6f, 0xfd, 0xf4, 0x5a, 0x6b, 0x8a, 0xdf, 0x0b, 0xae, 0x51, 0xa0,
+	0xb4, 0xf4, 0x8a, 0xcb, 0xf0, 0x35, 0x0c, 0x2e, 0x8f, 0x26, 0x03, 0x68, 0x9f, 0xe3, 0xb2, 0xba,
+	0x8d, 0x2b, 0xc9, 0x1d, 0xe8, 0x2e, 0x58, 0x56, 0x60, 0x75, 0x8d, 0x12, 0xec, 0xb5, 0x5e, 0x04,
+	0xf1, 0x57, 0x88, 0xfe, 0x37, 0xad, 0xc1, 0x67, 0x08, 0x7d, 0x95, 0xa3, 0x66, 0x56, 0xe9, 0xfa,
+	0x3f, 0xa4, 0xc6, 0x2e, 0x5e, 0xde, 0xd6, 0x44, 0xed, 0x51, 0xdb, 0xc5, 0xab, 0x44, 0x6f, 0x3a,
+	0x5f, 0x5a, 0x8b, 0xdd, 0x69, 0xcf, 0x87, 0xf4, 0xf9, 0xdf, 0x01, 0x00, 0xc1, 0xb2, 0x97, 0xed,
+	0x16, 0x05, 0x00, 0x00,
 }
@@ -23,10 +23,13 @@ import (
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
+
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
 )
 
 const (
@@ -34,6 +37,13 @@ const (
 	EncodingYAML = "yaml"
 )
 
+const (
+	// PatchTypeJSONMerge is a JSON merge patch as defined in RFC 7386.
+	PatchTypeJSONMerge = "merge"
+	// PatchTypeJSON is a JSON patch as defined in RFC 6902.
+	PatchTypeJSON = "json"
+)
+
 func NewRawObject(format string, data []byte) (*RawObject, error) {
 	if format != EncodingJSON && format != EncodingYAML {
 		return nil, errors.New("unsupported format")
@@ -62,6 +72,18 @@ func (ro *RawObject) Unmarshal(v interface{}) error {
 	}
 }
 
+// JSON returns the object data as JSON, converting it from YAML if needed.
+func (ro *RawObject) JSON() ([]byte, error) {
+	switch ro.Encoding {
+	case EncodingJSON:
+		return ro.Data, nil
+	case EncodingYAML:
+		return yaml.YAMLToJSON(ro.Data)
+	default:
+		return nil, fmt.Errorf("unsupported encoding format: %s", ro.Encoding)
+	}
+}
+
 type OptionsRequest interface {
 	GetLabelSelector() string
 	GetLimit() int64
@@ -108,25 +130,89 @@ func (req *ListRequest) GetListOptions() (*client.ListOptions, error) {
 		return nil, err
 	}
 	namespace.ApplyToList(listOptions)
-	// Limit
-	limit, err := GetLimitOption(req)
+	// Limit and Continue are part of the ListQuery,
+	// because pagination is applied after filtering and sorting.
+	// LabelsSelector
+	ls, err := GetLabelsSelectorOption(req)
 	if err != nil {
 		return nil, err
 	}
-	limit.ApplyToList(listOptions)
-	// Continue
-	c, err := GetContinueOption(req)
-	if err != nil {
+	ls.ApplyToList(listOptions)
+	return listOptions, nil
+}
+
+// ListQueryRequest is implemented by List requests supporting server-side filtering, sorting and pagination.
+type ListQueryRequest interface {
+	LimitGetter
+	ContinueGetter
+	FieldSelectorGetter
+	OrderByGetter
+	SearchGetter
+}
+
+// ListQuery holds the options of a List request, that are applied by the API server after listing the objects.
+type ListQuery struct {
+	FieldSelector fields.Selector
+	OrderBy       []OrderByField
+	Search        string
+	Limit         int64
+	Continue      string
+}
+
+// OrderByField is a field to sort items by.
+type OrderByField struct {
+	Path       fieldpath.Path
+	Descending bool
+}
+
+// GetListQuery returns the ListQuery of the request.
+func GetListQuery(req ListQueryRequest) (*ListQuery, error) {
+	if err := validateLimit(req); err != nil {
 		return nil, err
 	}
-	c.ApplyToList(listOptions)
-	// LabelsSelector
-	ls, err := GetLabelsSelectorOption(req)
+	query := &ListQuery{
+		FieldSelector: fields.Everything(),
+		Search:        req.GetSearch(),
+		Limit:         req.GetLimit(),
+		Continue:      req.GetContinue(),
+	}
+	if req.GetFieldSelector() != "" {
+		selector, err := fields.ParseSelector(req.GetFieldSelector())
+		if err != nil {
+			return nil, fmt.Errorf("invalid FieldSelector: %w", err)
+		}
+		query.FieldSelector = selector
+	}
+	orderBy, err := parseOrderBy(req.GetOrderBy())
 	if err != nil {
 		return nil, err
 	}
-	ls.ApplyToList(listOptions)
-	return listOptions, nil
+	query.OrderBy = orderBy
+	return query, nil
+}
+
+func parseOrderBy(orderBy string) ([]OrderByField, error) {
+	if orderBy == "" {
+		return nil, nil
+	}
+	var out []OrderByField
+	for _, field := range strings.Split(orderBy, ",") {
+		field = strings.TrimSpace(field)
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+		if field == "" {
+			return nil, fmt.Errorf("invalid OrderBy: empty field")
+		}
+		path, err := fieldpath.Parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OrderBy: %w", err)
+		}
+		if path.HasWildcard() {
+			return nil, fmt.Errorf("invalid OrderBy %s: wildcards are not supported", path)
+		}
+		out = append(out, OrderByField{Path: path, Descending: descending})
+	}
+	return out, nil
 }
 
 func (req *AccountListRequest) GetListOptions() (*client.ListOptions, error) {
@@ -146,7 +232,10 @@ func (req *WatchRequest) GetListOptions() (*client.ListOptions, error) {
 		return nil, err
 	}
 	ls.ApplyToList(listOptions)
-	listOptions.Raw = &metav1.ListOptions{ResourceVersion: req.ResourceVersion}
+	listOptions.Raw = &metav1.ListOptions{
+		ResourceVersion:     req.ResourceVersion,
+		AllowWatchBookmarks: req.AllowWatchBookmarks,
+	}
 	return listOptions, nil
 }
 
@@ -157,7 +246,10 @@ func (req *InstanceWatchRequest) GetListOptions() (*client.ListOptions, error) {
 		return nil, err
 	}
 	ls.ApplyToList(listOptions)
-	listOptions.Raw = &metav1.ListOptions{ResourceVersion: req.ResourceVersion}
+	listOptions.Raw = &metav1.ListOptions{
+		ResourceVersion:     req.ResourceVersion,
+		AllowWatchBookmarks: req.AllowWatchBookmarks,
+	}
 	return listOptions, nil
 }
 
@@ -169,18 +261,8 @@ func (req *InstanceListRequest) GetListOptions() (*client.ListOptions, error) {
 		return nil, err
 	}
 	namespace.ApplyToList(listOptions)
-	// Limit
-	limit, err := GetLimitOption(req)
-	if err != nil {
-		return nil, err
-	}
-	limit.ApplyToList(listOptions)
-	// Continue
-	c, err := GetContinueOption(req)
-	if err != nil {
-		return nil, err
-	}
-	c.ApplyToList(listOptions)
+	// Limit and Continue are part of the ListQuery,
+	// because pagination is applied after filtering and sorting.
 	// LabelsSelector
 	ls, err := GetLabelsSelectorOption(req)
 	if err != nil {
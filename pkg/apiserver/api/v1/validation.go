@@ -19,7 +19,9 @@ package v1
 import (
 	fmt "fmt"
 	"strings"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -87,6 +89,23 @@ func validateLabelSelector(req LabelSelectorGetter) error {
 	return nil
 }
 
+type FieldSelectorGetter interface {
+	GetFieldSelector() string
+}
+
+type OrderByGetter interface {
+	GetOrderBy() string
+}
+
+type SearchGetter interface {
+	GetSearch() string
+}
+
+func validateListQuery(req ListQueryRequest) error {
+	_, err := GetListQuery(req)
+	return err
+}
+
 type LimitGetter interface {
 	GetLimit() int64
 }
@@ -112,6 +131,58 @@ func validateSpec(req SpecGetter) error {
 	return nil
 }
 
+func validateResourceVersion(req SpecGetter) error {
+	if req.GetSpec().Metadata.ResourceVersion == "" {
+		return fmt.Errorf("missing metadata resourceVersion")
+	}
+	return nil
+}
+
+type MetadataGetter interface {
+	GetMetadata() *ObjectMeta
+}
+
+func validateMetadata(obj MetadataGetter) error {
+	if obj.GetMetadata() == nil || obj.GetMetadata().Name == "" {
+		return fmt.Errorf("missing metadata name")
+	}
+	return nil
+}
+
+// validateUpdateMetadata ensures the object matches the name of the update request
+// and carries a resourceVersion for optimistic locking.
+func validateUpdateMetadata(name string, obj MetadataGetter) error {
+	if err := validateMetadata(obj); err != nil {
+		return err
+	}
+	if obj.GetMetadata().Name != name {
+		return fmt.Errorf("metadata name %s does not match name %s", obj.GetMetadata().Name, name)
+	}
+	if obj.GetMetadata().ResourceVersion == "" {
+		return fmt.Errorf("missing metadata resourceVersion")
+	}
+	return nil
+}
+
+type PatchGetter interface {
+	GetPatchType() string
+	GetPatch() *RawObject
+}
+
+func validatePatch(req PatchGetter) error {
+	switch req.GetPatchType() {
+	case PatchTypeJSONMerge, PatchTypeJSON:
+	case "":
+		return fmt.Errorf("missing patch type")
+	default:
+		return fmt.Errorf("unsupported patch type: %s, should be one of %s, %s", req.GetPatchType(), PatchTypeJSONMerge, PatchTypeJSON)
+	}
+	if req.GetPatch() == nil || len(req.GetPatch().Data) == 0 {
+		return fmt.Errorf("missing patch")
+	}
+	return nil
+}
+
 type ContinueGetter interface {
 	GetContinue() string
 }
@@ -158,10 +229,10 @@ func (req *InstanceListRequest) Validate() error {
 	if err := validateAccount(req); err != nil {
 		return err
 	}
-	if err := validateLimit(req); err != nil {
+	if err := validateLabelSelector(req); err != nil {
 		return err
 	}
-	if err := validateLabelSelector(req); err != nil {
+	if err := validateListQuery(req); err != nil {
 		return err
 	}
 	return nil
@@ -183,6 +254,50 @@ func (req *InstanceCreateRequest) Validate() error {
 	return nil
 }
 
+func (req *InstanceUpdateRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateOffering(req); err != nil {
+		return err
+	}
+	if err := validateVersion(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if err := validateSpec(req); err != nil {
+		return err
+	}
+	if req.Spec.Metadata.Name != req.Name {
+		return fmt.Errorf("metadata name %s does not match instance name %s", req.Spec.Metadata.Name, req.Name)
+	}
+	if err := validateResourceVersion(req); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *InstancePatchRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateOffering(req); err != nil {
+		return err
+	}
+	if err := validateVersion(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if err := validatePatch(req); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (req *InstanceWatchRequest) Validate() error {
 	if err := validateAccount(req); err != nil {
 		return err
@@ -199,10 +314,48 @@ func (req *InstanceWatchRequest) Validate() error {
 	return nil
 }
 
+func (req *EventListRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateOffering(req); err != nil {
+		return err
+	}
+	if err := validateVersion(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if err := validateListQuery(req); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *EventWatchRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateOffering(req); err != nil {
+		return err
+	}
+	if err := validateVersion(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (req *AccountListRequest) Validate() error {
 	if err := validateLabelSelector(req); err != nil {
 		return err
 	}
+	if err := validateListQuery(req); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -223,7 +376,7 @@ func (req *ListRequest) Validate() error {
 	if err := validateLabelSelector(req); err != nil {
 		return fmt.Errorf("invalid LabelSelector: %w", err)
 	}
-	if err := validateLimit(req); err != nil {
+	if err := validateListQuery(req); err != nil {
 		return err
 	}
 	return nil
@@ -238,3 +391,180 @@ func (req *WatchRequest) Validate() error {
 	}
 	return nil
 }
+
+func (req *DeleteRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *CatalogCreateRequest) Validate() error {
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateMetadata(req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *CatalogUpdateRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateUpdateMetadata(req.Name, req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *CatalogEntryCreateRequest) Validate() error {
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateMetadata(req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *CatalogEntryUpdateRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateUpdateMetadata(req.Name, req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *CatalogEntrySetCreateRequest) Validate() error {
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateMetadata(req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *CatalogEntrySetUpdateRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateUpdateMetadata(req.Name, req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *ServiceClusterCreateRequest) Validate() error {
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateMetadata(req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *ServiceClusterUpdateRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateUpdateMetadata(req.Name, req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *DerivedCustomResourceCreateRequest) Validate() error {
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateMetadata(req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *DerivedCustomResourceUpdateRequest) Validate() error {
+	if err := validateName(req); err != nil {
+		return err
+	}
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateUpdateMetadata(req.Name, req.Spec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (req *APIKeyCreateRequest) Validate() error {
+	if err := validateAccount(req); err != nil {
+		return err
+	}
+	if req.Spec == nil {
+		return fmt.Errorf("missing spec")
+	}
+	if err := validateMetadata(req.Spec); err != nil {
+		return err
+	}
+	if req.Spec.Spec != nil && req.Spec.Spec.ExpiresAt != nil {
+		expiresAt, err := ptypes.Timestamp(req.Spec.Spec.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("invalid expiresAt: %w", err)
+		}
+		if !expiresAt.After(time.Now()) {
+			return fmt.Errorf("expiresAt should be in the future")
+		}
+	}
+	return nil
+}
@@ -19,8 +19,11 @@ package v1
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateGetRequest(t *testing.T) {
@@ -89,6 +92,40 @@ func TestValidateListRequest(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("invalid LabelSelector: unable to parse requirement: found '==', expected: identifier"),
 		},
+		{
+			name: "invalid field selector",
+			req: &ListRequest{
+				Account:       "test-namespace",
+				FieldSelector: "spec.provider.name",
+			},
+			expectedError: fmt.Errorf("invalid FieldSelector: invalid selector: 'spec.provider.name'; can't understand 'spec.provider.name'"),
+		},
+		{
+			name: "invalid order by",
+			req: &ListRequest{
+				Account: "test-namespace",
+				OrderBy: "metadata.name,-",
+			},
+			expectedError: fmt.Errorf("invalid OrderBy: empty field"),
+		},
+		{
+			name: "order by wildcard",
+			req: &ListRequest{
+				Account: "test-namespace",
+				OrderBy: "spec.versions[*].name",
+			},
+			expectedError: fmt.Errorf("invalid OrderBy .spec.versions[*].name: wildcards are not supported"),
+		},
+		{
+			name: "valid list query",
+			req: &ListRequest{
+				Account:       "test-namespace",
+				FieldSelector: "spec.provider.name=test-provider",
+				OrderBy:       "-metadata.creationTimestamp,metadata.name",
+				Search:        "database",
+			},
+			expectedError: nil,
+		},
 		{
 			name: "valid request",
 			req: &ListRequest{
@@ -150,3 +187,167 @@ func TestValidateWatchRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateInstancePatchRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *InstancePatchRequest
+		expectedError error
+	}{
+		{
+			name: "missing patch type",
+			req: &InstancePatchRequest{
+				Name:     "test-name",
+				Account:  "test-namespace",
+				Offering: "couchdb.eu-west-1.team-a",
+				Version:  "v1alpha1",
+				Patch:    NewJSONRawObject([]byte("{}")),
+			},
+			expectedError: fmt.Errorf("missing patch type"),
+		},
+		{
+			name: "unsupported patch type",
+			req: &InstancePatchRequest{
+				Name:      "test-name",
+				Account:   "test-namespace",
+				Offering:  "couchdb.eu-west-1.team-a",
+				Version:   "v1alpha1",
+				PatchType: "strategic",
+				Patch:     NewJSONRawObject([]byte("{}")),
+			},
+			expectedError: fmt.Errorf("unsupported patch type: strategic, should be one of merge, json"),
+		},
+		{
+			name: "missing patch",
+			req: &InstancePatchRequest{
+				Name:      "test-name",
+				Account:   "test-namespace",
+				Offering:  "couchdb.eu-west-1.team-a",
+				Version:   "v1alpha1",
+				PatchType: PatchTypeJSONMerge,
+			},
+			expectedError: fmt.Errorf("missing patch"),
+		},
+		{
+			name: "valid request",
+			req: &InstancePatchRequest{
+				Name:      "test-name",
+				Account:   "test-namespace",
+				Offering:  "couchdb.eu-west-1.team-a",
+				Version:   "v1alpha1",
+				PatchType: PatchTypeJSON,
+				Patch:     NewJSONRawObject([]byte("[]")),
+			},
+			expectedError: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedError, test.req.Validate())
+		})
+	}
+}
+
+func TestValidateCatalogUpdateRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *CatalogUpdateRequest
+		expectedError error
+	}{
+		{
+			name: "missing spec",
+			req: &CatalogUpdateRequest{
+				Name:    "test-name",
+				Account: "test-namespace",
+			},
+			expectedError: fmt.Errorf("missing spec"),
+		},
+		{
+			name: "name mismatch",
+			req: &CatalogUpdateRequest{
+				Name:    "test-name",
+				Account: "test-namespace",
+				Spec: &Catalog{
+					Metadata: &ObjectMeta{Name: "other-name", ResourceVersion: "1"},
+				},
+			},
+			expectedError: fmt.Errorf("metadata name other-name does not match name test-name"),
+		},
+		{
+			name: "missing resourceVersion",
+			req: &CatalogUpdateRequest{
+				Name:    "test-name",
+				Account: "test-namespace",
+				Spec: &Catalog{
+					Metadata: &ObjectMeta{Name: "test-name"},
+				},
+			},
+			expectedError: fmt.Errorf("missing metadata resourceVersion"),
+		},
+		{
+			name: "valid request",
+			req: &CatalogUpdateRequest{
+				Name:    "test-name",
+				Account: "test-namespace",
+				Spec: &Catalog{
+					Metadata: &ObjectMeta{Name: "test-name", ResourceVersion: "1"},
+				},
+			},
+			expectedError: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedError, test.req.Validate())
+		})
+	}
+}
+
+func TestValidateAPIKeyCreateRequest(t *testing.T) {
+	past, err := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	future, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		req           *APIKeyCreateRequest
+		expectedError error
+	}{
+		{
+			name: "missing metadata name",
+			req: &APIKeyCreateRequest{
+				Account: "test-namespace",
+				Spec:    &APIKey{},
+			},
+			expectedError: fmt.Errorf("missing metadata name"),
+		},
+		{
+			name: "expired",
+			req: &APIKeyCreateRequest{
+				Account: "test-namespace",
+				Spec: &APIKey{
+					Metadata: &ObjectMeta{Name: "test-name"},
+					Spec:     &APIKeySpec{ExpiresAt: past},
+				},
+			},
+			expectedError: fmt.Errorf("expiresAt should be in the future"),
+		},
+		{
+			name: "valid request",
+			req: &APIKeyCreateRequest{
+				Account: "test-namespace",
+				Spec: &APIKey{
+					Metadata: &ObjectMeta{Name: "test-name"},
+					Spec:     &APIKeySpec{ExpiresAt: future, ReadOnly: true},
+				},
+			},
+			expectedError: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedError, test.req.Validate())
+		})
+	}
+}
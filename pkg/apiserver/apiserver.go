@@ -24,6 +24,8 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -56,13 +58,18 @@ import (
 	"k8c.io/utils/pkg/util"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 	apiserverv1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
 	"k8c.io/kubecarrier/pkg/apiserver/auth"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/audit"
 	_ "k8c.io/kubecarrier/pkg/apiserver/internal/auth/anonymous"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/auth/apikey"
 	_ "k8c.io/kubecarrier/pkg/apiserver/internal/auth/htpasswd"
 	_ "k8c.io/kubecarrier/pkg/apiserver/internal/auth/oidc"
 	_ "k8c.io/kubecarrier/pkg/apiserver/internal/auth/token"
+	_ "k8c.io/kubecarrier/pkg/apiserver/internal/auth/x509"
 	"k8c.io/kubecarrier/pkg/apiserver/internal/authorizer"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/ratelimit"
 	v1 "k8c.io/kubecarrier/pkg/apiserver/internal/v1"
 )
 
@@ -73,6 +80,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(catalogv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(corev1alpha1.AddToScheme(scheme))
 }
 
 type flags struct {
@@ -81,6 +89,10 @@ type flags struct {
 	TLSPrivateKeyFile  string
 	CORSAllowedOrigins []string
 	AuthenticationMode []string
+	AuditLogPath       string
+	AuditWebhookURL    string
+	AuditPolicyFile    string
+	RateLimit          ratelimit.Config
 	*genericclioptions.ConfigFlags
 }
 
@@ -103,6 +115,12 @@ func NewAPIServer() *cobra.Command {
 	cmd.Flags().StringVar(&flags.TLSPrivateKeyFile, "tls-private-key-file", "", "File containing the default x509 private key matching --tls-cert-file.")
 	cmd.Flags().StringSliceVar(&flags.CORSAllowedOrigins, "cors-allowed-origins", []string{"*"}, "List of allowed origins for CORS, comma separated. An allowed origin can be a regular expression to support subdomain matching. If this list is empty CORS will not be enabled.")
 	cmd.Flags().StringSliceVar(&flags.AuthenticationMode, "authentication-mode", []string{"OIDC"}, "Ordered list of plug-ins to do authentication on secure port. Comma-delimited list of: "+strings.Join(auth.RegisteredAuthProviders(), ","))
+	cmd.Flags().StringVar(&flags.AuditLogPath, "audit-log-path", "", "If set, all authenticated calls are logged to this file as JSON lines. '-' means standard out.")
+	cmd.Flags().StringVar(&flags.AuditWebhookURL, "audit-webhook-url", "", "If set, audit events are posted as JSON to this URL.")
+	cmd.Flags().StringVar(&flags.AuditPolicyFile, "audit-policy-file", "", "Path to the file that defines the audit policy. If not set, all calls are audited at Metadata level.")
+	cmd.Flags().Float64Var(&flags.RateLimit.QPS, "rate-limit-qps", 0, "Number of unary calls per second allowed per user and account. 0 disables rate limiting.")
+	cmd.Flags().IntVar(&flags.RateLimit.Burst, "rate-limit-burst", 0, "Number of unary calls allowed at once per user and account. Defaults to --rate-limit-qps.")
+	cmd.Flags().IntVar(&flags.RateLimit.MaxConcurrentStreams, "max-concurrent-streams", 0, "Number of concurrently open streams (e.g. Watch) allowed per user and account. 0 disables the limit.")
 	auth.RegisterPFlags(cmd.Flags())
 	return util.CmdLogMixin(cmd)
 }
@@ -132,7 +150,11 @@ func runE(flags *flags, log logr.Logger) error {
 	if flags.TLSCertFile == "" || flags.TLSPrivateKeyFile == "" {
 		return fmt.Errorf("--tls-cert-file or --tls-private-key-file not specified, cannot start")
 	}
+	if flags.RateLimit.QPS < 0 || flags.RateLimit.Burst < 0 || flags.RateLimit.MaxConcurrentStreams < 0 {
+		return fmt.Errorf("--rate-limit-qps, --rate-limit-burst and --max-concurrent-streams should not be negative")
+	}
 
+	tlsConfig := &tls.Config{}
 	authProviders := make([]auth.Provider, 0, len(flags.AuthenticationMode))
 	for _, mode := range flags.AuthenticationMode {
 		authProvider, err := auth.GetAuthProvider(mode)
@@ -162,34 +184,62 @@ func runE(flags *flags, log logr.Logger) error {
 		if err := authProvider.Init(); err != nil {
 			return fmt.Errorf("cannot init auth provider: %s: %w", mode, err)
 		}
+		if configurer, ok := authProvider.(auth.TLSConfigurer); ok {
+			if err := configurer.ConfigureTLS(tlsConfig); err != nil {
+				return fmt.Errorf("cannot configure TLS for auth provider %s: %w", mode, err)
+			}
+		}
 		authProviders = append(authProviders, authProvider)
 	}
 
 	authz := authorizer.NewAuthorizer(log, scheme, c, mapper)
 	authFunc := auth.CreateAuthFunction(authProviders)
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_ctxtags.StreamServerInterceptor(),
+		grpc_opentracing.StreamServerInterceptor(),
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_zap.StreamServerInterceptor(util.ZapLogger),
+		grpc_auth.StreamServerInterceptor(authFunc),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_ctxtags.UnaryServerInterceptor(),
+		grpc_opentracing.UnaryServerInterceptor(),
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_zap.UnaryServerInterceptor(util.ZapLogger),
+		grpc_auth.UnaryServerInterceptor(authFunc),
+	}
+	// the auditor needs the authenticated user and records the authorization decision,
+	// so it has to run between authentication and authorization.
+	auditor, err := newAuditor(flags, log.WithName("audit"))
+	if err != nil {
+		return err
+	}
+	if auditor != nil {
+		streamInterceptors = append(streamInterceptors, auditor.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, auditor.UnaryServerInterceptor())
+	}
+	// APIKeys are restricted to their Account and scope before any further checks.
+	streamInterceptors = append(streamInterceptors, apikey.StreamServerInterceptor())
+	unaryInterceptors = append(unaryInterceptors, apikey.UnaryServerInterceptor())
+	// rate limiting runs before authorization, so throttled calls don't cause SubjectAccessReviews.
+	if flags.RateLimit.Enabled() {
+		limiter := ratelimit.NewLimiter(flags.RateLimit)
+		streamInterceptors = append(streamInterceptors, limiter.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, limiter.UnaryServerInterceptor())
+	}
+	streamInterceptors = append(streamInterceptors, authz.StreamServerInterceptor())
+	unaryInterceptors = append(unaryInterceptors, authz.UnaryServerInterceptor())
+	streamInterceptors = append(streamInterceptors, grpc_validator.StreamServerInterceptor())
+	unaryInterceptors = append(unaryInterceptors, grpc_validator.UnaryServerInterceptor())
 	grpc_zap.ReplaceGrpcLoggerV2(util.ZapLogger)
 	grpcServer := grpc.NewServer(
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			grpc_ctxtags.StreamServerInterceptor(),
-			grpc_opentracing.StreamServerInterceptor(),
-			grpc_prometheus.StreamServerInterceptor,
-			grpc_zap.StreamServerInterceptor(util.ZapLogger),
-			grpc_auth.StreamServerInterceptor(authFunc),
-			authz.StreamServerInterceptor(),
-			grpc_validator.StreamServerInterceptor(),
-		)),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_ctxtags.UnaryServerInterceptor(),
-			grpc_opentracing.UnaryServerInterceptor(),
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_zap.UnaryServerInterceptor(util.ZapLogger),
-			grpc_auth.UnaryServerInterceptor(authFunc),
-			authz.UnaryServerInterceptor(),
-			grpc_validator.UnaryServerInterceptor(),
-		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
 	)
 	wrappedGrpc := grpcweb.WrapServer(grpcServer)
 	grpcGatewayMux := gwruntime.NewServeMux(
+		// forward client certificates, as the gateway calls the gRPC server through its own connection.
+		gwruntime.WithMetadata(auth.ForwardClientCertificate),
 		gwruntime.WithProtoErrorHandler(func(ctx context.Context, serveMux *gwruntime.ServeMux, marshaler gwruntime.Marshaler, writer http.ResponseWriter, request *http.Request, err error) {
 			const fallback = `{"error": "failed to marshal error message"}`
 			writer.Header().Del("Trailer")
@@ -223,8 +273,8 @@ func runE(flags *flags, log logr.Logger) error {
 	if err != nil {
 		return fmt.Errorf("creating cache for account: %w", err)
 	}
-	if err := v1.RegisterAccountUsernameFieldIndex(ctx, accountCache); err != nil {
-		return fmt.Errorf("fail to register field index for Account Username: %w", err)
+	if err := v1.RegisterAccountSubjectFieldIndex(ctx, accountCache); err != nil {
+		return fmt.Errorf("fail to register field index for Account subjects: %w", err)
 	}
 	accountClient := &client.DelegatingClient{
 		Reader:       accountCache,
@@ -250,7 +300,7 @@ func runE(flags *flags, log logr.Logger) error {
 		return err
 	}
 
-	apiserverv1.RegisterKubeCarrierServer(grpcServer, &v1.KubeCarrierServer{})
+	apiserverv1.RegisterKubeCarrierServer(grpcServer, v1.NewKubeCarrierServer(accountClient))
 	if err := apiserverv1.RegisterKubeCarrierHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
 		return err
 	}
@@ -269,6 +319,12 @@ func runE(flags *flags, log logr.Logger) error {
 		return err
 	}
 
+	eventServer := v1.NewEventsServer(c, dynamicClient, mapper, scheme)
+	apiserverv1.RegisterEventsServiceServer(grpcServer, eventServer)
+	if err := apiserverv1.RegisterEventsServiceHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
+		return err
+	}
+
 	regionServer, err := v1.NewRegionServiceServer(c, dynamicClient, mapper, scheme)
 	if err != nil {
 		return err
@@ -286,6 +342,56 @@ func runE(flags *flags, log logr.Logger) error {
 		return err
 	}
 
+	catalogServer, err := v1.NewCatalogServiceServer(c, dynamicClient, mapper, scheme)
+	if err != nil {
+		return err
+	}
+	apiserverv1.RegisterCatalogServiceServer(grpcServer, catalogServer)
+	if err := apiserverv1.RegisterCatalogServiceHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
+		return err
+	}
+	catalogEntryServer, err := v1.NewCatalogEntryServiceServer(c, dynamicClient, mapper, scheme)
+	if err != nil {
+		return err
+	}
+	apiserverv1.RegisterCatalogEntryServiceServer(grpcServer, catalogEntryServer)
+	if err := apiserverv1.RegisterCatalogEntryServiceHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
+		return err
+	}
+	catalogEntrySetServer, err := v1.NewCatalogEntrySetServiceServer(c, dynamicClient, mapper, scheme)
+	if err != nil {
+		return err
+	}
+	apiserverv1.RegisterCatalogEntrySetServiceServer(grpcServer, catalogEntrySetServer)
+	if err := apiserverv1.RegisterCatalogEntrySetServiceHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
+		return err
+	}
+	derivedCustomResourceServer, err := v1.NewDerivedCustomResourceServiceServer(c, dynamicClient, mapper, scheme)
+	if err != nil {
+		return err
+	}
+	apiserverv1.RegisterDerivedCustomResourceServiceServer(grpcServer, derivedCustomResourceServer)
+	if err := apiserverv1.RegisterDerivedCustomResourceServiceHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
+		return err
+	}
+	serviceClusterServer, err := v1.NewServiceClusterServiceServer(c, dynamicClient, mapper, scheme)
+	if err != nil {
+		return err
+	}
+	apiserverv1.RegisterServiceClusterServiceServer(grpcServer, serviceClusterServer)
+	if err := apiserverv1.RegisterServiceClusterServiceHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
+		return err
+	}
+
+	apiKeyServer, err := v1.NewAPIKeyServiceServer(c, mapper, scheme)
+	if err != nil {
+		return err
+	}
+	apiserverv1.RegisterAPIKeyServiceServer(grpcServer, apiKeyServer)
+	if err := apiserverv1.RegisterAPIKeyServiceHandler(ctx, grpcGatewayMux, grpcClient); err != nil {
+		return err
+	}
+
 	docServer := v1.NewDocServiceServer()
 	apiserverv1.RegisterDocServer(grpcServer, docServer)
 	gwruntime.SetHTTPBodyMarshaler(grpcGatewayMux)
@@ -310,20 +416,57 @@ func runE(flags *flags, log logr.Logger) error {
 				"X-grpc-web",
 				"X-user-agent",
 			}),
-			handlers.AllowedMethods([]string{"GET", "POST"}),
+			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}),
 			handlers.AllowedOrigins(flags.CORSAllowedOrigins),
 		)(handler)
 	}
 
 	server := http.Server{
-		Handler: handler,
-		Addr:    flags.address,
+		Handler:   handler,
+		Addr:      flags.address,
+		TLSConfig: tlsConfig,
 	}
 
 	log.Info("serving serving API-server", "address", flags.address)
 	return server.ListenAndServeTLS(flags.TLSCertFile, flags.TLSPrivateKeyFile)
 }
 
+// newAuditor creates the Auditor from the audit flags, it returns nil if auditing is disabled.
+func newAuditor(flags *flags, log logr.Logger) (*audit.Auditor, error) {
+	var backends []audit.Backend
+	switch flags.AuditLogPath {
+	case "":
+	case "-":
+		backends = append(backends, audit.NewLogBackend(log, os.Stdout))
+	default:
+		f, err := os.OpenFile(flags.AuditLogPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("opening audit log: %w", err)
+		}
+		backends = append(backends, audit.NewLogBackend(log, f))
+	}
+	if flags.AuditWebhookURL != "" {
+		if _, err := url.ParseRequestURI(flags.AuditWebhookURL); err != nil {
+			return nil, fmt.Errorf("invalid --audit-webhook-url: %w", err)
+		}
+		webhook := audit.NewWebhookBackend(log, flags.AuditWebhookURL)
+		go webhook.Run(make(chan struct{}))
+		backends = append(backends, webhook)
+	}
+	if len(backends) == 0 {
+		return nil, nil
+	}
+
+	policy := audit.DefaultPolicy
+	if flags.AuditPolicyFile != "" {
+		var err error
+		if policy, err = audit.LoadPolicyFile(flags.AuditPolicyFile); err != nil {
+			return nil, err
+		}
+	}
+	return audit.NewAuditor(policy, audit.Union(backends...)), nil
+}
+
 func createInternalGRPCClient(ctx context.Context, flags *flags) (*grpc.ClientConn, error) {
 	certPool := x509.NewCertPool()
 	certs, err := ioutil.ReadFile(flags.TLSCertFile)
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// APIKeyPrefix prefixes all APIKeys, so they can be told apart from other bearer tokens.
+	APIKeyPrefix = "kcak"
+
+	// ExtraAPIKey holds the name of the APIKey that authenticated the user.
+	ExtraAPIKey = "kubecarrier.io/apikey"
+	// ExtraAPIKeyAccount holds the Account namespace that the APIKey is scoped to.
+	ExtraAPIKeyAccount = "kubecarrier.io/apikey-account"
+	// ExtraAPIKeyScope holds the scope of the APIKey, one of APIKeyScopeReadWrite or APIKeyScopeReadOnly.
+	ExtraAPIKeyScope = "kubecarrier.io/apikey-scope"
+
+	APIKeyScopeReadWrite = "read-write"
+	APIKeyScopeReadOnly  = "read-only"
+
+	apiKeySecretLength = 32
+)
+
+// GenerateAPIKey returns a new random key for the APIKey with the given namespace and name
+// and the hash of its secret to store in the APIKey.
+// The key has the form kcak_<namespace>_<name>_<secret>.
+func GenerateAPIKey(namespace, name string) (key, secretHash string, err error) {
+	b := make([]byte, apiKeySecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generating secret: %w", err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	key = strings.Join([]string{APIKeyPrefix, namespace, name, secret}, "_")
+	return key, HashAPIKeySecret(secret), nil
+}
+
+// ParseAPIKey splits the key into the namespace and name of the APIKey and its secret.
+func ParseAPIKey(key string) (namespace, name, secret string, err error) {
+	// Kubernetes object names can't contain "_", so only the secret might.
+	parts := strings.SplitN(key, "_", 4)
+	if len(parts) != 4 || parts[0] != APIKeyPrefix ||
+		parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", fmt.Errorf("malformed APIKey")
+	}
+	return parts[1], parts[2], parts[3], nil
+}
+
+// HashAPIKeySecret returns the hex encoded SHA-256 hash of the secret.
+func HashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyAPIKeySecret checks in constant time that the secret matches the hash.
+func VerifyAPIKeySecret(secret, secretHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKeySecret(secret)), []byte(secretHash)) == 1
+}
@@ -68,6 +68,8 @@ const (
 	ProviderAnynymous string     = "Anonymous"
 	ProviderOIDC      string     = "OIDC"
 	ProviderToken     string     = "Token"
+	ProviderX509      string     = "X509"
+	ProviderAPIKey    string     = "APIKey"
 )
 
 // ExtractUserInfo extracts the user info from context
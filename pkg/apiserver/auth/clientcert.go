@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// TLSConfigurer is implemented by Providers that need to configure the TLS server,
+// e.g. to request client certificates.
+type TLSConfigurer interface {
+	ConfigureTLS(config *tls.Config) error
+}
+
+const (
+	forwardedClientCertificateKey = "x-kubecarrier-forwarded-client-certificate"
+	forwardingSecretKey           = "x-kubecarrier-forwarding-secret"
+)
+
+// forwardingSecret proves that forwarded client certificates come from the REST gateway of this process.
+var forwardingSecret = newForwardingSecret()
+
+func newForwardingSecret() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("generating forwarding secret: %s", err))
+	}
+	return base64.RawStdEncoding.EncodeToString(b)
+}
+
+// ForwardClientCertificate returns metadata forwarding the verified client certificate of the HTTP request.
+// The REST gateway calls the gRPC server through its own connection,
+// so client certificates have to be forwarded to be used for authentication.
+func ForwardClientCertificate(ctx context.Context, req *http.Request) metadata.MD {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return metadata.Pairs(
+		forwardedClientCertificateKey, base64.StdEncoding.EncodeToString(req.TLS.VerifiedChains[0][0].Raw),
+		forwardingSecretKey, forwardingSecret,
+	)
+}
+
+// VerifiedClientCertificate returns the client certificate of the call,
+// that was verified during the TLS handshake, either directly or by the REST gateway.
+func VerifiedClientCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok &&
+			len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+			return tlsInfo.State.VerifiedChains[0][0], true
+		}
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	secrets, certs := md.Get(forwardingSecretKey), md.Get(forwardedClientCertificateKey)
+	if len(secrets) != 1 || len(certs) != 1 ||
+		subtle.ConstantTimeCompare([]byte(secrets[0]), []byte(forwardingSecret)) != 1 {
+		return nil, false
+	}
+	der, err := base64.StdEncoding.DecodeString(certs[0])
+	if err != nil {
+		return nil, false
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, false
+	}
+	return cert, true
+}
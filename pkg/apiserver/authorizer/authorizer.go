@@ -29,6 +29,8 @@ const (
 	RequestWatch  RequestOperation = "watch"
 	RequestCreate RequestOperation = "create"
 	RequestDelete RequestOperation = "delete"
+	RequestUpdate RequestOperation = "update"
+	RequestPatch  RequestOperation = "patch"
 )
 
 type AuthorizationOption struct {
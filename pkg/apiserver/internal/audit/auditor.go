@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+
+	apiserverv1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+	"k8c.io/kubecarrier/pkg/apiserver/authorizer"
+)
+
+// Auditor records an audit event for every authenticated call.
+// Its interceptors have to run after authentication and before authorization,
+// so the authorization decision is recorded.
+type Auditor struct {
+	policy  *Policy
+	backend Backend
+}
+
+// NewAuditor creates an Auditor, auditing calls according to the policy.
+func NewAuditor(policy *Policy, backend Backend) *Auditor {
+	return &Auditor{
+		policy:  policy,
+		backend: backend,
+	}
+}
+
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		event := a.newEvent(ctx, info.FullMethod)
+		if event == nil {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		recordRequest(event, info.Server, req)
+		resp, err := handler(withEvent(ctx, event), req)
+		if err == nil && event.Level.GreaterOrEqual(auditinternal.LevelRequestResponse) {
+			event.ResponseObject = marshalMessage(resp)
+		}
+		a.finish(event, start, err)
+		return resp, err
+	}
+}
+
+func (a *Auditor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		event := a.newEvent(stream.Context(), info.FullMethod)
+		if event == nil {
+			return handler(srv, stream)
+		}
+		start := time.Now()
+		wrapper := &auditStream{
+			WrappedServerStream: grpc_middleware.WrapServerStream(stream),
+			event:               event,
+			srv:                 srv,
+		}
+		wrapper.WrappedContext = withEvent(stream.Context(), event)
+		err := handler(srv, wrapper)
+		a.finish(event, start, err)
+		return err
+	}
+}
+
+// auditStream records the first received message on the audit event.
+type auditStream struct {
+	*grpc_middleware.WrappedServerStream
+	event *Event
+	srv   interface{}
+	once  sync.Once
+}
+
+func (s *auditStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.once.Do(func() {
+		recordRequest(s.event, s.srv, m)
+	})
+	return nil
+}
+
+// newEvent returns a new audit event for the call or nil, if the call should not be audited.
+func (a *Auditor) newEvent(ctx context.Context, method string) *Event {
+	user, err := auth.ExtractUserInfo(ctx)
+	if err != nil {
+		return nil
+	}
+	level := a.policy.Level(method, user.GetName(), user.GetGroups())
+	if level == auditinternal.LevelNone {
+		return nil
+	}
+	event := &Event{
+		Level:   level,
+		AuditID: uuid.NewUUID(),
+		Method:  method,
+		User: authenticationv1.UserInfo{
+			Username: user.GetName(),
+			UID:      user.GetUID(),
+			Groups:   user.GetGroups(),
+		},
+		RequestReceivedTimestamp: metav1.NowMicro(),
+	}
+	for key, values := range user.GetExtra() {
+		if event.User.Extra == nil {
+			event.User.Extra = map[string]authenticationv1.ExtraValue{}
+		}
+		event.User.Extra[key] = values
+	}
+	return event
+}
+
+func recordRequest(event *Event, srv interface{}, req interface{}) {
+	if accountReq, ok := req.(apiserverv1.AccountGetter); ok {
+		event.Account = accountReq.GetAccount()
+	}
+	if authReq, ok := req.(authorizer.AuthRequest); ok {
+		opts := authReq.GetAuthOption()
+		gvr := authReq.GetGVR(srv)
+		event.ObjectRef = &ObjectReference{
+			Resource:   gvr.Resource,
+			APIGroup:   gvr.Group,
+			APIVersion: gvr.Version,
+			Namespace:  opts.Namespace,
+			Name:       opts.Name,
+			Verb:       string(opts.Verb),
+		}
+	}
+	if event.Level.GreaterOrEqual(auditinternal.LevelRequest) {
+		event.RequestObject = marshalMessage(req)
+	}
+}
+
+func (a *Auditor) finish(event *Event, start time.Time, err error) {
+	event.Latency = metav1.Duration{Duration: time.Since(start)}
+	s := status.Convert(err)
+	event.ResponseStatus = ResponseStatus{
+		Code:    s.Code().String(),
+		Message: s.Message(),
+	}
+	a.backend.ProcessEvent(event)
+}
+
+var marshaler = &jsonpb.Marshaler{}
+
+func marshalMessage(msg interface{}) json.RawMessage {
+	pb, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if resp, ok := pb.(*apiserverv1.APIKeyCreateResponse); ok && resp.Key != "" {
+		// never record the secret of new APIKeys
+		resp = proto.Clone(resp).(*apiserverv1.APIKeyCreateResponse)
+		resp.Key = "<redacted>"
+		pb = resp
+	}
+	data, err := marshaler.MarshalToString(pb)
+	if err != nil {
+		return nil
+	}
+	return json.RawMessage(data)
+}
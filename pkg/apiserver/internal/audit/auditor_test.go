@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	apiserverv1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+type fakeAuthProvider struct{}
+
+func (fakeAuthProvider) AddFlags(fs *pflag.FlagSet) {}
+func (fakeAuthProvider) Init() error                { return nil }
+func (fakeAuthProvider) Authenticate(ctx context.Context) (user.Info, error) {
+	return &user.DefaultInfo{
+		Name:   "alice",
+		Groups: []string{"admins"},
+	}, nil
+}
+
+type fakeBackend struct {
+	events []*Event
+}
+
+func (b *fakeBackend) ProcessEvent(event *Event) {
+	b.events = append(b.events, event)
+}
+
+type fakeGVRServer struct{}
+
+func (fakeGVRServer) GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "catalog.kubecarrier.io", Version: "v1alpha1", Resource: "offerings"}
+}
+
+func TestAuditorUnaryServerInterceptor(t *testing.T) {
+	ctx, err := auth.CreateAuthFunction([]auth.Provider{fakeAuthProvider{}})(context.Background())
+	require.NoError(t, err)
+	req := &apiserverv1.GetRequest{
+		Name:    "test-offering",
+		Account: "test-account",
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     fakeGVRServer{},
+		FullMethod: "/kubecarrier.api.v1.OfferingService/Get",
+	}
+
+	tests := []struct {
+		name                  string
+		level                 auditinternal.Level
+		allowed               bool
+		expectedRequestObject string
+		expectedCode          string
+	}{
+		{
+			name:         "metadata",
+			level:        auditinternal.LevelMetadata,
+			allowed:      true,
+			expectedCode: "OK",
+		},
+		{
+			name:                  "request",
+			level:                 auditinternal.LevelRequest,
+			allowed:               false,
+			expectedRequestObject: `{"name":"test-offering","account":"test-account"}`,
+			expectedCode:          "PermissionDenied",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backend := &fakeBackend{}
+			auditor := NewAuditor(&Policy{
+				Rules: []PolicyRule{{Level: test.level}},
+			}, backend)
+
+			_, _ = auditor.UnaryServerInterceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				RecordAuthorizationDecision(ctx, test.allowed, "")
+				if !test.allowed {
+					return nil, status.Error(codes.PermissionDenied, "permission denied")
+				}
+				return &apiserverv1.Offering{}, nil
+			})
+
+			require.Len(t, backend.events, 1)
+			event := backend.events[0]
+			assert.Equal(t, test.level, event.Level)
+			assert.NotEmpty(t, event.AuditID)
+			assert.Equal(t, "/kubecarrier.api.v1.OfferingService/Get", event.Method)
+			assert.Equal(t, "alice", event.User.Username)
+			assert.Equal(t, []string{"admins"}, event.User.Groups)
+			assert.Equal(t, "test-account", event.Account)
+			assert.Equal(t, &ObjectReference{
+				Resource:   "offerings",
+				APIGroup:   "catalog.kubecarrier.io",
+				APIVersion: "v1alpha1",
+				Namespace:  "test-account",
+				Name:       "test-offering",
+				Verb:       "get",
+			}, event.ObjectRef)
+			assert.Equal(t, test.allowed, event.Authorization.Decision == DecisionAllow)
+			assert.Equal(t, test.expectedCode, event.ResponseStatus.Code)
+			if test.expectedRequestObject == "" {
+				assert.Empty(t, event.RequestObject)
+			} else {
+				assert.JSONEq(t, test.expectedRequestObject, string(event.RequestObject))
+			}
+		})
+	}
+
+	t.Run("not audited", func(t *testing.T) {
+		backend := &fakeBackend{}
+		auditor := NewAuditor(&Policy{}, backend)
+		_, err := auditor.UnaryServerInterceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, backend.events)
+	})
+}
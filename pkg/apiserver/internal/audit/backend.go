@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Backend stores audit events.
+type Backend interface {
+	ProcessEvent(event *Event)
+}
+
+// Union returns a Backend sending events to all the given backends.
+func Union(backends ...Backend) Backend {
+	return union(backends)
+}
+
+type union []Backend
+
+func (u union) ProcessEvent(event *Event) {
+	for _, backend := range u {
+		backend.ProcessEvent(event)
+	}
+}
+
+// LogBackend writes events as JSON lines.
+type LogBackend struct {
+	log logr.Logger
+	mux sync.Mutex
+	out io.Writer
+}
+
+// NewLogBackend creates a LogBackend writing to out.
+func NewLogBackend(log logr.Logger, out io.Writer) *LogBackend {
+	return &LogBackend{
+		log: log,
+		out: out,
+	}
+}
+
+func (b *LogBackend) ProcessEvent(event *Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		b.log.Error(err, "marshalling audit event", "auditID", event.AuditID)
+		return
+	}
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if _, err := b.out.Write(append(data, '\n')); err != nil {
+		b.log.Error(err, "writing audit event", "auditID", event.AuditID)
+	}
+}
+
+const (
+	webhookBufferSize = 1000
+	webhookTimeout    = 10 * time.Second
+)
+
+// WebhookBackend posts events as JSON to a collector.
+// Events are sent in the background, so slow collectors don't block calls.
+// When the buffer is full, events are dropped.
+type WebhookBackend struct {
+	log    logr.Logger
+	url    string
+	client *http.Client
+	events chan *Event
+}
+
+// NewWebhookBackend creates a WebhookBackend posting to the given URL.
+func NewWebhookBackend(log logr.Logger, url string) *WebhookBackend {
+	return &WebhookBackend{
+		log:    log,
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		events: make(chan *Event, webhookBufferSize),
+	}
+}
+
+func (b *WebhookBackend) ProcessEvent(event *Event) {
+	select {
+	case b.events <- event:
+	default:
+		b.log.Error(fmt.Errorf("buffer is full"), "dropping audit event", "auditID", event.AuditID)
+	}
+}
+
+// Run sends buffered events until stop is closed.
+func (b *WebhookBackend) Run(stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case event := <-b.events:
+			if err := b.send(event); err != nil {
+				b.log.Error(err, "sending audit event", "auditID", event.AuditID)
+			}
+		}
+	}
+}
+
+func (b *WebhookBackend) send(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshalling: %w", err)
+	}
+	resp, err := b.client.Post(b.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
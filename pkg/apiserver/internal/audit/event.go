@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+)
+
+// Event is the audit record of a single gRPC call.
+type Event struct {
+	// Level the event was recorded at.
+	Level auditinternal.Level `json:"level"`
+	// AuditID is a unique ID of the call.
+	AuditID types.UID `json:"auditID"`
+	// Method is the full gRPC method name, e.g. /kubecarrier.api.v1.InstancesService/Create.
+	Method string `json:"method"`
+	// User is the authenticated user.
+	User authenticationv1.UserInfo `json:"user"`
+	// Account is the Account namespace the call was made in.
+	Account string `json:"account,omitempty"`
+	// ObjectRef is the target object of the call.
+	ObjectRef *ObjectReference `json:"objectRef,omitempty"`
+	// Authorization is the authorization decision of the call.
+	Authorization *Authorization `json:"authorization,omitempty"`
+	// ResponseStatus is the gRPC status of the call.
+	ResponseStatus ResponseStatus `json:"responseStatus"`
+	// RequestObject is the first request message, recorded at Request level and above.
+	RequestObject json.RawMessage `json:"requestObject,omitempty"`
+	// ResponseObject is the response message of unary calls, recorded at RequestResponse level.
+	ResponseObject json.RawMessage `json:"responseObject,omitempty"`
+	// RequestReceivedTimestamp is the time the call was received.
+	RequestReceivedTimestamp metav1.MicroTime `json:"requestReceivedTimestamp"`
+	// Latency of the call, for streams the time until the stream was closed.
+	Latency metav1.Duration `json:"latency"`
+}
+
+// ObjectReference references the target object of a call.
+type ObjectReference struct {
+	Resource   string `json:"resource,omitempty"`
+	APIGroup   string `json:"apiGroup,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Verb       string `json:"verb,omitempty"`
+}
+
+// Authorization holds the authorization decision of a call.
+type Authorization struct {
+	// Decision is either allow or forbid.
+	Decision string `json:"decision"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+const (
+	DecisionAllow  = "allow"
+	DecisionForbid = "forbid"
+)
+
+// ResponseStatus is the gRPC status of a call.
+type ResponseStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type contextKey string
+
+const eventKey contextKey = "event.audit.kubecarrier.io"
+
+func withEvent(ctx context.Context, event *Event) context.Context {
+	return context.WithValue(ctx, eventKey, event)
+}
+
+// RecordAuthorizationDecision records the authorization decision on the audit event of the call.
+// It does nothing if the call is not audited.
+func RecordAuthorizationDecision(ctx context.Context, allowed bool, reason string) {
+	event, ok := ctx.Value(eventKey).(*Event)
+	if !ok {
+		return
+	}
+	decision := DecisionForbid
+	if allowed {
+		decision = DecisionAllow
+	}
+	event.Authorization = &Authorization{
+		Decision: decision,
+		Reason:   reason,
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	"sigs.k8s.io/yaml"
+)
+
+// Policy controls the level calls are audited at.
+// Like a Kubernetes audit policy, the first matching rule determines the level
+// and calls matching no rule are not audited.
+type Policy struct {
+	Rules []PolicyRule `json:"rules"`
+}
+
+// PolicyRule maps calls to an audit level.
+// Empty lists match everything.
+type PolicyRule struct {
+	// Level calls matching this rule are audited at.
+	Level auditinternal.Level `json:"level"`
+	// Methods are full gRPC method names,
+	// "/kubecarrier.api.v1.InstancesService/*" matches all methods of a service and "*" matches all methods.
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+	// Users matched by this rule.
+	// +optional
+	Users []string `json:"users,omitempty"`
+	// UserGroups matched by this rule, a user matches if it's a member of any of them.
+	// +optional
+	UserGroups []string `json:"userGroups,omitempty"`
+}
+
+// DefaultPolicy audits all calls at Metadata level.
+var DefaultPolicy = &Policy{
+	Rules: []PolicyRule{
+		{Level: auditinternal.LevelMetadata},
+	},
+}
+
+// LoadPolicyFile reads a Policy from a YAML file.
+func LoadPolicyFile(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading audit policy: %w", err)
+	}
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, fmt.Errorf("parsing audit policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Validate checks the levels and method patterns of the Policy.
+func (p *Policy) Validate() error {
+	for i, rule := range p.Rules {
+		switch rule.Level {
+		case auditinternal.LevelNone, auditinternal.LevelMetadata,
+			auditinternal.LevelRequest, auditinternal.LevelRequestResponse:
+		default:
+			return fmt.Errorf("audit policy rule %d: invalid level %q", i, rule.Level)
+		}
+		for _, method := range rule.Methods {
+			if method != "*" && !strings.HasPrefix(method, "/") {
+				return fmt.Errorf("audit policy rule %d: method %q should be a full gRPC method name", i, method)
+			}
+		}
+	}
+	return nil
+}
+
+// Level returns the level to audit the call at.
+func (p *Policy) Level(method, user string, groups []string) auditinternal.Level {
+	for _, rule := range p.Rules {
+		if rule.matches(method, user, groups) {
+			return rule.Level
+		}
+	}
+	return auditinternal.LevelNone
+}
+
+func (r PolicyRule) matches(method, user string, groups []string) bool {
+	if len(r.Methods) > 0 && !matchesMethod(r.Methods, method) {
+		return false
+	}
+	if len(r.Users) > 0 && !contains(r.Users, user) {
+		return false
+	}
+	if len(r.UserGroups) > 0 {
+		for _, group := range groups {
+			if contains(r.UserGroups, group) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func matchesMethod(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" || pattern == method {
+			return true
+		}
+		if strings.HasSuffix(pattern, "/*") && strings.HasPrefix(method, strings.TrimSuffix(pattern, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+)
+
+func TestPolicyLevel(t *testing.T) {
+	policy := &Policy{
+		Rules: []PolicyRule{
+			{
+				Level:   auditinternal.LevelNone,
+				Methods: []string{"/kubecarrier.api.v1.Doc/*"},
+			},
+			{
+				Level:      auditinternal.LevelRequestResponse,
+				Methods:    []string{"/kubecarrier.api.v1.InstancesService/Create"},
+				UserGroups: []string{"admins"},
+			},
+			{
+				Level: auditinternal.LevelRequest,
+				Users: []string{"alice"},
+			},
+			{
+				Level:   auditinternal.LevelMetadata,
+				Methods: []string{"/kubecarrier.api.v1.InstancesService/*"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		user          string
+		groups        []string
+		expectedLevel auditinternal.Level
+	}{
+		{
+			name:          "first matching rule wins",
+			method:        "/kubecarrier.api.v1.Doc/Swagger",
+			user:          "alice",
+			expectedLevel: auditinternal.LevelNone,
+		},
+		{
+			name:          "user groups",
+			method:        "/kubecarrier.api.v1.InstancesService/Create",
+			user:          "bob",
+			groups:        []string{"system:authenticated", "admins"},
+			expectedLevel: auditinternal.LevelRequestResponse,
+		},
+		{
+			name:          "users",
+			method:        "/kubecarrier.api.v1.InstancesService/Create",
+			user:          "alice",
+			expectedLevel: auditinternal.LevelRequest,
+		},
+		{
+			name:          "service wildcard",
+			method:        "/kubecarrier.api.v1.InstancesService/Create",
+			user:          "bob",
+			expectedLevel: auditinternal.LevelMetadata,
+		},
+		{
+			name:          "no matching rule",
+			method:        "/kubecarrier.api.v1.OfferingService/List",
+			user:          "bob",
+			expectedLevel: auditinternal.LevelNone,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedLevel, policy.Level(test.method, test.user, test.groups))
+		})
+	}
+}
+
+func TestLoadPolicyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	path := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+rules:
+- level: None
+  methods: ["/kubecarrier.api.v1.Doc/*"]
+- level: Metadata
+`), 0600))
+	policy, err := LoadPolicyFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, &Policy{
+		Rules: []PolicyRule{
+			{Level: auditinternal.LevelNone, Methods: []string{"/kubecarrier.api.v1.Doc/*"}},
+			{Level: auditinternal.LevelMetadata},
+		},
+	}, policy)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+rules:
+- level: Everything
+`), 0600))
+	_, err = LoadPolicyFile(path)
+	assert.EqualError(t, err, `audit policy rule 0: invalid level "Everything"`)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+// Auth authenticates users by APIKeys and resolves them to the user of the APIKey.
+type Auth struct {
+	logr.Logger
+	client client.Client
+	now    func() time.Time
+}
+
+func init() {
+	auth.RegisterAuthProvider(auth.ProviderAPIKey, &Auth{})
+}
+
+var _ auth.Provider = (*Auth)(nil)
+var _ inject.Logger = (*Auth)(nil)
+var _ inject.Client = (*Auth)(nil)
+
+func (a *Auth) InjectLogger(l logr.Logger) error {
+	a.Logger = l
+	return nil
+}
+
+func (a *Auth) InjectClient(c client.Client) error {
+	a.client = c
+	return nil
+}
+
+func (a *Auth) AddFlags(fs *pflag.FlagSet) {}
+
+func (a *Auth) Init() error {
+	if a.client == nil {
+		return fmt.Errorf("missing client")
+	}
+	if a.now == nil {
+		a.now = time.Now
+	}
+	return nil
+}
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=apikeys,verbs=get
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=accounts,verbs=get
+
+func (a *Auth) Authenticate(ctx context.Context) (user.Info, error) {
+	token, err := grpc_auth.AuthFromMD(ctx, "Bearer")
+	if err != nil {
+		return nil, err
+	}
+	namespace, name, secret, err := auth.ParseAPIKey(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	apiKey := &catalogv1alpha1.APIKey{}
+	if err := a.client.Get(ctx, types.NamespacedName{
+		Name:      name,
+		Namespace: namespace,
+	}, apiKey); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Error(codes.Unauthenticated, "invalid APIKey")
+		}
+		return nil, status.Errorf(codes.Internal, "getting APIKey: %s", err.Error())
+	}
+	if !auth.VerifyAPIKeySecret(secret, apiKey.Spec.SecretHash) {
+		return nil, status.Error(codes.Unauthenticated, "invalid APIKey")
+	}
+	if apiKey.IsExpired(a.now()) {
+		return nil, status.Error(codes.Unauthenticated, "APIKey is expired")
+	}
+
+	account, err := catalogv1alpha1.GetAccountByAccountNamespace(ctx, a.client, namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Error(codes.Unauthenticated, "Account of APIKey not found")
+		}
+		return nil, status.Errorf(codes.Internal, "getting Account: %s", err.Error())
+	}
+	if account.Status.Namespace == nil || account.Status.Namespace.Name != namespace {
+		return nil, status.Error(codes.Unauthenticated, "Account of APIKey not found")
+	}
+
+	scope := auth.APIKeyScopeReadWrite
+	if apiKey.Spec.ReadOnly {
+		scope = auth.APIKeyScopeReadOnly
+	}
+	// the user of the APIKey is bound to the Roles of the Account by the KubeCarrier manager
+	return &user.DefaultInfo{
+		Name: apiKey.UserName(),
+		UID:  string(apiKey.UID),
+		Extra: map[string][]string{
+			auth.ExtraAPIKey:        {name},
+			auth.ExtraAPIKeyAccount: {namespace},
+			auth.ExtraAPIKeyScope:   {scope},
+		},
+	}, nil
+}
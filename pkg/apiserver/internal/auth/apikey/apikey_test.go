@@ -0,0 +1,227 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+func TestAuthenticate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(catalogv1alpha1.AddToScheme(scheme))
+
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	account := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: catalogv1alpha1.AccountSpec{
+			Subjects: []catalogv1alpha1.AccountSubject{
+				{Subject: rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "team-a-admins"}},
+				{Subject: rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice"}},
+			},
+		},
+		Status: catalogv1alpha1.AccountStatus{
+			Namespace: &catalogv1alpha1.ObjectReference{Name: "team-a"},
+		},
+	}
+	validKey, validHash, err := auth.GenerateAPIKey("team-a", "ci")
+	require.NoError(t, err)
+	expiredKey, expiredHash, err := auth.GenerateAPIKey("team-a", "expired")
+	require.NoError(t, err)
+	orphanKey, orphanHash, err := auth.GenerateAPIKey("team-b", "ci")
+	require.NoError(t, err)
+	client := fake.NewFakeClientWithScheme(scheme, account,
+		&catalogv1alpha1.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team-a"},
+			Spec: catalogv1alpha1.APIKeySpec{
+				ExpiresAt:  &metav1.Time{Time: now.Add(time.Hour)},
+				ReadOnly:   true,
+				SecretHash: validHash,
+			},
+		},
+		&catalogv1alpha1.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "expired", Namespace: "team-a"},
+			Spec: catalogv1alpha1.APIKeySpec{
+				ExpiresAt:  &metav1.Time{Time: now.Add(-time.Hour)},
+				SecretHash: expiredHash,
+			},
+		},
+		&catalogv1alpha1.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team-b"},
+			Spec: catalogv1alpha1.APIKeySpec{
+				SecretHash: orphanHash,
+			},
+		},
+	)
+	a := &Auth{client: client, now: func() time.Time { return now }}
+	require.NoError(t, a.Init())
+
+	tests := []struct {
+		name         string
+		token        string
+		expectedUser user.Info
+		expectedCode codes.Code
+	}{
+		{
+			name:  "valid key",
+			token: validKey,
+			expectedUser: &user.DefaultInfo{
+				Name: "kubecarrier:apikey:team-a:ci",
+				Extra: map[string][]string{
+					auth.ExtraAPIKey:        {"ci"},
+					auth.ExtraAPIKeyAccount: {"team-a"},
+					auth.ExtraAPIKeyScope:   {auth.APIKeyScopeReadOnly},
+				},
+			},
+		},
+		{
+			name:         "wrong secret",
+			token:        validKey[:len(validKey)-4] + "AAAA",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "expired key",
+			token:        expiredKey,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "unknown key",
+			token:        "kcak_team-a_unknown_secret",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "missing account",
+			token:        orphanKey,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "other token",
+			token:        "eyJhbGciOiJSUzI1NiJ9.e30.c2lnbmF0dXJl",
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+test.token))
+			userInfo, err := a.Authenticate(ctx)
+			if test.expectedCode != codes.OK {
+				assert.Equal(t, test.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedUser, userInfo)
+		})
+	}
+}
+
+type fakeAuthProvider struct {
+	userInfo user.Info
+}
+
+func (fakeAuthProvider) AddFlags(fs *pflag.FlagSet) {}
+func (fakeAuthProvider) Init() error                { return nil }
+func (p fakeAuthProvider) Authenticate(ctx context.Context) (user.Info, error) {
+	return p.userInfo, nil
+}
+
+func TestCheckScope(t *testing.T) {
+	readOnly := &user.DefaultInfo{
+		Name: "alice",
+		Extra: map[string][]string{
+			auth.ExtraAPIKey:        {"ci"},
+			auth.ExtraAPIKeyAccount: {"team-a"},
+			auth.ExtraAPIKeyScope:   {auth.APIKeyScopeReadOnly},
+		},
+	}
+	readWrite := &user.DefaultInfo{
+		Name: "alice",
+		Extra: map[string][]string{
+			auth.ExtraAPIKey:        {"deploy"},
+			auth.ExtraAPIKeyAccount: {"team-a"},
+			auth.ExtraAPIKeyScope:   {auth.APIKeyScopeReadWrite},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		userInfo     user.Info
+		req          interface{}
+		expectedCode codes.Code
+	}{
+		{
+			name:     "read-only key lists",
+			userInfo: readOnly,
+			req:      &v1.ListRequest{Account: "team-a"},
+		},
+		{
+			name:         "read-only key deletes",
+			userInfo:     readOnly,
+			req:          &v1.DeleteRequest{Account: "team-a", Name: "test"},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "read-write key deletes",
+			userInfo: readWrite,
+			req:      &v1.DeleteRequest{Account: "team-a", Name: "test"},
+		},
+		{
+			name:         "key used for another account",
+			userInfo:     readWrite,
+			req:          &v1.GetRequest{Account: "team-b", Name: "test"},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "key lists accounts",
+			userInfo: readOnly,
+			req:      &v1.AccountListRequest{},
+		},
+		{
+			name:     "other users are not restricted",
+			userInfo: &user.DefaultInfo{Name: "bob"},
+			req:      &v1.DeleteRequest{Account: "team-b", Name: "test"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, err := auth.CreateAuthFunction([]auth.Provider{fakeAuthProvider{test.userInfo}})(context.Background())
+			require.NoError(t, err)
+			_, err = UnaryServerInterceptor()(ctx, test.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			assert.Equal(t, test.expectedCode, status.Code(err))
+		})
+	}
+}
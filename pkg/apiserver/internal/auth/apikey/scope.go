@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+	"k8c.io/kubecarrier/pkg/apiserver/authorizer"
+)
+
+// UnaryServerInterceptor restricts calls authenticated by an APIKey to the scope of the APIKey.
+// It has to run after authentication.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkScope(ctx, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor restricts streams authenticated by an APIKey to the scope of the APIKey.
+// It has to run after authentication.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &recvWrapper{stream})
+	}
+}
+
+type recvWrapper struct {
+	grpc.ServerStream
+}
+
+func (s *recvWrapper) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkScope(s.Context(), m)
+}
+
+// checkScope ensures that APIKeys are only used for their own Account
+// and that read-only APIKeys are only used to read objects.
+// Calls that are not scoped to an Account (e.g. listing Accounts) don't change anything and are always allowed.
+func checkScope(ctx context.Context, req interface{}) error {
+	userInfo, err := auth.ExtractUserInfo(ctx)
+	if err != nil {
+		return err
+	}
+	extra := userInfo.GetExtra()
+	if len(extra[auth.ExtraAPIKey]) == 0 {
+		// not authenticated by an APIKey
+		return nil
+	}
+	authReq, ok := req.(authorizer.AuthRequest)
+	if !ok {
+		return nil
+	}
+	opts := authReq.GetAuthOption()
+	if account := extra[auth.ExtraAPIKeyAccount]; len(account) != 1 || opts.Namespace != account[0] {
+		return status.Errorf(codes.PermissionDenied, "APIKey is not valid for account %q", opts.Namespace)
+	}
+	if scope := extra[auth.ExtraAPIKeyScope]; len(scope) != 1 || scope[0] != auth.APIKeyScopeReadWrite {
+		switch opts.Verb {
+		case authorizer.RequestGet, authorizer.RequestList, authorizer.RequestWatch:
+		default:
+			return status.Errorf(codes.PermissionDenied, "APIKey is read-only, %s is not allowed", opts.Verb)
+		}
+	}
+	return nil
+}
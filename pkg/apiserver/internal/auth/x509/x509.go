@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package x509
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+// Auth authenticates users by X.509 client certificates,
+// the CommonName is used as user name and the Organizations as groups.
+type Auth struct {
+	clientCAFile string
+	clientCAs    *x509.CertPool
+}
+
+var _ auth.Provider = (*Auth)(nil)
+var _ auth.TLSConfigurer = (*Auth)(nil)
+
+func init() {
+	auth.RegisterAuthProvider(auth.ProviderX509, &Auth{})
+}
+
+func (a *Auth) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&a.clientCAFile, "client-ca-file", "", "PEM encoded CA bundle to verify client certificates against.")
+}
+
+func (a *Auth) Init() error {
+	if a.clientCAFile == "" {
+		return fmt.Errorf("--client-ca-file is required")
+	}
+	pem, err := ioutil.ReadFile(a.clientCAFile)
+	if err != nil {
+		return fmt.Errorf("reading client CA file: %w", err)
+	}
+	a.clientCAs = x509.NewCertPool()
+	if !a.clientCAs.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no certificates found in client CA file %s", a.clientCAFile)
+	}
+	return nil
+}
+
+// ConfigureTLS makes the server verify client certificates against the client CA bundle.
+// Client certificates stay optional, so other providers can authenticate clients without one.
+func (a *Auth) ConfigureTLS(config *tls.Config) error {
+	config.ClientAuth = tls.VerifyClientCertIfGiven
+	config.ClientCAs = a.clientCAs
+	return nil
+}
+
+func (a *Auth) Authenticate(ctx context.Context) (user.Info, error) {
+	cert, ok := auth.VerifiedClientCertificate(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	if cert.Subject.CommonName == "" {
+		return nil, status.Error(codes.Unauthenticated, "client certificate has no CommonName")
+	}
+	return &user.DefaultInfo{
+		Name:   cert.Subject.CommonName,
+		Groups: cert.Subject.Organization,
+	}, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package x509
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+func TestAuthenticate(t *testing.T) {
+	caCert, caKey := newCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+	clientCert, _ := newCertificate(t, &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:   "automation",
+			Organization: []string{"team-a", "team-b"},
+		},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCert, caKey)
+
+	dir, err := ioutil.TempDir("", "x509")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	caFile := filepath.Join(dir, "ca.crt")
+	require.NoError(t, ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}), 0600))
+
+	a := &Auth{clientCAFile: caFile}
+	require.NoError(t, a.Init())
+	tlsConfig := &tls.Config{}
+	require.NoError(t, a.ConfigureTLS(tlsConfig))
+	assert.Equal(t, tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth)
+	chains, err := clientCert.Verify(x509.VerifyOptions{
+		Roots:     tlsConfig.ClientCAs,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+	state := tls.ConnectionState{VerifiedChains: chains}
+	expectedUser := &user.DefaultInfo{
+		Name:   "automation",
+		Groups: []string{"team-a", "team-b"},
+	}
+
+	t.Run("gRPC peer", func(t *testing.T) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: state},
+		})
+		userInfo, err := a.Authenticate(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, userInfo)
+	})
+
+	t.Run("forwarded by the REST gateway", func(t *testing.T) {
+		md := auth.ForwardClientCertificate(context.Background(), &http.Request{TLS: &state})
+		userInfo, err := a.Authenticate(metadata.NewIncomingContext(context.Background(), md))
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, userInfo)
+	})
+
+	t.Run("forwarded without secret", func(t *testing.T) {
+		md := auth.ForwardClientCertificate(context.Background(), &http.Request{TLS: &state})
+		md.Set("x-kubecarrier-forwarding-secret", "guessed")
+		_, err := a.Authenticate(metadata.NewIncomingContext(context.Background(), md))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("no client certificate", func(t *testing.T) {
+		_, err := a.Authenticate(context.Background())
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func newCertificate(t *testing.T, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
@@ -32,6 +32,7 @@ import (
 
 	"k8c.io/kubecarrier/pkg/apiserver/auth"
 	"k8c.io/kubecarrier/pkg/apiserver/authorizer"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/audit"
 )
 
 type Authorizer struct {
@@ -122,6 +123,7 @@ func (a Authorizer) Authorize(
 	if err := a.client.Create(ctx, review); err != nil {
 		return fmt.Errorf("creating SubjectAccessReview: %s", err)
 	}
+	audit.RecordAuthorizationDecision(ctx, review.Status.Allowed, review.Status.Reason)
 	if !review.Status.Allowed {
 		return fmt.Errorf("permission denied")
 	}
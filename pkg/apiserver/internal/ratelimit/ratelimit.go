@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	apiserverv1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+const (
+	// RetryAfterHeader is the response header with the number of seconds to wait before retrying.
+	RetryAfterHeader = "retry-after"
+	// streamRetryAfter is the retry hint for rejected streams, as we can't know when other streams end.
+	streamRetryAfter = 5 * time.Second
+	// idleLimiterTTL is the time after which limiters of idle users are removed.
+	idleLimiterTTL = 10 * time.Minute
+	// maxBuckets bounds the number of token buckets, the least recently used bucket is removed when exceeded.
+	maxBuckets = 10000
+)
+
+// Config configures the Limiter, zero values disable the respective limit.
+type Config struct {
+	// QPS is the number of unary calls per second allowed per user and account.
+	QPS float64
+	// Burst is the maximum number of unary calls allowed at once per user and account.
+	Burst int
+	// MaxConcurrentStreams is the maximum number of open streams per user and account.
+	MaxConcurrentStreams int
+}
+
+// Enabled returns true if any limit is configured.
+func (c Config) Enabled() bool {
+	return c.QPS > 0 || c.MaxConcurrentStreams > 0
+}
+
+// Limiter limits calls per authenticated user and account.
+// It runs after authentication and before authorization, the account is taken from the request.
+// As accounts are not yet authorized, the number of token buckets is bounded and the least recently used are evicted.
+// Unary calls are limited by a token bucket and streams by the number of concurrently open streams.
+// Rejected calls fail with codes.ResourceExhausted and carry a retry hint.
+type Limiter struct {
+	config Config
+	now    func() time.Time
+
+	mux       sync.Mutex
+	buckets   map[string]*bucket
+	streams   map[string]int
+	lastPrune time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewLimiter creates a new Limiter.
+func NewLimiter(config Config) *Limiter {
+	if config.Burst < 1 {
+		config.Burst = int(math.Ceil(config.QPS))
+	}
+	return &Limiter{
+		config:  config,
+		now:     time.Now,
+		buckets: map[string]*bucket{},
+		streams: map[string]int{},
+	}
+}
+
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if l.config.QPS <= 0 {
+			return handler(ctx, req)
+		}
+		key, err := limiterKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if delay, ok := l.allow(key); !ok {
+			return nil, exhausted(ctx, delay, "rate limit of %g calls per second exceeded", l.config.QPS)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if l.config.MaxConcurrentStreams <= 0 {
+			return handler(srv, stream)
+		}
+		wrapper := &limitedStream{ServerStream: stream, l: l}
+		defer wrapper.release()
+		return handler(srv, wrapper)
+	}
+}
+
+// limitedStream acquires a stream slot, when the first message tells the account of the stream.
+type limitedStream struct {
+	grpc.ServerStream
+	l   *Limiter
+	key string
+}
+
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.key != "" {
+		return nil
+	}
+	key, err := limiterKey(s.Context(), m)
+	if err != nil {
+		return err
+	}
+	if !s.l.acquireStream(key) {
+		return exhausted(s.Context(), streamRetryAfter, "maximum of %d concurrent streams exceeded", s.l.config.MaxConcurrentStreams)
+	}
+	s.key = key
+	return nil
+}
+
+func (s *limitedStream) release() {
+	if s.key != "" {
+		s.l.releaseStream(s.key)
+	}
+}
+
+// allow takes a token of the key's bucket, or returns the time until a token is available.
+func (l *Limiter) allow(key string) (time.Duration, bool) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	now := l.now()
+	l.prune(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxBuckets {
+			l.evictOldest()
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.config.QPS), l.config.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	r := b.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// prune removes buckets of idle keys, a refilled bucket is the same as a new one.
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) > idleLimiterTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// evictOldest removes the least recently used bucket.
+func (l *Limiter) evictOldest() {
+	var (
+		oldestKey  string
+		oldestSeen time.Time
+	)
+	for key, b := range l.buckets {
+		if oldestKey == "" || b.lastSeen.Before(oldestSeen) {
+			oldestKey, oldestSeen = key, b.lastSeen
+		}
+	}
+	delete(l.buckets, oldestKey)
+}
+
+func (l *Limiter) acquireStream(key string) bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	if l.streams[key] >= l.config.MaxConcurrentStreams {
+		return false
+	}
+	l.streams[key]++
+	return true
+}
+
+func (l *Limiter) releaseStream(key string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.streams[key]--
+	if l.streams[key] <= 0 {
+		delete(l.streams, key)
+	}
+}
+
+// limiterKey identifies the user and account of the call.
+func limiterKey(ctx context.Context, req interface{}) (string, error) {
+	user, err := auth.ExtractUserInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	var account string
+	if accountReq, ok := req.(apiserverv1.AccountGetter); ok {
+		account = accountReq.GetAccount()
+	}
+	return user.GetName() + "/" + account, nil
+}
+
+// exhausted returns a codes.ResourceExhausted error, with the retry delay as RetryInfo detail and retry-after header.
+func exhausted(ctx context.Context, retryAfter time.Duration, format string, args ...interface{}) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.FormatInt(seconds, 10)))
+	s := status.New(codes.ResourceExhausted, fmt.Sprintf(format, args...)+fmt.Sprintf(", retry after %ds", seconds))
+	if withDetails, err := s.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: ptypes.DurationProto(time.Duration(seconds) * time.Second),
+	}); err == nil {
+		s = withDetails
+	}
+	return s.Err()
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	apiserverv1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+type fakeAuthProvider struct{}
+
+func (fakeAuthProvider) AddFlags(fs *pflag.FlagSet) {}
+func (fakeAuthProvider) Init() error                { return nil }
+func (fakeAuthProvider) Authenticate(ctx context.Context) (user.Info, error) {
+	return &user.DefaultInfo{Name: "alice"}, nil
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *apiserverv1.WatchRequest
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) RecvMsg(m interface{}) error {
+	*m.(*apiserverv1.WatchRequest) = *s.req
+	return nil
+}
+
+func TestLimiterUnary(t *testing.T) {
+	ctx, err := auth.CreateAuthFunction([]auth.Provider{fakeAuthProvider{}})(context.Background())
+	require.NoError(t, err)
+	now := time.Now()
+	l := NewLimiter(Config{QPS: 1, Burst: 2})
+	l.now = func() time.Time { return now }
+
+	interceptor := l.UnaryServerInterceptor()
+	call := func(account string) error {
+		_, err := interceptor(ctx, &apiserverv1.ListRequest{Account: account}, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+		return err
+	}
+
+	// burst
+	require.NoError(t, call("team-a"))
+	require.NoError(t, call("team-a"))
+	err = call("team-a")
+	s := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, s.Code())
+	if assert.Len(t, s.Details(), 1) {
+		retryInfo := s.Details()[0].(*errdetails.RetryInfo)
+		assert.Equal(t, int64(1), retryInfo.RetryDelay.Seconds)
+	}
+
+	// other accounts have their own bucket
+	require.NoError(t, call("team-b"))
+
+	// tokens are refilled
+	now = now.Add(time.Second)
+	require.NoError(t, call("team-a"))
+}
+
+func TestLimiterStreams(t *testing.T) {
+	ctx, err := auth.CreateAuthFunction([]auth.Provider{fakeAuthProvider{}})(context.Background())
+	require.NoError(t, err)
+	l := NewLimiter(Config{MaxConcurrentStreams: 1})
+	interceptor := l.StreamServerInterceptor()
+
+	open := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- interceptor(nil, &fakeStream{ctx: ctx, req: &apiserverv1.WatchRequest{Account: "team-a"}}, &grpc.StreamServerInfo{},
+			func(srv interface{}, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(&apiserverv1.WatchRequest{}); err != nil {
+					return err
+				}
+				open <- struct{}{}
+				<-open
+				return nil
+			})
+	}()
+	<-open
+
+	watch := func() error {
+		return interceptor(nil, &fakeStream{ctx: ctx, req: &apiserverv1.WatchRequest{Account: "team-a"}}, &grpc.StreamServerInfo{},
+			func(srv interface{}, stream grpc.ServerStream) error {
+				return stream.RecvMsg(&apiserverv1.WatchRequest{})
+			})
+	}
+	assert.Equal(t, codes.ResourceExhausted, status.Code(watch()))
+
+	// closing the first stream frees its slot
+	open <- struct{}{}
+	require.NoError(t, <-done)
+	assert.NoError(t, watch())
+}
+
+func TestLimiterMaxBuckets(t *testing.T) {
+	now := time.Now()
+	l := NewLimiter(Config{QPS: 1})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < maxBuckets; i++ {
+		_, ok := l.allow(strconv.Itoa(i))
+		require.True(t, ok)
+		now = now.Add(time.Millisecond)
+	}
+	_, ok := l.allow("new")
+	require.True(t, ok)
+	assert.Len(t, l.buckets, maxBuckets)
+	assert.NotContains(t, l.buckets, "0", "least recently used bucket should be removed")
+	assert.Contains(t, l.buckets, "new")
+}
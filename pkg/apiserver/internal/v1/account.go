@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
@@ -53,27 +54,35 @@ func (o accountServer) GetGVR() schema.GroupVersionResource {
 }
 
 func (o accountServer) List(ctx context.Context, req *v1.AccountListRequest) (res *v1.AccountList, err error) {
-	user, err := auth.ExtractUserInfo(ctx)
+	userInfo, err := auth.ExtractUserInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return o.handleListRequest(ctx, req, user.GetName())
+	return o.handleListRequest(ctx, req, userInfo)
 }
 
-func (o accountServer) handleListRequest(ctx context.Context, req *v1.AccountListRequest, username string) (res *v1.AccountList, err error) {
+func (o accountServer) handleListRequest(ctx context.Context, req *v1.AccountListRequest, userInfo user.Info) (res *v1.AccountList, err error) {
 	listOptions, err := req.GetListOptions()
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	accountByUsernameListOption(username).ApplyToList(listOptions)
-	accountList := &catalogv1alpha1.AccountList{}
-	if err := o.client.List(ctx, accountList, listOptions); err != nil {
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	accountList, err := listAccountsOfUser(ctx, o.client, userInfo, listOptions)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "listing accounts: %s", err.Error())
 	}
+	totalCount, err := applyListQuery(accountList, listQuery)
+	if err != nil {
+		return nil, err
+	}
 	res, err = o.convertAccountList(accountList)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "converting AccountList: %s", err.Error())
 	}
+	res.Metadata.TotalCount = totalCount
 	return
 }
 
@@ -124,6 +133,7 @@ func (o accountServer) convertAccount(in *catalogv1alpha1.Account) (out *v1.Acco
 			ApiGroup:  subject.APIGroup,
 			Name:      subject.Name,
 			Namespace: subject.Namespace,
+			Role:      string(subject.GetRole()),
 		})
 	}
 	for _, condition := range in.Status.Conditions {
@@ -21,14 +21,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
 )
 
 func TestListAccount(t *testing.T) {
@@ -51,12 +54,12 @@ func TestListAccount(t *testing.T) {
 					Roles: []catalogv1alpha1.AccountRole{
 						catalogv1alpha1.ProviderRole,
 					},
-					Subjects: []rbacv1.Subject{
-						{
+					Subjects: []catalogv1alpha1.AccountSubject{
+						{Subject: rbacv1.Subject{
 							Kind:     rbacv1.GroupKind,
 							APIGroup: "rbac.authorization.k8s.io",
 							Name:     "provider1",
-						},
+						}},
 					},
 				},
 				Status: catalogv1alpha1.AccountStatus{
@@ -87,12 +90,12 @@ func TestListAccount(t *testing.T) {
 					Roles: []catalogv1alpha1.AccountRole{
 						catalogv1alpha1.TenantRole,
 					},
-					Subjects: []rbacv1.Subject{
-						{
+					Subjects: []catalogv1alpha1.AccountSubject{
+						{Subject: rbacv1.Subject{
 							Kind:     rbacv1.GroupKind,
 							APIGroup: "rbac.authorization.k8s.io",
 							Name:     "tenant1",
-						},
+						}},
 					},
 				},
 				Status: catalogv1alpha1.AccountStatus{
@@ -135,6 +138,7 @@ func TestListAccount(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      2,
 				},
 				Items: []*v1.Account{
 					{
@@ -159,6 +163,7 @@ func TestListAccount(t *testing.T) {
 									Kind:     "Group",
 									ApiGroup: "rbac.authorization.k8s.io",
 									Name:     "provider1",
+									Role:     "Admin",
 								},
 							},
 						},
@@ -199,6 +204,7 @@ func TestListAccount(t *testing.T) {
 									Kind:     "Group",
 									ApiGroup: "rbac.authorization.k8s.io",
 									Name:     "tenant1",
+									Role:     "Admin",
 								},
 							},
 						},
@@ -230,6 +236,7 @@ func TestListAccount(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      1,
 				},
 				Items: []*v1.Account{
 					{
@@ -254,6 +261,7 @@ func TestListAccount(t *testing.T) {
 									Kind:     "Group",
 									ApiGroup: "rbac.authorization.k8s.io",
 									Name:     "provider1",
+									Role:     "Admin",
 								},
 							},
 						},
@@ -278,10 +286,128 @@ func TestListAccount(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			// Here just pass empty user since fakeClient doesn't work with field indexer, here we just test the basic functionality.
-			accounts, err := accountServer.handleListRequest(ctx, test.req, "")
+			// fakeClient doesn't work with field indexer, so the accounts are only filtered by membership.
+			accounts, err := accountServer.handleListRequest(ctx, test.req, &user.DefaultInfo{
+				Name:   "alice",
+				Groups: []string{"provider1", "tenant1"},
+			})
 			assert.Equal(t, test.expectedError, err)
 			assert.Equal(t, test.expectedResult, accounts)
 		})
 	}
 }
+
+func TestListAccountPagination(t *testing.T) {
+	newAccount := func(name string, subject rbacv1.Subject) *catalogv1alpha1.Account {
+		return &catalogv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: catalogv1alpha1.AccountSpec{
+				Subjects: []catalogv1alpha1.AccountSubject{{Subject: subject}},
+			},
+		}
+	}
+	client := fakeclient.NewFakeClientWithScheme(testScheme,
+		newAccount("account-c", rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "group-b"}),
+		newAccount("account-a", rbacv1.Subject{Kind: rbacv1.UserKind, Name: "alice"}),
+		newAccount("account-b", rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "group-a"}),
+		newAccount("account-d", rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "group-c"}),
+	)
+	accountServer := accountServer{
+		client: client,
+	}
+	ctx := context.Background()
+	userInfo := &user.DefaultInfo{
+		Name:   "alice",
+		Groups: []string{"group-a", "group-b"},
+	}
+
+	// every Account is returned once across the pages of the merged subject queries
+	var (
+		names     []string
+		continued string
+	)
+	for page := 0; page < 5; page++ {
+		accounts, err := accountServer.handleListRequest(ctx, &v1.AccountListRequest{
+			Limit:    1,
+			Continue: continued,
+		}, userInfo)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), accounts.Metadata.TotalCount)
+		for _, account := range accounts.Items {
+			names = append(names, account.Metadata.Name)
+		}
+		continued = accounts.Metadata.Continue
+		if continued == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"account-a", "account-b", "account-c"}, names)
+}
+
+func TestIsAccountMember(t *testing.T) {
+	account := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: catalogv1alpha1.AccountSpec{
+			Subjects: []catalogv1alpha1.AccountSubject{
+				{Subject: rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice"}},
+				{Subject: rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "team-a"}},
+				{Subject: rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "ci", Name: "deployer"}},
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		userInfo user.Info
+		expected bool
+	}{
+		{
+			name:     "user subject",
+			userInfo: &user.DefaultInfo{Name: "alice"},
+			expected: true,
+		},
+		{
+			name:     "group subject",
+			userInfo: &user.DefaultInfo{Name: "bob", Groups: []string{"team-b", "team-a"}},
+			expected: true,
+		},
+		{
+			name:     "service account subject",
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:ci:deployer"},
+			expected: true,
+		},
+		{
+			name:     "user named like a group",
+			userInfo: &user.DefaultInfo{Name: "team-a"},
+			expected: false,
+		},
+		{
+			name:     "group named like a user",
+			userInfo: &user.DefaultInfo{Name: "bob", Groups: []string{"alice"}},
+			expected: false,
+		},
+		{
+			name:     "service account name without namespace",
+			userInfo: &user.DefaultInfo{Name: "deployer"},
+			expected: false,
+		},
+		{
+			name: "APIKey of the account",
+			userInfo: &user.DefaultInfo{Name: "kubecarrier:apikey:team-a:ci", Extra: map[string][]string{
+				auth.ExtraAPIKeyAccount: {"team-a"},
+			}},
+			expected: true,
+		},
+		{
+			name: "APIKey of another account",
+			userInfo: &user.DefaultInfo{Name: "alice", Extra: map[string][]string{
+				auth.ExtraAPIKeyAccount: {"team-b"},
+			}},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isAccountMember(account, test.userInfo))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
+)
+
+type apiKeyServer struct {
+	client client.Client
+
+	gvr schema.GroupVersionResource
+}
+
+var _ v1.APIKeyServiceServer = (*apiKeyServer)(nil)
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=apikeys,verbs=get;list;watch;create;delete
+
+func NewAPIKeyServiceServer(c client.Client, restMapper meta.RESTMapper, scheme *runtime.Scheme) (v1.APIKeyServiceServer, error) {
+	apiKeyServer := &apiKeyServer{
+		client: c,
+	}
+	objGVK, err := apiutil.GVKForObject(&catalogv1alpha1.APIKey{}, scheme)
+	if err != nil {
+		return nil, err
+	}
+	restMapping, err := restMapper.RESTMapping(objGVK.GroupKind(), objGVK.Version)
+	if err != nil {
+		return nil, err
+	}
+	apiKeyServer.gvr = restMapping.Resource
+	return apiKeyServer, nil
+}
+
+func (o apiKeyServer) GetGVR() schema.GroupVersionResource {
+	return o.gvr
+}
+
+func (o apiKeyServer) CreateAPIKey(ctx context.Context, req *v1.APIKeyCreateRequest) (res *v1.APIKeyCreateResponse, err error) {
+	if err := denyAPIKeyUser(ctx); err != nil {
+		return nil, err
+	}
+	apiKey := &catalogv1alpha1.APIKey{
+		ObjectMeta: newObjectMeta(req.Spec.Metadata, req.Account),
+	}
+	if spec := req.Spec.Spec; spec != nil {
+		apiKey.Spec.Description = spec.Description
+		apiKey.Spec.ReadOnly = spec.ReadOnly
+		if spec.ExpiresAt != nil {
+			expiresAt, err := ptypes.Timestamp(spec.ExpiresAt)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid expiresAt: %s", err.Error())
+			}
+			apiKey.Spec.ExpiresAt = &metav1.Time{Time: expiresAt}
+		}
+	}
+	key, secretHash, err := auth.GenerateAPIKey(apiKey.Namespace, apiKey.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generating APIKey: %s", err.Error())
+	}
+	apiKey.Spec.SecretHash = secretHash
+	if err := o.client.Create(ctx, apiKey); err != nil {
+		return nil, status.Errorf(errorCode(err), "creating APIKey: %s", err.Error())
+	}
+	out, err := o.convertAPIKey(apiKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting APIKey: %s", err.Error())
+	}
+	return &v1.APIKeyCreateResponse{
+		ApiKey: out,
+		Key:    key,
+	}, nil
+}
+
+func (o apiKeyServer) ListAPIKeys(ctx context.Context, req *v1.ListRequest) (res *v1.APIKeyList, err error) {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	apiKeyList := &catalogv1alpha1.APIKeyList{}
+	if err := o.client.List(ctx, apiKeyList, listOptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "listing APIKeys: %s", err.Error())
+	}
+	totalCount, err := applyListQuery(apiKeyList, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = o.convertAPIKeyList(apiKeyList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting APIKeyList: %s", err.Error())
+	}
+	res.Metadata.TotalCount = totalCount
+	return
+}
+
+func (o apiKeyServer) RevokeAPIKey(ctx context.Context, req *v1.DeleteRequest) (*empty.Empty, error) {
+	if err := denyAPIKeyUser(ctx); err != nil {
+		return nil, err
+	}
+	apiKey := &catalogv1alpha1.APIKey{}
+	apiKey.Name = req.Name
+	apiKey.Namespace = req.Account
+	if err := o.client.Delete(ctx, apiKey); err != nil {
+		return nil, status.Errorf(errorCode(err), "revoking APIKey: %s", err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+// denyAPIKeyUser denies calls authenticated by an APIKey,
+// so an APIKey can't be used to create APIKeys that outlive it or to revoke other APIKeys.
+func denyAPIKeyUser(ctx context.Context) error {
+	userInfo, err := auth.ExtractUserInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if len(userInfo.GetExtra()[auth.ExtraAPIKey]) != 0 {
+		return status.Error(codes.PermissionDenied, "APIKeys can't be managed with an APIKey")
+	}
+	return nil
+}
+
+// convertAPIKey converts the APIKey without its secret hash.
+func (o apiKeyServer) convertAPIKey(in *catalogv1alpha1.APIKey) (out *v1.APIKey, err error) {
+	metadata, err := convertObjectMeta(in.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := util.TimestampProto(in.Spec.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	out = &v1.APIKey{
+		Metadata: metadata,
+		Spec: &v1.APIKeySpec{
+			Description: in.Spec.Description,
+			ExpiresAt:   expiresAt,
+			ReadOnly:    in.Spec.ReadOnly,
+		},
+	}
+	return
+}
+
+func (o apiKeyServer) convertAPIKeyList(in *catalogv1alpha1.APIKeyList) (out *v1.APIKeyList, err error) {
+	out = &v1.APIKeyList{
+		Metadata: convertListMeta(in.ListMeta),
+	}
+	for _, inAPIKey := range in.Items {
+		apiKey, err := o.convertAPIKey(&inAPIKey)
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, apiKey)
+	}
+	return
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/user"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+func TestCreateListRevokeAPIKey(t *testing.T) {
+	client := fakeclient.NewFakeClientWithScheme(testScheme)
+	apiKeyServer := apiKeyServer{
+		client: client,
+	}
+	ctx, err := auth.CreateAuthFunction([]auth.Provider{fakeAuthProvider{&user.DefaultInfo{
+		Name: "alice",
+	}}})(context.Background())
+	require.NoError(t, err)
+	res, err := apiKeyServer.CreateAPIKey(ctx, &v1.APIKeyCreateRequest{
+		Account: "test-namespace",
+		Spec: &v1.APIKey{
+			Metadata: &v1.ObjectMeta{Name: "ci"},
+			Spec: &v1.APIKeySpec{
+				Description: "CI pipeline",
+				ReadOnly:    true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &v1.APIKeySpec{
+		Description: "CI pipeline",
+		ReadOnly:    true,
+	}, res.ApiKey.Spec)
+
+	namespace, name, secret, err := auth.ParseAPIKey(res.Key)
+	require.NoError(t, err)
+	assert.Equal(t, "test-namespace", namespace)
+	assert.Equal(t, "ci", name)
+
+	apiKey := &catalogv1alpha1.APIKey{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "ci", Namespace: "test-namespace"}, apiKey))
+	assert.True(t, auth.VerifyAPIKeySecret(secret, apiKey.Spec.SecretHash), "secret should match the stored hash")
+	assert.NotContains(t, apiKey.Spec.SecretHash, secret, "secret should not be stored")
+
+	list, err := apiKeyServer.ListAPIKeys(ctx, &v1.ListRequest{Account: "test-namespace"})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "ci", list.Items[0].Metadata.Name)
+	assert.Equal(t, int64(1), list.Metadata.TotalCount)
+
+	_, err = apiKeyServer.RevokeAPIKey(ctx, &v1.DeleteRequest{Name: "ci", Account: "test-namespace"})
+	require.NoError(t, err)
+	list, err = apiKeyServer.ListAPIKeys(ctx, &v1.ListRequest{Account: "test-namespace"})
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+}
+
+func TestManageAPIKeyWithAPIKey(t *testing.T) {
+	apiKeyServer := apiKeyServer{
+		client: fakeclient.NewFakeClientWithScheme(testScheme, &catalogv1alpha1.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "test-namespace"},
+		}),
+	}
+	ctx, err := auth.CreateAuthFunction([]auth.Provider{fakeAuthProvider{&user.DefaultInfo{
+		Name: "kubecarrier:apikey:test-namespace:ci",
+		Extra: map[string][]string{
+			auth.ExtraAPIKey:        {"ci"},
+			auth.ExtraAPIKeyAccount: {"test-namespace"},
+			auth.ExtraAPIKeyScope:   {auth.APIKeyScopeReadWrite},
+		},
+	}}})(context.Background())
+	require.NoError(t, err)
+
+	_, err = apiKeyServer.CreateAPIKey(ctx, &v1.APIKeyCreateRequest{
+		Account: "test-namespace",
+		Spec: &v1.APIKey{
+			Metadata: &v1.ObjectMeta{Name: "forever"},
+			Spec:     &v1.APIKeySpec{},
+		},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = apiKeyServer.RevokeAPIKey(ctx, &v1.DeleteRequest{Name: "ci", Account: "test-namespace"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
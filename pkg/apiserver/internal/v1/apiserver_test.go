@@ -22,6 +22,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 )
 
 var testScheme = runtime.NewScheme()
@@ -30,4 +31,5 @@ func init() {
 	// setup scheme for all tests
 	utilruntime.Must(clientgoscheme.AddToScheme(testScheme))
 	utilruntime.Must(catalogv1alpha1.AddToScheme(testScheme))
+	utilruntime.Must(corev1alpha1.AddToScheme(testScheme))
 }
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
+)
+
+type catalogServer struct {
+	client        client.Client
+	dynamicClient dynamic.Interface
+	scheme        *runtime.Scheme
+
+	gvr schema.GroupVersionResource
+}
+
+var _ v1.CatalogServiceServer = (*catalogServer)(nil)
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogs,verbs=get;list;watch;create;update;delete
+
+func NewCatalogServiceServer(c client.Client, dynamicClient dynamic.Interface, restMapper meta.RESTMapper, scheme *runtime.Scheme) (v1.CatalogServiceServer, error) {
+	catalogServer := &catalogServer{
+		client:        c,
+		dynamicClient: dynamicClient,
+		scheme:        scheme,
+	}
+	objGVK, err := apiutil.GVKForObject(&catalogv1alpha1.Catalog{}, catalogServer.scheme)
+	if err != nil {
+		return nil, err
+	}
+	restMapping, err := restMapper.RESTMapping(objGVK.GroupKind(), objGVK.Version)
+	if err != nil {
+		return nil, err
+	}
+	catalogServer.gvr = restMapping.Resource
+	return catalogServer, nil
+}
+
+func (o catalogServer) GetGVR() schema.GroupVersionResource {
+	return o.gvr
+}
+
+func (o catalogServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.CatalogList, err error) {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	catalogList := &catalogv1alpha1.CatalogList{}
+	if err := o.client.List(ctx, catalogList, listOptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "listing catalogs: %s", err.Error())
+	}
+	totalCount, err := applyListQuery(catalogList, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = o.convertCatalogList(catalogList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogList: %s", err.Error())
+	}
+	res.Metadata.TotalCount = totalCount
+	return
+}
+
+func (o catalogServer) Get(ctx context.Context, req *v1.GetRequest) (res *v1.Catalog, err error) {
+	catalog := &catalogv1alpha1.Catalog{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, catalog); err != nil {
+		return nil, status.Errorf(errorCode(err), "getting catalog: %s", err.Error())
+	}
+	res, err = o.convertCatalog(catalog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting Catalog: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogServer) Create(ctx context.Context, req *v1.CatalogCreateRequest) (res *v1.Catalog, err error) {
+	catalog := &catalogv1alpha1.Catalog{
+		ObjectMeta: newObjectMeta(req.Spec.Metadata, req.Account),
+		Spec:       o.toCatalogSpec(req.Spec.Spec),
+	}
+	if err := o.client.Create(ctx, catalog); err != nil {
+		return nil, status.Errorf(errorCode(err), "creating catalog: %s", err.Error())
+	}
+	res, err = o.convertCatalog(catalog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting Catalog: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogServer) Update(ctx context.Context, req *v1.CatalogUpdateRequest) (res *v1.Catalog, err error) {
+	catalog := &catalogv1alpha1.Catalog{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, catalog); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating catalog: %s", err.Error())
+	}
+	updateObjectMeta(&catalog.ObjectMeta, req.Spec.Metadata)
+	catalog.Spec = o.toCatalogSpec(req.Spec.Spec)
+	if err := o.client.Update(ctx, catalog); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating catalog: %s", err.Error())
+	}
+	res, err = o.convertCatalog(catalog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting Catalog: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*empty.Empty, error) {
+	catalog := &catalogv1alpha1.Catalog{}
+	catalog.Name = req.Name
+	catalog.Namespace = req.Account
+	if err := o.client.Delete(ctx, catalog); err != nil {
+		return nil, status.Errorf(errorCode(err), "deleting catalog: %s", err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (o catalogServer) convertEvent(event runtime.Object) (*any.Any, error) {
+	catalogCatalog := &catalogv1alpha1.Catalog{}
+	if err := o.scheme.Convert(event, catalogCatalog, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "converting event.Object to Catalog: %s", err.Error())
+	}
+	catalog, err := o.convertCatalog(catalogCatalog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting Catalog: %s", err.Error())
+	}
+	any, err := ptypes.MarshalAny(catalog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling Catalog to Any: %s", err.Error())
+	}
+	return any, nil
+}
+
+func (o catalogServer) Watch(req *v1.WatchRequest, stream v1.CatalogService_WatchServer) error {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return watch(o.dynamicClient, o.gvr, req.Account, *listOptions.AsListOptions(), stream, o.convertEvent)
+}
+
+func (o catalogServer) toCatalogSpec(in *v1.CatalogSpec) (out catalogv1alpha1.CatalogSpec) {
+	if in == nil {
+		return
+	}
+	return catalogv1alpha1.CatalogSpec{
+		CatalogEntrySelector: toLabelSelector(in.CatalogEntrySelector),
+		TenantSelector:       toLabelSelector(in.TenantSelector),
+	}
+}
+
+func (o catalogServer) convertCatalog(in *catalogv1alpha1.Catalog) (out *v1.Catalog, err error) {
+	metadata, err := convertObjectMeta(in.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	out = &v1.Catalog{
+		Metadata: metadata,
+		Spec: &v1.CatalogSpec{
+			CatalogEntrySelector: convertLabelSelector(in.Spec.CatalogEntrySelector),
+			TenantSelector:       convertLabelSelector(in.Spec.TenantSelector),
+		},
+		Status: &v1.CatalogStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Phase:              string(in.Status.Phase),
+		},
+	}
+	for _, tenant := range in.Status.Tenants {
+		out.Status.Tenants = append(out.Status.Tenants, &v1.ObjectReference{Name: tenant.Name})
+	}
+	for _, entry := range in.Status.Entries {
+		out.Status.Entries = append(out.Status.Entries, &v1.ObjectReference{Name: entry.Name})
+	}
+	for _, condition := range in.Status.Conditions {
+		lastTransitionTime, err := util.TimestampProto(&condition.LastTransitionTime)
+		if err != nil {
+			return nil, err
+		}
+		out.Status.Conditions = append(out.Status.Conditions, &v1.Condition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: lastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	return
+}
+
+func (o catalogServer) convertCatalogList(in *catalogv1alpha1.CatalogList) (out *v1.CatalogList, err error) {
+	out = &v1.CatalogList{
+		Metadata: convertListMeta(in.ListMeta),
+	}
+	for _, inCatalog := range in.Items {
+		catalog, err := o.convertCatalog(&inCatalog)
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, catalog)
+	}
+	return
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+func TestGetCatalog(t *testing.T) {
+	catalog := &catalogv1alpha1.Catalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-catalog",
+			Namespace: "test-namespace",
+		},
+		Spec: catalogv1alpha1.CatalogSpec{
+			CatalogEntrySelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"kubecarrier.io/test": "entry"},
+			},
+			TenantSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "kubecarrier.io/tenant",
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{"tenant-a", "tenant-b"},
+					},
+				},
+			},
+		},
+		Status: catalogv1alpha1.CatalogStatus{
+			Tenants: []catalogv1alpha1.ObjectReference{{Name: "tenant-a"}},
+			Entries: []catalogv1alpha1.ObjectReference{{Name: "couchdbs"}},
+			Phase:   catalogv1alpha1.CatalogPhaseReady,
+		},
+	}
+	client := fakeclient.NewFakeClientWithScheme(testScheme, catalog)
+	catalogServer := catalogServer{
+		client: client,
+	}
+	ctx := context.Background()
+	tests := []struct {
+		name           string
+		req            *v1.GetRequest
+		expectedError  error
+		expectedResult *v1.Catalog
+	}{
+		{
+			name: "valid request",
+			req: &v1.GetRequest{
+				Name:    "test-catalog",
+				Account: "test-namespace",
+			},
+			expectedError: nil,
+			expectedResult: &v1.Catalog{
+				Metadata: &v1.ObjectMeta{
+					Name:    "test-catalog",
+					Account: "test-namespace",
+				},
+				Spec: &v1.CatalogSpec{
+					CatalogEntrySelector: &v1.LabelSelector{
+						MatchLabels: map[string]string{"kubecarrier.io/test": "entry"},
+					},
+					TenantSelector: &v1.LabelSelector{
+						MatchExpressions: []*v1.LabelSelectorRequirement{
+							{
+								Key:      "kubecarrier.io/tenant",
+								Operator: "In",
+								Values:   []string{"tenant-a", "tenant-b"},
+							},
+						},
+					},
+				},
+				Status: &v1.CatalogStatus{
+					Tenants: []*v1.ObjectReference{{Name: "tenant-a"}},
+					Entries: []*v1.ObjectReference{{Name: "couchdbs"}},
+					Phase:   "Ready",
+				},
+			},
+		},
+		{
+			name: "not found",
+			req: &v1.GetRequest{
+				Name:    "other-catalog",
+				Account: "test-namespace",
+			},
+			expectedError: status.Errorf(codes.NotFound, "getting catalog: catalogs.catalog.kubecarrier.io \"other-catalog\" not found"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			catalog, err := catalogServer.Get(ctx, test.req)
+			assert.Equal(t, test.expectedError, err)
+			assert.Equal(t, test.expectedResult, catalog)
+		})
+	}
+}
+
+func TestCreateUpdateDeleteCatalog(t *testing.T) {
+	client := fakeclient.NewFakeClientWithScheme(testScheme)
+	catalogServer := catalogServer{
+		client: client,
+	}
+	ctx := context.Background()
+
+	created, err := catalogServer.Create(ctx, &v1.CatalogCreateRequest{
+		Account: "test-namespace",
+		Spec: &v1.Catalog{
+			Metadata: &v1.ObjectMeta{
+				Name:   "test-catalog",
+				Labels: map[string]string{"test-label": "catalog"},
+			},
+			Spec: &v1.CatalogSpec{
+				TenantSelector: &v1.LabelSelector{},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "test-namespace", created.Metadata.Account)
+	assert.Equal(t, "1", created.Metadata.ResourceVersion)
+
+	_, err = catalogServer.Update(ctx, &v1.CatalogUpdateRequest{
+		Name:    "test-catalog",
+		Account: "test-namespace",
+		Spec: &v1.Catalog{
+			Metadata: &v1.ObjectMeta{Name: "test-catalog", ResourceVersion: "0"},
+		},
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err), "stale resourceVersion should be rejected")
+
+	updated, err := catalogServer.Update(ctx, &v1.CatalogUpdateRequest{
+		Name:    "test-catalog",
+		Account: "test-namespace",
+		Spec: &v1.Catalog{
+			Metadata: &v1.ObjectMeta{Name: "test-catalog", ResourceVersion: created.Metadata.ResourceVersion},
+			Spec: &v1.CatalogSpec{
+				CatalogEntrySelector: &v1.LabelSelector{
+					MatchLabels: map[string]string{"kubecarrier.io/test": "entry"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &v1.CatalogSpec{
+		CatalogEntrySelector: &v1.LabelSelector{
+			MatchLabels: map[string]string{"kubecarrier.io/test": "entry"},
+		},
+	}, updated.Spec)
+	assert.Empty(t, updated.Metadata.Labels)
+
+	res, err := catalogServer.Delete(ctx, &v1.DeleteRequest{
+		Name:    "test-catalog",
+		Account: "test-namespace",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &empty.Empty{}, res)
+	err = client.Get(ctx, types.NamespacedName{Name: "test-catalog", Namespace: "test-namespace"}, &catalogv1alpha1.Catalog{})
+	assert.Error(t, err)
+
+	_, err = catalogServer.Delete(ctx, &v1.DeleteRequest{
+		Name:    "test-catalog",
+		Account: "test-namespace",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
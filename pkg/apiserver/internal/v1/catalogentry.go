@@ -0,0 +1,267 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
+)
+
+type catalogEntryServer struct {
+	client        client.Client
+	dynamicClient dynamic.Interface
+	scheme        *runtime.Scheme
+
+	gvr schema.GroupVersionResource
+}
+
+var _ v1.CatalogEntryServiceServer = (*catalogEntryServer)(nil)
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=get;list;watch;create;update;delete
+
+func NewCatalogEntryServiceServer(c client.Client, dynamicClient dynamic.Interface, restMapper meta.RESTMapper, scheme *runtime.Scheme) (v1.CatalogEntryServiceServer, error) {
+	catalogEntryServer := &catalogEntryServer{
+		client:        c,
+		dynamicClient: dynamicClient,
+		scheme:        scheme,
+	}
+	objGVK, err := apiutil.GVKForObject(&catalogv1alpha1.CatalogEntry{}, catalogEntryServer.scheme)
+	if err != nil {
+		return nil, err
+	}
+	restMapping, err := restMapper.RESTMapping(objGVK.GroupKind(), objGVK.Version)
+	if err != nil {
+		return nil, err
+	}
+	catalogEntryServer.gvr = restMapping.Resource
+	return catalogEntryServer, nil
+}
+
+func (o catalogEntryServer) GetGVR() schema.GroupVersionResource {
+	return o.gvr
+}
+
+func (o catalogEntryServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.CatalogEntryList, err error) {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	catalogEntryList := &catalogv1alpha1.CatalogEntryList{}
+	if err := o.client.List(ctx, catalogEntryList, listOptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "listing catalog entries: %s", err.Error())
+	}
+	totalCount, err := applyListQuery(catalogEntryList, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = o.convertCatalogEntryList(catalogEntryList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntryList: %s", err.Error())
+	}
+	res.Metadata.TotalCount = totalCount
+	return
+}
+
+func (o catalogEntryServer) Get(ctx context.Context, req *v1.GetRequest) (res *v1.CatalogEntry, err error) {
+	catalogEntry := &catalogv1alpha1.CatalogEntry{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, catalogEntry); err != nil {
+		return nil, status.Errorf(errorCode(err), "getting catalog entry: %s", err.Error())
+	}
+	res, err = o.convertCatalogEntry(catalogEntry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntry: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogEntryServer) Create(ctx context.Context, req *v1.CatalogEntryCreateRequest) (res *v1.CatalogEntry, err error) {
+	catalogEntry := &catalogv1alpha1.CatalogEntry{
+		ObjectMeta: newObjectMeta(req.Spec.Metadata, req.Account),
+		Spec:       o.toCatalogEntrySpec(req.Spec.Spec),
+	}
+	if err := o.client.Create(ctx, catalogEntry); err != nil {
+		return nil, status.Errorf(errorCode(err), "creating catalog entry: %s", err.Error())
+	}
+	res, err = o.convertCatalogEntry(catalogEntry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntry: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogEntryServer) Update(ctx context.Context, req *v1.CatalogEntryUpdateRequest) (res *v1.CatalogEntry, err error) {
+	catalogEntry := &catalogv1alpha1.CatalogEntry{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, catalogEntry); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating catalog entry: %s", err.Error())
+	}
+	updateObjectMeta(&catalogEntry.ObjectMeta, req.Spec.Metadata)
+	catalogEntry.Spec = o.toCatalogEntrySpec(req.Spec.Spec)
+	if err := o.client.Update(ctx, catalogEntry); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating catalog entry: %s", err.Error())
+	}
+	res, err = o.convertCatalogEntry(catalogEntry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntry: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogEntryServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*empty.Empty, error) {
+	catalogEntry := &catalogv1alpha1.CatalogEntry{}
+	catalogEntry.Name = req.Name
+	catalogEntry.Namespace = req.Account
+	if err := o.client.Delete(ctx, catalogEntry); err != nil {
+		return nil, status.Errorf(errorCode(err), "deleting catalog entry: %s", err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (o catalogEntryServer) convertEvent(event runtime.Object) (*any.Any, error) {
+	catalogCatalogEntry := &catalogv1alpha1.CatalogEntry{}
+	if err := o.scheme.Convert(event, catalogCatalogEntry, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "converting event.Object to CatalogEntry: %s", err.Error())
+	}
+	catalogEntry, err := o.convertCatalogEntry(catalogCatalogEntry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntry: %s", err.Error())
+	}
+	any, err := ptypes.MarshalAny(catalogEntry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling CatalogEntry to Any: %s", err.Error())
+	}
+	return any, nil
+}
+
+func (o catalogEntryServer) Watch(req *v1.WatchRequest, stream v1.CatalogEntryService_WatchServer) error {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return watch(o.dynamicClient, o.gvr, req.Account, *listOptions.AsListOptions(), stream, o.convertEvent)
+}
+
+func (o catalogEntryServer) toCatalogEntrySpec(in *v1.CatalogEntrySpec) (out catalogv1alpha1.CatalogEntrySpec) {
+	if in == nil {
+		return
+	}
+	out = catalogv1alpha1.CatalogEntrySpec{
+		Metadata: catalogv1alpha1.CatalogEntryMetadata{
+			CommonMetadata: toCommonMetadata(in.Metadata),
+		},
+		Derive:            toDerivedConfig(in.Derive),
+		ReferencedObjects: toReferencedObjects(in.ReferencedObjects),
+		Metering:          toMeteringConfig(in.Metering),
+		Migration:         toMigrationConfig(in.Migration),
+		Bundle:            toObjectReferences(in.Bundle),
+		ObjectReferences:  toObjectReferenceFields(in.ObjectReferences),
+	}
+	if in.BaseCRD != nil {
+		out.BaseCRD.Name = in.BaseCRD.Name
+	}
+	return
+}
+
+func (o catalogEntryServer) convertCatalogEntry(in *catalogv1alpha1.CatalogEntry) (out *v1.CatalogEntry, err error) {
+	metadata, err := convertObjectMeta(in.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	out = &v1.CatalogEntry{
+		Metadata: metadata,
+		Spec: &v1.CatalogEntrySpec{
+			Metadata: convertCommonMetadata(in.Spec.Metadata.CommonMetadata),
+			BaseCRD: &v1.ObjectReference{
+				Name: in.Spec.BaseCRD.Name,
+			},
+			Derive:            convertDerivedConfig(in.Spec.Derive),
+			ReferencedObjects: convertReferencedObjects(in.Spec.ReferencedObjects),
+			Metering:          convertMeteringConfig(in.Spec.Metering),
+			Migration:         convertMigrationConfig(in.Spec.Migration),
+			Bundle:            convertObjectReferences(in.Spec.Bundle),
+			ObjectReferences:  convertObjectReferenceFields(in.Spec.ObjectReferences),
+		},
+		Status: &v1.CatalogEntryStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Phase:              string(in.Status.Phase),
+		},
+	}
+	if in.Status.TenantCRD != nil {
+		if out.Status.TenantCRD, err = convertCRDInformation(*in.Status.TenantCRD); err != nil {
+			return nil, err
+		}
+	}
+	if in.Status.ProviderCRD != nil {
+		if out.Status.ProviderCRD, err = convertCRDInformation(*in.Status.ProviderCRD); err != nil {
+			return nil, err
+		}
+	}
+	for _, condition := range in.Status.Conditions {
+		lastTransitionTime, err := util.TimestampProto(&condition.LastTransitionTime)
+		if err != nil {
+			return nil, err
+		}
+		out.Status.Conditions = append(out.Status.Conditions, &v1.Condition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: lastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	return
+}
+
+func (o catalogEntryServer) convertCatalogEntryList(in *catalogv1alpha1.CatalogEntryList) (out *v1.CatalogEntryList, err error) {
+	out = &v1.CatalogEntryList{
+		Metadata: convertListMeta(in.ListMeta),
+	}
+	for _, inCatalogEntry := range in.Items {
+		catalogEntry, err := o.convertCatalogEntry(&inCatalogEntry)
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, catalogEntry)
+	}
+	return
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+func TestGetCatalogEntry(t *testing.T) {
+	catalogEntry := &catalogv1alpha1.CatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdbs.eu-west-1",
+			Namespace: "test-namespace",
+		},
+		Spec: catalogv1alpha1.CatalogEntrySpec{
+			Metadata: catalogv1alpha1.CatalogEntryMetadata{
+				CommonMetadata: catalogv1alpha1.CommonMetadata{
+					DisplayName:      "CouchDB",
+					ShortDescription: "small database living on couches",
+				},
+			},
+			BaseCRD: catalogv1alpha1.ObjectReference{
+				Name: "couchdbs.eu-west-1.provider",
+			},
+			Derive: &catalogv1alpha1.DerivedConfig{
+				Expose: []catalogv1alpha1.VersionExposeConfig{
+					{
+						Versions: []string{"v1alpha1"},
+						Fields: []catalogv1alpha1.FieldPath{
+							{JSONPath: ".spec.username"},
+							{JSONPath: ".spec.replicas", TenantPath: ".spec.size"},
+						},
+						Defaults: []catalogv1alpha1.FieldDefault{
+							{JSONPath: ".spec.version", Value: apiextensionsv1.JSON{Raw: []byte(`"3.1"`)}},
+						},
+					},
+				},
+			},
+			ReferencedObjects: []catalogv1alpha1.ReferencedObject{
+				{Kind: catalogv1alpha1.ReferencedSecret, JSONPath: ".status.connection.secretName"},
+			},
+			Bundle: []catalogv1alpha1.ObjectReference{
+				{Name: "couchdbbackups.eu-west-1"},
+			},
+			ObjectReferences: []catalogv1alpha1.ObjectReferenceField{
+				{
+					CatalogEntry:     catalogv1alpha1.ObjectReference{Name: "couchdbclusters.eu-west-1"},
+					JSONPath:         ".spec.cluster.name",
+					APIGroupJSONPath: ".spec.cluster.apiGroup",
+				},
+			},
+		},
+		Status: catalogv1alpha1.CatalogEntryStatus{
+			TenantCRD: &catalogv1alpha1.CRDInformation{
+				Name:     "couchdbs.eu-west-1.provider",
+				APIGroup: "eu-west-1.provider",
+				Kind:     "CouchDB",
+				Plural:   "couchdbs",
+				Versions: []catalogv1alpha1.CRDVersion{
+					{Name: "v1alpha1", Storage: true},
+				},
+				Region: catalogv1alpha1.ObjectReference{Name: "eu-west-1"},
+			},
+			Phase: catalogv1alpha1.CatalogEntryPhaseReady,
+		},
+	}
+	client := fakeclient.NewFakeClientWithScheme(testScheme, catalogEntry)
+	catalogEntryServer := catalogEntryServer{
+		client: client,
+	}
+	ctx := context.Background()
+	res, err := catalogEntryServer.Get(ctx, &v1.GetRequest{
+		Name:    "couchdbs.eu-west-1",
+		Account: "test-namespace",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &v1.CatalogEntry{
+		Metadata: &v1.ObjectMeta{
+			Name:    "couchdbs.eu-west-1",
+			Account: "test-namespace",
+		},
+		Spec: &v1.CatalogEntrySpec{
+			Metadata: &v1.CommonMetadata{
+				DisplayName:      "CouchDB",
+				ShortDescription: "small database living on couches",
+			},
+			BaseCRD: &v1.ObjectReference{
+				Name: "couchdbs.eu-west-1.provider",
+			},
+			Derive: &v1.DerivedConfig{
+				Expose: []*v1.VersionExposeConfig{
+					{
+						Versions: []string{"v1alpha1"},
+						Fields: []*v1.FieldPath{
+							{JsonPath: ".spec.username"},
+							{JsonPath: ".spec.replicas", TenantPath: ".spec.size"},
+						},
+						Defaults: []*v1.FieldDefault{
+							{JsonPath: ".spec.version", Value: `"3.1"`},
+						},
+					},
+				},
+			},
+			ReferencedObjects: []*v1.ReferencedObject{
+				{Kind: "Secret", JsonPath: ".status.connection.secretName"},
+			},
+			Bundle: []*v1.ObjectReference{
+				{Name: "couchdbbackups.eu-west-1"},
+			},
+			ObjectReferences: []*v1.ObjectReferenceField{
+				{
+					CatalogEntry:     &v1.ObjectReference{Name: "couchdbclusters.eu-west-1"},
+					JsonPath:         ".spec.cluster.name",
+					ApiGroupJSONPath: ".spec.cluster.apiGroup",
+				},
+			},
+		},
+		Status: &v1.CatalogEntryStatus{
+			TenantCRD: &v1.CRDInformation{
+				Name:     "couchdbs.eu-west-1.provider",
+				ApiGroup: "eu-west-1.provider",
+				Kind:     "CouchDB",
+				Plural:   "couchdbs",
+				Versions: []*v1.CRDVersion{
+					{Name: "v1alpha1", Schema: "null", Storage: true},
+				},
+				Region: &v1.ObjectReference{Name: "eu-west-1"},
+			},
+			Phase: "Ready",
+		},
+	}, res)
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
+)
+
+type catalogEntrySetServer struct {
+	client        client.Client
+	dynamicClient dynamic.Interface
+	scheme        *runtime.Scheme
+
+	gvr schema.GroupVersionResource
+}
+
+var _ v1.CatalogEntrySetServiceServer = (*catalogEntrySetServer)(nil)
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentrysets,verbs=get;list;watch;create;update;delete
+
+func NewCatalogEntrySetServiceServer(c client.Client, dynamicClient dynamic.Interface, restMapper meta.RESTMapper, scheme *runtime.Scheme) (v1.CatalogEntrySetServiceServer, error) {
+	catalogEntrySetServer := &catalogEntrySetServer{
+		client:        c,
+		dynamicClient: dynamicClient,
+		scheme:        scheme,
+	}
+	objGVK, err := apiutil.GVKForObject(&catalogv1alpha1.CatalogEntrySet{}, catalogEntrySetServer.scheme)
+	if err != nil {
+		return nil, err
+	}
+	restMapping, err := restMapper.RESTMapping(objGVK.GroupKind(), objGVK.Version)
+	if err != nil {
+		return nil, err
+	}
+	catalogEntrySetServer.gvr = restMapping.Resource
+	return catalogEntrySetServer, nil
+}
+
+func (o catalogEntrySetServer) GetGVR() schema.GroupVersionResource {
+	return o.gvr
+}
+
+func (o catalogEntrySetServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.CatalogEntrySetList, err error) {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	catalogEntrySetList := &catalogv1alpha1.CatalogEntrySetList{}
+	if err := o.client.List(ctx, catalogEntrySetList, listOptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "listing catalog entry sets: %s", err.Error())
+	}
+	totalCount, err := applyListQuery(catalogEntrySetList, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = o.convertCatalogEntrySetList(catalogEntrySetList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntrySetList: %s", err.Error())
+	}
+	res.Metadata.TotalCount = totalCount
+	return
+}
+
+func (o catalogEntrySetServer) Get(ctx context.Context, req *v1.GetRequest) (res *v1.CatalogEntrySet, err error) {
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, catalogEntrySet); err != nil {
+		return nil, status.Errorf(errorCode(err), "getting catalog entry set: %s", err.Error())
+	}
+	res, err = o.convertCatalogEntrySet(catalogEntrySet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntrySet: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogEntrySetServer) Create(ctx context.Context, req *v1.CatalogEntrySetCreateRequest) (res *v1.CatalogEntrySet, err error) {
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{
+		ObjectMeta: newObjectMeta(req.Spec.Metadata, req.Account),
+		Spec:       o.toCatalogEntrySetSpec(req.Spec.Spec),
+	}
+	if err := o.client.Create(ctx, catalogEntrySet); err != nil {
+		return nil, status.Errorf(errorCode(err), "creating catalog entry set: %s", err.Error())
+	}
+	res, err = o.convertCatalogEntrySet(catalogEntrySet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntrySet: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogEntrySetServer) Update(ctx context.Context, req *v1.CatalogEntrySetUpdateRequest) (res *v1.CatalogEntrySet, err error) {
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, catalogEntrySet); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating catalog entry set: %s", err.Error())
+	}
+	updateObjectMeta(&catalogEntrySet.ObjectMeta, req.Spec.Metadata)
+	catalogEntrySet.Spec = o.toCatalogEntrySetSpec(req.Spec.Spec)
+	if err := o.client.Update(ctx, catalogEntrySet); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating catalog entry set: %s", err.Error())
+	}
+	res, err = o.convertCatalogEntrySet(catalogEntrySet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntrySet: %s", err.Error())
+	}
+	return
+}
+
+func (o catalogEntrySetServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*empty.Empty, error) {
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{}
+	catalogEntrySet.Name = req.Name
+	catalogEntrySet.Namespace = req.Account
+	if err := o.client.Delete(ctx, catalogEntrySet); err != nil {
+		return nil, status.Errorf(errorCode(err), "deleting catalog entry set: %s", err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (o catalogEntrySetServer) convertEvent(event runtime.Object) (*any.Any, error) {
+	catalogCatalogEntrySet := &catalogv1alpha1.CatalogEntrySet{}
+	if err := o.scheme.Convert(event, catalogCatalogEntrySet, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "converting event.Object to CatalogEntrySet: %s", err.Error())
+	}
+	catalogEntrySet, err := o.convertCatalogEntrySet(catalogCatalogEntrySet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting CatalogEntrySet: %s", err.Error())
+	}
+	any, err := ptypes.MarshalAny(catalogEntrySet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling CatalogEntrySet to Any: %s", err.Error())
+	}
+	return any, nil
+}
+
+func (o catalogEntrySetServer) Watch(req *v1.WatchRequest, stream v1.CatalogEntrySetService_WatchServer) error {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return watch(o.dynamicClient, o.gvr, req.Account, *listOptions.AsListOptions(), stream, o.convertEvent)
+}
+
+func (o catalogEntrySetServer) toCatalogEntrySetSpec(in *v1.CatalogEntrySetSpec) (out catalogv1alpha1.CatalogEntrySetSpec) {
+	if in == nil {
+		return
+	}
+	out = catalogv1alpha1.CatalogEntrySetSpec{
+		Metadata: catalogv1alpha1.CatalogEntrySetMetadata{
+			CommonMetadata: toCommonMetadata(in.Metadata),
+		},
+		Derive:            toDerivedConfig(in.Derive),
+		ReferencedObjects: toReferencedObjects(in.ReferencedObjects),
+		Metering:          toMeteringConfig(in.Metering),
+		Scheduling:        toSchedulingConfig(in.Scheduling),
+		Migration:         toMigrationConfig(in.Migration),
+	}
+	if in.Discover != nil {
+		if in.Discover.Crd != nil {
+			out.Discover.CRD.Name = in.Discover.Crd.Name
+		}
+		if selector := toLabelSelector(in.Discover.ServiceClusterSelector); selector != nil {
+			out.Discover.ServiceClusterSelector = *selector
+		}
+		out.Discover.WebhookStrategy = corev1alpha1.WebhookStrategyType(in.Discover.WebhookStrategy)
+	}
+	return
+}
+
+func (o catalogEntrySetServer) convertCatalogEntrySet(in *catalogv1alpha1.CatalogEntrySet) (out *v1.CatalogEntrySet, err error) {
+	metadata, err := convertObjectMeta(in.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	out = &v1.CatalogEntrySet{
+		Metadata: metadata,
+		Spec: &v1.CatalogEntrySetSpec{
+			Metadata:          convertCommonMetadata(in.Spec.Metadata.CommonMetadata),
+			Derive:            convertDerivedConfig(in.Spec.Derive),
+			ReferencedObjects: convertReferencedObjects(in.Spec.ReferencedObjects),
+			Metering:          convertMeteringConfig(in.Spec.Metering),
+			Scheduling:        convertSchedulingConfig(in.Spec.Scheduling),
+			Migration:         convertMigrationConfig(in.Spec.Migration),
+			Discover: &v1.CustomResourceDiscoverySetConfig{
+				Crd: &v1.ObjectReference{
+					Name: in.Spec.Discover.CRD.Name,
+				},
+				ServiceClusterSelector: convertLabelSelector(&in.Spec.Discover.ServiceClusterSelector),
+				WebhookStrategy:        string(in.Spec.Discover.WebhookStrategy),
+			},
+		},
+		Status: &v1.CatalogEntrySetStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Phase:              string(in.Status.Phase),
+		},
+	}
+	if in.Status.AggregatedCRD != nil {
+		if out.Status.AggregatedCRD, err = convertCRDInformation(*in.Status.AggregatedCRD); err != nil {
+			return nil, err
+		}
+	}
+	for _, condition := range in.Status.Conditions {
+		lastTransitionTime, err := util.TimestampProto(&condition.LastTransitionTime)
+		if err != nil {
+			return nil, err
+		}
+		out.Status.Conditions = append(out.Status.Conditions, &v1.Condition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: lastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	return
+}
+
+func (o catalogEntrySetServer) convertCatalogEntrySetList(in *catalogv1alpha1.CatalogEntrySetList) (out *v1.CatalogEntrySetList, err error) {
+	out = &v1.CatalogEntrySetList{
+		Metadata: convertListMeta(in.ListMeta),
+	}
+	for _, inCatalogEntrySet := range in.Items {
+		catalogEntrySet, err := o.convertCatalogEntrySet(&inCatalogEntrySet)
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, catalogEntrySet)
+	}
+	return
+}
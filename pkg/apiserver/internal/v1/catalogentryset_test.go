@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+func TestCreateUpdateDeleteCatalogEntrySet(t *testing.T) {
+	client := fakeclient.NewFakeClientWithScheme(testScheme)
+	catalogEntrySetServer := catalogEntrySetServer{
+		client: client,
+	}
+	ctx := context.Background()
+
+	createRequest := &v1.CatalogEntrySetCreateRequest{
+		Account: "test-namespace",
+		Spec: &v1.CatalogEntrySet{
+			Metadata: &v1.ObjectMeta{
+				Name: "couchdbs.eu-west-1",
+			},
+			Spec: &v1.CatalogEntrySetSpec{
+				Metadata: &v1.CommonMetadata{
+					DisplayName: "CouchDB",
+				},
+				Discover: &v1.CustomResourceDiscoverySetConfig{
+					Crd: &v1.ObjectReference{Name: "couchdbs.couchdb.io"},
+					ServiceClusterSelector: &v1.LabelSelector{
+						MatchLabels: map[string]string{"region": "eu-west-1"},
+					},
+					WebhookStrategy: "ServiceCluster",
+				},
+			},
+		},
+	}
+	created, err := catalogEntrySetServer.Create(ctx, createRequest)
+	require.NoError(t, err)
+	assert.Equal(t, "test-namespace", created.Metadata.Account)
+	assert.Equal(t, "1", created.Metadata.ResourceVersion)
+	assert.Equal(t, "couchdbs.couchdb.io", created.Spec.Discover.Crd.Name)
+	assert.Equal(t, map[string]string{"region": "eu-west-1"}, created.Spec.Discover.ServiceClusterSelector.MatchLabels)
+	assert.Equal(t, "ServiceCluster", created.Spec.Discover.WebhookStrategy)
+
+	_, err = catalogEntrySetServer.Create(ctx, createRequest)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = catalogEntrySetServer.Update(ctx, &v1.CatalogEntrySetUpdateRequest{
+		Name:    "couchdbs.eu-west-1",
+		Account: "test-namespace",
+		Spec: &v1.CatalogEntrySet{
+			Metadata: &v1.ObjectMeta{Name: "couchdbs.eu-west-1", ResourceVersion: "0"},
+		},
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err), "stale resourceVersion should be rejected")
+
+	updated, err := catalogEntrySetServer.Update(ctx, &v1.CatalogEntrySetUpdateRequest{
+		Name:    "couchdbs.eu-west-1",
+		Account: "test-namespace",
+		Spec: &v1.CatalogEntrySet{
+			Metadata: &v1.ObjectMeta{Name: "couchdbs.eu-west-1", ResourceVersion: created.Metadata.ResourceVersion},
+			Spec: &v1.CatalogEntrySetSpec{
+				Metadata: &v1.CommonMetadata{
+					DisplayName: "CouchDB EU",
+				},
+				Discover: &v1.CustomResourceDiscoverySetConfig{
+					Crd: &v1.ObjectReference{Name: "couchdbs.couchdb.io"},
+					ServiceClusterSelector: &v1.LabelSelector{
+						MatchLabels: map[string]string{"region": "eu-central-1"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "CouchDB EU", updated.Spec.Metadata.DisplayName)
+	assert.Equal(t, map[string]string{"region": "eu-central-1"}, updated.Spec.Discover.ServiceClusterSelector.MatchLabels)
+	assert.Empty(t, updated.Spec.Discover.WebhookStrategy)
+
+	_, err = catalogEntrySetServer.Update(ctx, &v1.CatalogEntrySetUpdateRequest{
+		Name:    "missing",
+		Account: "test-namespace",
+		Spec: &v1.CatalogEntrySet{
+			Metadata: &v1.ObjectMeta{Name: "missing"},
+		},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	res, err := catalogEntrySetServer.Delete(ctx, &v1.DeleteRequest{
+		Name:    "couchdbs.eu-west-1",
+		Account: "test-namespace",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &empty.Empty{}, res)
+	err = client.Get(ctx, types.NamespacedName{Name: "couchdbs.eu-west-1", Namespace: "test-namespace"}, &catalogv1alpha1.CatalogEntrySet{})
+	assert.Error(t, err)
+
+	_, err = catalogEntrySetServer.Get(ctx, &v1.GetRequest{
+		Name:    "couchdbs.eu-west-1",
+		Account: "test-namespace",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = catalogEntrySetServer.Delete(ctx, &v1.DeleteRequest{
+		Name:    "couchdbs.eu-west-1",
+		Account: "test-namespace",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
+)
+
+type derivedCustomResourceServer struct {
+	client        client.Client
+	dynamicClient dynamic.Interface
+	scheme        *runtime.Scheme
+
+	gvr schema.GroupVersionResource
+}
+
+var _ v1.DerivedCustomResourceServiceServer = (*derivedCustomResourceServer)(nil)
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=derivedcustomresources,verbs=get;list;watch;create;update;delete
+
+func NewDerivedCustomResourceServiceServer(c client.Client, dynamicClient dynamic.Interface, restMapper meta.RESTMapper, scheme *runtime.Scheme) (v1.DerivedCustomResourceServiceServer, error) {
+	derivedCustomResourceServer := &derivedCustomResourceServer{
+		client:        c,
+		dynamicClient: dynamicClient,
+		scheme:        scheme,
+	}
+	objGVK, err := apiutil.GVKForObject(&catalogv1alpha1.DerivedCustomResource{}, derivedCustomResourceServer.scheme)
+	if err != nil {
+		return nil, err
+	}
+	restMapping, err := restMapper.RESTMapping(objGVK.GroupKind(), objGVK.Version)
+	if err != nil {
+		return nil, err
+	}
+	derivedCustomResourceServer.gvr = restMapping.Resource
+	return derivedCustomResourceServer, nil
+}
+
+func (o derivedCustomResourceServer) GetGVR() schema.GroupVersionResource {
+	return o.gvr
+}
+
+func (o derivedCustomResourceServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.DerivedCustomResourceList, err error) {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	derivedCustomResourceList := &catalogv1alpha1.DerivedCustomResourceList{}
+	if err := o.client.List(ctx, derivedCustomResourceList, listOptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "listing derived custom resources: %s", err.Error())
+	}
+	totalCount, err := applyListQuery(derivedCustomResourceList, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = o.convertDerivedCustomResourceList(derivedCustomResourceList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting DerivedCustomResourceList: %s", err.Error())
+	}
+	res.Metadata.TotalCount = totalCount
+	return
+}
+
+func (o derivedCustomResourceServer) Get(ctx context.Context, req *v1.GetRequest) (res *v1.DerivedCustomResource, err error) {
+	derivedCustomResource := &catalogv1alpha1.DerivedCustomResource{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, derivedCustomResource); err != nil {
+		return nil, status.Errorf(errorCode(err), "getting derived custom resource: %s", err.Error())
+	}
+	res, err = o.convertDerivedCustomResource(derivedCustomResource)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting DerivedCustomResource: %s", err.Error())
+	}
+	return
+}
+
+func (o derivedCustomResourceServer) Create(ctx context.Context, req *v1.DerivedCustomResourceCreateRequest) (res *v1.DerivedCustomResource, err error) {
+	derivedCustomResource := &catalogv1alpha1.DerivedCustomResource{
+		ObjectMeta: newObjectMeta(req.Spec.Metadata, req.Account),
+		Spec:       o.toDerivedCustomResourceSpec(req.Spec.Spec),
+	}
+	if err := o.client.Create(ctx, derivedCustomResource); err != nil {
+		return nil, status.Errorf(errorCode(err), "creating derived custom resource: %s", err.Error())
+	}
+	res, err = o.convertDerivedCustomResource(derivedCustomResource)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting DerivedCustomResource: %s", err.Error())
+	}
+	return
+}
+
+func (o derivedCustomResourceServer) Update(ctx context.Context, req *v1.DerivedCustomResourceUpdateRequest) (res *v1.DerivedCustomResource, err error) {
+	derivedCustomResource := &catalogv1alpha1.DerivedCustomResource{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, derivedCustomResource); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating derived custom resource: %s", err.Error())
+	}
+	updateObjectMeta(&derivedCustomResource.ObjectMeta, req.Spec.Metadata)
+	derivedCustomResource.Spec = o.toDerivedCustomResourceSpec(req.Spec.Spec)
+	if err := o.client.Update(ctx, derivedCustomResource); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating derived custom resource: %s", err.Error())
+	}
+	res, err = o.convertDerivedCustomResource(derivedCustomResource)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting DerivedCustomResource: %s", err.Error())
+	}
+	return
+}
+
+func (o derivedCustomResourceServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*empty.Empty, error) {
+	derivedCustomResource := &catalogv1alpha1.DerivedCustomResource{}
+	derivedCustomResource.Name = req.Name
+	derivedCustomResource.Namespace = req.Account
+	if err := o.client.Delete(ctx, derivedCustomResource); err != nil {
+		return nil, status.Errorf(errorCode(err), "deleting derived custom resource: %s", err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (o derivedCustomResourceServer) convertEvent(event runtime.Object) (*any.Any, error) {
+	catalogDerivedCustomResource := &catalogv1alpha1.DerivedCustomResource{}
+	if err := o.scheme.Convert(event, catalogDerivedCustomResource, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "converting event.Object to DerivedCustomResource: %s", err.Error())
+	}
+	derivedCustomResource, err := o.convertDerivedCustomResource(catalogDerivedCustomResource)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting DerivedCustomResource: %s", err.Error())
+	}
+	any, err := ptypes.MarshalAny(derivedCustomResource)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling DerivedCustomResource to Any: %s", err.Error())
+	}
+	return any, nil
+}
+
+func (o derivedCustomResourceServer) Watch(req *v1.WatchRequest, stream v1.DerivedCustomResourceService_WatchServer) error {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return watch(o.dynamicClient, o.gvr, req.Account, *listOptions.AsListOptions(), stream, o.convertEvent)
+}
+
+func (o derivedCustomResourceServer) toDerivedCustomResourceSpec(in *v1.DerivedCustomResourceSpec) (out catalogv1alpha1.DerivedCustomResourceSpec) {
+	if in == nil {
+		return
+	}
+	out = catalogv1alpha1.DerivedCustomResourceSpec{
+		Expose:            toVersionExposeConfigs(in.Expose),
+		ReferencedObjects: toReferencedObjects(in.ReferencedObjects),
+		ObjectReferences:  toObjectReferenceFields(in.ObjectReferences),
+	}
+	if in.BaseCRD != nil {
+		out.BaseCRD.Name = in.BaseCRD.Name
+	}
+	return
+}
+
+func (o derivedCustomResourceServer) convertDerivedCustomResource(in *catalogv1alpha1.DerivedCustomResource) (out *v1.DerivedCustomResource, err error) {
+	metadata, err := convertObjectMeta(in.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	out = &v1.DerivedCustomResource{
+		Metadata: metadata,
+		Spec: &v1.DerivedCustomResourceSpec{
+			BaseCRD: &v1.ObjectReference{
+				Name: in.Spec.BaseCRD.Name,
+			},
+			Expose:            convertVersionExposeConfigs(in.Spec.Expose),
+			ReferencedObjects: convertReferencedObjects(in.Spec.ReferencedObjects),
+			ObjectReferences:  convertObjectReferenceFields(in.Spec.ObjectReferences),
+		},
+		Status: &v1.DerivedCustomResourceStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Phase:              string(in.Status.Phase),
+		},
+	}
+	if in.Status.DerivedCR != nil {
+		out.Status.DerivedCR = &v1.ObjectReference{
+			Name: in.Status.DerivedCR.Name,
+		}
+	}
+	for _, condition := range in.Status.Conditions {
+		lastTransitionTime, err := util.TimestampProto(&condition.LastTransitionTime)
+		if err != nil {
+			return nil, err
+		}
+		out.Status.Conditions = append(out.Status.Conditions, &v1.Condition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: lastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	return
+}
+
+func (o derivedCustomResourceServer) convertDerivedCustomResourceList(in *catalogv1alpha1.DerivedCustomResourceList) (out *v1.DerivedCustomResourceList, err error) {
+	out = &v1.DerivedCustomResourceList{
+		Metadata: convertListMeta(in.ListMeta),
+	}
+	for _, inDerivedCustomResource := range in.Items {
+		derivedCustomResource, err := o.convertDerivedCustomResource(&inDerivedCustomResource)
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, derivedCustomResource)
+	}
+	return
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+func TestCreateUpdateDeleteDerivedCustomResource(t *testing.T) {
+	client := fakeclient.NewFakeClientWithScheme(testScheme)
+	derivedCustomResourceServer := derivedCustomResourceServer{
+		client: client,
+	}
+	ctx := context.Background()
+
+	createRequest := &v1.DerivedCustomResourceCreateRequest{
+		Account: "test-namespace",
+		Spec: &v1.DerivedCustomResource{
+			Metadata: &v1.ObjectMeta{
+				Name: "couchdbs",
+			},
+			Spec: &v1.DerivedCustomResourceSpec{
+				BaseCRD: &v1.ObjectReference{Name: "couchdbs.eu-west-1.provider"},
+				Expose: []*v1.VersionExposeConfig{
+					{
+						Versions: []string{"v1alpha1"},
+						Fields: []*v1.FieldPath{
+							{JsonPath: ".spec.username"},
+						},
+						Defaults: []*v1.FieldDefault{
+							{JsonPath: ".spec.replicas", Value: "3"},
+						},
+					},
+				},
+			},
+		},
+	}
+	created, err := derivedCustomResourceServer.Create(ctx, createRequest)
+	require.NoError(t, err)
+	assert.Equal(t, "test-namespace", created.Metadata.Account)
+	assert.Equal(t, "1", created.Metadata.ResourceVersion)
+	assert.Equal(t, "couchdbs.eu-west-1.provider", created.Spec.BaseCRD.Name)
+	assert.Equal(t, createRequest.Spec.Spec.Expose, created.Spec.Expose)
+
+	_, err = derivedCustomResourceServer.Create(ctx, createRequest)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = derivedCustomResourceServer.Update(ctx, &v1.DerivedCustomResourceUpdateRequest{
+		Name:    "couchdbs",
+		Account: "test-namespace",
+		Spec: &v1.DerivedCustomResource{
+			Metadata: &v1.ObjectMeta{Name: "couchdbs", ResourceVersion: "0"},
+		},
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err), "stale resourceVersion should be rejected")
+
+	updatedExpose := []*v1.VersionExposeConfig{
+		{
+			Versions: []string{"v1alpha1"},
+			Fields: []*v1.FieldPath{
+				{JsonPath: ".spec.username"},
+				{JsonPath: ".spec.password"},
+			},
+		},
+	}
+	updated, err := derivedCustomResourceServer.Update(ctx, &v1.DerivedCustomResourceUpdateRequest{
+		Name:    "couchdbs",
+		Account: "test-namespace",
+		Spec: &v1.DerivedCustomResource{
+			Metadata: &v1.ObjectMeta{Name: "couchdbs", ResourceVersion: created.Metadata.ResourceVersion},
+			Spec: &v1.DerivedCustomResourceSpec{
+				BaseCRD: &v1.ObjectReference{Name: "couchdbs.eu-west-1.provider"},
+				Expose:  updatedExpose,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, updatedExpose, updated.Spec.Expose)
+
+	_, err = derivedCustomResourceServer.Update(ctx, &v1.DerivedCustomResourceUpdateRequest{
+		Name:    "missing",
+		Account: "test-namespace",
+		Spec: &v1.DerivedCustomResource{
+			Metadata: &v1.ObjectMeta{Name: "missing"},
+		},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	res, err := derivedCustomResourceServer.Delete(ctx, &v1.DeleteRequest{
+		Name:    "couchdbs",
+		Account: "test-namespace",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &empty.Empty{}, res)
+	err = client.Get(ctx, types.NamespacedName{Name: "couchdbs", Namespace: "test-namespace"}, &catalogv1alpha1.DerivedCustomResource{})
+	assert.Error(t, err)
+
+	_, err = derivedCustomResourceServer.Get(ctx, &v1.GetRequest{
+		Name:    "couchdbs",
+		Account: "test-namespace",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = derivedCustomResourceServer.Delete(ctx, &v1.DeleteRequest{
+		Name:    "couchdbs",
+		Account: "test-namespace",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
+)
+
+type eventServer struct {
+	client        client.Client
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+	scheme        *runtime.Scheme
+}
+
+var _ v1.EventsServiceServer = (*eventServer)(nil)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch
+
+func NewEventsServer(c client.Client, dynamicClient dynamic.Interface, mapper meta.RESTMapper, scheme *runtime.Scheme) v1.EventsServiceServer {
+	return &eventServer{
+		client:        c,
+		dynamicClient: dynamicClient,
+		mapper:        mapper,
+		scheme:        scheme,
+	}
+}
+
+// involvedObjectSelector selects the Events of the instance named in the request.
+func (o eventServer) involvedObjectSelector(req v1.OfferingVersionGetter, name string) (fields.Selector, error) {
+	gvk, err := o.mapper.KindFor(v1.GetOfferingGVR(req))
+	if err != nil {
+		return nil, err
+	}
+	return fields.SelectorFromSet(fields.Set{
+		"involvedObject.apiVersion": gvk.GroupVersion().String(),
+		"involvedObject.kind":       gvk.Kind,
+		"involvedObject.name":       name,
+	}), nil
+}
+
+func (o eventServer) List(ctx context.Context, req *v1.EventListRequest) (res *v1.EventList, err error) {
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	selector, err := o.involvedObjectSelector(req, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing events: unable to get Kind: %s", err.Error())
+	}
+	eventList := &corev1.EventList{}
+	if err := o.client.List(ctx, eventList,
+		client.InNamespace(req.Account),
+		client.MatchingFieldsSelector{Selector: selector},
+	); err != nil {
+		return nil, status.Errorf(codes.Internal, "listing events: %s", err.Error())
+	}
+	totalCount, err := applyListQuery(eventList, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = o.convertEventList(eventList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting EventList: %s", err.Error())
+	}
+	res.Metadata.TotalCount = totalCount
+	return
+}
+
+func (o eventServer) convertEvent(event runtime.Object) (*any.Any, error) {
+	k8sEvent := &corev1.Event{}
+	if err := o.scheme.Convert(event, k8sEvent, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "converting event.Object to Event: %s", err.Error())
+	}
+	apiEvent, err := o.convertK8sEvent(k8sEvent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting Event: %s", err.Error())
+	}
+	any, err := ptypes.MarshalAny(apiEvent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling Event to Any: %s", err.Error())
+	}
+	return any, nil
+}
+
+func (o eventServer) Watch(req *v1.EventWatchRequest, stream v1.EventsService_WatchServer) error {
+	selector, err := o.involvedObjectSelector(req, req.Name)
+	if err != nil {
+		return status.Errorf(codes.Internal, "watching events: unable to get Kind: %s", err.Error())
+	}
+	gvr := corev1.SchemeGroupVersion.WithResource("events")
+	return watch(o.dynamicClient, gvr, req.Account, metav1.ListOptions{
+		FieldSelector:       selector.String(),
+		ResourceVersion:     req.ResourceVersion,
+		AllowWatchBookmarks: req.AllowWatchBookmarks,
+	}, stream, o.convertEvent)
+}
+
+func (o eventServer) convertK8sEvent(in *corev1.Event) (out *v1.Event, err error) {
+	metadata, err := convertObjectMeta(in.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	firstTimestamp, err := util.TimestampProto(&in.FirstTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	lastTimestamp, err := util.TimestampProto(&in.LastTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	out = &v1.Event{
+		Metadata:       metadata,
+		Reason:         in.Reason,
+		Message:        in.Message,
+		Type:           in.Type,
+		Count:          in.Count,
+		Source:         in.Source.Component,
+		FirstTimestamp: firstTimestamp,
+		LastTimestamp:  lastTimestamp,
+	}
+	return
+}
+
+func (o eventServer) convertEventList(in *corev1.EventList) (out *v1.EventList, err error) {
+	out = &v1.EventList{
+		Metadata: convertListMeta(in.ListMeta),
+	}
+	for i := range in.Items {
+		event, err := o.convertK8sEvent(&in.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, event)
+	}
+	return
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+func TestListEvent(t *testing.T) {
+	lastTimestamp := metav1.NewTime(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC))
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-instance.161d8b0e7c3b2a1f.couchdb",
+			Namespace: "test-namespace",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "eu-west-1.team-a/v1alpha1",
+			Kind:       "CouchDB",
+			Name:       "test-instance",
+			Namespace:  "test-namespace",
+		},
+		Reason:        "Provisioning",
+		Message:       "waiting for volume",
+		Type:          corev1.EventTypeWarning,
+		Count:         3,
+		LastTimestamp: lastTimestamp,
+		Source: corev1.EventSource{
+			Component: "catapult",
+		},
+	}
+
+	client := fakeclient.NewFakeClientWithScheme(testScheme, event)
+	eventServer := NewEventsServer(client, nil, newFakeRESTMapper("CouchDB"), testScheme)
+	ctx := context.Background()
+
+	eventList, err := eventServer.List(ctx, &v1.EventListRequest{
+		Name:     "test-instance",
+		Account:  "test-namespace",
+		Offering: "couchdb.eu-west-1.team-a",
+		Version:  "v1alpha1",
+	})
+	require.NoError(t, err)
+
+	expectedLastTimestamp, err := ptypes.TimestampProto(lastTimestamp.Time)
+	require.NoError(t, err)
+	if assert.Len(t, eventList.Items, 1) {
+		assert.Equal(t, &v1.Event{
+			Metadata: &v1.ObjectMeta{
+				Name:    "test-instance.161d8b0e7c3b2a1f.couchdb",
+				Account: "test-namespace",
+			},
+			Reason:        "Provisioning",
+			Message:       "waiting for volume",
+			Type:          corev1.EventTypeWarning,
+			Count:         3,
+			Source:        "catapult",
+			LastTimestamp: expectedLastTimestamp,
+		}, eventList.Items[0])
+	}
+}
+
+func TestListEventQuery(t *testing.T) {
+	newEvent := func(name, eventType, message string, hour int) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+			},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: "eu-west-1.team-a/v1alpha1",
+				Kind:       "CouchDB",
+				Name:       "test-instance",
+				Namespace:  "test-namespace",
+			},
+			Reason:        "Provisioning",
+			Message:       message,
+			Type:          eventType,
+			LastTimestamp: metav1.NewTime(time.Date(2020, 6, 1, hour, 0, 0, 0, time.UTC)),
+		}
+	}
+	client := fakeclient.NewFakeClientWithScheme(testScheme,
+		newEvent("test-instance.a", corev1.EventTypeWarning, "waiting for volume", 10),
+		newEvent("test-instance.b", corev1.EventTypeNormal, "volume bound", 11),
+		newEvent("test-instance.c", corev1.EventTypeWarning, "waiting for pod", 12),
+		newEvent("test-instance.d", corev1.EventTypeWarning, "pod unschedulable", 9),
+	)
+	eventServer := NewEventsServer(client, nil, newFakeRESTMapper("CouchDB"), testScheme)
+	ctx := context.Background()
+
+	// the latest warnings first, one per page
+	var (
+		names     []string
+		continued string
+	)
+	for page := 0; page < 5; page++ {
+		eventList, err := eventServer.List(ctx, &v1.EventListRequest{
+			Name:          "test-instance",
+			Account:       "test-namespace",
+			Offering:      "couchdb.eu-west-1.team-a",
+			Version:       "v1alpha1",
+			FieldSelector: "type=Warning",
+			OrderBy:       "-lastTimestamp",
+			Limit:         1,
+			Continue:      continued,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), eventList.Metadata.TotalCount)
+		for _, event := range eventList.Items {
+			names = append(names, event.Metadata.Name)
+		}
+		continued = eventList.Metadata.Continue
+		if continued == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"test-instance.c", "test-instance.a", "test-instance.d"}, names)
+
+	eventList, err := eventServer.List(ctx, &v1.EventListRequest{
+		Name:     "test-instance",
+		Account:  "test-namespace",
+		Offering: "couchdb.eu-west-1.team-a",
+		Version:  "v1alpha1",
+		OrderBy:  "invalid..path",
+	})
+	assert.Nil(t, eventList)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEventInvolvedObjectSelector(t *testing.T) {
+	eventServer := &eventServer{mapper: newFakeRESTMapper("CouchDB")}
+	selector, err := eventServer.involvedObjectSelector(&v1.EventWatchRequest{
+		Offering: "couchdb.eu-west-1.team-a",
+		Version:  "v1alpha1",
+	}, "test-instance")
+	require.NoError(t, err)
+	assert.True(t, selector.Matches(fields.Set{
+		"involvedObject.apiVersion": "eu-west-1.team-a/v1alpha1",
+		"involvedObject.kind":       "CouchDB",
+		"involvedObject.name":       "test-instance",
+	}))
+	assert.False(t, selector.Matches(fields.Set{
+		"involvedObject.apiVersion": "eu-west-1.team-a/v1alpha1",
+		"involvedObject.kind":       "CouchDB",
+		"involvedObject.name":       "other-instance",
+	}))
+}
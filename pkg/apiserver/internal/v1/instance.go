@@ -24,6 +24,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -95,6 +96,10 @@ func (o instanceServer) List(ctx context.Context, req *v1.InstanceListRequest) (
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	obj := &unstructured.UnstructuredList{}
 	gvk, err := o.getGVK(req)
 	if err != nil {
@@ -104,11 +109,16 @@ func (o instanceServer) List(ctx context.Context, req *v1.InstanceListRequest) (
 	if err := o.client.List(ctx, obj, listOptions); err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("listing instances: %s", err.Error()))
 	}
+	totalCount, err := applyListQuery(obj, listQuery)
+	if err != nil {
+		return nil, err
+	}
 
 	res, err = o.convertInstanceList(obj, req.Offering)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("converting InstanceList: %s", err.Error()))
 	}
+	res.Metadata.TotalCount = totalCount
 	return
 }
 
@@ -157,6 +167,100 @@ func (o instanceServer) Delete(ctx context.Context, req *v1.InstanceDeleteReques
 	return &empty.Empty{}, nil
 }
 
+func (o instanceServer) Update(ctx context.Context, req *v1.InstanceUpdateRequest) (res *v1.Instance, err error) {
+	obj := &unstructured.Unstructured{}
+	gvk, err := o.getGVK(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "updating instance: unable to get Kind: %s", err.Error())
+	}
+	obj.SetGroupVersionKind(gvk)
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "updating instance: %s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "updating instance: %s", err.Error())
+	}
+	if req.Spec.Spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "updating instance: missing spec")
+	}
+	rawObject, err := v1.NewRawObject(req.Spec.Spec.Encoding, req.Spec.Spec.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "updating instance: spec format: %s", err.Error())
+	}
+	val := map[string]interface{}{}
+	if err := rawObject.Unmarshal(&val); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "updating instance: spec should be type of map[string]interface{}")
+	}
+	if err := unstructured.SetNestedMap(obj.Object, val, "spec"); err != nil {
+		return nil, status.Errorf(codes.Internal, "updating instance: %s", err.Error())
+	}
+	// Only labels and annotations are mutable, the resourceVersion is used for optimistic locking.
+	obj.SetLabels(req.Spec.Metadata.Labels)
+	obj.SetAnnotations(req.Spec.Metadata.Annotations)
+	obj.SetResourceVersion(req.Spec.Metadata.ResourceVersion)
+	if err := o.client.Update(ctx, obj); err != nil {
+		if errors.IsConflict(err) {
+			return nil, status.Errorf(codes.Aborted, "updating instance: %s", err.Error())
+		}
+		if errors.IsInvalid(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "updating instance: %s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "updating instance: %s", err.Error())
+	}
+	res, err = o.convertInstance(obj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting Instance: %s", err.Error())
+	}
+	res.Offering = req.Offering
+	return
+}
+
+func (o instanceServer) Patch(ctx context.Context, req *v1.InstancePatchRequest) (res *v1.Instance, err error) {
+	obj := &unstructured.Unstructured{}
+	gvk, err := o.getGVK(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "patching instance: unable to get Kind: %s", err.Error())
+	}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(req.Account)
+	obj.SetName(req.Name)
+
+	var patchType types.PatchType
+	switch req.PatchType {
+	case v1.PatchTypeJSONMerge:
+		patchType = types.MergePatchType
+	case v1.PatchTypeJSON:
+		patchType = types.JSONPatchType
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "patching instance: unsupported patch type: %s", req.PatchType)
+	}
+	data, err := req.Patch.JSON()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "patching instance: patch format: %s", err.Error())
+	}
+	if err := o.client.Patch(ctx, obj, client.RawPatch(patchType, data)); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "patching instance: %s", err.Error())
+		}
+		if errors.IsConflict(err) {
+			return nil, status.Errorf(codes.Aborted, "patching instance: %s", err.Error())
+		}
+		if errors.IsInvalid(err) || errors.IsBadRequest(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "patching instance: %s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "patching instance: %s", err.Error())
+	}
+	res, err = o.convertInstance(obj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting Instance: %s", err.Error())
+	}
+	res.Offering = req.Offering
+	return
+}
+
 func (o instanceServer) convertEvent(event runtime.Object) (*any.Any, error) {
 	obj := &unstructured.Unstructured{}
 	if err := o.scheme.Convert(event, obj, nil); err != nil {
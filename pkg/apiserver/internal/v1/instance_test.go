@@ -279,6 +279,7 @@ func TestListInstance(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      2,
 				},
 				Items: []*v1.Instance{
 					{
@@ -321,6 +322,7 @@ func TestListInstance(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      1,
 				},
 				Items: []*v1.Instance{
 					{
@@ -347,3 +349,145 @@ func TestListInstance(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateInstance(t *testing.T) {
+	instance, err := newInstance("test-instance", "test-namespace",
+		map[string]string{"username": "username", "password": "password"},
+		map[string]string{"status": "ready"}, map[string]string{})
+	assert.Nil(t, err)
+	client := fakeclient.NewFakeClientWithScheme(testScheme)
+	instanceServer := NewInstancesServer(client, nil, newFakeRESTMapper("CouchDB"), testScheme)
+	ctx := context.Background()
+	err = client.Create(ctx, instance)
+	assert.Nil(t, err)
+	spec := v1.NewJSONRawObject([]byte("{\"password\":\"new-password\",\"username\":\"username\"}"))
+	tests := []struct {
+		name           string
+		req            *v1.InstanceUpdateRequest
+		expectedError  error
+		expectedResult *v1.Instance
+	}{
+		{
+			name: "conflict",
+			req: &v1.InstanceUpdateRequest{
+				Name:     "test-instance",
+				Account:  "test-namespace",
+				Offering: "couchdb.eu-west-1.team-a",
+				Version:  "v1alpha1",
+				Spec: &v1.Instance{
+					Metadata: &v1.ObjectMeta{Name: "test-instance", ResourceVersion: "0"},
+					Spec:     spec,
+				},
+			},
+			expectedError: status.Errorf(codes.Aborted, "updating instance: Operation cannot be fulfilled on couchdbs.eu-west-1.team-a \"test-instance\": object was modified"),
+		},
+		{
+			name: "valid request",
+			req: &v1.InstanceUpdateRequest{
+				Name:     "test-instance",
+				Account:  "test-namespace",
+				Offering: "couchdb.eu-west-1.team-a",
+				Version:  "v1alpha1",
+				Spec: &v1.Instance{
+					Metadata: &v1.ObjectMeta{
+						Name:            "test-instance",
+						ResourceVersion: "1",
+						Labels:          map[string]string{"test-label": "instance"},
+					},
+					Spec: spec,
+				},
+			},
+			expectedError: nil,
+			expectedResult: &v1.Instance{
+				Metadata: &v1.ObjectMeta{
+					Name:            "test-instance",
+					Account:         "test-namespace",
+					ResourceVersion: "2",
+					Labels:          map[string]string{"test-label": "instance"},
+				},
+				Offering: "couchdb.eu-west-1.team-a",
+				Spec:     spec,
+				Status:   v1.NewJSONRawObject([]byte("{\"status\":\"ready\"}")),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			instance, err := instanceServer.Update(ctx, test.req)
+			assert.Equal(t, test.expectedError, err)
+			assert.Equal(t, test.expectedResult, instance)
+		})
+	}
+}
+
+func TestPatchInstance(t *testing.T) {
+	instance, err := newInstance("test-instance", "test-namespace",
+		map[string]string{"username": "username", "password": "password"},
+		map[string]string{"status": "ready"}, map[string]string{})
+	assert.Nil(t, err)
+	client := fakeclient.NewFakeClientWithScheme(testScheme)
+	instanceServer := NewInstancesServer(client, nil, newFakeRESTMapper("CouchDB"), testScheme)
+	ctx := context.Background()
+	err = client.Create(ctx, instance)
+	assert.Nil(t, err)
+	tests := []struct {
+		name           string
+		req            *v1.InstancePatchRequest
+		expectedError  error
+		expectedResult *v1.Instance
+	}{
+		{
+			name: "json merge patch",
+			req: &v1.InstancePatchRequest{
+				Name:      "test-instance",
+				Account:   "test-namespace",
+				Offering:  "couchdb.eu-west-1.team-a",
+				Version:   "v1alpha1",
+				PatchType: v1.PatchTypeJSONMerge,
+				Patch:     v1.NewYAMLRawObject([]byte("spec:\n  password: merged-password\n")),
+			},
+			expectedError: nil,
+			expectedResult: &v1.Instance{
+				Metadata: &v1.ObjectMeta{
+					Name:            "test-instance",
+					Account:         "test-namespace",
+					ResourceVersion: "2",
+					Labels:          map[string]string{},
+				},
+				Offering: "couchdb.eu-west-1.team-a",
+				Spec:     v1.NewJSONRawObject([]byte("{\"password\":\"merged-password\",\"username\":\"username\"}")),
+				Status:   v1.NewJSONRawObject([]byte("{\"status\":\"ready\"}")),
+			},
+		},
+		{
+			name: "json patch",
+			req: &v1.InstancePatchRequest{
+				Name:      "test-instance",
+				Account:   "test-namespace",
+				Offering:  "couchdb.eu-west-1.team-a",
+				Version:   "v1alpha1",
+				PatchType: v1.PatchTypeJSON,
+				Patch:     v1.NewJSONRawObject([]byte("[{\"op\":\"replace\",\"path\":\"/spec/username\",\"value\":\"patched-username\"}]")),
+			},
+			expectedError: nil,
+			expectedResult: &v1.Instance{
+				Metadata: &v1.ObjectMeta{
+					Name:            "test-instance",
+					Account:         "test-namespace",
+					ResourceVersion: "3",
+					Labels:          map[string]string{},
+				},
+				Offering: "couchdb.eu-west-1.team-a",
+				Spec:     v1.NewJSONRawObject([]byte("{\"password\":\"merged-password\",\"username\":\"patched-username\"}")),
+				Status:   v1.NewJSONRawObject([]byte("{\"status\":\"ready\"}")),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			instance, err := instanceServer.Patch(ctx, test.req)
+			assert.Equal(t, test.expectedError, err)
+			assert.Equal(t, test.expectedResult, instance)
+		})
+	}
+}
@@ -21,16 +21,29 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
 	"k8c.io/kubecarrier/pkg/apiserver/auth"
 	"k8c.io/kubecarrier/pkg/internal/version"
 )
 
-type KubeCarrierServer struct{}
+type KubeCarrierServer struct {
+	client client.Client
+}
 
 var _ v1.KubeCarrierServer = (*KubeCarrierServer)(nil)
 
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=accounts,verbs=get;list;watch
+
+func NewKubeCarrierServer(c client.Client) *KubeCarrierServer {
+	return &KubeCarrierServer{
+		client: c,
+	}
+}
+
 func (v KubeCarrierServer) Version(context.Context, *v1.VersionRequest) (*v1.APIVersion, error) {
 	versionInfo := version.Get()
 	return &v1.APIVersion{
@@ -50,8 +63,22 @@ func (v KubeCarrierServer) WhoAmI(ctx context.Context, _ *empty.Empty) (*v1.User
 	if err != nil {
 		return nil, err
 	}
-	return &v1.UserInfo{
+	accountList, err := listAccountsOfUser(ctx, v.client, userInfo, &client.ListOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing accounts: %s", err.Error())
+	}
+	res := &v1.UserInfo{
 		User:   userInfo.GetName(),
 		Groups: userInfo.GetGroups(),
-	}, nil
+	}
+	for _, account := range accountList.Items {
+		userAccount := &v1.UserAccount{
+			Account: account.Name,
+		}
+		for _, role := range account.Spec.Roles {
+			userAccount.Roles = append(userAccount.Roles, string(role))
+		}
+		res.Accounts = append(res.Accounts, userAccount)
+	}
+	return res, nil
 }
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
+)
+
+type fakeAuthProvider struct {
+	userInfo user.Info
+}
+
+func (fakeAuthProvider) AddFlags(fs *pflag.FlagSet) {}
+func (fakeAuthProvider) Init() error                { return nil }
+func (p fakeAuthProvider) Authenticate(ctx context.Context) (user.Info, error) {
+	return p.userInfo, nil
+}
+
+func TestWhoAmI(t *testing.T) {
+	client := fakeclient.NewFakeClientWithScheme(testScheme,
+		&catalogv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec: catalogv1alpha1.AccountSpec{
+				Roles: []catalogv1alpha1.AccountRole{catalogv1alpha1.ProviderRole, catalogv1alpha1.TenantRole},
+				Subjects: []catalogv1alpha1.AccountSubject{
+					{Subject: rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "team-a"}},
+				},
+			},
+		},
+		&catalogv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+			Spec: catalogv1alpha1.AccountSpec{
+				Roles: []catalogv1alpha1.AccountRole{catalogv1alpha1.TenantRole},
+				Subjects: []catalogv1alpha1.AccountSubject{
+					{Subject: rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice"}},
+				},
+			},
+		},
+		&catalogv1alpha1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-b"},
+			Spec: catalogv1alpha1.AccountSpec{
+				Roles: []catalogv1alpha1.AccountRole{catalogv1alpha1.TenantRole},
+				Subjects: []catalogv1alpha1.AccountSubject{
+					{Subject: rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "team-b"}},
+				},
+			},
+		},
+	)
+	ctx, err := auth.CreateAuthFunction([]auth.Provider{fakeAuthProvider{&user.DefaultInfo{
+		Name:   "alice",
+		Groups: []string{"team-a"},
+	}}})(context.Background())
+	require.NoError(t, err)
+
+	res, err := NewKubeCarrierServer(client).WhoAmI(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, &v1.UserInfo{
+		User:   "alice",
+		Groups: []string{"team-a"},
+		Accounts: []*v1.UserAccount{
+			{Account: "alice", Roles: []string{"Tenant"}},
+			{Account: "team-a", Roles: []string{"Provider", "Tenant"}},
+		},
+	}, res)
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+)
+
+var (
+	// items are always sorted by namespace and name last, so the order is stable across pages.
+	tieBreakerFields = []v1.OrderByField{
+		{Path: fieldpath.Path{{Type: fieldpath.FieldSegment, Field: "metadata"}, {Type: fieldpath.FieldSegment, Field: "namespace"}}},
+		{Path: fieldpath.Path{{Type: fieldpath.FieldSegment, Field: "metadata"}, {Type: fieldpath.FieldSegment, Field: "name"}}},
+	}
+	// searchFields are matched by the search text of a ListQuery.
+	searchFields = []string{
+		".metadata.name",
+		".spec.metadata.displayName",
+		".spec.metadata.shortDescription",
+		".spec.metadata.description",
+	}
+)
+
+// listItem is an item of a list with the values it is sorted by.
+type listItem struct {
+	obj  runtime.Object
+	keys []interface{}
+}
+
+// continueToken points after the last item of a page, by holding its sort keys.
+// Pages stay consistent, when items before the token are added or removed.
+type continueToken struct {
+	Keys []interface{} `json:"keys"`
+}
+
+// applyListQuery filters, sorts and paginates the items of the given list in place,
+// the Continue and RemainingItemCount of the list are set if there are more items.
+// It returns the number of items matching the query across all pages.
+// Errors are returned as gRPC status errors.
+func applyListQuery(list runtime.Object, query *v1.ListQuery) (totalCount int64, err error) {
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "extracting list: %s", err.Error())
+	}
+	orderBy := append(append([]v1.OrderByField{}, query.OrderBy...), tieBreakerFields...)
+
+	var items []listItem
+	for _, obj := range objs {
+		content, err := toUnstructuredContent(obj)
+		if err != nil {
+			return 0, status.Error(codes.Internal, err.Error())
+		}
+		if !matchesFieldSelector(content, query.FieldSelector) || !matchesSearch(content, query.Search) {
+			continue
+		}
+		item := listItem{obj: obj}
+		for _, field := range orderBy {
+			value, _, _ := fieldpath.Get(content, field.Path)
+			item.keys = append(item.keys, value)
+		}
+		items = append(items, item)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return compareKeys(items[i].keys, items[j].keys, orderBy) < 0
+	})
+	totalCount = int64(len(items))
+
+	if query.Continue != "" {
+		token, err := decodeContinueToken(query.Continue, len(orderBy))
+		if err != nil {
+			return 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		start := sort.Search(len(items), func(i int) bool {
+			return compareKeys(items[i].keys, token.Keys, orderBy) > 0
+		})
+		items = items[start:]
+	}
+
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "accessing list: %s", err.Error())
+	}
+	listAccessor.SetContinue("")
+	listAccessor.SetRemainingItemCount(nil)
+	if query.Limit > 0 && int64(len(items)) > query.Limit {
+		remaining := int64(len(items)) - query.Limit
+		items = items[:query.Limit]
+		token, err := encodeContinueToken(continueToken{Keys: items[len(items)-1].keys})
+		if err != nil {
+			return 0, status.Error(codes.Internal, err.Error())
+		}
+		listAccessor.SetContinue(token)
+		listAccessor.SetRemainingItemCount(&remaining)
+	}
+
+	page := make([]runtime.Object, len(items))
+	for i, item := range items {
+		page[i] = item.obj
+	}
+	if err := meta.SetList(list, page); err != nil {
+		return 0, status.Errorf(codes.Internal, "setting list: %s", err.Error())
+	}
+	return totalCount, nil
+}
+
+func toUnstructuredContent(obj runtime.Object) (map[string]interface{}, error) {
+	if u, ok := obj.(runtime.Unstructured); ok {
+		return u.UnstructuredContent(), nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("converting %T: %w", obj, err)
+	}
+	return content, nil
+}
+
+func matchesFieldSelector(content map[string]interface{}, selector fields.Selector) bool {
+	if selector == nil || selector.Empty() {
+		return true
+	}
+	set := fields.Set{}
+	for _, requirement := range selector.Requirements() {
+		path, err := fieldpath.Parse(requirement.Field)
+		if err != nil {
+			return false
+		}
+		if value, found, _ := fieldpath.Get(content, path); found && value != nil {
+			set[requirement.Field] = fmt.Sprint(value)
+		}
+	}
+	return selector.Matches(set)
+}
+
+func matchesSearch(content map[string]interface{}, search string) bool {
+	if search == "" {
+		return true
+	}
+	search = strings.ToLower(search)
+	for _, field := range searchFields {
+		path, _ := fieldpath.Parse(field)
+		value, found, _ := fieldpath.Get(content, path)
+		if s, ok := value.(string); found && ok && strings.Contains(strings.ToLower(s), search) {
+			return true
+		}
+	}
+	return false
+}
+
+func compareKeys(a, b []interface{}, orderBy []v1.OrderByField) int {
+	for i, field := range orderBy {
+		c := compareValues(a[i], b[i])
+		if field.Descending {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareValues orders missing values first, numbers by value and everything else by its string representation.
+func compareValues(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func encodeContinueToken(token continueToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("encoding continue token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinueToken(in string, keys int) (continueToken, error) {
+	token := continueToken{}
+	data, err := base64.RawURLEncoding.DecodeString(in)
+	if err != nil {
+		return token, fmt.Errorf("invalid continue token: %w", err)
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, fmt.Errorf("invalid continue token: %w", err)
+	}
+	if len(token.Keys) != keys {
+		return token, fmt.Errorf("invalid continue token: the order of items has changed")
+	}
+	return token, nil
+}
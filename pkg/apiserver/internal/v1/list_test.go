@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+func TestApplyListQuery(t *testing.T) {
+	newProviderList := func() *catalogv1alpha1.ProviderList {
+		provider := func(name, displayName string) catalogv1alpha1.Provider {
+			return catalogv1alpha1.Provider{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "test-namespace",
+				},
+				Spec: catalogv1alpha1.ProviderSpec{
+					Metadata: catalogv1alpha1.AccountMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							DisplayName: displayName,
+						},
+					},
+				},
+			}
+		}
+		return &catalogv1alpha1.ProviderList{
+			Items: []catalogv1alpha1.Provider{
+				provider("beta", "Redis Corp"),
+				provider("alpha", "Postgres Inc"),
+				provider("delta", "Postgres Cloud"),
+				provider("gamma", "MongoDB Inc"),
+			},
+		}
+	}
+	names := func(list *catalogv1alpha1.ProviderList) (out []string) {
+		for _, provider := range list.Items {
+			out = append(out, provider.Name)
+		}
+		return
+	}
+
+	tests := []struct {
+		name               string
+		req                *v1.ListRequest
+		expectedNames      []string
+		expectedTotalCount int64
+	}{
+		{
+			name:               "sorts by namespace and name by default",
+			req:                &v1.ListRequest{},
+			expectedNames:      []string{"alpha", "beta", "delta", "gamma"},
+			expectedTotalCount: 4,
+		},
+		{
+			name: "field selector",
+			req: &v1.ListRequest{
+				FieldSelector: "metadata.name!=beta,spec.metadata.displayName!=MongoDB Inc",
+			},
+			expectedNames:      []string{"alpha", "delta"},
+			expectedTotalCount: 2,
+		},
+		{
+			name: "order by",
+			req: &v1.ListRequest{
+				OrderBy: "-spec.metadata.displayName",
+			},
+			expectedNames:      []string{"beta", "alpha", "delta", "gamma"},
+			expectedTotalCount: 4,
+		},
+		{
+			name: "search",
+			req: &v1.ListRequest{
+				Search: "postgres",
+			},
+			expectedNames:      []string{"alpha", "delta"},
+			expectedTotalCount: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := v1.GetListQuery(test.req)
+			require.NoError(t, err)
+			list := newProviderList()
+			totalCount, err := applyListQuery(list, query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedNames, names(list))
+			assert.Equal(t, test.expectedTotalCount, totalCount)
+			assert.Empty(t, list.Continue)
+		})
+	}
+
+	t.Run("pagination", func(t *testing.T) {
+		req := &v1.ListRequest{
+			Limit:   3,
+			OrderBy: "-metadata.name",
+		}
+		query, err := v1.GetListQuery(req)
+		require.NoError(t, err)
+		list := newProviderList()
+		totalCount, err := applyListQuery(list, query)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gamma", "delta", "beta"}, names(list))
+		assert.Equal(t, int64(4), totalCount)
+		require.NotEmpty(t, list.Continue)
+		if assert.NotNil(t, list.RemainingItemCount) {
+			assert.Equal(t, int64(1), *list.RemainingItemCount)
+		}
+
+		// items before the continue token don't shift the next page
+		req.Continue = list.Continue
+		query, err = v1.GetListQuery(req)
+		require.NoError(t, err)
+		list = newProviderList()
+		list.Items = list.Items[1:]
+		totalCount, err = applyListQuery(list, query)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alpha"}, names(list))
+		assert.Equal(t, int64(3), totalCount)
+		assert.Empty(t, list.Continue)
+		assert.Nil(t, list.RemainingItemCount)
+	})
+
+	t.Run("invalid continue token", func(t *testing.T) {
+		query, err := v1.GetListQuery(&v1.ListRequest{
+			Continue: "not-a-token",
+		})
+		require.NoError(t, err)
+		_, err = applyListQuery(newProviderList(), query)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
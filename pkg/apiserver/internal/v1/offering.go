@@ -18,7 +18,7 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
@@ -34,6 +34,7 @@ import (
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
 )
 
 type offeringServer struct {
@@ -75,15 +76,24 @@ func (o offeringServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	offeringList := &catalogv1alpha1.OfferingList{}
 	if err := o.client.List(ctx, offeringList, listOptions); err != nil {
 		return nil, status.Errorf(codes.Internal, "listing offerings: %s", err.Error())
 	}
+	totalCount, err := applyListQuery(offeringList, listQuery)
+	if err != nil {
+		return nil, err
+	}
 
 	res, err = o.convertOfferingList(offeringList)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "converting OfferingList: %s", err.Error())
 	}
+	res.Metadata.TotalCount = totalCount
 	return
 }
 
@@ -127,19 +137,18 @@ func (o offeringServer) Watch(req *v1.WatchRequest, stream v1.OfferingService_Wa
 }
 
 func (o offeringServer) convertOffering(in *catalogv1alpha1.Offering) (out *v1.Offering, err error) {
-	var versions []*v1.CRDVersion
-	for _, catalogCRDVersion := range in.Spec.CRD.Versions {
-		schemaBytes, _ := json.Marshal(catalogCRDVersion.Schema)
-		versions = append(versions, &v1.CRDVersion{
-			Name:    catalogCRDVersion.Name,
-			Schema:  string(schemaBytes),
-			Storage: catalogCRDVersion.Storage,
-		})
-	}
 	metadata, err := convertObjectMeta(in.ObjectMeta)
 	if err != nil {
 		return nil, err
 	}
+	crd, err := convertCRDInformation(in.Spec.CRD)
+	if err != nil {
+		return nil, err
+	}
+	lifecycle, err := convertOfferingLifecycle(in.Spec.Lifecycle, time.Now())
+	if err != nil {
+		return nil, err
+	}
 
 	out = &v1.Offering{
 		Metadata: metadata,
@@ -152,18 +161,17 @@ func (o offeringServer) convertOffering(in *catalogv1alpha1.Offering) (out *v1.O
 			Provider: &v1.ObjectReference{
 				Name: in.Spec.Provider.Name,
 			},
-			Crd: &v1.CRDInformation{
-				Name:     in.Spec.CRD.Name,
-				ApiGroup: in.Spec.CRD.APIGroup,
-				Kind:     in.Spec.CRD.Kind,
-				Plural:   in.Spec.CRD.Plural,
-				Versions: versions,
-				Region: &v1.ObjectReference{
-					Name: in.Spec.CRD.Region.Name,
-				},
-			},
+			Crd:       crd,
+			Lifecycle: lifecycle,
 		},
 	}
+	for _, bundledCRD := range in.Spec.BundledCRDs {
+		crd, err := convertCRDInformation(bundledCRD)
+		if err != nil {
+			return nil, err
+		}
+		out.Spec.BundledCRDs = append(out.Spec.BundledCRDs, crd)
+	}
 	if in.Spec.Metadata.Logo != nil {
 		out.Spec.Metadata.Logo = convertImage(in.Spec.Metadata.Logo)
 	}
@@ -173,6 +181,22 @@ func (o offeringServer) convertOffering(in *catalogv1alpha1.Offering) (out *v1.O
 	return
 }
 
+func convertOfferingLifecycle(in *catalogv1alpha1.Lifecycle, now time.Time) (*v1.OfferingLifecycle, error) {
+	out := &v1.OfferingLifecycle{
+		State: string(in.GetState(now)),
+	}
+	if in == nil {
+		return out, nil
+	}
+	sunsetDate, err := util.TimestampProto(in.SunsetDate)
+	if err != nil {
+		return nil, err
+	}
+	out.Message = in.Message
+	out.SunsetDate = sunsetDate
+	return out, nil
+}
+
 func (o offeringServer) convertOfferingList(in *catalogv1alpha1.OfferingList) (out *v1.OfferingList, err error) {
 	out = &v1.OfferingList{
 		Metadata: convertListMeta(in.ListMeta),
@@ -19,8 +19,11 @@ package v1
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -139,6 +142,7 @@ func TestListOffering(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      2,
 				},
 				Items: []*v1.Offering{
 					{
@@ -157,6 +161,7 @@ func TestListOffering(t *testing.T) {
 							Provider: &v1.ObjectReference{
 								Name: "test-provider",
 							},
+							Lifecycle: &v1.OfferingLifecycle{State: "Active"},
 							Crd: &v1.CRDInformation{
 								Name:     "test-crd",
 								ApiGroup: "test-crd-group",
@@ -190,6 +195,7 @@ func TestListOffering(t *testing.T) {
 							Provider: &v1.ObjectReference{
 								Name: "test-provider",
 							},
+							Lifecycle: &v1.OfferingLifecycle{State: "Active"},
 							Crd: &v1.CRDInformation{
 								Name:     "test-crd",
 								ApiGroup: "test-crd-group",
@@ -221,6 +227,7 @@ func TestListOffering(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      1,
 				},
 				Items: []*v1.Offering{
 					{
@@ -239,6 +246,7 @@ func TestListOffering(t *testing.T) {
 							Provider: &v1.ObjectReference{
 								Name: "test-provider",
 							},
+							Lifecycle: &v1.OfferingLifecycle{State: "Active"},
 							Crd: &v1.CRDInformation{
 								Name:     "test-crd",
 								ApiGroup: "test-crd-group",
@@ -285,6 +293,11 @@ func TestGetOffering(t *testing.T) {
 			Provider: catalogv1alpha1.ObjectReference{
 				Name: "test-provider",
 			},
+			Lifecycle: &catalogv1alpha1.Lifecycle{
+				State:      catalogv1alpha1.LifecycleStateDeprecated,
+				Message:    "use test-offering-v2 instead",
+				SunsetDate: &metav1.Time{Time: time.Date(2999, 12, 31, 0, 0, 0, 0, time.UTC)},
+			},
 			CRD: catalogv1alpha1.CRDInformation{
 				Name:     "test-crd",
 				APIGroup: "test-crd-group",
@@ -307,6 +320,17 @@ func TestGetOffering(t *testing.T) {
 					Name: "test-region",
 				},
 			},
+			BundledCRDs: []catalogv1alpha1.CRDInformation{
+				{
+					Name:     "test-backup-crd",
+					APIGroup: "test-crd-group",
+					Kind:     "test-backup-kind",
+					Plural:   "test-backup-plural",
+					Region: catalogv1alpha1.ObjectReference{
+						Name: "test-region",
+					},
+				},
+			},
 		},
 	}
 	client := fakeclient.NewFakeClientWithScheme(testScheme, offering)
@@ -340,6 +364,11 @@ func TestGetOffering(t *testing.T) {
 					Provider: &v1.ObjectReference{
 						Name: "test-provider",
 					},
+					Lifecycle: &v1.OfferingLifecycle{
+						State:      "Deprecated",
+						Message:    "use test-offering-v2 instead",
+						SunsetDate: &timestamp.Timestamp{Seconds: time.Date(2999, 12, 31, 0, 0, 0, 0, time.UTC).Unix()},
+					},
 					Crd: &v1.CRDInformation{
 						Name:     "test-crd",
 						ApiGroup: "test-crd-group",
@@ -355,6 +384,17 @@ func TestGetOffering(t *testing.T) {
 							Name: "test-region",
 						},
 					},
+					BundledCRDs: []*v1.CRDInformation{
+						{
+							Name:     "test-backup-crd",
+							ApiGroup: "test-crd-group",
+							Kind:     "test-backup-kind",
+							Plural:   "test-backup-plural",
+							Region: &v1.ObjectReference{
+								Name: "test-region",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -367,3 +407,25 @@ func TestGetOffering(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertOfferingLifecycle(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	sunsetDate := metav1.NewTime(now.Add(-time.Hour))
+
+	lifecycle, err := convertOfferingLifecycle(nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, &v1.OfferingLifecycle{State: "Active"}, lifecycle)
+
+	// Deprecated Offerings are retired after their sunset date.
+	lifecycle, err = convertOfferingLifecycle(&catalogv1alpha1.Lifecycle{
+		State:      catalogv1alpha1.LifecycleStateDeprecated,
+		Message:    "use test-offering-v2 instead",
+		SunsetDate: &sunsetDate,
+	}, now)
+	require.NoError(t, err)
+	assert.Equal(t, &v1.OfferingLifecycle{
+		State:      "Retired",
+		Message:    "use test-offering-v2 instead",
+		SunsetDate: &timestamp.Timestamp{Seconds: sunsetDate.Unix()},
+	}, lifecycle)
+}
@@ -74,15 +74,24 @@ func (o providerServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	providerList := &catalogv1alpha1.ProviderList{}
 	if err := o.client.List(ctx, providerList, listOptions); err != nil {
 		return nil, status.Errorf(codes.Internal, "listing providers: %s", err.Error())
 	}
+	totalCount, err := applyListQuery(providerList, listQuery)
+	if err != nil {
+		return nil, err
+	}
 
 	res, err = o.convertProviderList(providerList)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "converting ProviderList: %s", err.Error())
 	}
+	res.Metadata.TotalCount = totalCount
 	return
 }
 
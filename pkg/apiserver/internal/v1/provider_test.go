@@ -88,6 +88,7 @@ func TestListProvider(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      2,
 				},
 				Items: []*v1.Provider{
 					{
@@ -134,6 +135,7 @@ func TestListProvider(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      1,
 				},
 				Items: []*v1.Provider{
 					{
@@ -23,6 +23,7 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -33,6 +34,7 @@ import (
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
 )
 
 type regionServer struct {
@@ -74,15 +76,24 @@ func (o regionServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.Re
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	regionList := &catalogv1alpha1.RegionList{}
 	if err := o.client.List(ctx, regionList, listOptions); err != nil {
 		return nil, status.Errorf(codes.Internal, "listing regions: %s", err.Error())
 	}
+	totalCount, err := applyListQuery(regionList, listQuery)
+	if err != nil {
+		return nil, err
+	}
 
 	res, err = o.convertRegionList(regionList)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "converting RegionList: %s", err.Error())
 	}
+	res.Metadata.TotalCount = totalCount
 	return
 }
 
@@ -142,9 +153,56 @@ func (o regionServer) convertRegion(in *catalogv1alpha1.Region) (out *v1.Region,
 			},
 		},
 	}
+	if in.Spec.Health != nil {
+		out.Spec.Health = &v1.RegionHealth{
+			State:   string(in.Spec.Health.State),
+			Reason:  in.Spec.Health.Reason,
+			Message: in.Spec.Health.Message,
+		}
+	}
+	if in.Spec.Capacity != nil {
+		out.Spec.Capacity = &v1.RegionCapacity{
+			Allocatable: convertResourceList(in.Spec.Capacity.Allocatable),
+			Requested:   convertResourceList(in.Spec.Capacity.Requested),
+		}
+		for _, instances := range in.Spec.Capacity.Instances {
+			out.Spec.Capacity.Instances = append(out.Spec.Capacity.Instances, &v1.RegionInstanceCount{
+				Crd: &v1.ObjectReference{
+					Name: instances.CRD.Name,
+				},
+				Count: instances.Count,
+			})
+		}
+	}
+	if in.Spec.Maintenance != nil {
+		start, err := util.TimestampProto(in.Spec.Maintenance.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := util.TimestampProto(in.Spec.Maintenance.End)
+		if err != nil {
+			return nil, err
+		}
+		out.Spec.Maintenance = &v1.RegionMaintenance{
+			Reason: in.Spec.Maintenance.Reason,
+			Start:  start,
+			End:    end,
+		}
+	}
 	return
 }
 
+func convertResourceList(in corev1.ResourceList) map[string]string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := map[string]string{}
+	for name, quantity := range in {
+		out[string(name)] = quantity.String()
+	}
+	return out
+}
+
 func (o regionServer) convertRegionList(in *catalogv1alpha1.RegionList) (out *v1.RegionList, err error) {
 	out = &v1.RegionList{
 		Metadata: convertListMeta(in.ListMeta),
@@ -19,8 +19,12 @@ package v1
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -91,6 +95,7 @@ func TestListRegion(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      2,
 				},
 				Items: []*v1.Region{
 					{
@@ -143,6 +148,7 @@ func TestListRegion(t *testing.T) {
 				Metadata: &v1.ListMeta{
 					Continue:        "",
 					ResourceVersion: "",
+					TotalCount:      1,
 				},
 				Items: []*v1.Region{
 					{
@@ -190,6 +196,27 @@ func TestGetRegion(t *testing.T) {
 			Provider: catalogv1alpha1.ObjectReference{
 				Name: "test-provider",
 			},
+			Health: &catalogv1alpha1.RegionHealth{
+				State:   catalogv1alpha1.RegionDegraded,
+				Reason:  "ServiceClusterUnhealthy",
+				Message: "The service cluster is unhealthy.",
+			},
+			Capacity: &corev1alpha1.ServiceClusterCapacity{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				Requested: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("500m"),
+				},
+				Instances: []corev1alpha1.ServiceClusterInstanceCount{
+					{CRD: corev1alpha1.ObjectReference{Name: "couchdbs.couchdb.io"}, Count: 2},
+				},
+			},
+			Maintenance: &corev1alpha1.ServiceClusterMaintenance{
+				Reason: "Kubernetes upgrade",
+				End:    &metav1.Time{Time: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)},
+			},
 		},
 	}
 	client := fakeclient.NewFakeClientWithScheme(testScheme, region)
@@ -223,6 +250,27 @@ func TestGetRegion(t *testing.T) {
 					Provider: &v1.ObjectReference{
 						Name: "test-provider",
 					},
+					Health: &v1.RegionHealth{
+						State:   "Degraded",
+						Reason:  "ServiceClusterUnhealthy",
+						Message: "The service cluster is unhealthy.",
+					},
+					Capacity: &v1.RegionCapacity{
+						Allocatable: map[string]string{
+							"cpu":    "4",
+							"memory": "8Gi",
+						},
+						Requested: map[string]string{
+							"cpu": "500m",
+						},
+						Instances: []*v1.RegionInstanceCount{
+							{Crd: &v1.ObjectReference{Name: "couchdbs.couchdb.io"}, Count: 2},
+						},
+					},
+					Maintenance: &v1.RegionMaintenance{
+						Reason: "Kubernetes upgrade",
+						End:    &timestamp.Timestamp{Seconds: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC).Unix()},
+					},
 				},
 			},
 		},
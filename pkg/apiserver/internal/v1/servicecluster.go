@@ -0,0 +1,278 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
+)
+
+type serviceClusterServer struct {
+	client        client.Client
+	dynamicClient dynamic.Interface
+	scheme        *runtime.Scheme
+
+	gvr schema.GroupVersionResource
+}
+
+var _ v1.ServiceClusterServiceServer = (*serviceClusterServer)(nil)
+
+// +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusters,verbs=get;list;watch;create;update;delete
+
+func NewServiceClusterServiceServer(c client.Client, dynamicClient dynamic.Interface, restMapper meta.RESTMapper, scheme *runtime.Scheme) (v1.ServiceClusterServiceServer, error) {
+	serviceClusterServer := &serviceClusterServer{
+		client:        c,
+		dynamicClient: dynamicClient,
+		scheme:        scheme,
+	}
+	objGVK, err := apiutil.GVKForObject(&corev1alpha1.ServiceCluster{}, serviceClusterServer.scheme)
+	if err != nil {
+		return nil, err
+	}
+	restMapping, err := restMapper.RESTMapping(objGVK.GroupKind(), objGVK.Version)
+	if err != nil {
+		return nil, err
+	}
+	serviceClusterServer.gvr = restMapping.Resource
+	return serviceClusterServer, nil
+}
+
+func (o serviceClusterServer) GetGVR() schema.GroupVersionResource {
+	return o.gvr
+}
+
+func (o serviceClusterServer) List(ctx context.Context, req *v1.ListRequest) (res *v1.ServiceClusterList, err error) {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	listQuery, err := v1.GetListQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	serviceClusterList := &corev1alpha1.ServiceClusterList{}
+	if err := o.client.List(ctx, serviceClusterList, listOptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "listing service clusters: %s", err.Error())
+	}
+	totalCount, err := applyListQuery(serviceClusterList, listQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = o.convertServiceClusterList(serviceClusterList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting ServiceClusterList: %s", err.Error())
+	}
+	res.Metadata.TotalCount = totalCount
+	return
+}
+
+func (o serviceClusterServer) Get(ctx context.Context, req *v1.GetRequest) (res *v1.ServiceCluster, err error) {
+	serviceCluster := &corev1alpha1.ServiceCluster{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, serviceCluster); err != nil {
+		return nil, status.Errorf(errorCode(err), "getting service cluster: %s", err.Error())
+	}
+	res, err = o.convertServiceCluster(serviceCluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting ServiceCluster: %s", err.Error())
+	}
+	return
+}
+
+func (o serviceClusterServer) Create(ctx context.Context, req *v1.ServiceClusterCreateRequest) (res *v1.ServiceCluster, err error) {
+	serviceCluster := &corev1alpha1.ServiceCluster{
+		ObjectMeta: newObjectMeta(req.Spec.Metadata, req.Account),
+		Spec:       o.toServiceClusterSpec(req.Spec.Spec),
+	}
+	if err := o.client.Create(ctx, serviceCluster); err != nil {
+		return nil, status.Errorf(errorCode(err), "creating service cluster: %s", err.Error())
+	}
+	res, err = o.convertServiceCluster(serviceCluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting ServiceCluster: %s", err.Error())
+	}
+	return
+}
+
+func (o serviceClusterServer) Update(ctx context.Context, req *v1.ServiceClusterUpdateRequest) (res *v1.ServiceCluster, err error) {
+	serviceCluster := &corev1alpha1.ServiceCluster{}
+	if err = o.client.Get(ctx, types.NamespacedName{
+		Name:      req.Name,
+		Namespace: req.Account,
+	}, serviceCluster); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating service cluster: %s", err.Error())
+	}
+	updateObjectMeta(&serviceCluster.ObjectMeta, req.Spec.Metadata)
+	serviceCluster.Spec = o.toServiceClusterSpec(req.Spec.Spec)
+	if err := o.client.Update(ctx, serviceCluster); err != nil {
+		return nil, status.Errorf(errorCode(err), "updating service cluster: %s", err.Error())
+	}
+	res, err = o.convertServiceCluster(serviceCluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting ServiceCluster: %s", err.Error())
+	}
+	return
+}
+
+func (o serviceClusterServer) Delete(ctx context.Context, req *v1.DeleteRequest) (*empty.Empty, error) {
+	serviceCluster := &corev1alpha1.ServiceCluster{}
+	serviceCluster.Name = req.Name
+	serviceCluster.Namespace = req.Account
+	if err := o.client.Delete(ctx, serviceCluster); err != nil {
+		return nil, status.Errorf(errorCode(err), "deleting service cluster: %s", err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (o serviceClusterServer) convertEvent(event runtime.Object) (*any.Any, error) {
+	coreServiceCluster := &corev1alpha1.ServiceCluster{}
+	if err := o.scheme.Convert(event, coreServiceCluster, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "converting event.Object to ServiceCluster: %s", err.Error())
+	}
+	serviceCluster, err := o.convertServiceCluster(coreServiceCluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting ServiceCluster: %s", err.Error())
+	}
+	any, err := ptypes.MarshalAny(serviceCluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshalling ServiceCluster to Any: %s", err.Error())
+	}
+	return any, nil
+}
+
+func (o serviceClusterServer) Watch(req *v1.WatchRequest, stream v1.ServiceClusterService_WatchServer) error {
+	listOptions, err := req.GetListOptions()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return watch(o.dynamicClient, o.gvr, req.Account, *listOptions.AsListOptions(), stream, o.convertEvent)
+}
+
+func (o serviceClusterServer) toServiceClusterSpec(in *v1.ServiceClusterSpec) (out corev1alpha1.ServiceClusterSpec) {
+	if in == nil {
+		return
+	}
+	if in.Metadata != nil {
+		out.Metadata = corev1alpha1.ServiceClusterMetadata{
+			DisplayName: in.Metadata.DisplayName,
+			Description: in.Metadata.Description,
+		}
+	}
+	if in.KubeconfigSecret != nil {
+		out.KubeconfigSecret = &corev1alpha1.ObjectReference{
+			Name: in.KubeconfigSecret.Name,
+		}
+	}
+	out.Connection = corev1alpha1.ServiceClusterConnectionType(in.Connection)
+	return
+}
+
+func (o serviceClusterServer) convertServiceCluster(in *corev1alpha1.ServiceCluster) (out *v1.ServiceCluster, err error) {
+	metadata, err := convertObjectMeta(in.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	out = &v1.ServiceCluster{
+		Metadata: metadata,
+		Spec: &v1.ServiceClusterSpec{
+			Metadata: &v1.ServiceClusterMetadata{
+				DisplayName: in.Spec.Metadata.DisplayName,
+				Description: in.Spec.Metadata.Description,
+			},
+			Connection: string(in.Spec.Connection),
+		},
+		Status: &v1.ServiceClusterStatus{
+			Phase:              string(in.Status.Phase),
+			ObservedGeneration: in.Status.ObservedGeneration,
+		},
+	}
+	if in.Spec.KubeconfigSecret != nil {
+		out.Spec.KubeconfigSecret = &v1.ObjectReference{
+			Name: in.Spec.KubeconfigSecret.Name,
+		}
+	}
+	if in.Status.AgentSecret != nil {
+		out.Status.AgentSecret = &v1.ObjectReference{
+			Name: in.Status.AgentSecret.Name,
+		}
+	}
+	if version := in.Status.KubernetesVersion; version != nil {
+		out.Status.KubernetesVersion = &v1.KubernetesVersion{
+			Major:        version.Major,
+			Minor:        version.Minor,
+			GitVersion:   version.GitVersion,
+			GitCommit:    version.GitCommit,
+			GitTreeState: version.GitTreeState,
+			BuildDate:    version.BuildDate,
+			GoVersion:    version.GoVersion,
+			Compiler:     version.Compiler,
+			Platform:     version.Platform,
+		}
+	}
+	for _, condition := range in.Status.Conditions {
+		lastTransitionTime, err := util.TimestampProto(&condition.LastTransitionTime)
+		if err != nil {
+			return nil, err
+		}
+		lastHeartbeatTime, err := util.TimestampProto(&condition.LastHeartbeatTime)
+		if err != nil {
+			return nil, err
+		}
+		out.Status.Conditions = append(out.Status.Conditions, &v1.ServiceClusterCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: lastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastHeartbeatTime:  lastHeartbeatTime,
+		})
+	}
+	return
+}
+
+func (o serviceClusterServer) convertServiceClusterList(in *corev1alpha1.ServiceClusterList) (out *v1.ServiceClusterList, err error) {
+	out = &v1.ServiceClusterList{
+		Metadata: convertListMeta(in.ListMeta),
+	}
+	for _, inServiceCluster := range in.Items {
+		serviceCluster, err := o.convertServiceCluster(&inServiceCluster)
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, serviceCluster)
+	}
+	return
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+func TestCreateGetServiceCluster(t *testing.T) {
+	client := fakeclient.NewFakeClientWithScheme(testScheme)
+	serviceClusterServer := serviceClusterServer{
+		client: client,
+	}
+	ctx := context.Background()
+	_, err := serviceClusterServer.Create(ctx, &v1.ServiceClusterCreateRequest{
+		Account: "test-namespace",
+		Spec: &v1.ServiceCluster{
+			Metadata: &v1.ObjectMeta{Name: "eu-west-1"},
+			Spec: &v1.ServiceClusterSpec{
+				Metadata: &v1.ServiceClusterMetadata{
+					DisplayName: "EU West 1",
+				},
+				KubeconfigSecret: &v1.ObjectReference{Name: "eu-west-1-kubeconfig"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	serviceCluster := &corev1alpha1.ServiceCluster{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "eu-west-1", Namespace: "test-namespace"}, serviceCluster))
+	assert.Equal(t, "eu-west-1-kubeconfig", serviceCluster.Spec.KubeconfigSecret.Name)
+
+	serviceCluster.Status.Phase = corev1alpha1.ServiceClusterPhaseReady
+	serviceCluster.Status.KubernetesVersion = &version.Info{Major: "1", Minor: "18", GitVersion: "v1.18.5"}
+	serviceCluster.Status.Conditions = []corev1alpha1.ServiceClusterCondition{
+		{
+			Type:   corev1alpha1.ServiceClusterReady,
+			Status: corev1alpha1.ConditionTrue,
+			Reason: "ServiceClusterReady",
+		},
+	}
+	require.NoError(t, client.Status().Update(ctx, serviceCluster))
+
+	res, err := serviceClusterServer.Get(ctx, &v1.GetRequest{
+		Name:    "eu-west-1",
+		Account: "test-namespace",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &v1.ServiceClusterSpec{
+		Metadata: &v1.ServiceClusterMetadata{
+			DisplayName: "EU West 1",
+		},
+		KubeconfigSecret: &v1.ObjectReference{Name: "eu-west-1-kubeconfig"},
+	}, res.Spec)
+	assert.Equal(t, &v1.ServiceClusterStatus{
+		Phase: "Ready",
+		Conditions: []*v1.ServiceClusterCondition{
+			{
+				Type:   "Ready",
+				Status: "True",
+				Reason: "ServiceClusterReady",
+			},
+		},
+		KubernetesVersion: &v1.KubernetesVersion{Major: "1", Minor: "18", GitVersion: "v1.18.5"},
+	}, res.Status)
+}
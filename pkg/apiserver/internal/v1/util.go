@@ -18,45 +18,145 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+	"k8c.io/kubecarrier/pkg/apiserver/auth"
 	"k8c.io/kubecarrier/pkg/apiserver/internal/util"
 )
 
 const (
-	accountUserFieldIndex = "account.kubecarrier.io/user"
+	accountSubjectFieldIndex = "account.kubecarrier.io/subject"
 )
 
-// RegisterAccountUsernameFieldIndex adds a field index for user names in Account.Spec.Subjects.
-func RegisterAccountUsernameFieldIndex(ctx context.Context, indexer client.FieldIndexer) error {
+// RegisterAccountSubjectFieldIndex adds a field index for the subjects in Account.Spec.Subjects.
+// Subjects are indexed by kind, so a user and a group with the same name can be told apart.
+func RegisterAccountSubjectFieldIndex(ctx context.Context, indexer client.FieldIndexer) error {
 	return indexer.IndexField(ctx,
-		&catalogv1alpha1.Account{}, accountUserFieldIndex,
+		&catalogv1alpha1.Account{}, accountSubjectFieldIndex,
 		func(obj runtime.Object) (values []string) {
 			account := obj.(*catalogv1alpha1.Account)
 			for _, subject := range account.Spec.Subjects {
-				values = append(values, subject.Name)
+				switch subject.Kind {
+				case rbacv1.UserKind:
+					values = append(values, subjectIndexKey(rbacv1.UserKind, subject.Name))
+				case rbacv1.ServiceAccountKind:
+					values = append(values, subjectIndexKey(rbacv1.UserKind, serviceaccount.MakeUsername(subject.Namespace, subject.Name)))
+				case rbacv1.GroupKind:
+					values = append(values, subjectIndexKey(rbacv1.GroupKind, subject.Name))
+				}
 			}
 			return
 		})
 }
 
-func accountByUsernameListOption(username string) client.ListOption {
-	return client.MatchingFields{
-		accountUserFieldIndex: username,
+func subjectIndexKey(kind, name string) string {
+	return kind + ":" + name
+}
+
+// listAccountsOfUser lists all Accounts the user is a member of, either directly, as ServiceAccount or via one of its groups.
+// Users authenticated by an APIKey are only member of the Account of the APIKey.
+func listAccountsOfUser(ctx context.Context, c client.Client, userInfo user.Info, listOptions *client.ListOptions) (*catalogv1alpha1.AccountList, error) {
+	// the merged list is paginated once by applyListQuery,
+	// a continue token can't be used across the queries of the different subjects.
+	unpaginated := &client.ListOptions{}
+	listOptions.ApplyToList(unpaginated)
+	unpaginated.Limit = 0
+	unpaginated.Continue = ""
+
+	if _, ok := userInfo.GetExtra()[auth.ExtraAPIKeyAccount]; ok {
+		// the user of an APIKey is not a subject of the Account
+		accountList := &catalogv1alpha1.AccountList{}
+		if err := c.List(ctx, accountList, unpaginated); err != nil {
+			return nil, err
+		}
+		out := &catalogv1alpha1.AccountList{ListMeta: accountList.ListMeta}
+		for _, account := range accountList.Items {
+			if isAccountMember(&account, userInfo) {
+				out.Items = append(out.Items, account)
+			}
+		}
+		return out, nil
+	}
+
+	keys := []string{subjectIndexKey(rbacv1.UserKind, userInfo.GetName())}
+	for _, group := range userInfo.GetGroups() {
+		keys = append(keys, subjectIndexKey(rbacv1.GroupKind, group))
+	}
+
+	seen := map[string]struct{}{}
+	out := &catalogv1alpha1.AccountList{}
+	for _, key := range keys {
+		accountList := &catalogv1alpha1.AccountList{}
+		opts := &client.ListOptions{}
+		unpaginated.ApplyToList(opts)
+		client.MatchingFields{accountSubjectFieldIndex: key}.ApplyToList(opts)
+		if err := c.List(ctx, accountList, opts); err != nil {
+			return nil, err
+		}
+		for _, account := range accountList.Items {
+			if _, ok := seen[account.Name]; ok || !isAccountMember(&account, userInfo) {
+				continue
+			}
+			seen[account.Name] = struct{}{}
+			out.Items = append(out.Items, account)
+		}
+	}
+	// the merged list has no single resourceVersion
+	// keep the order of a single list
+	sort.Slice(out.Items, func(i, j int) bool {
+		return out.Items[i].Name < out.Items[j].Name
+	})
+	return out, nil
+}
+
+// isAccountMember checks if the user matches one of the subjects of the Account, considering the kind of the subject.
+func isAccountMember(account *catalogv1alpha1.Account, userInfo user.Info) bool {
+	if apiKeyAccount, ok := userInfo.GetExtra()[auth.ExtraAPIKeyAccount]; ok {
+		// the user of an APIKey is a member of the Account the APIKey belongs to
+		return len(apiKeyAccount) == 1 && apiKeyAccount[0] == account.Name
 	}
+	groups := sets.NewString(userInfo.GetGroups()...)
+	for _, subject := range account.Spec.Subjects {
+		switch subject.Kind {
+		case rbacv1.UserKind:
+			if subject.Name == userInfo.GetName() {
+				return true
+			}
+		case rbacv1.ServiceAccountKind:
+			if serviceaccount.MakeUsername(subject.Namespace, subject.Name) == userInfo.GetName() {
+				return true
+			}
+		case rbacv1.GroupKind:
+			if groups.Has(subject.Name) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func ToMetav1(obj *v1.ObjectMeta) (*metav1.ObjectMeta, error) {
@@ -135,6 +235,363 @@ func convertImage(in *catalogv1alpha1.Image) (out *v1.Image) {
 	}
 }
 
+func convertCommonMetadata(in catalogv1alpha1.CommonMetadata) (out *v1.CommonMetadata) {
+	out = &v1.CommonMetadata{
+		DisplayName:      in.DisplayName,
+		Description:      in.Description,
+		ShortDescription: in.ShortDescription,
+	}
+	if in.Logo != nil {
+		out.Logo = convertImage(in.Logo)
+	}
+	if in.Icon != nil {
+		out.Icon = convertImage(in.Icon)
+	}
+	return
+}
+
+func toCommonMetadata(in *v1.CommonMetadata) (out catalogv1alpha1.CommonMetadata) {
+	if in == nil {
+		return
+	}
+	out = catalogv1alpha1.CommonMetadata{
+		DisplayName:      in.DisplayName,
+		Description:      in.Description,
+		ShortDescription: in.ShortDescription,
+	}
+	if in.Logo != nil {
+		out.Logo = &catalogv1alpha1.Image{MediaType: in.Logo.MediaType, Data: in.Logo.Data}
+	}
+	if in.Icon != nil {
+		out.Icon = &catalogv1alpha1.Image{MediaType: in.Icon.MediaType, Data: in.Icon.Data}
+	}
+	return
+}
+
+func convertCRDInformation(in catalogv1alpha1.CRDInformation) (out *v1.CRDInformation, err error) {
+	var versions []*v1.CRDVersion
+	for _, catalogCRDVersion := range in.Versions {
+		schemaBytes, err := json.Marshal(catalogCRDVersion.Schema)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, &v1.CRDVersion{
+			Name:    catalogCRDVersion.Name,
+			Schema:  string(schemaBytes),
+			Storage: catalogCRDVersion.Storage,
+		})
+	}
+	return &v1.CRDInformation{
+		Name:     in.Name,
+		ApiGroup: in.APIGroup,
+		Kind:     in.Kind,
+		Plural:   in.Plural,
+		Versions: versions,
+		Region: &v1.ObjectReference{
+			Name: in.Region.Name,
+		},
+	}, nil
+}
+
+func convertLabelSelector(in *metav1.LabelSelector) (out *v1.LabelSelector) {
+	if in == nil {
+		return nil
+	}
+	out = &v1.LabelSelector{
+		MatchLabels: in.MatchLabels,
+	}
+	for _, requirement := range in.MatchExpressions {
+		out.MatchExpressions = append(out.MatchExpressions, &v1.LabelSelectorRequirement{
+			Key:      requirement.Key,
+			Operator: string(requirement.Operator),
+			Values:   requirement.Values,
+		})
+	}
+	return
+}
+
+func toLabelSelector(in *v1.LabelSelector) (out *metav1.LabelSelector) {
+	if in == nil {
+		return nil
+	}
+	out = &metav1.LabelSelector{
+		MatchLabels: in.MatchLabels,
+	}
+	for _, requirement := range in.MatchExpressions {
+		out.MatchExpressions = append(out.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      requirement.Key,
+			Operator: metav1.LabelSelectorOperator(requirement.Operator),
+			Values:   requirement.Values,
+		})
+	}
+	return
+}
+
+func convertVersionExposeConfigs(in []catalogv1alpha1.VersionExposeConfig) (out []*v1.VersionExposeConfig) {
+	for _, exposeConfig := range in {
+		config := &v1.VersionExposeConfig{
+			Versions: exposeConfig.Versions,
+		}
+		for _, field := range exposeConfig.Fields {
+			config.Fields = append(config.Fields, &v1.FieldPath{JsonPath: field.JSONPath, TenantPath: field.TenantPath})
+		}
+		for _, fieldDefault := range exposeConfig.Defaults {
+			config.Defaults = append(config.Defaults, &v1.FieldDefault{JsonPath: fieldDefault.JSONPath, Value: string(fieldDefault.Value.Raw)})
+		}
+		out = append(out, config)
+	}
+	return
+}
+
+func toVersionExposeConfigs(in []*v1.VersionExposeConfig) (out []catalogv1alpha1.VersionExposeConfig) {
+	for _, exposeConfig := range in {
+		config := catalogv1alpha1.VersionExposeConfig{
+			Versions: exposeConfig.Versions,
+		}
+		for _, field := range exposeConfig.Fields {
+			config.Fields = append(config.Fields, catalogv1alpha1.FieldPath{JSONPath: field.JsonPath, TenantPath: field.TenantPath})
+		}
+		for _, fieldDefault := range exposeConfig.Defaults {
+			config.Defaults = append(config.Defaults, catalogv1alpha1.FieldDefault{
+				JSONPath: fieldDefault.JsonPath,
+				Value:    apiextensionsv1.JSON{Raw: []byte(fieldDefault.Value)},
+			})
+		}
+		out = append(out, config)
+	}
+	return
+}
+
+func convertReferencedObjects(in []catalogv1alpha1.ReferencedObject) (out []*v1.ReferencedObject) {
+	for _, ref := range in {
+		out = append(out, &v1.ReferencedObject{
+			Kind:     string(ref.Kind),
+			Name:     ref.Name,
+			JsonPath: ref.JSONPath,
+		})
+	}
+	return
+}
+
+func toReferencedObjects(in []*v1.ReferencedObject) (out []catalogv1alpha1.ReferencedObject) {
+	for _, ref := range in {
+		out = append(out, catalogv1alpha1.ReferencedObject{
+			Kind:     catalogv1alpha1.ReferencedObjectKind(ref.Kind),
+			Name:     ref.Name,
+			JSONPath: ref.JsonPath,
+		})
+	}
+	return
+}
+
+func convertObjectReferenceFields(in []catalogv1alpha1.ObjectReferenceField) (out []*v1.ObjectReferenceField) {
+	for _, field := range in {
+		out = append(out, &v1.ObjectReferenceField{
+			CatalogEntry: &v1.ObjectReference{
+				Name: field.CatalogEntry.Name,
+			},
+			JsonPath:          field.JSONPath,
+			NamespaceJSONPath: field.NamespaceJSONPath,
+			ApiGroupJSONPath:  field.APIGroupJSONPath,
+		})
+	}
+	return
+}
+
+func toObjectReferenceFields(in []*v1.ObjectReferenceField) (out []catalogv1alpha1.ObjectReferenceField) {
+	for _, field := range in {
+		f := catalogv1alpha1.ObjectReferenceField{
+			JSONPath:          field.JsonPath,
+			NamespaceJSONPath: field.NamespaceJSONPath,
+			APIGroupJSONPath:  field.ApiGroupJSONPath,
+		}
+		if field.CatalogEntry != nil {
+			f.CatalogEntry.Name = field.CatalogEntry.Name
+		}
+		out = append(out, f)
+	}
+	return
+}
+
+func convertObjectReferences(in []catalogv1alpha1.ObjectReference) (out []*v1.ObjectReference) {
+	for _, ref := range in {
+		out = append(out, &v1.ObjectReference{
+			Name: ref.Name,
+		})
+	}
+	return
+}
+
+func toObjectReferences(in []*v1.ObjectReference) (out []catalogv1alpha1.ObjectReference) {
+	for _, ref := range in {
+		out = append(out, catalogv1alpha1.ObjectReference{
+			Name: ref.Name,
+		})
+	}
+	return
+}
+
+func convertMeteringConfig(in *catalogv1alpha1.MeteringConfig) (out *v1.MeteringConfig) {
+	if in == nil {
+		return nil
+	}
+	out = &v1.MeteringConfig{
+		Sink: in.Sink,
+	}
+	for _, dimension := range in.Dimensions {
+		out.Dimensions = append(out.Dimensions, &v1.MeteringDimension{
+			Name:     dimension.Name,
+			JsonPath: dimension.JSONPath,
+		})
+	}
+	return
+}
+
+func toMeteringConfig(in *v1.MeteringConfig) (out *catalogv1alpha1.MeteringConfig) {
+	if in == nil {
+		return nil
+	}
+	out = &catalogv1alpha1.MeteringConfig{
+		Sink: in.Sink,
+	}
+	for _, dimension := range in.Dimensions {
+		out.Dimensions = append(out.Dimensions, catalogv1alpha1.MeteringDimension{
+			Name:     dimension.Name,
+			JSONPath: dimension.JsonPath,
+		})
+	}
+	return
+}
+
+func convertMigrationConfig(in *catalogv1alpha1.MigrationConfig) (out *v1.MigrationConfig) {
+	if in == nil {
+		return nil
+	}
+	return &v1.MigrationConfig{
+		PreHook:  convertMigrationHook(in.PreHook),
+		PostHook: convertMigrationHook(in.PostHook),
+	}
+}
+
+func convertMigrationHook(in *catalogv1alpha1.MigrationHook) (out *v1.MigrationHook) {
+	if in == nil {
+		return nil
+	}
+	out = &v1.MigrationHook{
+		Url: in.URL,
+	}
+	if in.Timeout != nil {
+		out.TimeoutSeconds = int64(in.Timeout.Seconds())
+	}
+	return
+}
+
+func toMigrationConfig(in *v1.MigrationConfig) (out *catalogv1alpha1.MigrationConfig) {
+	if in == nil {
+		return nil
+	}
+	return &catalogv1alpha1.MigrationConfig{
+		PreHook:  toMigrationHook(in.PreHook),
+		PostHook: toMigrationHook(in.PostHook),
+	}
+}
+
+func toMigrationHook(in *v1.MigrationHook) (out *catalogv1alpha1.MigrationHook) {
+	if in == nil {
+		return nil
+	}
+	out = &catalogv1alpha1.MigrationHook{
+		URL: in.Url,
+	}
+	if in.TimeoutSeconds > 0 {
+		out.Timeout = &metav1.Duration{Duration: time.Duration(in.TimeoutSeconds) * time.Second}
+	}
+	return
+}
+
+func convertSchedulingConfig(in *catalogv1alpha1.SchedulingConfig) (out *v1.SchedulingConfig) {
+	if in == nil {
+		return nil
+	}
+	out = &v1.SchedulingConfig{
+		Policy: string(in.Policy),
+	}
+	for _, serviceCluster := range in.PreferredServiceClusters {
+		out.PreferredServiceClusters = append(out.PreferredServiceClusters, &v1.ObjectReference{
+			Name: serviceCluster.Name,
+		})
+	}
+	return
+}
+
+func toSchedulingConfig(in *v1.SchedulingConfig) (out *catalogv1alpha1.SchedulingConfig) {
+	if in == nil {
+		return nil
+	}
+	out = &catalogv1alpha1.SchedulingConfig{
+		Policy: catalogv1alpha1.SchedulingPolicy(in.Policy),
+	}
+	for _, serviceCluster := range in.PreferredServiceClusters {
+		out.PreferredServiceClusters = append(out.PreferredServiceClusters, catalogv1alpha1.ObjectReference{
+			Name: serviceCluster.Name,
+		})
+	}
+	return
+}
+
+func convertDerivedConfig(in *catalogv1alpha1.DerivedConfig) (out *v1.DerivedConfig) {
+	if in == nil {
+		return nil
+	}
+	return &v1.DerivedConfig{
+		Expose: convertVersionExposeConfigs(in.Expose),
+	}
+}
+
+func toDerivedConfig(in *v1.DerivedConfig) (out *catalogv1alpha1.DerivedConfig) {
+	if in == nil {
+		return nil
+	}
+	return &catalogv1alpha1.DerivedConfig{
+		Expose: toVersionExposeConfigs(in.Expose),
+	}
+}
+
+// newObjectMeta returns the user settable part of the given ObjectMeta for object creation.
+func newObjectMeta(in *v1.ObjectMeta, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        in.Name,
+		Namespace:   namespace,
+		Labels:      in.Labels,
+		Annotations: in.Annotations,
+	}
+}
+
+// updateObjectMeta applies the mutable fields of the given ObjectMeta to an existing object,
+// the resourceVersion is used for optimistic locking.
+func updateObjectMeta(obj *metav1.ObjectMeta, in *v1.ObjectMeta) {
+	obj.Labels = in.Labels
+	obj.Annotations = in.Annotations
+	obj.ResourceVersion = in.ResourceVersion
+}
+
+// errorCode maps errors from the Kubernetes API to the matching gRPC status code.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.IsNotFound(err):
+		return codes.NotFound
+	case errors.IsAlreadyExists(err):
+		return codes.AlreadyExists
+	case errors.IsConflict(err):
+		return codes.Aborted
+	case errors.IsInvalid(err), errors.IsBadRequest(err):
+		return codes.InvalidArgument
+	case errors.IsForbidden(err):
+		return codes.PermissionDenied
+	}
+	return codes.Internal
+}
+
 func convertObjectMeta(in metav1.ObjectMeta) (out *v1.ObjectMeta, err error) {
 	creationTimestamp, err := util.TimestampProto(&in.CreationTimestamp)
 	if err != nil {
@@ -173,31 +630,133 @@ type streamer interface {
 	Context() context.Context
 }
 
+var (
+	// watchHeartbeatInterval is the interval of BOOKMARK events sent on idle watches.
+	watchHeartbeatInterval = 30 * time.Second
+	// watchRestartBackoff is the initial delay before an expired upstream watch is restarted,
+	// it's doubled for every restart without events up to watchRestartMaxBackoff.
+	watchRestartBackoff    = 100 * time.Millisecond
+	watchRestartMaxBackoff = 30 * time.Second
+)
+
+// watchFunc starts a watch from the given options.
+type watchFunc func(ctx context.Context, opts metav1.ListOptions) (k8swatch.Interface, error)
+
 func watch(client dynamic.Interface, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions, stream streamer, convertFunc ConvertFunc) error {
-	watcher, err := client.Resource(gvr).Namespace(namespace).Watch(stream.Context(), opts)
-	if err != nil {
-		return status.Errorf(codes.Internal, "watching %s: %s", gvr.Resource, err.Error())
-	}
-	defer watcher.Stop()
-	for {
-		select {
-		case <-stream.Context().Done():
-			return stream.Context().Err()
-		case event, ok := <-watcher.ResultChan():
-			if !ok {
-				return status.Error(codes.Internal, "watch event channel was closed")
+	return resumableWatch(gvr.Resource, func(ctx context.Context, opts metav1.ListOptions) (k8swatch.Interface, error) {
+		return client.Resource(gvr).Namespace(namespace).Watch(ctx, opts)
+	}, opts, stream, convertFunc)
+}
+
+// resumableWatch streams events until the client disconnects.
+// When the upstream watch expires, it is restarted with backoff from the last resourceVersion
+// seen in events and bookmarks. Without a resourceVersion nothing was sent yet,
+// so a restart only replays the initial state the client asked for.
+// If bookmarks are allowed, upstream BOOKMARK events are forwarded
+// and idle watches receive a BOOKMARK event every watchHeartbeatInterval, once a resourceVersion is known.
+// An expired resourceVersion is reported as codes.OutOfRange, so clients know to relist.
+func resumableWatch(resource string, startWatch watchFunc, opts metav1.ListOptions, stream streamer, convertFunc ConvertFunc) error {
+	ctx := stream.Context()
+	resourceVersion := opts.ResourceVersion
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+	// idle tracks whether no event was sent since the last heartbeat tick.
+	idle := true
+	backoff := watchRestartBackoff
+
+	send := func(event *v1.WatchEvent) error {
+		idle = false
+		err := stream.Send(event)
+		if grpcStatus, _ := status.FromError(err); grpcStatus != nil && grpcStatus.Err() != nil {
+			return status.Errorf(codes.Internal, "sending %s stream: %s", resource, grpcStatus.Err())
+		}
+		return nil
+	}
+
+	for restart := false; ; restart = true {
+		if restart {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
 			}
-			any, err := convertFunc(event.Object)
-			if err != nil {
-				return err
+			if backoff *= 2; backoff > watchRestartMaxBackoff {
+				backoff = watchRestartMaxBackoff
 			}
-			err = stream.Send(&v1.WatchEvent{
-				Type:   string(event.Type),
-				Object: any,
-			})
-			if grpcStatus, _ := status.FromError(err); grpcStatus != nil && grpcStatus.Err() != nil {
-				return status.Errorf(codes.Internal, "sending %s stream: %s", gvr.Resource, grpcStatus.Err())
+		}
+		opts.ResourceVersion = resourceVersion
+		watcher, err := startWatch(ctx, opts)
+		if err != nil {
+			return watchError(resource, err)
+		}
+		err = func() error {
+			defer watcher.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-heartbeat.C:
+					if !idle || !opts.AllowWatchBookmarks || resourceVersion == "" {
+						idle = true
+						continue
+					}
+					if err := send(&v1.WatchEvent{
+						Type:            string(k8swatch.Bookmark),
+						ResourceVersion: resourceVersion,
+					}); err != nil {
+						return err
+					}
+					// heartbeats don't count as activity
+					idle = true
+				case event, ok := <-watcher.ResultChan():
+					if !ok {
+						// the upstream watch expired, restart it
+						return nil
+					}
+					if event.Type == k8swatch.Error {
+						return watchError(resource, errors.FromObject(event.Object))
+					}
+					// the upstream watch is healthy again
+					backoff = watchRestartBackoff
+					if accessor, err := meta.Accessor(event.Object); err == nil && accessor.GetResourceVersion() != "" {
+						resourceVersion = accessor.GetResourceVersion()
+					}
+					if event.Type == k8swatch.Bookmark {
+						if !opts.AllowWatchBookmarks || resourceVersion == "" {
+							continue
+						}
+						if err := send(&v1.WatchEvent{
+							Type:            string(event.Type),
+							ResourceVersion: resourceVersion,
+						}); err != nil {
+							return err
+						}
+						continue
+					}
+
+					any, err := convertFunc(event.Object)
+					if err != nil {
+						return err
+					}
+					if err := send(&v1.WatchEvent{
+						Type:            string(event.Type),
+						Object:          any,
+						ResourceVersion: resourceVersion,
+					}); err != nil {
+						return err
+					}
+				}
 			}
+		}()
+		if err != nil {
+			return err
 		}
 	}
 }
+
+func watchError(resource string, err error) error {
+	if errors.IsResourceExpired(err) || errors.IsGone(err) {
+		return status.Errorf(codes.OutOfRange, "watching %s: resource version too old, list again to get a current resourceVersion: %s", resource, err.Error())
+	}
+	return status.Errorf(codes.Internal, "watching %s: %s", resource, err.Error())
+}
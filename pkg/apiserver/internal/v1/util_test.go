@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
+
+	v1 "k8c.io/kubecarrier/pkg/apiserver/api/v1"
+)
+
+type fakeStreamer struct {
+	ctx    context.Context
+	events []*v1.WatchEvent
+	onSend func(*v1.WatchEvent)
+}
+
+func (s *fakeStreamer) Send(event *v1.WatchEvent) error {
+	s.events = append(s.events, event)
+	if s.onSend != nil {
+		s.onSend(event)
+	}
+	return nil
+}
+
+func (s *fakeStreamer) Context() context.Context {
+	return s.ctx
+}
+
+func TestResumableWatch(t *testing.T) {
+	newObj := func(resourceVersion string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetName("test")
+		obj.SetResourceVersion(resourceVersion)
+		return obj
+	}
+	convert := func(obj runtime.Object) (*any.Any, error) {
+		u := obj.(*unstructured.Unstructured)
+		return ptypes.MarshalAny(&v1.ObjectMeta{Name: u.GetName()})
+	}
+
+	defer func(backoff time.Duration) { watchRestartBackoff = backoff }(watchRestartBackoff)
+	watchRestartBackoff = time.Millisecond
+
+	t.Run("restarts expired watches", func(t *testing.T) {
+		for _, allowWatchBookmarks := range []bool{true, false} {
+			first := k8swatch.NewFakeWithChanSize(2, false)
+			first.Add(newObj("1"))
+			first.Action(k8swatch.Bookmark, newObj("2"))
+			first.Stop()
+			second := k8swatch.NewFakeWithChanSize(2, false)
+			second.Add(newObj("3"))
+			second.Error(&errors.NewResourceExpired("too old resource version").ErrStatus)
+
+			watchers := []k8swatch.Interface{first, second}
+			var resourceVersions []string
+			startWatch := func(ctx context.Context, opts metav1.ListOptions) (k8swatch.Interface, error) {
+				resourceVersions = append(resourceVersions, opts.ResourceVersion)
+				watcher := watchers[0]
+				watchers = watchers[1:]
+				return watcher, nil
+			}
+
+			stream := &fakeStreamer{ctx: context.Background()}
+			err := resumableWatch("tests", startWatch, metav1.ListOptions{
+				AllowWatchBookmarks: allowWatchBookmarks,
+			}, stream, convert)
+			assert.Equal(t, codes.OutOfRange, status.Code(err))
+			assert.Equal(t, []string{"", "2"}, resourceVersions)
+
+			var events []string
+			for _, event := range stream.events {
+				events = append(events, event.Type+"/"+event.ResourceVersion)
+			}
+			if allowWatchBookmarks {
+				assert.Equal(t, []string{"ADDED/1", "BOOKMARK/2", "ADDED/3"}, events)
+			} else {
+				assert.Equal(t, []string{"ADDED/1", "ADDED/3"}, events)
+			}
+		}
+	})
+
+	t.Run("sends heartbeats", func(t *testing.T) {
+		defer func(interval time.Duration) { watchHeartbeatInterval = interval }(watchHeartbeatInterval)
+		watchHeartbeatInterval = 10 * time.Millisecond
+
+		watcher := k8swatch.NewFake()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stream := &fakeStreamer{ctx: ctx, onSend: func(event *v1.WatchEvent) {
+			if event.Type == string(k8swatch.Bookmark) {
+				cancel()
+			}
+		}}
+		err := resumableWatch("tests", func(ctx context.Context, opts metav1.ListOptions) (k8swatch.Interface, error) {
+			return watcher, nil
+		}, metav1.ListOptions{
+			AllowWatchBookmarks: true,
+			ResourceVersion:     "5",
+		}, stream, convert)
+		assert.Equal(t, context.Canceled, err)
+		require.Len(t, stream.events, 1)
+		assert.Equal(t, &v1.WatchEvent{
+			Type:            "BOOKMARK",
+			ResourceVersion: "5",
+		}, stream.events[0])
+	})
+
+	t.Run("backs off restarts", func(t *testing.T) {
+		defer func(backoff, maxBackoff time.Duration) {
+			watchRestartBackoff, watchRestartMaxBackoff = backoff, maxBackoff
+		}(watchRestartBackoff, watchRestartMaxBackoff)
+		watchRestartBackoff, watchRestartMaxBackoff = 10*time.Millisecond, 40*time.Millisecond
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		var starts []time.Time
+		err := resumableWatch("tests", func(ctx context.Context, opts metav1.ListOptions) (k8swatch.Interface, error) {
+			starts = append(starts, time.Now())
+			if len(starts) == 5 {
+				cancel()
+			}
+			// the upstream watch closes right away
+			watcher := k8swatch.NewFake()
+			watcher.Stop()
+			return watcher, nil
+		}, metav1.ListOptions{ResourceVersion: "5"}, &fakeStreamer{ctx: ctx}, convert)
+		assert.Equal(t, context.Canceled, err)
+		require.Len(t, starts, 5)
+		for i, backoff := range []time.Duration{10, 20, 40, 40} {
+			assert.GreaterOrEqual(t, int64(starts[i+1].Sub(starts[i])), int64(backoff*time.Millisecond), "restart %d", i+1)
+		}
+	})
+
+	t.Run("sends no heartbeats without resourceVersion", func(t *testing.T) {
+		defer func(interval time.Duration) { watchHeartbeatInterval = interval }(watchHeartbeatInterval)
+		watchHeartbeatInterval = time.Millisecond
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		watcher := k8swatch.NewFakeWithChanSize(1, false)
+		watcher.Action(k8swatch.Bookmark, newObj(""))
+		stream := &fakeStreamer{ctx: ctx}
+		err := resumableWatch("tests", func(ctx context.Context, opts metav1.ListOptions) (k8swatch.Interface, error) {
+			return watcher, nil
+		}, metav1.ListOptions{AllowWatchBookmarks: true}, stream, convert)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Empty(t, stream.events, "bookmarks without resourceVersion can't be resumed from")
+	})
+
+	t.Run("reports other errors as internal", func(t *testing.T) {
+		watcher := k8swatch.NewFakeWithChanSize(1, false)
+		watcher.Error(&errors.NewForbidden(schema.GroupResource{Resource: "tests"}, "test", nil).ErrStatus)
+		err := resumableWatch("tests", func(ctx context.Context, opts metav1.ListOptions) (k8swatch.Interface, error) {
+			return watcher, nil
+		}, metav1.ListOptions{}, &fakeStreamer{ctx: context.Background()}, convert)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
@@ -38,9 +38,13 @@ import (
 
 	"k8c.io/utils/pkg/util"
 
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
 	"k8c.io/kubecarrier/pkg/catapult/internal/controllers"
 	"k8c.io/kubecarrier/pkg/catapult/internal/webhooks"
+	"k8c.io/kubecarrier/pkg/internal/consolidation"
+	utilwebhook "k8c.io/kubecarrier/pkg/internal/util/webhook"
 )
 
 type flags struct {
@@ -56,6 +60,8 @@ type flags struct {
 
 	mutatingWebhookPath string
 	webhookStrategy     string
+
+	consolidated bool
 }
 
 var (
@@ -66,6 +72,8 @@ var (
 func init() {
 	_ = clientgoscheme.AddToScheme(managementScheme)
 	_ = corev1alpha1.AddToScheme(managementScheme)
+	_ = catalogv1alpha1.AddToScheme(managementScheme)
+	_ = operatorv1alpha1.AddToScheme(managementScheme)
 	_ = clientgoscheme.AddToScheme(serviceScheme)
 }
 
@@ -128,6 +136,10 @@ func NewCatapult() *cobra.Command {
 		&flags.webhookStrategy, "webhook-strategy",
 		os.Getenv("CATAPULT_WEBHOOK_STRATEGY"), "The strategy of deploying the catapult webhook service {None (by default), ServiceCluster}")
 
+	cmd.Flags().BoolVar(
+		&flags.consolidated, "consolidated",
+		os.Getenv("CATAPULT_CONSOLIDATED") == "true", "Serve the CRDs of all Catapult objects of the ServiceCluster, instead of a single CRD.")
+
 	return util.CmdLogMixin(cmd)
 }
 
@@ -136,21 +148,27 @@ func run(flags *flags, log logr.Logger) error {
 	checks := []struct {
 		value, env, flag string
 	}{
-		{value: flags.managementClusterKind, env: "CATAPULT_MANAGEMENT_CLUSTER_KIND", flag: "management-cluster-kind"},
-		{value: flags.managementClusterVersion, env: "CATAPULT_MANAGEMENT_CLUSTER_VERSION", flag: "management-cluster-version"},
-		{value: flags.managementClusterGroup, env: "CATAPULT_MANAGEMENT_CLUSTER_GROUP", flag: "management-cluster-group"},
-
-		{value: flags.serviceClusterKind, env: "CATAPULT_SERVICE_CLUSTER_KIND", flag: "service-cluster-kind"},
-		{value: flags.serviceClusterVersion, env: "CATAPULT_SERVICE_CLUSTER_VERSION", flag: "service-cluster-version"},
-		{value: flags.serviceClusterGroup, env: "CATAPULT_SERVICE_CLUSTER_GROUP", flag: "service-cluster-group"},
-
 		{value: flags.serviceClusterKubeconfig, env: "CATAPULT_SERVICE_CLUSTER_KUBECONFIG", flag: "service-cluster-kubeconfig"},
-		{value: flags.serviceClusterKubeconfig, env: "CATAPULT_SERVICE_CLUSTER_NAME", flag: "service-cluster-name"},
+		{value: flags.serviceClusterName, env: "CATAPULT_SERVICE_CLUSTER_NAME", flag: "service-cluster-name"},
 
 		{value: flags.providerNamespace, env: "KUBERNETES_NAMESPACE", flag: "provider-namespace"},
-
-		{value: flags.mutatingWebhookPath, env: "CATAPULT_MUTATING_WEBHOOK_PATH", flag: "mutating-webhook-path"},
-		{value: flags.webhookStrategy, env: "CATAPULT_WEBHOOK_STRATEGY", flag: "webhook-strategy"},
+	}
+	if !flags.consolidated {
+		// a consolidated Catapult reads the CRDs to serve from Catapult objects
+		checks = append(checks, []struct {
+			value, env, flag string
+		}{
+			{value: flags.managementClusterKind, env: "CATAPULT_MANAGEMENT_CLUSTER_KIND", flag: "management-cluster-kind"},
+			{value: flags.managementClusterVersion, env: "CATAPULT_MANAGEMENT_CLUSTER_VERSION", flag: "management-cluster-version"},
+			{value: flags.managementClusterGroup, env: "CATAPULT_MANAGEMENT_CLUSTER_GROUP", flag: "management-cluster-group"},
+
+			{value: flags.serviceClusterKind, env: "CATAPULT_SERVICE_CLUSTER_KIND", flag: "service-cluster-kind"},
+			{value: flags.serviceClusterVersion, env: "CATAPULT_SERVICE_CLUSTER_VERSION", flag: "service-cluster-version"},
+			{value: flags.serviceClusterGroup, env: "CATAPULT_SERVICE_CLUSTER_GROUP", flag: "service-cluster-group"},
+
+			{value: flags.mutatingWebhookPath, env: "CATAPULT_MUTATING_WEBHOOK_PATH", flag: "mutating-webhook-path"},
+			{value: flags.webhookStrategy, env: "CATAPULT_WEBHOOK_STRATEGY", flag: "webhook-strategy"},
+		}...)
 	}
 	var errs []string
 	for _, check := range checks {
@@ -171,31 +189,99 @@ func run(flags *flags, log logr.Logger) error {
 		LeaderElection:         flags.enableLeaderElection,
 		Port:                   9443,
 		CertDir:                flags.certDir,
-		NewClient: func(cache cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
-			// Create the Client for Write operations.
-			c, err := client.New(config, options)
-			if err != nil {
-				return nil, err
-			}
-
-			// we don't want a client.DelegatingReader here,
-			// because we WANT to cache unstructured objects.
-			return &client.DelegatingClient{
-				Reader:       cache,
-				Writer:       c,
-				StatusClient: c,
-			}, nil
-		},
+		NewClient:              newClient,
 	})
 	if err != nil {
 		return fmt.Errorf("starting manager: %w", err)
 	}
 
+	// Setup client for Service Cluster
+	serviceCfg, err := clientcmd.BuildConfigFromFlags(
+		"", flags.serviceClusterKubeconfig)
+	if err != nil {
+		return fmt.Errorf("reading service cluster config: %w", err)
+	}
+
+	if flags.consolidated {
+		if err := setupConsolidated(mgr, log, managementCfg, serviceCfg, flags); err != nil {
+			return err
+		}
+	} else {
+		wbh := mgr.GetWebhookServer()
+		if err := setupInstance(mgr, log, serviceCfg, flags.providerNamespace, flags.serviceClusterName, instanceConfig{
+			ManagementClusterGVK: schema.GroupVersionKind{
+				Kind:    flags.managementClusterKind,
+				Version: flags.managementClusterVersion,
+				Group:   flags.managementClusterGroup,
+			},
+			ServiceClusterGVK: schema.GroupVersionKind{
+				Kind:    flags.serviceClusterKind,
+				Version: flags.serviceClusterVersion,
+				Group:   flags.serviceClusterGroup,
+			},
+			MutatingWebhookPath: flags.mutatingWebhookPath,
+			WebhookStrategy:     corev1alpha1.WebhookStrategyType(flags.webhookStrategy),
+		}, func(path string, hook *webhook.Admission) error {
+			wbh.Register(path, hook)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("adding readyz checker: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("adding healthz checker: %w", err)
+	}
+
+	log.Info("starting manager")
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		return fmt.Errorf("running manager: %w", err)
+	}
+	return nil
+}
+
+func newClient(cache cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
+	// Create the Client for Write operations.
+	c, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// we don't want a client.DelegatingReader here,
+	// because we WANT to cache unstructured objects.
+	return &client.DelegatingClient{
+		Reader:       cache,
+		Writer:       c,
+		StatusClient: c,
+	}, nil
+}
+
+// instanceConfig configures the controllers and webhook of a single CRD.
+type instanceConfig struct {
+	ManagementClusterGVK, ServiceClusterGVK schema.GroupVersionKind
+
+	MutatingWebhookPath string
+	WebhookStrategy     corev1alpha1.WebhookStrategyType
+}
+
+// registerWebhookFunc serves the given webhook at the given URL path.
+type registerWebhookFunc func(path string, hook *webhook.Admission) error
+
+// setupInstance adds the controllers and webhook for a single CRD to the given manager.
+func setupInstance(
+	mgr ctrl.Manager, log logr.Logger, serviceCfg *rest.Config,
+	providerNamespace, serviceClusterName string,
+	cfg instanceConfig, registerWebhook registerWebhookFunc,
+) error {
 	// Setup additional namespaced client for management cluster
-	namespacedCache, err := cache.New(managementCfg, cache.Options{
+	namespacedCache, err := cache.New(mgr.GetConfig(), cache.Options{
 		Scheme:    mgr.GetScheme(),
 		Mapper:    mgr.GetRESTMapper(),
-		Namespace: flags.providerNamespace,
+		Namespace: providerNamespace,
 	})
 	if err != nil {
 		return fmt.Errorf(
@@ -211,11 +297,6 @@ func run(flags *flags, log logr.Logger) error {
 	}
 
 	// Setup additional client and cache for Service Cluster
-	serviceCfg, err := clientcmd.BuildConfigFromFlags(
-		"", flags.serviceClusterKubeconfig)
-	if err != nil {
-		return fmt.Errorf("reading service cluster config: %w", err)
-	}
 	serviceMapper, err := apiutil.NewDiscoveryRESTMapper(serviceCfg)
 	if err != nil {
 		return fmt.Errorf("creating service cluster rest mapper: %w", err)
@@ -244,16 +325,15 @@ func run(flags *flags, log logr.Logger) error {
 	}
 
 	// Setup Types
-	managementClusterGVK := schema.GroupVersionKind{
-		Kind:    flags.managementClusterKind,
-		Version: flags.managementClusterVersion,
-		Group:   flags.managementClusterGroup,
-	}
-	serviceClusterGVK := schema.GroupVersionKind{
-		Kind:    flags.serviceClusterKind,
-		Version: flags.serviceClusterVersion,
-		Group:   flags.serviceClusterGroup,
+	managementClusterGVK := cfg.ManagementClusterGVK
+	serviceClusterGVK := cfg.ServiceClusterGVK
+
+	managementClusterMapping, err := mgr.GetRESTMapper().RESTMapping(
+		managementClusterGVK.GroupKind(), managementClusterGVK.Version)
+	if err != nil {
+		return fmt.Errorf("getting REST mapping for %s: %w", managementClusterGVK.Kind, err)
 	}
+	managementClusterCRD := managementClusterMapping.Resource.Resource + "." + managementClusterMapping.Resource.Group
 
 	// Setup field indexes
 	if err := corev1alpha1.RegisterServiceClusterAssignmentNamespaceFieldIndex(context.Background(), namespacedCache); err != nil {
@@ -265,14 +345,16 @@ func run(flags *flags, log logr.Logger) error {
 		Log:              log.WithName("controllers").WithName("ManagementClusterObjReconciler"),
 		Client:           mgr.GetClient(),
 		NamespacedClient: namespacedClient,
+		Scheme:           mgr.GetScheme(),
 
 		ServiceClusterClient: serviceCachedClient,
 		ServiceClusterCache:  serviceCache,
-		ServiceCluster:       flags.serviceClusterName,
-		ProviderNamespace:    flags.providerNamespace,
+		ServiceCluster:       serviceClusterName,
+		ProviderNamespace:    providerNamespace,
 
 		ManagementClusterGVK: managementClusterGVK,
 		ServiceClusterGVK:    serviceClusterGVK,
+		ManagementClusterCRD: managementClusterCRD,
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("cannot add %s controller: %w", "ManagementClusterObjReconciler", err)
 	}
@@ -284,27 +366,31 @@ func run(flags *flags, log logr.Logger) error {
 
 		ServiceClusterClient: serviceCachedClient,
 		ServiceClusterCache:  serviceCache,
-		ProviderNamespace:    flags.providerNamespace,
+		ProviderNamespace:    providerNamespace,
 
 		ManagementClusterGVK: managementClusterGVK,
 		ServiceClusterGVK:    serviceClusterGVK,
+		ManagementClusterCRD: managementClusterCRD,
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("cannot add %s controller: %w", "AdoptionReconciler", err)
 	}
 
-	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
-		return fmt.Errorf("adding readyz checker: %w", err)
-	}
+	if err := (&controllers.EventReconciler{
+		Log:              log.WithName("controllers").WithName("EventReconciler"),
+		Client:           mgr.GetClient(),
+		NamespacedClient: namespacedClient,
 
-	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
-		return fmt.Errorf("adding healthz checker: %w", err)
-	}
+		ServiceClusterClient: serviceCachedClient,
+		ServiceClusterCache:  serviceCache,
 
-	// Register webhooks as handlers
-	wbh := mgr.GetWebhookServer()
+		ManagementClusterGVK: managementClusterGVK,
+		ServiceClusterGVK:    serviceClusterGVK,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("cannot add %s controller: %w", "EventReconciler", err)
+	}
 
 	// mutating webhook
-	wbh.Register(flags.mutatingWebhookPath,
+	if err := registerWebhook(cfg.MutatingWebhookPath,
 		&webhook.Admission{Handler: &webhooks.ManagementClusterObjWebhookHandler{
 			Log:    log.WithName("mutating webhooks").WithName(managementClusterGVK.Kind),
 			Scheme: mgr.GetScheme(),
@@ -315,15 +401,82 @@ func run(flags *flags, log logr.Logger) error {
 			ManagementClusterGVK: managementClusterGVK,
 			ServiceClusterGVK:    serviceClusterGVK,
 
-			ProviderNamespace: flags.providerNamespace,
-			ServiceCluster:    flags.serviceClusterName,
+			ProviderNamespace: providerNamespace,
+			ServiceCluster:    serviceClusterName,
 
-			WebhookStrategy: corev1alpha1.WebhookStrategyType(flags.webhookStrategy),
-		}})
+			WebhookStrategy: cfg.WebhookStrategy,
+		}}); err != nil {
+		return fmt.Errorf("registering mutating webhook: %w", err)
+	}
+	return nil
+}
 
-	log.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
-		return fmt.Errorf("running manager: %w", err)
+// setupConsolidated configures the manager to serve the CRDs of all Catapult objects of the ServiceCluster.
+// Every CRD is served by its own instance, that is started and stopped as Catapult objects come and go.
+func setupConsolidated(mgr ctrl.Manager, log logr.Logger, managementCfg, serviceCfg *rest.Config, flags *flags) error {
+	namespacedCache, err := cache.New(managementCfg, cache.Options{
+		Scheme:    mgr.GetScheme(),
+		Mapper:    mgr.GetRESTMapper(),
+		Namespace: flags.providerNamespace,
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"creating namespaced scoped cache: %w", err)
+	}
+	if err = mgr.Add(namespacedCache); err != nil {
+		return fmt.Errorf("add namespaced cache to manager: %w", err)
+	}
+
+	// webhooks of all instances are served by the webhook server of this manager
+	dispatcher := utilwebhook.NewDispatcher()
+	mgr.GetWebhookServer().Register("/", dispatcher)
+
+	runner := consolidation.NewRunner(log.WithName("instances"), func() (ctrl.Manager, error) {
+		return ctrl.NewManager(managementCfg, ctrl.Options{
+			Scheme:             managementScheme,
+			MetricsBindAddress: "0",
+			NewClient:          newClient,
+		})
+	})
+	if err := mgr.Add(runner); err != nil {
+		return fmt.Errorf("add instance runner to manager: %w", err)
+	}
+
+	if err := (&controllers.ConsolidatedReconciler{
+		Log: log.WithName("controllers").WithName("ConsolidatedReconciler"),
+		Client: &client.DelegatingClient{
+			Reader:       namespacedCache,
+			Writer:       mgr.GetClient(),
+			StatusClient: mgr.GetClient(),
+		},
+		NamespacedCache: namespacedCache,
+		Runner:          runner,
+		ServiceCluster:  flags.serviceClusterName,
+
+		SetupInstance: func(instanceMgr ctrl.Manager, instanceLog logr.Logger, catapult *operatorv1alpha1.Catapult) error {
+			managementClusterGVK := schema.GroupVersionKind{
+				Kind:    catapult.Spec.ManagementClusterCRD.Kind,
+				Version: catapult.Spec.ManagementClusterCRD.Version,
+				Group:   catapult.Spec.ManagementClusterCRD.Group,
+			}
+			return setupInstance(instanceMgr, instanceLog, serviceCfg, flags.providerNamespace, flags.serviceClusterName, instanceConfig{
+				ManagementClusterGVK: managementClusterGVK,
+				ServiceClusterGVK: schema.GroupVersionKind{
+					Kind:    catapult.Spec.ServiceClusterCRD.Kind,
+					Version: catapult.Spec.ServiceClusterCRD.Version,
+					Group:   catapult.Spec.ServiceClusterCRD.Group,
+				},
+				MutatingWebhookPath: utilwebhook.GenerateMutateWebhookPathFromGVK(managementClusterGVK),
+				WebhookStrategy:     catapult.Spec.WebhookStrategy,
+			}, func(path string, hook *webhook.Admission) error {
+				if err := instanceMgr.SetFields(hook); err != nil {
+					return err
+				}
+				return instanceMgr.Add(dispatcher.Runnable(path, hook))
+			})
+		},
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("cannot add %s controller: %w", "ConsolidatedReconciler", err)
 	}
 	return nil
 }
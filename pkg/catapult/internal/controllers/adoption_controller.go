@@ -39,6 +39,7 @@ import (
 	"k8c.io/utils/pkg/util"
 
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/objectreferences"
 )
 
 type AdoptionReconciler struct {
@@ -50,6 +51,9 @@ type AdoptionReconciler struct {
 
 	// Dynamic types we work with
 	ManagementClusterGVK, ServiceClusterGVK schema.GroupVersionKind
+	// ManagementClusterCRD is the name of the CRD in the management cluster,
+	// CatalogEntries referencing this CRD configure how object references are translated.
+	ManagementClusterCRD string
 
 	ProviderNamespace string
 }
@@ -86,6 +90,13 @@ func (r *AdoptionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 	desiredManagementClusterObj.SetName(serviceClusterObj.GetName())
 	desiredManagementClusterObj.SetNamespace(sca.Spec.ManagementClusterNamespace.Name)
+	objectReferences, mapping, err := objectReferenceMapping(ctx, r.NamespacedClient, r.ProviderNamespace, r.ManagementClusterCRD, sca)
+	if err != nil {
+		return result, fmt.Errorf("building object reference mapping: %w", err)
+	}
+	if err := objectreferences.Translate(desiredManagementClusterObj, objectReferences, mapping.Reverse()); err != nil {
+		return result, fmt.Errorf("translating object references: %w", err)
+	}
 
 	// Reconcile
 	currentManagementClusterObj := r.newManagementObject()
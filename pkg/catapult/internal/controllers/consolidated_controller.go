@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/consolidation"
+)
+
+// SetupInstanceFunc adds the controllers and webhook for the CRD of the given Catapult to the Manager.
+type SetupInstanceFunc func(mgr ctrl.Manager, log logr.Logger, catapult *operatorv1alpha1.Catapult) error
+
+// ConsolidatedReconciler starts and stops the instances of a consolidated Catapult,
+// so every Catapult object of the ServiceCluster is served.
+type ConsolidatedReconciler struct {
+	// Client is only allowed to access the provider namespace.
+	Client client.Client
+	Log    logr.Logger
+	// NamespacedCache is used to watch Catapults in the provider namespace.
+	NamespacedCache cache.Cache
+
+	Runner         *consolidation.Runner
+	ServiceCluster string
+	SetupInstance  SetupInstanceFunc
+}
+
+// consolidatedInstanceConfig holds the settings of a Catapult, that require the instance to be restarted when changed.
+type consolidatedInstanceConfig struct {
+	ManagementClusterCRD, ServiceClusterCRD operatorv1alpha1.CRDReference
+	WebhookStrategy                         corev1alpha1.WebhookStrategyType
+}
+
+// +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=catapults,verbs=get;list;watch
+
+func (r *ConsolidatedReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("catapult", req.NamespacedName)
+
+	catapult := &operatorv1alpha1.Catapult{}
+	err := r.Client.Get(ctx, req.NamespacedName, catapult)
+	if errors.IsNotFound(err) {
+		r.Runner.Stop(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("getting Catapult: %w", err)
+	}
+	if !catapult.DeletionTimestamp.IsZero() ||
+		catapult.Spec.ServiceCluster.Name != r.ServiceCluster {
+		r.Runner.Stop(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	config := consolidatedInstanceConfig{
+		ManagementClusterCRD: catapult.Spec.ManagementClusterCRD,
+		ServiceClusterCRD:    catapult.Spec.ServiceClusterCRD,
+		WebhookStrategy:      catapult.Spec.WebhookStrategy,
+	}
+	if err := r.Runner.Ensure(req.NamespacedName, config, func(mgr ctrl.Manager) error {
+		return r.SetupInstance(mgr, log, catapult)
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("ensuring instance: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ConsolidatedReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	c, err := controller.New("consolidated", mgr, controller.Options{
+		Reconciler: r,
+	})
+	if err != nil {
+		return fmt.Errorf("creating controller: %w", err)
+	}
+
+	if err := c.Watch(
+		source.NewKindWithCache(&operatorv1alpha1.Catapult{}, r.NamespacedCache),
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return fmt.Errorf("watching Catapults: %w", err)
+	}
+
+	// restart instances, that stopped unexpectedly
+	return c.Watch(r.Runner.Source(), &handler.EnqueueRequestForObject{})
+}
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 )
 
@@ -50,4 +51,7 @@ func init() {
 	if err := corev1alpha1.AddToScheme(testScheme); err != nil {
 		panic(err)
 	}
+	if err := catalogv1alpha1.AddToScheme(testScheme); err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"k8c.io/utils/pkg/util"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+// eventSourceComponent is set as source of all Events mirrored into the management cluster.
+const eventSourceComponent = "catapult"
+
+// EventReconciler mirrors Events of service cluster objects onto the matching management cluster object,
+// and onto the objects owning it, so tenants can see what is happening with their instances.
+type EventReconciler struct {
+	client.Client
+	Log              logr.Logger
+	NamespacedClient client.Client
+
+	ServiceClusterClient client.Client
+	ServiceClusterCache  cache.Cache
+
+	// Dynamic types we work with
+	ManagementClusterGVK, ServiceClusterGVK schema.GroupVersionKind
+}
+
+func (r *EventReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	var (
+		result ctrl.Result
+		ctx    = context.Background()
+	)
+
+	serviceClusterEvent := &corev1.Event{}
+	if err := r.ServiceClusterClient.Get(ctx, req.NamespacedName, serviceClusterEvent); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+	if !r.isServiceObjectEvent(serviceClusterEvent) {
+		return result, nil
+	}
+
+	// Lookup SCA to see where the management cluster object lives.
+	sca, err := corev1alpha1.GetServiceClusterAssignmentByServiceClusterNamespace(
+		ctx, r.NamespacedClient, serviceClusterEvent.Namespace)
+	if err != nil {
+		// not a namespace managed by KubeCarrier
+		return result, nil
+	}
+
+	managementClusterObj := &unstructured.Unstructured{}
+	managementClusterObj.SetGroupVersionKind(r.ManagementClusterGVK)
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      serviceClusterEvent.InvolvedObject.Name,
+		Namespace: sca.Spec.ManagementClusterNamespace.Name,
+	}, managementClusterObj); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+
+	// Mirror the Event onto the management cluster object and every object owning it.
+	involvedObjects := []corev1.ObjectReference{
+		{
+			APIVersion:      r.ManagementClusterGVK.GroupVersion().String(),
+			Kind:            r.ManagementClusterGVK.Kind,
+			Name:            managementClusterObj.GetName(),
+			Namespace:       managementClusterObj.GetNamespace(),
+			UID:             managementClusterObj.GetUID(),
+			ResourceVersion: managementClusterObj.GetResourceVersion(),
+		},
+	}
+	for _, ownerRef := range managementClusterObj.GetOwnerReferences() {
+		involvedObjects = append(involvedObjects, corev1.ObjectReference{
+			APIVersion: ownerRef.APIVersion,
+			Kind:       ownerRef.Kind,
+			Name:       ownerRef.Name,
+			Namespace:  managementClusterObj.GetNamespace(),
+			UID:        ownerRef.UID,
+		})
+	}
+	for _, involvedObject := range involvedObjects {
+		if err := r.reconcileEvent(ctx, serviceClusterEvent, involvedObject); err != nil {
+			return result, fmt.Errorf("mirroring Event to %s: %w", involvedObject.Kind, err)
+		}
+	}
+	return result, nil
+}
+
+// reconcileEvent creates or patches the copy of the service cluster Event for the given involved object.
+func (r *EventReconciler) reconcileEvent(
+	ctx context.Context, serviceClusterEvent *corev1.Event, involvedObject corev1.ObjectReference,
+) error {
+	desiredEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceClusterEvent.Name + "." + strings.ToLower(involvedObject.Kind),
+			Namespace: involvedObject.Namespace,
+		},
+		InvolvedObject: involvedObject,
+		Reason:         serviceClusterEvent.Reason,
+		Message:        serviceClusterEvent.Message,
+		Type:           serviceClusterEvent.Type,
+		Count:          serviceClusterEvent.Count,
+		FirstTimestamp: serviceClusterEvent.FirstTimestamp,
+		LastTimestamp:  serviceClusterEvent.LastTimestamp,
+		Source: corev1.EventSource{
+			Component: eventSourceComponent,
+		},
+	}
+
+	err := r.Create(ctx, desiredEvent)
+	if err == nil {
+		return nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating Event: %w", err)
+	}
+
+	// The Event was mirrored before, so we just carry over the fields changing when it recurs.
+	patch, err := json.Marshal(map[string]interface{}{
+		"message":       desiredEvent.Message,
+		"count":         desiredEvent.Count,
+		"lastTimestamp": desiredEvent.LastTimestamp,
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling Event patch: %w", err)
+	}
+	if err = r.Patch(ctx, desiredEvent, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("patching Event: %w", err)
+	}
+	return nil
+}
+
+func (r *EventReconciler) isServiceObjectEvent(event *corev1.Event) bool {
+	return event.InvolvedObject.APIVersion == r.ServiceClusterGVK.GroupVersion().String() &&
+		event.InvolvedObject.Kind == r.ServiceClusterGVK.Kind
+}
+
+func (r *EventReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	c, err := controller.New(
+		strings.ToLower(r.ServiceClusterGVK.Kind)+"-events",
+		mgr, controller.Options{
+			Reconciler: r,
+		})
+	if err != nil {
+		return fmt.Errorf("creating controller: %w", err)
+	}
+
+	return c.Watch(
+		source.NewKindWithCache(&corev1.Event{}, r.ServiceClusterCache),
+		&handler.EnqueueRequestForObject{},
+		util.PredicateFn(func(obj runtime.Object) bool {
+			// we are only interested in Events of our service cluster objects
+			event, ok := obj.(*corev1.Event)
+			if !ok {
+				return false
+			}
+			return r.isServiceObjectEvent(event)
+		}))
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"k8c.io/utils/pkg/testutil"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+func TestEventReconciler(t *testing.T) {
+	sca := &corev1alpha1.ServiceClusterAssignment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "another-namespace.eu-west-1",
+			Namespace: providerNamespace,
+		},
+		Spec: corev1alpha1.ServiceClusterAssignmentSpec{
+			ServiceCluster: corev1alpha1.ObjectReference{
+				Name: "eu-west-1",
+			},
+			ManagementClusterNamespace: corev1alpha1.ObjectReference{
+				Name: "another-namespace",
+			},
+		},
+		Status: corev1alpha1.ServiceClusterAssignmentStatus{
+			ServiceClusterNamespace: &corev1alpha1.ObjectReference{
+				Name: "sc-test-123",
+			},
+		},
+	}
+
+	managementClusterObj := &unstructured.Unstructured{}
+	managementClusterObj.SetGroupVersionKind(managementClusterGVK)
+	managementClusterObj.SetName("test-1")
+	managementClusterObj.SetNamespace("another-namespace")
+	managementClusterObj.SetUID("management-uid")
+	managementClusterObj.SetOwnerReferences([]metav1.OwnerReference{
+		{
+			APIVersion: "eu-west-1.tenant/v1alpha1",
+			Kind:       "CouchDB",
+			Name:       "test-1",
+			UID:        "tenant-uid",
+		},
+	})
+
+	serviceClusterEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-1.16a7b1e5b1a1d2c3",
+			Namespace: "sc-test-123",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: serviceClusterGVK.GroupVersion().String(),
+			Kind:       serviceClusterGVK.Kind,
+			Name:       "test-1",
+			Namespace:  "sc-test-123",
+		},
+		Reason:  "Provisioning",
+		Message: "waiting for volume",
+		Type:    corev1.EventTypeWarning,
+		Count:   1,
+	}
+
+	log := testutil.NewLogger(t)
+	managementClient := fakeclient.NewFakeClientWithScheme(testScheme, sca, managementClusterObj)
+	serviceClient := fakeclient.NewFakeClientWithScheme(testScheme, serviceClusterEvent)
+	r := EventReconciler{
+		Client:               managementClient,
+		NamespacedClient:     managementClient,
+		Log:                  log,
+		ServiceClusterClient: serviceClient,
+
+		ServiceClusterGVK:    serviceClusterGVK,
+		ManagementClusterGVK: managementClusterGVK,
+	}
+	ctx := context.Background()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{
+		Name:      serviceClusterEvent.Name,
+		Namespace: serviceClusterEvent.Namespace,
+	}}
+
+	_, err := r.Reconcile(req)
+	require.NoError(t, err)
+
+	managementEvent := &corev1.Event{}
+	require.NoError(t, managementClient.Get(ctx, types.NamespacedName{
+		Name:      serviceClusterEvent.Name + ".couchdbinternal",
+		Namespace: "another-namespace",
+	}, managementEvent))
+	assert.Equal(t, types.UID("management-uid"), managementEvent.InvolvedObject.UID)
+	assert.Equal(t, "Provisioning", managementEvent.Reason)
+	assert.Equal(t, corev1.EventTypeWarning, managementEvent.Type)
+	assert.Equal(t, eventSourceComponent, managementEvent.Source.Component)
+
+	tenantEvent := &corev1.Event{}
+	require.NoError(t, managementClient.Get(ctx, types.NamespacedName{
+		Name:      serviceClusterEvent.Name + ".couchdb",
+		Namespace: "another-namespace",
+	}, tenantEvent))
+	assert.Equal(t, "CouchDB", tenantEvent.InvolvedObject.Kind)
+	assert.Equal(t, types.UID("tenant-uid"), tenantEvent.InvolvedObject.UID)
+	assert.Equal(t, "waiting for volume", tenantEvent.Message)
+
+	// the Event recurs in the service cluster
+	serviceClusterEvent.Count = 2
+	serviceClusterEvent.Message = "still waiting for volume"
+	require.NoError(t, serviceClient.Update(ctx, serviceClusterEvent))
+
+	_, err = r.Reconcile(req)
+	require.NoError(t, err)
+
+	require.NoError(t, managementClient.Get(ctx, types.NamespacedName{
+		Name:      serviceClusterEvent.Name + ".couchdb",
+		Namespace: "another-namespace",
+	}, tenantEvent))
+	assert.Equal(t, int32(2), tenantEvent.Count)
+	assert.Equal(t, "still waiting for volume", tenantEvent.Message)
+}
@@ -19,9 +19,12 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,15 +32,26 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"k8c.io/utils/pkg/owner"
 	"k8c.io/utils/pkg/util"
 
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/objectreferences"
+	"k8c.io/kubecarrier/pkg/internal/util/referencedobjects"
 )
 
-const catapultControllerFinalizer string = "catapult.kubecarrier.io/controller"
+const (
+	catapultControllerFinalizer string = "catapult.kubecarrier.io/controller"
+	// referencedObjectOwnerLabel is set on Secrets and ConfigMaps propagated from the ServiceCluster,
+	// its value is the UID of the management cluster object referencing them.
+	referencedObjectOwnerLabel = "catapult.kubecarrier.io/referenced-by"
+)
 
 // ManagementClusterObjReconciler reconciles CRD instances in the management cluster,
 // by creating a matching instance in the service cluster and syncing it's status back.
@@ -53,10 +67,15 @@ type ManagementClusterObjReconciler struct {
 
 	// Dynamic types we work with
 	ManagementClusterGVK, ServiceClusterGVK schema.GroupVersionKind
+	// ManagementClusterCRD is the name of the CRD in the management cluster,
+	// CatalogEntries referencing this CRD configure which Secrets and ConfigMaps are propagated.
+	ManagementClusterCRD string
 }
 
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusterassignments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusterassignments/status,verbs=get
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubecarrier.io,resources=customresourcediscoveries,verbs=get;list;watch
 
 func (r *ManagementClusterObjReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	var (
@@ -108,6 +127,13 @@ func (r *ManagementClusterObjReconciler) Reconcile(req ctrl.Request) (ctrl.Resul
 	desiredServiceClusterObj.SetGroupVersionKind(r.ServiceClusterGVK)
 	desiredServiceClusterObj.SetName(managementClusterObj.GetName())
 	desiredServiceClusterObj.SetNamespace(sca.Status.ServiceClusterNamespace.Name)
+	objectReferences, mapping, err := objectReferenceMapping(ctx, r.NamespacedClient, r.ProviderNamespace, r.ManagementClusterCRD, sca)
+	if err != nil {
+		return result, fmt.Errorf("building object reference mapping: %w", err)
+	}
+	if err := objectreferences.Translate(desiredServiceClusterObj, objectReferences, mapping); err != nil {
+		return result, fmt.Errorf("translating object references: %w", err)
+	}
 	if _, err := owner.SetOwnerReference(
 		managementClusterObj, desiredServiceClusterObj, r.Scheme); err != nil {
 		return result, fmt.Errorf("setting owner reference: %w", err)
@@ -115,7 +141,7 @@ func (r *ManagementClusterObjReconciler) Reconcile(req ctrl.Request) (ctrl.Resul
 
 	// Reconcile
 	currentServiceClusterObj := r.newServiceObject()
-	err := r.ServiceClusterClient.Get(ctx, types.NamespacedName{
+	err = r.ServiceClusterClient.Get(ctx, types.NamespacedName{
 		Name:      desiredServiceClusterObj.GetName(),
 		Namespace: desiredServiceClusterObj.GetNamespace(),
 	}, currentServiceClusterObj)
@@ -180,19 +206,250 @@ func (r *ManagementClusterObjReconciler) Reconcile(req ctrl.Request) (ctrl.Resul
 			"updating %s status: %w", r.ManagementClusterGVK.Kind, err)
 	}
 
+	// Propagate referenced Secrets and ConfigMaps from service cluster to management cluster
+	if err = r.reconcileReferencedObjects(
+		ctx, managementClusterObj, currentServiceClusterObj); err != nil {
+		return result, fmt.Errorf("reconciling referenced objects: %w", err)
+	}
+
 	return result, nil
 }
 
+// reconcileReferencedObjects copies the Secrets and ConfigMaps referenced by the service cluster object
+// into the namespace of the management cluster object and removes copies that are no longer referenced.
+func (r *ManagementClusterObjReconciler) reconcileReferencedObjects(
+	ctx context.Context, managementClusterObj, serviceClusterObj *unstructured.Unstructured,
+) error {
+	catalogEntryList := &catalogv1alpha1.CatalogEntryList{}
+	if err := r.NamespacedClient.List(ctx, catalogEntryList, client.InNamespace(r.ProviderNamespace)); err != nil {
+		return fmt.Errorf("listing CatalogEntries: %w", err)
+	}
+	var config []catalogv1alpha1.ReferencedObject
+	for _, catalogEntry := range catalogEntryList.Items {
+		if catalogEntry.Spec.BaseCRD.Name == r.ManagementClusterCRD {
+			config = append(config, catalogEntry.Spec.ReferencedObjects...)
+		}
+	}
+	refs, err := referencedobjects.Resolve(serviceClusterObj, config)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[referencedobjects.Reference]struct{}{}
+	for _, ref := range refs {
+		wanted[ref] = struct{}{}
+		if err := r.reconcileReferencedObject(ctx, managementClusterObj, serviceClusterObj, ref); err != nil {
+			return fmt.Errorf("%s %s: %w", ref.Kind, ref.Name, err)
+		}
+	}
+
+	// Cleanup objects that are no longer referenced
+	listOpts := []client.ListOption{
+		client.InNamespace(managementClusterObj.GetNamespace()),
+		client.MatchingLabels{referencedObjectOwnerLabel: string(managementClusterObj.GetUID())},
+	}
+	secretList := &corev1.SecretList{}
+	if err := r.List(ctx, secretList, listOpts...); err != nil {
+		return fmt.Errorf("listing Secrets: %w", err)
+	}
+	configMapList := &corev1.ConfigMapList{}
+	if err := r.List(ctx, configMapList, listOpts...); err != nil {
+		return fmt.Errorf("listing ConfigMaps: %w", err)
+	}
+	var existing []referencedobjects.Object
+	for i := range secretList.Items {
+		existing = append(existing, &secretList.Items[i])
+	}
+	for i := range configMapList.Items {
+		existing = append(existing, &configMapList.Items[i])
+	}
+	for _, obj := range existing {
+		ref := referencedobjects.Reference{Name: obj.GetName(), Kind: catalogv1alpha1.ReferencedSecret}
+		if _, ok := obj.(*corev1.ConfigMap); ok {
+			ref.Kind = catalogv1alpha1.ReferencedConfigMap
+		}
+		if _, ok := wanted[ref]; ok || !metav1.IsControlledBy(obj, managementClusterObj) {
+			continue
+		}
+		if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("deleting %s %s: %w", ref.Kind, ref.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *ManagementClusterObjReconciler) reconcileReferencedObject(
+	ctx context.Context, managementClusterObj, serviceClusterObj *unstructured.Unstructured,
+	ref referencedobjects.Reference,
+) error {
+	serviceClusterRefObj, err := ref.NewObject()
+	if err != nil {
+		return err
+	}
+	err = r.ServiceClusterClient.Get(ctx, types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: serviceClusterObj.GetNamespace(),
+	}, serviceClusterRefObj)
+	if errors.IsNotFound(err) {
+		// the object might be created later
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting from service cluster: %w", err)
+	}
+
+	managementClusterRefObj, _ := ref.NewObject()
+	err = r.Get(ctx, types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: managementClusterObj.GetNamespace(),
+	}, managementClusterRefObj)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("getting from management cluster: %w", err)
+	}
+
+	if errors.IsNotFound(err) {
+		managementClusterRefObj.SetName(ref.Name)
+		managementClusterRefObj.SetNamespace(managementClusterObj.GetNamespace())
+		managementClusterRefObj.SetLabels(map[string]string{
+			referencedObjectOwnerLabel: string(managementClusterObj.GetUID()),
+		})
+		if err := controllerutil.SetControllerReference(
+			managementClusterObj, managementClusterRefObj, r.Scheme); err != nil {
+			return fmt.Errorf("setting controller reference: %w", err)
+		}
+		if err := referencedobjects.CopyData(serviceClusterRefObj, managementClusterRefObj); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, managementClusterRefObj); err != nil {
+			return fmt.Errorf("creating in management cluster: %w", err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(managementClusterRefObj, managementClusterObj) {
+		// never overwrite objects that have been created by someone else
+		return fmt.Errorf("already exists in namespace %s and is not owned by %s %s",
+			managementClusterObj.GetNamespace(), r.ManagementClusterGVK.Kind, managementClusterObj.GetName())
+	}
+	currentRefObj := managementClusterRefObj.DeepCopyObject()
+	if err := referencedobjects.CopyData(serviceClusterRefObj, managementClusterRefObj); err != nil {
+		return err
+	}
+	if reflect.DeepEqual(currentRefObj, managementClusterRefObj) {
+		return nil
+	}
+	if err := r.Update(ctx, managementClusterRefObj); err != nil {
+		return fmt.Errorf("updating in management cluster: %w", err)
+	}
+	return nil
+}
+
 func (r *ManagementClusterObjReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// referenced objects in the service cluster are not owned by us,
+	// so we have to requeue all objects in the namespace when they change.
+	enqueueForReferencedObject := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) (requests []reconcile.Request) {
+			ctx := context.Background()
+			sca, err := corev1alpha1.GetServiceClusterAssignmentByServiceClusterNamespace(
+				ctx, r.NamespacedClient, mapObject.Meta.GetNamespace())
+			if err != nil {
+				// not a namespace managed by KubeCarrier
+				return nil
+			}
+
+			managementClusterObjList := &unstructured.UnstructuredList{}
+			managementClusterObjList.SetGroupVersionKind(r.ManagementClusterGVK)
+			if err := r.List(ctx, managementClusterObjList,
+				client.InNamespace(sca.Spec.ManagementClusterNamespace.Name)); err != nil {
+				r.Log.Error(err, "listing "+r.ManagementClusterGVK.Kind)
+				return nil
+			}
+			for _, managementClusterObj := range managementClusterObjList.Items {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+					Name:      managementClusterObj.GetName(),
+					Namespace: managementClusterObj.GetNamespace(),
+				}})
+			}
+			return
+		}),
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(r.newManagementObject()).
+		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
 		Watches(
 			source.NewKindWithCache(r.newServiceObject(), r.ServiceClusterCache),
 			owner.EnqueueRequestForOwner(r.newManagementObject(), mgr.GetScheme()),
 		).
+		Watches(
+			source.NewKindWithCache(&corev1.Secret{}, r.ServiceClusterCache),
+			enqueueForReferencedObject,
+		).
+		Watches(
+			source.NewKindWithCache(&corev1.ConfigMap{}, r.ServiceClusterCache),
+			enqueueForReferencedObject,
+		).
 		Complete(r)
 }
 
+// objectReferenceMapping returns the object references of instances of the given management cluster CRD,
+// and the Mapping of the API groups and namespace of the management cluster to the service cluster.
+func objectReferenceMapping(
+	ctx context.Context, c client.Client, providerNamespace, managementClusterCRD string,
+	sca *corev1alpha1.ServiceClusterAssignment,
+) ([]catalogv1alpha1.ObjectReferenceField, objectreferences.Mapping, error) {
+	mapping := objectreferences.Mapping{
+		APIGroups:     map[string]objectreferences.APIGroupMapping{},
+		FromNamespace: sca.Spec.ManagementClusterNamespace.Name,
+		ToNamespace:   sca.Status.ServiceClusterNamespace.Name,
+	}
+
+	catalogEntryList := &catalogv1alpha1.CatalogEntryList{}
+	if err := c.List(ctx, catalogEntryList, client.InNamespace(providerNamespace)); err != nil {
+		return nil, mapping, fmt.Errorf("listing CatalogEntries: %w", err)
+	}
+	var fields []catalogv1alpha1.ObjectReferenceField
+	catalogEntries := map[string]catalogv1alpha1.CatalogEntry{}
+	for _, catalogEntry := range catalogEntryList.Items {
+		catalogEntries[catalogEntry.Name] = catalogEntry
+		if catalogEntry.Spec.BaseCRD.Name == managementClusterCRD {
+			fields = append(fields, catalogEntry.Spec.ObjectReferences...)
+		}
+	}
+	if len(fields) == 0 {
+		return nil, mapping, nil
+	}
+
+	crDiscoveryList := &corev1alpha1.CustomResourceDiscoveryList{}
+	if err := c.List(ctx, crDiscoveryList, client.InNamespace(providerNamespace)); err != nil {
+		return nil, mapping, fmt.Errorf("listing CustomResourceDiscoveries: %w", err)
+	}
+	serviceClusterGroups := map[string]string{}
+	for _, crDiscovery := range crDiscoveryList.Items {
+		if crDiscovery.Status.ManagementClusterCRD != nil && crDiscovery.Status.CRD != nil {
+			serviceClusterGroups[crDiscovery.Status.ManagementClusterCRD.Name] = crDiscovery.Status.CRD.Spec.Group
+		}
+	}
+
+	for _, field := range fields {
+		name := field.CatalogEntry.Name
+		catalogEntry, ok := catalogEntries[name]
+		if !ok || catalogEntry.Status.ProviderCRD == nil {
+			return nil, mapping, fmt.Errorf("CatalogEntry %s is not ready", name)
+		}
+		serviceClusterGroup, ok := serviceClusterGroups[catalogEntry.Spec.BaseCRD.Name]
+		if !ok {
+			return nil, mapping, fmt.Errorf("missing CustomResourceDiscovery for CRD %s", catalogEntry.Spec.BaseCRD.Name)
+		}
+		mapping.APIGroups[name] = objectreferences.APIGroupMapping{
+			From: catalogEntry.Status.ProviderCRD.APIGroup,
+			To:   serviceClusterGroup,
+		}
+	}
+	return fields, mapping, nil
+}
+
 func (r *ManagementClusterObjReconciler) newServiceObject() *unstructured.Unstructured {
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(r.ServiceClusterGVK)
@@ -22,6 +22,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
@@ -32,6 +35,7 @@ import (
 
 	"k8c.io/utils/pkg/testutil"
 
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 )
 
@@ -253,4 +257,229 @@ func TestManagementClusterObjReconciler(t *testing.T) {
 			},
 		}, serviceClusterObj.Object)
 	})
+
+	t.Run("propagates referenced objects", func(t *testing.T) {
+		managementClusterObj := managementClusterObj.DeepCopy()
+		managementClusterObj.SetUID("b1b2c3")
+
+		serviceClusterObj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      "test-1",
+					"namespace": "sc-test-123",
+				},
+				"spec": map[string]interface{}{
+					"test1": "spec2000",
+				},
+				"status": map[string]interface{}{
+					"connection": map[string]interface{}{
+						"secretName": "test-1-credentials",
+					},
+				},
+			},
+		}
+		serviceClusterObj.SetGroupVersionKind(serviceClusterGVK)
+		serviceClusterSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-1-credentials",
+				Namespace: "sc-test-123",
+			},
+			Data: map[string][]byte{"password": []byte("hunter2")},
+		}
+		serviceClusterConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "couchdb-config",
+				Namespace: "sc-test-123",
+			},
+			Data: map[string]string{"port": "5984"},
+		}
+
+		catalogEntry := &catalogv1alpha1.CatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "couchdbs.eu-west-1",
+				Namespace: providerNamespace,
+			},
+			Spec: catalogv1alpha1.CatalogEntrySpec{
+				BaseCRD: catalogv1alpha1.ObjectReference{Name: "couchdbinternals.eu-west-1.provider"},
+				ReferencedObjects: []catalogv1alpha1.ReferencedObject{
+					{Kind: catalogv1alpha1.ReferencedSecret, JSONPath: ".status.connection.secretName"},
+					{Kind: catalogv1alpha1.ReferencedConfigMap, Name: "couchdb-config"},
+				},
+			},
+		}
+		// left over from a previous reference
+		controller := true
+		staleSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-1-old-credentials",
+				Namespace: "another-namespace",
+				Labels: map[string]string{
+					referencedObjectOwnerLabel: "b1b2c3",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "eu-west-1.provider/v1alpha1",
+						Kind:       "CouchDBInternal",
+						Name:       "test-1",
+						UID:        "b1b2c3",
+						Controller: &controller,
+					},
+				},
+			},
+		}
+
+		log := testutil.NewLogger(t)
+		managementClient := fakeclient.NewFakeClientWithScheme(
+			testScheme, managementClusterObj, sca, catalogEntry, staleSecret)
+		serviceClient := fakeclient.NewFakeClientWithScheme(
+			testScheme, serviceClusterObj, serviceClusterSecret, serviceClusterConfigMap)
+
+		r := ManagementClusterObjReconciler{
+			Client:               managementClient,
+			Log:                  log,
+			Scheme:               testScheme,
+			ServiceClusterClient: serviceClient,
+			NamespacedClient:     managementClient,
+
+			ManagementClusterGVK: managementClusterGVK,
+			ServiceClusterGVK:    serviceClusterGVK,
+			ManagementClusterCRD: "couchdbinternals.eu-west-1.provider",
+
+			ServiceCluster:    "eu-west-1",
+			ProviderNamespace: providerNamespace,
+		}
+
+		_, err := r.Reconcile(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      managementClusterObj.GetName(),
+				Namespace: managementClusterObj.GetNamespace(),
+			},
+		})
+		require.NoError(t, err)
+
+		secret := &corev1.Secret{}
+		require.NoError(t, managementClient.Get(ctx, types.NamespacedName{
+			Name:      "test-1-credentials",
+			Namespace: "another-namespace",
+		}, secret))
+		assert.Equal(t, serviceClusterSecret.Data, secret.Data)
+		assert.Equal(t, "b1b2c3", secret.Labels[referencedObjectOwnerLabel])
+		assert.True(t, metav1.IsControlledBy(secret, managementClusterObj), "Secret should be controlled by the management cluster object")
+
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, managementClient.Get(ctx, types.NamespacedName{
+			Name:      "couchdb-config",
+			Namespace: "another-namespace",
+		}, configMap))
+		assert.Equal(t, serviceClusterConfigMap.Data, configMap.Data)
+
+		err = managementClient.Get(ctx, types.NamespacedName{
+			Name:      staleSecret.Name,
+			Namespace: staleSecret.Namespace,
+		}, &corev1.Secret{})
+		assert.True(t, errors.IsNotFound(err), "stale Secret should be deleted")
+	})
+	t.Run("translates object references", func(t *testing.T) {
+		// a CouchDBBackup referencing the CouchDB it belongs to
+		backupManagementGVK := managementClusterGVK.GroupVersion().WithKind("CouchDBBackupInternal")
+		backupServiceGVK := serviceClusterGVK.GroupVersion().WithKind("CouchDBBackup")
+		backupObj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      "backup-1",
+					"namespace": "another-namespace",
+				},
+				"spec": map[string]interface{}{
+					"db": map[string]interface{}{
+						"name":       "test-1",
+						"namespace":  "another-namespace",
+						"apiVersion": "eu-west-1.provider/v1alpha1",
+					},
+				},
+			},
+		}
+		backupObj.SetGroupVersionKind(backupManagementGVK)
+
+		dbCatalogEntry := &catalogv1alpha1.CatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "couchdbs.eu-west-1",
+				Namespace: providerNamespace,
+			},
+			Spec: catalogv1alpha1.CatalogEntrySpec{
+				BaseCRD: catalogv1alpha1.ObjectReference{Name: "couchdbinternals.eu-west-1.provider"},
+			},
+			Status: catalogv1alpha1.CatalogEntryStatus{
+				ProviderCRD: &catalogv1alpha1.CRDInformation{APIGroup: "eu-west-1.provider"},
+			},
+		}
+		backupCatalogEntry := &catalogv1alpha1.CatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "couchdbbackups.eu-west-1",
+				Namespace: providerNamespace,
+			},
+			Spec: catalogv1alpha1.CatalogEntrySpec{
+				BaseCRD: catalogv1alpha1.ObjectReference{Name: "couchdbbackupinternals.eu-west-1.provider"},
+				ObjectReferences: []catalogv1alpha1.ObjectReferenceField{
+					{
+						CatalogEntry:      catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"},
+						JSONPath:          ".spec.db.name",
+						NamespaceJSONPath: ".spec.db.namespace",
+						APIGroupJSONPath:  ".spec.db.apiVersion",
+					},
+				},
+			},
+		}
+		crDiscovery := &corev1alpha1.CustomResourceDiscovery{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "couchdbs.eu-west-1",
+				Namespace: providerNamespace,
+			},
+			Status: corev1alpha1.CustomResourceDiscoveryStatus{
+				CRD: &apiextensionsv1.CustomResourceDefinition{
+					Spec: apiextensionsv1.CustomResourceDefinitionSpec{Group: "couchdb.io"},
+				},
+				ManagementClusterCRD: &corev1alpha1.ObjectReference{Name: "couchdbinternals.eu-west-1.provider"},
+			},
+		}
+
+		log := testutil.NewLogger(t)
+		managementClient := fakeclient.NewFakeClientWithScheme(
+			testScheme, backupObj, sca, dbCatalogEntry, backupCatalogEntry, crDiscovery)
+		serviceClient := fakeclient.NewFakeClientWithScheme(testScheme)
+
+		r := ManagementClusterObjReconciler{
+			Client:               managementClient,
+			Log:                  log,
+			Scheme:               testScheme,
+			ServiceClusterClient: serviceClient,
+			NamespacedClient:     managementClient,
+
+			ManagementClusterGVK: backupManagementGVK,
+			ServiceClusterGVK:    backupServiceGVK,
+			ManagementClusterCRD: "couchdbbackupinternals.eu-west-1.provider",
+
+			ServiceCluster:    "eu-west-1",
+			ProviderNamespace: providerNamespace,
+		}
+
+		_, err := r.Reconcile(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      backupObj.GetName(),
+				Namespace: backupObj.GetNamespace(),
+			},
+		})
+		require.NoError(t, err)
+
+		serviceClusterObj := &unstructured.Unstructured{}
+		serviceClusterObj.SetGroupVersionKind(backupServiceGVK)
+		require.NoError(t, serviceClient.Get(ctx, types.NamespacedName{
+			Name:      "backup-1",
+			Namespace: "sc-test-123",
+		}, serviceClusterObj))
+		assert.Equal(t, map[string]interface{}{
+			"name":       "test-1",
+			"namespace":  "sc-test-123",
+			"apiVersion": "couchdb.io/v1alpha1",
+		}, serviceClusterObj.Object["spec"].(map[string]interface{})["db"])
+	})
 }
@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
+	adminv1beta1 "k8s.io/api/admission/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -58,6 +60,8 @@ type ManagementClusterObjWebhookHandler struct {
 
 var _ admission.Handler = (*ManagementClusterObjWebhookHandler)(nil)
 
+// +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusters,verbs=get;list;watch
+
 // Handle is the function to handle validating requests of ManagementClusterObjs.
 func (r *ManagementClusterObjWebhookHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
 	if r.WebhookStrategy != corev1alpha1.WebhookStrategyTypeNone &&
@@ -82,6 +86,25 @@ func (r *ManagementClusterObjWebhookHandler) Handle(ctx context.Context, req adm
 			fmt.Errorf("the GVK (group, version and kind) is wrong with the requestd object, expected: %s, got: %s", r.ManagementClusterGVK, objGVK))
 	}
 
+	// ServiceClusters in maintenance don't accept new instances, existing instances are still synced.
+	if req.Operation == adminv1beta1.Create {
+		serviceCluster := &corev1alpha1.ServiceCluster{}
+		if err := r.ManagementClusterClient.Get(ctx, types.NamespacedName{
+			Name:      r.ServiceCluster,
+			Namespace: r.ProviderNamespace,
+		}, serviceCluster); err != nil {
+			return admission.Errored(http.StatusInternalServerError,
+				fmt.Errorf("getting the ServiceCluster: %w", err))
+		}
+		if maintenance := serviceCluster.Spec.Maintenance; maintenance.InEffect(time.Now()) {
+			msg := fmt.Sprintf("the ServiceCluster %s is in maintenance and doesn't accept new instances", serviceCluster.Name)
+			if maintenance.Reason != "" {
+				msg += ": " + maintenance.Reason
+			}
+			return admission.Denied(msg)
+		}
+	}
+
 	// Fetch the ServiceClusterAssignment obj to get the namespace to perform dry-run in the service cluster.
 	serviceClusterAssignment := &corev1alpha1.ServiceClusterAssignment{}
 	if err := r.ManagementClusterClient.Get(ctx, types.NamespacedName{
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cordon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+var (
+	scheme = runtime.NewScheme()
+)
+
+func init() {
+	utilruntime.Must(corev1alpha1.AddToScheme(scheme))
+}
+
+type cordonFlagpole struct {
+	Reason string
+	Start  string
+	End    string
+}
+
+// NewCordonCommand returns the cordon subcommand for KubeCarrier CLI.
+func NewCordonCommand(log logr.Logger) *cobra.Command {
+	flags := genericclioptions.NewConfigFlags(false)
+	var flagpole cordonFlagpole
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "cordon SERVICE_CLUSTER [--reason REASON] [--start TIME] [--end TIME]",
+		Short: "puts a ServiceCluster into maintenance",
+		Long: `puts a ServiceCluster into maintenance,
+no new instances are created in the ServiceCluster, while existing instances are still kept in sync.
+The maintenance window is optional, times are given in RFC3339 format, e.g. 2021-03-01T12:00:00Z.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			maintenance := &corev1alpha1.ServiceClusterMaintenance{
+				Reason: flagpole.Reason,
+			}
+			var err error
+			if maintenance.Start, err = parseTime(flagpole.Start); err != nil {
+				return fmt.Errorf("parsing --start: %w", err)
+			}
+			if maintenance.End, err = parseTime(flagpole.End); err != nil {
+				return fmt.Errorf("parsing --end: %w", err)
+			}
+			if maintenance.Start != nil && maintenance.End != nil &&
+				!maintenance.Start.Before(maintenance.End) {
+				return fmt.Errorf("--start must be before --end")
+			}
+
+			cl, key, err := newClient(flags, args[0])
+			if err != nil {
+				return err
+			}
+			if err := setMaintenance(context.Background(), cl, key, maintenance); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "servicecluster/%s cordoned\n", key.Name)
+			return nil
+		},
+	}
+	flags.AddFlags(cmd.Flags())
+	cmd.Flags().StringVar(&flagpole.Reason, "reason", "", "reason for the maintenance, shown to tenants")
+	cmd.Flags().StringVar(&flagpole.Start, "start", "", "start of the maintenance window, the maintenance is in effect immediately if not set")
+	cmd.Flags().StringVar(&flagpole.End, "end", "", "end of the maintenance window, the maintenance is in effect until uncordon if not set")
+	return cmd
+}
+
+// NewUncordonCommand returns the uncordon subcommand for KubeCarrier CLI.
+func NewUncordonCommand(log logr.Logger) *cobra.Command {
+	flags := genericclioptions.NewConfigFlags(false)
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "uncordon SERVICE_CLUSTER",
+		Short: "ends the maintenance of a ServiceCluster",
+		Long: `ends the maintenance of a ServiceCluster,
+so new instances are created in the ServiceCluster again.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, key, err := newClient(flags, args[0])
+			if err != nil {
+				return err
+			}
+			if err := setMaintenance(context.Background(), cl, key, nil); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "servicecluster/%s uncordoned\n", key.Name)
+			return nil
+		},
+	}
+	flags.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func newClient(flags *genericclioptions.ConfigFlags, name string) (client.Client, types.NamespacedName, error) {
+	cfg, err := flags.ToRESTConfig()
+	if err != nil {
+		return nil, types.NamespacedName{}, err
+	}
+	namespace, _, err := flags.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return nil, types.NamespacedName{}, err
+	}
+	cl, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, types.NamespacedName{}, err
+	}
+	return cl, types.NamespacedName{Name: name, Namespace: namespace}, nil
+}
+
+// setMaintenance replaces the maintenance of the ServiceCluster, nil ends the maintenance.
+func setMaintenance(
+	ctx context.Context, cl client.Client, key types.NamespacedName,
+	maintenance *corev1alpha1.ServiceClusterMaintenance,
+) error {
+	// the error is not wrapped inside, as RetryOnConflict needs to detect conflicts
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		serviceCluster := &corev1alpha1.ServiceCluster{}
+		if err := cl.Get(ctx, key, serviceCluster); err != nil {
+			return err
+		}
+		serviceCluster.Spec.Maintenance = maintenance
+		return cl.Update(ctx, serviceCluster)
+	}); err != nil {
+		return fmt.Errorf("updating ServiceCluster: %w", err)
+	}
+	return nil
+}
+
+func parseTime(value string) (*metav1.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &metav1.Time{Time: t}, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cordon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+func TestSetMaintenance(t *testing.T) {
+	serviceCluster := &corev1alpha1.ServiceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "eu-west-1",
+			Namespace: "provider",
+		},
+	}
+	key := types.NamespacedName{Name: serviceCluster.Name, Namespace: serviceCluster.Namespace}
+	cl := fakeclient.NewFakeClientWithScheme(scheme, serviceCluster)
+	ctx := context.Background()
+
+	end, err := parseTime("2021-03-01T12:00:00Z")
+	require.NoError(t, err)
+	maintenance := &corev1alpha1.ServiceClusterMaintenance{
+		Reason: "Kubernetes upgrade",
+		End:    end,
+	}
+
+	t.Run("cordon", func(t *testing.T) {
+		require.NoError(t, setMaintenance(ctx, cl, key, maintenance))
+
+		serviceClusterFound := &corev1alpha1.ServiceCluster{}
+		require.NoError(t, cl.Get(ctx, key, serviceClusterFound))
+		if assert.NotNil(t, serviceClusterFound.Spec.Maintenance) {
+			assert.Equal(t, "Kubernetes upgrade", serviceClusterFound.Spec.Maintenance.Reason)
+			assert.True(t, end.Equal(serviceClusterFound.Spec.Maintenance.End))
+			assert.True(t, serviceClusterFound.Spec.Maintenance.InEffect(end.Add(-time.Minute)))
+			assert.False(t, serviceClusterFound.Spec.Maintenance.InEffect(end.Time))
+		}
+	})
+
+	t.Run("uncordon", func(t *testing.T) {
+		require.NoError(t, setMaintenance(ctx, cl, key, nil))
+
+		serviceClusterFound := &corev1alpha1.ServiceCluster{}
+		require.NoError(t, cl.Get(ctx, key, serviceClusterFound))
+		assert.Nil(t, serviceClusterFound.Spec.Maintenance)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := setMaintenance(ctx, cl, types.NamespacedName{Name: "us-east-1", Namespace: "provider"}, maintenance)
+		assert.Error(t, err)
+	})
+}
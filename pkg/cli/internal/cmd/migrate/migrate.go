@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"k8c.io/utils/pkg/util"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+var (
+	scheme = runtime.NewScheme()
+)
+
+func init() {
+	utilruntime.Must(catalogv1alpha1.AddToScheme(scheme))
+}
+
+type migrateFlagpole struct {
+	Offering         string
+	TargetOffering   string
+	ReadinessTimeout time.Duration
+	Wait             bool
+	Timeout          time.Duration
+}
+
+// NewCommand returns the migrate subcommand for KubeCarrier CLI.
+func NewCommand(log logr.Logger) *cobra.Command {
+	flags := genericclioptions.NewConfigFlags(false)
+	var flagpole migrateFlagpole
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "migrate INSTANCE --offering OFFERING --to TARGET_OFFERING [--wait]",
+		Short: "migrates an instance to the ServiceCluster of another region",
+		Long: `migrates an instance to the ServiceCluster of another region,
+by creating an InstanceMigration in the namespace of the instance.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flagpole.Offering == "" || flagpole.TargetOffering == "" {
+				return fmt.Errorf("--offering and --to must be specified")
+			}
+			cfg, err := flags.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+			namespace, _, err := flags.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				return err
+			}
+			cl, err := util.NewClientWatcher(cfg, scheme, log)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			migration := &catalogv1alpha1.InstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: args[0] + "-",
+					Namespace:    namespace,
+				},
+				Spec: catalogv1alpha1.InstanceMigrationSpec{
+					Offering:       catalogv1alpha1.ObjectReference{Name: flagpole.Offering},
+					Instance:       catalogv1alpha1.ObjectReference{Name: args[0]},
+					TargetOffering: catalogv1alpha1.ObjectReference{Name: flagpole.TargetOffering},
+				},
+			}
+			if flagpole.ReadinessTimeout > 0 {
+				migration.Spec.ReadinessTimeout = &metav1.Duration{Duration: flagpole.ReadinessTimeout}
+			}
+			if err := cl.Create(ctx, migration); err != nil {
+				return fmt.Errorf("creating InstanceMigration: %w", err)
+			}
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), "created InstanceMigration", migration.Name)
+			if !flagpole.Wait {
+				return nil
+			}
+
+			if err := cl.WaitUntil(ctx, migration, func() (done bool, err error) {
+				return migration.Status.IsFinished(), nil
+			}, util.WithClientWatcherTimeout(flagpole.Timeout)); err != nil {
+				return fmt.Errorf("waiting for InstanceMigration: %w", err)
+			}
+			succeeded, _ := migration.Status.GetCondition(catalogv1alpha1.InstanceMigrationSucceeded)
+			if migration.Status.Phase != catalogv1alpha1.InstanceMigrationPhaseCompleted {
+				return fmt.Errorf("InstanceMigration %s failed and was rolled back: %s", migration.Name, succeeded.Message)
+			}
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), succeeded.Message)
+			return nil
+		},
+	}
+	flags.AddFlags(cmd.Flags())
+	cmd.Flags().StringVar(&flagpole.Offering, "offering", "", "Offering of the instance")
+	cmd.Flags().StringVar(&flagpole.TargetOffering, "to", "", "Offering of another region, the instance is migrated to")
+	cmd.Flags().DurationVar(&flagpole.ReadinessTimeout, "readiness-timeout", 0, "time to wait for the migrated instance to become ready, before the migration is rolled back")
+	cmd.Flags().BoolVar(&flagpole.Wait, "wait", false, "wait until the migration is completed or rolled back")
+	cmd.Flags().DurationVar(&flagpole.Timeout, "wait-timeout", time.Hour, "maximum time to wait for the migration")
+	return cmd
+}
@@ -22,8 +22,10 @@ import (
 
 	"k8c.io/utils/pkg/util"
 
+	"k8c.io/kubecarrier/pkg/cli/internal/cmd/cordon"
 	deletecmd "k8c.io/kubecarrier/pkg/cli/internal/cmd/delete"
 	e2e_test "k8c.io/kubecarrier/pkg/cli/internal/cmd/e2e-test"
+	"k8c.io/kubecarrier/pkg/cli/internal/cmd/migrate"
 	"k8c.io/kubecarrier/pkg/cli/internal/cmd/preflight"
 	"k8c.io/kubecarrier/pkg/cli/internal/cmd/setup"
 	"k8c.io/kubecarrier/pkg/cli/internal/cmd/sut"
@@ -47,6 +49,9 @@ https://github.com/kubermatic/kubecarrier`,
 		version.NewCommand(log),
 		sut.NewCommand(log),
 		deletecmd.NewDeleteCommand(log),
+		migrate.NewCommand(log),
+		cordon.NewCordonCommand(log),
+		cordon.NewUncordonCommand(log),
 		preflight.NewPreflightCommand(log),
 	)
 
@@ -36,8 +36,12 @@ import (
 	"k8c.io/utils/pkg/util"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
 	"k8c.io/kubecarrier/pkg/elevator/internal/controllers"
 	"k8c.io/kubecarrier/pkg/elevator/internal/webhooks"
+	"k8c.io/kubecarrier/pkg/internal/consolidation"
+	utilwebhook "k8c.io/kubecarrier/pkg/internal/util/webhook"
 )
 
 type flags struct {
@@ -52,6 +56,8 @@ type flags struct {
 	providerNamespace                            string
 
 	mutatingWebhookPath string
+
+	consolidated bool
 }
 
 var (
@@ -61,6 +67,8 @@ var (
 func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = catalogv1alpha1.AddToScheme(scheme)
+	_ = corev1alpha1.AddToScheme(scheme)
+	_ = operatorv1alpha1.AddToScheme(scheme)
 }
 
 const (
@@ -116,6 +124,10 @@ func NewElevator() *cobra.Command {
 		&flags.mutatingWebhookPath, "mutating-webhook-path",
 		os.Getenv("ELEVATOR_MUTATING_WEBHOOK_PATH"), "The URL path of the mutating webhook service.")
 
+	cmd.Flags().BoolVar(
+		&flags.consolidated, "consolidated",
+		os.Getenv("ELEVATOR_CONSOLIDATED") == "true", "Serve the CRDs of all Elevator objects in the provider namespace, instead of a single CRD.")
+
 	return util.CmdLogMixin(cmd)
 }
 
@@ -124,15 +136,22 @@ func run(flags *flags, log logr.Logger) error {
 	checks := []struct {
 		value, env, flag string
 	}{
-		{value: flags.providerKind, env: "ELEVATOR_PROVIDER_KIND", flag: "provider-kind"},
-		{value: flags.providerVersion, env: "ELEVATOR_PROVIDER_VERSION", flag: "provider-version"},
-		{value: flags.providerGroup, env: "ELEVATOR_PROVIDER_GROUP", flag: "provider-group"},
-		{value: flags.tenantKind, env: "ELEVATOR_TENANT_KIND", flag: "tenant-kind"},
-		{value: flags.tenantVersion, env: "ELEVATOR_TENANT_VERSION", flag: "tenant-version"},
-		{value: flags.tenantGroup, env: "ELEVATOR_TENANT_GROUP", flag: "tenant-group"},
-		{value: flags.derivedCRName, env: "ELEVATOR_DERIVED_CRD_NAME", flag: "derived-crd-name"},
 		{value: flags.providerNamespace, env: "KUBERNETES_NAMESPACE", flag: "provider-namespace"},
-		{value: flags.mutatingWebhookPath, env: "ELEVATOR_MUTATING_WEBHOOK_PATH", flag: "mutating-webhook-path"},
+	}
+	if !flags.consolidated {
+		// a consolidated Elevator reads the CRDs to serve from Elevator objects
+		checks = append(checks, []struct {
+			value, env, flag string
+		}{
+			{value: flags.providerKind, env: "ELEVATOR_PROVIDER_KIND", flag: "provider-kind"},
+			{value: flags.providerVersion, env: "ELEVATOR_PROVIDER_VERSION", flag: "provider-version"},
+			{value: flags.providerGroup, env: "ELEVATOR_PROVIDER_GROUP", flag: "provider-group"},
+			{value: flags.tenantKind, env: "ELEVATOR_TENANT_KIND", flag: "tenant-kind"},
+			{value: flags.tenantVersion, env: "ELEVATOR_TENANT_VERSION", flag: "tenant-version"},
+			{value: flags.tenantGroup, env: "ELEVATOR_TENANT_GROUP", flag: "tenant-group"},
+			{value: flags.derivedCRName, env: "ELEVATOR_DERIVED_CRD_NAME", flag: "derived-crd-name"},
+			{value: flags.mutatingWebhookPath, env: "ELEVATOR_MUTATING_WEBHOOK_PATH", flag: "mutating-webhook-path"},
+		}...)
 	}
 	var errs []string
 	for _, check := range checks {
@@ -144,6 +163,11 @@ func run(flags *flags, log logr.Logger) error {
 		return fmt.Errorf(strings.Join(errs, ", "))
 	}
 
+	leaderElectionID := "elevator-" + flags.derivedCRName
+	if flags.consolidated {
+		leaderElectionID = "elevator-consolidated"
+	}
+
 	cfg := ctrl.GetConfigOrDie()
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                  scheme,
@@ -151,35 +175,95 @@ func run(flags *flags, log logr.Logger) error {
 		HealthProbeBindAddress:  flags.healthAddr,
 		LeaderElection:          flags.enableLeaderElection,
 		LeaderElectionNamespace: flags.providerNamespace,
-		LeaderElectionID:        "elevator-" + flags.derivedCRName,
+		LeaderElectionID:        leaderElectionID,
 		CertDir:                 flags.certDir,
 		Port:                    9443,
-		NewClient: func(cache cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
-			// Create the Client for Write operations.
-			c, err := client.New(config, options)
-			if err != nil {
-				return nil, err
-			}
-
-			// we don't want a client.DelegatingReader here,
-			// because we WANT to cache unstructured objects.
-			return &client.DelegatingClient{
-				Reader:       cache,
-				Writer:       c,
-				StatusClient: c,
-			}, nil
-		},
+		NewClient:               newClient,
 	})
 	if err != nil {
 		return fmt.Errorf("starting manager: %w", err)
 	}
 
+	if flags.consolidated {
+		if err := setupConsolidated(mgr, log, cfg, flags); err != nil {
+			return err
+		}
+	} else {
+		wbh := mgr.GetWebhookServer()
+		if err := setupInstance(mgr, log, flags.providerNamespace, instanceConfig{
+			ProviderGVK: schema.GroupVersionKind{
+				Kind:    flags.providerKind,
+				Version: flags.providerVersion,
+				Group:   flags.providerGroup,
+			},
+			TenantGVK: schema.GroupVersionKind{
+				Kind:    flags.tenantKind,
+				Version: flags.tenantVersion,
+				Group:   flags.tenantGroup,
+			},
+			DerivedCRName:       flags.derivedCRName,
+			MutatingWebhookPath: flags.mutatingWebhookPath,
+		}, func(path string, hook *webhook.Admission) error {
+			wbh.Register(path, hook)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("adding readyz checker: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("adding healthz checker: %w", err)
+	}
+
+	log.Info("starting manager")
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		return fmt.Errorf("running manager: %w", err)
+	}
+	return nil
+}
+
+func newClient(cache cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
+	// Create the Client for Write operations.
+	c, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// we don't want a client.DelegatingReader here,
+	// because we WANT to cache unstructured objects.
+	return &client.DelegatingClient{
+		Reader:       cache,
+		Writer:       c,
+		StatusClient: c,
+	}, nil
+}
+
+// instanceConfig configures the controllers and webhook of a single CRD.
+type instanceConfig struct {
+	ProviderGVK, TenantGVK schema.GroupVersionKind
+	DerivedCRName          string
+
+	MutatingWebhookPath string
+}
+
+// registerWebhookFunc serves the given webhook at the given URL path.
+type registerWebhookFunc func(path string, hook *webhook.Admission) error
+
+// setupInstance adds the controllers and webhook for a single CRD to the given manager.
+func setupInstance(
+	mgr ctrl.Manager, log logr.Logger, providerNamespace string,
+	cfg instanceConfig, registerWebhook registerWebhookFunc,
+) error {
 	// We only have permissions to access DerivedCustomResources in the provider namespace.
 	// So we have to create a new cache, that is limited to this namespace, or we will break on permission errors.
-	namespacedCache, err := cache.New(cfg, cache.Options{
+	namespacedCache, err := cache.New(mgr.GetConfig(), cache.Options{
 		Scheme:    mgr.GetScheme(),
 		Mapper:    mgr.GetRESTMapper(),
-		Namespace: flags.providerNamespace,
+		Namespace: providerNamespace,
 	})
 	if err != nil {
 		return fmt.Errorf(
@@ -196,16 +280,8 @@ func run(flags *flags, log logr.Logger) error {
 	}
 
 	// Setup Types
-	providerGVK := schema.GroupVersionKind{
-		Kind:    flags.providerKind,
-		Version: flags.providerVersion,
-		Group:   flags.providerGroup,
-	}
-	tenantGVK := schema.GroupVersionKind{
-		Kind:    flags.tenantKind,
-		Version: flags.tenantVersion,
-		Group:   flags.tenantGroup,
-	}
+	providerGVK := cfg.ProviderGVK
+	tenantGVK := cfg.TenantGVK
 
 	// Setup Controllers
 	if err := (&controllers.TenantObjReconciler{
@@ -217,8 +293,8 @@ func run(flags *flags, log logr.Logger) error {
 		ProviderGVK: providerGVK,
 		TenantGVK:   tenantGVK,
 
-		DerivedCRName:     flags.derivedCRName,
-		ProviderNamespace: flags.providerNamespace,
+		DerivedCRName:     cfg.DerivedCRName,
+		ProviderNamespace: providerNamespace,
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("cannot add %s controller: %w", "TenantObjReconciler", err)
 	}
@@ -232,25 +308,57 @@ func run(flags *flags, log logr.Logger) error {
 		ProviderGVK: providerGVK,
 		TenantGVK:   tenantGVK,
 
-		DerivedCRName:     flags.derivedCRName,
-		ProviderNamespace: flags.providerNamespace,
+		DerivedCRName:     cfg.DerivedCRName,
+		ProviderNamespace: providerNamespace,
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("cannot add %s controller: %w", "AdoptionReconciler", err)
 	}
 
-	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
-		return fmt.Errorf("adding readyz checker: %w", err)
+	if err := (&controllers.QuotaReconciler{
+		Log:              log.WithName("controllers").WithName("QuotaReconciler"),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		NamespacedClient: namespacedClient,
+		NamespacedCache:  namespacedCache,
+
+		ProviderGVK: providerGVK,
+		TenantGVK:   tenantGVK,
+
+		DerivedCRName:     cfg.DerivedCRName,
+		ProviderNamespace: providerNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("cannot add %s controller: %w", "QuotaReconciler", err)
 	}
 
-	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
-		return fmt.Errorf("adding healthz checker: %w", err)
+	if err := (&controllers.UsageReconciler{
+		Log:              log.WithName("controllers").WithName("UsageReconciler"),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		NamespacedClient: namespacedClient,
+
+		ProviderGVK: providerGVK,
+		TenantGVK:   tenantGVK,
+
+		DerivedCRName:     cfg.DerivedCRName,
+		ProviderNamespace: providerNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("cannot add %s controller: %w", "UsageReconciler", err)
 	}
 
-	// Register webhooks as handlers
-	wbh := mgr.GetWebhookServer()
+	if err := (&controllers.UsageExportReconciler{
+		Log:              log.WithName("controllers").WithName("UsageExportReconciler"),
+		Scheme:           mgr.GetScheme(),
+		NamespacedClient: namespacedClient,
+		NamespacedCache:  namespacedCache,
+
+		DerivedCRName:     cfg.DerivedCRName,
+		ProviderNamespace: providerNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("cannot add %s controller: %w", "UsageExportReconciler", err)
+	}
 
 	// mutating webhook
-	wbh.Register(flags.mutatingWebhookPath,
+	if err := registerWebhook(cfg.MutatingWebhookPath,
 		&webhook.Admission{Handler: &webhooks.TenantObjWebhookHandler{
 			Log:    log.WithName("mutating webhooks").WithName(tenantGVK.Kind),
 			Scheme: mgr.GetScheme(),
@@ -261,13 +369,80 @@ func run(flags *flags, log logr.Logger) error {
 			TenantGVK:   tenantGVK,
 			ProviderGVK: providerGVK,
 
-			ProviderNamespace: flags.providerNamespace,
-			DerivedCRName:     flags.derivedCRName,
-		}})
+			ProviderNamespace: providerNamespace,
+			DerivedCRName:     cfg.DerivedCRName,
+		}}); err != nil {
+		return fmt.Errorf("registering mutating webhook: %w", err)
+	}
+	return nil
+}
 
-	log.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
-		return fmt.Errorf("running manager: %w", err)
+// setupConsolidated configures the manager to serve the CRDs of all Elevator objects in the provider namespace.
+// Every CRD is served by its own instance, that is started and stopped as Elevator objects come and go.
+func setupConsolidated(mgr ctrl.Manager, log logr.Logger, cfg *rest.Config, flags *flags) error {
+	namespacedCache, err := cache.New(cfg, cache.Options{
+		Scheme:    mgr.GetScheme(),
+		Mapper:    mgr.GetRESTMapper(),
+		Namespace: flags.providerNamespace,
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"creating namespaced scoped cache: %w", err)
+	}
+	if err = mgr.Add(namespacedCache); err != nil {
+		return fmt.Errorf(
+			"add namespaced cache to manager: %w", err)
+	}
+
+	// webhooks of all instances are served by the webhook server of this manager
+	dispatcher := utilwebhook.NewDispatcher()
+	mgr.GetWebhookServer().Register("/", dispatcher)
+
+	runner := consolidation.NewRunner(log.WithName("instances"), func() (ctrl.Manager, error) {
+		return ctrl.NewManager(cfg, ctrl.Options{
+			Scheme:             scheme,
+			MetricsBindAddress: "0",
+			NewClient:          newClient,
+		})
+	})
+	if err := mgr.Add(runner); err != nil {
+		return fmt.Errorf("add instance runner to manager: %w", err)
+	}
+
+	if err := (&controllers.ConsolidatedReconciler{
+		Log: log.WithName("controllers").WithName("ConsolidatedReconciler"),
+		Client: &client.DelegatingClient{
+			Reader:       namespacedCache,
+			Writer:       mgr.GetClient(),
+			StatusClient: mgr.GetClient(),
+		},
+		NamespacedCache: namespacedCache,
+		Runner:          runner,
+
+		SetupInstance: func(instanceMgr ctrl.Manager, instanceLog logr.Logger, elevator *operatorv1alpha1.Elevator) error {
+			tenantGVK := schema.GroupVersionKind{
+				Kind:    elevator.Spec.TenantCRD.Kind,
+				Version: elevator.Spec.TenantCRD.Version,
+				Group:   elevator.Spec.TenantCRD.Group,
+			}
+			return setupInstance(instanceMgr, instanceLog, flags.providerNamespace, instanceConfig{
+				ProviderGVK: schema.GroupVersionKind{
+					Kind:    elevator.Spec.ProviderCRD.Kind,
+					Version: elevator.Spec.ProviderCRD.Version,
+					Group:   elevator.Spec.ProviderCRD.Group,
+				},
+				TenantGVK:           tenantGVK,
+				DerivedCRName:       elevator.Spec.DerivedCR.Name,
+				MutatingWebhookPath: utilwebhook.GenerateMutateWebhookPathFromGVK(tenantGVK),
+			}, func(path string, hook *webhook.Admission) error {
+				if err := instanceMgr.SetFields(hook); err != nil {
+					return err
+				}
+				return instanceMgr.Add(dispatcher.Runnable(path, hook))
+			})
+		},
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("cannot add %s controller: %w", "ConsolidatedReconciler", err)
 	}
 	return nil
 }
@@ -35,6 +35,7 @@ import (
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	elevatorutil "k8c.io/kubecarrier/pkg/elevator/internal/util"
+	"k8c.io/kubecarrier/pkg/internal/util/objectreferences"
 )
 
 // AdoptionReconciler reconciles Provider objects, that are not owned by a Tenant by creating the Tenant instance.
@@ -82,9 +83,14 @@ func (r *AdoptionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return result, fmt.Errorf("missing version expose config for version %q", version)
 	}
 
+	mapping, err := objectReferenceMapping(ctx, r.NamespacedClient, r.ProviderNamespace, derivedCR.Spec.ObjectReferences)
+	if err != nil {
+		return result, fmt.Errorf("building object reference mapping: %w", err)
+	}
+
 	// Reconcile ProviderCRD
-	err := r.reconcileProviderObj(
-		ctx, providerObj, exposeConfig)
+	err = r.reconcileProviderObj(
+		ctx, providerObj, exposeConfig, derivedCR.Spec.ObjectReferences, mapping.Reverse())
 	if err != nil {
 		return result, fmt.Errorf("reconciling %s: %w", r.ProviderGVK.Kind, err)
 	}
@@ -110,6 +116,7 @@ func (r *AdoptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 func (r *AdoptionReconciler) reconcileProviderObj(
 	ctx context.Context, providerObj *unstructured.Unstructured,
 	config catalogv1alpha1.VersionExposeConfig,
+	objectReferences []catalogv1alpha1.ObjectReferenceField, mapping objectreferences.Mapping,
 ) error {
 	desiredTenantObj := r.newTenantObject()
 	desiredTenantObj.SetName(providerObj.GetName())
@@ -129,7 +136,11 @@ func (r *AdoptionReconciler) reconcileProviderObj(
 	}
 	if errors.IsNotFound(err) {
 		// Create the Tenant Obj
-		if err = elevatorutil.CopyFields(providerObj, desiredTenantObj, otherFields); err != nil {
+		translatedProviderObj := providerObj.DeepCopy()
+		if err = objectreferences.Translate(translatedProviderObj, objectReferences, mapping); err != nil {
+			return fmt.Errorf("translating object references: %w", err)
+		}
+		if err = elevatorutil.CopyFieldsToTenant(translatedProviderObj, desiredTenantObj, otherFields); err != nil {
 			return fmt.Errorf("copy fields: %w", err)
 		}
 
@@ -18,7 +18,9 @@ package controllers
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -54,6 +56,9 @@ var (
 						{JSONPath: ".spec.test1"},
 						{JSONPath: ".status.test1"},
 					},
+					Defaults: []catalogv1alpha1.FieldDefault{
+						{JSONPath: ".spec.test2", Value: apiextensionsv1.JSON{Raw: []byte(`"default"`)}},
+					},
 				},
 			},
 		},
@@ -68,4 +73,7 @@ func init() {
 	if err := catalogv1alpha1.AddToScheme(testScheme); err != nil {
 		panic(err)
 	}
+	// required to list tenant objects with the fake client
+	testScheme.AddKnownTypeWithName(
+		tenantGVK.GroupVersion().WithKind(tenantGVK.Kind+"List"), &unstructured.UnstructuredList{})
 }
@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	elevatorutil "k8c.io/kubecarrier/pkg/elevator/internal/util"
+)
+
+// QuotaReconciler reports the usage of Tenants in the status of Quotas,
+// for the CatalogEntry this Elevator is responsible for.
+// Usage of other CatalogEntries is reported by their own Elevators.
+type QuotaReconciler struct {
+	client.Client
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	NamespacedClient client.Client
+	// NamespacedCache is used to watch Quotas and Catalogs in the provider namespace.
+	NamespacedCache cache.Cache
+
+	// Dynamic types we work with
+	ProviderGVK, TenantGVK           schema.GroupVersionKind
+	DerivedCRName, ProviderNamespace string
+}
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=quotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=quotas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogs,verbs=get;list;watch
+
+func (r *QuotaReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	var (
+		ctx    = context.Background()
+		result ctrl.Result
+	)
+
+	quota := &catalogv1alpha1.Quota{}
+	if err := r.NamespacedClient.Get(ctx, req.NamespacedName, quota); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+
+	var usage []catalogv1alpha1.QuotaUsage
+	if limit, ok := quota.Spec.GetLimit(r.DerivedCRName); ok {
+		var err error
+		usage, err = r.calculateUsage(ctx, quota, limit)
+		if err != nil {
+			return result, fmt.Errorf("calculating usage: %w", err)
+		}
+	}
+
+	// keep the usage of other CatalogEntries
+	var desiredUsage []catalogv1alpha1.QuotaUsage
+	for _, u := range quota.Status.Usage {
+		if u.CatalogEntry.Name != r.DerivedCRName {
+			desiredUsage = append(desiredUsage, u)
+		}
+	}
+	desiredUsage = append(desiredUsage, usage...)
+
+	if equality.Semantic.DeepEqual(quota.Status.Usage, desiredUsage) {
+		return result, nil
+	}
+	quota.Status.Usage = desiredUsage
+	if err := r.NamespacedClient.Status().Update(ctx, quota); err != nil {
+		return result, fmt.Errorf("updating Quota status: %w", err)
+	}
+	return result, nil
+}
+
+func (r *QuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	c, err := controller.New("quota", mgr, controller.Options{
+		Reconciler: r,
+	})
+	if err != nil {
+		return fmt.Errorf("creating controller: %w", err)
+	}
+
+	if err := c.Watch(
+		source.NewKindWithCache(&catalogv1alpha1.Quota{}, r.NamespacedCache),
+		&handler.EnqueueRequestForObject{},
+	); err != nil {
+		return fmt.Errorf("watching Quotas: %w", err)
+	}
+
+	if err := c.Watch(
+		source.NewKindWithCache(&catalogv1alpha1.Catalog{}, r.NamespacedCache),
+		&handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) []reconcile.Request {
+				return r.enqueueQuotas(func(quota catalogv1alpha1.Quota) bool {
+					return quota.Spec.Catalog.Name == mapObject.Meta.GetName()
+				})
+			}),
+		},
+	); err != nil {
+		return fmt.Errorf("watching Catalogs: %w", err)
+	}
+
+	return c.Watch(
+		&source.Kind{Type: r.newTenantObject()},
+		&handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) []reconcile.Request {
+				return r.enqueueQuotas(func(quota catalogv1alpha1.Quota) bool {
+					return quota.Spec.AppliesToTenant(mapObject.Meta.GetNamespace())
+				})
+			}),
+		},
+	)
+}
+
+// calculateUsage returns the usage of all Tenants of the Quota for the given limit.
+func (r *QuotaReconciler) calculateUsage(
+	ctx context.Context, quota *catalogv1alpha1.Quota,
+	limit catalogv1alpha1.CatalogEntryLimit,
+) ([]catalogv1alpha1.QuotaUsage, error) {
+	catalog := &catalogv1alpha1.Catalog{}
+	err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+		Name:      quota.Spec.Catalog.Name,
+		Namespace: r.ProviderNamespace,
+	}, catalog)
+	if errors.IsNotFound(err) {
+		// no Tenants without Catalog
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting Catalog: %w", err)
+	}
+
+	derivedCR := &catalogv1alpha1.DerivedCustomResource{}
+	if err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+		Name:      r.DerivedCRName,
+		Namespace: r.ProviderNamespace,
+	}, derivedCR); err != nil {
+		return nil, fmt.Errorf("getting DerivedCustomResource: %w", err)
+	}
+	version := r.ProviderGVK.Version
+	exposeConfig, ok := elevatorutil.VersionExposeConfigForVersion(derivedCR.Spec.Expose, version)
+	if !ok {
+		return nil, fmt.Errorf("missing version expose config for version %q", version)
+	}
+
+	var usage []catalogv1alpha1.QuotaUsage
+	for _, tenant := range catalog.Status.Tenants {
+		if !quota.Spec.AppliesToTenant(tenant.Name) {
+			continue
+		}
+
+		// the Tenant namespace is named after the Tenant
+		tenantObjList := &unstructured.UnstructuredList{}
+		tenantObjList.SetGroupVersionKind(r.TenantGVK.GroupVersion().WithKind(r.TenantGVK.Kind + "List"))
+		if err := r.List(ctx, tenantObjList, client.InNamespace(tenant.Name)); err != nil {
+			return nil, fmt.Errorf("listing %s: %w", r.TenantGVK.Kind, err)
+		}
+		var providerObjs []unstructured.Unstructured
+		for i := range tenantObjList.Items {
+			providerView, err := elevatorutil.ProviderView(&tenantObjList.Items[i], exposeConfig)
+			if err != nil {
+				return nil, err
+			}
+			providerObjs = append(providerObjs, *providerView)
+		}
+
+		tenantUsage, err := elevatorutil.CalculateUsage(providerObjs, limit)
+		if err != nil {
+			return nil, err
+		}
+		tenantUsage.Tenant = tenant
+		usage = append(usage, tenantUsage)
+	}
+	return usage, nil
+}
+
+// enqueueQuotas enqueues all Quotas with limits for this CatalogEntry, that match the given filter.
+func (r *QuotaReconciler) enqueueQuotas(filter func(quota catalogv1alpha1.Quota) bool) (out []reconcile.Request) {
+	quotaList := &catalogv1alpha1.QuotaList{}
+	if err := r.NamespacedClient.List(context.Background(), quotaList, client.InNamespace(r.ProviderNamespace)); err != nil {
+		// This will makes the manager crashes, and it will restart and reconcile all objects again.
+		panic(fmt.Errorf("listing Quotas: %w", err))
+	}
+	for _, quota := range quotaList.Items {
+		if _, ok := quota.Spec.GetLimit(r.DerivedCRName); !ok || !filter(quota) {
+			continue
+		}
+		out = append(out, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      quota.Name,
+				Namespace: quota.Namespace,
+			},
+		})
+	}
+	return
+}
+
+func (r *QuotaReconciler) newTenantObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(r.TenantGVK)
+	return obj
+}
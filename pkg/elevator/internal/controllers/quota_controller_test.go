@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+func TestQuotaReconciler(t *testing.T) {
+	newTenantObj := func(name string, spec map[string]interface{}) *unstructured.Unstructured {
+		tenantObj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      name,
+					"namespace": "another-namespace",
+				},
+				"spec": spec,
+			},
+		}
+		tenantObj.SetGroupVersionKind(tenantGVK)
+		return tenantObj
+	}
+	tenantObj1 := newTenantObj("test-1", map[string]interface{}{"test1": "1Gi"})
+	tenantObj2 := newTenantObj("test-2", map[string]interface{}{"test1": "2Gi"})
+
+	catalog := &catalogv1alpha1.Catalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "free-tier",
+			Namespace: providerNamespace,
+		},
+		Status: catalogv1alpha1.CatalogStatus{
+			Tenants: []catalogv1alpha1.ObjectReference{
+				{Name: "another-namespace"},
+			},
+		},
+	}
+
+	maxInstances := int64(5)
+	otherUsage := catalogv1alpha1.QuotaUsage{
+		Tenant:       catalogv1alpha1.ObjectReference{Name: "another-namespace"},
+		CatalogEntry: catalogv1alpha1.ObjectReference{Name: "redis.eu-west-1"},
+		Instances:    1,
+	}
+	quota := &catalogv1alpha1.Quota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "free-tier",
+			Namespace: providerNamespace,
+		},
+		Spec: catalogv1alpha1.QuotaSpec{
+			Catalog: catalogv1alpha1.ObjectReference{Name: catalog.Name},
+			Limits: []catalogv1alpha1.CatalogEntryLimit{
+				{
+					CatalogEntry: catalogv1alpha1.ObjectReference{Name: dcr.Name},
+					MaxInstances: &maxInstances,
+					Fields: []catalogv1alpha1.FieldLimit{
+						{JSONPath: ".spec.test1", Max: resource.MustParse("10Gi")},
+					},
+				},
+				{
+					CatalogEntry: otherUsage.CatalogEntry,
+					MaxInstances: &maxInstances,
+				},
+			},
+		},
+		Status: catalogv1alpha1.QuotaStatus{
+			// reported by another Elevator
+			Usage: []catalogv1alpha1.QuotaUsage{otherUsage},
+		},
+	}
+
+	log := testutil.NewLogger(t)
+	client := fakeclient.NewFakeClientWithScheme(
+		testScheme, dcr, catalog, quota, tenantObj1, tenantObj2)
+
+	r := QuotaReconciler{
+		Client:           client,
+		Log:              log,
+		Scheme:           testScheme,
+		NamespacedClient: client,
+
+		ProviderGVK: providerGVK,
+		TenantGVK:   tenantGVK,
+
+		DerivedCRName:     dcr.Name,
+		ProviderNamespace: providerNamespace,
+	}
+
+	_, err := r.Reconcile(reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      quota.Name,
+			Namespace: quota.Namespace,
+		},
+	})
+	require.NoError(t, err)
+
+	checkQuota := &catalogv1alpha1.Quota{}
+	require.NoError(t, client.Get(context.Background(), types.NamespacedName{
+		Name:      quota.Name,
+		Namespace: quota.Namespace,
+	}, checkQuota))
+	require.Len(t, checkQuota.Status.Usage, 2)
+	assert.Equal(t, otherUsage, checkQuota.Status.Usage[0])
+
+	usage := checkQuota.Status.Usage[1]
+	assert.Equal(t, "another-namespace", usage.Tenant.Name)
+	assert.Equal(t, dcr.Name, usage.CatalogEntry.Name)
+	assert.Equal(t, int64(2), usage.Instances)
+	require.Len(t, usage.Fields, 1)
+	assert.Equal(t, ".spec.test1", usage.Fields[0].JSONPath)
+	assert.Equal(t, "3Gi", usage.Fields[0].Used.String())
+}
@@ -21,7 +21,9 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,11 +31,16 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"k8c.io/utils/pkg/util"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	elevatorutil "k8c.io/kubecarrier/pkg/elevator/internal/util"
+	"k8c.io/kubecarrier/pkg/internal/util/objectreferences"
+	"k8c.io/kubecarrier/pkg/internal/util/referencedobjects"
 )
 
 // TenantObjReconciler reconciles a tenant-side CRD by converting it into a provider-side object and syncing the status back:
@@ -52,6 +59,7 @@ type TenantObjReconciler struct {
 
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=derivedcustomresources,verbs=get;list;watch
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=derivedcustomresources/status,verbs=get
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=get;list;watch
 
 func (r *TenantObjReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	var (
@@ -78,9 +86,15 @@ func (r *TenantObjReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return result, fmt.Errorf("missing version expose config for version %q", version)
 	}
 
+	mapping, err := objectReferenceMapping(ctx, r.NamespacedClient, r.ProviderNamespace, derivedCR.Spec.ObjectReferences)
+	if err != nil {
+		return result, fmt.Errorf("building object reference mapping: %w", err)
+	}
+
 	// Reconcile TenantCRD
-	err := r.reconcileTenantObj(
-		ctx, tenantObj, exposeConfig)
+	err = r.reconcileTenantObj(
+		ctx, tenantObj, exposeConfig, derivedCR.Spec.ReferencedObjects,
+		derivedCR.Spec.ObjectReferences, mapping)
 	if err != nil {
 		return result, fmt.Errorf("reconciling %s: %w", r.ProviderGVK.Kind, err)
 	}
@@ -89,15 +103,36 @@ func (r *TenantObjReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 }
 
 func (r *TenantObjReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// referenced objects are controlled by the provider object,
+	// which has the same name as the tenant object.
+	enqueueForReferencedObject := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) []reconcile.Request {
+			controllerRef := metav1.GetControllerOf(mapObject.Meta)
+			if controllerRef == nil ||
+				controllerRef.Kind != r.ProviderGVK.Kind ||
+				controllerRef.APIVersion != r.ProviderGVK.GroupVersion().String() {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{
+				Name:      controllerRef.Name,
+				Namespace: mapObject.Meta.GetNamespace(),
+			}}}
+		}),
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(r.newTenantObject()).
 		Owns(r.newProviderObject()).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, enqueueForReferencedObject).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, enqueueForReferencedObject).
 		Complete(r)
 }
 
 func (r *TenantObjReconciler) reconcileTenantObj(
 	ctx context.Context, tenantObj *unstructured.Unstructured,
 	config catalogv1alpha1.VersionExposeConfig,
+	referencedObjects []catalogv1alpha1.ReferencedObject,
+	objectReferences []catalogv1alpha1.ObjectReferenceField, mapping objectreferences.Mapping,
 ) error {
 	desiredProviderObj := r.newProviderObject()
 	desiredProviderObj.SetName(tenantObj.GetName())
@@ -124,9 +159,15 @@ func (r *TenantObjReconciler) reconcileTenantObj(
 
 	if errors.IsNotFound(err) {
 		// Create the Provider Obj
-		if err = elevatorutil.CopyFields(tenantObj, desiredProviderObj, otherFields); err != nil {
+		if err = elevatorutil.CopyFieldsToProvider(tenantObj, desiredProviderObj, otherFields); err != nil {
 			return fmt.Errorf("copy fields: %w", err)
 		}
+		if err = elevatorutil.ApplyDefaults(desiredProviderObj, config.Defaults); err != nil {
+			return fmt.Errorf("apply defaults: %w", err)
+		}
+		if err = objectreferences.Translate(desiredProviderObj, objectReferences, mapping); err != nil {
+			return fmt.Errorf("translating object references: %w", err)
+		}
 
 		if err = r.Create(ctx, desiredProviderObj); err != nil {
 			return fmt.Errorf("creating %s: %w", r.ProviderGVK.Kind, err)
@@ -146,17 +187,20 @@ func (r *TenantObjReconciler) reconcileTenantObj(
 	}
 
 	// Update existing provider instance
-	if err = elevatorutil.CopyFields(tenantObj, currentProviderObj, otherFields); err != nil {
+	if err = elevatorutil.CopyFieldsToProvider(tenantObj, currentProviderObj, otherFields); err != nil {
 		return fmt.Errorf(
 			"copy fields from %s to %s: %w",
 			r.TenantGVK.Kind, r.ProviderGVK.Kind, err)
 	}
+	if err = objectreferences.Translate(currentProviderObj, objectReferences, mapping); err != nil {
+		return fmt.Errorf("translating object references: %w", err)
+	}
 	if err = r.Update(ctx, currentProviderObj); err != nil {
 		return fmt.Errorf("updating %s: %w", r.ProviderGVK.Kind, err)
 	}
 
 	// Sync status from provider to tenant instance
-	if err = elevatorutil.CopyFields(currentProviderObj, tenantObj, statusFields); err != nil {
+	if err = elevatorutil.CopyFieldsToTenant(currentProviderObj, tenantObj, statusFields); err != nil {
 		return fmt.Errorf(
 			"copy status fields from %s to %s: %w",
 			r.ProviderGVK.Kind, r.TenantGVK.Kind, err)
@@ -170,9 +214,93 @@ func (r *TenantObjReconciler) reconcileTenantObj(
 		return fmt.Errorf("updating %s: %w", r.TenantGVK.Kind, err)
 	}
 
+	// Re-expose referenced objects to the tenant
+	if err = r.reconcileReferencedObjects(
+		ctx, tenantObj, currentProviderObj, referencedObjects); err != nil {
+		return fmt.Errorf("reconciling referenced objects: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileReferencedObjects adds the tenant object as owner to the Secrets and ConfigMaps,
+// that have been propagated from the ServiceCluster for the provider object.
+func (r *TenantObjReconciler) reconcileReferencedObjects(
+	ctx context.Context, tenantObj, providerObj *unstructured.Unstructured,
+	referencedObjects []catalogv1alpha1.ReferencedObject,
+) error {
+	refs, err := referencedobjects.Resolve(providerObj, referencedObjects)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		obj, err := ref.NewObject()
+		if err != nil {
+			return err
+		}
+		err = r.Get(ctx, types.NamespacedName{
+			Name:      ref.Name,
+			Namespace: providerObj.GetNamespace(),
+		}, obj)
+		if errors.IsNotFound(err) {
+			// not yet propagated
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("getting %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		if !metav1.IsControlledBy(obj, providerObj) {
+			// only objects propagated for this instance are exposed
+			continue
+		}
+
+		ownerCount := len(obj.GetOwnerReferences())
+		if err := controllerutil.SetOwnerReference(tenantObj, obj, r.Scheme); err != nil {
+			return fmt.Errorf("setting owner reference on %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		if len(obj.GetOwnerReferences()) == ownerCount {
+			continue
+		}
+		if err := r.Update(ctx, obj); err != nil {
+			return fmt.Errorf("updating %s %s: %w", ref.Kind, ref.Name, err)
+		}
+	}
 	return nil
 }
 
+// objectReferenceMapping maps the API groups of the Tenant CRDs of referenced CatalogEntries to their Provider CRDs.
+// Tenant and Provider objects live in the same namespace, so namespaces are not changed.
+func objectReferenceMapping(
+	ctx context.Context, c client.Client, namespace string,
+	objectReferences []catalogv1alpha1.ObjectReferenceField,
+) (objectreferences.Mapping, error) {
+	mapping := objectreferences.Mapping{
+		APIGroups: map[string]objectreferences.APIGroupMapping{},
+	}
+	for _, field := range objectReferences {
+		name := field.CatalogEntry.Name
+		if _, ok := mapping.APIGroups[name]; ok {
+			continue
+		}
+		catalogEntry := &catalogv1alpha1.CatalogEntry{}
+		if err := c.Get(ctx, types.NamespacedName{
+			Name:      name,
+			Namespace: namespace,
+		}, catalogEntry); err != nil {
+			return mapping, fmt.Errorf("getting CatalogEntry: %w", err)
+		}
+		if catalogEntry.Status.TenantCRD == nil || catalogEntry.Status.ProviderCRD == nil {
+			return mapping, fmt.Errorf("CatalogEntry %s is not ready", name)
+		}
+		mapping.APIGroups[name] = objectreferences.APIGroupMapping{
+			From: catalogEntry.Status.TenantCRD.APIGroup,
+			To:   catalogEntry.Status.ProviderCRD.APIGroup,
+		}
+	}
+	return mapping, nil
+}
+
 func (r *TenantObjReconciler) newTenantObject() *unstructured.Unstructured {
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(r.TenantGVK)
@@ -22,12 +22,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 )
 
 func TestTenantObjReconciler(t *testing.T) {
@@ -214,7 +217,73 @@ func TestTenantObjReconciler(t *testing.T) {
 			},
 			"spec": map[string]interface{}{
 				"test1": "spec2000",
+				// default for a field that is not exposed
+				"test2": "default",
 			},
 		}, checkProviderObj.Object)
 	})
+	t.Run("translates object references", func(t *testing.T) {
+		referencingDCR := dcr.DeepCopy()
+		referencingDCR.Spec.Expose[0].Fields = append(referencingDCR.Spec.Expose[0].Fields,
+			catalogv1alpha1.FieldPath{JSONPath: ".spec.dbRef"})
+		referencingDCR.Spec.ObjectReferences = []catalogv1alpha1.ObjectReferenceField{
+			{
+				CatalogEntry:     catalogv1alpha1.ObjectReference{Name: "db"},
+				JSONPath:         ".spec.dbRef.name",
+				APIGroupJSONPath: ".spec.dbRef.apiVersion",
+			},
+		}
+		dbCatalogEntry := &catalogv1alpha1.CatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db",
+				Namespace: providerNamespace,
+			},
+			Status: catalogv1alpha1.CatalogEntryStatus{
+				TenantCRD:   &catalogv1alpha1.CRDInformation{APIGroup: "eu-west-1.tenant"},
+				ProviderCRD: &catalogv1alpha1.CRDInformation{APIGroup: "eu-west-1.provider"},
+			},
+		}
+		referencingTenantObj := tenantObj.DeepCopy()
+		require.NoError(t, unstructured.SetNestedStringMap(referencingTenantObj.Object, map[string]string{
+			"name":       "db1",
+			"apiVersion": "eu-west-1.tenant/v1alpha1",
+		}, "spec", "dbRef"))
+
+		log := testutil.NewLogger(t)
+		client := fakeclient.NewFakeClientWithScheme(testScheme, referencingDCR, dbCatalogEntry, referencingTenantObj)
+
+		r := TenantObjReconciler{
+			Client:           client,
+			Log:              log,
+			Scheme:           testScheme,
+			NamespacedClient: client,
+
+			ProviderGVK: providerGVK,
+			TenantGVK:   tenantGVK,
+
+			DerivedCRName:     dcr.Name,
+			ProviderNamespace: providerNamespace,
+		}
+
+		_, err := r.Reconcile(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      tenantObj.GetName(),
+				Namespace: tenantObj.GetNamespace(),
+			},
+		})
+		require.NoError(t, err)
+
+		checkProviderObj := &unstructured.Unstructured{}
+		checkProviderObj.SetGroupVersionKind(providerGVK)
+		require.NoError(t, client.Get(context.Background(), types.NamespacedName{
+			Name:      tenantObj.GetName(),
+			Namespace: tenantObj.GetNamespace(),
+		}, checkProviderObj))
+		dbRef, _, err := unstructured.NestedStringMap(checkProviderObj.Object, "spec", "dbRef")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"name":       "db1",
+			"apiVersion": "eu-west-1.provider/v1alpha1",
+		}, dbRef)
+	})
 }
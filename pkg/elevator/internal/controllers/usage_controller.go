@@ -0,0 +1,288 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/elevator/internal/metering"
+	elevatorutil "k8c.io/kubecarrier/pkg/elevator/internal/util"
+	"k8c.io/kubecarrier/pkg/internal/util/instance"
+)
+
+// UsageReconciler records the lifecycle of tenant objects as UsageRecords in the provider namespace:
+// a new UsageRecord is started and the previous one is ended,
+// when the tenant object is created, changed, becomes ready or not ready, and when it is deleted.
+type UsageReconciler struct {
+	client.Client
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	NamespacedClient client.Client
+
+	// Dynamic types we work with
+	ProviderGVK, TenantGVK           schema.GroupVersionKind
+	DerivedCRName, ProviderNamespace string
+
+	// now is used to get the current time, overridden in tests.
+	now func() metav1.Time
+}
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=usagerecords,verbs=get;list;watch;create;update
+
+func (r *UsageReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	var (
+		ctx    = context.Background()
+		result ctrl.Result
+		log    = r.Log.WithValues("tenantObj", req.NamespacedName)
+	)
+
+	// the CatalogEntry has the same name as the DerivedCustomResource
+	catalogEntry := &catalogv1alpha1.CatalogEntry{}
+	if err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+		Name:      r.DerivedCRName,
+		Namespace: r.ProviderNamespace,
+	}, catalogEntry); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+	if catalogEntry.Spec.Metering == nil || catalogEntry.Status.TenantCRD == nil {
+		return result, nil
+	}
+
+	openRecords, err := r.getOpenRecords(ctx, req.NamespacedName)
+	if err != nil {
+		return result, fmt.Errorf("getting open UsageRecords: %w", err)
+	}
+
+	tenantObj := r.newTenantObject()
+	err = r.Get(ctx, req.NamespacedName, tenantObj)
+	if err != nil && !errors.IsNotFound(err) {
+		return result, fmt.Errorf("getting %s: %w", r.TenantGVK.Kind, err)
+	}
+	if errors.IsNotFound(err) || !tenantObj.GetDeletionTimestamp().IsZero() {
+		// the instance is gone
+		endTime := r.now()
+		for i := range openRecords {
+			if err := r.endRecord(ctx, &openRecords[i], endTime); err != nil {
+				return result, err
+			}
+		}
+		return result, nil
+	}
+
+	var openRecord *catalogv1alpha1.UsageRecord
+	if len(openRecords) > 0 {
+		openRecord = &openRecords[len(openRecords)-1]
+		// a UsageRecord was started, but ending its predecessor failed
+		for i := range openRecords[:len(openRecords)-1] {
+			if err := r.endRecord(ctx, &openRecords[i], openRecord.Spec.StartTime); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	desiredRecord, err := r.buildDesiredRecord(ctx, catalogEntry, tenantObj, openRecord)
+	if err != nil {
+		return result, fmt.Errorf("building UsageRecord: %w", err)
+	}
+	if openRecord != nil {
+		if openRecord.Spec.Generation == desiredRecord.Spec.Generation &&
+			openRecord.Spec.Ready == desiredRecord.Spec.Ready &&
+			equality.Semantic.DeepEqual(openRecord.Spec.Dimensions, desiredRecord.Spec.Dimensions) {
+			// nothing changed
+			return result, nil
+		}
+
+		switch {
+		case openRecord.Spec.Ready == desiredRecord.Spec.Ready:
+			desiredRecord.Spec.Reason = catalogv1alpha1.UsageRecordReasonUpdated
+		case desiredRecord.Spec.Ready:
+			desiredRecord.Spec.Reason = catalogv1alpha1.UsageRecordReasonReady
+		default:
+			desiredRecord.Spec.Reason = catalogv1alpha1.UsageRecordReasonNotReady
+		}
+	}
+
+	// The new UsageRecord is started before the open one is ended,
+	// so a failed start leaves the open UsageRecord in place to be retried from.
+	if err := r.NamespacedClient.Create(ctx, desiredRecord); errors.IsAlreadyExists(err) {
+		// the UsageRecord was started already, but is not yet in the cache
+		result.Requeue = true
+		return result, nil
+	} else if err != nil {
+		return result, fmt.Errorf("creating UsageRecord: %w", err)
+	}
+	if openRecord != nil {
+		if err := r.endRecord(ctx, openRecord, desiredRecord.Spec.StartTime); err != nil {
+			return result, err
+		}
+	}
+	log.Info("started UsageRecord", "usageRecord", desiredRecord.Name, "reason", desiredRecord.Spec.Reason)
+	metering.UsageRecordsTotal.WithLabelValues(
+		desiredRecord.Spec.Offering.Name, string(desiredRecord.Spec.Reason)).Inc()
+	return result, nil
+}
+
+func (r *UsageReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.now == nil {
+		r.now = metav1.Now
+	}
+
+	c, err := controller.New("usage", mgr, controller.Options{
+		Reconciler: r,
+	})
+	if err != nil {
+		return fmt.Errorf("creating controller: %w", err)
+	}
+
+	// status changes are relevant, because of the ready state
+	return c.Watch(
+		&source.Kind{Type: r.newTenantObject()},
+		&handler.EnqueueRequestForObject{},
+	)
+}
+
+// getOpenRecords returns the open UsageRecords of the tenant object, ordered by their start time.
+// Normally there is at most one, the last one is the current UsageRecord.
+func (r *UsageReconciler) getOpenRecords(
+	ctx context.Context, tenantObj types.NamespacedName,
+) ([]catalogv1alpha1.UsageRecord, error) {
+	usageRecordList := &catalogv1alpha1.UsageRecordList{}
+	if err := r.NamespacedClient.List(ctx, usageRecordList,
+		client.InNamespace(r.ProviderNamespace),
+		client.MatchingLabels{catalogv1alpha1.UsageRecordOpenLabel: "true"},
+	); err != nil {
+		return nil, fmt.Errorf("listing UsageRecords: %w", err)
+	}
+	var openRecords []catalogv1alpha1.UsageRecord
+	for _, record := range usageRecordList.Items {
+		if record.IsOpen() &&
+			record.Spec.CatalogEntry.Name == r.DerivedCRName &&
+			record.Spec.Tenant.Name == tenantObj.Namespace &&
+			record.Spec.Instance.Name == tenantObj.Name {
+			openRecords = append(openRecords, record)
+		}
+	}
+	sort.Slice(openRecords, func(i, j int) bool {
+		if !openRecords[i].Spec.StartTime.Equal(&openRecords[j].Spec.StartTime) {
+			return openRecords[i].Spec.StartTime.Before(&openRecords[j].Spec.StartTime)
+		}
+		return openRecords[i].Name < openRecords[j].Name
+	})
+	return openRecords, nil
+}
+
+// buildDesiredRecord returns a new UsageRecord for the current state of the tenant object,
+// following the given previous UsageRecord, if any.
+func (r *UsageReconciler) buildDesiredRecord(
+	ctx context.Context, catalogEntry *catalogv1alpha1.CatalogEntry,
+	tenantObj *unstructured.Unstructured, previousRecord *catalogv1alpha1.UsageRecord,
+) (*catalogv1alpha1.UsageRecord, error) {
+	derivedCR := &catalogv1alpha1.DerivedCustomResource{}
+	if err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+		Name:      r.DerivedCRName,
+		Namespace: r.ProviderNamespace,
+	}, derivedCR); err != nil {
+		return nil, fmt.Errorf("getting DerivedCustomResource: %w", err)
+	}
+	version := r.ProviderGVK.Version
+	exposeConfig, ok := elevatorutil.VersionExposeConfigForVersion(derivedCR.Spec.Expose, version)
+	if !ok {
+		return nil, fmt.Errorf("missing version expose config for version %q", version)
+	}
+
+	// dimensions are defined on the provider object, like Quotas
+	providerView, err := elevatorutil.ProviderView(tenantObj, exposeConfig)
+	if err != nil {
+		return nil, err
+	}
+	dimensions, err := elevatorutil.Dimensions(providerView, catalogEntry.Spec.Metering.Dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous string
+	if previousRecord != nil {
+		previous = previousRecord.Name
+	}
+	// the Tenant namespace is named after the Tenant
+	tenant := tenantObj.GetNamespace()
+	return &catalogv1alpha1.UsageRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      usageRecordName(r.DerivedCRName, tenant, tenantObj.GetName(), tenantObj.GetUID(), previous),
+			Namespace: r.ProviderNamespace,
+			Labels: map[string]string{
+				catalogv1alpha1.UsageRecordOpenLabel: "true",
+			},
+		},
+		Spec: catalogv1alpha1.UsageRecordSpec{
+			Tenant:       catalogv1alpha1.ObjectReference{Name: tenant},
+			CatalogEntry: catalogv1alpha1.ObjectReference{Name: catalogEntry.Name},
+			Offering:     catalogv1alpha1.ObjectReference{Name: catalogEntry.Status.TenantCRD.Name},
+			Region:       catalogEntry.Status.TenantCRD.Region,
+			Instance:     catalogv1alpha1.ObjectReference{Name: tenantObj.GetName()},
+			Generation:   tenantObj.GetGeneration(),
+			Ready:        instance.IsReady(tenantObj),
+			Reason:       catalogv1alpha1.UsageRecordReasonCreated,
+			Dimensions:   dimensions,
+			StartTime:    r.now(),
+		},
+	}, nil
+}
+
+// endRecord ends the given UsageRecord at the given time.
+func (r *UsageReconciler) endRecord(ctx context.Context, record *catalogv1alpha1.UsageRecord, endTime metav1.Time) error {
+	record.Spec.EndTime = &endTime
+	delete(record.Labels, catalogv1alpha1.UsageRecordOpenLabel)
+	if err := r.NamespacedClient.Update(ctx, record); err != nil {
+		return fmt.Errorf("updating UsageRecord: %w", err)
+	}
+	return nil
+}
+
+func (r *UsageReconciler) newTenantObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(r.TenantGVK)
+	return obj
+}
+
+// usageRecordName returns the name for the UsageRecord of an instance following the previous UsageRecord.
+// Names are deterministic, so a UsageRecord that is started again from a stale cache already exists,
+// instead of being duplicated.
+func usageRecordName(catalogEntry, tenant, instance string, uid types.UID, previous string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s/%s", catalogEntry, tenant, instance, uid, previous)))
+	return fmt.Sprintf("%s.%s", instance, hex.EncodeToString(hash[:])[:12])
+}
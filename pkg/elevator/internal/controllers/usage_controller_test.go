@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+func TestUsageReconciler(t *testing.T) {
+	catalogEntry := &catalogv1alpha1.CatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dcr.Name,
+			Namespace: providerNamespace,
+		},
+		Spec: catalogv1alpha1.CatalogEntrySpec{
+			Metering: &catalogv1alpha1.MeteringConfig{
+				Dimensions: []catalogv1alpha1.MeteringDimension{
+					{Name: "storage", JSONPath: ".spec.test1"},
+				},
+			},
+		},
+		Status: catalogv1alpha1.CatalogEntryStatus{
+			TenantCRD: &catalogv1alpha1.CRDInformation{
+				Name:   "couchdbs.eu-west-1.team-a",
+				Region: catalogv1alpha1.ObjectReference{Name: "eu-west-1"},
+			},
+		},
+	}
+	tenantObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":       "test-1",
+				"namespace":  "another-namespace",
+				"generation": int64(1),
+			},
+			"spec": map[string]interface{}{
+				"test1": "1Gi",
+			},
+		},
+	}
+	tenantObj.SetGroupVersionKind(tenantGVK)
+
+	log := testutil.NewLogger(t)
+	c := fakeclient.NewFakeClientWithScheme(testScheme, dcr, catalogEntry, tenantObj)
+
+	startTime := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	r := UsageReconciler{
+		Client:           c,
+		Log:              log,
+		Scheme:           testScheme,
+		NamespacedClient: c,
+
+		ProviderGVK: providerGVK,
+		TenantGVK:   tenantGVK,
+
+		DerivedCRName:     dcr.Name,
+		ProviderNamespace: providerNamespace,
+
+		now: func() metav1.Time {
+			startTime = startTime.Add(time.Minute)
+			return metav1.NewTime(startTime)
+		},
+	}
+	ctx := context.Background()
+	reconcileTenantObj := func() {
+		_, err := r.Reconcile(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      tenantObj.GetName(),
+				Namespace: tenantObj.GetNamespace(),
+			},
+		})
+		require.NoError(t, err)
+	}
+	listUsageRecords := func(opts ...client.ListOption) []catalogv1alpha1.UsageRecord {
+		usageRecordList := &catalogv1alpha1.UsageRecordList{}
+		require.NoError(t, c.List(ctx, usageRecordList, append(opts, client.InNamespace(providerNamespace))...))
+		return usageRecordList.Items
+	}
+
+	t.Run("create", func(t *testing.T) {
+		reconcileTenantObj()
+
+		records := listUsageRecords()
+		require.Len(t, records, 1)
+		record := records[0]
+		assert.True(t, record.IsOpen())
+		assert.Equal(t, "another-namespace", record.Spec.Tenant.Name)
+		assert.Equal(t, dcr.Name, record.Spec.CatalogEntry.Name)
+		assert.Equal(t, "couchdbs.eu-west-1.team-a", record.Spec.Offering.Name)
+		assert.Equal(t, "eu-west-1", record.Spec.Region.Name)
+		assert.Equal(t, "test-1", record.Spec.Instance.Name)
+		assert.Equal(t, catalogv1alpha1.UsageRecordReasonCreated, record.Spec.Reason)
+		assert.False(t, record.Spec.Ready)
+		require.Len(t, record.Spec.Dimensions, 1)
+		assert.Equal(t, "storage", record.Spec.Dimensions[0].Name)
+		assert.Equal(t, "1Gi", record.Spec.Dimensions[0].Value.String())
+
+		// reconciling again is a noop
+		reconcileTenantObj()
+		assert.Len(t, listUsageRecords(), 1)
+
+		// starting the record again from a stale cache yields the same name
+		desiredRecord, err := r.buildDesiredRecord(ctx, catalogEntry, tenantObj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, record.Name, desiredRecord.Name)
+	})
+
+	t.Run("ready", func(t *testing.T) {
+		require.NoError(t, c.Get(ctx, types.NamespacedName{
+			Name:      tenantObj.GetName(),
+			Namespace: tenantObj.GetNamespace(),
+		}, tenantObj))
+		require.NoError(t, unstructured.SetNestedField(tenantObj.Object, "Ready", "status", "phase"))
+		require.NoError(t, c.Update(ctx, tenantObj))
+		reconcileTenantObj()
+
+		assert.Len(t, listUsageRecords(), 2)
+		openRecords := listUsageRecords(client.MatchingLabels{catalogv1alpha1.UsageRecordOpenLabel: "true"})
+		require.Len(t, openRecords, 1)
+		assert.True(t, openRecords[0].Spec.Ready)
+		assert.Equal(t, catalogv1alpha1.UsageRecordReasonReady, openRecords[0].Spec.Reason)
+	})
+
+	t.Run("failed start", func(t *testing.T) {
+		require.NoError(t, c.Get(ctx, types.NamespacedName{
+			Name:      tenantObj.GetName(),
+			Namespace: tenantObj.GetNamespace(),
+		}, tenantObj))
+		require.NoError(t, unstructured.SetNestedField(tenantObj.Object, "2Gi", "spec", "test1"))
+		require.NoError(t, c.Update(ctx, tenantObj))
+		previousRecords := listUsageRecords(client.MatchingLabels{catalogv1alpha1.UsageRecordOpenLabel: "true"})
+		require.Len(t, previousRecords, 1)
+
+		failingClient := &failingUsageClient{Client: c, failCreates: 1}
+		r.NamespacedClient = failingClient
+		defer func() { r.NamespacedClient = c }()
+		_, err := r.Reconcile(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      tenantObj.GetName(),
+				Namespace: tenantObj.GetNamespace(),
+			},
+		})
+		require.Error(t, err)
+		assert.Len(t, listUsageRecords(), 2)
+		openRecords := listUsageRecords(client.MatchingLabels{catalogv1alpha1.UsageRecordOpenLabel: "true"})
+		require.Len(t, openRecords, 1, "the open record should be kept, when the next one can't be started")
+		assert.Equal(t, previousRecords[0].Name, openRecords[0].Name)
+
+		// ending the open record fails after the next one is started
+		failingClient.failUpdates = 1
+		_, err = r.Reconcile(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      tenantObj.GetName(),
+				Namespace: tenantObj.GetNamespace(),
+			},
+		})
+		require.Error(t, err)
+		assert.Len(t, listUsageRecords(), 3)
+		assert.Len(t, listUsageRecords(client.MatchingLabels{catalogv1alpha1.UsageRecordOpenLabel: "true"}), 2)
+
+		reconcileTenantObj()
+		records := listUsageRecords()
+		assert.Len(t, records, 3)
+		openRecords = listUsageRecords(client.MatchingLabels{catalogv1alpha1.UsageRecordOpenLabel: "true"})
+		require.Len(t, openRecords, 1)
+		assert.Equal(t, catalogv1alpha1.UsageRecordReasonUpdated, openRecords[0].Spec.Reason)
+		assert.Equal(t, "2Gi", openRecords[0].Spec.Dimensions[0].Value.String())
+		for _, record := range records {
+			if record.Name == previousRecords[0].Name {
+				require.NotNil(t, record.Spec.EndTime)
+				assert.True(t, record.Spec.EndTime.Equal(&openRecords[0].Spec.StartTime),
+					"the previous record should end when the next one starts")
+			}
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, c.Delete(ctx, tenantObj))
+		reconcileTenantObj()
+
+		records := listUsageRecords()
+		require.Len(t, records, 3)
+		for _, record := range records {
+			assert.False(t, record.IsOpen(), "record %s should be ended", record.Name)
+		}
+	})
+}
+
+// failingUsageClient fails the given number of Create and Update calls.
+type failingUsageClient struct {
+	client.Client
+	failCreates, failUpdates int
+}
+
+func (c *failingUsageClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	if c.failCreates > 0 {
+		c.failCreates--
+		return fmt.Errorf("injected create failure")
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *failingUsageClient) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	if c.failUpdates > 0 {
+		c.failUpdates--
+		return fmt.Errorf("injected update failure")
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
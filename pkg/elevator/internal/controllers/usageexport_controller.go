@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"k8c.io/utils/pkg/util"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/elevator/internal/metering"
+)
+
+// UsageExportReconciler exports the started and ended events of UsageRecords to the metering sink of the CatalogEntry.
+// Exported events are recorded in the UsageRecord status, failed exports are retried.
+type UsageExportReconciler struct {
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	NamespacedClient client.Client
+	// NamespacedCache is used to watch UsageRecords in the provider namespace.
+	NamespacedCache cache.Cache
+
+	DerivedCRName, ProviderNamespace string
+
+	// sink is reused, until the sink url of the CatalogEntry changes.
+	sinkMu  sync.Mutex
+	sinkURL string
+	sink    metering.Sink
+}
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=usagerecords/status,verbs=get;update;patch
+
+func (r *UsageExportReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	var (
+		ctx    = context.Background()
+		result ctrl.Result
+	)
+
+	record := &catalogv1alpha1.UsageRecord{}
+	if err := r.NamespacedClient.Get(ctx, req.NamespacedName, record); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+	if record.IsExported() {
+		return result, nil
+	}
+
+	// the CatalogEntry has the same name as the DerivedCustomResource
+	catalogEntry := &catalogv1alpha1.CatalogEntry{}
+	if err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+		Name:      r.DerivedCRName,
+		Namespace: r.ProviderNamespace,
+	}, catalogEntry); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+	if catalogEntry.Spec.Metering == nil || catalogEntry.Spec.Metering.Sink == "" {
+		return result, nil
+	}
+
+	sink, err := r.getSink(catalogEntry.Spec.Metering.Sink)
+	if err != nil {
+		metering.ExportErrorsTotal.WithLabelValues(record.Spec.Offering.Name).Inc()
+		return result, fmt.Errorf("creating sink: %w", err)
+	}
+
+	if !record.Status.StartedExported {
+		if err := r.export(ctx, sink, metering.EventTypeStarted, record); err != nil {
+			return result, err
+		}
+		record.Status.StartedExported = true
+	}
+	if !record.IsOpen() && !record.Status.EndedExported {
+		if err := r.export(ctx, sink, metering.EventTypeEnded, record); err != nil {
+			// persist the started event, so it's not exported again
+			if updateErr := r.NamespacedClient.Status().Update(ctx, record); updateErr != nil {
+				return result, fmt.Errorf("updating UsageRecord status: %w", updateErr)
+			}
+			return result, err
+		}
+		record.Status.EndedExported = true
+	}
+
+	if err := r.NamespacedClient.Status().Update(ctx, record); err != nil {
+		return result, fmt.Errorf("updating UsageRecord status: %w", err)
+	}
+	return result, nil
+}
+
+func (r *UsageExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	c, err := controller.New("usage-export", mgr, controller.Options{
+		Reconciler: r,
+	})
+	if err != nil {
+		return fmt.Errorf("creating controller: %w", err)
+	}
+
+	return c.Watch(
+		source.NewKindWithCache(&catalogv1alpha1.UsageRecord{}, r.NamespacedCache),
+		&handler.EnqueueRequestForObject{},
+		util.PredicateFn(func(obj runtime.Object) bool {
+			record, ok := obj.(*catalogv1alpha1.UsageRecord)
+			return ok && record.Spec.CatalogEntry.Name == r.DerivedCRName
+		}),
+	)
+}
+
+// getSink returns the Sink for the given url, reusing the previous Sink if the url did not change.
+func (r *UsageExportReconciler) getSink(sinkURL string) (metering.Sink, error) {
+	r.sinkMu.Lock()
+	defer r.sinkMu.Unlock()
+
+	if r.sink != nil && r.sinkURL == sinkURL {
+		return r.sink, nil
+	}
+	sink, err := metering.NewSink(sinkURL)
+	if err != nil {
+		return nil, err
+	}
+	r.sink, r.sinkURL = sink, sinkURL
+	return sink, nil
+}
+
+// export sends the usage event of the UsageRecord to the sink.
+func (r *UsageExportReconciler) export(
+	ctx context.Context, sink metering.Sink,
+	eventType string, record *catalogv1alpha1.UsageRecord,
+) error {
+	if err := sink.Send(ctx, metering.NewEvent(eventType, record)); err != nil {
+		metering.ExportErrorsTotal.WithLabelValues(record.Spec.Offering.Name).Inc()
+		return fmt.Errorf("exporting %s event: %w", eventType, err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/elevator/internal/metering"
+)
+
+type fakeSink struct {
+	err    error
+	events []string
+}
+
+func (s *fakeSink) Send(ctx context.Context, event metering.Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event.Type)
+	return nil
+}
+
+func TestUsageExportReconciler(t *testing.T) {
+	const sinkURL = "http://metering.example.com"
+	catalogEntry := &catalogv1alpha1.CatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dcr.Name,
+			Namespace: providerNamespace,
+		},
+		Spec: catalogv1alpha1.CatalogEntrySpec{
+			Metering: &catalogv1alpha1.MeteringConfig{
+				Sink: sinkURL,
+			},
+		},
+	}
+	endTime := metav1.NewTime(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+	usageRecord := &catalogv1alpha1.UsageRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-1.123456789abc",
+			Namespace: providerNamespace,
+		},
+		Spec: catalogv1alpha1.UsageRecordSpec{
+			CatalogEntry: catalogv1alpha1.ObjectReference{Name: dcr.Name},
+			Offering:     catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1.team-a"},
+			EndTime:      &endTime,
+		},
+	}
+
+	c := fakeclient.NewFakeClientWithScheme(testScheme, catalogEntry, usageRecord)
+	sink := &fakeSink{err: fmt.Errorf("unavailable")}
+	r := UsageExportReconciler{
+		Log:              testutil.NewLogger(t),
+		Scheme:           testScheme,
+		NamespacedClient: c,
+
+		DerivedCRName:     dcr.Name,
+		ProviderNamespace: providerNamespace,
+
+		sinkURL: sinkURL,
+		sink:    sink,
+	}
+	ctx := context.Background()
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      usageRecord.Name,
+			Namespace: usageRecord.Namespace,
+		},
+	}
+
+	t.Run("failed export", func(t *testing.T) {
+		_, err := r.Reconcile(req)
+		assert.Error(t, err)
+
+		require.NoError(t, c.Get(ctx, req.NamespacedName, usageRecord))
+		assert.False(t, usageRecord.Status.StartedExported)
+		assert.False(t, usageRecord.Status.EndedExported)
+	})
+
+	t.Run("export", func(t *testing.T) {
+		sink.err = nil
+		_, err := r.Reconcile(req)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Get(ctx, req.NamespacedName, usageRecord))
+		assert.True(t, usageRecord.IsExported())
+		assert.Equal(t, []string{
+			metering.EventTypeStarted,
+			metering.EventTypeEnded,
+		}, sink.events)
+
+		// exported events are not sent again
+		_, err = r.Reconcile(req)
+		require.NoError(t, err)
+		assert.Len(t, sink.events, 2)
+	})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metering
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// UsageRecordsTotal counts the UsageRecords started per Offering.
+	UsageRecordsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubecarrier_usage_records_total",
+		Help: "Total number of UsageRecords started per Offering",
+	}, []string{"offering", "reason"})
+
+	// ExportErrorsTotal counts the usage events that could not be exported per Offering.
+	ExportErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubecarrier_usage_export_errors_total",
+		Help: "Total number of usage events that could not be exported per Offering",
+	}, []string{"offering"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(UsageRecordsTotal, ExportErrorsTotal)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metering exports usage events of UsageRecords as CloudEvents.
+package metering
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+const (
+	// EventTypeStarted is emitted, when a UsageRecord is started.
+	EventTypeStarted = "io.kubecarrier.usagerecord.started"
+	// EventTypeEnded is emitted, when a UsageRecord is ended.
+	EventTypeEnded = "io.kubecarrier.usagerecord.ended"
+
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsContentType = "application/cloudevents+json; charset=UTF-8"
+)
+
+// Event is a CloudEvent in structured content mode.
+type Event struct {
+	SpecVersion     string                          `json:"specversion"`
+	ID              string                          `json:"id"`
+	Source          string                          `json:"source"`
+	Type            string                          `json:"type"`
+	Subject         string                          `json:"subject"`
+	Time            time.Time                       `json:"time"`
+	DataContentType string                          `json:"datacontenttype"`
+	Data            catalogv1alpha1.UsageRecordSpec `json:"data"`
+}
+
+// NewEvent returns the Event of the given type for the UsageRecord.
+func NewEvent(eventType string, record *catalogv1alpha1.UsageRecord) Event {
+	eventTime := record.Spec.StartTime.Time
+	if eventType == EventTypeEnded && record.Spec.EndTime != nil {
+		eventTime = record.Spec.EndTime.Time
+	}
+	return Event{
+		SpecVersion: cloudEventsSpecVersion,
+		// record names are unique and every record is started and ended only once
+		ID:              record.Namespace + "/" + record.Name + "/" + eventType,
+		Source:          path.Join("/namespaces", record.Namespace, "catalogentries", record.Spec.CatalogEntry.Name),
+		Type:            eventType,
+		Subject:         record.Name,
+		Time:            eventTime.UTC(),
+		DataContentType: "application/json",
+		Data:            record.Spec,
+	}
+}
+
+// Sink receives usage events.
+type Sink interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// NewSink returns the Sink for the given URL.
+func NewSink(sinkURL string) (Sink, error) {
+	u, err := url.Parse(sinkURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing sink url: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return &httpSink{
+			url:    u.String(),
+			client: &http.Client{Timeout: 10 * time.Second},
+		}, nil
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("file sink requires a path")
+		}
+		return &fileSink{path: u.Path}, nil
+	default:
+		return nil, fmt.Errorf("unsupported sink scheme %q, should be one of http, https, file", u.Scheme)
+	}
+}
+
+// httpSink sends events as structured CloudEvents over HTTP.
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *httpSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshalling event: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", cloudEventsContentType)
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("sending event: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = ioutil.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sending event: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// fileMutex serializes writes of all file sinks.
+var fileMutex sync.Mutex
+
+// fileSink appends events to a file, one JSON encoded event per line.
+type fileSink struct {
+	path string
+}
+
+func (s *fileSink) Send(ctx context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshalling event: %w", err)
+	}
+
+	fileMutex.Lock()
+	defer fileMutex.Unlock()
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening file: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing event: %w", err)
+	}
+	return f.Close()
+}
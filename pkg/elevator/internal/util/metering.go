@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+)
+
+// Dimensions returns the values of the metering dimensions of the provider object.
+// Dimensions that are not set on the object are skipped.
+func Dimensions(
+	providerObj *unstructured.Unstructured,
+	dimensions []catalogv1alpha1.MeteringDimension) ([]catalogv1alpha1.UsageDimension, error) {
+	var values []catalogv1alpha1.UsageDimension
+	for _, dimension := range dimensions {
+		path, err := fieldpath.Parse(dimension.JSONPath)
+		if err != nil {
+			return nil, fmt.Errorf("dimension %s: %w", dimension.Name, err)
+		}
+		quantity, found, err := fieldQuantity(providerObj.Object, path)
+		if err != nil {
+			return nil, fmt.Errorf("dimension %s: %s: %w", dimension.Name, dimension.JSONPath, err)
+		}
+		if !found {
+			continue
+		}
+		values = append(values, catalogv1alpha1.UsageDimension{
+			Name:  dimension.Name,
+			Value: quantity,
+		})
+	}
+	return values, nil
+}
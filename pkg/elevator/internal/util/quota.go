@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+)
+
+// ProviderView returns the tenant object as it would be created on the provider side,
+// so Quotas are calculated on the same fields, including defaults, regardless of field renaming.
+func ProviderView(
+	tenantObj *unstructured.Unstructured,
+	config catalogv1alpha1.VersionExposeConfig) (*unstructured.Unstructured, error) {
+	_, otherFields := SplitStatusFields(config.Fields)
+
+	providerObj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := CopyFieldsToProvider(tenantObj, providerObj, otherFields); err != nil {
+		return nil, fmt.Errorf("copy fields: %w", err)
+	}
+	if err := ApplyDefaults(providerObj, config.Defaults); err != nil {
+		return nil, fmt.Errorf("apply defaults: %w", err)
+	}
+	return providerObj, nil
+}
+
+// CalculateUsage sums up the usage of the given provider objects for the limited fields.
+func CalculateUsage(
+	providerObjs []unstructured.Unstructured,
+	limit catalogv1alpha1.CatalogEntryLimit) (catalogv1alpha1.QuotaUsage, error) {
+	usage := catalogv1alpha1.QuotaUsage{
+		CatalogEntry: limit.CatalogEntry,
+		Instances:    int64(len(providerObjs)),
+	}
+
+	for _, fieldLimit := range limit.Fields {
+		path, err := fieldpath.Parse(fieldLimit.JSONPath)
+		if err != nil {
+			return usage, err
+		}
+
+		used := *resource.NewQuantity(0, fieldLimit.Max.Format)
+		for _, providerObj := range providerObjs {
+			quantity, found, err := fieldQuantity(providerObj.Object, path)
+			if err != nil {
+				return usage, fmt.Errorf("%s: %w", fieldLimit.JSONPath, err)
+			}
+			if found {
+				used.Add(quantity)
+			}
+		}
+		usage.Fields = append(usage.Fields, catalogv1alpha1.FieldUsage{
+			JSONPath: fieldLimit.JSONPath,
+			Used:     used,
+		})
+	}
+	return usage, nil
+}
+
+// CheckQuota returns an error, if the new usage exceeds the limit.
+// Usage that already exceeds the limit (e.g. after the Quota was lowered) is tolerated, as long as it does not grow.
+func CheckQuota(
+	limit catalogv1alpha1.CatalogEntryLimit,
+	oldUsage, newUsage catalogv1alpha1.QuotaUsage) error {
+	var exceeded []string
+	if limit.MaxInstances != nil &&
+		newUsage.Instances > *limit.MaxInstances &&
+		newUsage.Instances > oldUsage.Instances {
+		exceeded = append(exceeded, fmt.Sprintf(
+			"instances: %d exceeds limit of %d", newUsage.Instances, *limit.MaxInstances))
+	}
+
+	for i, fieldLimit := range limit.Fields {
+		// usage is calculated in the same order as the limits
+		newUsed, oldUsed := newUsage.Fields[i].Used, oldUsage.Fields[i].Used
+		if newUsed.Cmp(fieldLimit.Max) > 0 && newUsed.Cmp(oldUsed) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf(
+				"%s: %s exceeds limit of %s", fieldLimit.JSONPath, newUsed.String(), fieldLimit.Max.String()))
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return fmt.Errorf("%s", strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+// fieldQuantity returns the value of a numeric field.
+func fieldQuantity(obj map[string]interface{}, path fieldpath.Path) (quantity resource.Quantity, found bool, err error) {
+	value, found, err := fieldpath.Get(obj, path)
+	if err != nil || !found || value == nil {
+		return quantity, false, err
+	}
+	quantity, err = toQuantity(value)
+	return quantity, err == nil, err
+}
+
+func toQuantity(value interface{}) (resource.Quantity, error) {
+	switch v := value.(type) {
+	case int64:
+		return *resource.NewQuantity(v, resource.DecimalSI), nil
+	case int:
+		return *resource.NewQuantity(int64(v), resource.DecimalSI), nil
+	case float64:
+		return resource.ParseQuantity(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		return resource.ParseQuantity(v)
+	default:
+		return resource.Quantity{}, fmt.Errorf("expected number or quantity, got %T", value)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+func TestProviderView(t *testing.T) {
+	tenantObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"size":     int64(3),
+				"internal": "ignored",
+			},
+		},
+	}
+	providerObj, err := ProviderView(tenantObj, catalogv1alpha1.VersionExposeConfig{
+		Fields: []catalogv1alpha1.FieldPath{
+			{JSONPath: ".spec.replicas", TenantPath: ".spec.size"},
+			{JSONPath: ".status.phase"},
+		},
+		Defaults: []catalogv1alpha1.FieldDefault{
+			{JSONPath: ".spec.storage", Value: apiextensionsv1.JSON{Raw: []byte(`"1Gi"`)}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"storage":  "1Gi",
+		},
+	}, providerObj.Object)
+}
+
+func TestCalculateUsage(t *testing.T) {
+	providerObjs := []unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"storage": "1Gi", "replicas": int64(3)},
+		}},
+		{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"storage": "512Mi", "replicas": 1.5},
+		}},
+		{Object: map[string]interface{}{
+			"spec": map[string]interface{}{},
+		}},
+	}
+
+	t.Run("sums up fields", func(t *testing.T) {
+		usage, err := CalculateUsage(providerObjs, catalogv1alpha1.CatalogEntryLimit{
+			CatalogEntry: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"},
+			Fields: []catalogv1alpha1.FieldLimit{
+				{JSONPath: ".spec.storage", Max: resource.MustParse("10Gi")},
+				{JSONPath: ".spec.replicas", Max: resource.MustParse("10")},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "couchdbs.eu-west-1", usage.CatalogEntry.Name)
+		assert.Equal(t, int64(3), usage.Instances)
+		require.Len(t, usage.Fields, 2)
+		assert.Equal(t, "1536Mi", usage.Fields[0].Used.String())
+		assert.Equal(t, "4500m", usage.Fields[1].Used.String())
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		_, err := CalculateUsage([]unstructured.Unstructured{
+			{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"storage": true},
+			}},
+		}, catalogv1alpha1.CatalogEntryLimit{
+			Fields: []catalogv1alpha1.FieldLimit{
+				{JSONPath: ".spec.storage", Max: resource.MustParse("10Gi")},
+			},
+		})
+		assert.EqualError(t, err, ".spec.storage: expected number or quantity, got bool")
+	})
+}
+
+func TestCheckQuota(t *testing.T) {
+	maxInstances := int64(2)
+	limit := catalogv1alpha1.CatalogEntryLimit{
+		MaxInstances: &maxInstances,
+		Fields: []catalogv1alpha1.FieldLimit{
+			{JSONPath: ".spec.storage", Max: resource.MustParse("10Gi")},
+		},
+	}
+	usage := func(instances int64, storage string) catalogv1alpha1.QuotaUsage {
+		return catalogv1alpha1.QuotaUsage{
+			Instances: instances,
+			Fields: []catalogv1alpha1.FieldUsage{
+				{JSONPath: ".spec.storage", Used: resource.MustParse(storage)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		oldUsage, newUsage catalogv1alpha1.QuotaUsage
+		expectedError      string
+	}{
+		{
+			name:     "within limits",
+			oldUsage: usage(1, "5Gi"),
+			newUsage: usage(2, "10Gi"),
+		},
+		{
+			name:          "too many instances",
+			oldUsage:      usage(2, "5Gi"),
+			newUsage:      usage(3, "6Gi"),
+			expectedError: "instances: 3 exceeds limit of 2",
+		},
+		{
+			name:          "too much storage",
+			oldUsage:      usage(1, "5Gi"),
+			newUsage:      usage(2, "11Gi"),
+			expectedError: ".spec.storage: 11Gi exceeds limit of 10Gi",
+		},
+		{
+			name:     "already exceeding, but not growing",
+			oldUsage: usage(3, "20Gi"),
+			newUsage: usage(3, "15Gi"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckQuota(limit, test.oldUsage, test.newUsage)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -18,11 +18,12 @@ package util
 
 import (
 	"fmt"
-	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/json"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
 )
 
 func SplitStatusFields(fields []catalogv1alpha1.FieldPath) (
@@ -30,40 +31,119 @@ func SplitStatusFields(fields []catalogv1alpha1.FieldPath) (
 	otherFields []catalogv1alpha1.FieldPath,
 ) {
 	for _, field := range fields {
-		path := strings.Trim(field.JSONPath, ".")
-		if path == "status" || strings.HasPrefix(path, "status.") {
+		path, err := fieldpath.Parse(field.JSONPath)
+		if err == nil &&
+			path[0].Type == fieldpath.FieldSegment && path[0].Field == "status" {
 			statusFields = append(statusFields, field)
 			continue
 		}
 
+		// invalid paths are reported by CopyFields
 		otherFields = append(otherFields, field)
 	}
 	return
 }
 
-func CopyFields(
-	src, dest *unstructured.Unstructured,
+// CopyFieldsToProvider copies the exposed fields from the tenant object to the provider object.
+func CopyFieldsToProvider(
+	tenantObj, providerObj *unstructured.Unstructured,
 	fields []catalogv1alpha1.FieldPath) error {
 
 	for _, field := range fields {
-		path := strings.Trim(field.JSONPath, ".") // trim trailing and leading dots
-		fields := strings.Split(path, ".")
-		value, exists, err := unstructured.NestedFieldCopy(src.Object, fields...)
+		tenantPath, providerPath, err := parseFieldPaths(field)
 		if err != nil {
-			return fmt.Errorf("lookup path in %s: %w", src.GetKind(), err)
+			return err
 		}
+		if err := copyField(tenantObj, providerObj, tenantPath, providerPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		if !exists {
+// CopyFieldsToTenant copies the exposed fields from the provider object to the tenant object.
+func CopyFieldsToTenant(
+	providerObj, tenantObj *unstructured.Unstructured,
+	fields []catalogv1alpha1.FieldPath) error {
+
+	for _, field := range fields {
+		tenantPath, providerPath, err := parseFieldPaths(field)
+		if err != nil {
+			return err
+		}
+		if err := copyField(providerObj, tenantObj, providerPath, tenantPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyDefaults sets the default values on the provider object, if the fields are not already set.
+func ApplyDefaults(
+	providerObj *unstructured.Unstructured,
+	defaults []catalogv1alpha1.FieldDefault) error {
+
+	for _, fieldDefault := range defaults {
+		path, err := fieldpath.Parse(fieldDefault.JSONPath)
+		if err != nil {
+			return err
+		}
+		_, found, err := fieldpath.Get(providerObj.Object, path)
+		if err != nil {
+			return fmt.Errorf("default path %s of %s: %w", path, providerObj.GetKind(), err)
+		}
+		if found {
 			continue
 		}
 
-		if err := unstructured.SetNestedField(dest.Object, value, fields...); err != nil {
-			return fmt.Errorf("update path in %s: %w", dest.GetKind(), err)
+		var value interface{}
+		if err := json.Unmarshal(fieldDefault.Value.Raw, &value); err != nil {
+			return fmt.Errorf("default value of %s: %w", path, err)
+		}
+		if err := fieldpath.Set(providerObj.Object, path, value); err != nil {
+			return fmt.Errorf("default path %s of %s: %w", path, providerObj.GetKind(), err)
 		}
 	}
 	return nil
 }
 
+// parseFieldPaths returns the path of the field in the tenant and in the provider object.
+func parseFieldPaths(field catalogv1alpha1.FieldPath) (tenantPath, providerPath fieldpath.Path, err error) {
+	providerPath, err = fieldpath.Parse(field.JSONPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if field.TenantPath == "" {
+		return providerPath, providerPath, nil
+	}
+	tenantPath, err = fieldpath.Parse(field.TenantPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tenantPath, providerPath, nil
+}
+
+func copyField(src, dest *unstructured.Unstructured, srcPath, destPath fieldpath.Path) error {
+	if srcPath.String() == destPath.String() {
+		if err := fieldpath.Copy(src.Object, dest.Object, srcPath); err != nil {
+			return fmt.Errorf("copy path from %s to %s: %w", src.GetKind(), dest.GetKind(), err)
+		}
+		return nil
+	}
+
+	value, found, err := fieldpath.Get(src.Object, srcPath)
+	if err != nil {
+		return fmt.Errorf("copy path %s from %s: %w", srcPath, src.GetKind(), err)
+	}
+	if !found {
+		return nil
+	}
+	if err := fieldpath.Set(dest.Object, destPath, value); err != nil {
+		return fmt.Errorf("copy path %s to %s: %w", destPath, dest.GetKind(), err)
+	}
+	return nil
+}
+
 func VersionExposeConfigForVersion(
 	exposeConfigs []catalogv1alpha1.VersionExposeConfig, version string,
 ) (catalogv1alpha1.VersionExposeConfig, bool) {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
@@ -123,11 +124,45 @@ func Test_copyFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "arrays",
+			src: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"image": "nginx", "name": "web"},
+						},
+					},
+					"status": map[string]interface{}{
+						"endpoints": []interface{}{
+							map[string]interface{}{"host": "a.example.com", "port": int64(80)},
+						},
+					},
+				},
+			},
+			dest: &unstructured.Unstructured{Object: map[string]interface{}{}},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers[*].image"},
+				{JSONPath: ".status.endpoints[0].host"},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"image": "nginx"},
+					},
+				},
+				"status": map[string]interface{}{
+					"endpoints": []interface{}{
+						map[string]interface{}{"host": "a.example.com"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := CopyFields(test.src, test.dest, test.fields)
+			err := CopyFieldsToProvider(test.src, test.dest, test.fields)
 			require.NoError(t, err)
 
 			assert.Equal(
@@ -135,3 +170,76 @@ func Test_copyFields(t *testing.T) {
 		})
 	}
 }
+
+func Test_copyFieldsRenamed(t *testing.T) {
+	fields := []catalogv1alpha1.FieldPath{
+		{JSONPath: ".spec.size", TenantPath: ".spec.storageGB"},
+		{JSONPath: ".spec.engine"},
+		{JSONPath: ".status.endpoints[0].host", TenantPath: ".status.host"},
+	}
+	tenantObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"storageGB": int64(10),
+				"engine":    "couchdb",
+			},
+		},
+	}
+	providerObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": int64(3),
+			},
+			"status": map[string]interface{}{
+				"endpoints": []interface{}{
+					map[string]interface{}{"host": "db.example.com", "port": int64(5984)},
+				},
+			},
+		},
+	}
+
+	statusFields, otherFields := SplitStatusFields(fields)
+	require.NoError(t, CopyFieldsToProvider(tenantObj, providerObj, otherFields))
+	assert.Equal(t, map[string]interface{}{
+		"replicas": int64(3),
+		"size":     int64(10),
+		"engine":   "couchdb",
+	}, providerObj.Object["spec"])
+
+	require.NoError(t, CopyFieldsToTenant(providerObj, tenantObj, statusFields))
+	assert.Equal(t, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"storageGB": int64(10),
+			"engine":    "couchdb",
+		},
+		"status": map[string]interface{}{
+			"host": "db.example.com",
+		},
+	}, tenantObj.Object)
+}
+
+func Test_applyDefaults(t *testing.T) {
+	providerObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": int64(5),
+			},
+		},
+	}
+	defaults := []catalogv1alpha1.FieldDefault{
+		{JSONPath: ".spec.replicas", Value: apiextensionsv1.JSON{Raw: []byte(`3`)}},
+		{JSONPath: ".spec.storageClass", Value: apiextensionsv1.JSON{Raw: []byte(`"fast"`)}},
+		{JSONPath: ".spec.storageGB", Value: apiextensionsv1.JSON{Raw: []byte(`10`)}},
+		{JSONPath: ".spec.backup", Value: apiextensionsv1.JSON{Raw: []byte(`{"enabled":true}`)}},
+	}
+
+	require.NoError(t, ApplyDefaults(providerObj, defaults))
+	assert.Equal(t, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas":     int64(5),
+			"storageClass": "fast",
+			"storageGB":    int64(10),
+			"backup":       map[string]interface{}{"enabled": true},
+		},
+	}, providerObj.Object)
+}
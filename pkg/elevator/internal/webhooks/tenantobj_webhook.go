@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
+	adminv1beta1 "k8s.io/api/admission/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -33,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 	elevatorutil "k8c.io/kubecarrier/pkg/elevator/internal/util"
 )
 
@@ -55,6 +58,8 @@ type TenantObjWebhookHandler struct {
 
 var _ admission.Handler = (*TenantObjWebhookHandler)(nil)
 
+// +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusters,verbs=get;list;watch
+
 // Handle is the function to handle TenantObjs validating requests.
 func (r *TenantObjWebhookHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
 	obj := &unstructured.Unstructured{}
@@ -97,6 +102,28 @@ func (r *TenantObjWebhookHandler) Handle(ctx context.Context, req admission.Requ
 		return admission.Errored(http.StatusInternalServerError,
 			fmt.Errorf("DerivedCustomResource object is missing version expose config for version %q", version))
 	}
+	// Retired Offerings and ServiceClusters in maintenance don't accept new instances, existing instances keep running.
+	if req.Operation == adminv1beta1.Create {
+		// the DerivedCustomResource is named after its CatalogEntry
+		catalogEntry := &catalogv1alpha1.CatalogEntry{}
+		if err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+			Name:      r.DerivedCRName,
+			Namespace: r.ProviderNamespace,
+		}, catalogEntry); err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("getting the CatalogEntry: %w", err))
+		}
+		if resp, denied := r.checkLifecycle(catalogEntry); denied {
+			return resp
+		}
+		if resp, denied := r.checkMaintenance(ctx, catalogEntry); denied {
+			return resp
+		}
+	}
+	// Enforce the Quotas of the Tenant
+	if resp, denied := r.checkQuotas(ctx, obj, exposeConfig); denied {
+		return resp
+	}
+
 	// prepare config
 	_, otherFields := elevatorutil.SplitStatusFields(exposeConfig.Fields)
 
@@ -147,15 +174,18 @@ func (r *TenantObjWebhookHandler) Handle(ctx context.Context, req admission.Requ
 
 	if errors.IsNotFound(err) {
 		r.Log.Info("validate create", "name", obj.GetName())
-		if err := elevatorutil.CopyFields(obj, providerObj, otherFields); err != nil {
+		if err := elevatorutil.CopyFieldsToProvider(obj, providerObj, otherFields); err != nil {
 			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("copy fields: %w", err))
 		}
+		if err := elevatorutil.ApplyDefaults(providerObj, exposeConfig.Defaults); err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("apply defaults: %w", err))
+		}
 		if err := r.Create(ctx, providerObj, client.DryRunAll); err != nil {
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
 	} else {
 		r.Log.Info("validate update", "name", obj.GetName())
-		if err := elevatorutil.CopyFields(obj, providerObj, otherFields); err != nil {
+		if err := elevatorutil.CopyFieldsToProvider(obj, providerObj, otherFields); err != nil {
 			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("copy fields: %w", err))
 		}
 		if err := r.Update(ctx, providerObj, client.DryRunAll); err != nil {
@@ -164,7 +194,7 @@ func (r *TenantObjWebhookHandler) Handle(ctx context.Context, req admission.Requ
 	}
 
 	newObj := obj.DeepCopy()
-	if err := elevatorutil.CopyFields(providerObj, newObj, otherFields); err != nil {
+	if err := elevatorutil.CopyFieldsToTenant(providerObj, newObj, otherFields); err != nil {
 		return admission.Errored(http.StatusInternalServerError,
 			fmt.Errorf("changing %s .fields back: %w", r.ProviderGVK.Kind, err))
 	}
@@ -177,6 +207,126 @@ func (r *TenantObjWebhookHandler) Handle(ctx context.Context, req admission.Requ
 	return admission.PatchResponseFromRaw(req.Object.Raw, marshalledObj)
 }
 
+// checkLifecycle denies the request, if the given CatalogEntry is retired.
+func (r *TenantObjWebhookHandler) checkLifecycle(catalogEntry *catalogv1alpha1.CatalogEntry) (admission.Response, bool) {
+	lifecycle := catalogEntry.Spec.Lifecycle
+	if lifecycle.AllowsNewInstances(time.Now()) {
+		return admission.Response{}, false
+	}
+	msg := fmt.Sprintf("the Offering for %s is retired and doesn't accept new instances", r.TenantGVK.Kind)
+	if lifecycle.Message != "" {
+		msg += ": " + lifecycle.Message
+	}
+	return admission.Denied(msg), true
+}
+
+// checkMaintenance denies the request, if the ServiceCluster of the given CatalogEntry is in maintenance.
+func (r *TenantObjWebhookHandler) checkMaintenance(ctx context.Context, catalogEntry *catalogv1alpha1.CatalogEntry) (admission.Response, bool) {
+	if catalogEntry.Status.TenantCRD == nil {
+		return admission.Response{}, false
+	}
+
+	serviceCluster := &corev1alpha1.ServiceCluster{}
+	if err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+		Name:      catalogEntry.Status.TenantCRD.Region.Name,
+		Namespace: r.ProviderNamespace,
+	}, serviceCluster); err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("getting the ServiceCluster: %w", err)), true
+	}
+	maintenance := serviceCluster.Spec.Maintenance
+	if !maintenance.InEffect(time.Now()) {
+		return admission.Response{}, false
+	}
+	msg := fmt.Sprintf("the region %s is in maintenance and doesn't accept new instances", serviceCluster.Name)
+	if maintenance.Reason != "" {
+		msg += ": " + maintenance.Reason
+	}
+	return admission.Denied(msg), true
+}
+
+// checkQuotas denies the request, if the object would exceed a Quota that applies to the Tenant.
+func (r *TenantObjWebhookHandler) checkQuotas(
+	ctx context.Context, obj *unstructured.Unstructured,
+	exposeConfig catalogv1alpha1.VersionExposeConfig,
+) (admission.Response, bool) {
+	// the Tenant namespace is named after the Tenant
+	tenant := obj.GetNamespace()
+
+	quotaList := &catalogv1alpha1.QuotaList{}
+	if err := r.NamespacedClient.List(ctx, quotaList, client.InNamespace(r.ProviderNamespace)); err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("listing Quotas: %w", err)), true
+	}
+	var (
+		quotas []catalogv1alpha1.Quota
+		limits []catalogv1alpha1.CatalogEntryLimit
+	)
+	for _, quota := range quotaList.Items {
+		limit, ok := quota.Spec.GetLimit(r.DerivedCRName)
+		if !ok || !quota.Spec.AppliesToTenant(tenant) {
+			continue
+		}
+
+		catalog := &catalogv1alpha1.Catalog{}
+		err := r.NamespacedClient.Get(ctx, types.NamespacedName{
+			Name:      quota.Spec.Catalog.Name,
+			Namespace: r.ProviderNamespace,
+		}, catalog)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("getting Catalog: %w", err)), true
+		}
+		for _, catalogTenant := range catalog.Status.Tenants {
+			if catalogTenant.Name == tenant {
+				quotas = append(quotas, quota)
+				limits = append(limits, limit)
+				break
+			}
+		}
+	}
+	if len(quotas) == 0 {
+		return admission.Response{}, false
+	}
+
+	tenantObjList := &unstructured.UnstructuredList{}
+	tenantObjList.SetGroupVersionKind(r.TenantGVK.GroupVersion().WithKind(r.TenantGVK.Kind + "List"))
+	if err := r.List(ctx, tenantObjList, client.InNamespace(tenant)); err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("listing %s: %w", r.TenantGVK.Kind, err)), true
+	}
+	var oldObjs, newObjs []unstructured.Unstructured
+	for i := range tenantObjList.Items {
+		providerView, err := elevatorutil.ProviderView(&tenantObjList.Items[i], exposeConfig)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err), true
+		}
+		oldObjs = append(oldObjs, *providerView)
+		if tenantObjList.Items[i].GetName() != obj.GetName() {
+			newObjs = append(newObjs, *providerView)
+		}
+	}
+	providerView, err := elevatorutil.ProviderView(obj, exposeConfig)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err), true
+	}
+	newObjs = append(newObjs, *providerView)
+
+	for i, quota := range quotas {
+		oldUsage, err := elevatorutil.CalculateUsage(oldObjs, limits[i])
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("calculating usage of Quota %s: %w", quota.Name, err)), true
+		}
+		newUsage, err := elevatorutil.CalculateUsage(newObjs, limits[i])
+		if err != nil {
+			return admission.Denied(fmt.Sprintf("calculating usage of Quota %s: %s", quota.Name, err)), true
+		}
+		if err := elevatorutil.CheckQuota(limits[i], oldUsage, newUsage); err != nil {
+			return admission.Denied(fmt.Sprintf("exceeding Quota %s: %s", quota.Name, err)), true
+		}
+	}
+	return admission.Response{}, false
+}
+
 // TenantObjWebhookHandler implements admission.DecoderInjector.
 // A decoder will be automatically injected.
 // InjectDecoder injects the decoder.
@@ -17,25 +17,35 @@ limitations under the License.
 package ferry
 
 import (
+	"crypto/tls"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"k8c.io/utils/pkg/util"
 
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 	"k8c.io/kubecarrier/pkg/ferry/internal/controllers"
+	"k8c.io/kubecarrier/pkg/internal/tunnel"
 )
 
 var (
@@ -52,9 +62,18 @@ type flags struct {
 	managementMetricsAddr string
 
 	// service
-	serviceMetricsAddr string
-	serviceKubeconfig  string
-	serviceClusterName string
+	serviceMetricsAddr       string
+	serviceKubeconfig        string
+	serviceClusterName       string
+	serviceClusterConnection string
+
+	// tunnel
+	tunnelAddr           string
+	tunnelTokenFile      string
+	tunnelProxyAddr      string
+	tunnelProxyTokenFile string
+	tunnelTLSCertFile    string
+	tunnelTLSKeyFile     string
 }
 
 func init() {
@@ -87,10 +106,19 @@ func NewFerryCommand(log logr.Logger) *cobra.Command {
 	cmd.Flags().StringVar(&flags.serviceMetricsAddr, "service-cluster-metrics-addr", ":8081", "The address the metric endpoint binds to.")
 	cmd.Flags().StringVar(&flags.serviceKubeconfig, "service-cluster-kubeconfig", "", "Path to the Service Cluster kubeconfig.")
 	cmd.Flags().StringVar(&flags.serviceClusterName, "service-cluster-name", "", "Name of the Service Cluster the ferry is operating on.")
+	cmd.Flags().StringVar(&flags.serviceClusterConnection, "service-cluster-connection", string(corev1alpha1.ServiceClusterConnectionKubeconfig),
+		fmt.Sprintf("How to connect to the Service Cluster, one of (%s, %s).", corev1alpha1.ServiceClusterConnectionKubeconfig, corev1alpha1.ServiceClusterConnectionAgent))
+
+	// tunnel settings, used with the Agent connection
+	cmd.Flags().StringVar(&flags.tunnelAddr, "tunnel-addr", ":8443", "The address the tunnel endpoint for the agent binds to.")
+	cmd.Flags().StringVar(&flags.tunnelTokenFile, "tunnel-token-file", "", "Path to the file containing the token the agent has to present.")
+	cmd.Flags().StringVar(&flags.tunnelProxyAddr, "tunnel-proxy-addr", ":8444", "The address the Service Cluster API Server proxy binds to.")
+	cmd.Flags().StringVar(&flags.tunnelProxyTokenFile, "tunnel-proxy-token-file", "", "Path to the file containing the token clients of the Service Cluster API Server proxy have to present.")
+	cmd.Flags().StringVar(&flags.tunnelTLSCertFile, "tunnel-tls-cert-file", "", "Path to the serving certificate of the tunnel endpoint and the Service Cluster API Server proxy.")
+	cmd.Flags().StringVar(&flags.tunnelTLSKeyFile, "tunnel-tls-key-file", "", "Path to the private key of the serving certificate.")
 	for _, flagName := range []string{
 		"provider-namespace",
 		"service-cluster-name",
-		"service-cluster-kubeconfig",
 	} {
 		if err := cmd.MarkFlagRequired(flagName); err != nil {
 			panic(fmt.Errorf("req flag %s: %w", flagName, err))
@@ -116,14 +144,41 @@ func runE(flags *flags, log logr.Logger) error {
 	}
 
 	// Setup additional client and cache for Service Cluster
-	serviceCfg, err := clientcmd.BuildConfigFromFlags(
-		"", flags.serviceKubeconfig)
-	if err != nil {
-		return fmt.Errorf("reading service cluster config: %w", err)
-	}
-	serviceMapper, err := apiutil.NewDiscoveryRESTMapper(serviceCfg)
-	if err != nil {
-		return fmt.Errorf("creating service cluster rest mapper: %w", err)
+	var (
+		serviceCfg    *rest.Config
+		serviceMapper meta.RESTMapper
+		tunnelServer  *tunnel.Server
+	)
+	switch corev1alpha1.ServiceClusterConnectionType(flags.serviceClusterConnection) {
+	case corev1alpha1.ServiceClusterConnectionKubeconfig:
+		if flags.serviceKubeconfig == "" {
+			return fmt.Errorf("--service-cluster-kubeconfig is required for the %s connection", corev1alpha1.ServiceClusterConnectionKubeconfig)
+		}
+		serviceCfg, err = clientcmd.BuildConfigFromFlags(
+			"", flags.serviceKubeconfig)
+		if err != nil {
+			return fmt.Errorf("reading service cluster config: %w", err)
+		}
+		serviceMapper, err = apiutil.NewDiscoveryRESTMapper(serviceCfg)
+		if err != nil {
+			return fmt.Errorf("creating service cluster rest mapper: %w", err)
+		}
+
+	case corev1alpha1.ServiceClusterConnectionAgent:
+		tunnelServer, err = setupTunnel(flags, log, mgr)
+		if err != nil {
+			return err
+		}
+		serviceCfg = tunnelServer.RESTConfig()
+		// The agent might not be connected yet, so discovery is deferred until the mapping is needed.
+		serviceDiscoveryClient, err := discovery.NewDiscoveryClientForConfig(serviceCfg)
+		if err != nil {
+			return fmt.Errorf("creating service cluster discovery client: %w", err)
+		}
+		serviceMapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(serviceDiscoveryClient))
+
+	default:
+		return fmt.Errorf("unknown service cluster connection %q", flags.serviceClusterConnection)
 	}
 	serviceClient, err := client.New(serviceCfg, client.Options{
 		Scheme: serviceScheme,
@@ -155,8 +210,11 @@ func runE(flags *flags, log logr.Logger) error {
 
 	if err := (&controllers.ServiceClusterReconciler{
 		Log:                       log.WithName("controllers").WithName("ServiceCluster"),
+		Recorder:                  mgr.GetEventRecorderFor("ferry"),
 		ManagementClient:          mgr.GetClient(),
+		ServiceClient:             serviceClient,
 		ServiceClusterVersionInfo: serviceClusterDiscoveryClient,
+		AgentHealth:               agentHealth(tunnelServer),
 		ProviderNamespace:         flags.providerNamespace,
 		ServiceClusterName:        flags.serviceClusterName,
 		StatusUpdatePeriod:        flags.serviceClusterStatusUpdatePeriod,
@@ -166,6 +224,7 @@ func runE(flags *flags, log logr.Logger) error {
 
 	if err := (&controllers.CustomResourceDiscoveryReconciler{
 		Log:                log.WithName("controllers").WithName("CustomResourceDiscovery"),
+		Recorder:           mgr.GetEventRecorderFor("ferry"),
 		ManagementClient:   mgr.GetClient(),
 		ManagementScheme:   mgr.GetScheme(),
 		ServiceClient:      serviceCachedClient,
@@ -177,6 +236,7 @@ func runE(flags *flags, log logr.Logger) error {
 
 	if err := (&controllers.ServiceClusterAssignmentReconciler{
 		Log:              log.WithName("controllers").WithName("ServiceClusterAssignmentReconciler"),
+		Recorder:         mgr.GetEventRecorderFor("ferry"),
 		ManagementClient: mgr.GetClient(),
 		ManagementScheme: mgr.GetScheme(),
 		// We need the uncached client here or we might create a second namespace
@@ -194,3 +254,100 @@ func runE(flags *flags, log logr.Logger) error {
 	}
 	return nil
 }
+
+// setupTunnel adds the tunnel endpoint for the agent and the Service Cluster API Server proxy to the manager.
+func setupTunnel(flags *flags, log logr.Logger, mgr manager.Manager) (*tunnel.Server, error) {
+	token, err := readToken(flags.tunnelTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading tunnel token: %w", err)
+	}
+	proxyToken, err := readToken(flags.tunnelProxyTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading tunnel proxy token: %w", err)
+	}
+
+	// tokens are sent as bearer tokens, so both endpoints are only served with TLS.
+	if flags.tunnelTLSCertFile == "" || flags.tunnelTLSKeyFile == "" {
+		return nil, fmt.Errorf("--tunnel-tls-cert-file and --tunnel-tls-key-file are required for the %s connection", corev1alpha1.ServiceClusterConnectionAgent)
+	}
+	if _, err := tls.LoadX509KeyPair(flags.tunnelTLSCertFile, flags.tunnelTLSKeyFile); err != nil {
+		return nil, fmt.Errorf("loading tunnel serving certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the certificate is loaded for every handshake, so renewals of the mounted Secret are picked up.
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(flags.tunnelTLSCertFile, flags.tunnelTLSKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("loading tunnel serving certificate: %w", err)
+			}
+			return &cert, nil
+		},
+	}
+
+	tunnelServer := &tunnel.Server{
+		Log:   log.WithName("tunnel"),
+		Token: token,
+	}
+	tunnelMux := http.NewServeMux()
+	tunnelMux.Handle(tunnel.ConnectPath, tunnelServer)
+
+	for _, s := range []*http.Server{
+		{Addr: flags.tunnelAddr, Handler: tunnelMux, TLSConfig: tlsConfig},
+		{Addr: flags.tunnelProxyAddr, Handler: tunnelServer.ProxyHandler(proxyToken), TLSConfig: tlsConfig},
+	} {
+		if err := mgr.Add(&httpServerRunnable{server: s}); err != nil {
+			return nil, fmt.Errorf("adding tunnel server to manager: %w", err)
+		}
+	}
+	return tunnelServer, nil
+}
+
+func readToken(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("token file is required")
+	}
+	token, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// agentHealth prevents a typed nil interface, when no tunnel is used.
+func agentHealth(tunnelServer *tunnel.Server) controllers.AgentHealth {
+	if tunnelServer == nil {
+		return nil
+	}
+	return tunnelServer
+}
+
+// httpServerRunnable serves a http.Server with TLS, independent of leader election.
+type httpServerRunnable struct {
+	server *http.Server
+}
+
+var (
+	_ manager.Runnable               = (*httpServerRunnable)(nil)
+	_ manager.LeaderElectionRunnable = (*httpServerRunnable)(nil)
+)
+
+func (r *httpServerRunnable) Start(stop <-chan struct{}) error {
+	errCh := make(chan error, 1)
+	go func() {
+		// certificates are provided by the TLSConfig of the server
+		if err := r.server.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+	select {
+	case <-stop:
+		return r.server.Close()
+	case err := <-errCh:
+		return fmt.Errorf("serving %s: %w", r.server.Addr, err)
+	}
+}
+
+func (r *httpServerRunnable) NeedLeaderElection() bool {
+	return false
+}
@@ -21,10 +21,12 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,7 +46,8 @@ var (
 
 // CustomResourceDiscoveryReconciler reconciles a CustomResourceDiscovery object
 type CustomResourceDiscoveryReconciler struct {
-	Log logr.Logger
+	Log      logr.Logger
+	Recorder record.EventRecorder
 
 	ManagementClient client.Client
 	ManagementScheme *runtime.Scheme
@@ -91,6 +94,11 @@ func (r *CustomResourceDiscoveryReconciler) Reconcile(req ctrl.Request) (ctrl.Re
 
 	switch {
 	case errors.IsNotFound(err):
+		if discovered, _ := crDiscovery.Status.GetCondition(
+			corev1alpha1.CustomResourceDiscoveryDiscovered); discovered.Status != corev1alpha1.ConditionFalse {
+			r.Recorder.Eventf(crDiscovery, corev1.EventTypeWarning, CRDNotFound.Error(),
+				"CRD %s not found in ServiceCluster %s", crDiscovery.Spec.CRD.Name, r.ServiceClusterName)
+		}
 		crDiscovery.Status.CRD = nil
 		crDiscovery.Status.SetCondition(corev1alpha1.CustomResourceDiscoveryCondition{
 			Type:    corev1alpha1.CustomResourceDiscoveryDiscovered,
@@ -115,6 +123,11 @@ func (r *CustomResourceDiscoveryReconciler) Reconcile(req ctrl.Request) (ctrl.Re
 			}
 		}
 
+		if discovered, _ := crDiscovery.Status.GetCondition(
+			corev1alpha1.CustomResourceDiscoveryDiscovered); discovered.Status != corev1alpha1.ConditionTrue {
+			r.Recorder.Eventf(crDiscovery, corev1.EventTypeNormal, "Discovered",
+				"Discovered CRD %s in ServiceCluster %s", crd.Name, r.ServiceClusterName)
+		}
 		crDiscovery.Status.CRD = crd
 		crDiscovery.Status.SetCondition(corev1alpha1.CustomResourceDiscoveryCondition{
 			Type:    corev1alpha1.CustomResourceDiscoveryDiscovered,
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -67,7 +68,9 @@ func TestCustomResourceDiscoveryReconciler(t *testing.T) {
 		},
 	}
 
+	recorder := record.NewFakeRecorder(10)
 	r := &CustomResourceDiscoveryReconciler{
+		Recorder:           recorder,
 		ManagementClient:   fakeclient.NewFakeClientWithScheme(testScheme, crdRef),
 		ManagementScheme:   testScheme,
 		ServiceClient:      fakeclient.NewFakeClientWithScheme(testScheme, crd),
@@ -90,6 +93,9 @@ func TestCustomResourceDiscoveryReconciler(t *testing.T) {
 		assert.Equal(t, crd.Spec.Group, crdRef.Status.CRD.Spec.Group)
 		assert.Equal(t, crd.Spec.Versions[0].Name, crdRef.Status.CRD.Spec.Versions[0].Name)
 		assert.Equal(t, crd.Spec.Scope, crdRef.Status.CRD.Spec.Scope)
+		if assert.Len(t, recorder.Events, 1, "expected a single event for the discovery") {
+			assert.Equal(t, "Normal Discovered Discovered CRD "+crd.Name+" in ServiceCluster "+serviceClusterName, <-recorder.Events)
+		}
 	}) {
 		t.FailNow()
 	}
@@ -107,6 +113,9 @@ func TestCustomResourceDiscoveryReconciler(t *testing.T) {
 		require.NoError(t, r.ManagementClient.Get(context.Background(), crdRefNN, crdRef))
 		assert.NoError(t, testutil.ConditionStatusEqual(crdRef, corev1alpha1.CustomResourceDiscoveryDiscovered, corev1alpha1.ConditionFalse))
 		assert.Equal(t, (*apiextensionsv1.CustomResourceDefinition)(nil), crdRef.Status.CRD)
+		if assert.Len(t, recorder.Events, 1, "expected a single event for the missing CRD") {
+			assert.Equal(t, "Warning CRDNotFound CRD "+crd.Name+" not found in ServiceCluster "+serviceClusterName, <-recorder.Events)
+		}
 	}) {
 		t.FailNow()
 	}
@@ -0,0 +1,257 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+// capacityResources are the resources summarized in the ServiceClusterCapacity.
+var capacityResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// probeHealth runs the health probes against the ServiceCluster and reports the results in its status,
+// the returned condition summarizes, whether all probes passed.
+func (r *ServiceClusterReconciler) probeHealth(
+	ctx context.Context, serviceCluster *corev1alpha1.ServiceCluster, apiLatency time.Duration,
+) (corev1alpha1.ServiceClusterCondition, error) {
+	probes := serviceCluster.Spec.HealthProbes
+	health := &corev1alpha1.ServiceClusterHealth{
+		APILatency: metav1.Duration{Duration: apiLatency},
+	}
+	capacity := &corev1alpha1.ServiceClusterCapacity{
+		Allocatable: corev1.ResourceList{},
+		Requested:   corev1.ResourceList{},
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := r.ServiceClient.List(ctx, nodeList); err != nil {
+		return corev1alpha1.ServiceClusterCondition{}, fmt.Errorf("listing Nodes: %w", err)
+	}
+	for _, node := range nodeList.Items {
+		health.Nodes++
+		if !nodeReady(node) {
+			continue
+		}
+		health.ReadyNodes++
+		addResources(capacity.Allocatable, node.Status.Allocatable)
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.ServiceClient.List(ctx, podList); err != nil {
+		return corev1alpha1.ServiceClusterCondition{}, fmt.Errorf("listing Pods: %w", err)
+	}
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName == "" ||
+			pod.Status.Phase == corev1.PodSucceeded ||
+			pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			addResources(capacity.Requested, container.Resources.Requests)
+		}
+	}
+
+	discoveredCRDs, err := r.discoveredCRDs(ctx)
+	if err != nil {
+		return corev1alpha1.ServiceClusterCondition{}, err
+	}
+	crdNames := make([]string, 0, len(discoveredCRDs))
+	for crdName := range discoveredCRDs {
+		crdNames = append(crdNames, crdName)
+	}
+	sort.Strings(crdNames)
+	for _, crdName := range crdNames {
+		crd := discoveredCRDs[crdName]
+		count, err := r.countInstances(ctx, crd)
+		if err != nil {
+			return corev1alpha1.ServiceClusterCondition{}, err
+		}
+		capacity.Instances = append(capacity.Instances, corev1alpha1.ServiceClusterInstanceCount{
+			CRD:   corev1alpha1.ObjectReference{Name: crd.Name},
+			Count: count,
+		})
+	}
+
+	if probes != nil {
+		for _, operator := range probes.Operators {
+			if _, ok := discoveredCRDs[operator.CRD.Name]; !ok {
+				continue
+			}
+			operatorHealth, err := r.probeOperator(ctx, operator)
+			if err != nil {
+				return corev1alpha1.ServiceClusterCondition{}, err
+			}
+			health.Operators = append(health.Operators, operatorHealth)
+		}
+	}
+
+	serviceCluster.Status.Health = health
+	serviceCluster.Status.Capacity = capacity
+
+	var (
+		reasons  []string
+		messages []string
+	)
+	minReadyNodesPercent := probes.MinReadyNodesPercentOrDefault()
+	if health.ReadyNodes*100 < health.Nodes*minReadyNodesPercent ||
+		health.Nodes == 0 && minReadyNodesPercent > 0 {
+		reasons = append(reasons, "NodesNotReady")
+		messages = append(messages, fmt.Sprintf(
+			"%d/%d nodes are ready, at least %d%% are required", health.ReadyNodes, health.Nodes, minReadyNodesPercent))
+	}
+	if maxAPILatency := probes.MaxAPILatencyOrDefault(); apiLatency > maxAPILatency {
+		reasons = append(reasons, "APILatencyExceeded")
+		messages = append(messages, fmt.Sprintf(
+			"api server responded after %v, at most %v is allowed", apiLatency, maxAPILatency))
+	}
+	for _, operator := range health.Operators {
+		if operator.Ready {
+			continue
+		}
+		reasons = append(reasons, "OperatorUnavailable")
+		messages = append(messages, fmt.Sprintf(
+			"operator of %s: %s", operator.CRD.Name, operator.Message))
+	}
+
+	if len(reasons) > 0 {
+		return corev1alpha1.ServiceClusterCondition{
+			Type:    corev1alpha1.ServiceClusterHealthy,
+			Status:  corev1alpha1.ConditionFalse,
+			Reason:  reasons[0],
+			Message: strings.Join(messages, "; "),
+		}, nil
+	}
+	return corev1alpha1.ServiceClusterCondition{
+		Type:    corev1alpha1.ServiceClusterHealthy,
+		Status:  corev1alpha1.ConditionTrue,
+		Reason:  "HealthProbesPassed",
+		Message: "service cluster passed all health checks",
+	}, nil
+}
+
+// discoveredCRDs returns the CustomResourceDefinitions of this ServiceCluster,
+// that were discovered by a CustomResourceDiscovery, indexed by name.
+func (r *ServiceClusterReconciler) discoveredCRDs(ctx context.Context) (map[string]*apiextensionsv1.CustomResourceDefinition, error) {
+	crDiscoveryList := &corev1alpha1.CustomResourceDiscoveryList{}
+	if err := r.ManagementClient.List(ctx, crDiscoveryList); err != nil {
+		return nil, fmt.Errorf("listing CustomResourceDiscoveries: %w", err)
+	}
+	crds := map[string]*apiextensionsv1.CustomResourceDefinition{}
+	for _, crDiscovery := range crDiscoveryList.Items {
+		if crDiscovery.Namespace != r.ProviderNamespace ||
+			crDiscovery.Spec.ServiceCluster.Name != r.ServiceClusterName ||
+			crDiscovery.Status.CRD == nil {
+			continue
+		}
+		crds[crDiscovery.Status.CRD.Name] = crDiscovery.Status.CRD
+	}
+	return crds, nil
+}
+
+// countInstances returns the number of instances of the given CustomResourceDefinition in the ServiceCluster.
+func (r *ServiceClusterReconciler) countInstances(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) (int32, error) {
+	var storageVersion string
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+		}
+	}
+	if storageVersion == "" {
+		return 0, fmt.Errorf("no storage version in CustomResourceDefinition %s", crd.Name)
+	}
+
+	instanceList := &unstructured.UnstructuredList{}
+	instanceList.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: storageVersion,
+		Kind:    crd.Spec.Names.Kind + "List",
+	})
+	if err := r.ServiceClient.List(ctx, instanceList); err != nil {
+		return 0, fmt.Errorf("listing %s: %w", crd.Spec.Names.Kind, err)
+	}
+	return int32(len(instanceList.Items)), nil
+}
+
+// probeOperator checks whether the Deployment of an operator is present and available.
+func (r *ServiceClusterReconciler) probeOperator(
+	ctx context.Context, operator corev1alpha1.ServiceClusterOperatorProbe,
+) (corev1alpha1.ServiceClusterOperatorHealth, error) {
+	operatorHealth := corev1alpha1.ServiceClusterOperatorHealth{
+		CRD:        operator.CRD,
+		Deployment: operator.Deployment,
+	}
+
+	deployment := &appsv1.Deployment{}
+	err := r.ServiceClient.Get(ctx, types.NamespacedName{
+		Name:      operator.Deployment.Name,
+		Namespace: operator.Deployment.Namespace,
+	}, deployment)
+	if errors.IsNotFound(err) {
+		operatorHealth.Message = fmt.Sprintf("deployment %s/%s not found", operator.Deployment.Namespace, operator.Deployment.Name)
+		return operatorHealth, nil
+	}
+	if err != nil {
+		return operatorHealth, fmt.Errorf("getting Deployment: %w", err)
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentAvailable &&
+			condition.Status == corev1.ConditionTrue {
+			operatorHealth.Ready = true
+			return operatorHealth, nil
+		}
+	}
+	operatorHealth.Message = fmt.Sprintf("deployment %s/%s is not available", operator.Deployment.Namespace, operator.Deployment.Name)
+	return operatorHealth, nil
+}
+
+func nodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// addResources adds the capacityResources of add to total.
+func addResources(total, add corev1.ResourceList) {
+	for _, name := range capacityResources {
+		quantity, ok := add[name]
+		if !ok {
+			continue
+		}
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
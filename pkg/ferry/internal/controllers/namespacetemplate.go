@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// namespaceTemplateObjectName is the name of the policy objects created from a NamespaceTemplate.
+	namespaceTemplateObjectName = "kubecarrier-tenant"
+	// namespaceTemplateLabelsAnnotation lists the label keys added to a Namespace by the NamespaceTemplate,
+	// so they can be removed again when they are removed from the template.
+	namespaceTemplateLabelsAnnotation = "ferry.kubecarrier.io/namespace-template-labels"
+	// namespaceTemplateAnnotationsAnnotation lists the annotation keys added to a Namespace by the NamespaceTemplate.
+	namespaceTemplateAnnotationsAnnotation = "ferry.kubecarrier.io/namespace-template-annotations"
+)
+
+// namespaceTemplateData is the data available to the Go templates in a NamespaceTemplate.
+type namespaceTemplateData struct {
+	Tenant corev1alpha1.ServiceClusterAssignmentTenant
+}
+
+// renderNamespaceTemplate returns a copy of the NamespaceTemplate,
+// with the tenant values substituted into all its string values.
+func renderNamespaceTemplate(
+	in *corev1alpha1.ServiceClusterNamespaceTemplate, tenant corev1alpha1.ServiceClusterAssignmentTenant,
+) (*corev1alpha1.ServiceClusterNamespaceTemplate, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling NamespaceTemplate: %w", err)
+	}
+	var values interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("unmarshalling NamespaceTemplate: %w", err)
+	}
+	values, err = renderValue(values, namespaceTemplateData{Tenant: tenant})
+	if err != nil {
+		return nil, err
+	}
+	if data, err = json.Marshal(values); err != nil {
+		return nil, fmt.Errorf("marshalling rendered NamespaceTemplate: %w", err)
+	}
+	out := &corev1alpha1.ServiceClusterNamespaceTemplate{}
+	if err := json.Unmarshal(data, out); err != nil {
+		return nil, fmt.Errorf("unmarshalling rendered NamespaceTemplate: %w", err)
+	}
+	return out, nil
+}
+
+func renderValue(value interface{}, data namespaceTemplateData) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if !strings.Contains(v, "{{") {
+			return v, nil
+		}
+		tmpl, err := template.New("").Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing template %q: %w", v, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("executing template %q: %w", v, err)
+		}
+		return buf.String(), nil
+
+	case map[string]interface{}:
+		for key, elem := range v {
+			rendered, err := renderValue(elem, data)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = rendered
+		}
+		return v, nil
+
+	case []interface{}:
+		for i, elem := range v {
+			rendered, err := renderValue(elem, data)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = rendered
+		}
+		return v, nil
+	}
+	return value, nil
+}
+
+// applyTemplateMap sets the desired entries on the current map and removes entries,
+// that were set by a previous template and are no longer desired.
+// The keys set by the template are tracked in the given annotation.
+// It returns the updated copy of the map and the value of the tracking annotation.
+func applyTemplateMap(current, desired map[string]string, previousKeys string) (map[string]string, string) {
+	out := map[string]string{}
+	for key, value := range current {
+		out[key] = value
+	}
+	for _, key := range strings.Split(previousKeys, ",") {
+		if _, ok := desired[key]; !ok {
+			delete(out, key)
+		}
+	}
+	keys := make([]string, 0, len(desired))
+	for key, value := range desired {
+		out[key] = value
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return out, strings.Join(keys, ",")
+}
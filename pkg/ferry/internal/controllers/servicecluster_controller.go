@@ -22,9 +22,11 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,19 +43,34 @@ type ServerVersionInfo interface {
 	ServerVersion() (*version.Info, error)
 }
 
+// AgentHealth reports the health of the agent connection.
+type AgentHealth interface {
+	Healthy() error
+}
+
 // ServiceClusterReconciler sends a heartbeat to KubeCarrier to signal its readyness.
 type ServiceClusterReconciler struct {
-	Log logr.Logger
+	Log      logr.Logger
+	Recorder record.EventRecorder
 
 	ManagementClient          client.Client
+	ServiceClient             client.Client
 	ServiceClusterVersionInfo ServerVersionInfo
-	ProviderNamespace         string
-	ServiceClusterName        string
-	StatusUpdatePeriod        time.Duration
+	// AgentHealth is only set, when the ServiceCluster is connected via an agent.
+	AgentHealth        AgentHealth
+	ProviderNamespace  string
+	ServiceClusterName string
+	StatusUpdatePeriod time.Duration
 }
 
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=kubecarrier.io,resources=customresourcediscoveries,verbs=get;list;watch
+
+// +servicecluster:kubebuilder:rbac:groups="",resources=nodes;pods,verbs=list
+// +servicecluster:kubebuilder:rbac:groups=apps,resources=deployments,verbs=get
+// +servicecluster:kubebuilder:rbac:groups=*,resources=*,verbs=list
 
 func (r *ServiceClusterReconciler) Reconcile(req ctrl.Request) (res ctrl.Result, err error) {
 	ctx := context.Background()
@@ -64,7 +81,24 @@ func (r *ServiceClusterReconciler) Reconcile(req ctrl.Request) (res ctrl.Result,
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if r.AgentHealth != nil {
+		if agentErr := r.AgentHealth.Healthy(); agentErr != nil {
+			if err = r.updateStatus(ctx, serviceCluster, corev1alpha1.ServiceClusterCondition{
+				Type:    corev1alpha1.ServiceClusterReachable,
+				Status:  corev1alpha1.ConditionFalse,
+				Reason:  "AgentUnhealthy",
+				Message: agentErr.Error(),
+			}); err != nil {
+				return ctrl.Result{}, fmt.Errorf("updating status: %w", err)
+			}
+			// the agent is expected to reconnect on its own, so just check again.
+			return ctrl.Result{RequeueAfter: r.StatusUpdatePeriod}, nil
+		}
+	}
+
+	start := time.Now()
 	serverVersion, svcErr := r.ServiceClusterVersionInfo.ServerVersion()
+	apiLatency := time.Since(start)
 	serviceCluster.Status.KubernetesVersion = serverVersion
 
 	if svcErr != nil {
@@ -84,14 +118,27 @@ func (r *ServiceClusterReconciler) Reconcile(req ctrl.Request) (res ctrl.Result,
 		return ctrl.Result{}, svcErr
 	}
 
+	healthyCondition, probeErr := r.probeHealth(ctx, serviceCluster, apiLatency)
+	if probeErr != nil {
+		healthyCondition = corev1alpha1.ServiceClusterCondition{
+			Type:    corev1alpha1.ServiceClusterHealthy,
+			Status:  corev1alpha1.ConditionUnknown,
+			Reason:  "ProbeFailed",
+			Message: probeErr.Error(),
+		}
+	}
+
 	if err = r.updateStatus(ctx, serviceCluster, corev1alpha1.ServiceClusterCondition{
 		Type:    corev1alpha1.ServiceClusterReachable,
 		Status:  corev1alpha1.ConditionTrue,
 		Reason:  "ServiceClusterReachable",
 		Message: "service cluster is posting ready status",
-	}); err != nil {
+	}, healthyCondition); err != nil {
 		return ctrl.Result{}, fmt.Errorf("updateing status: %w", err)
 	}
+	if probeErr != nil {
+		return ctrl.Result{}, fmt.Errorf("probing health: %w", probeErr)
+	}
 
 	return ctrl.Result{RequeueAfter: r.StatusUpdatePeriod}, nil
 }
@@ -124,10 +171,20 @@ func (r *ServiceClusterReconciler) enqueueOwnCluster(h handler.EventHandler, q w
 
 func (r *ServiceClusterReconciler) updateStatus(
 	ctx context.Context, serviceCluster *corev1alpha1.ServiceCluster,
-	condition corev1alpha1.ServiceClusterCondition,
+	conditions ...corev1alpha1.ServiceClusterCondition,
 ) error {
+	for _, condition := range conditions {
+		if current, _ := serviceCluster.Status.GetCondition(condition.Type); current.Status != condition.Status {
+			eventType := corev1.EventTypeNormal
+			if condition.Status != corev1alpha1.ConditionTrue {
+				eventType = corev1.EventTypeWarning
+			}
+			r.Recorder.Event(serviceCluster, eventType, condition.Reason, condition.Message)
+		}
+		serviceCluster.Status.SetCondition(condition)
+	}
+
 	serviceCluster.Status.ObservedGeneration = serviceCluster.Generation
-	serviceCluster.Status.SetCondition(condition)
 
 	if err := r.ManagementClient.Status().Update(ctx, serviceCluster); err != nil {
 		return fmt.Errorf("updating status: %w", err)
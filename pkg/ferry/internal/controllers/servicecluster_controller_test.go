@@ -24,9 +24,16 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -46,6 +53,14 @@ func (f *fakeServiceClusterVersionInfo) ServerVersion() (*version.Info, error) {
 	return nil, fmt.Errorf("fake version info not found")
 }
 
+type fakeAgentHealth struct {
+	err error
+}
+
+func (f *fakeAgentHealth) Healthy() error {
+	return f.err
+}
+
 func TestServiceClusterReconciler(t *testing.T) {
 	serviceCluster := &corev1alpha1.ServiceCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -53,9 +68,12 @@ func TestServiceClusterReconciler(t *testing.T) {
 			Namespace: "my-provider",
 		},
 	}
+	recorder := record.NewFakeRecorder(10)
 	scc := &ServiceClusterReconciler{
 		Log:              testutil.NewLogger(t),
+		Recorder:         recorder,
 		ManagementClient: fakeclient.NewFakeClientWithScheme(testScheme, serviceCluster),
+		ServiceClient:    fakeclient.NewFakeClientWithScheme(testScheme, newTestNode("node-1", true)),
 		ServiceClusterVersionInfo: &fakeServiceClusterVersionInfo{
 			Info: &version.Info{
 				Major:        "1",
@@ -96,6 +114,13 @@ func TestServiceClusterReconciler(t *testing.T) {
 		if assert.True(t, present, "service cluster reachable condition missing") {
 			assert.Equal(t, corev1alpha1.ConditionTrue, cond.Status)
 		}
+		assert.Equal(t, "Normal ServiceClusterReachable service cluster is posting ready status", <-recorder.Events)
+
+		cond, present = serviceClusterFound.Status.GetCondition(corev1alpha1.ServiceClusterHealthy)
+		if assert.True(t, present, "service cluster healthy condition missing") {
+			assert.Equal(t, corev1alpha1.ConditionTrue, cond.Status)
+		}
+		assert.Equal(t, "Normal HealthProbesPassed service cluster passed all health checks", <-recorder.Events)
 	}) {
 		t.FailNow()
 	}
@@ -126,7 +151,206 @@ func TestServiceClusterReconciler(t *testing.T) {
 			assert.Equal(t, "ClusterUnreachable", cond.Reason)
 			assert.Equal(t, `fake version info not found`, cond.Message)
 		}
+		assert.Equal(t, "Warning ClusterUnreachable fake version info not found", <-recorder.Events)
+	}) {
+		t.FailNow()
+	}
+
+	if !t.Run("agent unhealthy", func(t *testing.T) {
+		scc.Log = testutil.NewLogger(t)
+		ctx := context.Background()
+		scc.AgentHealth = &fakeAgentHealth{err: fmt.Errorf("agent is not connected")}
+
+		res, err := scc.Reconcile(ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      serviceCluster.Name,
+				Namespace: serviceCluster.Namespace,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, scc.StatusUpdatePeriod, res.RequeueAfter)
+
+		serviceClusterFound := &corev1alpha1.ServiceCluster{}
+		require.NoError(t, scc.ManagementClient.Get(ctx, types.NamespacedName{
+			Name:      serviceCluster.Name,
+			Namespace: serviceCluster.Namespace,
+		}, serviceClusterFound))
+
+		cond, present := serviceClusterFound.Status.GetCondition(corev1alpha1.ServiceClusterReachable)
+		if assert.True(t, present, "service cluster reachable condition missing") {
+			assert.Equal(t, corev1alpha1.ConditionFalse, cond.Status)
+			assert.Equal(t, "AgentUnhealthy", cond.Reason)
+			assert.Equal(t, "agent is not connected", cond.Message)
+		}
 	}) {
 		t.FailNow()
 	}
 }
+
+func TestServiceClusterReconcilerHealthProbes(t *testing.T) {
+	minReadyNodesPercent := int32(100)
+	serviceCluster := &corev1alpha1.ServiceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "eu-west-1",
+			Namespace: "my-provider",
+		},
+		Spec: corev1alpha1.ServiceClusterSpec{
+			HealthProbes: &corev1alpha1.ServiceClusterHealthProbes{
+				MinReadyNodesPercent: &minReadyNodesPercent,
+				Operators: []corev1alpha1.ServiceClusterOperatorProbe{
+					{
+						CRD:        corev1alpha1.ObjectReference{Name: "couchdbs.couchdb.io"},
+						Deployment: corev1alpha1.NamespacedObjectReference{Name: "couchdb-operator", Namespace: "couchdb"},
+					},
+					{
+						// not discovered, so it's not probed
+						CRD:        corev1alpha1.ObjectReference{Name: "redis.redis.io"},
+						Deployment: corev1alpha1.NamespacedObjectReference{Name: "redis-operator", Namespace: "redis"},
+					},
+				},
+			},
+		},
+	}
+	crDiscovery := &corev1alpha1.CustomResourceDiscovery{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdbs.eu-west-1",
+			Namespace: "my-provider",
+		},
+		Spec: corev1alpha1.CustomResourceDiscoverySpec{
+			CRD:            corev1alpha1.ObjectReference{Name: "couchdbs.couchdb.io"},
+			ServiceCluster: corev1alpha1.ObjectReference{Name: "eu-west-1"},
+		},
+		Status: corev1alpha1.CustomResourceDiscoveryStatus{
+			CRD: &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "couchdbs.couchdb.io"},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "couchdb.io",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "CouchDB"},
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1alpha1", Storage: true},
+					},
+				},
+			},
+		},
+	}
+
+	// required to list instances with the fake client
+	instanceGVK := schema.GroupVersionKind{Group: "couchdb.io", Version: "v1alpha1", Kind: "CouchDB"}
+	testScheme.AddKnownTypeWithName(
+		instanceGVK.GroupVersion().WithKind(instanceGVK.Kind+"List"), &unstructured.UnstructuredList{})
+	instance := &unstructured.Unstructured{}
+	instance.SetGroupVersionKind(instanceGVK)
+	instance.SetName("db1")
+	instance.SetNamespace("default")
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db1-0",
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node-1",
+			Containers: []corev1.Container{
+				{
+					Name: "couchdb",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	operator := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdb-operator",
+			Namespace: "couchdb",
+		},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+			},
+		},
+	}
+
+	scc := &ServiceClusterReconciler{
+		Log:              testutil.NewLogger(t),
+		Recorder:         record.NewFakeRecorder(10),
+		ManagementClient: fakeclient.NewFakeClientWithScheme(testScheme, serviceCluster, crDiscovery),
+		ServiceClient: fakeclient.NewFakeClientWithScheme(testScheme,
+			newTestNode("node-1", true), newTestNode("node-2", false), pod, operator, instance),
+		ServiceClusterVersionInfo: &fakeServiceClusterVersionInfo{Info: &version.Info{}},
+		ServiceClusterName:        "eu-west-1",
+		ProviderNamespace:         "my-provider",
+		StatusUpdatePeriod:        time.Second,
+	}
+	_, err := scc.Reconcile(ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      serviceCluster.Name,
+			Namespace: serviceCluster.Namespace,
+		},
+	})
+	require.NoError(t, err, "error reconciling ServiceCluster")
+
+	ctx := context.Background()
+	serviceClusterFound := &corev1alpha1.ServiceCluster{}
+	require.NoError(t, scc.ManagementClient.Get(ctx, types.NamespacedName{
+		Name:      serviceCluster.Name,
+		Namespace: serviceCluster.Namespace,
+	}, serviceClusterFound))
+
+	cond, present := serviceClusterFound.Status.GetCondition(corev1alpha1.ServiceClusterHealthy)
+	if assert.True(t, present, "service cluster healthy condition missing") {
+		assert.Equal(t, corev1alpha1.ConditionFalse, cond.Status)
+		assert.Equal(t, "NodesNotReady", cond.Reason)
+		assert.Equal(t, "1/2 nodes are ready, at least 100% are required; "+
+			"operator of couchdbs.couchdb.io: deployment couchdb/couchdb-operator is not available", cond.Message)
+	}
+
+	if assert.NotNil(t, serviceClusterFound.Status.Health) {
+		health := serviceClusterFound.Status.Health
+		assert.Equal(t, int32(2), health.Nodes)
+		assert.Equal(t, int32(1), health.ReadyNodes)
+		assert.Equal(t, []corev1alpha1.ServiceClusterOperatorHealth{
+			{
+				CRD:        corev1alpha1.ObjectReference{Name: "couchdbs.couchdb.io"},
+				Deployment: corev1alpha1.NamespacedObjectReference{Name: "couchdb-operator", Namespace: "couchdb"},
+				Message:    "deployment couchdb/couchdb-operator is not available",
+			},
+		}, health.Operators)
+	}
+	if assert.NotNil(t, serviceClusterFound.Status.Capacity) {
+		capacity := serviceClusterFound.Status.Capacity
+		assert.Equal(t, "4", capacity.Allocatable.Cpu().String())
+		assert.Equal(t, "8Gi", capacity.Allocatable.Memory().String())
+		assert.Equal(t, "500m", capacity.Requested.Cpu().String())
+		assert.Equal(t, "1Gi", capacity.Requested.Memory().String())
+		assert.Equal(t, []corev1alpha1.ServiceClusterInstanceCount{
+			{CRD: corev1alpha1.ObjectReference{Name: "couchdbs.couchdb.io"}, Count: 1},
+		}, capacity.Instances)
+	}
+}
+
+func newTestNode(name string, ready bool) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: status},
+			},
+		},
+	}
+}
@@ -19,16 +19,23 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"k8c.io/utils/pkg/owner"
@@ -41,7 +48,8 @@ const serviceClusterAssignmentControllerFinalizer string = "serviceclusterassign
 
 // ServiceClusterAssignmentReconciler reconciles a ServiceClusterAssignment object
 type ServiceClusterAssignmentReconciler struct {
-	Log logr.Logger
+	Log      logr.Logger
+	Recorder record.EventRecorder
 
 	ManagementClient   client.Client
 	ServiceClient      client.Client
@@ -54,6 +62,8 @@ type ServiceClusterAssignmentReconciler struct {
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusterassignments/status,verbs=get;update;patch
 // https://github.com/kubermatic/kubecarrier/issues/143
 // +servicecluster:kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
+// +servicecluster:kubebuilder:rbac:groups="",resources=resourcequotas;limitranges,verbs=get;list;watch;create;update;patch;delete
+// +servicecluster:kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 
 func (r *ServiceClusterAssignmentReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -84,6 +94,8 @@ func (r *ServiceClusterAssignmentReconciler) Reconcile(req ctrl.Request) (ctrl.R
 		serviceClusterAssignment.Spec.ManagementClusterNamespace.Name,
 		r.ManagementScheme)
 	if err != nil {
+		r.Recorder.Eventf(serviceClusterAssignment, corev1.EventTypeWarning, "AssignmentFailed",
+			"Creating Namespace in ServiceCluster %s: %s", r.ServiceClusterName, err)
 		serviceClusterAssignment.Status.ObservedGeneration = serviceClusterAssignment.Generation
 		serviceClusterAssignment.Status.SetCondition(corev1alpha1.ServiceClusterAssignmentCondition{
 			Type:    corev1alpha1.ServiceClusterAssignmentReady,
@@ -97,9 +109,32 @@ func (r *ServiceClusterAssignmentReconciler) Reconcile(req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, fmt.Errorf("cannot create TenantAssignment namespace: %w", err)
 	}
 
+	appliedNamespaceTemplate, err := r.reconcileNamespaceTemplate(ctx, serviceClusterAssignment, ns)
+	if err != nil {
+		r.Recorder.Eventf(serviceClusterAssignment, corev1.EventTypeWarning, "NamespaceTemplateFailed",
+			"Applying NamespaceTemplate to Namespace %s in ServiceCluster %s: %s", ns.Name, r.ServiceClusterName, err)
+		serviceClusterAssignment.Status.ObservedGeneration = serviceClusterAssignment.Generation
+		serviceClusterAssignment.Status.SetCondition(corev1alpha1.ServiceClusterAssignmentCondition{
+			Type:    corev1alpha1.ServiceClusterAssignmentReady,
+			Status:  corev1alpha1.ConditionFalse,
+			Message: err.Error(),
+			Reason:  "NamespaceTemplateFailed",
+		})
+		if err := r.ManagementClient.Status().Update(ctx, serviceClusterAssignment); err != nil {
+			return ctrl.Result{}, fmt.Errorf("updating ServiceClusterAssignment Status: %w", err)
+		}
+		return ctrl.Result{}, fmt.Errorf("applying NamespaceTemplate: %w", err)
+	}
+
+	if readyCondition, _ := serviceClusterAssignment.Status.GetCondition(
+		corev1alpha1.ServiceClusterAssignmentReady); readyCondition.Status != corev1alpha1.ConditionTrue {
+		r.Recorder.Eventf(serviceClusterAssignment, corev1.EventTypeNormal, "Assigned",
+			"Assigned Namespace %s in ServiceCluster %s", ns.Name, r.ServiceClusterName)
+	}
 	serviceClusterAssignment.Status.ServiceClusterNamespace = &corev1alpha1.ObjectReference{
 		Name: ns.Name,
 	}
+	serviceClusterAssignment.Status.NamespaceTemplate = appliedNamespaceTemplate
 	serviceClusterAssignment.Status.ObservedGeneration = serviceClusterAssignment.Generation
 	serviceClusterAssignment.Status.SetCondition(corev1alpha1.ServiceClusterAssignmentCondition{
 		Type:    corev1alpha1.ServiceClusterAssignmentReady,
@@ -113,6 +148,104 @@ func (r *ServiceClusterAssignmentReconciler) Reconcile(req ctrl.Request) (ctrl.R
 	return ctrl.Result{}, nil
 }
 
+// reconcileNamespaceTemplate applies the NamespaceTemplate of the ServiceCluster to the assigned Namespace.
+// Policy objects of the template are removed again, when they are removed from the template.
+func (r *ServiceClusterAssignmentReconciler) reconcileNamespaceTemplate(
+	ctx context.Context, serviceClusterAssignment *corev1alpha1.ServiceClusterAssignment, ns *corev1.Namespace,
+) (*corev1alpha1.AppliedNamespaceTemplate, error) {
+	serviceCluster := &corev1alpha1.ServiceCluster{}
+	if err := r.ManagementClient.Get(ctx, types.NamespacedName{
+		Name:      r.ServiceClusterName,
+		Namespace: serviceClusterAssignment.Namespace,
+	}, serviceCluster); err != nil {
+		return nil, fmt.Errorf("getting ServiceCluster: %w", err)
+	}
+
+	namespaceTemplate := serviceCluster.Spec.NamespaceTemplate
+	if namespaceTemplate == nil {
+		// still reconcile an empty template, to cleanup after a removed template
+		namespaceTemplate = &corev1alpha1.ServiceClusterNamespaceTemplate{}
+	}
+	var tenant corev1alpha1.ServiceClusterAssignmentTenant
+	if serviceClusterAssignment.Spec.Tenant != nil {
+		tenant = *serviceClusterAssignment.Spec.Tenant
+	}
+	namespaceTemplate, err := renderNamespaceTemplate(namespaceTemplate, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	// Namespace labels and annotations
+	labels, labelKeys := applyTemplateMap(
+		ns.GetLabels(), namespaceTemplate.Labels, ns.GetAnnotations()[namespaceTemplateLabelsAnnotation])
+	annotations, annotationKeys := applyTemplateMap(
+		ns.GetAnnotations(), namespaceTemplate.Annotations, ns.GetAnnotations()[namespaceTemplateAnnotationsAnnotation])
+	annotations[namespaceTemplateLabelsAnnotation] = labelKeys
+	annotations[namespaceTemplateAnnotationsAnnotation] = annotationKeys
+	if !reflect.DeepEqual(labels, ns.GetLabels()) || !reflect.DeepEqual(annotations, ns.GetAnnotations()) {
+		ns.SetLabels(labels)
+		ns.SetAnnotations(annotations)
+		if err := r.ServiceClient.Update(ctx, ns); err != nil {
+			return nil, fmt.Errorf("updating Namespace: %w", err)
+		}
+	}
+
+	// Policy objects
+	applied := &corev1alpha1.AppliedNamespaceTemplate{
+		ServiceClusterGeneration: serviceCluster.Generation,
+	}
+	objectMeta := metav1.ObjectMeta{
+		Name:      namespaceTemplateObjectName,
+		Namespace: ns.Name,
+	}
+	resourceQuota := &corev1.ResourceQuota{ObjectMeta: objectMeta}
+	if err := r.reconcileTemplateObject(ctx, applied, "ResourceQuota", resourceQuota,
+		namespaceTemplate.ResourceQuota != nil, func() error {
+			resourceQuota.Spec = *namespaceTemplate.ResourceQuota
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+	limitRange := &corev1.LimitRange{ObjectMeta: objectMeta}
+	if err := r.reconcileTemplateObject(ctx, applied, "LimitRange", limitRange,
+		namespaceTemplate.LimitRange != nil, func() error {
+			limitRange.Spec = *namespaceTemplate.LimitRange
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: objectMeta}
+	if err := r.reconcileTemplateObject(ctx, applied, "NetworkPolicy", networkPolicy,
+		namespaceTemplate.NetworkPolicy != nil, func() error {
+			networkPolicy.Spec = *namespaceTemplate.NetworkPolicy
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+// reconcileTemplateObject creates or updates a policy object of the NamespaceTemplate, or deletes it if it's no longer desired.
+func (r *ServiceClusterAssignmentReconciler) reconcileTemplateObject(
+	ctx context.Context, applied *corev1alpha1.AppliedNamespaceTemplate,
+	kind string, obj runtime.Object, desired bool, mutate controllerutil.MutateFn,
+) error {
+	if !desired {
+		if err := r.ServiceClient.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("deleting %s: %w", kind, err)
+		}
+		return nil
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.ServiceClient, obj, mutate); err != nil {
+		return fmt.Errorf("creating or updating %s: %w", kind, err)
+	}
+	applied.Objects = append(applied.Objects, corev1alpha1.AppliedNamespaceTemplateObject{
+		Kind: kind,
+		Name: namespaceTemplateObjectName,
+	})
+	return nil
+}
+
 func (r *ServiceClusterAssignmentReconciler) handleDeletion(ctx context.Context, log logr.Logger, serviceClusterAssignment *corev1alpha1.ServiceClusterAssignment) error {
 	// Update the Provider Status to Terminating.
 	readyCondition, _ := serviceClusterAssignment.Status.GetCondition(corev1alpha1.ServiceClusterAssignmentReady)
@@ -154,9 +287,34 @@ func (r *ServiceClusterAssignmentReconciler) handleDeletion(ctx context.Context,
 }
 
 func (r *ServiceClusterAssignmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// the NamespaceTemplate of the ServiceCluster is applied to all assigned Namespaces
+	enqueueAllServiceClusterAssignments := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) (out []reconcile.Request) {
+			serviceClusterAssignmentList := &corev1alpha1.ServiceClusterAssignmentList{}
+			if err := r.ManagementClient.List(context.Background(), serviceClusterAssignmentList,
+				client.InNamespace(mapObject.Meta.GetNamespace())); err != nil {
+				// This will makes the manager crashes, and it will restart and reconcile all objects again.
+				panic(fmt.Errorf("listing ServiceClusterAssignment: %w", err))
+			}
+			for _, serviceClusterAssignment := range serviceClusterAssignmentList.Items {
+				if serviceClusterAssignment.Spec.ServiceCluster.Name != mapObject.Meta.GetName() {
+					continue
+				}
+				out = append(out, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      serviceClusterAssignment.Name,
+						Namespace: serviceClusterAssignment.Namespace,
+					},
+				})
+			}
+			return
+		}),
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1alpha1.ServiceClusterAssignment{}).
 		Watches(source.NewKindWithCache(&corev1.Namespace{}, r.ServiceCache), owner.EnqueueRequestForOwner(&corev1alpha1.ServiceClusterAssignment{}, r.ManagementScheme)).
+		Watches(&source.Kind{Type: &corev1alpha1.ServiceCluster{}}, enqueueAllServiceClusterAssignments).
 		WithEventFilter(util.PredicateFn(func(obj runtime.Object) bool {
 			if serviceClusterAssignment, ok := obj.(*corev1alpha1.ServiceClusterAssignment); ok {
 				if serviceClusterAssignment.Spec.ServiceCluster.Name == r.ServiceClusterName {
@@ -164,6 +322,10 @@ func (r *ServiceClusterAssignmentReconciler) SetupWithManager(mgr ctrl.Manager)
 				}
 			}
 
+			if serviceCluster, ok := obj.(*corev1alpha1.ServiceCluster); ok {
+				return serviceCluster.Name == r.ServiceClusterName
+			}
+
 			// for namespace owner reconciliation from the service cluster
 			if _, ok := obj.(*corev1.Namespace); ok {
 				return true
@@ -23,11 +23,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"k8c.io/utils/pkg/owner"
 	"k8c.io/utils/pkg/testutil"
 
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
@@ -49,11 +54,21 @@ func TestServiceClusterAssignmentReconciler(t *testing.T) {
 		},
 	}
 
+	serviceCluster := &corev1alpha1.ServiceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "eu-west-1",
+			Namespace: "provider-bar",
+		},
+	}
+
+	recorder := record.NewFakeRecorder(10)
 	r := ServiceClusterAssignmentReconciler{
-		Log:              testutil.NewLogger(t),
-		ManagementClient: fakeclient.NewFakeClientWithScheme(testScheme, serviceClusterAssignment),
-		ManagementScheme: testScheme,
-		ServiceClient:    fakeclient.NewFakeClientWithScheme(testScheme),
+		Log:                testutil.NewLogger(t),
+		Recorder:           recorder,
+		ManagementClient:   fakeclient.NewFakeClientWithScheme(testScheme, serviceClusterAssignment, serviceCluster),
+		ManagementScheme:   testScheme,
+		ServiceClient:      fakeclient.NewFakeClientWithScheme(testScheme),
+		ServiceClusterName: "eu-west-1",
 	}
 	_, err := r.Reconcile(ctrl.Request{
 		NamespacedName: types.NamespacedName{
@@ -69,4 +84,148 @@ func TestServiceClusterAssignmentReconciler(t *testing.T) {
 	if assert.Len(t, namespaceList.Items, 1) {
 		assert.Equal(t, "foo-", namespaceList.Items[0].GenerateName)
 	}
+	if assert.Len(t, recorder.Events, 1) {
+		assert.Contains(t, <-recorder.Events, "Normal Assigned")
+	}
+}
+
+func TestServiceClusterAssignmentReconcilerNamespaceTemplate(t *testing.T) {
+	serviceClusterAssignment := &corev1alpha1.ServiceClusterAssignment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo.eu-west-1",
+			Namespace: "provider-bar",
+		},
+		Spec: corev1alpha1.ServiceClusterAssignmentSpec{
+			ManagementClusterNamespace: corev1alpha1.ObjectReference{
+				Name: "foo",
+			},
+			ServiceCluster: corev1alpha1.ObjectReference{
+				Name: "eu-west-1",
+			},
+			Tenant: &corev1alpha1.ServiceClusterAssignmentTenant{
+				Name: "team-a",
+				Labels: map[string]string{
+					"example.com/cost-center": "1234",
+				},
+			},
+		},
+	}
+	serviceCluster := &corev1alpha1.ServiceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "eu-west-1",
+			Namespace:  "provider-bar",
+			Generation: 3,
+		},
+		Spec: corev1alpha1.ServiceClusterSpec{
+			NamespaceTemplate: &corev1alpha1.ServiceClusterNamespaceTemplate{
+				Labels: map[string]string{
+					"tenant":      "{{.Tenant.Name}}",
+					"cost-center": `{{index .Tenant.Labels "example.com/cost-center"}}`,
+				},
+				ResourceQuota: &corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{
+						corev1.ResourcePods: resource.MustParse("10"),
+					},
+				},
+				NetworkPolicy: &networkingv1.NetworkPolicySpec{
+					Ingress: []networkingv1.NetworkPolicyIngressRule{
+						{
+							From: []networkingv1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											"tenant": "{{.Tenant.Name}}",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo-abc12",
+			Labels: map[string]string{
+				"unrelated": "label",
+			},
+		},
+	}
+	_, err := owner.SetOwnerReference(serviceClusterAssignment, namespace, testScheme)
+	require.NoError(t, err)
+
+	managementClient := fakeclient.NewFakeClientWithScheme(testScheme, serviceClusterAssignment, serviceCluster)
+	serviceClient := fakeclient.NewFakeClientWithScheme(testScheme, namespace)
+	r := ServiceClusterAssignmentReconciler{
+		Log:                testutil.NewLogger(t),
+		Recorder:           record.NewFakeRecorder(10),
+		ManagementClient:   managementClient,
+		ManagementScheme:   testScheme,
+		ServiceClient:      serviceClient,
+		ServiceClusterName: "eu-west-1",
+	}
+	ctx := context.Background()
+	nn := types.NamespacedName{
+		Name:      serviceClusterAssignment.Name,
+		Namespace: serviceClusterAssignment.Namespace,
+	}
+
+	_, err = r.Reconcile(ctrl.Request{NamespacedName: nn})
+	require.NoError(t, err, "Reconcile")
+
+	require.NoError(t, serviceClient.Get(ctx, types.NamespacedName{Name: namespace.Name}, namespace))
+	assert.Equal(t, "team-a", namespace.Labels["tenant"])
+	assert.Equal(t, "1234", namespace.Labels["cost-center"])
+	assert.Equal(t, "label", namespace.Labels["unrelated"])
+
+	resourceQuota := &corev1.ResourceQuota{}
+	require.NoError(t, serviceClient.Get(ctx, types.NamespacedName{
+		Name:      namespaceTemplateObjectName,
+		Namespace: namespace.Name,
+	}, resourceQuota))
+	assert.Equal(t, serviceCluster.Spec.NamespaceTemplate.ResourceQuota.Hard, resourceQuota.Spec.Hard)
+
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	require.NoError(t, serviceClient.Get(ctx, types.NamespacedName{
+		Name:      namespaceTemplateObjectName,
+		Namespace: namespace.Name,
+	}, networkPolicy))
+	assert.Equal(t, map[string]string{"tenant": "team-a"},
+		networkPolicy.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels)
+
+	require.NoError(t, managementClient.Get(ctx, nn, serviceClusterAssignment))
+	assert.Equal(t, &corev1alpha1.AppliedNamespaceTemplate{
+		ServiceClusterGeneration: 3,
+		Objects: []corev1alpha1.AppliedNamespaceTemplateObject{
+			{Kind: "ResourceQuota", Name: namespaceTemplateObjectName},
+			{Kind: "NetworkPolicy", Name: namespaceTemplateObjectName},
+		},
+	}, serviceClusterAssignment.Status.NamespaceTemplate)
+
+	// removing the template cleans up again
+	require.NoError(t, managementClient.Get(ctx, types.NamespacedName{
+		Name:      serviceCluster.Name,
+		Namespace: serviceCluster.Namespace,
+	}, serviceCluster))
+	serviceCluster.Spec.NamespaceTemplate = nil
+	require.NoError(t, managementClient.Update(ctx, serviceCluster))
+
+	_, err = r.Reconcile(ctrl.Request{NamespacedName: nn})
+	require.NoError(t, err, "Reconcile")
+
+	namespace = &corev1.Namespace{}
+	require.NoError(t, serviceClient.Get(ctx, types.NamespacedName{Name: "foo-abc12"}, namespace))
+	assert.NotContains(t, namespace.Labels, "tenant")
+	assert.NotContains(t, namespace.Labels, "cost-center")
+	assert.Equal(t, "label", namespace.Labels["unrelated"])
+	assert.True(t, errors.IsNotFound(serviceClient.Get(ctx, types.NamespacedName{
+		Name:      namespaceTemplateObjectName,
+		Namespace: namespace.Name,
+	}, resourceQuota)), "ResourceQuota should be deleted")
+	assert.True(t, errors.IsNotFound(serviceClient.Get(ctx, types.NamespacedName{
+		Name:      namespaceTemplateObjectName,
+		Namespace: namespace.Name,
+	}, networkPolicy)), "NetworkPolicy should be deleted")
 }
@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consolidation runs the controllers of many CRDs within a single process,
+// starting and stopping them at runtime as CRDs come and go.
+package consolidation
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const maxRestartDelay = 5 * time.Minute
+
+// SetupFunc adds the controllers and webhooks of an instance to its Manager.
+type SetupFunc func(mgr ctrl.Manager) error
+
+// Runner starts and stops instances at runtime.
+// Every instance runs in its own Manager, so its informers and controllers are stopped together with the instance.
+type Runner struct {
+	log        logr.Logger
+	newManager func() (ctrl.Manager, error)
+
+	mu        sync.Mutex
+	instances map[types.NamespacedName]*instance
+	failures  map[types.NamespacedName]int
+	events    chan event.GenericEvent
+}
+
+type instance struct {
+	config interface{}
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+var _ manager.Runnable = (*Runner)(nil)
+
+// NewRunner creates a new Runner, that uses newManager to create the Manager of each instance.
+func NewRunner(log logr.Logger, newManager func() (ctrl.Manager, error)) *Runner {
+	return &Runner{
+		log:        log,
+		newManager: newManager,
+
+		instances: map[types.NamespacedName]*instance{},
+		failures:  map[types.NamespacedName]int{},
+		events:    make(chan event.GenericEvent, 100),
+	}
+}
+
+// Source returns a source.Source, that emits an event for an instance that stopped unexpectedly,
+// so the object it was started for is reconciled and the instance restarted.
+func (r *Runner) Source() source.Source {
+	return &source.Channel{Source: r.events}
+}
+
+// Ensure starts the instance with the given key, unless it's already running with the same config.
+// Running instances with a different config are restarted.
+func (r *Runner) Ensure(key types.NamespacedName, config interface{}, setup SetupFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if i, ok := r.instances[key]; ok {
+		if !i.exited() && reflect.DeepEqual(i.config, config) {
+			return nil
+		}
+		r.stop(key, i)
+	}
+
+	mgr, err := r.newManager()
+	if err != nil {
+		return fmt.Errorf("creating manager: %w", err)
+	}
+	if err := setup(mgr); err != nil {
+		return fmt.Errorf("setting up instance: %w", err)
+	}
+
+	i := &instance{
+		config: config,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	r.instances[key] = i
+	go r.run(key, i, mgr)
+	return nil
+}
+
+// Stop stops the instance with the given key and waits until it exited.
+func (r *Runner) Stop(key types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if i, ok := r.instances[key]; ok {
+		r.stop(key, i)
+	}
+	delete(r.failures, key)
+}
+
+// Start implements manager.Runnable and stops all instances, when the given channel is closed.
+func (r *Runner) Start(stop <-chan struct{}) error {
+	<-stop
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, i := range r.instances {
+		r.stop(key, i)
+	}
+	return nil
+}
+
+func (r *Runner) stop(key types.NamespacedName, i *instance) {
+	close(i.stop)
+	<-i.done
+	delete(r.instances, key)
+	r.log.Info("stopped instance", "instance", key)
+}
+
+func (r *Runner) run(key types.NamespacedName, i *instance, mgr ctrl.Manager) {
+	log := r.log.WithValues("instance", key)
+	log.Info("starting instance")
+	err := mgr.Start(i.stop)
+	close(i.done)
+
+	select {
+	case <-i.stop:
+		// stopped on purpose
+		return
+	default:
+	}
+
+	// The instance stopped on its own, e.g. because its CRD is not yet established.
+	// Trigger a reconcile after a delay, so the instance is restarted.
+	r.mu.Lock()
+	r.failures[key]++
+	delay := restartDelay(r.failures[key])
+	r.mu.Unlock()
+	log.Error(err, "instance stopped unexpectedly", "restartAfter", delay.String())
+
+	select {
+	case <-i.stop:
+		return
+	case <-time.After(delay):
+	}
+	r.events <- event.GenericEvent{
+		Meta: &metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+}
+
+func (i *instance) exited() bool {
+	select {
+	case <-i.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// restartDelay returns an exponentially growing delay for the given number of failures.
+func restartDelay(failures int) time.Duration {
+	delay := time.Second
+	for n := 1; n < failures && delay < maxRestartDelay; n++ {
+		delay *= 2
+	}
+	if delay > maxRestartDelay {
+		return maxRestartDelay
+	}
+	return delay
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consolidation
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// fakeManager only implements Start and counts how often it was started.
+type fakeManager struct {
+	manager.Manager
+	started *int32
+	err     error
+}
+
+func (m *fakeManager) Start(stop <-chan struct{}) error {
+	atomic.AddInt32(m.started, 1)
+	if m.err != nil {
+		return m.err
+	}
+	<-stop
+	return nil
+}
+
+func TestRunner(t *testing.T) {
+	var (
+		started int32
+		err     error
+	)
+	r := NewRunner(ctrl.Log, func() (ctrl.Manager, error) {
+		return &fakeManager{started: &started, err: err}, nil
+	})
+	key := types.NamespacedName{Name: "test", Namespace: "provider"}
+	noop := func(mgr ctrl.Manager) error { return nil }
+
+	waitForStart := func(n int32) {
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&started) == n
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("start once for the same config", func(t *testing.T) {
+		require.NoError(t, r.Ensure(key, "v1", noop))
+		require.NoError(t, r.Ensure(key, "v1", noop))
+		waitForStart(1)
+	})
+
+	t.Run("restart on config change", func(t *testing.T) {
+		require.NoError(t, r.Ensure(key, "v2", noop))
+		waitForStart(2)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		r.Stop(key)
+		assert.Empty(t, r.instances)
+	})
+
+	t.Run("setup error", func(t *testing.T) {
+		assert.Error(t, r.Ensure(key, "v1", func(mgr ctrl.Manager) error {
+			return fmt.Errorf("broken")
+		}))
+		assert.Empty(t, r.instances)
+	})
+
+	t.Run("unexpected exit triggers event", func(t *testing.T) {
+		err = fmt.Errorf("crd not established")
+		require.NoError(t, r.Ensure(key, "v1", noop))
+
+		select {
+		case e := <-r.events:
+			assert.Equal(t, key.Name, e.Meta.GetName())
+			assert.Equal(t, key.Namespace, e.Meta.GetNamespace())
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event for exited instance")
+		}
+
+		// the exited instance is started again
+		err = nil
+		require.NoError(t, r.Ensure(key, "v1", noop))
+		waitForStart(4)
+		r.Stop(key)
+	})
+}
+
+func TestRestartDelay(t *testing.T) {
+	assert.Equal(t, time.Second, restartDelay(1))
+	assert.Equal(t, 4*time.Second, restartDelay(3))
+	assert.Equal(t, maxRestartDelay, restartDelay(100))
+}
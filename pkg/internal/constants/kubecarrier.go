@@ -22,4 +22,7 @@ const (
 	KubeCarrierDefaultNamespace = "kubecarrier-system"
 
 	InternalAPIGroupPrefix = "internal"
+
+	// AggregatedCRDLabel marks CRDs, that are aggregated by the KubeCarrier manager for scheduling.
+	AggregatedCRDLabel = "kubecarrier.io/aggregated-crd"
 )
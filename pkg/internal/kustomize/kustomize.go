@@ -18,7 +18,7 @@ package kustomize
 
 import (
 	"fmt"
-	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
 
@@ -99,23 +99,17 @@ func (k *Kustomize) ForHTTP(httpFS http.FileSystem) KustomizeContext {
 		if err != nil {
 			return err
 		}
-		// Write modified data. It should not error since it's in memory FS
-		Fout, err := kustomizeFs.Create(path)
+		data, err := ioutil.ReadAll(Fin)
 		if err != nil {
-			return err
-		}
-		if _, err := io.Copy(Fout, Fin); err != nil {
-			return fmt.Errorf("cannot copy data: %w", err)
+			return fmt.Errorf("cannot read data: %w", err)
 		}
-
 		if err := Fin.Close(); err != nil {
 			return err
 		}
-		if err := Fout.Close(); err != nil {
-			return err
-		}
 
-		return nil
+		// Write data in a single call, since in memory files replace their
+		// content on every Write instead of appending to it.
+		return kustomizeFs.WriteFile(path, data)
 	}); err != nil {
 		// We're handling in memory data systems, this should NEVER happen
 		panic(err)
@@ -135,6 +135,9 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 			},
 		},
 	}
+	if c.Spec.RateLimit != nil {
+		addRateLimitConfig(&deploymentPatch.Spec.Template.Spec, c.Spec.RateLimit)
+	}
 	var supportedAuth []string
 	container := &deploymentPatch.Spec.Template.Spec.Containers[0]
 	if err := c.Spec.Authentication.Validate(); err != nil {
@@ -151,6 +154,11 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 			supportedAuth = append(supportedAuth, auth.ProviderToken)
 		} else if config.Anonymous != nil {
 			supportedAuth = append(supportedAuth, auth.ProviderAnynymous)
+		} else if config.ClientCertificate != nil {
+			addClientCertificateConfig(&deploymentPatch.Spec.Template.Spec, config.ClientCertificate)
+			supportedAuth = append(supportedAuth, auth.ProviderX509)
+		} else if config.APIKey != nil {
+			supportedAuth = append(supportedAuth, auth.ProviderAPIKey)
 		}
 	}
 	for j, env := range container.Env {
@@ -275,6 +283,56 @@ func addOIDCConfig(spec *corev1.PodSpec, config *operatorv1alpha1.APIServerOIDCC
 	})
 }
 
+func addRateLimitConfig(spec *corev1.PodSpec, config *operatorv1alpha1.APIServerRateLimit) {
+	container := &spec.Containers[0]
+	container.Args = append(container.Args,
+		"--rate-limit-qps=$(API_SERVER_RATE_LIMIT_QPS)",
+		"--rate-limit-burst=$(API_SERVER_RATE_LIMIT_BURST)",
+		"--max-concurrent-streams=$(API_SERVER_MAX_CONCURRENT_STREAMS)",
+	)
+	container.Env = append(container.Env,
+		corev1.EnvVar{
+			Name:  "API_SERVER_RATE_LIMIT_QPS",
+			Value: strconv.FormatInt(int64(config.QPS), 10),
+		},
+		corev1.EnvVar{
+			Name:  "API_SERVER_RATE_LIMIT_BURST",
+			Value: strconv.FormatInt(int64(config.Burst), 10),
+		},
+		corev1.EnvVar{
+			Name:  "API_SERVER_MAX_CONCURRENT_STREAMS",
+			Value: strconv.FormatInt(int64(config.MaxConcurrentStreams), 10),
+		},
+	)
+}
+
+func addClientCertificateConfig(spec *corev1.PodSpec, config *operatorv1alpha1.ClientCertificate) {
+	container := &spec.Containers[0]
+	container.Args = append(container.Args,
+		"--client-ca-file=$(API_SERVER_CLIENT_CA_FILE)",
+	)
+	container.Env = append(container.Env,
+		corev1.EnvVar{
+			Name:  "API_SERVER_CLIENT_CA_FILE",
+			Value: "/run/client-ca-certs/ca.crt",
+		},
+	)
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		MountPath: "/run/client-ca-certs",
+		ReadOnly:  true,
+		Name:      "client-ca-cert",
+	})
+
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: "client-ca-cert",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: config.CertificateAuthority.Name,
+			},
+		},
+	})
+}
+
 func addStaticUsersConfig(spec *corev1.PodSpec, config *operatorv1alpha1.StaticUsers) {
 	container := &spec.Containers[0]
 	container.Args = append(container.Args,
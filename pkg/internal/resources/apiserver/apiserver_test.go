@@ -43,6 +43,15 @@ func TestManifests(t *testing.T) {
 				operatorv1alpha1.AuthenticationConfig{StaticUsers: &operatorv1alpha1.StaticUsers{HtpasswdSecret: operatorv1alpha1.ObjectReference{Name: "test-secret"}}},
 				operatorv1alpha1.AuthenticationConfig{ServiceAccount: &operatorv1alpha1.ServiceAccount{}},
 				operatorv1alpha1.AuthenticationConfig{Anonymous: &operatorv1alpha1.Anonymous{}},
+				operatorv1alpha1.AuthenticationConfig{ClientCertificate: &operatorv1alpha1.ClientCertificate{
+					CertificateAuthority: operatorv1alpha1.ObjectReference{Name: "client-ca"},
+				}},
+				operatorv1alpha1.AuthenticationConfig{APIKey: &operatorv1alpha1.APIKey{}},
+			},
+			RateLimit: &operatorv1alpha1.APIServerRateLimit{
+				QPS:                  20,
+				Burst:                40,
+				MaxConcurrentStreams: 10,
 			},
 		},
 	}
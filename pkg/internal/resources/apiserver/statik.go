@@ -22,6 +22,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x93Ak\xdc0\x10\x85\xef\xfe\x15\x0f\xb6\xd0\x06j\xbb\xe9\xd1\xb7\xc5,%\x87,e\x13\xd2c\x99\x95\xc7\xb6\xb0,\x19\xcdx\x93\xb4\xf4\xbf\x17\xd9\x81%i	\xec\xcd\xc8\xdf\xbc\x19\xbdy\xda\xe0\xbeg\xb4\xc1\xb9\xf0h}\x87\x91\xbcmYT`\x82W\xb2\x1e\x04a\xd7\xe6b;\xcf\x0d\xac\xc8\xcc\x11\xf5\x01\xe4\x1b\x10\x0cG\xb5\xad5\xa4\x8c\xfaPd\x1b\xdc\x86\xc8h\x82\x99G\xf6\nC\x1e\xc7\xd4`N\xb8\xa2W\x9d\xa4*\xcb&\x18)Rq>\x92\xa7\x8ecaC\xb6\xc1\x8f\xeda\x7f\xb3\xffV\xe1\x9eb\xc7*\xa89\xea\xedJ\xe0Kq}\x0d\xd3\xb3\x19\xde\x95)\xd9\x97\x8e\x94EK%\x19\xa4\x9c\xa7.Rc}WZ\xdf\xf0S\xd1\xeb\xe8\xd0\x86\x88cd\x1a\xd2\xadMO\xbec\xc9h\xb2\x0f\x1c\xc5\x06_\xe1\xad\xea\xe9\x9a\xdc\xd4\xd3\xd7l\xb0\xbe\xa9p\xb3\x18\x91\x8d\xac\xd4\x90R\x95\x01\x9eF\xae0\xccG6\x14\xa3\xe5\x98\xd3d\x85\xe3\x89c\x9e<\\-\xccW\x0b_x\x99\xc8p\x05y\x16\xe51\x93\x89MRJ\xf4\xddBW\xf8\xfd'\xcb\xf3\xfc\x92\xd1\xea\xf3N.\x98/\x9e\xac\xef\xf2$\x0dl\xa0\xbd\x95\xa5\x00\xd2\x87\xd95\x18IM\x0f\xed\x19\xc13h\x9a\x98b\xca\x83\xc70\x8b\x86\xd1\xfeb\x13|k\xbb\xe2\x99F\xf7\xee\xed6\xf8\xf0\xe9nwx\xb8\xa9w?\xf7\xdb\xdb\xdd\xd5\x92\xa5\xd7gw\xdf\xb7\xf5\xee\n\x8f\xd6\xb9\x94\x1f\x99\x8f\xa2Vg\xe5\x06\xc7\xe7s\xcb\x0ch\xbc\xecS\xab\xa4\x9c\xbfU.\xfe\xa7Z\xc8\xc9\\\x04\x17\xc6\xcd\xa2\x1c\x0b\x17\x0c\xb9\xa5t\xf9\xea\x83h\x86\x977q\xe06\x8d\x00\xbc\xcaG:\xb84\x17\xc2&\xb2\xee\x97\xaa3\xa9N\xd6\xed\xbc,g\xa5V\x87|\xd0\xe4\xd2\x14\xb9\xb5O\xdc|\x86Xo\x18V?\xca\xf2o\xcd\xca?\xd6Y\xa9\xb7\x154\xce\x9c\xfd\x0d\x00\x00\xff\xffPK\x07\x08\xb8\xb6v\xad\xe3\x01\x00\x00\x08\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2UHN-*\xc9L\xcbLN,I\xd5\xabL\xcc\xcd\xe1\xe2J\xce\xcfK\xcbL/-J,\xc9\xcc\xcf\x03\xab\xc9.-.\xc9\xcf\xcd\xacJ\x85HA\xd4\x01\x02\x00\x00\xff\xffPK\x07\x08\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\xce\xb1J\x04A\x0c\xc6\xf1~\x9e\xe2\x03\xeb\xf3\xfam\xadl,N_ \xcefv\xc3\xb9\xc9\x92dV\xf0\xe9e\x04AA-\xac\xc3\xf7\xcb\xff\x06O\xab\x04\xaai\x93\xa5;\xa5\x98B\x02\xcd\x1c\xc9TW\xd1\x05\xd7\x1ei\x9b\xbc1V{E\x1a\xfa>S2\x946\x86s\x03\xe9\x8c\x83\x1c\xd1\x9f#%\xfbP\xca\xb8^\xb8\xb1\xb3V\x9e\xca	W\xd1y\xc2}Dg/\xc0\xe2\xd6\xf7	\x95=O\x1b)-\xec\xb7b\x05h\xc2/\xf3\xe3\xce5\xa6\x02|\xee\xee\xd8S\x9aTJ.\xc0\x1fs`\xa7\\'\xc4\xce\xf5,\x1f\xdf.\xdc\xce#\xa7\x94\x83\xfc\x87\xa6\xef\xf6\xaf\xf2\x17\xb7\xda\xb6\x99>\x0c\xf3\xff\xc8\xac1\x84(\xef\x01\x00\x00\xff\xffPK\x07\x08@\xa4D\x18\xbf\x00\x00\x00\x86\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xd4UOo\xe3\xb6\x13\xbd\xf3S\x0c\xa2\x83\x7f?`-\xa3=\xfa\xe6\x1an7h\xf3\x07Iv{(\x8a`D\x8e,n(\x8eJRv\xbc\x9f\xbe\x18\xfd\x89\xe4\xb4\xdb\x14\xe8\x02Eo\x129o\xde\x9b7#M\x06\x1bc\"x\xac)6\xa8	\x12\x03:\x07\x81\"\xb7AS\xcc\xd5\xcb\xdd\x1a\x9e\xda\x824\x86`),\xe3)&\xaa\x95\xca\xe0#\xba\x96\x80KH\x95\x8dPZr\x06l\x84&PC\xde\x90\x91\x9c\xa9\"\x95\xf54\x12yF\xf1\x0e(\xdf\xe7\x80`\xa8q|\xaa\xc9\xa7.\xd2\xa8\x0c.\x8e\x1cL\x13(\xc6\x0b(H\xb3\xe0/\xd0YMq9]\xe5*\x83kN\x04\xa9\xc2\x046A\xac\xb8u\x06\xd0E\x86\x1a\x93\xae\xe0hS%*DVi\x9f\xe1\x7f\x89\x9e\x13\x14Tr X,\x17\xff\xef\x0b\xa0\xc9\n\x95\x0d\xb5`\xc1\x07\xea}\xb8\xed\xc0\xe7F`c\x97\x91\xc2\x81\xc2R\xdc\xf8	\x0br\xb1\xf3\xd1\x98?\xd8	\xe8\x0dDr\xa4\x13\x87\x98+\xcdu\xcd\xbe\xc7\xac\x15\xcc\x13\xe7\x96W\x81\x1d\xadabP\xaa\xc0Hq\xad\xb2%\xe4\xf9J\x07\xa3\xba\x87P\xa0\xee\x9fj\xf4\xb8\xa7\xa02\xf8\xe5\xe7\xddw\xefon~\xfc\x15\x1e\x18\xc8c\xe1\x08\x8eTT\xccO\xef\xa0\xf5\xc2,N\x8b<\xa9;\x92N\x96}\xec\x9d\x9a\xc0\x83_\xd6k\xd7\x1a\xeb\xf7]0{\x02\xebA\x07\xb3zjc\xe2\xda~FA\xe7'\xac\xdd n\xe0\x12%\xdb\xdd\xdd\xc3\xd5\xe6z\xf3\xc3\xeen\xaeFSH\xcbA\xf0kI\xe7r\x16\xb3\x0c\x8b\x1c\x16\x83\xba\x05h\xae\x1b\xf6\xe4S\x04\x0c\x04\x81~km \x93\x8f\x06QH3Gn\xefn\xaev\x0f\xefw\x1f\xee\xe72\x9a\xc05\xa5\x8a\xda\x085{\x9b\xf8-1S\x9a\xc5H4\xe5P\xaa\x91\x81\xa3x\x9f\x02&\xda[}EaO\xd2\xdc\x0c.K8q\x0bG\xf4I\x1e\x02h\xf6)\xb0s\x14F#dd\xe8\xb9\xe1H\x9d\xd3\xab\x9aR\xb0:vp\xf2\xa6a\xeb\x13\x1cW\x0c\xe8O\x80m\xaa\xfc\xea\xf3\\\xaf\x80Jv\x8e\x8f\xd2,g=\xc9\xccu\xf01d`z\x14\xf4c\x13\xf8\xf9\xf4\xd8i\xee\xba\x97w\xa17\xde\x9d\xba.s\xf9\xd7\xe1/\xc9\xc7\xa8\xc9\x89\xc7A\xfa<z\xf80\x0b\x1a\xcc\xef;\xf5\xb7\xb0\xea\xdf\x9e\xe9Q\xe5@\xf8Z\xdaW\x1er\x95\xc1\x87\x97\xe0\xb3\xab	\xf6E\xb9\xdd\x10\xf5\xfcR\xdav\x03\xd6\x7f\xeaQ\x02\x9234\xb5\x8dQ\x0e\x86z\xa2P\x9e\x13y\"\xd3\xfd\xca\xa6~\xc9[\x1b	4N)\xc5\x9c!\x89\xc6\x97\xd3W\xfe\x9c\xcf\xa5f_\xda\xbd\xac\x89\x92\x03$B]\xc9\xf1X	A\xc5G\xa12\x0c\x07\x0c\x10\xdb\"&\x9b\xda\x8e\xed\x80A\xfe\x80_\xdb\xf1a*\xba\x81\x94\x7f\xfd\x1a\xe4\xf6\xf2\xfb\xcb\xed\xe6a\xf7x\xbd\xb9\xda\xdd\xdfn\xb6;\xc8f\xdbr\xd8\x19\xc2hK\xab1\x11l\xefT\x06\xc0\xc5\xa7@\xa5\xa8\x04\x80'\xeb\xcd\x1a\xb6SP\x7f\xbc\x0f\xdc6\xeb3\xb9\xb9\xe5\xfe\xee@Az\xb3\x86\xc37\xe8\x9a\n\xbf\xed\x8f{]\xb2\x0b\xac\xdf/\x05	Y\xbfv\xe5f\xfc\xba\xfa\x8d7\x9f\xe9\x89z\x98f\xe8w\xdb\xa4\xb1{m0Uk\xa8)\xa1\xc1\x84\xf9l\x15~\xc9\x92\xff@\xad\xa3\xf4\xfb\xdd\xdd\xc7\xcb\xed\x1b\x9d\xec\xe84\xa9\xa9\xaa\xa9\xa8\xfb\x97\xbb3\xcdj\x12;\xae\x9a\xb9\xbbo\x9b\xfbg\x02\xff\xa1\x80\xdf\x03\x00\x00\xff\xffPK\x07\x08:\x8e\xec\xdfk\x03\x00\x00\xd7	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8l\x90Mn\x840\x0c\x85\xf79\x85/\x80\xe8\x0f\x8b6\xdbv;-\x8b\xaa{7\xb83\x88$\x8ebC\xc5\xed\xabt\n\x021\xde@>???\x19S\xffIYz\x8e\x160%\xa9\xa7{3\xf4\xb1\xb3\xf0J\xc9\xf3\x1c(\xaa	\xa4\xd8\xa1\xa25\x00\x11\x03Y\x08\x18\xf1L\xf9\xff-	\x1dY\x90Y\x94\x82\x91D\xae(\x95B\xf2\xa8T\xfe\x01\x16Z\xcaqT\xec#eYHu\xf0\xbdV\xe2\xac\xab\xa8\xc8\xd6\xd1\x96\xb3Zxn\x9a\xc7\xb5\xbb\x84\xfb\xa1\xaf\x0b\xf3P	\xe5i\xe3\x05\x902+;\xf6\x16>^\xda\x95O\xec\xc7@'\x1e\xe3~U(\xa4E\xbdX\xa85\xa4zx\x92jo]\x97O\x1f\xcf\x95\xa3\xacr\xc8Q\xe8\x06f\xc2\xee=\xfa\xd9\x82\xe6\x91\xccv\xf9\xe1\x0e\xbbQ!\x97I\xed\xc6\xaa\xa3o\x1c\xbd\x9e\xb8#\x0b\xcd\xc3\xdd\xa6u\x15\xbf\xdd8\xc4_J\xf3\x1b\x00\x00\xff\xffPK\x07\x08\xf4Eb\xc7\xef\x00\x00\x00\xf1\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xc4\x90?k\xc30\x10\xc5w\x7f\x8a\x83th\x06%d+\xde\x82q!CJiC:\x96\x93t\xb1\xaf\xb6\xa4 \x9d\x1d\xdaO_\x94\x84\xfe\x81th\x97\x8eB\xefq\xbf\xdf\x9b\xc0\xa6\xe5\x04{\x14\xd3\x02Z\x0b\xe8}\x10\x14\x0e\x1e$\x00Z\xc7)\xe5\xc7\x81t\x1bB\x07&\xf8\x1d7\x80\xde\x16\x13\x90\x96`\xc4\xc8\xa8{Jpu]\xd5\x0f\x9b\xd5\xed\xaaZn\xea\xe7\xbb\xe5\xba~\xbc_V\xf54\x87/|N\xe1\xc0}\x0f\x9a \x0d:	\xcb dA\xbfB7$	\x8e\xdfhV\xe0\x9e\xb7\x143@\xf9\xc9\x12\xa9\xe1$\xf1\x089\xebn\xd2\x8c\xc3|\\h\x12\\\x14\x1d{[\xc2z\xc8\n\xbey:AWG\xe6\xe1\xd4(\x1c	Z\x14,\x0b\x00\x8f\x8eJp\xe7\xb8:K*\xf3\xad\x00_FI\xb9\x05`(\x8ar\xe8\xb1\xa1\x98\xcf\xb3\x7f!#\xca\xa0\xda\xc5\xe0\xca\x1f\xa7\x98_\x98\xa1PJ\xfdUt\x8b=\xdb\xdf\xa8\x8e\x1f\x85\x7f\x92}\x0f\x00\x00\xff\xffPK\x07\x08l\xd7\xcf&\x03\x01\x00\x00q\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xccKLO-\xd2\xabL\xcc\xcd\xe1\xd2U(N-*\xcbLN\x85p\x01\x01\x00\x00\xff\xffPK\x07\x08\x1fA\xf7\xf4+\x00\x00\x00)\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4\x92O\x8f\xd3@\x0c\xc5\xef\xf9\x14\xd6\xdeKS\xd8\x03\x9a\x1b\x02\x89\x0b\xa0J\x8b\xb8\xbb\x93\xa7]\xab\xf3om\xa7R\xbf=J\x1b\xaa\x04A/\xebS\xf2\xfc\xf4\xf3\x1b\xcfp\x93_P\x93Z\x02qk\xb6=\xed\xba\xa3\x94!\xd0\x17\xb4T\xcf\x19\xc5\xbb\x0c\xe7\x81\x9dCGT8#P\xe6\xc2\xcf\xd0\xf9\xdf\x1aG\x04\xb2\xb39rG\x94\xf8\x80d\x93\x9b(\xd6\xe2Z\xd3\xa6%.\x98f\xc8\xc6\xa0'hg\x0dq\xf2\x18\x12\xa2W\xbd\xfa3{|\xf9\xb6\x00\xdcC\x10)Z\x92\xc8\x16h\xd7\x119rK\xec\x98Q\x8b\xd8S\xa5\x15\xf5>\x97\xe8O\xbc\xcb7\xf4$\x11\x9fb\xacc\xf1\x1f\x97\x15\x18/\xe2\xb1\x14\xe8\x0d\xbd!\xc9\xfc\x8c@\xaf#\x9f\xdfI\xdd\x1e\xc7\x03\"\xab\nt\xcbM\xaeSBbs\x98\xdf\xf2\xfc\xbd\xdak)\xac\x8e\x1a\xb1\x08N\x94$\x8b\xaf\x14\xa2\xd8\xc6@\xbb\xbe\xef\xf3J\xce\xc8U\xcf\x81\xde\xf7\xfdwYt\x14\xaf#\xec\x7f\x90\x7f3v+\x86\x82\x07)0\xdbk=`\xc9\xf1\xd8\x9ej<\xc2\xd7\xf0V\xd5\x03\xbd\xb87\xbb\xe9INx+c\xd2V\xe7\xd8\xcc\xbb\\\xdbhyY\xfb\x0b\xe7\xe3\xe3\xe3\x875^\xab\xd7XS\xa0\x87\x9f\x9f\xf7\x0fs\xcf\xa1Y\n\xbb\xd4\xf2U9b\x0f\x95:<!\xd62LO\xaf\xef~\x07\x00\x00\xff\xffPK\x07\x08\x8b\xe9\xb1\xa1]\x01\x00\x00H\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/service.yamlUT\x05\x00\x01\x80Cm8\x84\xcf1j\x03A\x0c\x85\xe1~N\xf1p\xbfE\x88\xabis\x01CBze\xfcp\x86\xcc\x8e\x84$\x16r\xfb\xe0\xdd\xedS\xbe\x9f\x0f\x81\xc4\xfa'=\xba\xce\x8a\xed\xa5\xfc\xf4y\xafx\xa7o\xbd\xb1\xacL\xb9KJ-\xc0\x94\x95\x15\xabLy\xd0\xcf\x1d&\x8d\x15\xf1\x1b\xc9\xb5\x00C\xbe8\xe2\xa9\x81\xa63]\xc7bC&+\xc4\xfa\x12\xf4\x8d^\xc2\xd8\x9e&8\xd8R\xfd?\x0f\x98z\x9eg\x17\x98kj\xd3Q\xf1\xf1v\xdb\xdb\x01*\xae\xd7\xd7s\xa7\xf8\x83y\xdb\xebw\xa6\xc5\xd9\x8f'.{\xba\x94\xbf\x00\x00\x00\xff\xffPK\x07\x08\xb8\x0b/\x01\x9d\x00\x00\x00\xfc\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8\xcd\xcf\xcb,\xc9/\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8\x84\x8e\xb1j41\x0c\x84{?\x85\xe0o\xfe\x14\xbe#\xad\xdf\xe1 \x10H\xafx\x87\xac\x89m	IY\xc8\xdb\x87\xcd9E\xaat\xe2c\xf4\xcd\xa4\x7f\xf4d2\x10;>\x9cn2[\x88\xd13\xech\x15\xf4\xff\x86\xb0V\xfd!\xb1\xb6\x17\x987\x99\x85\xc6=\xd5\xe6\xdb\xa5\x8aA\xfcRe\\\x8f\xc7\xf4\xde\xe6V~\x9e\x97+\x0d\x04o\x1c\\\x12Q\xe7Wt?/\xa2*3Lz\xd6\xce\x13\x85X[v\xd8\x01KD\x93\xc7/\x94\xc7}G^\xd5+\xe2\xca\x15\x85\xfc\xd3\x03#\xb9\xa2\x9ej\xccM\xa5\xcdX=\x99\x94c/t]\x8eoH\xa4bQh\x8f\xd0\x938:j\x88\xfd5\xed+\x00\x00\xff\xffPK\x07\x08\xff\xc9\xc1\xf1\xb6\x00\x00\x00/\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8D\x8e=K\x051\x10E\xfb\xfc\x8aa\xfb\xac\xd8IZ[\xab\xa7\xd8\x88\xc5l\xde%o\xd8|,\x93\xc9\x16\xfez\xc9\"x\xcb\x03\xe7p\xbd\xf7\x8e\x0f\xf9\x84vi5\x90n\x1cW\x1e\xf6h*?l\xd2\xea\xba\xbf\xf4U\xda\xd3\xf9\xecv\xa9\xf7@\xafyt\x83\xdeZ\x86+0\xbe\xb3qpDQq	\x1fR\xd0\x8d\xcb\x11\xa8\x8e\x9c\x1dQ\xe5\x82@\x9c\x92/\\9A\xbdN\x99SR\xa4\xcb\xb9\x8d\x8c\xab\xf1\xdf~GF\xb4\xa6}r\"O\x85->\xdexC\xfeCs\xcb>6DV\x15\xe8|\xc9\x87t\xe8	]\x02-\xa6\x03\x8b\xd3\x91\xd1\x03}}\xbb\xdf\x00\x00\x00\xff\xffPK\x07\x081hoh\xaf\x00\x00\x00\xed\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd1\x8e\xc2@\x0c\x85\xe1~N\xe1\x0b$\xab\xedV\xd3-\x14\xf4A\xa2w&f0I\xec\xc8\xe3I\xc1\xe9Q\xa4\x88\x06A\xf7\x8a\xf7\xeb\xc3\x85/d\x85U\"X\x8f\xa9\xc5\xea75~\xa0\xb3J;\xfe\x95\x96\xf5g\xfd\x0d#\xcb\x10\xe18\xd5\xe2d\x9dNt`\x19Xr\x98\xc9q@\xc7\x18\x00\x04g\x8a\x80973\nf\xb2\xc6t\xa2~\x7fn\xbb\xa3\xebv\xc4\x85O\xa6u\xf9\x82\x06\x807\xf3#\x11J\xed\xef\x94\xbc\xc4\xd0\xec\xd9\x99l\xe5D\xff)i\x15\x7f\x95\x05\xc33\x00\x00\xff\xffPK\x07\x08\x9cNx\xd9\x96\x00\x00\x00\xf4\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U(\xca\xcfI\xd5\xabL\xcc\xcd\x81\xb2\xe3\x932\xf3R2\xf3\xd2ab\x89\xe9\xe9\xf1\xc8j`|tu9\xa9\x89)\xa9E\xf1\xa99\xa9\xc9%\x99\xf9y(z\xb0\xc9\xa1\xea\x07\x04\x00\x00\xff\xffPK\x07\x08\\\x1f\xb4\x9fG\x00\x00\x00\x93\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\x03A\x0cD\xfb\xfd\n+\xd4w\x11\x1d\xba\x1f\xa0\xa7\xa0wv\x87d\x95\xbd\xf5\xca\xf6\x1e\x12_\x8f\xeer\xa1\x00!A\xe5\x91\xedy3\x0f\xd4\xa0s6\xcbR\x8d\\(	\x15p\x82\x12\n\xa2g\xa9c\xe0\x96_\xa1\xeb\xcbDz\xe28r\xf7\x8bh\xfe\xe0\xed~}\xb21\xcbqy\x0c\xd7\\\xd3D/R\x10f8'v\x9e\x02Q\xe5\x19\xd3\x8e\x1d\xee\xd8A\xd77\xed\x056\x85\x81\xb8\xe5g\x95\xdel5\x0ct8\x04\"\x85I\xd7\x88}\x17\xa5\xbe\xe5\xf3\xcc\xcd\x02\xd1\x02=\xed\xfb3|\x9b%\xdbM\xbc\xb3\xc7\xcb\xcd\xa2`\xc7&{Kw\xd9\xbe\xee	\x05\x8e\xff\xc6\x1f\xcd\xd9\xfb/-~\xe4\xfc	\x8e\x05\xd5\xbf\x11\xf7\xf2\x9f\x01\x00\x00\xff\xffPK\x07\x08\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8d\xb1\xae\xc20\x0cEw\x7f\x85\x7f\xa0}z\xdbS\xb6\xc7\xc2^$v71`\x9a\xda\x95\x93t\xe0\xebQ\xa5\n\xb6nw8\xe7\x1eZ\xe4\xca^\xc44\xa0\x8f\x14{j\xf5a./\xaab\xdaO\x7f\xa5\x17\xfbY\x7fa\x12M\x01\x07\xcb|\x12M\xa2w\x98\xb9R\xa2J\x01\x10\x95f\x0e\x98\x99\x12{\xc7\x99\xe3fwn\x99\xc7\x9d\xde\xf6\xc0\xb7\x0d\xa6E\xcenm9(\x02\xe27x\xf8\x0f\xa5\x8dO\x8e\xb5\x04\xe8v\xe7\xc2\xbeJ\xe4\xff\x18\xadi\xfd\xd8\x85\xe0\x1d\x00\x00\xff\xffPK\x07\x08+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xb4\x901k\xf3@\x0c\x86\xf7\xfb\x15\"\xbb\xfd\xf1m\xc5k\x87\xee\xa5t\x97\x957\x8ej\xfbdt:\x07\xfa\xebK\xd2\x0c%\xc9\x90\x162\xdd!\xd0\xf3\xe8}S\xd34\x89\x17}\x87\x17\xb5\xdc\x91\xf7,-\xd7\xd8\x9b\xeb'\x87Zn\xc7\xa7\xd2\xaa\xfd[\xff\xa7Q\xf3\xb6\xa3\xe7\xa9\x96\x80\xbf\xda\x844#x\xcb\xc1]\"\x12\xc7i\xe1Mg\x94\xe0y\xe9(\xd7iJD\x99gt4s\xe6\x01\xde\xf8q\xd1\xeb\x84\xd2\xa5\x86x\xd1\x17\xb7\xba\x94#\xa2\xa1\xcd&\x119\x8aU\x17\x9cg\x05\xe2\x88\x92\x88Vx\x7f\x1e\x0e\x88\xd3;i\xf9\xfe\x1c8d\x7f\x0d<fA\x0e\x95\x9fa\xae\x1da#\xb2cU\x1c.D\xa7X\xb8\x0d\xbe,\xe9\xc6\xed\xb5\xff\x80\x04\x8b\xa0\x94_\xf1\x85\x83'\x1b\xda\xb1\xf6\x10vW\xf8M\x03\x8bX\xcd\x7f\xad\xe7^\x8b\xedvp\xcd\xc3\x835\x8b\xdb\xaa[\xf8\x835\x8eA-\xdf'\xf9\n\x00\x00\xff\xffPK\x07\x08\x14#5\xf0\xf7\x00\x00\x00#\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8f\xb1j\xc3@\x0c\x86w=\x85^\xe0\\\xba\x95\xdb\xda\x0e\xdd]\xc8.\x9f\x15G\xb1-\x19\xdd\x9d\x87<}0\x84`\x88\xc9\xa6\xe1\xd7\xf7\xf1\xd1\"'\xf6,\xa6\x11\xd7O\x18E\xfb\x88\xff\xec\xab$\xfeN\xc9\xaa\x16\x98\xb9PO\x85\" *\xcd\x1c1\x13\x84\x10`\xff\xec\x1d\xa5\x86j\xb9\x98\xcb\x8d\x8a\x986\xe3Wn\xc4>\x9e\xd8\xdf\xa9\xe6\xc2\xde\xda\xc4?\xa2\xbd\xe8p\x80\x9eIi`\x0fn\x13w\x8f\xd5v\xb7|\xdeF\xb4\xc8\x9f[]\xde\x08\x01\xf1\xc5w\x88\x87\\\xbb+\xa7\x92#\x04<,\xdf\xf5\xde\x03\x00\x00\xff\xffPK\x07\x08\xc0\xe9E\xce\x9f\x00\x00\x00)\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xcc\xcbLK-.)\xd6\xabL\xcc\xcd\xe1\xd2U(N-*\xcbLN\x85p\xb9\x92\xf3\xf3\xd22\xd3K\x8b\x12K2\xf3\xf3\xc0\x1a\xb2K\x8bK\xf2s3\xabR!R\x10u\x80\x00\x00\x00\xff\xffPK\x07\x08\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92\xb1n\xa4@\x10D\xf3\xf9\x8a\x926>\xb8\xbd\xe8Dz\xd1\x05N\xbc\x92\x1d\xf7B\x03-`\x1a\xf7\xf4\x80\xec\xaf\xb7\x00YZG\xdeh\xd3V\xe9\xd5\x9b\xd2\x9c\xe0=\xa3\xd5q\xd4Ub\x87Zc+\x1d$\xa1U\x833\xd5\xfdv\x1err\x9d\xe4\x83\xb1\xf6l\x0cW\x8c\xaa\x03\xc8\xb7CD\xca\xd7\xe4\xe2\xd9\xb7\xf0B\x96\x8ap\xc2\x7f\x87\xf1[\x16\xe3t\x03X\xfe\x14\xe7\xe27\xd4\x10ye\xdbP\xab\xda\x80\xd9tf\x1b\xdf\x8b\x10i\xe2gn\xd98\xd6\\\x85_\x18$6\x15.l\x8b\xd4\x1c\x80\x85-\x89\xc6\n\xcb9\x00\xad\xf0\xd8\\f\xaeS\x15\x80\xaf\xf4Sv\xdal^\xf9\xda\xab\x0e\xff\xf6we#\x17\x8d\x01\x00:\xd3<W\xa0f\x92\xb4\xd1\x8c;I~\x04\x8a\xe1o*D\xf7\xdcL\xdeWX\x0fL*\xebQ8\xfa\x81+\xd3\xa1Tn\xc67\xdd/4J\xf3\xe0\xf6}\xb54\xd3\xedb?lp\x9f\xc3\xbd\x06{w\x00jcr\xae\xe0\x969\xdc\xbd\xc8\x03\\\xc2B\xf6\xed[\x1d\xb4\x89\x9d\x1ar*)F\xf5\xbd0\x85\xcf\x00\x00\x00\xff\xffPK\x07\x08w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x01\x00\x00\xff\xffPK\x07\x08\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8dA\xaa\xc30\x0c\x05\xf7>\x85.\x90\xc5\xe7gS\x9d\xa2P\xe8^u\x1e\xadIb\x19I\xa4\xf4\xf6%vw\x9aA\xc3K\xd2\xca\x1d\xe6E+\xd3\xf1\x97\xd6R\x17\xa6\x1b\xec(\x19iG\xc8\"!\x9c\x88\xaa\xec`z\xe3\xf1R]'\xff}\x0c\xefM2\x98\xfc\xe3\x81=yC>\x8b\xa6\x16~\x1eDS\x07\xa6y\xfe\xefL\x14bO\xc4\xb5\xdb\xcb\xd0\x8e\x0d9\xd4F\x92\xb5\x86\xe96\xb5M*\x98\xa4\x95>\nK\xdf\x00\x00\x00\xff\xffPK\x07\x08\xf2Pc*\x84\x00\x00\x00\xb4\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb8\xb6v\xad\xe3\x01\x00\x00\x08\x04\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x806\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(@\xa4D\x18\xbf\x00\x00\x00\x86\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xcc\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(:\x8e\xec\xdfk\x03\x00\x00\xd7	\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe2\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4Eb\xc7\xef\x00\x00\x00\xf1\x01\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9e\x07\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(l\xd7\xcf&\x03\x01\x00\x00q\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe6\x08\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x1fA\xf7\xf4+\x00\x00\x00)\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80E\n\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x8b\xe9\xb1\xa1]\x01\x00\x00H\x03\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc1\n\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb8\x0b/\x01\x9d\x00\x00\x00\xfc\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80i\x0c\x00\x00manager/service.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80Q\x0d\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xff\xc9\xc1\xf1\xb6\x00\x00\x00/\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc5\x0d\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(1hoh\xaf\x00\x00\x00\xed\x00\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc9\x0e\x00\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x9cNx\xd9\x96\x00\x00\x00\xf4\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc1\x0f\x00\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\\\x1f\xb4\x9fG\x00\x00\x00\x93\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa8\x10\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80=\x11\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80X\x12\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x14#5\xf0\xf7\x00\x00\x00#\x03\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80F\x13\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc0\xe9E\xce\x9f\x00\x00\x00)\x01\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x82\x14\x00\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80n\x15\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80	\x16\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80{\x17\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf2Pc*\x84\x00\x00\x00\xb4\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xcd\x17\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x16\x00\x16\x00\xf6\x06\x00\x00\x9c\x18\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x93\xcfk\xdbN\x10\xc5\xef\xfa+\x1e\xf8\x0b\xdf\x06*\xb9\xe9Q7#L\xc9!\xa68!=\x96\xf1j$\x0d^\xed\x8a\x9d\x91\x93\xb4\xf4\x7f/k\x05BR\x08\xf8\xa6\x1f\x9f}\x9a\xf7\xe6i\x85\xfb\x81\xd1E\xef\xe3\xa3\x84\x1e#\x05\xe9XM\xe1b0\x92\x00\x82\xb2\xefJ\x95>p\x0bQ\x9d9\xa1\xd9\x83B\x0b\x82\xe3d\xd2\x89#c4\xfb\xaaX\xe16&F\x1b\xdd<r08\n80\xba8g\xdc0\x98MZ\xaf\xd7mtZ\xe5\xc3\xe5H\x81zN\x95\xc4b\x85\x1f\x9b\xfd\xeef\xf7\xad\xc6=\xa5\x9eM\xd1p\xb2\xdb\x85\xc0\x97\xea\xfa\x1an`w\xfcPf\xcda\xed\xc9Xmm\xa4G]\xcfS\x9f\xa8\x95\xd0\xaf%\xb4\xfcT\x0d6zt1\xe1\x90\x98\x8e\xd9\xb5\x1b(\xf4\xac\x05M\xf2\xc0I%\x86\x1a\xefUO\xd7\xe4\xa7\x81\xbe\x16G	m\x8d\x9bs\x10\xc5\xc8F-\x19\xd5\x05\x10h\xe4\x1a\xc7\xf9\xc0\x8eR\x12N%M\xa2\x9cN\x9c\xca\x9c\xe1\x12a\xb9D\xf8\xc2\xebD\x8ek\xe8\xb3\x1a\x8f\x85N\xec\xb2R\xa6\xef\xcet\x8d\xdf\x7f\x8a\xb2,/\x19\xady\xdd\xc9\x05\xf3\xa5\x93\x84\xbe\xcc\xae\x81\x15l\x10=\x1f\x80\x0eq\xf6-F27\xc0\x06F\x0c\x0c\x9a&\xa6\x94\xfb\x10p\x9c\xd5\xe2(\xbf\xd8\xc5\xd0I_=\xd3\xe8?t\xb7\xc2\x7f\x9f\xee\xb6\xfb\x87\x9bf\xfbs\xb7\xb9\xdd^\x9d\xbb\xf4\xf6\xd9\xdd\xf7M\xb3\xbd\xc2\xa3x\x8f\x03C\xe7\x83\x9a\xd8l\xdc\xe2\xf0\xfc\xfa\xc9\x02h\x83\xeehd\xcd\x0e\xcb\xf7\xca\xd5\xdb\xfbE\xb5\xd2\x93\xbb\x08\xae\x9c\x9f\xd58U>:\xca\xdeJ\x9c\xaf\x86\xa8V\xe0\xe5\x9f\xd8s\x97G\x00\xde\xf4\x03\xb8\xbc\x17\xca.\xb1eS5^I\xf3\xbal\xe7e9\x0b\xb5$\x14\xa2\xe5\x94\xa6\xc4\x9d<q\xfb\x19*\xc11\xc4\xfe\xd7\xf3\xbb\xa5+\xffD'\xdaljX\x9a\xb9\xf8;\x00PK\x07\x08\xb8\xb6v\xad\xe4\x01\x00\x00\x08\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00F\x00\xb9\xffresources:\n- certificate.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\xce\xb1J\x04A\x0c\xc6\xf1~\x9e\xe2\x03\xeb\xf3\xfam\xadl\xae8}\x818\x9b\xd9\x0d\xe7$K\x929\xc1\xa7\x97\x15\x04\x05\xb5\xb8:|\xbf\xfc\xef\xf0\xbcJ\xa0\x9a6Y\x86S\x8a)$\xd0\xcc\x91Lu\x15]p\x19\x91\xd6\xe5\x9d\xb1\xda\x1b\xd20\xb6\x99\x92\xa1\xd4\x19\xce\x0d\xa43\xae\xe4\x88\xf1\x12)9v\xa5\xec\xd737v\xd6\xcaS9\xe0\":Ox\x8c\x18\xec\x05X\xdc\xc66\xa1\xb2\xe7\xa1\x93\xd2\xc2~/V\x80&\xfc:?m\\c*\xc0\xd7\xee\x81=\xa5I\xa5\xe4\x02\xfc3\x076\xcauBl\\\x8f\xf2\xf9\xed\xcc\xed\xb8\xe7\x94r%\xff\xa5\xe9\xa7\xfd\xa7\xfc\xcd\xad\xd6\xbb\xe9i7oGf\x8d\x13u\x8e\xf21\x00PK\x07\x08@\xa4D\x18\xbd\x00\x00\x00\x86\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xd4UMo\xe36\x13\xbe\xf3W\x0c\xa2\x83\xdf\x17X\xcbh\x8f\xbe\xb9\x86\xdb\x0d\xda| \xc9n\x0fEa\x8c\xc8\x91\xc5\x0d\xc5QI\xca\x8e\xf7\xd7\x17#\xc9\x96\x9cv\x9b\x02]\xa0\xe8M\xe2|=\xf3\xccCN\x06+c\"x\xac)6\xa8	\x12\x03:\x07\x81\"\xb7AS\xcc\xd5\xd9\xb6\x84\xe7\xb6 \x8d!X\n\xf3x\x8c\x89j\xa52\xf8\x88\xae%\xe0\x12Re#\x94\x96\x9c\x01\x1b\xa1	\xd4\x907d$g\xaaHe}\x19\xf1\xbc(\xf1\x0e(\xdf\xe5\x80`\xa8q|\xac\xc9\xa7\xce\xd3\xa8\x0c\xae\x0e\x1cL\x13(\xc6+(H\xb3\xc4_\xa1\xb3\x9a\xe2|4\xe5*\x83[N\x04\xa9\xc2\x046A\xac\xb8u\x06\xd0E\x86\x1a\x93\xae\xe0`S%(\x04Vi_\xe0\x7f\x89^\x12\x14Tr \x98\xcdg\xff\x17Xb?\xb7\xab\xb2\xa1\x17,xO=\x0f\xf7]\xf0%\x11\xd8\xd8y\xa4\xb0\xa70W*\x83\x9f\xb0 \x17\xa5g4\xe6\x0ft\x02z\x03\x91\x1c\xe9\xc4!\xe6Js]\xb3\xefc\x96\n\xa6\x89s\xcb\x8b\xc0\x8e\x960VP\xaa\xc0Hq\xa9\xb29\xe4\xf9B\x07\xa3\xba\x8fP\xa0\xee\xbfj\xf4\xb8\xa3\xa02\xf8\xe5\xe7\xcdw\xef\xef\xee~\xfc\x15\x9e\x18\xc8c\xe1\x08\x0eTT\xcc\xcf\xef\xa0\xf5RY\x98\x96QH\xdf\x91t\xb2\xecc\xcf\xd4\x18<\xf0e\xbdv\xad\xb1~\xd79\xb3'\xb0\x1et0\x8b\xe76&\xae\xedg\x94\xe8\xfc\x88\xb5\x1b\xc0\x0d\xb5\x04\xc9z\xf3\xf0t\xb3\xba]\xfd\xb0y\x98\xa2\xd1\x14\xd2|\x00\xfc\x1a\xd2%\x9c\xd9$\xc3,\x87\xd9\x80n\x06\x9a\xeb\x86=\xf9\x14\x01\x03A\xa0\xdfZ\x1b\xc8\xe4'\x82(\xa4	#\xf7\x0fw7\x9b\xa7\xf7\x9b\x0f\x8fS\x18M\xe0\x9aREm\x84\x9a\xbdM\xfc\x16\x981\xcd\xecTh\xcc\xa1T#\x82\xa3\xf8\x98\x02&\xdaY}CaG2\xdc\x0c\xaeK8r\x0b\x07\xf4I>\x02h\xf6)\xb0s\x14ND\x88d\xe8\xa5\xe1(j&X\xd4\x94\x82\xd5\xb1\x0b'o\x1a\xb6>\xc1a\xc1\x80\xfe\x08\xd8\xa6\xca/>O\xf1\xcaxJv\x8e\x0f2,g=\x89\xe6\xba\xf0SKC\xa5\xadDo\x9b\xc0/\xc7m\x87\xb9\x9b^\xde\xb9\xdeyw\xec\xa6\xcc\xe5_\xbb\x9f\x93\x9f\xbcF&\xb6\x03\xf4I\xf2\xd3\xc5,hPd?\xa9\xbf\x15\xab\xfemM\x9fP\x0e\x05_C\xfb\xca\"W\x19|8;_\x98\xc6\xb0/^\xc1ND\xbd\xbaE\x0f\xeb\x15X\xff\xa9'C\x82\xe4\x0cMmc\x94\x83\xa1\x9f(%/\x0by\"\xd3=e\xe3\xbc\xe4\xaf\x8d\x04\x1a\xc7\x94r\x07\x86$\x1a\xcf\xa7\xaf\xf8\xb9\xd4\xa5f_\xda\x9d\xac\x89\x92\x03$B]\xc9\x1cN\x8f	A\xc5\x07)e\x18\xf6\x18 \xb6EL6\xb5]\xb5=\x86\xb8\xfc\xfa\x8c\x0f/]'HY\x02K\x10.\xae\xbf\xbf^\xaf\x9e6\xdb\xdb\xd5\xcd\xe6\xf1~\xb5\xde\xc0\xb0\xc6\xbam9\xec\x0c\xa9hK\xab1\x11\xac\x1fT\x06\xc0\xc5\xa7@\xa5\xa0\x04\x80g\xeb\xcd\x12\xd6\xa3S\x7f\xbc\x0b\xdc6\xcb\x0b\xb8\xb9\xe5\xde\xb6\xa7 \xb3Y\xc2\xfe\x1btM\x85\xdf\xf6\xc7=.\xd96\xd6\xef\xe6\x12	Y\xbfv\xc5r\xba]\xfd\xc6\x9b\xbe\xd3c\xe9A\xcd\xd0\xef\xb6\x11c\xf7\xdb`\xaa\x96PSB\x83	\xf3\xc9*\xfc\x12%\xff\x81^O\xd0\x1f7\x0f\x1f\xaf\xd7oL\xb2\xa3V\x93\x1a\xbb\x1a\x07\xf8x\xb6]`V\xe3`\x06\xd1\xa9)\xbbo\x93\xfbg\x00\xff!\x80\xdf\x07\x00PK\x07\x08:\x8e\xec\xdfp\x03\x00\x00\xd7	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xc1R\xf30\x0c\x84\xefy\n\xbd@&\xff\x0f=\x80\xafp-\xf4\xc0p\x17\xce\xd2fj[\x1eY)\xd3\xb7gL\x9aL2\xd5\xc9\xfe\xb4\xda\x95\xcdy\xf8\x84\x96A\x92#\xce\xb9t\x97\xff\xcdyH\xbd\xa3W\xe4 \xd7\x88dM\x84q\xcf\xc6\xae!J\x1c\xe1(r\xe2#\xf4v/\x99=\x1c\x95k1\xc4\xa6d\xf8\xaa4\xc4\x1c\xd8P\xcfD3\xad\xe5%\x19\x0f	Zf\xd2\xde\xf9N\x95Em\x11U\xd92z\x105G\xcf\xbb\xdd\xe3\xd2\x9d\x97\xfb\xc1\xd7I\xe4\xdc\x16\xe8e\xe5E\x94UL\xbc\x04G\x1f/\x87\x85_$\x8c\x11{\x19\xd36*Vr`;9\xea,\xe6\xee\xfcT\xda\xaduW\x13\x86tl=\xd4\xca*hzL\xa5+\xa8\xe0\xfe=\x85\xab#\xd3\x11\xb7\xc6\x14~\xf7\x0f\x9b\xd1\x02\xaf\xb0YR\xab\xc77\x8f\xc1\xf6\xd2\xc3\xd1\xee\xe1\xdf\xaa5\x89\xdf\xfe\\\xb6\xdb\xb6\x1ej\xcd\xef\x00PK\x07\x08\xf4Eb\xc7\xf2\x00\x00\x00\xf1\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xc4\x90=O\xc30\x10@\xf7\xfc\x8a\x93\xca@\x07\xb7\xea\x86\xb2UQ\x90:\x14!\xa8\xca\x88\xce\xf659\x12\xdb\x95}I\x05\xbf\x1e\xb9\xad\xf8\x100\xc0\xc2h\xdd=\xf9\xbd\x9b\xc0\xa6\xe5\x04{\x14\xd3\x02Z\x0b\xe8}\x10\x14\x0e\x1e$\x00Z\xc7)\xe5\xc7\x81t\x1bB\x07&\xf8\x1d7\x80\xde\x16\x13\x90\x96`\xc4\xc8\xa8{JpqY\xd5w\x9b\xd5\xf5\xaaZn\xea\xc7\x9b\xe5\xba\xbe\xbf]V\xf54/\x7f3\x9c\xc2\x81\xfb\x1e4A\x1at\x12\x96A\xc8\x82~\x86nH\x12\x1c\xbf\xd0\xac\xc0=o)f\x81\xf2\xdd%R\xc3I\xe2Qr\xd6]\xa5\x19\x87\xf9\xb8\xd0$\xb8(:\xf6\xb6\x84\xf5\x90\x13|\xf3p\x92\xae\x8e\xce\xc3\x89(\x1c	Z\x14,\x0b\x00\x8f\x8eJp\xe7uu\x8eT\xe6\x13\x00\x1f\x8e\x922\x05`(\x8ar\xe8\xb1\xa1\x98\xbfg\xffDF\x94A\xb5\x8b\xc1\x95?\x9eb\xfeu0-\x94R\x7f\x0d\xddb\xcf\xf67\xa9\xe3\x1b\xf0O\xb1\xaf\x03\x00PK\x07\x08l\xd7\xcf&\x00\x01\x00\x00q\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00)\x00\xd6\xffresources:\n- manager.yaml\n- service.yaml\n\x03\x00PK\x07\x08\x1fA\xf7\xf40\x00\x00\x00)\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4\x91Oo\xd4@\x0c\xc5\xef\xf9\x14V\xefK\x13\xe8\x01\xcd\x0d\x81\xc4\x05\xd0JE\xdc\xbd\x93\xa7\xd6\xda\xf9W\xdbYi\xbf=\x9amZm\x04\xf4\x82O\x89\x9f\xe77\xcfo\xb8\xc9/\xa8I-\x81\xb85\xbb=M\xc3Q\xca\x1c\xe8\x0bZ\xaa\xe7\x8c\xe2C\x86\xf3\xcc\xcea *\x9c\x11(s\xe1\x07\xe8\xfao\x8d#\x02\xd9\xd9\x1cy J|@\xb2>M\x14kq\xadi\xd7\x12\x17\xf4;dg\xd0\x13t\xb0\x86\xd8g\x0c	\xd1\xab\xf6o\xa2\xcc\x1e\x1f\xbf]\x01\xdeB\x10)Z\x92\xc8\x16h\x1a\x88\x1c\xb9%v\xac\xa8+\xdbD[[o[\xeb\xea\x8b\xbd^\xfd:\x89\xf8\x14c]\x8a\xff\xb8D`\xbc\x8a}C\x96\x02]7&\xda\x91d~@\xa0\xa7\x85\xcf\xef\xa4\xde\x1e\x97\x03\"\xab\n\xf4\x96\x9bt\x1c4$6\x87\xf9z\xe8\xcfh\x9fKau\xd1\x88Wz\xaf$Y|\xd3!\x8am	4\x8d\xe3\x987\xed\x8c\\\xf5\x1c\xe8\xfd8~\x97+E\xf1\xb4\xc0\xfe\x05\xf9;c\xda0\x14<K\x81\xd9^\xeba\xcd\xfc\xb9<\xb6\xfb\x1a\x8f\xf0\xeb&Q\xab\xea\x81\x1e\xdd\x9b\xbd\xf6\x93\x9c\xf0\xbf\x8c\xce\xdd\xec\xb1[\xb3\xdc\x8e\xbd\xbc\xf9\xe5\xb1\xf6\x17\xce\xc7\xbb\xbb\x0f\x1b\xbdi\xf5\x1ak\nt\xf3\xf3\xf3\xfef\xd5\x1c\x9a\xa5\xb0K-_\x95#\xf6P\xa9\xf3=b-\xb3\x05\x9a\xc6\xe1\xf7\x00PK\x07\x08\x8b\xe9\xb1\xa1b\x01\x00\x00H\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/service.yamlUT\x05\x00\x01\x80Cm8\x84\xce\xb1j\x03A\x0c\x04\xd0~\xbfbp\x7fE\x88+\xb5\xf9\x01CBze=\xd8K\xf6v\x85$\x0e\xf2\xf7\xe1.\xd7\xa7\x9c\xe1I\x8cZ\xfb\xa4G\x9bC\xb0\xbd\x94\xef6\xee\x82w\xfa\xd6*\xcb\xca\xd4\xbb\xa6J\x01\x86\xae\x14\xac:\xf4A?s\x98V\n\xe2'\x92k\x01\xba~\xb1\xc7\xae\x81:G\xfa\xec\x8bu\x1d\x14\xa8\xb5%\xe8\x1b\xbd\x84\xb1\xee&\xd8Ys\xfa\x7f\x1e\xb0\xe9y\xbe]`>s\xd6\xd9\x05\x1fo\xb7\xe3\xf4\x0f\x08\xae\xd7\xd73\xa7\xfa\x83y;\xdag\xa6\xc5\xd9\xef\xa3\x05\x97g\xa6\xc5\xa5\xfc\x0e\x00PK\x07\x08\xb8\x0b/\x01\x9c\x00\x00\x00\xfc\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- monitor.yaml\n\x03\x00PK\x07\x08*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8\x84\x8e\xb1j41\x0c\x84{?\x85\xe0o\xfe\x14\xbe#\xad\xdf\xe1 \x10H\xafx\x87\xac\x89m	IY\xc8\xdb\x87\xcd9E\xaatb\x18}\xdf\xa4\x7f\xf4d2\x10;>\x9cn2[\x88\xd13\xech\x15\xf4\xff\x86\xb0V\xfd!\xb1\xb6\x17\x987\x99\x85\xc6\xbd\xd5\xe6\xdb\xa5\x8aA\xfcRe\\\x8f\xc7\xf4\xde\xe6V~\x9e\x17+\x0d\x04o\x1c\\\x12Q\xe7Wt?/\xa2*3Lz\xd6\xce\x13\x85X[v\xd8\x01KD\x93\xc7\xaf(\x8f\xfb\x8e\xbc\xd4\xab\xe2\xca\x15\x85\xfc\xd3\x03#\xb9\xa2\x9eh\xccM\xa5\xcdX\x9eL\xca\xb1\x17\xba.\xc6\xb7\x9cH\xc5\xa2\xd0\x1e\xa1g\xe2\xe8\xa8!\xf6\xd7\xb4\xaf\x01\x00PK\x07\x08\xff\xc9\xc1\xf1\xb4\x00\x00\x00/\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8D\x8e=K\x051\x10E\xfb\xfc\x8aa\xfb\xac\xd8IZ[\xab\xa7\xd8\x88\xc5l\xde%o\xd8|,\x93\xc9\x16\xfez\xc9\"x\xcb\x03\xe7p\xbd\xf7\x8e\x0f\xf9\x84vi5\x90n\x1cW\x1e\xf6h*?l\xd2\xea\xba\xbf\xf4U\xda\xd3\xf9\xecv\xa9\xf7@\xafyt\x83\xdeZ\x86+0\xbe\xb3qpDQq	\x1fR\xd0\x8d\xcb\x11\xa8\x8e\x9c\x1dQ\xe5\x82@\x9c\x92/\\9A\xbdN\x99SR\xa4\xcb\xb9\x8d\x8c\xab\xf1\xdf~GF\xb4\xa6}r\"O\x85->\xdexC\xfeCs\xcb>6DV\x15\xe8|\xc9\x87t\xe8	]\x02-\xa6\x03\x8b\xd3\x91\xd1\x03}}\xbb\xdf\x01\x00PK\x07\x081hoh\xac\x00\x00\x00\xed\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd1\x8e\xc2@\x0c\x85\xe1~N\xe1\x0b$\xab\xedV\xd3-\x14\xf4A\xa2w&f0I\xec\xc8\xe3I\xc1\xe9Q\xa4\x88\x06A\xf7\x8a\xf7\xeb\xc3\x85/d\x85U\"X\x8f\xa9\xc5\xea75~\xa0\xb3J;\xfe\x95\x96\xf5g\xfd\x0d#\xcb\x10\xe18\xd5\xe2d\x9dNt`\x19Xr\x98\xc9q@\xc7\x18\x00\x04g\x8a\x80973\nf\xb2\xc6t\xa2~\x7fn\xbb\xa3\xebv\xc4\x85O\xa6u\xf9\x82\x06\x807\xf3#\x11J\xed\xef\x94\xbc\xc4\xd0\xec\xd9\x99l\xe5D\xff)i\x15\x7f\x95\x05\xc3s\x00PK\x07\x08\x9cNx\xd9\x93\x00\x00\x00\xf4\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U(\xca\xcfI\xd5\xabL\xcc\xcd\x81\xb2\xe3\x932\xf3R2\xf3\xd2ab\x89\xe9\xe9\xf1\xc8j`|tu9\xa9\x89)\xa9E\xf1\xa99\xa9\xc9%\x99\xf9y(z\xb0\xc9\xa1\xea\x07\x0c\x00PK\x07\x08\\\x1f\xb4\x9fD\x00\x00\x00\x93\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\xc3@\x0c\x86\xf7{\n\xab\xccI\xc5\x86\xf2\x02\xec\x0c\xec\xee\xddO{\xea\xe5|\xb2}A\xe2\xe9QB\xca\x00B\xa2\xdb'\xdb\xff\xf7\xfb\x81\x1at\xcefY\xaa\x91\x0b%\xa1\x02NPBA\xf4,u\x0c\xdc\xf2+t=\x99HO\x1cG\xee~\x11\xcd\x1f\xbc\xed\xafO6f9.\x8f\xe1\x9ak\x9a\xe8E\n\xc2\x0c\xe7\xc4\xceS \xaa<c\xda\xb5\xc3M;\xe8z\xa6\xbd\xc0\xa60\x10\xb7\xfc\xac\xd2\x9b\xad\x81\x81\x0e\x87@\xa40\xe9\x1a\xb1\xcf\xa2\xd4\xb7|\x9e\xb9Y Z\xa0\xa7}~\x86o\x99\x92\xed\x0b\xde\xd9\xe3e\xa3\xa8`\xc7\x86\xbd\xa5\x1b\xb6\xef}B\x81\xe3\xde\xfa\xa39{\xff\xe3\x8b_=\xff\x92cA\xf5\x1f\xc6\xa8`G\xf8\x1c\x00PK\x07\x08\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd\xb1\x8e\xc20\x0c\xc6\xf1\xddO\xe1\x17hO\xb7\x9d\xb2\x1d\x0b{\x91\xd8\xdd\xc4\x80ijWN\xd2\x81\xa7G\x95*\xd8\xbay\xf0\xef\xfb\xd3\"W\xf6\"\xa6\x01}\xa4\xd8S\xab\x0fsyQ\x15\xd3~\xfa+\xbd\xd8\xcf\xfa\x0b\x93h\n8X\xe6\x93h\x12\xbd\xc3\xcc\x95\x12U\n\x80\xa84s\xc0\xcc\x94\xd8;\xce\x1c7\xdd\xb9e\x1e\xf7\xef\xed\x1e\xf8\x16\x00\x91\x169\xbb\xb5\xe5\xa0\x08\x88\xdf\xe0\xe1>\x946>9\xd6\x12\xa0\xdb\xcd\x85}\x95\xc8\xff1Z\xd3\xfa\xd1\x85\xe0=\x00PK\x07\x08+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xc4\x95\xc1\xca\xdb@\x0c\x84\xef\xfb\x14\xcb\x7f\xb7Ko\xc5\xd7\x1ez/\xa5wY\x9e8\xaa\xd7\xbbF\xabuH\x9f\xbe8IKI]\x92\x98\xfc\xe4fV\xd2\xcc\xf0I`WU\x95\xa3I\xbeC\xb3\xa4\xd8xm\x89k*\xb6O*?\xc9$\xc5z\xf8\x94kI\x1f\xe6\x8fn\x90\xd85\xfes(\xd9\xa0_S\x80\x1ba\xd4\x91Q\xe3\xbcg\xc5i\xe0\x9b\x8c\xc8F\xe3\xd4\xf8XBp\xdeG\x1a\xd1\xf8\x91\"\xf5\xd0J\x97A-\x01\xb9q\x95\xa7I\xbeh*S^$*\xff\xf6\xe6\xbcW\xe4T\x94qy\xc3\x8ch\xd9y?C\xdb\xcb[\x0f;\xf5\x07\xc9\xe7\x8f\x03\x19\xef\xef\xd3\xcb`\xc5V\xc1\x85\x0d\xa2	\xff\x0d\xe7_\x0fK\x03\xa2b\x16\x1c\xae\x8cN\x98\xb0.|\x0d}%{i\x7f\x80\x8d\x98\x91\xf3C\xfaLF!\xf5\xf5PZ0\xa9\nt591\xa7\xb2\x99\xf7\xdd.\x93\x0c8\xae\xa3Y\xea\x1d\x02\x0c\x0fm\xe6^\xebK\x1f\xa2\xa9`[\x822u\xbf\xdb\xfesw\x1b\xc2\x1c3l\xfdVn\x01yz\x9cWS\xe9\xa02\xa3\xe3\x92-\x8d\x7fj/\x0e\x95v;\xa8\xc4k8\xcf>\xcfI\xd3,\x1d\xf4\x9dm\x14\xbd\xa4\xb8\xd1\xe4\x16\xaa\x0c\x9d\x85\xc1\xe7?\xc5\x93\x16\xf7k\x00PK\x07\x08\xd2\xc5^8D\x01\x00\x00\xac\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8f\xb1j\xc3@\x0c\x86w=\x85^\xe0\\\xba\x95\xdb\xda\x0e\xdd]\xe8.\x9fUG\xb1O2\xba;\x0fy\xfa`\x08!\x10\x93M\xc3\xa7\xef\xe3\xa7U\xfe\xd8\x8b\x98F\xdc\xdea\x16\x1d#\xfe\xb2o\x92\xf83%kZ!s\xa5\x91*E@T\xca\x1c\xb1\x10\x84\x10\xe0\xf1\xd9\x07J\x1d\xb5z2\x97\x0bU1\xed\xe6\x8f\xd2\x89\xbd\xdd\xb5\xdfK+\x95\xbd\xb7\x85\xbfDG\xd1\xe9@\x9dIib\x0fn\x0b\x0f7j\xbf{\xfe\xdf!Z\xe5\xc7\xad\xad/\x82\x80\xf8\xd4;\xd4Ci\xc3\x99S-\x11\x02\x1e.GT\xca\x1c\xb1\x10\\\x07\x00PK\x07\x08\xc0\xe9E\xce\xa0\x00\x00\x00)\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00S\x00\xac\xffresources:\n- manifests.yaml\n- service.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92?o\xa4@\x0c\xc5\xfb\xf9\x14O\xda\xfa\xe0\xf6\xaa\x13\xedUW\xa4\xc9JI\xed\x05\x03\x160&\x1e\x0f(\xf9\xf4\x11\xa0H\x9b*[mk=\xbd??\xf9\x04\xef\x19\xad\x8e\xa3\xae\x12;\xd4\x1a[\xe9 	\xad\x1a\x9c\xa9\xee\xb7\xf3\x90\x93\xeb$\x1f\x8c\xb5gc\xb8bT\x1d@\xbe\x1d\"R\xbe&\x17\xcf\xbe\x89\x17\xb2T\x84\x13\xfe;\x8c\xdf\xb2\x18\xa7\x1b\x83\xe5Oq.~C\x0d\x91W\xb6\xcdjU\x1b0\x9b\xcel\xe3{\x11\"M\xfc\xcc-\x1b\xc7\x9a\xab\xf0\x0b\x83\xc4\xa6\xc2\x85m\x91\x9a\x03\xb0\xb0%\xd1Xa9\x07\xa0\x15\x1e\x9b\xcb\xccu\xaa\x02\xf0\xa5~\xcaN[\x9bW\xbe\xf6\xaa\xc3\xbf}W6r\xd1\x18\x00\xa03\xcds\x05j&I\x9b\x9bq'\xc9\x0fA1\xfcM\x85\xe8\xae\x9b\xc9\xfb\n\xeba\x93\xcaz\x14\x8e~\xd8\x95\xe9\xa8Tn\x8do\xb2_h\x94\xe6\xc1\xe9;\xb54\xd3-\xb1\x1f\x18\xdcG\xe0\xde\xfd{v\x00jcr\xae\xe0\x969\xdcM\xe4\x01]\xc2B\xf6\xed\xad\x8ee\x13;5\xe4TR\x8c\xea\xe4\xa21\x85\xcf\x01\x00PK\x07\x08w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x03\x00PK\x07\x08\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8bA\xaa\xc30\x0cD\xf7>\x85.\xe0\xc5\xe7gS\x9d\xa2P\xe8^u\x86\xd6$\xb1\x8c$Rz\xfb\x92\xb8\xbby\x8fyIz\xbd\xc3\xbcjc\xda\xff\xd2R\xdb\xcct\x83\xed\xb5 m\x08\x99%\x84\x13Q\x93\x0dLo<^\xaaK\xf6\xdfcx\xefR\xc0\xe4\x1f\x0fl\xc9;\xcaQt\xb5\xf0c\x10\xe5\x13\x98\xa6\xe9\xffd\xa2\x10{\"\xae\xa7\xbd\x0c\xedXQBm$E[\x98\xae\xb9\xaf\xd2\xc0$\xbdf\x87\xed\xb0\xf4\x1d\x00PK\x07\x08\xf2Pc*\x81\x00\x00\x00\xb4\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb8\xb6v\xad\xe4\x01\x00\x00\x08\x04\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x807\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(@\xa4D\x18\xbd\x00\x00\x00\x86\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd9\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(:\x8e\xec\xdfp\x03\x00\x00\xd7	\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xed\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4Eb\xc7\xf2\x00\x00\x00\xf1\x01\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xae\x07\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(l\xd7\xcf&\x00\x01\x00\x00q\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xf9\x08\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x1fA\xf7\xf40\x00\x00\x00)\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80U\n\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x8b\xe9\xb1\xa1b\x01\x00\x00H\x03\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd6\n\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb8\x0b/\x01\x9c\x00\x00\x00\xfc\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x83\x0c\x00\x00manager/service.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80j\x0d\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xff\xc9\xc1\xf1\xb4\x00\x00\x00/\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xdf\x0d\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(1hoh\xac\x00\x00\x00\xed\x00\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe1\x0e\x00\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x9cNx\xd9\x93\x00\x00\x00\xf4\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd6\x0f\x00\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\\\x1f\xb4\x9fD\x00\x00\x00\x93\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xba\x10\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80L\x11\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80e\x12\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd2\xc5^8D\x01\x00\x00\xac\x06\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80Q\x13\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc0\xe9E\xce\xa0\x00\x00\x00)\x01\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xda\x14\x00\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc7\x15\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80r\x16\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe2\x17\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf2Pc*\x81\x00\x00\x00\xb4\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x801\x18\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x16\x00\x16\x00\xf6\x06\x00\x00\xfd\x18\x00\x00\x00\x00"
 	fs.Register(data)
 }
@@ -52,17 +52,40 @@ type Config struct {
 
 	WebhookStrategy string
 	LogLevel        *int
+
+	// ConsolidatedName is the name of the consolidated Catapult serving this CRD.
+	// If set, no Deployment is generated for this CRD,
+	// instead its RBAC and webhook configuration are bound to the consolidated Catapult.
+	ConsolidatedName string
+}
+
+// ConsolidatedConfig holds the config information to generate a consolidated Catapult,
+// that serves all CRDs of a ServiceCluster in a single Deployment.
+type ConsolidatedConfig struct {
+	// Name is the name of the consolidated Catapult instance.
+	Name string
+	// Namespace that the consolidated Catapult instance should be deployed into.
+	Namespace string
+
+	ServiceClusterName, ServiceClusterSecret string
+
+	LogLevel *int
+}
+
+// ConsolidatedName returns the name of the consolidated Catapult of a ServiceCluster.
+func ConsolidatedName(serviceCluster string) string {
+	return "consolidated." + serviceCluster
 }
 
 var k = kustomize.NewDefaultKustomize()
 
-func Manifests(c Config) ([]unstructured.Unstructured, error) {
+func newKustomizeContext(name, namespace string) (kustomize.KustomizeContext, error) {
 	v := version.Get()
 	kc := k.ForHTTP(vfs)
 	if err := kc.MkLayer("man", types.Kustomization{
 		// "." needs to be replaced, because it's forbidden for Deployment and Pod names
-		NamePrefix: strings.Replace(c.Name, ".", "-", -1) + "-",
-		Namespace:  c.Namespace,
+		NamePrefix: namePrefix(name),
+		Namespace:  namespace,
 		Images: []image.Image{
 			{
 				Name:   "quay.io/kubecarrier/catapult",
@@ -71,7 +94,7 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 		},
 		CommonLabels: map[string]string{
 			constants.NameLabel:      "catapult",
-			constants.InstanceLabel:  c.Name,
+			constants.InstanceLabel:  name,
 			constants.ManagedByLabel: constants.ManagedByKubeCarrierOperator,
 		},
 		Resources: []string{"../default"},
@@ -81,6 +104,19 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 	}); err != nil {
 		return nil, fmt.Errorf("cannot mkdir: %w", err)
 	}
+	return kc, nil
+}
+
+// namePrefix returns the prefix of all objects generated by kustomize for the given instance.
+func namePrefix(name string) string {
+	return strings.Replace(name, ".", "-", -1) + "-"
+}
+
+func Manifests(c Config) ([]unstructured.Unstructured, error) {
+	kc, err := newKustomizeContext(c.Name, c.Namespace)
+	if err != nil {
+		return nil, err
+	}
 
 	mutatingWebhookPath := utilwebhook.GenerateMutateWebhookPathFromGVK(schema.GroupVersionKind{
 		Group:   c.ManagementClusterGroup,
@@ -88,95 +124,48 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 		Kind:    c.ManagementClusterKind,
 	})
 
-	var logLevel int
-	if c.LogLevel != nil {
-		logLevel = *c.LogLevel
-	}
-	// Patch environment
-	// Note:
-	// we are not using *appsv1.Deployment here,
-	// because some fields will be defaulted to empty and
-	// interfere with the strategic merge patch of kustomize.
-	managerEnv := map[string]interface{}{
-		"apiVersion": "apps/v1",
-		"kind":       "Deployment",
-		"metadata": map[string]string{
-			"name":      "manager",
-			"namespace": "system",
+	managerEnv := managerEnvPatch([]map[string]interface{}{
+		{
+			"name":  "CATAPULT_MANAGEMENT_CLUSTER_KIND",
+			"value": c.ManagementClusterKind,
 		},
-		"spec": map[string]interface{}{
-			"template": map[string]interface{}{
-				"spec": map[string]interface{}{
-					"containers": []map[string]interface{}{
-						{
-							"name": "manager",
-							"env": []map[string]interface{}{
-								{
-									"name":  "CATAPULT_MANAGEMENT_CLUSTER_KIND",
-									"value": c.ManagementClusterKind,
-								},
-								{
-									"name":  "CATAPULT_MANAGEMENT_CLUSTER_VERSION",
-									"value": c.ManagementClusterVersion,
-								},
-								{
-									"name":  "CATAPULT_MANAGEMENT_CLUSTER_GROUP",
-									"value": c.ManagementClusterGroup,
-								},
-								{
-									"name":  "CATAPULT_SERVICE_CLUSTER_KIND",
-									"value": c.ServiceClusterKind,
-								},
-								{
-									"name":  "CATAPULT_SERVICE_CLUSTER_VERSION",
-									"value": c.ServiceClusterVersion,
-								},
-								{
-									"name":  "CATAPULT_SERVICE_CLUSTER_GROUP",
-									"value": c.ServiceClusterGroup,
-								},
-								{
-									"name":  "CATAPULT_SERVICE_CLUSTER_NAME",
-									"value": c.ServiceClusterName,
-								},
-								{
-									"name":  "CATAPULT_SERVICE_CLUSTER_KUBECONFIG",
-									"value": "/config/kubeconfig",
-								},
-								{
-									"name":  "CATAPULT_MUTATING_WEBHOOK_PATH",
-									"value": mutatingWebhookPath,
-								},
-								{
-									"name":  "CATAPULT_WEBHOOK_STRATEGY",
-									"value": c.WebhookStrategy,
-								},
-								{
-									"name":  "LOG_LEVEL",
-									"value": strconv.FormatInt(int64(logLevel), 10),
-								},
-							},
-							"volumeMounts": []map[string]interface{}{
-								{
-									"name":      "kubeconfig",
-									"mountPath": "/config",
-									"readOnly":  true,
-								},
-							},
-						},
-					},
-					"volumes": []map[string]interface{}{
-						{
-							"name": "kubeconfig",
-							"secret": map[string]interface{}{
-								"secretName": c.ServiceClusterSecret,
-							},
-						},
-					},
-				},
-			},
+		{
+			"name":  "CATAPULT_MANAGEMENT_CLUSTER_VERSION",
+			"value": c.ManagementClusterVersion,
 		},
-	}
+		{
+			"name":  "CATAPULT_MANAGEMENT_CLUSTER_GROUP",
+			"value": c.ManagementClusterGroup,
+		},
+		{
+			"name":  "CATAPULT_SERVICE_CLUSTER_KIND",
+			"value": c.ServiceClusterKind,
+		},
+		{
+			"name":  "CATAPULT_SERVICE_CLUSTER_VERSION",
+			"value": c.ServiceClusterVersion,
+		},
+		{
+			"name":  "CATAPULT_SERVICE_CLUSTER_GROUP",
+			"value": c.ServiceClusterGroup,
+		},
+		{
+			"name":  "CATAPULT_SERVICE_CLUSTER_NAME",
+			"value": c.ServiceClusterName,
+		},
+		{
+			"name":  "CATAPULT_SERVICE_CLUSTER_KUBECONFIG",
+			"value": "/config/kubeconfig",
+		},
+		{
+			"name":  "CATAPULT_MUTATING_WEBHOOK_PATH",
+			"value": mutatingWebhookPath,
+		},
+		{
+			"name":  "CATAPULT_WEBHOOK_STRATEGY",
+			"value": c.WebhookStrategy,
+		},
+	}, c.ServiceClusterSecret, c.LogLevel)
 	managerEnvBytes, err := yaml.Marshal(managerEnv)
 	if err != nil {
 		return nil, fmt.Errorf("marshalling manager env patch: %w", err)
@@ -206,6 +195,19 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 				Resources: []string{c.ManagementClusterPlural + "/status"},
 				Verbs:     []string{"get", "patch", "update"},
 			},
+			{
+				// Propagation of referenced Secrets and ConfigMaps
+				APIGroups: []string{""},
+				Resources: []string{"secrets", "configmaps"},
+				Verbs: []string{
+					"create", "delete", "get", "list", "update", "watch"},
+			},
+			{
+				// Mirroring of Events from the ServiceCluster
+				APIGroups: []string{""},
+				Resources: []string{"events"},
+				Verbs:     []string{"create", "patch"},
+			},
 		},
 	}
 	roleBytes, err := yaml.Marshal(role)
@@ -294,6 +296,195 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 	}
 	objects = append(objects, unstructured.Unstructured{Object: obj})
 
+	if c.ConsolidatedName != "" {
+		if objects, err = bindToConsolidated(objects, c.Name, c.ConsolidatedName, c.Namespace); err != nil {
+			return nil, err
+		}
+	}
+	setLabels(objects, c.Name)
+	return objects, nil
+}
+
+// ConsolidatedManifests returns the Deployment and supporting objects of a consolidated Catapult.
+// RBAC and webhook configuration of the served CRDs are generated by Manifests.
+func ConsolidatedManifests(c ConsolidatedConfig) ([]unstructured.Unstructured, error) {
+	kc, err := newKustomizeContext(c.Name, c.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	managerEnv := managerEnvPatch([]map[string]interface{}{
+		{
+			"name":  "CATAPULT_CONSOLIDATED",
+			"value": "true",
+		},
+		{
+			"name":  "CATAPULT_SERVICE_CLUSTER_NAME",
+			"value": c.ServiceClusterName,
+		},
+		{
+			"name":  "CATAPULT_SERVICE_CLUSTER_KUBECONFIG",
+			"value": "/config/kubeconfig",
+		},
+	}, c.ServiceClusterSecret, c.LogLevel)
+	managerEnvBytes, err := yaml.Marshal(managerEnv)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling manager env patch: %w", err)
+	}
+	if err = kc.WriteFile("/man/manager_env_patch.yaml", managerEnvBytes); err != nil {
+		return nil, fmt.Errorf("writing manager_env_patch.yaml: %w", err)
+	}
+
+	// webhooks are configured per CRD, but the kustomize base expects a MutatingWebhookConfiguration,
+	// that is dropped again below
+	mutatingWebhookConfigurationBytes, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "admissionregistration.k8s.io/v1beta1",
+		"kind":       "MutatingWebhookConfiguration",
+		"metadata": map[string]string{
+			"name": "mutating-webhook-configuration",
+		},
+		"webhooks": []map[string]interface{}{
+			{
+				"name": "placeholder.kubecarrier.io",
+				"clientConfig": map[string]interface{}{
+					"service": map[string]string{
+						"namespace": "system",
+						"name":      "webhook-service",
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling MutatingWebhookConfiguration: %w", err)
+	}
+	if err := kc.WriteFile("/webhook/manifests.yaml", mutatingWebhookConfigurationBytes); err != nil {
+		return nil, fmt.Errorf("writing /webhook/manifests.yaml: %w", err)
+	}
+
+	// execute kustomize
+	objects, err := kc.Build("/man")
+	if err != nil {
+		return nil, fmt.Errorf("running kustomize build: %w", err)
+	}
+
+	// cluster-wide permissions and webhooks are configured per CRD
+	var consolidatedObjects []unstructured.Unstructured
+	for _, obj := range objects {
+		switch obj.GetKind() {
+		case "ClusterRole", "ClusterRoleBinding", "MutatingWebhookConfiguration":
+			continue
+		}
+		consolidatedObjects = append(consolidatedObjects, obj)
+	}
+	setLabels(consolidatedObjects, c.Name)
+	return consolidatedObjects, nil
+}
+
+// bindToConsolidated drops the Deployment and namespaced objects of a Catapult instance
+// and binds the remaining RBAC and webhook configuration to the consolidated Catapult.
+func bindToConsolidated(objects []unstructured.Unstructured, name, consolidatedName, namespace string) ([]unstructured.Unstructured, error) {
+	var (
+		prefix             = namePrefix(name) + "catapult-"
+		consolidatedPrefix = namePrefix(consolidatedName) + "catapult-"
+		boundObjects       []unstructured.Unstructured
+	)
+	for _, obj := range objects {
+		var boundObj runtime.Object
+		switch obj.GetKind() {
+		case "ClusterRole":
+			boundObjects = append(boundObjects, obj)
+			continue
+
+		case "ClusterRoleBinding":
+			binding := &rbacv1.ClusterRoleBinding{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, binding); err != nil {
+				return nil, fmt.Errorf("converting ClusterRoleBinding: %w", err)
+			}
+			for i := range binding.Subjects {
+				binding.Subjects[i].Name = strings.Replace(binding.Subjects[i].Name, prefix, consolidatedPrefix, 1)
+			}
+			boundObj = binding
+
+		case "MutatingWebhookConfiguration":
+			config := &adminv1beta1.MutatingWebhookConfiguration{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, config); err != nil {
+				return nil, fmt.Errorf("converting MutatingWebhookConfiguration: %w", err)
+			}
+			for i := range config.Webhooks {
+				if service := config.Webhooks[i].ClientConfig.Service; service != nil {
+					service.Name = strings.Replace(service.Name, prefix, consolidatedPrefix, 1)
+				}
+			}
+			config.Annotations["cert-manager.io/inject-ca-from"] = namespace + "/" + consolidatedPrefix + "serving-cert"
+			boundObj = config
+
+		default:
+			continue
+		}
+
+		unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(boundObj)
+		if err != nil {
+			return nil, fmt.Errorf("converting to unstructured: %w", err)
+		}
+		boundObjects = append(boundObjects, unstructured.Unstructured{Object: unstructuredObj})
+	}
+	return boundObjects, nil
+}
+
+func managerEnvPatch(env []map[string]interface{}, serviceClusterSecret string, logLevel *int) map[string]interface{} {
+	var level int
+	if logLevel != nil {
+		level = *logLevel
+	}
+	env = append(env, map[string]interface{}{
+		"name":  "LOG_LEVEL",
+		"value": strconv.FormatInt(int64(level), 10),
+	})
+
+	// Patch environment
+	// Note:
+	// we are not using *appsv1.Deployment here,
+	// because some fields will be defaulted to empty and
+	// interfere with the strategic merge patch of kustomize.
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]string{
+			"name":      "manager",
+			"namespace": "system",
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []map[string]interface{}{
+						{
+							"name": "manager",
+							"env":  env,
+							"volumeMounts": []map[string]interface{}{
+								{
+									"name":      "kubeconfig",
+									"mountPath": "/config",
+									"readOnly":  true,
+								},
+							},
+						},
+					},
+					"volumes": []map[string]interface{}{
+						{
+							"name": "kubeconfig",
+							"secret": map[string]interface{}{
+								"secretName": serviceClusterSecret,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setLabels(objects []unstructured.Unstructured, name string) {
+	v := version.Get()
 	for _, obj := range objects {
 		labels := obj.GetLabels()
 		if labels == nil {
@@ -301,9 +492,8 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 		}
 		labels[constants.VersionLabel] = v.Version
 		labels[constants.NameLabel] = "catapult"
-		labels[constants.InstanceLabel] = c.Name
+		labels[constants.InstanceLabel] = name
 		labels[constants.ManagedByLabel] = constants.ManagedByKubeCarrierOperator
 		obj.SetLabels(labels)
 	}
-	return objects, nil
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
 	"k8c.io/utils/pkg/testutil"
@@ -52,6 +53,56 @@ func TestManifests(t *testing.T) {
 
 	manifests, err := Manifests(c)
 	require.NoError(t, err, "unexpected error")
+	compareGolden(t, goldenFile, manifests)
+}
+
+func TestConsolidatedManifests(t *testing.T) {
+	const (
+		goldenFile = "catapult_consolidated.golden.yaml"
+	)
+	c := ConsolidatedConfig{
+		Name:      ConsolidatedName("eu-west-1"),
+		Namespace: "test3000",
+
+		ServiceClusterName:   "eu-west-1",
+		ServiceClusterSecret: "eu-west-1-kubeconfig",
+	}
+
+	manifests, err := ConsolidatedManifests(c)
+	require.NoError(t, err, "unexpected error")
+	compareGolden(t, goldenFile, manifests)
+}
+
+func TestManifestsBoundToConsolidated(t *testing.T) {
+	const (
+		goldenFile = "catapult_bound.golden.yaml"
+	)
+	c := Config{
+		Name:      "db.eu-west-1",
+		Namespace: "test3000",
+
+		ManagementClusterKind:    "CouchDBInternal",
+		ManagementClusterVersion: "v1alpha1",
+		ManagementClusterGroup:   "eu-west-1.provider",
+		ManagementClusterPlural:  "couchdbinternals",
+
+		ServiceClusterKind:    "CouchDB",
+		ServiceClusterVersion: "v1alpha1",
+		ServiceClusterGroup:   "couchdb.io",
+		ServiceClusterPlural:  "couchdbs",
+
+		ServiceClusterName:   "eu-west-1",
+		ServiceClusterSecret: "eu-west-1-kubeconfig",
+
+		ConsolidatedName: ConsolidatedName("eu-west-1"),
+	}
+
+	manifests, err := Manifests(c)
+	require.NoError(t, err, "unexpected error")
+	compareGolden(t, goldenFile, manifests)
+}
+
+func compareGolden(t *testing.T, goldenFile string, manifests []unstructured.Unstructured) {
 	yManifest, err := yaml.Marshal(manifests)
 	require.NoError(t, err, "cannot marshall given manifests")
 
@@ -22,6 +22,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94ROk\xdd>\x10\xbc\xfbS\x0c\xbc\x1f\xfc\x1a\xa8\xed\xa6G\xdf\x82y\x94\x1c\xf2(/!=\x96}\xd2\xda\x16\x96%\xa3]\xe7OK\xbf{\x91\x1d\x08	i W\xcd\xec\x8cvfw\xb8\x19\x18]\xf4>\xde\xbb\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3UL\x0c\x1b\xcd2qP\x18\n8e\x83%\xd3\x15\x83\xea,M]\xdbh\xa4\xca\xc3\xe5D\x81zN\x95\x8b\xc5\x0e?.\x8e\x87\xcb\xc3\xb7\x067\x94zVA\xcbI\xaf6\x06\xbeT\xe7\xe70\x03\x9b\xf1]\x99\x9aC\xedIY\xb4V\x92Q\xeae\xee\x13Y\x17\xfa\xda\x05\xcb\x0f\xd5\xa0\x93G\x17\x13N\x89i\xcc[\x9b\x81B\xcfR\xd0\xecn9\x89\x8b\xa1\xc1k\xd5\xbbs\xf2\xf3@_\x8b\xd1\x05\xdb\xe0r\x0d\xa2\x98X\xc9\x92RS\x00\x81&n\xd6\xb4\xb6\xb0\xca-\xac'Df2\x19~\x14\xe5\xa9\x90\x99M\x9e\xc9\xec\xeb\x95\xdd\xe0\xf7\x9f\xa2,\xcb\x8f|\xa2}N\xff\xcd\x9f\xa4;\x17\xfa2\x8b\x00;\xe8\xe0d\x85 C\\\xbc\xc5Dj\x06\xe8\xc0\x88\x81A\xf3\xcc\x94r\xc7\x01\xe3\"\x1a'\xf7\x8bM\x0c\x9d\xeb\xabG\x9a\xfc\xbb{\xec\xf0\xdf\xa7\xeb\xfd\xf1\xf6\xb2\xdd\xff<\\\\\xed\xcf\xd6\xfbx\xf9v\xfd\xfd\xa2\xdd\x9f\xe1\xdey\x9foB\x96\x93\xa8\xd3E\xd9\xe2\xf4\xf8lY\x006\xc8![e\xe5\xf2\xb5r\xf5\x96j%w\xe6C\xe4\xca\xf8E\x94S\xe5\xa3\xa1\xbc\xdbV\xd6\x91\xbbl\n\xbch9?\xfc\xbb]a\x93X\x0f+\x9e\xed\xda\xe3\xfefs/\xef\xf94\xc48\x96k\x15\x86\xb7*\x9e\x9a\xd8\xc6\xb68B\xd4\x1c\xc9\x9c\xb8s\x0fl?C\\0\x0c\xa7\xff\xcb\x8am'\xf0*\xa7\xbf\x01\x00\x00\xff\xffPK\x07\x08\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2UHN-*\xc9L\xcbLN,I\xd5\xabL\xcc\xcd\xe1\xe2J\xce\xcfK\xcbL/-J,\xc9\xcc\xcf\x03\xab\xc9.-.\xc9\xcf\xcd\xacJ\x85HA\xd4\x01\x02\x00\x00\xff\xffPK\x07\x08\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x13\x83\xd5\x0b\x94=\xafg\x8au\xba\x87\xaa\xea\x15<\xbd\xf4\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06O\xab:r\xabE\x97n\x12\xda*\xd4Q\x9a!(y\xd5\xba\xe0\xdc=\xda\xa6o\xc4\xda^\x11\x0d}\x9f%\x88*\x1ba,\x90:\xe3\"\x06\xef\xcf\x1e\x1a}(i\\O,4\xd6\xcc)\x1dp\xd6:O\xb8w\xef\xb4\x04,\xd6\xfa>!\xd3\xe2\xb0I\x95\x85v\xab-\x01E\xf92?\xee\xcc>%\xe0cwG\x0b-\x9a%\x98\x80_\xe6\xc0.\xb1N\xf0\x9d\xf9\xa8\xd7o'\x96\xe3\xc8I\xe9\"\xf6M\xd3W\xfbG\xf9\x93\x9b\xdb\xb6\xb5\xfa0\xcc\xbf#s\xf5!\xf8?\x08g6\xc6\xb5\xe3=\x00\x00\xff\xffPK\x07\x08\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xcc\x96\xcdn\xe36\x10\xc7\xef|\x8aAtp\x0b\xace\xb4G\xdd\\C\xed\x06m>\xe0d\xb7\x87\xa2\x08F\xe4\xc8\xe2\x86\xe2\xa8$e\xc7\xfb\xf4\x05)\xd9\x92\x83n\xb7(\x02\xb47\x8b\x9c\x99\xffo>H:\x83\xb5R\x1e,\xb6\xe4;\x94\x04\x81\x01\x8d\x01G\x9e{'\xc9\xe7\xe2\xbcW\xc0s_\x91D\xe74\xb9\xa5?\xfa@\xad\x10\x19|D\xd3\x13p\x0d\xa1\xd1\x1ejMF\x81\xf6\xd09\xea\xc8*R1fhHd\x83L\xb4\xbc\x90x\x07\x94\xefr@P\xd4\x19>\xb6dC\xb2T\"\x83\xab\x03;\xd59\xf2\xfe\n*\x92\x1c\xfd\xaf\xd0hI~9m\xe5\"\x83[\x0e\x04\xa1\xc1\x00:\x80o\xb87\n\xd0x\x86\x16\x83l\xe0\xa0C\x13)\"V\xad_\xe0\x9b@/\x01*\xaa\xd9\x11,\x96\x8bo\x87\x04h*\x85\xc8\xc6\\\xb0\xe2=\x0du\xb8O\xce\x05H\x0c\xd8\xf5&,\x85\x90\xdc\xb6l\x7f\xc1\x8a\x8c/\x04\xcck\x94k^964\xd9\x0bQ\xa1'_\x88%\xe4\xf9\xcaU(\x87_-Z\xdc\x91\x13\x19\xfc\xf6k\xf9\xc3\xfb\xbb\xbb\x9f\x7f\x87G\x06\xb2X\x19\x82\x03U\x0d\xf3\xf3;\xe8mT\x8b\xf5\x89\x05\x8c\xb4\x9ed\xd0l\xfd\x90\xdf\xe4<f\xa9\xad4\xbd\xd2v\x97\x8c\xd9\x12h\x0b\xd2\xa9\xd5s\xef\x03\xb7\xfa3F\xef\xfc\x88\xad\x19@F\xa9\x08\xb2)\xb7\x8f7\xeb\xdb\xf5O\xe5v\x0e#\xc9\x85\xe5\xc8\xfb\x9a\xe8\x92f1\x8b\xb0\xc8a1\xc2-@r\xdb\xb1%\x1b<\xa0#p\xf4G\xaf\x1d\xa9|@\x88\x02\xb3z\xdco\xefn\xca\xc7\xf7\xe5\x87\x879E\xe7\xb8\xa5\xd0P\xef\xa1e\xab\x03\x7f\x8de\n\xb3\xc8E\x96\x84\xa6\x18BtqH\xc8?\x04\x87\x81vZ\xde\x90\xdbQlg\x06\xd75\x1c\xb9\x87\x03\xda\x10\x7f8\x18\xe1\xe2X\xd3K\xc7\x9eRqW-\x05\xa7\xa5O>dU\xc7\xda\x068\xac\x18\xd0\x1e\x01\xfb\xd0\xd8\xd5\xe79dt\xaa\xd9\x18>\xc4\xfe\x18m	\xd0\xaa\xe4~2\x19\x95\x9e\xa2\xf7S\xe7\xf8\xe5\xf8\x94@S\xc3\xf2dzg\xcd15\x96\xeb\xbf7?\x07?YM\xe9?\x8d\xe8s\xeb\xf1\x04U4V\\\xa5\xaa\xfd#_\xf1\x1f\x8f\xf1	r\xd4{M\xf6\xc6s-2\xf8p6\xbe\xd8\x9a\xdc\xbeH\x9bfh\xd0\x8f\x99m\xd6\xa0\xed\xa7\xc1+:\xc55T\xad\xf6>.\x8c\xf9\xf8(y)d\x89\x94\x8f\xb1\xa6v\xc5\xaf\xde\x13H\x9cB\x8a\xe5)\x86\xc4\xf3\xe2\xab\xf2\\N\xa5d[\xeb]\xbc\xcdkv\x10\x08e\x13\x97O\x89\x104|\x88J\x8aa\x8f\x0e|_\xf9\xa0C\x9f\xc4\xf6\xe8|\xf1\xf6\x05\x1fg\"^\x16\xf1F. n^\xffx\xbdY?\x96O\xb7\xeb\x9b\xf2\xe1~\xbd)!\x9b\xbdi\xe3\xcd\x1e\x05u\xad%\x06\x82\xcdV\x00p\xf5\xc9Q\x1dO9\xc0\xb3\xb6\xaa\x80\xcdd\x92Vw\x8e\xfb\xae\xb8@\xcd5\xa7\xad=\xb9\xd8\x95\x02\xf6\xdf\xa1\xe9\x1a\xfc>\xad\x0eH\x9e\xdc^\xdb\xdd2\xfaA6\xbc\x8bq\xe7t\xaa\x86'i>\xcb\x93\xee\xd0	\x18\xde\x9e3^\xfa\xea04\x05\xb4\x14Pa\xc0|z\xa9\xbeT\x8a\xffw\x8e'\xea\x87r\xfb\xf1z\xf3\x95\xe6%9I\x7f\x99\xd1\xc3y\xef\x02y\x06;\x8e\xfdr\x8a\xf2o\xca;\x07}#\x90)\xf4f[>\xbeed1\x9c\xdd\xde\xa5\xdb&\xfd\xe58\x1f\xdbak\xe8\xc2\x9f\x01\x00\x00\xff\xffPK\x07\x08Z\xc2\xb6\xf8`\x03\x00\x00\x02\n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x1c\xc7\xc9	\x031\x0c\x05\xd0\xbb\xab\xf8\x0d\x04\xdfuN\x05\xe9@8?\x0b\xf1\"$\xcd@\xba\x1f\xf0\xf1\x9d\xea\x0f\xbe\xe8\x9c\x8dR\x80\x1b~\xdf\xf9\x14\xdci}\xfd\x07g\x16\x00x\xfb:L\xa0f\xb1m\x9a\x1fA\x18[M\x0e\xeb\x9a\xac[\xe7\xea\xc7`\xd4`sf\xb9\x02\x00\x00\xff\xffPK\x07\x08\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xbdn\xeb0\x0c\x85w=\x05\x87;\xdc\x0e\x86\xfb\x93\xa1\xd5V\xa4\x19\x93\x06m\xd0\xb5`e6\x11\"\x89\x82D\xbb\xf0\xdb\x17Jj\xc3\x86\xb9H\xfaxxx \x8c\xf6\x83R\xb6\x1c4`\x8c\xb9\xee\xee\xd4\xd9\x86F\xc3\x0bE\xc7\xbd\xa7 \xca\x93`\x83\x82Z\x01\x04\xf4\xa4\xc1c\xc0#\xa5\xbfw\x8ehHC\xee\xb3\x90W9\x92)J!\x1f\x1d\n\x95;\xc0@K\x19\x0e\x826P\xca\x03\xa9\x16\xbe\xd7\x8a\x9cd\x14\x15\xd98\xba\xe7$\x1a\x9eV\xab\x87\xb1;\x84\xfb\xa1\xaf\x13\xf3\xb9\xca\x94\xba\x89\x17@L,l\xd8i8\xac\xf7#\xef\xd8\xb5\x9e\xb6\xdc\x86\xf9*_\xc8\x1e\xe5\xa4\xa1\x16\x1f\xeb\xf3c\xae\xe6\xd6u9l8V\x86\x92\xe4E\x8eB'0\x116\xaf\xc1\xf5\x1a$\xb5\xa4\xa6\xcb\x17\xff0\x1b\xcdd\x12\x89\x9eX5\xf4\x8d\xad\x93-7\xa4au\x7f;i]\xc5\xbb\x8b\xcb\xbf\xff\xef\x9b\xf5\xdb\xe6\xf0\xb9{\xdennf\xe1\xad\xa1Kj\xf5\x1b\x00\x00\xff\xffPK\x07\x08\x861cw\x06\x01\x00\x00\x01\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\xd01O\xf30\x10\x06\xe0\xdd\xbf\xe2$\x7f\xc3\xd7\xc1\xa9\xba\xa1lU\x14\xa4\x0eE\x08\xaa2\xa2K|M\x8e$ve_Z\xc1\xafGn\x0b\x05\xd4\x0eH\x8c\x96\xef\xb5\xdf{4\xacZ\x8e\xb0E\xa9[@k\x01\x9d\xf3\x82\xc2\xde\x81x@;p\x8c\xe9\xb0\xa7\xaa\xf5\xbe\x83\xda\xbb\x0d7\x80\xce*\x0d\xd2\x12\xec00V=E\xf8\xf7\xbf(\x1fV\x8b\xdbE1_\x95\xcfw\xf3e\xf9x?/\xcaI\x1a\xbep9\x81=\xf7=T\x04q\xac\xa2\xb0\x8cB\x16\xaaW\xe8\xc6(~\xe07\xca\x14nyM!\x15\xc8\xcf]\x025\x1c%\x1cJf\xddM\xcc\xd8Ow\xb3\x8a\x04g\xaacgsX\x8ei\x05\xd7<\x1dK\x17\x87\xce\xe31\xa1\x06\x12\xb4(\x98+\x00\x87\x03\xe50\x9c\xc6\xcdiIS\x7f\x0b\xc0\x17\x94\x98R\x005\x051\x03:l(\xa4\xef\xd9\xbdP-\xa6F\xb3	~\xc8\xafRL/0(\x0d\xa5K\x82\xb0\xf1\x01\xd6\xd8\xb3=\xb4\x81S\xfb\x98)m\x8cQ\xfa\xd7\x18\xfa\xa8q~\xf2\xa2\x87>\x83\xe8\x0f\x91\xddg\xe4\x8a\x89\xfe\x81\xa2\xff\\\xe5=\x00\x00\xff\xffPK\x07\x08:Z\xb9\xdb$\x01\x00\x00\x9a\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xccKLO-\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\x94SMO\xdb@\x10\xbd\xe7W\x8c\x10\x07z0\x0e-\x87v%\x0e\x14\\T5\xd0(P\xaeh\xb2\x1e\xe2U\xf6\x8b\xd9\xb1\xab\xf4\xd7W6I\xba\xa6\xa2*{\xb2g\xde\xbc\xf7ff\x17\xa3\xb9'N&x\x05\x18c*\xbb\x93\xc9\xda\xf8Z\xc1%E\x1b6\x8e\xbcL\x1c	\xd6(\xa8&\x00\x1e\x1d)p\xe8qE\xbc\xfdO\x115)H\x9b$\xe4&\x00\x16\x97dS\x8f\x06\xd0\xc1\x0b\x07[D\x8b>+L\x91t\x0fHdIK\xe0g\xb0C\xd1\xcd,\xab~\xb5\x1e\x80)Z\xa31)8\x99\x00\x08\xb9hQh\xcb\x93\x19\xee\x8f\x1dQ\xfe\x83\x14`gl\xf8&\xee\x8c\xa6s\xadC\xeb\xe5f\xe8<af\x0c\x8d'\xde\xf3\x16`\x1c\xaeH\xc1S\x8b\x9bc\x13\xcau\xbb$\x8d\xcc\x86\xb8\xd4(\x18[+\xaaw\x99do\xe5\xe5<\x9f\x0f\xf2*\xb3[\xc0AQhb)j\xc3g\x87G\x17\xd5\xe2\xee\xe1\xf2\xeb\xe2\xdd\xc1\x08\xd2\x9d\x1d\x1e\xcd\xbe_=\xcc\xaa\xfbj\x96\xe5\xc8w9\xd7\xb3\xe2\xb7\x1f\x9f\xab\xc5MuW\xdd>\xdc\x9c_W\xb7\xf3\xf3\x8bj\x0f\x02\xe8\xd0\xb6\xf4\x85\x83SY\x10\xe0\xd1\x90\xad\x17\xf48\x8en\xe3s\x94F\xedg\x7f\xbc\xbf\x19\x7fi\xef\xfc\xbf\xd4SpP\x8a\x8b\xe5\xfac*~\xd2\xb2	a]\xf4; .\x87U\xf8\xd50\x85\xf4\xa75\xa6\x14Z\xd6\x94rC\xd68#ilQ\xc7V\xc1\xc9t\xeaFQG.\xf0F\xc1\x87\xe9\xb5\xc9\x12LO-\xa5\xb7Q\xbc\xcf)b\xe0q\xf5\xaes&\xac\x8d\xa7\x94\x8a\x1e2\xa6\xdf\xdd\xa7y`Q\xf0\xe9\xf4t:\xcaG\x0e\x12t\xb0\n\xee.\xe6\xd9\x00\xb6\x84s\x0eK\xca%\x1b\x91xE2\xee!\x0e+*\xfb\xaa\xcd\xafqfP}\xc5\x9f5\x1d\xbdY\xa4!\xb4\xd2\xfc\xb7\x8a\x10;\xe3QL\xf0W\x8c\x9a\xe6\xc4&\xd4\xb7\xa4\x83\xaf\xfbG>\x9d\xfc\x0e\x00\x00\xff\xffPK\x07\x08\x92;=\xed\xee\x01\x00\x00\xac\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8\xcd\xcf\xcb,\xc9/\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xc1J\x841\x0c\x84\xef}\x8a\x01/z\xe8.^\xfb\x0e\x0b\x82\xe0=\xf6\x0f\xfb\x17\xdb\xa4$q\xc1\xb7\x97\xdf\xadx\xf3\x96L2\xdfLz\xc0\x8b\xe9\xe0\xd8\xf9\xd3qQi\xa1\x86W\xb6[\xab\x8c\xc7\x0b\x87\xb5\xeaO\x89f{c\xf3\xa6R0\xee_M\xae\xa7\xaa\xc6\xea\xa7\xaa\xe3|{N\x1fM\xb6\xf2k^\xac48h\xa3\xa0\x92\x80N\xef\xdc\xfd\x98\x80\xaa\x12\xa6=\xcfN\xc2\x05\x83\x84\xael	\x10\x1a\x7f{\x1e\xf7\x06y\x85\xae\xbbO\xaa\\\xe0_\x1e<\x92O\xae\x07\x94e\x9b\xda$VB\xc6\xa4\xd8\x0b\xce\x8b\xf1#\x02S-\n\xf6\x88y(\xce\x9dk\xa8\xfd[\xea;\x00\x00\xff\xffPK\x07\x08P\xff\xd7\xb2\xb3\x00\x00\x00&\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8\xb4\xcf\xb1n\x83@\x0c\xc6\xf1\xddO\xe1\x178\xaan\xd5mm\x87\xeeT\xean\x0e\x978\x80\x8d|w\x0cy\xfa(K\x14	\x94\x05e\xb6\xfc\xff\xf4\xa3E\xfe\xd8\xb3\x98F\\\xdfa\x14\xed#\xfe\xb2\xaf\x92\xf83%\xabZ`\xe6B=\x15\x8a\x80\xa84s\xc4L\x10B\x80\xc7g\xef(5T\xcb\xc9\\.T\xc4\xb4\x19?r#\xf6v\xcf~O5\x17\xf6\xd6&\xfe\x12\xedE\x87\x9d\xf4LJ\x03;\xb8M\xdc\xf2\xff\xed@\x8b\xfc\xb8\xd5\xe5\xc9\x08 n66\xc9\\\xbb3\xa7\x92#\x04\xdc\x85\x1e\xe3\xbd\xd4u\x18t\x0d\x00\x00\xff\xffPK\x07\x08\xa8\xc1#~\xa0\x00\x00\x00\xe9\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8,\x8c\xb1j\xed0\x10D{}\xc5\xc0m\x1f\xf7\x91.\xa8\xcd\x1f\x84\x90&\xa4X\xdb\xc3\xb5\xb0\xb4kV\xab\x18\xe7\xeb\x83C\xbaa\x0e\xe7\xdc\xf0\xa0\xd2%\xb8`:a\xfb\xb5\xcda\n\x1f\x9an\x988\xcb\xe8\xc4A\x1c\xe6\x1b\x8e\x12+\xc4\xa7\x12.~\"\xce\x9d\xfd\xdfEM\xeb\x89M\xed@\xac\x84\x92\x0b\x17\xec\xf4Vz/\xa6\xfd/\x19\xa51\xc9^\xde\xe9\xd7\x9d\xe1\x93\xccw\x19\xb1\x9a\x97o\x89bz\xdf\x9e\xfb\xbd\xd8\xff\xaf\xa7\xb4\x15]2^\xea\xe8A\x7f\xb5\xca\xd4\x18\xb2HHN\xc0\xec\xfc\x15\xdeJc\x0fi{\x86\x8eZ\x13\xa0\xd2\x98\xd1D\xe5AO>*{\xc6\xc7g\xfa	\x00\x00\xff\xffPK\x07\x08(\x1a\x93S\xb5\x00\x00\x00\xef\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U(\xca\xcfI\xd5\xabL\xcc\xcd\xe1\xd2UH\xce)-.I-\x8aG\x16K\xca\xccK\xc9\xccK/\x86\xf1sR\x13SR\x8b\xe2SsR\x93K2\xf3\xf3P\xd4b\x93\x8b\x87\x1a\x00Q\x03\x08\x00\x00\xff\xffPK\x07\x08\x02\x01\xf9\x87K\x00\x00\x00{\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\x03A\x0cD\xfb\xfd\n+\xd4w\x11\x1d\xba\x1f\xa0\xa7\xa0wv\x87d\x95\xbd\xf5\xca\xf6\x1e\x12_\x8f\xeer\xa1\x00!A\xe5\x91\xedy3\x0f\xd4\xa0s6\xcbR\x8d\\(	\x15p\x82\x12\n\xa2g\xa9c\xe0\x96_\xa1\xeb\xcbDz\xe28r\xf7\x8bh\xfe\xe0\xed~}\xb21\xcbqy\x0c\xd7\\\xd3D/R\x10f8'v\x9e\x02Q\xe5\x19\xd3\x8e\x1d\xee\xd8A\xd77\xed\x056\x85\x81\xb8\xe5g\x95\xdel5\x0ct8\x04\"\x85I\xd7\x88}\x17\xa5\xbe\xe5\xf3\xcc\xcd\x02\xd1\x02=\xed\xfb3|\x9b%\xdbM\xbc\xb3\xc7\xcb\xcd\xa2`\xc7&{Kw\xd9\xbe\xee	\x05\x8e\xff\xc6\x1f\xcd\xd9\xfb/-~\xe4\xfc	\x8e\x05\xd5\xbf\x11\xf7\xf2\x9f\x01\x00\x00\xff\xffPK\x07\x08\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8d\xb1\xae\xc20\x0cEw\x7f\x85\x7f\xa0}z\xdbS\xb6\xc7\xc2^$v71`\x9a\xda\x95\x93t\xe0\xebQ\xa5\n\xb6nw8\xe7\x1eZ\xe4\xca^\xc44\xa0\x8f\x14{j\xf5a./\xaab\xdaO\x7f\xa5\x17\xfbY\x7fa\x12M\x01\x07\xcb|\x12M\xa2w\x98\xb9R\xa2J\x01\x10\x95f\x0e\x98\x99\x12{\xc7\x99\xe3fwn\x99\xc7\x9d\xde\xf6\xc0\xb7\x0d\xa6E\xcenm9(\x02\xe27x\xf8\x0f\xa5\x8dO\x8e\xb5\x04\xe8v\xe7\xc2\xbeJ\xe4\xff\x18\xadi\xfd\xd8\x85\xe0\x1d\x00\x00\xff\xffPK\x07\x08+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xac\x8d;j\x04A\x0cDs\x9dB\x17\xe8Y\x9c\x99\xbe\x80sc\x9ckz\x8bY1\xfd\x19$\xf5\x18|z\xe3\xf5\x86\x0e7\xaa\x82z\xc5\xa3\x94\x12\xc9\xa1\x9f0\xd7\xd13\xdb*e\x91\x19\xb7a\xfa-\xa1\xa3/\xfb\xab/:.\xe7\x0b\xed\xda\xaf\x99\xdfG\x055\x84\\%$\x13s1\xdc\xc9\x0fm\xf0\x90vd\xee\xb3Vb\xee\xd2\x90\xb9I\x97\x0dF6+<Sb9\xf4\xcd\xc6<\xfc\xf7\x9dx\x9f+\x8a\x98)l\xd1A\xcc\x06\x1f\xd3\n\x1e\xbb\xc3N-(uz\xc0\xc4]\xb7\xde\xd0\xc3\x89\xf9\x84\xad\x0flC\xdc\xb3\xaa\xff\x95/\x89r{\xa2\xee\xe2!1\xff\xb1\xfe\x04\x00\x00\xff\xffPK\x07\x08\x89{\x9d2\xb1\x00\x00\x00F\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xcc\xcbLK-.)\xd6\xabL\xcc\xcd\xe1\xd2U(N-*\xcbLN\x85p\xb9\x92\xf3\xf3\xd22\xd3K\x8b\x12K2\xf3\xf3\xc0\x1a\xb2K\x8bK\xf2s3\xabR!R\x10u\x80\x00\x00\x00\xff\xffPK\x07\x08\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92\xb1n\xa4@\x10D\xf3\xf9\x8a\x926>\xb8\xbd\xe8Dz\xd1\x05N\xbc\x92\x1d\xf7B\x03-`\x1a\xf7\xf4\x80\xec\xaf\xb7\x00YZG\xdeh\xd3V\xe9\xd5\x9b\xd2\x9c\xe0=\xa3\xd5q\xd4Ub\x87Zc+\x1d$\xa1U\x833\xd5\xfdv\x1err\x9d\xe4\x83\xb1\xf6l\x0cW\x8c\xaa\x03\xc8\xb7CD\xca\xd7\xe4\xe2\xd9\xb7\xf0B\x96\x8ap\xc2\x7f\x87\xf1[\x16\xe3t\x03X\xfe\x14\xe7\xe27\xd4\x10ye\xdbP\xab\xda\x80\xd9tf\x1b\xdf\x8b\x10i\xe2gn\xd98\xd6\\\x85_\x18$6\x15.l\x8b\xd4\x1c\x80\x85-\x89\xc6\n\xcb9\x00\xad\xf0\xd8\\f\xaeS\x15\x80\xaf\xf4Sv\xdal^\xf9\xda\xab\x0e\xff\xf6we#\x17\x8d\x01\x00:\xd3<W\xa0f\x92\xb4\xd1\x8c;I~\x04\x8a\xe1o*D\xf7\xdcL\xdeWX\x0fL*\xebQ8\xfa\x81+\xd3\xa1Tn\xc67\xdd/4J\xf3\xe0\xf6}\xb54\xd3\xedb?lp\x9f\xc3\xbd\x06{w\x00jcr\xae\xe0\x969\xdc\xbd\xc8\x03\\\xc2B\xf6\xed[\x1d\xb4\x89\x9d\x1ar*)F\xf5\xbd0\x85\xcf\x00\x00\x00\xff\xffPK\x07\x08w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x01\x00\x00\xff\xffPK\x07\x08\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8bA\n\xc2@\x0cE\xf7s\x8a\\\xa0\x0b\xb1\x1bs\nAp\x1f\xa7\x9f:\xb43\x19\x92P\xf1\xf6\xd2\xd6\xdd\x7f\x8f\xff\x92\xf4\xf2\x84y\xd1\xc6\xb4]\xd2R\xda\xc4\xf4\x80m%#U\x84L\x12\xc2\x89\xa8I\x05\xd3\x07\xaf\xb7\xea2\xf8\xffqz\xef\x92\xc1\xe4_\x0f\xd4\xe4\x1dy/\xbaZ\xf8>\x88\x86\x03\x98\xc6\xf1z0Q\x88\xcd\x88\xfbao\xa7v\xac\xc8\xa1v&Y[\x98\xaeC_\xa5\x81\xa9J\x93\x19\x96~\x01\x00\x00\xff\xffPK\x07\x08\xf4	\xd6f\x82\x00\x00\x00\xb1\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80%\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbb\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Z\xc2\xb6\xf8`\x03\x00\x00\x02\n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd8\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x89\x07\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x861cw\x06\x01\x00\x00\x01\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x803\x08\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(:Z\xb9\xdb$\x01\x00\x00\x9a\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x92	\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x12\x0b\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x92;=\xed\xee\x01\x00\x00\xac\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x83\x0b\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbc\x0d\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(P\xff\xd7\xb2\xb3\x00\x00\x00&\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x800\x0e\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa8\xc1#~\xa0\x00\x00\x00\xe9\x01\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x801\x0f\x00\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!((\x1a\x93S\xb5\x00\x00\x00\xef\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1a\x10\x00\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x02\x01\xf9\x87K\x00\x00\x00{\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1c\x11\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb5\x11\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd0\x12\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x89{\x9d2\xb1\x00\x00\x00F\x01\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbe\x13\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb4\x14\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80O\x15\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc1\x16\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4	\xd6f\x82\x00\x00\x00\xb1\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x13\x17\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x15\x00\x15\x00\xad\x06\x00\x00\xe0\x17\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x92Mk\xdcL\x10\x84\xef\xfa\x15\x05\xfb\xc2\x1bC$\xc59\xeaf\xc4\x12|\xf0\x12\xd6\xc69\x86\xde\x99\x964h4#\xa6[\xfeH\xc8\x7f\x0f#\x19\x8cMX\xf0Q\xea\xea\x8fzjv\xb8\x1b\x18]\xf4>>\xba\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3ML\x0c\x1b\xcd2qP\x18\n81\xba\xb8d\xb9bP\x9d\xa5\xa9k\x1b\x8dT\xb9\xb9\x9c(P\xcf\xa9r\xb1\xd8\xe1\xc7\xd5\xf1p}\xf8\xd6\xe0\x8eR\xcf*h9\xe9\xcd\xa6\xc0\x97\xea\xf2\x12f`3\x9e\x1dSs\xa8=)\x8b\xd6J2J\xbd\xcc}\"\xebB_\xbb`\xf9\xa9\x1at\xf2\xe8b\xc2)1\x8d\xd9\xb5\x19(\xf4,\x05\xcd\xee\x9e\x93\xb8\x18\x1a\xbc\x9f\xfapI~\x1e\xe8k1\xba`\x1b\\\xaf \x8a\x89\x95,)5\x05\x10h\xe2f\xa5\xb5\xc1*7X/\x15\x99\xc9\xe4\xf2\xb3(O\x85\xcclrOV\xdf\xae\xea\x06\xbf\xff\x14eY~\xe4\x88\xf6\x95\xfe?/I\x0f.\xf4ev\x02\xec\xa0\x83\x93\xb5\x04\x19\xe2\xe2-&R3@\x07F\x0c\x0c\x9ag\xa6\x943\x0e\x18\x17\xd18\xb9_lb\xe8\\_=\xd3\xe4\xcf\xfa\xd8\xe1\xbfO\xb7\xfb\xe3\xfdu\xbb\xffy\xb8\xba\xd9_\xac\xef\xe3\xed\xbf\xdb\xefW\xed\xfe\x02\x8f\xce{\x9c\x18\xb2\x9cD\x9d.\xca\x16\xa7\xe7\xd7\x95\x05`\x83\x1chb\xc9^\xca\xf7\x93\xab\xb7\xdf\xdb\xd4J\x1e\xcc\x87\xc4\x95\xf1\x8b(\xa7\xcaGC\xd9\xdb\x16\xd6\x91\xbb\xbc\x14x\x932p.]a\x93X\xf3\xc1\xcdzk{\xdc\xdf\xad\xdb.\xcaG>\x0d1\x8e\xe5\x1a\x85\xe1-\x8a\x97$\xb6\xb6\x0dG\x88\x9a\x91\xcc\x89;\xf7\xc4\xf63\xc4\x05\xc3p\xfa\xbf\xac\xb5\xed	\xbc\xe3\xf4w\x00PK\x07\x08\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00F\x00\xb9\xffresources:\n- certificate.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x93\x0dV/P\xf6\xbc\x9e)\xd6\xee\x1e\xaa\xaaW\xf0\xf42\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06\x8f\xab:roE\x97a\x12\xda\x1b\xd4Q\xba!(y\xd5\xb6\xe02<z\xd5Wb\xed/\x88\x8e\xb1\xcd\x12D\x93J\x18\x0b\xa4\xcd\xb8\x8a\xc1\xc7\x93\x87\xc6\xd8\x95\xb4_\xcf,4\xb6\xcc)\x1dp\xd16O\xb8w\x1f\xb4\x04,\xd6\xc76!\xd3\xe2P\xa5\xc9B\xbb\xd5\x9e\x80\xa2|\x9e\x1f6f\x9f\x12\xf0\xb1\xbb\xa3\x85\x16\xcd\x12L\xc0/s`\x93X'\xf8\xc6|\xd4\xf7og\x96\xe3\x9e\x93\xd2U\xec\x9b\xa6\xaf\xf6\x8f\xf2'7\xf7Z{;\xed\xe6\xdf\x91\xb9\xf9.\xf8?\x08g6\xc6I*\xd3\xdb\x00PK\x07\x08\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xcc\x96Ko\xe36\x10\x80\xef\xfc\x15\x83\xe8\xe0\x16X\xcbh\x8f\xbe\xb9\x86\xdb\x0d\xda<\xe0d\xb7\x87\xa2\x08F\xd4\xc8\xe2\x86\xe2\xa8$e\xc7\xfb\xeb\x8b\xa1dK\x0e\xba\xdd\xa2\x08\xd0\xde,\xce\xeb\x9b\x07\x87\xce`U\x96\x01\x1c6\x14Z\xd4\x04\x91\x01\xad\x05O\x81;\xaf)\xe4\xea,[\xc2sW\x90F\xef\x0d\xf9y8\x86H\x8dR\x19|D\xdb\x11p\x05\xb16\x01*C\xb6\x04\x13\xa0\xf5\xd4\x92+\xa9\x14\x9f\xb1&\x95\xf5aD\xf3\"\xc4;\xa0|\x97\x03BI\xad\xe5cC.&\xcdRepu`_\xb6\x9eB\xb8\x82\x824\x8b\xfd\x15Z\xa3)\xccGQ\xae2\xb8\xe5H\x10k\x8c`\"\x84\x9a;[\x02\xda\xc0\xd0`\xd45\x1cL\xac\x85B\xb0*\xf3\x02\xdfDz\x89PP\xc5\x9e`6\x9f}+X\"?\xa7\xab\xb2!\x17,xO}\x1d\xee\x93\xf1\x124Fl;\x1b\xe7Jin\x1av\xbf`A6,\x15Lk\x94\x1b^x\xb64\xea+U`\xa0\xb0Ts\xc8\xf3\x85/P\xf7\xbf\x1at\xb8#\xaf2\xf8\xed\xd7\xcd\x0f\xef\xef\xee~\xfe\x1d\x1e\x19\xc8aa	\x0eT\xd4\xcc\xcf\xef\xa0s\x12M\xea#\x05\x14\xda@:\x1av\xa1\xcfo4\x1e\xb24N\xdb\xae4n\x97\x94\xd9\x11\x18\x07\xda\x97\x8b\xe7.Dn\xccg\x14\xeb\xfc\x88\x8d\xedA\x86P\x02\xb2\xdel\x1foV\xb7\xab\x9f6\xdb)\x8c&\x1f\xe7\x03\xefk\xa2K\x9a\xd9\xc4\xc3,\x87\xd9\x007\x03\xcdM\xcb\x8e\\\x0c\x80\x9e\xc0\xd3\x1f\x9d\xf1T\xe6=\x82\x04\x98\xd4\xe3~{w\xb3y|\xbf\xf9\xf00\xa5h=7\x14k\xea\x024\xecL\xe4\xaf\xb1\x8cnf\xb9\xcaR\xa0\xd1\x87R\xad\x0c	\x85\x87\xe81\xd2\xce\xe8\x1b\xf2;\x92vfp]\xc1\x91;8\xa0\x8b\xf2\xc3\xc3\x00'cM/-\x07\x19;\x82EC\xd1\x1b\x1d\x92\x0d\xb9\xb2e\xe3\"\x1c\x16\x0c\xe8\x8e\x80]\xac\xdd\xe2\xf3\x14R:R\xb1\xb5|\x90\xfeX\xe3\x08\xd0\x95\xc9\xfc\x94\xc7\x10\xe9I\xac\x9fZ\xcf/\xc7\xa7\x04\x9a\x1a\x96'\xd5;g\x8f\xa9\xb1\\\xfd\xbd\xfa\xd9\xf9IkL\xffi@\x9f8?\xdd\xa0\x82\x86!,S\xd5\xfe\x91\xad\xfa\x8f\xc7\xf8\x049\xc4{M\xf6\xc6s\xad2\xf8pV\xbe\x10\x8df_\xbcti\x86\xfa\x89\x96qX\xaf\xc0\xb8O}-\xc4H\xce\xb0lL\x08r0\xe4\x13$\xe4e GT\x06\xf15\xb6K\xbe\xba@\xa0qt\xa9\xe6'\x1f\x1a\xcf\x87\xaf\xcas9\x95\x9a]ev\xb2\xcd+\xf6\x10	u-]8m\x0f\x82\x9a\x0f\x12\xa9d\xd8\xa3\x87\xd0\x15!\x9a\xd8\xa5`{\xf4a\xf9\xf6\x8bdXm\xb2,dU/A*q\xfd\xe3\xf5z\xf5\xb8y\xba]\xddl\x1e\xeeW\xeb\x0d\x0c\x8fMz\xd3\x86\xcd.\x01Me4F\x82\xf5V\x01p\xf1\xc9S%\xb7\x1c\xe0\xd9\xb8r	\xebQ%\x9d\xee<w\xed\xf2\x0257\x9cD{\xf2\xd2\x95%\xec\xbfC\xdb\xd6\xf8}:\xed\x91\x02\xf9\xbdq\xbb\xb9\xd8A\xd6\xbf\x8b\"9\xdd\xaa\xfeI\x9a\xae\xe41n\xdf	\xe8\xdf\x9e3^\xfaj1\xd6Kh(b\x89\x11\xf3\xf1\xa5\xfaR)\xfe\xdf9\x9e\xa8\x1f6\xdb\x8f\xd7\xeb\xaf4/\x95T\xd3_f\xf4p\x96] O\x1a2\x8c\xfd|\xf4\xf2o\xca;\x05}#\x90\xd1\xf5z\xbby|K\xcf\xaa\xbf\xbb\x9dO\xdb&\xfd\xe58_\xdb^\x94\x1f\xb1\xb1\xea\xcf\x01\x00PK\x07\x08Z\xc2\xb6\xf8f\x03\x00\x00\x02\n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x00_\x00\xa0\xffvarReference:\n  - kind: Deployment\n    group: apps\n    path: spec/template/spec/volumes/secret\n\x03\x00PK\x07\x08\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xbfn\xf30\x0c\xc4w?\x05\x87o\xf8:\x18\xee\x9f\x0c\xad\xb6\"\xcd\x984h\x83\xae\x05+_\x13#\x96(Ht\x8a\xbc}\xa1:6l\x84\x93\xf4\xe3\xf1\x8e\x12\x87\xe6\x0315\xe2\x0dq\x08\xa9:\xdd\x15\xc7\xc6\xd7\x86^\x10Z9;x-\x1c\x94kV6\x05\x91g\x07C\x8e=\xef\x11/\xf7\x14\xd8\xc2P:'\x85+R\x80\xcdJ\x85\x0b-+\xf2\x99h\xa0\xb9\xacx\xe5\xc6#\xa6\x81\x94W\xbe}\x05\x89:\x8a\xb2l\x1c\xddJTCO\x8b\xc5\xc3\xd8\x1d\x96\xfb\xc1\xd7A\xe4X&\xc4\xd3\xc4\x8b(DQ\xb1\xd2\x1a\xda-\xb7#?I\xdb9\xac\xa5\xf3\xf3(\x97\xc9\x96\xf5`\xa8R\x17\xaa\xe3c*\xe7\xd6UNh\xfc\xbe\xb4\x88\x9a&A\xfdc2\x9d\xc0\x08\xae_}{6\xa4\xb1\xc3\xa5\xd1\x87_\xfd\xc3l4\xc1F\xe8 \xc9U\xe3\x9b\xbbV\xd7R\xc3\xd0\xe2\xfev\xd2\xea\xc5\x9b?\x97\x7f\xff\xdfW\xcb\xb7\xd5\xees\xf3\xbc^\xdd\xcc\x96o,J\x8b\xa8\xc5\xef\x00PK\x07\x08\x861cw\x07\x01\x00\x00\x01\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\x90Mo\xe20\x10\x86\xef\xfe\x15#y\x0f\xcb\xc1A\xdcV\xb9\xa1(+q\xa0\xaaZD\x8f\xd5$\x1e\x92i\x12\x1b\xd9\x13P\xfb\xeb+\x03-\xfd\x80C\xa5\x1e\xad\x99\xd7\xf3\xbc\x8f\x86U\xcb\x11\xb6(u\x0bh-\xa0s^P\xd8;\x10\x0fh\x07\x8e1=\xf6T\xb5\xdewP{\xb7\xe1\x06\xd0Y\xa5AZ\x82\x1d\x06\xc6\xaa\xa7\x08\x7f\xfe\x16\xe5\xddj\xf1\x7fQ\xccW\xe5\xe3\xcd|Y\xde\xdf\xce\x8br\x92\x96/\x0c'\xb0\xe7\xbe\x87\x8a \x8eU\x14\x96Q\xc8B\xf5\x0c\xdd\x18\xc5\x0f\xfcB\x99\xc2-\xaf)$\x80\xfc\xcc\x12\xa8\xe1(\xe1\x00\x99u\xffb\xc6~\xba\x9bU$8S\x1d;\x9b\xc3rL\x15\\\xf3p\x84.\x0e\xcc\xe31\xa1\x06\x12\xb4(\x98+\x00\x87\x03\xe50\x9c\xd6\xcd\xa9\xa4\xa9?\x05\xe0\x83\x94\x98R\x005\x051\x03:l(\xa4\xf3\xec\x9e\xa8\x16S\xa3\xd9\x04?\xe4WUL\xbf\x0f&JC\xe9\x92A\xd8\xf8\x00k\xec\xd9\x1e\xe0\xe1D\x1f3\xa5\x8d1J\xffX\x86>\xda8\x7fy\xd1\x87>\x0b\xd1oFv\xef\x91+N\xf4\x17)\xfa\xd7\xad\xbc\x0e\x00PK\x07\x08:Z\xb9\xdb#\x01\x00\x00\x9a\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- manager.yaml\n\x03\x00PK\x07\x08\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\x94ROO\x1bO\x0c\xbd\xe7SX\x88\x03\xbf\xc3\xb2\xc9\xaf\x1c\xda\x918P\xd8\xa2\xaa\x81F\x81rE\xce\xacIF\x99\x7fx\xbc\xa9\xd2O_\xcd\xb2YvUQ\x15_\x92\xf5\xf3{~\xf6\x18\xa3y N&x\x05\x18c*w\xb3\xc9\xd6\xf8Z\xc1\x15E\x1b\xf6\x8e\xbcL\x1c	\xd6(\xa8&\x00\x1e\x1d)p\xe8qM\xdc}\xa7\x88\x9a\x14\xa4}\x12r\x13\x00\x8b+\xb2)W\x03\xe8\xe0\x85\x83-\xa2E? \xa6H:\x17$\xb2\xa4%p\xfe\x0f\xe0P\xf4f>`\xbf\xc9\x07`\x8a\xd6hL\nf\x13\x00!\x17-\nu:\x03\xc3\x00cC\x7f1\x95\xa1\x83\xb1\x1c\x89xg4]h\x1d\x1a/\xb7\xed\xe4	;0\x0f\x86\xc6\x13w\x83\x02\x14`\x1c\xaeI\xc1s\x83\xfbS\x13\xcam\xb3\"\x8d\xcc\x86\xb8\xd4(\x18\x1b+*\xbbL\xd2Q\xfe\xdc\xe7K \xaf{\xd9,|T\x14\x9aX\x8a\xda\xf0\xf9\xf1\xc9e\xb5\xbc\x7f\xbc\xfa\xba\xfc\xefhT\xb2;?>\x99\x7f\xbf~\x9cW\x0f\xd5|\x80\x91\xdf\x0d\xb5\xf2\x8b)\xf8\xf6\xe3s\xb5\xbc\xad\xee\xab\xbb\xc7\xdb\x8b\x9b\xeanqqY\xf5E\x00;\xb4\x0d}\xe1\xe0^\x999\x9e\x0c\xd9zIO\xe3l\x97_\xa0lT\xbf\xfb\xd3\xdc\xa7\xbd\x8c\xbe\xf6\xd0\xfb\xe0\x7f \xd2\xf6SpT\x8a\x8b\xe5\xf6c*~\xd2j\x13\xc2\xb6\xc8o@\\\xe6\x1f\xe3\xd7\xed\x16\xd2\xebhL)4\xaci\xb0,\x00k\x9c\x91Q\x06@\xc7F\xc1l:u\xa3\xac#\x17x\xaf\xe0\xc3\xf4\xc6\x0c\x00\xa6\xe7\x86\xd2\xfb$\xfe\x1fJ\xc4\xc0c\xf6ar&\xac\x8d\xa7\x94\x8a\\2\xf2\xd2\xdf\xd3\"\xb0(\xf8tv6\x1d\xe1\x91\x83\x04\x1d\xac\x82\xfb\xcbE\x8f\xf4\x82\x0b\x0e\xab\xee\xfa_b#\x12\xafI\x86)\x80\xd8>Q\x99Y\xfb_c\xa4\xed\xfa\x86?kv\xf4\xee&\x1bB+\x9b\x7f\xee\"\xc4\xcex\x14\x13\xfc5\xa3\xa6\x05\xb1	\xf5\x1d\xe9\xe0\xeb\xa4`6\x9d\xfc\x1e\x00PK\x07\x08\x92;=\xed\xf8\x01\x00\x00\xac\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- monitor.yaml\n\x03\x00PK\x07\x08*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xcdJD1\x0c\x85\xf7}\x8a\x03nt\xd1\x19\xdc\xf6\x1d\x06\x04\xc1}\xec\x0ds\x8bmR\x928\xe0\xdb\xcbu*\xee\xdc\xe5\xf7\xfbNz\xc0\x8b\xe9\xe0\xd8\xf9\xd3qQi\xa1\x86W\xb6[\xab\x8c\xc7\x0b\x87\xb5\xeaO\x89f{c\xf3\xa6R0\xeeWM\xae\xa7\xaa\xc6\xea\xa7\xaa\xe3|{N\x1fM\xb6\xf2\xfb\xbcXip\xd0FA%\x01\x9d\xde\xb9\xfbQ\x01U%L{\x9e\x9d\x84\x0b\x06	]\xd9\x12 4\xfe\xfa<\xee	\xf2\x92\xae\xbdO\xaa\\\xe0_\x1e<\x92O\xae\x07\x94e\x9b\xda$\x96!cR\xec\x05\xe7\xc5\xf8\xd1\x02S-\n\xf6\x88yL\x9c;\xd7P\xfb7\xd4\xf7\x00PK\x07\x08P\xff\xd7\xb2\xaf\x00\x00\x00&\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8\xb4\xcf\xb1n\x83@\x0c\xc6\xf1\xddO\xe1\x178\xaan\xd5mm\x87\xeeT\xean\x0e\x978\x80\x8d|w\x0cy\xfa(K\x14	\x94\x05e\xb6\xfc\xff\xf4\xa3E\xfe\xd8\xb3\x98F\\\xdfa\x14\xed#\xfe\xb2\xaf\x92\xf83%\xabZ`\xe6B=\x15\x8a\x80\xa84s\xc4L\x10B\x80\xc7g\xef(5T\xcb\xc9\\.T\xc4\xb4\x19?r#\xf6v\xcf~O5\x17\xf6\xd6&\xfe\x12\xedE\x87\x9d\xf4LJ\x03;\xb8M\xdc\xf2\xff\xed@\x8b\xfc\xb8\xd5\xe5\xc9\x08 n66\xc9\\\xbb3\xa7\x92#\x04\xdc\x85\x1e\xe3\xbd\xd4u\x18t\x1d\x00PK\x07\x08\xa8\xc1#~\x9d\x00\x00\x00\xe9\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8,\x8c\xb1j\xed0\x10D{}\xc5\xc0m\x1f\xf7\x91.\xa8\xcd\x1f\x84\x90&\xa4X\xdb\xc3\xb5\xb0\xb4kV\xab\x18\xe7\xeb\x83C\xbaa\x0e\xe7\xdc\xf0\xa0\xd2%\xb8`:a\xfb\xb5\xcda\n\x1f\x9an\x988\xcb\xe8\xc4A\x1c\xe6\x1b\x8e\x12+\xc4\xa7\x12.~\"\xce\x9d\xfd\xdfEM\xeb\x89M\xed@\xac\x84\x92\x0b\x17\xec\xf4Vz/\xa6\xfd/\x19\xa51\xc9^\xde\xe9\xd7\x9d\xe1\x93\xccw\x19\xb1\x9a\x97o\x89bz\xdf\x9e\xfb\xbd\xd8\xff\xaf\xa7\xb4\x15]2^\xea\xe8A\x7f\xb5\xca\xd4\x18\xb2HHN\xc0\xec\xfc\x15\xdeJc\x0fi{\x86\x8eZ\x13\xa0\xd2\x98\xd1D\xe5AO>*{\xc6\xc7g\xfa\x19\x00PK\x07\x08(\x1a\x93S\xb2\x00\x00\x00\xef\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00{\x00\x84\xffresources:\n- role.yaml\n- cluster_role.yaml\n- bindings.yaml\n- leader_election_role.yaml\n- leader_election_role_binding.yaml\n\x03\x00PK\x07\x08\x02\x01\xf9\x87\x82\x00\x00\x00{\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\xc3@\x0c\x86\xf7{\n\xab\xccI\xc5\x86\xf2\x02\xec\x0c\xec\xee\xddO{\xea\xe5|\xb2}A\xe2\xe9QB\xca\x00B\xa2\xdb'\xdb\xff\xf7\xfb\x81\x1at\xcefY\xaa\x91\x0b%\xa1\x02NPBA\xf4,u\x0c\xdc\xf2+t=\x99HO\x1cG\xee~\x11\xcd\x1f\xbc\xed\xafO6f9.\x8f\xe1\x9ak\x9a\xe8E\n\xc2\x0c\xe7\xc4\xceS \xaa<c\xda\xb5\xc3M;\xe8z\xa6\xbd\xc0\xa60\x10\xb7\xfc\xac\xd2\x9b\xad\x81\x81\x0e\x87@\xa40\xe9\x1a\xb1\xcf\xa2\xd4\xb7|\x9e\xb9Y Z\xa0\xa7}~\x86o\x99\x92\xed\x0b\xde\xd9\xe3e\xa3\xa8`\xc7\x86\xbd\xa5\x1b\xb6\xef}B\x81\xe3\xde\xfa\xa39{\xff\xe3\x8b_=\xff\x92cA\xf5\x1f\xc6\xa8`G\xf8\x1c\x00PK\x07\x08\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd\xb1\x8e\xc20\x0c\xc6\xf1\xddO\xe1\x17hO\xb7\x9d\xb2\x1d\x0b{\x91\xd8\xdd\xc4\x80ijWN\xd2\x81\xa7G\x95*\xd8\xbay\xf0\xef\xfb\xd3\"W\xf6\"\xa6\x01}\xa4\xd8S\xab\x0fsyQ\x15\xd3~\xfa+\xbd\xd8\xcf\xfa\x0b\x93h\n8X\xe6\x93h\x12\xbd\xc3\xcc\x95\x12U\n\x80\xa84s\xc0\xcc\x94\xd8;\xce\x1c7\xdd\xb9e\x1e\xf7\xef\xed\x1e\xf8\x16\x00\x91\x169\xbb\xb5\xe5\xa0\x08\x88\xdf\xe0\xe1>\x946>9\xd6\x12\xa0\xdb\xcd\x85}\x95\xc8\xff1Z\xd3\xfa\xd1\x85\xe0=\x00PK\x07\x08+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xb4\xd2\xc1J51\x0c\x05\xe0}\x9e\"/0s\xf9w?}\x01\xf7\"\xee3\x9d07L\xdb\x94$\x1d\xc1\xa7\x17\xae\xba\x10\x15/\xca\xdd\x95r8\x1f\xa7\x14\xa6i\x02\xea\xf2\xc8\xe6\xa2-\xa1-\x94g\x1aqV\x93g\n\xd16\xef\xff}\x16=\x1d\xff`\x97\xb6&\xbc\xd7\xc2P9h\xa5\xa0\x04\x88\xd9\xf8\x92|\x90\xca\x1eT{\xc26J\x01\xc4F\x95\x13Vj\xb4\xb1\x81\x8d\xc2\x9e`B\xearg:\xba'@\x9c0SP\xd1m\xde\xc7\xc2\x99\xcc\x84m\x16\x05Dc\xd7a\x99?\xe6\xb8\x85	; \x1el\xcb[\xc7\xc6q\xe9*\xe2\xaf\x87'\x8a|\xfel\xfdh\x0c\x0f\xad\xef\x97\xabx\xd6\x83o\xc79\xdb!\x99s\x19\x1el\xe4.[\xab\xdc\xe2F\xeb\xbe\xe5N\x1e\x14\xe3\x0b\xf5\x8f\xc4/whg\xa3P\xbb\xeaK\xf4Q\xae|\xaf\x97\x01\x00PK\x07\x08\x10M*X\xe0\x00\x00\x00\xee\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00S\x00\xac\xffresources:\n- manifests.yaml\n- service.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92?o\xa4@\x0c\xc5\xfb\xf9\x14O\xda\xfa\xe0\xf6\xaa\x13\xedUW\xa4\xc9JI\xed\x05\x03\x160&\x1e\x0f(\xf9\xf4\x11\xa0H\x9b*[mk=\xbd??\xf9\x04\xef\x19\xad\x8e\xa3\xae\x12;\xd4\x1a[\xe9 	\xad\x1a\x9c\xa9\xee\xb7\xf3\x90\x93\xeb$\x1f\x8c\xb5gc\xb8bT\x1d@\xbe\x1d\"R\xbe&\x17\xcf\xbe\x89\x17\xb2T\x84\x13\xfe;\x8c\xdf\xb2\x18\xa7\x1b\x83\xe5Oq.~C\x0d\x91W\xb6\xcdjU\x1b0\x9b\xcel\xe3{\x11\"M\xfc\xcc-\x1b\xc7\x9a\xab\xf0\x0b\x83\xc4\xa6\xc2\x85m\x91\x9a\x03\xb0\xb0%\xd1Xa9\x07\xa0\x15\x1e\x9b\xcb\xccu\xaa\x02\xf0\xa5~\xcaN[\x9bW\xbe\xf6\xaa\xc3\xbf}W6r\xd1\x18\x00\xa03\xcds\x05j&I\x9b\x9bq'\xc9\x0fA1\xfcM\x85\xe8\xae\x9b\xc9\xfb\n\xeba\x93\xcaz\x14\x8e~\xd8\x95\xe9\xa8Tn\x8do\xb2_h\x94\xe6\xc1\xe9;\xb54\xd3-\xb1\x1f\x18\xdcG\xe0\xde\xfd{v\x00jcr\xae\xe0\x969\xdcM\xe4\x01]\xc2B\xf6\xed\xad\x8ee\x13;5\xe4TR\x8c\xea\xe4\xa21\x85\xcf\x01\x00PK\x07\x08w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x03\x00PK\x07\x08\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8bA\n\xc2@\x0cE\xf7s\x8a\\\xa0\x0b\xb1\x1bs\nAp\x1f\xa7\x9f:\xb43\x19\x92P\xf1\xf6\xd2\xd6\xdd\x7f\x8f\xff\x92\xf4\xf2\x84y\xd1\xc6\xb4]\xd2R\xda\xc4\xf4\x80m%#U\x84L\x12\xc2\x89\xa8I\x05\xd3\x07\xaf\xb7\xea2\xf8\xffqz\xef\x92\xc1\xe4_\x0f\xd4\xe4\x1dy/\xbaZ\xf8>\x88\x86\x03\x98\xc6\xf1z0Q\x88\xcd\x88\xfbao\xa7v\xac\xc8\xa1v&Y[\x98\xaeC_\xa5\x81\xa9J\x93\x19\x96~\x03\x00PK\x07\x08\xf4	\xd6f\x7f\x00\x00\x00\xb1\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80&\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc8\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Z\xc2\xb6\xf8f\x03\x00\x00\x02\n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe3\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9a\x07\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x861cw\x07\x01\x00\x00\x01\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80S\x08\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(:Z\xb9\xdb#\x01\x00\x00\x9a\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb3	\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x802\x0b\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x92;=\xed\xf8\x01\x00\x00\xac\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa4\x0b\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe7\x0d\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(P\xff\xd7\xb2\xaf\x00\x00\x00&\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\\\x0e\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa8\xc1#~\x9d\x00\x00\x00\xe9\x01\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80Y\x0f\x00\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!((\x1a\x93S\xb2\x00\x00\x00\xef\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80?\x10\x00\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x02\x01\xf9\x87\x82\x00\x00\x00{\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80>\x11\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x0e\x12\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80'\x13\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x10M*X\xe0\x00\x00\x00\xee\x02\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x13\x14\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x808\x15\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe3\x15\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80S\x17\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4	\xd6f\x7f\x00\x00\x00\xb1\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa2\x17\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x15\x00\x15\x00\xad\x06\x00\x00l\x18\x00\x00\x00\x00"
 	fs.Register(data)
 }
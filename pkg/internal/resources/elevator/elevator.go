@@ -48,17 +48,36 @@ type Config struct {
 	TenantKind, TenantVersion, TenantGroup, TenantPlural         string
 	DerivedCRName                                                string
 	LogLevel                                                     *int
+
+	// ConsolidatedName is the name of the consolidated Elevator serving this CRD.
+	// If set, no Deployment is generated for this CRD,
+	// instead its RBAC and webhook configuration are bound to the consolidated Elevator.
+	ConsolidatedName string
+}
+
+// ConsolidatedConfig holds the config information to generate a consolidated Elevator,
+// that serves all CRDs of a provider namespace in a single Deployment.
+type ConsolidatedConfig struct {
+	// Name is the name of the consolidated Elevator instance.
+	Name string
+	// Namespace that the consolidated Elevator instance should be deployed into.
+	Namespace string
+
+	LogLevel *int
 }
 
+// ConsolidatedName is the name of the consolidated Elevator of a provider namespace.
+const ConsolidatedName = "consolidated"
+
 var k = kustomize.NewDefaultKustomize()
 
-func Manifests(c Config) ([]unstructured.Unstructured, error) {
+func newKustomizeContext(name, namespace string) (kustomize.KustomizeContext, error) {
 	v := version.Get()
 	kc := k.ForHTTP(vfs)
 	if err := kc.MkLayer("man", types.Kustomization{
 		// "." needs to be replaced, because it's forbidden for Deployment and Pod names
-		NamePrefix: strings.Replace(c.Name, ".", "-", -1) + "-",
-		Namespace:  c.Namespace,
+		NamePrefix: namePrefix(name),
+		Namespace:  namespace,
 		Images: []image.Image{
 			{
 				Name:   "quay.io/kubecarrier/elevator",
@@ -67,7 +86,7 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 		},
 		CommonLabels: map[string]string{
 			constants.NameLabel:      "elevator",
-			constants.InstanceLabel:  c.Name,
+			constants.InstanceLabel:  name,
 			constants.ManagedByLabel: constants.ManagedByKubeCarrierOperator,
 		},
 		Resources: []string{
@@ -79,6 +98,19 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 	}); err != nil {
 		return nil, fmt.Errorf("cannot mkdir: %w", err)
 	}
+	return kc, nil
+}
+
+// namePrefix returns the prefix of all objects generated by kustomize for the given instance.
+func namePrefix(name string) string {
+	return strings.Replace(name, ".", "-", -1) + "-"
+}
+
+func Manifests(c Config) ([]unstructured.Unstructured, error) {
+	kc, err := newKustomizeContext(c.Name, c.Namespace)
+	if err != nil {
+		return nil, err
+	}
 
 	mutatingWebhookPath := utilwebhook.GenerateMutateWebhookPathFromGVK(schema.GroupVersionKind{
 		Group:   c.TenantGroup,
@@ -86,73 +118,40 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 		Kind:    c.TenantKind,
 	})
 
-	var logLevel int
-	if c.LogLevel != nil {
-		logLevel = *c.LogLevel
-	}
-
-	// Patch environment
-	// Note:
-	// we are not using *appsv1.Deployment here,
-	// because some fields will be defaulted to empty and
-	// interfere with the strategic merge patch of kustomize.
-	managerEnv := map[string]interface{}{
-		"apiVersion": "apps/v1",
-		"kind":       "Deployment",
-		"metadata": map[string]string{
-			"name":      "manager",
-			"namespace": "system",
+	managerEnv := managerEnvPatch([]map[string]interface{}{
+		{
+			"name":  "ELEVATOR_DERIVED_CRD_NAME",
+			"value": c.DerivedCRName,
 		},
-		"spec": map[string]interface{}{
-			"template": map[string]interface{}{
-				"spec": map[string]interface{}{
-					"containers": []map[string]interface{}{
-						{
-							"name": "manager",
-							"env": []map[string]interface{}{
-								{
-									"name":  "ELEVATOR_DERIVED_CRD_NAME",
-									"value": c.DerivedCRName,
-								},
-								{
-									"name":  "ELEVATOR_PROVIDER_KIND",
-									"value": c.ProviderKind,
-								},
-								{
-									"name":  "ELEVATOR_PROVIDER_VERSION",
-									"value": c.ProviderVersion,
-								},
-								{
-									"name":  "ELEVATOR_PROVIDER_GROUP",
-									"value": c.ProviderGroup,
-								},
-								{
-									"name":  "ELEVATOR_TENANT_KIND",
-									"value": c.TenantKind,
-								},
-								{
-									"name":  "ELEVATOR_TENANT_VERSION",
-									"value": c.TenantVersion,
-								},
-								{
-									"name":  "ELEVATOR_TENANT_GROUP",
-									"value": c.TenantGroup,
-								},
-								{
-									"name":  "ELEVATOR_MUTATING_WEBHOOK_PATH",
-									"value": mutatingWebhookPath,
-								},
-								{
-									"name":  "LOG_LEVEL",
-									"value": strconv.FormatInt(int64(logLevel), 10),
-								},
-							},
-						},
-					},
-				},
-			},
+		{
+			"name":  "ELEVATOR_PROVIDER_KIND",
+			"value": c.ProviderKind,
 		},
-	}
+		{
+			"name":  "ELEVATOR_PROVIDER_VERSION",
+			"value": c.ProviderVersion,
+		},
+		{
+			"name":  "ELEVATOR_PROVIDER_GROUP",
+			"value": c.ProviderGroup,
+		},
+		{
+			"name":  "ELEVATOR_TENANT_KIND",
+			"value": c.TenantKind,
+		},
+		{
+			"name":  "ELEVATOR_TENANT_VERSION",
+			"value": c.TenantVersion,
+		},
+		{
+			"name":  "ELEVATOR_TENANT_GROUP",
+			"value": c.TenantGroup,
+		},
+		{
+			"name":  "ELEVATOR_MUTATING_WEBHOOK_PATH",
+			"value": mutatingWebhookPath,
+		},
+	}, c.LogLevel)
 	managerEnvBytes, err := yaml.Marshal(managerEnv)
 	if err != nil {
 		return nil, fmt.Errorf("marshalling manager env patch: %w", err)
@@ -193,6 +192,12 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 				Resources: []string{c.TenantPlural + "/status"},
 				Verbs:     []string{"get", "patch", "update"},
 			},
+			{
+				// Re-exposing referenced Secrets and ConfigMaps
+				APIGroups: []string{""},
+				Resources: []string{"secrets", "configmaps"},
+				Verbs:     []string{"get", "list", "patch", "update", "watch"},
+			},
 		},
 	}
 	roleBytes, err := yaml.Marshal(role)
@@ -279,6 +284,32 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 	}
 	objects = append(objects, unstructured.Unstructured{Object: obj})
 
+	// InstanceMigrations and scheduling of the KubeCarrier manager need to create, update and remove Tenant objects.
+	migrationManagerRole := &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: c.TenantPlural + "." + c.TenantGroup + "-migration",
+			Labels: map[string]string{
+				"kubecarrier.io/manager": "true",
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{c.TenantGroup},
+				Resources: []string{c.TenantPlural},
+				Verbs:     []string{"create", "update", "patch", "delete"},
+			},
+		},
+	}
+	obj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(migrationManagerRole)
+	if err != nil {
+		return nil, fmt.Errorf("converting to unstructured: %w", err)
+	}
+	objects = append(objects, unstructured.Unstructured{Object: obj})
+
 	apiserverManagerRole := &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "rbac.authorization.k8s.io/v1",
@@ -303,6 +334,173 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 		return nil, fmt.Errorf("converting to unstructured: %w", err)
 	}
 	objects = append(objects, unstructured.Unstructured{Object: obj})
+
+	if c.ConsolidatedName != "" {
+		if objects, err = bindToConsolidated(objects, c.Name, c.ConsolidatedName, c.Namespace); err != nil {
+			return nil, err
+		}
+	}
+	setLabels(objects, c.Name)
+	return objects, nil
+}
+
+// ConsolidatedManifests returns the Deployment and supporting objects of a consolidated Elevator.
+// RBAC and webhook configuration of the served CRDs are generated by Manifests.
+func ConsolidatedManifests(c ConsolidatedConfig) ([]unstructured.Unstructured, error) {
+	kc, err := newKustomizeContext(c.Name, c.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	managerEnv := managerEnvPatch([]map[string]interface{}{
+		{
+			"name":  "ELEVATOR_CONSOLIDATED",
+			"value": "true",
+		},
+	}, c.LogLevel)
+	managerEnvBytes, err := yaml.Marshal(managerEnv)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling manager env patch: %w", err)
+	}
+	if err = kc.WriteFile("/man/manager_env_patch.yaml", managerEnvBytes); err != nil {
+		return nil, fmt.Errorf("writing manager_env_patch.yaml: %w", err)
+	}
+
+	// webhooks are configured per CRD, but the kustomize base expects a MutatingWebhookConfiguration,
+	// that is dropped again below
+	mutatingWebhookConfigurationBytes, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "admissionregistration.k8s.io/v1beta1",
+		"kind":       "MutatingWebhookConfiguration",
+		"metadata": map[string]string{
+			"name": "mutating-webhook-configuration",
+		},
+		"webhooks": []map[string]interface{}{
+			{
+				"name": "placeholder.kubecarrier.io",
+				"clientConfig": map[string]interface{}{
+					"service": map[string]string{
+						"namespace": "system",
+						"name":      "webhook-service",
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling MutatingWebhookConfiguration: %w", err)
+	}
+	if err := kc.WriteFile("/webhook/manifests.yaml", mutatingWebhookConfigurationBytes); err != nil {
+		return nil, fmt.Errorf("writing /webhook/manifests.yaml: %w", err)
+	}
+
+	// execute kustomize
+	objects, err := kc.Build("/man")
+	if err != nil {
+		return nil, fmt.Errorf("running kustomize build: %w", err)
+	}
+
+	// cluster-wide permissions and webhooks are configured per CRD
+	var consolidatedObjects []unstructured.Unstructured
+	for _, obj := range objects {
+		switch obj.GetKind() {
+		case "ClusterRole", "ClusterRoleBinding", "MutatingWebhookConfiguration":
+			continue
+		}
+		consolidatedObjects = append(consolidatedObjects, obj)
+	}
+	setLabels(consolidatedObjects, c.Name)
+	return consolidatedObjects, nil
+}
+
+// bindToConsolidated drops the Deployment and namespaced objects of an Elevator instance
+// and binds the remaining RBAC and webhook configuration to the consolidated Elevator.
+func bindToConsolidated(objects []unstructured.Unstructured, name, consolidatedName, namespace string) ([]unstructured.Unstructured, error) {
+	var (
+		prefix             = namePrefix(name) + "elevator-"
+		consolidatedPrefix = namePrefix(consolidatedName) + "elevator-"
+		boundObjects       []unstructured.Unstructured
+	)
+	for _, obj := range objects {
+		var boundObj runtime.Object
+		switch obj.GetKind() {
+		case "ClusterRole":
+			boundObjects = append(boundObjects, obj)
+			continue
+
+		case "ClusterRoleBinding":
+			binding := &rbacv1.ClusterRoleBinding{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, binding); err != nil {
+				return nil, fmt.Errorf("converting ClusterRoleBinding: %w", err)
+			}
+			for i := range binding.Subjects {
+				binding.Subjects[i].Name = strings.Replace(binding.Subjects[i].Name, prefix, consolidatedPrefix, 1)
+			}
+			boundObj = binding
+
+		case "MutatingWebhookConfiguration":
+			config := &adminv1beta1.MutatingWebhookConfiguration{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, config); err != nil {
+				return nil, fmt.Errorf("converting MutatingWebhookConfiguration: %w", err)
+			}
+			for i := range config.Webhooks {
+				if service := config.Webhooks[i].ClientConfig.Service; service != nil {
+					service.Name = strings.Replace(service.Name, prefix, consolidatedPrefix, 1)
+				}
+			}
+			config.Annotations["cert-manager.io/inject-ca-from"] = namespace + "/" + consolidatedPrefix + "serving-cert"
+			boundObj = config
+
+		default:
+			continue
+		}
+
+		unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(boundObj)
+		if err != nil {
+			return nil, fmt.Errorf("converting to unstructured: %w", err)
+		}
+		boundObjects = append(boundObjects, unstructured.Unstructured{Object: unstructuredObj})
+	}
+	return boundObjects, nil
+}
+
+func managerEnvPatch(env []map[string]interface{}, logLevel *int) map[string]interface{} {
+	var level int
+	if logLevel != nil {
+		level = *logLevel
+	}
+	env = append(env, map[string]interface{}{
+		"name":  "LOG_LEVEL",
+		"value": strconv.FormatInt(int64(level), 10),
+	})
+
+	// Patch environment
+	// Note:
+	// we are not using *appsv1.Deployment here,
+	// because some fields will be defaulted to empty and
+	// interfere with the strategic merge patch of kustomize.
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]string{
+			"name":      "manager",
+			"namespace": "system",
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []map[string]interface{}{
+						{
+							"name": "manager",
+							"env":  env,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setLabels(objects []unstructured.Unstructured, name string) {
+	v := version.Get()
 	for _, obj := range objects {
 		labels := obj.GetLabels()
 		if labels == nil {
@@ -310,9 +508,8 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 		}
 		labels[constants.VersionLabel] = v.Version
 		labels[constants.NameLabel] = "elevator"
-		labels[constants.InstanceLabel] = c.Name
+		labels[constants.InstanceLabel] = name
 		labels[constants.ManagedByLabel] = constants.ManagedByKubeCarrierOperator
 		obj.SetLabels(labels)
 	}
-	return objects, nil
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
 	"k8c.io/utils/pkg/testutil"
@@ -51,6 +52,52 @@ func TestManifests(t *testing.T) {
 
 	manifests, err := Manifests(c)
 	require.NoError(t, err, "unexpected error")
+	compareGolden(t, goldenFile, manifests)
+}
+
+func TestConsolidatedManifests(t *testing.T) {
+	const (
+		goldenFile = "elevator_consolidated.golden.yaml"
+	)
+	c := ConsolidatedConfig{
+		Name:      ConsolidatedName,
+		Namespace: "test3000",
+	}
+
+	manifests, err := ConsolidatedManifests(c)
+	require.NoError(t, err, "unexpected error")
+	compareGolden(t, goldenFile, manifests)
+}
+
+func TestManifestsBoundToConsolidated(t *testing.T) {
+	const (
+		goldenFile = "elevator_bound.golden.yaml"
+	)
+	c := Config{
+		Name:      "db.eu-west-1",
+		Namespace: "test3000",
+
+		ProviderKind:    "CouchDBInternal",
+		ProviderVersion: "v1alpha1",
+		ProviderGroup:   "eu-west-1.provider",
+		ProviderPlural:  "couchdbinternals",
+
+		TenantKind:    "CouchDB",
+		TenantVersion: "v1alpha1",
+		TenantGroup:   "eu-west-1.provider",
+		TenantPlural:  "couchdbs",
+
+		DerivedCRName: "couchdbs.eu-west-1",
+
+		ConsolidatedName: ConsolidatedName,
+	}
+
+	manifests, err := Manifests(c)
+	require.NoError(t, err, "unexpected error")
+	compareGolden(t, goldenFile, manifests)
+}
+
+func compareGolden(t *testing.T, goldenFile string, manifests []unstructured.Unstructured) {
 	yManifest, err := yaml.Marshal(manifests)
 	require.NoError(t, err, "cannot marshall given manifests")
 
@@ -22,6 +22,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94ROk\xdd>\x10\xbc\xfbS\x0c\xbc\x1f\xfc\x1a\xa8\xed\xa6G\xdf\x82y\x94\x1c\xf2(/!=\x96}\xd2\xda\x16\x96%\xa3]\xe7OK\xbf{\x91\x1d\x08	i W\xcd\xec\x8cvfw\xb8\x19\x18]\xf4>\xde\xbb\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3UL\x0c\x1b\xcd2qP\x18\n8e\x83%\xd3\x15\x83\xea,M]\xdbh\xa4\xca\xc3\xe5D\x81zN\x95\x8b\xc5\x0e?.\x8e\x87\xcb\xc3\xb7\x067\x94zVA\xcbI\xaf6\x06\xbeT\xe7\xe70\x03\x9b\xf1]\x99\x9aC\xedIY\xb4V\x92Q\xeae\xee\x13Y\x17\xfa\xda\x05\xcb\x0f\xd5\xa0\x93G\x17\x13N\x89i\xcc[\x9b\x81B\xcfR\xd0\xecn9\x89\x8b\xa1\xc1k\xd5\xbbs\xf2\xf3@_\x8b\xd1\x05\xdb\xe0r\x0d\xa2\x98X\xc9\x92RS\x00\x81&n\xd6\xb4\xb6\xb0\xca-\xac'Df2\x19~\x14\xe5\xa9\x90\x99M\x9e\xc9\xec\xeb\x95\xdd\xe0\xf7\x9f\xa2,\xcb\x8f|\xa2}N\xff\xcd\x9f\xa4;\x17\xfa2\x8b\x00;\xe8\xe0d\x85 C\\\xbc\xc5Dj\x06\xe8\xc0\x88\x81A\xf3\xcc\x94r\xc7\x01\xe3\"\x1a'\xf7\x8bM\x0c\x9d\xeb\xabG\x9a\xfc\xbb{\xec\xf0\xdf\xa7\xeb\xfd\xf1\xf6\xb2\xdd\xff<\\\\\xed\xcf\xd6\xfbx\xf9v\xfd\xfd\xa2\xdd\x9f\xe1\xdey\x9foB\x96\x93\xa8\xd3E\xd9\xe2\xf4\xf8lY\x006\xc8![e\xe5\xf2\xb5r\xf5\x96j%w\xe6C\xe4\xca\xf8E\x94S\xe5\xa3\xa1\xbc\xdbV\xd6\x91\xbbl\n\xbch9?\xfc\xbb]a\x93X\x0f+\x9e\xed\xda\xe3\xfefs/\xef\xf94\xc48\x96k\x15\x86\xb7*\x9e\x9a\xd8\xc6\xb68B\xd4\x1c\xc9\x9c\xb8s\x0fl?C\\0\x0c\xa7\xff\xcb\x8am'\xf0*\xa7\xbf\x01\x00\x00\xff\xffPK\x07\x08\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2UHN-*\xc9L\xcbLN,I\xd5\xabL\xcc\xcd\xe1\xe2J\xce\xcfK\xcbL/-J,\xc9\xcc\xcf\x03\xab\xc9.-.\xc9\xcf\xcd\xacJ\x85HA\xd4\x01\x02\x00\x00\xff\xffPK\x07\x08\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x13\x83\xd5\x0b\x94=\xafg\x8au\xba\x87\xaa\xea\x15<\xbd\xf4\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06O\xab:r\xabE\x97n\x12\xda*\xd4Q\x9a!(y\xd5\xba\xe0\xdc=\xda\xa6o\xc4\xda^\x11\x0d}\x9f%\x88*\x1ba,\x90:\xe3\"\x06\xef\xcf\x1e\x1a}(i\\O,4\xd6\xcc)\x1dp\xd6:O\xb8w\xef\xb4\x04,\xd6\xfa>!\xd3\xe2\xb0I\x95\x85v\xab-\x01E\xf92?\xee\xcc>%\xe0cwG\x0b-\x9a%\x98\x80_\xe6\xc0.\xb1N\xf0\x9d\xf9\xa8\xd7o'\x96\xe3\xc8I\xe9\"\xf6M\xd3W\xfbG\xf9\x93\x9b\xdb\xb6\xb5\xfa0\xcc\xbf#s\xf5!\xf8?\x08g6\xc6\xb5\xe3=\x00\x00\xff\xffPK\x07\x08\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xcc\x96\xdf\x8f\xe34\x10\xc7\xdf\xfdW\x8c6\x0f\x05\xe9\x92\n\x1e\xf3V\xaa\xc0\xad`\x7f\xa8\xdb;\x1e\x10\xaa&\xf6\xa4\xf1m\xe2	\xb6\xd3n\xef\xafGv\xd2&]q\x1cB+\xc1[c\xcf\xcc\xf73?l7\x81\x95R\x0e\x0c\xb6\xe4:\x94\x04\x9e\x01\x9b\x06,9\xee\xad$\x97\x89\xcb^\x0e\xcf}I\x12\xad\xd5dSwr\x9eZ!\x12\xf8\x88MO\xc0\x15\xf8Z;\xa845\n\xb4\x83\xceRGF\x91\n1}M\"\x19d\x82\xe5\x95\xc4;\xa0l\x9f\x01\x82\xa2\xae\xe1SK\xc6GK%\x12\xb89\xb2U\x9d%\xe7n\xa0$\xc9\xc1\xff\x06\x1b-\xc9\xa5\xd3V&\x12\xb8gO\xe0k\xf4\xa0=\xb8\x9a\xfbF\x016\x8e\xa1E/k8j_\x07\x8a\x80U\xe9\x17\xf8\xc6\xd3\x8b\x87\x92*\xb6\x04\x8bt\xf1\xed\x90\x00M\xa5\x10\xc9\x98\x0b\x96|\xa0\xa1\x0e\x8f\xd19\x07j\xe8\x80\x9em*\x84\xe4\xb6e\xf3\x0b\x96\xd4\xb8\\\xc0\xbcF\x99\xe6\xa5\xe5\x86&{!Jt\xe4r\x91B\x96-m\x89r\xf8\xd5\xa2\xc1=Y\x91\xc0o\xbf\x16?\xbc\x7fx\xf8\xf9w\xd82\x90\xc1\xb2!8RY3?\xbf\x83\xde\x04\xb5P\x9fP\xc0@\xebHz\xcd\xc6\x0d\xf9M\xcec\x96\xda\xc8\xa6W\xda\xec\xa31\x1b\x02m@Z\xb5|\xee\x9d\xe7V\x7f\xc6\xe0\x9d\x9d\xb0m\x06\x90Q*\x80\xac\x8b\xcd\xf6nu\xbf\xfa\xa9\xd8\xcca$Y\x9f\x8e\xbc\xaf\x89\xaei\x16\xb3\x08\x8b\x0c\x16#\xdc\x02$\xb7\x1d\x1b2\xde\x01Z\x02K\x7f\xf4\xda\x92\xca\x06\x84 0\xab\xc7\xe3\xe6\xe1\xae\xd8\xbe/><\xcd):\xcb-\xf9\x9az\x07-\x1b\xed\xf9k,S\x98E&\x92(4\xc5\x10\xa2\x0bCB\xee\xc9[\xf4\xb4\xd7\xf2\x8e\xec\x9eB;\x13\xb8\xad\xe0\xc4=\x1c\xd1\xf8\xf0\xc3\xc2\x08\x17\xc6\x9a^:v\x14\x8b\xbbl\xc9[-]\xf4!\xa3:\xd6\xc6\xc3q\xc9\x80\xe6\x04\xd8\xfb\xda,?\xcf!\x83S\xc5M\xc3\xc7\xd0\x9fF\x1b\x024*\xba\x9fMF\xa5]\xf0\xdeu\x96_N\xbb\x08\x1a\x1b\x96E\xd3\x07\xd3\x9cbc\xb9\xfa{\xf3K\xf0\xb3\xd5\x94\xfenD\x9f[\x8f'\xa8\xa4\xb1\xe2*V\xed\x1f\xf9\x8a\xffx\x8c\xcf\x90\xa3\xdek\xb27\x9ek\x91\xc0\x87\x8b\xf1\xd5\xd6\xe4\xf6E\xda8C\x83~\xc8l\xbd\x02m>\x0d^\xc1)\xac\xa1j\xb5saa\xcc\xc7\x05\xc9k!C\xa4\\\x885\xb5+|\xf5\x8e@\xe2\x14R\xa4\xe7\x18\x12/\x8b\xaf\xcas=\x95\x92M\xa5\xf7\xe16\xaf\xd8\x82'\x94uX>'BP\xf31()\x86\x03Zp}\xe9\xbc\xf6}\x14;\xa0u\xf9\xdb\x17|\x9c\x89pY\x84\x1b9\x87\xb0y\xfb\xe3\xedz\xb5-v\xf7\xab\xbb\xe2\xe9q\xb5. \x99\xbdi\xe3\xcd\x1e\x04u\xa5%z\x82\xf5F\x00p\xf9\xc9R\x15N9\xc0\xb36*\x87\xf5d\x12W\xf7\x96\xfb.\xbfB\xcd4\xc7\xad\x03\xd9\xd0\x95\x1c\x0e\xdfa\xd3\xd5\xf8}\\\x1d\x90\x1c\xd9\x836\xfb4\xf8A2\xbc\x8ba\xe7|\xaa\x86'i>\xcb\x93\xee\xd0	\x18\xde\x9e\x0b^\xfc\xea\xd0\xd79\xb4\xe4Q\xa1\xc7lz\xa9\xbeT\x8a\xffw\x8eg\xea\xa7b\xf3\xf1v\xfd\x95\xe6E9I\x7f\x99\xd1\xd3e\xef\ny\x06;\x8e}:E\xf97\xe5\x9d\x83\xbe\x11\xc8\x14z\xbd)\xb6o\x19Y\x0cg\xb7\xb7\xf1\xb6\x89\x7fM\xd2\xe9\xe0\x0e\x9bC\x1f\xfe\x0c\x00\x00\xff\xffPK\x07\x08\xf6\x96\xe2o`\x03\x00\x00\x04\n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x1c\xc7\xc9	\x031\x0c\x05\xd0\xbb\xab\xf8\x0d\x04\xdfuN\x05\xe9@8?\x0b\xf1\"$\xcd@\xba\x1f\xf0\xf1\x9d\xea\x0f\xbe\xe8\x9c\x8dR\x80\x1b~\xdf\xf9\x14\xdci}\xfd\x07g\x16\x00x\xfb:L\xa0f\xb1m\x9a\x1fA\x18[M\x0e\xeb\x9a\xac[\xe7\xea\xc7`\xd4`sf\xb9\x02\x00\x00\xff\xffPK\x07\x08\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xbdn\xeb0\x0c\x85w=\x05\x87;\xdc\x0e\x86\xfb\x93\xa1\xd5V\xa4\x19\x93\x06m\xd0\xb5`e6\x11\"\x89\x82D\xbb\xf0\xdb\x17Jj\xc3\x86\xb9H\xfaxxx \x8c\xf6\x83R\xb6\x1c4`\x8c\xb9\xee\xee\xd4\xd9\x86F\xc3\x0bE\xc7\xbd\xa7 \xca\x93`\x83\x82Z\x01\x04\xf4\xa4\xc1c\xc0#\xa5\xbfw\x8ehHC\xee\xb3\x90W9\x92)J!\x1f\x1d\n\x95;\xc0@K\x19\x0e\x826P\xca\x03\xa9\x16\xbe\xd7\x8a\x9cd\x14\x15\xd98\xba\xe7$\x1a\x9eV\xab\x87\xb1;\x84\xfb\xa1\xaf\x13\xf3\xb9\xca\x94\xba\x89\x17@L,l\xd8i8\xac\xf7#\xef\xd8\xb5\x9e\xb6\xdc\x86\xf9*_\xc8\x1e\xe5\xa4\xa1\x16\x1f\xeb\xf3c\xae\xe6\xd6u9l8V\x86\x92\xe4E\x8eB'0\x116\xaf\xc1\xf5\x1a$\xb5\xa4\xa6\xcb\x17\xff0\x1b\xcdd\x12\x89\x9eX5\xf4\x8d\xad\x93-7\xa4au\x7f;i]\xc5\xbb\x8b\xcb\xbf\xff\xef\x9b\xf5\xdb\xe6\xf0\xb9{\xdennf\xe1\xad\xa1Kj\xf5\x1b\x00\x00\xff\xffPK\x07\x08\x861cw\x06\x01\x00\x00\x01\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\xd01O\xf30\x10\x06\xe0\xdd\xbf\xe2$\x7f\xc3\xd7\xc1\xa9\xba\xa1lU\x14\xa4\x0eE\x08\xaa2\xa2K|M\x8e$ve_Z\xc1\xafGn\x0b\x05\xd4\x0eH\x8c\x96\xef\xb5\xdf{4\xacZ\x8e\xb0E\xa9[@k\x01\x9d\xf3\x82\xc2\xde\x81x@;p\x8c\xe9\xb0\xa7\xaa\xf5\xbe\x83\xda\xbb\x0d7\x80\xce*\x0d\xd2\x12\xec00V=E\xf8\xf7\xbf(\x1fV\x8b\xdbE1_\x95\xcfw\xf3e\xf9x?/\xcaI\x1a\xbep9\x81=\xf7=T\x04q\xac\xa2\xb0\x8cB\x16\xaaW\xe8\xc6(~\xe07\xca\x14nyM!\x15\xc8\xcf]\x025\x1c%\x1cJf\xddM\xcc\xd8Ow\xb3\x8a\x04g\xaacgsX\x8ei\x05\xd7<\x1dK\x17\x87\xce\xe31\xa1\x06\x12\xb4(\x98+\x00\x87\x03\xe50\x9c\xc6\xcdiIS\x7f\x0b\xc0\x17\x94\x98R\x005\x051\x03:l(\xa4\xef\xd9\xbdP-\xa6F\xb3	~\xc8\xafRL/0(\x0d\xa5K\x82\xb0\xf1\x01\xd6\xd8\xb3=\xb4\x81S\xfb\x98)m\x8cQ\xfa\xd7\x18\xfa\xa8q~\xf2\xa2\x87>\x83\xe8\x0f\x91\xddg\xe4\x8a\x89\xfe\x81\xa2\xff\\\xe5=\x00\x00\xff\xffPK\x07\x08:Z\xb9\xdb$\x01\x00\x00\x9a\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xccKLO-\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\x94SMo\xdb0\x0c\xbd\xfbW\x10E\x0f\xdd\xc1u\xba\xf5\xb0	\xe8\xa1k\xbdbX\xda\x19I\xd6k\xc0\xc8l,D_\xa5d\x0f\xd9\xaf\x1f\xec|L\xee\xd0a\xd5\xc9&\x1f\xdf{$%\xf4\xea\x918(g\x05\xa0\xf7\xa1\xe8.\xb2\x8d\xb2\xb5\x80[\xf2\xdam\x0d\xd9\x98\x19\x8aXcD\x91\x01X4$\xc0\xa0\xc55\xf1\xfe?x\x94$ lC$\x93\x01h\\\x91\x0e=\x1a@:\x1b\xd9\xe9\xdck\xb4Ia\xf0${@ M2:\xde\x81\x0dF\xd9L\x93\xeaW\xeb\x01\x98\xbcV\x12\x83\x80\x8b\x0c \x92\xf1\x1a#\xedy\x12\xc3\xfd\xd1#\xca\x7f\x90\x02\x1c\x8c\x0d\xdf\xc4\x9d\x92t-\xa5km|\x18:\x0f\x98\x18Ce\x89\x8f\xbc9(\x83k\x12\xf0\xdc\xe2\xf6\\\xb9b\xd3\xaeH\"\xb3\".HS\x87}\xa3\xbd\xcb\x10\x8fV^\xcesw\x90\xd7\x89\xdd\x1cN\xf2\\\x12\xc7\xbcV|uzvS\xce\x16\xcb\xdb\xaf\xb3w'#Hwuz6\xfd~\xb7\x9c\x96\x8f\xe54\xc9\x91\xedR\xae\x9d\xe2\xb7\x1f\x9f\xcb\xd9C\xb9(\xe7\xcb\x87\xeb\xfbr^]\xdf\x94G\x10@\x87\xba\xa5/\xec\x8cH\x82\x00O\x8at=\xa3\xa7qt\x1f\xaf06\xe28\xfb\xf3\xe3\xcd\xf8K\xfb\xe0\xff\xa5\x9e\x80\x93\"\x1a_l>\x86\xfc'\xad\x1a\xe76y\xbf\x03\xe2bX\x85]\x0fS\x08\x7fZc\n\xaeeI!5\xa4\x95Q1\x8c-J\xdf\n\xb8\x98L\xcc(j\xc88\xde\n\xf80\xb9WI\x82\xe9\xb9\xa5\xf06\x8a\xf7)\x85w<\xae>t\xce\x84\xb5\xb2\x14B\xdeC\xc6\xf4\x87\xfbT9\x8e\x02>]^NFy\xcf.:\xe9\xb4\x80\xc5M\x95\x0c`OX\xb1[Q*\xd9\xc4\xe8\xef(\x8e{\xf0\xc3\x8a\x8a\xbej\xfbk\x9c\x19T_\xf1\xa7UGo\x16i\x08ul\xfe[%\x12\x1be1*g\xef\x18%U\xc4\xca\xd5s\x92\xce\xd6\xfd#\x9fd\xbf\x03\x00\x00\xff\xffPK\x07\x08\xf8v=\xba\xee\x01\x00\x00\xac\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8\xcd\xcf\xcb,\xc9/\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xc1J\x841\x0c\x84\xef}\x8a\x01/z\xe8.^\xfb\x0e\x0b\x82\xe0=\xf6\x0f\xfb\x17\xdb\xa4$q\xc1\xb7\x97\xdf\xadx\xf3\x96L2\xdfLz\xc0\x8b\xe9\xe0\xd8\xf9\xd3qQi\xa1\x86W\xb6[\xab\x8c\xc7\x0b\x87\xb5\xeaO\x89f{c\xf3\xa6R0\xee_M\xae\xa7\xaa\xc6\xea\xa7\xaa\xe3|{N\x1fM\xb6\xf2k^\xac48h\xa3\xa0\x92\x80N\xef\xdc\xfd\x98\x80\xaa\x12\xa6=\xcfN\xc2\x05\x83\x84\xael	\x10\x1a\x7f{\x1e\xf7\x06y\x85\xae\xbbO\xaa\\\xe0_\x1e<\x92O\xae\x07\x94e\x9b\xda$VB\xc6\xa4\xd8\x0b\xce\x8b\xf1#\x02S-\n\xf6\x88y(\xce\x9dk\xa8\xfd[\xea;\x00\x00\xff\xffPK\x07\x08P\xff\xd7\xb2\xb3\x00\x00\x00&\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8\xb4\xcf\xb1n\x83@\x0c\xc6\xf1\xddO\xe1\x178\xaan\xd5mm\x87\xeeT\xean\x0e\x978\x80\x8d|w\x0cy\xfa(K\x14	\x94\x05e\xb6\xfc\xff\xf4\xa3E\xfe\xd8\xb3\x98F\\\xdfa\x14\xed#\xfe\xb2\xaf\x92\xf83%\xabZ`\xe6B=\x15\x8a\x80\xa84s\xc4L\x10B\x80\xc7g\xef(5T\xcb\xc9\\.T\xc4\xb4\x19?r#\xf6v\xcf~O5\x17\xf6\xd6&\xfe\x12\xedE\x87\x9d\xf4LJ\x03;\xb8M\xdc\xf2\xff\xed@\x8b\xfc\xb8\xd5\xe5\xc9\x08 n66\xc9\\\xbb3\xa7\x92#\x04\xdc\x85\x1e\xe3\xbd\xd4u\x18t\x0d\x00\x00\xff\xffPK\x07\x08\xa8\xc1#~\xa0\x00\x00\x00\xe9\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8,\x8c\xb1j\xed0\x10D{}\xc5\xc0m\x1f\xf7\x91.\xa8\xcd\x1f\x84\x90&\xa4X\xdb\xc3\xb5\xb0\xb4kV\xab\x18\xe7\xeb\x83C\xbaa\x0e\xe7\xdc\xf0\xa0\xd2%\xb8`:a\xfb\xb5\xcda\n\x1f\x9an\x988\xcb\xe8\xc4A\x1c\xe6\x1b\x8e\x12+\xc4\xa7\x12.~\"\xce\x9d\xfd\xdfEM\xeb\x89M\xed@\xac\x84\x92\x0b\x17\xec\xf4Vz/\xa6\xfd/\x19\xa51\xc9^\xde\xe9\xd7\x9d\xe1\x93\xccw\x19\xb1\x9a\x97o\x89bz\xdf\x9e\xfb\xbd\xd8\xff\xaf\xa7\xb4\x15]2^\xea\xe8A\x7f\xb5\xca\xd4\x18\xb2HHN\xc0\xec\xfc\x15\xdeJc\x0fi{\x86\x8eZ\x13\xa0\xd2\x98\xd1D\xe5AO>*{\xc6\xc7g\xfa	\x00\x00\xff\xffPK\x07\x08(\x1a\x93S\xb5\x00\x00\x00\xef\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U(\xca\xcfI\xd5\xabL\xcc\xcd\xe1\xd2UH\xce)-.I-\x8aG\x16K\xca\xccK\xc9\xccK/\x86\xf1sR\x13SR\x8b\xe2SsR\x93K2\xf3\xf3P\xd4b\x93\x8b\x87\x1a\x00Q\x03\x08\x00\x00\xff\xffPK\x07\x08\x02\x01\xf9\x87K\x00\x00\x00{\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\x03A\x0cD\xfb\xfd\n+\xd4w\x11\x1d\xba\x1f\xa0\xa7\xa0wv\x87d\x95\xbd\xf5\xca\xf6\x1e\x12_\x8f\xeer\xa1\x00!A\xe5\x91\xedy3\x0f\xd4\xa0s6\xcbR\x8d\\(	\x15p\x82\x12\n\xa2g\xa9c\xe0\x96_\xa1\xeb\xcbDz\xe28r\xf7\x8bh\xfe\xe0\xed~}\xb21\xcbqy\x0c\xd7\\\xd3D/R\x10f8'v\x9e\x02Q\xe5\x19\xd3\x8e\x1d\xee\xd8A\xd77\xed\x056\x85\x81\xb8\xe5g\x95\xdel5\x0ct8\x04\"\x85I\xd7\x88}\x17\xa5\xbe\xe5\xf3\xcc\xcd\x02\xd1\x02=\xed\xfb3|\x9b%\xdbM\xbc\xb3\xc7\xcb\xcd\xa2`\xc7&{Kw\xd9\xbe\xee	\x05\x8e\xff\xc6\x1f\xcd\xd9\xfb/-~\xe4\xfc	\x8e\x05\xd5\xbf\x11\xf7\xf2\x9f\x01\x00\x00\xff\xffPK\x07\x08\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8d\xb1\xae\xc20\x0cEw\x7f\x85\x7f\xa0}z\xdbS\xb6\xc7\xc2^$v71`\x9a\xda\x95\x93t\xe0\xebQ\xa5\n\xb6nw8\xe7\x1eZ\xe4\xca^\xc44\xa0\x8f\x14{j\xf5a./\xaab\xdaO\x7f\xa5\x17\xfbY\x7fa\x12M\x01\x07\xcb|\x12M\xa2w\x98\xb9R\xa2J\x01\x10\x95f\x0e\x98\x99\x12{\xc7\x99\xe3fwn\x99\xc7\x9d\xde\xf6\xc0\xb7\x0d\xa6E\xcenm9(\x02\xe27x\xf8\x0f\xa5\x8dO\x8e\xb5\x04\xe8v\xe7\xc2\xbeJ\xe4\xff\x18\xadi\xfd\xd8\x85\xe0\x1d\x00\x00\xff\xffPK\x07\x08+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xac\x8d1N\x051\x0cD{\x9f\xc2\x17H\xbe\xe8P.@\x8f\x10\xbd7k\xed\xb76\x89W\xb6\xb3H\x9c\x1e\xf1YQQR\xcdH\xf3F\x0fRJ@\x87\xbc\xb3\xb9\xe8(h\x0b\xd5L3\xeej\xf2I!:\xf2\xfe\xecY\xf4v>\xc1.c-\xf8\xaa\x8d\xa1s\xd0JA\x05\x10\xab\xf1\x83|\x93\xce\x1e\xd4\x8f\x82c\xb6\x06\x88\x83:\x17\xec4hc\x03\x9b\x8d\xbd@B:\xe4\xc5t\x1e\xfe\xfdNX)\xa8\xe9\x96\xf7\xb9p%3a\xcb\xa2\x80h\xec:\xad\xf2\xc5\xadlr\xf2Z\xa7\x87\xf6\xdf\x0d\x10O\xb6\xe5b6\x8eG6\xf1\x9f\xf2AQ\xef\xff\xed\xbcyP\xcc?\xd4_\x01\x00\x00\xff\xffPK\x07\x08\x96\x0d\x0e \xb2\x00\x00\x00P\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xcc\xcbLK-.)\xd6\xabL\xcc\xcd\xe1\xd2U(N-*\xcbLN\x85p\xb9\x92\xf3\xf3\xd22\xd3K\x8b\x12K2\xf3\xf3\xc0\x1a\xb2K\x8bK\xf2s3\xabR!R\x10u\x80\x00\x00\x00\xff\xffPK\x07\x08\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92\xb1n\xa4@\x10D\xf3\xf9\x8a\x926>\xb8\xbd\xe8Dz\xd1\x05N\xbc\x92\x1d\xf7B\x03-`\x1a\xf7\xf4\x80\xec\xaf\xb7\x00YZG\xdeh\xd3V\xe9\xd5\x9b\xd2\x9c\xe0=\xa3\xd5q\xd4Ub\x87Zc+\x1d$\xa1U\x833\xd5\xfdv\x1err\x9d\xe4\x83\xb1\xf6l\x0cW\x8c\xaa\x03\xc8\xb7CD\xca\xd7\xe4\xe2\xd9\xb7\xf0B\x96\x8ap\xc2\x7f\x87\xf1[\x16\xe3t\x03X\xfe\x14\xe7\xe27\xd4\x10ye\xdbP\xab\xda\x80\xd9tf\x1b\xdf\x8b\x10i\xe2gn\xd98\xd6\\\x85_\x18$6\x15.l\x8b\xd4\x1c\x80\x85-\x89\xc6\n\xcb9\x00\xad\xf0\xd8\\f\xaeS\x15\x80\xaf\xf4Sv\xdal^\xf9\xda\xab\x0e\xff\xf6we#\x17\x8d\x01\x00:\xd3<W\xa0f\x92\xb4\xd1\x8c;I~\x04\x8a\xe1o*D\xf7\xdcL\xdeWX\x0fL*\xebQ8\xfa\x81+\xd3\xa1Tn\xc67\xdd/4J\xf3\xe0\xf6}\xb54\xd3\xedb?lp\x9f\xc3\xbd\x06{w\x00jcr\xae\xe0\x969\xdc\xbd\xc8\x03\\\xc2B\xf6\xed[\x1d\xb4\x89\x9d\x1ar*)F\xf5\xbd0\x85\xcf\x00\x00\x00\xff\xffPK\x07\x08w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x01\x00\x00\xff\xffPK\x07\x08\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8bA\n\xc2@\x0cE\xf7s\x8a\\\xa0\x0b\xb1\x1bs\nAp\x1f\xa7\x9f:\xb43\x19\x92P\xf1\xf6\xd2\xd6\xdd\x7f\x8f\xff\x92\xf4\xf2\x84y\xd1\xc6\xb4]\xd2R\xda\xc4\xf4\x80m%#U\x84L\x12\xc2\x89\xa8I\x05\xd3\x07\xaf\xb7\xea2\xf8\xffqz\xef\x92\xc1\xe4_\x0f\xd4\xe4\x1dy/\xbaZ\xf8>\x88\x86\x03\x98\xc6\xf1z0Q\x88\xcd\x88\xfbao\xa7v\xac\xc8\xa1v&Y[\x98\xaeC_\xa5\x81\xa9J\x93\x19\x96~\x01\x00\x00\xff\xffPK\x07\x08\xf4	\xd6f\x82\x00\x00\x00\xb1\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80%\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbb\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6\x96\xe2o`\x03\x00\x00\x04\n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd8\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x89\x07\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x861cw\x06\x01\x00\x00\x01\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x803\x08\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(:Z\xb9\xdb$\x01\x00\x00\x9a\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x92	\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x12\x0b\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf8v=\xba\xee\x01\x00\x00\xac\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x83\x0b\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbc\x0d\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(P\xff\xd7\xb2\xb3\x00\x00\x00&\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x800\x0e\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa8\xc1#~\xa0\x00\x00\x00\xe9\x01\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x801\x0f\x00\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!((\x1a\x93S\xb5\x00\x00\x00\xef\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1a\x10\x00\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x02\x01\xf9\x87K\x00\x00\x00{\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1c\x11\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb5\x11\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd0\x12\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x96\x0d\x0e \xb2\x00\x00\x00P\x01\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbe\x13\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb5\x14\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80P\x15\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc2\x16\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4	\xd6f\x82\x00\x00\x00\xb1\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x14\x17\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x15\x00\x15\x00\xad\x06\x00\x00\xe1\x17\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x92Mk\xdcL\x10\x84\xef\xfa\x15\x05\xfb\xc2\x1bC$\xc59\xeaf\xc4\x12|\xf0\x12\xd6\xc69\x86\xde\x99\x964h4#\xa6[\xfeH\xc8\x7f\x0f#\x19\x8cMX\xf0Q\xea\xea\x8fzjv\xb8\x1b\x18]\xf4>>\xba\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3ML\x0c\x1b\xcd2qP\x18\n81\xba\xb8d\xb9bP\x9d\xa5\xa9k\x1b\x8dT\xb9\xb9\x9c(P\xcf\xa9r\xb1\xd8\xe1\xc7\xd5\xf1p}\xf8\xd6\xe0\x8eR\xcf*h9\xe9\xcd\xa6\xc0\x97\xea\xf2\x12f`3\x9e\x1dSs\xa8=)\x8b\xd6J2J\xbd\xcc}\"\xebB_\xbb`\xf9\xa9\x1at\xf2\xe8b\xc2)1\x8d\xd9\xb5\x19(\xf4,\x05\xcd\xee\x9e\x93\xb8\x18\x1a\xbc\x9f\xfapI~\x1e\xe8k1\xba`\x1b\\\xaf \x8a\x89\x95,)5\x05\x10h\xe2f\xa5\xb5\xc1*7X/\x15\x99\xc9\xe4\xf2\xb3(O\x85\xcclrOV\xdf\xae\xea\x06\xbf\xff\x14eY~\xe4\x88\xf6\x95\xfe?/I\x0f.\xf4ev\x02\xec\xa0\x83\x93\xb5\x04\x19\xe2\xe2-&R3@\x07F\x0c\x0c\x9ag\xa6\x943\x0e\x18\x17\xd18\xb9_lb\xe8\\_=\xd3\xe4\xcf\xfa\xd8\xe1\xbfO\xb7\xfb\xe3\xfdu\xbb\xffy\xb8\xba\xd9_\xac\xef\xe3\xed\xbf\xdb\xefW\xed\xfe\x02\x8f\xce{\x9c\x18\xb2\x9cD\x9d.\xca\x16\xa7\xe7\xd7\x95\x05`\x83\x1chb\xc9^\xca\xf7\x93\xab\xb7\xdf\xdb\xd4J\x1e\xcc\x87\xc4\x95\xf1\x8b(\xa7\xcaGC\xd9\xdb\x16\xd6\x91\xbb\xbc\x14x\x932p.]a\x93X\xf3\xc1\xcdzk{\xdc\xdf\xad\xdb.\xcaG>\x0d1\x8e\xe5\x1a\x85\xe1-\x8a\x97$\xb6\xb6\x0dG\x88\x9a\x91\xcc\x89;\xf7\xc4\xf63\xc4\x05\xc3p\xfa\xbf\xac\xb5\xed	\xbc\xe3\xf4w\x00PK\x07\x08\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00F\x00\xb9\xffresources:\n- certificate.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x93\x0dV/P\xf6\xbc\x9e)\xd6\xee\x1e\xaa\xaaW\xf0\xf42\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06\x8f\xab:roE\x97a\x12\xda\x1b\xd4Q\xba!(y\xd5\xb6\xe02<z\xd5Wb\xed/\x88\x8e\xb1\xcd\x12D\x93J\x18\x0b\xa4\xcd\xb8\x8a\xc1\xc7\x93\x87\xc6\xd8\x95\xb4_\xcf,4\xb6\xcc)\x1dp\xd16O\xb8w\x1f\xb4\x04,\xd6\xc76!\xd3\xe2P\xa5\xc9B\xbb\xd5\x9e\x80\xa2|\x9e\x1f6f\x9f\x12\xf0\xb1\xbb\xa3\x85\x16\xcd\x12L\xc0/s`\x93X'\xf8\xc6|\xd4\xf7og\x96\xe3\x9e\x93\xd2U\xec\x9b\xa6\xaf\xf6\x8f\xf2'7\xf7Z{;\xed\xe6\xdf\x91\xb9\xf9.\xf8?\x08g6\xc6I*\xd3\xdb\x00PK\x07\x08\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xcc\x96Ko\xe36\x10\x80\xef\xfc\x15\x83\xe8\xe0\x16X\xcbh\x8f\xbe\xb9\x86\xdb\x0d\xda<\xe0d\xb7\x87\xa2\x08F\xd4\xc8\xe2\x86\xe2\xa8$e\xc7\xfb\xeb\x8b\xa1dK\x0e\xba\xdd\xa2\x08\xd0\xde,\xce\xeb\x9b\x07\x87\xce`U\x96\x01\x1c6\x14Z\xd4\x04\x91\x01\xad\x05O\x81;\xaf)\xe4\xea,[\xc2sW\x90F\xef\x0d\xf9y8\x86H\x8dR\x19|D\xdb\x11p\x05\xb16\x01*C\xb6\x04\x13\xa0\xf5\xd4\x92+\xa9\x14\x9f\xb1&\x95\xf5aD\xf3\"\xc4;\xa0|\x97\x03BI\xad\xe5cC.&\xcdRepu`_\xb6\x9eB\xb8\x82\x824\x8b\xfd\x15Z\xa3)\xccGQ\xae2\xb8\xe5H\x10k\x8c`\"\x84\x9a;[\x02\xda\xc0\xd0`\xd45\x1cL\xac\x85B\xb0*\xf3\x02\xdfDz\x89PP\xc5\x9e`6\x9f}+X\"?\xa7\xab\xb2!\x17,xO}\x1d\xee\x93\xf1\x12\xc8\xd2\x1e#\xfb\xb9R\x9a\x9b\x86\xdd/X\x90\x0dK\x05\xd3\x1a\xe5\x86\x17\x9e-\x8d\xfaJ\x15\x18(,\xd5\x1c\xf2|\xe1\x0b\xd4\xfd\xaf\x06\x1d\xee\xc8\xab\x0c~\xfbu\xf3\xc3\xfb\xbb\xbb\x9f\x7f\x87G\x06rXX\x82\x03\x155\xf3\xf3;\xe8\x9cD\x93\xfaH\x01\x856\x90\x8e\x86]\xe8\xf3\x1b\x8d\x87,\x8d\xd3\xb6+\x8d\xdb%ev\x04\xc6\x81\xf6\xe5\xe2\xb9\x0b\x91\x1b\xf3\x19\xc5:?bc{\x90!\x94\x80\xac7\xdb\xc7\x9b\xd5\xed\xea\xa7\xcdv\n\xa3\xc9\xc7\xf9\xc0\xfb\x9a\xe8\x92f6\xf10\xcba6\xc0\xcd@s\xd3\xb2#\x17\x03\xa0'\xf0\xf4Gg<\x95y\x8f \x01&\xf5\xb8\xdf\xde\xddl\x1e\xdfo><L)Z\xcf\x0d\xc5\x9a\xba\x00\x0d;\x13\xf9k,\xa3\x9bY\xae\xb2\x14h\xf4\xa1T+CB\xe1!z\x8c\xb43\xfa\x86\xfc\x8e\xa4\x9d\x19\\Wp\xe4\x0e\x0e\xe8\xa2\xfc\xf00\xc0\xc9X\xd3K\xcbA\xc6\x8e`\xd1P\xf4F\x87dC\xael\xd9\xb8\x08\x87\x05\x03\xba#`\x17k\xb7\xf8<\x85\x94\x8eTl-\x1f\xa4?\xd68\x02te2?\xe51Dz\x12\xeb\xa7\xd6\xf3\xcb\xf1)\x81\xa6\x86\xe5I\xf5\xce\xd9cj,W\x7f\xaf~v~\xd2\x1a\xd3\x7f\x1a\xd0'\xceO7\xa8\xa0a\x08\xcbT\xb5\x7fd\xab\xfe\xe31>A\x0e\xf1^\x93\xbd\xf1\\\xab\x0c>\x9c\x95/D\xa3\xd9\x17/]\x9a\xa1~\xa2e\x1c\xd6+0\xeeS_\x0b1\x923,\x1b\x13\x82\x1c\x0c\xf9\x04	y\x19\xc8\x11\x95A|\x8d\xed\x92\xaf.\x10h\x1c]\xaa\xf9\xc9\x87\xc6\xf3\xe1\xab\xf2\\N\xa5fW\x99\x9dl\xf3\x8a=DB]K\x17N\xdb\x83\xa0\xe6\x83D*\x19\xf6\xe8!tE\x88&v)\xd8\x1e}X\xbe\xfd\"\x19V\x9b,\x0bY\xd5K\x90J\\\xffx\xbd^=n\x9enW7\x9b\x87\xfb\xd5z\x03\xc3c\x93\xde\xb4a\xb3K@S\x19\x8d\x91`\xbdU\x00\\|\xf2T\xc9-\x07x6\xae\\\xc2zTI\xa7;\xcf]\xbb\xbc@\xcd\x0d'\xd1\x9e\xbcte	\xfb\xef\xd0\xb65~\x9fN{\xa4@~o\xdcn.v\x90\xf5\xef\xa2HN\xb7\xaa\x7f\x92\xa6+y\x8c\xdbw\x02\xfa\xb7\xe7\x8c\x97\xbeZ\x8c\xf5\x12\x1a\x8aXb\xc4||\xa9\xbeT\x8a\xffw\x8e'\xea\x87\xcd\xf6\xe3\xf5\xfa+\xcdK%\xd5\xf4\x97\x19=\x9ce\x17\xc8\x93\x86\x0cc?\x1f\xbd\xfc\x9b\xf2NA\xdf\x08dt\xbd\xden\x1e\xdf\xd2\xb3\xea\xefn\xe7\xd3\xb6I\x7fM\xe6\xe3\xc5\xed\x85\xf9\x11\x1b\xab\xfe\x1c\x00PK\x07\x08\xf6\x96\xe2of\x03\x00\x00\x04\n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x00_\x00\xa0\xffvarReference:\n  - kind: Deployment\n    group: apps\n    path: spec/template/spec/volumes/secret\n\x03\x00PK\x07\x08\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xbfn\xf30\x0c\xc4w?\x05\x87o\xf8:\x18\xee\x9f\x0c\xad\xb6\"\xcd\x984h\x83\xae\x05+_\x13#\x96(Ht\x8a\xbc}\xa1:6l\x84\x93\xf4\xe3\xf1\x8e\x12\x87\xe6\x0315\xe2\x0dq\x08\xa9:\xdd\x15\xc7\xc6\xd7\x86^\x10Z9;x-\x1c\x94kV6\x05\x91g\x07C\x8e=\xef\x11/\xf7\x14\xd8\xc2P:'\x85+R\x80\xcdJ\x85\x0b-+\xf2\x99h\xa0\xb9\xacx\xe5\xc6#\xa6\x81\x94W\xbe}\x05\x89:\x8a\xb2l\x1c\xddJTCO\x8b\xc5\xc3\xd8\x1d\x96\xfb\xc1\xd7A\xe4X&\xc4\xd3\xc4\x8b(DQ\xb1\xd2\x1a\xda-\xb7#?I\xdb9\xac\xa5\xf3\xf3(\x97\xc9\x96\xf5`\xa8R\x17\xaa\xe3c*\xe7\xd6UNh\xfc\xbe\xb4\x88\x9a&A\xfdc2\x9d\xc0\x08\xae_}{6\xa4\xb1\xc3\xa5\xd1\x87_\xfd\xc3l4\xc1F\xe8 \xc9U\xe3\x9b\xbbV\xd7R\xc3\xd0\xe2\xfev\xd2\xea\xc5\x9b?\x97\x7f\xff\xdfW\xcb\xb7\xd5\xees\xf3\xbc^\xdd\xcc\x96o,J\x8b\xa8\xc5\xef\x00PK\x07\x08\x861cw\x07\x01\x00\x00\x01\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\x90Mo\xe20\x10\x86\xef\xfe\x15#y\x0f\xcb\xc1A\xdcV\xb9\xa1(+q\xa0\xaaZD\x8f\xd5$\x1e\x92i\x12\x1b\xd9\x13P\xfb\xeb+\x03-\xfd\x80C\xa5\x1e\xad\x99\xd7\xf3\xbc\x8f\x86U\xcb\x11\xb6(u\x0bh-\xa0s^P\xd8;\x10\x0fh\x07\x8e1=\xf6T\xb5\xdewP{\xb7\xe1\x06\xd0Y\xa5AZ\x82\x1d\x06\xc6\xaa\xa7\x08\x7f\xfe\x16\xe5\xddj\xf1\x7fQ\xccW\xe5\xe3\xcd|Y\xde\xdf\xce\x8br\x92\x96/\x0c'\xb0\xe7\xbe\x87\x8a \x8eU\x14\x96Q\xc8B\xf5\x0c\xdd\x18\xc5\x0f\xfcB\x99\xc2-\xaf)$\x80\xfc\xcc\x12\xa8\xe1(\xe1\x00\x99u\xffb\xc6~\xba\x9bU$8S\x1d;\x9b\xc3rL\x15\\\xf3p\x84.\x0e\xcc\xe31\xa1\x06\x12\xb4(\x98+\x00\x87\x03\xe50\x9c\xd6\xcd\xa9\xa4\xa9?\x05\xe0\x83\x94\x98R\x005\x051\x03:l(\xa4\xf3\xec\x9e\xa8\x16S\xa3\xd9\x04?\xe4WUL\xbf\x0f&JC\xe9\x92A\xd8\xf8\x00k\xec\xd9\x1e\xe0\xe1D\x1f3\xa5\x8d1J\xffX\x86>\xda8\x7fy\xd1\x87>\x0b\xd1oFv\xef\x91+N\xf4\x17)\xfa\xd7\xad\xbc\x0e\x00PK\x07\x08:Z\xb9\xdb#\x01\x00\x00\x9a\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- manager.yaml\n\x03\x00PK\x07\x08\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\x94ROO\xdbN\x10\xbd\xfbS\x8c\x10\x07~\x07\xe3\xe4W\x0e\xedJ\x1c(\xb8\xa8j\xa0V\xa0\\\xd1d=\xc4\xab\xec?f\xd7\xae\xd2O_\xadq\x8c\xad\x8a\xaa\xcc%\xf1\xbcyo\xde\xcc\x0ez\xf5@\x1c\x94\xb3\x02\xd0\xfbPt\xcbl\xa7l-\xe0\x8a\xbcv{C6f\x86\"\xd6\x18Qd\x00\x16\x0d	0hqK<|\x07\x8f\x92\x04\x84}\x88d2\x00\x8d\x1b\xd2!U\x03Hg#;\x9d{\x8dvB\x0c\x9ed*\x08\xa4IF\xc7\xe9?\x80\xc1(\x9b\xd5\x84\xfd&\x1f\x80\xc9k%1\x08Xf\x00\x91\x8c\xd7\x18i\xd0\x99\x18\x06\x98\x1b\xfa\x8b\xa9\x04\x1d\x8c\xa5\x08\xc4\x9d\x92t!\xa5km\xbc\xed'\x0f8\x80i0T\x96x\x18\x14 \x07epK\x02\x9e[\xdc\x9f*W\xec\xda\x0dIdV\xc4\x05i\xea0\x0d\x9a\\\x868P\xfe\xdc\xe7K oG\xd9$|\x94\xe7\x928\xe6\xb5\xe2\xf3\xe3\x93\xcbr}\xffx\xf5u\xfd\xdf\xd1\xac\xa4;?>Y}\xbf~\\\x95\x0f\xe5j\x82\x91\xed\xa6Z\xe9\xc5\x04|\xfb\xf1\xb9\\\xdf\x96\xf7\xe5\xdd\xe3\xed\xc5MyW]\\\x96c\x11@\x87\xba\xa5/\xec\xcc+3\xc5\x93\"]\xaf\xe9i\x9e\x1d\xf2\x15\xc6F\x8c\xbb?M}\xfa\xcb\x18k\x0f\xbd\x0f\xfe'\"}?\x01GE4\xbe\xd8}\x0c\xf9O\xda4\xce\xed\xf2\xf4\x06\xc4E\xfaQv\xdbo!\xbc\x8e\xc6\x14\\\xcb\x92&\xcb\x02\xd0\xca\xa88\xcb\x00H\xdf\nX.\x16f\x965d\x1c\xef\x05|X\xdc\xa8	\xc0\xf4\xdcRx\x9f\xc4\xffS	\xefx\xce>L\xce\x84\xb5\xb2\x14B\x9eJf^\xc6{\xaa\x1cG\x01\x9f\xce\xce\x163\xdc\xb3\x8bN:-\xe0\xfe\xb2\x1a\x91Q\xb0b\xb7\x19\xae\xff%\x9a\x18\xfd5\xc5i\n\xc0\xf7OT$\xd6\xfe\xd7\x1c\xe9\xbb\xbe\xe1O\xab\x8e\xde\xdd\xa4!\xd4\xb1\xf9\xe7.\x91\xd8(\x8bQ9{\xcd(\xa9\"V\xae\xbe#\xe9l\x1d\x04,\x17\xd9\xef\x01\x00PK\x07\x08\xf8v=\xba\xf8\x01\x00\x00\xac\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- monitor.yaml\n\x03\x00PK\x07\x08*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xcdJD1\x0c\x85\xf7}\x8a\x03nt\xd1\x19\xdc\xf6\x1d\x06\x04\xc1}\xec\x0ds\x8bmR\x928\xe0\xdb\xcbu*\xee\xdc\xe5\xf7\xfbNz\xc0\x8b\xe9\xe0\xd8\xf9\xd3qQi\xa1\x86W\xb6[\xab\x8c\xc7\x0b\x87\xb5\xeaO\x89f{c\xf3\xa6R0\xeeWM\xae\xa7\xaa\xc6\xea\xa7\xaa\xe3|{N\x1fM\xb6\xf2\xfb\xbcXip\xd0FA%\x01\x9d\xde\xb9\xfbQ\x01U%L{\x9e\x9d\x84\x0b\x06	]\xd9\x12 4\xfe\xfa<\xee	\xf2\x92\xae\xbdO\xaa\\\xe0_\x1e<\x92O\xae\x07\x94e\x9b\xda$\x96!cR\xec\x05\xe7\xc5\xf8\xd1\x02S-\n\xf6\x88yL\x9c;\xd7P\xfb7\xd4\xf7\x00PK\x07\x08P\xff\xd7\xb2\xaf\x00\x00\x00&\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8\xb4\xcf\xb1n\x83@\x0c\xc6\xf1\xddO\xe1\x178\xaan\xd5mm\x87\xeeT\xean\x0e\x978\x80\x8d|w\x0cy\xfa(K\x14	\x94\x05e\xb6\xfc\xff\xf4\xa3E\xfe\xd8\xb3\x98F\\\xdfa\x14\xed#\xfe\xb2\xaf\x92\xf83%\xabZ`\xe6B=\x15\x8a\x80\xa84s\xc4L\x10B\x80\xc7g\xef(5T\xcb\xc9\\.T\xc4\xb4\x19?r#\xf6v\xcf~O5\x17\xf6\xd6&\xfe\x12\xedE\x87\x9d\xf4LJ\x03;\xb8M\xdc\xf2\xff\xed@\x8b\xfc\xb8\xd5\xe5\xc9\x08 n66\xc9\\\xbb3\xa7\x92#\x04\xdc\x85\x1e\xe3\xbd\xd4u\x18t\x1d\x00PK\x07\x08\xa8\xc1#~\x9d\x00\x00\x00\xe9\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8,\x8c\xb1j\xed0\x10D{}\xc5\xc0m\x1f\xf7\x91.\xa8\xcd\x1f\x84\x90&\xa4X\xdb\xc3\xb5\xb0\xb4kV\xab\x18\xe7\xeb\x83C\xbaa\x0e\xe7\xdc\xf0\xa0\xd2%\xb8`:a\xfb\xb5\xcda\n\x1f\x9an\x988\xcb\xe8\xc4A\x1c\xe6\x1b\x8e\x12+\xc4\xa7\x12.~\"\xce\x9d\xfd\xdfEM\xeb\x89M\xed@\xac\x84\x92\x0b\x17\xec\xf4Vz/\xa6\xfd/\x19\xa51\xc9^\xde\xe9\xd7\x9d\xe1\x93\xccw\x19\xb1\x9a\x97o\x89bz\xdf\x9e\xfb\xbd\xd8\xff\xaf\xa7\xb4\x15]2^\xea\xe8A\x7f\xb5\xca\xd4\x18\xb2HHN\xc0\xec\xfc\x15\xdeJc\x0fi{\x86\x8eZ\x13\xa0\xd2\x98\xd1D\xe5AO>*{\xc6\xc7g\xfa\x19\x00PK\x07\x08(\x1a\x93S\xb2\x00\x00\x00\xef\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00{\x00\x84\xffresources:\n- role.yaml\n- cluster_role.yaml\n- bindings.yaml\n- leader_election_role.yaml\n- leader_election_role_binding.yaml\n\x03\x00PK\x07\x08\x02\x01\xf9\x87\x82\x00\x00\x00{\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\xc3@\x0c\x86\xf7{\n\xab\xccI\xc5\x86\xf2\x02\xec\x0c\xec\xee\xddO{\xea\xe5|\xb2}A\xe2\xe9QB\xca\x00B\xa2\xdb'\xdb\xff\xf7\xfb\x81\x1at\xcefY\xaa\x91\x0b%\xa1\x02NPBA\xf4,u\x0c\xdc\xf2+t=\x99HO\x1cG\xee~\x11\xcd\x1f\xbc\xed\xafO6f9.\x8f\xe1\x9ak\x9a\xe8E\n\xc2\x0c\xe7\xc4\xceS \xaa<c\xda\xb5\xc3M;\xe8z\xa6\xbd\xc0\xa60\x10\xb7\xfc\xac\xd2\x9b\xad\x81\x81\x0e\x87@\xa40\xe9\x1a\xb1\xcf\xa2\xd4\xb7|\x9e\xb9Y Z\xa0\xa7}~\x86o\x99\x92\xed\x0b\xde\xd9\xe3e\xa3\xa8`\xc7\x86\xbd\xa5\x1b\xb6\xef}B\x81\xe3\xde\xfa\xa39{\xff\xe3\x8b_=\xff\x92cA\xf5\x1f\xc6\xa8`G\xf8\x1c\x00PK\x07\x08\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd\xb1\x8e\xc20\x0c\xc6\xf1\xddO\xe1\x17hO\xb7\x9d\xb2\x1d\x0b{\x91\xd8\xdd\xc4\x80ijWN\xd2\x81\xa7G\x95*\xd8\xbay\xf0\xef\xfb\xd3\"W\xf6\"\xa6\x01}\xa4\xd8S\xab\x0fsyQ\x15\xd3~\xfa+\xbd\xd8\xcf\xfa\x0b\x93h\n8X\xe6\x93h\x12\xbd\xc3\xcc\x95\x12U\n\x80\xa84s\xc0\xcc\x94\xd8;\xce\x1c7\xdd\xb9e\x1e\xf7\xef\xed\x1e\xf8\x16\x00\x91\x169\xbb\xb5\xe5\xa0\x08\x88\xdf\xe0\xe1>\x946>9\xd6\x12\xa0\xdb\xcd\x85}\x95\xc8\xff1Z\xd3\xfa\xd1\x85\xe0=\x00PK\x07\x08+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xc4\x93\xc1j31\x0c\x84\xef~\n\xbd\xc0n\xf8o?\xfb\x02\xff\xfd\xa7\xf4\xaex\x87D\xc4k\xb9\xb2\xbc\x85>}\xd9M(mIII\x1az\x13x\x98\xcf\x83F\xa1\xeb\xba\xc0E\x1eaU4\x0fd[\x8e=7\xdf\xab\xc9\x0b\xbbh\xee\x0f\x7fk/\xba\x99\xff\x84\x83\xe4q\xa0\xff\x9a\x10&8\x8f\xec<\x04\xa2hX\x95\x0f2\xa1:Oe\xa0\xdcR\nD\x99'\x0c4q\xe6\x1d,XK\xa8C\xe8\x88\x8b\xfc3m\xa5\x0e\x81\xa8\xa3\xc8\xceIw\xfd\xa1m\x11\xd9L`\xbdh 2Tm\x16\xf1Q\x87\xec&\xa8\x81h\x86mO\x1e;\xf8\xea\x95\xa4\x1e\x87g\xf6\xb8\xbf\x99ug\xca\x08\x93\x19cl\xd5uz{\xfb\x0d\xe6\xa6:{;\x83\xbe:\xdbSS\xe7;g92\xbe\xfc\xfbbUV\xc22\xb52\xb2\xe3\xfa@\xad.-FT\x1b?\xc5Z\x0f\x00\xe7{x\xa2\xfe@%\xdf\xf3o\x8c|	Ua\xb3D\xc4\xd4\xaa\xc3\xae\\\xa2\x16\x18\xbb\xda\xc5-\"a^\x84\xdf\xe3\xbc\x0e\x00PK\x07\x08\x8eS\x00&\x0b\x01\x00\x00\xb3\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00S\x00\xac\xffresources:\n- manifests.yaml\n- service.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92?o\xa4@\x0c\xc5\xfb\xf9\x14O\xda\xfa\xe0\xf6\xaa\x13\xedUW\xa4\xc9JI\xed\x05\x03\x160&\x1e\x0f(\xf9\xf4\x11\xa0H\x9b*[mk=\xbd??\xf9\x04\xef\x19\xad\x8e\xa3\xae\x12;\xd4\x1a[\xe9 	\xad\x1a\x9c\xa9\xee\xb7\xf3\x90\x93\xeb$\x1f\x8c\xb5gc\xb8bT\x1d@\xbe\x1d\"R\xbe&\x17\xcf\xbe\x89\x17\xb2T\x84\x13\xfe;\x8c\xdf\xb2\x18\xa7\x1b\x83\xe5Oq.~C\x0d\x91W\xb6\xcdjU\x1b0\x9b\xcel\xe3{\x11\"M\xfc\xcc-\x1b\xc7\x9a\xab\xf0\x0b\x83\xc4\xa6\xc2\x85m\x91\x9a\x03\xb0\xb0%\xd1Xa9\x07\xa0\x15\x1e\x9b\xcb\xccu\xaa\x02\xf0\xa5~\xcaN[\x9bW\xbe\xf6\xaa\xc3\xbf}W6r\xd1\x18\x00\xa03\xcds\x05j&I\x9b\x9bq'\xc9\x0fA1\xfcM\x85\xe8\xae\x9b\xc9\xfb\n\xeba\x93\xcaz\x14\x8e~\xd8\x95\xe9\xa8Tn\x8do\xb2_h\x94\xe6\xc1\xe9;\xb54\xd3-\xb1\x1f\x18\xdcG\xe0\xde\xfd{v\x00jcr\xae\xe0\x969\xdcM\xe4\x01]\xc2B\xf6\xed\xad\x8ee\x13;5\xe4TR\x8c\xea\xe4\xa21\x85\xcf\x01\x00PK\x07\x08w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x03\x00PK\x07\x08\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8bA\n\xc2@\x0cE\xf7s\x8a\\\xa0\x0b\xb1\x1bs\nAp\x1f\xa7\x9f:\xb43\x19\x92P\xf1\xf6\xd2\xd6\xdd\x7f\x8f\xff\x92\xf4\xf2\x84y\xd1\xc6\xb4]\xd2R\xda\xc4\xf4\x80m%#U\x84L\x12\xc2\x89\xa8I\x05\xd3\x07\xaf\xb7\xea2\xf8\xffqz\xef\x92\xc1\xe4_\x0f\xd4\xe4\x1dy/\xbaZ\xf8>\x88\x86\x03\x98\xc6\xf1z0Q\x88\xcd\x88\xfbao\xa7v\xac\xc8\xa1v&Y[\x98\xaeC_\xa5\x81\xa9J\x93\x19\x96~\x03\x00PK\x07\x08\xf4	\xd6f\x7f\x00\x00\x00\xb1\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80&\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc8\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6\x96\xe2of\x03\x00\x00\x04\n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe3\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9a\x07\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x861cw\x07\x01\x00\x00\x01\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80S\x08\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(:Z\xb9\xdb#\x01\x00\x00\x9a\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb3	\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x802\x0b\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf8v=\xba\xf8\x01\x00\x00\xac\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa4\x0b\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe7\x0d\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(P\xff\xd7\xb2\xaf\x00\x00\x00&\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\\\x0e\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa8\xc1#~\x9d\x00\x00\x00\xe9\x01\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80Y\x0f\x00\x00rbac/bindings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!((\x1a\x93S\xb2\x00\x00\x00\xef\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80?\x10\x00\x00rbac/cluster_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x02\x01\xf9\x87\x82\x00\x00\x00{\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80>\x11\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x0e\x12\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80'\x13\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x8eS\x00&\x0b\x01\x00\x00\xb3\x04\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x13\x14\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80c\x15\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x0e\x16\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80~\x17\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4	\xd6f\x7f\x00\x00\x00\xb1\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xcd\x17\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x15\x00\x15\x00\xad\x06\x00\x00\x97\x18\x00\x00\x00\x00"
 	fs.Register(data)
 }
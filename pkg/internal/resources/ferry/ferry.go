@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/kustomize/v3/pkg/types"
 	"sigs.k8s.io/yaml"
 
+	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
 	"k8c.io/kubecarrier/pkg/internal/kustomize"
 	"k8c.io/kubecarrier/pkg/internal/resources/constants"
 	"k8c.io/kubecarrier/pkg/internal/version"
@@ -41,11 +42,31 @@ type Config struct {
 	Name string
 
 	// KubeconfigSecretName of the secret holding the service cluster kubeconfig under the "kubeconfig" key
+	// For the Agent connection, the secret also holds the "token", "proxy-token", "tls.crt" and "tls.key" keys.
 	KubeconfigSecretName string
+	// Connection to the service cluster.
+	Connection operatorv1alpha1.FerryConnectionType
 	// LogLevel
 	LogLevel *int
 }
 
+const (
+	// TunnelPort is the port of the tunnel endpoint the agent connects to.
+	TunnelPort = 8443
+	// ProxyPort is the port of the service cluster API Server proxy.
+	ProxyPort = 8444
+)
+
+// TunnelServiceName returns the name of the Service exposing the tunnel endpoint for the agent.
+func TunnelServiceName(name string) string {
+	return name + "-ferry-tunnel"
+}
+
+// ProxyServiceName returns the name of the Service exposing the service cluster API Server proxy.
+func ProxyServiceName(name string) string {
+	return name + "-ferry-proxy"
+}
+
 var k = kustomize.NewDefaultKustomize()
 
 // Manifests generate all required manifests for the Operator
@@ -53,6 +74,19 @@ var k = kustomize.NewDefaultKustomize()
 func Manifests(c Config) ([]unstructured.Unstructured, error) {
 	v := version.Get()
 	kc := k.ForHTTP(vfs)
+	patches := []string{
+		fmt.Sprintf(`{ "op": "add", "path": "/spec/template/spec/containers/0/env/0", "value": {"name": "SERVICE_CLUSTER", "value": "%s"}}`, c.Name),
+		fmt.Sprintf(`{ "op": "add", "path": "/spec/template/spec/volumes/0/secret/secretName", "value": "%s"}`, c.KubeconfigSecretName),
+	}
+	resources := []string{"../default"}
+	if c.Connection == operatorv1alpha1.FerryConnectionAgent {
+		patches = append(patches, agentPatches(c)...)
+		resources = append(resources, "tunnel_service.yaml")
+		if err := kc.WriteFile("/man/tunnel_service.yaml", []byte(tunnelServices(c))); err != nil {
+			return nil, fmt.Errorf("writing tunnel_service.yaml: %w", err)
+		}
+	}
+
 	if err := kc.MkLayer("man", types.Kustomization{
 		Namespace:  c.ProviderNamespace,
 		NamePrefix: fmt.Sprintf("%s-", c.Name),
@@ -75,14 +109,9 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 				Name:      "manager",
 				Namespace: "system",
 			},
-			Patch: strings.TrimSpace(fmt.Sprintf(`
-			 [
-{ "op": "add", "path": "/spec/template/spec/containers/0/env/0", "value": {"name": "SERVICE_CLUSTER", "value": "%s"}},
-{ "op": "add", "path": "/spec/template/spec/volumes/0/secret/secretName", "value": "%s"}
-]
-`, c.Name, c.KubeconfigSecretName)),
+			Patch: "[\n" + strings.Join(patches, ",\n") + "\n]",
 		}},
-		Resources: []string{"../default"},
+		Resources: resources,
 	}); err != nil {
 		return nil, fmt.Errorf("cannot MkLayer: %w", err)
 	}
@@ -143,3 +172,59 @@ func Manifests(c Config) ([]unstructured.Unstructured, error) {
 	}
 	return objects, nil
 }
+
+// agentPatches configures the ferry to serve the tunnel endpoint and the service cluster API Server proxy.
+func agentPatches(c Config) []string {
+	return []string{
+		fmt.Sprintf(`{ "op": "add", "path": "/spec/template/metadata/labels/%s", "value": "%s"}`,
+			strings.ReplaceAll(constants.InstanceLabel, "/", "~1"), c.Name),
+		fmt.Sprintf(`{ "op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "--service-cluster-connection=%s"}`,
+			operatorv1alpha1.FerryConnectionAgent),
+		`{ "op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "--tunnel-token-file=/kubeconfig/token"}`,
+		`{ "op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "--tunnel-proxy-token-file=/kubeconfig/proxy-token"}`,
+		`{ "op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "--tunnel-tls-cert-file=/kubeconfig/tls.crt"}`,
+		`{ "op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "--tunnel-tls-key-file=/kubeconfig/tls.key"}`,
+		fmt.Sprintf(`{ "op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "--tunnel-addr=:%d"}`, TunnelPort),
+		fmt.Sprintf(`{ "op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "--tunnel-proxy-addr=:%d"}`, ProxyPort),
+		fmt.Sprintf(`{ "op": "add", "path": "/spec/template/spec/containers/0/ports", "value": [{"name": "tunnel", "containerPort": %d}, {"name": "proxy", "containerPort": %d}]}`,
+			TunnelPort, ProxyPort),
+		`{ "op": "add", "path": "/spec/template/spec/volumes/0/secret/items/-", "value": {"key": "token", "path": "token"}}`,
+		`{ "op": "add", "path": "/spec/template/spec/volumes/0/secret/items/-", "value": {"key": "proxy-token", "path": "proxy-token"}}`,
+		`{ "op": "add", "path": "/spec/template/spec/volumes/0/secret/items/-", "value": {"key": "tls.crt", "path": "tls.crt"}}`,
+		`{ "op": "add", "path": "/spec/template/spec/volumes/0/secret/items/-", "value": {"key": "tls.key", "path": "tls.key"}}`,
+	}
+}
+
+// tunnelServices exposes the tunnel endpoint and the service cluster API Server proxy.
+// Both endpoints serve TLS, the tunnel Service has to be made reachable for the agent without terminating TLS,
+// e.g. via a LoadBalancer or an Ingress with TLS passthrough.
+func tunnelServices(c Config) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Service
+metadata:
+  name: ferry-tunnel
+  namespace: system
+spec:
+  ports:
+  - name: tunnel
+    port: %d
+    targetPort: tunnel
+  selector:
+    control-plane: manager
+    %s: %s
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: ferry-proxy
+  namespace: system
+spec:
+  ports:
+  - name: proxy
+    port: %d
+    targetPort: proxy
+  selector:
+    control-plane: manager
+    %s: %s
+`, TunnelPort, constants.InstanceLabel, c.Name, ProxyPort, constants.InstanceLabel, c.Name)
+}
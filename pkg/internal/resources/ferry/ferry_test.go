@@ -26,17 +26,28 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"k8c.io/utils/pkg/testutil"
+
+	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
 )
 
 func TestManifests(t *testing.T) {
-	const (
-		goldenFile = "ferry.golden.yaml"
-	)
-	var c = Config{
+	testManifests(t, "ferry.golden.yaml", Config{
 		ProviderNamespace:    "provider-1000",
 		Name:                 "hans",
 		KubeconfigSecretName: "service-cluster-100",
-	}
+	})
+}
+
+func TestAgentManifests(t *testing.T) {
+	testManifests(t, "ferry-agent.golden.yaml", Config{
+		ProviderNamespace:    "provider-1000",
+		Name:                 "hans",
+		KubeconfigSecretName: "hans-agent",
+		Connection:           operatorv1alpha1.FerryConnectionAgent,
+	})
+}
+
+func testManifests(t *testing.T, goldenFile string, c Config) {
 	manifests, err := Manifests(c)
 	require.NoError(t, err, "unexpected error")
 	yManifest, err := yaml.Marshal(manifests)
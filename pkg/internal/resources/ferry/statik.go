@@ -22,6 +22,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x92\xcfj\xdd<\x10\xc5\xf7~\x8a\x03\xf7\x83\xaf\x81\xdan\xba\xf4.\x98K\xc9\"\x97r\x13\xd2e\x99+\x8dmaY2\x9aq\xfe\xb4\xf4\xdd\x8b\xec@HH\x03\xd9\xea\x1c\x9d\xd1\xfc\x8ev\xb8\x19\x18]\xf4>\xde\xbb\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3UL\x0c\x1b\xcd2qP\x18\n8\xe5\x01K\xb6+\x06\xd5Y\x9a\xba\xb6\xd1H\x95/\x97\x13\x05\xea9U.\x16;\xfc\xb88\x1e.\x0f\xdf\x1a\xdcP\xeaY\x05-'\xbd\xda\x1c\xf8R\x9d\x9f\xc3\x0cl\xc6wcj\x0e\xb5'e\xd1ZIF\xa9\x97\xb9Od]\xe8k\x17,?T\x83N\x1e]L8%\xa61om\x06\n=KA\xb3\xbb\xe5$.\x86\x06\xafS\xef\xce\xc9\xcf\x03}-F\x17l\x83\xcb\x15D1\xb1\x92%\xa5\xa6\x00\x02M\xdc\xac\xb46X\xe5\x06\xebI\x91\x99L\x96\x1fEy*df\x93\xefd\xf7\xf5\xean\xf0\xfbOQ\x96\xe5G\x1e\xd1>\xd3\x7f\xf3%\xe9\xce\x85\xbe\xcc!\xc0\x0e:8Y%\xc8\x10\x17o1\x91\x9a\x01:0b`\xd0<3\xa5\xdcq\xc0\xb8\x88\xc6\xc9\xfdb\x13C\xe7\xfa\xea\x91&\xff\xee\x1e;\xfc\xf7\xe9z\x7f\xbc\xbdl\xf7?\x0f\x17W\xfb\xb3\xf5\x7f\xbc<\xbb\xfe~\xd1\xee\xcfp\xef\xbc\xcf\x7fB\x96\x93\xa8\xd3E\xd9\xe2\xf4\xf8<\xb2\x00l\x90C\x1e\x95\x93\xcb\xd7\xc9\xd5[\xa9\x95\xdc\x99\x0f\x99+\xe3\x17QN\x95\x8f\x86\xf2n[YG\xee\xf2P\xe0E\xcb\xf9\xe0\xdf\xed\n\x9b\xc4zX\xf5{>\x0d1\x8eef\xcfiC\xffD~\xb3m\xeb\x87\xa8\x19\xc1\x9c\xb8s\x0fl?C\\0\x0c\xa7\xff\xcb\xaam\x95\xbf\xe2\xf27\x00\x00\xff\xffPK\x07\x08\xe0B5\x0f\xca\x01\x00\x00\xac\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2UHN-*\xc9L\xcbLN,I\xd5\xabL\xcc\xcd\xe1\xe2J\xce\xcfK\xcbL/-J,\xc9\xcc\xcf\x03\xab\xc9.-.\xc9\xcf\xcd\xacJ\x85HA\xd4\x01\x02\x00\x00\xff\xffPK\x07\x08\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\xce\xb1J\x04A\x0c\xc6\xf1~\x9e\xe2\x03\xeb\xf3\xfam\xadl,N_ \xcefv\xc3\xb9\xc9\x92dV\xf0\xe9e\x04AA-\xac\xc3\xf7\xcb\xff\x06O\xab\x04\xaai\x93\xa5;\xa5\x98B\x02\xcd\x1c\xc9TW\xd1\x05\xd7\x1ei\x9b\xbc1V{E\x1a\xfa>S2\x946\x86s\x03\xe9\x8c\x83\x1c\xd1\x9f#%\xfbP\xca\xb8^\xb8\xb1\xb3V\x9e\xca	W\xd1y\xc2}Dg/\xc0\xe2\xd6\xf7	\x95=O\x1b)-\xec\xb7b\x05h\xc2/\xf3\xe3\xce5\xa6\x02|\xee\xee\xd8S\x9aTJ.\xc0\x1fs`\xa7\\'\xc4\xce\xf5,\x1f\xdf.\xdc\xce#\xa7\x94\x83\xfc\x87\xa6\xef\xf6\xaf\xf2\x17\xb7\xda\xb6\x99>\x0c\xf3\xff\xc8\xac1\x84(\xef\x01\x00\x00\xff\xffPK\x07\x08@\xa4D\x18\xbf\x00\x00\x00\x86\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xd4\x95Ko\xe36\x10\xc7\xef\xfc\x14\x83\xe8\xe0\x16X\xcbh\x8f\xba\xb9\x86\xdb\x0d\xda<\x90d\xb7\x87\xa2\x08F\xe4\xc8\xe2F\xe2\xa8$e\xc7\xfb\xe9\x8b\x91\xe8H\x0e\xba\xdd\xa2\x08P\xf4&q\x1e\xff\xdf<$f\xb06&\x80\xc3\x96B\x87\x9a 2`\xd3\x80\xa7\xc0\xbd\xd7\x14r\xf5b+\xe0\xa9/I\xa3\xf7\x96\xfc2\x1cC\xa4V\xa9\x0c>b\xd3\x13p\x05\xb1\xb6\x01*K\x8d\x01\x1b\xa0\xf3\xd4\x913d$g\xacIe\xa3\x8cx\x9eI\xbc\x03\xcaw9 \x18\xea\x1a>\xb6\xe4\xe2\xe0iT\x06\x17\x07\xf6\xa6\xf3\x14\xc2\x05\x94\xa4Y\xe2/\xb0\xb1\x9a\xc2r2\xe5*\x83k\x8e\x04\xb1\xc6\x086B\xa8\xb9o\x0c`\x13\x18Z\x8c\xba\x86\x83\x8d\xb5P\x08Ve\x9f\xe1\x9bH\xcf\x11J\xaa\xd8\x13,\x96\x8bo\xc7\x02hj\x85\xcaR-X\xf2\x9e\xc6>\xdc\x0e\xc1\x05T\xe4\xfdq\xa9\x94\xe6\xb6e\xf7\x0b\x96\xd4\x84B\xc1\xbcA\xb9\xe5\x95\xe7\x86\x92\xb3R%\x06\n\x85ZB\x9e\xaf|\x89z|j\xd1\xe1\x8e\xbc\xca\xe0\xb7_\xb7?\xbc\xbf\xb9\xf9\xf9wx` \x87eCp\xa0\xb2f~z\x07\xbd\x13)\xe9\x8c\xb4N8\x03\xe9h\xd9\x85\xb1\xb2)8\xd5g\x9dnzc\xddnpfG`\x1dhoVO}\x88\xdc\xda\xcf(\xd1\xf9\x11\xdbFe\x03I\xd2\x12\x92\xcd\xf6\xee\xe1j}\xbd\xfei{7\xa7\xd1\xe4\xe32\x01\xbfF:\xc7Y\xcc2,rX$\xba\x05hn;v\xe4b\x00\xf4\x04\x9e\xfe\xe8\xad'\x93'\x06Q\x98u\xe4\xf6\xee\xe6j\xfb\xf0~\xfb\xe1~\x8e\xd1yn)\xd6\xd4\x07h\xd9\xd9\xc8_\x83\x99\xd2,NBS\x0e\xa5:Y\x10\n\xf7\xd1c\xa4\x9d\xd5W\xe4w$\xd3\xcc\xe0\xb2\x82#\xf7p@\x17\xe5\xc1C\x82\x93\x95\xa6\xe7\x8e\x03\x0d\xed]\xb5\x14\xbd\xd5a\x88!g:\xb6.\xc2a\xc5\x80\xee\x08\xd8\xc7\xda\xad>\xcf!%\xa8\xe2\xa6\xe1\x83L\xa8\xb1\x8e\x00\x9d\x19\xc2O.I\xe9Q\xa2\x1f;\xcf\xcf\xc7\xc7\x01t\x18Y>\xb8\xde\xb8\xe68\x8c\x96\xab\xbfw\x7fI~\xf2\x9a\xca\x7fL\xe8s\xef\xf4\xf5\x94\x94:>\x8e\xe7\x1f\xc5\xaa\xffz\x91O\x94I\xf05\xda\x1bo\xb6\xca\xe0\xc3\x8b\xf3\x99i\n\xfb\"\xee\xb0D\xa3\xbe\x94\xb6Y\x83u\x9f\xc6(	\x9234\xad\x0dA\x0eR=A$\xcf\x85\x1c\x91	\x92k\x9a\x97\xbc\xf5\x81@\xe3\x94R\x9a\x93\x92h|9}\xd5\x9f\xf3\xbd\xd4\xec*\xbb\x93\x7fy\xc5\x1e\"\xa1\xae\xe5\xf8T	A\xcd\x07\x912\x0c{\xf4\x10\xfa2D\x1b\xfbAm\x8f>\x14o\xdf\xf1\xb4\x15\xc3B\xca\x0f\xb9\x00\xb1^\xfex\xb9Y?l\x1f\xaf\xd7W\xdb\xfb\xdb\xf5f\x0b\xd9\xecJK?vQ\xb4\x95\xd5\x18	6w*\x03\xe0\xf2\x93\xa7J(\x01\xe0\xc9:S\xc0fr\x1a\x8fw\x9e\xfb\xae8\xc3\xcd-\x8f\xb6=y\x99M\x01\xfb\xef\xb0\xe9j\xfc~<\x1e\xb9\x02\xf9\xbdu\xbb\xa5DB6\xde\x8db9}]\xe3\xb54\xdf\xe9I:m3\x8c\x17\xd0\xc48\xbcv\x18\xeb\x02Z\x8ah0b>\xbb\xaf\xbe\xd4\x92\xffC\xad'\xf6\xfb\xed\xdd\xc7\xcb\xcdWF9\xe8\x0d7\xf4_\xd5u?Y\xcf\xb8\xe7\xc4\xe9KX\xce2\xfd\xbb^\xcfy\xdf\x90\xe7\xcf\x00\x00\x00\xff\xffPK\x07\x08\xb4\x8c\x19\x7fG\x03\x00\x00\x98	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8l\x90Mn\x840\x0c\x85\xf79\x85/\x80\xe8\x0f\x8b6\xdbv;-\x8b\xaa{7\xb83\x88$\x8ebC\xc5\xed\xabt\n\x021\xde@>???\x19S\xffIYz\x8e\x160%\xa9\xa7{3\xf4\xb1\xb3\xf0J\xc9\xf3\x1c(\xaa	\xa4\xd8\xa1\xa25\x00\x11\x03Y\x08\x18\xf1L\xf9\xff-	\x1dY\x90Y\x94\x82\x91D\xae(\x95B\xf2\xa8T\xfe\x01\x16Z\xcaqT\xec#eYHu\xf0\xbdV\xe2\xac\xab\xa8\xc8\xd6\xd1\x96\xb3Zxn\x9a\xc7\xb5\xbb\x84\xfb\xa1\xaf\x0b\xf3P	\xe5i\xe3\x05\x902+;\xf6\x16>^\xda\x95O\xec\xc7@'\x1e\xe3~U(\xa4E\xbdX\xa85\xa4zx\x92jo]\x97O\x1f\xcf\x95\xa3\xacr\xc8Q\xe8\x06f\xc2\xee=\xfa\xd9\x82\xe6\x91\xccv\xf9\xe1\x0e\xbbQ!\x97I\xed\xc6\xaa\xa3o\x1c\xbd\x9e\xb8#\x0b\xcd\xc3\xdd\xa6u\x15\xbf\xdd8\xc4_J\xf3\x1b\x00\x00\xff\xffPK\x07\x08\xf4Eb\xc7\xef\x00\x00\x00\xf1\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xc4\x90?k\xc30\x10\xc5w\x7f\x8a\x83th\x06%d+\xde\x82q!CJiC:\x96\x93t\xb1\xaf\xb6\xa4 \x9d\x1d\xdaO_\x94\x84\xfe\x81th\x97\x8eB\xefq\xbf\xdf\x9b\xc0\xa6\xe5\x04{\x14\xd3\x02Z\x0b\xe8}\x10\x14\x0e\x1e$\x00Z\xc7)\xe5\xc7\x81t\x1bB\x07&\xf8\x1d7\x80\xde\x16\x13\x90\x96`\xc4\xc8\xa8{Jpu]\xd5\x0f\x9b\xd5\xed\xaaZn\xea\xe7\xbb\xe5\xba~\xbc_V\xf54\x87/|N\xe1\xc0}\x0f\x9a \x0d:	\xcb dA\xbfB7$	\x8e\xdfhV\xe0\x9e\xb7\x143@\xf9\xc9\x12\xa9\xe1$\xf1\x089\xebn\xd2\x8c\xc3|\\h\x12\\\x14\x1d{[\xc2z\xc8\n\xbey:AWG\xe6\xe1\xd4(\x1c	Z\x14,\x0b\x00\x8f\x8eJp\xe7\xb8:K*\xf3\xad\x00_FI\xb9\x05`(\x8ar\xe8\xb1\xa1\x98\xcf\xb3\x7f!#\xca\xa0\xda\xc5\xe0\xca\x1f\xa7\x98_\x98\xa1PJ\xfdUt\x8b=\xdb\xdf\xa8\x8e\x1f\x85\x7f\x92}\x0f\x00\x00\xff\xffPK\x07\x08l\xd7\xcf&\x03\x01\x00\x00q\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xccKLO-\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4TMO\xe30\x10\xbd\xf7W\x8c\xba\x1c\xd8Ch\xd9\xbdY\xea\x81\xed\x06\x84\xb6\x14\xd4\x02\xd7hp\xa6\xc5\xc2_\x8c\x9dH\xfd\xf7+\xa7iI\xa2\x82\xb4Z\x9f\x1c\xcf\x9b\xf7\xde\x8c\xc7A\xaf\x9e\x89\x83rV\x00z\x1f&\xf5\xe5\xe8M\xd9R\xc0o\xf2\xda\xed\x0c\xd982\x14\xb1\xc4\x88b\x04`\xd1\x90\x00\x83\x16\xb7\xc4\xedw\xf0(I@\xd8\x85Hf\x04\xa0\xf1\x85tHh\x00\xe9ld\xa73\xaf\xd1v\x12\x83'\x99\x00\x814\xc9\xe8x\x0f6\x18\xe5\xeb\xa2\x93\xfdi>\x00\x93\xd7Jb\x10p9\x02\x88d\xbc\xc6H-O\xc7pZ\xbaG\xf9\x05)\xc0\xc1X\xb3'\xae\x95\xa4+)]e\xe3\xb2\xa9<`\xc7\x18*K|\xe4\xcd@\x19\xdc\x92\x80\xf7\nw\x17\xcaM\xde\xaa\x17\x92\xc8\xac\x88'\x1bb\xde\x89d1\xc4\xa3\x0f\xe4m\xc7U\x06\xe3,k53\xa9\xab\x10\x89\xb3\xd4\xdf\xd9\xd9\xf9:_=\xdf\xce\xf3b\xbexZ?\xe6\xab\xef\xe3\xaf\xb3\x1aeg7j;\x9b|\xec;\xdbA\xbegW\xab\xb2\x95k\xaesvv\xfe\xe7\xe9W\xbeZ\xe6\x8f\xf9\xbaX^\xdd\xe5\xeb\x87\xaby>\x10\xaegg\xe7\x8b\xfb\x9bb\x91?\xe7\x8bNl8%\xfbE\xb6\xfe\xa8\x16\xe0\x1b\xdc\xda@\x1c\xa9\x84\xb2be\xb7)Am(D\xd8\x92%\xc6\xa8\x9c\x05e\x81)\xb8JR75k%\x06}\xe9\xb1C\x8d\xba\"\x01\xe3\xa2\x18\xc0\x9az\x8ab\xdc\x81\x1f\x08O\x15\xdd\x81\xb5\xa4\xd7\xec\x8c\xe8\x1d\x03l\x14\xe9rE\x9b\xe1y\x1by\xc0\xf8*\x8e\x93yql\xf4\x11];]\x19\xbaK\xa3\x16D\xcf\x99Ig\xfb\xfc\xce\x15\xf6d\xf6\xe6O\x04\x9b\xde\xb1\xa4\x1e\xa5VF\xf5E\x00\xa4\xaf\x04\\N\xa7\xa6wj\xc88\xde	\xf89\xbdS\x9d\x00\xd3{E\xe1\xdf(~|PDb\xa3ls\xbf7\x8c\x92\x1e\x88\x95+\xd7\xc9{\x99\x1e\xf4\xb4\xc5\xed[\xd2{\"\x9f\x16\x9a\x9e\xabd\x8a}K\xce'\x11\xd4\x026\xa8\x03\xf5b\xff1\x7f\x07\xb1\xfd?a\\\x14il\xe6\xf7\xcb\xeb\xdb\x9bb\x9d\xcfW\xf9\xe3\x89\x11\x03P\x91L\x18\xceG\x06o\xb4\x130>\xf58\x0f\xcb7\x97\xdf\x83\xfc\x0d\x00\x00\xff\xffPK\x07\x08\xab$C\x937\x02\x00\x00\xba\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8\xcd\xcf\xcb,\xc9/\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xc1J\x841\x0c\x84\xef}\x8a\x01/z\xe8.^\xfb\x0e\x0b\x82\xe0=\xf6\x0f\xfb\x17\xdb\xa4$q\xc1\xb7\x97\xdf\xadx\xf3\x96L2\xdfLz\xc0\x8b\xe9\xe0\xd8\xf9\xd3qQi\xa1\x86W\xb6[\xab\x8c\xc7\x0b\x87\xb5\xeaO\x89f{c\xf3\xa6R0\xee_M\xae\xa7\xaa\xc6\xea\xa7\xaa\xe3|{N\x1fM\xb6\xf2k^\xac48h\xa3\xa0\x92\x80N\xef\xdc\xfd\x98\x80\xaa\x12\xa6=\xcfN\xc2\x05\x83\x84\xael	\x10\x1a\x7f{\x1e\xf7\x06y\x85\xae\xbbO\xaa\\\xe0_\x1e<\x92O\xae\x07\x94e\x9b\xda$VB\xc6\xa4\xd8\x0b\xce\x8b\xf1#\x02S-\n\xf6\x88y(\xce\x9dk\xa8\xfd[\xea;\x00\x00\xff\xffPK\x07\x08P\xff\xd7\xb2\xb3\x00\x00\x00&\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U(\xca\xcfI\xd5\xabL\xcc\xcd\x81\xb2\xe3\x932\xf3R2\xf3\xd2ab9\xa9\x89)\xa9E\xf1\xa99\xa9\xc9%\x99\xf9y\xf1\xc8\xea\xb1\xc9\xa1\xea\x07\x04\x00\x00\xff\xffPK\x07\x08	\xa8'\x97>\x00\x00\x00k\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\x03A\x0cD\xfb\xfd\n+\xd4w\x11\x1d\xba\x1f\xa0\xa7\xa0wv\x87d\x95\xbd\xf5\xca\xf6\x1e\x12_\x8f\xeer\xa1\x00!A\xe5\x91\xedy3\x0f\xd4\xa0s6\xcbR\x8d\\(	\x15p\x82\x12\n\xa2g\xa9c\xe0\x96_\xa1\xeb\xcbDz\xe28r\xf7\x8bh\xfe\xe0\xed~}\xb21\xcbqy\x0c\xd7\\\xd3D/R\x10f8'v\x9e\x02Q\xe5\x19\xd3\x8e\x1d\xee\xd8A\xd77\xed\x056\x85\x81\xb8\xe5g\x95\xdel5\x0ct8\x04\"\x85I\xd7\x88}\x17\xa5\xbe\xe5\xf3\xcc\xcd\x02\xd1\x02=\xed\xfb3|\x9b%\xdbM\xbc\xb3\xc7\xcb\xcd\xa2`\xc7&{Kw\xd9\xbe\xee	\x05\x8e\xff\xc6\x1f\xcd\xd9\xfb/-~\xe4\xfc	\x8e\x05\xd5\xbf\x11\xf7\xf2\x9f\x01\x00\x00\xff\xffPK\x07\x08\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8d\xb1\xae\xc20\x0cEw\x7f\x85\x7f\xa0}z\xdbS\xb6\xc7\xc2^$v71`\x9a\xda\x95\x93t\xe0\xebQ\xa5\n\xb6nw8\xe7\x1eZ\xe4\xca^\xc44\xa0\x8f\x14{j\xf5a./\xaab\xdaO\x7f\xa5\x17\xfbY\x7fa\x12M\x01\x07\xcb|\x12M\xa2w\x98\xb9R\xa2J\x01\x10\x95f\x0e\x98\x99\x12{\xc7\x99\xe3fwn\x99\xc7\x9d\xde\xf6\xc0\xb7\x0d\xa6E\xcenm9(\x02\xe27x\xf8\x0f\xa5\x8dO\x8e\xb5\x04\xe8v\xe7\xc2\xbeJ\xe4\xff\x18\xadi\xfd\xd8\x85\xe0\x1d\x00\x00\xff\xffPK\x07\x08+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xbc\x91\xcdN\x031\x0c\x84\xefy\n\xbf\xc0n\xc5\x0d\xed\x0bpG\x88\xbb\x9b\x1d\xb5V\xf3'\xdbY$\x9e\x1e\x11\xca\x05\xb4\x87J\xd0SF\x8e\xa3\xf9f\x12\xa6i\n\xdc\xe4\x15jR\xcbBz\xe48s\xf7sUyg\x97Z\xe6\xcb\xa3\xcdR\x0f\xdbC\xb8HY\x17z\xae	!\xc3ye\xe7%\x10E\xc5\xd8|\x91\x0cs\xcem\xa1\xd2S\nD\x853\x16\xca\\\xf8\x04\x0d\xda\x13l	\x13q\x93'\xad\xbd\xd9\xe7\xeb\x89.\xfd\x88\xc8\xaa\x02\x9d\xa5\x06\"\x85\xd5\xae\x11\xd7\xfb\xd8\xcdk\xfe\x1e\xaeb\xb1nP\x81\x05\xa2\x0dz\xbc\xae\x9d\xe0\xe3Lb_\xa2\xb1\xc7\xf3P\xbd\xad\xec\x18\xf2m\x0c\xff\x8e\xe1`\xce\xdewP~\x11\xdc\xeck\xd0M\"b\xea\xe6P6\x93S\xc9(\xfe\xc3p|\x01\xfe\xbf\x86]\x9c\xbb\xd6\xb0\x1f~E\xc2\xfd{\xb81\xfdG\x00\x00\x00\xff\xffPK\x07\x08\x8e\x1dL\x1b\xe6\x00\x00\x00u\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8fMj\xc3@\x0cF\xf7:\x85.0.\xdd\x95\xd9\xb5\x9b\xee]\xe8^\x1e\xab\xaeb\x8f4\xcc\x8f!9}0\x84\xc4\x10\x93\xa5@\xdf{<J\xf2\xcb\xb9\x88\xa9\xc7\xf5\x1df\xd1\xd1\xe3\x0f\xe7U\x02\x7f\x86`M+D\xae4R%\x0f\x88J\x91=\x16\x02\xe7\x1c\xec\xc7y\xa0\xd0Q\xab\xff\x96\xe5BUL\xbb\xf9\xa3tbowlo\x0b\x7f\x89\x8e\xa2\xd3\x013\x92\xd2\xc4\xf9v\x97Da\x13\x9dK\xe5\x08\xd9\x16\xee\xf9o{\xa6$\xdf\xd9Zza\x04\xc4\x87\xf0\x89_\xdap\xe2P\x8b\x07\x87\x87\xb9\xbb\xc8k\x00\x00\x00\xff\xffPK\x07\x08Z\xec=\xd0\x9f\x00\x00\x00\x1e\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xcc\xcbLK-.)\xd6\xabL\xcc\xcd\xe1\xd2U(N-*\xcbLN\x85p\xb9\x92\xf3\xf3\xd22\xd3K\x8b\x12K2\xf3\xf3\xc0\x1a\xb2K\x8bK\xf2s3\xabR!R\x10u\x80\x00\x00\x00\xff\xffPK\x07\x08\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92\xb1n\xa4@\x10D\xf3\xf9\x8a\x926>\xb8\xbd\xe8Dz\xd1\x05N\xbc\x92\x1d\xf7B\x03-`\x1a\xf7\xf4\x80\xec\xaf\xb7\x00YZG\xdeh\xd3V\xe9\xd5\x9b\xd2\x9c\xe0=\xa3\xd5q\xd4Ub\x87Zc+\x1d$\xa1U\x833\xd5\xfdv\x1err\x9d\xe4\x83\xb1\xf6l\x0cW\x8c\xaa\x03\xc8\xb7CD\xca\xd7\xe4\xe2\xd9\xb7\xf0B\x96\x8ap\xc2\x7f\x87\xf1[\x16\xe3t\x03X\xfe\x14\xe7\xe27\xd4\x10ye\xdbP\xab\xda\x80\xd9tf\x1b\xdf\x8b\x10i\xe2gn\xd98\xd6\\\x85_\x18$6\x15.l\x8b\xd4\x1c\x80\x85-\x89\xc6\n\xcb9\x00\xad\xf0\xd8\\f\xaeS\x15\x80\xaf\xf4Sv\xdal^\xf9\xda\xab\x0e\xff\xf6we#\x17\x8d\x01\x00:\xd3<W\xa0f\x92\xb4\xd1\x8c;I~\x04\x8a\xe1o*D\xf7\xdcL\xdeWX\x0fL*\xebQ8\xfa\x81+\xd3\xa1Tn\xc67\xdd/4J\xf3\xe0\xf6}\xb54\xd3\xedb?lp\x9f\xc3\xbd\x06{w\x00jcr\xae\xe0\x969\xdc\xbd\xc8\x03\\\xc2B\xf6\xed[\x1d\xb4\x89\x9d\x1ar*)F\xf5\xbd0\x85\xcf\x00\x00\x00\xff\xffPK\x07\x08w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x01\x00\x00\xff\xffPK\x07\x08\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8bA\n\xc2@\x0cE\xf7s\x8a\\\xa0\x0b\xb1\x1bs\nAp\x1f\xa7\x9f:\xb43\x19\x92P\xf1\xf6\xd2\xd6\xdd\x7f\x8f\xff\x92\xf4\xf2\x84y\xd1\xc6\xb4]\xd2R\xda\xc4\xf4\x80m%#U\x84L\x12\xc2\x89\xa8I\x05\xd3\x07\xaf\xb7\xea2\xf8\xffqz\xef\x92\xc1\xe4_\x0f\xd4\xe4\x1dy/\xbaZ\xf8>\x88\x86\x03\x98\xc6\xf1z0Q\x88\xcd\x88\xfbao\xa7v\xac\xc8\xa1v&Y[\x98\xaeC_\xa5\x81\xa9J\x93\x19\x96~\x01\x00\x00\xff\xffPK\x07\x08\xf4	\xd6f\x82\x00\x00\x00\xb1\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe0B5\x0f\xca\x01\x00\x00\xac\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1d\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(@\xa4D\x18\xbf\x00\x00\x00\x86\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb3\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb4\x8c\x19\x7fG\x03\x00\x00\x98	\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc9\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4Eb\xc7\xef\x00\x00\x00\xf1\x01\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80a\x07\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(l\xd7\xcf&\x03\x01\x00\x00q\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa9\x08\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x08\n\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xab$C\x937\x02\x00\x00\xba\x05\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80y\n\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xfb\x0c\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(P\xff\xd7\xb2\xb3\x00\x00\x00&\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80o\x0d\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(	\xa8'\x97>\x00\x00\x00k\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80p\x0e\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xfc\x0e\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x17\x10\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x8e\x1dL\x1b\xe6\x00\x00\x00u\x03\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x05\x11\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Z\xec=\xd0\x9f\x00\x00\x00\x1e\x01\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x800\x12\x00\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1c\x13\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb7\x13\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80)\x15\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4	\xd6f\x82\x00\x00\x00\xb1\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80{\x15\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x13\x00\x13\x00\x11\x06\x00\x00H\x16\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x92Ak\xdc>\x10\xc5\xef\xfe\x14\x0f\xf6\x0f\xff\x06j\xbb\xe9\xd1\xb7\xb0,%\x87,e\x13\xd2c\x19Kc[X\x96\x8cf\xbcIZ\xfa\xdd\x8b\xec@H(\x81\x1c\xedyz\x9a\xf7{\xda\xe1n`t\xd1\xfb\xf8\xe0B\x8f\x89\x82\xebXT`bPr\x01\x04a\xdf\x95\xe2\xfa\xc0\x16Nd\xe1\x84\xfd	\x14,\x08\x86\x93\xba\xce\x19R\xc6\xfeT\x15;\xdc\xc4\xc4\xb0\xd1,\x13\x07\x85\xa1\x80\x96\xd1\xc5%\xcb\x15\x83\xea,M]\xdbh\xa4\xca\x87\xcb\x89\x02\xf5\x9c*\x17\x8b\x1d~\\\x9d\x8e\xd7\xc7o\x0d\xee(\xf5\xac\x82='\xbd\xd9\x14\xf8R]^\xc2\x0cl\xc6wmj\x0e\xb5'e\xd1ZIF\xa9\x97\xb9Od]\xe8k\x17,?V\x83N\x1e]Lh\x13\xd3\x98S\x9b\x81B\xcfR\xd0\xec\xee9\x89\x8b\xa1\xc1[\xd7\xf3%\xf9y\xa0\xaf\xc5\xe8\x82mp\xbd\x82(&V\xb2\xa4\xd4\x14@\xa0\x89\x9b\x95\xd6\x06\xab\xdc`=Od&\x93\xc7O\xa2<\x152\xb3\xc9g\xb2\xfavU7\xf8\xfd\xa7(\xcb\xf2#K\xec_\xe8\xffs\x93tv\xa1/s\x12`\x07\x1d\x9c\xac#\xc8\x10\x17o1\x91\x9a\x01:0b`\xd0<3\xa5\xdcq\xc0\xb8\x88\xc6\xc9\xfdb\x13C\xe7\xfa\xea\x89&\xffn\x8e\x1d\xfe\xfbt{8\xdd_\xef\x0f?\x8fW7\x87\x8b\xf5}\xbc\xfew\xfb\xfdj\x7f\xb8\xc0\x83\xf3\x1e-C\x96V\xd4\xe9\xa2l\xd1>\xbd\\Y\x006\xc8\x91&\x96\x9c\xa5|\xeb\\\xbd\xfe\xde\\+9\x9b\x0f\x89+\xe3\x17QN\x95\x8f\x86r\xb6\xad\xac\x13w\xf9R\xe0U\xcb\xc0{\xed\n\x9b\xc4\x9a\x17n\xf0\xc0\xed\x10\xe3X\n\xa73\xa7\x0d\xfd3\xf9M\xb6\xc5\x0fQ3\x829q\xe7\x1e\xd9~\x86\xb8`\x18N\xff\x97u\xb6U\xfe\x86\xcb\xdf\x01\x00PK\x07\x08\xe0B5\x0f\xcb\x01\x00\x00\xac\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00F\x00\xb9\xffresources:\n- certificate.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\xce\xb1J\x04A\x0c\xc6\xf1~\x9e\xe2\x03\xeb\xf3\xfam\xadl\xae8}\x818\x9b\xd9\x0d\xe7$K\x929\xc1\xa7\x97\x15\x04\x05\xb5\xb8:|\xbf\xfc\xef\xf0\xbcJ\xa0\x9a6Y\x86S\x8a)$\xd0\xcc\x91Lu\x15]p\x19\x91\xd6\xe5\x9d\xb1\xda\x1b\xd20\xb6\x99\x92\xa1\xd4\x19\xce\x0d\xa43\xae\xe4\x88\xf1\x12)9v\xa5\xec\xd737v\xd6\xcaS9\xe0\":Ox\x8c\x18\xec\x05X\xdc\xc66\xa1\xb2\xe7\xa1\x93\xd2\xc2~/V\x80&\xfc:?m\\c*\xc0\xd7\xee\x81=\xa5I\xa5\xe4\x02\xfc3\x076\xcauBl\\\x8f\xf2\xf9\xed\xcc\xed\xb8\xe7\x94r%\xff\xa5\xe9\xa7\xfd\xa7\xfc\xcd\xad\xd6\xbb\xe9i7oGf\x8d\x13u\x8e\xf21\x00PK\x07\x08@\xa4D\x18\xbd\x00\x00\x00\x86\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xd4\x95Mo\xe36\x13\xc7\xef\xfc\x14\x83\xe8\xe0\xe7\x01\xd62\xda\xa3n\xae\xe1v\x836/\xb0\xb3\xdbCQ\x18#jdq#qT\x92\xb2\xe3\xfd\xf4\xc5H\xb4%\x07H\xb7(\x02\x14\xbdI\xe4\xcc\xfc\x7f\xf3B2\x81eQx\xb0\xd8\x90oQ\x13\x04\x06\xackp\xe4\xb9s\x9a|\xaa.{\x19<w9it\xce\x90\x9b\xfb\x93\x0f\xd4(\x95\xc0g\xac;\x02.!T\xc6Ci\xa8.\xc0xh\x1d\xb5d\x0b*$f\xa8H%\x83\x8cX^I|\x00J\xf7) \x14\xd4\xd6|j\xc8\x86\xde\xb2P	\xdc\x1c\xd9\x15\xad#\xefo '\xcd\xe2\x7f\x83\xb5\xd1\xe4\xe7\xe3V\xaa\x12\xb8\xe7@\x10*\x0c`\x02\xf8\x8a\xbb\xba\x00\xac=C\x83AWp4\xa1\x12\n\xc1*\xcd\x0b\xfc/\xd0K\x80\x9cJv\x04\xb3\xf9\xec\xff\x82%\xfb\x97tU\x12s\xc1\x9c\x0f4\xd4\xe1\xb1w\xce\xa0$\xe7Ns\xa547\x0d\xdb_0\xa7\xdag\n\xa6\x05J\x0d/\x1c\xd7\x14\x8d\x95\xca\xd1\x93\xcf\xd4\x1c\xd2t\xe1r\xd4\xc3W\x83\x16\xf7\xe4T\x02\xbf\xfd\xba\xfe\xe1\xe3\xc3\xc3\xcf\xbf\xc3\x13\x03Y\xcck\x82#\xe5\x15\xf3\xf3\x07\xe8\xacHIe\xa4t\xc2\xe9I\x07\xc3\xd6\x0f\x99\x8d\xce1?cu\xdd\x15\xc6\xee{c\xb6\x04\xc6\x82v\xc5\xe2\xb9\xf3\x81\x1b\xf3\x15\xc5;=aS\xab\xa4'\x89ZB\xb2Zo\x9e\xee\x96\xf7\xcb\x9f\xd6\x9b)\x8d&\x17\xe6\x11\xf85\xd25\xcel\x12a\x96\xc2,\xd2\xcd@s\xd3\xb2%\x1b<\xa0#p\xf4Gg\x1c\x15id\x10\x85IE\x1e7\x0fw\xeb\xa7\x8f\xebO\xdb)F\xeb\xb8\xa1PQ\xe7\xa1ak\x02\x7f\x0bf\x0c3;\x0b\x8d1\x94je@\xc8o\x83\xc3@{\xa3\xef\xc8\xedI\xba\x99\xc0m	'\xee\xe0\x886\xc8\x87\x83\x08'#M/-{\x199\x82EC\xc1\x19\xed{\x1f\xb2E\xcb\xc6\x068.\x18\xd0\x9e\x00\xbbP\xd9\xc5\xd7)\xa4\xf4\xa4\xe4\xba\xe6\xa3t\xa86\x96\x00m\xd1\xbb\x9f\xf3\x88J;\xf1\xde\xb5\x8e_N\xbb\x1e\xb4oY\xda\x9b>\xd8\xfa\xd4\xb7\x96\xcb\xbf6\xbf\x04?[\x8d\xe9\xef\"\xfa$\xf8\xf9\xf4\xe4\x14\xc7ph\xcf\xdf\xf2U\xff\xf6 \x9f)\xa3\xe0k\xb4w\x9el\x95\xc0\xa7\x8b\xf1\xd5\xd6\xe8\xf6\xe6\xb9\xeb\x87h\x18i\x99\x87\xd5\x12\x8c\xfd2\x14C\x9cd\x0d\x8b\xc6x/\x0b1\x1f/\x92\xd7B\x96\xa8\xf0\x12k\xec\x97\xfcu\x9e@\xe3\x18R\x06?\x06\xd1xY}U\x9f\xeb\xb9\xd4lK\xb3\x97\xbb\xbcd\x07\x81PW\xd2\x87\xf3\x0dBP\xf1Q\xa4\n\x86\x03:\xf0]\xee\x83	]\xafv@\xe7\xb3\xf7\xbfK\xe2\xf5\xd6\x0f\xa4\xdc\xd4\x19H-n\x7f\xbc]-\x9f\xd6\xbb\xfb\xe5\xddz\xfb\xb8\\\xad!\xbe5\xfd\x93\x16/vQ4\xa5\xd1\x18\x08V\x1b\x95\x00p\xfe\xc5Q)\x94\x00\xf0ll\x91\xc1j4\x1a\x96\xf7\x8e\xbb6\xbb\xc2M\x0d\x0f{\x07r\xd2\x9b\x0c\x0e\xdfa\xddV\xf8\xfd\xb0<pyr\x07c\xf7s\xf1\x84dx\x1be\xe7|\xba\x86giz9\x8f\xd2q\x9aax\x80F\xc6\xfe\xb7\xc5Pe\xd0P\xc0\x02\x03\xa6\x93\xf7\xea\xad\x92\xfc\x17r=\xb3o\xd7\x9b\xcf\xb7\xabo\xb4\xb2\xaf\xad\xa67\xf2\xda\x8e\xbbW\xdc\xd3\xee\xc4\x930\x9fD\xfag\xb5\x9e\xf2\xbe#\xcf\x9f\x03\x00PK\x07\x08\xb4\x8c\x19\x7fL\x03\x00\x00\x98	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xc1R\xf30\x0c\x84\xefy\n\xbd@&\xff\x0f=\x80\xafp-\xf4\xc0p\x17\xce\xd2fj[\x1eY)\xd3\xb7gL\x9aL2\xd5\xc9\xfe\xb4\xda\x95\xcdy\xf8\x84\x96A\x92#\xce\xb9t\x97\xff\xcdyH\xbd\xa3W\xe4 \xd7\x88dM\x84q\xcf\xc6\xae!J\x1c\xe1(r\xe2#\xf4v/\x99=\x1c\x95k1\xc4\xa6d\xf8\xaa4\xc4\x1c\xd8P\xcfD3\xad\xe5%\x19\x0f	Zf\xd2\xde\xf9N\x95Em\x11U\xd92z\x105G\xcf\xbb\xdd\xe3\xd2\x9d\x97\xfb\xc1\xd7I\xe4\xdc\x16\xe8e\xe5E\x94UL\xbc\x04G\x1f/\x87\x85_$\x8c\x11{\x19\xd36*Vr`;9\xea,\xe6\xee\xfcT\xda\xaduW\x13\x86tl=\xd4\xca*hzL\xa5+\xa8\xe0\xfe=\x85\xab#\xd3\x11\xb7\xc6\x14~\xf7\x0f\x9b\xd1\x02\xaf\xb0YR\xab\xc77\x8f\xc1\xf6\xd2\xc3\xd1\xee\xe1\xdf\xaa5\x89\xdf\xfe\\\xb6\xdb\xb6\x1ej\xcd\xef\x00PK\x07\x08\xf4Eb\xc7\xf2\x00\x00\x00\xf1\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xc4\x90=O\xc30\x10@\xf7\xfc\x8a\x93\xca@\x07\xb7\xea\x86\xb2UQ\x90:\x14!\xa8\xca\x88\xce\xf659\x12\xdb\x95}I\x05\xbf\x1e\xb9\xad\xf8\x100\xc0\xc2h\xdd=\xf9\xbd\x9b\xc0\xa6\xe5\x04{\x14\xd3\x02Z\x0b\xe8}\x10\x14\x0e\x1e$\x00Z\xc7)\xe5\xc7\x81t\x1bB\x07&\xf8\x1d7\x80\xde\x16\x13\x90\x96`\xc4\xc8\xa8{JpqY\xd5w\x9b\xd5\xf5\xaaZn\xea\xc7\x9b\xe5\xba\xbe\xbf]V\xf54/\x7f3\x9c\xc2\x81\xfb\x1e4A\x1at\x12\x96A\xc8\x82~\x86nH\x12\x1c\xbf\xd0\xac\xc0=o)f\x81\xf2\xdd%R\xc3I\xe2Qr\xd6]\xa5\x19\x87\xf9\xb8\xd0$\xb8(:\xf6\xb6\x84\xf5\x90\x13|\xf3p\x92\xae\x8e\xce\xc3\x89(\x1c	Z\x14,\x0b\x00\x8f\x8eJp\xe7uu\x8eT\xe6\x13\x00\x1f\x8e\x922\x05`(\x8ar\xe8\xb1\xa1\x98\xbfg\xffDF\x94A\xb5\x8b\xc1\x95?\x9eb\xfeu0-\x94R\x7f\x0d\xddb\xcf\xf67\xa9\xe3\x1b\xf0O\xb1\xaf\x03\x00PK\x07\x08l\xd7\xcf&\x00\x01\x00\x00q\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- manager.yaml\n\x03\x00PK\x07\x08\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4TMO\xe3@\x0c\xbd\xf7WX]\x0e\xec!\xb4\xec\xdeF\xea\x81\xed\x06\x84\xb6\x14\xd4\x02\xd7\xc8L\xdc2b\xbe\xf0L\"\xf5\xdf\xaf&\x0dt\x1a\x01\xd2j}J\xe3g??\xfb\xa5\xe8\xd5#qP\xce\n@\xef\xc3\xa4=\x1f\xbd([\x0b\xf8M^\xbb\x9d!\x1bG\x86\"\xd6\x18Q\x8c\x00,\x1a\x12`\xd0\xe2\x96\xb8\xff\x1d<J\x12\x10v!\x92\x19\x01h|\"\x1d\x12\x1a@:\x1b\xd9\xe9\xc2k\xb4Ya\xf0$\x13 \x90&\x19\x1d\xa7g\x00\x83Q>/\xb2\xeaO\xeb\x01\x98\xbcV\x12\x83\x80\xf3\x11@$\xe35F\xea\xfbd\x03\x03\x1c\x0f\xf4\xc5P)\xf56X\x8a@\xdc*I\x17R\xba\xc6\xc6e\xa7<`\x9fL\xc2PY\xe2^(@\x01\xca\xe0\x96\x04\xbc6\xb8;Sn\xf2\xd2<\x91DfE<\xd9\x10\xf3N\xa4\x11C\xec\xf1\x00\xc8\xdb\xf7\xeaT?.\x8a\x9e\xb3\x90\xba	\x91\xb8H\xfb\x9d\x9d\x9c\xae\xcb\xd5\xe3\xf5\xbc\xac\xe6\x8b\x87\xf5}\xb9\xfa>\xfe\xba\xaacvv\xa3\xb6\xb3\xc9\xe19{\x1c\xd4{v\xad\xaa{\xba\xee\x9c\xb3\x93\xd3?\x0f\xbf\xca\xd5\xb2\xbc/\xd7\xd5\xf2\xe2\xa6\\\xdf]\xcc\xcb\x01q;;9]\xdc^U\x8b\xf2\xb1\\d\xb9\xa1K\xf6A\xb6=\xa8\x05\xf8\x06\xd76\x10G\xaa\xa1nX\xd9m:\x84\xdaP\x88\xb0%K\x8cQ9\x0b\xca\x02Sp\x8d\xa4\xbc\xb4\xe8)\x06{9\xea\x0e-\xea\x86\x04\x8c\xabj\x00\xeb\xf4T\xd5a\xde\xa4f\xdf\xf0#\xd1\x19\xacoz\xc9\xce\xe4RRl\x14\xe9zE\x9b\xe1\xfb>s\x87\xf1Y\xbc;\xf3,\xb1u\x8b~G\xb7N7\x86n\x92\xd52S\xa4\xc9Lz\xb7\xaf\xcfN\x98A\xde>\xcb\x0f\x92\xdd\xeeX\xd2QK\xad\x8c:&\x01\x90\xbe\x11p>\x9d\x9a\x0c\x07`\xc88\xde	\xf89\xbdQY\x82\xe9\xb5\xa1\xf0o-~\x1cZDb\xa3lw\xdf+FIw\xc4\xca\xd5\xeb4{\x9d>\xe8iO\xb5_I\xc6R|.\x14 \x90d\x8a\x07p\n\xe7\x13	j\x01\x1b\xd4!\xb7\xd0\x7f\xf9\xef\x8dl\xff\x9f0\xae\xaad\x9b\xf9\xed\xf2\xf2\xfa\xaaZ\x97\xf3Uy\xff\x81\xc5\x00T$3\xd8Y\xd2\xf4B;\x01\xe3\xc3\xf1r_\xee\xc3w\xc7?\x82\xfc\x1d\x00PK\x07\x08\xab$C\x93?\x02\x00\x00\xba\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- monitor.yaml\n\x03\x00PK\x07\x08*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xcdJD1\x0c\x85\xf7}\x8a\x03nt\xd1\x19\xdc\xf6\x1d\x06\x04\xc1}\xec\x0ds\x8bmR\x928\xe0\xdb\xcbu*\xee\xdc\xe5\xf7\xfbNz\xc0\x8b\xe9\xe0\xd8\xf9\xd3qQi\xa1\x86W\xb6[\xab\x8c\xc7\x0b\x87\xb5\xeaO\x89f{c\xf3\xa6R0\xeeWM\xae\xa7\xaa\xc6\xea\xa7\xaa\xe3|{N\x1fM\xb6\xf2\xfb\xbcXip\xd0FA%\x01\x9d\xde\xb9\xfbQ\x01U%L{\x9e\x9d\x84\x0b\x06	]\xd9\x12 4\xfe\xfa<\xee	\xf2\x92\xae\xbdO\xaa\\\xe0_\x1e<\x92O\xae\x07\x94e\x9b\xda$\x96!cR\xec\x05\xe7\xc5\xf8\xd1\x02S-\n\xf6\x88yL\x9c;\xd7P\xfb7\xd4\xf7\x00PK\x07\x08P\xff\xd7\xb2\xaf\x00\x00\x00&\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00k\x00\x94\xffresources:\n- role.yaml\n- role_binding.yaml\n- leader_election_role.yaml\n- leader_election_role_binding.yaml\n\x03\x00PK\x07\x08	\xa8'\x97r\x00\x00\x00k\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\xc3@\x0c\x86\xf7{\n\xab\xccI\xc5\x86\xf2\x02\xec\x0c\xec\xee\xddO{\xea\xe5|\xb2}A\xe2\xe9QB\xca\x00B\xa2\xdb'\xdb\xff\xf7\xfb\x81\x1at\xcefY\xaa\x91\x0b%\xa1\x02NPBA\xf4,u\x0c\xdc\xf2+t=\x99HO\x1cG\xee~\x11\xcd\x1f\xbc\xed\xafO6f9.\x8f\xe1\x9ak\x9a\xe8E\n\xc2\x0c\xe7\xc4\xceS \xaa<c\xda\xb5\xc3M;\xe8z\xa6\xbd\xc0\xa60\x10\xb7\xfc\xac\xd2\x9b\xad\x81\x81\x0e\x87@\xa40\xe9\x1a\xb1\xcf\xa2\xd4\xb7|\x9e\xb9Y Z\xa0\xa7}~\x86o\x99\x92\xed\x0b\xde\xd9\xe3e\xa3\xa8`\xc7\x86\xbd\xa5\x1b\xb6\xef}B\x81\xe3\xde\xfa\xa39{\xff\xe3\x8b_=\xff\x92cA\xf5\x1f\xc6\xa8`G\xf8\x1c\x00PK\x07\x08\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd\xb1\x8e\xc20\x0c\xc6\xf1\xddO\xe1\x17hO\xb7\x9d\xb2\x1d\x0b{\x91\xd8\xdd\xc4\x80ijWN\xd2\x81\xa7G\x95*\xd8\xbay\xf0\xef\xfb\xd3\"W\xf6\"\xa6\x01}\xa4\xd8S\xab\x0fsyQ\x15\xd3~\xfa+\xbd\xd8\xcf\xfa\x0b\x93h\n8X\xe6\x93h\x12\xbd\xc3\xcc\x95\x12U\n\x80\xa84s\xc0\xcc\x94\xd8;\xce\x1c7\xdd\xb9e\x1e\xf7\xef\xed\x1e\xf8\x16\x00\x91\x169\xbb\xb5\xe5\xa0\x08\x88\xdf\xe0\xe1>\x946>9\xd6\x12\xa0\xdb\xcd\x85}\x95\xc8\xff1Z\xd3\xfa\xd1\x85\xe0=\x00PK\x07\x08+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xbc\x92Mj\xc40\x0c\x85\xf7>\x85\x98}2tW|\x81\xeeK\xe9^qDF\xc4\x7fHr\n=}I\xe8@iHa\xa03;#\x8b\xf7}~\xd8u]\xe7\xb0\xf2;\x89r\xc9\x1ed\xc0\xd0c\xb3K\x11\xfeD\xe3\x92\xfb\xf9Y{.\xe7\xe5\xc9\xcd\x9cG\x0f\xaf%\x92Kd8\xa2\xa1w\x00Ah\xdb|\xe3Dj\x98\xaa\x87\xdcbt\x00\x19\x13yH\x98q\"q\xd2\"\xa9w\x1d`\xe5\x17)\xad\xaaw\x00\x1d\x9cN\x0e@HK\x93\xb0.\xac3Z(\x9b:\x80\x85d\xf8\x9em\x1c\xda\xae+Z\xb8\xec\x93\xe66P@\x11&\xe9\xb9\xecSCS+\xe9\x8a\x1aYCYH\x98~\x81&\xb2\x8d\x12Y\xed\x07n=\xb5:^\x1d>\xfe\xd9\xe1\xac\x86\xd6\x0eTv\x067s\x95d\xe1@!65\x12T\xe5)\xa7?K\xbek\x0d\x87:\x0f\xad\xe1\xf8\x87\x8d\x14\xe9\xf1=\xdc\xf8\xfa\xaf\x01\x00PK\x07\x08\xf0\xb5\xf2\xaf\xef\x00\x00\x00\xbf\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcf\xc1J\xc4@\x0c\xc6\xf1{\x9e\"/0\x15o27\xbdx\xaf\xe0=\x9d\xc6\x1a\xdbI\x86\xc9LA\x9f^\n\xe2.l\xd9{\xf2\xff\xf1Q\x91w\xae.\xa6\x11\xf7GXE\xe7\x88o\\wI\xfc\x9c\x92um\x90\xb9\xd1L\x8d\" *e\x8e\xe8\x04!\x04\xb8~\xae\x13\xa5\x81z\xfb\xb4*?\xd4\xc4tX\x9f|\x10{\xf8\xcf\x8e\xb6\xf1\x8b\xe8,\xba\x9c43)-\\\xff\x0c/\x94\x0e\xe8\xdb\x1bg\xa8\xb6\xf1\xc8\x1f\xc71\x15y\xad\xd6\xcb\x1d\x11\x10/\xe0M\xdf\xfb\xf4\xc5\xa9y\x84\x80\xa7s\x11\x952Gt\x82\xdf\x01\x00PK\x07\x08Z\xec=\xd0\x9f\x00\x00\x00\x1e\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00S\x00\xac\xffresources:\n- manifests.yaml\n- service.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92?o\xa4@\x0c\xc5\xfb\xf9\x14O\xda\xfa\xe0\xf6\xaa\x13\xedUW\xa4\xc9JI\xed\x05\x03\x160&\x1e\x0f(\xf9\xf4\x11\xa0H\x9b*[mk=\xbd??\xf9\x04\xef\x19\xad\x8e\xa3\xae\x12;\xd4\x1a[\xe9 	\xad\x1a\x9c\xa9\xee\xb7\xf3\x90\x93\xeb$\x1f\x8c\xb5gc\xb8bT\x1d@\xbe\x1d\"R\xbe&\x17\xcf\xbe\x89\x17\xb2T\x84\x13\xfe;\x8c\xdf\xb2\x18\xa7\x1b\x83\xe5Oq.~C\x0d\x91W\xb6\xcdjU\x1b0\x9b\xcel\xe3{\x11\"M\xfc\xcc-\x1b\xc7\x9a\xab\xf0\x0b\x83\xc4\xa6\xc2\x85m\x91\x9a\x03\xb0\xb0%\xd1Xa9\x07\xa0\x15\x1e\x9b\xcb\xccu\xaa\x02\xf0\xa5~\xcaN[\x9bW\xbe\xf6\xaa\xc3\xbf}W6r\xd1\x18\x00\xa03\xcds\x05j&I\x9b\x9bq'\xc9\x0fA1\xfcM\x85\xe8\xae\x9b\xc9\xfb\n\xeba\x93\xcaz\x14\x8e~\xd8\x95\xe9\xa8Tn\x8do\xb2_h\x94\xe6\xc1\xe9;\xb54\xd3-\xb1\x1f\x18\xdcG\xe0\xde\xfd{v\x00jcr\xae\xe0\x969\xdcM\xe4\x01]\xc2B\xf6\xed\xad\x8ee\x13;5\xe4TR\x8c\xea\xe4\xa21\x85\xcf\x01\x00PK\x07\x08w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\x03\x00PK\x07\x08\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8bA\n\xc2@\x0cE\xf7s\x8a\\\xa0\x0b\xb1\x1bs\nAp\x1f\xa7\x9f:\xb43\x19\x92P\xf1\xf6\xd2\xd6\xdd\x7f\x8f\xff\x92\xf4\xf2\x84y\xd1\xc6\xb4]\xd2R\xda\xc4\xf4\x80m%#U\x84L\x12\xc2\x89\xa8I\x05\xd3\x07\xaf\xb7\xea2\xf8\xffqz\xef\x92\xc1\xe4_\x0f\xd4\xe4\x1dy/\xbaZ\xf8>\x88\x86\x03\x98\xc6\xf1z0Q\x88\xcd\x88\xfbao\xa7v\xac\xc8\xa1v&Y[\x98\xaeC_\xa5\x81\xa9J\x93\x19\x96~\x03\x00PK\x07\x08\xf4	\xd6f\x7f\x00\x00\x00\xb1\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe0B5\x0f\xcb\x01\x00\x00\xac\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1e\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(@\xa4D\x18\xbd\x00\x00\x00\x86\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc0\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb4\x8c\x19\x7fL\x03\x00\x00\x98	\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd4\x03\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4Eb\xc7\xf2\x00\x00\x00\xf1\x01\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80q\x07\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(l\xd7\xcf&\x00\x01\x00\x00q\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbc\x08\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x18\n\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xab$C\x93?\x02\x00\x00\xba\x05\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x8a\n\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x14\x0d\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(P\xff\xd7\xb2\xaf\x00\x00\x00&\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x89\x0d\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(	\xa8'\x97r\x00\x00\x00k\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x86\x0e\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80F\x0f\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80_\x10\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf0\xb5\xf2\xaf\xef\x00\x00\x00\xbf\x03\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80K\x11\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Z\xec=\xd0\x9f\x00\x00\x00\x1e\x01\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x7f\x12\x00\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80k\x13\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x16\x14\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x86\x15\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf4	\xd6f\x7f\x00\x00\x00\xb1\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd5\x15\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x13\x00\x13\x00\x11\x06\x00\x00\x9f\x16\x00\x00\x00\x00"
 	fs.Register(data)
 }
@@ -22,6 +22,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94ROk\xdd>\x10\xbc\xfbS\x0c\xbc\x1f\xfc\x1a\xa8\xed\xa6G\xdf\x82y\x94\x1c\xf2(/!=\x96}\xd2\xda\x16\x96%\xa3]\xe7OK\xbf{\x91\x1d\x08	i W\xcd\xec\x8cvfw\xb8\x19\x18]\xf4>\xde\xbb\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3UL\x0c\x1b\xcd2qP\x18\n8e\x83%\xd3\x15\x83\xea,M]\xdbh\xa4\xca\xc3\xe5D\x81zN\x95\x8b\xc5\x0e?.\x8e\x87\xcb\xc3\xb7\x067\x94zVA\xcbI\xaf6\x06\xbeT\xe7\xe70\x03\x9b\xf1]\x99\x9aC\xedIY\xb4V\x92Q\xeae\xee\x13Y\x17\xfa\xda\x05\xcb\x0f\xd5\xa0\x93G\x17\x13N\x89i\xcc[\x9b\x81B\xcfR\xd0\xecn9\x89\x8b\xa1\xc1k\xd5\xbbs\xf2\xf3@_\x8b\xd1\x05\xdb\xe0r\x0d\xa2\x98X\xc9\x92RS\x00\x81&n\xd6\xb4\xb6\xb0\xca-\xac'Df2\x19~\x14\xe5\xa9\x90\x99M\x9e\xc9\xec\xeb\x95\xdd\xe0\xf7\x9f\xa2,\xcb\x8f|\xa2}N\xff\xcd\x9f\xa4;\x17\xfa2\x8b\x00;\xe8\xe0d\x85 C\\\xbc\xc5Dj\x06\xe8\xc0\x88\x81A\xf3\xcc\x94r\xc7\x01\xe3\"\x1a'\xf7\x8bM\x0c\x9d\xeb\xabG\x9a\xfc\xbb{\xec\xf0\xdf\xa7\xeb\xfd\xf1\xf6\xb2\xdd\xff<\\\\\xed\xcf\xd6\xfbx\xf9v\xfd\xfd\xa2\xdd\x9f\xe1\xdey\x9foB\x96\x93\xa8\xd3E\xd9\xe2\xf4\xf8lY\x006\xc8![e\xe5\xf2\xb5r\xf5\x96j%w\xe6C\xe4\xca\xf8E\x94S\xe5\xa3\xa1\xbc\xdbV\xd6\x91\xbbl\n\xbch9?\xfc\xbb]a\x93X\x0f+\x9e\xed\xda\xe3\xfefs/\xef\xf94\xc48\x96k\x15\x86\xb7*\x9e\x9a\xd8\xc6\xb68B\xd4\x1c\xc9\x9c\xb8s\x0fl?C\\0\x0c\xa7\xff\xcb\x8am'\xf0*\xa7\xbf\x01\x00\x00\xff\xffPK\x07\x08\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2UHN-*\xc9L\xcbLN,I\xd5\xabL\xcc\xcd\xe1\xe2J\xce\xcfK\xcbL/-J,\xc9\xcc\xcf\x03\xab\xc9.-.\xc9\xcf\xcd\xacJ\x85HA\xd4\x01\x02\x00\x00\xff\xffPK\x07\x08\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x13\x83\xd5\x0b\x94=\xafg\x8au\xba\x87\xaa\xea\x15<\xbd\xf4\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06O\xab:r\xabE\x97n\x12\xda*\xd4Q\x9a!(y\xd5\xba\xe0\xdc=\xda\xa6o\xc4\xda^\x11\x0d}\x9f%\x88*\x1ba,\x90:\xe3\"\x06\xef\xcf\x1e\x1a}(i\\O,4\xd6\xcc)\x1dp\xd6:O\xb8w\xef\xb4\x04,\xd6\xfa>!\xd3\xe2\xb0I\x95\x85v\xab-\x01E\xf92?\xee\xcc>%\xe0cwG\x0b-\x9a%\x98\x80_\xe6\xc0.\xb1N\xf0\x9d\xf9\xa8\xd7o'\x96\xe3\xc8I\xe9\"\xf6M\xd3W\xfbG\xf9\x93\x9b\xdb\xb6\xb5\xfa0\xcc\xbf#s\xf5!\xf8?\x08g6\xc6\xb5\xe3=\x00\x00\xff\xffPK\x07\x08\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/bases/catalog.kubecarrier.io_accounts.yamlUT\x05\x00\x01\x80Cm8\xecZ\xdds\xdb\xb8\x11\x7f\xd7_\xb1\xa3>(\x97\xb1\xe8\xe6\xdai{zs\xed\xf4\xc6sI\xea\xb1}yi:\x93\x15\xb9\xa2\x10\x83\x00\x0f\x00\x9d\xe8:\xfd\xdf;\x8b\x0f~H\xa4\xe4\xfa.3\xf7px\xb1\x05\x80\x8b\xdd\xdf.\xf6\x8b\x9c-\x97\xcb\x19\xd6\xe2=\x19+\xb4Z\x01\xd6\x82\xbe8R\xfc\xcbf\x0f\x7f\xb3\x99\xd0\xe7\x8f\xaff\x0fB\x15+\xb8l\xac\xd3\xd5-Y\xdd\x98\x9c\xaeh#\x94pB\xabYE\x0e\x0bt\xb8\x9a\x01\xa0R\xda!O[\xfe	\x90k\xe5\x8c\x96\x92\xcc\xb2$\x95=4kZ7B\x16d<\xf1t\xf4\xe3\x1f\xb3o\xb3\xeff\x00\xb9!\xff\xf8\xbd\xa8\xc8:\xac\xea\x15\xa8F\xca\x19\x80\xc2\x8aV\x80y\xae\x1b\xe5l\x96\xa3C\xa9KO1Gc\x84\xa78\xb35\xe5|ritS\xaf`bW\xa0\x96XDG\xa56\"\xfd^\x02\xfa\x03\xf9\xbf\xdesK,*\xa1\xfc|\x00\xe4\"\xb0\xe2g\xa4\xb0\xee\x87\xfe\xec\x1ba\xc3J-\x1b\x83\xb2c\xdcO\xda\xad6\xee]\xc7\xc2\x92\x97\xc3\x8aPe#\xd1\xb4\x0f\xcc\x00l\xaekZ\xc1\xa5l\xac#3\x03\x88\xb0\xf9\x87\x97\x80E\xe1\x15\x81\xf2\xc6\x08\xe5\xc8\\j\xd9T\xaa%\xfd\xc9ju\x83n\xbb\x82\xcc:t\x8d\xcd\xbc\xf05\xe6\xe4\xff\xf3\xdb\x12\xbe\x91}x\x97\xb6\xc4U\xb7c\x16\xac3B\x95#tk\xca\xb3d\x07Y!l-q\xf7n\x9f\xf6U\x98\x87\xde\xc2	\xb2\x81\xddz\x8bvH\xe9\xce/<\x89F\xcb\xd5\x81e\x0d\xe5.\x87,\x15\xe8\xc2DX~|\x85\xb2\xde\xe2\xab\xa0\xa3|K\x15\xae\xe2~]\x93\xba\xb8\xb9~\xff\xa7\xbb\xc14@A67\xa2v\xde\xc0\xe7	XC\xb5!K\xcaY@\x05\x98;m@(\xf8\xa1Y\xd3e4P\xb8\xa2\x9aT!T	Z\x81p\x0b\xdb\xd2\x040Z\x92=\x03\xe1 G\x05\xb5\xd1\x8f\xa2 \xb0d\x1eE\xce\x0b\xb9V\xb6\xa9\x08\xf4fC\x86\x8av\x05\xb4\x81\xb5v\xdb\x0c>\x0cN\xeb\x91\xf6\x08\x91\x05\x04E\x9f;\x0b\x80\x8d6@\x98o\x93qdp\xbf\xa5\xd6R\xdeF\x80AX\xa0/\xb5\xb6T\xf4h:\x0d\x8d%c\xc1m\xd1\x01\x9a\x11\xc66FW\xe0\xb6\xc2v\xf4?(x\xf9\xf2\xf5\x17\xacjI/_\xc2\xc7\x8f\x1fwX\xc9\x1e\xd9\xbe\xdb\x1a\xbf\xe3\xe7Ie\xc3\xdb\n\xad\xb7J\xba\xed3KX-\x11\xbc\x0f\x01\x18l\x05\xe8Y\xf5*\x00\x00\xf7\x84UX\xeb\xab\xfaZ\xf5H\xbe\xfa\xee\xaf\xdf\x9e\x01Bn0\x7f\x80\\W\x15\xaaBC\xa3\x84\x83\xcfh\x81-\x81A\xaa\x8d\xb0Z\xc1z\x07\x08\x95\x90\xc2\xa1\xd9A\xae\x1b\xe3\xb2,Kj\xef\xf3\xfa\x81\xed\xfc&\xa8\xdfx\xa3\xbf'\x85\xca1X\xf3v_mtM\xc6\x89\xe1\xb3=\xf4z\xb3{b,\xd8\xa8\xc3.(\xd8\xdb\x13+\x91\x92\xf3a\x0dz\x83\x07\xbd	\xdak-\xdb_\xb3\x01a\xe0M\xa8@\xaf?Q\xee2\xb8#\xc3d\xd8\x0f6\xb2`\x9b}$\xc3w#\xd7\xa5\x12?\xb7\xb4-C\xc3\x87J6L\xb7G\xd3;;\x85\x12\x1eQ6t\x06\xa8\n\xa8p\x07\x86\xf8\x14hT\x8f\x9e\xdfb3x\xab\x0d\x81P\x1b\xbd\x82\xads\xb5]\x9d\x9f\x97\xc2\xa5`\xc7\xfaa\xd5\xec\xce}\xdc\x12\xeb\xc6ic\xcf\x0bz$ynE\xb9D\x93o\x85\xa3\xdc5\x86\xce\xb1\x16K\xcf\xba\xf2\x01/\xab\x8a?\x98\x18\x1e\xedb\xc0\xeb\x81\x8b\n\xc3[\xe6\x11\x0dpD\xe1k\x85\xf1\xd1 E\x074O1:\xb7\xaf\xef\xee!\x1d\xed\x95\xb1\x8f\xbe\xc7\xbd\xe7{:\x150`Bm\xc8\x04%\xc6\xcbH@\xaa\xa8\xb5`\xe3\xdc\x12\xe4R\x90\xda\x87\xdf6\xebJ8\xd6\xfbO\x0dY\xc7\xba\xca\xe0\xd2g\x00\xb0&hj\xf6\xa1E\x06\xd7\n.\xb1\"y\x89\x96\xbe\xba\x02\x18i\xbbd`\x9f\xa6\x82~\xf2\xb2\xbf9\xa0\xd6[H\xc9E7\x06\xfa\x8a.\xe6\xae\xa6<.\xac\xe3\x9d)\xc8\n\xef\xf3\x1c:\xf6\x80\xad\xa7\x1b\x10\x1b\xbf\xac0\xc9%\x1c\x04\x99\xe4\x8b?8\x86\x0f\x85\xb2\xbd\xec\x00\xb6M\x85\n\x0ca\x81kI\xbd\x04\xe3\x90\xa8C!m6?X\x9bf\x11\xf6\x98\x19Y\xde\xe3\xf6\xaa\xfb\xc1F\xee\xaf\xb9V\xa5\xbf\xc6\x81\x03*\xfaO\x8cR\x84\xe0~\xc8\xdb\xb3\xc8)\x1b\xddU	\xf5\x86T\xc9	\xc1\xab\xd1\x0d\x13\xd6\x91F\xdf\xf7?A\xb0nw\x12\xccC\xbfl\xa1\xe7\xc0\xd3\xfa\xcd\xaf\xca\xb9\xc8\x9f\xa4\x8b\xeb<(\x01\xc1V(%\xd8\x9f\x1ad\x83\x95\xba\xd4	a{\x8c\xcf\xe3\x86\xc1c\xdc~\xdb\xd5\x01\x801\x9d\xe0SE\x85%\xf9\x87\xc7\x0f\xe6\xb1\xd1\xa6B\xb7\x82\xf5\xce\xd1\xe4\xa6\x13@\x81\xbff\x85\xc0{\xde\xf74>\xdf\xa6\xfd	\"\xa1r\xd9\x14TD\xae\x85\n\x8c\x03e\xe54\xf7\x10v\x9f\xd7\xaa<\x8b\xff~\xaa\xa9\xfd\xdf>N\xb1\xfb\x04\x99\xd87\xb3\xe7\x19\x17h\xe9\xd9\x9bXj\xc18bv\x07\x1e2\x0d\xb6\x9b'\x98\xdd\x1b6\xaf\xa8\xe8M\xc3v\xe7\x83\xf5\xefV\xb77~\xb7\xba0NX\x9d/\xab\xaf\xfe\xaf t\xb7\xf7Ht\x82B\x95\x92@\nE\x81\xe8o\"\x0eM\xc3\xba\x84\xc3\x9a\xbb\xbf\xba\x0f\xcc\xc1\x96#\xc0\x1eT\x1ea\x0cP\xbc\xe5=!\x9a\xc5\xac\x82\xab>{\x08\x82pT\x9dL\x1dRv\xc4T=g\x83\xa2\xf9\x11\x85\xf4A\xb4\xad\xa8\xf9\xf01\xbcI5\xd5\xd8Y]\xdd4\xba\x18\xea\xa8\x91\xa5\xa3\xca\xa9\x84\xba\xf6\xc2\x8dh6<\x88\xc6\xe0no\xcd6\x1e\xf2S\xf8\xde\xc5m\xb0\xd5\xb2\xe0\x8c\x9bKh\xc5\xc5s\xac\x8ft\\\xf7Uv\x85\xaa\x1c\x14\xe1i\xdc\xfe\xfd\xe22\xc0\x95*/\xack\xb9\xe3\xc4\xfd\x99\x9a\x8a\x8cA\x97nv\xcc\x0dy\x03m|'`\xd4\xecE\xc1)<\xfbq&\xc0j_\x8b\xd0\x00a\x06\x05\x85\xda\x02\xee\xd9\xc0rT@\xc2m\xc9x4`\xdc\x93\x14\xc2\xf0\xa1\x177\xd7]\xf1\x13\xf9:cV0\xd6R\x1bm@i\xb5L\x00\xda&\xdf\x02\xee\xd7Oa0\xfb>;\xf5\xcd\xc5\xd0B\x1c\xb3\xbbSQ	k\xf1\xbdoONx\x92\xe1]\xb8\xb9\xf6\x9b\xa3\xea\x19P\x16*\xb0\x10}N+\xda\x98\xd2\xc3\x88\x86\x96\xc1\x15m\xb0\x91\xbeZ\x83\xf9\xdc\xcb\x1f]V\xbaO\xc9&\xbb\xbd\x93T\x99\x86Yc\x9ea\xe3\xb6\xda\x88\x9f}\xe9\x1f\x8b\xb9@\xfc\xc7\x04Z\x90\xa2%>A\xf3dl9\xac\x99\xbb1\x00\xce\x17\xcfz\x93\xd4\xbf&6\xa7\x0e\xa9\x0c\xde\xfb\x96@\xecmL#\xb7\xde\xc5\xe6T\x8b:\x1a\x829\xcb5?\x83\xb9\x17k\x1e\x9a\x0f\xf3!\x92\xf3\xe9\xe8{\x1d\x14w\x11a#\x03\x85&\x0b\\:\xf7\x9a\x16\xbc\x85\xe5M\x0d\x0e\xb7\x9dN4z\xa4\xe2\xdd6Ts\xe4\xe2\xebb\x8c6\xcfF|\xbfK\xd6\x1f\x03\xc4\xdf\xb5U\x0dM\xa2\xfeK\x98\xf0\xdd\xc8's\x12z\x97\x077\xa4\xedA%\x1dLf\x13a<\xc4\x1e\x0c;\x89\x96\x89\xb3\xe4'\xa2\x1d\xb0G\x19X\xc2H\x0f\xa6\x1b\xc1\xf3\x88\xa0n\xaaj\xb7\xdb7\x86_U\x83\xc7\xb2\xb1\xa5\x97otA\x8d\xa5\x12'R\xb0\xe7\x04\xc1q\xf6\x96!N\xed\xcd%\xef1\x98\x9ej\xd6\xf8w\x04Oh\xd7\xf8}\xfd\x14#X\x05\x17\x1e\xbf\xa0e\x93k\x15\x1a/\xa7b\xfbe\xbbq\x9f\x87\xd0\xf4\xece<\x81\xa9\xf0zmD5z\x03\x98\xd8\\X\xc8\x1bc\x88\xfd9K\xf0\xdc\x00\x1f\xc9\xb5Lv\x91>6\x89\xbc\x93\xf7=\xc2x\\+\xf8\xa8\xb5\xa6\xa6\xc7(\x9aa\x9c\n\x9f\x12\xad\xbb7\xa8\xac?\xe5^<\xd1;\xbd9x\xac\xed:\xa1u\xe0x\xc2\xcbq\x94\x7f\x1e.P\x89\xcdR\xcd5B\xb0!\xa7\x01\x95\xe6\xd4d\xea\xaa\xa6\x9a\xb1@GK>\xf2yW\x9a\x8bFk\xb1|\x9a\xe4o\xc3\xdeA/\xaak\x03ViU\x15\"G7]\xa7\xb6}A\xc0\xb5n\\\x84\xadE\xf4\x99\xde\x89\x1d\x00\xda\xa9Zm\xbf\xce\xf0[\x93 /\xd6F\xd0\xe6\x9bH\xa03\xc4\xa4\xc0\xc5\xb4\x0b\xfe\xb5x\x1fs2\xdd\x18\xa6\xca\xc1F\"\xef\xd1bbxjY>\xf3\xe6\xa47\xf0bqo\x1aZ\x9cMJ\xb0\xf8\x07JK\x8b3X\xfc\xa8\x1e\x94\xfe\xac\x16\xdf<[\nw\xa4\xf90\x90\xc1w\x1d\xa2\x04\xae\xd7\x81H\xa9cO\x8e\xe8\x0e\xe4~\xc5\xd3\x8d\x17\x8b[\xc2b\xf7l\xbe\x8f\xc7\xb5C/1\xba-Z\xff\xe8Z\xb0\xab\xd1%\xdb\x7f\x01=\\r\xe3\xcd\x8b\xa3qs\xba@<\x92\xf7\x8cg<\xfd\xae\xb3\xea%d]J\xe4k\xc4\x9e\xd6F\x98\xadPa9V\xdc\x1c\xf7\xcd\xd3\x99\xe2\xd7k|\x8c\xa6)G\xc0N\x81\xfd{Rd|(=\x81\xec?\x0f\x1eH\xf0V\xda\xfat\x9d\xa3^\xd9\xad\xa6\x13F\xe4\x0c.\xaa\x0b\x7f\xa1\xb8\xa0\xdeW2\x87\x90\xa7\x90!\x94\xfb\xcb\x9f'$\x15\xcaQyP[\xfb\xef&NHw\xf5\xfa\xe6\xf6\xf5\xe5\xc5\xfd\xeb\xab\x0cnx\xff~\x12\x92\xc2\xba\x14\x1b\xcaw\xb9\x0c\xaek\xcc\xc2ShO	\xf6\xa5V\xd6\xbf\x9c\x0e/\x16\x05\xc9\xa2w\x9c\xff\x84\xe1\xb3\x90\x12\xd6|f\xa5\xc7\x11C\x0bVk\xc5\x7f9\xaeR\xe8\xcfU\x94oQ	[q\xc8\xad\xb0\xeee[<\x13\xcc\xc8\xc2\xe7-\x8d]\xdf\xda\x88\xee\xddi\xb4\xe8]\x16\xba\x0b\xcc\xbf\x92;\xaf\xa9\xd8S\x83\xba1\xb5\xb6t\x16>\x84x$\xb3s\xdbq\x97D\xd2\x124\xb6\xe7\xd0G\xee\xd0\xa4\xa9\x8fZ\xed\xc1d0\xae\x158\xd3\x04-X\xa7\x0d\xa7\x02\xbd\x99f\xdd\xbe\x88N\xfa\x8f\x91\n\xfe\xf3\xdfY\x17\xb40\xcf\xa9vT\xf4>E\n_K\xcc\xc3\xab\xbf\xf4\xf1\x92\xff\xd9\xcbh\xe1_\xff\x9e\x85\x83\xa9x\x9f>F\xe2\xc9\xff\x05\x00\x00\xff\xffPK\x07\x08m\xf7>\x01\xa2	\x00\x00T&\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x004\x00	\x00crd/bases/catalog.kubecarrier.io_catalogentries.yamlUT\x05\x00\x01\x80Cm8\xec[Ko\x1c\xb9\x11\xbe\xebW\x146\x07\xed\n\x9aV\x94\x04A2@\x0e^I\xbbpb{\x05I\xf1%\x0e`Nw\xcd\x0cm6\xd9K\xb2G\x9a\x04\xf9\xefA\xf1\xd1\xef\x97WN\x10\x04\xe2E\xd3\xcdb\xb1^\xac\xfa\x9a\xa4X\xc1\xdf\xa36\\\xc95\xb0\x82\xe3\x93EIO&\xf9\xfc\x07\x93puq\xb8<\xf9\xcce\xb6\x86\xab\xd2X\x95\xdf\xa1Q\xa5N\xf1\x1a\xb7\\r\xcb\x95<\xc9\xd1\xb2\x8cY\xb6>\x01`R*\xcb\xe8\xb5\xa1G\x80TI\xab\x95\x10\xa8W;\x94\xc9\xe7r\x83\x9b\x92\x8b\x0c\xb5c\x1e\xa7>\xfc:\xf9M\xf2\xc7\x13\x80T\xa3\x1b\xfe\xc0s4\x96\xe5\xc5\x1ad)\xc4	\x80d9\xae!e\x96	\xb5Ci5G\x93\x84G\xc77eZs\xc7\xf7\xc4\x14\x98\xd2\xfc;\xad\xca\xa2\x1a\xd4\xa5\xf2<\xa3\xa0\xcc\xe2N\x11S\xff\x0c\xb0\x02\xe6&\xf6\xbf\x1bcW\x85V\x07\x9e\xa1v\x9d\xc1:~\x8a\x1bi\xf5\xd1\xbd\x16\xdc\xd8\xbf\xf4\xba\xdepc]w!J\xcdDW\x1f\xd7e\xf6J\xdbw\xb5d4{\x8a\xbe\x8b\xcb])\x98n\x8d\xa3\xf9L\xaa\n\\\x83\x1bU\xb0\x14\xb3\x13\x80`\xdc\xc0e\x05,\xcb\x9c\xc3\x98\xb8\xd5\\Z\xd4WJ\x94\xb9\xacf!\x9aOF\xc9[f\xf7kH\x8ce\xb64I\xb1g\x06+\x8a\xe8\x86{\xd7\xd9xm\x8f4\xbf\xb1\x9a\xcb\xdd\x08\xbf\x02\xd3d\xc3\x0c^\xdd]'\xc4\xa5\xc7\xf4{f\x10\xae\xee\xae\xbf\x8c\xad\x17\xd3\xa2d\xd2\x8e\xb2~p\xdd_\xcc<\x86v\xd2\x0b\xcb\xde\x0c\xafv\xd8c\x9d1\x1b_z\xa2\xc3%\x13\xc5\x9e]\x86\x97&\xddc\xcej\xfb\xab\x02\xe5\xab\xdb\xd7\xef\x7f{\xdf\xe9\x00\xc8\xd0\xa4\x9a\x17\xd6\xad\x96o\x9a!\x15\x97\x98\x81\xbdz\x04\xab@m\xb7\xa8\x81\x91\xb2\xee\xd1\xeeQ\x07\x03\x98\xe4C\x83'\xc0\x07\xf8 \xe1U+BA\xe3\x165\xca\x14\x0d0\x17p\xc29\xe5\x9c\xe2\xc7@\xb4\x08q\xe6\x16\x98\xcch\x95\xa8G\xd3el\x15\x08\x9es\x0b[\x8e\"\x03\x96\xa6h\x0clU-\n\xcd}vv\xcf\xf3B \xdc<1\xfa{v\x06\x1f?~<\xb2\\@#3uy\x0f\xaf\xe7\x8bh\xdd\x81%Y\xc9\xbd\x8e\xbe\xe8\xf1Te\xba\xcf6&\xc1r\xf5\x88\xc6\xae.\xc1e\x11\x80\xd6X\x80\x8c\x9bB\xb0\xe3;\xe7\xd0+\x1au\xfd\xbd\xefh\xb8\xa8\xcb\xfda\x8f\x90\xaa\xbc\xd8\x1e)(\x18-\x03\x00\x08\xab\xc1\xf3\x0d\xf9\xad'F\"\x94\xca\x0c\x92]\x06\x9cwv\x16,\x07\x8f\xdc\xee\xbd\xc91\xf3F7\xc3\xd6\x9c3_w\x96%\xd6\\d\xbe.cXj\xcd\x19\xf3\x0d\xf1\x9d\xb3&M\xa0\xf9\x01\xbd\xed\xf1\xa9P&\xfc^\xd5\x89s\x88\xb1#\x88\x81\xe6\x9b7\xf6\xba\xea\xee&\xbd\xd2\xa0&y\x1c\x93\x1e\xcb>}\xc1\x8cyT:\x1bd\xd8\xc8\xca\xb1\xbf\xcb\xb3O\xbee\xe5\x93U\xf2U\x96iZ\x85\xe3|Y\xa0\x18\xd4|h\x80\xda\x18\xd4\x07\xcc~D\x89\xda\xa5H\n\xb9o\x1a\xc3\x0b\xad\n\xd4\xb6QX}kDd\xeb}\xc7\xf5\xa7\x94\x10=\x1dd\x04:\xd0\x80\xddct\x12f!\x8b\x82\xda\x82\xdds\x03\x1a\x0b\x8d\x06\xa5\x87!\x1d\xd6@dL\x82\xda|\xc2\xd4&pO\xc2kC5\xb7\x14\x19\xa5\xd2\x03j\x0b\x1aS\xb5\x93\xfc\x1f\x15wC\xf9\x8c\xa6\x15\xccb(\xe1\xcd\xe6\xea\xa9d\x02\x0eL\x94x\xee\xf2b\xce(\x99\xd2<P\xca\x06GGb\x12x\xab4\x02\x97[\xb5\x86\xbd\xb5\x85Y_\\\xec\xb8\x8d\xb8+Uy^Jn\x8f\x17.\xbf\xf3Mi\x956\x17\x19\x1eP\\\x18\xbe[1\x9d\xee\xb9\xc5\xd4\x96\x1a/X\xc1WNx\xe9\xb0W\x92g\xbf\xd2\x01\xa9\x99\xd3\x8e\xb4\x83e\x0f\"\x92\x99\xf4\x05\x01\x1a\xe0\xae0\xb8\xe1^\x97\xda\xe4\xf4\x8a\xactws\xff\x00Q\x80\x9e\xb1\x9c\x9b\xbc\x07\xea\xa1\xa6v\x06\x19\x8eK*b\x8er\xabU\xee\xb8\xa2\xcc\n\xc5\xa5\xa5\x87\x1e\xd3Tp\x94\x16L\xb9\xc9\xb9\xa5(\xf8\xb9Dc\xc9o	\\9X\n\x1b\x84\xb2\xa0\x9a\x9c%\xf0Z\xc2\x15\xcbQ\\1\x83\xde\x15\x03>\xfdO\xb9\x86<`Vd\xee\xe5\xcei\xa2\xec\xfe\x00o\xcdVW\xc4\xc0\xcd\xd6\xf2f3\xa3\xdf\x17\x98\x86\xdeMX`\x19\x1a\xaei\x01Xf\x91\x96M\x93>\xe90\x1e[\xe4\xd0H\xd3\xbd\x8e\x8e<\xdf{:\x8a/\x9a\xbf\x94\x19jq\xa4\x90\x8a=^K\xeaf\xd6\xc5\xc6\x00K\x18@3.\n\x06h\xa7\xa4\x86XD\x06{\x00r.\xdf\xa0\xdcQ.\xbc\x1c!\x19\xf5\xa5o\x14\xa2d\xe1\xe1\x19V\xd0\x01\xb2\xb1\x8d:\x1c\xea\xa26g\xeakG\xe6\x90#\xe3\xd2\xdb;Ur\xcbweH\xe2V\xc1\xce\xa7t\x0c\xd4Y\xfb\x03pP\xe8j\xa9V\x1e\x0bIy*v`\x81'B\x81\x1e1tK\xb7\n\x0e?\xeey\xba\x0f\xc5\x19\x1e\xb9\x10\x94\x00B\xb6\x01.C\x90;\xd5F\xf8\x02\x01\xdf!q\xa9q\x8b\xf9\x88\xb4\xd0\x95)\x14\xb0\x1b\xa7\xc5\x953\xb4[\x9f|\xcb\xb1#\xa8U\xa3,\xa3\x19:\xc2O	9oY\xdf\x02\x84\x99\xa0\xe8hTKO\x92D\xd9i]\x86RZ\xdbz\x92)|\x9120ow\xdfZ\xb2\xfe@\xd2\x11l\xa1\xd4\xe2\x05wi%|/\x05\xcc3\xc3\x11\x80A\x8a\x9a\xd6\x8bWwZ\xca\xa5\x86\xf7\xad\xc2U\xb3\x94\x1d\xd5\xfe|\xff\xd3;\xa7\x19&\xbb$\xc0G\xa3r\xf4\x12\xd2/Sn\xdc\xc3\x02\xce3\xf9\xaa\xd9\xa6sW\xb3\xd5\xa0q\x86t2\xaf5[\xce\xe5k\x17\x03\xa3\x89\xd77\xcf\x90i\xcd\x8e\x13t\xed]\x92\xb16\x11\xfb\x91\x81\xcfuX\x7f?\xcf\x99\xdc}TW\xa5,\xaem\x9f\x86\x81\x15\x85p3\xa8\xc4}\xfd\x18\xfa~\x08S\xcd\xf0%\xf8\x14\xe0N\x944\xa3U\x96\x97\xc2r\xfaR\x1c\xc8F\xe6+\xac\xb9\xc5\xe1\xf35\x1d\xb8$\x10W!?M\x92D7\x8e\x12-\x88\xcfi\x81\xe7\xea\xbd\x0f\x80\x81\xce\xc9\x99\xc7p!t\xa3\xf6m\xdc\xb9iU\xfdj?'Doo\x1f\xb3\xdb\xb6J;B\x02\xeb<\xad\x06\xfc\x92\xa2\xde\x14o\xc4\xa2\x1d\xd4R=D\x90(\x94\xdc\xb9\x8f\xad\x0c-\xe3\x02\xb3\xe6\x88QW\x06]\x83\nc\xa1\xff|\x84\xd7\xde\xdeX\xa4bM\x1fU\xdc\x979\x93+\x8d,c\x1b\x81\x0e\x15FX5\xaa\xe0\x7fA3\x9e.\xf4\xda\xeb\xd4\xbb\x8b\x81\xc9\x99\x10`~.\x19}W\x08\xb5S\xd1\x13fZ\xde\xb9@\xa26\xb6\x06\xaa\xfe\x96\x99)\xe2\x83}y\xcev\xe8\x86O\xe5\xc0\xad\xd29\xb3k\xd8\x1c\xed0\xf4\xf5m\xd6l\xe0\x96l\xc6\xd9\x03Q.\x95\xf7m\x1c\x11\x0d\xc6e*\xca\x8c\xf2\xba\x93\x9eK\xaf\x80\x83\x01\x939\xd5\xd1_\x14rw\x1e~~*\xb0\xfam\x0eS\x89{\x81n\xf3\xd9x\xe5\x04\x9d\xe8\xae\x8c3\x19\x98\x13i\x98\xe2jQ`\xbe\xa1\x00\x0cA\xb0-)2\xdd\xf6\xccK\\\xbe\xc4\xe5@\xf7\xb3\xe3\xd2\x1d\xeb]\x7fa\xc9\xbb\xef\x0c\n\x89\xd4\x9f\xca\x08.\xd1\xb3\xfd\x9f\xaazs8\xa7Q\x13G(\xba\xa6\x1a \x9b0\xf7\xf8\xfc\xab\xb8\x175\xd0\x13\x81P\xa7k|{\xcd\xed\x7fw\xe7\x18\xdf`s\xe4\x8d\xedN\xe7\x8b\xb8{\xfe\xfcM\xb6TI\x7f\xba;\x98\x8a\xdabU\xa4]q\xfc\xe66\xb0\x03\xe3\xc2\x81\x0d/\x9f?\xcf\x1f\xf4\x95\xda\x02k\xc9|j -\xb5v[\xb1\xa4\xd3P\x98M|M\x8c\xda\xaf\x12\xba\x86\xaf\x1e\xf2\x99\n\x91\x86yG\x82\xb62\xd0\xe2=\xa9%\xf9]0c\x1f4\x93\xc6\xb1~\xe0\xe30\xaf[|z\x03+H\xcb\x8c\x05K/\xc2\xae\x9c\x17{*\x01z>a\xa7\\QR\xf0\xd1f\x150\xe9\xce\x7f\xc7\x93o, \x19\xb3\xb8\xa2iG)\x17\xe4\xd9\x1c\x8da\xbb\xa56x\xeb\xa9[@\x17*\xa0\x9b\xc7^\x99\xf1\x94\xd9\xe9\x8f\xca\x18\x0bl\xa3J\x1bLXYw\\\xf9\x05*idf<Yw4\xbas\xc4Q\xa1o7\x9a\xe3\xf6\xbb\xc0\xa2\x8e\xd3\xe8\xd2\xd3\xa9/\xd2\xaf\xa9\xc3p\xae\xaa[\xbb\xe4\xf8\xd8	:\x84H\n\xb5\xa3\x12\xfd\xdc\x85\x99\xdaN(\xf0\xed\xe9\x83.\xf1\xf4\x1cN\x7f`\xc2\xb8\x1f\x7f\x95\x9f\xa5z\x94\xa7\xdf=K\x1b;\x89PZ\xba8h\x124\xb1\x0d\x98\xd2\xbd?\x11\x94\x9a\xd0&$\x17q\x84oO\xef\x90e\xc7g)1\x87GV\x03\x89e\x944,\x94\xd1~\x1f~\xa3\xdd\xa6{\x95\xa7\xddm\xc7 \xcf\x0c\xe0\x99\xda\x0b\xe9\x1f\x1a\xcf\x16\xad\x9f\xfa\xe7\xcc\xc1\xaf\xb92\xee\xc0\x96J\xce\xae\xee\x8ds\x0c\xca\xee\x97b\xa7\x06\xc0\xe6\x18\x83<\\Y\x1b\xf2p\xcc\x97\\\xda\xdf\xffnTo.-\xee\xc2\x05\xb1fs\xe7\xf6\xb3\xca^\xdf\xdc\xde\xdd\\\xbdz\xb8\xb9N\xe0\xd6\x9d\xf4w\nu,\xb1\x82o1=\xa6b\xd8C\x15\xa8h\x1c\xb8&T\xfe\x0d\xcf\xaa\xc3Uw;\xa7\x9e\xf0\x1c\xb8u\x87%\x83\x1c7$I\xae\x08\xb10\x03F)I\x7f\xa9\xc0\xa0G\xa69\xa6{&\xb9\xc9\xa9\xf6\xe4\xach\xe0\x92\xb1\x93\x0d\xbfL\x0c<\xeeQB\xa1y}\x8a\x1c\xca\xef1\x81\x07\x12\x95t\x90\xe2\xe8\x9c\x170$\x14\xa5.\x94\xc1\xe1\x95K\x84x@}\xb4{b\x89\xc2 \x94\xa6\x91\xc5\x06w='\x17o\xbc\xf9\xb7\xe4<\xf3\xb6\xa6\x85\xbd\xf2\xe7#\xfe\xc0\x9f\"\x88b\xd4W+z{\xdb\xbcQ\xd8m[\x96\x92\xfc\xee>\xd7\x9eYg\x88\xed\x165f>d\x17`\x999$\xc3\n\xfe\xa3\xbb+9\x95s\xa72\xda\xd0\xdd\x81\xd8f\x07O\x9d\xb3\xce\x0e\x0e7)\x7f\xe9p\x8d\xbb\xa5\x1fcw\x8e\xb4}A.\xbc\xab0\xe5\xf8\xe9\xd5\x9c\x0b`\xc6\x10\xb0\xec\xe3\x0c\x96(=_\x81&\x0e\xa0a>\xf9\xcf\x1f\xab|\xc9\xe9\xe9\xd5\xddu\xbc\x01\xe4\xd7\x11\xad\x840A<\xe2H#\x04|\xeeI\xe8\x9c\x0b:\xb2\x9d\xbek\xec\xc7F\xa1\xce}\xea\xf1\x17\xf3\xd6p\xb8<\xaf\xae\x8cMa\x0cj\x87\xcb\x0dZv\xd9\xbd\x16\xd2n\x0b\xfc\x0b\x03\xd7K\x87Z\x1b\xfa\xb5oR5\xcc<\xbdy3\x1b\x0d\xb1=\xad>\x97\x1b\xd4\x12-\x9a\x95\xabh\xfa\x80\xab\xd2\xc3\xc2U\xbcGgu9\xb5\x97e\xac\xd2\x93\x1f\x19\xd0\x87\xb4nD\xfc\x94p\x07w\xb5\xb7\xea\x13\xeb\x19\xdf\x94\x06\xb3P\xa6h\xa4q\x85*u\xb7\"\xaa\xcbN\xee\xa3+H\xe8N\xee\x06.\x16\xb5[^\x1awV\x87O,%|I\xc0:J\xe6\xeet\x06n\x7f\"\xb3,\xf1\xc3F)\x81l\xfc\x83q~\xe9\xcf,\xfeE\x0e\x9f\xc2\x7fs2\xac\xaaz4\xd2M\xd5f\xa4kT\xeeU\xa8\x14#\x9d\xbe\x0e\x8ctN\x1c\x0eN\x9a\xa2\xba\x95>\x0b\x16\x1e\"\xe5,T\x08W\xd9=(\x18\x94\xf7\x05(\x0c\x92\xbc\x00\x85^\x9bUzn\xa5\xc2\\\xae\x98\xcd\x14/@\xe1\x05(\xf4\xdb\x0bP\xe8\xb7\x17\xa0\xd0k\xff'@a\xa4k\xe0\xb5\xdf\xd3jE~\x8c\xf2\xd6\xbbrS\xc5Ym\xb9\xb0\x0d\x0c\xff\xfc\xd7I\xbd#\xcc\xd2\x14\x0b\x8bY\xe3\x1f\x0e\xfd?\xfc|\xe3\xff\x89#\xfe\xa3\xa2{l\x9c2\xc1\xdf\xfe~\xe2'\xc7\xec}\xcc\xe1\xf4\xf2\xdf\x01\x00\x00\xff\xffPK\x07\x08H\x08\x104\x0c\x0b\x00\x00R:\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x006\x00	\x00crd/bases/catalog.kubecarrier.io_catalogentrysets.yamlUT\x05\x00\x01\x80Cm8\xec;Os\xdb\xb8\xf5w\x7f\x8a7\xfe\x1d\x94\xcdX\xf4/m\xa7\xd3\xea\xe6\xb5\xbd\x1d\xb7\x897c\xb9\xe9\xa1\xdb\x99\x85\xc8'\x12k\x10`\x00P\x8e\x9a\xc9w\xef<\x00\xa4H\x8a \xe54;\xd3\xc3\xe2\xb0k\x11\x0f\x0f\xef\xff\x1f\x009[.\x97g\xac\xe2\x1fP\x1b\xae\xe4\nX\xc5\xf1\x93EI\xbfL\xf2\xf4'\x93pu\xb9{s\xf6\xc4e\xb6\x82\xeb\xdaXU>\xa0Q\xb5N\xf1\x06\xb7\\r\xcb\x95<+\xd1\xb2\x8cY\xb6:\x03`R*\xcb\xe8\xb3\xa1\x9f\x00\xa9\x92V+!P/s\x94\xc9S\xbd\xc1M\xcdE\x86\xda!o\xb6\xde\xfd\x7f\xf2\xbb\xe4\xcfg\x00\xa9F\xb7\xfc\x91\x97h,+\xab\x15\xc8Z\x883\x00\xc9J\\A\xca,\x13*Gi\xf5\xde\xa05I\xf8\xe00\xa7Lk\xee0\x9f\x99\nS\xa2 \xd7\xaa\xae\xdaeC(\x8f\xb5!\x95Y\xcc\x95\xe6\xcd\xef%0\xb71\xfd\xd5Y\xb7\xac\xb4\xda\xf1\x0c\xb5\x9b\n\xb2\xf1\xe8o\x89\xaa5Z7#\xb8\xb1\x7f\x1b\x9b}\xcb\x8d\x87\xa8D\xad\x998\xe6\xc9M\x9aBi{\x7f\xa0n	)\x86\x19.\xf3Z0}\xb4\xf0\x0c\xc0\xa4\xaa\xc2\x15\xb8u\x15K1;\x03\x08Bvx\x96\xc0\xb2\xcc\xa9\x8d\x89\xf7\x9aK\x8b\xfaZ\x89\xba\x94\xed.\xbf\x18%\xdf3[\xac 1\x96\xd9\xda$U\xc1\x0c\xba\xd9F	k7\x11>\xd9=\xedh\xac\xe62\x1f\xc1Qa\x9ad\xdc\xa4j\x87:Iu\x96\x10\x8a\x1e\xb6\xeb\x87\x9b\x93P5v\x96\x1c\xd9H\x0f\xddU\x8e=t\x19\xb3\xfe\x83\x9f\xde\xbda\xa2*\xd8\x1b/\xca\xb4\xc0\x92\xad\x02\xbc\xaaP^\xbd\xbf\xfb\xf0\xfbu\xef3@\x86&\xd5\xbc\xb2\xceT\xcf\x07\xea\x84\x92I\x96\xa3\x016t\x91\xc0\xb5\x03b2\xf3\xb6\x8d\xa6E\x0b]\xcb\xe0h`\xab4 K\x0b\x12	4B\xc3\x0c\xb6Z\x95`\x0b\x04\x83\x02S\x8b\x19\xacQ\xefx\x8a\xd7\xa26\x16\xb5I:8\x7f\x92\xf0\xfa\xf5\xed'VV\x02_\xbf\x865b\xcf\x00!Si]\xa2\xf4^\xea\xb6,\x95Fr\xd4-\xcfk\xed?gh\x19\x17=\xbc?\xff\xfc\xf3\x9e\x95\x02\xba\x11c\xdc\xad.\x1b\x19Ox\x87\x1fm\xe8h\xd5\x93\xaa:-\xb2\x8d\x01\xe7\xc0\x03\x10 \xa1T\x82\xed\xef\xbd\xe1\x10\xec\xcd\xf7G*\xeal\xf0X\x10ge\xb5\xdd\x93\x1d\xb0\x0d3\xe8\xb1\xb4\x9a\xf1xS\x9d\xad\xc2\x92>\x1dI\xf8\xc3G\x8bf\xfcD\xff1=\x1d\xac\x9dj\x94^\xc1\xe7/$\xab\xf3\x16\xbc\xd2\xaaBm\xdb\xb8\xe2GG\x8c\x9d\xaf\x03[[\x909z(\xc8(\xe2\xa2qv\x10\\\x1a\xb3`\xc1\xa0\xb6`\x0bn@c\xa5\xd14\xda\xed!\x06\x02b\x12\xd4\xe6\x17Lm\xe2L\x08\xb5\xa1@S\x8b\x8c\xf4\xbfCmAc\xaar\xc9\xff\xdd\xe26`\x95\xdbT\x90\xf1\xda\x01N\x17B$\x13\xb0c\xa2\xc6\x0bg\xe7%\xdb\x83F\xda\x05j\xd9\xc1\xe7@L\x02\xef\xc8\xde\xb8\xdc\xaa\x15\x14\xd6Vfuy\x99s\xdb$\x9cT\x95e-\xb9\xdd_\xba\xdc\xc17\xb5U\xda\\f\xb8Cqix\xbed:-\xb8\xc5\xd4\xd6\x1a/Y\xc5\x97\x8et\xe9\x92NRf\xff\xa7\x83\xff\x99E\x8f\xd6\xa3\xe0\xe2\x873\xd1	\x0dP\x08\x07N\xde\xed\x97z.\x0e\x82\xa6O$\x9d\x87\xdb\xf5#4[;e\x0c\xa5\xef\xe4~Xh\x0e* \x81q\xb9E\xed\x95\xd8\xba;\xca\xacR\\Z\xf7#\x15\x1c\xe5P\xfc\xa6\xde\x94\xdc\x92\xde?\xd6h,\xe9*\x81k\x97\x85a\x83PW\x14\xfd\xb2\x04\xee$\\\xb3\x12\xc553\xf8\xab+\x80$m\x96$\xd8\xd3T\xd0- \x86\xc0^j\x9d\x89&\xb1\x1fFO_\x83X\xb3\xae0\xedyN\x86\x86SH\xa5\xdc\x86\xe4\x11\x83\x05I\x0f\xf5\xb8\xeb\xfaM5\xdf\xe1\xf0\xeb\x80\x98\x1b\x07\xe4j \xc6\xa5'\xa0\x1fg\xad\x82\x1c%j\"\xc6Cg\xfd$2\xb4\"\x1a\xad}|\xcf\x0c^?\xdc\x10bf\x81\xe9N\x82\xd8\xec\xbd-M\xb2\x07\x93,\xd2\xc0O\x952#l\xc2\x90\xd5P\xe7\x19x.xZ\xc0\x96\xa3\xc8\x0c<s!\xc8\x0e\x83\xc9\x03\x97A	\x8e\xd3Q\xac@\xc9\xef\x98L\x1a\xdcb9J%\x0c\xa9	1\xf3\xd6Q\x7f\xed$\xee\x0c\x87o)\xcd\xf6H\xb4*0\x19\xc1\x0b\x03\xa2\xe3\xe4\xcd\xc9\xd2\x0f\xbfm|~\xc0\xc9\x81j\xa2\xa1\xa1\x99\xd4\x1d\xe2\xf6A\xba\x13(_\xc2\x04\xccI\xda\x8f\x1e\x95?\x10]T\xa6Q\xa4\xf4$\xef)0\x16\xea\x99\x04\xcc\xb2L\xa31\xc0&1\x02\xa4\xa8\xc9Q<\x9bS\xf4\x9d&j?\xda\nr\x06n\xc0\xd2_\xd7?\xde;\x8e0\xc9\x93P\xc7\x1aU\xa2\xa7\x8d\xfe2\xf5\xc6\xfd\x98\xc5\x1b	{\xc7\x83\xa28E\xa7iR\x0fE\xf1$X$~\x0eG\xc9\xe5\x9d\xd36\xbc\x99\x80\xf2\xc8\x98\xd6l\x1f\x85\xea\xf6\x19\xb11a\xdb\xcdr_\xcbP\x86\xdd\xa2F\x99zk\xbd\x98\xe6\x95\x1c\xc2\xc5;\xef\xcb!\xca\x02\xab*\xe1\xf0\xab\xc4\x95\x82\x86\x95\xedF\x94u'\x91\x86\x1c\xdaP\x99\x91\x0f\x95\xb5\xb0\xbc\x128\x16a\xcc\x7f\xe9Q'\x9a\xc9\xb7R\xd8\x9c\xb1-C\xb4\x89N7\n\x8b\x00\xcc\xda\xdf\x14\x89q\xe2\x96\xb1p=\xb1_S\xef\xcf\xa5\xeb\x006\x93\xb0}\x1b7\xd9\xee\xbd4\xcdR\xfb1*\xa3~m\xf3ps\xf0\x8a\x91~\xb3=\x92\x81gn\x0b>,\xff\x9bAL\xf5\xfb\xc7q\xc3\x9d\x0f\xb1\xaeU\x8a\x1aa\xc9\xe5[\x949\xf5\xeeqK\x9d5\xfai3]B\xe78\xa1?f\xec/\xd2\xbd\xcdka=\xba\xb0\xaf\x18\x83\x96\xc2\xd8\xa0K\x8f\xe9C\x81A\xaa\xa9\x9df\xa2:U\xf2k\xd5T2\x9b\x16\xb7\x9f\xa8L\x98	\xd0=F\x87\xcb|\x17$\xb8q\xbc	\xb6A\x11\xeaM\xa5'\"QP`\xe9[\x9eG\x17\xd9\x0f_\\\xd5zu\x7f\x83\x13\xf9~6v\xf6\xc8\xbe\x1a\x90\xd6\xdd.4r\xf3D\x87\x94\xd2\x06\x02\xdf\xba^\x00\x83'\xdc\xfb.\x97Z\xe9\x8a*w\xa5=\xb0F18\xde\x19\xc3\x8a\x84\xc1!\x08\xed\xf0\x04\xfc\xbcj\xfdx\xc2\xfd\\u\xd3\x13\x11Q\xc0Mh\xeaIV\xf4!\xe4P<M<\xe0\x8e0\xda\xec:\x03;\xeb\xe5\xcdh$\xfa\"vZ5\x1czk\xaf\xa8\x85\xf1J!\xeb-x5\xcb\x10\x95\xaa\x8d\xef6\x87\x15\x1f\x98\xe0Y\xbb\x85\xb7\xd7;y\x01\xf7\xca\xde\xc9\xe9\xaa\x84\xc6\xed'Nm9\xe9\xfbF\xa1\xb9W\xd6}\xf9f\x02\xf3d\xbeH\\~\x89s\x05\xe9\xd3/\xf1\xdb=\xdd0	\xdcmgY#[iE\xcf\x0d\xdcIP:\xc8\xc5Wt~#\xbfEY\x1f\x9d\x1d\x1d\x8f\x0d\x82Tr\x89ee\xf7D\xc3\xd1\x1eA\x9cJ\xf7\xa49\xaf\x86Qrh\xbb\xb0\xd5#\xd5\x8e~\xc6\x9f\xa2\x89px>=\xb2\xda	\xcd\x9d\x0d1\x8b9O\xa1D\x9d#T\x14;\xe7\x94<\x1b\xd7\xfc8\xd9\x16N\xa9\xfa`6\xa5\xfa\xb1$\xff\x99\x9co\xd42\x014[\x02\xc2I4\xbbD\xf4\x96\x02\xd5\x84\xb4\xba\xf7\x1a\xa7D\xcd\x93\xa4z\x9c\x0f=\x19>\x8f\x94\xac\"\xcf\xf9L)\xc1\x19\xd7\x17\xa8\x18\xd7&\x81\xab\x89\x8d\x0d\x97\xb9\xc0\xde\xaa\xd0\x9cw7 \xdc\xd4\xce|\xac\xf9\x8e\x89\xe9\xee\x9e\xc2\x96\x04\x14>\xc5\xa9\xedQ\xe6\xbe\x80\xe7\x82\xba\"\n\xf3\xae\xa8'\xd4\xe7O\xb8?\x9f\xf2\x9c\xa1\xef\x9d\xdf\xc9s\x9f\xfa\x8e\xbc\xa9\xcd\x93J\x8a)\xab9w\xab\xce\xbf\xae\x0c\x98\xb5\xa6\x19\x80g\xdc\x14J=\xad\xbd\xabF2f\x86[V\x0b\xbb\x82{%\xc7\xeb\xca\xfe\xf1\xf0?\xfaHC\xb7\xe0sk\xd8\xb0ih\xa9|wGO\x11\x0e]\xe0\xc99\x95\x8a\xbe\xd9\xf46!Y\xee\x15\x9b\xfa2\x126\xfbX\xe7\x11A\xdc\x9c\xf8?\x16\xa8\xd1I\xda>+\xa8\x941|#ZMnU8~\xee5<\x11\x94\x9fI<\xf0j\xb3o\x04\xf6\xdd\xc5\xa0\xda\xfd\x02G +\x08\xd2\x8a u\xa7\x85dA\x90\x16\x98>\x01wr\xd3\xd8\xa4\xaa\x1d\xe3\x82\x11\xc5\xfd\x9d\xae\x8c\xe1\xb9,\xe3>\x12D\x99\xd6Z\x93\x1c\xdb\xcb\xd1d\x80\xa8\xa5\xcfS\x926\xd7\xbf\xc7#h\xd6tU\x1bv\xe9\xa3t\x9d\x18dz\xbf\xd4\xb5\x84\xad`y\xb2\x18\xc5\x89\xb2.c-N\xd4\x14\x97\x83\xdd&\xbc\"\x12\xe8\xa6\xfa\xecT\x1f'\xc0e\xa4o:\x02\x9c\xf0\xc5\xf1\xe3~\x18\xba\xd6\xbb\x00\xd6\xef\xc6\x9b\xc5$y\x7fU\xda\xbd\xdb\xdc\x8e\xa6\xa3\x8eZ\x1a\xf0\x976\xe8\x91\xeb\xc5q\x00\xb89\xfch\xeblEu\x82\xcc\xc2\xcd*f\xdd\x151\xbf\xddvI\x1f\x0f\x8c\xb3M\xf6L\x9e\xeb^\xa8\x9e\xc0\xd8\x01\xbaa\xac\xa8K&\x97\x1aY\xe6\\\x93\xda\xf1\xf66\xf2W\xa5\x9c\xa7'\xe9\xe2.\xf5J``J&\x04\x98\x8f5\xa3\xf8*T\xae\x1a	\x9b):\xe7\x9b\xb0qcng{\x02$\xd3\x0d\x92\xe3%\xcb\xd1-\x8e\xe7\xbc\xad\xd2%\xb3+\xd8\xecm\xfc\x82\xe2\x84R\xa6\xc4\x8c\xb3G\x82;\x8d\xcew\x0d|#\".SQg\x94\x97\x1c\xd5\\z\xc2\xdd\xe9x\x14%x\xe8\xcbJ\xe6\x17\xe1\xcf_*l\xff6\xbbx\xe55\xcb\xd3\xdc\xb9\x10\x91\x17\x99j\x851av\xd1*\x82\xec\xe6\x04\xb3{K\xe6\x15\x14\xbd\xad\xc9\xee\xdc\x15\xf8oV7\x18\xbfY\x9d\x1fs\xa7\x95\x85\xd2\xf6\xe6EIh=X\x12\x82\xa0\xef>\x04\x97\xe8\x91\xfeO\xe4\xa1\xa92\xa4\x93\xa1Ff\x87\x829\x02\x89\nv|\xcfe{Y0\xf8\xdc\x14\x1e\xbd\xcf\xb17\n\xee}\\\x1f\xf1\xe4+\x05\x07\xdf{\xa7\xa06\x14\x1e\xbe\xc1C\x85TI\xdf\x18\x8f\x04\x92>M-`\xf7\x1c\xcd\xb6\x0f\x7f:\xf5\xb7'\xce\x9f\xab\x8d([m\x81\x0d\xc9]\x98\xb6\xfev\x1c\x1d[Q\xf44dJr-\xd1\x87*1<]\x0b\xfdL[\xf6\x8f\x1ae+\x9c\xb6d\x99}/1\x1f\x9a\x053\xf6Q3i\x1c\xe6G\x1e\xbf#\xe9g\x8c\xa3e\x873Zc\xc1\xd2\x07\xeb\xef\xa3<\xcd\xf1(\xe6\xb1\x84\x87D\xd4\x85y\x83\xf4\x07\x05\x8az\xaaX\xf4l\"\x7f\xc6,.i\xcb\x08\xdcl\xa0,\xd1\x18\x96\x9f\xc6\xf9;\x0f\xdb\xab(\xa1\xad(\xcbfVf<ev\xfa\xb8\xc6\xab\x9emTm\x83\xd8Z\x89\xc6X\x9eeE#3\xb1\x88;\xe0\xe4\xc1\x816\x8c\xbc\xdah\x8e\xdb\xef\x02\x82\x83A6\n\\\xc4\xef\n\xbe\x15\xedc\x91\xe80\xfa\xf9\xc2\xdbH\xa0=XL\x08\xfd-\xc9\x17\xce\x9c\xd4\x16^-\x1eu\x8d\x8b\xf8\xf9\xd1\xe2\x07&\x0c..`\xf1w\xf9$\xd5\xb3\\|\xf7\xd5\\\xd8\x89\x12\xa2\xc7\x83\xab\x1d\x02\x07\xb6SG\x0c\x9f\xec\x1e\xf8\x89\xd2\x1f\xc2\x86\xd8\xc3\xab\xc5\x03\xb2l\xff\xd5\xf4O\xd5\x0c\xcb\x91h1\n\x16\xbc`t\xce\xdb\xd7\xe8\x94\xe9\xbe\xd4\xeeO\xd9\xf1Rd\xb2\x10\x89\x9f\xd56\xf9\xea/\xfe5\xdbh\x89\xd2S\xd5\x8fG\x0b\x1a\xc5\x95\xca\xb8g\xa8\x94+\xf2\xc3l\xb3\xc3\x08\xcd\xed\xd1\xd5P\xcf\xee\x15\x1cv\xfe\x11\xc2\xb1\n\x9b\x88\xc7\xa5\xfd\xe3\x1f\"\x0csi1?:^q\xef\xe2g\xb8\xbc\xb9}\xffp{}\xf5x{\x93\xc0{\x82\x1f\xe6\xd6&)\n\xbe\xc5t\x9f\n\xefyc\x8ai2Ts\x9aw\xad\xa4\xe1Y\xfbf\xd4\x1d\xeb\x1e\xb6\xbb\x00n\xdb\xe7w\x1aK5.9f\xc0(%\xe9\xff\x87\xa36(1-\x98\xe4\xa6\xa4\x8cQ\xb2\xaaSD\xb8\xdbjg\xe9\x06\x9e\x0b\x1c\xb3\xbaJ\xf3\xc3\xb3\xd8\x90,\x9b+\x16\xee\x8f\x88\x9d\xc6Ba\x07U\xad+e\xf0\xc2?\x7f\xdf\xa1\xde\xdbb\xdc\x93P\x18\x84\xdat\xe2\xd1\xc8\xf5i\xd4\x1bG-\xfb\xe8\xa37\xb2\x15X]{-\x18\xab4e\xb2\xce\x97z\xd3\xbe1n\xf4\x1f\x02-|\xferv\x88\xb9,M\xb1\xb2\x98u\xfe\x19\x87\x7f\x11\x7f\xee_\x877\xff\x00\xc4\xfd\xec\x14j\xf0\xcf\x7f\x9d\xf9\x8d1\xfb\xd0\xbc\xaa\xa2\x8f\xff	\x00\x00\xff\xffPK\x07\x08n\x80-.\xc5\x0b\x00\x00\xb33\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/bases/catalog.kubecarrier.io_catalogs.yamlUT\x05\x00\x01\x80Cm8\xec\x1a]o\xdb\xba\xf5\xdd\xbf\xe2 {p[\xc4\xce\xba\x0d\xc3\xe6\xb7 \xcd\x86\xe2\xf6vA\xe2\xf5e\x1b\x90c\xe9\xd8\xe2\x0dEj$\xe5\xd6+\xfa\xdf\x87\xc3\x0fY\x92%\xc5\xeb\xee}\xb8@\xf8\x92\x98<<\xdf_\x149[,\x163\xac\xc4'2Vh\xb5\x02\xac\x04}q\xa4\xf8\x97]>\xfd\xc9.\x85\xbe\xda\xbf\x9d=	\x95\xaf\xe0\xa6\xb6N\x97\xf7dum2zG[\xa1\x84\x13Z\xcdJr\x98\xa3\xc3\xd5\x0c\x00\x95\xd2\x0ey\xda\xf2O\x80L+g\xb4\x94d\x16;R\xcb\xa7zC\x9bZ\xc8\x9c\x8cG\x9eH\xef\x7f\xbb\xfc\xdd\xf2\xcf3\x80\xcc\x90\xdf\xbe\x16%Y\x87e\xb5\x02UK9\x03PX\xd2\n2t(\xf5\xce.\xe3?\x1ec\x86\xc6\x08\x8fqf+\xca\x98\xf2\xce\xe8\xbaj\xc0\xfbP\x01[b\x11\x1d\xed\xb4\x11\xe9\xf7\x02\xd0\x13\xe4\xffZ\xfb\x16\x95\xd1{\x91\x93\xf1KQ'\x01\xbd\x9f\x91\xc2\xba\x1f\xda\xb3\x1f\x84u~\xa5\x92\xb5Ay\xe4\xddO\xdaB\x1b\xf7\xf1\xc8\xc5\x02\xb2@\xd4\n\xb5\xab%\x9a\x06~\x06`3]\xd1\n<x\x85\x19\xe53\x80\xa8;\xbf}\x01\x98\xe7\xde\x1a(\xef\x8cP\x8e\xcc\x8d\x96u\xa9\x1a\xe4?Y\xad\xee\xd0\x15+XZ\x87\xae\xb6\xcb\xaa@K~5\xe9\xf6\xc1/\xc4)w`\x8a\xd6\x19\xa1v\xa78\x92\xcd\x97'\xf6\xea`\xbc\xdeQ\x07]\x8e.L\x84\xe5\xfd[\x94U\x81o\x83\xdcYA%\xae\"\xbc\xaeH]\xdf\xbd\xff\xf4\xfb\x87\xce4@N63\xa2r\xdem.\xa2\xaa\xa1\xaa7R\xd8\x82, X\x92\x94\xf1:\xe8m\xb2\xc5\xadrl`p\xba\x0d\xd0 \x05\x06]\x93B\xe5\xec\x12\xfe\xa9\xe0\x87zC7\xc1\xee\xf0YH	X;]\xa2\x13\x19Jy\x08^J\xf0@f/2\xba\x91\xb5ud\xae\xad\x15;U\x92rm\xbc\x9b\x9f(s\x16\xb6\xda\x00aVD*\x91\x07\xcaas\x00WP\xe2\xd3\x13\x7f\xf3\xe6\xf6\x0b\x96\x95\xa47o\xe0\xf1\xf1\xf1\x80\xa5lalG\xec\xb0{_%\xbdv\xbd\x14\x9a@M\x06ha\xcdi\x8b\xb5t\xe0\xe3\x87\xed\xe5\xd9|\xf0\\j\xb3\x82\xaf\xdfB\xa4$e\x1e:K\x8f\x8f\x8f\x17\x0d\xb2\xca\xe8\x8a\x8ck\xe2\xe9\x84\xed\xd6l\xcf\x9es6y\x80b\x8e\x84b\x93\x15\x94|\x9d\xf2\xe8%l.W\x08\x0b\x86*C\x96T\xc89\x1d\xc4\xde\xa6\xa8\xa2\x05\x96\xdeXd,\x07^-sNL{2\x0e\x0cez\xa7\xc4\x7f\x1a\xdc\xdeI\x98\xa8DG\xd6\xf5p\xfa\xd8R(a\x8f\xb2\xa6K@\x95C\x89\x070\xc4T\xa0V-|\x1e\xc4.\xe1Gm\x08\x84\xda\xea\x15\x14\xceUvuu\xb5\x13.%\xd8L\x97e\xad\x84;\\\xf9\\)6\xb5\xd3\xc6^\xe5\xb4'ye\xc5n\x81&+\x84\xa3\xcc\xd5\x86\xae\xb0\x12\x0b\xcf\xba\xf2IvY\xe6\xbf11%\xdby\x87\xd7\x93\x00\x0e\xc3\xbb\xc4\x84\x058\x85\x81\xf0q\xe4\xb7\x06)\x8e\x8a\xe6)\xd6\xce\xfd\xed\xc3\x1a\x12io\x8c\xbe\xf6\xbd\xde\x8f\x1b\xed\xd1\x04\xac0\xa1\xb6d\x82\x11\xb7F\x97\x1e'\xa9\xbc\xd2B9\xff#\x93\xa2\x1bJ<l\xbd)\x85c\xbb\xff\xbb&\xeb\xd8VK\xb8\xf1U\x076\x04u\xc5\x19&_\xc2{\x057X\x92\xbcAK\xbf\xb8\x01X\xd3v\xc1\x8a=\xcf\x04\xed\x82\xd9\x07\x0eZk-\xa4\x82v\x1c\x1d{\xc5\xd8~\xa8(\x8b\x0b\x9b\x1839Ya\xc8;\xbac\xf5\xfbph\xf2L\x07\xe3p\xc4\xc2X\xc4\xf7`\x869\xeal\x89\xe9\xcev\x16\x9b\xdc\xe8\n\xec\xdb\x19B\x85\xe4@\xdd\x10Th\\\x13\xf2\xd9\x90\x040)\x05\x8f\x12]V\xdc~ag\xb4\xc7\xf6\xa4?:\x92\xf4\xb7\x84\xb0\xe0:\xcf\xccH\xdc\x90\x8c\x82i\xe3=R\x18*\xbd\xa7\x0f\xe2\x06X\x17\xd4\x81\x034\x04\xd7\x1f\xdfQ>\xbcC8*G\x18\xed\xb1z=\xc1N\x8c\xe6\xb42\xa2\xed0\xd8YP(\x1bs\xd7% <\xd1!\xa49\xce\xa5\x15\x19LH\xc0\x90O\x91\xde\xdb\x9e\xe80\x8a\x947\xc7\\8\x023m\xba0\x9e\xe80\xbe\xd8S\xc7\x13\x1dX\xea\x90\xc5Y/<\xe1y\xe6\xa9F\x15XURP?qu\x87\xd3cL\xc3x\x84wG\xd2\xda\xd9\xec7j>&\xcf`\x88\xb9\x0dJgo,D\x05NO\xf2\xceV\xf7\xbe\x9a*\xd1'\x94\"o\xd0\x07\xff{\xaf.\xe1\xa3v\xfc\xe7\xf6\x8b\xb0nZ\x1dl\xcbw\x9a\xecG\xed<\xf4\xff\xad\x9c\xc0\xda\xd9\xaa	\xe0\xde\xa5\x15\xa01x`\xf9\xda\xa5\xca.\xe1=\xa7\n\x9a\x94\xa3Q\xb1\xb0\\,\xb4I:\xf0\xedF \x12\xd0\x97\xb5\xf5\xb5Ei\xb5\xa0\xb2r\x87)\x91!\xd2\xee\xe0\x0fje\x1am\xcd\xb5IM\xab\xbc\xc3F`\x01\xd6\x9c\n\xc3Jh\x83$\x1f\x0b \xaf\xbd\"|\xf1\xe6c\x8d\xc8&Q\x97dv\x9c^]VLI5\x99\x87\xc28\xcb\xd6	\xcc\xf3=\x02\x15\x13W>Fn1\x91j\x16\x8d\xdaG\x00Fjl\x1f`\x8c?_\x10>pB\x19\xd1F\xfb\x14\xf6\\F{Vc\xa7\xb5(\x90\x0e\xf9\xbc\xc4\x8a=\xff+\xa7g\xefD\xdf\xa0Ba\xec\x12\xae\xfd\x11R\x8e\xf9\x7f{\x87P\xde	\xdb\xc8\x19\xaf\xb0\xc0V\xd8\xa3\xe4\xf2\xc1g&\x05$\xa9<\xed\xc7\xd2\xd0\xdb\x93jy	\x9f\x0bm}e\x80\xad \xe9\xbb\xca\x8b':\\\\v\"d\x04#\x03\xbfW\x17\xa1\xf4\x9c\x04eS\xa7\xb4\x92\x07\xb8\xf0k\x17\xcb\x93\x02;\x82\xfb\x99\xb2;\xe9%\x13\x8b\xbd3\xd3\xc9\xd6\x8eA\xd7\x1d\xe0\xa6A\x8a\xc7\xc3vk\x14\xba\xe1\xd6\x97\x86\xeeh\xb5I\xf1\x10\x9c\x0f\x16\xad\x97\xf6\xe8\xa5=zi\x8f\xda\xe3\xa5=\x1a\x1d/\xed\x11\xbc\xb4Gc\x00/\xed\xd1K{\xf4?\xb7Gc\x9f\xb7\xfc\x9d\xc3\x19\x1f\xb8<\\;\xe3{%m,\x99=\xe5\x1e\x0d\xb5\xbe\xf7\x9f\xfd}K\xab\xe0\x92\x03~\xd8e\xa3\x01\xec\xf3\x10>\x13\x03\xeeQH\xdc\xc8\xc4T\xa8B\x03\nl\x7f\x86\x9b[\xc8jc\xd8\x8d\xbc\x04\xa7\n\x1f\xcd-CJj\x98<:@N\x0e\x85\x0c\xf7\x0f\xbe\x8f\x8c\xe4\x1a\xc1\x07M\x9c\xbe\xb5\x0dj3\x8c\xe7z\x12\x89\xd6\xad\x0d*\xeb\xa9\xacE\xf7\xba\xa1=:\x92|8\xd9v\xecT\xac\x03\xc7\x13^\x8eI\xfey\xb8\x80%~^\xd6\x8a\xa2\xaf\x85\x80\xd5\xae 3\x96\xd4\xb7\xda\x94\xe8\xc2u\xd5\x82I~o\x8e*\xc9Z\xdc\x9d'\xf9\x8f\x016\x89[\xd4%*0\x84\xb9w\xaa2\xad\xaa\\d\xe8\xa6\xcaH29nt\xed\xa2\xda\x1a\x8d\x8e\x89\xfc\xac(\x86\xd0\xf6\xefn\x8e\xa3#\xc9\xbd\x07M\x82\xbc\xda\x18A\xdb\xd7\x11\xc1\xd1\x11\x93\x01\xe7\xe3\xe5\xfd\xe7\xe2}(\xc9\x1cG\x87\xf7\x98g\"\xef\xd1c\xf4\xb6\xcb\xf2\xa5w'\xbd\x85W\xf3\xb5\xa9i~9*\xc1\xfc/(-\xcd/a\xfew\xf5\xa4\xf4g5\x7f\xfd\xddRx\x80sdX\x1f\xaa\xc6\x8dxS\xe2?\xdd\x03\xb6\xe4\x88\xe9@\x8e\x9fN^\xcd\xef	\xf3\xc3w\xf3=\xd5\xb0,\x06\xb2\xc4 X\xf4\xfe\xc1\xb5\xe0W\x83K\xb6}\xa1\xdd]b\xae\x07\x16\xce\xa8mC\xdd\x0f\x85\xeb\xe5gjH\xba\x84N	-\x9e\x94[\xa6i\xae\xa9\xf9,\x96\xae\x88\x07\xd8\xf4\x97\xc6S\xf9\xf9\xbc\xb2\xf17/\xe7=m\xc9\x90\xca\xa8w\x81$\x85zj%\xcb\xa9\xbe06J\x16K\n/+*\xcc\x06\x8a\xd9\xf3U\xa3\x7f-\xdd\x1e\xa5P\x1fH\xed\\\xb1\x82\xb7\xbf\x84/2\xed\x9f\xcf%Rk\xf2WR\xdc\xc8\x9d\xdc{\xc3\xa91\xfa\x1b\x92\xa3\x94\xda\xfa{j\xae\xdb\xbb\xe3j\xa20\xc0sH\xb2G\x07I\xaf\x0c\x8e\xafqN\x8d\x93\x8a\x9eP\xee\x8f\x7f\x18\x11T(G;\xea\xf7\xa4\xfe%\xc93\xd2\xbd\xbb\xbd\xbb\xbf\xbd\xb9^\xdf\xbe[\xc2\x1d\xc3\xf7\xdb\xa8\xd4\x98H\xb1\xa5\xec\x90\xc9\x90|\x87\x0c\x92\x9a\x93t\xb1\x7f\xa3\x95\x15ys\x99\xec{\xe9#\xb9K\x10.<\xe1\xd80\xcdR\x0fk\x0c-X\xad\x15\xffeg\xa7x\x8a\xa0\xac@%l\xc9q\xc0\xad\xff\xb1_\xe4\x99\xe0h\x16>\x174\x94\x80*#\x8e\xf7\xe5\xd1\xf3\xd3\xd9T\xc4\xde\x9c-\x95\x0b[I<@U\x9bJ[\xba\x0c\x8fE\xf6d\x0e\xae\x18vd\x92\x96\xa0\xb6\xad\x924\xf0\xedh\"\x18\xc2\x97\xca\xe7\xf2U|\x103\x94\xaf\xe2\xd2\xab\xf0\xf7\xf5K\xc2\x1a\x1a\xbf\xa6\x845\xb8\xe9d2$\x9c\x158S\x07\xca\xd6i\xc3\x0dnk\xa6\xde4\x0fR\x12\xdb\xb1\xff\x82\xaf\xdff\xc7V\x0c\xb3\x8c*Gy\xeb\x0d\\x\xaet\x11\x9e\x12\xa5Ws\xfeg\xeb\x9c\x06\xff\xf8\xd7,\x10\xa6\xfcSz\x03\xc7\x93\xff\x0d\x00\x00\xff\xffPK\x07\x08Q$\x93\x1c\x94\x08\x00\x00\xd0(\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00<\x00	\x00crd/bases/catalog.kubecarrier.io_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8\xd4Y_\x93\xe38\x11\x7f\xcf\xa7\xe8:\x1e\xb2W5\xf1\xb0@Q\x90\xb7%3G-\xec\xddMe\x86}\xa1x\xe8\xd8\x1d[\xb7\xb2d$9\xb3\x81\xe2\xbbS-Y\xfe\x93\xc8I\x98\x83\x07\xf4\x14K\xad\xd6\xaf\xff\xb7\x94\xc5j\xb5Z`#>\x93\xb1B\xab5`#\xe8\xab#\xc5_6\xfb\xf2;\x9b	}\x7fx\xbf\xf8\"T\xb1\x86Mk\x9d\xae\xb7dukrz\xa0\xbdP\xc2	\xad\x1659,\xd0\xe1z\x01\x80Ji\x87<m\xf9\x13 \xd7\xca\x19-%\x99UI*\xfb\xd2\xeeh\xd7\nY\x90\xf1\xcc\xe3\xd1\x87_f\xbf\xca~\xbf\x00\xc8\x0d\xf9\xed/\xa2&\xeb\xb0n\xd6\xa0Z)\x17\x00\nkZCAF\x1c\xa8\xc8=\x18\xd3\x81\xb1Y\x8e\x0e\xa5.=\xff\x1c\x8d\x11\x9e\xff\xc26\x943\x8e\xd2\xe8\xb6Y\xc3\x0cU\xe0\x1d\x01\xa3\xa3R\x1b\x11\xbfW\x80\xfex\xfe5\xda\xb7j\x8c>\x88\x82\x8c_\n\x1az\x08\xd8\xa6\x8a\xf2\xebRX\xf7\xe7y\x9aO\xc2:O\xd7\xc8\xd6\xa0\x9c\x93\xd2\x93\xd8J\x1b\xf7\xc3\x80w\x05E\x1e@X\xa1\xcaV\xa2\x99\xd9\xbe\x00\xb0\xb9nh\x0d~w\x839\x15\x0b\x80\xce\x04\x9e\xdb\n\xb0(\xbcQQ>\x19\xa1\x1c\x99\x8d\x96m\xad\xfa\xb3~\xb2Z=\xa1\xab\xd6\x90\xb1n\xb3\x1dZ\xdal\x1f2V\xa0'\x89v\xfa\x03Z\x82\xcd\xf6\xa1\x9btG>\xd8:#T\x99`\xe5\xd0\xb56k*\xb4S.\xcf~\xe1&\x1e\xd1\x0b\xb33\x0f\x9ap\xfcP\xd2\x84]\x81.L\x84\xe5\xc3{\x94M\x85\xef\x83B\xf3\x8aj\\w\xf4\xba!\xf5\xe1\xe9\xe3\xe7_?O\xa6\x01\n\xb2\xb9\x11\x8d\xf3\x8e\x9c\xb4og\x10\x0b\x08\x8a^Y-\xb07\xba\x06\x04\xfa*\xac\x13\xaa\x04\xad(\xeb96F7d\\\xef\x83a\x8cBu4{r\xfe\x92!\x06*(8F\xc9\x82\xab(\x9a\x99\x8aN*\xd0{p\x95\xb0`\xa81dI\x85\xa8\x9d0\x06&B\x05z\xf7\x13\xe5.\x83g2\xcc\x86]\xb0\x95\x05\x87\xf6\x81\x8c\x03C\xb9.\x95\xf8G\xcf\xdb\x82\xd3\xfeP\x89\x8e:\xcf\x1e\x86w+\x85\x12\x0e([\xba\x03T\x05\xd4x\x04C|\n\xb4j\xc4\xcf\x93\xd8\x0c\xbe\xd7\x86@\xa8\xbd^C\xe5\\c\xd7\xf7\xf7\xa5p1E\xe5\xba\xae[%\xdc\xf1\xdeg\x1b\xb1k\x9d6\xf6\xbe\xa0\x03\xc9{+\xca\x15\x9a\xbc\x12\x8er\xd7\x1a\xba\xc7F\xac<t\xe5\xd3TV\x17\xbf\xe8#l9\xc1z\xe6pa\xf8`\xbf`\x01\x0et\x10l\xec\xb05H1(\x9a\xa7X;\xdb\xc7\xe7\x17\x88G{c\x9cj\xdf\xeb}\xd8h\x07\x13\xb0\xc2\x84\xda\x93	F\xf4\xde\xc4<I\x15\x8d\x16\xca\xf9\x8f\\\nR\xa7\xea\xb7\xed\xae\x16\x8e\xed\xfe\xf7\x96\xacc[e\xb0\xf1y\x1bv\x04m\xc3\x11Qd\xf0Q\xc1\x06k\x92\x1b\xb4\xf4?7\x00k\xda\xaeX\xb1\xb7\x99`\\rN\x89\x83\xd6F\x0b\xb1\x08\x0c\xe3z\xc4>7\x94O\xe2\xa7 +\x0c{\xb8CG\x1c\x17\xc9m\xd9\xe4\x98t\x18\xf3\xe8\xd2\xe6\xe9\xf4	2\xce\x13\xaeB\x17\x03\x8e\xcdc\xa9\x00d\xdfb\x16\x1cg!\xb5\x0c\x99\xe5\x8c%x\xef\xc8\xce\xe6\xe7\xd1AL\x87\x89y\x80Z\xa8O\xa4JN\xba\xef\x93\x0436\x0b\x83\xdd\x8e\x15y\xce{\x05\xa3\"2\x8c\x19\xa3\xf2\xa0\xaf\x8d\xb6	\x94\x13%v\x0d\x88\x85\xd7J\xe4\x15\xec\x05\xc9\xc2\xc2\xab\x90\x92\xf5\xd9E\x16\x08\xd5Y\xd9\x1b5!\x16\x17\xb9\xb3i\xe1\xa8Njo\x82\xa0K\xc7\x8f\x1e\xedF\xab\xbd(\xbdO\x8a\xbd\xa0\x13XNwB%\x15;\x05\x99\x86t\xcd\xae\xd0\x1d\x95^;A>\xa0\xe4s#\xc6\xa9G\x0e\x1a\x9c\xe1\x08\xb7\x80\x86K\xda\x0cc\x82\xec;\xc6\xc2\x95\x9f\x13m\x80y\xe4\xbcZ\xe9WV\"\x16\x85!\xcba\x92\x93qx\x01[\xa7\x8f9L\xd7\xd5\x19F\xdf\x88\\\xa09\x11\xe1O\xcf?\xfe\xe0%\xa0\xac\xcc\xbav\xca\xea\x9a\x02\x1e\xfee\xdb\x9d\xff\xb8\xc8\xf3b\xb4\xc51\x1fuq\x0c\xbd\xd4\xbc\x19\xe7#1\x8eZ\xa8\x8f\xde\x8a3\xa9!2Ac\xf0\x98\xa4\x18\xb7\xa3\xa9q\xc1?\xe3\xd6\xd0\xdap\xc1\xdd\x93!\x95\x07\xaf\xbb\xf3\x8e{\xc1G\x85\xedb\x8fS\x06\xc7(6\x8d\xf4\xbcu\x06/\x15\x81\xc5\xba?\xc4\x17\xe0P2gYFt\x05\x07n\xddJ'\x1aI\xa9l`\xdf\x18\x117\x98\xfe\xe7\x1a\xe4\x92\xe3\xac\xba\x8c\x90\\\x8a\xc6H,^\xf4\xa3K\x80\xe7\xa0\xa6A\xaeb\x95=\x99M$\xd8\xb9\xc6\xc1\xdf>\xfe\xe3\xd6\xc1\xef\x9a4\x0fzg\xb9o\x1b\xba\x87\x13\xd1~V/\x91k\x15\xeel	O\x99\xb6\x13=\xe1\xa8\xa5\x1c\xf5\xe9\x80\x07\x14\x12w2\x02\x0e\xf7\xf8\x84\x05\xf9Z\x90\x06\xbd\xb4\x90\xb7\xc6pA\xf0\xb2\xbe\xb5f&\x99\xf7\x02\xf8\xa2\x8eB\xb1\x96\x1d\nia\xafM\xd2\xbf}\x1b\xdc\x01\xea\x15\xd5\xdf}n\xd0{\x18\xd7\x8a\x80D\xeb^\x0c*+\xe2\xcd\xf3\xa6\xfc\xf5\xe9l\x1b\x97\xb3`\x11\xeb\xc0\xf1\x84\x97 \"\x9fO\x05\x81Kw\x13\xd0\x8a:\xe7\xf5\xc5PiW\x91\x99K2{mjt\xe1&\xbc\xe2#/&\x8a\x0b\xd9\xa6&k\xb1\xbcM\xf2\xef\x03m\x14\xb7jkT`\x08\x0b\xef~u\\U\x85\xc8\xd1]\xaal\xd1\x01p\xa7[\xd7\xa9\xad\xd7\xe8\x9c\xc8WE1\x84\xf6\xf4\x9a=\x8c\x89$[O\x1a\x05y\xb73\x82\xf6\xdfv\x0cX\xb7S\x03.S\xe1\x14\xc6\x7f\x0b{*k\x0dc\x82\xbdKU\x1d\xf6\xcec\xba\xea\xd9C\xbe\xf3\xee\xa4\xf7\xf0n\xf9bZZ\xde\xcdJ\xb0\xfc\x0e\xa5\xa5\xe5\x1d,\xff\xa2\xbe(\xfd\xaa\x96\xdf\xbeY\nOp\x8b\x0c/\xc7\xa6w#\xde\x14\xf1\xa7\x1fb\x06\xa9f\xa5\xe8\xd2\x85<\xc2\xbb\xe5\x96\xb08\xbeY\x8a\xcb\xc5\xf3<g$\xc9\xbaXH\xae\x05/K.\xd9\xf1\xcb\xd9t\x89Q'\x16.\x96\xe5\xf9.\xa1k\xec7\xdb+\xd5'\xdac\x0b\x95\xe6k\x84P!\xf1p>\x0e\xc1{\xf5\x9a\xf0\xff\x7fc\x8d\x8d\xc0\x1fI\x91\xf1\xb2_\xd1\xda\x8fg\x1b\xa2\xaf\xd7\xda\xfa\xa77\xael\xe5\xb0\x1aOH\xc8\x19\x92\xd1\\\xd9\x83\xdd1\xc6}\xf7`\x7fn\x80X*\x84r\xbf\xfd\xcd\x8c\xdcB9*\xe9\xb4\x1a\xfb\xa7\xddk\x1e\xf2\xf8\xb4}\xdc|xy|\xc8\xe0\x89\xe9O\xdb\x94X\xc8\xa5\xd8S~\xcceHY)_\x8e\x05>\xbe\\n\xb4\xb2\xa2\xe8_\xcb\xb8m\x1d\x1dw\x07\xc2\xf5/\x02\x86j\x9d\xd6\x1fZ\xb0\x9a\xbd\xd5\xa31\x14\xde\xf8j\xca+T\xc2\xd6\\jklF\xfd\x18\xcf\x04\xa7\xe2k>\xa5\x02\xb51bx\x10\xec\xfc\xfb\xc8\xd7\x0d\xe1\xd3\xb2V\xf2\xe8\xedV\x08\xdbH<B\xd3\x1a\xee^\xef\xfc$\x1d\xc8\x1c]\x95N>$\xad\x7f.\x1a\xe197\xe8\xac\xe3'}\xf8l2\xb8\xda\x1a\x9ci\x83\x15\xac\xd3\x86[\x80\xd1L\xbb\xeb_W\xa3\xfd\xbb\n\x05\xff\xfc\xd7b(V\x98\xe7\xd48*F\x7fm\x84\x7fU\xbe\xf9\xc6\x7f\xc4\xbfF\xfc\xe7\xa8\xe7\x85\xbf\xfem\x11\x0e\xa6\xe2s\xbc<\xf2\xe4\xbf\x03\x00\x00\xff\xffPK\x07\x08?G\xb2\xeb\x02\x07\x00\x00\xdf\x1a\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00/\x00	\x00crd/bases/catalog.kubecarrier.io_offerings.yamlUT\x05\x00\x01\x80Cm8\xecYO\xaf\xdb\xb8\x11\xbf\xfbS\x0c^\x0f\xbb\x0bXz}\xed\xa55\xd0C\xe0W\x14\xc1&m\xf0\xdeC.M\x0f#r,3\xa1H-\xff8q\x8b~\xf7\x82\xa4(K\xb6$;\xbb\xd8b\x0f\x99\x93E\x0e\x87\xc3\xdf\xfc%\x8d\xadxO\xc6\n\xad6\x80\xad\xa0/\x8eT\xf8\xb2\xe5\xa7?\xd9R\xe8\xfb\xc3\xc3\xea\x93P|\x03[o\x9dn\x9e\xc8jo\x18=\xd2N(\xe1\x84V\xab\x86\x1crt\xb8Y\x01\xa0R\xdaa\x18\xb6\xe1\x13\x80i\xe5\x8c\x96\x92LQ\x93*?\xf9\x8a*/$'\x13\x85\xe7\xad\x0f\xbf/\xffP\xfey\x05\xc0\x0c\xc5\xe5/\xa2!\xeb\xb0i7\xa0\xbc\x94+\x00\x85\x0dm@\xefvd\x84\xaam\xc9\xd0\xa1\xd4u\x14\xc9\xd0\x18\x11E\xaelK,l]\x1b\xed\xdb\x0d\xccp%qYGtTk#\xf27@\x01\x18\xf7L\xbf\x07k\x0bG\n\x95\x8bS	\x96\x7ft\n\xc5!)\xac\xfbq4\xfcF\xd8\xc4\xddJoP\x0e\x0e\x10G\xed^\x1b\xf7\xf7\x93*a\xbb;\xbd\xdb\xdd\xa5Y\xa1j/\xd1\x9cV\xad\x00,\xd3-m .j\x91\x11_\x01t8vB\n@\xce\xa3mP\xbe3B92[-}\xa3\xfaM\x02\xcfG\xab\xd5;t\xfb\x0d\x94\x01\xb32\x9b\xb1\xe4\xc2\xb6\x12\x8fa\x83\x9e=\xc3\xff\x98\xe6\xe0l\xd2\x1d\x83J\xd6\xf5@Lo\xd1\x1a}\x10\xc1\xf4jJ\xf6\xbbn\xf6\xab\xe4\xf6Z_8\xce\x85\xfcW\xf5\xa5\xca\x1c]\x1eLL\x87\x07\x94\xed\x1e\x1f\xbaA\xcb\xf6\xd4\xe0	6\xdd\x92z\xf5\xee\xf5\xfb?>\x9fM\x00p\xb2\xcc\x88\xd6E\x7f\xbe\xcb\xf6\x07a\xc1[\xe2\xb0\xd3\x06^\xa2\xf3Xp\x1a\xb8\xb0L\x1f\xc8\x80%s\x10\x8c,\xb8=:\xd8\xe3\x81>\x0c\x84\x02|\x80\x8aHA\x83\x9c\x00\x0f($V\x92\x82\x04\xb7\xa7\xa6\x84\x0f\xaaw5\xd0\xd5Gb\xce\x02\x1aJqD\x1c\xd0;\xdd\xa0\x13\x0c\xa5<^H>\xc2\x8f\xbe\xa2m\xf2m\x10\n^1\xa6\xbdr):\x82w\xd9\xf5I1\xc0\xacm\xf2G\xe2\xe0\xf4\xb9\xcc\xa0\x16\x1c\x04\x02\xc2\xb6\x8b\xbd\xbb\x01KktK\xc6\x0db-\xd1 \x0f\x8d\xc6\xcfp\xfd.\x80\x9f\xf8\x80\x87\x14\x14\x81\xa3\x1c\x00\xc4;\x8b\x81\xde\x81\xdb\x0b\x0b\x86ZC\x96TJJg\xa2!\xb0\xa1\xea\x80+\xe1\x99L\x10\x14\xa2\xd2K\x1er\xd7\x81\x8c\x03CL\xd7J\xfc\xbb\x97n;\xfcA\xa2\xa3.\xbe\x87\x14CN\xa1\x84\x03JOk@\xc5\xa1\xc1#\x18\n\xfb\x80W\x03\x89\x91\xc5\x96\xf0V\x1b\x02\xa1vz\x03{\xe7Z\xbb\xb9\xbf\xaf\x85\xcbY\x98\xe9\xa6\xf1J\xb8\xe3}L\xa8\xa2\xf2N\x1b{\xcf\xe9@\xf2\xde\x8a\xba@\xc3\xf6\xc2\x11s\xde\xd0=\xb6\xa2\x88\xca\xab\x98\x89\xcb\x86\xff\xcety\xdb~w\xa6\xedd\x88ANo\x8b\xb6\x08\x99.\xf87v\xcb\xd3YN\x90\x87\xa1\x80\xd2\xd3_\x9f_ +p\x01V4S\xb2\xc0i\xa9=\x19#\x00'\xd4\x8eL\xe2\xdc\x19\xddD\xa9\xa4x\xab\x85r\xe1\xe3B(\x93\x82\x94\x03\xeb\xabF\xb8\xe0\x05?y\xb21\xf2J\xd8\xc6\"\x05\x15\x81oC\xfc\xf3\x12^+\xd8bCr\x8b\x96\x92)&l\xfak\x99&X\xc0\x16\x01\xee\xdb\x8d3\xac\xb9\x97\x0b\x12\x9a\xa3\xa9\\\x16\x874\xb2f\xce\"\xcf-\xb1Qh\x85m\xe0\xfb\xbc\xe1\x1ar\x12_\x033\xdc\xae/\x80\"\xc7\xca\x1fB`e\x89\xe5\x19\xcb\\\n\x08\xc4\xcc\x85\xcf\xc1\xb9\xa6\xdb\xa7G\xd8k\xc9\x93z\xc1.\xa6\x89\xd1\x0dXi\x1f\xfd\x01\xbc\xe2d\xe41x\xe0\xf6\xe9qB\"\xa4\xb4\x16\\\xafKe\xd5\xb1s\xc5\x19\xb5\xe1\x8a\xea\x90\xb2\xd8\xdfb\xdf19\xbb`\xcfLSA\x97\xe9\xea\xe2X\xc1~\xee\xe2\xae?\xf9\xb9\xcb\x0d\xd5\x13\xb9;\xd3\xc8~O\x91\x15\x0c\x05\xdcU(}\x98\xc7r\xd6\xde>=N\xc1\x0f7\x98\x00\xae\x00\x11\xa8\x11\xea\x0d\xa9:4\x10\x0f\x0blW\x0f\x0d1\xb1\x08C\xb36\x0b\xdd\xcaY\xb33\xa4\xd9p\xcd4n\xec.I8j\x16\x808\x8f\x9b\\:S\xf8\x84@\xea6\x88\xf9A\xecD\x08}\x87B\xda9\xf8o3\xc0u\x13\x9c\xd7\x92\xd0P\xf6\xe6\xef\x94Z\xc7\xa6\x89\xbe`\xd3\xca\xd8\x9a\xad\xfb\xf6\xec2\xed\x8c\xe9\xf0P\x91\xc3\x87\xf3|:\xa6\x1b\xec\x0b\x13=\xe0\x14\x8d\x0e\xf3<nA\x060\xcf\xa3\n\xb7xC\xa6/E\xb8\x92\x18E\x8el\x11+\xa69P\xe1\xd5'\xa5?\xabb'Hr\xbb\x01g\xfc\x9c\xdf\x05\xb2N\x1b\xac\xbf\xc6H\xcfi\x05\x08\xc5E\xb83\xd9\x91\xb5r\xd7T-m\x1a(\xb6\xc3\x9f\xf7\xa4\xa0\x0d+m\xec\x13X\xbc]\xf6]B\xec\xb0:\x0dKx\xd9\xd3DE\x1eS\xe3m\xac\xe9\xf4\x05\x99\x93G\xd0\xaa\xef\n\xe1\xb3p\xfb,\xed/\x01\x96[\xecPi-	/[\xc6L\xd7C\xffJ\xf0\xdfd\xf0\xc4\x82\xc6\xe0q\x82cY\x87\xa2\xafG3\xd3\xa1\xda\xccL\xcd\xea]t\x95bf2\xd5\x81\x99\xc9\x9c\xcd&\xa6\x17\xa1\x98\xebv`\xae\x89y\xdb-\x88\xcf\x0f(Tj\x15\xb2\x18\xf8\xbe\xbb\xe2\xc6SN\xa7\x92\x81\xd8uhi~H\xe1L\xb3]\x0d\xdc\x90\x1a\x87\xba\xce\xd8{t\x9c\xc7\xd3G\xe8T\xe2\x8dC\xab:\xde'R\x9e&>\\1\xebh\x9d\xee\xcf\xe9\xf66\xe7\xfd7\x94\xc5\xab)s\xf0xp\xdb\x11O\xfc\xf9\x88{\xdf\xa0*\x0c!\x8fW]5\xa8\x0c\xb3\x07\xfc?\x9cL\xb0\x1b\xad\xf6\x9a%s!\xd8\x06\xa5\x04\xfb\x93\xc7\xd0`J]\xebl	\xbb\xac\xef5G\n4\x17\x10\xfd\xfc\x08\xe6\xe0\xf6\x1d\xbe\xa2	I<>\x9a,,O]\xf5\x06\xaa\xa3[J\xbdWa\x83\x18\xbf\\\xe0K\xe0\xbcU\xdf\xb7yE\x06L(&='\xdei/T:\x00P9\x19\x89'\x8a\xfc\xf7\xad\xaa\xd7\xdd\xcf\x8f-\xf5\xbf\xeda\xa9\xf0\xdfp\xb6\xebE\xa0\x88\x8a.L\xf7\xe0,:\xe6B\x91\x08~u\x93c\xbe	\x0e\xd89\xc1\xce\x07\xcf\x8c/\x10\xdf\xfc\xf2\x9b_NL\xffb\xbf\x8co\xdb\x8f_Y\xf2\x9e\xcf\x16u\x89T\xa8Z\x12H\xa1(\x89\xfdMU\xbdk]\xd8\xf4\x83\xfa\x90\xe3\x1c\xaa	\xb6E\xb8\xf3\x9b\xcc\xd5\x06)\xbf\xb1\x0fo\xdf\x01\xa3~\xbcA\x85uz\xb8\x13\xf6\x175;K7\xc0_\x1f\xf3\x99\xfeu\x01\xc5y\x89E\xdf9NL\xb5\x97\xffZ\xc0\xfcF\x13\xc3\xf1\xf2\xc6G\xd7\xb5|5\x1b\x8d\xf9\xaa\xbf\x1cm\xe0?\xff]Y\x87\xceG\xec\x911j\x1d\xf1\xc1\xffH\xe9\xbf\xa9\xbb\xf4\xf2\x9e\xffz\x8a\x9fL\xab\xf4\xdf\x90\xdd\xc0?\xff\xb5J\x9b\x11\x7f\x9f\x1f\x1a\xc2\xe0\xff\x02\x00\x00\xff\xffPK\x07\x08\xef\xb9oV\x9a\x06\x00\x00\x15\x1c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00/\x00	\x00crd/bases/catalog.kubecarrier.io_providers.yamlUT\x05\x00\x01\x80Cm8\xecWM\x8f\xdb6\x10\xbd\xebW\x0c\xd2CZ`%w\xdbK\xab[\xe0\xed!HR,\xb2A.m\x0fcr,3\xa1H\x86\x1c\xbaq\x8b\xfe\xf7\x82\xa4$\xcb_\xbb\xee!@\x0f\xe1\xc9\x1a\x0e\x1f\x1f\xdf|\xd0\xac\xea\xba\xae\xd0\xa9\xf7\xe4\x83\xb2\xa6\x05t\x8a>3\x99\xf4\x15\x9a\x8f?\x85F\xd9\xc5\xf6\xb6\xfa\xa8\x8cla\x19\x03\xdb\xfe-\x05\x1b\xbd\xa0;Z+\xa3XYS\xf5\xc4(\x91\xb1\xad\x00\xd0\x18\xcb\x98\xcc!}\x02\x08k\xd8[\xad\xc9\xd7\x1d\x99\xe6c\\\xd1**-\xc9g\xf0q\xeb\xed\xf7\xcd\x0f\xcd\xcf\x15\x80\xf0\x94\x97\xbfS=\x05\xc6\xde\xb5`\xa2\xd6\x15\x80\xc1\x9eZp\xden\x95$\x1f\x1a\x81\x8c\xdav\x19R\xa0\xf7*CV\xc1\x91H[w\xdeF\xd7\xc2\x05\xaf\x027rD\xa6\xcez5~\xd7\x80y\xc7\xf4k\xb6\xaef2h8O\x14I\xee\x072\xd9\xa4U\xe0W\x07\xe6\xd7*\x14o\xa7\xa3G=#\x9f\xadac=\xff\xba\xa7Q\x83+HA\x99.j\xf4\xfb\x05\x15@\x10\xd6Q\x0b\xd9\xdf\xa1 Y\x01\x0c\xf2\xe5\xf55\xa0\x949 \xa8\xef\xbd2L~iu\xec\xcd\x84\xfe!Xs\x8f\xbci\xa1I\"5c\xdc\x1a\xa9\x82\xd3\xb8K\xd0\xd9u\xd4\xfa\xae\xd8a6\xc1\xbbD\"\xb0W\xa6;\x85\x9d\x10O\xa2x\x80\xfb\xa2;\x84\x93\xc8\xc5P\xa6\xb7\xb7\xa8\xdd\x06o\x8b\x16bC=\xb6\x83\xbfud^\xdc\xbf|\xff\xe3\xc3\x81\x19@R\x10^9\xce\xc9\xf4l\x0c\x00\xd0gg\x03\x05Pfm}\x9f)\x81]\x03\x1ax!\x84\x8d\x86\xe1O\xc5\x1b\xe0\x0d\x1d\xc6\xb2\x0co55\xf0\xbb\x99\xe6\xc0\xae>\x90\xe0\x00\xe8\xa9d*I\xc0\xc86A\x0b\xd4z\x07\xab\x1d\xbc\x8a+Z\x96\x94\x015\xed4\xc35c\x0c\xc3\x0d\xf0\x06\x196\xb8%@\x08\xe4\xb7J\x10\xd8\xf5\x9a<I`\x9b\x98\xf5\xb0U\x08\x08\xcb!\x93\x9fMH\xce[G\x9e\xa7\xbc-cV\xd13\xeb\x91D\xcf\x93\x8a\xc5\x0bd*e\nY\x85!\xa3H\x0e\xc2'\xb5x\xa3\x02xr\x9e\x02\x99R\xdc\x07\xc00HZ\xc4i\xe0\x81|\x82I\xf9\x1d\xb5L\x1d`K\x9e\xc1\x93\xb0\x9dQ\x7fM\xd8a8 hd\n|\x84\x993\xd8\xa0\x86-\xeaH7\x80FB\x8f;\xf0\x94v\x81hfx\xd9%4\xf0\xc6z\xca\xb1na\xc3\xecB\xbbXt\x8a\xc7N&l\xdfG\xa3x\xb7\xc8MI\xad\"[\x1f\x16\x92\xb6\xa4\x17Au5z\xb1QL\x82\xa3\xa7\x05:Ug\xea&w\xb3\xa6\x97\xdf\xf8\xa1\xf7\x85\xe7\x07\\Oj\xa2\x8c\xdc\"\x1e\x89@\xea\x15\xa0B\x8a{^ZN\xb1\x17:\x99\x92:o\x7fyx\x07\xe3\xd69\x18\xc7\xeag\xdd\xf7\x0b\xc3>\x04I0e\xd6\xe4K\x10\xd7\xde\xf6\x19\x93\x8ctV\x19\xce\x1fB+2\xc7\xf2\x87\xb8\xea\x15\xa7\xb8\x7f\x8a\x148\xc5\xaa\x81en\xef\xb0\"\x88.\x15\xadl\xe0\xa5\x81%\xf6\xa4\x97\x18\xe8\x8b\x07 )\x1d\xea$\xecu!\x98\xdfL\xc7\xceE\xb5\xd9\xc4xq\xec\xc7A\xbc\xc6\x1e\xf0\xe0H\x1c\x94\x8c\xa4\xa0R\xb5\x06FN\xb5{\xae\x93\\\xaaU\xb8H\x12\x8e\xf7\x7f3\xb8\xe5\x1b\x15\x95)\x9b\x8f\x8b\xe1\xdb\xa1\x89\xe7\xe6r3_zs\x82\x0b@,\xbe+\xa5\xbdo|\xcd\x89\xdfe\xcep\xc4\xee\xcc\xf4\x11\xfd\xbb\xfdGJ\xfa\\\xf6\xd6t\xb9\xac%1*Mr\xbe\xe2,\"\x8c\x9c\x1fJ\xa7<\xa5\x9cF\xaf\xcck2]\xba\x91n\xcf:\\\xc8\x96q\xcc\xae\xc3k\x0e\xb6\xf7\x1e\x0f\xb6\x89=\x9a\xda\x13J\\i\xca\x11\x99\xfa\xe8\x17e\xae\xc4U\xb1x)J\x10\x10B\x8fZC\xf8\x141e\xb0\xb6\x9d\x1d\x15\x0e\x8f\xf1|<1\xd28\x9f\xd0\xd3\xec\x81\x80)}\x07\xe5T\x8f\x1d\xe5\xc5\xe77N\xa3\xdc\xe4-\xacvL\x17\x9d\x9e\x10\nr\xddI\x85\xef\x92\xdfu<\xdf\x8c\xfe\xa3D\xca\x08\x1d%\xc9\x81\xb52\x858P\xd3]f\x0f\xc5{\xe1Lw3\xfc\xfc\xe0h\xfa\x1d\xb6\x97\xe8^q\xa6\xd4\xabS+:\x7f\xa0:\xd3\xbb05\x89\xf1H\xda\x9dt\xccq\xa4\xbc\xb9\"\xed^\xa7\xf4\x1a\x02\xbd\x8e)\xef\xf2\xe5\xfd5\xeb\x8e\xc6\xd7\xac+\xe3\x89\xac\xcb\xcf\xa7\xbb\xfft	=\x1c-\x19\x9a\xa02\x9d&\xd0\xcaP\x01\xfd_\xdcC\x97e\xad\xe1\xf4\xc16\x9f=\x16\xe6\xc4\xe5\xa2\xb0\xe7\xf7\xac\xa7\x7f\x19\xd5\x93('\xc6T\xd1$[`\x1f\x0b\xd7\xc0\xd6cGsK\\M\xff\xac[\xf8\xfb\x9f*\xfd\x93\x8a\xb9\xc8Q\x08rLr\xf6J./\xefg\xe5\x154>\xac\xf3\xa7\xb0\xa6<\x80C\x0b\xbf\xfdQ\x95\xadH\xbe\x1f\x1f\xc9\xc9\xf8o\x00\x00\x00\xff\xffPK\x07\x08f\x90\x00\xd6\xa7\x04\x00\x00\xf4\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00-\x00	\x00crd/bases/catalog.kubecarrier.io_regions.yamlUT\x05\x00\x01\x80Cm8\xb4VMo\xe36\x13\xbe\xebW\x0c\xf6=\xec[ \x92\x9b\xf6\xd2\xea\x168=,\xf6\x03A\xb2\xc8\xa5\xedaD\x8een(\x92%\x87\xc6\xbaE\xff{AR\x92e;\xde\xec\xa2(O\xe2p\xf8\xcc\xe8\x99/Vu]W\xe8\xd4#\xf9\xa0\xaci\x01\x9d\xa2\xcfL&\xedB\xf3\xf4Sh\x94]\xed\xae\xab'ed\x0b\xeb\x18\xd8\x0e\xf7\x14l\xf4\x82ni\xa3\x8cbeM5\x10\xa3D\xc6\xb6\x02@c,c\x12\x87\xb4\x05\x10\xd6\xb0\xb7Z\x93\xaf{2\xcdS\xec\xa8\x8bJK\xf2\x19|2\xbd\xfb\xbe\xf9\xa1\xf9\xb9\x02\x10\x9e\xf2\xf5\x8fj\xa0\xc08\xb8\x16L\xd4\xba\x0208P\x0b\x9e\xfa\xec\x9d@Fm\xfb\x0c(\xd0{\x95\x01\xab\xe0H$\xc3\xbd\xb7\xd1\xb5pA\xab\x80M\x1e\"So\xbd\x9a\xf65`\xb6\x97\xbe\x16\xf7j&\x83\x86\xf3A!\xe4>\xbb\x92\x05Z\x05~\xbb\x10\xbeS\xa1h:\x1d=\xea\xd9\xed,\x0b[\xeb\xf9\xc3\xc1\x81\x1a\x82\xf0\xe5D\x99>j\xf4\x93~\x05\x10\x84u\xd4BVw(HV\x00#k\xf9z\x0d(e\x8e\x03\xea;\xaf\x0c\x93_[\x1d\x073\x83\x7f\n\xd6\xdc!o[h\x12;\x8d\xf3v\xa7\x12\xff\x89\x83\xac3q{7\x9e\x8cB\xde'\xcb\x81\xbd2\xfd\x05\xac)\xf4\x8dT\xc1i\xdc\x7f8\x85\xbc-rX\x1c|\x19vF<K\x84#\xdc\x9b\xfe\x18N\"\x17A9\xde]\xa3v[\xbc.\xac\x8a-\x0d\xd8\x8e\xfa\xd6\x91\xb9\xb9{\xf3\xf8\xe3\xc3\x91\x18@R\x10^9\xce\xf9\xf8\xaa\xc4\x11\xe8\xb3\xb3\x81\x02(\xb3\xb1~\xc8\x0e\x01v62\xe0LW\x80\xb5\x8e\x81\xc97\xf0\x9bYf\xc5h\xb0\xfbD\x82\x03\xa0\xa7\x92\xdd$\x01#\xdb\x84&P\xeb=t{x\x1b;Z\x97D\x03e\xe0F\x08\x1b\x0d\x974Ma\x0fW\x0bD\xde\"\xc3\x16w\x04\x08\x81\xfcN	\x02\xbb\xd9\x90'	l\x81\xb74\xc0N! \xac\xc7\xfc\x7f5\xdfv\xde:\xf2<g{Y\x8b.\xb0\x90\x9ep\xf2:\xd1V\xb4@\xa6\xf2\xa7\x90lM\xe9Hrd\x1a\xec\x06x\xab\x02xr\x9e\x02\x99\xd2\x10\x8e\x80!)\xa1\x19\xc9i\xe0\x81|\x82I\xb5\x11\xb5L]cG\x9e\xc1\x93\xb0\xbdQ\x7f\xce\xd8a\xfcA\xd0\xc84\xd6\xd8a\xe5\xf47\xa8a\x87:\xd2\x15\xa0\x910\xe0\x1e<%+\x10\xcd\x02/\xab\x84\x06\xde[O9\xbc-l\x99]hW\xab^\xf1\xd4\xfd\x84\x1d\x86h\x14\xefW\xb9\x91\xa9.\xb2\xf5a%iGz\x15T_\xa3\x17[\xc5$8zZ\xa1Suv\xdd\xe4\x0e\xd8\x0c\xf2\x7f~\xec\x97\xe1\xf5\x91\xafgEPVn,_\x88@\xea1\xa0B\x8a{\xbeZ\xfe\xe2@t\x12%v\xee\x7fy\xf8\x08\x93\xe9\x1c\x8cS\xf63\xef\x87\x8b\xe1\x10\x82D\x982\x1b\xf2%\x88\x1bo\x87\x8cIF:\xab\x0c\xe7\x8d\xd0\x8a\xcc)\xfd!v\x83\xe2\x14\xf7?\"\x05N\xb1j`\x9dG\x02t\x04\xd1\xa5*\x95\x0d\xbc1\xb0\xc6\x81\xf4\x1a\x03\xfd\xe7\x01HL\x87:\x11\xfbu!XN\xb3S\xe5\xc2\xda\xe2`\x1a7\x87u\x14\xaf\xd2\x0b\x1e\x1c\x89\xa3\x82\x91\x14T\xaa\xd5\xc0\xc8\xa9r\xcf{\xc6\xa5:\x85\x8b\x0e\xc2\xa9\xed\xf7\xa3Z\x9e\xc0\xa8L1=]\x86\xff\x8f\x1d;\xb7\x98\xab\xe5\xd5\xab3\\\x00b\xf1])k\xcay\xa2\x04M=\xefL\xfb\xb2\xe7p\xe2\xe33\xc7'?q{\xd8\xa4\xb4O\xe6\xb7q@S{B\x89\x9d\xa6\xa5:\xd8\xcd\xb3\x88P\x12\xf9%\xbf\xe1rNLk1\xe5\xbe\xc6\xf9\x83\xf6\x05\xe7\x13\xf7s\xb7\xfc\xd7\xfe]\xc8Q(1\xc9\xa3\xea\x85\x9c\x99&\x1axJ\xd3\xc4\x881agy\x9e<\xcfx\xfb\x8c\xb3\x1dik\xfa\xdc\x03\xbe1E\xccEz\x07e\xde\x91\xe9\xd3C\xe1\xfa\xdb\xf9Im)\xd5\xdd9v\x0d\x8b\xb7\xd0a]$\xf4y\xa4z\xa6\xf9\xe5\xd6q&Ls\x9cd\x0b\xeccq$\xb0\xf5\xd8\xd3R\x12\xbby\x9a\xb4\xf0\xd7\xdfU\xea\x1f1S\x88B\x90c\x92\x8bWey\xa3\xbe*\x93\x7fz\x86\xe6\xad\xb0\xa6\xbc\x18C\x0b\xbf\xfe^\x15S$\x1f\xa7We\x12\xfe\x13\x00\x00\xff\xffPK\x07\x08\x91\xd8.L\x1e\x04\x00\x00\x1c\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00-\x00	\x00crd/bases/catalog.kubecarrier.io_tenants.yamlUT\x05\x00\x01\x80Cm8\xb4U=s\xe36\x10\xed\xf9+v\x9c\xe2\x9a\x90\x8a\x93&a\xe7QR\xdc\xe4c<g\x8f\x9b8\xc5\x12X\x918\x83\x00\x82]\xe8\xce\xc9\xe4\xbfg\x00\x90\x12\xa5\x9b\xdc\xa49U\xc2b\xf1v\xf9\xde~4m\xdb6\x18\xcc\x13E6\xde\xf5\x80\xc1\xd0G!\x97O\xdc\xbd|\xcf\x9d\xf1\xbb\xe3m\xf3b\x9c\xeea\x9fX\xfc\xfc\x8e\xd8\xa7\xa8\xe8G:\x18g\xc4x\xd7\xcc$\xa8Q\xb0o\x00\xd09/\x98\xcd\x9c\x8f\x00\xca;\x89\xdeZ\x8a\xedH\xae{I\x03\x0d\xc9XM\xb1\x80\xaf\xa1\x8f\xdft\xdfv?4\x00*Ry\xfehfb\xc19\xf4\xe0\x92\xb5\x0d\x80\xc3\x99z\x10r\xe8\x84;\x85\x82\xd6\x8f\x05Pa\x8c\xa6\x006\x1cH\xe5\xc0c\xf4)\xf4\xf0\x1f^\x15l\xcd\x10\x85F\x1f\xcdzn\x01K\xbc\xfco\xf3\xae\x0d\xd1\x1f\x8d\xa6X\xae*%\x8f%\x99b\xb0\x86\xe5\xe7\x8d\xf1\x17\xc3\xf5\"\xd8\x14\xd1\x9e\x12/6\x9e|\x94\xdf\xce)\xb4 \x15\x96\x8d\x1b\x93\xc5\xb8\xba7\x00\xac|\xa0\x1e\x8aw@E\xba\x01Xh+\xaf[@\xad\x8b\x10h\xef\xa3qBq\xefm\x9a\xdd	\xfb={w\x8f2\xf5\xd0\xadRu\x9f\xd0\\|W\x92\xefFZ\xce\xf2\x9a\x83k\x94j\xa8\xd7\xc7[\xb4a\xc2\xdb\x9a\xb2\x9ah\xc6~\xf1\xf7\x81\xdc\xdd\xfd\xdb\xa7\xef\x1e.\xcc\x00\x9aXE\x13\xa4\xa8}S9\x02\xfa\x18<\x13\x83q\x07\x1f\xe7\x92\x10\xe0\xe0\x93\x00\x1e\xd1X\x1c,-t2x\x072\x11\x04\x8b\x92\x9dO\xc0\xb9\xe6t\x96\xcc\x7f`@\xb8_T\x02\xf1\xc0$\xa0J\xd5\x82\xc5\x81\xec\n2w\xf0\xec\x16`\xf0\xc3{R\xc2\xf0\xc1,\xaa\xd7\xdf@\xb5\x14I\xc3\xc1\xc7\x8c\x0fwJ\xf9\xe4\x8a\xabL%\x99\xe8-\xc1\xf3\xf25\xcf7`\xdc\xd6\xf1\xac\x19o\x80\xaf\x1f\xaf	?\xdft7'\xb7\x10}\xa0(\xa7\x9a\\>\xf4\xdc\xab\x1b\xeb\x15\xb7o2\xfd\xd5\x0btnR\xe2\x12n\xa9\x19\xd2\x8bb\xe0\x0f \x93a\x88\x14\"1\xb9\xda\xb6\x17\xc0\x90\x9d\xd0-\x1cu\xf0@1\xc3\xe4\xfaMV\xe7\xde>R\x14\x88\xa4\xfc\xe8\xcc_'l\xce\xec\xe7\xa0\x16\x85\x96>8\xffJ\x8d:\xb4pD\x9b\xe8\xeb\xa2\xdf\x8c\xaf\x10)G\x81\xe46x\xc5\x85;\xf8\xd5G*e\xd2\xc3$\x12\xb8\xdf\xedF#\xeb\x8cR~\x9e\x933\xf2\xba+\xe3\xc6\x0cI|\xe4\x9d\xa6#\xd9\x1d\x9b\xb1\xc5\xa8&#\xa4$E\xdaa0mI\xdd\x959\xd5\xcd\xfa\xab\xb8L5~s\x91k\xad~\x96h\xdc\xb8\xb9(\xcd\xff\x19\x05\xf2\x1c\x00\x93\xab\xb1>\xad_q&:\x9b2;\xef~zx\x845t\x11\xe3\x9a\xfd\xc2\xfb\xf9!\x9f%\xc8\x84\x19w\xc8\x85\x9eE<D?\x17Lr:x\xe3\xa4\x1c\x945\xe4\xae\xe9\xe74\xccF\xb2\xee\x7f&b\xc9Zu\xb0/\x83;W}\n\xb9\xdbu\x07o\x1d\xecq&\xbbG\xa6/.@f\x9a\xdbL\xec\xff\x93`\xbbs\xae\x9d+k\x9b\x8bu)\x9c\x7f\x17z\xd5\xf6}\x08\xa4.\x1aF\x13\x9b\x98KZP(7\xc2f\xda\x7f6\xe0'F\xce\x9a\xe9\x1e$\xa6:FY|\xc4\x91\xb6\x964\x9cj\xb0\x87\xbf\xffir\xd4T\xba\x1f\x95\xa2 \xa47\xfb\xa2n\x9f\x9b:/\xd6\x05S\x8e\xca\xbb\xba\x0c\xb8\x87\xdf\xffhj(\xd2O\xeb\xc2\xc8\xc6\x7f\x03\x00\x00\xff\xffPK\x07\x08\xf6?\x07\x17W\x03\x00\x00\xf8\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x007\x00	\x00crd/bases/kubecarrier.io_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8\xe4Y\xdfo\xe3\xb8\xf1\x7f\xcf_1\xd8\xef\x83w\x17\xb6\xf2\xdd\xb6(Z\xbf\x05NZ\x1cnw\x11$\xe9\xf6\xa1[ \x145\x96x\xa1H\x95C\xd9\xeb\x1e\xee\x7f/\x86\xa2,\xeb\x97\xe3\xeb\xf5\x1e\x8a\xf2%19\x1c\xce\xef\xf9\x90\x12\x95\xfa\x82\x8e\x945k\x10\x95\xc2o\x1e\x0d\xff\xa2\xe4\xe5\x0f\x94({\xbd\xfbp\xf5\xa2L\xb6\x86MM\xde\x96\x0fH\xb6v\x12oq\xab\x8c\xf2\xca\x9a\xab\x12\xbd\xc8\x84\x17\xeb+\x00a\x8c\xf5\x82\xa7\x89\x7f\x02Hk\xbc\xb3Z\xa3[\xe5h\x92\x97:\xc5\xb4V:C\x17\x98\xb7G\xef\xfe?\xf9M\xf2\xc7+\x00\xe90l\x7fR%\x92\x17e\xb5\x06Sk}\x05`D\x89k\x90A\n\x17\xa5\xc8\x14I\xbbC\xa7\x90\x02k)\x9cS\x81\xf5\x15U(Y\x84\xdc\xd9\xbaZ\xc3`\xb5a\x17e\x9c\xd4/r>\x04\n\xad\xc8\x7f\x7f\x8e\xea\xa3\"\x1f(+];\xa1\xcf\xc8\x19\xa8\xa8\xb0\xce\x7f\xee$\x00X\x81t\x99\x8a\xab\xca\xe4\xb5\x16n\x8e\x0b\xcbD\xd2V\xb8\x86\xc0\xa3\x12\x12\xb3+\x80h\xcd\xc8s\x05\"\xcb\x82\x87\x84\xbew\xcaxt\x1b\xab\xeb\xd2\x1c\xcfd\x9a\x1f\xc8\x9a{\xe1\x8b5$l\xb1D\xba,a\xd3\x1cIZ\xc3\xcf\xba\xbf#\xf4\x07\x16\x89\xbcS&?s\x04\xa1\xdb)\x89\x1b]\x93G7}\xdac\x8f\xe6\xe7\x9d\xe1\x85\xaf)\xa9\nA\x13|\xc3\xe2\xcf\xe2\xd7\x86w2\n\xcd\x11\xf7\x9b\x1cG\xac3\xe1\xdb\xc9\x86h\xf7A\xe8\xaa\x10\x1f\xe2$\xc9\x02K\xd1\xb9\xc4Vhn\xee\xbf\xfb\xf2\xdb\xc7\xc1\x02@\x86$\x9d\xaa|\xc8\x9873\x91\x08\x1e\xb5&\xf8\xbeNq\xd3\xc4;x\x0bm\xe8\x80\x188\xf2\xeb	\x7f\x80\xaf\xb0u\xb6\x041\xb0\xff\x12\x1c\xe6\x8a\xff\x03\xe5A\x19\xf0\x05\xc2'aD\x8e%\x1a\x0f\x91\x0c\x84\xc9\x80\xbcp~\xc8U\x80\xc1=T\xceJ$by\x1cJk\xa4\xd2\x08\xca\x90\x17F\"\x81\xdd\x82/\x14\xc1\xe6\xe16\x81\xaf\x06>\xe3\xbe\xb7:d\xcaBl\x1ena\xaf\xb4\x86\x14;\x9e\x19\xa4\x87XHL\x0e\x02J\xe1e\xc1\xff\xb6\xdcZ\x15\xfaZ&\xc3\x03\xee\x84,\xba\x0c\xeb\xe9\xddS\xdb f42\xda\x0d\x91\xca\x0d\x13\x0e\xf9\xda\xf4\x07\x94~	\xa5\xa8\xaa \x96g\x93\x88\xf1Q\x03\xf9\xd8(7s\x15hx\xc8Q\xd7\xce<\";,Am\x1b\xc3\xf5\xb8\xc0^\x10lmm\xb2\xe9\xa3G\xfe4\x19\x08\x90\xda\x1ad\xb7qP\x10pR\xa4ZQ\x81\xd9|\x8c$p\x8b\x1a\xd91S\xee\xec\x0b-4q\xa4\x94v\x87Gg\x87\x93\xb5\xee\x87\x8d\xf2\xc16\xef\xdf\xdf}\x13e\xa5\xf1\xfd\xfb!\xf3\xe7\xe7\xe7\x83(5\x9c\xb6\xbb~K\xb8n\xf3\xf2|;\x182>6\xbfc~K[\xcb\"K\x13\xacW{$\xbf\xfa\x00\xa1\x15q/t\xd9:l\xeb\x11R\xd2\xeePv\xc8\x1d\xa0_,O\xb7w\xfc\x9f\x9f\x9f\xdf\x9cl\xac\x9c\xad\xd0y\x85\xb4\xee\xb1;\xd1\xbd7?(,\x0b\xae>\x0d\x1dd\\\xe6\x91\x82\xf5cw\xc1,\x96\xacc\xbe:\xac\x1c\x12\x9a\xa6\xef\x0fX\x03\x93	\x13c>	q\x85\x8e\xb8\x03\xd6:cx\xb0C\xe7C\xee\xe6F\xfd\xf3\xc8=T	>V\x0b\x8f\xb1\xb7\x9e\x8e\xd0\xcf\x8c\xd0\xb0\x13\xba\xc6e\x88\x8bR\x1c\xc0!\x9f\x03\xb59\xe1\x18H(\x81O\xd6q\x88m\xed\x1a\n\xef+Z__\xe7\xca\xb7@G\xda\xb2\xac\x8d\xf2\x87\xeb\x80YTZ{\xeb\xe8:\xc3\x1d\xeakR\xf9J8Y(\x8f\xd2\xd7\x0e\xafE\xa5VAx\x13\xc0NRf\xff\xd7\xb6iZ\x0c\xa4\x9d\xec1\xd0\x02\x8f\xb3\xbe`\xd0\xc1\xb9%\xe2\xf6F\x97\xce\xe4<\xc5Vz\xb8{|\x82V\x80\x91\xb1\x82\x9b\x1a\x0ft[\xa9s\x06\x1bN\x99-\xb7\n\xa6\x0c=\x80\xb9\xa2\xc9*\xab\x8c\xe7\x1f#\xa6R+\xcek\xaa\xd3Ry\x8e\x82\x7f\xd4H\x9e\xfd\x96\xc0&\xe0@\xae:u\xc5\x0d0K\xe0;\x03\x1bQ\xa2\xde\x08\xc2\xc6\x15\x13>\xfd\xb5\\\xc3\x1e\xa0\x15\x9b\xfbr\xe7\x9c\xc2\xda\xf1\x86\xc6\x9a\xbd\xa5\x16q\x9e\x8e\x9e7gJ\xcac\x852\x12\xa61\xd72$\xe504S?\xb6\x13g\xd4\x1c\xb3d@=W\x0c \xd6\xa3\xd1\xe4P\xe6\x87[p\xb8E\x87\xa1\xd8\x8e\xce=\xe2@\xd8+_4e\x7f\x82'\x0c{\xd9\x04\xcd9Q\xa1-{\x93+\x00\xa52\x1f\xd1\xe4\x0c\xd8>\xcc\x90\xcc:\xba\x19\x1c\xbfl\xf3\xe9\x13V0\x80\xa9\xed\x98\x8d\x06\x18\x17\xef\xd7l\xdd7Q\xdf\xec\x835o\x81\x90\xa3~R\xdaq\x83?\xf1\x935\xff\x1b\xc6\xdfcZX\xfb\xf2\xe8\x9d\xf0\x98\x1f\xa6\xad\xbf\x15\xb5\xf6k\xf8l\xcd\x14\xff~-\xfek\x9f!\xf7\xae\xad\xca\x9b\x84\x8d\x875=1\xc2\xd2B\xcd\xb8'\xf4\xcc\x06OwP\xa9\xec\xa0\x92\x8cNN\x0f\x11\x10_\x96\xea\xcdx*\xd0!\x08\x87\xe0\xf7\x16*K\xa4R\x8d\xb1\x07\xc2\xd6\xc6\x1a\xdf\x08_\xbb\xd0\xb3\xe1G6\x00\xbcM\x0f\x93,\xa3\x99\xde-\x07Q\xf8\x13\xb4\xdb\x8e$k\x88Vj\x10\x9c5z\x9a\xa5,P\xbeD,\xcaM\x99\x18#\x88\x9dPZ\xb0\xb4sH\x1a\xce\x14\x18Y;\xc7$G\x14\x9d\x0c\xd8\x0cd\x93\x0c#\xa3\xdfh\x92\xe3\x893'Qq(y\x90\xb9\xc3\xca\xd5\x06\xb6Z\xe4\xc9\xb0\xbb\xf0@S\x97s\x81=\x13x\xbc4{\x05n\xc7\x99\x9c\x9a\xcf\xa7\xf0\xce01K\xe7N\x9boy\xe1:\xfdo5\xbd\xb0\xf3\x04\x8d\x04\xfb\xda\x94\xe5\xf8\xf5\x1b\x9f5\xcd\xcb\xc8d\xb1\xeb\x8b\x7f$m`\x98V\xe4\x83\x1c\x1cA\xdd\xda\xb9<\x9d\xab\x01j\xb2\x10+\x8f\xe5L\x0d\xbe\xc4\xaeGy\xc3\xcb\x9bP\x86 C/\x94\x9e\x0e\xf1c\x90\xb7\xd9\x13\x0c\xdf]\xc5/\xb3u3^k!\x00Z\x90\x7fr\xc2\x90j\xdfQ\xe6(\x07\xca~\x1cmd\x036\xd7\x03\xf2\xe0y\"h\xd1j?\xcb\x16\xc07|\x1a\x94\xcb\xf5\xab	\xe2p\x057\x96\xeb\xd1\xb4z<\xb6\xd6\x95\xc27\x8f:+>u\x96\xf2\x95\x86\x07\x01`\x12\x89\xfcR\x13|j\xa8[\xbd\x8b\xba\x14&\xdc\xe9C\xc1,\xdbU\x93)\x19\xde=\xceX \x06\x04\x88\xd4\xd6>Z\xf0h\xdcy\xe5/P\xc9\xa1\xa0\xf1\xf5\xb2\x1b=\x8d\x1e\x02q\xab\xd0\xdb\xd4)\xdc\xbe\x8b,b\xa3:\xf1\xe8b.\x80!\x06\xd6\x7fJ\x87\xe9\x9a\xd6\x8d>dkb\xa7M\xa2V\xd8%\xc4\x97\x91\xb7\x8b'W\xe3b	\x8b?	M\xb8X\x9eQb\xf1\x17\xf3b\xec\xde,\xde\xfd\"\xf9\x03\xc9e\xd2?\x1d*\x9c\x90=\xd6\x02}\x80\xb7\x8b\x07\x14\xd9\xe1\x17It\x1e\xdbq\xef\x19\x97\x85Y\xd2\x18\xe7\xb3\xebM\xf4\xcc.\xd3\xf0\xf9\xb7\xbf\xcc\xbaL.\x9e\x05\x9a\xed\xb2pN\x8c\x8b\xfd\xa5\x97\xac\xd3\xa7\x16\xebT\xae\x8c\xd0\xf3 \x9e\xef\x9aj\x1bR}F\xdd\xe3%>v\xf6\x16UNy\xf2\x15\xf5\xbe\xad^\xea\x14\x9dA\x8f\xb4\n\xfd\xda\xedpU7\xd1\xba\xda*\xd4\x19\xad\xc1\xbbzl\xbc\x0e\xd5Fd\xb1y\xb8}\xd5\x1c\x9f&6\x9d\xde\x86\xce]o\xa6\xddW\x88\xf0B\x19\xde\x84\x9b\xe7\xe1\x8bq\x04\\\xd0\xd9\xfe\xfb.G-\xd0\xfa3\x1al\xee\x00\xafz\xe5\x89/)\x96\xc2[\x1d#\x85\xfc\xb8\xb5\x83m\xe1\xc6\x82'\x1f\xfd\xa6\xac\xd96Qe\xfc\xef\x7f7+\xb82\x1e\xf3	\xdc\x1b\xbe\xeb\xbc*\xec\xed\xdd\xfd\xc3\xdd\xe6\xe6\xe9\xee6\x81{\xde1D\x9a-\xde\xd1j\x8b\xf2 \xf5t\xde\xf7\xd1P|A\x93\xd6\x90\xca\x8e\x8fe\x1c\xfe'\xe7-A\xf9\xf6\xcd}\xa6\x1e\x96\x96m%\x08\xc8Z\xc3\x7f\xbbK\x10\x94(\x0ba\x14\x95\x8cGJQ\xf5@\xa6\x051#fx\x1b\xdc\x17h\xa0r\xaa{\x16\x8c\x81{`\xde|\x17\x83h\x83\xd0^3E\x95\x9e\xa8X<\xaa\xdaU\x96\x90\x96\x81\x1291|\xf8\x84\x82\x9a\x10j:\xe9\x17S\xe5\xf4LD\xcf\x84\xe5\xc4t\x13S\xbd\xc2B\xde:\x86L\xbd\xb9:=\xbe\xbcvq\x11\xbb8\xfc\xf8\xd3U\xd7\xd0\x85\x94Xy\xcc>\x0f\xbf\xfd\xbei\xde\xcf\xdb\x8f\xb7\xe1\xe7\xc9%\x01\xfe\xf6\xf7\xab\xe6p\xcc\xbe\xb4\x1fYy\xf2_\x01\x00\x00\xff\xffPK\x07\x08\xa7\xd9\xd0-\x19\x08\x00\x00>\x1f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x009\x00	\x00crd/bases/kubecarrier.io_customresourcediscoverysets.yamlUT\x05\x00\x01\x80Cm8\xec\x1aMo\xe3\xba\xf1\xee_1H\x0f\xde\xb7\x88\x95n[\x14\xadoA\x92\x16\x8b\xee\xdb.\x92t{h\x0b<Z\x1a[|\xa6H\x95C9\xab.\xf6\xbf\x17C\xea\xd3\xa6d7\xef\x15\xbdt.\x89\xc9\xe1p\xbe8\x1f\xa4\x16\xab\xd5j!J\xf9\x19-I\xa3\xd7 J\x89_\x1cj\xfeE\xc9\xfew\x94Hssx\xb7\xd8K\x9d\xad\xe1\xae\"g\x8aG$S\xd9\x14\xefq+\xb5t\xd2\xe8E\x81Nd\xc2\x89\xf5\x02@hm\x9c\xe0a\xe2\x9f\x00\xa9\xd1\xce\x1a\xa5\xd0\xaev\xa8\x93}\xb5\xc1M%U\x86\xd6\x13o\xb7>\xfc2\xf9U\xf2\xfb\x05@j\xd1/\x7f\x96\x05\x92\x13E\xb9\x06])\xb5\x00\xd0\xa2\xc05\xa4\x9e\x0b\xdbp\x91IJ\xcd\x01mM\xe8\xc8\x13O\x85\xb5\xd2\x13_P\x89)3\xb1\xb3\xa6*\xd7p4\x1b\x08\xb6\\\n\x87;ce\xfb{\x05\xc2\xef\xc9\xff\x0d\xd6\xadJk\x0e2C\xeb\xa7\xa2ji\x19zB\xe7\x91\x94$\xf7\xa73\x88\x1f$\x05\xe4RUV\xa8Y!=\x1e\xe5\xc6\xba\x8f=\xfb+Hm&\x89\x9a=I\xea]\xa5\x84\x9d#\xb4\x00\xa0\xd4\x94\xb8\x06O\xa7\x14)f\x0b\x80\xc6 \x9e\xee\nD\x96y\x13\x0b\xf5\xc9J\xed\xd0\xde\x19U\x15\xba\xdb\xf5G2\xfa\x93p\xf9\x1a\x12Vv\x92\xda,a\xad\xfa\xe9\xd6b\x93~\x13\x90\\\xcdL\x90\xb3R\xef\"d\x9dp\x15%e.hL\xf5\xc9OL\xd1\x088\x87wB\x95\xb9x\x17\x94\x92\xe6X\x88u\xb3\xc2\x94\xa8o?\xbd\xff\xfc\xeb\xa7\xd10@\x86\x94ZY:\xef\x95W\xd36\x83Bh\xb1C\x82\xa2RN\x96\n\xa7\xec\xdbQ\x060\x9b\x1f1u\x04[cA\x00\xa1\x03\xb3\x85'\xb4\x07\x99\xe2\x9d\xaa\xc8\xa1\xa5\x04\xfe\xae\xe1\xed\xdb\x87/\xa2(\x15\xbe}\x0b?\xfc\xf0C-\n\x05\x83\x83: 9v\xea\x9bV\xe2\xb3\xbe	\xdd\xa1m\xb55 \x9a\x9a*\xcd\xb3\x0d\xf8\x03\xc4\xbfm\xb6\x86^\xad\xcd4%\xcd?\x894\xac\xdf\x91\x1cO\xa80u\xc6\x0e\xc9~\xfd\xc6\xc2\\u#\xa55%Z\xd7\x1d\xba\x00q9\x8f\x0c\xb3d\xdb\x05,\xc8\xd8\xa3\x90\xc0\xe5\xd8\xba/f\x8d\xb9Y\xc1.\x97\x04\x16K\x8b\x84:\xc4\xa6\x11a`$\xa1\x1b\xeb$\xde h\x89\x0fY\xa52\x0e`\x07\xb4\x0e,\xa6f\xa7\xe5\xbf:\xda\x04\xce\xf8M\x95p\xd8\x1c\xe0\x1e\xfcq\xd1B\xc1A\xa8\n\xafA\xe8\x0c\nQ\x83E\xde\x05*=\xa0\xe7Q(\x81\xef\x8dE\x90zk\xd6\x90;W\xd2\xfa\xe6f']\x1b\x88SS\x14\x95\x96\xae\xbe\xf11Un*g,\xdddx@uCr\xb7\x126\xcd\xa5\xc3\xd4U\x16oD)W\x9eu\xed\x83qRd\xbfh\xc3\x00-G\xbc\x9e\x1c\x9e\x00\xde\x83f,\xc0A\x0d$\xb1#\xfb\xa5A\x8a^\xd1<\xc4\xday|xz\x86vko\x8cc\xed{\xbd\xf7\x0b\xa97\x01+L\xea-\xda`\xc4\xad5\x85\xa7\x89:+\x8d\xd4\xce\xffH\x95D}\xac~\xaa6\x85tl\xf7\x7fVH\x8em\x95\xc0\x9d\xcfN\xb0A\xa8\xcaL8\xcc\x12x\xaf\xe1N\x14\xa8\xee\x04\xe1\x7f\xdd\x00\xaciZ\xb1b/3\xc10\xb1\x1e#\x07\xad\x0d&\xdal\xd7\xc3\xc8^\xd3\xa1\xe0\xa9\xc4\xb4\xc1\xdd4\xc7(C\x92\x96\x1d\xdd	\x87\x91\xc32C-\x19\xa1\xc7\x8f841\xe5h\xe8\x98\xe3\xc7{\xb0\xb8E\x8b:E:\xdd\xb4K$\xf0\"].5s~B\x11\x8e\xe2kr\x821\xcd\"DBc\x0f\x85\xd4\x1fP\xef8K\xbd\x8b\"L\x185\x00\xfb%\xab\xf8\x94\xf6\n\x06\x19\xb4\x87	\xab\xc3E\x917\xc0H\xbbO\xd1Ec\x85\xc73TDXg\x80\x90\xbd\xdf{\xcf\xa4\x9d\x8c\xfeO\xd5_\x08\x97\xe6\x0f_82P_S\x1e\xc3H\xb0\xe3%!Fq\x19\xc6\xb2(\xb1A\x05\xd4\x8b\xeb\xcdP\xf8\xb0\x13\xa5\x0d\xf0\x9c\xe3\x08\x0f\x84E\xb8\xfdx\x8fY|\x85tXL0z\xc4\xea\xed\x0c;Mhmg\\.\x8e\xcd\xde\x03\x87#!55\x89\xe4\x1a\x04\xec\xb1\x0e9\x87\x13[\x89V\xb4D\xc0\xa2\xcfW\xdeR{\xac'\x89\xf2\xe2&1M\xe0\xcc\x9b.\xc0\x1e\xeb\xe9\xc9#u\xec\xb1f\xa9CJe\xbd\xf0\x80\xe7\x99\x87:U\x88\xb2T\x12cn\xd8\x833SL\xc3\xb9\x93\xd9B\xab\xb5\x8b\xd9\xef\xd4\xdcg\xb2`\x88%\x05\xa5\xb37\xe6\xb2\x04gfy\xef\xce][\x16|\x16Jf\x1d\xf9\xe0\x7f\xef\xf55|4\x8e\xff<|\x91\xe4\xe6\xd5\xc1\xb6\xbc7H\x1f\x8d\xf3\xd8?Y9\x81\xb5\x8bU\x13\xd0\xbdKk\x10\xd6\x8a\x9a\xe5\x1b\xd6\x0d\x94\xc0\xfb\xedD\x04\xef\xa1S\xb1$\xce\xdc\xc6\xb6:\xf0\xb5_\xd8$\x90/*\xf2\x89^\x1b\xbd\xc2\xa2t\xf5\x9c\xc8\xd0\xec=\xa2\x1f\xd4\xca{\x0c57\xdcj^\xe5#6\x02\x0b\xf0\xccUL\x98	5\xa9\xe2\xb6\x0b\xb2\xca+\xc2WR\xdc\x88\xcat\x96t\x81v\x87Pr\x9c\x9b\x93j6\x0e\x05\xb8\xc8\xd6-\x9a\xe7{\x02k:\x9d\x05X\xcd\x84\x9aU\xa7\xf6	\x84\x99\xd47D\x98\xe2\xcf'\x84\x0f\x1cP&\xb41\xecr\xcfE\xb4\xb3\x1a;\xcdEa\xeb\x10\xcf\x0bQ\xb2\xe7\x7f\xe5\xf0\xec\x9d\xe8\x1b\x94Br\xd7w\xeb\xbbv5\xe5\xff\xc3\x15\xa1\xd4\x19\x11g\xba\x92\x80\xadp\x10\x8a\xd3\x873|\xd6P\xf9d2A\xd4lO\xb2\xe55\xbc\xe4\x86|f\x80\xadD\xe5K\xfc\xab=\xd6W\xd7\xa3\x132A\x91\x91\xdf\xeb\xab\x90zN\x0ee\x97\xa7\x8cV5\\\xf9\xb9\xab\xe4$\xc1N\xd0>\x93vg\xbddf\xf2\x057\xb91\xfb\xa7p\xf4\"\xd9*\xc3\xad\xa8\x94[\xc3G\xa3O\xcd3n\x8a\xfe:&\xc6\x12o\xe5.\xe4\xb4f#\n=)r}K\xf0\x92\xcb4\x8f\xc8\xc3\xc2Z\xdcI\xae\xb80\xebM\xae\xc5.\x94\x07i\xa8\xc6`S\x87\xde\xe8\xd2\x8a<\xc0s\x8e\x16\xfd&\xee\xc5@i\x88\xe4Fu\xd6\xda\x9a\xa6\xe1\n\xecW\xd6\xe7.\xf8\xca\n\x807\x9b\xbaU\xc9w\xd7\x11\xd2\xe3j\xf1\x1b\x9c,ZC\xa3%x\x91J\x05_HsL\xf7 \xbdbl\xec\x0c4\xa9\xe3 \xa4\x12\xcc\xe9x\x97[\"\xb9\xd3\xa1l\n\x9aJ+k\xf9gw\xb9\x15\xd3\xc2\x98\xc8\x11_\xa9P*f\xb3v\x87\xf1\xe2\x08u\xeeJ \xb3\xf5\xcaV\x1a\xb6J\xec\x92\xe5	\x16\xea\xaa\x88\xb5\x00QW[\x9d\xdbs28\xc5\xc3\xb3\xbf5<\x1a\x89\xf7\x12#\xa4\xa9\xfe\xd3_\xc8\xbd\xae\x03\xf5K\x87u\x93\x0f5\x1bf\x06\xb3#1}O\xfa\xb3u\xa1F\x87\xd8\x1f	\xf8c\xe6;\xc4q?\xc1N\xd2\x13\x99=\x8aq\xaf\x96\x91\x9eh2s_\xa8\xcf\x8e\xd9>\xe2f\xe8\x84T\xf1\xd0\x1a\x8e{\x7ff:y\xba\xdb\xb3K\x15\x1d\xe0\\_\xa0\x04\xb9g+4\xc9\xf6\xa2\xff\xa2n\xe9\xc3\xc9\xb2\xbe[ \x07\x8e\x07\xbc\x18-\xfb\x13D\x01\\\xa0\x12\xee\x938<\x05\xdf\x0d9\xd3p\x08\x9a\xaa\xab\xb6\xc6\x16\xc2\xad!\x13\x0eW\xbc\xe3\x04\xde\xd92\xa1@\"\xb1\xbbL\xf0\xef\x03n+m^\x15B\x83E\x91\xf9HX\xb4\xb3:\x93\xa9ps\x95\\\xe3\x04 6\xa6r\x8d\xd6:\x85N\x89|V\x14\x8b\x82\x8e/k{\x18I\xf2\xe8Q[A\xdel\xac\xc4\xedw\x0d\x81\xde\x0d[\xfb-\xa7+\xec\x9f\x8b\xf7X\xd0\xeaa|a\x12|\xa4I\x04\x1d\x93\xd7\xde\x81\xcc\x16\xde,\x9fm\x85\xcbkX\xfeA(\xc2e,7\x06X\xfeE\xef\xb5y\xd1\xcb\xef^\xcd\xb7G\xb8\x84\xeb\xe7\xba\xc4\x08\xcf\xcdQW5\xbcY>\xa2\xc8\xeaW\xf32W\xf9\xaf\"G=\x8a\xd6\xf8pt.xGt\x8a\x86o@\xe3)\xe6:2qA\x91\x18k#\xfa\xba\xabI\x8c\\\xbb\x9d\xc9\x18\xdf\xc7\xd6\xf4\x01\xb9\xab\x00\xa5\x0eA\x85\xe3\xed\xc4-\x8f\x7f\x13\xc5,\xd4z\x93\x0fM\xdd\xf3\xd2k\xa2\xf6ON9\x8f\xf7\x8f\xed\xd5\xe1\xe8\x121\xeaU\xedC$f\x93\x97\x85\xafI,\xd1;\xe5\x00#1\xfe\xec\x15\xd5\xf3;\xbe\xfcVR\xef\x07\xa9`\xae\xf1\x04\xff\xd0\x13\xae\xa7D\x81\xf3\xd5&\\ \x01\xcc^9\x078{\xf1\x1c\xe0l\x0c\xb9\xa4k\x8f^E\x078\xdb\x95\x8fK\xc9\xff\x1b\xa6\x85\xff\xb1a\xe6\x03\xf6qG\xd0\x8e\xd3\xb9n\xe7\x95\x91\xb5-\xf2\xff\x88\x1aC\x9by&\xac>s\x1bl\xc8\xbf\xc7r\xb1\xba\xeb\x16v\xb4\xda8\xd9\x7fzrj\xf5\xb6\x90\x93\xda\xfd\xf67\x13\xfcJ\xedpw\"\xac\xff\x14\xe1\x0c\x93\xf7\x0f\x9f\x1e\x1f\xeen\x9f\x1f\xee\x13\xf8\xc4\xf8\xc7\xbdM[j+\xb9\xc5\xb4N\x15F\x9f\xda \xdc\xd1\xf8`\xde\xbc\x92\xa6F\x93\xcc\xba\x07Q\x7fC\xd3\xefv\x0d\xd2\x85\xeeu\xc3[\x16\xe6\xb4}b\x10\x04d\x8c\xe6\xbf\xbe\xdfn\xee\xa60\xcd\x85\x96T\xf0\x19+D9\xeakL\xff\xce\xfb\x92c,\x1d\x97V\xf6\x8f\xbe\xcdq\xf2\xd7\x9c\xbe\xc1o\xa4\xf7E^&\xa9T\xa2\x86\xb2\xb2\xa5!\xa4k\x1e\x8dPD\xce-.g\x9a\xa8\x08\xa1\xa2A\x0ds\x9a\xf6'\xcfU\xd45O\x06\x83\xef\xac\xc1\xd9*\xd8\x81\x9c\xb1\\\xa2\x0fF\xaaM\xf7\x86\xdez@SA\xc2\xd7o\x8b\xbe\x98\x14i\x8a\xa5\xc3l\xf0\x89N\xf8 \xe3*|\xfd\xd0~\xe7\xe3\x7f\x0ezP\xf8\xdb?\x16ac\xcc>\xb7_\xe2\xf0\xe0\xbf\x03\x00\x00\xff\xffPK\x07\x08\xf8\x87Q3@	\x00\x00\xab%\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x007\x00	\x00crd/bases/kubecarrier.io_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8\xdcXOo\x1b\xbb\x11\xbf\xebS\x0c\xd2\x83\xf2\x0ckU\xb7E\xd1\xeaf(n\xf1\xd0\xe4\xc1\xb0\xdd\\\xfa\nd\xb4;\xd22\xe6\x92[\xce\xac\x1c\xb5\xe8w/H\xee\x7f\xed*F\xd0\\\xc2\xcbb\xc9\xe1\x8f\xc3\xf9\xf7#\xb9X\xadV\x0b,\xd5Gr\xac\xac\xd9\x00\x96\x8a\xbe\x08\x19\xff\xc7\xc9\xf3\x9f8Qv}\xbcY<+\x93m`[\xb1\xd8\xe2\x81\xd8V.\xa5w\xb4WF\x89\xb2fQ\x90`\x86\x82\x9b\x05\x00\x1ac\x05}7\xfb_\x80\xd4\x1aqVkr\xab\x03\x99\xe4\xb9\xda\xd1\xaeR:#\x17\xc0\x9b\xa5\x8f\xbfM~\x97\xfcy\x01\x90:\n\xd3\x9fTA,X\x94\x1b0\x95\xd6\x0b\x00\x83\x05m\x80\xc9\x1dUJ\xa9\xaeX\xc8!\xb3:\x98\x82\x8cp\x80N\xd19\x15\xa0\x17\\R\xeaU88[\x95\x1b\x18\x8dF\xb8Z\xc7\xb8\xbf\xc7\x88\xbc\x8d\xc8\xb7-r\x10\xd1\x8a\xe5o\x17\xc5\xde+\x8e\xa2\xa5\xae\x1c\xea\x0b\x9a\x06)\xce\xad\x93_:\x1dV\xc0)\xc6\x11e\x0e\x95F7\x8f\xb0\x00\xe0\xd4\x96\xb4\x81\x00PbJ\xd9\x02\xa06f\x00\\\x01fYp\x0f\xea{\xa7\x8c\x90\xdbZ]\x15\xa6]\xee3[s\x8f\x92o aA\xa98)sd\n\xa3\x8d\xb1\x1f\xc3@\xdd%'\xbf\"\x8bS\xe6p\x8e\xd1\x04Ar\xe6\xc0\x01\xe2\xed\x81\x06p\x19J\xec\x88\xc3\xc7\x1b\xd4e\x8e7\xd1\x10iN\x05njy[\x92\xb9\xbd\xff\xf9\xe3\xef\x1f\x07\xdd\x00\x19q\xeaT)!\x8e\xde\xcc\xb9\x07\x14C\xb4\xa02\x07\xc0\xcer\xa0\x0cHN\xf0\x01\x0d\x1e\xa8uxl\xb5\xe9\xe1EI>\x98d\xe3\xa4\xe1j	\xfcj\xe0)\xa73\xf0\x1e\xe2p\x06\xbc(\xadaG1\xec)\x03\xac\xc4\x16(*E\xadO\x80&\xf3z;*\xad\xf3\xa3\xb5\xaa\xca\xb0\xa0I\xfb\xb0\xb5\x0b\xbd\x02WWw_\xb0(5]]\xc1\xa7O\x9fNXh\xe8g\xf90\x13\xd6\x8d\xc9c\x16\xccj\xda\xb3d\x9b\xef\xad\xdb(.x\x93P\xb5z!\x96\xd5\x0d\x84\xf4\xf3\x8a\x0dP\xfa\xf8\xbfB7\xbf\x9d\x06P\xb4~h\xad\xb8\x018[\xcao\xedM\x8bV:[\x92\x13\xd5dSl\xbd]\xf7zG\x11\xb3\xf4A\x15\xa5 \xf3E\x8d8\x18\xb9\xce&\xca\xea8\x04\xbb\x07\xc9\xa37\x1c1\x99X\xe6\x06\xc0\xe0\x85\xd0\x80\xdd}\xa6T\x92`Br\xec\x93\xbd\xd2\x99\xaf\x85Gr\x02\x8eR{0\xea\xdf-6\x83\xd8\xb0\xa8F!\x96\x11f\xc8^\x83\x1a\x8e\xa8+\xba\x0eQQ\xe0	\x1c\xf9U\xa02=\xbc \xc2	|\xb0\xce\xc7\xc9\xden \x17)y\xb3^\x1f\x9445=\xb5EQ\x19%\xa7u(\xcfjW\x89u\xbc\xce\xe8Hz\xcd\xea\xb0B\x97\xe6J(\x95\xca\xd1\x1aK\xb5\n\xaa\x9bP\xd7\x93\"\xfb\x8d\xabY\x80\x97\x03]\xcfJDl\xe3\xc8\x1a{\xc0\x17\xd6\x90\x9f\xf5\xd4\xb8\x8b\xce\xd0\xbe\xcb[\xe7\xe1\xee\xf1	\x9a\xa5\x833\xc6\xd6\x0fv\xef&r\xe7\x02o0e\xf6\xe4\xa2\x13\xf7\xce\x16\x01\x93LVZe$\xfc\xa4Z\x0d\xf3\xdf7\xaev\x85\x12\xef\xf7\x7fU\xc4\xe2}\x95\xc06\x10\x9d\xcf\xdc\xaa\xf45,K\xe0g\x03[,Ho\x91\xe9\xbb;\xc0[\x9aW\xde\xb0\xafsA\x9f\xa3\xc7\xc2\xd1j\xfdbRSg\xd7\x06\xfe\x9a+\x0c\x8f%\xa5\xb5\xe4\xaeN\xa2\x8cX9\x1f\xe6\xd2\xd4\xf9\xae\xd9\xfd,R2\x10\x9dNn\x98\xa9\x15#\x91\x91\xee\x0f\xb4'G&\xad\xf5\xabC\xc9\x8c\xc9\xa0\x03n( 9\xc3\x9dW\x0b\x9aj5\xd1\x0fP(\xf3\x9e\xcc\xc1\xb3\xe6\xcd\xa4\xc0\x8c\x0bc\xf3Q\xe8Mz\x8e\xbd\nk\x9eu\xcf\xf8\x18.\xd6\xe6\xd8.Yn\xc4|?\x9cu\xa6\x91VS!7\x92\x18Z\xf5U\xf9\x16\xc8\xfb[2.L\xec\xd5\xbb\xe0\x1a\xbb\xf3*|\xbf\xacK\xad\x89G\xcb	\xd7\x0e\xd4\xde\xb6\x82\xb1\xba\xfbCt`H\xad{ \xb1 _<}\x0f\x9bbP\xe6<\xe2\x94P1\x19l\xaf2e\xabk\xb8\xb1\xa02\x0c\x19	*=\xe6\x98\xd8\xf6\xd6E\xc6\xa8\x9c\x0bU\xa2\x9d\xde\x1c\x13^g\xe5\xd8.'\x0b\x80F\x96'\x87\x86Us\xb2\x9e\x96\x1b\xed\xf5\xfd\xd94o\xbbx\xce`\x01\xf1\x1da\x13\x8d\xf23\xa0\x00\x12Q\"mZCu\xc8\xfac\x0b\x1a+\xf9T\x05\x88mo]\x81\x12\x0f\xfa+\xbf\xe2\x8c\xdc\xc5\xb4\x86\xc0`\xccxx\xdd\xc6?D\xd9f\xb7yU\xa0\x01G\x98\xe1NS\x83\x04\xcad*E\x99[0\xa2\x86\x10\x00\xdc\xd9Jj\xab\xb5\x06\x9d\xdb\xf2W\xb7\xe2\x08y|&\xed\xda\xa8\xeaz\xd1f#owN\xd1\xfe\xa7\x1a\xa0\x0b\xc2\xc6\x7f\xcb\xe9`\x85:\x84\xfe\x1f\xbaO\xd5\xaa\xae\x0dS-\xc6HH\x88\x9e\x92\xd7!\x80\xec\x1e\xde.\x9f\\E\xcbkX\xfe\x055\xd3\xf2zV\xf9\xe5\xdf\xcd\xb3\xb1/f\xf9\xd37\xeb\x1d\x04^\xa3\xf5\xd3\xa9\xa4	\x9d\xebD\xd7'x\xbb| \xccN\xdf\xac\xcb<Gy\xf68O\xf5I\xb1:\x86'\xc7btL\x0eq\xffB?\x1c\xf2ZO\x0c\\8>4\x83\xe8\x1c\x9eFc\x0d\x07\xfd\x95\x0c\xb9pQ\xfa\nW\xf8ksa9\\\x8e|A=\xb4\x13;>\xdb\x9d\x1a\xaf\xd4OJ\xe7\x1eh\xca\x8d2\xf2\xc7?\xcc\xe8\xeb\xefS\x87\x113\x03\x84\xd7\x8f\xaf(\xf9\xee\xee\xfe\xe1n{\xfbt\xf7.\x81{/?\xa6\xde\x86\x0e\xb4\xdaSzJ5Mr0D\x1e\x0e4Q_YRkXe\xed\xedD\x91\xcez\xab]\x83\x92\xf6\xa9\xc0Qa\x8f\xe1\xc1g\xdc\x90\x81\xad5\xfe\xebK2E\xd6-(\xcd\xd1(.|\xb5.\xb0\x1cP\xaf\xed.]/9M\x05M\xe9Tw\x03\xab\x89\xea\xe4\x91\xad\xd1'\xa8w\x1fJQ\xa6\xb8\xd4x\x82\xb2r\xa5e\xe2k\xdf;\x81HGr'\xc9=&i&\xa8\xb8\x97i\xe7\xc1y!\x9d\x86G\xad\xd7\xde\x01\x1e\xa7g\x81\x1b\x9ep\xc7\x8f=\x13;\x19=\xe6H\x8e\x02/\xd8<4Q\xf6\xe3\x1d\x8b'\x07\xce:c\xben@\\\x15\xb1Y\xac\xf3\xe4\xdd\xeb\xa9v\xed#B\xa3V\xcd-\xf0\x9f\xff.:\x9a\xc14\xa5R(\xfbe\xfc^\xfb&>\xff4\xcf\xad\xe1\xb7w4\x85\x7f\xfcs\x11\x17\xa6\xecc\xf32\xea;\xff\x17\x00\x00\xff\xffPK\x07\x08\x84C\x8c\x7f5\x06\x00\x00\xf7\x16\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00-\x00	\x00crd/bases/kubecarrier.io_serviceclusters.yamlUT\x05\x00\x01\x80Cm8\xb4\x19Mo\x1b\xbb\xf1\xae_1H\x0f\xca\x0b\xbcr\xdd\x16E\xab[ \xbbm\x90\xa40l\xbf\xf4\xd0\x16\xc8hw\xb4b\xc2%YrVyj\xd1\xff^\x90\xdcoqe?\xbd\x94\x17{9\xc3\xf9\x9e\xe1p\xb4\xc8\xb2l\x81F|\"\xeb\x84Vk@#\xe8'&\xe5\xbf\xdc\xea\xeb\x1f\xdcJ\xe8\xeb\xc3\xcd\xe2\xabP\xc5\x1a6\xb5c]=\x90\xd3\xb5\xcd\xe9\x96vB	\x16Z-*b,\x90q\xbd\x00@\xa54\xa3\xdfv\xfe\x13 \xd7\x8a\xad\x96\x92lV\x92Z}\xad\xb7\xb4\xad\x85,\xc8\x06\xe2-\xeb\xc3\xafW\xbfY\xfdq\x01\x90[\n\xc7\x9fDE\x8e\xb12kP\xb5\x94\x0b\x00\x85\x15\xad\xc1\x91=\x88\x9crY;&\xeb\x02\xc1\x1c\xad\x15\x81\xe0\xc2\x19\xca=\xe3\xd2\xea\xda\xaca\x02\x8dDZ\xc9\x90\xa9\xd4V\xb4\xdf\x19`\xe0\xe3\xff\x1b\x9c\xcb\x8c\xd5\x07Q\x90\x0d\xa0h\x8a\xc7(\xc4&\n\x11\x00R8~\x9f\x00~\x10\x8e\x03\x82\x91\xb5Ey\xa2@\x80\xb9\xbd\xb6\xfc\xd7^\xb4\x0c\\\x1e\x01B\x95\xb5D;=\xb6\x00p\xb96\xb4\x86p\xca`N\xc5\x02\xa01g\xa0\x92\x01\x16Ep\x10\xca{+\x14\x93\xddhYW\xaa\xe3\xf1\xc5iu\x8f\xbc_\xc3\xca1r\xedVf\x8f\x8e\x02\xb45\xf7c\x004[|\xf4\x1c\x1d[\xa1\xca\x04\x0dC\xf9\xaa\x8d\x85U!\x9c\x91x\xf4\xe2\x8d\x08\xde\xc6}\x18\x00\x9e!\x1bE\xf3\x1e\xb1\x8a\x98\\\x13\xae\xabRp\xf3\xef\x88\xc1\xfb\x0e\x11\xc6\xe0\xf3l:\xc1O\x02pD\xfdm9\x96\xba@\x8e\x1b\x11|\xb8Ai\xf6x\x13\x9d\x97\xef\xa9\xc2u\x83\xaf\x0d\xa9\xb7\xf7\xef>\xfd\xf6q\xb4\x0dP\x90\xcb\xad0\x1c\xf2\xe0\xd58z\xc0\x92\xb1\xe4H\xb1\x03\xecTs\xd0\x83K\xe1\xff\xa1\x02\x84b\xdd\xd1\x84\x80\xbbi\"\x1f\xfe\xa1\xe0\xcd\x9b\xbb\x9f\xb02\x92\xde\xbc\x81\xcf\x9f?\x1f\xb1\x920\xcc\xfdq\xa6\\\x8f\x14\x89+\x15\xfa\xd0\xe5~g\x02\xaa\xb3o\xe48\xbb\x81\x90\x8b\x00#\x1c\x80A`\xac\x07\xd4\xef~\x84\xbf\x91c\xb8\xf1\x9c\xbc,Z\xedD\xf9H\xb9%\x8e\x07'\xe4\xb3\x1e\xcb+\xf4\xaa\xa3e\xac6d\xb9\xcb\xeb\xb8\x06\xba\x0ev'\xd6_z\x07E,(|\x81#\x07\xbc\xa76\xaf\xa8h|\nz\x07\xbc\x17\xaewO\x08\x99\x11a\xf0H\xa8@o\xbfP\xce\xab`8\xb2\xce\xe7z-\x0b_\x17\x0fd\x19,\xe5\xbaT\xe2\xdf\x1dm\x07\xac\x03S\x89LM\xed\xe8W\xc8c\x85\x12\x0e(k\xba\x02T\x05Tx\x04K\x9e\x0b\xd4j@/\xa0\xb8\x15|\xd4\x96@\xa8\x9d^\xc3\x9e\xd9\xb8\xf5\xf5u)\xb8\xad\xef\xb9\xae\xaaZ	>^\x87R-\xb65k\xeb\xae\x0b:\x90\xbcv\xa2\xcc\xd0\xe6{\xc1\x94sm\xe9\x1a\x8d\xc8\x82\xe8*\xd4\xf8UU\xfc\xca67\x82[\x8ed=I\xb7\xb8B\x14\x9d\xf1\x80\xaf\xa1 |\xb4\xc7\xa3Q\x8b\xde\xd0~\xcb[\xe7\xe1\xee\xf1	Z\xd6\xc1\x19S\xeb\x07\xbb\x0f\x12\xa8w\x817\x98P;\xb2\xd1\x89;\xab\xab@\x93Ta\xb4P\x1c>r)HM\xcd\xef\xeam%\xd8\xfb\xfd_59\xf6\xbeZ\xc1&\\z\xb0%\xa8\x8d\xaf\x07\xc5\n\xde)\xd8`Er\x83\x8e\xfe\xef\x0e\xf0\x96v\x997\xec\xcb\\0\xbc\xaf\xa7\xc8\xd1j\x03@{\xa1\xf6k\xe4\xafq9x4\x947\xf0m\x93:\x059\xe1\xcb\x93/\xe3\x14Rbrf5\"\x9eN^H\x15\x85	|\"\xd8\xfb	zPD\xecD#V\x0f\xf6\xe9V;\x82o{\x9a&0\xc4\xfeEQ\x1e\xc3.\xe6\xe59\xf1\xe1\xac\n\xd0V\xb1\xc4>@%\xd4\x07R\xa5\xbf\x8dn\x92\x083\xee\x8c\xcbG\xa47\xf4)\xed,\xf0<\xd9\x9e\xf17\xcc\x06\x08LM\xfc\xb1A\x83\x9d\xb6mY\x07\xa1\x86F\x82\x0d2J]\xfe\\+\x0d\xf9$m1\x12\xe4\xb6\xff\xf0\xa5\xc3\xf3\xdf\xd7\x15\xaa\xcc\x12\x16\xb8\x954D\x07\xbdKR\x84X\x0c\x9e\xf3.<\xe7\x88\xd9\x1bn\x800\x12\xbe\xc7\x9e\x11\xde\xbb\xaf\xbbq~\xb1|\xb3~OGPv\x92x/\xaa\x1a\xa1i{y\xdd\x08\xe8\xc3f\xc7\x9bAo}\xd3\xfb=jG\xaeUl\x85\x13\xc16\x12k\xd3!\xc6;\xc8w\xf5\x81\xb1\x94\x03\")O$\x1c!\x1c\x08u\xea!\xc1T=\x1b\xf4\x13\xea\x9d\\\xe1=\x85B9(\x88QH\x17\x92/\xdcW\xb5\xb5\xa7\x17V\\\x9d\xe8?#\x8c\xce'(\x80D\xc7\x7f!\xb4\xbc%d\xdf,\xa7\xd1&j}\x98\x9ejc\x9e\xdbv\x1brm-9\xe3EN\xa6W\\]\x93\xe4\xb8\xb9v;\xdd:m\xd3\xd9\x01\xded\x15rl\xde3\xcfx\xae \x9c\xcf\xf3h\x83'\x8b\xca\x89\xf6\xc5\xf0b#\x8c\x8f\xb5V\x08\xeax\x89\xa2K[E\xe6\xad\x10\xa9\xc4\x16F+j\x12\xcf[\x07\x95\xe6\xfd\\\x85\xf8\x8e6\xa8\xc89,_\xa6\xf8\xc7\x88;\xaas\xd0\xd5\xb9\xaa\x85\xaaB\xe4\xc8\xe7\xbc\xdf\x06?nu\xcd\x8d\xd5:\x83\xce\xa9\xfc\xac*\x96\xd0\xcd]8\x13M\x1e\x02j\xab\xc8\xeb\xad\x15\xb4\xfb\xa1!\xd0\xa7d\xeb\xbf\xe5\xb49\xed\xd7\xf7\x92=Uq\xfb5.-1FB\xba\x0c\x84\xbc\n\x01\xa4w\xf0z\xf9dkZ^\xc1\xf2O(\x1d-\xaff\x85_\xfe\xa8\xbe*\xfdM-\x7f\xb8X\xee\x80\xf0\x12\xa9\x9f\x8e\x86\x1227eO\x1e\xe1\xf5\xf2\x81\xb08^,\xcb|\x07\xe5o\xc1\x93r7\x8b5\xce\xec$Z\x13\xe9IX\x8c\xa1$\xc8\x0d\x871c\x90\xd7-\x018\xd3\xe4\xb5@\xb4\x16\x8f\x13\xd8\xc9\xb0\xe5\x05\xcd\xf6\x08\xbfuT3\xb5\x82fl\x05o\xef\xdf5\xaf\xb0\x13\x82\xe7\xef\x9b03\xbcE\x9e\x89\x95g\\\x9b\xeb\xca\x08I\xf6\xa2\xc3\xa5\xe0\x8d\xae*\x91xq\xbc\xec\xf4\x93%\xf29w\x99\xec\xfd\x98\xeb\xb2\xe3\xfa\x97\x9c\xae\xf0\x8b\xbe\xccj\x95P\x17\x9e4\x12\xd9\xdfN\x17\x1c>\xf7\x08\xea\"(\x01k\xe3#\x01\xea\xbc\x9f\x86u\xbeM\x83\xc7\x13\xc8\x11P\xcf\xc3\x82\xd5S\xfb\xde\xa6\x89\xfd\xd6b3\x19\x9eL\xff\xb6\xb9\xfe3)\xb2\x98~h\x8d\xeb\xef\x9e\xa0\xd2.L\xacH1\x94\xdd\xc1\xbeQ\xdf\x1e\xdb\xf2\xdc\xcc\xfcOKq\xdbw\x08\xc5\xbf\xff\xdd\x8c\xc0B1\x95'\xde\x08\xc3\xe9g\x84\xbc\xbd\xbb\x7f\xb8\xdb\xbc}\xba\xbb]\xc1\xbd\xc7\x9f\xbe)\x9a\xeb\x02\xa4\xd8Q~\xcce\xec\x98R\xa5\xb3\xed&\x9b9R\xae\x95\x13E72\x12$\x8b\x01\xb7+\x10\x0c\xdf\x84\x94\xb0\xf5,+}\x08\xf3\xf8\xe9B\x07Nk\xe5\xff\xfa\xde\x8c\xe2#\xa3\xa2|\x8fJ\xb8\xca\xb7m\x15\x9a\xd1KC\xf7\x93\xb0\x99	\x85\xb1\xa2\x1f\x8b5U\xf4\xe8)k%\x8f\xd0h?z\xa3\x9b\xda\x1a\xed\xc8]\xf9\xdd\x04E:\x90=\xf2\xde\xd3$\xe9(\x8cGz\x99f\x9c\x96H\xc9d\xf8\x9dl\xc6\xd8Y\x03\xdb:\xfa\xc1\xb1\xb6\xbe\xa3\x1c\xec\xd4\xdbn\xca\xd8F@\xd3\xf0\xc0\x7f\xfe\xbb\xe8{\x1f\xccs2L\xc5\xe0\xb7\x948\xb6~\x15\xe7\xc3\xed\x8f0\xe1s\xf0*\x84\xbf\xffs\x11\x19S\xf1\xa9\xfd\x11\xc5o\xfe/\x00\x00\xff\xffPK\x07\x08o\xd4\x072\x07\x07\x00\x00$\x1b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8\x9cU\xc1n\xdbJ\x0c\xbc\xeb+\x08\xf8\xf8\x12\xe7\xee[\x9e\x13\xb4E\x91\x06p\x03\xf4P\x14\xc1j\x97\x92X\xaf\x97\x06I\xd9M\xbf\xbeX\xd9q,;j\x94\xde\x84\xd5\x0c\xb9#\xce\x88\x13xhHa\xd9\xaa\xf1\x8a~;#N\xd3'\xb7\x8a@\n\x89\x0d(\x19\xa6\x80\x01\x8c\xa1D\x906A\xf9\x04d\x8a\xb1\xba(&\xa0\x94<\x02\x19\x04\\c\n\n\x9c@Q6\xe4\x11\x92[!\xb8\x14\xba\x07];\x8f`\x8d3p\x82\xc0\xad\x01W`\xc7\xdd\x11\xd6\xce/]\x8d\xd3b\x02\x9f\x0c\xb4\xe16\x86\xa3\xb6\x9eSE\xf5U\xc0\xca\xb5\xd1\nA\xe5V<\xea\xac\xb8\x84\xd2)\xea\x95w\xe6\"\xd7\xd3e[\xa2w\"\x842%~t\xdes\x9bL;io\x81\xf7\xc7\x98L\x08\xdfMyR|_\x9fq\xe0\x80B\x1b\x0c\xbe\xfbT\x07\xe1\xa3\xa8\\U(\x94F6Z\x0bo(\xa0\x8cC\x0b\xd6\xc4i\x1c\xd60\xb9\xb3\x19\x9c~\x93\x9e\xbe@\xeay\x83\xe7c\x18\xc5ze\x12'\xbc\xbdQ}l\xd5P\x9c*\xd5i\x85o\xdd\xb1\xcf\xdac'\xf0_\x86\x95-\xc5\x802S\xef\xaa\x8ac\x98y	\x07{?_\xb0(\xd6\xce|\x83\xfa\xd5\xc4\x19\xd6\xe4\xefPj\x9c\x15\x13\xf8\xfe\xed\xf6\xff\x8f\xf7\xf7\x9f\x7f\xc0\x03\x03&WF\x84-\x96\x0d\xf3\xf2\x02\xda\xe4y\x95\xef\x07.F\xb0\x06A\xd1\xe7\xc4*l\xc9\x9a#\xf2Z\xb0\xa2_9F\xfbN\xd0\xa0`\x17\xbc\x8aeW\x98R\xdd\xd5\xf0\x9c6(J\x9c\x9e;\xed0\xce70_\xdc\x14\x93\xcb\xe7\"W\xfb\xf7\x8f\x94\xfa\xc3\x1c#~\xcf\xedJ\x15Y\xe9\xfcv\xf1pw\xfd\xe5\xfa\xc3\xed\xe2\xdf\xd4\xf6\n\xbcG\xf1\xfc\x1a(\xfd\xdc\x15\xebk}\x91\xea\xdd\x01\x92\xe5\x9e\xfe@\x06`\xaf\xff:\x06\xc0\x7f\x8f\xf4\x00\xe9,\xcc\x03\xb8\xb3\x18\x0f\xe0N\x02<\x80:\x89\xee\x90\xfa\xb7\xc2;\xc0{5P#\xd1g\xa1\x1d\xe3\xc5\xa3\x8a\x07?fcT\x1c#o\xb3Mv\x9b&/\xc1\xec\x0f\xcb\x06\xc9\xc7/\x9b\xaa\xe1m^\x89\x81\xfb\xbb\xb3\x83\xcf\x177:-v%Z\xe9\xce\xbb\x15\xf5\xd2\xbf{\xb5\xbb\xef\x9f\x00\x00\x00\xff\xffPK\x07\x08|\xfe[\xf0,\x02\x00\x00\x82\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\xd01\x8e\xe30\x0c\x05\xd0^\xa7\xf8\xc0\xd6\x1bc\xbb\x85Z\xe7\x04\xc9^\x80\x91\xe9\x98\xb0C\x1a\"\xed,\xe6\xf4\x03;\x93\x19L\x93b*	\xa2\xf0\xff\x93~\xe1\xdf \x8e^&\xc6\xb6ZE0\x95A\xf4\x8aq\xf1\xb0\x9b\xbc1\x06\xbb#\x0c\xbe\\<$\x96`(\xdd\x18\xa4\xdd\xbe\xf1\x99\n\xa3r\xcf\x95\xb50D\xd1\x9e\x8ei\x1b\x9d\x9e\x879\xfd\xc6(\xdae\x9c\xb9\xaeR8\x01+W\x17\xd3\x8c\xf5O\x02z\xe1\xa9;\xcf\\<'\xe0y\xbb\xdd\x11'v[j\xe1#\xf7\xa2\x12b\x9a\x00\xe0Zm\x993h\x16\xfe\x1f\xac[\x98\x1f\xc6\xbf~\x10\xdb\xe73\xc5\x90\xe13\x97\xa6\x98~\xd45w\xbe\x0cfc;	k\xb4\xa6\xbd\\\x1b\x7f\xa0\x9a\xcd\x9c\xd2\xe7\xa3\xbe\xd4/\x1c\xaf\x15?3\xec\xed	(\x95)8\xa3\xa7\xc99\xa5\x95\xea\xb7\x1f}\x84\xdf8\xa8\xa3\xa0\x86T-hcyz\x0f\x00\x00\xff\xffPK\x07\x08\xf6]\x02\x05\xf3\x00\x00\x00\xda\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00	\x00crd/patches/cainjection_in_accounts.yamlUT\x05\x00\x01\x80Cm8d\x8e1O\xc3@\x0c\x85\xf7\xfb\x15O*\x03\x1d\x9a\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9\xb8\xadIb\x97;\xa7\xf0\xf3QZ6\x16K\xcf\xfe\xe4\xf7\xcd\xb0?1\x0e\xd6\xf7\xf6-z\xc4\x99<\x9e@m\x9bAh%qt\xb9L@B\xe4\xe4\x03)\x1d9\xc1\x0d\xa2\x9f\x1c\x1du\x05Q7\xf8\x89Q\xef6a6MD\xd3\x0b\xa7,\xa6H\xfc5J\xe2\x8cn\x9d\xb1*V\x0f\xb0\x84\x9e\x9cS\x11\xe8,o7\xac\x04\x9d\x85\x7f\x9cuJ\xb9\xe8\xd6\xb9\x10[^V\xa1\x13mK\xd4cv\x1bv\x9cmL\x917|\x10\x15\x17\xd30\xb0SKNe\x00H\xd5\x9c\xa6u\x9e\"\xae\xca\x8b?\xe7\xe9\xdd\xcdy\x11iqH6\x94\xb8\xbb\xaf\x9b\xdd~\xfb\xb8\xad\xab}\xf3\xfe\\=5\xaf/U\xdd\xcc\x97\xff\x0f\xf3\x00(\x0d\\\x82b\xb4Q=\x17\x91\x9cz;\x16\xdd\xf8\xc1\x91R\x92kI\xf8\x0d\x00\x00\xff\xffPK\x07\x08\xee\x1f\xba$\xf4\x00\x00\x00S\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/patches/cainjection_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8d\x8e\xb1n\xf2P\x0c\x85\xf7\xfb\x14G\xe2\x1f~\x06\x82P\x17\x94-\n\xa9\xc4\xd0\xaa\xa2\xa8k\xe5\xde\x18p\x93\\S_\x87\xf6\xf1\xab\x00[\x17K\x9f}\xe4\xf3\xcd\xb0?1\x0e\xda\xf7\xfa-\xe9\x883y<\x81\xda6\x83\xd0\x8aqt\xb9L\x01Cd\xf3\x81\x12\x1d\xd9\xe0\nI\x9f\x1c\x1du\x05I\xae\xf0\x13\xa3\xdem\xc2l\x9a\x88\x9a.lY4\xc1\xf8k\x14\xe3\x8cn\x9d\xb1*V\x0fPCO\xceV\x04:\xcb\xdb-V\x82\xce\xc2?\xcei\xa2\\t\xeb\\\x88./\xab\xd0IjK\xd4cv\x1dv\x9cu\xb4\xc8\x1b>H\x12\x17Ma`\xa7\x96\x9c\xca\x00PJ\xea4\xad\xf3\x84\xb8*/\xee\xce\xd3\xbb\x9b\xf3\"\xd2\xe2`:\x94\xf8\xf7\xbfnv\xfb\xed\xe3\xb6\xae\xf6\xcd\xfbs\xf5\xd4\xbc\xbeTu3_\xfe=\xcc\x03\x90h\xe0\x12\x91\x9cz=rr\x13\xce\xc5\x1d\x8bn\xfc\xe0Hfr\xad\n\xbf\x01\x00\x00\xff\xffPK\x07\x08\xcb?\xf2a\xf6\x00\x00\x00Y\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00	\x00crd/patches/cainjection_in_catalogs.yamlUT\x05\x00\x01\x80Cm8d\x8e\xb1n\xc2P\x0cE\xf7|\xc5\x95\xe8P\x06\x82\xa2.([\x14R\x89\xa1UEQ\xd7\xca\xbc\x18p\x93<S?\x87\xf6\xf3\xab\x00[\x17\xcb\xd7>\xb2\xcf\x0c\xbb\x13\xe3\xa0}\xaf?\x12\x8f8\x93\x87\x13\xa8m\x13\x08\xad\x18\x07\x97\xcb\x04\x18\x02\x9b\x0f\x14\xe9\xc8\x06WH\xfc\xe2\xe0\xa8+Ht\x85\x9f\x18\xf5v\x9d\xcd\xa6\x8a\xa0\xf1\xc2\x96D#\x8c\xbfG1N\xe8V	E^<A\x0d=9[\x9e\xd1Y>nX	:\x0b\xff:\xc7)\xa5\xbc[\xa5\\ty)\xf6\xecTd\x9d\xc4\xb6D=&\xd7a\xcbIG\x0b\xbc\xe6\x83Dq\xd1\x98\x0d\xec\xd4\x92S\x99\x01\x14\xa3:M\xe34E\\\xbd\x17w\xf1\xe9\xe6M|\x11hq0\x1dJ<<\xd6\xcdv\xb7y\xde\xd4\xd5\xae\xf9|\xad^\x9a\xf7\xb7\xaan\xe6\xcb\xff\x8by\x06D\x1a\xb8D \xa7^\x8f)\xbf7y7\xee9\x90\x99\\\x9fd\x7f\x01\x00\x00\xff\xffPK\x07\x08\xe6\xc5\x0c\x9b\xf5\x00\x00\x00X\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x009\x00	\x00crd/patches/cainjection_in_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8d\xce1o\xfa@\x0c\x05\xf0\xfd>\xc5\x93\xf8\x0f\x7f\x06\x82P\x17\x94-\n\xa9\xc4\xd0\xaa\xa2\xa8k\xe5^\x0c\xb8!g\xeas\xd2~\xfc*\xc0\xd6\xe5\xa4w~\xb2\x7f3\xecO\x8c\x83\x9e\xcf\xfa-\xe9\x88\x0by<\x81\xda6\x83\xd0\x8aqt\x19\xa7\x82!\xb2yO\x89\x8elp\x85\xa4O\x8e\x8e\xba\x82$W\xf8\x89Q\xef6a6\xbd\x88\x9aF\xb6,\x9a`\xfc5\x88qF\xb7\xceX\x15\xab\x07\xa8\xe1L\xceV\x04\xba\xc8\xdb\xadV\x82.\xc2?\xceiJ\xb9\xe8\xd6\xb9\x10]\x8e\xab\xd0IjK\xd4Cv\xedw\x9cu\xb0\xc8\x1b>H\x12\x17M\xa1g\xa7\x96\x9c\xca\x00PJ\xea4}\xe7)\xe2J^\xdc\xcd\xd3\xba\x9by\x11iq0\xedK\xfc\xfb_7\xbb\xfd\xf6q[W\xfb\xe6\xfd\xb9zj^_\xaa\xba\x99/\xff\x0e\xe6\x01H\xd4s\x89x\xa5\xd8\x9d\xd2J\x8e:\xb2	\xe7\xa2\x1b>8\x92\x99\\\xaf\x85\xdf\x00\x00\x00\xff\xffPK\x07\x08\xe3\x06\xc8\xef\xf8\x00\x00\x00\\\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x006\x00	\x00crd/patches/cainjection_in_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8d\xce1o\xe2@\x10\x05\xe0\xde\xbf\xe2I\\q\x14\x18\xa1k\x90;\xcb\xf8$\x8a;E\x04\xa5\x8d&\xbb\x03Ll\xef\x90\xd9\xb1\x93\x9f\x1f\x19\xe8\xd2\xac\xf4v\x9ef\xbe\x05\x8e\x17\xc6I\xfb^?%\x9dq%\x0f\x17P\x8c\x19\x84(\xc6\xc1e\x9a\x0b\x86\xc0\xe6\x03%:\xb3\xc1\x15\x92\xde98\x9a\x1a\x92\\\xe1\x17Fs\xd8\x15\x8b\xf9E\xd04\xb1e\xd1\x04\xe3\x8fQ\x8c3\xbam\xc6\xa6\xdc\xfc\x81\x1azr\xb6\xb2\xa0\xab\xbc\xdck\x15\xe8*\xfc\xe5\x9c\xe6\x94\xcbn\x9bK\xd1\xf5\xb4):I\xb1B3f\xd7\xe1\xc0YG\x0b\xbc\xe3\x93$q\xd1T\x0c\xec\x14\xc9\xa9*\x00JI\x9d\xe6\xef<G\xdc\xc8\xab\x87y^w7\xaf\x02\xadN\xa6C\x85_\xbf\x9b\xf6p\xdc\xff\xdd7\xf5\xb1}\xfd_\xffk\x9f\x9f\xea\xa6]\xae\x7f\x0e\x96\x05\x90h\xe0\n\x91M&\x8e\xe1&\xb2\x87(\x97\x81\x9cz=\x97\xdd\xf8\xc6\x81\xcc\xe4v\xb2\xf8\x0e\x00\x00\xff\xffPK\x07\x08i\x13/\xb1\xfc\x00\x00\x00a\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00)\x00	\x00crd/patches/cainjection_in_offerings.yamlUT\x05\x00\x01\x80Cm8d\x8e\xb1N\xc3P\x0cE\xf7|\xc5\x95\xca@\x87\xa6\xaaX\xaalQ\x1a\xa4\x0e T*Vd^\x9c\xd4$y.~N\xe1\xf3QZ6\x16K\xd7>\xf2=\x0b\x1cO\x8cV\x87A\xbf%v8\x93\x87\x13\xa8i\x12\x08\x8d\x18\x07\x97\xcb\x0c\x18\x02\x9b\x8f\x14\xa9c\x83+$~rpT%$\xba\xc2O\x8c\xea\xb0\xcb\x16\xf3D\xd0xaK\xa2\x11\xc6_\x93\x18'\xf4\xdb\x84M\xbey\x80\x1a\x06r\xb6<\xa3\xb3\xbc\xdd\xb0\x02t\x16\xfeq\x8esJy\xbfM\xb9\xe8\xfa\xb2\xc9z\x89M\x81jJ\xae\xe3\x81\x93N\x16x\xc7\xadDq\xd1\x98\x8d\xec\xd4\x90S\x91\x01\x14\xa3:\xcd\xeb4G\\\x95W\x7f\xce\xf3\xbb\x9b\xf3*\xd0\xaa5\x1d\x0b\xdc\xddW\xf5\xe1\xb8\x7f\xdcW\xe5\xb1~\x7f.\x9f\xea\xd7\x97\xb2\xaa\x97\xeb\xff\x87e\x06D\x1a\xb9\x80\xb6-\x9b\xc4.\xe5\x81\x9c\x06\xed\xf2~\xfa\xe0@frm\xc9~\x03\x00\x00\xff\xffPK\x07\x08\xebRX\xf0\xf5\x00\x00\x00T\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00)\x00	\x00crd/patches/cainjection_in_providers.yamlUT\x05\x00\x01\x80Cm8d\x8e\xb1N\xc3P\x0cE\xf7|\xc5\x95\xca@\x87\xa6\xaaX\xaalQ\x1a\xa4\x0e T*Vd^\xdc\xd6$y\x0e~N\xe0\xf3QZ6\x16K\xd7>\xf2=\x0b\x1c/\x8c\x93v\x9d~K<c \x0f\x17P\xd3$\x10\x1a1\x0e.\xd3\x0c\x18\x02\x9b\xf7\x14\xe9\xcc\x06WH\xfc\xe4\xe0\xa8JHt\x85_\x18\xd5a\x97-\xe6\x89\xa0qbK\xa2\x11\xc6_\xa3\x18'\xb4\xdb\x84M\xbey\x80\x1a:r\xb6<\xa3A\xdenX\x01\x1a\x84\x7f\x9c\xe3\x9cR\xdenS.\xba\x9e6Y+\xb1)P\x8d\xc9\xb5?p\xd2\xd1\x02\xef\xf8$Q\\4f=;5\xe4Td\x00\xc5\xa8N\xf3:\xcd\x11W\xe5\xd5\x9f\xf3\xfc\xee\xe6\xbc\n\xb4:\x99\xf6\x05\xee\xee\xab\xfap\xdc?\xee\xab\xf2X\xbf?\x97O\xf5\xebKY\xd5\xcb\xf5\xff\xc32\x03\"\xf5\\`0\x9d\xa4aKy \xa7N\xcfy;~p 3\xb9\xb6d\xbf\x01\x00\x00\xff\xffPK\x07\x08\xeb\xb3\xf1u\xf5\x00\x00\x00T\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00crd/patches/cainjection_in_regions.yamlUT\x05\x00\x01\x80Cm8d\x8e1o\xeaP\x0c\x85\xf7\xfb+\x8e\xc4\x1b\x1e\x03A\xe8-([\x14\xf2$\x86V\x15E]+\xf7\xc6\x047\xc95\xf5uh\x7f~\x15\xe8\xd6\xc5\xd2\xb1?\xf9|\x0b\x1c\xcf\x8c\x93\x0e\x83~J\xeap!\x8fgP\xdbf\x10Z1\x8e.\xd7\x190D6\x1f)Q\xc7\x06WHz\xe7\xe8\xa8+Hr\x85\x9f\x19\xf5a\x17\x16\xf3D\xd4te\xcb\xa2	\xc6\x1f\x93\x18g\xf4\xdb\x8cM\xb1\xf9\x075\x0c\xe4lE\xa0\x8b\xbc\xdc\xb1\x12t\x11\xferNs\xcaE\xbf\xcd\x85\xe8\xfa\xba	\xbd\xa4\xb6D=e\xd7\xf1\xc0Y'\x8b\xbc\xe3\x93$q\xd1\x14Fvj\xc9\xa9\x0c\x00\xa5\xa4N\xf3:\xcf\x117\xe5\xd5\x8f\xf3\xfc\xee\xee\xbc\x8a\xb4:\x99\x8e%\xfe\xfc\xad\x9b\xc3q\xff\x7f_W\xc7\xe6\xf5\xb1zh\x9e\x9f\xaa\xbaY\xae\x7f\x1f\x96\x01H4r	\xe3\xee\xa6\x18\xc9i\xd0\xae\xe8\xa77\x8ed&\xb7\x8e\xf0\x1d\x00\x00\xff\xffPK\x07\x08cH\xebq\xf4\x00\x00\x00R\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x009\x00	\x00crd/patches/cainjection_in_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8d\x8e1o\xeaP\x0c\x85\xf7\xfb+\x8e\xc4\x1b\x1e\x03A\xe8-([\x14\xf2$\x86V\x15E]+\xf7\xc6\x80\x9b\xc4\x97\xfa:i\x7f~\x15\xe8\xd6\xc5\xd2\xb1?\xf9|\x0b\x1c/\x8cS\xea\xfb\xf4)z\xc6\x95<^@m\x9bAh\xc58\xbaL3`\x88l>\x90\xd2\x99\x0d\x9e \xfa\xce\xd1QW\x10\xf5\x04\xbf0\xea\xc3.,\xe6\x89\x98tb\xcb\x92\x14\xc6\x1f\xa3\x18gt\xdb\x8cM\xb1\xf9\x87d\xe8\xc9\xd9\x8a@Wy\xb9c%\xe8*\xfc\xe5\xacs\xcaE\xb7\xcd\x85\xa4\xf5\xb4	\x9dh[\xa2\x1e\xb3\xa7\xe1\xc09\x8d\x16y\xc7'QqI\x1a\x06vj\xc9\xa9\x0c\x00\xa9&\xa7y\x9d\xe7\x88\x9b\xf2\xea\xc7y~ww^EZ\x9d,\x0d%\xfe\xfc\xad\x9b\xc3q\xff\x7f_W\xc7\xe6\xf5\xb1zh\x9e\x9f\xaa\xbaY\xae\x7f\x1f\x96\x01P\x1a\xb8Df\x9b$r\xec\xc7\xecl\x94\xb3\x9cu`\xf5\\t\xe3\x1bG2\x93[[\xf8\x0e\x00\x00\xff\xffPK\x07\x08\xa2&\x0d\xd6\xfb\x00\x00\x00\\\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00/\x00	\x00crd/patches/cainjection_in_serviceclusters.yamlUT\x05\x00\x01\x80Cm8d\x8e1o\xeaP\x0c\x85\xf7\xfb+\x8e\xc4\x1b\x1e\x03A\xe8-([\x14\xf2$\x86V\x15E]+\xf7\xc6\x80\x9b\xe4\x9a\xfa:i\x7f~\x15\xe8\xd6\xc5\xd2\xb1?\xf9|\x0b\x1c/\x8c\x93\xf6\xbd~J:\xe3J\x1e/\xa0\xb6\xcd \xb4b\x1c]\xa6\x190D6\x1f(\xd1\x99\x0d\xae\x90\xf4\xce\xd1QW\x90\xe4\n\xbf0\xea\xc3.,\xe6\x89\xa8ib\xcb\xa2	\xc6\x1f\xa3\x18gt\xdb\x8cM\xb1\xf9\x075\xf4\xe4lE\xa0\xab\xbc\xdc\xb1\x12t\x15\xferNs\xcaE\xb7\xcd\x85\xe8z\xda\x84NR[\xa2\x1e\xb3\xebp\xe0\xac\xa3E\xde\xf1I\x92\xb8h\n\x03;\xb5\xe4T\x06\x80RR\xa7y\x9d\xe7\x88\x9b\xf2\xea\xc7y~ww^EZ\x9dL\x87\x12\x7f\xfe\xd6\xcd\xe1\xb8\xff\xbf\xaf\xabc\xf3\xfaX=4\xcfOU\xdd,\xd7\xbf\x0f\xcb\x00$\x1a\xb8Df\x9b$r\xec\xc7\xecl\xb9\xe8\xc67\x8ed&\xb7\x8e\xf0\x1d\x00\x00\xff\xffPK\x07\x08\x97%|\x92\xf5\x00\x00\x00R\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00crd/patches/cainjection_in_tenants.yamlUT\x05\x00\x01\x80Cm8d\x8e1O\xc3@\x0c\x85\xf7\xfb\x15O*\x03\x1d\x9a\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9\xb8\xadIb\x97;\xa7\xf0\xf3QZ6\x16K\xcf\xfe\xe4\xf7\xcd\xb0?1\x0e\xd6\xf7\xf6-z\xc4\x99<\x9e@m\x9bAh%qt\xb9L@B\xe4\xe4\x03)\x1d9\xc1\x0d\xa2\x9f\x1c\x1du\x05Q7\xf8\x89Q\xef6a6MD\xd3\x0b\xa7,\xa6H\xfc5J\xe2\x8cn\x9d\xb1*V\x0f\xb0\x84\x9e\x9cS\x11\xe8,o7\xac\x04\x9d\x85\x7f\x9cuJ\xb9\xe8\xd6\xb9\x10[^V\xa1\x13mK\xd4cv\x1bv\x9cmL\x917|\x10\x15\x17\xd30\xb0SKNe\x00H\xd5\x9c\xa6u\x9e\"\xae\xca\x8b?\xe7\xe9\xdd\xcdy\x11iqH6\x94\xb8\xbb\xaf\x9b\xdd~\xfb\xb8\xad\xab}\xf3\xfe\\=5\xaf/U\xdd\xcc\x97\xff\x0f\xf3\x00(\x0d\\\xc2YI=\x17\x91\x9cz;\x16\xdd\xf8\xc1\x91R\x92kG\xf8\x0d\x00\x00\xff\xffPK\x07\x08\x01\xe8r\x13\xf3\x00\x00\x00R\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00crd/patches/webhook_in_accounts.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aAr\xf8\xff\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4z,\xb1Z/\xd5%\x15\xd7o_\xc8v\xd2\xf4\xb2\x00\xc9\xe1r\xf8\xf1\x16_Gbo9\xdbQ\xcb\x80Y\"\x8d`\x91>\xd3\x91\xac\xbc\xb2\xbaZ\xc1\x91\xfdh6ao\x15\xdb\x97\xe7\xe6v}?\n*\x7f-Z\xe9\x98\x1e\x1d\x9bv\xf3\x19V\x91%X\xdbFf\xfdv\x91u\x90Y\xf9;X\xd6\xc8\xdb\xe9\xd1[\xb5\x87\xd7M\xcf\x90M3i\xd9u\xd8.\x1evx\xa1\xdbR\x13\x9f\xb9\xd7\xa2\xa1V\x9a\x03Cv\x12\xd25@\x91\x03;HJ\xb6\x94\xf06IH\xb6\xa1\x9d\x96\x9eIjU\xd6V\xad\xf1\x99iU\xff5\xbaF\x80G\x95\xe0p\xea\xf0\xfd\xb2\xd89{]r\x9b\x95%\xb6V\xf6:\\\xe4\xc0-bT\x87:n~\x94\x1b,\xce\x1d\xc4!\x98\xb3$\x8e\x96w\xacw\xb0\x18Y\x8f\xea\x84\x06\x8e\x9a3z\xa2\xf2'Sp\x87\xfe\x84\x18\xb9\x12p\xd6W\xd63\xcc\x9e+\xf7>K\x99\xee\xdeg\xf5K\xe0\xc8\xff*1\xd8Z\x0e\x833\xd6O\xcfD\xb1\xf89;\x12\x895\xee\x0fRd`\xc5\xffV1[\xb0\x84J\xce\xa7\xd5\xdd\xf9\x9e\xbaG\xb1\xb8v}\xec\xf8t\x9d\x98\xe4\xcbRv\x99\x1d\xb6\xc3\xd3\xd35\xb9\x9a\xd4\xc47\x02\x17\xe4>Kb\x07?y\xf0\xf0O\xa5{\xc3w\x7fm|\xaf\xce\x12c\x87\x87\xcb\x0d\xa2\xf9\x13\x00\x00\xff\xffPK\x07\x08`\xae\xa9Fx\x01\x00\x00t\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00*\x00	\x00crd/patches/webhook_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8T\x91\xcfn\xdbL\x0c\xc4\xefz\x8aA|\xf8\xbe\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4j,\xb1Z\xef\xaaK\xca\xae\xdf\xbe\x90\xa5\xa4\xe9e\x01\xfe\x99\xe5\xf0\xc7\x1d\xbe\x0e\xc41\xc7\x98/\x9azL\xe2a\x00\x93\xb4\x91\x86\x90\xd3\x99\xc54'\\\xd8\x0e9\x8f8\xe6\x82\xc3\xebK\xb5[\xde\x8f\x0d\x85\xbff-4\x8cO\x86}\xbd\xff\x8c\\\x10\xc5Y\xeaJ&\xfd\xb6\xb65\x90I\xf9\xdb\x99\x96\xc8\xea\xf1\xc9j\xcd\x8f\xe7}K\x97}5j\xea\x1a\x1cf\xf3|z\xa5\xe5\xb9\x04\xbe\xf0\xa8I]s\xaaNt\xe9\xc4\xa5\xa9\x80$'6\x08\xe2\x12s\xcf\xe4Ei\xf5\x16\xd6\xe3\xdc2H)\xcaRk\xaelbX4\x7f\xed.\x11`^\xc4\xd9_\x1b|_\xd7\xbbe\xb7U\x0fQ\x99\xfc\x90\xd3Q\xfb\xb5\x1d\xd8\xc1\x075\xa8\xe1\xeeG\xba\xc3l\xec \x06\xc1\x14%p\xc8\xb1c\xb9G\xf6\x81\xe5\xa2F\xa8\xe3\xa21\xa2%\n\x7f28;\xb4W\xf8\xc0\x85\x83\xb1\x9cYnH[.\xf4\xdb(i\xbc\x7f\x9f\xd5\xce\x8e\x0b\xff+D\x9f\x97\xb2g\x18}\xf9\xf4\xc6\x15\xb3\xdd\xb2\x03\x11X\xfc\xe1$Iz\x16\xfc\x9f\x0b\xa6\xecL\xae\x12\xe3uqw\xbb\xaa\x1e\x91\xb2o\xaa\x8f\x8aO\xdb\xc4 _\xe6\xd4E68\xf4\xcf\xcf[r1\xa9\x81o\x04V\xf06I`\x03\xbb\x9a\xf3\xf4O\xa5y\xc3\xf7\xb0	\xdf\xab\x93\xf8\xd0\xe0q\xbd\x81W\x7f\x02\x00\x00\xff\xffPK\x07\x08\"	(\xc8y\x01\x00\x00z\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00crd/patches/webhook_in_catalogs.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aA|\xf8\xff\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4j,m\xb5^\xaaK\xca\xae\xdf\xbe\x90\xa5\xa4\xe9E\x10\xc9\xe1r\xf8q\x87\xaf\x03q\xd4\x94\xf4\x12s\x8fI<\x0c`\x966\xd1\x104\x9fY,j\xc6\x85\xed\xa0:\xe2\xa8\x05\x87\xd7\x97j\xb7|?\n\n\x7f\xcd\xb1\xd00>\x19\xf6\xf5\xfe3\xb4 \x89\xb3\xd4\x95L\xf1\xdb*k S\xe4og^\"\xab\xc7'\xab\xa3>\x9e\xf7-]\xf6\xd5\x18s\xd7\xe00\x9b\xeb\xe9\x95\xa6s	|\xe11\xe6\xe8Qsu\xa2K'.M\x05d9\xb1A\x10\x97\xa4\xbd\xd5\xdbO=\xce-\x83\x94\x12Y\xea\xa8\x95M\x0c\x8b\xfa\xaf\xd1%\x02\xcc\x8b8\xfbk\x83\xef\xebb\xb7\xec\xb6\xe4!Ef?h>\xc6~\x95\x03;\xf8\x10\x0d\xd1p\xf7#\xdfa6v\x10\x83`J\x128h\xeaX\xee\xa1>\xb0\\\xa2\x11\xd1q\x89)\xa1%\n\x7f28;\xb4W\xf8\xc0\x85\x80\xb1\x9cYn0[.\xdc\xdb$y\xbc\x7f\x9f\xd5\xce\x8e\x0b\xff+D\xafK\xd9\x15F_\x1e\xbd\x11\xc5l\xb7\xec@\x04\x16\x7f8I\x96\x9e\x05\xffk\xc1\xa4\xce\xecQR\xba.\xeen\xf7\x8cGd\xf5\xad\xebc\xc7\xa7mb\x90/s\xee\x12\x1b\x1c\xfa\xe7\xe7-\xb9\x98\x8c\x81o\x04V\xe46I`\x03\xbb\x9a\xf3\xf4O\xa5y\xc3\xf7\xb05\xbeW'\xf1\xa1\xc1\xe3z\x03\xaf\xfe\x04\x00\x00\xff\xffPK\x07\x08M\xcc2ev\x01\x00\x00t\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00crd/patches/webhook_in_offerings.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aA|\xf8\xff\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4j$\xb1Z/\xd5]\xca\xae\xdf\xbe\x90\xad\xa4\xe9e\x01\x92\xc3\xe5\xf0\xe3\x0e_G\xa2\xb7\x18\xed\xaci\xc0,\x1eF0I\x1bY\x10,\x9d\x98\x8bZ\xc2\x99\xedh6\xa1\xb7\x8c\xc3\xebK\xb5[\xdf\x8f\x82\xcc_\x8bf\x16LO\x05\xfbz\xff\x19\x96\x11\xc5\x99\xebJf\xfdv\x935\x90Y\xf9\xdb\x99\xd6\xa8\xd4\xd3S\xa9\xd5\x1eO\xfb\x96.\xfbj\xd2\xd458,\xc5\xed\xf8\xcabK\x0e|a\xafI]-UG\xbat\xe2\xd2T@\x92#\x1bX\xdf3k\x1aJ\x1d\xc4%\xdaPOK\xcb 9+s\xadV\x95\x99a\x95\xffu\xbaF@\xf1,\xce\xe1\xd2\xe0\xfbm\xb3kv\xdb\xf2\x10\x95\xc9\x0f\x96z\x1dnr`\x07\x1f\xb5@\x0b\xee~\xa4;,\x85\x1d\xa4@0G	\x1c-v\xcc\xf70\x1f\x99\xcfZ\x08u\x9c5F\xb4D\xe6O\x06g\x87\xf6\x02\x1f\xb9\"(\xcc'\xe6+\xcd\x96+\xf86J\x9a\xee\xdfg\xb5\x8b\xe3\xcc\xff21\xd8ZvC\xa1\xaf\x9f^\x91b)\xd7\xecH\x04f\x7f8J\x92\x81\x19\xff[\xc6l\xce\xe4*1^Vw\xd7\x83j\x8fd\xbeu}\xec\xf8\xb4M\x0c\xf2eI]d\x83\xc3\xf0\xfc\xbc%W\x93\x1a\xf8F\xe0\xc6\xbc\xcc\x12\xd8\xa0\\\x8a\xf3\xf8O\xa5y\xc3\xf7\xb05\xbeWg\xf1\xb1\xc1\xe3\xed\x06^\xfd	\x00\x00\xff\xffPK\x07\x08=\xcf\x87\xady\x01\x00\x00u\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00crd/patches/webhook_in_providers.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aAr\xf8\xff\x02\x89\x82\xa0\x97@@.u\x9e (\xdaK/\xd4j,\xb1^/\xd5%e\xd7o_\xc8v\xd2\xf4\xb2\x00\xc9\xe1\x92\xf3\xf1\x16_'bk9\xdbQ\xcb\x88Y\"M`\x91>\xd3\x91\xac\x1cX]\xad\xe0\xc8~2\xdbak\x15\x9b\xd7\x97\xe6v}?\n*\x7f-Z\xe9\xd8=9\x1e\xdb\xc7\xcf\xb0\x8a,\xc1\xda62\xeb\xb7\x8b\xac\x83\xcc\xca\xdf\xc1\xb2F\xde\xee\x9e\xbcU{8<6;-C\x87\xcd\xe2a\xfbW\xba-5\xf1\x85[-\x1aj\xa5\xd93d\x90\x90\xae\x01\x8a\xec\xd9a\xaev\xd0\x81\xd5\xdb$!\xd9\xc6v\xb7\xf4LR\xab\xb2\xb6j\x8d\xcfL\xab\xfc\xef\x92k\x04xT	\x8e\xa7\x0e\xdf/\xa6\xce\xd9\xab\xc1MV\x96\xd8X\xd9\xeax\x91\x03\xb7\x88I\x1d\xea\xb8\xf9Qn\xb08\x07\x88C0gI\x9c,\x0f\xacw\xb0\x98X\x8f\xea\x84\x06\x8e\x9a3z\xa2\xf2'Sp@\x7fBL\\\xdd;\xeb\x81\xf5\x0c\xb2\xe7\xca\xbc\xcfRvw\xef\xb3\xfa%p\xe4\x7f\x95\x18m-\x87\xc1\x19\xeb\xa7g\x9aX\xfc\x9c\x9d\x88\xc4\x1a\xf7{)2\xb2\xe2\x7f\xab\x98-XB%\xe7\xd3\xba\xdd\xf9\x96\xbaE\xb1\xb8v}\xec\xf8t\x9d\x98\xe4\xcbR\x86\xcc\x0e\x9b\xf1\xf9\xf9\x9a\\\x97\xd4\xc47\x02\x17\xe6>Kb\x07?yp\xffO\xa5{\xc3w\x7fm|\xaf\xce\x12S\x87\x87\xcb\x0d\xa2\xf9\x13\x00\x00\xff\xffPK\x07\x08\xd2\x03\xbe\xdfv\x01\x00\x00p\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00crd/patches/webhook_in_regions.yamlUT\x05\x00\x01\x80Cm8T\x91\xcfn\xdbL\x0c\xc4\xefz\x8aA|\xf8\xbe\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4j,\xb1Z/\xd5]\xca\xae\xdf\xbe\x90\xac\xa4\xe9e\x81%\x87\x7f\xe6\xc7\x1d\xbe\x0e\xc4\xd1b\xb4\x8b\xa6\x1e\x93x\x18\xc0$mdA\xb0tf.j	\x17\xb6\x83\xd9\x88\xa3e\x1c^_\xaa\xdd\xf2~\x14d\xfe\x9a5\xb3`|*\xd8\xd7\xfb\xcf\xb0\x8c(\xce\\W2\xe9\xb7\x9b\xac\x81L\xca\xdf\xce\xb4\xfcJ=>\x95Z\xed\xf1\xbc\xafFM]\x83\xc3\\\xdcN\xaf,6\xe7\xc0\x17\x1e5\xa9\xab\xa5\xeaD\x97N\\\x9a\nHrb\x83\xcc~m\x11\xc4%Z_\x8fs\xcb 9+s\xadV\x95\x89a\x11\xff]q\xf9\x01\xc5\xb38\xfbk\x83\xef7Kkt\xb3w\x88\xca\xe4\x07KG\xedor`\x07\x1f\xb4@\x0b\xee~\xa4;\xcc\x85\x1d\xa4@0E	\x1c,v\xcc\xf70\x1f\x98/Z\x08u\\4F\xb4D\xe6O\x06g\x87\xf6\n\x1f\xb8x/\xccg\xe6\x15c\xcb\x85x\x1b%\x8d\xf7\xef\xb3\xda\xd9q\xe1\x7f\x99\xe8mI\xbb\xa1\xd0\x97\xa6+K\xcce\x8d\x0eD`\xf6\x87\x93$\xe9\x99\xf1\xbfeL\xe6L\xae\x12\xe3u\xd9n\xbd\xa4\x1e\x91\xcc\xb7\xaa\x8f\x15\x9f\xb6\x89A\xbe\xcc\xa9\x8blp\xe8\x9f\x9f\xb7\xe0\xb2\xa4\x06\xbe\x11\xb8\x11/\x93\x046(\xd7\xe2<\xfd\x93i\xde\xf0=l\x85\xef\xd9I|h\xf0x\xbb\x81W\x7f\x02\x00\x00\xff\xffPK\x07\x08D\xa1\xa7,t\x01\x00\x00n\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00crd/patches/webhook_in_tenants.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aAr\xf8\xff\x02\x89\x82\xa0\x97@@.u\x9e (\xdaK/\xd4z,\xb1Z/\xd5%e\xd7o_\xc8v\xd2\xf4\xb2\x00\xc9\xe1\x92\xf3\xf1\x16_Gbg9\xdbQ\xcb\x80Y\"\x8d`\x91>\xd3\x91\xac\x1cX]\xad\xe0\xc8~4\x9b\xb0\xb3\x8a\xcd\xebKs\xbb\xbe\x1f\x05\x95\xbf\x16\xadtLO\x8e\xc7\xf6\xf13\xac\"K\xb0\xb6\x8d\xcc\xfa\xed\"\xeb \xb3\xf2w\xb0\xac\x91\xb7\xd3\x93\xb7j\x0f\x87\xc7f\xd2\xb2\xed\xb0Y<l\xffJ\xb7\xa5&\xbep\xa7EC\xad4{\x86l%\xa4k\x80\"{v\x08\x16)\xe1m\x92\x90lC;-=\x93\xd4\xaa\xac\xadZ\xe33\xd3*\xfe\xbb\xe2\x1a\x01\x1eU\x82\xc3\xa9\xc3\xf7\x8b\xa5s\xf6jo\x93\x95%6Vv:\\\xe4\xc0-bT\x87:n~\x94\x1b,\xce-\xc4!\x98\xb3$\x8e\x96\xb7\xacw\xb0\x18Y\x8f\xea\x84\x06\x8e\x9a3z\xa2\xf2'Sp\x8b\xfe\x84\x18\xb9zw\xd6\x03\xeb\x19c\xcf\x95x\x9f\xa5Lw\xef\xb3\xfa%p\xe4\x7f\x95\x18l-\x87\xc1\x19\xeb\xa7g\x96X\xfc\x9c\x1d\x89\xc4\x1a\xf7{)2\xb0\xe2\x7f\xab\x98-XB%\xe7\xd3\xba\xdd\xf9\x92\xbaC\xb1\xb8v}\xec\xf8t\x9d\x98\xe4\xcbR\xb6\x99\x1d6\xc3\xf3\xf35\xb9.\xa9\x89o\x04.\xc4}\x96\xc4\x0e~\xf2\xe0\xfe\x9fJ\xf7\x86\xef\xfe\xda\xf8^\x9d%\xc6\x0e\x0f\x97\x1bD\xf3'\x00\x00\xff\xffPK\x07\x08\xba\x93/vt\x01\x00\x00n\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xccVMo\xe36\x10\xbd\xf3W\x0c\xa2\x83[`-\xa3=\xea\xe6\x1aj7h\xf3\x01'\xbb=\x14E0\"G\x167\x14G%);\xde__\x90\x92-9\xe8v\x8b\"@{\x93\xe7\xeb\xbdy3$\x9d\xc1Z)\x0f\x16[\xf2\x1dJ\x82\xc0\x80\xc6\x80#\xcf\xbd\x93\xe4sq\xf6\x15\xf0\xdcW$\xd19Mn\xe9\x8f>P+D\x06\x1f\xd1\xf4\x04\\Ch\xb4\x87Z\x93Q\xa0=t\x8e:\xb2\x8aT\xac\x19\x1a\x12\xd9\x00\x13#/ \xde\x01\xe5\xbb\x1c\x10\x14u\x86\x8f-\xd9\x90\"\x95\xc8\xe0\xea\xc0Nu\x8e\xbc\xbf\x82\x8a$\xc7\xfc+4Z\x92_N\xae\\dp\xcb\x81 4\x18@\x07\xf0\x0d\xf7F\x01\x1a\xcf\xd0b\x90\x0d\x1cth\"\x8bH\xab\xd6/\xf0M\xa0\x97\x00\x15\xd5\xec\x08\x16\xcb\xc5\xb7C\x034I!\xb2\xb1\x17\xacxO\x83\x0e\xf7)\xf9R\x88\x16-\xee\xc8-\x85\x90\xdc\xb6l\x7f\xc1\x8a\x8c/\x04\xcc\xa3r\xcd+\xc7\x86\n\x18\xc3\x85\xa8\xd0\x93/\xc4\x12\xf2|%\x9d\x1a>\\\x85r\xf8:\xc5e\xf0\xdb\xaf\xe5\x0f\xef\xef\xee~\xfe\x1d\x1e\x19\xc8be\x08\x0eT5\xcc\xcf\xef\xa0\xb7\x115J\x165\x8d\x0dx\x92A\xb3\xf5C\xcbS\xf2\xd8\xb8\xb6\xd2\xf4J\xdb]\nfK\xa0-H\xa7V\xcf\xbd\x0f\xdc\xea\xcf\x18\xb3\xf3#\xb6f 2BE\"\x9br\xfbx\xb3\xbe]\xffTn\xe7d$\xb9p\x92\xe15\xa3K6\x8bY\x85E\x0e\x8b\x91\xdc\x02$\xb7\x1d[\xb2\xc1\x03:\x02G\x7f\xf4\xda\x91\xcaGy\xc8\x85\x99\x1e\xf7\xdb\xbb\x9b\xf2\xf1}\xf9\xe1a\xce\xa2s\xdcRh\xa8\xf7\xd0\xb2\xd5\x81\xbf\xc6e*\xb3\xc8E\x96\x80\xa6\x1aBtqo\xc8?\x04\x87\x81vZ\xde\x90\xdbQ\x1ck\x06\xd75\x1c\xb9\x87\x03\xda\x10?\x1cH\xb6\xc1\xb11\xd3:\xc4\xa5\xa7\x97\x8e=%\x9dW-\x05\xa7\xa5O\xe9dU\xc7\xda\x068\xac\x18\xd0\x1e\x01\xfb\xd0\xd8\xd5\xe79\xdf\x98T\xb31|\x88\xa32\xda\x12\xa0U)\xfd\x142\"=\xc5\xec\xa7\xce\xf1\xcb\xf1)qN\xb3\xcbS\xe8\x9d5\xc74c\xae\xff>\xfc\\\xfc\x145)\xf14R\x9fG\x8f\xe7\xab\xa2Q|\x95\x04\xfcG\xb9\xe2?\xde\xe8\x13\xc9\x11\xef5\xb37^q\x91\xc1\x87s\xf0\x85kJ\xfb\"\xdb\xb4C\x03~\xecl\xb3\x06m?\x0dY1)\xdaP\xb5\xda\xfbh\x18\xfb\xf1\x11\xf2\x12\xc8\x12)\x1fkM\xe3\x8a\xbfzO q*)\x96\xa7\x1a\x12\xcf\xc6W\xf2\\n\xa5d[\xeb]\xbc\xebkv\x10\x08e\x13\xcd\xa7F\x08\x1a>D$\xc5\xb0G\x07\xbe\xaf|\xd0\xa1O`{t\xbex{\xc1\xc7\x9d\x88\xf7F\xbc\xaf\x0b\x88\xce\xeb\x1f\xaf7\xeb\xc7\xf2\xe9v}S>\xdc\xaf7%d\xb3\x17o\xbc\xf7#\xa0\xae\xb5\xc4@\xb0\xd9\n\x00\xae>9\xaa\xe3\x81\x07x\xd6V\x15\xb0\x99B\x92u\xe7\xb8\xef\x8a\x0b\xaa\xb9\xe6\xe4\xda\x93\x8bS)`\xff\x1d\x9a\xae\xc1\xef\x93u\xa0\xe4\xc9\xed\xb5\xdd-c\x1ed\xc3\xab\x19=\xa7S5<X\xf3]\x9ep\x87I\xc0\xf02\x9d\xe9\xa5_\x1d\x86\xa6\x80\x96\x02*\x0c\x98O\xef\xd8\x97\xa4\xf8\x7f\xf7xb\xfdPn?^o\xbe2\xbc\x04'\xe9/;z8\xfb.(\xcf\xc8\x8ek\xbf\x9c\xaa\xfc\x1by\xe7D\xdf\x88\xc8Tz\xb3-\x1f\xdf\xb2\xb2\x18\xcen\xef\xd2m\x93\xfe\x86\x9c\x8f\xed\xe0\x1a\xa6\xf0g\x00\x00\x00\xff\xffPK\x07\x08\x83Jq\xaaf\x03\x00\x00 \n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x1c\xc7\xc9	\x031\x0c\x05\xd0\xbb\xab\xf8\x0d\x04\xdfuN\x05\xe9@8?\x0b\xf1\"$\xcd@\xba\x1f\xf0\xf1\x9d\xea\x0f\xbe\xe8\x9c\x8dR\x80\x1b~\xdf\xf9\x14\xdci}\xfd\x07g\x16\x00x\xfb:L\xa0f\xb1m\x9a\x1fA\x18[M\x0e\xeb\x9a\xac[\xe7\xea\xc7`\xd4`sf\xb9\x02\x00\x00\xff\xffPK\x07\x08\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xbdn\xeb0\x0cFw?\x05\x87;\xdc\x0e\x86\xfb\x93\xa1\xd5V\xa4\x19\x93\x06m\xd0\xb5`e6\x11\"\x89\x02E\xa7\xc8\xdb\x17Jb\xd7F\xb8\xd88$\x0f?\x08\x93\xfb \xc9\x8e\xa3\x01L)7\x87\xbbj\xefbk\xe0\x85\x92\xe7c\xa0\xa8U \xc5\x16\x15M\x05\x101\x90\x01\xcbQ\x85\xbd'\xa9\x03F\xdc\x92\\Z9\xa1%\x03\xf9\x98\x95B\x95\x13\xd9\xb2\xa4\x14\x92G\xa5\xf2\x0f\xd0\xd3RE\x84.\x92\xe4\x9e\xd4\x97\x13\x7f\xdes%\x16\x1d\x86\xca\xd8\xb0\xbafQ\x03O\xb3\xd9\xc3\xd0\xeds\xfe\xd0\xd7\x8ey_g\x92\xc3\xc8\x05\x90\x84\x95-{\x03\x9b\xf9z\xe0\x07\xf6]\xa0%wqz*\x14\xb2F\xdd\x19h4\xa4f\xff\x98\xeb\xa9\xba)\x1f\x17\xb7\xb5%\xd1|\x95\xa3\xd0\x11\x14\xc2\xf65\xfa\xa3\x01\x95\x8e\xaa\xf1\xf1\xabw\x98\xacf\xb2BjF\xaa\x96\xbe\xb1\xf3\xba\xe4\x96\x0c\xcc\xeeoG\xad\xf3\xf0\xead\xf9\xf7\xff}1\x7f[l>W\xcf\xcb\xc5\xcd$\xbc\xb3tJ]\xfd\x06\x00\x00\xff\xffPK\x07\x08\xf2\xcb'\xa8\x0e\x01\x00\x00\x0c\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\xd0?k\xf30\x10\x06\xf0]\x9f\xe2\xc0\xef\xf0fPB\xb6\xe2-\x18\x172\xa4\x946\xa4c9[\x17\xfbjK\n\xd29\xa1\xfd\xf4E\xf9\xdb\x96d(t\x14\xba\xe7x~\x97\xc1\xb2\xe5\x08\x1b\x94\xba\x054\x06\xd09/(\xec\x1d\x88\x074\x96cL\x8f\x1dU\xad\xf7\x1d\xd4\xde\xad\xb9\x01tFeP:\xacz\x02I+\xd6>\x80\x1dR\xd45\xa7i\x95\x81\xb4\x04[\x0c\x9c\x06#\xfc\xfb_\x94O\xcb\xf9\xfd\xbc\x98-\xcb\xd7\x87\xd9\xa2|~\x9c\x15\xe5(\xed\xbb\xf29\x82\x1d\xf7=T\x04q\xa8\xa2\xb0\x0cB\x06\xaaw\xe8\x86(\xde\xf2\x07\x8dU\x86\x1b^QH%\xf3K\xdf@\x0dG	{\xc8\xb8\xbb\x8bc\xf6\x93\xed\xb4\"\xc1\xa9\xca:v&\x87\xc5\xb1\xec\xcb\xa1k\xb1\x87\x0d\x87\x88\xca,	\x1a\x14\xccU\x06\xe0\xd0R~\xd6\xe9\xa3N\xd7\xdf#\xf0\xe5xq\x9f\x03\xa8)\x88\xb6\xe8\xb0\xa1\x90:\xb0{\xa3Zt\x8dz\x1d\xbc\xcdo\x1edr\xe5\x18Jk\xad~\xad=`W\xd8\xb3\xb9\xcd\xbdhO\xd8\xed9p\x83\xfbC\xfb\xe7\xd8\xcf\x00\x00\x00\xff\xffPK\x07\x08mK01\x1f\x01\x00\x00\x9a\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xccKLO-\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4\x93\xcfN\xdb@\x10\xc6\xefy\x8aQ\xc4\x81\x1e\x8c\x13\x89C\xbb\x12\x874\xb8\x085\xd0\xc8P\xaeh\xb2\x1e\xe2U\xf6\x1f\xb3kW\xe9\xd3W6N\xba\xa6T\xa2\xea\x9e\xac\x9d\x99\xef\xfb\xcd\xcc\x1a\xbdz \x0e\xcaY\x01\xe8}\xc8\xdb\xf9d\xa7l%\xe0\x92\xbcv{C6N\x0cE\xac0\xa2\x98\x00X4$@:\x1b\xd9iM\x9c\x19\xb4\xb8%\x1eB\xc1\xa3$\x01a\x1f\"\x99	\x80\xc6\x0d\xe9\xd0\x15\xc2\xa1(\xf3\x1a\xed\xdb\x1a\xc1\x93\xecr\x03i\x92\xd1\xf1K\x9d\xc1(\xebU\"\xf4\x1e)\x00&\xaf\x95\xc4 `>\x01\x88d\xbc\xc6H\x83d\xd2Qw\xf4H\xfd}\xfa\x00\x07\xdc\xfe\x9b\xb8U\x92\x16R\xba\xc6\xc6\xdb~J\x01\x13\\T\x96\xf8h\x91\x812\xb8%\x01\xcf\x0d\xee\xcf\x94\xcbw\xcd\x86$2+\xe2|\xf0\x10\x1do\x88G\xa8\x97\xd1\xa7\x00\xddA\xde&\xe0\x9d\xf24\xcb$q\xcc*\xc5\x17'\xa7\xcb\xa2\xbc\x7f\xbc\xbc.?L_%\xb5\x17'\xa7\xaboW\x8f\xab\xe2\xa1X%Q\xb2\xedX\xef\xc5\xf7\xeb\xf7\xcf\xc5rQ\x96\xd7E\xf9x\xbb\xb8)\xee\xd6\x8be\x91\xe4\x01\xb4\xa8\x1b\xfa\xc2\xce\x88\xd15\xc0\x93\"]\x95\xf4\xf4\xfa~\x88\xac1\xd6\xe2\xb8\x92\xb3\xe33z\x03\xe2\xd0\xcc\x9f\xbe\x02\xa6y4>\xdf}\x0c\xd9\x0f\xda\xd4\xce\xed\xb2n'\xc4y\xbf\x1a\xbb\xed\x87\x12~\xf7\xc9\x14\\\xc3\x92F\xd3\xd3\xca\xa8\x18\xc6\xa0\xd27\x02\xa6\xf3\xe9\xe8\xd2\x90q\xbc\x170\x9f\xcdnT\x12azn(\xfc\x87\x84w\x9cV\x1f:g\xc2JY\n!\xeb\x12\x12\x9d\xe3\xdbZ;\x8e\x02>\x9d\x9f\xcf\x92\xa8g\x17\x9dtZ\xc0\xfdr\x9d\xb4>\x88\xad\xd9m(E\xadc\xf4W\x14\xc7\xf4\xbe_P\xdeU\xed\x7f\x8e#\xbd\xe7_\xd8\xb4j\xe9\x9fMjB\x1d\xebw\xb8\x0c\x19\x91\xd8(\x8bQ9{\xc5(iM\xac\\uG\xd2\xd9\xaa\xfb\xf5g\x93_\x01\x00\x00\xff\xffPK\x07\x08\xe3u\x89\xd4\xf4\x01\x00\x00\xe3\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8\xcd\xcf\xcb,\xc9/\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8\x8c\x8e1jt1\x0c\x84{\x9fB\xf07\x7f\n\xef\x92\xd6wX\x08\x04\xd2+~\xc3>\x13[2\x92\xb2\x90\xdb\x87\x97\xf5v)\xd2I\xa3\xd1\xcc\x97\xfe\xd1\x8b\xe9@\xec\xf8t\xba\xa8\xb4P\xa3W\xd8\xadU\xd0\xff\x0b\xc2Z\xf5\xa7\xc4\xb3\xbd\xc1\xbc\xa9\x14\x1awW\x93\xeb\xa9\xaaA\xfdTu\x9co\xcf\xe9\xa3\xc9V\x1e\xcf++\x0d\x04o\x1c\\\x12Q\xe7wt?&\xa2\xaa\x12\xa6=\xcf\xce\x82\xf2X;,\x0f\x16\xbe\xc2\x12\x91\xf0\xf8\xf5\x94\xc7\x9d+/\x94e\xf5\xc9\x15\x85\xfc\xcb\x03#\xf9D=\xaa \xdb\xd4&\xb1z3M\x8e\xbd\xd0ye\xfc\x88DS-\n\xed\x11\xf3P\x1c\x1d5\xd4\xfe\x8a\xfa\x1d\x00\x00\xff\xffPK\x07\x08-\xd9\xee\xd9\xb9\x00\x00\x00G\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8\x94\xce\xb1N\x041\x0c\x04\xd0>_am\x9fEt(--\xd5\x81h\x10\x8577\xcaE\xeb$'\xc7\xa1\xe0\xebQV\x8b\xa8q9\xd2\x1b\x8f\xf7\xde\xf1=\xbfC{n5\x90n\x1cW\x1evk\x9a\xbf\xd9r\xab\xeb\xfe\xd4\xd7\xdc\x1e\xbe\x1e\xdd\x9e\xeb5\xd0\xb3\x8cn\xd0K\x13\xb8\x02\xe3+\x1b\x07G\x14\x15\x07x\xcb\x05\xdd\xb8\xdc\x03\xd5!\xe2\x88*\x17\x04\xe2\x94|\xe1\xca	\xeaubNI\x91\x0es\x19\x82\xa3\xe3\xaf\xfb\x15\x82hM\xfb\xcc\x89<\x15\xb6x{\xe1\x0drF\xf3\x96}l\x88\xac\x9a\xa1s\xe5\xf9`	\xb4\x98\x0e,\xff\xb1s\xd4\x84\xbf\x1dN\x87\xa0\x07\xfa\xf8t?\x01\x00\x00\xff\xffPK\x07\x08\x9e\xfbyQ\xb5\x00\x00\x00'\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8f\xb1N\xc50\x0cEw\x7f\x85\x7f El(\x1b0\xb0\x17\x89\xddMM0m\xed\xcaI:\xf0\xf5\xa8\xd2SU\xe9Uo\xf3p}\x8e\x0e\xad\xf2\xc5^\xc44\xe2\xf6\x0c\x93\xe8\x18\xf1\x93}\x93\xc4\xaf)Y\xd3\n\x0bW\x1a\xa9R\x04D\xa5\x85#\x16\x82\x10\x02\x9c\x9f}\xa0\xd4Q\xab?\xe6\xf2GUL\xbb\xe9\xa5tbO\x07\xf6}n\xa5\xb2\xf76\xf3\x9b\xe8(\x9a/\xd0\x0b)e\xf6\xe06\xf3p[\xedw\xcf\xdf\xfb\x88V\xf9pk\xeb\x03! \xde\xf9\x0e<\xe5\x1c\xce\n(m\xf8\xe5TK\x84\x80\x97\xf5\xa7\xe6\xff\x00\x00\x00\xff\xffPK\x07\x08J\xa69\xe5\xa3\x00\x00\x00-\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U(\xca\xcfI\xd5\xabL\xcc\xcd\xe1\xd2UHLO\x8f\xc7\xc6\x8fO\xca\xccK\xc9\xccK\x87\x89\xe7\xa4&\xa6\xa4\x16\xc5\xa7\xe6\xa4&\x97d\xe6\xe7\xa1\xe8\xc1&\x87\xaa\x1f\x10\x00\x00\xff\xffPK\x07\x08t|\xfd\x8cD\x00\x00\x00\x7f\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\x03A\x0cD\xfb\xfd\n+\xd4w\x11\x1d\xba\x1f\xa0\xa7\xa0wv\x87d\x95\xbd\xf5\xca\xf6\x1e\x12_\x8f\xeer\xa1\x00!A\xe5\x91\xedy3\x0f\xd4\xa0s6\xcbR\x8d\\(	\x15p\x82\x12\n\xa2g\xa9c\xe0\x96_\xa1\xeb\xcbDz\xe28r\xf7\x8bh\xfe\xe0\xed~}\xb21\xcbqy\x0c\xd7\\\xd3D/R\x10f8'v\x9e\x02Q\xe5\x19\xd3\x8e\x1d\xee\xd8A\xd77\xed\x056\x85\x81\xb8\xe5g\x95\xdel5\x0ct8\x04\"\x85I\xd7\x88}\x17\xa5\xbe\xe5\xf3\xcc\xcd\x02\xd1\x02=\xed\xfb3|\x9b%\xdbM\xbc\xb3\xc7\xcb\xcd\xa2`\xc7&{Kw\xd9\xbe\xee	\x05\x8e\xff\xc6\x1f\xcd\xd9\xfb/-~\xe4\xfc	\x8e\x05\xd5\xbf\x11\xf7\xf2\x9f\x01\x00\x00\xff\xffPK\x07\x08\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8d\xb1\xae\xc20\x0cEw\x7f\x85\x7f\xa0}z\xdbS\xb6\xc7\xc2^$v71`\x9a\xda\x95\x93t\xe0\xebQ\xa5\n\xb6nw8\xe7\x1eZ\xe4\xca^\xc44\xa0\x8f\x14{j\xf5a./\xaab\xdaO\x7f\xa5\x17\xfbY\x7fa\x12M\x01\x07\xcb|\x12M\xa2w\x98\xb9R\xa2J\x01\x10\x95f\x0e\x98\x99\x12{\xc7\x99\xe3fwn\x99\xc7\x9d\xde\xf6\xc0\xb7\x0d\xa6E\xcenm9(\x02\xe27x\xf8\x0f\xa5\x8dO\x8e\xb5\x04\xe8v\xe7\xc2\xbeJ\xe4\xff\x18\xadi\xfd\xd8\x85\xe0\x1d\x00\x00\xff\xffPK\x07\x08+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xcc\x97?\x8f\xdb0\x0c\xc5w\x7f\n\xe1\x96\x9b\x92C\xb7\xc2k\x87\xeeE\xd1\x9d\x91_rDdI %\xf7\xcf\xa7/\xec\xf3\x15\x88\x9d4\xb0\xe387E D\xbc\x1f\x9f%R\xa1\xc8? \xca\xc1\x97Fvd\xb7\x94\xd3k\x10\xfeC\x89\x83\xdf\x1e?\xeb\x96\xc3K\xf3\xa98\xb2\xafJ\xf3\xc5eM\x90o\xc1\xa1\xa8\x91\xa8\xa2Dea\x8c\x15t	\xdf\xb9\x86&\xaaci|v\xae0\xc6S\x8d\xd2\xd4\xe4\xe9\x00\xd9H\x9bh\x8c\xa3\x1d\x9c\xb6\x89\xc6\x1c\xf3\x0e\x96D\x18\xd2J\xf5;K\xf3\x9c$\xe3\xb9\x90\xec\xa0e\xb11\x14\xf9\xab\x84\x1c\xbb\xb4\x8dyz*\x8c\x11h\xc8b\xd1\xc7Z-\x8dd\xa1\x851\x0dd\xd7\xc7;:t\xcb\n\x0e\xfd\xf2\x80\xd4\xfd:\xd6\xb7E\xa4d_\xbbU\x8e\xd5{\xc2\xcf.8\x92\xa7\xc8\xf8\x95\xe0[\xe7\xb4\xb7i\x0cd\xb3\xa6P\xbf\x07+\xec\xd9sk\xd3\xfd\xf9,%r\xe1\xb0=uwLH\xd6\x86\xec\xd3\x00h$\xbd\xbc\xe0\x8b&J\xf9\x82\xee\xa8\xd2\xd9r\xfd>\xf8$<\xf3X,W\xfb)\xcc\xfa\x0e\xfcV\xac\xf8\xa5\x87\xc2\xeb\xd6\xbbz\x9dk\xd5WA\xb8Au\xdaZ.\x9f\xec;\x16~\x9ed%\x1b\xc2~\x0fa?\xfc\xce\xab\xdf\xe9(\xa1\xe1\n\xf2h\x0e\xc1a\xf6`Y\x8e\"\xc1\xd3h\x9a,FqM}0nYmhp\xc7\xbe?\x9b\xe7\xc6;2O\xf7L\xf7\x7f\xb0\x13K\x8c\x85k\xca\ni\xd8\xc2\xbe=\\I\x95\x0f\xbe\xc6\xe3\xce\xe8)\xcf\xedSj\xa2\xde\x8dn\x87\x08\xa1\x14\xe4j#h\x1bF\xccn\xa6\xcdS^\xba\xd3\x91\xd6\xf2\x00\x0eM\xbb\xf1\x03y\xf0\x0fi-\x0f\xf6\x90\xd9\x0d\xf8.\x0e\xf4@7\xd6\x7f\xf1\xaf\xf2\x99\xc1\x1c\x1cv\xec\xab\xd9o\x95)6L\xe4\x1a\x00\xb5\x98\xff!\x83Zr4\x03\xf3o\x00\x00\x00\xff\xffPK\x07\x08\x7f\xbd\xc5\xf3\xdd\x01\x00\x00e\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xcc\xcbLK-.)\xd6\xabL\xcc\xcd\xe1\xd2U(N-*\xcbLN\x85p\xb9\x92\xf3\xf3\xd22\xd3K\x8b\x12K2\xf3\xf3\xc0\x1a\xb2K\x8bK\xf2s3\xabR!R\x10u\x80\x00\x00\x00\xff\xffPK\x07\x08\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92\xb1n\xa4@\x10D\xf3\xf9\x8a\x926>\xb8\xbd\xe8Dz\xd1\x05N\xbc\x92\x1d\xf7B\x03-`\x1a\xf7\xf4\x80\xec\xaf\xb7\x00YZG\xdeh\xd3V\xe9\xd5\x9b\xd2\x9c\xe0=\xa3\xd5q\xd4Ub\x87Zc+\x1d$\xa1U\x833\xd5\xfdv\x1err\x9d\xe4\x83\xb1\xf6l\x0cW\x8c\xaa\x03\xc8\xb7CD\xca\xd7\xe4\xe2\xd9\xb7\xf0B\x96\x8ap\xc2\x7f\x87\xf1[\x16\xe3t\x03X\xfe\x14\xe7\xe27\xd4\x10ye\xdbP\xab\xda\x80\xd9tf\x1b\xdf\x8b\x10i\xe2gn\xd98\xd6\\\x85_\x18$6\x15.l\x8b\xd4\x1c\x80\x85-\x89\xc6\n\xcb9\x00\xad\xf0\xd8\\f\xaeS\x15\x80\xaf\xf4Sv\xdal^\xf9\xda\xab\x0e\xff\xf6we#\x17\x8d\x01\x00:\xd3<W\xa0f\x92\xb4\xd1\x8c;I~\x04\x8a\xe1o*D\xf7\xdcL\xdeWX\x0fL*\xebQ8\xfa\x81+\xd3\xa1Tn\xc67\xdd/4J\xf3\xe0\xf6}\xb54\xd3\xedb?lp\x9f\xc3\xbd\x06{w\x00jcr\xae\xe0\x969\xdc\xbd\xc8\x03\\\xc2B\xf6\xed[\x1d\xb4\x89\x9d\x1ar*)F\xf5\xbd0\x85\xcf\x00\x00\x00\xff\xffPK\x07\x08w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\xcc\x97\xcf\x8e\xda0\x10\xc6\xefy\x8ay\x01\xb3\xe2VE\xdaC\xbbK{\xe9aUm\xb7\xe7\xc1\x19\xc2\x08\xc7\x8e<v*\xde\xbe\x02'l\x10\x90\x14iW\xc9\x0d{\xfe\xf8\xe3\xf7\xc5\x03\xc9\x94R\x19\xd6\xfcF^\xd8\xd9\x1c\xb0\xa8X\x0e\x1f=\x95,\xc1c`g\x17\xbb/\xb2`\xf7\xd0,\xd7\x14p\x99\xed\xd8\x169\xbc\xa1\xe1\x02\x03\xdb\xf2\x0f\xad\xb7\xce\xed\x9e\x9c\xddp\x19SMVQ\xc0\x02\x03\xe6\x19\x80\xf6t\xdc|\xe5\x8a$`U\xe7`\xa31\x19\x80\xc5\x8arhN\xad\xd4\xdf\xd4K\xe9\xb3f\xed\xae\xe4\x99\x02m\x98lH\x87\x1d\x9a\x03h\xfc\x16ma(\x87\xa7\xf2\xf1\xf1\xb8%\xe4\x1b\xd6\x94\xe2\xdd1]\xef6\xd8\x8bI\x8d\x9ar\x90\xbd\x04\xaa\xda\xfd\x1a\xc36\x87\x87V\x1b)\x8d\x01\x8d+\xd5.\xaeI\xa3\xf7L^\xb1S\xcd\x12M\xbd\xc5\xa5B\xad]\xb4!\x03\xd8 \x9b\xe8\xe9\xc5\x19\xd6\xfb\x1c\xbe#\xf7\xbfjJ[\xf4\xda,\xd8e\x00>\x1a\x92\x83`\x05X\xf3\x0f\xefb-I\xbf\x82\xf6\xec\xcb\"\x80w\xf7N\xd9\x9d\xa4\xe3\xd2\xd5\x94 \x9e\xc2O\xbfV__W\xed\xe2\xf7\xcb\xf3\xfb\xe2y\xf5s\xd5.<\x89\x8b^\xd3\xa9\xaa\xd5-3v\xa0\x8d\x93\x0d~?fC?wB/z\xf8/\x88\xf7$2M\xc9\xfd&\xef(\xc1U\x9d\xee\x82E\xbb\x86\xfe\x03\xfd\xf5\xb2\xfb\\\xf8h\xfa\xc3\x0f\xffu\xc9\xd3\xba2v\x1b\n\xf2\xdcPq.}\xcc\x9b\xabE\xf79\xf3Y\xf7c\xd8\xa1\xab\xc2\xe7l\x8f\xdbl\xc8\xb3-\xc7\x1c\xe9\xf2\xe6a\xc2\x05\xf7N\xde\x9cQ\xd7\xde5\\\x90\x1fC\xdd\xe5M\x88\xba\xf7\xdb|\x81\xba\x937%\xea[\x88\xdbn\xdaD	\xe3\xa0\xcf\xb3\xef\xc3\xfd\xe9\x98\xcf\xc5\xcd\x1f6\x8api+\x1a\xff\xeby\xabnZ\x03\x86\xe7\xcb-\xcdS\xfa26o\x0e\xefN\xce\x8e\xb9\x91\xb2\xeec\xff\xa1\xb3f\x08{\x127g\xc8\x81,\x8e?\xf2)kB\xc8C\x93&\x89\x93\xec_\x00\x00\x00\xff\xffPK\x07\x08$\x01~\xbc\x05\x02\x00\x00\x86\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm84\x8bA\xaa\xc30\x0cD\xf7>\x85.\xe0\xc5\xe7gS\x9f\xa2P\xe8^u\x86\xd4\xc4\xb6\x8c$Rz\xfb\x92\xa4\xdd\xcd{\xcc\x0b<\xca\x1djEz\xa2\xed/\xac\xa5\xcf\x89n\xd0\xadd\x84\x06\xe7\x99\x9dS \xea\xdc\x90\xe8\x85\xc7Sd\x8d\xf6}\x9c\xde\x06g$\xb2\xb79Z\xb0\x81\xbc\x17C\xd4m\x1fD\xf1\x80D\xd3\xf4\x7f0\x91\xb3.\xf0\xeba/\xa76Td\x17=\x93,\xddUj\x1c\x95;\xd2\x0f+46\xee\xbc@\xc3'\x00\x00\xff\xffPK\x07\x08\xd1\x9cP\xa7\x86\x00\x00\x00\xbc\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80%\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbb\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(m\xf7>\x01\xa2	\x00\x00T&\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd8\x03\x00\x00crd/bases/catalog.kubecarrier.io_accounts.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(H\x08\x104\x0c\x0b\x00\x00R:\x00\x004\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xdf\x0d\x00\x00crd/bases/catalog.kubecarrier.io_catalogentries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(n\x80-.\xc5\x0b\x00\x00\xb33\x00\x006\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80V\x19\x00\x00crd/bases/catalog.kubecarrier.io_catalogentrysets.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Q$\x93\x1c\x94\x08\x00\x00\xd0(\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x88%\x00\x00crd/bases/catalog.kubecarrier.io_catalogs.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(?G\xb2\xeb\x02\x07\x00\x00\xdf\x1a\x00\x00<\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x81.\x00\x00crd/bases/catalog.kubecarrier.io_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xef\xb9oV\x9a\x06\x00\x00\x15\x1c\x00\x00/\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xf65\x00\x00crd/bases/catalog.kubecarrier.io_offerings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(f\x90\x00\xd6\xa7\x04\x00\x00\xf4\x10\x00\x00/\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xf6<\x00\x00crd/bases/catalog.kubecarrier.io_providers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x91\xd8.L\x1e\x04\x00\x00\x1c\x0c\x00\x00-\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x03B\x00\x00crd/bases/catalog.kubecarrier.io_regions.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6?\x07\x17W\x03\x00\x00\xf8\x07\x00\x00-\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x85F\x00\x00crd/bases/catalog.kubecarrier.io_tenants.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa7\xd9\xd0-\x19\x08\x00\x00>\x1f\x00\x007\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80@J\x00\x00crd/bases/kubecarrier.io_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf8\x87Q3@	\x00\x00\xab%\x00\x009\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc7R\x00\x00crd/bases/kubecarrier.io_customresourcediscoverysets.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x84C\x8c\x7f5\x06\x00\x00\xf7\x16\x00\x007\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80w\\\x00\x00crd/bases/kubecarrier.io_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(o\xd4\x072\x07\x07\x00\x00$\x1b\x00\x00-\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x1ac\x00\x00crd/bases/kubecarrier.io_serviceclusters.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(|\xfe[\xf0,\x02\x00\x00\x82\x07\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x85j\x00\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6]\x02\x05\xf3\x00\x00\x00\xda\x01\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xfel\x00\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xee\x1f\xba$\xf4\x00\x00\x00S\x01\x00\x00(\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80@n\x00\x00crd/patches/cainjection_in_accounts.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcb?\xf2a\xf6\x00\x00\x00Y\x01\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x93o\x00\x00crd/patches/cainjection_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe6\xc5\x0c\x9b\xf5\x00\x00\x00X\x01\x00\x00(\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xeep\x00\x00crd/patches/cainjection_in_catalogs.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe3\x06\xc8\xef\xf8\x00\x00\x00\\\x01\x00\x009\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80Br\x00\x00crd/patches/cainjection_in_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(i\x13/\xb1\xfc\x00\x00\x00a\x01\x00\x006\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xaas\x00\x00crd/patches/cainjection_in_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xebRX\xf0\xf5\x00\x00\x00T\x01\x00\x00)\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x13u\x00\x00crd/patches/cainjection_in_offerings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xeb\xb3\xf1u\xf5\x00\x00\x00T\x01\x00\x00)\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80hv\x00\x00crd/patches/cainjection_in_providers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(cH\xebq\xf4\x00\x00\x00R\x01\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbdw\x00\x00crd/patches/cainjection_in_regions.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa2&\x0d\xd6\xfb\x00\x00\x00\\\x01\x00\x009\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x0fy\x00\x00crd/patches/cainjection_in_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x97%|\x92\xf5\x00\x00\x00R\x01\x00\x00/\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80zz\x00\x00crd/patches/cainjection_in_serviceclusters.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x01\xe8r\x13\xf3\x00\x00\x00R\x01\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd5{\x00\x00crd/patches/cainjection_in_tenants.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(`\xae\xa9Fx\x01\x00\x00t\x02\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80&}\x00\x00crd/patches/webhook_in_accounts.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\"	(\xc8y\x01\x00\x00z\x02\x00\x00*\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xf9~\x00\x00crd/patches/webhook_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(M\xcc2ev\x01\x00\x00t\x02\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd3\x80\x00\x00crd/patches/webhook_in_catalogs.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(=\xcf\x87\xady\x01\x00\x00u\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa4\x82\x00\x00crd/patches/webhook_in_offerings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd2\x03\xbe\xdfv\x01\x00\x00p\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80y\x84\x00\x00crd/patches/webhook_in_providers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(D\xa1\xa7,t\x01\x00\x00n\x02\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80K\x86\x00\x00crd/patches/webhook_in_regions.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xba\x93/vt\x01\x00\x00n\x02\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x19\x88\x00\x00crd/patches/webhook_in_tenants.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x83Jq\xaaf\x03\x00\x00 \n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe7\x89\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9e\x8d\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf2\xcb'\xa8\x0e\x01\x00\x00\x0c\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80H\x8e\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(mK01\x1f\x01\x00\x00\x9a\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xaf\x8f\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80*\x91\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe3u\x89\xd4\xf4\x01\x00\x00\xe3\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9b\x91\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xda\x93\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(-\xd9\xee\xd9\xb9\x00\x00\x00G\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80N\x94\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x9e\xfbyQ\xb5\x00\x00\x00'\x01\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80U\x95\x00\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(J\xa69\xe5\xa3\x00\x00\x00-\x01\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80S\x96\x00\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(t|\xfd\x8cD\x00\x00\x00\x7f\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80G\x97\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd9\x97\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xf4\x98\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x7f\xbd\xc5\xf3\xdd\x01\x00\x00e\x10\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe2\x99\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x04\x9c\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9f\x9c\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!($\x01~\xbc\x05\x02\x00\x00\x86\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x11\x9e\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd1\x9cP\xa7\x86\x00\x00\x00\xbc\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80c\xa0\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x006\x006\x00{\x13\x00\x004\xa1\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x92Mk\xdcL\x10\x84\xef\xfa\x15\x05\xfb\xc2\x1bC$\xc59\xeaf\xc4\x12|\xf0\x12\xd6\xc69\x86\xde\x99\x964h4#\xa6[\xfeH\xc8\x7f\x0f#\x19\x8cMX\xf0Q\xea\xea\x8fzjv\xb8\x1b\x18]\xf4>>\xba\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3ML\x0c\x1b\xcd2qP\x18\n81\xba\xb8d\xb9bP\x9d\xa5\xa9k\x1b\x8dT\xb9\xb9\x9c(P\xcf\xa9r\xb1\xd8\xe1\xc7\xd5\xf1p}\xf8\xd6\xe0\x8eR\xcf*h9\xe9\xcd\xa6\xc0\x97\xea\xf2\x12f`3\x9e\x1dSs\xa8=)\x8b\xd6J2J\xbd\xcc}\"\xebB_\xbb`\xf9\xa9\x1at\xf2\xe8b\xc2)1\x8d\xd9\xb5\x19(\xf4,\x05\xcd\xee\x9e\x93\xb8\x18\x1a\xbc\x9f\xfapI~\x1e\xe8k1\xba`\x1b\\\xaf \x8a\x89\x95,)5\x05\x10h\xe2f\xa5\xb5\xc1*7X/\x15\x99\xc9\xe4\xf2\xb3(O\x85\xcclrOV\xdf\xae\xea\x06\xbf\xff\x14eY~\xe4\x88\xf6\x95\xfe?/I\x0f.\xf4ev\x02\xec\xa0\x83\x93\xb5\x04\x19\xe2\xe2-&R3@\x07F\x0c\x0c\x9ag\xa6\x943\x0e\x18\x17\xd18\xb9_lb\xe8\\_=\xd3\xe4\xcf\xfa\xd8\xe1\xbfO\xb7\xfb\xe3\xfdu\xbb\xffy\xb8\xba\xd9_\xac\xef\xe3\xed\xbf\xdb\xefW\xed\xfe\x02\x8f\xce{\x9c\x18\xb2\x9cD\x9d.\xca\x16\xa7\xe7\xd7\x95\x05`\x83\x1chb\xc9^\xca\xf7\x93\xab\xb7\xdf\xdb\xd4J\x1e\xcc\x87\xc4\x95\xf1\x8b(\xa7\xcaGC\xd9\xdb\x16\xd6\x91\xbb\xbc\x14x\x932p.]a\x93X\xf3\xc1\xcdzk{\xdc\xdf\xad\xdb.\xcaG>\x0d1\x8e\xe5\x1a\x85\xe1-\x8a\x97$\xb6\xb6\x0dG\x88\x9a\x91\xcc\x89;\xf7\xc4\xf63\xc4\x05\xc3p\xfa\xbf\xac\xb5\xed	\xbc\xe3\xf4w\x00PK\x07\x08\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00F\x00\xb9\xffresources:\n- certificate.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x93\x0dV/P\xf6\xbc\x9e)\xd6\xee\x1e\xaa\xaaW\xf0\xf42\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06\x8f\xab:roE\x97a\x12\xda\x1b\xd4Q\xba!(y\xd5\xb6\xe02<z\xd5Wb\xed/\x88\x8e\xb1\xcd\x12D\x93J\x18\x0b\xa4\xcd\xb8\x8a\xc1\xc7\x93\x87\xc6\xd8\x95\xb4_\xcf,4\xb6\xcc)\x1dp\xd16O\xb8w\x1f\xb4\x04,\xd6\xc76!\xd3\xe2P\xa5\xc9B\xbb\xd5\x9e\x80\xa2|\x9e\x1f6f\x9f\x12\xf0\xb1\xbb\xa3\x85\x16\xcd\x12L\xc0/s`\x93X'\xf8\xc6|\xd4\xf7og\x96\xe3\x9e\x93\xd2U\xec\x9b\xa6\xaf\xf6\x8f\xf2'7\xf7Z{;\xed\xe6\xdf\x91\xb9\xf9.\xf8?\x08g6\xc6I*\xd3\xdb\x00PK\x07\x08\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/bases/catalog.kubecarrier.io_accounts.yamlUT\x05\x00\x01\x80Cm8\xecZMs\xe4\xb6\xd1\xbe\xf3Wt\xcd{\x90\xbd5\xa4\xdeuRI<7E\xda\xb8T\xde]\xab$y/^W\xb9\x87\xec\xe1\xc0\x0b\x024\x00J\x1e\xa7\xf2\xdfS\x0d\x02\x1cr\x06\xd4\xd7\xda\x95\x8bq\x91\x86\x00\x1a\x8d\xa7\xbf\x9b\xcc\xf2<\xcf\xb0\x15\x1f\xc8X\xa1\xd5\n\xb0\x15\xf4\xab#\xc5\xbfl\xf1\xe9\x1f\xb6\x10\xfa\xf4\xeeu\xf6I\xa8j\x05\xe7\x9du\xba\xb9&\xab;S\xd2\x05m\x84\x12Nh\x955\xe4\xb0B\x87\xab\x0c\x00\x95\xd2\x0e\xf9\xb1\xe5\x9f\x00\xa5V\xceh)\xc9\xe45\xa9\xe2S\xb7\xa6u'dE\xc6\x13\x8fG\xdf\xfd\x7f\xf1U\xf1u\x06P\x1a\xf2\xdboEC\xd6a\xd3\xae@uRf\x00\n\x1bZ\x01\x96\xa5\xee\x94\xb3E\x89\x0e\xa5\xae=\xc5\x12\x8d\x11\x9ebf[*\xf9\xe4\xda\xe8\xae]\xc1\xcc\xaa\x9eZd\x11\x1d\xd5\xda\x88\xf8;\x07\xf4\x07\xf2\x7f\xa3}9V\x8dP~E\x0f\xc8Y\xcf\x8a\x7f\"\x85u\xdf\x8e\x9f\xbe\x15\xb6\x9fiegP\xee\x19\xf7\x0f\xedV\x1b\xf7~\xcfB\xce\xd3\xfd\x8cPu'\xd1\x0c\x1b2\x00[\xea\x96Vp.;\xeb\xc8d\x00\x016\xbf9\x07\xac*/\x08\x94WF(G\xe6\\\xcb\xae\x89\x02\xc8\xe1g\xab\xd5\x15\xba\xed\n\n\xeb\xd0u\xb6`(m\x8b%\xf9\xff\xfc\xb2\x88o`\x1f\xde\xc7%a\xd6\xed\x98\x05\xeb\x8cPu\x82nKe\x11\xf5\xa0\xa8\x84m%\xee\xde\x1f\xd2\xbe\xe8\x9f\xc3h\xe2\x11\xb2=\xbb\xed\x16\xed\x94\xd2\x8d\x9fx\x12\x8d\x81\xab#\xcd\x9aP<\xab\xe3	=K\x15\xba\xfe\x01#\xb4\x82\xbb\xd7(\xdb-\xbe\xf6\x8fl\xb9\xa5\xc6\xab;\x0f\xdd\x92:\xbb\xba\xfc\xf0\x97\x9b\xc9c\x80\x8aliD\xcb\xa2Y\xc1\"\x02k\xa85dI9\x0b\xa8\x00K\xa7\x0d\x08\x05\xdfvk:\x0fj\x0c\x17\xd4\x92\xaa\x84\xaaA+\x10\xee$\xde\x94\x87\xd1\x92\xec\x12\x84\x83\x12\x15\xb4F\xdf\x89\x8a\xc0\x92\xb9\x13%O\x94Z\xd9\xae!\xd0\x9b\x0d\x19\xaa\x86\x19\xd0\x06\xd6\xdam\x0b\xf889mD\xda#D\x16\x10\x14\xdd\xef5\x006\xda\x00a\xb9\x8d\xcaQ\xc0\xed\x96\x06My\x17\x00\x06a\x81~m\xb5\xa5jD\xd3i\xe8,\x19\x0bn\x8b\x0e\xd0$\x18\xdb\x18\xdd\x80\xdb\n\xbb\xa7\xffQ\xc1\xabWo~\xc5\xa6\x95\xf4\xea\x15\xfc\xf4\xd3O;l\xe4\x88\xec\xd8m\xa5m\xfc4\x8alj\xad\x10\xf5a\x15\x95i\xcc,a\x93#\xb06\xf3\xf4x)\xc0H\xabW=\x00pK\xd8\x1c\x8b\xfaR\x8dH\xbe\xfe\xfa\xef_-\x01\xa14X~\x82R7\x0d\xaaJC\xa7\x84\x83{\xb4\xc0\x9a\xc0 \xb5FX\xad`\xbd\x03\x84FH\xe1\xd0\xec\xa0\xd4\x9dqEQD\xb1\x8fy\xfd\xc8\xb6r\xd5\x8b\xdfx\xa5\xbf%\x85\xca1X\x8ba]ktK\xc6\x0d\xbe\xed\x08\xbd\x11\xc5\x03\x8d=a\xa5\xee\x1d\x0dT\xec\xed\x89\x85H\xd1\xf9\xb0jy\x85\x07\xbd\xe9\xa57h\xb67\xb3	a\xe0E\xa8@\xaf\x7f\xa6\xd2\x15pC\x86\xc9\xb0\x1f\xecd\xc5:{G\x86m\xa3\xd4\xb5\x12\xbf\x0d\xb4-C\xe3\xb6\x04\x92\x15\xd3\x1d\xd0\xf4\xceN\xa1\x84;\x94\x1d-\x01U\x05\x0d\xee\xc0\x10\x9f\x02\x9d\x1a\xd1\xf3Kl\x01\xef\xb4!\x10j\xa3W\xb0u\xae\xb5\xab\xd3\xd3Z\xb8\x18\xecX>,\x9a\xdd\xa9\x8f[b\xdd9m\xeciEw$O\xad\xa8s4\xe5V8*]g\xe8\x14[\x91{\xd6\x15_\xd8\x16M\xf5\x7f&\x84G{2\xe1\xf5\xc8\xcd\xf5\xc3k\xe6\x03\x12\xe0\x88\xc2f\x85ak\x7f\x8b=\xd0\xec!\x18\x9d\xeb77\xb7\x10\x8f\xf6\xc2\x98\x10\x85\x80\xfb~\xa3\xdd\x8b\x80\x01\x13jC\xc6\xef\x8b\xc6H@\xaaj\xb5P\xce\x1fPJA\xea\x10~\xdb\xad\x1b\xe1,\x18\xfa\xa5#\xebXV\x05\x9c\xfb\x0c\x00\xd6\x04]\xcb>\xb4*\xe0R\xc196$\xcf\xd1\xd2\x1f.\x00F\xda\xe6\x0c\xec\xd3D0\xd8xbq\x8f\xdah\"&\x173\x16\x13\xdc\xd7MKe0\xa5u\xb0\x99\x8a\xac\xf0>\xcf\xa1c\x0f8x\xba	\xb1\xb4\xb1\xcesy\xe4y\x16\xd1\x17\x7ft\x0c\x1f\neG\xd9\x01l\xbb\x06\x15\x18\xc2\n\xd7\x92F	\xc6\xe1\xa8\xc8\xa1\x90\xb6Xd\x873\xf3,\x1e2\x93\x98>\xe0\xf6b\xbf\x9a\x95\x9cq\x92Z\xd5\xde\x8c{\x0e\xa8\x1a\xefHR\x04F\x93\xb7\xb2K\x11%\x15YjQ#\xd4[R5\xe7@\xaf\x93\x0bf\x0c4\x8e\xb1\xefO\xee\x9f^l\xbf:^\xccC\x9f\x0f\xd0s\xe0\x19\xfc\xe6\x1f\xca\xb9(\x0f\x9d|\x92\xe5\xcb\x923\x0d\x0e\xfd\xb6A)\xc1\xfe\xd2!+\xac\xd4\xb5\x8e\x08\xdb\x87\x10~X1x\xa4\xf57\xc9\xcd\x05:\x8c\xc8\x89\x06k\xf2\x9b\xd3\xa2\xe5\xb1\xd1\xa6A\xb7\x82\xf5.$m/\x00\x8aGC\x95\xc0[^\xf74>\xdf\xc5\xf5\x11\"\xa1J\xd9UT\x05\xae\x85\xea\x19\x07*\xeay\xee\xa1_}\xda\xaaz\x19\xfe\xfd\xb9\xa5\xe1\x7f{W\xbf\xfcN\xec\x9b\xd9\xf3\xa4/\x94{\xf6f\xa6\x060\x92\xf33\x1e2\x0e\xd6\x9bU\xf6\xa8\xa0\xdf\xb2z\x05Ao:\xd6;\xf1\xdb\x9fZ\xf7\xa7\xd6\xbdP\xeb|Y=\n+O\xd0\xc0\x9b\x83-\xc1	\nUK\x02)\x14\xf5D\xc7\x12\xf8\x9f\xc5\xa1yc\xce\xc7\x11\xeah_~\x04L\xf6\x0cs>\xaa<\x12v|\xcdk\xfah\x16\xb2\n\xae\xfa\xec\xb1\xed\x08GM\xd2VG\xf8\x0e\xb5\x1aS\xf5\x9c\x8d\x12W\xc0;\x14\xd2\xe7/aU\xcf`\xcaLIuM\xea\xac}\xdd\x94\x9c\xec\xeb\xa8\xec\x99\xc2i\x84\xba\xf4\x97Kd\x18\xfdF4\x06w\x07s\xb6\xf39f\x02\x92	 7a\x19l\xb5\xac8\xe3\xe6\xda^\x954\xd4G:\xcc\xfb*\xbbAUO\x8a\xf08\xae\xffyv\xde\xc3\x15+/l[\xb9\xe3\xc4\xfd\xf3$\x15\xf8\x1b36T{\x03OXS\xe5YH\x10\x854[Kp\xba&\xb7%\x03\xf7\xc2m\xc1\x97\x1b\xac\x15\xfcK(\x1fs\x83\x1a\x14\xd9\xf3\xc3\x03\xb6\xe2\x1b\xdf'L\xce\x1e\xc8\xe0\xec\xea\xd2/\x0e2\xf0G_]\xf6\x8d\xc6\x18\xff\x87\xfb\xa7\xd0\x0f5D\x17j\xe0\x0b\xda`'}\xd9\x04\x8b\x05G\x93\xe8;\xa2bG\xe5\xd8\xaf\x9d\xa5\xca4\xcc\x1a\xcb\x02;\xb7\xd5F\xfc\xe6k\xf0P\xd6\xf6\xc4\xbf\xb7d|n\xdd\xdfb >C\xf3\xd1\xc0u\\\xbc\xce\x00\xe7\xabX\xbd\x89\xea\xb0&.[\xf7H\x15\xf0\xc1\xd7\xe6\xa1\xc90\x8f\xdcz\x17\xbaD\x03\xea\xdcNZ\xf0\xbd\x16KX\xf8k-\xfa.\xc0b\x8a\xe4b\xee\x92\x00\x97\xbd\xd7>\x0b\xb0\x91\x81J\x93\x05\xa5'\xdd\x08\x166\xdf7v\x1a\xdc6\x1d\x9fx\x8cH\x05#3\xd4r\x08A\x05d\x8c6/F\\\xcd\x96\x1f\x07\x88\xbf\x1f\xca\x0b\x9aE\xfds\x98\xf0\x8d\xe1'\x19\xcd\xbe\x89xd!\x81\xb3b\x90\xc1Q\xb9=\x1d\x9fB3Di\x95\x0fL,\xc1v\xe5\x16\xd0\x06=\xe0.\xe7D\x13\x12\xcd\x90\xfd\xf0\xd2\xeci:\xa0\xa6u\xbbCe\xf8\x9d%\xc8\xbe\xebI\xb8\xf9\xd0\x17 \x0b\x96\x1a}\x1e\x82\xf3\x01*:\xbe%h5\xaf\x8dz\x03\x1f\x04\xdd\x93Y\xc2\x9bJp\xb3Y\x1b8\xe37\x19E\x98\xb0\xbe\x91\xcc\xa5)|\xc7\xadY\xa1jnM\xcf\xdb\xa1P\xd6!\x07\x9fH\xb2\xa7\x80\xd2\xea\xd0B\xe6\xed\xa1\xff\xb3_\xfd M\xcfQO\xa7\"I\x87\xfbB\xf8`\x87\xfb-\xed\xb8{\x15\xbc\x97\x07Ew\xf3Z\x83\x1es\xef)\xfa3\xe6\x14\x7f.Y\xe0\x91\x07\xa8f\xa7{hg\xa7\xcf\x867G\xcf6\xb8\x87\n\xb8\xdc\xdbD\xe2\xd4\x1cF\xefu\x9e\\\xb5\xbd$\x83I\xb3\x97{\xd0\xa7\xd1*\x1f\"N\xf6\x84:\x92\x1be\xddAV\x94L<\xfc\xbaq~\xc8\x16\xa3\xd7\\5~F\xbf\xad\xd4\xaa\xef\x9a=\x96\x98\x9d\x0f\x0b\x0fy\xe8;\xd6\xa3t\xb5g\xca\xc7e\x9b%\x0d\x15#\x9b'\x16\xca\xce\x18~5\xe0o\xf0\x99\xd9\xd9\xc0$\x0c]\xc1\xd0\xe1\xf3\x89\x01s\x1b\x8f\x1b.~tb\xe0q\xf2\x9e&{~E.\xd1\xba[\x83\xcaz\xd4\xf8-o\xea\x16G\xe2~{\xb4-V\xeaL\x10\x9ch\xb8\xefM\x8f\xf0\xcf\xc3\xf5\x87\x87N\xb7\xe6\x02\xaf\xd7!\xa7\x01\x95\xe6\\\xb3\xc8\x1en3\xb1_\xcb\xf9\xc8\x97\xc6\xd0\x86\xac\xc5\xfai7\x7f\xd7\xaf\x8d\xd7=\xe8\xe1\x06J T%Jts\x07\xf2\x88\"\xc7\xb5\xee\\\x80m\x10D\xf1\xd2\xab\x18B\xab\xd5\x93nr\xed\x97\xc6\x8b|\xb16\x826_\x06\x02{E\x8c\x02\x9c\xbc\xf4\x9c\x8e\xdf\x8b\xf7\x94\x93\x99q7\xc1\xcf\x04\xde\x83\xc6\xe8\xcd\x94e\x1f\x89\xf9\xe9\x17'\xb7\xa6\xa3\x93\xe5\xec\x0dN\xfe\x85\xd2\xd2\xc9\x12N\xbeW\x9f\x94\xbeW'_\xbeX\x02\xee\x81~\xe5\xc4e\xde\xee\xdaA\x8d\xdc\xa8i\x19\xacy\x0f\xfd2\xba\x03\xb9\xcb\x92d\x01\xe0\x8b\x93k\xc2j\xf7b\xbe\x1f\x8ek\xc7^\"A\"\x8f\xda\x9f\x9c\xeb\xf5*9e\xc7_\x0f\x8cG\xeeq\xc9\x9e\xd9\xed\x9c\xaf\xee\x1f\xc8\x95\xd3Y2\xa7\xcbQD\xfbw\x044J\xa3}1=*{\x13\xcc\xf6\xb9R\"\xd1y\xb8[:_]\xfcq]\xabd\x9a\xf2@k9\x06\xf6oH\x91\xc1\xf4{\xa6	\xb2\xdf\x1dm\x88\xf06\xda\xfa\x12\x8f\x83l\xbd\x9f\x8d'\x1c\x91\x85\xe0\xa2\xf6\xe1\xaf/Hi\xf4\x89S\x91\xcd\xbd\x99\x10\xca\xfd\xed\xafG\xb3\xfdM\xf95v}\x94\\\xfa\x8f^\x1e\xb9\xdd\xc5\x9b\xab\xeb7\xe7g\xb7o.\n\xb8\xe2\xf5\x87IH\xb0c\x90bC\xe5\xae\x94}\xb0Kix|\x19\x15\x8b\xb2s\xad\xac\xa8\x86\xb7\xc2\x82d5:\xce\x7f\x7fr/\xa4\xe4\x17\xbd\x86\x1a\x9dF\x8c\xbfo\xd0Z\xf1_\x8e\xab^\xb5\x11\x1a*\xb7\xa8\x84m8\xe46\xd8\xee\x1d\x8f\xef\x85\xf4jd\xe1~K)\xf3m\x8d\xd8\xbf\xf8\x0e\x1a\xbd\xe3/R\x84e\xf2Z\xc9\x1d\xa3\x1e\x1b\xa2\xd0v\xa6\xd5\x96\x96\xfe!\xdd\x91\xd9\xb9mJM\x01HZ\xe2\xb6\xe5\x88\x9f\"{\xb2[Kj\xed\xd1\xc3^\x1bW\xe0L\xd7K\xc1:m8\x15\x18=\xe9\xd6\xc3W\x04\xabl\x12\xa9\xe0\xdf\xff\xc9\xf6A\x0b\xcb\x92ZG\xd5\xe8;2\xae\x07V\xb0XL\xbe<\xf3?\xf7wZ\xc1\x0f?\xf2GeN\x1b\xaa\xc2\x07\x1ev\x05?\xfc\x98\xfdw\x00PK\x07\x08\x83e\x19z\x1f\n\x00\x00\x11(\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00-\x00	\x00crd/bases/catalog.kubecarrier.io_apikeys.yamlUT\x05\x00\x01\x80Cm8\xb4V_o\x147\x10\x7f\xbfO1J+\xf1\x92\xdd\x00mQYUHQ@\x02\xd1?Q\x82x\xa1T\x9a\xb3\xe7vM\xbc\xf6b\x8f\xef\xb8\"\xbe{5\xf6\xee\xdd\x1e\x97\x10^:O\xbb\xf6\xf87\xe3\xf9\xf3\x1b/\xaa\xaaZ\xe0`\xdeR\x88\xc6\xbb\x06p0\xf4\x89\xc9\xc9_\xaco~\x8d\xb5\xf1g\xebG\x8b\x1b\xe3t\x03\x17)\xb2\xef\xaf(\xfa\x14\x14=\xa7\x95q\x86\x8dw\x8b\x9e\x18526\x0b\x00t\xce3\xcar\x94_\x00\xe5\x1d\x07o-\x85\xaa%W\xdf\xa4%-\x93\xb1\x9aB\x06\x9fL\xaf\x1f\xd6\x8f\xeb\xa7\x0b\x00\x15(\x1f\x7fcz\x8a\x8c\xfd\xd0\x80K\xd6.\x00\x1c\xf6\x94}\xbc\xa1m\xac\x152Z\xdff@\x85!\x98\x0c\xb8\x88\x03)1\xdc\x06\x9f\x86\x06\xee\xd0*`\x93\x87\xc8\xd4\xfa`\xa6\xff\n0\xdb\x93\xaf\xd9\xb9j\x08~m4\x85[\xb6\x98\x1c:\xce\x1b%V\xe7\x97\xaf^\xd36/X\x13\xf9\xf5l\xf1w\x13\x8b\xe6`S@\xbb\xbbQ^\x8b\x9d\x0f\xfc\xe7\xde\xb7\n\xf0\xa6l\x18\xd7&\x8baR_\x00D\xe5\x07j k\x0f\xa8H/\x00\xc6x\xe6\xd3\x15\xa0\xd69Ch/\x83qL\xe1\xc2\xdb\xd4O\x99\xa9\xe0C\xf4\xee\x12\xb9k\xa0\x96\xb8\xd5\x81P\xff\xe5\xac\x80\x8b\x94\x80_\x11j\x98\xad\xf2V\xac.\xbd\xb7\x84\xee\x0e \xfa4\x98@\xf1\x9c\x0f\x90^\x94U\xd8-\x17(\x8dL\xc78SQ\xd5G\x05q\x80y\xde\xd2\xed`\xc5\xf9\xf5#\xb4C\x87\x8f\xb2NT\x1d\xf5\xb9JE\xfc@\xee\xfc\xf2\xd5\xdb\x9f\xae\x0f\x96\x014E\x15\xcc \x81k\xe0\xa4d\x12L\x04\x04\xeb][Y\xb3&-e\xaa\xc9\xb1A\x0b+\x1f\x80;\x82\xd7iI\x17\xa5 $\xff\xa7%Az4'\xc2>+\x9e+\xe5\x93c0,\xb0\xf9~\xa4\xc1\xb8\x1a\xfevp\x81\xd6F\xc0\xc4\x9d\xc0KmjXn\x01\xddXS\x80j\n\x9f\x08\xc6\x0c\x99\"\x85y\xb16\xa5J\x9a\xdf\xdcT\x1d\xcf\xca\xf7\xb3S\xe0\x0e\xb3\xe1\xa5ON\x8f>\xcd\x10\xaf\xbc\xa5\x08~u\xe0*FxkhC!\xdfV\xea\xa4\xf2\xcenG\x9f\"\xa0\xd3\xa2\xf3B\x1b\xf6\x01<w\x146&R=\xc3}N\x96\xd8\xb8\xb6\xe0\x96\xa8\x06Z\xfb\x1b\x8a`\xb8>\xd9\xa9\x0e\xc1\x0f\x14x\xd7\x8fEf<5\x03\xfd*[\x0f$\xa1\xa5\x05@\x0bAQ	\xcf\xd8\x16\xa4\xc7\x1a(\xd73\x11\x02\x0d\x81\"\xb9BY\x07\xc0 J\xe8\xc0/?\x90\xe2\x1a\xae)\x08\x8c\xb4h\xb2ZxmM\x81!\x90\xf2\xad3\xff\xee\xb0\xe3\x94f\x8bLc\xab\xef%\xb7\xa1C\x0bk\xb4\x89Ns\xe0z\xdcB \xb1\x02\xc9\xcd\xf0\xb2J\xac\xe1\x0f\x1f\x08\x8c[\xf9\x06:\xe6!6gg\xad\xe1\x89\x9f\x95\xef\xfb\xe4\x0co\xcf2\xd5\x9aeb\x1f\xe2\x99\xa65\xd9\xb3h\xda\n\x83\xea\x0c\x93\xe2\x14\xe8\x0c\x07Se\xd7\x9dD,\xd6\xbd\xfe!\x8c\x8c\x1e\x1f\x1c\xf8Z\x9a3r0\xae\x9dmd~\xfbF\x06\x84\xea\xa4\xbap<Zn\xb1\x0f\xf4T\x01W/\xae\xdf\xc0d:'\xe3\x00\x14\xc6\xb8\xef\x0f\xc6}\n$`\xc6\xadH\xfa\xceDX\x05\xdfgLrz\xf0\xc6q\xfeQ\xd6\xd0\xc8\xc9{\x89i\xd9\x1b\x96\xbc\x7fL\x14YrU\xc3E\x1eZ\xb0$H\x83\x90\x91\xae\xe1\x954bO\xf6\x02#\xfd\xef	\x90H\xc7J\x02\xfb})\x98\xcf\xdb\xbd\x14\xe5\x12\xb5\x19\xca4\x10\xef\xe8\x98\xd2\x88\xd7\x03\xa9\x83\x86\xd1\x14M\x90\x92fd\x92F(z\xf3~\xbe\xabQ\xbf\xa6\xd0\x83#G\xfc\xfa|\xff#f6\xc2M3\x820Q\xa8M\x0b\xeb\x1c\xda\xfeFxDv\x03\xe8\x1e\xf3\xe3H:\xcf\x84(v\xd9\xf4\x04\xb8b\n\xb0\xe9\x8c\xea\xber\xc6\xf9<\x04\xc6G\xc0\xa1\xa0R4\xe4\xea\x99Xqc\xb8\xf3\x89\x8b7[\x88\x8c[i\x07\xa3!96V\xb0\xb7\x80\x81F\x1e\xd4\xc7W\\\xf9\xd0#\x97\xb1V\x89oG\x1aw\xb4\xa9\xc84\xce\xef\x89\xc1\xd5\xa8&\xdd\xc8\xc1(\x8e\xf3K\xb3\x87\x96\xf84?c2[m\x90Uw\x84\x08\xa0dp\xdd\x95\xa4\xf9sa/\x91T ~\x89\xb1\xbb\xc7\xc5\xeb\x9d\xe2\x94\xa7\x8e>\x019\xe55i\xb8~y^=\xfe\xe5	t\xb2?\x0e\xae\x02}\x84\n\xd3\xfeX\xcf\xf0f\xa7\x0b\x86#\xd9\x95\x18\xc8\xa3-\x10\xa7 #\xc3;E\xa7\xb0\xe9\xc8\xc9\xc9[ \xf7\xe51\xcd\xf2\xb5\xc1\xdb\x9e\x04\xc7\xd1\x19\x90\x99\x82k\xe0\x9fw\x0f\xab\xa7X\xad\xde\x7f~\xf2\xf3\x97\x1f\xbf?\xcb\x81>&\xe9\xd4\xc3\x00V\xe3\x9d$\xb4\xf7\x93\xc4\x11sD\xe1Y\xdd\x00\x87T\xee\x1b\xd9\x07li\xbe\x92\x96\xbb\xb9\xd1\xc0\xe7/\x0ba\x8a\x94_\x96S\x1f\xcc\x9e\xb1\xc2m\x0d\x9c\x9c\x1c\xbc{\xf3\xaf\xf2\xae\xbcQc\x03\xef\xde\xcb\xab\x96} =\x0e\xf1\xd8\xc0\xbb\xf7\x8b\xff\x06\x00PK\x07\x08\xfc-\x19\xa6\xd4\x04\x00\x00\xa8\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x004\x00	\x00crd/bases/catalog.kubecarrier.io_catalogentries.yamlUT\x05\x00\x01\x80Cm8\xec=Ko\x1c9s\xf7\xfe\x15\x05\xe7 \xaf1\xd3^\xe7\x0b\x82d\x80\x1cl\xc9\xdf~N\xec]A\xd2\xee\xc56\xb0\x9c\xee\x9a\x19\xae\xba\xc96\xc9\x1ey6\xc8\x7f\x0f\x8a\x8f~\xbf$\xd9\xc1\x06P\x1fv%5Y\xac*\xd6\x9b\xc56+\xf8o\xa84\x97b\x03\xac\xe0\xf8\xd5\xa0\xa0\xdft|\xfbo:\xe6\xf2\xe5\xf1Ut\xcbE\xba\x81\xf3R\x1b\x99_\xa1\x96\xa5J\xf0\x02w\\p\xc3\xa5\x88r4,e\x86m\"\x00&\x844\x8c\xfe\xac\xe9W\x80D\n\xa3d\x96\xa1Z\xefQ\xc4\xb7\xe5\x16\xb7%\xcfRT\x16xX\xfa\xf8c\xfc\xcf\xf1\xbfG\x00\x89B;\xfd\x86\xe7\xa8\x0d\xcb\x8b\x0d\x882\xcb\"\x00\xc1r\xdc@\xc2\x0c\xcb\xe4\x1e\x85Q\x1cu\xec\x7f\xb5p\x13\xa6\x14\xb7p#]`B\xeb\xef\x95,\x8b\x0d\x8c\x8cr0\x03\xa2\xcc\xe0^\x12P\xf7;\xc0\x1a\x98]\x98\x9e54\xe6\xae\x0b%\x8f<Ee\x07z\xee\xb8%\xde\n\xa3N\xf6\xcf\x19\xd7\xe6\xbfz\xaf\xdesm\xec\xeb\"+\x15\xcb\xba\xf4\xd8W\xfa \x95\xf9\xb9\xc6\x8c0I\xd0\xbd\xe2b_fL\xb5\xe6\xd1z:\x91\x05n\xc0\xce*X\x82i\x04\xe0\x99\xeb\xe9Y\x03KS\xbba,\xbbT\\\x18T\xe72+\xf3\xf0\x9e\x9e5\xfc\xa1\xa5\xb8d\xe6\xb0\x81X\x1bfJ\x1d\x17\x07\xa6\xd1\xe3A\x8f\xdb\x86k\xfb\xb2\xf1gs\xa2\xf5\xb5Q\\\xecG\xe0\x15\x98\xc4[\xa6\xf1\xfc\xea\"&(\x8d\xd9\xf4\xeb\x06\xde0\x8dp~uq?\xb0\x16\x93\xd8\xa0`\xc2\x8c\x82\xbe\xb1\xaf\xef\x0f\x9cp\xce\xf8\x0e\x93S\x92\xa1eI\x1f\xed\xf7\xe1\xfd\xbd\xf0\x0eZ\x13\xf7$\xbe\x01\xc6-\xf0z\xdf\x07\x9d\xd6\x98\xb8A\xc7W,+\x0e\xec\x95\x1f\xa9\x93\x03\xe6\xac\xdeZY\xa0x}\xf9\xee\xb7\xbf]w^\x00\xa4\xa8\x13\xc5\x0b\x12\x8d\x0d<kJk\xd0^\x0d\x07y\x07F\x82\xdc\xedP\x01#>\xda_\xcd\x01\x95\xe7\xad\x8e?U\x8b\xd1\xf3	>	x\xdd\xd2\x0bP\xb8C\x85\"A\x0d\xcc\xcarf\xf7{E\xa2\xa9!p\x84 s\x03L\xa4\xa4\x80\xf2Nw\x01\x1b	\x19\xcf\xb9\x81\x1d\xc7,\x05\x96$\xa85\xecd\x8d\n\xad\xfd\xe2\xc55\xcf\x8b\x0c\xe1\xedWF\xff\x7f\xf1\x02~\xff\xfd\xf7\x13\xcb3h\x18\xbd.\xeca\x83\xf22pw@\xdb+\xbc7a\xc3z0e\x99\x1c\xd2\xad\x8e\xb1\\\xdf\xa16\xebW@\x82E\xe3\x9bs\x01R\xae\x8b\x8c\x9dH\x8b7pN\xb3.\xde\xf4\xb6\xa8\x0b\xfd\xe6\x80\x90\xc8\xbc\xd8\x9dH(\x18i\x18\x00xE\xdb4$\xa4\x8fF\x9cI\x99j$\xbet\xa1Z\x06z\xce\xc1\x1d7\x07\xc7rL\x1d\xd3\xf507\xe7\xd8\xd7]e	7\x17\xb1\xaf\x0bx17g\xd87\x04w\x8e\x9b\xb4\x80\xe2G$\x04\x01\xf0k!\xb5\xffy]\xdb\xe4!\xc0d!*As\x8fc\xf6f\xc8\x80\x90\x08\xc5\xa5FE\xf8\xd0\xdb>\xc8\xb6\xa1\xa4\xf1\x05\xd3\xfaN\xaa\xd4\x0f\x1b5\xf8\xe1}\x17f\x7f\xf8\x8e\x95_\x8d\x14\xaf\xd3T\x91\x16\xfay\x03\x03\x99\x1f\xd1Gs\xc4\xa4\xcb\xadFu\xc4\xf4'\x14\xa8\xac\x89$A\x1d\x90L\xd6\x05\xb9-E\x9a\xe1\xc3Dt@&\xbb\xe0{\n\x0f\xf7V\xee\x8b7]\xa0]5\x87\x0b\xaf\xcc\xda\xa9\xdf\x96%\xb7e\xa1\x87U{H\x0e\xfb\x0b8\xb6\x106k\x8f\xb7\x07Z\xcb0\xac\xd7\xeb\xef\xae\xd0\xfdU\xef\xaf\xcfo,\x8c>\xd7\xdex6\xc9\x1d0\xb8x\xb3H\x95{\xf8\x04\xbb\x08 \xb7\x7f`b\xae*\xbfED\xac\x83\x95\xb3\x11\xdd\x88&\x0f\xec\x8b\xfd{S\xccI!\xd3-Y{\x12\xecg\x0d8\x85\x92\x05*\xd3\x08F\xdd\xd3\xd8\x99\xd6\xaa\x1d\xd19#O\xef\xe4\x1dR\n\xd4Q\x839`\xb0>\x98\xfa\xf0\x80\xb8d\x0e\\\x83\xc2B\xa1F\xe1B\xf7\x0eh\xa0aLx^\xc4pMZ\xa94\xc5\xa9e\x96R\x8cpDe@a\"\xf7\x82\xffYA\xd7\xe4\xa8i\xd9\x8c\x19\xf4ao\xf3\xb11\xa8`\x19\x1cYV\xe2\xca:\xfc\x9cQ\x94@\xeb@)\x1a\x10\xed\x10\x1d\xc3\x07\xa9\x10\xb8\xd8\xc9\x0d\x1c\x8c)\xf4\xe6\xe5\xcb=7!WId\x9e\x97\x82\x9b\xd3K\x1b\xb8\xf0mi\xa4\xd2/S<b\xf6R\xf3\xfd\x9a\xa9\xe4\xc0\x0d&\xa6T\xf8\x92\x15|m\x91\x17\xc45\x1d\xe7\xe9?)\x9f\xdd\xe8\xb3\x0e\xb6\x83\xf1\\\x15\xfdO\xee\x05%\x01\xc0m\xc4c\xa3cGK\xcdr\xfa\x13q\xe9\xea\xed\xf5\x0d\x04\x04:\x10\xc1m\x93\xdb\x81z\xaa\xae7\x83\x18\xc7\x05Egv\xe4N\xc9\xdcBE\x91\x16\x92\x0bC\xbf\xf4\x80&\x19Ga@\x97\xdb\x9c\x1b\x92\x82/%jC\xfb\x16\xc3\xb9M\xe5`\x8bP\x16\x14l\xa61\xbc\x13p\xcer\xcc\xce\x99F\xb7\x15=\x88\xdfokh\x07\xf4\x9a\xec\xcb\xf2\xcd\xa9\xcc\xc9\xe0\x04\xc7\xcd\xd6\xab\x907\x8ejV3\x9c\xbd.0\xf1o\xb7^\xc1R\xd4\\\x91\x02\x18f\x90\xd4\xa69>\xee\x00\x1eSrh\xd8\xf8\xde\x8b\x8e\xa6S\xcaD\xc18w\xeb\x97\"E\x95\x9dH\xa4\xc2\x1bG%\xbdf$\x04\\\x0f\x80\x84\x16\x9e.L\xb7R00v\n\xeb*\xd6\x1c|\x03\x90s\xf1\x1e\xc5\x9e\xac\xdf\xab\xa8\xf3nn/\xdd\xa3\xf0KI\x1c\x1e^\xc1\xb9\xb5\x81W\xa3\x1b\x0e\xb5[\x9ce\xb5\xf5\x9e6\xaf\xd7\xb0+\x95\xcd}\x1a|\xe3\xa8\x81\x0b\xbb\x0d\x9aL\xba\x08\xb9\xf8j\x002)43\xc0\x14I	9\x97\x14\x8c\xdc\xa3\x85i\xdd\xbd\xd5\xe2\xe6\xae\xac\x00\xe3}\x1c\xfc\xdb H2\xa0\xd7\x82\x15\xfa \x8d\xf7\x80!#\x88=\xfai\x17\xe3\x03;\"\xd9\xe9\xed\x10\xdb\x004f\x98P\xd4\xbf=\xd5\x94y\x10zE\x7f:Y\"\x88+\x98\x06\xfa\x7f!\x92\xba\xca\x18\x9e\xe0s\x1a\x88\x9c,\xea\xa9\x14g\x06\xf6H\xa9_\x05\xc2\x0dG\xae@\xde\x89\x8a\x8aA\\\x1b\x00\x89\xb2\x84\x118K\x1f\xf3\xf3\x08R\xae1;R\x02*R\x1a\x02Rd\xa71\xea\x0b\xa6\x0c!P%\xab\x0e\xcc\x90Zp\x83y\xc7Y\x0f*\xec/\xed\x80\xa2c?2.ni7\x98p\xa9\xf5\x80\x89\xaa\x9fAa\x1b\xc2m^i\xa7\xd5v\x91\xe2\xce\xaa\xee\x9c\xf2N\xa8\xef\x8c\x02\x87\xd7L)v\xea\xbd\xf5\xc9X4\xb31\x17v\x18E3\x86q\xe1\xb6#\x91b\xc7\xf7\xa5O>\x8c\x84\xbdKE\xd0\x8fN\xdb5\xd1\x81%\xa0\xf6\xc4\x95A\x1e\x90\xff8\xba\xff\x9e\xf9\xc4r\xf0]\xc7tUe\x9c\xbb\x03O\x0e>\xa9\x84;\x9ee\xe4\xdf}\xe8\x17\xe4\xc9\xf1kX\xc5\xe8\xa1\x1a\xdb\xc8\xcb	%\xe8\xf1\xdb\xc7\xa7o-\x15\xe7\x96\xd1\xd6\xfd\xf2\x1d\xc7\x0e\xa2F\x8e\xacW\xe7\xd7\x1d\xe4\xa7\x90\\\xa2\x0d\xf4\xa4\xb8cef&\xc7th\xaa\xf1\xf7\x93}\xdcjkS\x9e\x18\xbb\xfd8	\xd3bh\x0b\xcc\xde\x00\xd4\xceBH\xe37>\xed\x90<\x03\x91\x18\x02\x17\x9e$k\xb2YQd\x1cS\xb8;\xa03$\xae\x84\x1a\x96\x1c	\x14\xea\xc7\xd6-1\x1d\x93\x85E\x121 \xab\x7f'6yD)\xacq<=\x85(\xd93vrQz,\xe3\x89\xd9\xcc1~\x1a\xcd\xa52\x01\xed\x1cn\x06f\x8f\xb6\xff\xbc\xfe\xe5g\x9a\xe8\x85\xc0\xd7/\xfd>n}\xf5{\x0e\xd3E\xa6\xb6\xf9XV\xdc\x1b\xd7\xdfhV\x88+[\xe2\xdc\xc2~\x15M\x00\xac\x1en\xac\xaf\xdd\"0q\"\x84xj\x99A?\x96#>\xab\xfd|]S\x05B	4\xa8\xd7\xd6b\xa9#\xaeKq+\xe4\x9dX\x872\x99Q\xe5\x9cj\xcd9\xa1\xfa\xa9\x8bX3 \xc9sYBf\xc6\xcd8\xb1e\xee\xac~<\xd1\x0f1O\xd5\xf6\xf9\xbc\xc0\xa7\xf2\xb53\x98\xa1\xe4>\xd6\xf6\x11f\x80x\xdf\xb1\x01\xfe \xc2\x17\x13g\xd0\x04`\x90\xa0\"\x87\xfeW\xb4\x026\xa0wuY-st\x18\xd2O\xba\xdc\xda_f\xc9\xa3\xe75E=\x80\x19\xe6(\x8c\x0ez\xd6\x0c\xdc\xb9H\xf1+H\xca,\xb24aj\xa1\xd2z\xf4\\\x997\xd4\x11\xf4\xc7\x1f?\xc7\x07\xa9\x0d\xc1s\xb8\xfb\x90	\x95\xfe\xf8\xe2s\xccs\xb6\x1fIy\xba\xcf-\x9et\x08\xb8\xc8\xbe\xa7\xb2\xc6\xffK)\x0d\xa6>\xe9\xa9\x0f\xcb\x1a\xe7\xcb\x1f\xcf:g4\xc4\xb9\xf8\x16Og\x9f\x97\x18\x94\xd9h\xb5\xf98\xd7\xf8\xa0\xdd\xbe\xa9\xa6\x06cZt=\xc0\x02\x90C*\xb7\x02\xbe\x03^)o\xca)\xeb\xaa\xe2\xcdE@+\x87\xd4\xf5A\x9e\xefv\x7f\xb5\x91\x8a\xed\xf1\xa77\x8b@\x1aY\x05\x94~:\xff\xb3r\x19\xc1\xc7\x01\xd3\x0f\x05n\xfci\xe3\x02d\xee\xb1\xc5\xdf\xc1+,\xb6\xf69\x17\xefl\xe0<Z\x14\x99Or\xea\xc7\xd7xg\x0c\xd8\x84_\x08\x00\xc2\x9eU\x87\xb6srjE\xb2*3\xf90\xd5\xe7P>\xda\xb4E%{\xe4fK$~\xa9\x19\xb8T\xda\xf4\xa5\xc8\x10`\xdb\xe07/3\xc3\xe9\x80w \x95\x98\x91\x8f\x99Dea>\xfb=6p\x89 \xae\xbd\xeb\x9f \xb1>i\x8c\x1e!\x9fSi\xf5\x9c\xce\xac\xbd\x00D\xf7\xd4\x8c\xaa\xdbb\x13\xcd\x88m\xd5w\xe1\xffLE\xd8\xbb\x83\xab\xa1\xf52l2\xbe,1\xfc8\xe2\x9bR:\x01\xa1\x1e\xa0\x94\x1c\x9bBC\x949Q\xad\xd7\xe1\xda\xe6	lo\xc7\xf9\xd3\x8dP\xa9\x1a\xe6\xf4\xb7\xca\xf6s\xd4\x9a\xedG\xc3\xf8\x96>\x7fpc	]}\x90w\x820\xf5\x9d\x11\xde\xa1\x1aI\xbb\x93Q\\dK\x02#P\xa1\"\x8ef\x946\xcd\xd6\x06\xd9h\x1c5kq)\x9a\x98 \xc2\xa6\xa7\x1bxm7j	\xa9\xd7\xa1\xd0N;\xd1\xe4\xf1*\xe4,dr<\xc01\xacQ\x94\xf9\x18N$\xc8\x93\xe8\xd0\x80\x8bJx&\xa0\\9\x99z0\xe7J\xa1\xd1\\0\xb3L\x06\xae\xab\xe1!\xec0<\xa7Jh\x8dk\x8b_#0\x81f'Rh\xaaF`\x1a\x88\x18\xe3\xe4N\xaa\x9c\x99\x0d\x95\x9dqM\x0b>\x8c\xdaI\xd7\x19b\xc1M4#\x1b\x1f\xfc\xc0vM/L\x0f\xeem\x96	T\xbb!\xe9\xa2cO\x9eTR\x16G\xf7O\x1f\x9a\xa2\xbbd\x13/\xea\xf1a\x173)\xf6\xaeZ\x8e\x86\xf1\x0c\xd3&\xd0\x11\x98\xde\nU$\x8c\xed\xde\x82*\xef\xac\x986[\x98F\x96i\x93X\x8f\x0f$\x1e\xca\x9c\x89\xb5B\x96\xb2m\x86\xb6&\x1c\xcc\xe8\x08D\xf8\xbf\xa0\x8c'\xfdS\xf7A\x92\xde%R\xf8\x03\xdf\x9ce\x19\xe8/%#\xdd\xc9\xe4^\x86\x9d\xd0\xd3\xf8.\xc9CC\xcb-\xc0\x02\x9c\xa8\x99$\xf0\xd7fhv\xfa\x18\xbb\x9a\xaa\xbc=U\x8d\x86\x0f\x12\x08zrL9\xbb\xa1\x91\x13\x90Z<\xfc\x10f\x04\x86q\x91deJ\x81\x9f\xc5\x9e\x0bG\x80ug\x13@\xc1Q\xfb\xb2\x10\xfb\x95\xff\xf1\x8f\x02\xab\x9f\xf5q\xff8\xda\xe6\xc3\xb5\xb5Ett\x95u\xcd\x9c\x911\xb3y\x04\xc9\xd5&Z\xc0\xd4\xf7$\x80^\x08v%I&\xff\xf3I.\x9f\xe4\xf2;\xc9\xa5\xedco\xb8\xb0M\xb4\x80\xc1\xd7\x9dI\xde\x90\xba\xe3\xd7\x8c\x0bt`\x9bR4\xbaw^\xa6\xbf\xbbo\x98K\x84\x1a>q\x10\x87u\x8fU\xd1=\xcd@\x8e\xc6\xe6 C\x1848ec\";\xb0:\xda\xf4I\xff\xaf\x94\\\\a\"U(	\xd3\x11\x91?\xe2\x19\x00j\xfd\x83\xcd\x06\x98m\xe7\xf6\xfe\xf9\xd1\x87\x9a)\xcf\xfd\xfd\x93E\xd2rQ\x0d\xb7\xf8\x8a2G\xc5\x13\x9f\x1e\xd7\xae\xc3a9\x9c\xf8\xb5z1\xa8\xe3LY/#Z,\x89\xa3\x07\xd5\x11\x06Y_\xe1\xecD\xbb\x85\xb3\xef\xb4\x0b\x18\x8f[\x90e\x85\xeaP\xa9\x1a\xe3\xe5\x00\x96\x93GT\x9dc\xc9q\x86\xfa\x13\xea^\x0d\xd1\xe6\xd3vwl\x9d\x1a\xb9M\xd6\x0f\x92\xba\xf8\x89\x13[\x7f\xa7f\xfc\x91t\xfd\xe0K\xc9\x84\xe1\xe6\x04\x19\xbfEx\xf6\xea\xc7\x9f\xf8\xb3q^-\xd0\xe0e]\x10=f\xfd\\\x85\xa6XK\xaeO\xb1}\xd5\xf4\x1b\xa05\x1fc,<\xab\x9a\xe8\xafX\xd0c1\xd7g\xe1\xef'\xddn\xa2\x05\xcapM\x8d.>\x14\xf9\xf5\xea=\x94d\x7f\x00\xa9\xe1\xcf\xa92\x15\x8f\x94\xab\xb2\xac\x80\x8d\x07\xfe\xe7\x99,\xd3\xb7v^\x0c\xd7e\xe1g\xd9\x0eT\xea\xf2Qh\x1bEW\xf6\xbf\x1a\x9ek\xa3J\xdb\xfe9\x96\x88\xb7@\x82<\xa2\x82\x7f\xdc\xdc\\\xfe`\x93\xae\x1d\xcf\x10\x9eK\x81VO\x00E\"\xc9Z\xd43F\x81\x16\xa8\xac\xfbZQ%\x14\x05\xcd\xa2>\x1f\x07\xd1\x1b\xaa\xb7\x19\x1e\x99\x91\xea\x878zP\xb0=\x9d6\xf3\xbd\xebc\xd9Ds\x86*\x8cl:\x89\x83\x94\xb7M\xcf\xc0\xb2,\xf4\x0e\x04k5\xbcIC\x9e\xc1\x82p\x08a\xda\xecw\xf2\xae\xfa<+\xb5A\x15G\xf77|\x85\xd4\xe6\x1fR.\x93\xc2K?\x98$\xb1I\x9194\xd0\x0b\xe4MuDP\xb2zZ\xc1\x16w\xd4\x9fL\xd3\xa5\xe2{N\xcd\xcd\x8d\xe9\xa00\x97\xc7\xeaT\xcbHj\xf75Cm\xb4\xe1a\xbe7=\x8e\x1e\xee\x06\xa8\x0c#K3>\xa0\xc3\x14\xbay)K\x13\x84\x926\xde2g\x05\x7f\xfbQS\x1f\xa0/\xac\x8d\xe1\xb48+,U\xb6\x18)\xb2\x11\x0d\x84Vt\xeeup\x1d\xe6\xd4T\xe0\xb5\x9bFQ\xf02\x01\x15 \x95\xa8\xa93\xd0\xb7EK\xe7p}+\xba?W~$m\xf3\x16{M\xd4\x8f,2\x9b\xf0\x15\n\x97\x8b\xb8\xc2\x8e\x847\x84\xb4)\x9bS\xb1^\xe3\xfc\xd10E\x1d\x9amE\xad\x05\xdaAy\x92\xdb'\xb9\xed\xc9\xed\xa4X\xf7\xee\xd9D3\xbb\xdd\xe9\xa3\xd5\xa1/\xbb\x8a\xfc\x83l\xdb.ef\xeac\xccA\xec\xab\xd1deB\xf7m\xd3e\x05\x11?`\xd5SMm\xc5\xf6\xe6\xd0 \xc4-R\xa9v\xac\x8b~\xe2\x00\xb2%\xd4\xcf:t\xda\xb6\x18?\x84\xce\xbb\xd8`\xde\xb0\xfa\x14\x0d\x00\xb6\xb7\x93\xda\xbc\xa06\xe8\x8a\xf61\xd2\xedm\xdaw~P\xe72n\xfd|\x82[\xc4\x82\x18\xc4\x95\x8d3\xab\xc6B\xe7\xee\x13Y\xd0\xb9\xed\x16\xcd\x1d\xa2\xf0\xe7Q+\xb8\xf4)\xc58X\x8a\xbb\xba\xe6F\xbb\xa3\xb7\xba4\xdc<\xa6\xae\x08\x1a\x87\xc95\x08\xa4\xd0.90\xb1\x0f\x07}\xaf/\xdf\xb9/\x06\xd85\xab\xfe\xfd\xde\n\x13\xb8*\x1av\xa7\xb81D\xbb\x84\x9c\x99\xe4\xe0{\x89\x8b\xd3\x04\xae\x9d\xdb\xd3\xf5\xf3	\xea\xdbg\x96\x91\xb6a]W\x91n`h Z\x83\xac\xfb\xc2\xc7\x81\xb69\x1a?\x8bzc\x16e\x99\xac\xe0?\xd17\x16B\xda8,\xd2=\xb1~}\xf9\xae5-0\xc6\xa7\x86uK\xd0\xd0\xad\xa5\xfa\xa97\x8c\xd2\xc2\xean\xdc\x04\x9bm\xcf\x0c\x13\x83\x0d\xd7\x8b]@\xeb\xfa\xdf2\x82[\xd1\xef$zb\x86\xe6\xf6\xdd\x92\xc7V\x08\xe6\x13\xde\x05G'\x8b\x19\xb74\x97}l\x9a:_\xf7h\x19\xd9%R\x18l\xcd\x04\xde#V\xc8{\x8e\xe6u\xcf\xe8\x11\xf1G\xb5\xf5\x01\xeb\x85D\xfe\xdc\x9d7E\xed(H\x980\x8b\xdfT\xcb\xe6\x04\xa5}	7zPUdF\x92\xa6\xba_j\xd3\xe2\x1c\xf4\xa0\x92\xb5\xf8\x7f\xd5\x9d\xe1#\x96kL\x14R\xd1C\xa4\xe0\xaeA|`\x85n,0\x00\x18(\x0dk\x18\xfc]\xf3\x82\x89\x8fw|W\xa0m\xe9\xadZV&\x9b\x03\xdb.!\xb4\xb78G\xfd\x98\x18\xa6K\xb9\xe7\x8b\x0b_\x1c\xf9d\xbf+\xe2\x07!6YN\xd47\xe2\x96U\xa8#\xd2\x9fm\x87	O\xbc\xba\xfa\xbfMuYz\xe6\x05n\xc6\xf4\xb9\x90\xc4d'\xa0*\x8f\xdc\xf9\x1a\x9f\xaa\x8dD\x9dvj\x1cI\x82\xe7m\xef_\xc2B9\xc9\xaf\xec\x93k\xf4M\xa4\x10\x98\xd0\xce\xc5\xda\xee\x0d1 ~\x8c\xc5\x1a\xba\"=\"*\xf6\xae\xb4\xdc\x0d#:\x8e\xc4t\x03\x0f\x19\x0b'f\x93C\xe6\xe4o\x11\xad\xd3\xfet\xb4x\xdc\xa354\xe4V\xd6v\x14f_\x82\x1f\x8e\xff\xbc\xd1\xa5\xbd\xfc\xb6\xb6t|\xcdu\xf8\nKo\xc1u\xd5\xc5\x13-\xb4\xe8N\xb87\xd1\xc4~4C4j++\x1b\x9fE\xa0\xca'B\xf8 \xc9\xe3\xafw'R\xb8oq\x0d\xbc\xeb\xa8\xc4y5\xb4\x8b\x8e\xfb\xac\x02\xb0#\xe3\x99\xed\x94q\xf8\xd9\xd2\xedh-\x96\xb5\xe8<\xd3\x90\x94J\xd9\x8f\x00P'\xddc\xcc|\x13n\x85t\xb0Q\xda\xf7+\xe9\xaa\x9d\xca\xaf;\x08\xb7\xc1\xa0\xc5'\x8bK\xb2\x95\x8cis\xa3\x98\xd0\x164\x15\x8c\x86\xd8?@\xdb\xfb\xde\xc4pxA m\x895\\\x18ut\x8f\x82\x050\x0e\x01\xff\x8d\x06\xf22\xc4\xfaR7\xee\xfd\x8e\xab\xf0\xd2\x06\xbb\x05\xca>\xdbS:\xdeUZuiA\xd5\xa5\xe5a\xd1]\x13\x9e03\xbe(=A\x16\xd8\x96\n\xcd\x8e\x85\xd5\xb6\xc4\x8f!I!\xd3R,\xa4\xe8\xca\x0e\x0e;\xf9|\xab8\xee~\xf0 j9\x0d\xa2|6^\xfe\x87oJ\xc3\xb0\xad\x1a\xa1\xc1[*O\x83\x97$\xb9k\xa3\xbe\xf2\xc1\xcc\x04\x01\xcf\xcfnT\x89g+8\xfb;\xcb\xb4\xfd\xe1Ww\xb9\xee\xec\x87G\xed\x88\x99l\xfbj\xed\x075\x9b\x04JL\xa3\xf7\xabiY\xea\xfd\x98:}\xf6\xc6%;\xc1\xf3\xb3+:\x9by\x14\x11\xf3n\xb1oXF\x90[\x07E\x19}\xef\xc4o\xf4\xb5\xee~x\xb1\xf9\xac--\x83/\x1f\x91\xeb\xf4\xbf\xc3\xb5\x89fv\xf2\x97\xde\x94\xb0\xaf9]\x1dS\x98\x90\xcb\xf1\xb7\xe9\xc9M\x845\x06qw\xaa\xd8=C\xf4_\xa4\xf0w\xdb\xb3\xe1\xe3\xc2`/\xb90\xff\xfa/\x03\xc0\x1d[\xe8KD\xfb\x81\xd6\x03\xfb\xed\xcbYb/\xde^^\xbd=\x7f}\xf3\xf6\"\x86K\x9a\xd1u\xd4^\x1a\xeb\xab\x0d\x03\x10\xa1\x0e*\x1a\x9f\xfa\x89\xc9\xfd\xdb\xdek\xffY\x1fJd\x1a\x0b\xdas0\xba\xc7?\x08q\x8b\xe1\xc4\x91\xee[i)\x05\xfd\x9fR%\xabf\x0cr\xa4\xfa'\xd79\xf9\x9e\x9c\x15\xb5r\x8d^\xbaw\x96K\xbb\xe2nA\xdf\x11\x0d\x05\x12\xef~Oti\x82kZ\xc0V*i\xf3|\x03\x14\x14\xa5*\xa4\x1ei\xc4\xa1\x81T\x93=\x99\x03\x81\xc4L\xa3\xbdrP\xe3\x14G\xf7\xb4@\xa1_e\xc9\x97tB!\x9a\xbe\xa6C\x0d)\xbeWV8	\"\x19u\xde\x8a64\x8c\x1d\xa6\x83%\x84?\xc1\xb1\xc5v\xae\xabO\xbclO}9\x1e\"j.\x92	U\xd7!\xd1\x9ce\xca\\J6k\xd3\xa7r\x9c\xd9\xc9\xfe\xbb\xb7\x0f\x9d\xaep\xbf\xb4\x93\xf0\xca\x0e\xadS+\x12x\xff\xb7*\xa6\x1c\xbfm?\xb7\x05s\x8cX\xd8O\xbfh\xb7\xe6=\xd0\xe4\xb7S&\xd2\xa2\xf0\x84\xcbbc\xd4L\xe4\x00=\xbe\x9f_]\x84:\xbc\xd3#\xd2\x04\xbf@\xb8\xc0\x97\x84\x10p\x8c\xfdK\xa2\xf9%[\xd0\xc1\xed\xac\xee\xd8\xe2: \xb5r\xa6\xc7}\xebt\x03\xc7W\xab\xea+\x9c\xc3\x96\xaa~\x8e\xaf\xb6h\xd8\xab\xb3h\xf8\xf5B\xa1\xf6a_\xef\xc3\xbc3\xc4\\\xb7\xbf\xe1\xd7`s\xbc\x00\x9f	i\xf8\xa6\xdf\\\xf0}p\xf7\xa1\xcb\xf7\n\xfaT\x02uk\xb7\xea\xc2\xe6$D \xdf\xe1\xdby\n\x12>m\x1dUb?\xd8S}f\x8f\xdc\\\xd5\xa9Gg\x80\x13\xbd8\xbe\x8d\xaa\xd4\xf6\xa3x\xd8\xa8\x12z9r\x1f\xee\xf2\xd0\xfe\x83>E\xb1d\x1f\xb6Rf\xc8\xc6\x02\xbe%\xaa?\xa3\xfc\x0b\xd4\x7f\xfa\x03Js8\xac+\x7f\x14\xdd\xb3h4\x81\xf7\xda{\x8a\x91y\xce\x0f\x8c\xbc\xf4\xfb\xa1\xa3{\xb2\xa2\xfa\x86\xf8&\x9a\xb1#7a\xe4l\xa8\xe0?<\xee\x82\x82\x01\xb0\xf0\x14(<\x05\nO\x81\xc2S\xa0\xf0\x14(<\x05\nO\x81\xc2\xff\x87@a\xe4\xd5\xc0\x9f\xad\x1e\xa4-\xc9\xf7\xbb\xde\xfe[\xb9\xad\x02\xd2M\xd4)\x03\xc3\x7f\xffOTW\x84\xe9\xdf\xba(\x0c\xa6\x8d\x7f\x1e\x86\x8e\xe46\xf0\xecY\xeb\x9f\x95\xb1\xbf\xd6\x95\x93\x0d|\xfcL\xffJ\x0c5\x9b\xa7>;\xd3\x1b\xf8\xf89\xfa\xdf\x01\x00PK\x07\x08d\xac\x1d0k\x13\x00\x00\x00h\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x006\x00	\x00crd/bases/catalog.kubecarrier.io_catalogentrysets.yamlUT\x05\x00\x01\x80Cm8\xec<K\x93\xdb6\x93w\xfe\x8a\xae\xd9\xc38)\x89\x8e\xf7\xdb\xda\xdaU\xd5\x1e\x9c\x99\xf9\xf2y?\xdbq\x8d&\xd9C\x92\xaa@dKB\x86\x04h\x00\xd4XI\xe5\xbfo5\x1e|\x89/\xc9\xe3\xad=\x84\x07\x97G\x04\x1a\xfd\xeeF\xa3	V\xf0\x1fQi.\xc5\nX\xc1\xf1\x93AA\x7f\xe9\xf8\xf1?t\xcc\xe5\xcb\xc3\xab\xe8\x91\x8bt\x057\xa562\xbfG-K\x95\xe0-n\xb9\xe0\x86K\x11\xe5hX\xca\x0c[E\x00L\x08i\x18\xfd\xac\xe9O\x80D\n\xa3d\x96\xa1Z\xeeP\xc4\x8f\xe5\x067%\xcfRT\x16xX\xfa\xf0M\xfc\xaf\xf1\x7fF\x00\x89B;\xfd\x81\xe7\xa8\x0d\xcb\x8b\x15\x882\xcb\"\x00\xc1r\\A\xc2\x0c\xcb\xe4\x0e\x85QG\x8dF\xc7\xfe\x07\x0b9aJq\x0b9\xd2\x05&\x84\xc1N\xc9\xb2X\xc1\xc0(\x075\xa0\xca\x0c\xee\xa4\xe2\xe1o\x80%0\xbb4=Kh\xcc]\x16J\x1ex\x8a\xca\x0e\xf4\xfcqK\xdc\x11fk4\xf6M\xc6\xb5\xf9g\xdf\xdb\xb7\\\xbb\x11EV*\x96\x9d\xd2e_\xea\xbdT\xe6}\x8d!a\x94\xa0\x7f\xc7\xc5\xae\xcc\x98:\x99\x1a\x01\xe8D\x16\xb8\x02;\xb3`	\xa6\x11\x80g\xb5\xa7m	,M\xad\xf8X\xf6AqaP\xdd\xc8\xac\xcc\xc3{z\x96\xf0\x9b\x96\xe2\x033\xfb\x15\xc4\xda0S\xea\xb8\xd83\x8d\x1e\x17z\x9cP\xd6\xf6e\xe3gs\xa4\xf5\xb5Q\\\xec\x06\xe0\x15\x98\xc4)\xd7\x89<\xa0\x8a\x13\x95\xc6\x04\xaa\x01\x82\xfe\\\xc1\xcd\xfd\xedY`\x83.\xc6'z\xd4\x00\xe3@\xbf\xde5\x97s\x18\xa7\xcc\x84\x1f\xdd\xa0\xc3+\x96\x15{\xf6\xca\xff\xa8\x93=\xe6\xacf\x91,P\xbc\xfe\xf0\xe6\xc7\xbf\xad;/\x00R\xd4\x89\xe2\x05\xb1x\x05W\x1d\xf1C\xce\x04\xdb\xa1\x06\xd65+\xcf\x11\xd2 `\"u\xf6\x80\xfa\xe7jIz~n*\x1bG\x0d[\xa9\x00Y\xb2'~A\xe0*\xa6\xb0U2\x07\xb3G\xd0\x98ab0\x855\xaa\x03O\xf0&+\xb5A\xa5\xe3.\xe0\x9f\x05|\xfd\xf5\xdd'\x96\x17\x19~\xfd5\xac\x11[\x8a\x0d\xa9L\xca\x1c\x85\xb3q\xbbn.\x15\x92\x99o\xf9\xaeT\xf6\xe7.\xcc\x14\x0d\xe3\x99\x8e\xe1\xd7_\x7f=\xb2<\x83\xa6\xd3\xe9\xb7\xdf\x97\x81\xf1\xfd\xc6\xd5]\xa1rA\x95\xe0\x12Y&\xfbt\xa3\x81\x14\x8d~n\x0e\x01\xe2Q\x91\xb1#Y\xc8\nnh\xec\xed\xb7]\xa0'R|\xd8\x13\xa1y\xb1=\x92\xa2\xb0\x0d\xd3\x08P\xb1\x9b\xf0\xa2\x85\xc8\x87\xa5+\x0f\xa4\x03\xb2\x8d[\xec\x91\x8c\xb9\xa4\xd7\xa0[\xb2Y[\x91I\xb5\x82?\xfe$\xce]5`\x15J\x16\xa8L\xc3U\xb9\xa7\xc1\xd8\xd6\xca\x1dB\xaeIg\x9d?\x80\x94\x1c9j\xab%\xdeG`\xea\x15\x1d\xe4\x16\xcc\x9ekPX(\xd4A\xec\x1d\xd0@\xc3\x98\x00\xb9\xf9\x0d\x13\x13[\x15C\xa5\xc9{\x95YJ\xaaq@e@a\"w\x82\xff^A\xd7`\xa4]6c\x06\xbd;l>\xd6+	\x96\xc1\x81e%.\xac9\xe4\xec\x08\ni\x1d(E\x03\xa2\x1d\xa2cxG\xca\xc8\xc5V\xae`oL\xa1W/_\xee\xb8	\xb1,\x91y^\nn\x8e/mX\xe2\x9b\xd2H\xa5_\xa6x\xc0\xec\xa5\xe6\xbb%S\xc9\x9e\x1bLL\xa9\xf0%+\xf8\xd2\"/\x88k:\xce\xd3\x7fQ\xdeL\xf5u\x07\xdb^\xcfT\xc5\x86QYP|\x00N\xae\xc0Mw\xb4\xd4,\xa7\x9f\x88K\xf7w\xeb\x07\x08\x08t \x82\x13\x93\x93@=U\xd7\xc2 \xc6q\xb1E\xe5FV\x8e\x01EZH.\x0c-q\x024\xc98\n\x03\xba\xdc\xe4\xdc\x90\x16|,Q\x1b\x92[\x0c76\xd4\xc3\x06\xa1,\xc8m\xa61\xbc\x11p\xc3r\xccn\x98F'\x8a\x13\x88_N4$\x01\xbd$o1_8\x95O\xe8\x9d\xe0\xb8\xd9z\x15\xb2\x8aA\xcbj\xfa\xca5\x9au\x81I\xcb\xc2R\xd4\x9c\x1c3\x85S$\xbb\xe9L\x88;\xc0\x87\x0c\x9d\x9e\x14\x15?`\x17\xf7\x13\x94n\xed02C\xc3\xb8p\x86\xder\xd7d\x86;\x14\xa8\x98A?:mG\xa5fh\xaf\x9fJ\x87\xbee\x1ao\xeeo	43\xc0T#\xdcl\x8eN\xdf&\xc8\x9c\"\x95\x1e\xfcTH\xddK\xee	\xc9>\xe9\xd4\xf0\xb4\xe7\xc9\x1e\xb6\x1c\xb3T\xc3\x13\xcf2\xd2W\xef\xca\x80\x0b/\x12K\xf1\x00\\\xa0\x90\x1aG\xfd\xaf\xb8\xc1\xbc\xe3\x81\x07}\xae\xf7\xb7w\x96\x8a\x1b\xcb\x7f\xabN|\xcb\xb1\x83\xa8\x91\x83 \x03\x1b:\xc8\x8f!9\xcd\xd9\xa04[VfftL\x87\xcf5\xfe~\xb2\xf7\xc36/\xf0\xc4\xd8\x10\x82\xd1\x10\xbcJ\xf66\x9d\x0e\x1e\xacR$!\x8d\xa78\x9d+\xafZjp\xebI\xb2:\xc9\x8a\"\xe3\x98\xc2\xd3\x1e\x9d\xe0\x0d\n&LX\x92\xebh\x04\x1c\xf8\x9d	\xa6C\xba0K#z\xcc\xf3\xef\xc4&\x8f(\x85\x01\xc7\xd3c\xf0\xfa\x9e\xb1\xa3\x8b\xd2c\x19O\xccf\x8e\xf1\xe3h\xce\xd5	\xf7Ti\xfb\x04\xcc\x13\xda\xfe{\xfd\xfd{\xca\xf7\xbd\x128\xcc\x82\x1cm\xfa4\xaa\xb73<x\xdfcYq6\xae?\xd2,\x12@\x83\xeb5W+\xec\x173\xe0\x02p\x03	\x13\xe4l\x988\x12\x14\x9eZf\xd0\x7fK\x9c\x92\x0d=\x9f\x96\xb4\xddT\x02\x0d\xea\xa5\xf5X\xea\x80\xcbR<\n\xf9$\x96\x96\x91z\x05F\x95S\xa6\xa5\xf0cI!g\x9a!\xf5Fj\xc6PK\xc8\xc4\xb8\xc10\xda}\xdc@\xa6\x14;\x8e\x80\xf4D_\xe2\x9e*\xf1\xf9\x18\xe5S\xd3:\x18\x8c\x02\x85\x8e\xeb\x99\xd6\xda\x89\xc0\xd0\x83\xabu\x03\xc4\xfb\x8e\x0f\xd8\xcb'\x8a\xcf,M\x15\xea)\x17\x05\xc0 AEq\xfe\xff\xa3\x17\xc0x\x17\xfb\x9d\xbf\x969:\x0c\xe9\x7f\xba\xdc\xd8?&\xc9\xa3\xe75\xa9	`\x86\xb4\x0d\xd5\xc1\xce\x9a\x19\x07\x17)~\x02\xa9\xe0\x89gi\xc2\xd4L\xa3\xf5\xe8\xd9ZF\x1c\xf2b\xfd\xd37\xbf\xc4{\xa9\x0d\xc1s\xb8\xfbL\n\x95\xfe\xe9\xeb_b\x9e\xb3\x1d\xce[\xe0\x11\x8f:\xe4a\xe4\xdfSY\xe3\xff\xb1\x94\x06\xd3\x85G\"$\xa6q\xa3\x9e\xf6\xd3ug\x8bL\x9c\x8b\x1f\xf1x\xfd\xcb\x1c\x872\x98\x04\xf7=.4^$\xed\x87jjp\xa6E7\x02\xcc\x00\xd9gr\x0b\xe0[\xe0\x95\xf1\xa6|K\xdb\x99\x90\x85\xce\x02Z\x05\xa4n\x0c\n\xc2\xb7\xbai\xa4b;\xfc\xee\xdbY \x8d\xac\x12J\xaf\xda\xfc\xf7*d\x84\x18\x07L_\n\xdc\x89B?\xb3\x88\xbf@T\x98\xed\xeds.\xdeX\xff\x08\xaf\xa2\xce\xab\x0b\xa2B\xbb\xaeyA\\\x08\x00\x82\xcc\x14nQ\xa1pU\xd3\xb1\xc7\xaa\xa4\xcdT\xed\x16\xc7'\xe6nk\xe5\xb3M\xb7U\xa6\xba\x91fyU_\x99\x10%m\xd5\xfd\xd6:$\xd86i\xca\xcb\xcc\xf0\"\xc3\xbe\xad\xc4\x84~\xcc\x8aG\xb3=\xc4s\np\x8e\".}\xe8\x1fYl\x19x\xab\xa3\xcf\xd0\xcf\xf1<d\x1c\xd5\xa5W\x80\xe8L\xcb\x08\xe5\xc3U4\x91\xcd\x84\xd2\xf0\xc4N\xde\x15\x8cG\x0b\xcbqt~&\x90\xa8\xc1\x0c\xb2\x95sQ\x01\xba\xb2\xa0\x9e\xfavul\x04O\xdc\xec\xf9\xb0-\x90{n\x17\xab\xe3\xe8\xf2$\xc6\x16^\x07\xd7\xb2*\xfd\x16\xc5\xce\xec\xc7\x9d\xd2\x0c\x13\x99V\xe8%t\x8e:\xce\xf2\xa2\x03E\xe29\xb2Y\xf7Nm\x8bK\xa3!G\xd8\x1e:bT\x124R\xd5\xd4\xcakP\xd6R|\x8e\xf0rf\x92\xfd\xdd'\n\xb3\xba>Y\x9cApw\"e$\xcc\x1e\xca\x11\x8d\x19\xdb`\xe6\x13G\xa9F`V^\xcaf\x9c\xce\x9f7\x7f\xb1\xe5\x85\xd7\xefo\xc7\x8b\x033|pKZ\xaf;\x086\x97\xf4\xf5\xe29\xa8\x93%1S;\x0d\xbbq\xd3\x0b`\x94\x8e\xba\xa2:\xd5\xee\x0b*\xffI\xaa\x0e3* \xdb\x92\xfc(K\x08.\x12\x0c\x0b\xc2\xd7\xdf\xa3\xe7\xd9l<\xe2qjHG\xd2\x84\x87O7\x1d\xd7\xe8\x07\x1f\x9bq.\xa3\xe8i\xc4\xedI\x0cf\x07L\xa8\xf8{&YaZ\xa3\xa4\xef\x04wM\xd5\xf8\xcc\x9e\xc6\xe8=/\xa2S8\xdd\xc7\xc8\xda\xba\xc3i\xc9\x8f\xb60\x11\x16qz\xfcF,\xe0\xbd4o\xc4\x9c=\xcd\xdd'\xae	%\x91\xc2\xadD\xfd^\x1a\xfb\xcb\xb3\xb2\xce!{&\xe3|\x1d\x92\xccD\xb8\x0c\x84\xe8n\x1e\xb0L(\xab{\xde\xb84\xbe\x12\x03\xd7t\xc8!\x95\xe7\x90}\xe9\x97\x9a\xaa^\x84'/\xb5=5\x11R,1/\xcc1\xee[\xc53v\xd2\xb6\xe9ir\xfe\x14\xa5jA\xbf\xd8\xc3~\xb2\xd2I\x8f%\x87\xe8UXd\xd4B\x00iI\xc2\"\xb7c\xe8\xa4`\xc7\x13\xc8Q\xed\xec\xfe.\xd9OssV\x0ez\xa6Y\x85\xc1\xd3\xcc\xf7\xces\xb2\x0c\xb6$\xcf19&\x88*z\x86\x0d\xd1\x1c\nl {K\x8em\xd4\x10\x9a=\x1d\xd3a\xf5\x0c^\xb7|m\x03\x19R\x10\x069+\xc8\xba\xfe\xa0\x80bU\xefO(\x18W\x13\x16\xf6\xda\xb6\xb0d\xd8\x9a\xe7\xf7\xe5\xcd%\x08:\xd7@\xf2;\xb0l\xaaVGnN\x84\xea\x10a\xd5\xcd\x01\x16\xf0\xb4\xa7}\x1a\x05\x08_\x8f\x1e\xb7\x87\xabG<^-N\xfc\xc0\xd5\x1bq\xe5\xc2\xe7\x89\xc5\x85X;\nV\x8a\xec\x08W\x96\xec\xabKS\x8a\x19[\xee\xc9!O\xb8\xd9K\xf9\xb8vF=\x18w\xfd9\xc4\n\xdeK\x81\xd1\x0c-\xb9\xfe\x9f6`\xbf1v1\xda/\x1a\xb6\xdc\xed.\x9f\xf6\xe3\x8e\xf0\xac#\xdaq\xca[\xeb\x93 \xd7DCI\x19$\xae\x9f\x85\xce\x1b;I\xa8\xdf5\x0d\xdbth[x\xd8\xa3B\xcbw\xf3$\xa1\x90Z\xf3MVI\x96N\xb4\xec6\xbf\xb5\xe1\x1a\x04\xfa\x07\xb1	^l\x8e\x81q_-:9\xf5\x9fp2d\x05\x9eg\x83`\xed\x11\xa6\xd5\x9cd\x8f\xc9#\x15\xc4\x8cE\xdb\x07\xba\x03\xe3\x19#\xac\xdbk\xbd\xd6\x9a\xefD>f;\xde\xf0\x92R)\xe2g\xd5:\x16w@U8:\\\x92\xbaA\xee\xf4\xf1R\xae*+\xb47\xf4\xeb\xb4\x81\xda\xbd \xa4\xea\xb8T\xa5\x80m\xc6v\xf1u\xd4\x0b\x12P\x94\xf9\x90\x8eR/\xd8\x88z\xd2\xeb\xf6\xba\xd1Ey\xddx(YB\xa2\xfa+G\xcb\x81\xbd[t\xa6\xd5\x0eu-\x9cT\x0c\xde\xf9\x81u\xf2O\xac\x0f\xd3I*\xaeg\xacq6\xdf\x8b\xb8\xd3\xfdJh\xe10?\x8e\xce?Ph\x06\x92\xde\x01\x1d\x12n\xeb\xf1U~/\xc5\xce\xfa]\xd7V\x86is\xc6\x00L\x08*\xe8I\x88\xa3\x8b\xcb\x00\x93I\x7f\xb3\xb5l`\x996\x89\xf5\xf8@\xe2\xbe\xcc\x99X*d\xa95f*\x17\x84V\xac\x01\x88\xf0\x7fA\x19ON\xfb\xcazIz\x93H\xe1\xb7\xa89\xcb2\xd0\x1fKF~;\x93;\x19$\xa1\xc7\xf1\x9ds2\x15\x9a\x8e\x01f\xe0t\xcb\x0c\x0b\xfc\xb5g6v\xfa\x10\xbb\xbc\xda\xe7\xcc\xac`s\xac\x9aB/R\x08zrL9{\xa0\x91#\x90Z<|\x17f\x04\x86q\x91deJ\xd1\xcfb\xcf\x85#\xc0\x9e\\\x8c\x00\x05G\xed\xcbB\xec\x16\xfe\xbf\xbf\x15X\xfd_\x1fv\x9fG\xdbtb\xbd\xb4\x88\x0e\xae\xb2\xac\x99si\x0eCz\xb5\x8af0\xf5-)\xa0W\x82mI\x9a\xc9\x7f\xffK/\xff\xd2\xcb/\xa4\x97\xb6\x7f\xbf\x11\xc2V\xd1\x0c\x06\xaf;\x93\xbc#u{\xa5\x8c\x0bt`\x9bZ4(;\xaf\xd3_<6L\xa5D\x8d\x98\xd8\x8b\xc3\xf2\x84U\xd1\x99n G\x83\xa4\x0d}\x1848e\x93\";\xb0J\xe4\xfd1\xe0\x0f\x9a\xed\xf0\x1e\x13\xa9B\x93\x08m\x05|\xd3W\x0fP\x1b\x1f\x80\x0bm\x18\x9dt\xc4\xd1\xf91,\xe5\xb9\xff\xd8f\x96b\xdcV\xc3-j\xa2\xccQ\xf1\xc4\x9f\x8d\xd5Q\xc2!4\\\xbd\xabz\xeb\xa8}Z\xd9\x80\"Z\xd4\xc7\xd1E\x05\x9c^.W8;-n\xe1\xec\xdb\xc6\x03\xc6C\xcb\xce-\x1c\x87c\xea!^\xf6`9\xda\x9f\x16>\xf1\xf1\x1a7U\x0e=m \xa0\x1d\xa5/1P\x93\x07r\xda\xaa\xc1^f\xa9\xe3\xc4\xc6\x7f>4\xfcH\x05\x0c>\x96L\x18n\x8e\x90\xf1G\x84\xabW\xdf|\xc7\xaf\x86y5\xc3X\xe7\x9e\x89u\xfc\xd2\xfb*\x0b\xc5Zs}\xc3\x8a\xa7\xf8\x19\xd0\x9aN'f6\xaa\x8d\x9e\xb2\xcd\xc8)\xa6K\x8b\x9a\x8b\xc7U4\x83sk.\x1eC\x88\xff\xe1\xfe-\x94\xe4j\x00\x0fU\xa1\x07?\x15R\xd1\xd79F.\x80\x0d\xe7\xf87\x99,\xd3;;/\x86uY\xf8Y\xf6s\n:\xbdSh\xbfzX\xd8\x7f5\xbc\xd0F\x95\xf6[\x86~\x07\xd6\x01	T'\x81\x7f<<|\xf8\xca\xee\xaf\xb6<CxAE\n\xb2\x13@\x91H\xf2\x165\x12\x83@\x0bT6R-\xe88\x05\x05\xcd\xa2\xba\x9c\x83\xe8\x1d\xd5]\x86\x07\xaa\xa5}\x15G\x17\xe5\xd5\xa3\x02\xcc\xf9N\xd9\xc3\x91U4\xa1\xd5\xef\xc2\xc8f<pU\x8b\xcaQR\x91#4\x0e\x07o\xd5/$\x92\x80[\xdb\x93,\xa45\xfauk\xe3\x1fG\xe7\xfb\xb8Bj\xf3\x0f)\xe7)\xdc\x07?\x98\x94\xae\x89\xbc\xd97\xd0\x0b\x94\x8c\xd5?i\x0bz\\\xc0\x06\xb7\xf4]\x0dM\x97\x8a\xef\xb8`Ys:(\xcc\xe5\xa1\xea^3\x92>S1}\x9f\x7f\x84\x87\xc1\x86%\x8fe\x11G\x97{|\xc3s\x94\xa5\x19\x1e\xd0a\n}Q*K\x13\xf4\x8fdl\x99\xb3\x80\xbf}\xa3\xa1\xae\xd4\xc5\xd1g\xba\xb0Re\xb3\x91\"w\xd0@hA\xfdm{\xf7e\x145\x0f{C\xa6Q\xa4\x8d#P\x01R\x89Z\\\x1b\xf0\x9f\xf3\xd8:x\xf5	\x95\xef\x1f\xfdL\xda\xa6\x9d\xf3\x92\xa8\x1fXd\xd2\xe5\x16\n\xe7\xab\xb8\xc2\x8e\x867\x94\xb4\xa9\x9bc\x19\\\xa3\xcf\xd00\xb5C\xd31\xd4Z\xa1\xab\xae\x9a\xbf\xf4\xf6/\xbdm\xeb\xed\xa8ZW\xbd5\xe9\xf76\xd5\xe8\xcd`[\xe2\xbe\xef\xce\xb0\xfd*\x1a\xd6\x98(\xf4g\xed\xee\xf3\xa1w\xac\xd0\x8d\x05z\xb1\xdf\x1c\xeb\x88\xb58\xed}\x97\x05\xdb\x85XE\xb2t\xcd\xbbqt\xd6\xe9\xed(\xfa\xfe\xed\x86\xd2\x13O\x03\xa5\xb5\x15\x05\xbdX7\xf9F$4v	\x8b\x90G\xd3\xcf@\xcd\xda<\xf1\x05R\xff\xdbX\x8b\xb1\xb7\xa1\xc0\x92\x18\xee>\xb1\xc4dG\xa0,Gn}\x8e\xab\xea\x8dA\xed\x8b5\x0eD\x86\xe9\xdd\xc9\xf4\xce\xa4\xc5\xc2jq\x8f\xad\xdfB\xd4}\xe3f_\xd5\x84\x07V\x0c\xed9\x0d6\xfa]L\xd8\xa7\xb8.\xf7D\n\x81	\xf9\x99X[\xfd\"\x06\xc4\xd1g\x98Z\xdf\xf7\xae\x03t\xda\x0f_\xe5\xb6\x1f\xd1a$\xc6O\x80hw\xe0\xd4lt\xc8\x94\xfe\xcd\n\x87\xe3\x1b\xa8\xc1\xcd\xd3	\xad\xe1PL\x84C\xb7A\x98\xa7\x1a|9\xfeSNqie\xd9\x0b\x7f\"\x92\x8fm\x9ch\xaf\x92\x96\xd9\x9cJ\xcd\xba\x1a\n(\xe8 \x84\x9c\x1f\xb0\xddN\xa1sZu\x03vF\x9e\xf1\x9f\xe5\x06o\xdc\x0d \xbdX\xbb.\xb0\xbe\xe3G\xaa\xe4\x08|\xaa\xb8\xda\x9b'M\xd9y!3\x9eL\x9f\x9f\xbfE\xa6\xcd[\xc9\xd2\xc1\x9c\xa4\xc5\x84\xeb\x0f\x16,9!\x8f?\xbb\x08{\xf74\x16o\x1dN\xaf<p\xdd\xc7\x1d:\x9c\x1d@\x95\xaaI\x08\x19A\xad\x97\x0f:\xda\xb9\xb3!\x86u\xa1\x90\xa5\xd5Z\xa30{p\x18[\xcb\x05\xae\x91\xa2\x97\x04mWo\xa3\xc9B\xc4\x83\x0f\xd6*\x15\xd6\xe8y\xdf\xabzn&\x08\xcf\xd0y;q\xa0\x02\xd8\xa6D_|\xb2=\xad8d\xb3\x8e\xc7#\x03*\xbc\xa2\x0b\xfd^1@\xd8j\x8e:\x0fq\xc570P=\xd2\x1e\x14\xba\x16\xdd\xa9\xc3N\xcfn\x0d\xa5\x0e\xdf\x9ac\xbd\x84\xb7\xc8>[\x9e\xd1\x8f\xdbB\xdb\xa5bUj\xe6_n|\xe98\xa3\xfa\x8e\xddp\xd9\xdd\xfe\xc0z\x00\xd0\xf1\xf7\xf6\x9b\x90\xca\xe9\x0f\xe19\xb7\xfe9]\xce\x9b\xd9\xe4>C\x07\xe6m\x06'Z\xdd\x9f\xa1\x087\x02`8\xbc-\xabo.N\xd6\\V\xdd\x11\xd1\xcc\x85\\\"\xb5\x8aF\x94\xa7\xe3\x07\xddeI\xad[!\xe4\x86N\x1d\x9f\xe9Z\x88:@\xde\xdc\xdf\xae\xa2\x89\xf8\xf2\xba9\xda\x16\xa7\xfd\x11\xb9p\xc7\x8cT\xbag\x1b\xaa\x9b\x10\xa25\xec\x1e\xb8\xf6\xaa\x04\xfb\x91^\x1d\xe4\xc9\xae]\xf0\xee\xed\x8d\x9f\xd2lV\xf0\xef\xec\x05^\xbdog(\xeaX::9y\xcc\xa2&'\xfb\x8b\xbd.\x9dN\xddn3\xbb-\xee\xed\xd0:\xad\xa4\x9d\x96\xff\xcd7\x8d\x8c}\xb0<\xc7\xb9L\xb9\x96\x99\x8ee\x92\xe89Ne\xd4\xa5\x8c\xf8\x03\xdfL\xee\xbf\x12[E\x175)\xb7\xed\xfa\xfe\xd6\x7f\x89\xe7\xed\x86,\xc8/\x10\xbe\xdcK|\x97\xd2HN6G\x00s\xbc{\x0b\xb7\xeb\xfa\xb4\x86\xeb\x80\xd4\xc2\xa6\x8a\xe8.\xf7Z\xc1\xe1\xd5\xa2\xba\xdfl\xeat\xeb\xf0j\x83\x86\xbd\x1a\xca]f*u\xff%j\x93\xc4\xac\xdb\x97Q5\xd8\x1c\xcf\xc0g4\xbc<\xe3e\x0b\xfe\x0c\xec\x1c\xba\xdc\x0c\xfa^\x9d\xd3\xe5\x83\xba%\xad\xbaP3\n\x11\\\xf2c\x0f'\nR>mo\x8cJl\x17lu_\x94\xad\xcc\x85S::\x98\x1c)\xce{\x9b\x0e\x9f\x0d4*$^\x8f\xec\x8e @\xfb/\xba\x83b\x8e\x1c6Rf\xc8\x86{\x15\xa6M\xff\xcb\xe7\x13\xe38,\xabx\x14\x9d\xb9a\x1e\xc1{\xe9#\xc5\xc0<\x17\x07\x06^zy\xe8\xe8L\xddO\xa4p\x97?\xf6\xba\xba\x96\xed\xddTC\x9b\xdf \xd5\xb7\xb65\xf6@.\x8baf\x00#[\xbb`\xdd\xcc\xe6ZW=\xc76\xf9\x89\xa3\xb3\xbe\x1ci\xe3\xda\x06]\xa1^\xf7\xbfzg\x1c:Z{\xd6\xa2' T\xb1\xa9v=\xed\x15\xe2\xe82\x87\x9e1m\x1e\x14\x13\xdar\x96\n\xfc\xfd\xd4\x9dP\xf8\xf6db8W&\x90\xf6H,\xdc\xef\xe50\x1f\x04\x0b`\x1c\x02\xda\xdd\xa0@\x05P\x12@\xa9\x1b;\x99~\xfa\x9a=h)3\xb8\xa4e\xa3\xcf\x08\xfa9j:\x11\x9f\xc9\x83wnt \xdc\xf6\xcaB\xd5+\xeba\x05\x9f:\x94^\x05\xb866\xfb\x04\xd7\xb1\xb0\x12K\x1c}V\x1e\xc3\xb4\x143\xa5zo\x07\x07\x82^l\x14\xc7\xedWD\x92\x96\xa2j\xbe\xaeDz=\\8\x81g\xa5\xa1\x7fk3@\x83\xdf\xd5x\x1a\xbc&\xc9m\x1b\xf5\x85\xaf\xb3\x8f\x10\xf0\xe2\xfaA\x95x\xbd\x80\xeb\xbf\xb3L\xdb\xff\xfc\xe0.=\xba\x1e\xec\x14\x98\x91\x8d\xfb!\xf3\xe4A-\x7f\x81\x12\xd3\xe8\xc0\xedX\x7f-\x92\xb1\xec\xc9;\x93\xec\x08/\xae\xef\xe98\xfd\xb3\xe8\x98\n\x92\xcb\x1e\xdf2\x80\xdc2\xd8\xca\xe0{\xa7\x81\x83\xafu\xf7\xd6\xdf\xe6\xb3\xb4\xb4D\x17\xa4ec\x11:l\x94\xbfs\xd7\x15\xcei\xf0\xf8\xfedJ\x10mN\xb7\xfa(L\xe8{\x17\x7f\xff\xa1\xf5\xf5~B\x0fj\xe0\xad\xf14\x12\x84\xe2S}\xddu\x9f\x90\x83\xd7\xe4\xc2\xfc\xfb\xbf\xf5\xbcw\xa4\xd3\xa1\xfd\xae\xa77\xcc\xde\xbd<I\xef\xed\xdd\x87\xfb\xbb\x9b\xd7\x0fw\xb71|\xa0\x19\xdd\xb8\x1d\xa2[\xc6\xb7\x98\x1c\x93\xac_H\xd5\x05\x95\x8d\x8bEc\xca\x06\xb4\xbd\xa7\xcf\xfej\x13\xe3\xc6\x82\xb6{\x81>\x0b\xea\x85\xb8\xc1\xd0'B\xb7\xe1hI\x85\x05\xdd\xf8|	rL\xf6Lp\x9dS\x04\xa2\xaf\xfe*\xfb\x1a\xbc\x12\xd1\xf9/\xed\xb3_\xba\xc7:\xdc\x9b\xe7\x83\xf0\x91\x92]n\xcb\x8b\xf6\xb3)\x92\x9foF\x85\xa2TtaG\xbf\xf1\xd2@\xa4[}\xe9\x92\x8a\x1d`\xa6\x91\x12\xed\x06Nqt\x96\x1f\x1a\xd0\xfa\x9e\x9f\x9d\xfa\xb5.w\xf3\xb9v\xfb\xb7rSe\xf7\xab\xa8\xe3\xb4\xe1\x8f?\xa3\xda\x7f\xb3$\xc1\xc2`\xda\xb8J\xdc\x16F\xe0\xea\xaau	\xb9\xfd\xb3\xa6p\x05?\xfdB\xb7\x89S+O\xea\xb7\xbaz\x05?\xfd\x12\xfd\xef\x00PK\x07\x08\x12K\x1b\xa9'\x13\x00\x006^\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/bases/catalog.kubecarrier.io_catalogs.yamlUT\x05\x00\x01\x80Cm8\xecZ[o\x1b\xbb\xf1\x7f\xd7\xa7\x18\xf8\xff\xa0$\xb0\xd6\xff\xb4E\xd1\xea\xcdp\xdc\xc289i`\xbby9=\x80\xa9\xdd\x91\x96\xc7\\r\xcb\xe1*Q\x83|\xf7bx\xd9\x8b\xb4\xbb6\xd2s\n\x140_l-\xc9\x99\xe1\\~3\xbc,V\xab\xd5B\xd4\xf2\x13Z\x92F\xafA\xd4\x12\xbf8\xd4\xfc\x8b\xb2\xc7?Q&\xcd\xc5\xfe\xed\xe2Q\xeab\x0dW\x0d9S\xdd\"\x99\xc6\xe6\xf8\x0e\xb7RK'\x8d^T\xe8D!\x9cX/\x00\x84\xd6\xc6	\xfeL\xfc\x13 7\xdaY\xa3\x14\xda\xd5\x0eu\xf6\xd8lp\xd3HU\xa0\xf5\xc4\x13\xeb\xfd\xffg\xbf\xcb\xfe\xbc\x00\xc8-\xfa\xe9\xf7\xb2Br\xa2\xaa\xd7\xa0\x1b\xa5\x16\x00ZT\xb8\x86\\8\xa1\xcc\x8e\xb2\xf8\x8f\xa7\x98\x0bk\xa5\xa7\xb8\xa0\x1as\xe6\xbc\xb3\xa6\xa9\xd701*PK\"\n\x87;ce\xfa\xbd\x02\xe1\x19\xf2\x7f\xbdy\xab\xda\x9a\xbd,\xd0\xfaAQ'\x81\xbc\xff\xa2$\xb9\x1f\xfa_\xdfKr\xbe\xa7V\x8d\x15\xaa\x93\xdd\x7f\xa4\xd2X\xf7\xa1\x93b\x05y`JR\xef\x1a%l;~\x01@\xb9\xa9q\x0d~x-r,\x16\x00Qw~\xfa\nDQxk\x08\xf5\xd1J\xed\xd0^\x19\xd5T\xc9\n+\xf8\x85\x8c\xfe(\\\xb9\x86\x8c\x9cp\x0deu)\x08}o\xd2\xed\x9d\xef\x88\x9f\xdc\x819\x92\xb3R\xefNi$\x9bg'\xf6\x1aP\xbc\xdc%\x0e\x81\\!\\\xf8\x10\x8c\xb9\x7f+T]\x8a\xb7\xfe\x13\xe5%V\xde\x89\xb8\x99\x1a\xf5\xe5\xc7\x9bO\xbf\xbf\x1b|\x06(\x90r+k^\xeb\x1a\xce\xa2\xaa\xa1n6JR\x89\x04\x02\x08\x15\xe6\xdc\x0ff\x9b,t\xad\x1d\x1b\x18\x9c\xe9\x0f\x88\xbc\xb8\x99-\xdc\xa3\x16\xdaQ\x06\xff\xd0\xf0C\xb3\xc1\xab\xe0U\xf0Y*\x05\xa2q\xa6\x12N\xe6B\xa9C\xf0R\x84;\xb4{\x99\xe3\x95j\xc8\xa1\xbd$\x92;]\xa1v}\xba\x9b_0w\x04[c\x01E^F.Q\x06,`s\x00Wb\x92\xd33\x7f\xf3\xe6\xfa\x8b\xa8j\x85o\xde\xc0\xc3\xc3\xc3AT\xaaG\xb1\x1f\xb1\xe3Ap\x91\xf4:\xf4Rh\x035Y\xbcG\xb5\xc0\xadh\x94\x03\x1f?\x00\xe0\xbc2\xee\xbc*\x8d]\xc3\xd7o\x1c\xcc\x9d2\x0f\x83\xae\x87\x87\x87\xb3\x96XmM\x8d\xd6\xb5\xf1t\"v\x8f\xed\x91=\x97l\xf2\xe0\xd7,\x91\xd4H^;\xd1\xd7\xb1\x88^\xc2\xe6r\xa5$\xb0X[$\xd4\x01s\x06\x84\x81\x07	\x1d-\x90yc\xa1%\x0e\xbcF\x15\x0cL{\xb4\x0e,\xe6f\xa7\xe5\xbfZ\xda\xdeI\x98\xa9\x12\x0ec\x04w\xcd\xc7\x96\x16\n\xf6B5x\x0eB\x17P\x89\x03Xd.\xd0\xe8\x1e=?\x842\xf8\xd1X\x04\xa9\xb7f\x0d\xa5s5\xad/.v\xd2%\x80\xcdMU5Z\xba\xc3\x85\xc7J\xb9i\x9c\xb1tQ\xe0\x1e\xd5\x05\xc9\xddJ\xd8\xbc\x94\x0es\xd7X\xbc\x10\xb5\\y\xd15k\x8c\xb2\xaa\xf8?\x1b!\x99\x96\x03YO\x0284\xef\x123\x16`\x08\x03\xe9\xe3\xc8\xc7~XE\xa7h\xfe\xc4\xda\xb9\xbd\xbe\xbb\x87\xc4\xda\x1bc@4y~7\x91:\x13\xb0\xc2\xa4\xde\xa2\xf5\xf3`kM\xe5i\xa2.j#\xb5\xf3?r%\x87\xa1\xc4\x8d\x9aM%\x1d\xdb\xfd\x9f\x0d\x92c[ep\xe5\xb3\x0el\x10\x9a\x9a\x11\xa6\xc8\xe0F\xc3\x95\xa8P]	\xc2\xdf\xdc\x00\xaciZ\xb1b\x9fg\x826\x0eG\x06\x07o\xedu\xa4\x846\x111\x117\xeej\xccc(mb\xcc\x14H\xd2\xa2wt\x87\xda\x91\x0f\x87\x16g\x06\x14\xc7#\x16\xa6\"\xfeh\xcc\xb8D\x03\x90\x88pG\x83\xce\xb8V\x96V\x1c\xdb9fH\x0e\xd4\x0dB-\xackC>a\xde\xc9\x8c\xe9Up\xab\x84\xcb\xcb\xeb/\xec\x8c\xd4\x95'\xb3+9\x9e\x12\xc2\x82\xf3<\x0b\xa3\xc4\x06U\\\x98\xb1\xde#\xa5E\x86\x7f:\x95-\xb4\xfb\x12\x07\xe3@X\x84\xcb\x0f\xef\xb0\x18\x9f!\x1dV\x03\x10\x9dT\xfa\xe5\x8c81\x9aS\xcf\x84\xb6\xa3\xbd\x8dvBj\x8a\xd8u\x0e\x02\x1e\xf1\x10`\x8e\xb1\xb4F+\x12\x11\xb0\xe8!\xd2G\xeb#\x1e&\xe4\xe4\xa2\xb0\xc5\xc2\x891\xf3\xa6\x8b\xc0\x85\x87)U\x9c\xa8\xe3\x11\x0f\xbcj\x16,\xe8\x85?\xf0\xc2\xfd\xa7V\x15\xa2\xae\x95DZ\x8cS\x8c\x85\x8b\x99\x12z&\xc2\x87-i\xed\xd9\xe2\xb7j\xee\xc03\x18b\xc9\xc8\xa7|\xae\xa3R\xd6\xe0\xcc\x0cI\xf0V\xf7\xbe\x9a2\xd1'\xa1d\xd1\x92\x0f\xfew\xa3\xcf\xe1\x83q\xfc\xe7\xfa\x8b$7\xaf\x0e\xcew\xef\x0c\xd2\x07\xe3\xfc\xe8\xffX9A\xb4g\xab&\x0cg\xe3\n\x0d\xc2Zq\xe0\xf5\xf5S\x15ep\xb3e;\xcf\x90\xecl\xc2\x94n4\x18\x9bt\xc0\x0e\x12\x99\x04\xf2UC>\xb7h\xa3WX\xd5\xee0\xb7d\x88\xbc\x07\xf4\xbd\xa2\x88y\xf45\xd7g5Kq(F\x10\x01\xee\xb9\xfa	=\xa1\x0cR\xbc-\x80\xa2ae\xb3\xe1\x9d\xe5m\x8d\xccgIWhw\x0c\xaf./\xe7V5\x8bC\x13\xdb\x85\xf1\x16\x86y\xb9'\xf8E|,\xa6\xd8\xadf\xa0f\xd5\xaa}b\xf2D\x8e}\xae|>!\xbcg@\x99p\xd8\xfe.\xec)D\x9b\xa8\xcf&\xb2j\x8f5\x1b\\@%j\xf6\xfc\xaf\x0c\xcf\xde\x89\xbeA-\xa4\xa5\x0c.\xfd\x16RM\xf9\x7f\x7f\x86\xd4\xde	\xfb\xc4\x99\xae$`+\xec\x85\xe2\xf4\xc1{&\x0d\xa8\xf0hk\xd3of{\x92-\xcf\xe1si\xc8g\x06\xd8JT\xbe\xaa<{\xc4\xc3Y\xf0\xfc'L%	\xcen\xf4YH='A\xd9\xe6)\xa3\xd5\x01\xce\xbc\x02\xce\xb2\x93\x04;A\xfb\x89\xb4;\xeb%3\x9dG{\xa6\xf92\xe9~08\xe6$J\xdb\xc3~i\xe4W\x1f\xab\x9e\x91\x05\xc5\xfd\xcc\x06\xdbMp1\x9a\xb4^\xca\xa3\x97\xf2\xe8\xa5<z)\x8f^\xca\xa3\x97\xf2\xe8\xa5<z)\x8f\xfe\xcb\xe5\xd1DG\xb8\x8cX/&\xc1>\x9e\x18\x85\xbb\x89>\xe2{\x18\xd9\x10\x1f)\x16\x9e\x0c\xf6\xce\xfb\xb3\xc5\xf3\xf2gnt\xb87\x19\xc9\xac\x83\x9cs\xd5\x0e<\x96\xc1\x9f\x818\x10{!\x95\xd8\xa8$T\xd8\xa4\x9f\x10\x85\xc11\xdc\x92 o\xac\xe5*\xdb\xaf [<\x1b[\x86\xd2\x05%\xb5Bv\x0eP\xa0\x13R\x85\xfb\x07\x966\xb1k\x17>\"!\xb4gm\x91\xee\xa9Xsg\x86\xa1)A\xee\xde\nM^k|\x9dw\xaa\xe1\x91\x95\xbc?\x99\xd6\x1d\xe4\x90\x03'+>l\xc6'\xe4\xe7\xe6\x02\xf3x\xbcl4F_\x0b\xfb\x19\xe3J\xb4c\xeb\xe2\xb65\xb6\x12.\\W\xad\x98\xe5\xf7n\xe1*$\x12\xbb\xe7\xad\xfc\xc706-\xb7l*\xa1\xc1\xa2(\xbcSEJ u!s\xe1\xa6\x18rK&\x17\x1b\xd38o\x87\xa4\x0bit\xf6\xbdK\xb1(\xe8\xf8\xeef\xc2\x1bo\xfd\xd0\xb4\x90W\x1b+q\xfb:\x12\xe8\x1c1\x19p9\x05C\xf0\xab\xc9>\x062\x13\x91\x14q&\xca\x1e=\xc6l\x87>w\xee\xdd\xc9l\xe1\xd5\xf2\xde6\xb8<\x9f\\\xc1\xf2/B\x11.\xcfa\xf9w\xfd\xa8\xcdg\xbd|\xfd\xdd\x16\xf0\x03&&\x0f\xd0\xe0\xfeP\xb7n\xc4\x93\x92\xfc1\x9a;\xd5\x9f'8PS%\x03\xc0\xab\xe5-\x8a\xe2\xf0\xddr\xcf\x15,\xab\x11\x94\x18!\xb1J\xde?\xda\x17\xfcj\xb4\x8b\xfa\x17\xda\xfd\xb6\xf2z\x19\xe9\x98L_\xf3\xd5\x0f\x86\xeb\xe5\xf5b\xd6.\xe9\x12:\x9a&]$\xf4\xae\x7f\xdbkj\xde\xf6\xa7+\xe2\x111\xfd\xa5\xf1\x1c>O\x96\xa4\x03G\xf9\x9bO\xd3\xb7\xb8E\x8b:\xc7\xa3\x0b$%\xf5c\x0f,\xa7\xb4\xc2-\x9e#\x91\xa8\xd0_\xed\xfbG\n\xdf\x935x\xf2\x98\xd8\xdc*\xa9\xdf\xa3\xde\xf1\x1b\x86\xb7\xbf\x85/2\xef_\xcf%Ri\xf2W\xd4\\s\xcb1\xec<2\xc6\xf1\x84\xe4(\x95!\xbel\xc9\xb9L\xd8\xb5\xe4Z\x0e#2\x07\x90\xed\x1c$\xbd2\xe8^\xe3d\x8b\xa9\xa4'\xb5\xfb\xe3\x1fNz\xc3B\xf9\xf6{\x17_\xc0t\xcd\xbf$ybu\xef\xae?\xde^_]\xde_\xbf\xcb\xe0#\x8f?.\xa3\"\x12\x81\x92[\xcc\x0f\xb9\n\xe9\x1a\x17\xd3\xc5I\xba\xd8\xbf2\x9ad\xd1^&\xfb\xa3\xc6\x8e\xdd9H\x17\x9epl\x98ge\xc65&\x08\xc8\x18\xcd\x7f\xb92\xc0x\xc8\x8ay)\xb4\xa4\x8a\xe3\x80OF[\xe8\xf4eD\x00k\x82\xcf%\x8e\x01Pmew_\x1e=?\xedMe<\xbadK\x15\x92j%\x0eP7\xb66\x84\xe7\xfe#\xee\xd1\x1e\\9\xee\xc8\xa8\x08\xa1\xa1^\x194r\xa86\x13\x0c\xe1\xa4\xf2)\xbc\x8a\x0fb\xc6\xf0*v\xbd\n\x7f_\xbf\x00\xd6\xff:`\x8d&\xbe\x93\x8f\x01p\xd6\xe0l\x13\"\x93\x9c\xb1\\\xe0\xf6\xbe4\x9b\xf6A\xcaz1\xa8\xbf\xe0\xeb\xb7EW\x8a\x89<\xc7\xdaa\xd1{\x03\xc7O(\xd6pv6x5\xe7\x7fv~\xbe\x86\x9f~\xe6\x17q\xceX,\xe2[!Z\xc3O?/\xfe=\x00PK\x07\x08Q$\x93\x1c\xbb\x08\x00\x00\xd0(\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00<\x00	\x00crd/bases/catalog.kubecarrier.io_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8\xd4ZKs\xe4\xb6\xf1\xbf\xf3St\xed\xff0\xf6\xbfD\xca\x9b\xa4R\xc9\xdc\xe4Y\xd9\xa5\xc4^\xab$\xc5\x97\xcd\x1e0d\xcf\x10^\x12\xe0\x02\xa0\xb4\xe3T\xbe{\xaa\xf1\xe0cHpF\xb2R\xae\xe0$q\x80F?\x7f\xddh I\xd34a\x0d\xff\x19\x95\xe6R\xac\x815\x1c\xbf\x18\x14\xf4\x9f\xce>\xfdEg\\^>\xbeM>qQ\xaca\xd3j#\xeb;\xd4\xb2U9\xbe\xc3\x1d\x17\xdcp)\x92\x1a\x0d+\x98a\xeb\x04\x80	!\x0d\xa3\xcf\x9a\xfe\x05\xc8\xa50JV\x15\xaat\x8f\"\xfb\xd4nq\xdb\xf2\xaa@e\x89\x87\xad\x1f\xbf\xc9\xfe\x90\xfd5\x01\xc8\x15\xda\xe5\x0f\xbcFmX\xdd\xacA\xb4U\x95\x00\x08V\xe3\x1a\nT\xfc\x11\x8b\xdc2\xa3<3:\xcb\x99a\x95\xdc[\xfa9S\x8a[\xfa\x89n0'>\xf6J\xb6\xcd\x1a\"\xb3\x1c\xed\xc003\xb8\x97\x8a\x87\xffS`v{\xfak\xb0.m\x94|\xe4\x05*;\xc9i\xe8\x9d\xe3m\xac(\xfb{\xc5\xb5\xf9{|\xce\x0f\\\x1b;\xaf\xa9Z\xc5\xaa\x98\x94v\x8a.\xa52\xef{~S(r\xc7\x84\xe6b\xdfVLE\x96'\x00:\x97\x0d\xae\xc1\xaenX\x8eE\x02\xe0M`\xa9\xa5\xc0\x8a\xc2\x1a\x95U\xb7\x8a\x0b\x83j#\xab\xb6\x0e\xc6L\xe1\x17-\xc5-3\xe5\x1a2\xd2m\xb6e\x1a7w\xef22\x8e\x9d\x12\xec\xf4-\xd3\x08\x9b\xbbw\xfe\xa39\xd0\xc6\xda(.\xf63\xa4\x0c3\xad\xce\x9a\x92\xe91\x95{\xfb\xc3Y4\x82\x17f\x13\x0f\x1aQ\xbc\xda\x87\x1d\x1cK\x053\xee\x03I\xb0\x86\xc7\xb7\xacjJ\xf6\xd6~\xd2y\x89\xb5uk\x1a\xb2Aqu{\xf3\xf3\x1f\xefG\x9f\x01\n\xd4\xb9\xe2\x0d\xa9-b_o\x10\x0d\x0c\x04>\x91Z`\xa7d\x0d\x0c\xf0\x0b\xd7\x86\x8b=H\x81\x99\xdf\x08\xa0Q\xb2Ae:\x1ftc\x10\xaa\xdd\xcc\xc9\xfe+b\xd1\x99\x14\n\x8aQ\xd4`J\x0cf\xc6\xc2K\x05r\x07\xa6\xe4\x1a\x146\n5\n\x17\xb5#\xc2@\x93\x98\x00\xb9\xfd\x05s\x93\xc1=*\"C.\xd8V\x05\x85\xf6#*\x03\ns\xb9\x17\xfc\xd7\x8e\xb6\x06#\xed\xa6\x153\xe8=\xbb\x1f\xd6\xad\x04\xab\xe0\x91U-^\x00\x13\x05\xd4\xec\x00\ni\x17h\xc5\x80\x9e\x9d\xa23\xf8Q*\x04.vr\x0d\xa51\x8d^_^\xee\xb9	\x10\x95\xcb\xban\x057\x87K\x8b6|\xdb\x1a\xa9\xf4e\x81\x8fX]j\xbeO\x99\xcaKn07\xad\xc2K\xd6\xf0\xd4\xb2.\xc8b:\xab\x8b\xff\xebpd5\xe2u\xe2\xb4n\xd8`\x1fM\x1cy\xc0\x8a\x02\x1d8\x19\xdb-uR\xf4\x8a\xa6O\xa4\x9d\xbb\xeb\xfb\x07\x08[[c\x8c\x88\x82\xd7{\xbfP\xf7& \x85q\xb1Ce\xd79o\"\x9a(\x8aFra\xec?y\xc5Q\x1c\xab_\xb7\xdb\x9a\x1b\xb2\xfb\xe7\x16\xb5![e\xb0\xb1\xb8\x0d[\x84\xb6\xa1\x88(2\xb8\x11\xb0a5V\x1b\xa6\xf1\xbfn\x00\xd2\xb4NI\xb1\xe7\x99`\x98r\xfa\xe1&;\xad\x0d\xa8\x84$\x10\xb1\xd7l\xc4\xde7\x98\x8f\xe2\xa7@\xcd\x15y\xb8a\x06).f\x97\xf5!\x1c\x0fc\x1a\x1e6\x8f?\x1fqF8aJfB\xc0\x91y4\x16\xc0\xc8\xb7\x88\x04\xc5\x99\x83\x96\x1eY&$\xc1z\xc7\x98\xb3e\xee:8\x9c\xac\xa1Qs\xf1\x03\x8a=\xe5\x80\xb7\xb3\x13\"a\xe3\x86\xc2\xcf-)rJ;\x85A\x129iT\x1a\xf8\xa5\x91\x1aO(\xd1\x17 \x1a\x9eJ\x9e\x97\xb0\xe3X\x15\x1a\x9exU\x91>=\xf6\x01\x17\xde\xca\x84\xd3\xc5\x84\"\x90f\xa7*\xe4\x06\xeb\x11D\xcf\x9a\xd1\xc3\xf1\xb5\xe5v#\xc5\x8e\xef\xadO\xf2\x1d\xc7#\xb6\x8c\xf4B\xcd\xd0\x84#&\xe7Y:eW\xe2m\xc7\xda\xcaD~=r\xc1\x9eO\xbf\xccC2\xec\xa4\n\xba\xb4\x99\x04aT\x10\xcd\x0d\x0fg\xd6\xa3\x99B\x10\xd2xi\x8b9\xd1\xe0\x9dg\x94&G\x89\xb2\xa6\xa98\x16\xf0T\xa23\xa1A\xc1\x84	\x9bq\xed*J,\xa6\xd6;a\xc3\x19K~GV\xf2l\x11i\xa7\x9dC\x00\xf4\x91\x8a\"\xdb\x85\xac\xea\x94\x17c\xea\xb4\x15\xdd\xe8\xea\x9f(\x9d\x89\x0c\x7f\xbb\xff\xe9=\x95L\xc1l\x96\x8f\xa0\xff\xad\xaf\xda\xe2|\x9d\x0c\xf0~XOy\x06g?\xd3|R\xebD\x97#^/\x16)\x02p\x039\x13\x14\xdcL\x1c\x88	^X\xa1\xe9\xcf\xf6\x08\xa1\x8f\xc7\x97\x94J|%\xd0\xa0N-6\xa8GL[\xf1I\xc8'\x91ZU\xe95\x18\xb5`\xde8\xc0\x85\xd1\x97\xbeQ\"\xe9	\x1f\x8ad\xba\xe1pS\x98R\xec0\xbb\x8d\x17\xe6y \xd0\x19A\x1f'\xa6\x1eH#\x14\xe1\x1c\xeczQ@\x92&\x8f\xa2\xb1\x94O\x84\xa5\xac(\x14j\xca\x969*\xc3\x16x\x83\xdf?\x1e1\xdbg\xfeT\xa5e\x8d\x8e\x1f\xfaK\xb7[\xfb\xcf\x02\xf34\xae\xc8\xd4\x80\x15\xd6(\x8c\x0e1\xa0\xb1\xc2\xdc`\x01\xdb\x03pQ\xe0\x17\x90\n\x9exU\xe4L\x9d\x0c%\xcf\x92=\x84e\xa1\xb4\xd4\x1f\xbe\xf9\x98\x95R\x1b\xa2\xe4\xf8\xa5\x1c\xcb\xb8@\xa5?\xfc\xff\xc7\x8c\xd7l\x8f\xa7H\x7f\xc2\x83\x06\xbf\x8e\xf0\xb3\x90=\xcf\x9f[i\xb0\xb8\xf0\xdbw\x07\xbbAo\xe1\xc3j|\x84\xbf$=e\x9f\xf0\xb0\xfa\xb8\x1c\xe0\x8b\xb5I?\\\x12y\xa6%\x1f\xbaE\x01\xc4\x9a\x08\xce.\xd2\x04_\xd2\xd9\x04\x7f\x01|\x07\xbc\xab\x00\x0b\xbe\xa3\x9a\xbf+\xf7;0_tm\x00\x18\xa3{0\xac\xf55#\x15\xdb\xe3\xf7\xdfR\xc0\x84(\xf6n\xc8\x7f=\xc5\xaa\xdc\x8d(Si:C\xd6\xa9S\xbf\x82i^\x05]\xcf@\xcf\x9a\x8b\x1b[\xdcEj\xdc\xd3\xf8\xea\x0f\xdcQ\xb8X@\xd8\xb04hW\xe1\x0e\x15\x8a<\xb8\x04Ao\x84*\xa1,\xd7\xbe\xac\xa2\xf8\xa2b\xd3\xd5H\xee\xa4\xf7P\"hVw\x0d\x01{\x92\xa4Jl\x1b7u(\x02\xad\xfb\xd6mexS\xe1\\Y\x1b\xb5p\xb4P\x8etv^\xdf K\x8e\x93\xfad8C8\x0dz\xd2\xc93\xfdh\xc9\x83\xe2\xbe\xe3\x88\xdd\x05\x83\xcfx\xcf\xc8o~:\x9an\x1b\x8d\xda\x8bC\xee\xc3\x856L\xe4\xd8\xf9\x12\xf5\xe5\xc8\x89&t\xc9s\x98\xe9}m\xbc\x94	iJT\xb0q\x1d\xd4ka\xd4!K\xce6\xf3\x88\xe97G\\\xdb$\xee\xa7lm\x93\xcc\xf2\xef\x9bO\x81\x8d\xf9\x842\xe6Y\x0f\x17D\xd9\x86\x7f\n\xb8\xf1\x93\xe6\xccJ\xc9	\x1b\x8a<\xae\xec\x99\xb4;\\\x1c\xe8(\x02\xb9l(\x16\xb6h\x9e\x10\x85\x87\xfd\x0b\xb8]:\xffP\x93\x8b\xdag<\xc7M\xd5j\x83\xea\x02\xb4k\x92\xd9\x1d\xa6\x91\x1e\xe4\x06>\xcf\xa3\xc0GT\x90\x97L\xec\xa9]Cq}u{\xe3:\xddv;\x11\xba\xbc\x13\xe2\xd1\x03\x95\xc2'\xc5\x8d!a%\xd4\xcc\xe4\xa5]\x98\xcb\xe6\xb0\xc0a\x06\xbd\x03\xce\xb2JJ\x93\xa2:\x80n\x9bF*3P^\x90R\x83\xb4\x99\xd1a\xd3XS\xd9\x9b\xe4\xf9\xf5\x18k\xf8\xf7\xd4\xf4\x0f\x19r\x1aH3\x9eyu{3Z\x14\x84v\xfe8\xa8VH+W\xb77\x11\x9a\xfe\xbe\x81\xea\xa3\xbeg\xbb\xa0@\x9b\xe4\x99\x98	\xa83\xe1\xd1\xdfk\xd8\xa8<K\xd0a\x18/3f\xad\x12!	\xceZ\x9d\xa3e\xc9\xcbk\xe7x\xbf\xe9\xcc\xae\xd3\xd9\x99\xe4T	\x11\xe9C-\xb6\x18\xfbq\xeaD>B\xc2\xb3\xfcl\x80\x0fQ\xfd\xcc\xdanP\xe4\x15\xdb\xf7\xa7d\x1a\xdc\xcd\x1c\x8f\xce\xc0\x81\xdf\xb3\x84{\x7f\xbc\xea\xa4\x94vv\x84tWj\xce\xbb\xe9o\x89\x9f\xe5\xd2`\x18[\xc93\x0f\xf3\x8b\x85f\xbc\x08\xe8Et\x99\xf2T\x15pw<\xdf\x97\x01\xf7\x98+4\x94\x14\x0bp}\xc7\x1fY\xa3\x07\xe4'd\xc1\x1d\x13;L\x1e\xd7\x0c\xc7g~\x85i\xe8\xdf\x19\xe9\xf3\xe0\\\x060r\x8f\xb6zx\xe2\xa6\x1c\xb5\x01\x82\x05g\n\xc7\xf3z\xab\xc7\xa2{\x17te\x84\x93\x9f`\xb8\x93~Yx+\xfe\xa0\x84\xb8\x00\xe4\x96\xf1\xed\xc1\xde\xe60\xc3s\x1f\x8f\xfe[W\xa9\x90TA\x9a\xa904\xae\xbf\xb0\xdcT\x07\xba\xe9#_~\xef\xc9t\xd1Q\xba{3{f7Y\xf2\xfc\x16\xc4\xef\x0e=\xce\xd1/\xc6m\x83\\\n\x819m\x9aik\x0f\x12|^C'#u\xee\xee-\"\x9f\xbd\x84\x9bB\x86\xbf\xca\x8cl\x8f\xa2\xadc\xdaK\xbd;E\x7f\xee|\xec\xa5\xb2-\xa5\xc0\x91\xed\xde\xf7f\x99\xca\x16Z\xb9g\x03\xea\xb2\xd7\x9e\xb4\xc92z\xd2\x95\xdek\x01\xe3\xfcNi\xb8L\x1b\xcdM\xfd\x1189\x03\x8e)\xae\xdb#\x8c\x1d\xe9{\xfe\x86\xd0\xae\x1a\xdd\x11\xca\xad\xed\x17\xf7\x97\x84#\x92\xf0\xdb\xae\x0cs)\xdc\xd3\x8cS\xd9`\xd3M\x1c\xdc\x1c\x0f\xae\xe3\x81=2^\xb1m\x15\x18\xb6\x17\xffs\xb8M\x07\xa8y\xa6W\x1a\xf2V)\x14\xc6\xc9\xfaR\xf8\x9e%\xde	\x10*n\xd2\xb2a\xbc\xb2\xb7NI\xac\xbb\x15\x18\xea\x14\xd5=q\x98\xdd%K\x9e\xdf\xe4\xad\x986\x0f\x8a	m\xf5KO\x94\xe6$\x9c\xf8\xcf\x0f\x93e\xa1AH\x04\xc1\xf0\x1a\xfd9\xcbs\x1e!\n`\xdc\xe6\xfe\xc2\x9f2	\xa9\xbf\xb5\x89\xc3\x1f\xd1\xe7\xe4\xa2\xb1\x93\xaaf\xc6=xIi\xcb\x97\x85;\xdd\xbdk\xcd\xf6\xe7I\xfe\xa3\x9b\x1b\xc4-\xdb\x9a	P\xc8\n\xeb~\x9e\x12\xf5\xa5y\xceLlC\x1a\xc1\x01\xd8V\xb6\xc6\xab\xad3D\xf6RQ\x142}\xfc\x9a&\x82\x01wvj\x10\xe4\xab\xad\xe2\xb8\xfb\xda\x13 \xdd\x8e\x0d\xb8\x9a\x0b\xa7\xde\x87^\x83\xf79\xd4\x8a\xe0\x97\x87*\xcf\xbb\xf7\x18\xb9\x1b\xb3|\x11\n\x93\xafV\x0f\xaa\xc5\xd5|\xbf\x85\xc6\xea;Vi\\]\xc0\xea\x1f\xeebl\xf5\xf5\x8b\xa5\xb0\x13\"\x8bG\x18\xfcph:7\xa2E\x81\xff\xd9\xd8\xee1 .\x85\x87\x8b\xea\x00_\xad\xee\x90\x15\x87\x17K\xb1\x9c\x01\xa7\x981C\"\x0d\xb10\xfb\x9b\xf3\xb2\xd9\x9f\xf4\xf0\x81\xdcp\xa4VK\xafw&\xf1\x15\xfb\xe6n\x9d,\xda)\xd8\xe3\x0eJI\x0f-\xe8\xd1\x16\x01\x0f\xe1\xb1\x0b\xde\x93\xd7\x80\xcbWm\xff\x0b\x0fSB!\xf0=\nT6\xbd\x9e\xd0\xdaO\x93\x05\xc1\xd7k\xbahS\x98S\xaa\xdd\xf7\xbf\x86\x1d&d\xc1\x83Q,\xed\xd1\xb1\xc5\xc7\xbd\x7f\x97\x9b%\xb1T\xc1\x85\xf9\xf3\x9f&\xbf:\x1f\xa1W|\xfbI\xb3\xd3\xbe\xe0<\xe5!\xd7\xb7w\xd7\x9b\xab\x87\xebw\x19\xdc\xd2\xfc\xe32%$\xf2\x8a\xef0?\xe4\x15Z/\x9f\xf3\xe5\x90\xe0\xc3\x03\xc5\x8d\x14\x9a\x17\xdd\xa38{6\xeb\xb7\xbb\xa0\x8b\xb4\xf0\xf0Ga-\xe7\xf5\xc74hI\xdej\xc1\x86\xde\x1dRK\xbaFj\xb5r]S\xaa\xadY\xd3C\x8cM\xbe\x0e\xe2\xe85\x0f\xce\x05jC\x8fi\xc3\xa9\xca\xfb\xf7!\x83\x072\x13\xa7\xdegu \xadC\xc1uS\xb1\x034\xadj\xa4\xc6\x0b\xfb\x91\x1a\xbd\x07S\xce\x83\x0fV\xda\xbe\n\x1b\xf0\x93%g;\xfe\xac\x0fO>:\xdf\x1c\xbc{\xf0w\x86\xc3/\xed\xb6{D\xb9NF\x19\n\xfe\xf5\xef\xa4OV,\xcf\xb11X\x0c^0\xd3\x19a\x0do\xde\x8c^@\xdb\x7f{\x99\xd6\xf0\xe1#=`6Ra\xe1o\x9e\xf4\x1a>|L\xfe3\x00PK\x07\x08;R\xfc{\xf8\n\x00\x00\xc6.\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x008\x00	\x00crd/bases/catalog.kubecarrier.io_instancemigrations.yamlUT\x05\x00\x01\x80Cm8\xdcY_s\xa4\xc6\x11\x7f\xdfO\xd1uy\xd8\xf3\xd5\x82\xacs*\x95\xf0\xe6\x92/\xa9\xab\xdc\xe5T\x92\xe2<\xd8\xaeR\x03\x0d\x8c\x053x\xa6Yy\x93\xcawO\xf5\xc0\xb0\xb0\xb0\x92\xee\x92<$\xf3\xb40\xdd=\xfd\xf7\xd7\xcd\xec&\x8a\xa2\x0d\xb6\xea{\xb2N\x19\x9d\x00\xb6\x8a~e\xd2\xf2\xe4\xe2\x87\xdf\xbbX\x99\x8b\xfd\xe5\xe6A\xe9<\x81\xab\xce\xb1in\xc8\x99\xcef\xf4\x1d\x15J+VFo\x1ab\xcc\x911\xd9\x00\xa0\xd6\x86Q^;y\x04\xc8\x8cfk\xea\x9alT\x92\x8e\x1f\xba\x94\xd2N\xd59Y/<\x1c\xbd\xff:~\x1b\xffa\x03\x90Y\xf2\xecw\xaa!\xc7\xd8\xb4	\xe8\xae\xae7\x00\x1a\x1bJ@i\xc7\xa83jTi=\xa1\x8b3d\xacM\xe9egh\xad\xf2\xb27\xae\xa5Lt(\xad\xe9\xda\x04\xceP\xf5r\x83\xb2\xc8T\x1a\xab\xc2s\x04\xe8\x8f\x96_\x13\xbe\x88I\xa3fO\xd2\xfb\xe6\xfd\xa0\xd5\xc7\xa0\x95\xdf\xab\x95\xe3?\xaf\xef\x7fP\xae\xe7o\xeb\xceb\xbdf\x97\xdfv\x95\xb1\xfc\x97\xa3\x86\x11\xa8\xa6\xdfP\xba\xecj\xb4+\x9c\x1b\x00\x97\x99\x96\x12\xf0\x8c-f\x94o\x00\x06_{A\x11`\x9e\xfb\xe8a}m\x95f\xb2W\xa6\xee\x9a\x10\xb5\x08~vF_#W	\xc4\xe2\xc88\x9c\x12K\x18<M\x88H\xb0mx\xc9\x079\xd9\xb1U\xba<#\xcb\x14\x05\xc9\xf6R\xd6\xa7a\xe7\xe5\xb2\x18mI\xfc\xe9\xac\xc4;\xbf\xff\x99\x82\x19\xb9sq[\xa1\x9b\x0b\xbb\xf5\x1b/24\x14E\xbcH\xe8\x99\xc4o\xcbpB\xef\xb7\x1c\xb9\x7f\xd1\xfbc\x7f\x89u[\xe1\xa5\x7f\xe5\xb2\x8a\x1a_e\xb2LK\xfa\xdb\xeb\xf7\xdf\x7fs;{\x0d\x90\x93\xcb\xacj%\xb8	\xbcZd\x1e\xf4\x95C\x0eP\x8f\xb9\x03l\x80+\x82[\xb2{\x95\xd1U\xdd9&;\x9c$\xcb\x14\x80\xdapE\x16,\x95\xca\xe8\x18~\xd4pW\xd1Q\x84r`\xc9[K9<*\xae\xbc\xc4\xab\x9b\xef\x84Y~\xf6\xa1\x9a\x08\x0dA\x89\xe1o\x15iO3(\x97\x9f\x88\xc5\xfc\xb0\x03\xc5`\xa9\xad1#\xe7i\x8dU\xa5\xd2XO$\x06\xae\x1d<V*\xabz\xe6\xc6\xec)\x07,\x98\xec#\xda\xdc\xc5pm\xcd^\xe5d\x1dd\xa8\x05\xa2\nUv\x96\xa02\xe6\xc1\xc1P\xbdCX*\x82\xab\x1e:\xdei\xb6\x87\x1dp\x85\x0ch	2\xack\xca!\xa5\xc2X\xf2\x1aM\x95\x0e\x9e@\x1dHN\xa4\x06\xf5g\\\x83\xb61\xbc/\x00\xbd>P\xa0\xaa\x1d\x18\xbb\xee\x9f\x89\xd0\xdc\x90\x03m\x18R\xcaLC\xbd\xdb@i`\xd5\xd0n\xc2\xae\x8c\xf6g	,\xe7\x90b\xf6\xe0\xa3\xf9\xe6\xcd\xbb_\xb1ikz\xf3f\"\xf5\xfe\xfe\xfe\x80M\x0d\xd3F\xb1\x8e\xa5\x17![\x9f\x04\xc5~\x8d=c\xac\xad<\xbd\x8c\xd8D\xd4E\x8f\xe48z\x0b\x02\x15\xb2\x1d\xd0\"\x99\x94Mf\xba\xac\xcaS\x17\x07\xf2\xcb\x98z\xdd\xa3v\x88\xed\xc4\x84\x1f\x8f\x891\x15\x92\xa7\x97\x12\xe0\x19\x80<y\xc8\xdb\xa7\x0e\xb9\xbf\xbf\x7f5>\xb6\xd6\xb4dyl$\xfd\x9a\xb8p\xc2xR\xb1[)\xea\x1e\xaa!\x97&;d\xfb\x00\xdf\x94\x0f8\xd0W\x95\x84\x91ZK\x8e4\x07\xf0\x9f._\xb7`\xd2\x9f)\xe3\xd8\x97\xb7d\xbd\xabLW\xe7\x92\xf8{\xb2RU\x99)\xb5\xfa\xfb(\xdb\x05<\xa8\x91ihT\xc7\xe5\xdb\x85\xc6\x1a\xf6Xw\xb4\xf3\x19\xde\xe0\x01,\xc9)\xd0\xe9\x89<O\xe2b\xf8(%\xa2ta\x12\xa8\x98[\x97\\\\\x94\x8a\xc3\x8c\x91\x99\xa6\xe9\xb4\xe2\xc3\x85\x1f\x17T\xda\xb1\xb1\xee\"\xa7=\xd5\x17N\x95\x11\xda\xacRL\x19w\x96.\xb0U\x91W]\x8b\xc1.n\xf2\xdf\xd8a*q\xdb\x99\xae\x8b^\xd4/\x9f\x9eOD@z\xb6\x14\x08\x0e\xac\xbd\x15GG+]z\xef\xdc\xbc\xbb\xbd\x83p\xb4\x0f\xc6L(\x0c~?2\xbac\x08\xc4aJ\x17$e\xad\x1c\x14\xd64^&\xe9\xbc5J\xb3\x7f\xc8jE\xc3\x9cq\\\xaeK\x1b\xc5\x12\xf7_:r,\xb1\x8a\xe1\xca\x0f^\x90\x12t\xad\xf4\x10A\x10\x0dW\xd8P}\x85\x8e\xfe\xeb\x01\x10O\xbbH\x1c\xfb\xb2\x10\x8c\xf5\xbfB\xdc{m\xb2\x11&\xb93\x15\xb3hq\xb7-eCQ\xa5C\xf5\xe4\xe4\x94\x95\xfcfd\x92\xaaX0\xc5\xb3\x03\xd6\x0bx\xdab\xe6\x9a/\x1ao\x90\x0f\x96\n\xb2\xa4C\xd3\n@\xe4q\xb9\"\xdf\xe7\xfd\x88\x06\xa6XH\xec\x1b\xc53\xaa\x9e\xc7\x9b\xb0\xe4\x8c\xb5\xf7\x00\x8d\xd2\x1fH\x972\xe6]\xae\x12\x9c\xa9\xa0~Y\xfa\xa5\x13\xaf.eG0\x19\xc4\x8e\xebL|a\x8a\xf2\x0bY3t\x0c8\x0d\xa6\x98\xbb\xd3we\xe5\xc6\x01\xe2\xff\xd1G\xd2\xd0\x95&\xe7\xe4\xe3\xc8t\xfc\x8c\xafnN\xc8\xc5=\xe23\x19\x07\x04\xe0\x1fQ1\x14'\x83\xc5B\xe41\xe3\x85g:Z\xec\x86\xb9\xe6\xc9\xc9b\x07\xdf|\xdd\xac\x08M\x0f\xd2\xdd\xb0\xab9\xde|\x86CO\xba\xf5\xd3\xc9r7#\x0e\xe6\x9f\xa6\x90\xc3\x86\xc6\x89\x10\xd4tJ	k>\xfa\xee\x16\xb3^H:\x81\xe2\x05\xfb\xffz\xde\xadWy4\xa6\xc5\x8c8\x1a\x0b\xf9\xe4\xf5<p\x9b\x17\x9c,P\xdd\xcd\xe6\xa7gq\xdfsL\xda\xad\x0f\x94I\x9d\xf4\xdc#\xf6\xcf$\xc2s\xf0z>|\x99\x91a9|\xdb\xcd\x15]({5#\x9e\x97\xe2\xac|\x1e\xd1\x05\xc9\xab\xd5h\xec\xb4\xbc\x96\xe9V\x18\xdb \xf7_\x92\x91\x94\xfa\xe63\xf2&3\xba\xbf\x16p\xcf\x9a\x13\x08O\xdd\xdd\x8f\x8c\x80{T5\xa6u\xf0\xff\xe4Nc\xbedB]\xc6`\xeb \xeb\xac%\xcd}\xd8\x96v*\xa6fE\xcb\xe7rdT\\leT\xdaAN\xec?\xb1\x06(\\\x11	\xa32\xa3\x83\xc6\xf1{\xa1\xfaR\xd5\xe7\xfa3@\x8d\x8e\xef,j\xa7B\x86\xacY\xb6\xb0\xee\xc3\x82-$\x96\x08\x1c\x80\xbe\xa2\xa3\xd6g\x84\x02p\x7f\xf80\x88\x1aMC\xf9	\xe6\x0f\xe8\xb7f\xd7\xcb\x12\xee\x05p%\xab!\xe7\xb0|\x99\xe5\x1f{\xda`n\xd55\xa8}W\xf2)7H\x02\xa5s\x95!\x9f\x02\xcet\x85\xe0c*\x1d\xb2w\xdb\x18\x88s&?\x89\xbc\xb2,\xa1;\xfd\xca;\x93\xa17\x9e4\x18\xf2:\xb5\x8a\x8a\xaf\x06\x01cw\x1e\x03\xb8]+\xa1c\x0e\x858\xfe;\xba\xaf\xe1\xee\x99\xea\x1a\x00w\xd0}\xc8\x18S\xccU\xde\xf9t2\x05\xbc\xde\xde\xd9\x8e\xb6\xbb\xb3\x16l\xff\x88\xb5\xa3\xed\x0e\xb6\x7f\xd5\x0f\xda<\xea\xedW_\x1c\x01Op\x86y\x86\x10w\x87vL#a\n\xfa/\xea\xfa\x18\x84\xf3\x16\x0c0Q\x1f\xe0\xf5\xf6\xb6\xcb2\xa2\x9c\xf2/\xb6\xe2\xfc|-\xb7\xaaK\xccX\x11\x11\x85ZX\xdd\xeb\xb3lu\xcbM\xaf:\xa7+\xf2^Z\xd9xbp\x0d\x9bh-\x1eNXC{\xfe\x13i\xea\x1d\x9dl\x9e\x0c\xd8\xa7\x05C\x08_c\x9c|lg\xd25\xca\xe3n8a!\x16\x86\xfaZCq\x19P\x874\x1e\xfe\xbf8\xdfj\x95\xe6\xdf\xfdv\xb1\xdb\xfbC.K\xca\xd9m\x94,\x7f\xb5\xfc\x8c\x9d\xd7B\x13L\x0b\xfd\xc71\xb5!C\xc7\x91;\xde|Fb9F\xcb\xeb\x1dfV\x17\xb7\x81.\xa80v\x93\xe3\xa8/\xb3\x8a\x97G\xf9\x7ft\x10YM\xa5\xc5\xcb>\x0d\x12`\xdb\xf5\xf9\xe8\xd8Xi \x937]:^\x0d%\x9b\x19\xbe\xc1?\xfe\xb99B\x1df\x19\xb5L\xf9\xe4\xaf\x16\xb9\xccH\xe0\xd5\xab\xd9\xdf4\xfeq\x04\x02\x97\xc0\x0f?\xc9\xdf-l,\xe5\xc3\xad\x9dK\xe0\x87\x9f6\xff\x1a\x00PK\x07\x08cxUVj\x07\x00\x00]\x1b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00/\x00	\x00crd/bases/catalog.kubecarrier.io_offerings.yamlUT\x05\x00\x01\x80Cm8\xec=\xefo\xdb8\xb2\xdf\xfdW\x0cr\x0bl\x02Dj\x1c\xa7\xbd\xd6\xc0C\xd1M\xb6\x87\xbc\xdb^\x8b\xa4\xbd\x0f\xaf\xe9\xa2\xb44\xb6y\x95H-I\xa5\xf1n\xf3\xbf?\x0cII\xb6c\xcbd\xd2\xf6\xf6\xc3\x12(\x1aI\xe4p8\xbf8\x1c\x92cV\xf1\x7f\xa3\xd2\\\x8a1\xb0\x8a\xe3\x8dAAO:\xfd\xf4T\xa7\\>\xba\x1e\x0e>q\x91\x8f\xe1\xb4\xd6F\x96\x17\xa8e\xad2<\xc3)\x17\xdcp)\x06%\x1a\x963\xc3\xc6\x03\x00&\x844\x8c^kz\x04\xc8\xa40J\x16\x05\xaad\x86\"\xfdTOpR\xf3\"Ge\x817]_\x1f\xa5\xc7\xe9\xb3\x01@\xa6\xd06\x7f\xcbK\xd4\x86\x95\xd5\x18D]\x14\x03\x00\xc1J\x1c\x83\x9cNQq1\xd3i\xc6\x0c+\xe4\xcc\x82\xcc\x98R\xdc\x82\x1c\xe8\n3\xeaz\xa6d]\x8daK-\x07\xae\xc1\x91\x19\x9cI\xc5\x9bg\x80\x04\x98\xed\x93J\x02Km\x13\x83\x82	c\xab9\xb2\xbc\xf6\x08\xd9W\x05\xd7\xe6\x9f+\xaf\x7f\xe1\xda\xd5\xae\x8aZ\xb1bi\x00\xf6\xad\x9eKe\xfe\xd5\xa1B]\xef\xc9\xe9t\xcf}\xe5bV\x17Lu\xad\x06\x00:\x93\x15\x8e\xc16\xaaX\x86\xf9\x00\xc0\xd3\xd1\xe3\x9f\x00\xcbs\xcb\x1bV\xbcQ\\\x18T\xa7\xb2\xa8\xcb\xe6;\x95\x04\xfe\xa3\xa5x\xc3\xcc|\x0c)\xd1,m\xd8\x98\xe6\\W\x05[P\x07\x1e'*\x8e\xfcg\xee\x1b\xac}4\x0bBI\x1b\x8f\xe2\xb6.*%\xaf9\xb1^\xac6\xa7\xc71\xbc\xf1_\xe3\xe1\x16|\x8a\xd9\"+0\xd5\x86\x99\xbb\x90\x7fi\xbeG\x81n	rG&\x97\xc0\xb8\x0e^\xcc\xee\x82\xce;L\\\xa5\xeb!+\xaa9\x1b\xfa\x9a:\x9bc\xc9:\x8e\xc8\n\xc5\x8b7\xe7\xff\x1e]\xae}\x00\xc8Qg\x8aW\xc4\xd11\xec5\xa2\x05\\C\xad1\x87\xa9T\xf0\xd6\xca\xa5\x06#!\xe7:\x93\xd7\xa8@\xa3\xba\xe6\x19j0sf`\xce\xae\xf1\xaa\xed\x8d\xca\x15L\x10\x05\x94,G`\xd7\x8c\x17lR A0s,S\xb8\x12\xadp\x83\x9c\xfc\x073\xa3\x81)t*\x8a9\xb0\xda\xc8\x92\x19\x9e\xb1\xa2X\xdc\x81\xbc\x80\x7f\xd6\x13<u\x8a	\\\xc0\x8b,\x93\xb50\x96\x18Vp\xf5a\x87\x18\xb0\x06['\xea\x98\x83\x91\xeb0	-\xb8\xe6\x0c\x18\x9cz\xb5\xde[\xaaR)Y\xa12Kj\xec\xca\x92\x89[\x01\xb8F\xd7\x1f\x89\xf8N\x8f '\xebf	\x87\x8dna\xee9\x06r\nf\xce5(\xac\x14j\x14\xce\xde\xad\x81\x06\xaa\xc6\x84'\\\n\x97\xa8\x08\x10)|]\xe4d\x16\xafQ\x19P\x98\xc9\x99\xe0\xbf\xb7\xd0\xb5\xa7?\x14\xcc\xa07\x1d\xcb\xc5j\xb3`\x05\\\xb3\xa2\xc6C`\"\x87\x92-@!\xf5\x03\xb5X\x82h\xab\xe8\x14^I\x85\xc0\xc5T\x8eanL\xa5\xc7\x8f\x1e\xcd\xb8i\x0c|&\xcb\xb2\x16\xdc,\x1eY[\xcd'\xb5\x91J?\xca\xf1\x1a\x8bG\x9a\xcf\x12\xa6\xb297\x98\x99Z\xe1#V\xf1\xc4\"/\x88j:-\xf3\xbf)?%\xe8\x1f\xd7\xb0\xdd\xa8b\xad\xe5\xec\xe5\x05\x19Q\x92o\xe6\x9b\xbb\xb1t$'\x99$\xe6\\\xfc|\xf9\x16\x1a\x04\xd6 \x82c\x93\xe3@\xd7Tw\xcc \xc2q1E\xe5jN\x95,-T\x14y%\xb90\xf4p\x07hVp\x14\x06t=)\xb9!)\xf8\xadFm5/\x85S;\xff\xc1\x04\xa1\xaeH\xff\xf3\x14\xce\x05\x9c\xb2\x12\x8bS\xa6\xd1\xb1\xe2\x0e\xc4o\xc7\x1a\xe2\x80Nh\xa2\ngNc\xf6\xd6\x19D\xb0\xc6\x9e\x9a+\x9f\x9a\x19w\xabf5V\xe4\xb2\xc2lE\xb5\xc8k\x80\xfd\xa6\xc3Ch\xe6\x87C\xc8T\xae\x0f\xd7`\x02\xa0\xc9\xd2\x03R\xac\x06b\xbaVe\x9b	\xa02\xa9E^`~zq\xb6f\x1f6\xd8\xd8\x9f\xba\xba0\x97E\xee,\x01\xf1I\x91\xc5\x93\x02\xd8D\xd6\x06\xa6\xb52sT@\x157\xc0$	d\xc6\x1a\xcd\xce\xaa\xcd\xd06\xf9\xcc\xcd\xdcB=\xbd8;\x04Lg)\xfc\xc4\xb2Ou\xa5i\x80\xcc\xd2fB2\xb3\x01.7Xn\x1c\xc4\x9aI;\xbd8;_\xc2\x99\xf4\x9bq\xa1-+7\x8cf\x93\xb4\xbbrzq\xb6	\x8f~\x82\xb7\xd6\xf7\x1f\xd6\x15\xdb\xd8\xbe\xd7Lte\x93\xc1\x08\x10\xe5\xae\xd0\xa4\xf3 \x0c\xbc\xfb\xf6\x10\x10\ng\x1b\xe6\xa0-\xac\xbb\xb0\x95A!M\x86\x82&q\xd6\xbck\xe6\x9f\xadL\xd9\xa5	\xa1d\xa1Rr\xf1\x0b\x8a\x19\xb9\x89\xc3\xc1\xda\xb7X\x1a\x825\x96\\a\xde\xd7g\x02k\xdea\xa0\x19\xea\x8a\x9f\xb1\xb7\x8ad\xaf\x02mV\xa3\xc61p\xc6\xe0\xf4\xe2\xac\xe9\xc4Z?>\xe5d\xd8\x0c\xe3\x85\xde\xac'1L	a\xcb\x9a\xb4\xfcH\xfex+\x18\x1e\xb5C\xeb\x18\xe2\x0d+\xab\xc2\xba\x9f\x87\x83\xad\xd0|i\\\xd4C\xb8\x1eN\xd0\xb0\xe1\x8f\x83\x8d\xf5b4g\xb3\xb7\x1b0\xa8\xcbUwk\x89\xe8}4\x8e\xa1\xf3\x0e\xb7;\x88\xf4kn;9-d\xd5_\xbb\xd7p=\xf2C\x0f\x80\x0b\xe4\xfb\xd5\x1a-\xdf\xaeY\xc1s?\xd7\x88\x1c*U\x8b\x0d\x13\xde\xfdl\xf2j\xf9A\xe1t7\x9d\x82-\xe5j\xf9a}\x99\xd3_V\xa4\xfa\x7f/_\xff\xcbQ\xf5\xdd\xc5/K\x0e\x1c\xb0\x18\x9aR\xa9U\xb1Kf\"\xe6\x82\xd5\xd2\xad\xb5\xcf\xb7O\xcb\xc1#}\xa3d\xa5_\xab\x9f\xa4,\x96F\x1c\x08\x13\x96Hf\x01\x81T\xc0`\"e\x81L\x90H\xd5\x98\xc2\x19NY]D\x00\xa5\x15\x89\xaa\x9dX\x92l7\xf0\xbc\xa0-\xe2H\xdbk\xba\xb7\x91\xf6M\x84Fo\xb0\x8f\x7f\xd1w3}\x8b\xe2u\xb0\xea;\xa7\x13\xfe\xb8\x0d\xac\xefL\x05S\x8a-\x82Z0\xb1\xf8\xf3 \x93;\x1d	Eg\xc5l\xf9\xb64\x13\xb0\xf6\xc1\xca\x06q8\x10\"@-\xdcR)\xf7\x1a\x03S\x8eE\xae[\xf9\xf2\x11 \x064Q\x07C\x9d\"\xa3\xc5\xa2\x85\xee\xa4\xedN\\\xd7\x03\x8f\x069c\xa6\xb3.\x84\x9d\xf7\xf6\xb4\xf5\x91R\xabk\xea\x1a\xdf\x89OB~\x16/\xedh\x82;1\x92V\xd5SVl^\x11m*7	\x05n\x95@\x83:izOj\xd7}\xe2\xa89\xb6\x96-\x08\x8b\xbc\x8dx\x07\xdb\xa0\x8d\xf6+\\\x83\xb6L\x86]\xe8]\xb7K\xba@\x84hA\x8aP\xca\x1c\x0b\x0dxS\x15<\xe3\xa6X\xf8EyNa:\xebkY\x86\x05\xc2\x8c\xb6:9V(r\x14Y\x90\x7f\xf6\xf0\x99`\xd7\\pi\xe7\xfa\x17\xc1\x86\xaaY\xca\xf8\xe8\x1f\xb9#\x1b\xa7\xdd\x08\x1f\xc2\x0f\x92P\x08%\xfb=\xa6\xd3\xad\x848[\xe2H7\xb0`D\xd8\nK\xbf\xbd[\xb0<\x8a@$\xa3\xbd:\x14u\x19\n|\xe7j\xf2\x9b\xda\xa5\xf8\x99\xb6Y\x12\x06\x82\xff\xca\xf8\xe2MV\xd4\x9a_\xe3+v\xc3\xcbp*;\x16z\xdf3\x08\xf5\xae'.\xbeuO.*\x7f&\xb3`\x93\xb6\xa2\x8b?w\x00\xea\xb2\xd9R\xa0MH\xf9Y\xb7A\xa0\xd0\x15	\x15&Z\xa4\xda\xf8\xb8\x8f\x07\x18\xb2\xbe9\xe4\xcb]\x85Z\x9d\xea\x81\xd67\xb0\x9b{\xa9\xac_\xe8}\xe3>\xa2\xad\xae\x8b\xb2\x86b\xb5\"\x15{\xae\xadu\xf3\xc4rH\xc1\xbd\xbf\x1al\x82\xb0\xa9\\y\xd3\x97\x82\xf7\xbe<\x04\xb7\xa1\xc7gB*\xda\xabx;_\xdb!\xec+W0\x95E!?\x93\xa7\xb7\x0c\xcd\x07.0\x1f\xd3\x0eb\x02\x13-\x85#\x17\xcf\xc71\xe0\x99m\xeaI\x0d\xe7g\x87\xc0SL\x81\xc1\xf1	ds\xa6Xfh\x13g\x8e71P\xfd~R\x02\xb5\xe2c\"\xeb\xbb\x8bs`\x1a*\xa6h/u\xb2\x80\x7f\xc8\x82\x89Y\x0cL\x81\xe6\x11\x85\x18\xde\x10\x8c\x0b\xb7!D`\x13\xc0\x92\xf1\xc2vc\xff\x8a\x01\xca\xf2\\\xa1\xd6\x9bp\xb3\x1d\x12<\xd7\xe3\x0bW3\x06x\x02s\xa9\x0d\x05Z\xc7\xc0\x1c\xcb\xba\x89\xdf\x19\x1f2\x16L\xc4\x00=\xb7{\x93h,l\x1b\xc4<$\xec\x1b\xe7r\xb2\x80\x8b\x97\xa70<\x1a\x9d\x1c\xc6\x80\xd5$:R\xc0(\x1d\xc2\xfb\x8b\x97\xa7\x04\xe0C\n	\xf0\xea\xfa\xc4\xd2\xf6\xfc\xcd\xf5	\x9c\xbf\x89\x01\xba\x85\xaa\x8e\xa0\xe7o\x1c\xf4'q\xf2j\x11y\x02\xe7ovA\x8f\x81\x9a@\xc6sE\\:=?\xbb\xe8\x87L5\xe2`\x97,#\xd0\xaf^\x9c\xf6\xc9[\x0c\xcc\x96\x88\x043\x81\xba\xe6\xb9\xe5\xd1\xbbw\xe7g~;\x8c\xacF\x94\xb4\xd6U\x85*c\x1a\x97e\x89\"Q\ngx\x03\xfb\xcf\xf9\xc1\xaf\xef\x8f\x92g,\x99~\xf8\xe3\xe9m\xf2\xbc}8	{\x18\x1e\xdf\xfe\x10\x83\x90\x1b\xd7\xa8\x1d\xd8hyd\x1d\xba1 \xef3\xb2Q\xfbi\xf4\x95\x87v\xd2\x0e\xed\xe4\xbf3\xb4\x93\xd5\xa1=}\xc6&\x1fV_=\x8cw\x8f\xdb\x01>\xfe\xef\x0c\xf0q\xfb\xe9\xeb\x0f\x90\xeb	\x1d\xe7\x13p~\xf9\xd3\xbf\x86G\xb4\x1c\xb5\x7f\x8d@\xd4\xe5\x84N\x06\xd9I0F,\n\xfe	\xe1j\xefht<\xfc\xfb\xe3\xe1\xd1\xc9\xe8j\x8f\xc0^\xed=\xfb\xfb\xd3\xa4};\xbc\xda\x8b\x935\xc2tx\xb4\x8c\xeb\n\x86M\xb7\xe1@W\x10\xf4\xf0G\x1d\xfc\x87S`}\xbcd\xa0\x15\xe6\xdcdL\x91\xa5\x8b\x19\xbfk\x08\xd4\xb2\xc1k\x93\x19\x88\x01\xf9\xeb\xfe\xf3\xb1\xd5\x1d+7\xfb\xcf\xc7\xee\xef\xd1\xed\xc1\xf3/\x8f\xdf\x0f\x93\xc7\x1f\xfc\xc7\x93\xdb/O\xf6\x9f\x8f\x8f\x86\xc3/V\x14\xdd\xfb\x83\xb6\xe9\x97\xd1\xfb\x93\xbf7\x95G\xb7_FT\xf9\xfdQ\xf2\xf8\xc3\x97\xf7O\x9e\xae\xd6\x1e\xde~\xd9\x7f>>\x1e\x8e\x86_\x86O\x8f\x8e\xbe\x8c\x1e_]E }\x95\x13~WW\xf4\xc7px{\xf0\x83;\x1c\xc1\xc4\x02\x84\x14\x90\xf3\x197K\x1e_\x0c9J~\xe3\xc2Z	hM:\x01\xef\xd2\xcb\x14\xb4\xcc8+\xc8\xb3\xa8\x157\x0bO\xfc\x18\xc0\x9d\xef\xdbi\xfc\xafn\x08\xa3\xdb\xf7	|x\xee\x1e\x8eo\xdf'1p\x9bv'\xb7?\x90\x93\x867\x99,$M\xff\x82\x1c]\x96c\xc6KV\x80}\x1b\x037\x9396\xda\xf4\xb7\x97\xb6\x8c7\x0d\"\x06\xe6\xaf\x7f{\xbe\xef\xad\xd4\x8b\xe4\xe5\x87?F\xb7_\x96\x1f\x9f\x10'\x13P\xb3\x89\xc56\xd6\x97\xba\xf8\xc7On\x98K\xb8\xab\xd9\xa4\x19\x84\x9aM\xf6\x8f\x1f?>\xf4\xff\x9e\xc5Z\x9e\xc9\xc2P8\x81i|r\x02(\xa8\x8f\x1c&\\0\xb5\xb0go \x81\x8ai\xfdY\xaa\xc8U\x8bX\xd8\xe3*\xb4\x1b\xdf\xae5\xe8,\x18	\x1f\xfd\x7f\x7f\xd3s|t\xf4$9\x1a&G\xc7W{k\x8e\xf5\xb4.\x8a\x84\xa0\xc7\xa0\xca\x059\xe4\xa3\xd1\xe8\x19\xa1X+\xeb\xf7[4\xfd\xdf\x0f@\xf5\x18\x84\xbe\xda\xdb\xb4\xb0\x02\xc3Kt.\xe2\x99\xef'\x06i+\x0fe\xc5\x0c\xa7\xc3\xaa\xd6P\\f\xacXB:~q\x9cX\xc6\x10^-\x93\xe8\xc1\x0f\xbf#\xff\xf0$\x19\x1e'\xc3\xc7o\x87\xcf\xc6\xa3\xa3\xf1\xf1Qztt\xf4\x7fq\x92\xb7\xca7\xea7\xb1}u\xccX9\xd4\xfaU\xa3\x17\xbc\xf7\xe0\xcd\x83\x02#Q1\xd0\x95 \xc7\x9d\xbdY\x1b\x89\xefV\xa3\xe1{\x1b\xcc\xef\xb2Y\x7f.\xa3\x08\x18\xb7G\xed&x7D\x1f\x0c\xd4\xae\x82\xdd6&\xa9\xf4\x1a\xb6)\xbcb\\\x14\x0b\x98\xa3\n\x0b\x7fR\xa1\xa5\xb5F\xc5Y\xc1\x7f\xb7J\x07U\xad*\xa9\xb1\xf7\xf4\xd0r\x89\x8eB\x95\xec&\xeax\x82\x8fZ\xd1y\xe3''QH\xd1	\xe5\xd9\xca)\xfe\xed\xa5d7\xfetY`\x17\xdf\x0d\xad\xf8\x03\x07\xdf\x0d5\x1b\xca\x0e\xddE,\xb9\xf83\xf2\xbd=U\x18T\xfd\xbb\x89#\x17\x7fZ\xbes\x11\xc7w\xda\xc4\xaf\n|=\x0d\x16\x15!Mx\xdd\xba\xb0\xb7EB\xf9\x17\xbf\xa5\"\x05\xfey\x8e\x83T\xccPh3n\xb4\x11\xdb\n\x1e~\xbc\xf0=\xf0\x84A\xf44\x12\xbf\xff\xf3\xbd1\x0c9b|o\xc7\xe5\x1e\xac\x8d\xdf\x9e5\xdc\x84o\xceF\xe3c\x1bDa\x1e1\xd8Z\xf0\xdfj\x8c\x9ap\xe2-\xc3\xca~4\x96\x13\xccs\xcc\x93f\x933\xb4\xdf\x15\x1f\xb4\x1fd D\x7fD\xa8\xb9oGq\x01\xe7\x8f\xba\xed\xbb\x06S{/\xceu\x15\x0cX\xd5\x82\x16	\xe9k\xbb\x8fv\xe8\x96=o\x17\x15\xbeB\xc3\xec\x1d0\xf7\x85\x1eC]H\xa0\xed>K~(km/-5\x97\xd5\xce\xdd\x96#\x05\x07\xecE\xbc`\x88\xcd}\x18\x85$4\x99#B;\xf0\x06<-\x8fw\x9fE\xbf{}\xcf\x0e\xb4\xb9-\xb4\xba\xd7\xb8z\x191\x9c\x04!g\xc4\x96h\x11\x0c\xd7\x1dW\xa3\xb3e\x870\xa9\x0d\xe4\x125M\xb0\xf6\x16\xa6\xff\xca\xa7=7n\xee\x16\xbf\x0fJ\xf7\xd4\xea\xa2X4\x17\x0f0\x87\xfd\xba\"\x90\x96\xacK\xb7\x1d\xc3I\xdc\xd0\xf4 \x94p\x0fTZ.L\"UB2\"f\xf7R\xd8\xbd\xed\xf0Z\xc2D\x05\n\xbd\xcar\xdd\xe9\xac_=2\xd18j\x10\x17rk\xee.\xa6p\xee/f\xd0\xdd\x00+\x12\xd6\x1cTfa	\x19\x03\xb3\x13E\xab\x0c\xd4\x9c\"\n\xd9\x9c\x176\xe2d\x0f\xd1\x02\x8f\x1az\x85\xaa\xe4\x86n\xf4\xf2\xe9RHP\n\x7f\xa3\x04\xbb\x97Q`\x9dO\xa3\xed1\x80\xe1\x81?\xdfK_\x13/?\x9e\xac1@\x97Z{n\x1f\x1f\xf8c\xcc\xee\x9b\xeb%\x12\xe4\x1d\x94\x00\xee\xf6\x14\x8df\x9a\xa6\xf0;*I!\x84R*\x8c\x0b\xeb\xc4\x1c?ZQ\x06\xcaA\x90\x94\xacJ>\xe1\xe2~\x87\x91\xf6\xb6\xc3k\x12<\xc4)W\x1b@i/=\xde\xed!V\x13>\x96\xac\xfaH[\xd4N\xdd\x17\xcd\x8d`\x1a5]\xa2\xc9I/L\xdc\x81\x16.r\xbci\x84\xbedUJ\xa2\xfb\x964\xd7\xb0\x19\xbczw\xf9\x16\xa4X\xbf\xeb\xdeW\xe8\x86\xbdCF\n\x9b\x1c\xc2\xfb\x06n\x16\x98S\xa4t\x0b%\xa2b\x8am\xd6\x0e\xd0hh*\xb8\xda+Yu\xb5\x97\xc2\x8bB\xcb\xc3\xce\x15\x89\xe2ZcGs\x7f\xf1\x83l\x8fq\x17\xc4;\x97\x81\xae\xe2\xb0\x82E)1\x0d0w\xc7\xd9\x1bb;\x03\xa6\x8d\xaa\xed5s\xd8\x17\x12\x04j\x13\xb9K\xc95\xe8\xba\xaa\xa42\x98\x1fx\xee\xe1\xd2\xa2\xa5\x1bS\x0c\xbe\xd6=\xeab\x89\xcd\n\x83\xf4\xda2\xb2=\xe5\x1f\x03\xd4\xdf\xdf7\x12Ph:>o\xe6R\xaf\xe0J\x0e\x8e?c\x1c\x03\x98\x98\xc5\x8a\xc2\x8a\x9b\x0b\xcb\x06\x07\x94#\x0f\xb2F\xaf=\xe2\xd7B\x9b\xb5\xe3kY5\x82\xf5@\x8bfd\xeb\xf4\xba\x15\xc5\x04\x81\xd2\x02\x18Y\xc9B\xce\x16i\x0c\xeb\xac\xa9\xe9\x94\xd9\xca\x1d\x19\x9c;V$\x06h\x93!\xc2\x8a\xea\x08*\xa9\xb5\xdd:\xb1\x02\xd8\xce\xce\x1f\x99\x91%\xcf>FM\x9ddW\x08\x1f\xd2;\xd3d\x05\xa1\xf3\xf0\x94\xb8\xa6\xa0<\n\x86\x9bE\xd4a/\xbb\xd7\xd2\x18\x95\x94\xde\x01\xbc\xb0\xa8y\xfb\xf9\x99\x17\x05L\xa2\xcc:\xe5\xaaPX\xd8=\x85\x822\xe6\xc0\xe79\x8a.E\xc3*\xd9c \xfb\xd0\xfa\n\x83h\x03\xd0\n\x96\x9cZ\x94a\xdf\x99\xb5\xb83ov\xfc\x87\xe4C\x1c\xa4\xe4\xe3|\xd4h>Z\x1f\x07\xe0\x12\xfdQ\xcbhY\xb0^\xae\x15\xabv-\xd2\x04\n\x9d\xf2\xb73t\x14\xb2t\xf9\xd8_.\xfc\x99es\x7f\x97\x98^\xdc\x99'\xa2\xa8\xd0\xe6Oqh\xad\x18\x03\xf2H,\x99\x1b\xc9\x8d\x01L&R\xf8\xe5e\xe7\x98l661p\x1b\\R\x18\x1d8\x0f\xc5\xb3\xec\xed\x1c\xb5\xe7\x17\xd9\xf5\x18\x98V#JVi\xda\"\xf4\xcb\x14\xe4\n\xb0@:\xbe\xad\xfd\x1c\x14\x03RH\x918_\xe7\x13.\xfcm3\x92^#\x81\xe7\xa4-S{\xfa\xa4\x8c2^\xaf\x15\xdd(\xe3t\xff\xc3-\xa3s\xa8+)\xa0D5\xc3\x94(@\xa3 O*\x06\xaa-\x1b\x0d!\xb3\xe4t\x92\xd1\xd0\"\x062y\x1e$?MT\xc2\xdf|\xa3D.\xe0\xd8\xe8\xa6R\x92\x8f\xf0)4zN\xdc(\xd4_a\x82k\xf5\xe3\xbe\xf3\x9b\x8f7|\xdf	\xce\x1a\xe6{,\x8a=\xb2+s\xdd\xf1\x9d\xb9.F<\xfc\xb48SLP\n\xb6UE\xb6\xeaH\x16\x98e\xa6fQ\xc7\xbam\xd3\xfdO\xb8H\x9c\x81\xac\x18W\x9aV\xc79 \xd9N\xeb\x16G\x1b	\xd2fw\x05\xcb\xf8H\x1c\xe52\xb2\x00\xa5\xe5\xdd\xbe\x99\xe3\x826\xc5c\xa0\xda\xf6\x13\x1a\xae\xe0UM\x19\xa9\xec\xa1\x05\x8d\x15St\x92\x85e\x94*\xea ^\x04\xb8\xf6\x1e\xe4\x1c\x1b\xdf\x19&8g\xd7\\\xd6\xaa\xf1`c\xa0\x12U\xdd<\xe9-\xf0x\x8bs\x12\x03t\xdd\x8f\xe9qNbqm\xdc\x98]\xbe\xc9\xf71;\xdb.\xc7\x06v\xbe=\x94\xbe\x05p \\\x00m\xe86%q\x92riXPd\x862\x99\xd3r_\x1b\xac\\\xd2.\x9f\x1d#\x18\xaeW\xb2\xcfs\x9e\xcdI\xd5\xac;\xd4\xae\x0d\xddD\xdb\xded\xe12\xec\x12X\x97\xe6\xc4\xbb\x94l:\xb5I\xf3|o\n\xb3Z\xd1\xc5\xb7ba\x83\xc2\xe1@?s\x93\xcdQ\xc3\x84e\x9f\xc8 \x0b\xdar.\x9aQw\x9a\x13\x0c\x91O\xed\xea\x1a\xf3\xe5\xf5\xa6T\x1b7\xeb\x82\x81\x12\x1d\xef\xd0p\x85 \xdd\x99\x9c`\xa0>\x8aN\xc8\xb5\x17\xe2SxI\xf7\xc0I\xb1\xa7RMx\x9ec\xf0\x05\xb6\xd8\xc8u\xc4\x0eiD\xd5 \xed\xdb}5]\x1b\xa9\xd8l\xa7\xb7\xb0\xa2\x9f\x97\xae\x0d\xcd\x18\x9c\x12\x9fRD\x8a\xa2\xdeM:#\x97\x9fp\xb2{\xd3\xab\x9b\xaf+jkc5\x90\xd9d\x02\xed\x8dC\xebLy,ws\xe8-\x1d\xa2jW\x0cx\xc32\xba\x9bNq\xe8\x06;\xeb\xecyx\xffC\xe4\xd9\x054\x94\xdda\xbb\xc6;RS\x05\x8a\xc0\xae\x18\xc8.\\\x926\xa7\xda\x96.\x12\xbb\x154\xb8\xc7\x18\x12\x9f\xe7lk[\x97\xc3l\xebg\xcf'=\xb8\x07q\xfa\xc8\x92\xa9\x8d'\x17W\x04\x9b\xf2C\xf5\xe5\xea\xa3\xb7dBTac\xc5\xa7\x17g\xdb3\xf6q\xdd&\xec\xb37\x8d\x9a\xe7-\x19\x98:#\xba	Kh\xf95\x1e\xf4\x89M\xcf>{_\xfe\xbb\x9d+\x8e\xbe$o;\x1b\xf7\xe7\xbd\xdb\xd9\xbc?\xe7\xdd\n\xff|v\xbb{f\xbc\xdb\xc5\x82]\x84\x88\xc8u\xb7s\xd0!\xe6\xa4\xd7\x94\xec4#\x8d\x9em\x1b\xcd\x8e@\xee\xba\xde|\x8d\xecv!\x0c\xd8\xcd\x82\xfb\xe5\xb5\xeb\xf2\xd6\xf5tM%(\xab]\x00\x7fC3\xda\xad\x0c\xe6\xbe\xf9\xecvJ\xc3W\xf5*\xfc\xec\x1a\xc1\xa4\xaf\xe4QD\xfb\x13\xce_\xe8\xc5\x13\xbe\xa67\x11\xe6K\xecV\xfd\xaf\x91\xe2\xb2\xdf\x87\xe8\xc7a\x87\xff\xd0\xe3=\xf4\xe0\xdd\xeb9\xf4\xfa\x0d\xbd^C\xaf\xec\xb7i\xdf\xc7\x83\x1d\xaa\xd7&\x80\xa7\x99\x9d,\x15\xa3dUy\x97\xf1\xd9\xe7\x13\xffY\x18\xb5h\xec\xcdFl\xb7e\x1e\x0eI;Y\xa2\xd6=\xee\xfa\x8a\xb1x\xe5\xea\x12\xbez.?\x0br\xa4}\x8ew\x9f-\x98v\x0eo\xaa\x82q\xe1\xd6\xb1\x1b\xd1]F\xb9\xc9.\xc9\x856\xc8\xf2tpO\xf3gs\xeco\x1e!4\xd1\x9c1\xbc\xc8\x0c\xbf\xc6\x90\xa1^\x12\xbcf#x\x99\x11\x87\x0d4\xab\xf6\x0e`:\xb8O\xbe\x9e\xa4\x1f\x1d\x12\xc23\xca\xe7D\xe6k\x9b\xe3L\x95.\x90\xa25\xf9\xe0\x9e\x13\x87\xae\x85Fs\xc6L\x98\x0c\\\xb6\xd5\x9b\xac\xa2t\x08\x10\xd8\x12\xae+\xf4\xda\x02\x13\xa8u&\x85\xa6\x1fW\xc0\xbc\x19D:\xe8?\xcf\xde^M\x1a\xdck\x9a\xec\xd5\xdc\xe6\xc0\xd9x\xb0C6\x1au{\xe5\x1b,\xe5\xb0\xa6Df\xcd\xdb}\xff\x9b\x15\xd6>\x1d\x0ev\x88\xdc!\xa0\xc9\x0e\x1a\x89{\x88F/\xcbq\x08G\xcf\xba\xfa\x0dK\x0b)f6\xfa\xeb<,J\xcc\xd3U\xda\x02\x13\x1a\xdc\xe9W\x05x\xb6U)\x02\x1c\xda\x9d\xce\xce\xd2\xaf\x81\x84\x0d\xb1\xab\xdf\x0cq^\x97L$\nYNW\x06,\x8fzm,\x95\xef02\x9em\xcf\xc6\xbd\xc2\xb5\xf3L\n\xffc\x04%\x9d\xac\xd0\xbf\xd5\x8c\x14\xa9\x903\xd9pB\xf7s\"\xc4;n~C\x07 \x00\xa73J\x9d\xef\xe9\xcbK\x9a)\xa8y:\xd8}\x85\x86.\xbe\xf6T\x0b\xf2~K\xcc9{\xbb\xe3$\xf9\n\x0d_5-\x1a\x82q\x91\x15uN\x87\x0e-\xf6\\\xb8\x01\xd8\xb9\xad\x07=p\xa3}T\x89\xd9\xa1\xff\xf3?\x15\xb6\x7f\xeb\xeb\xedH\x07\x18\xe8\xb0\x95\x1b!\xda\xf3\xb9%\xce\x96:\xbd\x96\x91\n\xc9U\x90`\xfeB\x02\xe8\x85\x80\x0e\x05\x83\xe6\xbf\xff%\x97\x7f\xc9\xe57\x92K\xfbcUKS\xd8x\x10@\xe0\xcb\xb5F\xde\x90\xba\x8d\xb5\x82\x0bt`\x97\xa5h+\xef\xbcL\x7f\xf3\xb9a\xd7\xfaiiN\xdc\x88Cr\x87T\x83H3\xd0\xfc\x12\xca&\x0c\x96(\xd5\xfdh\xd6r\xdc\x8ch\xd4\xbe/\x99`3\xeb\xfdS\x04\xed!\xceN_\xec\xe6\xe1\x9e\xc6.\x9aoYy\xf6\x18\xd3\xed\x10\x93\xd6s\xbc\x83H\xd2\x92~\x10\xd4\xd1\x86\xd7dr1_\xd9\xbei\x82*+\xef\xeaI\xbbMb\xef]\xd2\xba\xaa\xb6\xfe\x01\xcb2\xac\x0c\xe6K?\x0cg#\xc0\xb0\xb7\xb7\xf2[r\xf61\x93\xc2m\xda\xe91\xbc\xff@?\x10g\xa4\xc2\xdc\xc7\xf4\xf4\x18\xde\x7f\x18\xfc\xff\x00PK\x07\x08\xc4\xa3\xc4Q\x9a\x14\x00\x00\xe6o\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00/\x00	\x00crd/bases/catalog.kubecarrier.io_providers.yamlUT\x05\x00\x01\x80Cm8\xecWA\x8f\xdb\xb6\x12\xbe\xebW\x0c\xf6\x1d\xf2\x1e\xb0\x92\xdf\xb6\x97V\xb7\xc0\xdbC\x90\xa4Xd\x83\\\xd2\x1c\xc6\xe4XfB\x91\n9t\xe3\x14\xfd\xef\xc5\x90\x92-\xaf\xedd\x11 \xe8%s\x92\xa8\xe1\xf0\xe3\xf7\xcd\x0c\xc5\xaa\xae\xeb\n\x07\xf3\x86B4\xde\xb5\x80\x83\xa1OLN\xdeb\xf3\xe1\x97\xd8\x18\xbf\xd8\xdeT\x1f\x8c\xd3-,Sd\xdf\xbf\xa2\xe8SPtKk\xe3\x0c\x1b\xef\xaa\x9e\x1852\xb6\x15\x00:\xe7\x19e8\xca+\x80\xf2\x8e\x83\xb7\x96B\xdd\x91k>\xa4\x15\xad\x92\xb1\x9aB\x0e>-\xbd\xfd\x7f\xf3S\xf3k\x05\xa0\x02\xe5\xe9\xafMO\x91\xb1\x1fZp\xc9\xda\n\xc0aO-\x0c\xc1o\x8d\xa6\x10\x1b\x85\x8c\xd6w9\xa4\xc2\x10L\x0eY\xc5\x81\x94,\xdd\x05\x9f\x86\x16.x\x95p\x13Fd\xea|0\xd3{\x0d\x98W\x94\xa7\xd9\xbc\x9a\xc9\xa1\xe3\xecR(\xb9\x1b\xc1\xe4!k\"??\x1a~ab\xf1\x1el\nhg\xe0\xf3h\xdc\xf8\xc0\xbf\x1f`\xd40\x94H\xd1\xb8.Y\x0c\x87	\x15@T~\xa0\x16\xb2\xff\x80\x8at\x050\xd2\x97\xe7\xd7\x80ZgA\xd0\xde\x05\xe3\x98\xc2\xd2\xdb\xd4OB\xd4\xf0>zw\x87\xbci\xa1\x11\x92\x9aI\xb7F\x9b8X\xdcI\xe8\xec:q}[\xc6a\xf6\x81w\x02\"r0\xae;\x0d\xbb\x8fx\xa2\xe2Q\xdc\xa7\xdd\xb4N	\xa7\x91\xcb@\x91x{\x83v\xd8\xe0M\x1e\x8ajC}N-1?\x90{z\xf7\xec\xcd\xcf\xf7G\xc3\x00\x9a\xa2\nf\x90\xed\xb7p5	\x00\xf4i\xf0\x91\"\x18\xb7\xf6\xa1\xcf\x90\xc0\xaf\x01\x1d<U\xca'\xc7\xf0\xa7\xe1\x0d\xf0\x86\x8e\xb5,\x16\xbc\xa5\x06\xfep\xfbo\xe0W\xefIq\x04\x0cT2\x954`b/\xa1\x15Z\xbb\x83\xd5\x0e\x9e\xa7\x15-KB\x82\xd9\xaf4\xee`\xdaf\xd60^\x03o\x90a\x83[\x02\x84Hak\x14\x81_\xaf)\x90\x06\xf6\x82\xac\x87\xadA@X\x8e\x99|\xb5\x8f4\x04?P\xe0}\xde\x16\x9bU\xf4l\xcd\x07\x14=\x11\x16K\xf6\x80\x96R\xa6(kM\x19Ez$^\xd8\xe2\x8d\x89\x10h\x08\x14\xc9\x95\xe2>\n\x0c#\xa5\x85\x9c\x06\xee)H\x18\xc9\xefd\xb5t\x80-\x05\x86@\xcaw\xce|\xde\xc7\x8e\xe3\x06\xc1\"\xd3X)\x07\xcb\x19\xec\xd0\xc2\x16m\xa2k@\xa7\xa1\xc7\x1d\x04\x12	 \xb9Y\xbc\xec\x12\x1bx\xe9\x03e\xad[\xd80\x0f\xb1],:\xc3S'S\xbe\xef\x933\xbc[\xe4\xa6dV\x89}\x88\x0bM[\xb2\x8bh\xba\x1a\x83\xda\x18&\xc5)\xd0\x02\x07Sg\xe8N\x18\x8bM\xaf\xff\x13\xc6\xde\x17\x9f\x1ca=\xa9\x89b\xb9E|A\x01\xe9\x15`\xa2\xe8\x9e\xcb\xa9\xec\xe2@\xb4\x0c\x89$\xaf~\xbb\x7f\x0d\xd3\xd2Y\x8c\xa3\xa00\xf2~\x98\x18\x0f\x12\x08a\xc6\xad)\xe4y\xb0\x0e\xbe\xcf1\xc9\xe9\xc1\x1b\xc7\xf9EYCc[;XL\xab\xde\xb0\xe8\xfe1Qd\xd1\xaa\x81en\xef\xb0\"H\x83\x14\xadn\xe0\x99\x83%\xf6d\x97\x18\xe9\xbb\x0b L\xc7Z\x88}\x9c\x04S?:\x96\xa18\x17\xd6f\x1f\xa6\x83\xe3B\xc5L=\xe0~ uT2\x9a\xa2\x91j\x8d\x8c,\xb5\xbb\xf7<\x8au\xbeV/\x83<ij/G7\xa9'F\xe3J\xbdN;\x84\xff\x8eM<7\x97\xeby\xb1_?XO\x8cX\xfdO\xa0\xce\x1b_s\xe2w\x19\xf3Ctg>?\x80\x7f{\xf0\x96\xa4\x97\x85\xadw].kM\x8c\xc6\x92\x9e\xcf8\x1b\x11&\xcc\xd2b\x8c\xa2S\xc8b\xbdq/\xc8ur\xd0\xdd\x9cu\xb8P\xb0\x93\xcd\x8e\xc3\xc7l\xec\xe0=ml\x93ztu \xd4\xb8\xb2\x94\x15\xd9\xf7\xd1\xef\x8a\xdc\xa8\x87M\x7f\xb2#-\x9e)\xef\xc6\xce\xd3\xa3\xb5\x10?&\x94\x0c\xb6\xbe\xf3\x13\xc3\xf1K\x0c\x7f91\xc4\xce'\xf4Y4\xb7\xc881gz\xec(O>/\xadX9\xc9[X\xed\xc6\xbf\x86o J\xac'm\xf0\xb5\xf8=\x0e\xe7\xcb\xc9\x7f\xa2\xc88e\x93&=\xa26\xae\x00\x07j\xba\xcb\xe8\xa1x/\x06\xd7]\x8f\x8f\xef\x07\xda?\xc7m\xf7\xed{\n\xf41I+:\xbf\xa1:\xc3\xbb\xf0iO\xc6\xd9\xef\x17:\xe6d\x927m\xf5U\xa1_Hz\x8dB\xaf\x93\xe4\x9d\xf9\xfc#\xeb~d\xdd7f]\xbe>\xcd\x8e\x95Gd\xe0\xfd\x83)c\x134\xae\xb3\x04\xd68*A\xe7\n\xfck\xe7\xd0\xe5b\xae\xe7'\xd4\xc9\xbc\xfa\x84\x98\xea\xd1\xe5|~\xcdz\xff\x97Q}\xb5)\x9ct\n\xa9h\xd2-pH\x05kd\x1f\xb0\xa3\xf9HZ\xed\xff\xac[\xf8\xeb\xefJ\xfe\xa4R>ZP)\x1a\x98\xf4\xec\x96,\x7f\x7f-\\]\x1d]\xac\xf3\xab\xf2\xae\\\x80c\x0bo\xdf\xc9\x95\x99} =^sb\x0bo\xdfU\xff\x0c\x00PK\x07\x08f\x90\x00\xd6\xb9\x04\x00\x00\xf4\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00,\x00	\x00crd/bases/catalog.kubecarrier.io_quotas.yamlUT\x05\x00\x01\x80Cm8\xecY\xddo\xe4\xb6\x11\x7f\xd7_1p\x0b\xc4\xf6\x9d\xe4sZ\x14\x8d^\x0e\x81\xeb\x06n\xee\x92k\xec\xe4\xc5\xe7\xe2\xb8\xd2\xec.c\x89\xd4\xf1c\xe3m\xaf\xff{1$\xf5\xb5\xfaX\xb9i\x80\xa6(\x9fV\xd4h8\x9c\xf9\xcd\xf07\xcb(\x8e\xe3\x88U\xfc\x07T\x9aK\x91\x02\xab8>\x19\x14\xf4\xa4\x93\xc7?\xea\x84\xcb\x8b\xdde\xf4\xc8E\x9e\xc2\x95\xd5F\x96\xdf\xa1\x96Ve\xf8'\\s\xc1\x0d\x97\"*\xd1\xb0\x9c\x19\x96F\x00L\x08i\x18Mkz\x04\xc8\xa40J\x16\x05\xaax\x83\"y\xb4+\\Y^\xe4\xa8\x9c\xf2z\xe9\xdd\xab\xe4\xf3\xe4\x8b\x08 S\xe8>\xbf\xe3%j\xc3\xca*\x05a\x8b\"\x02\x10\xac\xc4\x14>Zi\x98N2fX!7N_\xc6\x94\xe2N_\xa4+\xcch\xdd\x8d\x92\xb6JaB\xca\xeb\xaa\x0dd\x067R\xf1\xfa9\x06\xe6\x96\xa3_\x9d\xef\xe2J\xc9\x1d\xcfQ9!\xef\x91\xbf\x92-\xee\xb9\xe0\xda|\xdd\xce\xbd\xe1\xda\xb8\xf9\xaa\xb0\x8a\x15\xb5\xd5nJo\xa52\xdf\xb4\xeb\xc7\xf0\xd1\xcbj.6\xb6`*HG\x00:\x93\x15\xa6\xe0\x84+\x96a\x1e\x01\x04\x8f\xb9\x8fc`y\xeeb\xc0\x8aw\x8a\x0b\x83\xeaJ\x16\xb6\xac}\x1f\xc3\x8fZ\x8aw\xcclSH\xc85\x8d\xdb\xc8\x97N\xa4v\xeb\x95\xf7T\x983{ZW\x1b\xc5\xc5fB\x93A\xc1\x84\x19*\xbas\xf3\x8b\xf4\xd4\xb8I\x061\xefi\xfcrS\x9b\xea\xcd\xca\x99\xf1\x13~\xc1\xdd%+\xaa-\xbbtS:\xdbb\xe9\x80HCV(\xbe|w\xf3\xc3\xefn{\xd3\x009\xeaL\xf1\x8a<\x97\xc2\x89\x0b#\x14\xbc\xe4F\x83\xd9\"\x08[\xaeP\x81\\\x03\x17\xda0\x91\xa1\x06&r\xf7N\xdb\x92^\x08[\xa2\xe2YX\x88\xc6\x9ac\x91\xeb\x97`\xb6\xcc\x04/h\xc8\x98\xf0\x88FX+Y\x02\xab\x1d}-\x8c\xda'\xf0^x\x10i`\n\x01\xc5Z*\x1f\xe6z\xac\xf6n\xd5\xeb\x02w\xccH\x05?mQ\xb4\xda\xc9o\x08R\x81\xad\xc8+\xad\xb9	\xdc\xac\xa1\xb4\x85\xe1U\x81a\x89\x8e\xad\xac\xaa\x8a=\x18	,\xe8zI\xa0\xa7}\x99-\x96\xb0e;\xa4\xb7+\x04\xcd\x0c\xd7k\x8e\xb9\xb3\xf5\xfc\xfc\xfa\x89\x95U\x81\xe7\xe7\xf0\xe1\xc3\x87=+\x8b\x9e\xd6\xb6\x94\xd4@\xebg\xdeE\x1d\xacn\x02AS?j4vt\xae\x15bl8* \xd4\x91D\xd0\x9cv\x10\xd2\nA\x08#\xbd\x8dkQ\xe7\xeb\xae\xd2\xf7]xe\xd2f\xdb|\xa5\x13\xb4\xf1O\xa8M|\xe9^\x96\xec\xe9\xa6\xf6f\n\x9fwBL\xba{\x19\xd1\xd1\xec\xb3L\x1b\xa9\xd8\x06\xc3l\xc9\x9eR\xb8|\xf5\x15'\x97\x9d4\xc2\x95\x92\x15*\xd3\xd4\x9d\x81\x0f;j\x0f\x10\xfb\x19\x81\xda\xd7\x01\xc8\xa9\x0e\xa3\xc7m\xa8\x0d\x98\x87<\xf0\x01\xe5\x1a\x14V\n5\n_\x99{\x8a\x81\x84\x98\x00\xb9\xfa\x113\x93\xc0-*RCe\xca\x169\x95\xef\x1d*\x03\n3\xb9\x11\xfc\xef\x8dnM\x00!h\x16\xcc`\xa8v\xedp\xb5H\xb0\x02v\xac\xb0\xf8\xd2eO\xc9\xf6\xa0\x90V\x01+:\xfa\x9c\x88N\xe0\xadT\x84\xe0\xb5LakL\xa5\xd3\x8b\x8b\x0d7\xf51\x94\xc9\xb2\xb4\x82\x9b\xfd\x85;Q\xf8\xca\x1a\xa9\xf4E\x8e;,.4\xdf\xc4Le[n03V\xe1\x05\xabx\xecL\x17\xe41\x9d\x94\xf9oT8\xb8\xf4g=[\x07\xa5\xce\x0f\x87\xcf\x99\x08P\xb1\x07\xae\x81\x85O\xfd.ZGs\xb1q\xde\xf9\xee\xfa\xf6\x0e\xea\xa5]0zJ!\xf8\xbd\xfdP\xb7! \x87q\xb1F\xe5\xbe\xf3\x05\x84t\xa2\xc8+\xc9\x85q\x0fY\xc1Q\x1c\xba_\xdb\x15e\x01(\xfchQSU\x93	\\\xb9\xb3\x99\x92\xdaW\x8b<\x81\x1b\x01W\xac\xc4\xe2\x8ai\xfc\xc5\x03@\x9e\xd619vY\x08\x9a\xb20\"\xec\xbd\xd6yQ\x1f\xfc\x13\x19\xe3*\xe0m\x85YH\xa4U\xc8\x98P\xf4\xeb\xc2K\xe5\x96y\xe1\xa4\xa7l<Y\xa1S\x8e\x0e\xa6\x0f\xd6\x0fU\x1f\x14\xaeQ!Uh\x17\xbcz\xda\xc5\xd7-\xebJ3'\xebd\xdf\x02?\xee\xb6\xa1\x9a\x03\xefX\xbd\x96\xca\xd5\xef\xfa\\\xd0X`f\xfcv:\xcb\x0c\x15No\xab\xa9\x8f#\xf3\x00%\x17oPl\x88W\\\x8e\nLd\x95\x1f\x84J\xae0\x1f\xea\x8e\xa1\xc3'\xda1\x11sh\xcb\xfd\xbc\xf7\xdf\xf8(W\xa8j\x8f\xbb#a\xe8\x0fn\xb0\x1cQ6\x1eL\xa7\xc3\xa9\x1e\x07\x15\xd5\xd5\x10\x92\x11\x8d\xe0\xa3\xe6X_\x81G\xec\x9a\x03`\x0f\x86\x83\xa3n\xd1&&`\xe9\x94y>\xc3u4\xaa\xb2\x8e\x00\xe6Cg\x1e\xcb\x9cvL\x03m!\xdc&\xd8&\xc0r\xe8\xcd\x02p\xb6\xf4\xb4#\xf0\x83\x89\x9d\xf40\xf4g'ZCeH,\x83.\x90;TMC26\x1a\xca\xd7\xc5\xdbT0&\xf1=e\xa1\x87\xf7\xd0JV\x13`o\xe7\xd4z\xc7q\xebG\xd3\x14L\xea\x19\x98\xf7\x97\xdbo\xbf\xa1>\x82\xb6M\xeesv\x00\x17\xee\xa1\xee\xd5B\xb0^\xce\xaa\x05\xc0d\x93\x1cP\xb7\xbbF%Qx\xe4f\x8b\n\xb6\xb2\xc8\xfd\xd6W\xa1\x0f\x9c\x1eT\x92\xe1\xa3e\xc2p\xb3\x87\x82?\"\x9c\x10	<\x99\xf6\xd5B\x0c\x07v:\xef+&\xf6\xdf\xae\xe7E\xe2\x00h\xe2j\x9b#\xfb\x89\x87-!\xc0\x02\x98\xbfeOtRQLJ\xf6\xc4K[\xd6\x0dT\x1b3\xb9;\xb28P\x02<\x0f\xe8\x01|\xcc\x18T\"\x85\xbf\x9d\xbe\x7f\xf1)>{}zz\xff*\xfe\xe2\xe1\xc5\xe9\xfb\xc4\xfd8?{}\xf6\xa9~xqvvzz\xff\xf5\xdb\xaf\xee\xde]?\xf0\xb3O\xf7\xc2\x96\x8f\xfe\xe9\xd3\xe9=^?,Trv\xf6\xfa\xb7\xb3f=\xc5\xd4\x15)\x81\x06u\xcc\x85\x89\xa5\x8a}\xd5J\xc1(;U~\x8eW\xafnW2#R\xb2\xa7\xc9\xb73Gm=\xbc\x08S\x8a\xedG\xd5\xf4:\xa7%\xb5\xf0m\xe7\x83C\xb4\x8c\xb4\xe2\x13*\xa19m;]\xf7T\xb2\xad\xa5*\x99q\xd8\xff\xc3\xef'dJ.\x08\xb0)\xbc\x9a\x108\x96>s\xe1\xea7\xa7\xd13\x03Qrq\xe3J\xf9\x08\xfd\x9a\x0e\x8f\xff\xcb&\x8df\x83\x11\\\xd8\x94\xfc\x86\x98\x1a\xd9\xb2\x95 \x14\xd28p\x97$z^\xf1\xff\xef\xa7\x97\xe3\x01lB\xd7\x93\x8d\x83\xcb\xa2\x05\x9a\xb5a\xc6\x1e\xe4F/#\x9c\xc7o\x9dT\xa73t\x89!W\x9a\xda\xc3\xdc)A\n\xc1\xb3\xda\x15\xab\xd9f\xc4\xeb\xbd\xd5\xbf'\x99:\x113\xab\x14\n\xe3?t\x14:\x04\x9fZ\xfay\xd6\xba\x8cM;\xf3g\x96\xecT\xfb\x1ee\x1e\xec\x81\xc6\xbcA\xc7\x10\xf9\x0b\xd2\xe8ar,3h\xbe\x0f[\x98.\x0b\x92f\xd9\x013\xd1\x9f\x1dI\xa3\x7f\x9f\x1e\x1f`0P\x07d\xd9\xb6n;\xe6\xf9\xe7\xb3\xf9\xee(\x0e\x7f\x95\x9cw\np\x8b\xb1@\xc3j\xcc\x7f\x15<\xf3{\x8dy\x1d\xb6\x10\xae>\xc1\xecS\xc8Y\x1b`I'\xf5\x7f\x829C0	6\x93\x06\xfe\x07\x18f\x13\xc74Z\x80\x8d\x01\xb7\x1c\xbb\xde!\xb4\xcc\xfcWCc\xcb\xea\x1b\x97\xfc\xe7\xd0\xca\xe3M\xd7\x14O\x1b\xc1}8\x17\x0f\xfe\xbf\x99\xef\x8d\x96\xb4\xe4\xff\x1b\xff\xc8\xfc,\x02\x1e\xb70\x1bQ\x1d\x87(E\xcf4j\n\xda\xa3;\x19Lz\xea\xd7\xe9\x12\xc3MSw\xc6\xae\x9a\x9b\x8e4\xea\xf1M\xf8\xc7?\xa3\x96z\xb2,\xc3\xca`\xde\xb9\x8a\xa6\xff\xe6S89\xe9]]\xbb\xc7L\n\x7f\xd1\xacS\xb8\x7f\xa0\xabi#\x15\xe6\xe1\x12J\xa7p\xff\x10\xfdk\x00PK\x07\x08t\xf7\x1d;g\x07\x00\x00O \x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00-\x00	\x00crd/bases/catalog.kubecarrier.io_regions.yamlUT\x05\x00\x01\x80Cm8\xecY\xdds\xdb\xb8\x11\x7f\xd7_\xb1\x93v&Rc\xd2\x97\xbb\x97\x1e_2\x199\xd3frI=v\xee^\x1cwf\x05\xac$\x9cI\x80\x87\x0f9j\xdd\xff\xbd\xb3\x00IQ\x1f\x94e_\xda\xbe\x14O\"\xb8\xfca\xf1\xdbO@\xa3,\xcbFX\xab_\xc8:et\x01X+\xfa\xeaI\xf3\x93\xcb\xef\xfe\xecre\xceW\xafGwJ\xcb\x02\xa6\xc1yS]\x913\xc1\n\xba\xa0\xb9\xd2\xca+\xa3G\x15y\x94\xe8\xb1\x18\x01\xa0\xd6\xc6#O;~\x04\x10F{k\xca\x92l\xb6 \x9d\xdf\x85\x19\xcd\x82*%\xd9\x08\xde.\xbd\xfa.\xff>\xffq\x04 ,\xc5\xcf?\xab\x8a\x9c\xc7\xaa.@\x87\xb2\x1c\x01h\xac\xa8\x00K\x0b\x06\xcf\x05z,\xcd\"\x02\n\xb4VE\xc0\x91\xabI\xf0\xc2\x0bkB]\xc0\x80T\x02k5DO\x0bcU\xfb\x9c\x01\xc6\xf5\xf8W\xef\xbb\xcc\x93F\xed\xa3H\"\xe4*\xaa\x12'J\xe5\xfc\x87\xde\xe4O\xca%\xc9\xba\x0c\x16\xcbN\xed8\xe7\x96\xc6\xfaO\x1b\x052p\xc2\xa67J/B\x89\xb6\x95\x1f\x018aj* \x8a\xd7(H\x8e\x00\x1a\xd6\xe2\xe7\x19\xa0\x94\xd1\x0eX^Z\xa5=\xd9\xa9)C\xd5\xf2\x9f\xc1\xaf\xce\xe8K\xf4\xcb\x02rf'\xaf\xadY)\xe6\x9f	\x8d2-\xb7\x97\xcd\x9bf\xd2\xafye\xe7\xad\xd2\x8b\x01\xac\xd6\xf4\xb9T\xae.q\xfdi\x17\xf2\"\xcdC\xef\xc5	\xb0K\xc2\xd2/s\xe7\xd1o\xc3\xfd5\xbe8	\xa8Sm\xcf\xa3\xb6\x10\xdf.\xda\x15\x92^\xb2]\x92\xd9)`\xf5\x1a\xcbz\x89\xaf\xe3\x94\x13K\xaa\xa2\x9b\xf305\xe9\xb7\x97\xef\x7f\xf9\xe1zk\x1a@\x92\x13V\xd5l\x93\x02^$/\x01\xfaZ\x1bG\x0e\x94\x9e\x1b[E\x85\x00g&x\xc0\x8ew\x07\xd328O6\x87/\xba\xef^i\x98\xd9\xaf$\xbc\x03\xb4\x94\xc2\x84$`\xf0\x86\xd1\x04\x96\xe5\x1afk\xf8\x10f4M\xf1\x00J\xc3[!L\xd0>\xee&\xfa\x8f;k\xd4\xe7\xe1\x97\xe8a\x89+\x02\x04Gv\xa5\x04\x81\x99\xcf\xc9\x92\x04o\xc0/\xa9\x82\x95B@\x986\x81\xf4\xa2\xfb\xba\xb6\xa6&\xeb\xbb\xb0I\xa3\x97Nz\xeb\xecp\xf2\x92iK>\x0c\x92\xf3\x089^\xab\xf5k\x92\x0d\xd3`\xe6\xe0\x97\xca\x81\xa5\xda\x92#\x9d2\xcb\x160\xb0\x10\xea\x86\x9c\x1c\xae\xc92\x0c\x07Y(%\xa7\x9f\x15Y\x0f\x96\x84Yh\xf5\x8f\x0e\xdb5\x1b\x84\x12=5\xc1\xba\x191\x8e4\x96\xb0\xc22\xd0\x19\xa0\x96P\xe1\x1a,\xf1*\x10t\x0f/\x8a\xb8\x1c>\x1aK\xd1\xbc\x05,\xbd\xaf]q~\xbeP\xbeM\xa3\xc2TU\xd0\xca\xaf\xcfcFT\xb3\xe0\x8du\xe7\x92VT\x9e;\xb5\xc8\xd0\x8a\xa5\xf2$|\xb0t\x8e\xb5\xca\xa2\xea\x9a\x19sy%\xff`\x9b\xc4\xeb^n\xe9\xba\x17Mi\xc4\x0cu\xc4\x02\x9c\xac@9\xb6{\x8c\x9f\xb4\x8b\x0d\xd1<\xc5&\xb9zw\xfd\x19\xda\xa5\xa31\xb6@\xa1\xe1}\xf3\xa1\xdb\x98\x80	SzN6~\x07sk\xaa\x88IZ\xd6Fi\x1f\x1fD\xa9\xa8\xc9\xaa\x9b\xe1\xc2\xacR\x9e\xed\xfe[ \xe7\xd9V9Lcm\x81\x19A\xa89Je\x0e\xef5L\xb1\xa2r\x8a\x8e\xfe\xe3\x06`\xa6]\xc6\xc4\x9ef\x826\x01m\x9b!	'\xd6z/\xda\xba5\x101)\x17\\\xd7$\xb6\x02F\x92S\x1c\xab1Qr \xec\xe5\x8c\xa18\xe5!\xb0F\xa1\xfcz[\xc1\xbd\x0c6m\xc4\xd8]\x18\x0c\x17L\xfe\xc6\x9c\xbcxp\xbc:?q\xfc)Am\"\xdb\x83\x1eV\x87\x07\x96\xa5\xe1\x9a=+i_+\x1e\xfdbw\x0c\x88\x07\xea\xf5\xdf\xe6C/\xb3\xc6\x12\x1c\xe9\x8b\xae\xe4\x0dI\xf5\x8a\xe0\xfe\xa8\xd1{\xb2\xba\x80\xbf\x8f\xbf\xbcz\xc8&o\xc6\xe3\x9b\xef\xb2\x1fo_\x8d\xbf\xe4\xf1\xc7\x9f&o&\x0f\xed\xc3\xab\xc9d<\xbe\xf9\xf0\xf1/\x9f/\xdf\xdd\xaa\xc9\xc3\x8d\x0e\xd5]zz\x18\xdf\xd0\xbb\xdb\x13A&\x937\x7f\x1c\xd0\xfak\xc6}\x8b\xd5\xe4\xc9eJ\xfb\xcc\xd8,\xed\xa0\x00o\x03\x8dv\xe4\xf7m\xfevc\x076;\x1b\xd6\x85\xaa\xb5\xf1\xf4\xf2\xe7\x94\x11\xa92v}\x16\xcb\xc8\x80*\x9cbzX\x86\xcb\x1a\xca5|2\x92\xdc\xbew\x1c\x89\x90v(\xed<jq\xd8\x7fv\\\xf7}+\xdbnB\x87jF\x96\xf7\xd1\xc1@M\x16\xa4r\xc2\xac\xb8\xee\x1dT	\x06\x9b\xdf\xc3[P\x9e\xaa\x01\xfdv4\xdc\x0e\x97V\xdfi\xac\xd9\xc3:\x0f \x03o\x0c\x9f\xa8\xeb\xb1\x0c\xb1\x19\xb1\x8b\x18\xda\xd1\x1e\xef\x8f\xe9\x7f\x98\xb54R{\x14\x03\xf3\x87\xef\x8f\xc8\x9d\x12\xbe|\x9c\x90\xa7k}u\x01\x96\xb8\xfba\xf7\x8a\xfe2D\xe5\x11Hh\xa3\xe4\xa4\xdd\x9e\xc2}\xd7\x8d\x1e\x95\x00\xa8\x94\xfe\x89\xf4\x82;\xfd\xd7\x8f\x88\x0et\x0d\xbb\xc3\xd2o\x81\x0b\xcc\xb1\x953\xe8\x9d#\x0e\x8d\x81\x8a\xf7\x94u\xb2\xe4\x7f\x03Zdl\xe5\xd13\x17O\xda\xa1\xb5\xb8>\x00\xd14 $\xff_\x8d\xfe\x87\xd5\xe8\xaa\xb5\xc2\xef\xaeE\x9d=\xf9\xa8\xc4\x07\x01\x19J\x92pi\xe4@\x94\x1eu\xde#/\xd3	\xb6\x18\x1d\xddW:\xcd\x0e\xf5U\xc2\xe8\xe4]\xdf\xa8\xb7\xaa\xc89\\P\xf18\xdf\x1f\x93d\xcb\xf62T\xa8\xc1\x12\xcaX\xc3\x1b\x1cPZ*\x81\xbe\xbd\x1c\xd8\x1f\x92<\xaa\xd25\xc7\\6\x96\x08\xd6\x92\xf6\xb1e\xa4c\x84\x0f\xf6[\x96\xd0\xed\x9e-\x07|\x86\x05\xdb-\x8cgV\xd1|\xd2|\xce5\xe6\x1b\xe9\x13wr\x82:\xd7m\x87\xce\xcb\xa6.\xfd\x0c\x8c\x8eS\xe3\xe4\x07\xeb3\xb8\xa0\x85EI\xf2\x0c~\xd6w\xda\xdc\xeb\xc93H\x1aN\xda\x19\xf4oTN\xf2\xe3\n\xb9\xc2j\xae\xda\xc5\xe8(\xe1\x1f7\x92C\x1e\xbds6\x80Of\x0f\x12@\xd3}\xaf9\x13\xa8a\xb6\xb9\xeeP:\x9d%[\x06\xef\x97\xaa\xe4c)\xf5\x15=\x00\xaa\xf8\xe2\x05h>'\xe1\x9f\x1a7\xb4{\x92>\xe8o\xef\xb4l\xc3\xb4\xa7\n\xdc+-\xcd}\x0e\x9fw\xe6\xd5P\x0f\xd7\xa9	A{U\x82\xf2/9mUf\xc5n\xa1\xe6\xa0\x8d\x07G\x07v\xd1o\x9e\xf8|\x9cy5P\x97\x1f\xa9\xfe\xcf\x8c\xb1\x9d4\xb1\x13j=R\xf2\xe7(\xe5<Z\x7f\x82\x1d\xaeY\xee	\x968\x88\xb8\xed0\xa0\xaa\x8a\xa4BO\xe5\xfa\xbfb\x82#u\xe5\xf0\xa5\xc2\x81\x0c\x9e\xae\xe4\xb9\x88xT:u\xb3\xed\xc70n\xaekc\xebv\xd6O\xfe\xfd\xdb\xc1v\x90\x17\x93\x96\xd1\x9d\x10~b	\xea-t\x82)/6\x0f[>\x96u\xa5\xa8\x87\x07f~\x10\x11R\xc2xL\xef\x13<\xb0w\xc5}\x8a\xf2\x1b\xe9\x01\xe5\x99\xfb\xee\x86\xf3w\xebw\xc4g\xda\x0b\xff}\xad\xfb\xf6\xe8n\xa1w\xcf@\xdd<\x1f\xf3\x0f\xb1\xb9\x07\x0b0\xa3\xd2\xe8E\xbc\xb7{\xa2\x8b\xe8Az\x1f=\xde<\xbb*\x1e<\xc0\x0c\x12z\x18)\xebh\x1e=\x8a\xb2\x07\xcdw\xef${\xed\xb0\xf3\xc6r\xaf\xd6\x9b	\xb3\xee\x06\xb8\x80\x7f\xfek\xc4\xa5<\xc4\x82\x85BP\xedI\xf6\xfeR\xe2[\xca\x02^\xbc\xd8\xfa\x0f*>n\xba\xca\x02nn\xf9\x0f&o,\xc9\xe6:\xde\x15ps;\xfa\xf7\x00PK\x07\x08\x9b\xa1\x9e!\x0e\x07\x00\x00\x19\x1c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00-\x00	\x00crd/bases/catalog.kubecarrier.io_tenants.yamlUT\x05\x00\x01\x80Cm8\xb4UMs\xdc6\x0c\xbd\xebW`\xb6\x87\\*m\xdd^Z\xdd<n\x0f\x99~\x8c'\xf6\xe4\x12\xe7\x00\x91X-c\x8ad\x01p\x13\xb7\xd3\xff\xde!%y\xb5\xce$\xd3KyZb\x81\x07\xe8=\x00l\xda\xb6m0\xb9\xb7\xc4\xe2b\xe8\x01\x93\xa3OJ\xa1\xdc\xa4{\xfcQ:\x17\xf7\xa7\xab\xe6\xd1\x05\xdb\xc3M\x16\x8d\xd3\x1b\x92\x98\xd9\xd0\xcftp\xc1\xa9\x8b\xa1\x99H\xd1\xa2b\xdf\x00`\x08Q\xb1\x98\xa5\\\x01L\x0c\xca\xd1{\xe2v\xa4\xd0=\xe6\x81\x86\xec\xbc%\xae\xe0k\xea\xd3w\xdd\xf7\xddO\x0d\x80a\xaa\xe1\xf7n\"Q\x9cR\x0f!{\xdf\x00\x04\x9c\xa8\x07\xa5\x80A\xa53\xa8\xe8\xe3X\x01\x0d2\xbb\n\xd8H\"S\x12\x8f\x1cs\xea\xe1\x0b^3\xd8Z!*\x8d\x91\xddzo\x01k\xbe\xf2k\x13\xd7&\x8e'g\x89\xab\xd3L\xc9}-\xa6\x1a\xbc\x13\xfduc\xfc\xcd\xc9\xfcG\xf2\x99\xd1?\x17^mr\x8c\xac\x7f\x9cKhAgXqa\xcc\x1eyuo\x00\xc4\xc4D=T\xef\x84\x86l\x03\xb0\xd0V\xa3[@k\xab\x10\xe8o\xd9\x05%\xbe\x89>O\xab\x00-|\x90\x18nQ\x8f=t\xabT\xddg4W\xdf\x95\xe4\xeb\x91\x96\xbb>\x95\xe4\x16u6\xcc\x1a\x9c\xae\xd0\xa7#^U\x93\x98#MU\xfbrb\xa2p}\xfb\xfa\xed\x0fw\x17f\x00Kb\xd8\xa5Rg\x0f\xbb\x998\xa0O)\n	\xb8p\x88<\xd5\x82\x00\x87\x98\x15\xf0\x84\xce\xe3\xe0i\xe1X \x06\xd0#A\xf2\xa8\xc5y\xc9W\x0e\x06[$\x8b\x1f\x05\x10n\x17\x95@#\x08)\x98\xda\xb5\xe0q \xbf\x82L\x1d<\x84\x05\x18\xe2\xf0\x81\x8c\n|t\x8b\xea\xf3\x19hnE\xb2p\x88\\\xf0\xe1\xda\x98\x98Cu\xd5c-\x86\xa3'xX\xbe\xe6a\x07.l\x1d\xcf\x9a\xc9\x06\xf8e\xf0Z\xf0\xc3\xae\xdb=\xbb%\x8e\x89X\x9f{r\xf9\xd0\xf3\xacn\x00_p\xfb\xaa\xd0?{\x81-CJRk]z\x86\xec\xa2\x18\xc4\x03\xe8\xd1	0%&\xa10\x8f\xed\x050\x14'\x0c\x0bG\x1d\xdc\x11\x17\x98\xd2\xbf\xd9\xdb2\xdb'b\x05&\x13\xc7\xe0\xfez\xc6\x96\xc2~I\xeaQi\x99\x83\xf3\xa9=\x1a\xd0\xc3	}\xa6o\xab~\x13>\x01S\xc9\x029l\xf0\xaa\x8bt\xf0{d\xaam\xd2\xc3Q5I\xbf\xdf\x8fN\xd7\x1de\xe24\xe5\xe0\xf4i_\xd7\x8d\x1b\xb2F\x96\xbd\xa5\x13\xf9\xbd\xb8\xb1E6G\xa7d43\xed1\xb9\xb6\x96\x1e\nc\xd2M\xf6\x1b^\xb6\x9a\xbc\xba\xa8u\xee~Qva\xdc\xfcQ\x87\xff+\n\x94=\x00\xaet\xe3\x1c:\x7f\xc5\x99\xe8b*\xec\xbc\xf9\xe5\xee\x1e\xd6\xd4U\x8c\x0bPXx?\x07\xcaY\x82B\x98\x0b\x07\xe2\x1a\x07\x07\x8eS\xc5\xa4`StA\xeb\xc5xG\xe1%\xfd\x92\x87\xc9i\xd1\xfd\xcfL\xa2E\xab\x0en\xea\xe2.]\x9fS\x99v\xdb\xc1\xeb\x0078\x91\xbfA\xa1\xff]\x80\xc2\xb4\xb4\x85\xd8\xff&\xc1\xf6\xcd9\x9f\xd9yfm\x83\xb2>\n_\x98\x98y|\xef\x12\x99\x8b\x81\xb1$\x8eKK+*\x95A\xd8l\xfb\xaf&\xfc\xcc(E3\xdb\x83r\x9e\xd7\xa8hd\x1cik\xc9\xc3s\x0f\xf6\xf0\xf7?M\xc9\x9a\xeb\nGc()\xd9\xcd{Qx\xeaa\xb7\xbbx`\xea\xd5\xc40?\x06\xd2\xc3\xbb\xf7\xe5\xf9\xd0\xc8d\x97\x85 =\xbc{\xdf\xfc;\x00PK\x07\x08\xf6?\x07\x17Y\x03\x00\x00\xf8\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x002\x00	\x00crd/bases/catalog.kubecarrier.io_usagerecords.yamlUT\x05\x00\x01\x80Cm8\xe4Y_o\xe4\xb6\x11\x7f\xdfO1\xb8\x16\x88\xdd\xb3\xe4\\[\x14\x8d^\x0e\x81c\x04\x87K\x1a\xc3v\xef\xc5\xe7\x02\\qV\xcbX\x1a*\xe4h}n\xaf\xdf\xbd\xe0\xbf]j\xb5\xf2:h\xfbp-\x9fV\xc3\xe1\x8f\xc3\xf9\xc7\x19\xee\xa2(\x8a\x85\xe8\xd5\x074Vi\xaa@\xf4\n?1\x92\xfb\xb2\xe5\xc3\x9fm\xa9\xf4\xf9\xe6\xcd\xe2A\x91\xac\xe0b\xb0\xac\xbbk\xb4z05~\x87+E\x8a\x95\xa6E\x87,\xa4`Q-\x00\x04\x91f\xe1\xc8\xd6}\x02\xd4\x9a\xd8\xe8\xb6ES4H\xe5\xc3\xb0\xc4\xe5\xa0Z\x89\xc6\x83\xa7\xad7_\x97\xbf/\xbfY\x00\xd4\x06\xfd\xf2[\xd5\xa1e\xd1\xf5\x15\xd0\xd0\xb6\x0b\x00\x12\x1dV0X\xd1\xa0\xc1Z\x1bi\xcbZ\xb0hu\xe3Qka\x8c\xf2\xa8\x0b\xdbc\xedvo\x8c\x1e\xfa\nf\xb8\x02b\x12S06\xda\xa8\xf4]@\xc6]\xf4Fo\x94D\xe3\xa7\x826\xfe\xea\xe4\xb8\xf6rxj\xab,\xbf\xdf\x9f\xf9AY\xf6\xb3};\x18\xd1\x8e\xa5\xf7\x13v\xad\x0d\xffe'G\x01C\xd8\xc5*j\x86V\x98\xd1\x9a\x05\x80\xadu\x8f\x15\xf8%\xbd\xa8\xd1\xd1\xa2\x16=D\x01BJo\x17\xd1^\x19E\x8c\xe6B\xb7C\x97\xecQ\xc0\xcfV\xd3\x95\xe0u\x05\xa5ST\xc9H\x82\xb8t\xea\xf5\x1cI\xd3\xb7\x9e\x1eI\xfc\xe4v\xb5l\x14538\x8a,\x0b\xaaq\x8a\xf4.\xce\xbc\x1c+\x9a\xec\x92\xd8<M\xf1.\xb2\xd9\x97c\x1a\x142\xb1\x07\x9c\xeb\x8c\x12\x0e\xb8\xd4\xbaEA3'\xb4,\x0c;\xc7\x1c\xa1\xdc8j\xa4\x04\x14)\x18g \x90\xe4\x04\xe0\x92\xe4\xe1\xe5A\xca\xcd\x1b\xd1\xf6k\xf1\xc6\xf3\xd8z\x8d\x9d\x8f47t\x8f\xf4\xed\xd5\xbb\x0f\x7f\xb8\x19\x91\x01$\xda\xda\xa8\xde\xb9A\x05\xaf2\x8f\x8c3K\xb4\xc0k\x0c\xbe\x05z\x05\"\x9a\x1b\x92\x15Ao\xd0\x80\x88\x1b\xb9\xd1\xa3QZ:fV\x1d\x9e\xc1J\x1bX\xaa\xb6U\xd4\x94\xf0\x91\xf2\x88\xb0 \x0c\x86PF	\xcb'x?,\xf1\"\x04h\x86\xa8\xc8\x0bq\x15\xa3\xcb\x9f\xd7;\xb5\xc7\x16m\xbb\x95\xc6\xbam3\xb3+\xb4\xf0\xa8x\x0d\x1d2n}2\x8cZ\xd3J5\x83AY\xc2\xb7@\xf8\x98K\x06\xca\x827#J\x10$\xfd\xfe\xbd\xc1\x8d\xd2\x83\x05M\xe8\xe6\x91$\xca\xb3L\xce\xc75\x06I\x938\x8e+\x9e\xee\x0c\xea\xb5\xa0\x06%h\x03\x12[L\xc8~\x91bXb\xad;\xb4\x90\xbb\x9f\x1b\xda\x00i\x0e\xe4\x12n\xd78\x92\xcb\xcb\x00\xb8Ab\x7f\xf6\xec\x08A\xb9\xf8\xa9\xd7\xee\x14\x19\"k/dR\x89K!\x0fn\xad#\xe6!s\x06\x03\xb1j==\xc0\x80\x1d\xea\x1aQ\xa2,_m\x01{\xa3{4\xbcM\x89ad\xf7E\xb6\xf5\x9e\xc7}\xe5\x9c2$%\x90\xee\xa2\x88\xee\x16\x13\x15\xca\xe8\xc7A:\xe5\x94\xd3\x1b\xb4H\xe1\xea\x18\x01\x83c\x12\x04z\xf93\xd6\\\xc2\x0d\x1a\x07\xe32\xe7\xd0Jg\xed\x0d\x1a\xa7\xc7Z7\xa4\xfe\xbe\xc5\xb6I\x1f\xad`\x8cix7|b$\xd1\xc2F\xb4\x03\x9ey\x8bu\xe2	\x0c\xba]`\xa0\x0c\xcf\xb3\xd8\x12~\xd4\x06A\xd1JW\xb0f\xeemu~\xde(N\xf7d\xad\xbbn \xc5O\xe7\xfe\xcaS\xcb\x81\xb5\xb1\xe7\x127\xd8\x9e[\xd5\x14\xc2\xd4k\xc5X\xf3`\xf0\\\xf4\xaa\xf0\xa2\x93\xd3\x98-;\xf9\x1b\x13oV\xfb\xd5H\xd6I\xe6\x0d\xc3\xdfB\xcfX\xc0\xddE\xceME\\\x1aN\xb1S\xb4\xa2\xc6k\xe7\xfa\xf2\xe6\x16\xd2\xd6\xde\x18#P\x88z\xdf-\xb4;\x138\x85)Z\xa1\xf1\xeb`et\xe71\x91d\xaf\x15\xb1\xff\xa8[\x85\xb4\xaf~;,;\xc5\xce\xee\xbf\x0ch\xd9\xd9\xaa\x84\x0b_<\xc0\x12a\xe8]\n\x95%\xbc#\xb8\x10\x1d\xb6\x17\xc2\xe2\x7f\xdd\x00N\xd3\xb6p\x8a}\x99	\xf2\xbag7\x02s\xd0Z\x86\x92j\x92\x99\x88\xc9\xe2\xfb\xa6\xc7z>M\xbb\xba\xa0\xdde\xa2\x11\"\xc4\xa4\xbd\x97\xaa\xcb\x11\xd3\xe1\xb0v#\xbft\xc7g\x9a\\)y:\x01\x83+4\xe8\xb3\xf44\xd5\xf0Z\xf0(wN\x80\x01\x1e\xc56\x9dz'\x1a\x0b<\x9f\x8b\xd2p\xf7\xc6!:@\xa7\xe8\x07\xa4\xc6\x15;o\x0e2\xccDW\x18\x06\x7f\x19\x94A9\xc5. +J\x8e\xda\xde\x0d\xa9\xbaXXO\xd1F\xb9\xf3\xbb-\xa3\xcf\xf3Nu>t\xfd50\xca\xef;\xc8	b~\x0b\x82\xa6\x89]\xa6\x1aV\x8c\xdd\x01\xd1\x0e\xb9\xe9VB\x97_\xb6\xf29\xf1\xc4\x01\xe1\xa6[\x1d3g,|\x0e\xac\x9b\xc8\xe3\xca\xe0\xa4\x97gw<jk7\xbc\x9e\xe7\xf6\x15\xf4\xf4\xd3jn\xb2\x88\x8e\xe4\xae\x95&\xf6	\xf3\\Y\x19}D\xdf\x1f\x92f_t\xc0^0\xa3\xa1\n\xfev\xf2\xf1\xf5\xe7\xe2\xf4\xed\xc9\xc9\xdd\xd7\xc57\xf7\xafO>\x96\xfe\xc7\xefN\xdf\x9e~N\x1f\xafOOON\xee\xde\xff\xf8\xfd\xed\xd5\xe5\xbd:\xfd|GC\xf7\x10\xbe>\x9f\xdc\xe1\xe5\xfd\x0bANO\xdf\xfevF\xa0O\x85\xeb\xa2\x0c!\xa3-\x14q\xa1M\x11\x0e_\x01\x9b\xe1P.\x98\x8f\xb9\xd9\xa8s\x13\xdet\x07ff\xb2q^n\x0bc\xc4\xd3b4\x01\xb1L\xaf\x16\xcfF\xc3e\xe0Ja\x80$\x93/\x86\x04\xec\n-\x8b\x0c\x8fk\xd5\x86H\x0e\xf4	*8\x08\xcb\xaam}I?\x0d\x99\x956\x9d\xe0\xd0\x1a\x14\xbck v\xe3\x19\xcfj\x90\xd0\xf8\xc2\xea\xc8y\xbe\xdf2\xa6s\xa4\xa4=/\x91\"\xfe\xd3\x1f'\xb3\xcfEC\xc2<\"Lj\x1a\xf7\xaf\x97\xb4<u\x0f\xb1o\xd9\xf6\x0e\xe5\xe2\xd7e\x9b/\xe1\xf2\xd0\xab\x95/\xe9\x8f\xa8\xec\xa7\xc8\xb6\xaf\xb2-\xfd\xffHe\xbe\xaf:\xa2/\xdf\xff\xbb\xd0s\xb9\xe8\x0c\xd4\xd8\xe7}Y\xe2a@\x0eN\xdeC\xd5\xf1\xae1\x9e*q\xfa\xa4\xb0\x1b\x06\x85\xd5t\\>\xabi\xafe\xdf\xfa\x7f\xabVX?\xd5-\x8661TZ\xb1\x87\x9c\xe0\x82\x17\xfe\x88\xa8\x07=\xd9`s<s\\{\xa6}\xbf\x8b\xd4\xa3\xb9\xe4\xcb\xf7\xb6\xed\xcb\xd0\x11\x8f\xbbI|\xe9\xce\xf0\x0b\x93\x86\xe6\xac\xf3oe\xff\xf0\xbcwD\xae\x98D\xf7\xec\x17\xa9\xfa\x91R\xb7\x98\x9c\xef\x7f/c\x1c\xae;\x8aQS4ZPd\xf7\xea\xdeD\xd2\xd2\x1e9e\xf1=\xf2\xfe\xc3P\xa4\xd9	l\x88\xc4=\xe2\xfe\x9bd\xca\x97\x9c\xbf\xde>{v\xcb\x82\x87\xbd<?\xdb\x9bz\xde\xec1\xc0{\x85^Z\xf7\" =Tl\x04\xb2\x86\xb6\\\xbc,\xd8\xfd\x9b\xd7e|\xd7\x1a\xcb3\x91\xe92\xe7\xdd\xa5pM5\xa6'\x88\xf4~\x96\xa2+\x93h\x82\x1dz\xd0\xf4\xa6v\xf0%\xed\xd7\xe5\xf7\x98\x87_x\x9a\x9b1\xf7\x81\xf3D\xbc\xd1\x89&\x02A~\xc6\xff\xec\x89\x0e\xc6\xcd\x84\x18\xdc \xab\xed-k#\x1a\xcc)\xc3r\xfb\xd0U-F\x1e\x08\xff\xf8\xe7b\xe7\x8c\xa2\xae\xb1g\x94\xd9\x9f#\xeei\xa6\x82W\xafF\x7f\xa9\xf8\xcfZS\xf8\xd3\xc3Vpw\xef\xfe&amP\xc67H[\xc1\xdd\xfd\xe2_\x03\x00PK\x07\x08\x1e\x8bHr\xe9\x06\x00\x00\xef\x1a\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x007\x00	\x00crd/bases/kubecarrier.io_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8\xe4Y_\x8f\xdb\xb8\x11\x7f\xf7\xa7\x18\xa4\x0f\x9b\x04\xb6\xb6i\x8b\xa2\xf5[\xe0\xdd\x16\x87K\x82`w{}\xb8\x1c\xb045\x96xK\x91*\x87\xb2\xe3\x1e\xee\xbb\x17CQ\x92%S\xde\x04\xe9=\x14\xe5\xcb\xaeE\xea\xc7\xe1\xfc\xfdq$j\xf5\x03:R\xd6\xacA\xd4\n?{4\xfc\x8b\xb2\xa7\xbfP\xa6\xec\xf5\xfe\xcd\xe2I\x99|\x0d\x9b\x86\xbc\xad\xee\x90l\xe3$\xde\xe0N\x19\xe5\x955\x8b\n\xbd\xc8\x85\x17\xeb\x05\x800\xc6z\xc1\x8f\x89\x7f\x02Hk\xbc\xb3Z\xa3[\x15h\xb2\xa7f\x8b\xdbF\xe9\x1c]\x00\xef\xb6\xde\xff>\xfbC\xf6\xd7\x05\x80t\x18^\x7fP\x15\x92\x17U\xbd\x06\xd3h\xbd\x000\xa2\xc25\xc8 \x85\x8bR\xe4\x8a\xa4\xdd\xa3SH\x01Z\n\xe7T\x80^P\x8d\x92E(\x9cm\xea5Lf[\xb8(c\xf2|\x11\xf9\x18N\xa1\x15\xf9\xef/\xadz\xa7\xc8\x87\x95\xb5n\x9c\xd0\x17\xe4\x0c\xab\xa8\xb4\xce\x7f\x18$\x00X\x81t\xb9\x8a\xb3\xca\x14\x8d\x16n\x0e\x85e\"ik\\C\xc0\xa8\x85\xc4|\x01\x10\xb5\x19O\xb5\x02\x91\xe7\xc1BB\x7ft\xcaxt\x1b\xab\x9b\xaa\x9b\xe7\xb1\x82\x9f\xc9\x9a\x8f\xc2\x97k\xc8Xc\x99ty\xc6\x9a\x8eb\xf1\xe0\x9f\x17\xcc?,\xf4G\x16\x89\xbcS\xa6\xb8\xb0\x05\xa1\xdb+\x89\x1b\xdd\x90G\x97\xde\xed~\xb4\xe6\xeb\xf6\xf0\xc27\x94\xd5\xa5\xa0\xf3S\xdc\x87\xc9\xaf\xc2\xeb\xdc;;s\xcd\x13\x98\x16\xfdmq\xbac\xab\x8e\\\xf8\xeea\xbbh\xffF\xe8\xba\x14o\xe2C\x92%Vb0\x89\xad\xd1\xbc\xfd\xf8\xdd\x0f\x7f\xbc\x9fL\x00\xe4H\xd2\xa9\x9aM\xba\x86\x173\x9e\x08\x1e\xb5&\xf8\xbe\xd9\xe2\xa6\x8d\x06\xf0\x16\xba@\x0111\xe4\xa7~c\x1e\x9f`\xe7l\x05b\xa2\xff%8,\x14[\x02\x94\x07e\xc0\x97\x08\xef\x85\x11\x05Vh<\xc4e L\x0e\xe4\x85\xf3ST\x01\x06\x0fP;+\x91\x88\xe5q(\xad\x91J#(C^\x18\x89\x04v\x07\xbeT\x04\x9b\xbb\x9b\x0c>\x19\xf8\x80\x87\xd1\xec\x14\x94\x85\xd8\xdc\xdd\xc0Ai\x0d[\x1c0s\xd8\x1ec\"1\x05\x08\xa8\x84\x97%\xff\xdb\xa1uG\x18\x9f2\x9bnp+d9D\xd8\xe8\xdc\xa3c\x1b\xc4\x9c\xce\x94\xf6\x96H\x15\x86\x17Nq\xed\xf6g\x94~	\x95\xa8\xeb \x96g\x95\x88\xf3\xad&\xf2\xb1R\xdeN\x031\x1a\xf68\xdd\xa4?\xeb\xa0\x1e\x91\x1f\x97\xa0X\xcb8A\x81\x83 \xd8\xd9\xc6\xe4i\xd5L\xc1\xd9\xd0\x02\xa4\xb6\x06\xd9l\xec\x14\x04\x9c\xaf\xb7ZQ\x89=\xca\xb9\x8fdp\x83\x1a\xbd2\xc5\x14\x93\xcd9\x16Zh\xb2\xe0\xb0\xb2{\xec\x8d\x1dv\xd6\xba_\xc9\x8e\x01\xca\x07\xdd\xbc~}\xfbYT\xb5\xc6\xd7\xaf\xa7\xe0\x8f\x8f\x8fGQi8-w\xe3\x92p\xdd\xc5\xe5\xe5r0\x05\xee\x8b_\x1f\xdf\xd26\xb2\xcc\xb7\x196\xab\x03\x92_\xbd\x01N\xac</]\xbe\x06\x98.\xa4\xac{C\xd9):\xc08Y\x9e\xbe>\xe0?>>\xbe8y\xb1v\xb6F\xe7\xd5P\\\xdaqr\xf6\xd16\x93\xc4r\xc5\xd9\xa7\xad$\x90s\x95G\n\xda\x8f\xd5\x05\xf3\x98\xb2\xfaxuX;$4m\xdd\x9f@\x03/\x13&\xfa|\x16\\\x1a\x1dq\x05lt\xce\xf4`\x8f\xce\x87\xd8-\x8c\xfaw\x8f\x1e\xb2\x04o\xab\x85\xc7X[OG\xa8gFh\xd8\x0b\xdd\xe02\xf8E%\x8e\xe0\x90\xf7\x81\xc6\x9c \x86%\x94\xc1{\xeb8\xb4vv\x0d\xa5\xf75\xad\xaf\xaf\x0b\xe5;\xa2#mU5F\xf9\xe3u\xe0,j\xdbx\xeb\xe8:\xc7=\xeakR\xc5J8Y*\x8f\xd27\x0e\xafE\xadVAx\xc3Z\xa3\xac\xca\x7f\xd7\x95i\xba\x9aH\x9b\xac1<\x82\xa7]\xb4\x05\x93\x0eP\x9c\\\xda\xd7\xdb\xb3\x0c*\xe7G\xac\xa5\xbb\xdb\xfb\x07\xe8\x04\x98 Bk\xa6\xd6\x02\xc3\xab4\x18\x83\x15\xa7\xcc\x0e]\xbb2\xd4\x00FE\x93\xd7V\x19\xcf?\xce@\xa5V\x9c\x04\xa9\xd9V\xca\x138\xfcW\x83\xe4\xd9n\x19l\x02\x0f\xe4\xa4\xdc\xd4\\\x00\xf3\x0c\xbe3\xb0\x11\x15\xea\x8d lMq\x86\xf8\xdb\x99\x86-@+V\xf7\x97\x1b\xa7\x8f\xec\xe4\x0b\xad6GS\x1d\xe3\x9c\x8d\xac\x99\xcc}_\xa3\x8c\x0b\xb71\xd6r$\xe58\x16\xfc\xc0\x1e\x86\xc1\x115\x07\x96M\x04\x98K\x06\x10\xf3\xd1\xd9\xc3I6\xe0\x02\xebp\x87\x0eC\xb2\x15\xb3<\x10\x0e\xca\x97m\xf1H`\xc2\xb4\x96%\xd6\\\x12\xb5O{\xc9\x19\x80J\x99wh\n\xe6\xb1o\x16\x93\xb9\xe7\x0c\xdd\x0e\xf6_\xd6yz\x87\x15Lhj7f\xbd\x01\xce\x93\xf7s\xba\x1e\xabh\xac\xf6\xc9\x9c\xb7@\xc8	))\xedy\x81\x1f\xaek`\xcd\xff\x87\xf2\x0f\xb8-\xad}\xba\xf7Nx,\x8ei\xed\xefD\xa3\xfd\x1a>X\x83\xcfE\xc2\xd5?\xc7\x80\\\xbbv\xaah\x036n\xc6\xa1\xd91\x95C\xa9f\xcc\x13jf\xcb\xa7\x07\xaaT\x0dTIF#o\x8f\x91\x10\xa7\x19_\xca\x8c\x00\x0f%:\x04\xe1\x10\xfc\xc1Bm\x89\xd4Vc\xac\x81\xb0\xb31\xc7\xb7\xc27.\xd4l\xf8\x85\x15\x00/\xb7\xc7$dT\xd3\xab\xe5\xc4\x0b\x7f\x85\xee\xb5~\xc9\x1a\xa2\x96Z\xdai\x8dNC\xca\x12\xe5S\xe4\xa2\\\x94\x899\x82\xd8\x0b\xa5\x05K;\xc7\xa4\xa3\xb2\xd2\x90\x8ds\xbc\xa4g\xd1\xd9\x04f\"\x9bd\x1a\x19\xedF\x8b38\x80Sc&YqHy\x90\xbb\xe3\xca5\x06vZ\x14\xd9\xb4\xba\xf0@\xd3T\xe9\xd0Y\xcd9\x1e\xfb\xfcX\xf8\xc4\xa2\xd9\xcau)\x9eB\x9f!\xf1t\xcc3\x17_\x98\xe4\xb8>5g\x19c\\@\xd2\xce\xdb^\xc4O\xd8HP\xb5\xdd\xb2\x1c\xbf}\xe1\xb3\xa6\xed\x8c$\x93\xddX\xfc~)\x87\xad\x08\x9d\xa0 \x07{\xd00w)N\x13[\x84\x1c\xa0\x92\x89Xy\xac\x92bM\xd2\xd1\xccf\xbd\xbc|H/\x94!\xc8\xd1\x0b\xa5\xd3.\xde;y\x17=A\xf1\xc3U<\xbdIJ\xee\xe7\xeb7\x80\x16\xe4\x1f\x9c0\x14\xb4\xc6-\xbe\xf4A\xcf\xac\xf0\xee\xecEV {\x0cC\x82WU{C\xec-2\x0b\x0b\xe0[\x01Z\x96\xcb\xf9\x8b\x8f\xdc0o\x05a\xac/\xd3\xe4\xa4\x1d;\xeb*\xe1\xdb\xa6\xce\x8aw\x9d\xdd\xe7Bpv\xa3B\"Q|\xa9\n\xde\xb7\xab\xbbs\x97M%\x0c8\x14yH\x98\x11\x0b\x94\xc9\x95\x14~\xcae\xc7#:\x04\x88\xadm|\xd4`o\x95\xec[\x8e\xe4P\xd0\xf9\xf5r\xc6\x83\xef\xc2\xe2\xee@/\xb7N\xe1\xeeU\x84\x88\x85\xea\xc4\xa2Ws\x0e\xdc9\xd6\x7f\xeb\x0c\xe9\x9c6\x13\x851\x8d\xd9\xdd\xd8\xfd\x96\x10;#/\xaf\x1e\\\x83WK\xb8\xfa\x9b\xd0\x84W\xcb\x0b\x87\xb8\xfa\x87y2\xf6`\xae^}\x93\xfc\xc1\xf3f\x01F9\xe4\xe1X\xc7X?Q\xf4\xb2\xcb\x05\xfa\x08/\xaf\xee\xb8k\xf4M\x12]\xe6v\\{\xce\xd3\xc2\x8c\xf8\xab\xce\xcfg\xe7[\xef\x99\x9d\xa6i\xfb\xf7t\xac\xc2Y\x92\x93\x17Y~\xa7\x03\xe1\x9c8O\xf6_z\xc9:m\xb5X\xa7\n\xc5\xcd\x8dY\x12\xcfwM\xb5S2\xddr\xe1\xd1_\xe2ce\xefXe\xb6\xf8\xea\xe3}^q\xab\xcc\x19\xf4H\xabP\xaf\xdd\x1eWM\xeb\xad\xab\x9dB\x9d\xd3\x1a\xbck\xce\x957\xb0\xda\xc8\x996w7\xcf\xaa\xe3}\xe2\xa5\xd3\xdb\xd0\xa5\xebM\x02\x9b\xfb\x1f\"t(CO\xb8m\x0f\x7f\xf1\x05\xfa9.\xf1\xbfy3\xed\x88\xd6\xdf\xd1`{\x07x\xd6*\x0f%Be\x89;H\x92yv\xd1\xbf:\xd0\xb6pc\xc1\x93\x8f~\xd9b\xbe\x88*\xe3\xff\xfc\xa7\xc4|+87\xf8\x8a\xd1\xa7\x9fxQ\xe5\xef:\xcf\n{s\xfb\xf1\xeev\xf3\xf6\xe1\xf6&\x83\x8f\xfc\xc6\x94i\xc6\x1c\x07Z\xedP\x1e\xa5N\xc7\xfd\x98\x0d\xc5\x0e\x9a\xb4\x86T\xde7\xcb\xd8\xfdO\xf6[r7<\xf6\xdc\x93\x98mC;\x07A@\xd6\x1a\xfe;\\\x82\xa0BY\n\xa3\xa8b>R\x89z\xc8\xcb-C\x99\x11\x93]\x07\x0e%\x1a\xa8\x9d\x1a\xda\x82\x91\x92\x1d\xd9\xf9\xf9.\x06\xb1S\x1b\xcak\xae\xa8\xd6\x89\x8c\xc5\xa3n\\m	i\x19V\"\x07\x06ww\n@M\x08\x0d\x9d\xd4\x0bZ|U\x99\x9aI\xa5\x89\xc7\xadO\x8d\x12\x0by\xeb\x982\x8d\x9e5\xdb\xbe\xf3\xba^L\xaa8\xfc\xf2\xebb(\xe8BJ\xac=\xe6'_^\xb9'\xb8\x86\x17/F\x1fo\xc3\xcf\xe1\x80k\xf8\xf1'\xfe\xe4\xea\xad\xc3<\xb6\xc6i\x0d?\xfe\xb4\xf8\xcf\x00PK\x07\x08\xa7\xd9\xd0-G\x08\x00\x00>\x1f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x009\x00	\x00crd/bases/kubecarrier.io_customresourcediscoverysets.yamlUT\x05\x00\x01\x80Cm8\xecZ_s\xdb\xb8\x11\x7f\xd7\xa7\xd8q\x1f\x94\xcb\x98r\xd3v:\xad\xde<\xb6\xdb\xf14\x97fl\xf7\xfap\xbd\x99@\xe4J\xc4	\x04xX\xd0\x8e\x9a\xc9w\xef,\xc0\xbf\"H)\xb9\xdc[\xf1b\x8b\x04v\x17\xfb\xe7\xb7\x8b\x05\x17I\x92,D)\x7f@K\xd2\xe85\x88R\xe2G\x87\x9a\x7f\xd1j\xff\x17ZIs\xf5\xfcf\xb1\x97:[\xc3ME\xce\x14\x0fH\xa6\xb2)\xde\xe2Vj\xe9\xa4\xd1\x8b\x02\x9d\xc8\x84\x13\xeb\x05\x80\xd0\xda8\xc1\x8f\x89\x7f\x02\xa4F;k\x94B\x9b\xecP\xaf\xf6\xd5\x067\x95T\x19ZO\xbca\xfd\xfc\xfb\xd5\x1fV\x7f]\x00\xa4\x16\xfd\xf2'Y 9Q\x94k\xd0\x95R\x0b\x00-\n\\C\xea\xa5\xb0\xb5\x14\x99\xa4\xd4<\xa3=\x10:\xf2\xc4Sa\xad\xf4\xc4\x17Tb\xcaB\xec\xac\xa9\xca5\x1c\xbd\x0d\x04\x1b)\x85\xc3\x9d\xb1\xb2\xf9\x9d\x80\xf0<\xf9\xbf\xde\xba\xa4\xb4\xe6Yfh\xfd\xa4\xa8Z\x1a\x81\x1e\xd1\xf9IJ\x92\xfb\xc7\x89\x89o%\x85\xc9\xa5\xaa\xacP\xb3\x9b\xf4\xf3(7\xd6\xbd\xeb\xc4O \xb5\x99$\xaay\x92\xd4\xbbJ	;Gh\x01@\xa9)q\x0d\x9eN)R\xcc\x16\x00\xb5A<\xdd\x04D\x96y\x13\x0b\xf5\xdeJ\xed\xd0\xde\x18U\x15\x8di\x13\xf8\x99\x8c~/\\\xbe\x86\x15+{\x95\xdal\xc5f\xf2\xaf\x1b\x8bM\xfa\x0dO\x01p\x07\x16\x82\x9c\x95z\x17!\xeb\x84\xabhU\xe6\x82\x86T\x1f\xfd\x8b)\x1a,\xc4\x1a\x9e\xdf\x08U\xe6\xe2\x8d\x9fEi\x8e\x85\xf7Q\x1e\xa6D}\xfd\xfe\xfe\x87?>\x0e\x1e\x03dH\xa9\x95%\xefz\x0d\x17\xd36\x83Bh\xb1C\x82\xa2RN\x96\n\xa7\x1c\xa1f\xc8\xc3l~\xc6\xd4\x11l\x8d\x05\x01\x84\x0e\xcc\x16\x1e\xd1>\xcb\x14oTE\x0e-\xad\xe0?\x1a^\xbf\xbe\xfb(\x8aR\xe1\xeb\xd7\xf0\xe1\xc3\x87\x83(\x14\xf4\x02\xb5Gr\xe8\xd4W\xcd\x8eO\xfa&\xb4A\xdbh\xb4G45U\x9ag\x1b`\x9b\xf2\xfb\xd4f\xeb\x9eA\xeb\xd7\xb4\xaa\xffYI\x03\x004\xd8\xc7#*L\x9d\xb1}\xb2\x9f>\xf3f.\xda'\xa55%Z\xd7\x06]\x18\xf1}\x1e\x19f\xc9\xb6\x0b\xae\n\x19{\x14\x12\xb8\x1c\x1b\xf7\xc5\xac67+\xd8\xe5\x92\xc0bi\x91P\x07l\x1a\x10\x06\x9e$tm\x9d\x957\x08Z\xe2 \xabT\xc6\x00\xf6\x8c\xd6\x81\xc5\xd4\xec\xb4\xfcoK\x9b\xc0\x19\xcfT	\x87u\x00w\xc3\x87\x8b\x16\n\x9e\x85\xaa\xf0\x12\x84\xce\xa0\x10\x07\xb0\xc8\\\xa0\xd2=z~\n\xad\xe0{c\x11\xa4\xde\x9a5\xe4\xce\x95\xb4\xbe\xba\xdaI\xd7\x00qj\x8a\xa2\xd2\xd2\x1d\xae<\xa6\xcaM\xe5\x8c\xa5\xab\x0c\x9fQ]\x91\xdc%\xc2\xa6\xb9t\x98\xba\xca\xe2\x95(e\xe2E\xd7\xac1Z\x15\xd9\xef\x1a\x18\xa0\xe5@\xd6Q\x00\xd6\x9e\xc5\xa05c\x01\x065\x90\xc4\x8e\xec\xe3.\xec\xa2S4?b\xed<\xdc=>A\xc3\xda\x1bc@\xb4\x89\x8an!u&`\x85I\xbdE\xeb\xd7\xc1\xd6\x9a\xc2\xd3D\x9d\x95Fj\xe7\x7f\xa4J\xa2>V?U\x9bB:\xb6\xfb/\x15\x92c[\xad\xe0\xc6g'\xd8 Te&\x1cf+\xb8\xd7p#\nT7\x82\xf077\x00k\x9a\x12V\xecy&\xe8'\xd6n\x84\xc9\xc1[{T\x9al7\x111\x13\xe8\xf4\x88\xee\xb1\xc4\xb4\x8e\xaeM\x1dF\x19\x92\xb4\xec\xe8N\xb8\x06v\x9b\xc1\xc12Cm5\x98\x1e\x0fq\xa81\xe5\xe8\xd1\xb1\xc4\x0f\xb7`q\x8b\x16u\x8a\x04b\xb2\x00\x81\x17\xe9r\xa9\xd9\x19F\x14\xe1\x08_\x87\xe2M\xa3P3\x8e\xa1\xb1\x1b\x85\xd4oQ\xef8\xf9\xbd\x89N\x98\x88\xab0,\xfeR\xb1\x8a\xc7<\x13\xe8e\xd0\x93V\x87\xb3\x907\xa2\xdd\xc7\xe8\xa2\xa1\xc2\xe3\x19jD\x17\x18\x08	\x19~\xd8\x06\x93\x85\"\x18\xfd\xa5\xea/\x84K\xf3\xbb\x8f\x8c\x0c\xd4\xd5\x94\xb3ns\xbc$`\x14\x97a\xbc\x17%6\xa8\x80\xba\xedz3\x14\xa8\x1d\x8de\x0b\xe3)\xc7\xc6\\~\x1e\x08\x8bp\xfd\xee\x16\xb3\xf8\n\xe9\xb0\x18d\xb4I\x0f\xbf\x9e\x11\xa7\x86\xd6\xe6\x8d\xcb\x85[D)\xd65\xb6\x90\x9a\xeaDr	\x02\xf6x\x089\x87\x13[\x89V4D\xc0\xa2\xcfW\xdeR{<,F\xd4\xea!t\x9b\x98&\xe6L\x07w7\xf6x\x98R\xc5H\x1d{<\xf0\xaeY\xb0\xa0\x17~\xc0\x1b\xf7\x8fZU\x88\xb2T\x12i\x86*;\xe4\x94\xd0'#\xb3\x19\x8d\xd6\xce\x16\xbfUs\x97\xc9\x82!\x96\x9c\x86\x94/<(\x97%83C\x12\xba\xb8k\xca\x82\x1f\x84\x92YK>\xf8\xdf\xbd\xbe\x84w\xc6\xf1\x9f\xbb\x8f\x92\xdc\xbc:\xd8\x96\xb7\x06\xe9\x9dq~\xf6\xafVN\x10\xedl\xd5\x84\xe9l\\\xa1AX+\x0e\xbc\xbf~\xdd@+\xb8\xdfN x7\x1a\x1d0\xa5{\x0d\xc66:`\x07\xa9\x99\x04\xf2EE>\xd1k\xa3\x13,Jw\x98\xdb2\xd4\xbc\x07\xf4\xbd\xa2\x88y\xf45\xd7g5Kq(F\x10\x01\x9e\xb8\x14\x0doBM\xaa\xf8\xd8\x05Y\xc5\xcaf\xc3;\xcb\x07Q\x99\xce\x92.\xd0\xee\x10J\xc6\xb9\xb9]\xcd\xe2\xd0\xc4\xb9)>\xc24/\xf7\x04\xbf\xe9t\x16F2\x035I\xab\xf6	\xe2\x13\x05\xcf\xb9\xf2\xf9\x84\xf0\x96\x01e\xc2a\xfb\xa7\xdcS\x886\x9b\xd4G\x88\xd6c\xcd\x06\x17P\x88\x92=\xff\x13\xc3\xb3w\xa2\xcfP\n\xc9\xa7\xbek\x7fjW\xb1\n\x86G\x7fE(u\x06\xc4\x99\xae$`+<\x0b\xc5\xe9\xc3\x19\x8e5T>gM\x105\xdbQ\xb6\xbc\x84\x97\xdc\x90\xcf\x0c\xb0\x95\xa8|\x89\x7f\xb1\xc7\xc3E\xf0\xfc\x13\xa6\x92\x04\x17\xf7\xfa\"\xa4\x9eQP\xb6y\xcahu\x80\x0b\xaf\x80\x8b\xd5(\xc1N\xd0>\x91vg\xbdd\xe6\xe5\x0bnrc\xf6\x8f!\xf4\"\xd9*\xc3\xad\xa8\x94[\xc3;\xa3q\xbed]\xfe{H\x8cw\xbc\x95\xbb\x90lkF\xc4jg\xd5\xdc<\xdc\x12\xbc\xe42\xcdGD\xc3f-\xee$\x17g\x98u&\xe7\x9e\x03+	\xd2P\x8d\xc1\xe6\x10\xceFGEW\xd3\xe9\x19U\xe4mMc\xd13q/\x06JC$7\xaa\xb5\x16\xf7'<\xd1 ~e}\xee\x82O\xac\x00x\xb594*\xf9\xee2\"\xf9\xb0\xb0\xfc\x0c\xa3Ek\xa8\xb5\x04/R\xa9\xe0\x0bi\x8e\xe9\x1e\xa4\xcf\x006\x16\x03u\xeax\x16R	\x96t\xc8\xe5\x9aH\xee\xb4\xd7K\xad\xa9\xb4\xb2\x96\x7f\xb6\xcd\xad\x18R\x0e\x89\x1c\xc9\x95\n\xa5b6k8\x0c\x17G\xa8\xf3\xa9\x042{Hl\xa5a\xab\xc4n5<\xf4\xf1@]\x15c\x8fK\xe2\xae\x96\x9c\xe29	Nqx\xf6]\xc3\x01\x97d\xe2,\xb18#\x96\xf8\xacX\x1d!\xec\xf0<7\xe9\xa1\xa1\x97\xd7\xaf\x9b\xd8\x84f\xc3\xc2\xe0P\xc2\xc0\x07\xbf\xd9)\xd4\xe8\xd0\xe1\x8c\xa4\x86\xa1\xf0\xed\xc4\xe1y\x82\x9d\xa4#2\x1b\x8a#\x06\xde\xabe\xe4L4\x99\xb9\xcf\xd4g+l\x87\xb8\x19:!U\x1cZC\xb8w1\xd3\xee\xa7\xed\x9e}\x19\xb8\x9c:\x17(A\xee\xc9\nM^\xa1\xdc\xe8\x8f\xedu\xe4?oG\xcb\xba\xd3\x029p\xb2\xe0\xf6\x12v\xe2O\x10\x05p\x81y\xe8'1<\x05\xdf\x0d9\xd3\xb8<\xd6&hUU\x08\xb7\x06\xee\x1e%\xccq\x82\xc7\xc92\xa1@\"\xb1;o\xe3\xdf\x87\xb9\xcdn\xf3\xaa\x10\x1a,\x8a\xcc#aM	\xa4\xced*\\\xd3E\x8f\x8d\xda	@lL\xe5\xbc\x19\x1aU\xc8\xd8\xd1\xfc\xcc\xadX\x14t\xdc\xac\x9dp\xd9\x07?\xb5\xd9\xc8\xab\x8d\x95\xb8\xfd\xae&\xd0\xb9ac\xbf\xe5T%\x00\xdfL\xf6\x18hM\x84[\x0dRf;\x14\xf2\xd2;\x90\xd9\xc2\xab\xe5\x93\xadpy	\xcb\xbf	E\xb8\x8c\xe5\xc60\x96\xff\xd2{m^\xf4\xf2\xbb\xaf\xd6\xb9\x9f0\xb1x\x00\x12O\x87\x12#2\xd7\xa1\xae\x0e\xf0j\xf9\x80\";|\xb5,\xf1\xd4\xd2T\xfd\xe3P\x8f\x90H\x1a\x1f\x8e\xbe\x0b\xde\x11}E\xfd;\xa0\xfeH\xbc\x82\"/\xce(\x12c\xc7\x9c\xae\xee\xaa\x13#\xd7n\xeb\xc5\xac\xe6\xbf\x8f\xad\xe9\x00\xb9\xad\x00\xb9\xdf\xcf\xa0\xc2x;\xd1\xe5\xf1w\xa2\x98\x85Zo\xf2\xa2\xa9\xdeV\xed\xa2_\x88\xda\xbf:\xe5<\xdc>4\xad\xc3A\x13q\xa4$\x1e\xcd\xb5-f\x93\xb7\xca_\x93X\xa2=\xe5HP\xfc\xd3\xf7\xce;y\x87\xcdo%\xf5\xbe\x97\n\xa6\xbc\xa5\x19u9H\xa2\xc0\xf9j\xf3t\xb7\xf3T\xcb\xf9\xcc\xc6\xf3Yq{*vgZ\xd1}\xfa3\xca\x19\x96\x92g\xa1\xd5\xff\x0d\xf3\xdb\x1bf\x1e\xb0\x8fO\x04\x0d\xbf\xa11\xbf\x1d\xb26E\xfe\xdfQs\x0fH\xc6\n\x89aB\xcb\x11\nC\xdc\xc7N\xb9X\xdd\xb5\x0b[Z\x0dNv\x9f\x9e\x8c\xad\xde\x14rR\xbb?\xffi\xf46\xc8\xcbW\xb8\xbb\xfa[\x8fn\xf8O\x11N\x08y{\xf7\xfe\xe1\xee\xe6\xfa\xe9\xeev\x05\xefy\xfe\xf1\xd9\xa6)\xb5\x95\xdcbzH\x15F\xaf\xda\xea\xbbi\x7f\xa8\xa8oIS\xa3If\xed\x85\xa8\xef\xd0t\xdc.A\xbapz\xdd0\xcb\xc2\x8c\x8fO<\x04\x01\x19\xa3\xf9/\x17\xbbX\xf7\xa60\xcd\x85\x96T0\xf8qC\xa9\xad\x17Be\\c	\xbc\xe4\x18K\xc7\xa5\x95\xdd\xa5o\x1dN\xbe\x9f\xef\x0f\xf8uG\x9c\xf5\xce\xe8_*q\x80\xb2\xb2\xa5!\xa4K~\x1a\xa1\x88\x9c[\xf8fo\x07\xa8\x08\xa1\xa2^qO\x8b\xb3\x0b\x95h\xd2\x1f=\x0c\xbe\xb3\x06g\xab\x00y\xe4\x8c\xe5\x12\xbd\xf7\xa4\xda\xb4w\xe8\xeb\xc5\xa0\x82\x84O\x9f\x17]1)\xd2\x14K\x87Y\xef\x13\x1d\xbe\xf5]\xc3\xc5\xc5\xe0;\x1f\xff\xb3\xdb\xd2\x1a~\xfc\x89\xbf\xcbq\xc6bV\x7f\xde@k\xf8\xf1\xa7\xc5\xff\x06\x00PK\x07\x08\xf8\x87Q3x	\x00\x00\xab%\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x007\x00	\x00crd/bases/kubecarrier.io_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8\xdcY_\x8f\xdb\xb8\x11\x7f\xd7\xa7\x18\xa4\x0f\xce\x05+m\xb7-\x8aVo\x8bMZ\x04Mz\xdb]\xf7^\xee\x0e\xc8X\x1a[\xbcP\xa4\x8e\xa4\xbc\xe7\x16\xfd\xee\xc5\x90\x94,Y\x92\xbd\x9b6@Q\xbe\x18\x96\xc8\xdf\x0c\x7f\xf3\x97T\x92\xa6i\x82\x8d\xf8\x8e\x8c\x15Z\xe5\x80\x8d\xa0_\x1c)\xfeg\xb3\xcf\x7f\xb0\x99\xd0\xd7\xfb\x9b\xe4\xb3Pe\x0ew\xadu\xba~ \xab[S\xd0[\xda\n%\x9c\xd0*\xa9\xc9a\x89\x0e\xf3\x04\x00\x95\xd2\x0e\xf9\xb1\xe5\xbf\x00\x85V\xceh)\xc9\xa4;R\xd9\xe7vC\x9bV\xc8\x92\x8c\x07\xefD\xef\x7f\x9d\xfd&\xfbc\x02P\x18\xf2\xcb\xd7\xa2&\xeb\xb0nrP\xad\x94	\x80\xc2\x9ar\xb0d\xf6\xa2\xa0B\xb6\xd6\x91Ak\xc5N\xd5\xa4\x9c\xf5\xd0\x05\x1a#<tb\x1b*X\x85\x9d\xd1m\x93\xc3\xc9\xdb\x00\x17u\x0c\xfb{\x0c\xc8w\x01\xf9\xb6G\xf6\xdb\x90\xc2\xba\xbf\x9c\x9d\xf6A\xd80\xb5\x91\xadAyFS?\xcbV\xda\xb8\xbf\x1euH\xc1\x16\x18\xde\x08\xb5k%\x9ae\x84\x04\xc0\x16\xba\xa1\x1c<@\x83\x05\x95	@$\xd3\x03\xa6\x80e\xe9\xcd\x83\xf2\xde\x08\xe5\xc8\xdci\xd9\xd6\x9dYR\xf8\xc9ju\x8f\xae\xca!\xb3\x0e]k\xb3\xa6BK\xfemG\xf6\xa3\x7f\x11\x1f\xb9\x03K\xb4\xce\x08\xb5\x9bbtN\x90M\x0c8B\xbc\xddu\x12\x02\\\x89.<\x08\xd6\xdd\xdf\xa0l*\xbc\xf1\x8flQQ\xed\xbd\x8a\x87nH\xdd\xde\xbf\xff\xee\xb7\x8f\xa3\xc7\x00%\xd9\xc2\x88\x86\xf7\x9a\xc3\xab%\xf3\x80\xb0\x10\xbcE\xa8\x1d\xe0\x919\x10\n\\E\xf0\x11\x15\xee\xa87x\x18\xd1\xcd\xe0I\xb8j\xb4H\x87Eci\x19\xfc\xa0`]\xd1`^\x00\x8f[\xe01^\x01OBJ\xd8Pp{*\x01[\xa7kt\xa2@)\x0f\x80\xaad\xbd\x0d5\xda\xf0\xdb\xa8\xaaP\xd6\xa1*\x86\xb0\xd1\x84\xac\xc0\x9b7\xef~\xc1\xba\x91\xf4\xe6\x0d|\xfa\xf4\xe9\x80\xb5\x84a\x94\x8f#\xe1\xba\xa3<D\xc1\xa2\xa6\x03&\xfbx\xef\xfd\x84\x82\xc0\x9b\x8c\xda\xf4\x89\xacKo\xc0\x87\x1f+6\xda\xef\x10\xff\x87\xa3\xd9\x8f\xcb\x00\xea\xde\x0e=\x8b9\xc0D\x14o\xedU\x8f\xd6\x18\xdd\x90q\xa2\x8b\xa60\x06\xbb\x1e\xc8=\xf1\x98\x15;U\x88\x1c(9\xa9\x91\xf5\xa6\x8d\xd1De\xf4C\xd0[pU\xb0\x86!K*\xa4\xb9\x110\xf0$T\xa07?Q\xe12ol2\x96\x83\xbd\x95%\xe7\xc2=\x19\x07\x86\n\xbdS\xe2\x1f=\xb6\x05\xa7\xbdP\x89\x8eb\x1a9\x0e\x1f\xbd\n%\xecQ\xb6t\xe5\xbd\xa2\xc6\x03\x18b)\xd0\xaa\x01\x9e\x9fb3\xf8\xa8\x0d;\xdfV\xe7P9\xd7\xd8\xfc\xfaz'\\\x97\xd3\x0b]\xd7\xad\x12\xeep\xed\xd3\xb3\xd8\xb4N\x1b{]\xd2\x9e\xe4\xb5\x15\xbb\x14MQ	G\x85k\x0d]c#R\xaf\xbab\xc6lV\x97\xbf2\xb1\n\xd8\xd5H\xd7I\x8a\x08\xe3\xd4\xb3N-\xc0\x89\x95\xfd\x1c\xe3\xd2\xb0\x8b#\xd1\x1c\xb1\xcc\xce\xc3\xbb\xc75t\xa2\xbd1F\xa0\x10y?.\xb4G\x130aBm\xc9\xf8u\xb05\xba\xf6\x98\xa4\xcaF\x0b\xe5\xfc\x9fB\x8aq\xfc\xf3\xb0\xed\xa6\x16\x8e\xed\xfesK\xd6\xb1\xad2\xb8\xf3\x85\x8e#\xb7m8\x87\x95\x19\xbcWp\x875\xc9;\xb4\xf4\xd5\x0d\xc0L\xdb\x94\x89}\x9e	\xfa\x98\x9d\x99\x1cX\x1b\xbc\xe8J\xe7\x82\xbd\x96\x12\xc3cCE\xb4\xec&\x06QIV\x18vs\xd7\xe5\xf9\xe3\xd0\xdbE\xa4l$|>\xb8a!W\x9cL9\xd1\xfd\x81\xb6dH\x15Q\xbf\xe8J\xaa[\xdde\xd8c\x12\xeaJ\xc0X\xa5\xe5\x9c\xd3\x0d\x86\x9c{\x0eP\x0b\xf5\x81\xd4\x8e+\xef\xcd\xec\x84\x85(\n\xc3\xd0\xcf-S:\xc5N\xbd\xcc\xc9\xe3\x05\x1b\xc3\xd9\xdc|\x99\xb9\xb1\xe9\xfe\x1f\xd9q\xa4P\xb9\x0b\xac\xac\xfd$\xd0O\xaaKR\xc7>\"\xbau_\xc62x\xefl\xcc\xd0\x13T\x004\x04\xb8G!q#\xa9+\x08\xfd\xe25\xd5\x0dW\x07\x0e\x9b\x99\xcec\x82\xb7\x1c4<$nH\x8ej\xe5q\x0c\x1b\xc7s\x18\x17]uB\xd6\x07/\xb5\xdbA\xe0\x17n\x8bB\xb7\xa7!\xff\x0c\xf3\x9cw\xa3Q\xd03\x89/\x97\xfa\xd5]l\x1e)\x9d\xcbj#Y\xe9I\xe0&\xcf`\x8c\x13p{b\xf0\x11GK\xa98\x1c\x01\x06%\xd5\xb3\xa87\xac\xc2\xd7K\xec\x85V\xe1\xf42\xe3\xa3#\xb5\xef\xfa\x89\xa1\x81\xe0s\x1a\x9b\x1a\xa5\x1c\x80\x84\x9a\xbf\xa4\xd4D\x020\x96PS\x97\x14\x8e\xea\x19\x8dNtZ\x92\xd3\xeb\xca\x9a9\x14\xcaBI\x0e\x85\x9c\xcb\x06\x00[\xcd\xbd\nA\xd1\x1a\xc3\x07\x98~;}'\xba$h\xaa\xf8\xa5j\x05 \xd1\xba\xb5Ae=\x9b|\xfa\x9e\xdb\xe7d\xaf\x1f&\xcb\x98;v\x11\x06\x04'jn\xd4\xe8\xa8\xfc\x02(\x80\x0b\xc2Cg\xa6\x15E\x97\xe5D\x88J\xbbj\xae\xc8\xf4D\xd5\xe8\xc2Y2e\x89\x0b2\xce\x865\x8f\x9a\xac\xc5\xdd\xf36\xfe1\xcc\xedv[\xb55*0\x84\xa5\xcf\xde\x11	\x84*E\x81n\xaeTu#\xba\x00\xe0F\xb7.\xb2\xd6\xdb!\xfb\xd2\xad\x18B{z\xecYp\xd7\x07?\xb5\xdb\xc8\xeb\x8d\x11\xb4\xfd&\x02\x1c\x9d\xb0\xb3\xdfj\xdeY\x01\xe0\xbf\xa6\xfb\\\xaeZ\n5?\xb5\xcb\xed\xbd\x92W\xde\x81\xf4\x16^\xaf\xd6\xa6\xa5\xd5\x15\xac\xfe\x84\xd2\xd2\xeajQ\xf9\xd5\xdf\xd5g\xa5\x9f\xd4\xea\x9b/\xe6\xdcOXX<J\x10\xebCC3:\xc7@\x97\x07x\xbdz ,\x0f_\xac\xcbr\x8d\xe2[\x9bi\xa8\xcf@\xa4\x9d\x0f\xcf\xbe\x0b\xde1\xfb\xca\x0e\xef\x8c\x86#\xf5\x04%/,\xf2\xe1%\x1a\x83\x87\x93\xa5\xea\xb45\xca\x93\xb3\xacO[\xa9p\xaf\xc2\xd5\x81\xe3XK\x7f\xa0\x87FKQ\x08\xb2\x80M#\x85\xbfY;\x1d\xb19\x1b\xe7\xdf\x1e?K^\x96|\xc3\xce\x17\x1c~\xe48\xdf\x86\x99\xbe\xca\x05\xb5\xbd\xb2\x87\x0e\xa2\xbfF\x12\xeae\n\x9e-n\x93\xa4\x7f\x1b\x88\x99\x10\xfamw\xfc\xee\xcfX\x18\x15\x9c\x1588\xb1wz\xfb\xe4?\xb8g\xebL\xbb\x14\x07\x97\xaa\xda\xfc\xed\xc3\x19\x86\xfdUD\x0c\xcd@\xea\x15\x88\x8c2\xe8\xee\xbd\xff\xd6j\x87K\xea<#4/\x9d\x0e/\xb4\xaf\xf1f\xe9?\x93\x7f.=\xf0H=g\x0b2\x16\x8e\x99\xcf\xea\xd7\xcf\x85\xf3\xf4@\xfagRd|L\xe6\xc9E\xc3=.,\xed\xea\xda\xee\xf8$P\xb9\xb0\xbd1\xce\x15<U\xa2\xa8\xa6\x0e	O\xd8'\x88ykt]\x89P\xee\xf7\xbf\x9b\x9d\x11\xc8\xe2\xdb\xbd\x1d\x99\xe4\xf99<]\xe4)y\xc1\xf9\xa9k\xe1\xcf\xb1<b\x98/\xb6km\xf9z\xad\xe0~t\xc8h\xc4\x82\xcd\xa1\xeb\xf6\xe2G\x9f,y\x19/\xe78\xf1\xdf'.(\xf9\xf6\xdd\xfd\xc3\xbb\xbb\xdb\xf5\xbb\xb7\x19\xdc\xf3\xfc\xd3\x93K\xd7MK\xb1\xa5\xe2PH\x9a=\xc2\xc4\xab\\\x7fl\x88\x97\x8a\x85VV\x94\xfd\xfd\xa1 Y\x0e\xa4]\x81p\xfde\xbe\xa1Z\xefg\x0b\x07Z\xb0Z+\xfe\xe5\x8e\x96\xcb\x0e \xd4TT\xa8\x84\xad\xb9\xb0\xd4\xd8\x1c\x1b\x99\xd0\xfe\xc6h\x86\xa7\x8a\xd4\x0ch\xc3\xdfw\xba\xeb\x87\x98\x11\x0f\x8c\xac\x95<@\xbc\xacf\xde\xa1\x14\xb6\x91x\x80\xa65\x8d\xb6d\xaf\xf8\xe9\x0c\"\xed\xc9\x1c\\\xc5\x98$-Ak\x07\x1d\xbcM^\xd0\x8d\x8c=\xb5\x0f\xa3\x0bV|\x9c_5\xac,\xae\xa2\xc9\xe7\x98	\xe8\xe4s\x8b\xab\xd0\x85\xd0\xf5\x87\xa5\xb9\xd8=_R\xfe\xf7\xaf\xf5f_L\x1e\xb2]\xa8\xcc\xc1\x996\xd0f\x9d6|\xf6\x19<i7\xfd5\x7f\x9e\x8cZs\xf8\xe7\xbf\x92c\x97\x8eEA\x8d\xa3r\xf05\x93\xebG\x0e\xaf^\x8d>\x88\xfa\xbfG7\xca\xe1\xfb\x1f\xf9K\xa6\xd3\x86\xca\xf8\x05\xc6\xe6\xf0\xfd\x8f\xc9\xbf\x07\x00PK\x07\x08D2\x10\x8e\xa3\x07\x00\x00\x99\x1e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00-\x00	\x00crd/bases/kubecarrier.io_serviceclusters.yamlUT\x05\x00\x01\x80Cm8\xec}[s\xe4\xb6\xd1\xe8\xfb\xfc\n\x94\xceW%\xc9\xd6\x8cv\x9d\xef\xa4\xe2\xc9\x83KGR\x12\x95\xf7\xa2\xa3\x95}\x1e\xd6\x9b2\x86\xec\x99\x81E\x024\x00J;\xc9\xe6\xbf\x9fj\\x\x19\xde\xc0\xd1\xda\xb1\xf3\x11/\xbb\x1a\x82\x8d\xeeF\xa3oh\x80\xb3\xf9|>\xa3\x19\xfb\x1e\xa4b\x82/	\xcd\x18|\xd4\xc0\xf1/\xb5x\xf8\x93Z0q\xfe\xf8r\xf6\xc0x\xbc$\x97\xb9\xd2\"\xbd\x03%r\x19\xc1\x15\xac\x19g\x9a	>KA\xd3\x98j\xba\x9c\x11B9\x17\x9a\xe2\xcf\n\xff$$\x12\\K\x91$ \xe7\x1b\xe0\x8b\x87|\x05\xab\x9c%1H\x03\xdc\x0f\xfd\xf8b\xf1\xd5\xe2\xeb\x19!\x91\x04\xf3\xfa=KAi\x9afK\xc2\xf3$\x99\x11\xc2i\nK\xa2@>\xb2\x08\xa2$W\x1a\xa42\x00#*%3\x00g*\x83\x08\x07\xdeH\x91gK\xb2\xf7\xd4\x02\xf1\x98Q\x0d\x1b!\x99\xff{N\xa8\x19\x07\xffWyo\x9eI\xf1\xc8b\x90\xa6\x93e\xc5;\x8b\xc4\xa5E\xc2<H\x98\xd2\xdf\xb6<|\xc5\x946\x1d\xb2$\x974i\x10`\x9e\xa9\xad\x90\xfaM\x89\xda\x9c\xa8\xc8>`|\x93'T\xee\xbf6#DE\"\x83%1oe4\x82xF\x88c\xa7\x812'4\x8e\xcd\x04\xd1\xe4V2\xaeA^\x8a$O\xfd\xc4\xcc\xc9OJ\xf0[\xaa\xb7K\xb2P\x9a\xea\\-\xb2-U`\x9ezv\xbf3\x0f\xdcOz\x87#*-\x19\xdf\xb4\xc0\xc8 ZD\x82s\x88p\xd4\x1a\x98\xcb\xfd\x9f\x03@y\xb1Z\xc4Le	\xdd!\xa55\xa0W\xf6wRy\x10\x02\x96\"/8\xe5\x11,$P\xb5\x87\xe9\xeb\xf2q\x18L\xc3 #\x86\x92\x83\x06\xe5V\xd3b\xc3\xb4\xfbo\x0d\xfe\xb7EGR\x7f\xdc\x8fz\xc1\x8c\xc6\xfa\xa8A\xbf\xd8\xd4\xb1\x8e\xa9\xb6?X\xe2\x1e_\xd2$\xdb\xd2\x97\xe6'\x15m!5\x8b\x16\x9b\xc8\x80_\xdc\xde|\xff\x87w\xb5\x9f	\x89AE\x92e8\xa5KrT\x17n\"!\x93\xa0\x80kEhA\x9a\"na\x10	\x1b\x86\xff\x81\x980\xae\x85\x1b\n\x1b\xf6\xbdt\x0b\x93\xfc\xc0\xc9\x17_\\\x7f\xa4i\x96\xc0\x17_\x90\x1f\x7f\xfcqG\xd3\x84TUS}!\x9f\xd7\x08!\xa4ke\x12\xcf\xb6e!\xd0\x90\xcf\x9f@\xe9\xf9K\x82\xd2\x80\xbfW\xfb\x10R\x11\xb6\x92\x07\x84\\\x7fG\xfe\x1f(M^\xe2H\x88\x8b\xe0k\xb6y\x07\x91\x04\xbd$\xa4	~^\xf6B\x82\x90\xc6:\xef\xfc\xa2\xc2\xa6\xb7T\x13*\x81p\xa1\x89\x04\x1am\xe9*\x01\xb2\x96\"%z\x0b$\xa5\x9cn \x05\xae\x89S|$\xa2\x9c\xac\x00\xd5+\xae7\x88\xc9#\xa3\x15\x88\x94\x13\xba\xc1\xfe\x8c+\x0d4^\x8e\xe1j\xb7\x96\xb3\xad\x85\xab\xf1\x06*,uX\x19\x91\xb90h\xfc\xf8\xe3\x8fG\x05z\x99\x14\x19H]h^\x87r9\xdd\x15B\xf6\x04\xf0\x18e\xd4\xf6\"1\x9a P\x86CN\xf3A\xec\xc4\x9a\x885\xd1[\xa6J	\xa5\x15\xed\xe3\x9bX#\xa3\xc4\xea'\x88\xf4\xc2\xd0\x0bR\xa16\xce\x93\x18\x89x\x04\x89\xf3\x11\x89\x0dg\xff(`+\xa2\x85\x194\xa1\x1a\x9cv/\x1b\xaa\x0f\xc9iB\x1ei\x92\xc3\x19\xa1<&)\xdd\x11	8\n\xc9y\x05\x9e\xe9\xa2\x16\xe4\xb5\x90@\x18_\x8b%\xd9j\x9d\xa9\xe5\xf9\xf9\x86io\x81#\x91\xa69gzwn\x8c)[\xe5ZHu\x1e\xc3#$\xe7\x8am\xe6TF[\xa6!\xd2\xb9\x84s\x9a\xb1\xb9A\x9d#\xc1j\x91\xc6\xffK:\x9b\xad\x8ek\xb864Ne!\xf5\xcc\x00Z9\xc2p\xc1\xdbW-\x15%\xa3\xf1'\xe4\xce\xdd\xf5\xbb{\xe2\x876\x93Q\x03J\x1c\xdf\xcb\x17U9\x05\xc80\xc6\xd7 \xcd{\xe5B\x00\x1eg\x82qm\x06\x88\x12\x06|\x9f\xfd*_\xa5L\xe3\xbc\xff\x9c\x83\xd28W\x0bri\xdc\x12\\/y\x86*1^\x90\x1bN.i\n\xc9%U\xf0\x8bO\x00rZ\xcd\x91\xb1aSP,\xb0\x96\xce\x96k\x95\x07\xde\xe5\xe9X1u\xad\xf3.\x83\xc8\xad\xa8\x95[:1(\x86\x1a\x1a-\x19\x98%\xb1\xf7\xce\xa2\x06\xbc}\xf1:W\xcf\x19\xf9:\xde\xd8bX\xd3<\xd1K\xa3\xf8\xad\xf2l\xe9S\xb14\xa5\xc7`\x08dk\x06\x8al\xc5S\xd5rx=\xe3\x17d\x03\"\xe9\xa5\x04\x1b\xf0<mb;\xefCsnUZ\xe3\xf7\x8e\xe5\x84m\x0b4\xd1\xdb[)VM\xae\xedM\xd7\xdf*]\x91\xbe5\xdb\xe4\xd2\xcdT\xb4\x85\xe8A\x9dYs\x11C\xc4b O[\xd0[\xe7\x9c\xd6\x1b\xbeQ'\x1fW\xadEewFV\xb0\x13<6\xbd\x9c\xc5a	\xd3;\x14\x00\\?\x17\xb77-0\x11^\x1b\x1b\xbb\x85\x02[J?^\xdc\xde\xbc\xa2\x1ax\xb4k\xd2\xdf\xf02^W\xfb#\xd2\x88dJ?\xb24O\x89f)j\x13@\x0c\x1d>\xad\x10\x89Q\"\x9a>\x00J\x87\x04\x95	\x1e/\xc8\x95\x15D#2\xff[5)\xe9Y\x95\xbe\xa5\x8c\xdf\x01\x8dwoD\x0c\xea\x16d\x04\\\x87P\xd5\xf2ZA\x1c\xe3\x86\xb8\xccB\xa3\x1b\\\x88\xad 	1\xc3:!\xe0\x001R\xb2\x02bP\xda\xa3\xefE;}k!S\xaa\x97h\xaa\xfe\xf0Uk\x0f\xc7\xec%y\xf9\xe2E{\x07\x8b\xf0\x92\xb4?\xb6k\x01M\xe1\xa6uz\xd0\xfeS\xb4b\x01|{\xeb\xfb\x9a\x18\xcb\xae\x84+\xc8\x12\xb1C\x87H\xa1\xc0\xa2|\x14 [!\x12\xb2\xa2\xd1\x03\x9a\xa6\xae0V\x11\xc6[\xd6\xcc\x82\xdc\xb7*\x16l%\x16\xde\x93p8\x98yQnb\x9c\x87l\x1c\x01\xfaHY\x82\xbe\xddY\x07\xc8\xa7-p\xb3\xfe\xba\xd0D\x89\x89\x99\x8a\xc4#H\x88\xdb\xe7\x97iH;8\xbb\xc7\xdb:\xad\x9e\xd3F\xfb\x10	k\x90\xc0#\xa7|Jb;\x00\x93}&\xb4\xf3\xb3\xe3\xed~\x0d\xe2\x0c\x8c\x8c\xbb\xa8j\xe8\x90\xcb\xbb\xab}\n\xbaX\xda\x03\x92\xd4D\x0by\x8f\xde\x1b\x8fX\xc2\xf8\xa6\x8b\x94n\x17\xb7\xd9\xf8^\x9c\xd1\xd6R\xc6_\x01\xdf`\xa0\xfe\xb2g\xc8\x00\xcd\xe5\x9b\x84\x9fs\xb4\xf9}\xb8\xcd\x0dn=\x1d:\xdc\x91z\x8b\x0b\xa9\xe9\x1b\xab&\x93\x95U\xb57\x81\x95'v\xd1\xf7\x80$\x85B\xf8\xfdN\x93KWav\xa7\x1f\xbd\xdf\xac\x8c\xccK\n\x9e'IC\x12;'\x91\x8c;\x86\x98W\xa4pv\xa0,[\x14\xa9\x94t7\x1b\xb1\x10\x1a\xa9\x82Y\xaf\xe8\x97^\xa7\xcd,T\\_\xd4C\xe5c4-\xb92\xde_\x9b\xfar~1\x9a;\x17\xaa\xee\x1b\xb5;\xc7O\x03a\x1f\xb8\x7f\xbd\x11/cc\nGn\xb1<\xfd\x1a\xbc{\x05\x0d\x8an\x8fc\xdd'\x18\x1d\n\xacGmUR\x83\x03\x13U\xc9\x12\x92H\xc8Xp\xd5\xc2\xe63\xa2\x04\xe1\x82px2y\x18L*\xb6\xb9'\x98\xf91y=\x93'#L/\xc8\xf5G\xa6\xcc\xfc\x15\xef\x99^J\xb3$!\x0f\x90i\xb4\xaej\xc7\xa3\xb1\xf3\x00\xfb\xc1}\xab\x0e\xbe\xe6\xb1\xf7\xab*L!O\x8c\xc7\xe2\xc9\xb8D\xb5\xdfY\x1bU\xd8\x18'\xb0^\xdb\xbc\x87f	a\xfa\x18C\xf3T<B|F\xd8\xda$\xbc\x14\xe8&\x15U?\x15C\xf69\xfa\xfd\xe3\xc5\x03\x05\x04s\xbc\x014\xdf\x99\x8e\xde#\xdf\xe6)\xe5\xf8r\x8c\xee\x9a\x83\x82\x18\xed3e1^f	\xc6\xda2$fx\xa7\xa9\xd4#f\xa2\x15\"A\x9a\xca\x99`i\n1\xa3\x1a\x92\xdd\xaf2\x05}\x0b\xae5\xcf\xd1\\m\xae\x1bb\xe3\x13\xb4{\xde%\xb9\xa4\x9a&b3V-U\xb9\xdd\xf2x\x0f\x91\xab\xb2wMN\xe6\x85\x9cT\xbawGp&\xa7UW\x15\x07I\x91c\xc5\x9bN\xd5ZG\xbe\xec\xdd\x81<\xea\xe8\"q\xfal\xfcz\xe6\xbdp\n\xee!\xcd0\x8b\xba\x9c\xf5\xa2\xfef\xbf\xbf\xa3\x0cSXL\x89\xc4\xe4vI&\x12\x161\x1f\x1b\xb70\x145(\xcd\xb2\x84\xd9\xa0\x19\x1eA\xeeJ\xd8\x84*\xc56\x98B\xd6\x82PbV\x94Q\xb3A\xd3\xd5o\xfd\x1a\xdb\xa2\xfb\xad\xbaW\xd7\x07h\x90\xeb\x0d\x99\xbd(\x87\xb6\x0c\x88cK\"\xea\xb1\x82\xf8\xbe\xf9md\x1c}K\xe8\n\x92_\x9d\xa0Wf\xd4\xcfN\x0bK\x99\xbe\xa3|\x13\xb2\x94^\x15\x9d\xd1WRZR\xe6\x1c\x80\"\xd5\x8e\xeb\x08wo\x13p\xfc\xeb1\x91\x01\xd8\xf7\x8bWA@g\xd4\xbf\xcfC$@y5\x80y\x15\xc4\xb7$\xebFC:\x80wm\xdf\n\xf8Z\xc8\xa8+\x1f1\x98\x93h\xc7\xaf\xc4\xc4\xef\xf4Pt\x16\xcfS\xfa\x91\xe4\nsdf\xd2z\xf0#\xc6bP\xbe+\xe6\xc5\xa2\x9cR\x1dmA\x11\xc1\xcdVW7\xd6C\xeb\xba\x99\xe0\xee\x015vY\x94\x8d\xf2\xdd\xdb\xf5\x10h\x0ct\x86\xf2n\xed\xfd+;\xfa}-\xa3Z\x83\xe4K\xf2\xf7\x93\x1f\xbe\xfc4?\xfd\xe6\xe4\xe4\xfd\x8b\xf9\xd7\x1f\xbe<\xf9aa\xfe\xf3\xc5\xe97\xa7\x9f\xfc\x1f_\x9e\x9e\x9e\x9c\xbc\xff\xf6\xf5_\xefo\xaf?\xb0\xd3O\xefy\x9e>\xd8\xbf>\x9d\xbc\x87\xeb\x0f\x81@NO\xbf\xf9\xafA\xca?\xce\xcb\x0d\xf89\xe3z.\xe4\xdcR\xb5$Z\xe60\xeb}\xbb\xbe6\\\x06\xb5\x14\x19\x17b\x98\xbc\x83\x918\xbb\xe35\x88\xd4\xaa\"vh\xf1\xd0\xd9*~\xb0\x80\x98\"\"eZ\xf7\xad\x9c U\xdc\xd8i\xc1\x00\x0f\xd4$\x8f\xff1\xf2\xe8&\xd4\xebl\xb7\xa1V\x08\xd4 \xb7\xaaR\xec6G\xdd\xcem\x9f\x9c\x06\x81\x05\xf5\x8bHrJ?\x0e\xc9\xd8\x18/cR\xa7\xff\x16u\xfa\xba\xb0\xd6\x85\xab\x84\x9b6\xce\xadF\xf3[\x15\xc0A\xd4P@?\x9b\xaeL\xe9G\xeb\xf3\xd8\xf5p\x87q\xc4$r\xff	\"\xd7\x98V4\xbe>\x9b\x1a\x9f\x0dn\x1exQ\x8bK\xd5\x98\xe6J\x93-}\x04B\x0b\xad\x87{z\xd6\x92w\x84\x9c\xf5\x86\xae\xf2J\xe8-\xe1\x82\xcf\xff\x01R`\xfaU:?\x96\xc4\x0c\xebL\xddr\xf0ZuP\x04\x12P\x18z`\x1d\x93$\xf0sN\x13\x1f\xdcam\x03n\x81\xf8B\xa3?\xefUD\x0d\xc3v\xfb\xeed\x95K\xa5\x8b\xe4W\x9d/\x9fo12\xbe\xec\xed0y\xcf\xbf\x03\xef\xf95\xe3\xbfYuo\xcc\xf3\x18b\xeew\x99I\x88\x15:\x00W\xb9\xa5\xc4\xaez\x9bM\nXH\"\x8c\x88A\xcf\xd7yq\x1d9\xfc\xaa`\xce\x9e\xb9$\xfbv\xbc\x861\x99[\x06\xa9\xd9\x01\xe3s\xd0OB>\xdcb:/\xa4\\\xe8M\xb5?\xce\x95\x96,r\x85\"Z\xd2\xf5\x9aE\xa8\x12QW\x9bB\xc2[\x11w\xcd\x18\xe3!i\xb1\xe1\x9c\x00l$\xa8\x9e\xc0\xbe\x86\xff+\x97~\xb1/\x11\x99'\xe0kG*\xd9J\xc4KAb\x8a};\xe1\x12\x92\x89X-\xc8\xdb\\o\x04n\x1fy\xfa\xb1^3I\xc4\x13\xee0\x99\xadsL\x85\x9a\x82\xe3\x1a\xfb\xfae\xd9\x0e\xef\xeb;3\x11\x93\x13d\xaa/I6\xf9\xd7\x1d\x11X\x8f\xf6\xc4\x14\xe0y\n\xf1\xa4\x06l\xad\xc3\xf0\xf4\x8c\xbc\xbds\xb8\x15X\xfb<\x0d\xd5$\x01\x8a\\\xe2\xe0\xd8\xd4\xc3\x02d \xa1\x91\x14\xca\x10\xed\xb7O\xaat\xee|r\x8b<m\x852\xb4\xbc3\xc4\x89\xbe(\xd5\xa3\x83S\x91	,\"u\xb9\xf25\x83\xc4\xd4\xc4B\x9a\xe9\x1d>w\xfa\xae6f\x0fd\xbbX,\xb6\xfbSgx\x8c\xc7^@\x11%\x12Hv(\x1c\xc0U.{\x17\xb9UU\x866E\x98v\xb3g\x13\xa76in}\x0d\x17I\x9e\xe2\xb6^\xe7F\x1e\xb6\x82\xca\x15h:O\xb0\xfc\x18\x17\xcc\xcb\xc5\x9f:_\xea\xadDj,\x84\x1a\xb7\xae\xcdj\xb8\xcb\x93j\xb2\x9f\x92\x8cJ\xcd\"<\x13\xd3\x83)\xc1=E3\xefN\x90\x0c3*k@\xe4\xe61.\x167\xab\xc8\x8a^\x88\xb4\x8e\x1e\x16\xd2\x1e\xab\xaa\xe0 \xff*\xa2\x8b\xde\xa2\x01\xdd\x0b\xd5x\x83\x99\x908/X\x8d)\x10\n\x86\xf8hyF\xb1:<Ui\x86\xeb\x9b\x94N\x0d\x15\x03nJ\xfb\xdd\x16D\x1a}B/\xb1\x03\x10\x8b\xa5\xbe \xd74\xda\x1a\xd9(\xb6WL\n\x9aa\xc5k\xbab\xb8\x07\x93\xab\x10\x88\x94$b\xc3\"\x9a\x90\xb7w\x9d\xcbQH\x922\x85\xf0\xce\xda\xca\xd0\x9b\xcd(\x90B\xf7$\x89\xa3\xf5\xc4\xcf-nZ{33,8\x9e\xe5\xa7-\x08Vk\x03\xb1\xb6\xdc\xec#xm7\x08\x15\xb5!r\xf1\xac\xa2q\x0c\xee^\xf1:|\x05OvN\xb5\x0e\xc2\xf4\xef\x14\xe4;d\xcc`\xc8\x07o#q\xc6\x86\x9c\x9a\xc1\xe5?d\xcdoq\xc0\xda\xf2\xc7\x1fj'\x89\xba\x9a\xe1AANk)\xcba\xcb\xa7\x9c\xd1a\xcaF$K\x0fI\x98V\xdf	L\x9a6\xf8}oL\x04V\x19\xa0\xcd\x02\xb2a\x8f\xc0Q\x97h\x11\x89d\xd0(T\x1b\x9eI\x02\x86\xe6\x1f\xeb^)1\xf1\xa7Y\x9dB\xba\xa8\xd1\x8fDg\x8d\xb7[[\xbb\x99\xc5\x05\xe8\x8ee\x9aX\x9ey-\x1e\x8a\xa9_\xd4\x06+\xabzy\x9e\xae\xf0@i\x10\x84g&\xc8\x9c\x13i8\x1c&D5\x87\xd1L\x99{\x9d\x9c\xdc_\xde\x9e\x91\xef\xaen\xcf\x90\xcb\xef.\xefoO\x83 b\xad1\x8b\xb6-\x06\xcb0\x1cY\\\xe6K\x02!Vf\xc9y\x15\xc6\x91\xbd\xbf\xbc\x0dc\xeb\xa8\xf4\x7fP\x143\\\xbdWmz0\xfb7d\x16\xeb\x16\xd13w\x00()|\x11\xef\xe0\xbb\\\x8b\xd3\xe7\x0b\x82\xfb\xb1\xae@\xdd\x19\xcbA\x90\xe8\xdd\xd7\xadi\xcdZ\xba\xf2X&xa\x96\x06A\x0e\xd8\xd5\xa6\xdd\xac\xf8\x0b\xc3\xd0\xbb\xcdk\x15\xce\xbe\x15\x1d\x04;dekV\xd4\x111\x08\xb4\xdb\xc0v\x1a\xd0A\x98\x1e\x0fo`\xb5\xf8\x95l,\x80\xac\xbb\xd8\xf8\xc3\x016\x16\x83\xeb\x05y\x8b\xceF\x04\x12\xb9\xec\x04\xd0\n@w=T\xb5\x19\x19s\xd5\x1d\xc8d\x18\x9e\xe1q\x86\x9be\xff'\x11\xd1\xc3\xd0BoQ\xbb7\xb7\xe6\xcd\xa2\x1a\xc1\xc7\xbb<<,)\x9b\x03\xb6'\xcd(\xad\x18\xba\x18\x7f\x8e;[\x1a\xc4\xb7\xe2\xac\x821\xbf\x8e\x8bh\x90W\x83\x19\xdaC\xdc\x1fl\x11\x8be\x18\x1b\x1b\xac\xbc\xbc\xb9\xba\xab\x9d!-\x0e\x81\x86x\xfde\xc3H\xfc\xe6\x96\xd8y\xf9\x9e&,&`\xcf\x91[\x11:z\xf9\xf5W\x8b\x97\x7f\xfc\xd3\xe2\xe5\xe2\xe5\xf9W\xff}4\xdb\x07\xd0\xdd\x84$G_\xbdx\xf1r\x19\xaf\xbe^.\xcf\xff8\xe2\xe5Qf\xcc\x9c\xb9%\xf01\x82,\xd0\xa1lp\xf3\xda\xbc\xec\xf8\x99`5\xa4X\x1b\x16\xab\xb0m\x89\xb2\xb9\xa3\xcf\xee\xb0,\xe3Q\x92c\xb5\xd8\x13\xd3[\xc6\xf1p\x8fg\xf6\x08\x98\x01\xd3\xf2\x1cf\x13O\xbe\xd9\xe3P\xe4	+\xa4\xcd\xf1%L\xf0\x14\xf9\xae\x1d\xca\xc3\x08\xacE\xae\x15\x9e\xafD	CV\x12\x89\xd5H\xc1\x00\x02#\x9fg\xc9L\xff\xa9\x84C\xb3\xc7e\x9b\x9b\x15\x1e\xd4u\x84/\xe6w\xd6L~\xd5'\xdd\xc28Us\xbe\x8e\xec\xbb\xaa\xac\xfbS\xce\xcdq\xf9\xc8\xb9\xb9!e\xd8|Tk\x19]\xf6\xc5\xa8O\xb2\x16h\x80\x14\x16k\xf3\x98\xca\xd8\xd6;\x125\x9c(\xac6\x05)\xe5\x9aE\xea\xcf\x18\x82\xfb\x80\x7f\x95k\x9b\x9d8\xab\xa4\xe6\x02Q\xc5\xe0\xa5\xa8\xa0U\xe6\xfa\x8c\x9b5f\xb5=7\x8d&H\x94@S\x12\xee\xba\x83\xcb}\xd7\xe2ot\x05(:\x05O[\x91\xc0HDq\xed\xdc\x16)6\x9c\x9a\x1a\x92{\xb9\xf6P\xf2\x0b\x1fyU)\xde\xf5\xa4/\xc8\xdb\"\xfd\xccBD\xb1\xcck[\x97\xd5\xe0\xdb@\xad\x184\x10b+ja\x06d\x9c7\x83\xcdx\x9a\xd7\x1fQ\xb4Ty\xf1RH\xab-\xa8}0F\x8a|mh0H\xb2\xb7J\xaauGfy\xd5\xea\xe9B\xe7\xdc\x074\x17o\xae\x86K\xe5\x9e\xa1\x85k\x0c\xb9\xe8!e\x04\xde\xc4\x19f\x0f\x05Mr\x19\x86X\xabu\x16\x9c\x10qwt\xc0\xee\xccd-\xaa\xe7m\x0d`	\x98\xd8\x1f\xdati\xb6\x07\xb4\x90\xdcU\n\x04fA\x0e\x15\xd8\x82\x861\x06\xb2!\xaf\x88\xb1+\x86\xb3\xf3\x84?\x8c\xf4wJ=UL\x8f\xdb\xd2\x0d\xd8\xb2\xfd\x0cn_\xfd\xa4\xea\xb3\xd8\xe1\x8f\xbbV\xea;\x08E\x9e\x1c\x8fYd\xdeOHl\xcc\xb6e\x19\xc6\xa1\xd4\xef\xa7\xf8\x0bk\x8cO7\x1a\xacG\xd1\xfa\xe67\xfc\x8c\xbc\x11\x1a\xff1\xa7\xddL\x1an4\xcc+\x01\xea\x8d\xd0\x06\xc2\x18\xa9-\xdd\xa7\x11\x89S\xdf,\x1b\x9e5]\xce[E\xd5\xc0\xed\xb9Rt\xda\x0f\xc0\xa5\xc4\xc7\xc5\x92\xe5U\x08\x08\xfd\xc6\xd4\x05Y>\x8f\xc4\xd7\x1ap\x87\xa8E\xd1$	W\xb8m\xcc\xe7\xc6}\x19\xcbr\xd2\x86\xa3\x9b~!k\xb3\xf9\x19\xd1\xb5\xa8\x8eIe\x97\xcd\x12\x8e\x89!\xc8\x12\xbcg\x8f\xc49\xaep\\\x14\x1a\xab\xab6\x01\xf9\xbd\xfd\x96\x82\xdc\x00\x96\x04E\xdb\xb1\x1c\x1c\x1dY<S\xd6\xc7G\x19\x87\xc4\x1a~/\xe3\x01\xfawa\xebm^\x88\xfa\x88\x97F\xc6*\x87\xf2\xc0\xb8S\xafzNH\xb5\xb5\xc3\n\xdc\x9e\x95y\xd8\xb3\xaa\x15\xb4Q\xe8)Ii\x86\x9a\xe9\x9f\xe8r\x98\xc5\xf5\xaf\x11\xbc\xce(\x93jA.\xfcQ\xa8\n\x14\xefdW\x06\x1c\x01\x18\xb1b\n\xeb\x1d\xd9#M\xf0d\x06\x9a)N \xb1\x15\xeeb\xdd\xf0g\xc7\xe8\x13[\x15\x82\x0eE\x91\xf3>z\x80\xdd\xd1\xd9\xfe} #`\x1e\xdd\xf0#\xeb\xb45\xf4T\xe1\x0db\"y\x0cH3!GM\xb7\xfa\x10gy\xf4\xba\x18\xf9B\xa5T\"L\xa4kry\x84\xca\xdb\x85$u\xaf\xdcnc\x8d\x8bL1\xca\xfb\xdd\xc4\xfaX\xa5\xe2\xa3\xfcF\xc0;\xc5\xfa\x87\xc5\xfa!\xb9\x89\xc0Y\xb7\xfb\x00\xc7\x8a\x88'^b9\x85\xfbS\xb8?\x85\xfbS\xb8?\x85\xfbS\xb8?\x85\xfbS\xb8?\x85\xfbS\xb8?\x85\xfbS\xb8\xff?#\xdc\x1f\xd19,\xbd\x18\x04p\x18\x14\xe3\x87\x9c\xccb\xfc\xf3\x1d\xcd\xbaw\xd5r\x95\xd3(\x98\xba\xc2(\xff\xf7t4\xcb\x1d\x88t\x1bp\x99\x88\x8f\x15I\x04\xd6zs\x11w^o\x8c-\xf0\x98W\x95\xe7\xee4W\x0f\xd0\xf1\xe7\xbc\xfc\xa8=@?\xff9\xafX\x802\xd5Uf\xea\xf1h\xc0\xaf~\xcckvp\xf4\\\x8b?j\\\xbe\xe1\xbf\xe4a-\xb7\xc2~s\x87\xb5L\xbd\xeb\xec\xf9\x912\xc2\xe9\xe3{\xa7Z\xf2W\x8d\xb9\x0c\xa8\xad\xddC\xd5\x847V\x06\x14\xf0\xd2(\xc2\x03\xa7N\xcc\x03\xab\xcf\x07\xa1\x9a\x8a\x91Q\xd5\xe7\x83 \xeb\x05\xb2\x01\xd5\xe7\x83\x10Q_x\xf6\xf5\x14\x9e\xdb.\xa7\x07 \x19Xs\x1eF\xfa\xc0\xa1\xae\xe2\xb7a\x95\xe6&\xbez\xa8\xab<\x87\xe7\xbe84U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7S\xd5\xf9Tu>U\x9dOU\xe7\xbf\xf3\xaa\xf3\xe7\\\x96o\x0bE\xf7k3S\x1a\x83+\xbcd\xab\x80\x9bf\xdd\xad\xdc\xbd\xf5\x99\x8d\xeb\xf4\x07\xa1\xb6_\xb7\xdfw\x9d\xfe \xc8\xb0\xc2\xcc\x96\xeb\xf4\x07!7\xae\xdb7\xac\xed\xb9N\x7f\x10\xe2\xf0E\xc0\xc0\x1b\x95\x97\x83P\x03*3\xeb\x95\x97H\xc6 TW\x99\xf9\xeb\x14eN\xd7\xedO\xd7\xedO\xd7\xedO\xd7\xed\xff\x1a\xd7\xed\x07\x19\xebaPA	\xfe\xda|\xfa\x8bc\x8bs\x0fZ83=\xea\x9bM\x16s_\xb9\x81+\x17\xca\x18\xbb~b\x8c\xa9\xca\x97\xbcz\xc4\x05\xcf\x03\xd5-\xfdjW\x99\xe5\x05y\x9d'\x9ae	\xf8o\xa4\x91l\xf8h\x18\xaa	\x9b]4\x14+\xfc\x12\xb5+\xac\xc0\xb1\x16\x18\xbd\x9b1\"\xaa\x00\x977x\xec{0E\xbbh\xbfu\x00\xe8|\xd4\x8e{\xd8K\xac\x1e!q\xd1p\xa9H\xde\xbc\xbd\xef\x81)\xcc\xfc\xd0\xc4\x98\xe7\x81\xcbw\xcbku\xfbD\xfd\x82;\x9f\xa4\"${\x9e\x88\xbf\xed\x15\xd5\x9c\xdf'X\xcc\x9eg\xb4\xc6\xe5\xcak\xb2\xd9\x9b\x1f\xdfK\x15\xcf\x82b\xe3\x8e\x9cxha[Pv;\xb8\x80\xad^\x946@\x80;\xdc\xd6\xbc*\xb5;3=\x08\xb1\x92\xb9\x1ey\xe5\xe9\x18\x7f%\xb0\xc8,,\xd3\\\xbb\x9etphl\xe3\xae0\x1d\x15\xce\xfb\xca\x87!\x89h\x90\xe7_l\xc9\x1c\xd7\xae\x1b\x0d\x80K\xba\x8b\xc3:\xaf\x18\x0d\x02[f\x88G\x17x\x8dbbx!WMB\xfa\xb2\xb9\xee\xe1b6\x08\x92\x90\xa1\x82\xadF\x8a3\x08h\xb3H\xab\xb7\xe8*\x08fu\x16\x9ah\xed\x15Z\x05A4\xf6(\xa4\xb8\xca^\xd1\x19\x0438\xc3:b\xa7p\x94<\x85z\\c\xae\xe5\x0c\xc9\x92\x06gF\x83\xf32at\x04g=\x0f)l\n\xe6|mm\x06d4]Y\xd2\x00O\x03\xaf\xcal^\x809\x00w\xb8P\xa9%\x1f9\x00\xb3\xbd8)$\x079\x00\x183\x94\xcf/3\x1a\x11`\xf4\xa6\x0c\x8d\x8f\xbd\xbb\xdfe}\xa6\xbf\xf5\x806z\xc9f\x04\xe5\xbf\x1c\xbdw\xd2\xc5\xfb#=\xfc\xc0O\x82z\xdb\x86\xfe\xb1;b\xe8\x8e\xdc\x1f\x9d\x91\xa3k\xff?!\x8b\xdf\xcf\xdc\x8f}\xe2\xd6\xf8\xf2P\xfd\x9boX6d\x8e\xb5\xb9\xd8\x92\xac\xa8\x82\xb8?\xb5\x83\x04\x02\xaax\xe0\xf6+4\x0e\x1dD\xed\xba\x12\x11\xfd\xb9\x08\\\x86j\xe7\x9d\xf7\x87&\xcf\x01P\xf8\x0db\xfc\xe8\x12~\"J\xa9<u7h\xac\xd7\x18\xe2\xd8N}\xf6\xde\xf8\x8d&!\xe108y\xda\x82\xc9\xa2\xe0W\n\x85\xf1\xb7\nI\xc5q\x1d\x0d= \x1dJ\xa7\x1d89\x00\xc6 \xeeDN\x9e\xa8\xbd\xaf\xf6I2\xddgbp\xc1\x1ar\xe6fA\x18\x84wg\x06\x841}\xf01C\x12t\xb2s\x9f\xea\xdbUe\xb5\x07\xf0\xfbBh\xc8\x87\x05y\xc5\x1e\x00\x8f.\x9d\x11\xd6\x82\x1f\x1e36\x03\x0f\xcd\x94O_8Y\xe7\xc2!_\xb9\xca\xa1\x82\xbbG\xb8\xaf$\xa5B\x8c\xf5o\xdcw|\xed\x01\xd1\x92\x86\x13\xc5P\xdcT\x8e\x91\xa8{\xab\x07\xecSq\xda\xd4Cj\x11.\x1e\xbb~\xc5\xe7\xac{ \xfa\x15\xa2\x05\xf9	'\xe6}\xb9>\xc9\x87\xd3\xfd(\xb8\xfc|r\x0f\xc8\xcf\xfaae\xa7l\x90\x93\xce\xb29}\xb5\x02\xd5TJ=X\x11\xa3\xcd\x0e\xffDq\x90q\x1dr\x02\xfa]\x98y5\xd8\x9f\x1d`\x17\xfc\xc7\xfe\xffo.4]\xce\x06\x95\xfd]\xb5\xbf\xff\x9272\x95n6\x126TC\x01\x12\xb5\x8a\xcaS\xa3\xc7[\x01\x93\xe2c\xe5\xfd\xb9\x88\xe1`tKe\x8f\x8f7\xd61\x1aL\xaa\xfb\xb4\xf8p*=8\x81\x9eQ\xadA\xf2%\xf9\xfb\xc9\x0f_~\x9a\x9f~sr\xf2\xfe\xc5\xfc\xeb\x0f_\x9e\xfc\xb00\xff\xf9\xe2\xf4\x9b\xd3O\xfe\x8f/OOON\xde\x7f\xfb\xfa\xaf\xf7\xb7\xd7\x1f\xd8\xe9\xa7\xf7<O\x1f\xec_\x9fN\xde\xc3\xf5\x87@ \xa7\xa7\xdf\xfcW\x0fR\x07\x7f\xf8\xb5&2\xc7[\xfc\x9c\x94\x8b\xf6]..\x06\x85\x07\x17\xcc\xc4y)\xf2\xf9\xb5\x1e\x840i\x15\x17\x02\xb6 \xaf\x05\xde\xfb\xc3\xd7bI\xb6Zgjy~^b\xbc`\xe2<\x16\x91:\x8f\x04\xc7\xd3\xeb\xea\xdc*\xcbs\xff\xfe\xfcg\x14zu~\xdc9b\x80SeN@\xfb\xa2\xd6e\x18Gj\xef\x14\xd5\xc5\x94D\"I\x9cJ\x16k\xb2f\x89\x06\xd9g\xd9\x12\xf6\x00\x16\x983C\xe5w\xd8\x0d'\x1d\xe6\xb8Q\x15=\xd8\xcdDJ\x0c\xd1=@\xcd\xb1i\x9b\x93+\xb6J\xdfU\x11~\xeb\x93\x1b\xf5O^\xf6\x80\xc4/\xea\x91L\xd8M`\xe7#/\xc8_\xf0$`\xa9-\xb4\xb0.\xff\x99\xbdy\xc8\x92\xd5\x03\xf4\xe2\xcd\xd5\x1e#O\xd8\xba\xb0\xcb1b\x87\x7f\x9c\x9e\x15\x91\xb3\xbbN\xa9\xdbK\x0c\xcbz\xed\xc7\x12}\x9abo\xe2/\xfcY_\x8byk\xd6P\x0d\x7fb\xdd\xbe\xec\x94\xa7g\xe0@\xb0uHj\xd3\x8c\x13\xcf\x8b)jC7d\xab|\xb8T\xd7\x91\x84\xeb{8{5T\xa5[\x016\xe2\xbcm\xd8\xe4;\xbb\xe5\x86\x1e\xe2gC\x00\xee\xaa\xf9@\x83\xe6^F0l\xff\x99t\x1c\x17\xed\xfc\xe8P\x00\x9e>#x\xf6\xcb\xa6\x03\x0d\xd1h\xf0G\xf3\x0ew&Q@\xbc\xe0\x1bHe\xa8\xe9E,\x00\xec/\x9a4>0\xdfy\xd1\x99\xe5\xdc\xcf,\x06\x118\x94\xe5l\xc9`\x06\xc1\x0d\xaf5\x1d\xb8n\xb1lC\xc7I\x9b\xc7C\x830m\x1e!\x1d{$\xf4\xf7\x95\xc1\x0c\x14\x8fy\xb9\x02\x07z\x068?\xe1\xf9\xd8PO\xaag\xe1\xecY\xd2Vg\xa9\xd5\x0d\xea\x84H\xba\x1d\xa4\x96:\x05\x14h\xeb=\xf9]\xd5\x1e\xc0\x98s\xb1\xc0{\xec\xf2\xa0\x80\xed\x91\\\x0b\xbe\x8cOV|\x9e\x9b:wq(oA\xf6\x99\xd3\xcd\x82\x1e(\x9f%\xb2\xed\x15\x89\xce\x87\x1d\x0f\x94\xa6:\xdf\x13\x9e\x1a\xf7\xde\x81|d\x11\\\xda\xcf\xe5\xbe3\xdd\xab\xdbs8\xb9be\xae$\x8d\x0d4cg\xe8\xde{\x8bY\x98\xcb@7\xc0\xf5;\x88$\xb4\\8V\x9f\xd5\xb2'\x91\xb0\x06\x89\xc9D\x94c \xf6}\xb2\x15I\xec\xef\xba\xd5\xe2\xc1\x14\xeb\xb5\xad]3&\xc9\x15\xbe-\xd0\xc7\xe2\x98\"\xf4\x9f`\xba\xbc0\xdf\xa9gk\x16!mH\xae}aKM\x7f-\xf3\xf6\x03\x026\x1b\xe7\xeeTz\xf2W\x18\x19\xb4\xfd \x18\xb00\x85C\xb78\xd7\xfd~\x15\xef\xf4\x04R\xc6_\x01\xdf\xe8\xed\x92\xbcly\x91\xf4G\xd6\xddi\x93\xb9\x19\xb3\x01\xb1S\xe0\x08\x89hF#\xa6w\x033y\xe9\xba\x11\x95\xa7)\x95\xec\x1fn\x1a\x0b\x07\xdd\xcc\x05\xe3x\xd7\x15\xfee\xdd\x98\x06L\xd2+r\xc3\xf9\x10\xbcH6\xa2\x1a\xafE]\xce\x9e[\x11\xdf\x9b\n	K\x83\x04\xa5@~s\xe9\x8f\x83R\x1f\xf5\x85]\xceC\x91\xfc\xc8S\xef\xbc^\xde~g\xc4!\x85T\xc8\xddY\x9f\xdaf\xaa:\xa7X\xe0x\x074\xde\x917\"\xee\n\xfaz\x84\x19[!\x83\xcba2n|_O\x84\xadeD:JQ\xce@\x92\x98\xa9H<\x82\xec\xfc\x08\xf9e\xae\xb4H\xbd\x0d\xbb\x825\xe3&-\xb7\x98\x8d\xb6\x8d5F\xd7\x97\x8b\xc7\xf7R\xe4\xb6\x02\xa7\x03\xe7\x0e\xc8\x04	\xa3#q\x1dZ\x92~s'\xe7\xbd\xd7O\xd6\xa8\x1a\xc2\xbf\x9dk\xb6\xad\x85L\xa96\x0b\xf3\x0f_\xf5\xf4\x0b\xcbbF}\xb9\xd5\xbd\xb9\xb8\xbc\xbb\xda\xb7b]\xac\xec\xc1\xabH\x0c\x07Q\x1b\xc2\xfb~S3\xc2\xe8\x94-@\xab\x85:\xf0\x1d&)x=\x87\x8c3\xb7\xf2\xd7\xc1\xa19\xcer\xc7\xb3\x01e2T\x8f\x81\x96\x18\x94\x86x\xb2F\xffFkt\xe7g\xe1\xd9\xb6\xa8\x98O\x0c\x15\x14&T\xf3\x04bs1\xc5b6Z~z\x1eF\x82[\xd1h\x91\x87\xba\xd2):\xd6.X@O\xa8\x02\xc4\x96\xfd\xd6\x8dE\x03\xae\xa1\x90\xb5\x98\xa4NsT\xc3\xa4\x0e\xbd\xc0\xcb\xef\xaf+\x12\x83\xa6,\xb1{\x1d\xc8\xfa(\x97\xb2+\x89Z\xa0n\xb5a\x03\xf9\xc5l\xbc.L\xa8\xd2\x7f\x03*\xf5\n\xa8\xbeg\xddY\xb8\x1aY\xaf\xf6\xdf\xf2B\xa4Y\nJ\xd34#\x91\x90\x12T\x86(\xf7d@\xdc\xc7\x0d\x10\x0b\x92g1\x06\"\x9e\xb6\x82\xda\xc5\xac\xdf\xa8\xe1[s\x1cxv\xa0^\xc6\xd1\xef%\xe5\xca\xc8\xcc(&\xd4_\xf3\\@\x80\x86\x15\xe6\xaf\x82\x90\x0e\xa0\x04\xb7c\x10\x8a\xbd\x7f\x1e/\xc7\xc7p37Q\x18\xe5f\x07\xfe\x17\xe7A\nJ\xd1M\xd8\xec\xbf\xb6}=\xb5\xdb<\xa5\x9cH\xa0\xb1qm\x1d$\xc2x\x8c\x91e\xdf\xec{\xe1\xa7+\x91k\xc7\xb5\x82\xa1\x8b\xd9\x81fV\x02U\x82\x07Qrg\xbazBNV\x92\xc1\xfa\xd4\x01(\x97\xa4\x9f\xbf\x9eK\x1d?\x17\xeemY\x8b\x8e5\xe82\x16b]G\xf2\xcc\x08\x90X\x93\x93\xe3{\x99\xc3\xf1\x199\xfe\x0bM\x14\x1cw\xa7\xfc\x8f\xbf\xe3\x0f\\<\xf1\xe3\xd3\x83yn:\x84h\x0eS\x84\xd1\xc4\xd9\xa9\xbddGN\x8eM0s0.}\xe5\x11\xf3\xa6\xbak\x810oQ\x08\xad\xdd\x9c\xa4\xb7>\xb32\xd4\xfa\xc8\xceq\xeb#dc\xcb\x83\x00\x83\xd9\xe6lm\x81&z\xbb\x9c\xf5N\xc8\xdfL'L|\x99c\xa98/\x12\x949L\xe5\xa6	\xb9\xe1`\x91h\x0b\xd1C\x8bY\xefw\xb9i\xc6^Q,\x8a\xeb8\x17P\x93\x90\x8b\xdb\x1b\xd7\xd9\xaf\xcbB\x93^\xdc\xde\x18\x8bj>\x95!\x1eP?:\x1b\xb3\xe8\x96\xcan\xb1\xc5O5\xa9\x00\x8cL\\\xdd\x8cv\xdd\xcf\xdc\xa5\xe6\x86,qH\x146\x14\x7f\xf9\x94~\x08\xd6~W^\xd5\xe6\xb6Hd\xe2\x1f\x99\x14+(\xf7\x08[a\x12r\x05Y\"v\xa6\xf6\xb4\x9d\xcd\x87F\xe6\x1e\xc3\x16\x114h\xb6\x0e\xe6#\xf2\xcavo\x89`;~a\x11\xe1\xbf#\xaa\xdd\xbf\xa5EB$x\xc4\x12\xc67]\xa4\x84\x92\xf3\x9f\x1f\xe0\xa2l\xf9\x89\xef\xa3\xb2&\x81\xa5\xac\xecO`\xe5Ig\"\xb6l^\xf8~\xbf\xd3D\xca\x13\x7f\xfd\xe8\xfdfed^R\xf0\\Ir\xa6<X\x8c>\x9b\x0b\x8c\xedi\xbbkh\x02\xac\xc4Fx\xbd\xbb\xf0A\x0b\xd2@	&\xcc8_\xa8\x8a\xb4\xcc\xe1\xac\xdc\xde\xa9\xac\x8e\xc0\x9b&LQ\xce#e	F\x05\xc3T\xac\x84H\x80\xf2\xd9a:\xa5/]5\xaf\xe8\x89\xce.\x86K\xb3\x03u\xd1P\xbe\x8b\xc6\xbb7\x81\xbe\xc6]\xd1\xb9\xe9pT\xb2\xfc\xbf\xa6\xdb\xd1\xad\xcf\xe7\x15\xcf\xae1\xf4\xdc\xfaW-\xbf\x97\x0c\x99\x8dP\xfbe\xc2\xeb{\x90x{\xe6r\xd6\xcb\xc9o\xf7\xfb{\xaf\x07\xb7t\xf1{M\x91\xf5\xd5*^\xe5l\x9c\xdf\xb0\xcaY\x12_Q\xdd\xa16\x06Vg$\xd2\x8c% \x0fzy\xc3\xf4\xa5HS\xa6\x0f\x1az\xc3\xf4\xbd\x04x\xa7\x0f\xc5}\xc3t\xe7$\x04x\n\x1b\xf1\x9c\xb7S\xfa\x93\x90\x87\xbd\xc9\xf8\x81of	\xd5k!\xd3\x03\x10\xee[>\x85\x045\xc0\xce\x0b\xf9hyT\xcc~\xfb\xb3bn\xdb\x1f;\xde\xb7=\x14\xdd\xcf\x0c\xd7[\xde1<m\xf9\xddsl6\xc2\xb3\xf3\xe5\x16\x7f\x05\x8e>\xf1\xf0\x1a\xc7\n\xc0T(\xf4\xe5\"\xacF\xd8\x14/\x96\xa5\x1b\xe6\x0e	\x93 \xd1\x12+\x84Z\\\xb6\x8a\x86\xfc\xe3\x7fw \xdc\xbe\xa7\x9dm\xa9jYBu\x97\xf3\xfa\xf6\xee\xfa\xf2\xe2\xfe\xfajAn\xb1\xff~\x95\x89K~\x90\x84\xad!\xdaEIw\xf8\xe3s\xa3\xaeD\x07\xcfS\xb0\x18d\xe5\x92\x8c\xcah\xe5\x11\xb6\x15\x0e\x99\x8a\xc7\xd6\x9dX\xaa\x88\x12x\xe6\xc7\xb82X>\x8fw.\xa4\x10m)g*\xc5 \x1bO[\x16	&\x9b\x96tK\xc58\x08-@3\xc9\xccg\x05}\x98\x89\xc9\x01\xe3V\xd4\x8aG0\xc3\x163\x95%tG\xb2\\fB\x81:\xc3_[ \xc2#\xc8\x9d6\x97FC\xa2\x00kK*8\xcd\x82uHk`\xd1\xf8\xd1\xcaNe/Ei!1?Z\xf9%_\x15\x85\x1c\xcbY-}G\xfe\xf9\xafY\x99\xc9\xc3\xeb\xce2\x0d1V\xd8\xb9\x9e\x0f\x8c\xc7Krd\xbfn\x98%\xb9\xa4\x89\xfb\xb3$iI\xde\x7f\x98\xd9\x81!v\xebR-\xc9\xfb\x0f\xb3\xff?\x00PK\x07\x08\\0p\xecy\x1e\x00\x00\xba\xe2\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8\x9cVMo\xdbH\x0c\xbd\xebW\x10\xf0q\x13\xe7\xee[\xd6	v\x17\x8b4\x80\x1b\xa0\x87\xa2\x08\xa8\x19Jb=\"\xd5\xf9\xb0\xeb\xfe\xfaB\xb2\xecZv\xd4\xc8\xbdF\xef=\x0e\x99\xc7G\xcf\xe0\xa5\xe2\x00\xeb\x14\xa2\xd6\xfc\x03#\xab\xccwX;\xe0\x00\xa2\x11X\"\x89%\x0bQ!'\xf0I \xdf\x01\xc7@\xae\xb8\xc9f\x10X\x0c\x01G\xb0\xd4\x90\xd8\x00*\x10\xc8o\xd8\x10\x08\xd6\x04(\x16\x04k\n\x0d\x1a\x82Xa\x04\xf4\x04\x9a\"h\x01\xf1\xb4:A\x83f\x8d%\xcd\xb3\x19\xfc\x17!T\x9a\x9c=)kT\n.\xef,\x15\x98\\\xcc<\x05M\xdePXd\xb7\x90c\xa0pg0\xa2\xd3r\xbeN9\x19\xf4\x9e\xc9\xcfY_\xd1\x18M\x12C\xd7\xda\xbb\xe0\x86\xd7\xb4\x9b\x86\xed\xeb\x91D\xcft5e\x17h\xe2\x9bz\xd24\xb0%\xcf\x1b\xb2\xa6\x1b\xebqH\x93\xa8,!\xa2\x18\xaa\xb9\xf4\x9d\x19\xa6\xd1\xb4(\xc8\xb3L|_\xe3u\xc3\x96\xfc4\xf4\xb7\xa4\x11\xa7A=\x95\x93\x9f\x1cIp\xaa#R\xc0\x92<\x19\xf5\xf6\x8cp&:\x1c\xb9\xe5`tC\x97\xce\x98\xc4z\xc3\x1cg\xbc~\xcf\x8cK!\x92\xc7\x10\xb8\x94\x9a\xe4*V\x8f\x9d\xc1_\xadx\x9e\xd8Y\xf2\x8b`\xb0(\xd4\xd9\x85\xf1\xf6\x90\x0dtpR\x965\x18ME\xe1c\xf4\x18\xa9d\xf3D\xbe\xa4E6\x83\xcf\x9f\x1e\xff\xfe\xf7\xf9\xf9\xff/\xf0\xa2@\x82\xb9#\xd8R^\xa9\xaeo \x89\xd1\xba}\x1f\xa0s\x10+\x82@\xa6\xf3\x18l9V'\xe4\xc6S\xc1\xdf\xdb\x14\xe8+AE\x9e\xba\xdc(\xd4\xef\x85Y\xcaN\xc3\xa8l\xc8\x07V9T\xdac\xd0T\xb0\\=d\xb3\xdb\x83\xc8]\xff\xfd\x95e\xf8\xdf\x9f\xd2|\xcf\xed\xa4\xb2\xb6\xd3\xe5\xe3\xea\xe5\xe9\xfe\xc3\xfd?\x8f\xab?\xebv pM\xc7\xcb{`\xf9\xba\x1f\xdd\xb0\xd7_\xad\x1a<B\xdav\xcf\xf3o\x04\xf6v\x9a\x8d\x80\x7f\x9f2#\xa4\x8b\xa0\x18\xc1]D\xc4\x08n\x18\x0e#\xa0\xb3X\x18A\x0d,1>\xa2\xf76|\x847\xdc\xd5\xeb\xd0\x17\x9b=\xc5\xb0'\x8aG\xd3\xb6\xfbR\xa8s\xbam\xbd\xb4\xbf\xa6\xed\xa1oM\x14\xdb\x8di\xff|\xdcw\xa8t\xdb\x9e}\xab\xc3\xdf\x07\x1d|\xb9z\x08\xf3l/\x91\xfaS\xd1\x9e\xe1\x03\x92\xf6\x9f\xe6;\xac]\xf6s\x00PK\x07\x08\x14\xdf\xcf\xe2Q\x02\x00\x00f\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\x90Aj\xebP\x0cE\xe7o\x15\x17\xfe\xf8\xc7\xfc\xd9\xe7M\x9d\x15$\xdd\x80\xf2,\xc7\xc2\x8ed\x9ed\xa7t\xf5\xc5NS\xe8\xa4\x94\x8e$t\xc5\xd5\xd1\xfd\x83\x97A\x1c\xbdL\x8c\xadZE0\x95A\xf4\x8aq\xf1\xb0\x9b\xbc1\x06\xbb#\x0c\xbe\\<$\x96`(\xdd\x18\xa4\xdd\xde\xf8L\x85Q\xb9\xe7\xcaZ\x18\xa2hO\xc7\xb4I\xa7\xe70\xa7\xbf\x18E\xbb\x8c3\xd7U\n'`\xe5\xeab\x9a\xb1\xfeK@/<u\xe7\x99\x8b\xe7\x04<\xb7\xdb\x1d\xe2\xc4nK-|\xe4^TBL\x13\x00\\\xab-s\x06\xcd\xc2\xaf\xc1\xba\x99\xf9a\xfc\xef\x07\xb1]\x9f)\x86\x0c\x9f\xb94\xc5\xf4\xe3\\s\xe7\xcb`6\xb6\x93\xb0Fk\xda\xcb\xb5\xf1\x07T\xb31\xa7\xf4\xf9TN?\xe0\xf8\x9e\xe2w\x0c{\xa4	(\x95)8\xa3\xa7\xc99\xa5\x95\xea\x97D\x1f\xe67\x0e\xea(\xa8!U\x0b\n1\xf5\xf4>\x00PK\x07\x08\xf6]\x02\x05\xef\x00\x00\x00\xda\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00	\x00crd/patches/cainjection_in_accounts.yamlUT\x05\x00\x01\x80Cm8d\x8e1o\xe2`\x0c\x86\xf7\xfc\x8aW\xe2\x86c \x08\xdd\x82\xb2E!'1\xdc\xa9\xa2\xa8k\xe5~1\xe0&\xb1\xa9?\x87\xf6\xe7W)\xdd\xbaX\xb2\xfd\xbc\xaf\x9e\x05\x8e\x17\xc6\xc9\x86\xc1\xdeE\xcf\xb8R\xa4\x0b\xa8\xeb2\x08\x9d8\xa7\x90\xdb\x0c8\x12{\x8c\xa4tfG\x18D_9\x05\x9a\x1a\xa2a\x88\x0b\xa39\xec\x8a\xc5<\x91Lo\xecYL\xe1\xfc6\x89sF\xbf\xcd\xd8\x94\x9b?0\xc7@\xc1^\x16t\x95\xa7;V\x81\xae\xc2\x1f\xc1:\x87r\xd9os)\xb6\xbem\x8a^\xb4\xab\xd0L9l<p\xb6\xc9\x13\xef\xf8$*!\xa6\xc5\xc8A\x1d\x05U\x05@\xaa\x164\x9f\xf3\xbc\xe2Ky\xf5\xed<\xd7\xdd\x9dW\x89V'\xb7\xb1\xc2\xaf\xdfM{8\xee\xff\xee\x9b\xfa\xd8>\xff\xaf\xff\xb5\x8f\x0fu\xd3.\xd7?\x1f\xcb\x02P\x1a\xb9\x02\xa5d\x93F.\x13\x05\x0dv.\xfb\xe9\x85\x13\xb9\x0b{)V|\x0e\x00PK\x07\x08\xee\x1f\xba$\xf4\x00\x00\x00S\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/patches/cainjection_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8d\x8e1O\xc3@\x0c\x85\xf7\xfc\x8a'\x95\x81\x0e\xbd\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9\xb8\xadIr.>\xa7\xf0\xf3Qh7\x16K\xcf\xfe\xfc\xf4\xcd\xb0?1\x0e\xda\xf7\xfa-\xe9\x883y<\x81\xda6\x83\xd0\x8aqt\xb9L\x80!\xb2\xf9@\x89\x8elp\x85\xa4O\x8e\x8e\xba\x82$W\xf8\x89Q\xef6\xc5l\x9a\x88\x9a.lY4\xc1\xf8k\x14\xe3\x8cn\x9d\xb1\n\xab\x07\xa8\xa1'g\x0b\x05\x9d\xe5\xed\x8a\x95\xa0\xb3\xf0\x8fs\x9a\x9er\xe8\xd69\x88./\xab\xa2\x93\xd4\x96\xa8\xc7\xec:\xec8\xebh\x917|\x90$.\x9a\x8a\x81\x9dZr*\x0b\x80RR\xa7i\x9d\xa7\x88?\xe5\xc5\xcdy\xaa\xbb:/\"-\x0e\xa6C\x89\xbb\xfb\xba\xd9\xed\xb7\x8f\xdb\xba\xda7\xef\xcf\xd5S\xf3\xfaR\xd5\xcd|\xf9\xff0/\x80D\x03\x97\x88\xe4\xd4\xeb\x91\x93\x9bp\x0e\xb7\x18\xba\xf1\x83#\x99	[\x10-~\x07\x00PK\x07\x08\xcb?\xf2a\xf3\x00\x00\x00Y\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00	\x00crd/patches/cainjection_in_catalogs.yamlUT\x05\x00\x01\x80Cm8d\x8e=o\xe2@\x10\x86{\xff\x8aW\xe2\x8a\xa3`\x91u\x0drg\x19\x9fDq\xa7\x88\xa0\xb4\xd1\xb0\x1e`b{\x87\xcc\x8eI~~\xe4@\x97f4\x1f\xcf\xbcz\x168\\\x18'\x1d\x06\xfd\x90t\xc6\x95<^@]\x97A\xe8\xc48\xba\xdcf\xc0\x10\xd9|\xa4Dg6\xb8B\xd2\x1bGGSC\x92+\xfc\xc2h\xf6\xdbb1WDM7\xb6,\x9a`\xfc>\x89qF\xbf\xc9(C\xf9\x07j\x18\xc8\xd9BAWy\xb9c\x15\xe8*\xfc\xe9\x9c\xe6\xa7\x1c\xfaM\x0e\xa2\xeb[yd\xa7\xb2\xe8%u\x15\x9a)\xbb\x8e{\xce:Y\xe4-\x9f$\x89\x8b\xa6bd\xa7\x8e\x9c\xaa\x02\xa0\x94\xd4i^\xe7y\xc4\xb7\xf7\xea!>g\xde\xc5W\x91V'\xd3\xb1\xc2\xaf\xdfM\xbb?\xec\xfe\xee\x9a\xfa\xd0\xbe\xfe\xaf\xff\xb5\xcfOu\xd3.\xd7?\x0f\xcb\x02H4r\x85HN\x83\x9esx4\xa1\x9f\x8e\x1c\xc9L\xd8\x82h\xf15\x00PK\x07\x08\xe6\xc5\x0c\x9b\xf5\x00\x00\x00X\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x009\x00	\x00crd/patches/cainjection_in_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8d\xce1o\xf2@\x0c\x06\xe0\xfd~\xc5+\xf1\x0d\x1f\x03A\xa8\x0b\xca\x16\x85TbhUQ\xd4\xb5r/\x06\xdc\x903\xf59i\x7f~\x15`\xebb\xc9\xf6k\xeb\x99a\x7fb\x1c\xf4|\xd6oIG\\\xc8\xe3	\xd4\xb6\x19\x84V\x8c\xa3\xcb8\x05\x0c\x91\xcd{Jtd\x83+$}rt\xd4\x15$\xb9\xc2O\x8cz\xb7	\xb3\xa9\"j\x1a\xd9\xb2h\x82\xf1\xd7 \xc6\x19\xdd:cU\xac\x1e\xa0\x8639[\x11\xe8\"o\xb7X	\xba\x08\xff8\xa7\xe9(\x17\xdd:\x17\xa2\xcbq\x15:Im\x89z\xc8\xae\xfd\x8e\xb3\x0e\x16y\xc3\x07I\xe2\xa2)\xf4\xec\xd4\x92S\x19\x00JI\x9d\xa6q\x9eZ\\\xc9\x8b\xbbyzw3/\"-\x0e\xa6}\x89\x7f\xff\xebf\xb7\xdf>n\xebj\xdf\xbc?WO\xcd\xebKU7\xf3\xe5\xdf\xc5<\x00\x89z.\x11\xaf\x14\xbbSZ\xc9QG6\xe1\\t\xc3\x07G2\x13\xb6B4\xfc\x0e\x00PK\x07\x08\xe3\x06\xc8\xef\xf5\x00\x00\x00\\\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x006\x00	\x00crd/patches/cainjection_in_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8d\xce1o\xe2P\x0c\x07\xf0=\x9f\xe2/q\xc31\x10\x84nA\xd9\xa2\x90\x93\x18\xeeTQ\xd4\xb5r\xdf3\xe0&y\xa6~N\xda\x8f_\x05\xd8\xbaX\xb2\xfd\xb7\xf5[\xe0xa\x9c\xb4\xef\xf5S\xd2\x19W\xf2p\x01\xc5\x98A\x88b\x1c\\\xa69`\x08l>P\xa23\x1b\\!\xe9\x9d\x83\xa3\xa9!\xc9\x15~a4\x87]\xb1\x98+\x82\xa6\x89-\x8b&\x18\x7f\x8cb\x9c\xd1m36\xe5\xe6\x0f\xd4\xd0\x93\xb3\x95\x05]\xe5\xe5\x1e\xab@W\xe1/\xe74\x1f\xe5\xb2\xdb\xe6Rt=m\x8aNR\xac\xd0\x8c\xd9u8p\xd6\xd1\x02\xef\xf8$I\\4\x15\x03;Er\xaa\n\x80RR\xa7y\x9c\xe7\x167\xf2\xeaa\x9e\xdf\xdd\xcd\xab@\xab\x93\xe9P\xe1\xd7\xef\xa6=\x1c\xf7\x7f\xf7M}l_\xff\xd7\xff\xda\xe7\xa7\xbai\x97\xeb\x9f\x8be\x01$\x1a\xb8Bd\x93\x89c\xb8\x89\xec!\xcae \xa7^\xcfe7\xbeq 3a+E\x8b\xef\x01\x00PK\x07\x08i\x13/\xb1\xfa\x00\x00\x00a\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00)\x00	\x00crd/patches/cainjection_in_offerings.yamlUT\x05\x00\x01\x80Cm8d\x8eAk\xe3@\x0c\x85\xef\xfe\x15\x0f\xb2\x87\xcd!\x13\xc2^\x82o\xc6\xf1B\x0e\xbb\x944\xf4Z\xd4\xb1\xec\xa8\xb6G\xa9FN\xfb\xf3\x8b\x9b\xdez\x11H\xfa\xde\xe3[\xe1|at:\x8e\xfa.\xa9\xc7\x95<^@m\x9bAh\xc58\xba\xdc\x16\xc0\x10\xd9|\xa2D=\x1b\\!\xe9\x95\xa3\xa3\xae \xc9\x15~a\xd4\xa7C\xb1Z&\xa2\xa6\x1b[\x16M0~\x9b\xc58c\xd8g\xec\xc2\xee\x0f\xd40\x92\xb3\x85\x82\xae\xf2t\xc7J\xd0U\xf8\xc39-\xa1\x1c\x86}\x0e\xa2\xdb\xdb\xae\x18$\xb5%\xea9\xbbN'\xce:[\xe4\x03w\x92\xc4ES1\xb1SKNe\x01PJ\xea\xb4\x9c\xf3\xb2\xe2Ky\xf3\xed\xbc\xd4\xdd\x9d7\x916\x9d\xe9T\xe2\xd7\xef\xba9\x9d\x8f\x7f\x8fuun\x9e\xffW\xff\x9a\xc7\x87\xaan\xd6\xdb\x9f\x8fu\x01$\x9a\xb8\x84v\x1d\x9b\xa4>\x87HN\xa3\xf6a\x98_8\x92\x99\xb0\x05\xd1\xe2s\x00PK\x07\x08\xebRX\xf0\xf5\x00\x00\x00T\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00)\x00	\x00crd/patches/cainjection_in_providers.yamlUT\x05\x00\x01\x80Cm8d\x8eAk\xe3@\x0c\x85\xef\xfe\x15\x0f\xb2\x87\xcd!\x13\xc2^\x82o\xc6\xf1B\x0e\xbb\x944\xf4Z\xd4\xb1\x92\xa8\xb6G\xaeFv\xfb\xf3\x8b\x9b\xdez\x11H\xfa\xde\xe3[\xe1|c\\\xb4\xef\xf5]\xd2\x15#y\xbc\x81\xda6\x83\xd0\x8aqt\x99\x17\xc0\x10\xd9|\xa0DW6\xb8B\xd2+GG]A\x92+\xfc\xc6\xa8O\x87b\xb5LDM3[\x16M0~\x9b\xc48\xa3\xdbg\xec\xc2\xee\x0f\xd4\xd0\x93\xb3\x85\x82Fy\xbac%h\x14\xfepNK(\x87n\x9f\x83\xe8v\xde\x15\x9d\xa4\xb6D=e\xd7\xe1\xc4Y'\x8b|\xe0\x8b$q\xd1T\x0c\xec\xd4\x92SY\x00\x94\x92:-\xe7\xbc\xac\xf8R\xde|;/uw\xe7M\xa4\xcd\xc5t(\xf1\xebw\xdd\x9c\xce\xc7\xbf\xc7\xba:7\xcf\xff\xab\x7f\xcd\xe3CU7\xeb\xed\xcf\xc7\xba\x00\x12\x0d\\b4\x9d\xa5e\xcb!\x92S\xaf\xd7\xd0M/\x1c\xc9L\xd8\x82h\xf19\x00PK\x07\x08\xeb\xb3\xf1u\xf5\x00\x00\x00T\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00crd/patches/cainjection_in_quotas.yamlUT\x05\x00\x01\x80Cm8d\x8e1O\xc3@\x0c\x85\xf7\xfc\x8a'\x95\x81\x0e\xbd\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9\xb8\xadIrn}N\xe1\xe7\xa3P6\x16K\xb6\xbf\xf7\xf4\xcd\xb0?1\x0e\xda\xf7\xfa%\xe9\x883y<\x81\xda6\x83\xd0\x8aqt\xb9N\x80!\xb2\xf9@\x89\x8elp\x85\xa4O\x8e\x8e\xba\x82$W\xf8\x89Q\xef6\xc5l\x9a\x88\x9a\xaelY4\xc1\xf82\x8aqF\xb7\xceX\x85\xd5\x03\xd4\xd0\x93\xb3\x85\x82\xce\xf2v\xc3J\xd0Y\xf8\xdb9M\xa1\x1c\xbau\x0e\xa2\xcb\xeb\xaa\xe8$\xb5%\xea1\xbb\x0e;\xce:Z\xe4\x0d\x1f$\x89\x8b\xa6b`\xa7\x96\x9c\xca\x02\xa0\x94\xd4i:\xe7i\xc5\xaf\xf2\xe2\xcfy\xaa\xbb9/\"-\x0e\xa6C\x89\xbb\xfb\xba\xd9\xed\xb7\x8f\xdb\xba\xda7\xef\xcf\xd5S\xf3\xfaR\xd5\xcd|\xf9\xff1/\x80D\x03\x97\xb8\x8c\xea\x94C$\xa7^\x8f\xa1\x1b?8\x92\x99\xb0\x05\xd1\xe2g\x00PK\x07\x08\xe2\x1c\xc2\x90\xf1\x00\x00\x00Q\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00crd/patches/cainjection_in_regions.yamlUT\x05\x00\x01\x80Cm8d\x8e1O\xf3@\x0c\x86\xf7\xfc\x8aW\xea7|\x1d\x9a\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9\xb8\xa9Ir.>\xa7\xf0\xf3\xd1Q6\x16K\xb6\x1f\xfb}\x168\x9e\x19'\x1dG\xfd\x94\xd8\xe3B\x1e\xce\xa0\xaeK tb\x1c\\\xae\x190\x046\x9f(R\xcf\x06WH|\xe7\xe0hjHt\x85\x9f\x19\xcdaW,rE\xd0xeK\xa2\x11\xc6\x1f\xb3\x18'\x0c\xdb\x84M\xb9\xb9\x83\x1aFr\xb6\xb2\xa0\x8b\xbc\xdc\xb0\nt\x11\xfer\x8e\xf9(\x95\xc36\x95\xa2\xeb\xeb\xa6\x18$v\x15\x9a9\xb9N\x07N:[\xe0\x1d\x9f$\x8a\x8b\xc6bb\xa7\x8e\x9c\xaa\x02\xa0\x18\xd5)\x8fSn\xf1\xa3\xbc\xfau\xce\xefn\xce\xab@\xab\x93\xe9T\xe1\xdf\xff\xa6=\x1c\xf7\xf7\xfb\xa6>\xb6\xaf\x8f\xf5C\xfb\xfcT7\xedr\xfdw\xb1,\x80H\x13W0\xeesB\x19\xc8i\xd4\xbe\x1c\xe67\x0ed&l\xa5h\xf1=\x00PK\x07\x08cH\xebq\xf2\x00\x00\x00R\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x009\x00	\x00crd/patches/cainjection_in_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8d\x8e\xb1N\xf3P\x0c\x85\xf7\xfb\x14G\xea?\xfc\x1d\x9a\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9q[\x93\xc4\xb7\xf8:\x81\xc7G\xa1l,\x96l\x7f\xe7\xe8[\xe0xa\x9cR\xdf\xa7O\xd13\xae\xe4\xf1\x02j\xdb\x0cB+\xc6\xd1e\x9a\x01Cd\xf3\x81\x94\xcel\xf0\x04\xd1w\x8e\x8e\xba\x82\xa8'\xf8\x85Q\x1fva1O\xc4\xa4\x13[\x96\xa40\xfe\x18\xc58\xa3\xdbfl\x8a\xcd\x1d\x92\xa1'g+\x02]\xe5\xe5\x86\x95\xa0\xab\xf0\x97\xb3\xce\xa1\\t\xdb\\HZO\x9b\xd0\x89\xb6%\xea1{\x1a\x0e\x9c\xd3h\x91w|\x12\x15\x97\xa4a`\xa7\x96\x9c\xca\x00\x90jr\x9a\xcfy^\xf1\xa3\xbc\xfau\x9e\xebn\xce\xabH\xab\x93\xa5\xa1\xc4\xbf\xffus8\xee\xef\xf7uul^\x1f\xab\x87\xe6\xf9\xa9\xaa\x9b\xe5\xfa\xefc\x19\x00\xa5\x81Kd\xb6I\"\xc7~\xcc\xceF9\xcbY\x07V\xcfE7\xbeq$3a+$\x85\xef\x01\x00PK\x07\x08\xa2&\x0d\xd6\xf9\x00\x00\x00\\\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00/\x00	\x00crd/patches/cainjection_in_serviceclusters.yamlUT\x05\x00\x01\x80Cm8d\x8e\xb1N\xf3P\x0c\x85\xf7\xfb\x14G\xea?\xfc\x1d\x9a\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9q[\x93\xe4\xba\xf8:\x81\xc7G\xa1l,\x96l\x7f\xe7\xe8[\xe0xa\x9c\xb4\xef\xf5S\xd2\x19W\xf2x\x01\xb5m\x06\xa1\x15\xe3\xe82\xcd\x80!\xb2\xf9@\x89\xcelp\x85\xa4w\x8e\x8e\xba\x82$W\xf8\x85Q\x1fva1ODM\x13[\x16M0\xfe\x18\xc58\xa3\xdbfl\x8a\xcd\x1d\xd4\xd0\x93\xb3\x15\x81\xae\xf2r\xc3J\xd0U\xf8\xcb9\xcd\xa1\\t\xdb\\\x88\xae\xa7M\xe8$\xb5%\xea1\xbb\x0e\x07\xce:Z\xe4\x1d\x9f$\x89\x8b\xa60\xb0SKNe\x00(%u\x9a\xcfy^\xf1\xa3\xbc\xfau\x9e\xebn\xce\xabH\xab\x93\xe9P\xe2\xdf\xff\xba9\x1c\xf7\xf7\xfb\xba:6\xaf\x8f\xd5C\xf3\xfcT\xd5\xcdr\xfd\xf7\xb1\x0c@\xa2\x81Kd\xb6I\"\xc7~\xcc\xce\x96\x8bn|\xe3Hf\xc2V\x88\x86\xef\x01\x00PK\x07\x08\x97%|\x92\xf3\x00\x00\x00R\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00crd/patches/cainjection_in_tenants.yamlUT\x05\x00\x01\x80Cm8d\x8e1o\xe2`\x0c\x86\xf7\xfc\x8aW\xe2\x86c \x08\xdd\x82\xb2E!'1\xdc\xa9\xa2\xa8k\xe5~1\xe0&\xb1\xa9?\x87\xf6\xe7W)\xdd\xbaX\xb2\xfd\xbc\xaf\x9e\x05\x8e\x17\xc6\xc9\x86\xc1\xdeE\xcf\xb8R\xa4\x0b\xa8\xeb2\x08\x9d8\xa7\x90\xdb\x0c8\x12{\x8c\xa4tfG\x18D_9\x05\x9a\x1a\xa2a\x88\x0b\xa39\xec\x8a\xc5<\x91Lo\xecYL\xe1\xfc6\x89sF\xbf\xcd\xd8\x94\x9b?0\xc7@\xc1^\x16t\x95\xa7;V\x81\xae\xc2\x1f\xc1:\x87r\xd9os)\xb6\xbem\x8a^\xb4\xab\xd0L9l<p\xb6\xc9\x13\xef\xf8$*!\xa6\xc5\xc8A\x1d\x05U\x05@\xaa\x164\x9f\xf3\xbc\xe2Ky\xf5\xed<\xd7\xdd\x9dW\x89V'\xb7\xb1\xc2\xaf\xdfM{8\xee\xff\xee\x9b\xfa\xd8>\xff\xaf\xff\xb5\x8f\x0fu\xd3.\xd7?\x1f\xcb\x02P\x1a\xb9B\xb0\x92F.\x13\x05\x0dv.\xfb\xe9\x85\x13\xb9\x0b{)V|\x0e\x00PK\x07\x08\x01\xe8r\x13\xf3\x00\x00\x00R\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00crd/patches/webhook_in_accounts.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aA|\xf8\xff\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4z,\xb1^/\xd5%e\xd7o_\xc8v\xd2\xf4\"@$g8\xfcv\x85\xaf#\xb1\xb3\x9c\xed\xa4e\xc0$\x91F\xb0H\x9f\xe9HV\x8e\xac\xaeVpb?\x9a\xed\xb1\xb3\x8a\xcd\xebK\xb3Z\xbe\x1f\x07*\x7f\xcdZ\xe9\xd8?9\xd6\xed\xfa3\xac\"K\xb0\xb6\x8dL\xfa\xed\xea\xd3A&\xe5\xef`Y\\\xbd\xdd?y\xab\xf6x\\\xf7\x0cY7{-\xdb\x0e\x9b\xd9\xc3\x0e\xaft\x9bk\xe2\x0bwZ4\xd4Js`\xc8VB\xba\x06(r`\x07I\xc9\xe6\x12\xde&	\xc96\xb4\xfb\xb9g\x92Z\x95\xb5Uk|bZ\xa6\xff\x06]\xfe\x00\x8f*\xc1\xe1\xdc\xe1\xfb\xf5\xb0K\xf5v\xe4&+Kl\xac\xect\xb8\x8e\x03+\xc4\xa8\x0eu\xdc\xfd(w\x98\x9d[\x88C0eI\x1c-oY\xefa1\xb2\x9e\xd4	\x0d\x9c4g\xf4D\xe5O\xa6\xe0\x16\xfd\x191r!\xe0\xacG\xd6\x0b\xcc\x9e\x0b\xf7>K\xd9\xdf\xbf\xef\xea\xe7\xc0\x89\xffUb\xb0\xa5\x1d\x06g,\xa6\x17\xa2\x98\xfdR\x1d\x89\xc4\x1a\x0f\x07)2\xb0\xe2\x7f\xab\x98,XB%\xe7\xf3\x92\xee\xf2\x9e\xbaC\xb1\xb8\xa9>*>\xdd6&\xf92\x97mf\x87\xcd\xf0\xfc|+.!5\xf1\x8d\xc0\x15\xb9O\x92\xd8\xc1\xcf\x1e<\xfc\xd3\xe9\xde\xf0=\xdc\x84\xef\xddIb\xec\xf0\x98\xac\x1cY\xa3\xf93\x00PK\x07\x08`\xae\xa9Fw\x01\x00\x00t\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00*\x00	\x00crd/patches/webhook_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aA|\xf8\xff\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4z,\xb1^/\xd5%e\xd7o_\xc8v\xd2\xf4\"@$g8\xfcv\x85\xaf#\xb1\xb3\x9c\xed\xa4e\xc0$\x91F\xb0H\x9f\xe9HV\x8e\xac\xaeVpb?\x9a\xed\xb1\xb3\x8a\xcd\xebK\xb3Z\xbe\x1f\x07*\x7f\xcdZ\xe9\xd8?9\xd6\xed\xfa3\xac\"K\xb0\xb6\x8dL\xfa\xed\xea\xd3A&\xe5\xef`Y\\\xbd\xdd?y\xab\xf6x\\\xf7\x0cY7{-\xdb\x0e\x9b\xd9\xc3\x0e\xaft\x9bk\xe2\x0bwZ4\xd4Js`\xc8VB\xba\x06(r`\x87$!\xd9\x06\x96\xa8Jo\x93\x84d\x1b\xda\xfd\xdc3I\xad\xca\xda\xaa5>1-\x9a\xbfq\x97?\xc0\xa3Jp8w\xf8~=\xefR\xbd\x9d\xba\xc9\xca\x12\x1b+;\x1d\xae\xe3\xc0\n1\xaaC\x1dw?\xca\x1df\xe7\x16\xe2\x10LY\x12G\xcb[\xd6{X\x8c\xac'uB\x03'\xcd\x19=Q\xf9\x93)\xb8E\x7fF\x8c\\88\xeb\x91\xf5\x82\xb4\xe7B\xbf\xcfR\xf6\xf7\xef\xbb\xfa9p\xe2\x7f\x95\x18li\x87\xc1\x19\x8b\xe9\x85+f\xbfTG\"\xb1\xc6\xc3A\x8a\x0c\xac\xf8\xdf*&\x0b\x96P\xc9\xf9\xbc\xa4\xbb\xbc\xaa\xeeP,n\xaa\x8f\x8aO\xb7\x8dI\xbe\xcce\x9b\xd9a3<?\xdf\x8aKHM|#p\x05\xef\x93$v\xf0\xb3\x07\x0f\xfft\xba7|\x0f7\xe1{w\x92\x18;<&+G\xd6h\xfe\x0c\x00PK\x07\x08\"	(\xc8z\x01\x00\x00z\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00crd/patches/webhook_in_catalogs.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aA|\xf8\xff\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/\xd4z,\xb1^/\xd5%e\xd7o_\xc8v\xd2\xf4\"@$g8\xfcv\x85\xaf#\xb1\xb3\x9c\xed\xa4e\xc0$\x91F\xb0H\x9f\xe9HV\x8e\xac\xaeVpb?\x9a\xed\xb1\xb3\x8a\xcd\xebK\xb3Z\xbe\x1f\x07*\x7f\xcdZ\xe9\xd8?9\xd6\xed\xfa3\xac\"K\xb0\xb6\x8dL\xfa\xed\xea\xd3A&\xe5\xef`Y\\\xbd\xdd?y\xab\xf6x\\\xf7\x0cY7{-\xdb\x0e\x9b\xd9\xc3\x0e\xaft\x9bk\xe2\x0bwZ4\xd4Js`\xc8VB\xba\x06(r`\x87$!\xd9\x06o\x93\x84d\x1b\xda\xfd\xdc3I\xad\xca\xda\xaa5>1-\xd3\x7f\x83.\x7f\x80G\x95\xe0p\xee\xf0\xfdz\xd8\xa5z;r\x93\x95%6Vv:\\\xc7\x81\x15bT\x87:\xee~\x94;\xcc\xce-\xc4!\x98\xb2$\x8e\x96\xb7\xac\xf7\xb0\x18YO\xea\x84\x06N\x9a3z\xa2\xf2'Sp\x8b\xfe\x8c\x18\xb9\x10p\xd6#\xeb\x05f\xcf\x85{\x9f\xa5\xec\xef\xdfw\xf5s\xe0\xc4\xff*1\xd8\xd2\x0e\x833\x16\xd3\x0bQ\xcc~\xa9\x8eDb\x8d\x87\x83\x14\x19X\xf1\xbfUL\x16,\xa1\x92\xf3yIwyO\xdd\xa1X\xdcT\x1f\x15\x9fn\x1b\x93|\x99\xcb6\xb3\xc3fx~\xbe\x15\x97\x90\x9a\xf8F\xe0\x8a\xdc'I\xec\xe0g\x0f\x1e\xfe\xe9to\xf8\x1en\xc2\xf7\xee$1vxLV\x8e\xac\xd1\xfc\x19\x00PK\x07\x08M\xcc2ew\x01\x00\x00t\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00crd/patches/webhook_in_offerings.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdb@\x0cD\xef\xfa\x8aArh\x0b$\n\x8c^\x02\x01\xb9\xd4\xf9\x82\xa0h/\xbdP\xab\x91\xc4j\xbdTw)\xbb\xfe\xfbB\xb6\x12\xb8\x97=\x90\x9c\xe1\xf0\xed=\xbe\x8fDo1\xdaI\xd3\x80Y<\x8c`\x926\xb2 X:2\x17\xb5\x84\x13\xdb\xd1lBo\x19\xfb\xb7\xd7\xea~}o\x072\xff,\x9aY0=\x17\xec\xea\xddWXF\x14g\xae+\x99\xf5\xc7\xd5\xa7\x81\xcc\xca\xbf\xce\xb4\xba\x96zz.\xb5\xda\xd3q\xd7\xd2eWM\x9a\xba\x06\xfb\xa5\xb8\x1d\xdeXl\xc9\x81\xaf\xec5\xa9\xab\xa5\xea@\x97N\\\x9a\nHr`\x03\xeb{fMC\xa9\x83\xb8D\x1b\xeaii\x19$ge\xae\xd5\xaa234\x15n\x92\xaeb\xa0x\x16\xe7pn\xf0\xf3z\xd9\xa5\xba]\xb9\x8f\xca\xe4{K\xbd\x0e\xd7q\xe0\x1e>j\x81\x16\xdc\xfdJwX\n;H\x81`\x8e\x128Z\xec\x98\x1f`>2\x9f\xb4\x10\xea8i\x8ch\x89\xcc\xdf\x0c\xce\x0e\xed\x19>rEP\x98\x8f\xcc\x17\x9a-W\xf0m\x944=|\xecj\x17\xc7\x89\x9f21\xd8\xdavC\xa1\xaf\xa6\x17\xa4X\xca\xa5:\x12\x81\xd9\x1f\x0f\x92d`\xc6g\xcb\x98\xcd\x99\\%\xc6\xf3\x9a\xee\xf2\xa1\xda#\x99o\xaa[\xc5\x97mc\x90oK\xea\"\x1b\xec\x87\x97\x97\xad\xb8\x86\xd4\xc0w\x02W\xe6e\x96\xc0\x06\xe5\\\x9c\x87\xff:\xcd;\xbe\xc7M\xf8\xd1\x9d\xc5\xc7\x06O\xc1\xd2\x91\xd9\xab\x7f\x03\x00PK\x07\x08=\xcf\x87\xadw\x01\x00\x00u\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00crd/patches/webhook_in_providers.yamlUT\x05\x00\x01\x80Cm8T\x91\xbfn\xdbL\x10\xc4{>\xc5\xc0.\xbe/\x80M\xc3Hc\x10p\x13\xf9	\x8c i\xd2,\x8f#r\xa3\xd3-\xb3\xb7\x94\xa2\xb7\x0f(\xc9\x8e\xd3\x10\xe0\xfe\x99\x9d\xf9\xdd-\xbeN\xc4\xd6r\xb6\xa3\x96\x11\xb3D\x9a\xc0\"}fE\xb2r\xa0W\xb5\x82#\xfb\xc9l\x87\xad96\xaf/\xcd\xed\xfa\xfd8\xe0\xfc\xb5\xa8\xb3b\xf7T\xf1\xd8>~\x869\xb2\x04\xbdmd\xd6o\x17\x9d\x0e2+\x7f\x07\xcb\xaaZ\xdb\xddSm\xd5\x1e\x0e\x8f\xcdN\xcb\xd0a\xb3\xd4\xb0\xfd+\xab-\x9e\xf8\xc2\xad\x16\x0d\xb5\xd2\xec\x192HH\xd7\x00E\xf6\xec0\xbb\x1dt\xa0\xd76IH\xb6\xb1\xdd-=\x93\xb8+\xbdUk\xea\xcc\xb4\x8e\xff5\xb9\xfe\x015\\\x82\xe3\xa9\xc3\xf7K\xa8s\xf5\x1ap\x93\x95%6V\xb6:^\xc6\x81[\xc4\xa4\x15Zq\xf3\xa3\xdc`\xa9\x1c \x15\x829K\xe2dy\xa0\xdf\xc1b\xa2\x1f\xb5\x12\x1a8j\xce\xe8	\xe7O\xa6\xe0\x80\xfe\x84\x98\xb8\xa6\xaf\xf4\x03\xfd\x0c\xb2\xe7\xca\xbc\xcfRvw\xef\xb7\xfa%p\xe4\x7fN\x8c\xb6\xb6\xc3P\x19\xab\xe8\x99&\x96z\xaeND\xa2\xc7\xfd^\x8a\x8ct\xfco\x8e\xd9\x82%Tr>\xad\xee\xceo\xa9[\x14\x8b\xeb\xd6\xc7\x8dO\xd7\x8bI\xbe,e\xc8\xec\xb0\x19\x9f\x9f\xaf\xc5\xd5\xa4&\xbe\x11\xb80\xaf\xb3$v\xa8\xa7\x1a\xdc\xff\xd3\xe9\xde\xf0\xdd_\x17\xdf\xbb\xb3\xc4\xd4\xe1!Y9\xd0\xa3\xf93\x00PK\x07\x08\xd2\x03\xbe\xdfu\x01\x00\x00p\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00crd/patches/webhook_in_regions.yamlUT\x05\x00\x01\x80Cm8T\x91\xbfn\xdbL\x10\xc4{>\xc5\xc0*\xbe/\x80MCHc\x10p\x13\xf9	\x8c i\xd2,\x8f#\xf2\xc2\xd3-\xb3\xb7\x94\xa2\xb7\x0fH\xd1\x8e\xd3\x10\xe0\xfe\x99\x9d\xf9\xdd\x0e_\x07\xe2\xa8)\xe9%\xe6\x1e\x93x\x18\xc0,mbA\xd0|\xa6\x95\xa8\x19\x17\xb6\x83\xea\x88\xa3\x1a\x0e\xaf/\xd5n\xf9~\x1c0\xfe\x9a\xa3\xb1`|*\xd8\xd7\xfb\xcfPC\x12\xa7\xd5\x95L\xf1\xdbM\xa7\x81L\x91\xbf\x9dyQ-\xf5\xf8T\xea\xa8\x8f\xe7}5\xc6\xdc58\xcc\xc5\xf5\xf4\xca\xa2\xb3\x05\xbe\xf0\x18s\xf4\xa8\xb9:\xd1\xa5\x13\x97\xa6\x02\xb2\x9c\xd8\xc0\xd8\xaf\x12A\\\x92\xf6\xf58\xb7\x0cb\x16iu\xd4\xaaL\x0c\xcb\xf0_\x8b\xcb\x1fP\xdc\xc4\xd9_\x1b|\xbfEZ\xab[\xbcC\x8a\xcc~\xd0|\x8c\xfdm\x1c\xd8\xc1\x87X\x10\x0b\xee~\xe4;\xcc\x85\x1d\xa4@0%	\x1c4u\xb4{\xa8\x0f\xb4K,Dt\\bJh	\xe3O\x06g\x87\xf6\n\x1f\xb8d/\xb43m\xc5\xd8r!\xde&\xc9\xe3\xfd\xfb\xadvv\\\xf8\x9f\x11\xbd.mW\x14\xfa\"\xba\xb2\xc4\\\xd6\xea@\x04\x9a?\x9c$KO\xc3\xffj\x98\xd4\x99=JJ\xd7\xc5\xdd\xfa\x92\xf1\x88\xac\xbem}\xdc\xf8\xb4]\x0c\xf2e\xce]b\x83C\xff\xfc\xbc\x15\x17\x931\xf0\x8d\xc0\x8dx\x99$\xb0A\xb9\x16\xe7\xe9\x9fN\xf3\x86\xefa[|\xefN\xe2C\x83\xc7\xa0\xf9L\xf3\xea\xcf\x00PK\x07\x08D\xa1\xa7,r\x01\x00\x00n\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00crd/patches/webhook_in_tenants.yamlUT\x05\x00\x01\x80Cm8T\x91\xbfn\xdbL\x10\xc4{>\xc5\xc0.\xbe/\x80M\xc3Hc\x10p\x13\xf9	\x8c i\xd2,O#r\xc3\xd3-s\xbb\x94\xa2\xb7\x0f(\xc9\x8e\xd3\x10\xe0\xfe\x99\x9d\xf9\xdd-\xbe\x8e\xc4\xcer\xb6\xa3\x96\x01\xb3D\x1a\xc1\"}\xa6#Y9\xb0\xbaZ\xc1\x91\xfdh6ag\x15\x9b\xd7\x97\xe6v\xfd~\x1c\xa8\xfc\xb5h\xa5czr<\xb6\x8f\x9fa\x15Y\x82\xb5md\xd6o\x17\x9d\x0e2+\x7f\x07\xcb\xaa\xea\xed\xf4\xe4\xad\xda\xc3\xe1\xb1\x99\xb4l;l\x16\x0f\xdb\xbf\xd2m\xa9\x89/\xdci\xd1P+\xcd\x9e![	\xe9\x1a\xa0\xc8\x9e\x1d\x82EJx\x9b$$\xdb\xd0NK\xcf$\xb5*k\xab\xd6\xf8\xcc\xb4\x0e\xff\xb5\xb8\xfe\x01\x1eU\x82\xc3\xa9\xc3\xf7K\xa4s\xf5\x1ao\x93\x95%6Vv:\\\xc6\x81[\xc4\xa8\x0eu\xdc\xfc(7X\x9c[\x88C0gI\x1c-oY\xef`1\xb2\x1e\xd5	\x0d\x1c5g\xf4D\xe5O\xa6\xe0\x16\xfd	1r\xcd\xee\xac\x07\xd63\xc6\x9e+\xf1>K\x99\xee\xdeo\xf5K\xe0\xc8\xff*1\xd8\xda\x0e\x833V\xd13K,~\xae\x8eDb\x8d\xfb\xbd\x14\x19X\xf1\xbfU\xcc\x16,\xa1\x92\xf3iuw~I\xdd\xa1X\\\xb7>n|\xba^L\xf2e)\xdb\xcc\x0e\x9b\xe1\xf9\xf9Z\\Mj\xe2\x1b\x81\x0bq\x9f%\xb1\x83\x9f<\xb8\xff\xa7\xd3\xbd\xe1\xbb\xbf.\xbewg\x89\xb1\xc3C\xb2r`\x8d\xe6\xcf\x00PK\x07\x08\xba\x93/vs\x01\x00\x00n\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xccVM\x8f\xe36\x0c\xbd\xebW\x10\xe3CZ`\xe3\xa0=\xfa\x96\x06nw\xd0\xce\x072\xb3\xdbCQ\x0cd\x89\x8e\xb5#\x8b\xae$'\x93\xfd\xf5\x05e;N\x06\xddnQ\x0c\xd0\xde\x1c\x91|||\xa4\xa8d\xb0\xd6:\x80\x93-\x86N*\x84H \xad\x05\x8f\x81z\xaf0\xe4\xe2d+\xe0\xb9\xafPI\xef\x0d\xfae8\x86\x88\xad\x10\x19|\x94\xb6G\xa0\x1abc\x02\xd4\x06\xad\x06\x13\xa0\xf3\xd8\xa1\xd3\xa8\x1936(\xb2!\x0d{^\xa4x\x07\x98\xefr\x90\xa0\xb1\xb3tl\xd1\xc5\xe4\xa9E\x06W\x07\xf2\xba\xf3\x18\xc2\x15T\xa8\x88\xe3\xaf\xa45\n\xc3r6\xe5\"\x83[\x8a\x08\xb1\x91\x11L\x84\xd0Po5H\x1b\x08Z\x19U\x03\x07\x13\x1bf\xc1\xb4j\xf3\x02\xdfD|\x89PaM\x1ea\xb1\\|\xcb\xb4\xd8~*Wdc-\xb2\xa2=\x0e:\xdc\xa7\xe0K!Z\xe9\xe4\x0e\xfdR\x08EmK\xee\x17Y\xa1\x0d\x85\x80s\xaf\xdc\xd0\xca\x93\xc5\x02Fw!*\x190\x14b	y\xbeR^\x0f\x1f\xbe\x92j\xf8\x9a\xfc2\xf8\xed\xd7\xf2\x87\xf7ww?\xff\x0e\x8f\x04\xe8de\x11\x0eX5D\xcf\xef\xa0w\x9c\x95%cM\xb9\x80\x80*\x1ara(y\x0e\x1e\x0b7N\xd9^\x1b\xb7K\xce\xe4\x10\x8c\x03\xe5\xf5\xea\xb9\x0f\x91Z\xf3Yrt~\x94\xad\x1d\x88\x8c\xa9\x98\xc8\xa6\xdc>\xde\xaco\xd7?\x95\xdbs2\n}\x9cdx\xcd\xe8\x92\xcd\xe2\x0ca\x91\xc3b$\xb7\x00EmG\x0e]\x0c =\x82\xc7?z\xe3Q\xe7\xa3<\xe8\xe3\x88\xcf4\xee\xb7w7\xe5\xe3\xfb\xf2\xc3\xc39\x8b\xceS\x8b\xb1\xc1>@K\xceD\xfa\x1a\x97\x19f\x91\x8b,%\x9a1\x84\xe8xn0<D/#\xee\x8c\xbaA\xbfCnk\x06\xd75\x1c\xa9\x87\x83t\x91?<(r\xd1\x93\xb5\xf38\xf0\xd0\xe3KG\x81\x87\x12a\xd5b\xf4F\x85\x14\x8eNwd\\\x84\xc3\x8a@\xba#\xc8>6n\xf5\xf9\x9c/7\xa7&k\xe9\xc0\xad\xb2\xc6!H\xa7S\xf8T\xd2\xa8\xc8\x13G?u\x9e^\x8eO\x89s\xea]\x9e\\\xef\x9c=\xa6\x1eS\xfd\xf7\xee'\xf0\xc9kV\xe2i\xa4~\x06>\xdd\xaf\n\xc7y\xd4I\xc0\x7f\x14+\xfe\xe3\x89\x9eH\x8e\xf9^3{\xe3\x11\x17\x19|89_\x98\xe6\xb0/\xde\xbf4C\xc3p\xf38l\xd6`\xdc\xa7A\x0b\x0e\xe23\xa9[\x13\x02\x1f\x8c\xf5\x04Ny\x99\xc8!\xea\xc0Xs\xbb\xf8W\x1f\x10\x94\x9c!\xc5r\xc2P\xf2t\xf8J\x9e\xcb\xa9T\xe4j\xb3\xe3]_\x93\x87\x88R5\xdc\x85i\x91 4t\xe0L\x9a`/=\x84\xbe\n\xd1\xc4>%\xdbK\x1f\x8a\xb7\xdf)\xe3\x96\xe3\xbd\xc1\x8b\xbc\x00V\xe2\xfa\xc7\xeb\xcd\xfa\xb1|\xba]\xdf\x94\x0f\xf7\xebM	\xe3S\x94^\xbcq\xefsBS\x1b%#\xc2f+\x00\xa8\xfa\xe4\xb1\xe6\x0b\x0f\xf0l\x9c.`3\xbb\xa4\xd3\x9d\xa7\xbe+.\xa8\xe6\x86\x92i\x8f\x9e\xbbR\xc0\xfe;i\xbbF~\x9fN\x07J\x01\xfd\xde\xb8\xdd\x92\xe3 \x1b^M\xb6L\xb7jx\xb0\xce\xb7\xf3\x9cw\xe8\x04\x0c/\xd3\x89^\xfa\xd5\xc9\xd8\x14\xd0b\x94ZF\x99\xcf\xef\xd8\x97\xa4\xf8\x7f\xd78\xb1~(\xb7\x1f\xaf7_i^\x92T\xe1_V\xf4p\xb2]P>k\xc88\xf6\xcb\x19\xe5\xdf\xc8{N\xf4\x8d\x88\xcc\xd0\x9bm\xf9\xf8\x96\xc8b\xb8\xbb\xbdO\xdb&\xfd\x0d9]\xdb\xc1\x94\x1fek\xc5\x9f\x03\x00PK\x07\x08\x83Jq\xaao\x03\x00\x00 \n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x00_\x00\xa0\xffvarReference:\n  - kind: Deployment\n    group: apps\n    path: spec/template/spec/volumes/secret\n\x03\x00PK\x07\x08\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8dP=O\xf30\x10\xde\xf3+nx\x87\x97!\n\x1f\x1d\xc0\x1b*\x1d[*\xa8X\xd1\xe1\x1c\xadU\xdbg\x9d/E\xfd\xf7\xc84	\x89x&\xeb\xf9\xb4\x0e\x93{#\xc9\x8e\xa3\x01L)7\xa7\x9b\xea\xe8bk\xe0\x89\x92\xe7s\xa0\xa8U \xc5\x16\x15M\x05\x101\x90\x01\xcbQ\x85\xbd'\xa9\x03F\xdc\x93\xf4RNh\xc9@>g\xa5P\xe5D\xb6\x84\x94B\xf2\xa8T\xde\x00\x03[P\x8a\xd0E\x92<0u?\xf1\xdb{Ab\xd1\xd1Tlct\xcb\xa2\x06\x1e\x16\x8b\xbbQ\x1d\xfe\xf9E\x1f\x07\xe6c\x9dIN\x93.\x80$\xacl\xd9\x1b\xd8-\xb7#\x7fb\xdf\x05Zs\x17\xe7S\xa10[\xd4\x83\x81FCj\x8e\xf7\xb9\x9eW7e\xc1\xc5}mI4O\x86\xfa{\x91\xe8\x84\x14\xc2\xf69\xfa\xb3\x01\x95\x8ez\xe12\xfe\xe7\x0e\xb3h&+\xa4\x83\xa5\xa0\xa5O\xec\xbc\xae\xb9%\x03\x8b\xdb\xeb\x89t1o~Z\xfe\xfd\x7f]-_V\xbb\xf7\xcd\xe3zu5\xfb\xbc\xb3T[\x12\xad\xbe\x07\x00PK\x07\x08\xf2\xcb'\xa8\x0c\x01\x00\x00\x0c\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\xd0=k\xf30\x10\x07\xf0]\x9f\xe2\xc0\xcf\xf0dPB\xb6\xe2-\x18\x172\xa4\x946\xa4c9[\x17\xfbjK\n\xd29\xa1\xfd\xf4Ey\xed[\x86BG\xa1\xfb\x1f\xff\xdfe\xb0l9\xc2\x06\xa5n\x01\x8d\x01t\xce\x0b\n{\x07\xe2\x01\x8d\xe5\x18\xd3cGU\xeb}\x07\xb5wkn\x00\x9dQ\x19\x94\x0e\xab\x9e@\xd2\x8a\xb5\x0f`\x87\x14u\xcdiZe -\xc1\x16\x03\xa7\xc1\x08\xff\xfe\x17\xe5\xc3r~;/f\xcb\xf2\xf9n\xb6(\x1f\xefgE9J\xfb~\xf8\x1c\xc1\x8e\xfb\x1e*\x828TQX\x06!\x03\xd5+tC\x14o\xf9\x8d\xc6*\xc3\x0d\xaf(\xa4\x92\xf9\xa5o\xa0\x86\xa3\x84=d\xdc\xdd\xc41\xfb\xc9vZ\x91\xe0Te\x1d;\x93\xc3\xe2X\xf6\xe9 +\xf6\xb0\xe1\x10Q\x99%A\x83\x82\xb9\xca\x00\x1cZ\xca\xcf:}\xd4\xe9\xfas\x04>\x1c/\xees\x005\x05\xd1\x16\x1d6\x14R\x07v/T\x8b\xaeQ\xaf\x83\xb7\xf9\xd5\x83L\xbe\x7f\x8c\x94\xd6Z\xfdZ{\xc0\xae\xb0gs\x9d{\xd1\x9e\xb0\xdbs\xe0\n\xf7\x8b\xf6\xcf\xb1\xef\x03\x00PK\x07\x08mK01\x1c\x01\x00\x00\x9a\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- manager.yaml\n\x03\x00PK\x07\x08\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4SMo\xdb0\x0c\xbd\xe7W\x10A\x0f\xdd\xc1u\x02\xf4\xb0	\xe8!K\xbd\xa2X\xda\x05i\xd7k\xc1\xc8l,D_\xa5d\x0f\xd9\xaf\x1f\x94\xd9\xae]l@\x86\xf1\x92\x80|\xef\x91|\x94\xd1\xab'\xe2\xa0\x9c\x15\x80\xde\x87\xbc\x99O\xf6\xca\x96\x02\xae\xc9kw0d\xe3\xc4P\xc4\x12#\x8a	\x80EC\x02\xa4\xb3\x91\x9d\xd6\xc4\x99A\x8b;\xe2\xb6\x14<J\x12\x10\x0e!\x92\x99\x00h\xdc\x92\x0e\x89\x08\x1d)\xf3\x1a\xed\x9f5\x82'\x99\xb0\x814\xc9\xe88\xfd\x070\x18e\xb5\x1a\x08\x9d\"\x05\xc0\xe4\xb5\x92\x18\x04\xcc'\x00\x91\x8c\xd7\x18\xa9\x95\x1cl\x040\x1e\xf3\xb4Q\x13\xaa\x1b7E n\x94\xa4\x85\x94\xae\xb6\xf1\xfe\xe8R\xc0\xb6\x98VEe\x89['\x002P\x06w$\xe0\xb5\xc6\xc3\x85r\xf9\xbe\xde\x92DfE\x9c\xb7v\x884o\x88-\xa3\xb3\xbe\xf3\xaa\xcb\"\xefz\xd5\x14\x19L\xb3L\x12\xc7\xacT|uv\xbe,6\x8f\xcf\xd7\xb7\x9b\x0f\xd3w\xa0\xe6\xea\xec|\xf5\xed\xe6yU<\x15\xabA\x95l3\xd6Kw\x15\xf0\xf5\xfb\xe7b\xb9\xd8ln\x8b\xcd\xf3\xfd\xe2\xaexX/\x96\xc5\x00\x07\xd0\xa0\xae\xe9\x0b;3\xa4\xa7xQ\xa4\xcb\x0d\xbd\xbc\xcf\xb7\x955\xc6J\xf4'\xb9\xe8\x9f\xd1\x00\xdd\x0d\xd1-3\x12:\xf6\x150\xcd\xa3\xf1\xf9\xfec\xc8~\xd0\xb6rn\x9f\xa5\x9b\x10\xe7\xe9G\xd9\xdd\xd1\x94\xf0\xb6'Sp5K\x1a\xb9\xa7\x95Qq\x94\x01\x90\xbe\x160\x9d\xbf1S\x182\x8e\x0f\x02\xe6\xb3\xd9\x9d\x1aT\x98^k\n\xff!\xe1\x1d\x0f\xd9\xdd\xe6LX*K!d	0h\xd8\xbf\xad\xb5\xe3(\xe0\xd3\xe5\xe5lP\xf5\xec\xa2\x93N\x0bx\\\xae\xfb|/\xb6f\xb7m?\x89\xdfQ\xc5\xe8o(\x0eS\x00\xfex\xa0<\xb1\x0e?\xc7\x95c\xcf\xbf\xcc\xa6UC\xff\xdc\xa4\"\xd4\xb1:\xa1K\x8b\x88\xc4FY\x8c\xca\xd9\x1bFIkb\xe5\xca\x07\x92\xce\x96A\xc0|6\xf95\x00PK\x07\x08\xe3u\x89\xd4\xfb\x01\x00\x00\xe3\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- monitor.yaml\n\x03\x00PK\x07\x08*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8\x8c\x8e\xbdjs1\x0c\x86w_\x85\xe0[\xbe\x0eN\xe8\xea{\x08\x14\n\xddU\x9f\x97\x1cS[2\x92\x1a\xe8\xdd\x97\xd38[\x87n\xfay\x7f\x9e\xf4\x8f^L\x07b\xc7\xa7\xd3E\xa5\x85\x1a\xbd\xc2n\xad\x82\xfe_\x10\xd6\xaa?%\x9e\xed\x0d\xe6M\xa5\xd0\xb8\xab\x9a\\OU\x0d\xea\xa7\xaa\xe3|{N\x1fM\xb6\xf20\xaf\xac4\x10\xbcqpID\x9d\xdf\xd1\xfd\x98\x88\xaaJ\x98\xf6<;\x0b\xcac\xed\xb0<X\xf8\nKD\xc2\xe3\xd7W\x1ew\xae\xbcP\x96\xd4'W\x14\xf2/\x0f\x8c\xe4\x13\xf5\xa8\x82lS\x9b\xc4\xea\xcd49\xf6B\xe7\x95\xf1\x03C4\xd5\xa2\xd0\x1e1\x8f\x8b\xa3\xa3\x86\xda_Q\xbf\x07\x00PK\x07\x08-\xd9\xee\xd9\xb6\x00\x00\x00G\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8\x94\xce\xb1N\x041\x0c\x04\xd0>_am\x9fEt(--\xd5\x81h\x10\x8577\xcaE\xeb$'\xc7\xa1\xe0\xebQV\x8b\xa8q9\xd2\x1b\x8f\xf7\xde\xf1=\xbfC{n5\x90n\x1cW\x1evk\x9a\xbf\xd9r\xab\xeb\xfe\xd4\xd7\xdc\x1e\xbe\x1e\xdd\x9e\xeb5\xd0\xb3\x8cn\xd0K\x13\xb8\x02\xe3+\x1b\x07G\x14\x15\x07x\xcb\x05\xdd\xb8\xdc\x03\xd5!\xe2\x88*\x17\x04\xe2\x94|\xe1\xca	\xeaubNI\x91\x0es\x19\x82\xa3\xe3\xaf\xfb\x15\x82hM\xfb\xcc\x89<\x15\xb6x{\xe1\x0drF\xf3\x96}l\x88\xac\x9a\xa1s\xe5\xf9`	\xb4\x98\x0e,\xff\xb1s\xd4\x84\xbf\x1dN\x87\xa0\x07\xfa\xf8t?\x03\x00PK\x07\x08\x9e\xfbyQ\xb2\x00\x00\x00'\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8f1N\xc50\x0c@w\x9f\xc2\x17H\x11\x1b\xca\x06\x0c\xec\x1f\x89\xddMM0m\xec\xcaI:pzT	U\x95\xa8\xfe\xe6\xe1\xf9=\x9bV\xf9`\xafb\x1aq{\x84Yt\x8a\xf8\xce\xbeI\xe2\xe7\x94\xack\x83\xc2\x8d&j\x14\x01Q\xa9p\xc4J\x10B\x80\xf3\xb2\x8f\x94\x06\xea\xed\xcb\\~\xa8\x89\xe90?\xd5A\xec\xe1\xd0\xbe.\xbd6\xf6\x9b-\xfc\":\x89\xe6\x0bu!\xa5\xcc\x1e\xdc\x16\x1e\xff\xa8}\xbe\xf1\xe7\x0e\xd1*on}\xbd\x13\x04\xc4\x7f\xbd\xe3r\xca9\x9c\x13P\xfb\xf8\xcd\xa9\xd5\x08\x01/\xbfGT*\x1c\xb1\x12\xfc\x0e\x00PK\x07\x08J\xa69\xe5\xa3\x00\x00\x00-\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x7f\x00\x80\xffresources:\n- role.yaml\n- agg_role.yaml\n- agg_role_binding.yaml\n- leader_election_role.yaml\n- leader_election_role_binding.yaml\n\x03\x00PK\x07\x08t|\xfd\x8c\x86\x00\x00\x00\x7f\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\xc3@\x0c\x86\xf7{\n\xab\xccI\xc5\x86\xf2\x02\xec\x0c\xec\xee\xddO{\xea\xe5|\xb2}A\xe2\xe9QB\xca\x00B\xa2\xdb'\xdb\xff\xf7\xfb\x81\x1at\xcefY\xaa\x91\x0b%\xa1\x02NPBA\xf4,u\x0c\xdc\xf2+t=\x99HO\x1cG\xee~\x11\xcd\x1f\xbc\xed\xafO6f9.\x8f\xe1\x9ak\x9a\xe8E\n\xc2\x0c\xe7\xc4\xceS \xaa<c\xda\xb5\xc3M;\xe8z\xa6\xbd\xc0\xa60\x10\xb7\xfc\xac\xd2\x9b\xad\x81\x81\x0e\x87@\xa40\xe9\x1a\xb1\xcf\xa2\xd4\xb7|\x9e\xb9Y Z\xa0\xa7}~\x86o\x99\x92\xed\x0b\xde\xd9\xe3e\xa3\xa8`\xc7\x86\xbd\xa5\x1b\xb6\xef}B\x81\xe3\xde\xfa\xa39{\xff\xe3\x8b_=\xff\x92cA\xf5\x1f\xc6\xa8`G\xf8\x1c\x00PK\x07\x08\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd\xb1\x8e\xc20\x0c\xc6\xf1\xddO\xe1\x17hO\xb7\x9d\xb2\x1d\x0b{\x91\xd8\xdd\xc4\x80ijWN\xd2\x81\xa7G\x95*\xd8\xbay\xf0\xef\xfb\xd3\"W\xf6\"\xa6\x01}\xa4\xd8S\xab\x0fsyQ\x15\xd3~\xfa+\xbd\xd8\xcf\xfa\x0b\x93h\n8X\xe6\x93h\x12\xbd\xc3\xcc\x95\x12U\n\x80\xa84s\xc0\xcc\x94\xd8;\xce\x1c7\xdd\xb9e\x1e\xf7\xef\xed\x1e\xf8\x16\x00\x91\x169\xbb\xb5\xe5\xa0\x08\x88\xdf\xe0\xe1>\x946>9\xd6\x12\xa0\xdb\xcd\x85}\x95\xc8\xff1Z\xd3\xfa\xd1\x85\xe0=\x00PK\x07\x08+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xcc\x981o\xdb0\x10\x85w\xfd\n\"K\xa68\xe8Vh\xed\xd0\xbd(\xba\x9f\xa9g\xe5`\x8a$\x8eG\xb5\xe9\xaf/\xa4*E\x1cEv,[r7\x81\x10\xf9\xde}G\xdeI\xa4\xc8? \x89\x83/\x8dl\xc9n(\xebS\x10\xfeM\xca\xc1o\xf6\x9f\xd3\x86\xc3c\xfb\xa9\xd8\xb3\xafJ\xf3\xc5\xe5\xa4\x90o\xc1\xa1h\xa0T\x91RY\x18c\x05\xfd\x84\xef\xdc )5\xb14>;W\x18\xe3\xa9Ai\x1a\xf2TC\x1e\xa4\x9bh\x8c\xa3-\\*\x0bc\x8c\xd9\xe7-,\x890\xa4\x93\x1a\xde,\xcd\xbdJ\xc6}!\xd9!\x95\xc5\x83\xa1\xc8_%\xe4\xd8O{0ww\x851\x82\x14\xb2X\x0cch\xe15\x15\xc6\xb4\x90\xed0\xd6;C\xff\x18I\xed\xd3\xc7V\xea\\\xa7H\x16\xd3\xabUp\x18\x16\xae\xa1\xfd\x90\xe3\xa4\xaf\x94\xba\xa7\x1c\xab\x97	??.\x9f`\x05G\"\x19	\x9e\x96\xa1\xc8\xf8\xa5\xf0]\xaa\xd3\x90\xd7q\xdc6'\x0d\xcd\x0b\x8c\n;\xf6\xdc\xe5uy\x0c\x96\x94\\\xa87\x87\xdba\xec\x90\xac\x0dy\x94\xe5\x19@\xce\x15|LJ\x9a'tG	\x9f/\x17y\x8f\xe7S\xe1M$\xf9\xa3\"\xc3{\xf0*<s\x8b_\x0f\xf0\xa1\x99\x950\xbf\x12}N\xa3\xa3\xb6N\xb4\xbd\xf0\xba\xf1\xae\x1e\xe7Z\xf1U\x10nQ\x1d\xd6\xaf\xe9V\xb0`\x82\xdfw\xb2\x12\x06\xf6I\xc9[4\\\x0b\xbdS\xb8\x17\x8c{,\xbdR\xcca\xb7\x83\xb0\xaf\xa7\x1b\xff\xb1\x1eu=\x00QB\xcb\x15\xe4\xd6>\x04\xf5\xec\x8e}=\x1a\nO^\x97rqjO\xbc\xf9\x8e\xe1dC\x8b\x05{\xddl?\x17\x9e\x91y\xba\xeft\xbc\x1b\x93\xb8F+<\xc5\"AZ\xb6\xb0\x7f\x7fa(%\xae}\x03\x7f3\x12\x87~./\xd4g\xea]H;D\x08i\x90\x93\x85\xa0+\xe31\xbb\x99\xa5`d\xe4\x08\xf0\xf3-\xad\xc5\x00\x0em\x07\xeb?b\xf0\xcf\xd2Z\x0cv\x90\xd9\x05x\x11\x02\x83\xa1\x0b\xe3\x9f\xbc4\x19\x13\xe8.?\xb6\xec\xab\xd9\xdf*\xe7`8\xd3\xd7\x9b\x9d\xd9\xd9<rL\x91,9\x9aqf\xff\x0c\x00PK\x07\x08\xb8\\\xd2\x1a\x15\x02\x00\x00o\x12\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00S\x00\xac\xffresources:\n- manifests.yaml\n- service.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92?o\xa4@\x0c\xc5\xfb\xf9\x14O\xda\xfa\xe0\xf6\xaa\x13\xedUW\xa4\xc9JI\xed\x05\x03\x160&\x1e\x0f(\xf9\xf4\x11\xa0H\x9b*[mk=\xbd??\xf9\x04\xef\x19\xad\x8e\xa3\xae\x12;\xd4\x1a[\xe9 	\xad\x1a\x9c\xa9\xee\xb7\xf3\x90\x93\xeb$\x1f\x8c\xb5gc\xb8bT\x1d@\xbe\x1d\"R\xbe&\x17\xcf\xbe\x89\x17\xb2T\x84\x13\xfe;\x8c\xdf\xb2\x18\xa7\x1b\x83\xe5Oq.~C\x0d\x91W\xb6\xcdjU\x1b0\x9b\xcel\xe3{\x11\"M\xfc\xcc-\x1b\xc7\x9a\xab\xf0\x0b\x83\xc4\xa6\xc2\x85m\x91\x9a\x03\xb0\xb0%\xd1Xa9\x07\xa0\x15\x1e\x9b\xcb\xccu\xaa\x02\xf0\xa5~\xcaN[\x9bW\xbe\xf6\xaa\xc3\xbf}W6r\xd1\x18\x00\xa03\xcds\x05j&I\x9b\x9bq'\xc9\x0fA1\xfcM\x85\xe8\xae\x9b\xc9\xfb\n\xeba\x93\xcaz\x14\x8e~\xd8\x95\xe9\xa8Tn\x8do\xb2_h\x94\xe6\xc1\xe9;\xb54\xd3-\xb1\x1f\x18\xdcG\xe0\xde\xfd{v\x00jcr\xae\xe0\x969\xdcM\xe4\x01]\xc2B\xf6\xed\xad\x8ee\x13;5\xe4TR\x8c\xea\xe4\xa21\x85\xcf\x01\x00PK\x07\x08w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\xd4\x98\xcbn\xdb:\x10\x86\xf7z\x8ay\x01:\xf0\xee@@\x16\xa7\x8e\xdbM\x17A\x91\xa6\xeb15\x96\x07\xa6H\x95\x17\x15~\xfb\xc2\x12e\xcb\xf0\x85qaT\xea.\xe4\\\xf8\xeb\xff(\x8aq&\x84\xc8\xb0\xe6w\xb2\x8e\x8d\xce\x01\x8b\x8a\xdd\xfeOK%;o\xd1\xb3\xd1\xb3\xed\x7fn\xc6\xe6\xa9\x99\xaf\xc8\xe3<\xdb\xb2.rxG\xc5\x05z\xd6\xe5\x0fZm\x8c\xd9.\x8c^s\x19\xba\x9a\xac\"\x8f\x05z\xcc3\x00i\xa9\x9d|\xe3\x8a\x9c\xc7\xaa\xceA\x07\xa52\x00\x8d\x15\xe5\xd0\x1cZ\x89_]/!O\x9a\xc5Y\x97g\x02\xa4b\xd2\xbe[l\xdf\x1c@\xe2\xa7\xa0\x0bE9,\xca\xe7\xe7v\xca\x91mXR\x17\xef\x97\xe9{\xc7\xe0 \xe6j\x94\x94\x83\xdb9OU\x9c\xaf\xd1orx\x8a\xdaHH\xf4\xa8L)\xb6aE\x12\xade\xb2\x82\x8dh\xe6\xa8\xea\x0d\xce\x05Ji\x82\xf6\x19\xc0\x1aY\x05K\xafF\xb1\xdc\xe5\xf0\x19y\xf8\xa8]\xdal\xd0f\xc6&\x03\xb0A\x91\xdb\x0b\x16\x805\x7f\xb1&\xd4\xed\x10@@\\\xfb\xbc\x08\xe0H\xef\x90\xddKj\xe3\xa6\xa6\xce\xc4Cx\xf1m\xf9\xff\xdb2\x0e\xbe\xbf\xbe\x1c\x07/\xcb\xaf\xcb8\xb0\xe4L\xb0\x92\x0eUQ\xb7\x9b0\x81\xe8\x12iow)\x0c\xc3\xdc	\xb28\xb3\x7f\xa0\x97iL\x08\xd7\xb6\xbf\x0c\xce\x9b\xaa\xd7]\xb0\x93\xa6\xa1\x0fp\xb8\\v\x1f\x92\x87\xa0\xf8\xf0\x9bpY\xf2\xb8T\xe2\xf6\xb8J\xa7 \xcb\x0d\x15\xa7\xd2Sl.\x16\xddG&\xea\x9a=\x84\xd0\x9f\x1d\\\x17\x9fb\xca\xacX;\x8fZR\xc5e\xfc\x96&8\x9d\x15L\x90Q\xff\x86\x1f\xfa\x9c\x89\x9e2\x12\xb3^\x93e]\xa6H\xf4y#\x02\xb8\xe5y/o\xcaV\xd7\xd64\\\x90MY\xdd\xe7\x8dh\xf5\xe0<:\xb3\xba\x977e\xab\x7f\x06\xe31\xe5s\x9b4\x0d\x93o\x1f(\xad\xd01\xed\xbefs\\J\xaa\xe0|z_\x9ff\xdfg\xfc\xdf5\xfcT\xe9\xf4\x9dG\xe7\xb8\xd4\x15\xa5\xffG\xbbV\xf7\xef\xd08j\x1e\x93K\xeaV\xba\xff\x91!}\xbd\xe9\xb2\xee\xf3\xfe\xa1\xf7\xce[\xb6w\xe2\xa6l\xb2'\x8d\xe9-\xdfe\x8dh\xf2\xad\x8f\xa9'\x8d\xda\xbb\xec\xf7\x00PK\x07\x08l.K>3\x02\x00\x00\xaf\x12\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm84\x8bA\xaa\xc30\x0cD\xf7>\x85.\xe0\xc5\xe7gS\x9f\xa2P\xe8^u\x86\xd4\xc4\xb6\x8c$Rz\xfb\x92\xa4\xdd\xcd{\xcc\x0b<\xca\x1djEz\xa2\xed/\xac\xa5\xcf\x89n\xd0\xadd\x84\x06\xe7\x99\x9dS \xea\xdc\x90\xe8\x85\xc7Sd\x8d\xf6}\x9c\xde\x06g$\xb2\xb79Z\xb0\x81\xbc\x17C\xd4m\x1fD\xf1\x80D\xd3\xf4\x7f0\x91\xb3.\xf0\xeba/\xa76Td\x17=\x93,\xddUj\x1c\x95;\xd2\x0f+46\xee\xbc@\xc3g\x00PK\x07\x08\xd1\x9cP\xa7\x83\x00\x00\x00\xbc\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80&\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc8\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x83e\x19z\x1f\n\x00\x00\x11(\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe3\x03\x00\x00crd/bases/catalog.kubecarrier.io_accounts.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xfc-\x19\xa6\xd4\x04\x00\x00\xa8\x0c\x00\x00-\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80g\x0e\x00\x00crd/bases/catalog.kubecarrier.io_apikeys.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(d\xac\x1d0k\x13\x00\x00\x00h\x00\x004\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9f\x13\x00\x00crd/bases/catalog.kubecarrier.io_catalogentries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x12K\x1b\xa9'\x13\x00\x006^\x00\x006\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80u'\x00\x00crd/bases/catalog.kubecarrier.io_catalogentrysets.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Q$\x93\x1c\xbb\x08\x00\x00\xd0(\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80	;\x00\x00crd/bases/catalog.kubecarrier.io_catalogs.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(;R\xfc{\xf8\n\x00\x00\xc6.\x00\x00<\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80)D\x00\x00crd/bases/catalog.kubecarrier.io_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(cxUVj\x07\x00\x00]\x1b\x00\x008\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x94O\x00\x00crd/bases/catalog.kubecarrier.io_instancemigrations.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc4\xa3\xc4Q\x9a\x14\x00\x00\xe6o\x00\x00/\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80mW\x00\x00crd/bases/catalog.kubecarrier.io_offerings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(f\x90\x00\xd6\xb9\x04\x00\x00\xf4\x10\x00\x00/\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80ml\x00\x00crd/bases/catalog.kubecarrier.io_providers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(t\xf7\x1d;g\x07\x00\x00O \x00\x00,\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x8cq\x00\x00crd/bases/catalog.kubecarrier.io_quotas.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x9b\xa1\x9e!\x0e\x07\x00\x00\x19\x1c\x00\x00-\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80Vy\x00\x00crd/bases/catalog.kubecarrier.io_regions.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6?\x07\x17Y\x03\x00\x00\xf8\x07\x00\x00-\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc8\x80\x00\x00crd/bases/catalog.kubecarrier.io_tenants.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x1e\x8bHr\xe9\x06\x00\x00\xef\x1a\x00\x002\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x85\x84\x00\x00crd/bases/catalog.kubecarrier.io_usagerecords.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa7\xd9\xd0-G\x08\x00\x00>\x1f\x00\x007\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd7\x8b\x00\x00crd/bases/kubecarrier.io_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf8\x87Q3x	\x00\x00\xab%\x00\x009\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x8c\x94\x00\x00crd/bases/kubecarrier.io_customresourcediscoverysets.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(D2\x10\x8e\xa3\x07\x00\x00\x99\x1e\x00\x007\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80t\x9e\x00\x00crd/bases/kubecarrier.io_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\\0p\xecy\x1e\x00\x00\xba\xe2\x00\x00-\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x85\xa6\x00\x00crd/bases/kubecarrier.io_serviceclusters.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x14\xdf\xcf\xe2Q\x02\x00\x00f\x08\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80b\xc5\x00\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6]\x02\x05\xef\x00\x00\x00\xda\x01\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\xc8\x00\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xee\x1f\xba$\xf4\x00\x00\x00S\x01\x00\x00(\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80>\xc9\x00\x00crd/patches/cainjection_in_accounts.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcb?\xf2a\xf3\x00\x00\x00Y\x01\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x91\xca\x00\x00crd/patches/cainjection_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe6\xc5\x0c\x9b\xf5\x00\x00\x00X\x01\x00\x00(\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe9\xcb\x00\x00crd/patches/cainjection_in_catalogs.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe3\x06\xc8\xef\xf5\x00\x00\x00\\\x01\x00\x009\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80=\xcd\x00\x00crd/patches/cainjection_in_customresourcediscoveries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(i\x13/\xb1\xfa\x00\x00\x00a\x01\x00\x006\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa2\xce\x00\x00crd/patches/cainjection_in_derivedcustomresources.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xebRX\xf0\xf5\x00\x00\x00T\x01\x00\x00)\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80	\xd0\x00\x00crd/patches/cainjection_in_offerings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xeb\xb3\xf1u\xf5\x00\x00\x00T\x01\x00\x00)\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80^\xd1\x00\x00crd/patches/cainjection_in_providers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe2\x1c\xc2\x90\xf1\x00\x00\x00Q\x01\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb3\xd2\x00\x00crd/patches/cainjection_in_quotas.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(cH\xebq\xf2\x00\x00\x00R\x01\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x01\xd4\x00\x00crd/patches/cainjection_in_regions.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa2&\x0d\xd6\xf9\x00\x00\x00\\\x01\x00\x009\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80Q\xd5\x00\x00crd/patches/cainjection_in_serviceclusterassignments.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x97%|\x92\xf3\x00\x00\x00R\x01\x00\x00/\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xba\xd6\x00\x00crd/patches/cainjection_in_serviceclusters.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x01\xe8r\x13\xf3\x00\x00\x00R\x01\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x13\xd8\x00\x00crd/patches/cainjection_in_tenants.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(`\xae\xa9Fw\x01\x00\x00t\x02\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80d\xd9\x00\x00crd/patches/webhook_in_accounts.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\"	(\xc8z\x01\x00\x00z\x02\x00\x00*\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x806\xdb\x00\x00crd/patches/webhook_in_catalogentries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(M\xcc2ew\x01\x00\x00t\x02\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x11\xdd\x00\x00crd/patches/webhook_in_catalogs.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(=\xcf\x87\xadw\x01\x00\x00u\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe3\xde\x00\x00crd/patches/webhook_in_offerings.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd2\x03\xbe\xdfu\x01\x00\x00p\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb6\xe0\x00\x00crd/patches/webhook_in_providers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(D\xa1\xa7,r\x01\x00\x00n\x02\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x87\xe2\x00\x00crd/patches/webhook_in_regions.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xba\x93/vs\x01\x00\x00n\x02\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80S\xe4\x00\x00crd/patches/webhook_in_tenants.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x83Jq\xaao\x03\x00\x00 \n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80 \xe6\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe0\xe9\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf2\xcb'\xa8\x0c\x01\x00\x00\x0c\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x99\xea\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(mK01\x1c\x01\x00\x00\x9a\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xfe\xeb\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80v\xed\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe3u\x89\xd4\xfb\x01\x00\x00\xe3\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe8\xed\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80.\xf0\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(-\xd9\xee\xd9\xb6\x00\x00\x00G\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa3\xf0\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x9e\xfbyQ\xb2\x00\x00\x00'\x01\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa7\xf1\x00\x00rbac/agg_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(J\xa69\xe5\xa3\x00\x00\x00-\x01\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa2\xf2\x00\x00rbac/agg_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(t|\xfd\x8c\x86\x00\x00\x00\x7f\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x96\xf3\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80j\xf4\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x83\xf5\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb8\\\xd2\x1a\x15\x02\x00\x00o\x12\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80o\xf6\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc9\xf8\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80t\xf9\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(l.K>3\x02\x00\x00\xaf\x12\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe4\xfa\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd1\x9cP\xa7\x83\x00\x00\x00\xbc\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80d\xfd\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00;\x00;\x00w\x15\x00\x002\xfe\x00\x00\x00\x00"
 	fs.Register(data)
 }
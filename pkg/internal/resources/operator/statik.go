@@ -22,6 +22,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94ROk\xdd>\x10\xbc\xfbS\x0c\xbc\x1f\xfc\x1a\xa8\xed\xa6G\xdf\x82y\x94\x1c\xf2(/!=\x96}\xd2\xda\x16\x96%\xa3]\xe7OK\xbf{\x91\x1d\x08	i W\xcd\xec\x8cvfw\xb8\x19\x18]\xf4>\xde\xbb\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3UL\x0c\x1b\xcd2qP\x18\n8e\x83%\xd3\x15\x83\xea,M]\xdbh\xa4\xca\xc3\xe5D\x81zN\x95\x8b\xc5\x0e?.\x8e\x87\xcb\xc3\xb7\x067\x94zVA\xcbI\xaf6\x06\xbeT\xe7\xe70\x03\x9b\xf1]\x99\x9aC\xedIY\xb4V\x92Q\xeae\xee\x13Y\x17\xfa\xda\x05\xcb\x0f\xd5\xa0\x93G\x17\x13N\x89i\xcc[\x9b\x81B\xcfR\xd0\xecn9\x89\x8b\xa1\xc1k\xd5\xbbs\xf2\xf3@_\x8b\xd1\x05\xdb\xe0r\x0d\xa2\x98X\xc9\x92RS\x00\x81&n\xd6\xb4\xb6\xb0\xca-\xac'Df2\x19~\x14\xe5\xa9\x90\x99M\x9e\xc9\xec\xeb\x95\xdd\xe0\xf7\x9f\xa2,\xcb\x8f|\xa2}N\xff\xcd\x9f\xa4;\x17\xfa2\x8b\x00;\xe8\xe0d\x85 C\\\xbc\xc5Dj\x06\xe8\xc0\x88\x81A\xf3\xcc\x94r\xc7\x01\xe3\"\x1a'\xf7\x8bM\x0c\x9d\xeb\xabG\x9a\xfc\xbb{\xec\xf0\xdf\xa7\xeb\xfd\xf1\xf6\xb2\xdd\xff<\\\\\xed\xcf\xd6\xfbx\xf9v\xfd\xfd\xa2\xdd\x9f\xe1\xdey\x9foB\x96\x93\xa8\xd3E\xd9\xe2\xf4\xf8lY\x006\xc8![e\xe5\xf2\xb5r\xf5\x96j%w\xe6C\xe4\xca\xf8E\x94S\xe5\xa3\xa1\xbc\xdbV\xd6\x91\xbbl\n\xbch9?\xfc\xbb]a\x93X\x0f+\x9e\xed\xda\xe3\xfefs/\xef\xf94\xc48\x96k\x15\x86\xb7*\x9e\x9a\xd8\xc6\xb68B\xd4\x1c\xc9\x9c\xb8s\x0fl?C\\0\x0c\xa7\xff\xcb\x8am'\xf0*\xa7\xbf\x01\x00\x00\xff\xffPK\x07\x08\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2UHN-*\xc9L\xcbLN,I\xd5\xabL\xcc\xcd\xe1\xe2J\xce\xcfK\xcbL/-J,\xc9\xcc\xcf\x03\xab\xc9.-.\xc9\xcf\xcd\xacJ\x85HA\xd4\x01\x02\x00\x00\xff\xffPK\x07\x08\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x13\x83\xd5\x0b\x94=\xafg\x8au\xba\x87\xaa\xea\x15<\xbd\xf4\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06O\xab:r\xabE\x97n\x12\xda*\xd4Q\x9a!(y\xd5\xba\xe0\xdc=\xda\xa6o\xc4\xda^\x11\x0d}\x9f%\x88*\x1ba,\x90:\xe3\"\x06\xef\xcf\x1e\x1a}(i\\O,4\xd6\xcc)\x1dp\xd6:O\xb8w\xef\xb4\x04,\xd6\xfa>!\xd3\xe2\xb0I\x95\x85v\xab-\x01E\xf92?\xee\xcc>%\xe0cwG\x0b-\x9a%\x98\x80_\xe6\xc0.\xb1N\xf0\x9d\xf9\xa8\xd7o'\x96\xe3\xc8I\xe9\"\xf6M\xd3W\xfbG\xf9\x93\x9b\xdb\xb6\xb5\xfa0\xcc\xbf#s\xf5!\xf8?\x08g6\xc6\xb5\xe3=\x00\x00\xff\xffPK\x07\x08\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x001\x00	\x00crd/bases/operator.kubecarrier.io_apiservers.yamlUT\x05\x00\x01\x80Cm8\xe4ZKs#7\x92\xbe\xebWd\xc8\x07\xda\x11b\xc9m\xaf\xbd^\xdd\x14T\xaf\x97v\xdb\xad\x10\xd9\xbd\x87\xe9\x89	\xb0*\x8b\x84\x85\x02j\xf0\xa0\xcc\x99\x98\xff>\x91x\xd4\x83\xac*\xb2\xbb\xed\xd3\xe0\"\x11\x8fD>\xbfL\x00u5\x9f\xcf\xafX\xcd\xdf\xa36\\\xc9;`5\xc7\xdf-J\xfae\xb2\xe7\x1fL\xc6\xd5\xed\xfe\xd5\xd53\x97\xc5\x1d,\x9c\xb1\xaazB\xa3\x9c\xce\xf1\x01K.\xb9\xe5J^UhY\xc1,\xbb\xbb\x02`R*\xcb\xa8\xdb\xd0O\x80\\I\xab\x95\x10\xa8\xe7[\x94\xd9\xb3\xdb\xe0\xc6qQ\xa0\xf6\xc4\xd3\xd6\xfb\xaf\xb3o\xb2\xff\xb9\x02\xc85\xfa\xe5k^\xa1\xb1\xac\xaa\xef@:!\xae\x00$\xab\xd0\xf3hP\xd3\xbaL\xd5\xa8\x99U\xda\x13\xcd\x99\xd6\xdc\x13\xbd25\xe6\xb4\xf9V+W\xdf\xc1\xd8\xb4@1\xb1\xc9,n\x95\xe6\xe9\xf7\x1c\x98\xdf\x14 \x08\x7f\xff\xb8\\\xf9m}\x9f\xe0\xc6\xfe\xdc\xef\x7f\xc3\x8d\xf5c\xb5p\x9a\x89.\xa3\xbe\xdbp\xb9u\x82\xe9\xce\xc0\x15\x80\xc9U\x8dw\xf0+1R\xb3\x1c\x8b+\x80\xa8\x13\xcf\xc8\x1cXQx-3\xf1\xa8\xb9\xb4\xa8\x17J\xb8J6l\xfef\x94|dvw\x07\x99\xb1\xcc:\x93\xd5;f\xd0\x8f&\x9d\xad\xfc@\xec\xb2\x07\xda\xd1X\xcd\xe5\xf6\x94F\xb2evb\x87\x1e\xc5\xfb-\xf6\xc8\x15\xcc\x86\x8e0\xbc\x7f\xc5D\xbdc\xaf\x82\xe4\xf9\x0e+v\x17\xe7\xab\x1a\xe5\xfd\xe3\xf2\xfd\xb7\xab^7@\x81&\xd7\xbc\xb6\xde\x1d\x1a\xb5B\xc5$\xdb\xa2\x01\xbbC(\xb0\x16\xeaP\xa1\xb4\xa0J\xdf\xf3\xb3\xdb\xe0\"\x98\x14r\x94V3Ak\x1b\xa2\x00A\xd5Y\xd3Sk\xf2\x07\xdb\x18:\xb4N\x14tz\x8f\x98\x9a\x11\xdfa\x16\x14\xe4\xfe\x91\xadh0,\xa2\xa8\x819n@c\xad\xd1\xa0\x0c\x01\xd1#\x0c4\x89IP\x9b\xdf0\xb7\x19\x04i\x0d\x98\x9dr\xa2\xa0\xa8\xd9\xa3\xb6\xa01W[\xc9\xff\xd1\xd06`\x95\xdfT0\x8b\xd1\xe3\xda\xe6\x1dD2\x01{&\x1c\xde\x00\x93\x05T\xec\x00\x1ai\x17p\xb2C\xcfO1\x19\xfc\xa24\x02\x97\xa5\xba\x83\x9d\xb5\xb5\xb9\xbb\xbd\xddr\x9b\xa2?WU\xe5$\xb7\x87[\x1f\xc8|\xe3\xac\xd2\xe6\xb6\xc0=\x8a[\xc3\xb7s\xa6\xf3\x1d\xb7\x98[\xa7\xf1\x96\xd5|\xeeY\x97\x1e\x01\xb2\xaa\xf8BG\xbc0\xb3\x1e\xaf'^\x18\x9a\x0f\xb6	\x0bP\xd0\x017\xc0\xe2\xd2 E\xabh\xea\"\xed<\xbd^\xad!m\xed\x8dq\xac}\xaf\xf7v\xa1iM@\n\xe3\xb2D\x1d\x8cXjUy\x9a(\x8bZqi\xfd\x8f\\p\x94\xc7\xea7nSqKv\xff\xbbCc\xc9V\x19,<$\xc2\x06\xc1\xd5\x14&E\x06K	\x0bV\xa1X0\x83\x7f\xba\x01H\xd3fN\x8a\xbd\xcc\x04]4?\x9e\x1c\xb4\xd6\x8d\xae\x08\xb5m\x1b\x0e\xe3U\x8dy/f\n4\\\x93W[f\x91b\xa1\x0f\xb0\xa9\x0dG+5\xe6\xec\x8e\xa4\xcc}l\x1d\x8f\x1e\xf3\xd1\x9bL\xd1U\xf2\xad\xd3Ca	\xc0-V'\xdb\xc1$3\xa11\xa9\xe4\xa1Rnd\xf8\x98\xa74\xdb+\x91\x97\x1c\x0d\xbc\xec\xd0\xeeP\xb7\x94\xbc\x98\xb4\xf1\x9e\x17G\xaa\xe96\x94l#|\xf2\x18j#\xb6k\x9b\xe2E~\x11\xcfo\x97\x0f\x8b\x0e\xbbok\x94\xcb\x07X())\x98zj\x85R\x8d\xf3{\xff\xb8\x8c\xe1vd\xc7\x91\x15\xe74O-\xa7	%\xd1A2\xb7\xd2\xdc\x1e\xc6g\x1f	\xb6\x18X\x0c\x1aK\xd4(\xf3\xe8\xb2\x06s\x8d\xc3\nL\x8dB\x94qI(\xc4\x8dq\xa8g\x06\x16\xf7\xc0%0x|\xfd\x0b\xa0\xccU\x81\x05h\xa5l\x97\xe1I\xa21\xd5%'\xc8&&_\xa2'HYzr\xc6(<\x1c7\xc2:\x8a\xe4)rs\xbf\xe1\xc4\x84\xb3\x1eJ-@\xee\xf2\xe1b\xa3^/\xe2\n\xaf\xbf\x9f\xfe\x7f\x0d\x953\x1e\x89\xbdq\nr\xd1\x1b?\xf6\xe1\xda\xb8\xcd\x87\xebI\x8d\x94\x1cE\x91\xc1\x9arB-\xdc\x96KPR\x1c\xc0jGP\xcf|\x85'Rf\xa04\x8d\xd28=m[o\xd8@,g\xd2'	\x83\x05\xbcp\x8az\xb7\x11<o\xecn2\xf8 a\xdd,\x98\xa4k\\]+mM\x94\x8e\x05\x9f\xa6\xb4_3m\x0f\x1f\xaeObW0^\xddL\xd2|\xd9\xf1|Ge\xb1z\x89\x98\xc5D \x998$\xa1\xbdj\xc1\xaag\x94\xf4{\x92\"K\xca*\x95\x06\x06\x05/}\xc0\xd9\xd8M)\x19\xdb\xa4H\x95#/2\x89\xf6\x96v7\xb1\x83\xf2\x1d\x890\xcf\x95\xc6\xf9\xab\xbf}\x9d\xedl%\xbeX>\xac\x89\x87)\x9b^\xe4\xe1\xfe\x10a\x16\xa4\x9e\x8b\x1d\xef\xc7v\xcd\x0d\xf0\xb2A\xcc\xe2\x06r\xe6L\xc4\x13\x02\xd3Nb\x9a\x80KjT\xf6\xe9\x03\xfd\xa9U\xed\xa8\xfa\xf3D\x9c\xf1 \x13\xb8\x0c~\xc3$,\x1f\xc0K\x7f\x89C/\x03\xbctx\x0e#Tf\xc5\xe2\xc8\x03\xd8\x85T}A\xec\x8b\xb2\x14lM\xad\xa6\xb4?8\x11\xa0\x85\x1e3\x85e\x1fa\x9dG\x8d%\xff\xfd#\xcd\x13\x16\x8d\xd8\xc7\x87\x03\x95\x82u=\x0d\x7f\xde0\x9e\x8bp\x9a\xa4\x9f\x1b\x02k\"\x9e\"\xb9\xd1I\x06\xf7\xe1\x9fI\x8a\xd7>\x1f_\xc3\x8b?\nh4Nx\x13D#\x0b\xfe\x8cq\x0e\xca-\x97\x88\xa4\xa2i\xf0\xa2\x83@XR1\xfd\x8cT'_\x7f\xb6\xf2C\x8a{\xf7\xf4\xe6b\xcd_/\xd3\x12\xf2.R\x0b\xfd\xdb\xcdn`\xf8V\x1aX>L\x8a\xb3\x0e\xf0\xc2L\xc4\xe3\x17.\x04\xa9=\xa0\x1d7\xe6\xc3utb:e	\x11\xf1h\x92f\xadU\xe1r,`s\xe8s\xc4\xc2\x91\xc3\x033\x01\xd5t\xf5\xd8m\x057\xb9\xda\xa3>4\xd0\x1d%w\xc61!\xfa\xfb\xcc\x8c\x1f%\x87Qn\xba\xcc`P3\xbb\xbb\xf1\xdc\xe0\xef\xac\xaa\x05\x89\x9dp\x92\xe5\xb9rt\xa2\xd9*\xb5\x15\x98\xe5\xaa\xfap\x0dg\xe0\xa5].\xd4\x96\xcb\xcc0\x81\xa6T:\x8f\xeb\xdb\xe4\x93\xd4B\xe1=I\x92\x13_\xfe\xb0\xde/\x0c;Z\xf9(|o\xd6\xb5 \xff\x18\x99Y\xf8\x0d>\x1b\xebS-\xe3Qp\xb2\x84\xea\xde\xca\\Vr]\xc4\xc0Q\xb8<\xf5\xf8\xf9#S\xc9\x1e5/\xc9\xff\x98\x0d\xf1\xb1\xc3F\xfa\x04~\xcfx\x88H^3\xae\xa7\x83\x87i\xec&\x0b\"\x97\xb2\xc5y\x989S\xf7\xc5Z\x06\x8b\x15\xdfRi}/\xb6\x93\xba.\xb0dN\xd8\xe9\x82\xf4i\xf5\xcdw\xdf_j\x87\xeb\xd5\x00\x0b`0\xd6W,\xcf\xb1\xb6t\x98=s<P%\xfc\xf4v\xf5\xdac\x1c%D&\xb6t\xd2\xd8U&X\xa2[\x02\x1e\xa1\xd09\x83\x12\xc9\x88r>TSPY\xa5\x84\xc98\xda2Sz{KQs\xab\xcb\xfc\xbf\xbf{\xf5\xc3\x17\x06s\x92o\xfem\xf6j\x1a\x1bd@\xd9\xe0\x0cQ\xbd>\xd3y-\xdetr\xbe\xc6\\U\x15\xcab\xf40\x1aZ\x94\xee\xa8\x08\xf5\xb7	]\xee\xcfA\xc2`\xc9\xf7\x9e	^x\xa8\x99\x02\x84\xd1S~\xdb.\x0c\xd90\x8di\xcd\x0e\xa3\xb3\xa8L\xa3\xfa\xe0\x82:28/\x18\xb7\xb9\xd4=\xdfu\x89\xa7\xc4J\xc7\x9d\x90\x02\xad\xa2\xed\x81\xf9\xfeI\x81c1\x99\x98=\x1f\xb9gt\x93\x08}d}\xf6\xae\xb7l\xb2B\x9b\x14'VoQ\x01\x9ed\xaf<k\x8b\xb3\x18e\xf1RtJnH\x15\xdch\x95\x96\xb6\xea\x15j\xbf\xa9\x9d\xfc\xdc\x82\xeb\xdcI{>x\x052>9\x1e\x8eG'4\xe5\xdd\xc8\x8c\xb3\xe0mP\xefy\x8e\xf7\xa1\x1e\xb9\xe8\x82i\xd5[2p3\x16iB,rF5\xda\xbb7\xfb\xdc\xfb1c\x99\xe59\xb9\xe5eW{\xabv~G\x84@\xc5\xfb\x87\xb9\xb0\x88\xa4\x02\xef\xcf\xb8+\xdb\xd9\x9a\x19\xf3R\xac\xfc\x95\xd6\xc5\x81\xf9\x7f~Y\xb3\xae#\x1b\x05Q\"z\xc9EY\xc4$\x92\xaf\xa3\x96#\x01\xffCo\xba\xce\xed3?2\xdf\xa7y\xf5\xe4\xf0xJ\x13j\xfb\x06\xf7(\xce\\\xb8\xbf\x89\xd3F(siq{R\xd4\xd4\x84\xec\x03b\xf7(?\xfaI`Q\x88\xce\xdb\xb2\xbf\x17\xa1\x11_\x81\xc8\x9c\x0b\x1e\x0e\x1b\xb5V9\x9a\xa1<A\xc7:f\x8c\xa3\xa4@\xc5W\xfb\xe4\xe8_\xeeXq\n\x9d\x13\x8eb\x85	\xd6x\xc2\xf2\x8c\x04\xeb7\xabf\xea\xf1\x1d\xf3\xfa\xcd\xaa\x8b\xe2\x9e\xc9Z\xf3\xfd\xf0\x1d1U\xe8>\x84\x08\x14\xe3\xcbW\xf7E\xf4\xfeqy\x1aC\xd3\x913\x1e/\x93Q2\xee\xb3#\x111\xea~c\x0fM\xfe	\xbbO~\xe4\xa9\xc9\xcf\xec=6\xa9\x8d\x7f\x05\xfe\x8c\xd7\xa6\\\xc9p\xe0\x1bPZ\xff!\xa1\x99\xd8y[\xf4L\x84\x07[`{\xc6\x05e\xa3\xc8U\xf8Vb@\xe3\xaa\x04\xd6\xf293\x90;\xed/G\xbd\x10\xa7v\x1d-h\x87\xd5\xd40\x9a\x1e-He\x96qa\xbcK\xf97\xce\xb0\xe1\xa074\ni\x1e\xbb\x1b\xcaC\xb0}\x0e\xae\x053v\xad\x994\x9e\xe6\x9a\x8f\x83v\x1ffN\x96\xa5\xf2\x97\x08\x82\xe5U\xb8\x13j\xb8\x1d!\n`\x03\x95\xf8\xdc\xab$F\x8f#`aRQ\xed1\x96\x8eJ\xa5+f\xc37\x10s\xdard\xde\xd94S\xa11l{\x99\xe4\xbf\x84\xb9I\xdc\x9d\xab\x98\xf4\xa8\xe5]\xabJ\xa3\xb2\xf0\xb9t\"\xaf%\xa3\xb3\x8dr6\xaa\xad\xd1\xe8\x98\xc8gE\xd1\xc8\xcc\xd0\xablhG\x97\x1c45	\xf2\xe5Fs,\xbf\x8a\x04ZWL\x06\x9c\x8d\x97\xfc\x7f\x14\xefCP\xd3\xb6\x93B\xcf\x99\xc4{\xf4\x98\xf8^\xd7\xb0|\xe3\xddI\x95\xf0\xe5l\xad\x1d\xce\xc6_Yf\xff\xcb\x84\xc1\xd9\x0d\xcc\xde\xc9g\xa9^\xe4\xec\xabO\x96\xc2O\xb8D\x86\xf5\xa1n\xdc\x88\x16%\xfe\xdb|\xd8\x91$B\x82\x18?\xe9~9{\xa2\xdc\xf9\xc9\x9cOU?\xf3\x01\x9c\x18\x9c\x16\xfd\x7fp,x\xd6\xe0\x90\xe9~'\xd5\x1f\"\xae\x07\x06>\xb1\x8aJ	\xe9G\x94\xa8/\xf9\x80\xe1\xed\xc9\x82d\xb2J\x19\xff\x99\x10e\x86m;\x9av\x18\xe09\xc4T\x17\xb1\xd3uL[O\x9d\x1a/\xa1\x1c\x97\xf6\xfb\xff\x1a\x11u\xa4\xac\xdb13\xe0\x8a=\xf9\x1e^?>\xbd^\xdc\xaf_?d\xf0H\xf3\x8f\xb3gJ~\x82\x97\x98\x1fr\x11\xa2m\xc8$)\x1f\xa5/\xab\x16J\x1a\x7f\x08\x0c_\xf3\xf8+\x91v\xbb\x1b\xe0\xb6y>\xd0X\xa9a\x9d1\x03F)\x19/Q4\x86o\x90*\xccwLrSQ\x96\xa8X\xdd)\x13\xfc\x9d\\x\xe4\xa2\xb3\xeb\x90\xbf\xd5\x9a\xb7\x1f,\xc5\x04y\x88\x0f\x1a\xc4\xbf\x14\xa1\xba+\xb8\xa9\x05;@\xedt\xad\x0c\xc6k\xff=\xea\x83\xdd\x0d\xc7\x10\n\xe3o\x11;\xfc\x9c\x1at4\x0e\x07}\xfa\xa43\xb8\xd7\x1dX\x1d_\xb3\x8cU\x9a\xb2W\xa7\xc7m\x9a\xaf\xbf\x92\xfd#\xb8\xc2?\xffu\xd5\xe2l\xba@\xfd\xb5\xfd\x1e3|uy\x1dn\xf0\xd2'\x95\xfeg\xa7\x14\x83\xbf\xfc\xf5*l\x8c\xc5\xfb\xf4\xd5$u\xfe;\x00\x00\xff\xffPK\x07\x08\xd80\xa2\x10\xb7\n\x00\x00\xda*\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x000\x00	\x00crd/bases/operator.kubecarrier.io_catapults.yamlUT\x05\x00\x01\x80Cm8\xecYKs\x1b\xb9\xf1\xbf\xf3St\xf9\x7f\xa0]%\x8e\xfeNR\xa9\x847\x17\xe5\xa4\xb6\xd6vT\x92\xe2\x1c\x92\x1c\x9a\x98\xe6\x0cV\x18`\x82\xc6Pa\xb6\xf6\xbb\xa7\x00\x0c\xe6\xc1\x01-\xd9\xbb\xa9\\\x82\x8b4@\xa3\xd1\x8f_?\x00\xae6\x9b\xcd\n[\xf9\x99,K\xa3\xb7\x80\xad\xa4\x7f:\xd2\xfe\x8b\x8b\xc7\xdfq!\xcd\xf5\xf1\xed\xeaQ\xear\x0b\xbb\x8e\x9di\xee\x88Mg\x05\xdd\xd0Aj\xe9\xa4\xd1\xab\x86\x1c\x96\xe8p\xbb\x02@\xad\x8dC?\xcd\xfe\x13@\x18\xed\xacQ\x8a\xec\xa6\"]<v{\xdawR\x95d\x03\xf3t\xf4\xf1\xff\x8b_\x15\xbf_\x01\x08Ka\xfb\x83l\x88\x1d6\xed\x16t\xa7\xd4\n@cC[\x10\xe8\xb0\xed\x94\xe3\xc2\xb4d\xd1\x19\x1bx\n\xb4V\x06\x9e+nI\xf8\xb3+k\xbav\x0b\x97\xc8\"\xc3$%:\xaa\x8c\x95\xe9{\x03\x18\xce\x04\xe8u\xefO\x0dSJ\xb2\xfb~6\xfdAr\\jUgQM\xa4\x0c\xb3,u\xd5)\xb4\xe3\xfc\n\x80\x85ii\x0b\x9f\xbc\x10-\n*W\x00\xbd9\x82\x10\x1b\xc0\xb2\x0c\x06Fuk\xa5vdwFu\x8d\x1eD\xfc\x81\x8d\xbeEWo\xa1`\x87\xae\xe3\xa2\xad\x91)\xac&s\xdd\x87\x85~\xca\x9d\xfc\x89\xec\xac\xd4\xd5\x92Grc\xb1p\xc1\x8c\xe3\xbb\x8af\xecJtq\".\x1f\xdf\xa2jk|\x1b\x15\x1755\xb8\xed\xe9MK\xfa\xdd\xedw\x9f\x7f}?\x9b\x06(\x89\x85\x95\xad\x0bHx\x95\x8c\n\x0dj\xac\x88\xc1\xd5\x04%\xb5\xca\x9c\x1a\xd2\x0e\xcc!\xcc\x0cd#\xc2\xfa\x1d\xb6\x188\x03\xfcM\xc3\xbb\x91Vjv\xa8\x05\x81d`\x87\xd6Q	\x07c\x81P\xd4\xe7\x00\x97,\xcc\x91\xeci\xd84\xe1\x8a\xba\x04K\xdc\x1a\xcdr\xaf(\xf0\xb0$\x8c\x16RI]\xc1\xee\xeef\xd8\xc6\x80\xc2\x1af\xf8\xbe\xdb\x93\xd5\xe4\x88a\xa7:vd\xb9x50m\xadG\xaa\x1b \xd8\x1f4\x86\xe7d\xf6\xccdko\xd5H\x05\xa5\x8f\xcb\xdeh=\x9c\xa8\xec\x1d\x11M'\x19,\xb5\x96\x98t\x8c\xd4\x19c\xf0D\xa8\xc1\xec\x7f \xe1\n\xb8'\xeb\xd9\x00\xd7\xa6S\xa57\xf6\x91\xac\x0b\xcaVZ\xfek\xe0\xcd\xe0L8T\xa1\xa3>\x1a\xc6\x11\xe0\xabQ\xc1\x11UGW\xc1~\x0d\x9e\xc0\x92?\x05:=\xe1\x17H\xb8\x80\x8f\xc6\x12H}0[\xa8\x9dky{}]I\x97\xd2\x920M\xd3i\xe9N\xd7\xc1\xffr\xdf9c\xf9\xba\xa4#\xa9k\x96\xd5\x06\xad\xa8\xa5#\xe1:K\xd7\xd8\xcaM\x10]\x87\xd4T4\xe5\xff\xd9\xde\xcf\xbc\x9e\xc9\xba\x88\x918B\x1a\xf8\x82\x07|>\xf0\xa0\xc2~k\xd4b4\xb4\x9f\xf2\xd6\xb9{\x7f\xff\x00\xe9\xe8\xe0\x8cs\xeb\x07\xbb\x8f\x1byt\x817\x98\xd4\x07\xb2\xd1\x89\x07k\x9a\xc0\x93t\xd9\x1a\xa9]\xf8\x10J\x92>7?w\xfbF:\xef\xf7\x7ft\xc4\xce\xfb\xaa\x80]\xc8\xd5\xb0'\xe8Z\x1f\xc4e\x01\xdfi\xd8aCj\x87L\xffq\x07xK\xf3\xc6\x1b\xf6e.\x98\x96\x99s\xe2h\xb5\xc9B*\x02\xe3\x98\xf9+%\x84\xfb\x96\xc4,dJbi=\xa8\x1d:\xf2\xa1\x90(\x8b\x19\xb3|\xb0\xfa\xa1L\xf5\xc1\x9b\xe0|\xfeL\x80\x0f=\xd9\x82*\xea\xe3\xe3\xa5\"{\xb6\x1a\xd3\x9bO\x82}\xfe\xd8\xdd\xdd<s\xce\x1d\x1d\xc8R\xc8B!i\x86\xbc\x14\xfe\xfd80K\xd9\xa8X\xb0\xba\xac\xa6\x1f\xb1\xc0f\x16.\xba0\x8de4\xbdpc__\xbfe\xeb1\x97F_\xb4\xd7G\x8d\x07\xc5r\xeb&\xda 3\xef5\xccLG\xf93\x0b\xbdt\x17\xe0\xb0\x80\xb7\x1f-v\x9c\x13j\xe6\xff\xdb@\x04\x8e\x94\x9a\x16Jg\xe2\xf6\xb1h\x85J\xe0\x1d.\x88\xcfs\x12\xf4\x15\x0f\x99\xbb\xc6g-t\x93\x92\xea\xd3\n\x96\xa7\x0b\xa2gm\xcad\x8fRP\x8f\xbb\xaf\x83\xf0\xfdlo\xca\x97A\xa6\x90\x16\xb3\xb6\xf2cO\xca\xe8*\xe4\xbe\xaf\x04z\xe8l~Y\xdcx\x96_\xe3\xec\xb9\xc5~N\xdc\xcf\xed\xf7\xb5\xa6\xf8_\xcc\xffWc\xfe\x89\xf6\xb51\x8f\xf7\xce\xfa\x0b\xcb)\x07\x82\x03v\xcam\xe1\x93\xd1K\x80\xcd{\x96\xbf\xcc\x99\xf9\xfcp\x90U\x04L\x7f\xd0\xd0l\xdf\xdd\xc0S-E\x9d\xb1dH\x00\x95\xf4h\xa22\xa1l,U \xfaH\xdd\x9fb\x80\x0e\x15\x15\x1ej\xb2K!\x01\xd0\x12\xb8'\x03\xad\xe1\xd8^\xc7\x960t\xd9\x81E\x94\xb4\xb31i\xfd\xe8u\x85\xd7\xfbS\xd2\xfe\xcdU\x86\xe9\x1c\xf7?\xc1b\xd3\x16z\x83\xc0\x93T\n\x8cV'\x105\x89G\x90\xc1\x08YQ}\xcb\xa7\x01\x8f(\x15zI\xe7\xa7\xbcc\x96\x95\x0eV\xe8\xed\":k\xfd\xe7p\xed[\x06\xe0\xb9\xa8gr	T*\xb9\x87\xa7\xfe\xc9\xc6w\x86\xfb\x93t5\x94\xf6\xb4\xb1\x9d\x86\x83\xc2\xaaX/\xa8HwM\x0e\xfaYTm\x9e;\xf3b\xa4\xe5\xa3l\x93mt\xceH\xf8\xf2\x91\xe7\x8b\xe7\x9b/\xb5\x8c\xe1\xaa\xfc\x92\xa61\x10\xce\xdaF\xb3\xf7G\xfe\x9c\xbeQ\x18\x1do\xfb\x99\xb4;\x97b \x9c\\\x12&7\xaf	\x18\xa3T\xf15&\x03\x04\x7f\xd1\x1b\xe4\\\xf3\x80\xcd\xa0\xc3\x12\x97\xd2Q\x93\xad	Y#\x0db\x86\xb6\x03\xa5\xf6\x06s(U\n\xe3!\x162\x1c'\xe6\x18\xee\xacy\x83\xc6\xf1\xe5\x9a\x05\xa0\x90\xdd\x83E\xcd2\xbdk\xe4\xe9\xce\x9b\xf4\xc56\x1f\xef\xd1\xd4\xec\xc0\xc9\xd0\x0c\xd1(\xec\x05\xa6\x00.r\xe9/m>\xf3D\xb4\xf9F\x0c\xb5\xf1\xd9%\xa7\x97\x1f\x07c\x1bt\xf1\x9de\xe3\x8f\xbc@\xf7L)\xf4\xf7'f\xac^\xa6\xf9\xc7H\x9b\xd4\xad\xbb\x06uh\xf3\x02\xae\x9a\xb4\xaaK)\xd0]:0r\x8d>\xc7\xbd\xe9\\o\xb6\xc1\xa2\x97T~V\x15K\xc8\x97\xca\xfa\xa2\x11\xf2\xa4I\x91\xd7{+\xe9\xf0\xa6g0\"19p\x9d\x8b\x938~)\xd9sif\x1c3\xd9\xfbD\xd3\xcb\xde#\xa6\xcf\xf8\x83\xc8W\x01N\xe6\x00\xaf\xd7\x0f\xb6\xa3u\xae\xf6\xc5\xb1\xfe\x03*\xa6\xf5\x15\xac\xff\xac\x1f\xb5y\xd2\xeb7\xdf\xacE x\x89\x0e\x0f\xa7v\x80\x91\xdf\x94{\xbeK\x8a\xf4	A-\xef\x12i\xbc^\xdf\xf9\xbb\xc67\x0b~\xb9\xad\xf3ec\x99&\xb2d=\xfc\xb3k\x11X\xd9%\x9e>\xc5\xce\x97\xbc\xd4\x99\x85/4\x82i\x11\xad\xc5ss\xa5b\xf4G\xd2\x14[\xa4gJ\xca\x9f\x16\x1b\x92\xc7\x1a\xc3\xe1\xad\xcf\x97\x85j\\M'dd\x1ez\xb4\xc1\xc1\xa1\xef\xa3\xc9\xeds\xe9\xbb\x94\xe3\xa4v\xbf\xfd\xcd\x05M\xf3O\"\xe1\xc5\xfb\x19\xf5n\xde\xdf\xde\xbd\xdf\xbd{x\x7fS\xc0\xad\xa7?/\x9c\xa9\xf0)y q\x12*\xc6Z\xce#\xa9\x18\xa5\xd7\xd1\x9d\xd1,\xcb\xe1EN\x92*'\xc7]\x81t\xb1i\xdb\xfb3\x1b\x937\x192\xb01\xda\xff\x0dmf|GlH\xd4\xa8%7\xbeF4\xd8N:\x04?\x13!\xce\xf0TS\x0en\xad\x95\xe3\xa3c_\x1eO\xbe\xe3\x96!\x9f\x84\xe6\xd6\xbb\xaa\x94\xdc*<A\xdb\xd9\xd60]\xc5\xd7\xf0#\xd9\x93\xab\xf3!D\x8a	:\x9ed ^:\xf4b\x18f!\xbd\x98\x8c\xe8\xda\x82\xb3]\xf4\x02;c}\xed\x9a\xcct\xfb\xe1\x057\xf9\xbfO\xad\xf0\xe3O\xab1\xcb\xa2\x10\xd4:*?\x8d\xbf\xf6\xc4\xdft^\xc5\xb7\xf7\xf4\x93M\xf8\x9cta\xf0\xd7\xbf\xaf\xe2\xc1T~N\xbf\xcb\xf8\xc9\x7f\x07\x00\x00\xff\xffPK\x07\x08\x05C\xa5\x1fE\x07\x00\x007\x1b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x000\x00	\x00crd/bases/operator.kubecarrier.io_elevators.yamlUT\x05\x00\x01\x80Cm8\xecX\xdd\x8f#9\x11\x7f\xcf_QZ\x1e\xb2'MzX@\x08\xf2\xb6\xca\xcc\xa1\x13{\xc7(3\xec\x0b ]\xa5\xbb\x92\xf8\xd6m7.w\x96\x80\xf8\xdfQ\xd9\xed\xfeH\xdc;3\x0bH<\xe0\x97\x99\x94\xcb\xe5\xfa\xf8\xd5G{\xb1Z\xad\x16\xd8\xa8\x8f\xe4XY\xb3\x06l\x14\xfd\xcd\x93\x91_\\|\xfa\x0d\x17\xca\xde\x9e\xde->)S\xada\xd3\xb2\xb7\xf5\x96\xd8\xb6\xae\xa4;\xda+\xa3\xbc\xb2fQ\x93\xc7\n=\xae\x17\x00h\x8c\xf5(d\x96\x9f\x00\xa55\xdeY\xad\xc9\xad\x0ed\x8aO\xed\x8ev\xad\xd2\x15\xb9 <]}\xfay\xf1\x8b\xe2\xb7\x0b\x80\xd2Q8\xfe\xa4jb\x8fu\xb3\x06\xd3j\xbd\x000X\xd3\x1aH\xd3	\xbdu\\\xd8\x86\x9c\xfc\x17d\x96\xe8\x9c\n2\x17\xdcP)w\x1f\x9cm\x9b5\xcc\xb1E\x81IK\xf4t\xb0N\xa5\xdf+\xc0p'@\xb4\xfd\xbe\xbb5\x90\xb4b\xff\xfb	\xf9\x83b\x1f\xb6\x1a\xdd:\xd4#-\x03\x95\x959\xb4\x1a\xdd@_\x00pi\x1bZ\xc3\x0f\xa2D\x83%U\x0b\x80\xce\x1dA\x89\x15`U\x05\x07\xa3~p\xcaxr\x1b\xab\xdb\xda\xf4*\xfe\xc4\xd6<\xa0?\xae\xa1`\x8f\xbe\xe5\xa29\"S\xd8M\xeez\x0c\x1b\x1d\xc9\x9f\xe5F\xf6N\x99\xc3\xb5\x8c\x14\xc6\xe2*\x04\x13\x89\xef\x0f4\x11W\xa1\x8f\x84\xb8}z\x87\xba9\xe2\xbbhxy\xa4\x1a\xd7\x1d\xbfm\xc8\xbc\x7f\xf8\xee\xe3/\x1f'd\x80\x8a\xb8t\xaa\xf1\x01	o\x92S\xa1F\x83\x07b\xf0G\x82\x8a\x1am\xcf5\x19\x0fv\x1f(=\xdb\x80\xb0\xee\x84+z\xc9\x00\x7f6\xf0\xadu@X\x1e\xe1\xc7;r\xeaD\xd5\x14\xc9?\x02\x0e\xc2\x94a\x8f\xa6$P\x0c\x1a[S\x1e\xa9\x02o\xa1q\xb6\xc1C2\xb5\xb3?\xe8\x15$\xc2f{7:k\xbc\x0d\xbb}pEi\xe5\x97,rNJ\xc0\xff\xa6\x17$\xa2\xc9\xf9\x1e{q\x8d\xf2rD\xbd\xf0\xd5R\xdc\x19\xb9\xa0\x92\x84\xec\xbc\xd5\xe1\x88\xaa.\x02\xd1g\x8a\xc1Q\xe3\x88\xc9\xc4\x14\x9d\x08\x06aB\x03v\xf7\x13\x95\xbe\x80Gr\"\x06\xf8h[]\x89\x97O\xe4<8*\xed\xc1\xa8\xbf\xf7\xb2\x19:c5z\xea\xd2`X\x01\xb7\x065\x9cP\xb7t\x03h*\xa8\xf1\x0c\x8e\xe4\x16h\xcdH^`\xe1\x02\xbe\xb7N|\xb8\xb7k8z\xdf\xf0\xfa\xf6\xf6\xa0|\xaaG\xa5\xad\xeb\xd6(\x7f\xbe\x0d\x81W\xbbV\xf2\xec\xb6\xa2\x13\xe9[V\x87\x15\xba\xf2\xa8<\x95\xbeut\x8b\x8dZ\x05\xd5M\xa8IE]\xfd\xccuq\xe7\xe5D\xd7\xab\xe4\x88+\xe4\xff\x17\" \x85@\xc0\x82\xdd\xd1h\xc5\xe0h!\x89w\xb6\xf7\x8fO\x90\xae\x0e\xc1\xb8\xf4~\xf0\xfbp\x90\x87\x10\x88\xc3\x94\xd9\x93\x8bA\xdc;[\x07\x99d\xaa\xc6*\xe3\xc3\x8fR+2\x97\xee\xe7vW+/q\xffkK\xec%V\x05lB\x91\x86\x1dA\xdbH\xf6V\x05|g`\x835\xe9\x0d2\xfd\xd7\x03 \x9e\xe6\x958\xf6e!\x18\xf7\x97K\xe6\xe8\xb5\xd1F\xaa\xfe\xc3\x9a\xc4+%\xfacC\xe5$e*b\xe5\x04\xd4\x1e}H\xd7\xc4YL\x84\xe5\x935^\x13k\xcb\xf6r\xe3B\x83-\xed\xc9\x91)\xbb{\xb3%\xa9\xafi\x1dz\xae$\x02<\x91A\xe3W\xac*\x92\xe2S\\\xb1\xcck\n\xa9Xg\xe8\xb3A\x88Kp$n\xba>\xba\n\"\xaf\xc83Q\x92\xa5\xed\xe1\x83`\xe6\x19\x7f}\xe8\xd8fDK\x819\x90\xbb\xd8m\xb0\xe5\x9c\x96\x13\xc9\x0f\x81	<i=n\"R\xede'\x94:S*\xadB\xb1\x14\x87\x96\xc4\x97i+K\x8a\x1a2\xb7\xb5$6z\xd8\xa0\xc7\xa6\xd5\x1eB\xc5\xc5\xea<\xa3{\xd6\xc9\xa9Cl\xb6w\xafCR:\x08\xa1\x8duew\xb3\xbd\xbb\x89J\xc5:\x9e\xd1~Gq\xe8\xa2\n\x94\x99J2\xa9\x7f\xbd\x16^q\xf6z=\xber\xf5\xf6\x85\x07\xbb\xd1\xebk\x8e\x9er\x8d\xf6Eg\xbf\x94\x0f\xc1\x07\x19\xbaX\x98!G\xfd3\x1b\x9dv\xafI-\x1f\x8a\xc3\xeb\x11\xd4\xee\xb4*\xc3,\x13 \xf3Yi-\xf0\xe0\xb3)\x03:b\xa7\xcf9\xff\xff\x88y\xe1\xd9\xffI\xc4\xe4\x95Z\x0d=\xed\x82>\xaaQ\x17;=\xf2&\xf4\xb9^\x1d>N^\xd2\xad\x03\xe3\xa4_\xdb\x1d\xcbt\xf4o4\xec\xd2\x9a\xf8}\x95\xc1\xe3D\x8bM\xcf8\x9a\xceF#/\xe0	\x95\xc6\x9dNZ\xc5\xef\xdf\x0c6d\xc2\xee\xf5\\2\x94\xads\xf2I\x13l\xb8\xce\x18\xe5\xa9\xce&K\xd6I\xbd\x9a\xa1\x99\xa12\xe20\x8fJ3\xec\xad\x8b3b\xbc/\x8b\xda\xde\x1d\xfd\xc7B\xde\xa1q}9\x99\x014\xb2\x7frhX\xa5/\xc9<\xdfe\xb3\xbf:&=4\xba\x9a=x\x15Z,\x0d\xca\xce\x08\x05\xf0QJ7-[C\x1d\xda\xa4\xbd\xa3\xb1\xfeHY\xbbd\xed\xad\xab\xd1\xc7/\xdb\x95\\9\xc3\xf7L\x8d\x90\xc1\x95\x19\x0f/\xb3\xfc\xfb\xc8\x9b\xcc=\xb65\x9a0<\x04\\\xd5i\xd7T\xaaD?wa\x94\x1ac\x8e;\xdb\xfa\xcem\xbdG\xe7L~\xd6\x14G\xc8s\xf5\xee\xaa\xa9\x08k2\xe4\xed\xce)\xda\x7f\xd3	\x18\x90\x98\x02\xb8\xcc\xe5I\\\xff)\xddsefX\x13\xdd\xbbB\xd3\xe9\xde!\xa6{p\xe8U\xbe	p\xb2{x\xbb|r--of-X~\x8b\x9aiy\x03\xcb?\x9aO\xc6~6\xcbo\xbe\xda\x8a\xc0\xf0\x12\x1b\x9e\xceM\x0f#9\x94{0I\x86t\x05A_O\xa8i\xbd]ne\x82\xfdj\xc5\xe7\xfb\x9d\xb4\x8b\xeb2\x91e\xeb\xe0\x9f\xdd\x8b\xc0\xcan\xf1\xf8\xf1k\xba%Zg6\xbe0S\xa5Mt\x0e/\xdd\x95\x9a\xd1\xef\xc8\x90\x0b\xd5\xff\x99\x96\xf2\x87\xab\x03)b\xb5\xe5\xf0\xc8\"m\xe10\xec\xa6\x1b2:\xc7\x94\x1a\xd5k\xd8\x9d\x13`\xbbo\x9a\xeb\xd8\xa5\x1a\xa7\x8c\xff\xf5\xaff,\x9d\xf9\xb4:\"g\x8081\xef\xee\xfea{\xbfy\xfft\x7fW\xc0\x83\xf0_6\xce\xd4\xf8\xb4\xdaSy.u\xcc\xb5\\DR3J\xcfR\x1bk8L\x9a\xf1)D\x91\xaeF\xd7\xdd\x80\x1a\xc6VG\xb5\xcd\xbb\x0c\x19\xd8Z#\x7f\xa5\x11P|\xc0\xa9\xa9<\xa2Q\\K\x8f\xa8\xb1\x19M\x08B\x89\x10g\xf8|\xa4\x1c\xdc\x1a\xa7\x86\xd7\x9e\xae=\x9e\x0bx\x125E\x7f\xa3\xcf!T\x95\xe2F\xe3\x19\x9a\xd65\x96\xe9&\x10\xe9D\xee\xec\x8f\xf9\x14\"\xcd\x04\xf2A:\xe8s\x1d\xd0\xd94\xccB\xfa\x8a\x18\xd1\xb5\x06\xef\xda\x18\x05\xf6\xd6I\xef\x1aQ\xda]\xfft\x96\xe2\xdf\x95V\xf8\xc7?\x17C\x95\xc5\xb2\xa4\xc6S\xf5\xc3\xf0\xbe\x1e_\xd1\xdf\xc4G\xcf\xf4H\x1e~\x8e\xa60\xf8\xd3_\x16\xf1b\xaa>\xa6\x97p!\xfe+\x00\x00\xff\xffPK\x07\x08saiI\x9b\x06\x00\x00\xa9\x18\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/bases/operator.kubecarrier.io_ferries.yamlUT\x05\x00\x01\x80Cm8\xb4XO\x8f\xdb\xba\x11\xbf\xfbS\x0c\xd2\x83\x93\xc0\xd66mQ\xb4>\x14\x08\x9c\xa4\x08\x92\xf7\xb0\xd8\xdd\xe6\xd2\xf4@S#\x89/\x14\xc9rH\xe7\xb9E\xbf{1\xa4dI\x96\xbc\xbb\x08Z\x9elr8\x7f~\xf3\x97Zm\xb7\xdb\x95p\xea\x0bzR\xd6\xec@8\x85\xbf\x064\xfc\x8f\x8ao\x7f\xa2B\xd9\x9b\xe3\x9b\xd57e\xca\x1d\xec#\x05\xdb\xde!\xd9\xe8%\xbe\xc3J\x19\x15\x945\xab\x16\x83(E\x10\xbb\x15\x800\xc6\x06\xc1\xdb\xc4\x7f\x01\xa45\xc1[\xad\xd1ok4\xc5\xb7x\xc0CT\xbaD\x9f\x98\xf7\xa2\x8f\xbf-~W\xfcy\x05 =\xa6\xeb\x0f\xaaE\n\xa2u;0Q\xeb\x15\x80\x11-\xee\xa0B\xef\x15Ra\x1dz\x11\xacO\x1c\xa5\xe0M\xe6\xb8\"\x87\x92%\xd7\xdeF\xb7\x83kd\x99]\xaf\xa3\x08X[f\x9b\xffoA$\x89\x00\xd9\xf2\x0f\xe8\xfd)\xfd\xd7\x8a\xc2\xa7a\xef\xb3\xa2\x90\xf6\x9d\x8e^\xe8\xb3vi\x8f\x94\xa9\xa3\x16>\xef\xf2}\x92\xd6\xe1\x0e~f\xc9NH,W\x00\x1d\x02I\xf2\x16DY&L\x85\xbe\xf5\xca\x04\xf4{\xabck\xcez\xfdB\xd6\xdc\x8a\xd0\xec\xa0\xa0 B\xa4\xc25\x820\x9d\xf6\x08\xdd\xa7\x83n+\x9cX\"\x05\xafL=\xe7\xd1{\xae\x98\xa1>\xe1\xf8\xb6\xc6	\xbbR\x84\xbc\x91\x8f\x8fo\x84v\x8dx\x93\xad\x96\x0d\xb6b\xd7\xd1[\x87\xe6\xed\xed\xc7/\xbf\xbf\x9fl\x03\x94H\xd2+\x17\x92\xf3_$0\xa1\x15F\xd4H\x10\x1a\x84\x12\x9d\xb6\xa7\x16M\x00[\xa5\x9dL3\x84SG\xee\x8b3O\x80\xaf\xa6#\xd3\xea\x88\x04\xca\xa4\x9b\xb7\xde\x1eU\x89~\x00\xbe\x80\x0f\xd6\x03\n\xd9d\xdf$\xb2O\xf1\x80\xfb\x1c!#\x96}\x00\x019e\x08\xa2K\xb4\xd5\xa52\x83\xbe\x1b0(\x91H\xf8\x13x\xab\x916@\xe8\x8fJ\xe2\x88\xab\x90\xd2F\x13h\x03\xc2\x94\x89\x0c\x0e\xca\x94\xca\xd4T\xb0\x15\x0f\x0d\x82GA\xd6@e\xfdc\xe2\xc6L=B\x1buPN#\xed\xe05\x10\xca\xe8U8\xc1v\xfb\x97\xb1y\x83Q\x8d \xa8\xd9\xf7\xe8\xc1yuT\x1ak\xa4\x11\xcf\xd0\xa0\x99\x8b\x7f\x0d\xbe+\x03\xa0\xc8\xea\x14:I\xc8\x08\xd2\x11\xb9\xb3\xe5\x0cQ$\xb0F\x9f\xc0\x1a\x10)U4\xf68\x81\xd4\x91\x02\xfa\x0d|\x0d_Ch\"\x0d\xe2\x84\xd6V&yc\xc3M	\xad5*X\x0esP\x04\x84N\xb0\x10p\xd8\xa1W\xc0C\xa3(\xdd\xffNP)\x83\x1ej/JLBz\xfec\xcb\xa3af\x17\xbc_C\xa5\xf1WuP\xba\xc7\xf5c\x05\x06\xb1\xc4\x12JUU\xe89d\xbb2\x00\xd2F=6\xbd\x11G\x1c\x91\x0d^$\xfe\x8d)\x00\x18\x91l7*\x8e:\x94\xaaR2\xc9\x80\x97X\xd4\xc5\x95@U\xad\xa8\xb1/')j\xd8\xbc(4\x87\x97f\xc6\xd1%\x837#\x0d\x16p\xdd\x8cxb\x90\xaf6/\xce\x1b\xce\xb3\xef\xc2\xb9N\xe65\xea \xa3\xdd\x8b\x14_s\x15\xe8t+\xb9uty\xde\xe9\x8beW8r\xb6+\xf6\xb8\xf3Hh\xc2\xa5\xb3y\xd9\n\x84\x01{\xf8\x05e(\xe0\x1e=\xb3\x01j\x18n\x0e\xbc#z\xb6M\xda\xda\xa8\x7f\x9dy\x13\x04\x9b\x84j\x0e\xbfp\xc13\x95[#4\x1c\x85\x8e\x98\x13\xb3\x15'\xf0\xc8R \x9a\x11\xbfDB\x05\xfcd=\x822\x95\xddA\x13\x82\xa3\xdd\xcdM\xadB\xdf9\xa5m\xdbhT8\xdd\xa4\\P\x87\x18\xac\xa7\x9b\x12\x8f\xa8oH\xd5[\xe1e\xa3\x02\xca\x10=\xde\x08\xa7\xb6Iu\x93\xbag\xd1\x96\xbf\xe9\xbdC\xeb\x89\xae\xb3\x9a\x9eW\xeaU\x8fx\x80\xfb\x16\xe7\x86\xe8\xaef+\x06\xa0y\x8b\xd1\xb9{\x7f\xff0\x04\x06;\xe3\x12\xfd\x84\xfbp\x91\x06\x170`\xcaT\xe8\xb3\x13+o\xdb\xc4\x13M\xe9\xac2!\xfd\x91ZM\x0b\x17/\x8a\x87V\x05\xf6\xfb?#R`_\x15\xb0O\xe3\x04\x1c\x10\xa2\xe3\xa6S\x16\xf0\xd1\xc0^\xb4\xa8\xf7\x82\xf0\xff\xee\x00F\x9a\xb6\x0c\xec\xf3\\0\x9e\x84.\x893j\xa3\x83~R\x19\xd6\xc4_\xa9\x91\xdd;\x94\x93|)\x91\x94\xe7\x88\x0e\\\xddl\x95\xc9\x8a	\x9b\xe54\xe5\x95\x86 k*U\xdf\xa3\xf4\x18.\xcf/T\xf8tA\xdeW\xa3N\x97\xe1\x98\x13+\x12\xc2\xf7\x06/S\x15\xf2\x0chP\xe6\x00\xcb\x19x\x9f+\xfd>\x17\xfabv\xe7\xba	\xd0\x8f\x1d\x0b\xfbW\xfd\x92\x17\x87\x16\x837\xbf\xbaM,g\xdbW\x1c\xc7K\xdb\xfa3\x87\xd1\x13\x08~\xee\xc8\xae\xb0\xe6\x9aSO\xaa8/'\"-i9\xe1|\x9b\x88 \xa0\xd6\xe3V\x1bl\xbe\x9e\xaa\x9f\x91J\xab\xdc\x9c\x9d\xb7<\x94,\x80\xc6uN\x10\xc5\x96s]\x84n\x80J\x15X\x94\xa7+\x8a/ \xbc\x8c\xeev\x16s\xcfJ\x8d4\xc2>\x99\x1c\x89j\x92\x1e\xf6\xc03\xc4\x0f\xe7\x87\xb4&\xcf\xdf\x0ba7\x91\xbf?\x13\xe6\x8a\xca\xef\x82\xd4\x95\xb27\xfa\xb3T\x04{@\x17\xb0Wf\x1e\xfa*`\xbb\x18\xf5s\x00\xceZ\xa4\x08\x10<\x9f\x96\x18\x84\xd2\x94\xfa\x7f\xaa\xb5\xd1\xa7F\x7fVj1m\xfa\xa6\xbb\x00V^\x8f\xe7#\x80\x16\x14\x1e\xbc0\xa4\xfaW\xc42\xddez\xcc\xae1\x9c\xb9ES\x80\xa0RP\xe2\x13\xda\xf3\n\x99K\xee8\xd6`\x17B\x9c\x0f\xc2\xd8\xd0,\x15\x99\xbc*\xeb[\x11\xf2\xa3f\xcb\x12\xaf\xd0=ZZ \x15\x7f\"Q?\xcf\xf0\x9f2mom\x13[aR\xc2\x89\x83\xc6\x9e\x13\xf0\x8b\x80\x87\xb2+\x023\xd7\xecoq\xb01t\xa8\x9d\x01\xbdf\xf2\x93\xa6\xe4\xa7\xc7\xb3,\xb9\xcb\xaf\x94\xce\x90\x97\x07\xaf\xb0z5~\xbbL\xfc\xb7^\xca\x82\xbc\xfeW\xba/\xd5\x8eaMt\xef\nH\xf7\xc0<+\xb9I\x01d+x\xb9~\xf0\x11\xd7\x1bX\x7f\x10\x9ap\xbd\xb9\xaa\xfc\xfao\xe6\x9b\xb1\xdf\xcd\xfa\xd5\x0f\xeb\x9d\x08\x9e\xa3\xf5\xc3\xc9\xe1\x82\xce]\xa6\xeb\x13\xbc\\\xdfq\xe9\xfea]\xae\xf7I\xae\xe7\xf3T_$\xebbx\xf1,G\xc7\xe2\x11\x8d?^L\x8fX\xeb\x85\x83G\x9at\x7f(\xbc\x17\x97\x9d\xac\xef\x14\x7fE\xc3\xcf\xd1\xd9\xeb\x05f\xb07\x08\xad\xa5\xf4\xae\xe0\x8aZ\x9f/\x0e]\xe7p\xea\xbd\xd2\xf5\xe3\xb9\x07\xfar\xa3L\xf8\xe3\x1f\xae\xe8{e,h\x04-D\xc8D\xc9w\xefo\xef\xde\xef\xdf>\xbc\x7fW\xc0-\xd3\x8f\xa6\xf4I?\xd0\xaaBy\x92:\x17\xca%\\\xfb\x9e\xd0\xbf\xb2\xf6\xd6P\xfa\x8a\x92'{\x85\xba\x1c\x89\xdb\x80\n\xf0]i\xcd\xc3\xba\xc7\xd6\x1e\xb1\\`*\x08\xc8\xf2\x93?i\xe31w\xcf\x16e#\x8c\xa2\x96\xcbu+\xdc\xa4\x85\xda.P\xe9\xdat\xe9\xbc\x1a\x1e/]\xa3\xea\x9f\xfb\xaa\xfb\xca\xc0\x85\xa8T\xe4\xb48\x81\x8b\xdeY\xc2M\xda\xc4#\xfaSh\x96\x13\x015a\x9ak\x07}\xe6\x0e\xbd\x9aL\x8b\x819\xdb\xcc\x91\xb3\x83\xe0c\xf6\x02\x05\xeb\xb9\x8d\x8cv\xe2\xe1\xfc\x12\xec\xfd\xdfU9\xf8\xf7\x7fVC\xc1\x13R\xa2\x0bX\xfe<|\xda\xcc\x1f0_\xe47|\xff\x952\xfd\x1d\xcd:\xf0\xf7\x7f\xac\xb2`,\xbf\xf4\xdf#y\xf3\xbf\x01\x00\x00\xff\xffPK\x07\x08\xcd\xaa\xe7\x91\x00\x07\x00\x00\"\x16\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x003\x00	\x00crd/bases/operator.kubecarrier.io_kubecarriers.yamlUT\x05\x00\x01\x80Cm8\xdc\x1a]s\xe3\xb6\xf1\xdd\xbfb\xc7yP2c\xd1\xb9\xa4IS\xbfy\xe4k\xea\xe4\xd2x,\xdd\xf5\xa1\xeet r%\"\x06\x01\x16\x1fr\xd4N\xff{g\xf1A\x91\x12)\xd2\x97\xcbK\xf1b\x8bX,\xf6{\x17\x0b\\\xcc\xe7\xf3\x0bV\xf3\x0f\xa8\x0dW\xf2\x06X\xcd\xf1W\x8b\x92~\x99\xec\xf9;\x93qu\xbd{s\xf1\xcceq\x03\x0bg\xac\xaa\x1e\xd1(\xa7s\xbc\xc3\x0d\x97\xdcr%/*\xb4\xac`\x96\xdd\\\x000)\x95e\xf4\xd9\xd0O\x80\\I\xab\x95\x10\xa8\xe7[\x94\xd9\xb3[\xe3\xdaqQ\xa0\xf6\xc8\xd3\xd6\xbb/\xb3\xaf\xb2?]\x00\xe4\x1a\xfd\xf2\x15\xaf\xd0XV\xd57 \x9d\x10\x17\x00\x92Ux\x03\x84 gZs\xd4&S5jf\x95\xceZ_3\xae.L\x8d9m\xbf\xd5\xca\xd570\x04\x16p&B\x99\xc5\xad\xd2<\xfd\x9e\x03\xf3\xdb\x02\x04\xf6\x7ftk\\\x84\xb5\xfe\xab\xe0\xc6\xfex<\xf3\x8e\x1b\xebgk\xe14\x13]r\xfd\x84\xe1r\xeb\x04\xd3\x9d\xa9\x0b\x00\x93\xab\x1ao`!\x9c\xb1\xfeC\x14\x8d\xa7f\x0e\xac(\xbc\xb0\x99x\xd0\\Z\xd4\x0b%\\%\x1bZ\x7f1J>0[\xde@f,\xb3\xcedu\xc9\x0c\xfa\xd9$\xba\xa5\x9f\x88\x9f\xec\x9e\xb63Vs\xb9=\xc5\x91T\x9a\x9d\xa8\xa3\x83\xf1v\x8b\x1dt\x05\xb3\xe1C\x98\xde\xbda\xa2.\xd9\x9b\xc0x^b\xc5n\"\xbc\xaaQ\xde>\xdc\x7f\xf8z\xd9\xf9\x0cP\xa0\xc95\xaf\xad\xb7\x8a\x96d\xa1b\x92m\xd1\x80-\x11\n\xac\x85\xdaW(-\xa8\x8d\xff\xd2\x86<\x98\\\x83\x15\xe2r\x9d5\x9fjMfa\x1b}\x87\xd1r\x87\xd6\xd7#\xb2fDy\x80\x82\x82\xfc \x92\x15U\x86Ed6\x10\xc7\x0dh\xac5\x1a\x94\xc13:\x88\x81\x80\x98\x04\xb5\xfe\x05s\x9b\xc1\x125\xa1\x01S*'\n\xe2e\x87\xda\x82\xc6\\m%\xffw\x83\xdb\x80U~S\xc1,F\xa3;\x0co\"\x92	\xd81\xe1\xf0\n\x98,\xa0b{\xd0H\xbb\x80\x93-|\x1e\xc4d\xf0\x93\xd2\x08\\n\xd4\x0d\x94\xd6\xd6\xe6\xe6\xfaz\xcbm\n\x03\xb9\xaa*'\xb9\xdd_{\xf1\xf2\xb5\xb3J\x9b\xeb\x02w(\xae\x0d\xdf\xce\x99\xceKn1\xb7N\xe35\xab\xf9\xdc\x93.}(\xc8\xaa\xe23\x1d\x03\x87\x99uh=\xb1\xc30\xbc\xcf\x9d\xd1\x00y\x1ep\x03,.\x0d\\\x1c\x04M\x9fH:\x8fo\x97+H[{e\x1cK\xdf\xcb\xfd\xb0\xd0\x1cT@\x02\xe3r\x83:(q\xa3U\xe5q\xa2,j\xc5\xa5\xf5?r\xc1Q\x1e\x8b\xdf\xb8u\xc5-\xe9\xfd_\x0e\x8d%]e\xb0\xf0\xb1\x11\xd6\x08\xae&G)2\xb8\x97\xb0`\x15\x8a\x053\xf8\xbb+\x80$m\xe6$\xd8i*h\x87\xf5c\xe0 \xb5\xd6D\x8a\xb8\x871\xe4\xc8\xcb\x1a\xf3\x8e\xd7\x14h\xb8&\xbb\xb6\xcc\"y\xc3q\xa4M\xa3\xdfc!x\xed\xf1\xa7#\x02n\x1f\xee\x83Z\xc7\xb7o@O0\x0e\x13@\x839[\x92\xb8s\xef\xe4}\x10\xc74u\x16\x90\xabo\xf8\xd6\xe9\xbe\x18\x11\x06\xb7X\xf5n\x0d\xa3\xc4\x85\xc1\xa4\x92\xfbJ\xb93 \xc74\xa6\x15^\xc3|\xc3\xd1\xc0K\x89\xb6D}\xc0\xe6Y?\x83\xd1\x13\xb7\xe3\x05j@\xc9\xd6\x02\x8b3\xc0\x03\x06\xd6\x1d\x8a\x17\xf9d\x1e~\xbe\xbf[\xb4\xc8\xff\xb9Fy\x7f\x07\x0b%%y\xfe\xb8\xd8\xd3\xd8(M\xc6\x11\xe3\xc3\x91\xbe\xcf\xac\x9c\xa2\x19\x1a9\x01m\x08\x1f\x92i(\xcd\xed\xfe\xfc\x8a#F\x17=\x08@\xe3\x065\xca<\x9a\xbb\xc1\\\xe3\xb0`\xd3\xa0\x18\xc3\xb8\xa40\xca\x8dq\xa8g\x06\x16\xb7\xc0%0xx\xfb\x13\xa0\xccU\x81\x05h\xa5l\x9b\xf0Q\xc41_'\x83\xc8F\x16L\x95\x1d\xa4\xa2c\x14j0\xde\xf5\x0d\n\xe0\x14\x1d\xc6\xd0\xce\xfd\xe6#@\x93,\x9bF\xc8)\xf7wc\xbbv\x94\x7f\xb9\x88\xab\xbc|\x7f\xf8\xdb\n*g|\xba\xf1\n,\xc8|\xafhnTBO\x97\xc6\xad\x9f.a\xc3Q\x14\x19\xac(\xf9\xd5\xc2m\xb9\x04%\xc5\x1e\xacv\x94\xd3\x98\xafe\xc5@\n<\x1dV\x01J\xe34\x06\x03\x08\x08s&}F4X\xc0\x0b\xb7%\xd4n-x\xde\xd8\x87\x193\x10\x80'	\xab\x03B\xe3\xeaZi\x1b\x8c\xfd\xe9\x92\x05?\xa0:\xa7f\xda\xee\x9f.\xa3\xff\x8f\xa2m\xe2\x83`\xbc\xba\x82\x97\x92\xe7%\x1d	\xd4K\x8c\x85L\x04\xb4\x89\xd2Q\x8cV\x05U\x80U\xcf(}\x01\xc7\xa2\xf0|laP\xf0\x8dwV\x1b?\x8f3\xbfD<T\x0bTT\xf3\"\x93h\xaf\x89B\x13?P!@\xac\xccs\xa5q\xfe\xe6\x9f_f\xa5\xad\xc4g\xf7w+\xa2\xe3r\x92\xd5N\xf0\x16\x7f\xd82\x0b\x12\xd7\xab\x0c\xf7\xfb\xc3\xba+\xe0\x9b&R\x17W\x903gb\xdc\xa2 \xdeJ\x98\xea4;\x1f\x0f\xaa\x8f\xf5\x9e\xfe\xd4\xaavT&{D\xce\xf8`\x16\xa8\x0d6\xc7$\xdc\xdf\x81\x97\xc6(\xd6\xe8\x14\xf7!\x8c\xb5h\x0f3T\x93\xc6J\xd2\x07K\xc2<\x8a\xd3\xef\x1cN\x10\xbe\x8aM\x8e\xdb\x14\xb7J\xfb\xe3&\x05\xcf\xf0e\xd4-^\xa9\xb5\x07\x8d\x1b\xfe\xebG\xa8-,\x1c\xd0\x9bw\x9dq\xbf\xa8X]\xfbz]\x05j\xc2\xa9\x9c~\xae)Q\xd0\x06):42\x1a\xe3\x1f\xe06\n\xf3\xd2\xd7\n\x97\xf0\xe2\xcfS\x1a\x8d\x13^5\xd1\x00\x04\x7fN0(\xb7\\\"\x92\xc8\xc6\x9c\x02\xfc\x89*,\xab\x98~F:p\\\x8e\x115Y)!\xe5\xbe\x7f|\xf7*\x8d\\\xde\xa7ed\x85$*\xfa\xb7\x9dm\xc1\xf0\xad\x1cWHr\x06\x03\xcc\xc4\xf8\xff\xc2\x85 u\x84\xa8\xca\x8dI\xf9\xc1\x1f_c\xa3\xe4\xdc\x881\xaf\xd6\xaap9\x16\xb0\xdew)c\xe1<\xe7\x13\x01\x05\xc3\xe9e\x19\x8d\x82\x9b\\\xedP\xef\xb3\x94\x0e\xa2\x14\x9cqL\x88\xee^3C\xb3\xa38\xc9\xe0\x94\xb3\xc0\xa0f\xb6\xbc\xf2T\xe1\xaf\xac\xaa\x05\x89 \xc5\\\x96\xe7\xca\xd1\xb1q\xab\xd4V`\x96\xab\xeai\xdcz\x94n\xa1\x10j\xcbef\x98@\xb3Q:\x8f8\x1aN\x12\xd9\xa3H}\xd8\xe0D\x9f\xef\x8ct$\xd8\x96\xd0\xabrF\xb3\xee\x908\x1e\"A\x0b\xbf\xc1\x18\xb3\x93\x8d>\xd5Z>\x9a\x8e\x96{\xed\x86\xd8\xf4\x12q21G\xae\xf5\xd8\xa1m8M\x8d\x12p\x92\xc6(\xcc\xedP\xf3\x0d\xd9(\xb3\xe4K\xdeX\x934F1\x86 \x0b\xcf\xb8\x8f\x01\xaff\\\x1b`\x1a\xdb\x89\x88P&\xb7\x9e\x16\xa6&\xd4\xa9\xb1\xd6\xc2b\xc9\xb7tT\xb8\x15\xdbQ\x1d\x14\xb8aN\xd81\xb09<.\xbf\xfa\xe6\xdbQd\xed\xf0\xb7\xec!\x07\x0c\xc6Z\x90\xe59\xd6v\x82@\x0d\xfaD\xfb\xc3\xcf\xcb\xb7>^RjbbK\xa7\xa9\xb22AK\xed\xb2u\xbd\x1fE\xd9\x89tVy\xb41\"z7O\xceh\x95\x12&\xe3h7\x99\xd2\xdbk\xf2\xb6k\xbd\xc9\xff\xf8\xcd\x9b\xef>3\x98\x13\x9f\xf3\xaf\xb37\xa3\xfb\xf9\xc8\xc1M4\x88(r\x9fQ\xbdT\xafZ\xb5\x86\xc6\\U\x15\xcab\x82db\xcc>:@\xfb\xd6O\x9b\x8b\xb1\x90\xd2[\x86~`\x82\x17>T\x8d\x05\x94\xb3]\x90\xc3x\x85\xab\x07P\xa65;\xafJ*\x1d\xa96\x99X\xe3\x06C\x07\xe3\xd6\xa3\xa0-3~\xdf\xde$%r:\xce\x85tk\x15\x91\x01l<\x8b\xb7\x8a\xddD\xf84\xcf\x9f \xb3\x84\xf0#\xea\xc6\xf7\x9d\xa5\x9f\xacrL\x14u\xca\xc6C\xd1\x18\xbdo\xdc\xc6Cu9Z=\xa6\xedB\x019\x8a5\xa0\xf9E\x95\xf2S\x15\x89S:\x13\xf3\xde\x96\xd2\xf9\x05\xb1\x89p\x16\xa8)O\xcf@MJ\"\x06\xf5\x8e\xe7x\x1bj\xa8\xc9\x0d\xbdegYOg2\xe2\x85X\x9c\x9d\x958s\xb6\xfc\xe4\xfdIc\x99\xe59\x99\xfa\xf4V\xeb\xf2\xb0\xa6\xc5R\xc0\xe4\xed\xcd\xbc\xa2(\xfe\xbd{\x95\xa5\xad\x991/\xc5\xd2\xb7\x13_\x15\x00\xfe\xe2\x976k[\xbc\x92\xa3&\xc4\xa3.\x15\x1a\x99)\x1e\x12\xbf-Q\x1d1<\xe6sS\xb9\x86\xff\x9f.\xe3\x94=\xe7Gj\xfem^1\nr>\x0d\x0b\xb5}\x87;\x14\x13.V\xdeE\xd03\xbbpiq\xdb{\xa6\xaa)\x03\x0d\x88\xa5\xb3\xcb\x83\x07\x04\x8bB\xb4\xae\x9c}\xbf\x89f|e%s.x8\x80\xd5Z\xe5h\x86r\x1a\x1d\x7f\x991\x8e\x12\x18\x15\x98\xcd%\x14\xf8\xebcV\xf4\x87\xed\x11#\xb3\xc2\x04\xcd=\xe2f\x02G\xabw\xcb\x06\xfc\xf8\xde`\xf5n\xd9\xce&\x9e\xe0Z\xf3\xddp\xcf\x9fN$\xde-)\x18\xc7\xeb\xd8\xf65\xfd\xed\xc3}\xbf_\x8e{\xe3y\x1f\x1c\xf5\xbc\xf3\xb6\x7f\xc6\xcb\xce\x9a\xf0\x99\xc9a\xdb\x9df\xb7\xe7lv\xc8^\x7f\x1f[=\xb6\xd3\x1f\xdd\x9a%\x85\x0eZ\xea\xa0B\x86.\x93\xfdC\x95.K\x83\xd7\xc9\x1e\xb6s\xa3\xab\xd6dr\xbf\xedF9W2\xf4\x17zl\xb0{\xe7\xd6\x00\xb6\xde\x10x2\xc2\xc3\x0c`;\xc6\x05\x15\x15\x91\xae\xf08\xaaG\xb4j\x03\xacM\xe9\xcc@\xee\xb4\xbf\x0d\xf0\x8c\x9c:\xcb\xe0IhHX\x0d\xb1\xe9~\x8f\x04g\x19\x17\xc6{\xaa\x7f\xcf\x10\xb6\xec5\xffF(\xcd\xc3\x96\x16\xee>_\x1e\xf3d\xc1\x8c]i&\x8d\xc7\xba\xe2\xc3^\xdd\xf5\x93\x93e\xe9\x9cD\x08\xc1\xf2*4*\x1bz\x87CE\xc0\x12\x1fw(\x89\xd1\xf6\xfc\xdd\x8cTTL\x0e\xd5\x0e\x1b\xa5+f\xc3\x9b\xa79m9\x007\x1a\x8d*4\x86m\xa7q\xfeS\x80M\xec\x96\xaeb\xd2;\x9d7\xb0*\xcd\xca\xc2\x17>g\n\x8f\xa4v\xb6V\xceF\xb15\x12\x1dby\x94\x15\x8d\xcc\x0c=}8\xe9\xa8\x11hb\xe4\xf3\xb5\xe6\xb8\xf9\"\"8\x18cR\xe0l\xf8,\xf8\xa9h\xef\x0b:\x87qR\xa9;\x93h\x8f\x16\x13/\xb6\x1b\x92\xaf\xbc9\xa9\x0d|>[i\x87\xb3\xabA\x0ef\x7ff\xc2\xe0\xec\nf\xef\xe5\xb3T/r\xf6\xc5Gs\xe1\x01\xa6\xf0\xb0\xda\xd7\x8d\x19\xd1\xa2\x81\x87t\x91\x97A\xdac\xb8\x10{\xf8|\xf6H\xc1\xff\xa3i?\x97\x94\xe7=\x91\xa2\x17,z@\xef\\\xb0\xad\xde)\xd3~\x19\xd9\x9d\"\xaa{&&T\x02}\x85lJM\xdf\xa3D=\xf0N\xa8\xfbv\xe5dARZ\xa5\x8c\x7f\x16H\xf9a{\x98M;\xf4\xd0\x1c\xbc\xaa\x1b\xb5SO\xefP\x14\x9c\xaa/E:.\xed\xb7\x7f\x18`v\xa06)\x99\xe91\xc7\x0e\x87wo\x1f\x1e\xdf.nWo\xef2x \xf8\xe3<\x9a\x92\xa0\xe0\x1b\xcc\xf7\xb9\x08\x1e\xd7\xa7\x94\x94\x95\xd2[\xca\x85\x92&\xb4\\\xfd\xfb=\xdf?;lw\x05\xdc6\xf7Z\x1a+\xd5/5f\xc0(%\xe9/\xa5\x03\x0c\xaf\x0e+\xccK&\xb9\xa9(ST\xacn\x15\x0c\xbe\xc1\x1bni\xe1\xa5\xec\xbdR\xae5?<Q\x8cIr\x1fo\xda\x88~)B\xe9\\pS\x0b\xb6\x87\xda\xe9Z\x19\x8cwP;\xd4{[\xf6{\x11\n\xe3\xbb~-zN\x15\xfa\xbaz\xec\xe4c0\xb0\x1b\xb0\xda\x05-\x18\xab4e\xb0\xd6\x17\xb7n\xde{&\xfd\xc7\x00\x0b\xff\xf9\xef\xc5!\xd6\xa6\xae\xfc_\x0f\x0f\xb1\xc3s\xeb\xcb\xd0\x06N\xef\xa8\xfd\xcfVQ\x06\x7f\xff\xc7E\xd8\x18\x8b\x0f\xe9\xa54}\xfc_\x00\x00\x00\xff\xffPK\x07\x08\xeb\x94\xac\x94\xff\n\x00\x00\xd5.\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8\x9cT\xcdn\x1b=\x0c\xbc\xefS\x10\xd8\xe3\x97\xd8w\xdf\xfc9F[\x14i\x00\xd7@\x0fE\x11p%\xcab-\x8b\x0b\x89\x8a\x9b>}\xa1]\xff\x9e\xea\xfa&S\xa3\xe1\x0c\xe9\xd9\x16\xd6\x9e3lKV\xd9\xf1oT\x968y\xc7]\x00\xce\x10E\x81\xa3R\xb4dA\x05:\x82T\"t\xef\xc0\x9a)\xb8\x87\xa6\x85\xcc\xd1\x10\xb0\x82\xa5\x9e\xa2\xcd \x112\xa576\x04\x11w\x04\x18\xedp\xc8=\x1a\x02\xf5\xa8\x80\x89@\x8a\x828\xd0\xcb\xee\x04=\x9a-nh\xd2\xb4\xf0I!{)\xc1^\xb45\x12\x1do\xa6\x96\x1c\x96\xa0M\xa2,%\x19\xca\xb3\xe6\x11:\xcc\x94\xa7\xd2SB\x954\xd9\x96\x8e\x0c\xa6\xc4\x94&,\xaf\xd8sUE)\x0f\xee\xfe\x8a7\xa8\xd8\x97\xa07\xc2)\xd0[\xad\xdf\x08wT\x8f7\x82/~\x1e^\xb4\xf0_-v\x85\x83\xa54\xcb\x06\x9d\x93`g&\xd9\xd3(\x8f\xb3i\x9a\x1e\xd5x\xca_5\xa1\xd2\x86\xcd3\xa5\x0d\xcd\x9a\x16\xbe\x7f[\xfe\xff\xf1\xe5\xe5\xf3\x0fX\x0bP\xc4.\x10\xec\xa9\xf3\"\xdb\x07(\xd1\xc8nGQ\x01C\x00\xf5\x04\x99L\xfdwd\xd8\xb3\xfa\x8b\xc7}\"\xc7\xbf\xea\xca\x0e\x9d\xc0S\xa2a\xc9N\xd2H\xccq3p\x18\x89u\x07,\xf1\xd8i\xc4\xa0\xf1\xb0X=5\xed\xe3\x91dz\xb8\x7f\xe5x\xe7\x04\x0e\x04\x03_S\xed.\x96\xab\xf5\xf3\xfc\xcb\xfc\xc3ru\x9f\xe5+\x82\x7f\xb1\xbd\x98\x03\xc7\x9f#\xd9\xb5\xe1\xb3_\x83'\xc8\xfd\x9e/HN\xbe\xab\x00'!\xc8\xbe\xca\x19#T\xd3]uh\x15R\xcb\xe7\x08z\xd9\xd7\xac[\xb9\xfe(\x0c\xf0\xc5\xea)O\x9a\x91\xa2\xa4\xa1>d\xef\xdc\x7f\xb8\x1a\xf5\xfe	\x00\x00\xff\xffPK\x07\x08\xd1\x91\xefR\xb6\x01\x00\x00[\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\xd01\x8e\xe30\x0c\x05\xd0^\xa7\xf8\xc0\xd6\x1bc\xbb\x85Z\xe7\x04\xc9^\x80\x91\xe9\x98\xb0C\x1a\"\xed,\xe6\xf4\x03;\x93\x19L\x93b*	\xa2\xf0\xff\x93~\xe1\xdf \x8e^&\xc6\xb6ZE0\x95A\xf4\x8aq\xf1\xb0\x9b\xbc1\x06\xbb#\x0c\xbe\\<$\x96`(\xdd\x18\xa4\xdd\xbe\xf1\x99\n\xa3r\xcf\x95\xb50D\xd1\x9e\x8ei\x1b\x9d\x9e\x879\xfd\xc6(\xdae\x9c\xb9\xaeR8\x01+W\x17\xd3\x8c\xf5O\x02z\xe1\xa9;\xcf\\<'\xe0y\xbb\xdd\x11'v[j\xe1#\xf7\xa2\x12b\x9a\x00\xe0Zm\x993h\x16\xfe\x1f\xac[\x98\x1f\xc6\xbf~\x10\xdb\xe73\xc5\x90\xe13\x97\xa6\x98~\xd45w\xbe\x0cfc;	k\xb4\xa6\xbd\\\x1b\x7f\xa0\x9a\xcd\x9c\xd2\xe7\xa3\xbe\xd4/\x1c\xaf\x15?3\xec\xed	(\x95)8\xa3\xa7\xc99\xa5\x95\xea\xb7\x1f}\x84\xdf8\xa8\xa3\xa0\x86T-hcyz\x0f\x00\x00\xff\xffPK\x07\x08\xf6]\x02\x05\xf3\x00\x00\x00\xda\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00,\x00	\x00crd/patches/cainjection_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8d\x8eAK\xc3@\x10\x85\xef\xf9\x15\x0f\xea\xc1\x1e\xba\xa5x)\xb9\x854B\x0f\x8a\xd4\xe2U\xc6\xcd\xb4\x1d\xd3\xec\xc4\xd9I\xf5\xe7K\xac\x07\xc1\xcb\xc0{\xf3\xf1\xf8f\xd8\x9f\x18\x07=\x9f\xf5S\xd2\x11\x03y<\x81\xda6\x83\xd0\x8aqt\xb9L\x80!\xb2yO\x89\x8elp\x85\xa4w\x8e\x8e\xba\x82$W\xf8\x89Q\xef6\xc5l\xba\x88\x9a.lY4\xc1\xf8c\x14\xe3\x8cn\x9d\xb1\n\xab;\xa8\xe1L\xce\x16\n\x1a\xe4\xe5\x8a\x95\xa0A\xf8\xcb9M)\x87n\x9d\x83\xe8\xf2\xb2*:Im\x89z\xcc\xae\xfd\x8e\xb3\x8e\x16y\xc3\x07I\xe2\xa2\xa9\xe8\xd9\xa9%\xa7\xb2\x00(%u\x9a\xea<E\xfc(/~\x9d\xa7\xb9\xab\xf3\"\xd2\xe2`\xda\x97\xb8\xb9\xad\x9b\xdd~{\xbf\xad\xab}\xf3\xfaX=4\xcfOU\xdd\xcc\x97\xff\x1f\xf3\x02H\xd4s\x89n|\xe3Hf\xc2\x96\x83\x0el\xe4j\xe1O\x1bD\x8b\xef\x00\x00\x00\xff\xffPK\x07\x08k\x90O \xf4\x00\x00\x00X\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00	\x00crd/patches/webhook_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdbL\x0c\x84\xefz\x8aAr\xf8\xff\x02\x89\x02\xa3\x97@@.u\x9e (\xdaK/+i,\xb1Z/U\x92\xb2\xeb\xb7/d;\xa9{Y\x80C\xce\x92\xfcx\x8f\xaf#\xb1\xd3\x9c\xf5(e\xc0\x9c\xa2\x1b\xc1\x92\xdaLG\xa7\xe5@s\xd1\x82#\xdbQu\xc2N\x0d\xdb\xb7\xd7\xea~}o\x0b\x8c\xbf\x161:\xa6g\xc7\xa6\xde|\x86\x1ar\nZ]\xa5Y\xbe]\xca\x1a\xa4Y\xf8;X\xd6\xc8\xeb\xe9\xd9k\xd1\xa7\xc3\xa6\x9a\xa4\xf4\x0d\xb6\x8b\x87\xee\xdf\xe8\xbaX\xc7W\xee\xa4H\x88\x96j\xcfH}\x8a\xd4T@I{6\x98\x96\x96]2\x13\x9a\xd7:\xd3R\xa8\xd57j-Z\xf9\xccn\xb5\xfc\x1dt\x8d\x00\x0fK\xc1\xe1\xd4\xe0\xfbe\xb1\xb3z]r\x9b\x85%\xb6Zv2\\\xca\x81{\xc4(\x0eq\xdc\xfd(wX\x9c=\x92#a\xce\xa9\xe3\xa8\xb9\xa7=@c\xa4\x1d\xc5		\x1c%g\xb4\x84\xf1'\xbb`\x8f\xf6\x84\x18\xb9\x12p\xda\x81v\x86\xd9r\xe5\xde\xe6T\xa6\x87\x8f^\xed\x128\xf2?#\x06]\xd3\xa1p\xc6\xfa\xe9\x99(\x16?\xab#\xd1\xd1\xe2q\x9fJ\x1ah\xf8_\x0d\xb3\x06KH\xca\xf9\xb4Nw\xbe\xa7\xecP4\xae\xae[\xc7\xa7k\xc7.}YJ\x9f\xd9`;\xbc\xbc\\\xc5uH\xe9\xf8N\xe0\xc2\xdd\xe7\xd4\xb1\x81\x9f<\xb8\xff'\xd3\xbc\xe3{\xbc\x1a?\xb2s\x8a\xb1\xc1\xd3\xe5\x06Q\xfd	\x00\x00\xff\xffPK\x07\x083\xf7a\xe1s\x01\x00\x00t\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xccVMo\xe36\x13\xbe\xf3W\x0c\xa2\x83\xdf\x17X\xcbh\x8f\xba\xb9\x86\xda\x0d\xda|\xc0\xc9n\x0fE\x11\x8c\xc8\x91\xc5\x0d\xc5QI\xca\x8e\xf7\xd7\x17\xa4dKN\xbb\xdd\xa2\x08\xd0\xded\xce\xc7\xf3\xcc3C\x8e3X+\xe5\xc1bK\xbeCI\x10\x18\xd0\x18p\xe4\xb9w\x92|.\xce\xb6\x02\x9e\xfb\x8a$:\xa7\xc9-\xfd\xd1\x07j\x85\xc8\xe0#\x9a\x9e\x80k\x08\x8d\xf6Pk2\n\xb4\x87\xceQGV\x91\x8a9CC\"\x1b`\xa2\xe7\x05\xc4;\xa0|\x97\x03\x82\xa2\xce\xf0\xb1%\x1b\x92\xa7\x12\x19\\\x1d\xd8\xa9\xce\x91\xf7WP\x91\xe4\x18\x7f\x85FK\xf2\xcb\xc9\x94\x8b\x0cn9\x10\x84\x06\x03\xe8\x00\xbe\xe1\xde(@\xe3\x19Z\x0c\xb2\x81\x83\x0eMd\x11i\xd5\xfa\x05\xfe\x17\xe8%@E5;\x82\xc5r\xf1\xff\xa1\x00\x9a\xa4\x10\xd9X\x0bV\xbc\xa7A\x87\xfb\x14|)\x04w\xe40\xb0[F-~\xc2\x8a\x8cO**\xf5\x071\x01\xad\x02O\x86d`\xe7s!\xb9m\xd9\x0e1\x85\x80y\xda\\\xf3\xca\xb1\xa1\x02N\xf9\x85\xa8\xd0\x93/\xc4\x12\xf2|%\x9d\x1a>\\\x85r\xf8j\xd1\xe2\x8e\x9c\xc8\xe0\x97\x9f\xcb\xef\xde\xdf\xdd\xfd\xf8+<2\x90\xc5\xca\x10\x1c\xa8j\x98\x9f\xdfAo#l\x149r\x8b%{\x92A\xb3\xf5\x83HS\xf0(\x95\xb6\xd2\xf4J\xdb]rfK\xa0-H\xa7V\xcf\xbd\x0f\xdc\xea\xcf\x18\xa3\xf3#\xb6f 2BE\"\x9br\xfbx\xb3\xbe]\xffPn\xe7d$\xb9\xb0\x1c\xf9\xbeft\xc9f1\xcb\xb0\xc8a1\x92[\x80\xe4\xb6cK6x@G\xe0\xe8\xb7^;R\xf9(\x0f\xb90\xd3\xe3~{wS>\xbe/?<\xccYt\x8e[\n\x0d\xf5\x1eZ\xb6:\xf0\xd7\xb8Li\x16\xb9\xc8\x12\xd0\x94C\x88.N\x1a\xf9\x87\xe00\xd0N\xcb\x1br;\x8a}\xcd\xe0\xba\x86#\xf7p@\x1b\xe2\x87\x03\xc9686\x86\xdcI\x878-\xf4\xd2\xb1\xa7\xa4\xf3\xaa\xa5\xe0\xb4\xf4)\x9c\xac\xeaX\xdb\x00\x87\x15\x03\xda#`\x1f\x1a\xbb\xfa<\xe7\x1b\x83j6\x86\x0f\xb1UF[\x8a\xe3\x96\xc2O.#\xd2S\x8c~\xea\x1c\xbf\x1c\x9f\x12\xe7\xd4\xbb<\xb9\xdeYsL=\xe6\xfa\xaf\xdd\xcf\xc9O^\x93\x12O#\xf5\xb9\xf7x#+\x1a\xc5WI\xc0\xbf\x15+\xfe\xe5\x89>\x91\x1c\xf1^3{\xe3\x11\x17\x19|8;_\x98\xa6\xb0/\xb2M34\xe0\xc7\xca6k\xd0\xf6\xd3\x10\x15\x83\xe2\x19\xaaV{\x1f\x0f\xc6z|\x84\xbc\x04\xb2D*=bS\xbb\xe2\xaf\xde\x13H\x9cR\x8a\xe5)\x87\xc4\xf3\xe1+y.\xa7R\xb2\xad\xf5.n\x87\x9a\x1d\x04B\xd9\xc4\xe3S!\x04\x0d\x1f\"\x92b\xd8\xa3\x03\xdfW>\xe8\xd0'\xb0=:_\xbc\xbd\xe0\xe3L\xc4w#\xbe\xf0\x05D\xe3\xf5\xf7\xd7\x9b\xf5c\xf9t\xbb\xbe)\x1f\xee\xd7\x9b\x12\xb2\xd9\x8e\x1c7E\x04\xd4\xb5\x96\x18\x086[\x01\xc0\xd5'Gu\xbc\xf0\x00\xcf\xda\xaa\x026\x93K:\xdd9\xee\xbb\xe2\x82j\xae9\x99\xf6\xe4bW\n\xd8\x7f\x83\xa6k\xf0\xdbt:P\xf2\xe4\xf6\xda\xee\x961\x0e\xb2a\xcfF\xcb\xe9V\x0d+n>\xcb\x13\xee\xd0	\x18v\xd9\x99^\xfa\xd5ah\nh)\xa0\xc2\x80\xf9\xb4\xf9\xbe$\xc5\x7f\xbb\xc6\x13\xeb\x87r\xfb\xf1z\xf3\x95\xe6%8I\x7fZ\xd1\xc3\xd9vAyFv\x1c\xfb\xe5\x94\xe5\x9f\xc8;'\xfaFD\xa6\xd4\x9bm\xf9\xf8\x96\x99\xc5pw{\x97^\x9b\xf4we9]\xdc\xc18\xf4\xe1\xf7\x00\x00\x00\xff\xffPK\x07\x08qInk\x7f\x03\x00\x00T\n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x1c\xc7\xc9	\x031\x0c\x05\xd0\xbb\xab\xf8\x0d\x04\xdfuN\x05\xe9@8?\x0b\xf1\"$\xcd@\xba\x1f\xf0\xf1\x9d\xea\x0f\xbe\xe8\x9c\x8dR\x80\x1b~\xdf\xf9\x14\xdci}\xfd\x07g\x16\x00x\xfb:L\xa0f\xb1m\x9a\x1fA\x18[M\x0e\xeb\x9a\xac[\xe7\xea\xc7`\xd4`sf\xb9\x02\x00\x00\xff\xffPK\x07\x08\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xbdn\xeb0\x0c\x85w=\x05\x87;\xdc\x0e\x86\xfb\x93\xa1\xd5V\xa4\x19\x93\x06m\xd0\xb5`e6\x11\"\x89\x82D\xbb\xf0\xdb\x17Jj\xc3\x86\xb9H\xfaxxx \x8c\xf6\x83R\xb6\x1c4`\x8c\xb9\xee\xee\xd4\xd9\x86F\xc3\x0bE\xc7\xbd\xa7 \xca\x93`\x83\x82Z\x01\x04\xf4\xa4\xc1c\xc0#\xa5\xbfw\x8ehHC\xee\xb3\x90W9\x92)J!\x1f\x1d\n\x95;\xc0@K\x19\x0e\x826P\xca\x03\xa9\x16\xbe\xd7\x8a\x9cd\x14\x15\xd98\xba\xe7$\x1a\x9eV\xab\x87\xb1;\x84\xfb\xa1\xaf\x13\xf3\xb9\xca\x94\xba\x89\x17@L,l\xd8i8\xac\xf7#\xef\xd8\xb5\x9e\xb6\xdc\x86\xf9*_\xc8\x1e\xe5\xa4\xa1\x16\x1f\xeb\xf3c\xae\xe6\xd6u9l8V\x86\x92\xe4E\x8eB'0\x116\xaf\xc1\xf5\x1a$\xb5\xa4\xa6\xcb\x17\xff0\x1b\xcdd\x12\x89\x9eX5\xf4\x8d\xad\x93-7\xa4au\x7f;i]\xc5\xbb\x8b\xcb\xbf\xff\xef\x9b\xf5\xdb\xe6\xf0\xb9{\xdennf\xe1\xad\xa1Kj\xf5\x1b\x00\x00\xff\xffPK\x07\x08\x861cw\x06\x01\x00\x00\x01\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\x90Ao\xe20\x10\x85\xef\xfe\x15O\xf2\x1e\x96\x83A\xdcV\xb9\xa1(+q`\xb5j\x11=V\x93\xd8$\xd3$6\xb2'\xa0\xf6\xd7W\x01\xd4\xd2\x16\x0e\x95z\xb4\xfcf\xe6\xfb\x9e\xc6\xba\xe1\x84\x1dI\xd5\x80\xac\x05y\x1f\x84\x84\x83\x87\x04\x90\xed9\xa5\xf1qpe\x13B\x8b*\xf8-\xd7 o\x95\x864\x0e{\x8aLe\xe7\x12~\xfd\xce\x8b\xbb\xf5\xf2\xef2_\xac\x8b\xc7\x7f\x8bUq\xff\x7f\x91\x17\x931|\xe5s\x82\x03w\x1dJ\x874\x94IX\x06q\x16\xe53\xda!I\xe8\xf9\xc5M\x95F\xe1\xc7\xe5\x90\x91r\x1b\"V\xc3H\xe7\xeb\x87\x13\x8f\xa2\x1do\\\x1c\x11\xb3w\xda\xe8jN\x12\x8f\x1a\xd3\xf6O\x9ar\x98\xed\xe7\xa5\x13\x9a\xab\x96\xbd\xcd>\xaf\xc9\x8fV\xc3iB\xf5N\xc8\x92P\xa6\xe0\xa9w\x19\xfas\xda\x9c[0\xd5\x87\xfcEi)S\x00*\x17\xc5\xf4\xe4\xa9vq\xbc\xcd\xfe\xc9Ub*2\xdb\x18\xfa\xecfS\xb3+-)\x0dc\x8c\xd2\xf8\xb6\xa9\xc6\xc9uC\x1d\xdb\xdb\xb6\x1a\x17\xbe\xd0g\xe5\xfd\xdb\xd0-i\xe8/\xde\xd0?\xab\xfe\x1a\x00\x00\xff\xffPK\x07\x08PeNS#\x01\x00\x00\x9e\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xccKLO-\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4\x93Ak\xdb@\x10\x85\xef\xfa\x15\x83\xc9!=\xa8\xb2!\x87v!\x87\x92\x18Sp[\x93\x86\\\xc3x5X\x8bww6\xb3#\x15\xf7\xd7\x17\xc9v*\x85\xa6\xb4dO\xd2\xbe\xb7\xdf{;B\x98\xdc\x03Iv\x1c\x0d`J\xb9\xea\x16\xc5\xde\xc5\xda\xc0-%\xcf\x87@Q\x8b@\x8a5*\x9a\x02 b \x03\x01#\xeeHN\xef9\xa1%\x03\xf9\x90\x95B\x01\xe0qK>\xf7n\x00\xcbQ\x85}\x99<F2\xc0\x89\x04\x95\xa5\xc8\x89l\xef\xc8\xe4\xc9*\xcb\xd1\x1dPm\xb3\x1e\x1d\x7f\x1d\x00 \x94\xbc\xb3\x98\x0d,\n\x00\xa5\x90<*\x9d@\xa3\xca\xfd\xf2\x13\xe6\xdf\xa8\x00\xe7j\xc33I\xe7,}\xb2\x96\xdb\xa8_\x87\xcbg\x1cUC\x17I\x9e\xc1%\xb8\x80;2\xf0\xd4\xe2\xe1\xbd\xe3j\xdfn\xc9\xa2\x88#\xa9\xce!\xc6cV\xca\xfa\\\xe6\xe5L\x8f\x0be7*\xdc\xb3geiI\xb4\xac\x9d\\_\\\xde,\xef\xee\x1fo?\xdf\xbd\x9b\xbd0u\xd7\x17\x97\xebo\xab\xc7\xf5\xf2a\xb9\x1e\xa9\x14\xbb)\xef\x98{\xe6\x8c$\x80\x0e}K\x06f\x95\x86T\xed?\xe4\xf2\x07m\x1b\xe6}\xd9\x0f\x84\xa4\x1a\xe6\x12wC\x9f\xfc;B(s+\x96&\xc5\xbd\x0bN';\x006\xb5\x06f\x8b\xd9d3P`9\x18X\xcc\xe7_\xdcH\x11zj)\xbf\x01\x91X4\xff\xe9\xeeBX\xbbH9\x97\xbde\x8a?\x7f\xdc\x0d\x8b\x1a\xf8xu5\x9f\xe8IX\xd9\xb27p\x7f\xb3\x19\x0d\xe0\x04\xdc\x08oi\x1c\xd9\xa8\xa6\x15\xe9\xf4\x0e	\xb51P\xf5\xa7\x0e?\xa7\xca\x90\xfaJ?\xef:\xfa\xef\x90\x86\xd0k\xf3\xcf)J\x12\\Du\x1cW\x82\x966$\x8e\xeb\xefd9\xd6\xfd/7/~\x05\x00\x00\xff\xffPK\x07\x08\xe1$a\x8f\xbe\x01\x00\x00<\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8\xcd\xcf\xcb,\xc9/\xd2\xabL\xcc\xcd\xe1\x02\x04\x00\x00\xff\xffPK\x07\x08*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xc1J\x04A\x0cD\xef\xfd\x15\x05^\xf40\xbbx\xed\x7fX\x10\x04\xef\xb1'8\x8d\xd3IH\xe2\x82\x7f/\xe3\xb6\xe0io\xa1xyU\xe5\x01/\xae\x83s\xe3\xaf\xc0E\xa5\xa7:^\xd9\xaf\xbd1\x1e/\x9c\xde[<\x15\xb2\xfe\xc6\x1e]\xa5b\xdc\xa8.\x1f\xa7\xa6\xce\x1a\xa7\xa6\xe3|}.\x9f]\xd6\xfa\xf7<]ep\xd2JI\xb5\x00;\xbd\xf3\x1e\xc7\x054\x95t\xdd\x17\xdbI\xb8B\x8d\x9d\x0e\x1e\x10\x1a\xff\x82e\xdc6,\xb3v\x02a\xd4\xb8\"\xbe#y\x940n\x87\x96e5\xed\x92\xb3c\x81Qn\x15\xe7\xe9\xf8\x0d\x01S\xcf\x8a-\xd3\x8e$x\xe7\x96\xea\xf7g\xfd\x04\x00\x00\xff\xffPK\x07\x08\xcb1r\x07\xb3\x00\x00\x00)\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U(\xca\xcfI\xd5\xabL\xcc\xcd\x81\xb2\xe3\x932\xf3R2\xf3\xd2ab9\xa9\x89)\xa9E\xf1\xa99\xa9\xc9%\x99\xf9y\xf1\xc8\xea\xb1\xc9\xa1\xea\x07\x04\x00\x00\xff\xffPK\x07\x08	\xa8'\x97>\x00\x00\x00k\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\x03A\x0cD\xfb\xfd\n+\xd4w\x11\x1d\xba\x1f\xa0\xa7\xa0wv\x87d\x95\xbd\xf5\xca\xf6\x1e\x12_\x8f\xeer\xa1\x00!A\xe5\x91\xedy3\x0f\xd4\xa0s6\xcbR\x8d\\(	\x15p\x82\x12\n\xa2g\xa9c\xe0\x96_\xa1\xeb\xcbDz\xe28r\xf7\x8bh\xfe\xe0\xed~}\xb21\xcbqy\x0c\xd7\\\xd3D/R\x10f8'v\x9e\x02Q\xe5\x19\xd3\x8e\x1d\xee\xd8A\xd77\xed\x056\x85\x81\xb8\xe5g\x95\xdel5\x0ct8\x04\"\x85I\xd7\x88}\x17\xa5\xbe\xe5\xf3\xcc\xcd\x02\xd1\x02=\xed\xfb3|\x9b%\xdbM\xbc\xb3\xc7\xcb\xcd\xa2`\xc7&{Kw\xd9\xbe\xee	\x05\x8e\xff\xc6\x1f\xcd\xd9\xfb/-~\xe4\xfc	\x8e\x05\xd5\xbf\x11\xf7\xf2\x9f\x01\x00\x00\xff\xffPK\x07\x08\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8d\xb1\xae\xc20\x0cEw\x7f\x85\x7f\xa0}z\xdbS\xb6\xc7\xc2^$v71`\x9a\xda\x95\x93t\xe0\xebQ\xa5\n\xb6nw8\xe7\x1eZ\xe4\xca^\xc44\xa0\x8f\x14{j\xf5a./\xaab\xdaO\x7f\xa5\x17\xfbY\x7fa\x12M\x01\x07\xcb|\x12M\xa2w\x98\xb9R\xa2J\x01\x10\x95f\x0e\x98\x99\x12{\xc7\x99\xe3fwn\x99\xc7\x9d\xde\xf6\xc0\xb7\x0d\xa6E\xcenm9(\x02\xe27x\xf8\x0f\xa5\x8dO\x8e\xb5\x04\xe8v\xe7\xc2\xbeJ\xe4\xff\x18\xadi\xfd\xd8\x85\xe0\x1d\x00\x00\xff\xffPK\x07\x08+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xcc\x96\xcfn\xdb0\x0c\xc6\xef~\n\xa1w\xa7\xd8m\xf0u\x87\xdd\x87awZb\x1c\"\xfa\x07\x92r\xb7=\xfd \xc7)\x82\xa4\xe9\xd0nQz\n!\x85\xfe~\x9f$\x12\xec\xfa\xbe\xef \xd3\x0fd\xa1\x14\x07\xc3#\xd8\x0d\x14\xdd%\xa6\xdf\xa0\x94\xe2f\xffY6\x94\x1e\xe7O\xdd\x9e\xa2\x1b\xcc\x17_D\x91\xbf%\x8f]@\x05\x07\nCg\x8ce\\\x12\xbeS@Q\x08y0\xb1x\xdf\x19\x13!\xe0`\x02D\x98\x90{\xae\x89\\<\xca\xd0\xf5\x062}\xe5T\xb2\xd4O\xf4\xe6\xe1\xa13\x86QRa\x8b\xeb\x9a \xcfd\x11\xacM%\xaat\xc6\xcc\xc8\xe3\xba\xb9\xa8\xe2\x12:\xf4\xb8\x86\x13\xea\xf2\xebI\x0eA\x06\xb5\xbb%*\xd9\x1d\x13\x9e\x96\xc5\xb70\xdc^\x1c\\ \xa9w\xc18\x91(\x9f\xde\xc1%V(\nJqz\xc2q\x97\xd2\xde\xa6\xb8\xa5\xa9\x1c\x92>\x1a\xeb\x0c\x9e\xdc=i3\xe1O\xc5X\x81\xe5*\xa5-\xa2)\x1c\x17\x1dn)R+\xbe,\x97<\x0e\xb3O\xbf\x02\xb6x\xf8\x16Y\xfb\xb5L_>\x1cd\xa5-Y\xd0\x06\x95\xf0W\x1a\x12)\xc8\xb7\x07I\x19\x194\xf1f_F\xb4\xc0LW\x80 S\xed\x13\xaf1\xb5\x06y\x14\x05-g<G\x88\x0b\xed\xf7+ZP\xc8\xc5\x9f?\xd26v\x9f\xc5[\xb9E\x8fs\xfd\xe3]\xdc>\x8b\xb7r\xbb\xc5\xbaw\x17\xaf\xabt+\xa7'\xfbw\xb1{\xaa\xff\x8f\x9e\xaf\x0er/\x94\xcfa\xa0\xabs\xd9H\xd1Q\x9cn\xdfS\xdf\x87w\xc6Ui_\x01D\xb1\xe0\xffK\xe3}\x03\xedG=\xc5\x86\xc7\xf7'\x00\x00\xff\xffPK\x07\x080\x8b\xa1\xcd\x9b\x01\x00\x00U\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8f\xb1j\xc3@\x0c\x86w=\x85^\xe0\\\xba\x95\xdb\xda\x0e\xdd]\xc8.\x9f\x15G\xb1-\x19\xdd\x9d\x87<}0\x84`\x88\xc9\xa6\xe1\xd7\xf7\xf1\xd1\"'\xf6,\xa6\x11\xd7O\x18E\xfb\x88\xff\xec\xab$\xfeN\xc9\xaa\x16\x98\xb9PO\x85\" *\xcd\x1c1\x13\x84\x10`\xff\xec\x1d\xa5\x86j\xb9\x98\xcb\x8d\x8a\x986\xe3Wn\xc4>\x9e\xd8\xdf\xa9\xe6\xc2\xde\xda\xc4?\xa2\xbd\xe8p\x80\x9eIi`\x0fn\x13w\x8f\xd5v\xb7|\xdeF\xb4\xc8\x9f[]\xde\x08\x01\xf1\xc5w\x88\x87\\\xbb+\xa7\x92#\x04<,\xdf\xf5\xde\x03\x00\x00\xff\xffPK\x07\x08\xc0\xe9E\xce\x9f\x00\x00\x00)\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8*J-\xce/-JN-\xb6\xe2\xd2U\xc8M\xcc\xcbLK-.)\xd6\xabL\xcc\xcd\xe1\xd2U(N-*\xcbLN\x85p\xb9\x92\xf3\xf3\xd22\xd3K\x8b\x12K2\xf3\xf3\xc0\x1a\xb2K\x8bK\xf2s3\xabR!R\x10u\x80\x00\x00\x00\xff\xffPK\x07\x08\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92\xb1n\xa4@\x10D\xf3\xf9\x8a\x926>\xb8\xbd\xe8Dz\xd1\x05N\xbc\x92\x1d\xf7B\x03-`\x1a\xf7\xf4\x80\xec\xaf\xb7\x00YZG\xdeh\xd3V\xe9\xd5\x9b\xd2\x9c\xe0=\xa3\xd5q\xd4Ub\x87Zc+\x1d$\xa1U\x833\xd5\xfdv\x1err\x9d\xe4\x83\xb1\xf6l\x0cW\x8c\xaa\x03\xc8\xb7CD\xca\xd7\xe4\xe2\xd9\xb7\xf0B\x96\x8ap\xc2\x7f\x87\xf1[\x16\xe3t\x03X\xfe\x14\xe7\xe27\xd4\x10ye\xdbP\xab\xda\x80\xd9tf\x1b\xdf\x8b\x10i\xe2gn\xd98\xd6\\\x85_\x18$6\x15.l\x8b\xd4\x1c\x80\x85-\x89\xc6\n\xcb9\x00\xad\xf0\xd8\\f\xaeS\x15\x80\xaf\xf4Sv\xdal^\xf9\xda\xab\x0e\xff\xf6we#\x17\x8d\x01\x00:\xd3<W\xa0f\x92\xb4\xd1\x8c;I~\x04\x8a\xe1o*D\xf7\xdcL\xdeWX\x0fL*\xebQ8\xfa\x81+\xd3\xa1Tn\xc67\xdd/4J\xf3\xe0\xf6}\xb54\xd3\xedb?lp\x9f\xc3\xbd\x06{w\x00jcr\xae\xe0\x969\xdc\xbd\xc8\x03\\\xc2B\xf6\xed[\x1d\xb4\x89\x9d\x1ar*)F\xf5\xbd0\x85\xcf\x00\x00\x00\xff\xffPK\x07\x08w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\xcc\x91\xcd\x8e\xdc \x10\x84\xef<E\xbf\x00\xb3\x9a[\x84\xb4\x87d\xb4\xc9)R\x14\xad\x92s\x0f\xd3\xe3i\x19\x03j\xc0\xd1\xbc}\xe4\x1f\x1cG{\xf0\x95\x9b\xe9*\xaa\x8b\xcfJk\xad0\xf2/\x92\xc4\xc1\x1b\xc0\xdb\xc0i\xfa\x14\xea8e\xc1\xcc\xc1\x9f\xfaO\xe9\xc4\xe1e<_)\xe3Y\xf5\xeco\x06\xbe\x97\x8c\x99}\xf7\x9b\xae\x8f\x10\xfaK\xf0w\xee\xcarC\x0d\x94\xf1\x86\x19\x8d\x02\xb0B\xf3\xf0\x9d\x07J\x19\x87h\xc0\x17\xe7\x14\x80\xc7\x81\x0c\x0ck\x90\xfe\xb3$i\xfb_\xd4:MFi\xb0\x8e\xc9\xe7e\xd5\x14\x0d`\xf1K\xf17G\x06.\xdd\xeb\xeb<J$#[Z\xf4\xba\xa4f\xaf\xe2NK\x11-\x19H\xcf\x94iX\xe7\x11\xf3\xc3\xc0\xcb\xdc\x8ct\x88$\x98\x83\xe8\xbe\\\xc9\xa2\x08\x93h\x0ez<\xa3\x8b\x0f<k\x8c<\xe5\x92(\x80;\xb2+B?\x82c\xfb4\xf0\x15y\xf7\xd2\xcdx\xdaE\x9d8(\x00)\x8e\xd2\xd4Y\x03F\xfe&\xa1\xc4\xb4<AC-\xf0\xf1\x16\xc0\xbf\xbf\xb7\xd9k\xaf\xf9\xb8\xdc\xdd\xcb\x97\x9fo\x9f\xdf\xdf\xe6\x83P\nE,m\xdaV0\xb5K\xdbb\xc6X\\>\x82]}\xad\xb2\xae\xfd\x1aFM\x8e\xc6\xc9p\x84\xba\xfaZE]\xfb5\x8c\xfaN\"\xcf#\xce\xb3\xa9U\xc8S9\xa6\x86\x11\xef\xe6G\xa0\xf7\xb0\x1a\xc5\xbd[\x90\xd4\xdf\x00\x00\x00\xff\xffPK\x07\x08\xcc\xfe_\x88d\x01\x00\x00\xc9\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8b1\xaa\xc3@\x0cD\xfb=\x85.\xe0\xe2\xf3\xddD\xa7\x08\x04\xd2+\xeb!Yl\xaf\x84$\x1cr\xfb`;\xdd\xbc\xc7\xbc\"\xd6\xee\xf0h\xda\x99\xb6\xbf2\xb7>1\xdd\xe0[\xab(+R&I\xe1B\xd4e\x05\xd3\x1b\x8f\x97\xea<\xc4\xefq\xfa0\xa9`\x8aO$\xd6\x12\x86\xba\x17\xa6\x9e\xb1\x0f\xa2\xe1\x00\xa6q\xfc?\x98(\xc5\x9f\xc8\xeba/\xa7\x0e,\xa8\xa9~&U{\xba.\x83-\xd2\xc1\xa4\x06\x97T/\xdf\x00\x00\x00\xff\xffPK\x07\x08\xe4\xdd>\x05\x83\x00\x00\x00\xb2\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd2\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noA\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80%\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc6\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbb\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd80\xa2\x10\xb7\n\x00\x00\xda*\x00\x001\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd8\x03\x00\x00crd/bases/operator.kubecarrier.io_apiservers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x05C\xa5\x1fE\x07\x00\x007\x1b\x00\x000\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xf7\x0e\x00\x00crd/bases/operator.kubecarrier.io_catapults.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(saiI\x9b\x06\x00\x00\xa9\x18\x00\x000\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa3\x16\x00\x00crd/bases/operator.kubecarrier.io_elevators.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcd\xaa\xe7\x91\x00\x07\x00\x00\"\x16\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa5\x1d\x00\x00crd/bases/operator.kubecarrier.io_ferries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xeb\x94\xac\x94\xff\n\x00\x00\xd5.\x00\x003\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\n%\x00\x00crd/bases/operator.kubecarrier.io_kubecarriers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd1\x91\xefR\xb6\x01\x00\x00[\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80s0\x00\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6]\x02\x05\xf3\x00\x00\x00\xda\x01\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80v2\x00\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(k\x90O \xf4\x00\x00\x00X\x01\x00\x00,\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb83\x00\x00crd/patches/cainjection_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(3\xf7a\xe1s\x01\x00\x00t\x02\x00\x00(\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x0f5\x00\x00crd/patches/webhook_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(qInk\x7f\x03\x00\x00T\n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe16\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7W\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb1:\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x861cw\x06\x01\x00\x00\x01\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80[;\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(PeNS#\x01\x00\x00\x9e\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xba<\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b \x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x809>\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe1$a\x8f\xbe\x01\x00\x00<\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xaa>\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c \x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb3@\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcb1r\x07\xb3\x00\x00\x00)\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80'A\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(	\xa8'\x97>\x00\x00\x00k\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80(B\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc6\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xb4B\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x91\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xcfC\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(0\x8b\xa1\xcd\x9b\x01\x00\x00U\x0c\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xbdD\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc0\xe9E\xce\x9f\x00\x00\x00)\x01\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9dF\x00\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabJ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x89G\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1f\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80$H\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\xfe_\x88d\x01\x00\x00\xc9\x07\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x96I\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe4\xdd>\x05\x83\x00\x00\x00\xb2\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80GK\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x1d\x00\x1d\x00\xc7	\x00\x00\x15L\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8\x94\x92Mk\xdcL\x10\x84\xef\xfa\x15\x05\xfb\xc2\x1bC$\xc59\xeaf\xc4\x12|\xf0\x12\xd6\xc69\x86\xde\x99\x964h4#\xa6[\xfeH\xc8\x7f\x0f#\x19\x8cMX\xf0Q\xea\xea\x8fzjv\xb8\x1b\x18]\xf4>>\xba\xd0c\xa2\xe0:\x16\x15\x98\x18\x94\\\x00A\xd8w\xa5\xb8>\xb0\x85\x13Y8\xa1=\x82\x82\x05\xc1pR\xd79C\xcah\x8fU\xb1\xc3ML\x0c\x1b\xcd2qP\x18\n81\xba\xb8d\xb9bP\x9d\xa5\xa9k\x1b\x8dT\xb9\xb9\x9c(P\xcf\xa9r\xb1\xd8\xe1\xc7\xd5\xf1p}\xf8\xd6\xe0\x8eR\xcf*h9\xe9\xcd\xa6\xc0\x97\xea\xf2\x12f`3\x9e\x1dSs\xa8=)\x8b\xd6J2J\xbd\xcc}\"\xebB_\xbb`\xf9\xa9\x1at\xf2\xe8b\xc2)1\x8d\xd9\xb5\x19(\xf4,\x05\xcd\xee\x9e\x93\xb8\x18\x1a\xbc\x9f\xfapI~\x1e\xe8k1\xba`\x1b\\\xaf \x8a\x89\x95,)5\x05\x10h\xe2f\xa5\xb5\xc1*7X/\x15\x99\xc9\xe4\xf2\xb3(O\x85\xcclrOV\xdf\xae\xea\x06\xbf\xff\x14eY~\xe4\x88\xf6\x95\xfe?/I\x0f.\xf4ev\x02\xec\xa0\x83\x93\xb5\x04\x19\xe2\xe2-&R3@\x07F\x0c\x0c\x9ag\xa6\x943\x0e\x18\x17\xd18\xb9_lb\xe8\\_=\xd3\xe4\xcf\xfa\xd8\xe1\xbfO\xb7\xfb\xe3\xfdu\xbb\xffy\xb8\xba\xd9_\xac\xef\xe3\xed\xbf\xdb\xefW\xed\xfe\x02\x8f\xce{\x9c\x18\xb2\x9cD\x9d.\xca\x16\xa7\xe7\xd7\x95\x05`\x83\x1chb\xc9^\xca\xf7\x93\xab\xb7\xdf\xdb\xd4J\x1e\xcc\x87\xc4\x95\xf1\x8b(\xa7\xcaGC\xd9\xdb\x16\xd6\x91\xbb\xbc\x14x\x932p.]a\x93X\xf3\xc1\xcdzk{\xdc\xdf\xad\xdb.\xcaG>\x0d1\x8e\xe5\x1a\x85\xe1-\x8a\x97$\xb6\xb6\x0dG\x88\x9a\x91\xcc\x89;\xf7\xc4\xf63\xc4\x05\xc3p\xfa\xbf\xac\xb5\xed	\xbc\xe3\xf4w\x00PK\x07\x08\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00F\x00\xb9\xffresources:\n- certificate.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xa4\xce1J\x04A\x10\x85\xe1\xbcO\xf1\xc0x\xdd|R#\x93\x0dV/P\xf6\xbc\x9e)\xd6\xee\x1e\xaa\xaaW\xf0\xf42\x82\xa0\xa0\x06\x1a\x17\xef\xab\xff\x06\x8f\xab:roE\x97a\x12\xda\x1b\xd4Q\xba!(y\xd5\xb6\xe02<z\xd5Wb\xed/\x88\x8e\xb1\xcd\x12D\x93J\x18\x0b\xa4\xcd\xb8\x8a\xc1\xc7\x93\x87\xc6\xd8\x95\xb4_\xcf,4\xb6\xcc)\x1dp\xd16O\xb8w\x1f\xb4\x04,\xd6\xc76!\xd3\xe2P\xa5\xc9B\xbb\xd5\x9e\x80\xa2|\x9e\x1f6f\x9f\x12\xf0\xb1\xbb\xa3\x85\x16\xcd\x12L\xc0/s`\x93X'\xf8\xc6|\xd4\xf7og\x96\xe3\x9e\x93\xd2U\xec\x9b\xa6\xaf\xf6\x8f\xf2'7\xf7Z{;\xed\xe6\xdf\x91\xb9\xf9.\xf8?\x08g6\xc6I*\xd3\xdb\x00PK\x07\x08\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x001\x00	\x00crd/bases/operator.kubecarrier.io_apiservers.yamlUT\x05\x00\x01\x80Cm8\xe4[\xdfs\xdb6\xf2\x7f\xd7_\xb1\xa3>(\x99\xb1\xe8\xa4\xfd\xb6\xdfVo>9\xd7S\xe3\\T\xcbio\xa6\xee\xdc@\xe4\x8aB\x0d\x02\x0c~\xd8\xd1\xdd\xdc\xff~\xb3 @\x91\x16I\xcbv:\x9d\xb9\xf2%\x11	,\xf6\xf7~\xb0\x80G\xd3\xe9t\xc4J\xfe\x13j\xc3\x95\x9c\x01+9~\xb2(\xe9\x97In\xbe5	W\xa7\xb7\xafG7\\f3\x98;cUq\x89F9\x9d\xe29n\xb8\xe4\x96+9*\xd0\xb2\x8cY6\x1b\x010)\x95e\xf4\xda\xd0O\x80TI\xab\x95\x10\xa8\xa79\xca\xe4\xc6\xadq\xed\xb8\xc8P{\xe2q\xe9\xdbW\xc9\x97\xc9w#\x80T\xa3\x9f~\xc5\x0b4\x96\x15\xe5\x0c\xa4\x13b\x04 Y\x81\x9eG\x83\x9a\xe6%\xaaD\xcd\xac\xd2\x9eh\xca\xb4\xe6\x9e\xe8\xc8\x94\x98\xd2\xe2\xb9V\xae\x9cA\xdf\xb0\x8abd\x93Y\xcc\x95\xe6\xf1\xf7\x14\x98_\x14\xa0\x12\xfel\xb9X\xf9e\xfdW\xc1\x8d}\xdb~\x7f\xc1\x8d\xf5\xdfJ\xe14\x13MF\xfdk\xc3e\xee\x04\xd3\x8d\x0f#\x00\x93\xaa\x12g\xf0wb\xa4d)f#\x80\xa0\x13\xcf\xc8\x14X\x96y-3\xb1\xd4\\Z\xd4s%\\\x11\xb5;\x85\xdf\x8c\x92Kf\xb73H\x8ce\xd6\x99\xa4\xdc2\x83\xfek\xd4\xd9\xca\x7f\x08\xaf\xec\x8eV4Vs\x99\x1f\xd2\x88\xb6L\x0e\xec\xd0\xa2x\x96\xc7\x15*r\x19\xb3\xd5\x8b\xcaH\xb7\xaf\x99(\xb7\xec\xb5\x7fe\xd2-\x16\xde9\xe8Q%\xca\xb3\xe5\xe2\xa7\xafV\xad\xd7\x00\x19\x9aT\xf3\x92dm\xa8\x15\n&Y\x8e\x06\xec\x16!\xc3R\xa8]\x81\xd2\x82\xda\xf87o\xdd\x1a\xe7\x95\xe5!Ei5\x1347\xacEO\xe5,I\xfd\xa6\xd4\xe4\x0f\xb66t\xf54\xa2\xa01\xf7\x1eS\x13\xe2\xbb2\x0ed\xe4\xfe\x81\xad`0\xcc\x82\xa8\x15s\xdc\x80\xc6R\xa3AY\x05D\x8b0\xd0 &A\xad\x7f\xc3\xd4&P9\x97\x01\xb3UNd\x145\xb7\xa8-hLU.\xf9\xbfj\xda\x06\xac\xf2\x92\x0bf1x\xdc\xfe\xf1\x0e\"\x99\x80[&\x1c\x9e\x00\x93\x19\x14l\x07\x1ai\x15p\xb2A\xcf\x0f1	\xbcS\x1a\x81\xcb\x8d\x9a\xc1\xd6\xda\xd2\xccNOsnc\xf4\xa7\xaa(\x9c\xe4vw\xea\x03\x99\xaf\x9dU\xda\x9cfx\x8b\xe2\xd4\xf0|\xcat\xba\xe5\x16S\xeb4\x9e\xb2\x92O=\xeb\x924f\x92\"\xfbB\x87|a&-^\x0f\xbc\xb0z|\xb0\x0dX\x80\x82\x0e\xb8\x01\x16\xa6VR\xec\x15\xcde\xee\xb5s\xf9fu\x05qio\x8c\x16Q\x08z\xdfO4{\x13\x90\xc2\xb8\xdc\xa0\xf6\xf3`\xa3U\xe1i\xa2\xccJ\xc5\xa5\xf5?R\xc1Q\xdeW\xbfq\xeb\x82[\xb2\xfbG\x87\xc6\x92\xad\x12\x98\xfb\x94\x08k\x04WR\x98d	,$\xccY\x81b\xce\x0c\xfe\xee\x06 M\x9b))\xf68\x134\xb3\xf9\xfe\xa9\x06WZkP\x89\xa9\xb6'b\xea0^\x95\x98\xb6b&C\xc35y\xb5e\x16)\x16\xea\xa1-j\xdd\xd1J\x0fsvKn\x96\xfa\xd8j3{\x98NZ\x83)\xba6<w\xba+,\x01\xb8\xc5\xa2\x95\x1c\x1ef&\xb0$\x95\xdc\x15\xca\xf5|\xbe\xcfS\x1c\xed\x95\xc87\x1c\x0d\xdcm\xd1nQ\xef)y1I\x0b\xb7<C=\xea\"J\x0fJ\xb6\x16\xbext==\xb6\xdb?\xac\xe4oqw\x1c\xd7\xcb\xc5[\xdcu\xb0\\}0U\xfd\xc6\x0cn9\xa3@\xe9\xa1	\xad\xcc}\xb6\\\x00\xd3\x08,M\xb1\xb4O\x97\xa3\n\xca9\xd9hC\xb6\xc6\xa3D\x9a\xdf\x9f\xd5\x90\xee\x1f\xc9\xd7\xaf\xbe\x0bt!\xdd\x13\xee\xa1\x0bm\xd7\x82\x8d\xaa\x84\x0b\xb5\xac\xed\xb3=4\x1e\xf22z\x1a\x9c\x90k+\xcdm\xaf\xf9\x0e\xe5\xed\x98\x0c\x1a7\xa8Q\xa6\xa1\xa4\x19L5v;K|(\x1d1.c\xc6]\xbey\x07(S\x95a\x06\xf33X;\x99	\x84\x17)!	\xfb\x92\xaa\xd6-j\xbe\xd9\x0d\xd3<P\xb4\x01\x963.\x8dMF\x07\xa3\x1f\xa5\xb2\x1a\x9c\x0c\x8e\xe8\x80G\xdd\x8f\xc6\x8f\x8e\x12\xd8\x10\xb9\xa9_p`\xc0\x83\x81\xf9\xf0:\xd3N_\x18=q=\xc5\xb3\xf4\xa8\xe4\xf5~q>o\x84\xc9\xfb\x12\xe5\xe2\x1c\xe6JJ\xc2\x19\x07A\xd0C\x12\xfe\x0c\xc1\xc1\x8dq\xa8'\x86\xa2\x82K`\xadH\xd1J\x1d\x97W\x02b\xa4\xd8\x8c\xd5\xe0O\x1a\x111\xcd/\xce\x87Dk\x95\xdaq\x95\xe2\x17\xe7^\x7f?\xfc|\x05\x853\x1e\x92y\xe3d\x94\xa7O\xfc\xb7\xeb\xb1q\xeb\xeb\xf1\x00a\x80\x0dG\x91%pE\x08\xbf\x14.\xe7\x12\x94\x14;\xb0\xda\x11\xe6c~\xab'\"D\xa4\xcc\x87\xd28=\x94\x08\xc0/\x1e\x88\xa5L\x12k\xce`\x06w\x9c\xca\xbf[\x0b\x9e\xd6v7	\\K\xb8\xaa'\x0c\xd25\xae,\x95&(\xea\xa5\xa3 S\xda\xe3\xff\x92i\xbb\xbb\x1e\x1f\xc4\xae`\xbc8\x19\xa4y\xb7\xe5\xe9\x96\xf6\xc7\xea.\x80\x17&*\x92\x91C\x12\xda\xab\x16\xac\xbaAI\xbf\x07)\xb2\xa8,*\x98\x0c2\xbe\xf1\xd5\xc8\x86\xd7\x84\xcdq\x8f\x8ei\x0b\xc9\xb3D\xa2=\xa5\x14d\xc2\x8biZ\x890M\x95\xc6\xe9\xeb\x7f\xbeJ\xb6\xb6\x10_,\xce\xaf\x88\x87\xf1\xe8\x99\x1e\xee\xbb	fN\xea9\xda\xf1\xbe\xdf\xcf9\x01\xbe\xa93fv\x02)s&\x14[J\xa6\x0d\x84:\x90.\xe9\xa1\xfd\x9f\xde\xd1?\xa5*\x1dm\x03\xbd\xf38\xe3\x93L\xc5e\xe57L\xc2\xe2\x1c\xbc\xf4\xc78\xf4\xa2\xdaR7x\xae\\\x9d\xf6[a\x1f\xeb\x13\xd8\x91T\x89\xa9jw\x16\x83\xad\xde\xb4)\xed;(\x94\xd0\xaa\xed\x87I>\x8fu\x96\x1a7\xfc\xd3#\xcdSM\xea\xb1\x8f\x0f\x07\x03\x05+\xcb\xe1\xf4\x07d\x11\xaf}\x9f\xe3\xc8\xe5)\x8aKO<Fr\xad\x93\x04\xce\xaa\xff\x0c\xb0\n0\xf6\xf5x\x0cw\xbe'\xa0\xd18\xe1M\x10\x8c,\xf8\x0d\x861(s.\x115\x97\xf9p\xf2\xa2\x8e@5\xa5`\xfa\x06i\xc3<~\xb6\xf2}\xa8\xeb\x0f\x97\x17Gk~\xbc\x88S\xc8\xbbH-\x1f./Z\xd5\x0d\x0c\xcf\xa5\x81\xc5\xf9\x00I\xa8\x9c\xdb\x003!\x1f\xdfq!H\xedD\xe9z\xcc\x8d\xb9\x1e\x07'\xa6v\x8b\x10!\x1f\x0d\xd2,\xb5\xca\\\x8a\x19\xacwm\x8eHw\xdcP\xa4\xf9\x8a\xd1\x869\x83$3nRu\x8bzW\xa7\xee \xb93\x8e	\xd1^gb\xfcWr\x18\xe5\x8618\x83\x92\xd9\xed\x89\xe7\x06?\xb1\xa2\x14\x94\xe4c\x9edi\xaa\x9c\xb4&\xc9\x95\xca\x05&\xa9*\xae\xc7\xf0@z\xd9O\x17*\xe721L\xa0\xd9(\x9d\x86\xf9\xfb\xe2\x13\x92\xbd\x0f\xefA\x92\x9c\xf8\xf2]\xbb\x96\xc6\x9aZyT~\xaf\xe7\xed\x93\xfc203\xf7\x0b<;\xd7G,\xe3\xb3`\xefv\x9e\x9ef{\xf6\xb8M\xc8\x91p\xaa\x05`.[\xfc|\xceRRm\xca\xc0n\x99\xa5\x8a\xee\x1d1J\x1f\x93\xdf\x0d\xeeB&/\x19\xd7\xc3\xc1C\xdb\xf8F\xb1 r\xb1Z<\x9cf\x1e\xc0}\x01\xcb`\xb6\xe29\xed;\xcfD>h\x9b\x0c7\xcc	;4d\n\x97\xab/\xbf\xfe\xe6X;\x8cW\x1d,\x80\xc1\x80\xafb\xff\x82\xde\x0c\x90\x04JF?\xbc_\xbd\xf19\x8e\xcb\x1c\x98\xa0\xb3\x07\xbb-\x88\x0e\xb3-\x08x/\x0b\x0d\xd2\xb5\xca\x93\x0cY\xce\x87j\x0c*\xab\x940	G\xbbI\x94\xceO	\x1a\x9d\xeaM\xfa\xff_\xbf\xfe\xf6\x0b\x83)\x05\xe4\xf4\xab\xe4\xf5 y\x0f;\xb9	\xce\x10\xd4\xeb+\x9d\xd7\xe2\xc9\xbe\xbe\xf9\xc6uQ\xa0\xccz\xbb9\xd5\x13\xa4\xbb\x07B)\xecgM\xee\x9f\x04\xf9~b\x82g>9\x0f%\x84\xdev\xdf\xa3C\xb6\x8al\xa65\xeb\xefr\x10L#|p\x04\x8e\xac\x9c\x97\x9a\xca\xc7V\xd5\x0fM\xe2\xb1\xb0\xd2v\xa7*\x81V\xd1\xf2\xc0\xcc`c.r915\xb3\xcf\x06\x08\x91\xd0#\xf1Y\x94\xe7\x08\x846\xc0!D\xf4\x16\x14\xe0I\xb6\xe0\xd9\x1e\x9c\x85\xa2\x16NG\x86\xe4\x86\x88\xe0zQZ\x14\xba\x05\xd4~S[\xf9\\\xc0\x15\xf3\xf3g\xe9	M\xc3\x1e\xab\x17hM\xf7\xf0n\xf4\xc4M;\x9d\xc1\xf1\x14\xcf*<2\x1b\x1dQ\xf1V\xad)\x1d\xfd\xe6@\x13\x02\xc8\xe9\xa1	\xed\x06\xfas\x1b\xe5tZ\xc1Sr\xcb\xde\xc2\xd3\xaa\xdb\xab\xfd\xf8\x86\x08\x15\x15\xef\x1f\xe6H\x10\xf9{5\x92\xb7\xb6d\xc6\xdce+\xdf\xd2\xea\x1fwO\xae\xbf\xf9i\xf5\xbc\x86l\x14D\x91\xe81]\xe4\x90\x93H\xbe\x86Z\xee	8\x14/\xc7\xb4\xcb\xffg{\xbfm\xf3\x8d\x9e\xd4S\x1bl\xb9\xf5\x974\xa1\xf2\x0b:\x04\x9e\x8d\x06=\xe5\"\x0c;\x18UQ\xa6\x03\xeb\xfc\xe0`\xab\xa4\xdeH\x87z[>\xb8\xf4\x83\xc0\xa2\x10\x8dK&\xe4P~\xbaG 2\xe5\x82\xfb\x18!?I\xd1t\xd5	\xda\xd61c\x1c\x15\x05\x02_\xf5I$UP\x8d,\xdb\x8d\x1e\x91\xa45\xb3x\xc1\x0bn\x1f`\xff2\x8e\x03A\xa3\xab\xc8I\x99\x10\x06J\xd4!\nd\x06!i\x9ex\xc1\xb4\xb2\xdd\x8dQ\n\xbb\xea\xa6D\xb5\xcf\x12\xceX\xd4\xd5\xd9uhGZ\x94\x8c6\x83\xa3\xc7E\xd0\xdai\xd3\x93\x18Z\xe6\xf8\x0b\x8d\x8b\x98C\xbab\x8d\x9a`\xae\x93L\xef\x82\\\xbec\x88\x190\x0bJ\xa6}\xad\x8fn\xe9\x9b`\xf3\xc7\xe5\xaa;#l\x94.\x98\xf5^\xf5\xd5\x97\x9d#\n.y\xe1\x8a\x19\xbc\xea\xfc<\xe4\x94\xf4\x14\xec\xd3\\\xc9\xd4i\x8d\xd2\xae\xacFV\x98#t\xf3\xaec\xda\xa1\xaa\xd2\x9a\xb2\xe8\xc3\x8f\x04\x83\xc9\xed<\x81\x17\x98\xe4	\xfc\xccl\xba}\xd9\xa3\xb5W\xb4\xc7\xa6\x8a7\x04\xf9\xbc\xff\xfdA\n\xfdX\x1e\xa3\xbf\x1f\x97\xaba\xcf\"\xe9\x0d\xa6\x8a\"\xb9r\xb2N\xa2\xf0\xb0\x9a4\xf5S\xbdF\xb8\xcc\xff\x10\xa5\x0c\xe4c+LUp/q3\x1b\x0d\xaa\xec\xeabU\x0f\xbd\x7f\xb8{u\xb1j\x9e9y]\x94\x9a\xdf\xc6;\\\xed\x87v\xff\xbe<\x13\xe0\n\x87\xbd\xf7\x0e\xef\x93\xd1\xe3\xaar\xff\xa9\xd3 \xfa\xed\xc7\xbd=\xe7J\xbd\xaa\xec\xf9@\x08\xe4\xfe\xed\x8d\x96#\xd6\xa5\x81\x80\x9d3\xad\x1b-jM\x1az\xce\x95\x16\xf2_\xde\xb8:\xd9\xcb\xc6\xbc\x1e\xd8\xb8\xc0\xd4\xb8\x15\x06\xec\x96qAq\x1f\xb8\xf2%\xb0\xab\xf6QWt\xcf\xe7\xc4@\xc8@^\x15\x1d\xfb\x9f\xde\xcdr\xb7\x9ajF\xe3m\x01R\x99e\\\x18\xefR\xc4qX\xb0\x83\xb7\x86B\xea\x1bu5\xab\x87\xac=\xe4t\x00\x82\x19{\xa5\x994\x9e&]0\xed\x92\xe4@\xdb\x17\x07\xd3b2\"\x82`\xb9\x07\x0e\xb8\xe7\xb6\x87(\x80\xad\x16\x0fw\xca\x94\xc4\xe0qT\xdb\x99Tv\xdb-W\xd8\x00\xf8\xd2F7\xc8\xa6\xb4d\xcf\"\x0fn\x1f\x0b4\x86\xe5\xc7I\xfe\xae\x1a\x1b\xc5\xdd\xba\x82I\x8f\x88\xbck\x05J\xc0eFW5\x86\x8eG\xa2\xd1\xd9Z9\x1b\xd4V\x1b\"y\xaa(\x1a\x99\xb9\x7fa\xb3\xc7#/\xfd\xd0(\xc8\x8b\xb5\xe6\xb8y\x19\x08\xec]1\x1ap\xd2\x15*\x01\xf6~&\xde\xbbRMO4\x85\\\x13x\x0f\x1e\xa36m\x96O\xbc;\xa9\x0d\xbc\x98\\i\x87\x93\xfe\x13\xdc\xc9_\x99089\x81\xc9\x07y#\xd5\x9d\x9c\xbc|\xb2\x14~@\xcf\xe4VF\xb8\xda\x95\xb5\x1b\xd1\xa4\xc8\x7f\x1d\xcf{\xe5\x9f\xc0C(\x08\xe0\xc5\xe4\x92p\xf9\x939\x1f\xea\xa0L;\xf2D\x07\x89i\xf4\xff\xceo\x95gu~2\xcd\xcb\xd8\xcdg\xea5\xd3\xf1\xa1\xb7\x8c\x0d7\x1dcA\xfa\x1e%\xeacnI\xbe?\x98\x10MV(Cwe\xe9\x865\xe4\xfb\xafq\x85\x0e\x9e\xab\x98jf\xec\xd8\xea\xdd\xef\xd5\x92\xd1\x00\x80\xff\xe6\xffF\x8fA\xe7\xfe\xd2\xfb\x03\xf2\x9d\xbfY^\xbe\x99\x9f]\xbd9O`I\xe3\xefW\xcf\xe0x \xf8\x06\xd3]*\xaa\xfc\xdce\x92X\x8f\xe2\xf5\xed\xb9\x92\x86g\xf5\x95a\xdfn\xdd/w\x02\xdc\xd6G\x93\x1a\x0b\xd5\xad3f\xc0(%C\x83\x96ne\xd3E\xe7\x02\xd3-\x93\xdc\x14T%\nV\xee#\xc5\xd7\x8dp\x80NWG\xbb\xfc\xad\xa4?\x1a\x88\xb0-\x14\xc8]8,\xe5\xa6\xba\xb8B\xb6\xca\xb8)\x05\xdbA\xe9t\xa9\x0c\x86#E:\x9c\xb3\xdb\xee\x18Ba\xfc	E\x83\x9fdtt\x1cv\xfa\xf4\xc1\xcb\xca\x1fg`\xb5\xab\xac`\xac\xd2T\xbd\x1ao\xdc:\xde\xf3\xae\xd3iH\xae\xf0\xef\xff\x8c\xf6y6\x1e\xce\xf8\xbf\xb5\x985\xfe\xb4c<n\xfd\xdd\x86\xff\xb9\x97i\x06\xbf\xfcJ\x7f\xa8a\x95\xc6,\xdc\xfe73\xf8\xe5\xd7\xd1\x7f\x07\x00PK\x07\x08\xd7\x00\xab\xc4<\x0c\x00\x00?3\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x000\x00	\x00crd/bases/operator.kubecarrier.io_catapults.yamlUT\x05\x00\x01\x80Cm8\xecYKo#\xb9\xf1\xbf\xebS\x14\xe6\x7f\xd0\x0c`\xb5\xff\x93\x04A\xa2\x9b!O\x82\xc5\xcel\x0c\xdb\xd9\x1c6{\xa0\xd8\xa5n\xae\xd9d/\x8b\xb4\xa3,\xf6\xbb\x07E6\xfb\xa1n\xd93\x9e\xe4\x10 \xbc\xd8\xcdG\xbd\x7fUEj\xb5\xd9lV\xa2U\xdf\xa3#e\xcd\x16D\xab\xf0\x1f\x1e\x0d\x7fQ\xf1\xf0\x07*\x94\xbd||\xbfzP\xa6\xdc\xc2.\x90\xb7\xcd-\x92\x0dN\xe25\x1e\x94Q^Y\xb3j\xd0\x8bRx\xb1]\x01\x08c\xac\x17<M\xfc	 \xad\xf1\xcej\x8dnS\xa1)\x1e\xc2\x1e\xf7A\xe9\x12]$\x9eY?\xfe\x7f\xf1\x9b\xe2\x8f+\x00\xe90\x1e\xbfW\x0d\x92\x17M\xbb\x05\x13\xb4^\x01\x18\xd1\xe0\x16\xa4\xf0\xa2\x0d\xdaSa[t\xc2[\x17iJ\xe1\x9c\x8a4W\xd4\xa2d\xde\x95\xb3\xa1\xdd\xc2\xb9m\x89`\x96Rx\xac\xacS\xf9{\x03\"\xf2\x04\xe8t\xef\xb8\xc6E\xad\xc8\x7f;\x99\xfe\xa8(-\xb5:8\xa1GR\xc6YR\xa6\nZ\xb8a~\x05@\xd2\xb6\xb8\x85\xefX\x88VH,W\x00\x9d9\xa2\x10\x1b\x10e\x19\x0d,\xf4\x8dS\xc6\xa3\xdbY\x1d\x9al\xd8\x0d\xfcD\xd6\xdc\x08_o\xa1 /|\xa0\xa2\xad\x05a\\\xcd\xe6\xba\x8b\x0b\xdd\x94?2G\xf2N\x99jN#\xbb\xb1\x98\xb9`B\xf1\xaa\xca\x1c\x12\xb9R\xf84\x91\xfc\xf3\xf8^\xe8\xb6\x16\xef\xe3\x14\xc9\x1a\x9b\x18\x17<l\x8b\xe6\xea\xe6\x9b\xef\x7f{7\x99\x06(\x91\xa4S-\xeb\xba\x857\xd9\xa8\xd0\x08#*$\xf05B\x89\xad\xb6\xc7\x06\x8d\x07{\x883\xfd\xb6!\xc2\xba\x13\xae\xe8\x18\xf2\xf8\xbb\x81\xaba\xaf2\xe4\x85\x91\x08\x8a\x80\xbcp\x1eK8X\x07(d}\x1a\xe0\x8a\xa4}Dw\xec\x0f\x8d\xa8\nS\x82Cj\xad!\xb5\xd7\x18i8\x94\xd6H\xa5\x95\xa9`w{\xdd\x1f#\x10\xd2Y\"\xf86\xec\xd1\x19\xf4H\xb0\xd3\x81<:*\xde\xf4D[\xc7\x91\xea\xfb\x10\xec\x18\x0d\xf0\x1c\xb1?1\xd9\x9a\xad\x9avA\xc9\xb8\xec\x8c\xd6\x85\x13\x96\x9d#\x92\xe9\x14\x81\xc3\xd6!\xa1IH\x9d\x10\x06\xde$\x0c\xd8\xfdO(}\x01w\xe8\x98\x0cPm\x83.\xd9\xd8\x8f\xe8|T\xb62\xea\x9f=m\x02o\xa3_\xb4\xf0\xd8\xa1a\x181|\x8d\xd0\xf0(t\xc0\x8bh\xbfF\x1c\xc1!s\x81`F\xf4\xe2\x16*\xe0\x93u\x08\xca\x1c\xec\x16j\xef[\xda^^V\xca\xe7\xb4$m\xd3\x04\xa3\xfc\xf12\xfa_\xed\x83\xb7\x8e.K|D}I\xaa\xda\x08'k\xe5Q\xfa\xe0\xf0R\xb4j\x13E7l1*\x9a\xf2\xff\\\xe7gZOd\x9da$\x8d\x98\x06\x9e\xf1\x00\xe7\x03\x0e*\xd1\x1dMZ\x0c\x86\xe6\xa0`\xeb\xdc~\xb8\xbb\x87\xcc::cB\x14:\xbb\x0f\x07ip\x01\x1bL\x99\x03\xbax\x0e\x0e\xce6\x91&\x9a\xb2\xb5\xca\xf8\xf8!\xb5Bsj~\n\xfbFy\xf6\xfb\xcf\x01\xc9\xb3\xaf\n\xd8\xc5\\\x0d{\x84\xd02\x88\xcb\x02\xbe1\xb0\x13\x0d\xea\x9d \xfc\x8f;\x80-M\x1b6\xec\xe7\xb9`\\f\x86\x916'\xab\x8d\xa8\xe4\"p\x0619!\xdc\xb5('\x90)\x91\x94\xe3\xa0\xf6\xc2#C!\xef\x1c\xe7\x94s`\xe5\xa1m\xf5\x91cp*\xe5,\xcb}\xec\xb6\xcdv%}\x18/\x15\xba\x93\xd5\x94\xde8	v\xf9cw{\xfd\x02\x9f[<\xa0\xc3\x98\x85X\xbd\x94\x97\xe2\xbf\x9fzb9\x1bMU<\x9f\x93\xf2H\x05vv\xe8\x19\x17\x9eG\xd3\x0b\xf0\xcb\xa3\xab\xaf\xaf\xe1\xd9%\xc3W\x9cu\xf8s\xe0\xa0\x98\x1f\xdd$\x1b,\xccsX/L'\xf9\x17\x16:\xe9f+g\xc2\x9bG+\x02-	5)\x0d7q\x13x\xd4z\\(\xbdM\xc7\x87\xa2\x15+\x01;\\\"\x9d\xe6\xa4\\\xf1\x04Qh8k	\xdf\xe3\x82\x93\x9eCQ\x1eW_\xe0JB\xf7\xa8$vq\xf7\x82\n'!|79\xdb\x99&\xc9\x14\xd3\xe2,\x15\xe4\xb1GmM\x15s\xdf\x8c\xe1y<\xf7\x9d\xcd\xc2\xfcW\xc4\x0d7K_\xe2\xec\xa9\xc5\xbe\x06\xf7S\xfb\xfd\x0f\xf3\xffU\x98\x7f\xc2}m\xed\xc3\x9dw|a9\xce\xedY\xe2A\x04\xed\xb7\xf0\x9d5\xf8|jX\xffmJ\x8c\xf3\xc3A1@j\xcc\x8c\xfaf\xfb\xf6\x1a\x9ej%\xeb\x19IH	\xa0R\x8cF,su\x19J\x15\xc8\x84r\xd8\x1f\x13@s\xe6(\xe0\xbeF7\x17\x12@8\x04\xffd\xa1\xb5\x94\xda\xeb\xd8LQ\xec\xb2#\x89$ip)i\xfd\xc2\xba\xc2\xdb\xfd1k\xff\xeeb\x81\xe84\xee\x7f\x85\xd9\xa1-t\x06\x81'\xa55X\xa3\x8f k\x94\x0f\xa0\xe2\x8dcQTn\xf9\x0c\x88G\xa1\xb4\xe0\x8b\xc0\x94\xcb\x15\x91\xaaL\xb4Bg\x17\x19\x9c\xe3\xcf\xfe\xdaW\xbc(\xea\x89\\Rh\x9d\xddCc\xfft\x1c\xa6\x12,P\x7fR\xbe\x86\xd2\x1d7.\x188hQ\x15\xd3\xf6\x8b\x07\x9a\xd0\xcc\x83k\xb3\x1cU\x9b\x97x\x9eE\xdare\xdd,6:\x13\xb6\x9b\x93\x84\xf8\xec\xe2\xe9\xe13\xf8\xe2\x96/\x9c\xf4s\x13\xc0\xe4\xc0Mw\xeaI\xdbh\xf7\xcc\xf2k\xfaFiM\xba\xed\xd3\x0b\x9d\xe3\xae\xdf8\xba$\x8cn^\xa3`LRE\x90,\x95t\xbe\xe8\xf5r\xae\xa9\x8f\xcd\xa8\xc3<.\x95\xc7fA\xb83\x9du/&k\xe6\x852l0/\x94\xce0\xee\xb1\xb0@qd\x8e\xfe\xce\x9a\x05\x9d\x0b\xf6R\x9f\n\xa0\x05\xf9{'\x0cE\x92\xfc\xb4\xb4\xa4\xc7L\x97\x8f\xb3c\x8cw\x16\x9d	\x82W\xb1\x19\xc2A\xd83D\x01|b\xde]\xda8\xf3\xb0\x91\x037# \x8c\xf5\xf5R%N\xe3`]#|zg\xd90\xcb3L\x9e-g<\x1a$\x12\xd5\xe7i\xfe)\xed\xcd\xea\xd6\xa1\x11&\xb6y1\xae:J\xa0L\xa9\xa4\xf0K\xf53\x8f\xecs\xb1\xb7\xc1wf\xeb\x1dQ\xbcV\x15\x87\x82\xac\xf9,Mn\xe3\xd6\xac\xc8\xdb\xbdSxx\xd7\x11\x18\"1;p\xbd\x84\x93!\x86\xfe\x1d\xb2/\xa5\x993X\xea\x12M'{\x171\xf60\x15\xf9\"\x86\x93=\xc0\xdb\xf5\xbd\x0b\xb8^\xaa}i\xac\xff$4\xe1\xfa\x02\xd6\x7f5\x0f\xc6>\x99\xf5\xbbWk\x117\x9c9<\xc9\x07\xf7\xc7\xb6\x0f#>\xd4W\xac\x0e\xce\x83\xed/rB\xd0\xf3\xbbD\x1eo\xd7\xb7|\xd7x\xb5\xe0\xe7\xafr\xdc\xa9\xcd\xd3\xc4\x02\x89M\x0e\xff\xc5\xb5\x14X\x8bK4~\x8a\x1d\x8fM4\xcc\xc2\xc23\x8d`^\x14\xce\x89Ss\xe5b\xf4g4\xfcB\xae\x96\x902\xf1\xd1_f\x07\xb2\xc7\x1aK\xfc\x16%\xb9e\xa9\x86\xd5\xcca\xb5\x98\xae\xa6m^\xea\xfbpt\xfb,V\xe7r\x9c2\xfe\xf7\xbf\xfb\xa2'\x91\xf8\xe2\xfd\x82z\xd7\x1fnn?\xec\xae\xee?\\\x17p\xc3\xfbO\x0bg\x17w\xa0\xd5\x01\xe5Qj\x8c\xbeZ\xf2H.F\xf9ut\xc7\x0f\xc0e\xff\"\xa7P\x97#v\x17\xa0|j\xda\xf6\xcc\xb3\xb1\xcb&\x13\x04d\xad\xe1\xbf\\\x08\"\\\x044(ka\x145\\#\x1a\xd1\x0e@\x89U#a\x93\xe0\xa9\xc6\xa5pk\x9d\x1a\x1e\x1d\xbb\xf2x\xe4\x8e[\x11\x93\x8f\xcd-\xbb\xaaT\xd4jq\x846\xb8\xd6\x12^\xc4I\xe4go_/C\x085!\xf0\xd3\xc1 O\xb1\xfal\x18.\x86\xf4l2\x85\xe3\x16\xbc\x0b\xc9\x0b\xe4\xad\xe3\xda5\x9a	\xfb\xfe\x05w\xbb\x9a\xa4V\xf8\xe5\xd7\xd5\x90e\x85\x94\xd8z,c\xc7\xbd\x1d\xfd\xa6\xf3\xe6\xcd\xe4'\x9b\xf89\xe8\xb4\x85\x1f~\xe4_i\xbcuXv\x8f\xeb\xb4\x85\x1f~\\\xfdk\x00PK\x07\x08\x05C\xa5\x1f`\x07\x00\x007\x1b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x000\x00	\x00crd/bases/operator.kubecarrier.io_elevators.yamlUT\x05\x00\x01\x80Cm8\xecXK\x8f\xe3\xb8\x11\xbe\xebW\x14&\x07\xcf\x02-u&	\x82\xc4\xb7\x81\xbb7Xd6i\xb8;{\xd9,\xb0e\xa9ls\x87\"\xb5,\xca\x13'\xc8\x7f\x0f\x8a\"\xf5h\xc9\xfdJr\x08\x10^\xba\xcdG\xb1\x1e_}Ub\x96\xe7y\x86\x8d\xfa\x8e\x1c+k\xd6\x80\x8d\xa2\xbfy2\xf2\x8b\x8b\xcf\xbf\xe3B\xd9\xeb\xd3\x87\xec\xb32\xd5\x1a6-{[o\x89m\xebJ\xba\xa1\xbd2\xca+k\xb2\x9a<V\xe8q\x9d\x01\xa01\xd6\xa3L\xb3\xfc\x04(\xad\xf1\xcejM.?\x90)>\xb7;\xda\xb5JW\xe4\x82\xf0t\xf5\xe9\x97\xc5\xaf\x8a\xdfg\x00\xa5\xa3p\xfcA\xd5\xc4\x1e\xebf\x0d\xa6\xd5:\x030X\xd3\x1aH\xd3	\xbdu\\\xd8\x86\x9c\xfc\x17d\x96\xe8\x9c\n23n\xa8\x94\xbb\x0f\xce\xb6\xcd\x1a.m\xeb\x04&-\xd1\xd3\xc1:\x95~\xe7\x80\xe1N\x80\xce\xf6\xdbxkX\xd4\x8a\xfd\x1f'\xd3\x9f\x14\xfb\xb0\xd4\xe8\xd6\xa1\x1ei\x19fY\x99C\xab\xd1\x0d\xf3\x19\x00\x97\xb6\xa15\xfcI\x94h\xb0\xa4*\x03\x88\xee\x08J\xe4\x80U\x15\x1c\x8c\xfa\xce)\xe3\xc9m\xacn\xeb\xe4\xd8\x1c~bk\xee\xd0\x1f\xd7P\xb0G\xdfr\xd1\x1c\x91)\xac&w\xdd\x87\x858\xe5\xcfr#{\xa7\xcca.#\x85\xb1\x98\x85`\"\xf1\xe3!\xdd\xd0\x89\xab\xd0w\x13]|N\x1fP7G\xfc\x10\xa6\xb8<R\x1dp!\xc36d>\xde}\xf3\xdd\xaf\xef'\xd3\x00\x15q\xe9T#\xb6\xae\xe1]r*\xd4h\xf0@\x0c\xfeHPQ\xa3\xed\xb9&\xe3\xc1\xee\xc3L\xbfm@X<\xe1\x8ax\xa1\x8c\xbf\x1a\xf8\xda: ,\x8f\xf0\xe3\x0d9u\xa2j\x8a\xe4\x1f\x01\x07a\xca\xb0GS\x12(\x06\x8d\xad)\x8fT\x81\xb7\xd08\xdb\xe0!\x99\x1a\xed\x0fz\x05\x89\xb0\xd9\xde\x8c\xce\x1ao\x83\x8e}pEi\xe5W,rNJ\xc0\xff\xae\xd7QD\x93\xf3=\xf6\xba1\xca\xcb\x915\x8f|\xb5\x12wv\x98\x81J\x122z+\xe2\x88\xaa\x18\x01\xb9\xde\x1f\x15\x83\xa3\xc6\x11\x93\xe9Rt\"\x18d\x13\x1a\xb0\xbb\x9f\xa8\xf4\x05\xdc\x93\x131\xc0G\xdb\xeaJ\xbc|\"\xe7\xc1Qi\x0fF\xfd\xbd\x97\xcd\x10\x8d\xd5\xe8)\xa6\xc10\x02n\x0dj8\xa1n\xe9\n\xd0TP\xe3\x19\x1c\xc9-\xd0\x9a\x91\xbc\xb0\x85\x0b\xf8\xd6:\x02e\xf6v\x0dG\xef\x1b^__\x1f\x94O|T\xda\xban\x8d\xf2\xe7\xeb\x10x\xb5k\x85\x0d\xae+:\x91\xbefu\xc8\xd1\x95G\xe5\xa9\xf4\xad\xa3klT\x1eT7\xe21.\xea\xea\x17.2\x18\xaf&\xba\xce\x92\xa3\x1b!\xff\x9f\x88\x80\x10\x81\x80\x05\xe3\xd1\xce\x8a\xc1\xd1\xca\x1c\x02\x14\xb6\xb7\xf7\x0f\x90\xae\x0e\xc1\x98\x08\x85\xe8\xf7\xe1 \x0f!\x10\x87)\xb3'\x17\xce\xc1\xde\xd9:\xc8$S5V\x19\x1f~\x94Z\x91y\xec~nw\xb5\xf2\x12\xf7\x9f[b/\xb1*`\x13H\x1av\x04m#\xd9[\x15\xf0\x8d\x81\x0d\xd6\xa47\xc8\xf4_\x0f\x80x\x9asq\xec\xcbB0\xae/\xc3\xe86w^\x1bII\xec\x7f!cR\xa2\xdf7TNR\xa6\"VN@\xed\xd1\x87tM;\xc7dr)YeD&\xd8l\xa7j\xce\xf8mK{rd\xca\x98\xaa\x8b\x94\xd4sZD\xcfL\"\xc0\x03\x194>gU\x91\x90\xcfT\xcb\xcb\xb4\x92F \xeb\xd9\x99'\x82\xd0\x0dG?\xb7\xe2\xa6\xf9\xd1<\x88\x9cM_\x88\x92\x0cm\x0f\x9f$i\xe7\xb2&\x1c\xf7)n\x9b\xed\xeaD\x0b\xc1\x1c\xc8=Zm\xb0e\xaa\x9e\x91|\x176\x81'\xad\xc7ED\xd8^V\x02\xd5\x99Ri\x15\xc8R\x1cZ\x12s6\xf3e 5dnkIl\xf4\xb0A\x8fM\xab\xbd\xf0\x82#\xac\xce\xb33\x17\xc8&\xc6-T\x88\xcd\xf6\xe6\x19\xcf<BR*-`\xdd@\xbb\x9b\xed\xcdU\xa7T\xc7\xe33\x89 <\x10*>U\xa0\xccT\x92I\xf5\xeb\xb5\xf0\xeaz\xaf\xd7\xe3+\xf6[o9\x18[\xaf\xb7\x1c\x8d\xe5\xf2\x0dg\x9f\xca\x87\xe0\x83\x99\xc8<X\xb80\xdd\xe9\xbf\xb0\x10\xb5{Mj\xf9@\x0e\xafGP\xbb\xd3\xaa\x14\xcct\x90\xf9\xa2\xb4\x16x\xf0\xd9\x94\x01\x1d]\xa5\x9fi\x02\xffG\xcc\xff6b\x96\x95\xca\x87\x9a6\xb9$\xef\xc3\xbd\xd9\xde<Z\xe9\x91\x97\xbd\x00\xaaRk\xdbG\x85t\xc2\xfd}\xb5\x0e\x1b'\xf5\xda\xeeX\xba\xa3\x7f\xa3`\x97\xd6t\xdfW\xfcL\xc5\xde\xf4\x1bG\xdd\xd9\xa8\xe5\x05<\xa1\xd2\xb8\xd3I\xabP/\x96\n\x85t\xd8\xbdQ+\x86\xb2uN>i\xc4\x11\x0b\x1c\xab<\xd5\x0b\xca]hiz5\xc52\x8f\xca\x88\xc3<*\xcd\xb0\xb7\xd2:R\xbaoA\xe2\xc8\x1d\xfd\xc7BRt\xae\xd8s\xc9\x0c\xa0\x91\xfd\x83C\xc3A\xa4|\xcc/\xd91\xb3\xe5\xd3\xec\x98\xd4\xd0\xce\xd5\xec\xc1\xabPbiP\xf6\x82P\x00\xdf]\x1e\xbbek(\xa2M\xca;\x1a\xeb\x8f\xd3\xcf\xc4\xf1\xd8[W\xa3\xef\xbels\xb9\xf2\xc2%O\x14\xf1\xd4\xb82\xe3\xe1e\x96\x7f\xdb\xedM\xe6\x1e\xdb\x1aMh\x1e\x02\xae\xea\xb4j*U\xa2O\xdf\xefK#\xc5\x1cw\xb6\xf5\xd1m} \x8a\xb7\x9a\xe2\x08\xd9\x9a\x17Y\xb2\x0d[\x93!\xefwN\xd1\xfe\xab(`@b\n\xe0j)O\x06\x0c\xfd't_\xa2\x99\x0b\xb9\x14\x89&\xea\x1e\x11c\xf7S\x95\xaf\x02\x9c\xec\x1e\xde\xaf\x1e\\K\xab\xab\x8b\x16\xac\xbeF\xcd\xb4\xba\x82\xd5_\xccgc\xbf\x98\xd5Wo\xb6\"l\xb8px\xc2\x07\x0f\xe7\xa6\x87\x91\x1cJ\xfa\xa7t\x1e|\x7f\x95\x08A\xcf;\xd44\xde\xaf\xb6\xd2\xc1\xbeY\xf1\xcb\xf5Nz\x9b9M,\x88\xc8\x13\xfc\x17\xd7:`-.\xf1\xf8\xf1k<\xf2\xe0\x98\x85\x85'z\xaa\xb4\x88\xce\xe1cw\xa5b\xf4\x072\xf2&\xb9\xd8\x19Lb\xf4\xe7\xd9\x81\x14\xb1\xda\xb2<\x02\x94R\x16\x0e\xc3j\xba![\xa4\xab)_\xc3\xee\x9cH2>\xbd\x16\xd9%\x8eS\xc6\xff\xf67\xaf\xfb\xb4\x927\xc6g\xcc\xbb\xb9\xbd\xdb\xden>>\xdc\xde\x14p'\xfb\x1f\x17\xce\x88;\xd0jO\xe5\xb9\xd4\x1d;/E$\x15\xa3\xf4,\xb5\xb1F\xbey\xd3S\x88\"]\x8d\xae\xbb\x025\xb4\xad\x8ej\xbb\xec2d`k\x8d\xfc\x95B\x10\xd2\x05\xa1\xa6\xf2\x88Fq-5\xa2\xc6fH\x94P5\xba\xdcd\xf8r\xa4%\xb85\xf2F\x9b^{by<\x17\xf0 j*\x06k\xf4Y\xbc\x0e\x95\xe2F\xe3\x19\x9a\xd65\x96\xe9*L\xd2\x89\xdc\xd9\x1f\x97S\x884\x13\xc8\x07\xe9\xa0O\x91\xbd8\x0d\x17!=\x9b\xec\xe0\xb8\x06\xef\xda.\n\xec\xad\x93\xda5\x9aiw\xfd\xd3\xd9:\x9bP+\xfc\xe3\x9f\xd9\xc0\xb2X\x96\xd4x\xaa\xc2\xeb\xe7z\xf4\x8a\xfe\xee\xdd\xe4\x91<\xfc\x1clZ\xc3\xf7?\xc8\xbb\xb8\xb7\x8e\xaa\xf8\xaa\xc9k\xf8\xfe\x87\xec_\x03\x00PK\x07\x08saiI\xb7\x06\x00\x00\xa9\x18\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00.\x00	\x00crd/bases/operator.kubecarrier.io_ferries.yamlUT\x05\x00\x01\x80Cm8\xb4\x18]\x8f\xdb\xb8\xf1\xdd\xbfb\x90>l\x12\xd8\xda\xa6-\x8a\xd6\x0f\x05\x02')\x82\xcb\x15\x8b\xec\xf6^.\xf70\xa6F\x12o)R\xe5\x90\xdes\x8b\xfe\xf7bH\xc9\x92W\xf2f\x9b\xf6\xe6\xc9\xa6\x86\xf3\xfd\xc9\xd5f\xb3Ya\xa7\x7f \xcf\xda\xd9-`\xa7\xe9\x97@V\xfeqq\xff'.\xb4\xbb>\xbcY\xddk[na\x179\xb8\xf63\xb1\x8b^\xd1;\xaa\xb4\xd5A;\xbbj)`\x89\x01\xb7+\x00\xb4\xd6\x05\x94c\x96\xbf\x00\xca\xd9\xe0\x9d1\xe475\xd9\xe2>\xeei\x1f\xb5)\xc9'\xe2\x03\xeb\xc3o\x8b\xdf\x15\x7f^\x01(O\xe9\xfa\x9dn\x89\x03\xb6\xdd\x16l4f\x05`\xb1\xa5-T\xe4\xbd&.\\G\x1e\x83\xf3\x89\xa2B9\x14\x8a+\xeeH	\xe7\xda\xbb\xd8m\xe1\x12Z&7\xc8\x88\x81j'd\xf3\xff\x0d`\xe2\x08\x905\xff@\xde\x1f\xd3\x17\xa39|7\x9e}\xd2\x1c\xd2yg\xa2Gs\x92.\x9d\xb1\xb6u4\xe8\xf3\xa9\xdcg\xe5:\xda\xc2\xdf\x84s\x87\x8a\xca\x15@o\x81\xc4y\x03X\x96\xc9\xa6hn\xbc\xb6\x81\xfc\xce\x99\xd8\x0e\xb6\xdc\xc0\xcf\xec\xec\x0d\x86f\x0b\x05\x07\x0c\x91\x8b\xaeA\xa6\xf4u\xb0\xd0m\xfa\xd0\x1f\x85\xa3p\xe4\xe0\xb5\xad\xe74\x06\xcf\x153\xab\x9fQ|[\x0f\x1c2\xb9\x12C>\xc8.9\xbcA\xd35\xf8&\x1d\xb1j\xa8M\xa1 \xe0:\xb2oo>\xfe\xf0\xfb\xdb\xb3c\x80\x92Xy\xdd\x89\xae[x\x91\x0c\x0c-Z\xac\x89!4\x04%u\xc6\x1d[\xb2\x01\\\x95N2\xce\x18N=\xba/zV\x02_l\x8ff\xf4\x81\x18\xb4M7o\xbc;\xe8\x92\xfch\xf8\x02>8\x0f\x84\xaa\xc9\xbeIh\xdf\xc5=\xedr MH\x0e\x01\x04\xdci\xcb\x10\xbb\x84[=\x16f\x94w\x0d\x96\x141\xa3?\x82w\x86x\x0dL\xfe\xa0\xd5`C\x01T\xcaE\x1bx\x0dh\xcb\x84\x06{mKmk.D\x8b\xbb\x86\xc0\x13\xb2\xb3P9\xff\x14\xbb)QO\xd0F\x13tg\x88\xb7\xf0\x1a\x98T\xf4:\x1ca\xb3\xf9\xcbT\xbdQ\xa9\x06\x19j\xf1=y\xe8\xbc>hC5\x0d\xb1#\x10\x1a\xb2s\xf6\xaf\xc1\xf7e\x004;\x93B'1\x99\x98tb\x9c\xce\x95\x13\x92\x99918k\x8e\xe0,`J\x15C\x83\x9d@\x99\xc8\x81\xfc\x1a\xbe\x84/!4\x91Gvh\x8cS\x89\xdf\x84\xa2\xd8\xb0uV\x07'a\x0e\x9a\x81\xa9Ca\x02\x1d\xf5\xd6+\xe0\xae\xd1\x9c\xee?0T\xda\x92\x87\xdacI\x89\xc9@\x7fB4D+\xc4\x1e\xd1~\x0d\x95\xa1_\xf4^\x9b\xc1\xae\x1f+\xb0D%\x95P\xea\xaa\"/!\xdb\x97\x01P.\x9a\xa9\xea\x0d\x1eh\x826\x06\x0d\xcboJ\x01 \x16\x11\x91BCZ\xa2\x8e\x94\xae\xb4J<\xe0%\x15uq!Pu\x8b5\x0d\xe5$E\x8d\xa8\x17\xd1Hx\x19Q%vrB\xeb\x89\x04\x0bv]O\xe4\xa5\xa0^\xad_\x9c\x0e:/\xbe\x0b\xa7:\xd9[\x7f\xec \x93\xab\x8fR\xfcJ\xaa@/[)\xad\xa3\xcf\xf3^^*\xfb\xc2\x91\xb3]\x8b\xc7;OL6<v\xb6\x80\xab\x00-\xb8\xfd\xcf\xa4B\x01\xb7\xe4\x85\x0cp#\xe6\x96\xc0;\x90\x17\xdd\x94\xab\xad\xfe\xe7\x896Cp\x12\xd0`0P_\xb8GH\xe5\xd6\xa2\x81\x03\x9aH91[<\x82'\xe1\x02\xd1N\xe8%\x14.\xe0{\xe7	\xb4\xad\xdc\x16\x9a\x10:\xde^_\xd7:\x0c\x9dS\xb9\xb6\x8dV\x87\xe3u\xca\x05\xbd\x8f\xc1y\xbe.\xe9@\xe6\x9au\xbdA\xaf\x1a\x1dH\x85\xe8\xe9\x1a;\xbdI\xa2[Q\x98\x8b\xb6\xfc\xcd\xe0\x1d\xbe:\x93uV\xd33\xa4^\xf5\x84\x07\xa4oIn`\x7f5k1\x1aZBD\xac\xf3\xf9\xfd\xed\xdd\x18\x18\xa1\xd1\xd3z \x90\xed>^\xe4\xd1\x05b0m+\xf2\xe9\x1eT\xde\xb5\x89&\xd9\xb2s\xda\x86\xf4G\x19}^\xb8\x048\xee[\x1d\xc4\xef\xff\x88\xc4A|U\xc0.\x8d\x13\xb0'\x88\x9d4\x9d\xb2\x80\x8f\x16v\xd8\x92\xd9!\xd3\xaf\xee\x00\xb14o\xc4\xb0\xcfs\xc1t\x12\x1a!#g\xabM\xa8\x0c\x93\xca\x85\x8cI\x8d\xec\xb6#u\x96/%\xb1\xf6\x12\xd1A\xaa\x9b\xab2\xda\xb4\x07^J\xd3~\x18\xb3\xa4$\xbe\xce%\x14(\xa9\xc2h\xc26\x95\x17\xe5l\xa5\xa7\x9a-t\xed\xdd\x89\xd8P\xa5\x88\xa1q\x0f\xe7\xddZ\xf8\x9d\x12O2U+\xda\xe5\xfa~.\xb5\x00\xd9\xd8\xce%\xdb<%\xd2F\xa6\x93Y4]L\x12\x81\xfb\x13\xb5[R\x9e\xc2\xf6i=G\xe6\x19}\xa2\xed06dj\xa2ed\x82\x87\x86\xa6\xddi\x80\xde\x18)\xcd\x16\xcd\x91\x86\x12!\x994:\xe1;\xbb^\xcaB\x81^ 4\xec\xa0q\xa6\xcc\x12\x05wO\x96S\x01\x13j\xa9\xab\xda\x1a\x94\xc4D\xa5e\xd8\x151K\xd8\x1f\x17H\xca\x8d\x10\xad%3w\xcf\xe5\xc0:\x0d\x83\x0b\xe7O\xfa\x02R\xc2KH\xcf\xafn\x12\xc9\xd9\xf1\x85t\x120\xae\xfe$\xd5\xf5+\x1e\xfd\xd4\xa3\xcd\xb02i\xe9\x04\xf5Yo\x15\xe8P\x8c\xf6\x15\xca7		\x02\x193\x1d\x80\x82\xcb\xd7SO\xb2J\x1b\x9d\xba\x9a\x18TF\xc5\x19\xcd<\xd2 sl	B\x83\xa1\x1fkS_\xc4\xf2\xb8z\xb6\x85\x97\xad\xbb\x99\xe5\xc0\xea\x19\x16\x96\x9a\x13\x1f\xd5\x94\xb3LIB\xe6\xf5\xe3\xach\xb9\xbd\xc4\xe0\xffR\xb5\xf2V4\xabg\x8fJ\xe6\xee\x84(\x96\xc2\xb4\xadI\x8d\xc4\xec\x8d\xe1\x9b$\xd3\xc9\xa03\x8a\x00\xda\xceC_\x07j\x17\xd8/\xd5\xec\x93\x14)\x02P\xb6\x86\x92\x02j\xc3i*\x13\x83\xa8\xe8}\x9f\xe2\x19u\x810\x9cF\xa1\x85\x12\xff\xb5B\x9f\xc1 \x87;\x8f\x96\x13\x0f\xd9\xa8\x974\x98i\xf1ivM\xcc)r\x0bA\x08:\x05%\x8d&\xbd@\x14 d\xe6y\x0ep\x96\xfa\x10\x92|@\xebBs\xbe\xbdM\xa1r\xbe\xc5\x90W\xcd\x8dp\xbc\xc0\xe3\xc9\xd2\"\xd0\xca*V?O\xf1\xef3\xee\xa0m\x13[\xb4\xb2\x86\x95\xb874P\x02\xd9\xd3d\x05y\x94iS\x18\xfc\x8d{\x17Co\xb5\x93\x1f\x8aoU%/\x84\xcf\xd2\xe4sB\x1d\x14y\xb9\xf7\x9a\xaaW\xd3\x8d\xf2\xcc\x7fWKY0\x86\xd0\xffC\xf6\xa5\xdaq!\x89\xfa\x02\xe2\xaa\xf3 [\xa7\x00r\x15\xbc\xbc\xba\xf3\x91\xae\xd6p\xf5\x01\x0d\xd3\xd5z5'\x98\xe1\xea\xef\xf6\xde\xba\x07{\xf5\xea\x9b\xe5N\x08\x17.\x9f\xa5\xfe\xdd\xb1\xa3\x05\x99\xfbL7Gxy\xf5YJ\xf77\xcbr\xb9O\xca\xa3\xce<\xd5\x17Hl\x86\x18^\xfc\x96\xa3c\xf1\x13O\x9f\x94\xa6\xb0I\x06Z\xf8\xf0D\x93\x1e>\xa2\xf7\xf8\xb8\x93\x0d\x9d\xe2\xafd\xe5\x85\xe2\xc2\xa0:5{C\xd0:\x96mDIE\xadO\x17\xc7\xae\xb3?\x0e\xe1\xde\xbf\xd6\x14\xabK\xe5F\xdb\xf0\xc7?\xfcwc\x81\xbc\xbfmWO\xc6\xc6\xbb\xf77\x9f\xdf\xef\xde\xde\xbd\x7fW\xc0\x8d\xe0Ov\xa7\xb3~`tE\xea\xa8\xe4)$\x0col\xcb=a\xd8}w\xcerz\xdbJM\xad\xd2d\xca	\xbb5\xe8\x00\x0f\xda\x18Y\xa1<\xb5\xee@\xd3\xe7\x88\x01\x90\x81\x9d<\xc4$id\xa5e@hI5h5\xb7R\xae[\xec\xceZ\xa8\xeb\x93\x86/M\xbb\x9d\xd7\xe3J\xd9\x0f\x8e\xc3#\x8c\xee\xdf~\xa4\x1d\x96\x9a;\x83G\xe8\xa2\xef\x1c\xd3:\x1d\xd2\x81\xfc14\xcb\x89@\x86\xd3\x00;\x91\xa7X=;\x99\x16\x03sv\x98#g\x0b\xc1\xc7\x1c\xdd\x1c\x9c\x97629\x89\xfbaI>\xd5\xb5\xbe\xca\xc1\xbf\xfe\xbd\x1a\x0b\x1e*E]\xa02\xbd>n'\xcf\xca/^\x9c\xbd\x1d\xa7\xbf\xa3N[\xf8\xf1'y3\x0e\xceS\xd9?\x9d\xf0\x16~\xfci\xf5\x9f\x01\x00PK\x07\x08\x91\x84\x8c9\x8a\x07\x00\x00\xb8\x17\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x003\x00	\x00crd/bases/operator.kubecarrier.io_kubecarriers.yamlUT\x05\x00\x01\x80Cm8\xec[[o\x1b\xb7\x97\x7f\xd7\xa78p\x1f\x9c\x00\xd68i\xb7\xffm\xf5\xe6\x95\xb3]7\xce\xc6\xb5\x9cv\x81\xa6XP3G#\xd6\x1crJr\xe4h\x17\xfb\xdd\x17\x87\x97\xb9Xs\x91\x9d\x14(\xd0?_\x04\xcd\x90\x87\xe7~~\xbc\xccl>\x9f\xcfX\xc9\x7fFm\xb8\x92\x0b`%\xc7O\x16%\xfd3\xc9\xfdw&\xe1\xea|\xf7zv\xcfe\xb6\x80ee\xac*n\xd1\xa8J\xa7x\x89\x1b.\xb9\xe5J\xce\n\xb4,c\x96-f\x00LJe\x19=6\xf4\x17 U\xd2j%\x04\xeay\x8e2\xb9\xaf\xd6\xb8\xae\xb8\xc8P;\xe2q\xea\xdd\xab\xe4\xeb\xe4\xfb\x19@\xaa\xd1\x0d\xbf\xe3\x05\x1a\xcb\x8ar\x01\xb2\x12b\x06 Y\x81\x0b \x02)\xd3\x9a\xa36\x89*Q3\xabt\xd2z\x9ap53%\xa64}\xaeUU.`\xa8\x9b\xa7\x19\x19e\x16s\xa5y\xfc?\x07\xe6\xa6\x05\xf0\xe2\xbf\xad\xd6\xb8\xf4\x13\xbb\xf7\x82\x1b\xfb\xf6\xf1\x9bkn\xac{[\x8aJ3\xd1e\xd7\xbd0\\\xe6\x95`\xba\xf3j\x06`RU\xe2\x02\x96\xa22\xd6=\x08\xaaq\xdc\xcc\x81e\x99S6\x137\x9aK\x8bz\xa9DUD%\xcf\xe1w\xa3\xe4\x0d\xb3\xdb\x05$\xc62[\x99\xa4\xdc2\x83\xeemT\xdd\xca\xbd\x08\x8f\xec\x9e\xa63Vs\x99\x1f\xd2\x88&M\x0e\xcc\xd1\xa1x\x91\xc7\x19<\xb9\x8cY\xff\xc0\xdbj\xf7\x9a\x89r\xcb^\xbbG&\xddb\xe1|\x84\x9a*Q^\xdc\\\xfd\xfc\xcd\xaa\xf3\x18 C\x93j^\x92\xac\x1d\xcdB\xc1$\xcb\xd1\x80\xdd\"dX\n\xb5/PZP\x1b\xf7\xa4\xdd\xb3q\xb90\x195?\\'\xf5\xa3R\x93[\xd8\xda\xde\xbe\xb5\xc2\xa15\xf8\x11[\xa7\xc4\xb97\x0fd\x14\x07\x81\xad`2\xcc\x82\xb0\x9e9n@c\xa9\xd1\xa0\xf4\x91\xd1!\x0c\xd4\x89IP\xeb\xdf1\xb5	\xacP\x13\x190[U\x89\x8cd\xd9\xa1\xb6\xa01U\xb9\xe4\xffS\xd36`\x95\x93\\0\x8b\xc1\xe9\x9a\xe6\\D2\x01;&*<\x03&3(\xd8\x1e4\xd2,P\xc9\x16=\xd7\xc5$\xf0Ni\x04.7j\x01[kK\xb38?\xcf\xb9\x8di UEQIn\xf7\xe7N\xbd|]Y\xa5\xcdy\x86;\x14\xe7\x86\xe7s\xa6\xd3-\xb7\x98\xdaJ\xe39+\xf9\xdc\xb1.Ic&)\xb2\xaftH\x1c\xe6\xb4\xc3\xeb\x81\x1f\xfa\xe6bn\xc4\x02\x14y\xc0\x0d\xb00\xd4K\xd1(\x9a\xcb\xdci\xe7\xf6\xcd\xea\x0e\xe2\xd4\xce\x18\x1d\xa2\x10\xf4\xde\x0c4\x8d	Ha\\nP\xbbq\xb0\xd1\xaap4Qf\xa5\xe2\xd2\xba?\xa9\xe0(\x1f\xab\xdfT\xeb\x82[\xb2\xfb\x1f\x15\x1aK\xb6J`\xe9r#\xac\x11\xaa\x92\x02%K\xe0J\xc2\x92\x15(\x96\xcc\xe0\x9fn\x00\xd2\xb4\x99\x93b\x8f3A;\xad7\xcdw\xf6ZkQ\x89\x19w bZ\xe1\xb9*1\xedDM\x86\x86k\xf2k\xcb,R4\xb4:w(\xf6Gl\x88\xda.\x97\x07\x99\xe4\xe2\xe6\xcaG\xd6\xf4\xf4u\xd7\x03\x8a\xc3\x0cPc\x95\xdd\x92\xbf\xa7.\xc8\x0f\xf99\xe4\xa93\x80B}\xc3\xf3J\xf7\xe5\x08\xdf\xb8\xc5\xa2\x93\xad\x8eg.\xe8I*\xb9/T5H\xe4\x90\xc78\xc2Y\x98o8\x1ax\xd8\xa2\xdd\xa2n\xa89\xd1G(:\xcd\xedx\x86\x1aP\xb2\xb5\xc0l\xa4\xf3\x80\x83u\x1b+\xf9[\xdc\x1f/\xc5\xcd\xd5[\xdc\xf7\x88\xe0_\x18\x8f:0\x83\x1dg#4\xe1\xa0\xd4\\\xdc\\\x01\xd3\x08,M\xb1\xb4\x9f/\x97\xcf&K\xd4\x96o\xc8/\xf0h\x11\x97\x8fG\xb6\xa4\xfd\xaf\xe4\xdbW\xdf\x07\xda\x906\xc4GhC\xd7\x1da\xa3\xbc\xb0>2\x1e\xf9\xfa\x08\x9d\xf1\x90iZ\x8b+\n\x0b\xa5\xb9\x1d5\xef\x81\xa3.{\x08\x80\xc6\x0dj\x94i\xa8\xcf\x06S\x8d\xc3N\x15\x1b\xe5W\xc6e,!7o\xde\x01\xcaTe\x98\xc1\xf2\x02\xd6\x95\xcc\x04\xc2\x8b\x94\xc0\x91}Iex\x87\x9ao\xf6\xd3t\x0f\x0c`\x80\xe5\x8cKc\x93\xd9\xa3\xbe\xcfTc\x8d\xbd&{\x0dV\xde\xbe\xa6\xf1\x8f\x8ar\xf4\x14\xd9\xb9\x9b|\xa2\xd3Q\x81p\xdc\x9c\xf3^\xbf\x99}\xe6\xdc\x8ag\xe9\x98\xa4\x1d,\xf8\xfe\xear\xd9\n\xb5\xf7%\xca\xabKX*)	hM\xe7\xf4\xd8\xfe\xa6\x01\xc6\x8d\xa9P\x9f\x1a\x8a,.\x81u\xa2M+u|\xbe\nP\x9a\xe2<V\x9b\x7fF\xd5AT\xc5,tu95k\xc7\xcbO|y\xb9\xbat\xfa\xfd\xf1\x97;(*\xe3\xb0\xac3`F\xee{F\xef&\x88\x02|<1\xd5\xfa\xe3	l8\x8a,\x81;Z\x1e\x95\xa2\xca\xb9\x04%\xc5\x1e\xac\xae\x0803\xb7P\x16\x03\xf8\xfa\xb0Y\x05(M\xa5	\xe3c$\x982I,V\x063x\xe0v\x0be\xb5\x16<\xad\xfd\xc3L9\x08\xc0G	w\x0dAS\x95\xa5\xd2\x84\xe7\xb7\x08\x1fO(H\x95v\x8b\xa8\x92i\xbb\xffx\x12\xe2\x7f\x92l\x9d\x1f\x04\xe3\xc5\x19<ly\xba\xa5\xfd\x06\xf5\x10\x80\x16\x13\x9el\xe4t\x92\xa2U\xde\x14`\xd5=JZq\x00\x0b\xca#\xe3\x00\x83\x8co\\5\xb4\xe1\xf1\xb4\xf0+\xc4f)B+v\x9e%\x12\xed9\xa5;\x13\x1e\xccS/\xca<U\x1a\xe7\xaf\xff\xfbU\xb2\xb5\x85\xf8\xea\xea\xf2\x8e\xf88\x99\x98\xe3\xe8hq;9fI\xeaz\x92\xe3\xfe\xd0\x8c;\x03\xbe\xa93uv\x06)\xabL\x00\x06\x94\xc4[h\\\xe9\xd9 \xf5\xd0h\xf1\xad\xf7\xf4S\xaa\xb2\xa25\xb8s\xbc\xca\xb8d\xe6\xb9\xf5>\xc7$\\]\x82\xd3\xc6$\xd5\x10\x14W~O\xa3\xc5\xbb\x7fC\x0b\xde\xb0\x91\xe0\x92%Q\x9e\xa4\xe9fv\xf4\xfc\x129\x06n\xbdrV\xda\xedeQ\xf2\xf4\x86\x98\x0c\x8b'Z\xedF\xe3\x86\x7fz\x86\xd9\xfc\xc0\x01\xbb\xb9\xd0\x99\x8e\x8b\x82\x95\xa5Cr\xca[\xc5%r\x17\x1ek$en\xf8\xa7\x98\x1dj\x1dM\xc9\x0fp\x11\x94y\xe2\xb0\xc2	<\xb8\xcd\x1a\x8d\xa6\x12\xce4\xc1\x01\x04\xbf\x8f}P\xe6\\\"j.\xf3\xa9\xa0\xa0]\xd4,\x0c+\x98\xbeG\xda\xcd8\xf9bFqiB\x7f\xb8\xbd~\x92EN\xae\xe20\xf2BR\xd5\x87\xdb\xebN\xb5\x05\xc3s9m\x90\x18\x0c\x06\x98	\xf9\xff\x81\x0bA\xe6 j\x1fO\xb81\xb1>\x90O\xc6]\xd8\xb1\x16r^\xa9UV\xa5\x98\xc1z\xdf\xe5\x8c\xb6\xbf\xb8\xa1\xe8t\x95\xea	\xb0\x8cZ\xc6M\xaav\xa8\xf7I,\x07A\x0b\x95\xa9\x98\x10\xdd\xb9N\x0d\xbd\x9d\xa4I\x0e\xa7*\x0b\x0cJf\xb7g\x8e+\xfc\xc4\x8aRPa\x899\x97\xa5\xa9\xaa\xa45I\xaeT.0IU\xf1q\xda{\x94n\x91\x10*\xe721L\xa0\xd9(\x9d\x06\x1a\xb5$QE\x93D]\xda\xe0\xc4\x9f\xdbv\xedh\xb0\xad\xa1'\xd5\x8cz\\S8n\x02CK7\xc1\x94\xb0G;}\\M\xb8l:	\xf7\xda\xbb\xed\xc7/\xbc\x8ef\xe6\x11\xb2\xbe\xed\xf06\\\xa6&\x98\x86\xc32\xd6,N\xc1n\x99\xa5Xr\xce\x1a\xb51I\xd1'Y\xb8\xc7}Hx%\xe3\xda\xb8-\x8fV!\"\x921\xac\x8fKSG\xe0\xd4\x80\xb50[\xf1\x9c\xd6\xe2\x17\"\x9f\xb4[\x86\x1bV	;\xd5m\x0e\xb7\xab\xaf\xbf\xfd\xc7D\xafn\xfa[\xf5\xb0\x03\x06\x03\x16<b\xff'H\x85\xae\xd0\xfe\xf8~\xf5\xc6\xe5K*ML\xd0\xb9\x93\xdd\x16D\x8b\xd9\x0el]Oo+t2\x9dU\x8el\xc8\x88.\xccc0Z\xa5\x84I8\xdaM\xa2t~N0\xed\\o\xd2\x7f\xfd\xf6\xf5w_\x19L\xc9\x17\xe7\xdf$\xaf'\xe7s9\x90\x9b\xe0\x10A\xe5\xae\xa2:\xad\x9e5u\xd4\x9dZ\x14\x05\xcaltg\xcc\xb7\x90\xb3\x1f-\xa0)m,\xdaR<\x0b\x86\xfe\xcc\x04\xcf\\\xb2\x9fJ(\xa3[\xac\xcf\nu\x9f\x15\x98\xd6l\xdc\x94\x04\x1d	\x9b\x1c\x89q\xbd\xa3\xd3I\xc3S\xdc\xf8C{\x92X\xc8i9\xe7\xcb\xadU\xc4\x06\xb0\xe9*\xde\x02\xbb\x91\xf1/\x06P\"\xc1g\xe0\xc6(\xdf\x17F\x8e\x91\xa3\x0ell@c(\xa2\xd3>\xeeB&\x99D\x8fq:\x0f '\xa9z2\xbf\xab\xad\xfcR 1\xd6\x87/\xba\xf76\x0f\xeb\xce\xd15\xcb\xbc\x81\xa7\xb3\xcf,\"\x06\xf5\x8e\xa7x\xe11\xd4bv\xa4\x0b\xad:\xc3z\xce\x0c\x02]\x08\xe0l\x84.\xb8}\xf2/~\xf8A\x07e<%W7\xc7K\xd5\x8ci\x89\xe4)9\x7f3O\x00\xc5\x7f\xf6^\xe5\xd6\x96\xcc\x98\x87l\xe5\xb6\x13\x9ft\x0c\xf0\x1fnh=\xb6%+\x05j$<A\x11\xc2IA\xcc\x87$oKU\x8f\x04\x9e\x8a\xb9\xbf\xe1.\xe3q\xf9\xa3k\xe6\xcf\x8b\x8a\xc9\xc0\x19/\xc3B\xe5\xd7t\x93a1\x9b\xf4\xb0\xeb\xd0\xb5\xb7\xa7\x9f\x85n_\xe4\x9d\xdb'\xb1\x95\xb4\xe7\x94\x1d1\xcb\x8d\xeb\x08\x16\x85h\xddg!\x87t$\x1c\xb2\x92)\x17\xdc\xc5\x1c\xf9X\x8af\xa8l\xd3\xf2\x97\x19SQ\x01#\x80Y\x9fp\x13\x02\xd0\xc8\xb2\xfd\xec\x19\x05[3\x8b\xd7\xbc\xe0\xf6\x08\xa5\xdd\xc6\xbe h\x84\x8f\xc6\x94	a\xa0D\x1d\xa2Jf\x10\x92\xf5Y/Ep\xe2kE\x97L\x1c\x01\x7f\xa7\xc7\xafG\xfd\xbd)\x7fI#l\x1d[\x94\x8c\x16\xcf\xb3\xe7E\xe5\xba\xd2f$\xf9t\x9c\xe2\xdf\xa8o\xc4S\xb2*\xd6\xa8	\xe6W\x92\xe9}\x90\xd3\xed\xeeb\x06l\xc8\x85\xa9)\x99b\xbfF:@\xfb\xa7\x9b\xd5p\xd6\xd9(]0\xeb\xbc\xf0\x9b\xaf\x07{\x15\\\xf2\xa2*\x16\xf0j\xb0\xcb\x943S+\xd8\xa7\xa5\x92i\xa55J\xbb\xb2\x1aYa\x8e\xd4\xd9\xbb\x9e\xa1\x87*Lk\xeab\x0c?\xd3\xb2\x80\xf0\xb8#\xf2\x02\x93<\x81_\x98M\xb7/\x07\xb4\xf9\x8a\xf6,\xa8\x1a\x8fA]\xf21\xe7\xaf\x7f\x11e\xffQ\x1e\xab\xdb\x9fnV\xe3\xdeHZ1\x98*\xca\x0c\xde1\x07	\xc3\xa4\n]*\xf0\x9a\xe22\xffK(k\xa2\x1eXa<@\xb8\xc5\xcdb6\xe9\xaaw\xd7\xab\xba\xfb\xe3K\x05w\xd7\xab6\x12v:*5\xdf\xc5\xab\x90\x87\x8dvS\x1c\xa4  )\xf3\xbeK%\xcf\xcdY\xe3\xf8ar\x056^\xb7G\x10\xc2\xa8\xbaG^\xa6\xaa(\x95Di{\x1c\xbbc\x81e\xdd\xb1\xc6\xa9h`\xab\x1e\x9c\x8f/\x99e%m\xbd\x93\xf2\xdf\x08\xdc\xd1\x19N\x8bx\x8fF\xba\xf7\xcc\xdc\x8e\x96\xbfU\x8aY2{Z\xb1(T6\x00\xdaB\xce^\xc0\x0d\xea\xe5m\xff\xba\xa7#\xe6\xe9;\x95\xd11\xba\xc0\xd4z\xf1\xa2h\xa6#\x9b\x99`\xd87?+\xbcX\xef#'/\x17\x80\xb4O;\xa0\xb1p\x192G;\x94\x16\xe9V\xa3z\x90pY\xdf\xc0Mh\xaf\xc6(\xb7\xc1\x82\xd9\xa29\xb9\xad\xa7h\xfa\x0e\x10\xa5\xfc\x12\x96]\xe1\xfe\xb3c\xab\xa6T\xf3\xd7Prc\xe2\xc2j\x80,\x05\x83)YJ\n\xd5;\xa4\n<*\xb7INg}\x84PV\x03\x87\x8e\xf31\xc3\xce;\x8a\x99=9 G\xc2f\x18\xae\x1e\x07U\xc7*\xfb\x10D\xfds\xe0\xe9ch\xfa\xb6Z\xb3p\xcb~\x18\x9c\x0e\xaam@e\xb4v{|\xe7\xb1#N+\xfb\xd2B\xb92\x9d\x0b\xaajM\x99\xfa\xf3n\xa8R\xa5\xe5\xad\xcf\"\x06YY\xd6\x1d[w\x92[\x17\xbd\x81\xed\x18\x17\x84\\\x02_N\xc3\x03\x19\x8e\xb59=5\x10\x90\x94SH\xcf~\xdd\xe0\xe6\xe7\x90\xb2jf\xe3\x9d9R\x9ce\\\x18W\xe0\x88\xeb0e\x0f\x7f-\xa5\xd4\x17\xe5[\xec\x1e\xb27]\x00\x053\xf6N3i\x1cU\xfa\x84\xa4O\x9a\x03\x9d_\x1f\x0c\x8b\xe0\x89\x08\x82\xe5n\xe1\x84\x0d\xbf\x03D\x01\xac\x9f<\\\x16W\x12\x83\xef\x11hgR\xd9m\xbf\\mpDW\xc3\xe74\xe5\xc0$\xa39\x83Z\x81\xc6\xb0\xfc8\xc9\xdf\xf9\xbeQ\xdcmU0\xe9\x82\xce9X\xa0\x04\\f\xb4\xcb\xd7\xbf\x0c\x8cy\xc7\x9b\x9d\xad\xe9T\xd3\xab\xad6D\xf2\\Q42\xf3\xf8K\x8c\x01\xaf\xbcu]\xa3 /\xd6\x9a\xe3\xe6e \xd08c4\xe0\xe9P}\x83/\xc6{_\xd2\x19\x88\xa8\x90s\x02\xef\xc1c\xd4\xa6\xcb\xf2\x99s'\xb5\x81\x17\xa7w\xba\xc2\xd3\xa152\xc0\xe9\xbf3a\xf0\xf4\x0cN?\xc8{\xa9\x1e\xe4\xe9\xcbg[\xc0u\x18\x18\xdc\xc9\nw\xfb\xb2v#\x1a\x14\xf9oEt\xa3\xfea\xdeC\xba\x10{xqzK;\x13\xcf\xe6}\x0c\xcb\xce{2E\x0f\x89y\x8c\x80\xdew\xde\xb7z_\x99\xf6\x97V\xed6w\xba\xe9y1R\xec\xc7\x8e\x90bi\xfa\x01%\xea\x81\xef\x0e:fz\x7f0 \x1a\xadP\x86>\x83Ii7%o\xde\xc6\x19zx\xf6Q\xd5\xcd\xda\xf1\x18\xaf\xd9\xb3Jf#\xcb\xc0\x7f\xfc\xcb\xd3\xb0	}\xd36!\xe1\xe5\x9b\x9b\xdb7\xcb\x8b\xbb7\x97	\xdcP\xff\xc7u4\xb8\x18\x08\xbe\xc1t\x9f\n\x9f\xa3\xfb\x8c\x12\xabR\xfc6\x8b\xf0\x1c\xcf\xea\xef\x81\xdc\x91Y3\xdd\x19p[_e\xd1X\xa8~\xad1\x03F)I\xbfT\x0e\\\xd00(0\xdd2\xc9MA\x95\xa2`e\x13+\xaev\x84\x8bY\xf4\xe9E\x9f\xc7\x95\xf4M`\\J\x86\"\xb9\x0f\x97k\xb8\xf1\x17+\xc9Z\x197\xa5`{(+]*\x83\xe1\xda	-\x08\xec\xb6?\x8aP\x18w\x12\xdd\xe2'\x99\x1d\x1d\x89\xbd^}\xf0\xd0{\xe4\x02\xac\xae\xbc\x15\x8cU\x9a*X\xebI\xb5\x8e\x1fq\xd5)5$X\xf8\xdf\xff\x9b5\xb96\x1e\xc4\xff'a\xffE\xeb\xf3\xcd\x93\x93\xcew\x99\xeeo#\xd3\x02~\xfd\x8d>\xc2\xb4Jc\x16>\xed3\x0b\xf8\xf5\xb7\xd9\xff\x0f\x00PK\x07\x08\xaeEu\xdc>\x0d\x00\x00%;\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8\x9cT\xc1\x8e\xd30\x10\xbd\xfb+F\xca\x91\xdd\xec\xbd\xb7\xd2\xad\x00\xa1e\xa5R\x89\x03B\xab\x89=i\x86:\x9e\xc8\x1e\xb7\x94\xafGN\xda\xd2\x9e(\xbdE\xe3\xe7\xf7\xe6\xbd\xc9\xb8\x82u\xc7	\xb69\xa9\xf4\xfc\x1b\x95%\xd4\x07\xec=p\x82 \n\x1c\x94\x82#\x07*\xd0\x10\xc4\x1c\xa09\x00k\"\xdf>\x98\n\x12\x07K\xc0\n\x8e\x06\n.\x81\x04H\x14wl	\x02\xf6\x04\x18\x1c\x04\xec)\x0dh	\xb4C\x05\x8c\x04\x92\x15\xa4\x05\xbdT'\x18\xd0nqC\xb5\xa9\xe0\x93B\xea${w!k%\xb4\xbcyr\xd4b\xf6j\"%\xc9\xd1R\x9a\x99Gh0Qz\x92\x81\"\xaa\xc4z\x9b\x1b\xb2\x18#S\xacY\xdep\xe0DqG1\x8d\xee\xfe\x89\xb7\xa88d\xaf7\xc2\xc9\xd3\xae\xc8\xde\x08o\xa9|\xde\x08\xbepr\xbcQ\xc1\xbbRl2{Gq\x96,\xb6\xadx7\xb3\xd1\x9d\x06I\xa7l\x8c\x19PmG\xe9\xabFT\xda\xb0}\xa1\xb8\xa1\x99\xa9\xe0\xfb\xb7\xe5\xfb\x8f\xaf\xaf\x9f\x7f\xc0Z\x80\x026\x9e`OM'\xb2}\x80\x1c\xac\xf4=\x05\x05\xf4\x1e\xb4#Hd\x95%$\xd8\xb3v\x17\x97\x87H-\xff*#;*AG\x91\xc6!\xb7\x12'b\x0e\x9b\x91\xc3J(3`	'\xa5	\x83\xb6\x83\xc5\xea\xd9T\x8f'\x92\xa7\xe3\xf9\x1b\x87;\x138\x12\x8c|\xa6\xd8],W\xeb\x97\xf9\x97\xf9\x87\xe5\xea>\xcbW\x04\xffc{1\x07\x0e?\xa7\xfc\xae\x0d\xff\xf5k\xf1\x0c\xb9\xdf\xf3\x05\xc9\xd9w\xc9\xbd\x15\xefe_\xda\x99V\xa8lw\xe9CK\xf2\xa5|\xfeo\xa0\x93}\xd9u'\xd7\x8f\xc2\x08_\xac\x9eSm&\x8a\x1c\xc7\xfa\xb8{'$MG\xf5\x01{o\xfe\x0c\x00PK\x07\x08\xd1\x91\xefR\xb9\x01\x00\x00[\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x9c\x90Aj\xebP\x0cE\xe7o\x15\x17\xfe\xf8\xc7\xfc\xd9\xe7M\x9d\x15$\xdd\x80\xf2,\xc7\xc2\x8ed\x9ed\xa7t\xf5\xc5NS\xe8\xa4\x94\x8e$t\xc5\xd5\xd1\xfd\x83\x97A\x1c\xbdL\x8c\xadZE0\x95A\xf4\x8aq\xf1\xb0\x9b\xbc1\x06\xbb#\x0c\xbe\\<$\x96`(\xdd\x18\xa4\xdd\xde\xf8L\x85Q\xb9\xe7\xcaZ\x18\xa2hO\xc7\xb4I\xa7\xe70\xa7\xbf\x18E\xbb\x8c3\xd7U\n'`\xe5\xeab\x9a\xb1\xfeK@/<u\xe7\x99\x8b\xe7\x04<\xb7\xdb\x1d\xe2\xc4nK-|\xe4^TBL\x13\x00\\\xab-s\x06\xcd\xc2\xaf\xc1\xba\x99\xf9a\xfc\xef\x07\xb1]\x9f)\x86\x0c\x9f\xb94\xc5\xf4\xe3\\s\xe7\xcb`6\xb6\x93\xb0Fk\xda\xcb\xb5\xf1\x07T\xb31\xa7\xf4\xf9TN?\xe0\xf8\x9e\xe2w\x0c{\xa4	(\x95)8\xa3\xa7\xc99\xa5\x95\xea\x97D\x1f\xe67\x0e\xea(\xa8!U\x0b\n1\xf5\xf4>\x00PK\x07\x08\xf6]\x02\x05\xef\x00\x00\x00\xda\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00,\x00	\x00crd/patches/cainjection_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8d\x8e1O\xc3@\x0c\x85\xf7\xfc\x8a'\x95\x81\x0e\xbd\xaab\xa9\xb2Ei\x90:\x80P\xa9X\x91\xb9\xb8\xadIs\x0e>\xa7\xf0\xf3Q(\x03\x12\x8b%?\x7f~\xfaf\xd8\x9f\x18\x07=\x9f\xf5S\xd2\x11\x03y<\x81\xda6\x83\xd0\x8aqt\xb9L\x80!\xb2yO\x89\x8elp\x85\xa4w\x8e\x8e\xba\x82$W\xf8\x89Q\xef6\xc5l\x9a\x88\x9a.lY4\xc1\xf8c\x14\xe3\x8cn\x9d\xb1\n\xab;\xa8\xe1L\xce\x16\n\x1a\xe4\xe5\x8a\x95\xa0A\xf8\xcb9MO9t\xeb\x1cD\x97\x97U\xd1IjK\xd4cv\xedw\x9cu\xb4\xc8\x1b>H\x12\x17ME\xcfN-9\x95\x05@)\xa9\xd3\x14\xe7i\xc5\x8f\xf2\xe2\xd7y\xaa\xbb:/\"-\x0e\xa6}\x89\x9b\xdb\xba\xd9\xed\xb7\xf7\xdb\xba\xda7\xaf\x8f\xd5C\xf3\xfcT\xd5\xcd|\xf9\xff0/\x80D=\x97\xe8\xc67\x8ed&l9\xe8\xc0F\xae\x16\xfe\xa4A\xb4\xf8\x1e\x00PK\x07\x08k\x90O \xf1\x00\x00\x00X\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00	\x00crd/patches/webhook_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8T\x91\xc1n\xdb@\x0cD\xef\xfa\x8aA|h\x0b$\n\x8c^\x02\x01\xb9\xd4\xf9\x82\xa0h/\xbd\xacVci\xab\xf5R%)\xbb\xfe\xfbB\xb6\x92:\x17\x01\x1ar\xc8\xe1\xdb\x0d\xbe\x0f\xc4^r\x96S*=\xa6\xe0q\x00Kh3\x0dQ\xca\x91jI\nNl\x07\x91\x11{Q\xec^_\xaa\xcd\xf2\xbdmP\xfe\x99\x93\xd20>\x19\xb6\xf5\xf6+D\x91\x83S\xeb*L\xe9\xc7uN\x830%\xfeu\x96e\xaa\xd5\xe3\x93\xd5I\x1e\x8f\xdbjL\xa5k\xb0\x9b\xcd\xe5\xf0J\x93Y#_\xb8O%y\x92R\x1d\xe8\xa1\x0b\x1e\x9a\n(\xe1\xc0\x06\xe3\xdc2\x06\xd5D\xb5Z&jp\xd1\xfaF\xad\x93T61.\x96\xffA\x97?\xc0\\\x83\xb3?7\xf8y=\xec\xa2\xaeG\xeerb\xf1\x9d\x94}\xea\xaf\xed\xc0\x06>$C2\xdc\xfd*w\x98\x8d\x1d\x82!`\xca!r\x90\xdcQ\xef!>PO\xc9\x88\xe48\xa5\x9c\xd1\x12\xca\xdf\x8c\xce\x0e\xed\x19>p!`\xd4#\xf5\x02\xb3\xe5\xc2\xbd\xcd\xa1\x8c\xf7\xef\xbb\xda\xd9q\xe2'%zY\xca.0\xfa2\xf4B\x14\xb3]\xd4\x81\x88T\x7f8\x84\x12z*>\x8bb\x12g\xf1\x14r>/\xe9.\xef\x99\xf6(\xe2\xab\xeb\xd6\xf1e\xdd\x18\xc3\xb7\xb9t\x99\x0dv\xfd\xf3\xf3*.!S\xe4\x1b\x81+w\x9bBd\x03;\x9b\xf3\xf0\xa1\xd2\xbc\xe1{X\x8d\xef\xd5)\xf8\xd0\xe01J9R\xbd\xfa7\x00PK\x07\x083\xf7a\xe1q\x01\x00\x00t\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8\xccVMo\xe36\x13\xbe\xf3W\x0c\xa2\x83\xdf\x17X\xcbh\x8f\xbe\xb9\x86\xda\x0d\xda|\xc0\xc9n\x0fE\x11\x8c\xc8\x91\xc5\x0d\xc5QI\xca\x8e\xf7\xd7\x17\xa4$KN\xbb\xdd\xa2\x08\xd0\xded\xce\xd73\xcf|9\x83\x8dR\x1e,6\xe4[\x94\x04\x81\x01\x8d\x01G\x9e;'\xc9\xe7\xe2,[\xc3sW\x92D\xe74\xb9\xa5?\xf9@\x8d\x10\x19|D\xd3\x11p\x05\xa1\xd6\x1e*MF\x81\xf6\xd0:j\xc9*R\xd1g\xa8Id}\x98\xa8y\x11\xe2\x1dP\xbe\xcf\x01AQk\xf8\xd4\x90\x0dIS\x89\x0c\xae\x8e\xecT\xeb\xc8\xfb+(Ir\xb4\xbfB\xa3%\xf9\xe5$\xcaE\x06\xb7\x1c\x08B\x8d\x01t\x00_sg\x14\xa0\xf1\x0c\x0d\x06Y\xc3Q\x87:\xa2\x88\xb0*\xfd\x02\xff\x0b\xf4\x12\xa0\xa4\x8a\x1d\xc1b\xb9\xf8\x7f\x84\x15\xe5\xe7tE6\xe4\x82%\x1f\xa8\xe7\xe1>\x19_\x12\xc1-9\x0c\xec\x96Bd\xf0\x13\x96d|\xcc\x18\x95\xfa\x03\x99\x80V\x81'C2\xb0\xf3\xb9\x90\xdc4l{\x9b\xb5\x80\xb9\xdb\\\xf3\xca\xb1\xa15\x8c\xfe\x85(\xd1\x93_\x8b%\xe4\xf9J:\xd5\x7f\xb8\x12e\xff\xd5\xa0\xc5=9\x91\xc1/?\x17\xdf\xbd\xbf\xbb\xfb\xf1Wxd \x8b\xa5!8RY3?\xbf\x83\xce\xc6\xb0\x91\xe4X\x85\x98\xb2'\x194[\xdf\x934\x19\x0fTi+M\xa7\xb4\xdd'e\xb6\x04\xda\x82tj\xf5\xdc\xf9\xc0\x8d\xfe\x8c\xd1:?acz C\xa8\x08d[\xec\x1eo6\xb7\x9b\x1f\x8a\xdd\x1c\x8c$\x17\x96\x03\xde\xd7\x88.\xd1,f\x1e\x169,\x06p\x0b\x90\xdc\xb4l\xc9\x06\x0f\xe8\x08\x1c\xfd\xd6iG*\x1f\xe8!\x17f|\xdc\xef\xeen\x8a\xc7\xf7\xc5\x87\x879\x8a\xd6qC\xa1\xa6\xceC\xc3V\x07\xfe\x1a\x96\xc9\xcd\"\x17Y\n4\xf9\x10\xa2\x8d\x9dF\xfe!8\x0c\xb4\xd7\xf2\x86\xdc\x9eb]3\xb8\xae\xe0\xc4\x1d\x1c\xd1\x86\xf8\xe1@\xb2\x0d\x8e\x8d!7\xf2\x10\xbb\x85^Z\xf6\xb1\x8d	V\x0d\x05\xa7\xa5O\xe6dU\xcb\xda\x068\xae\x18\xd0\x9e\x00\xbbP\xdb\xd5\xe79\xdeX\x9c\x8a\x8d\xe1c,\x95\xd1\x96b\xbb%\xf31\xa5!\xd2S\xb4~j\x1d\xbf\x9c\x9e\x12\xe6T\xbb<\xa9\xdeYsJ5\xe6\xea\xaf\xd5\xcf\xceG\xad\x89\x89\xa7\x01\xfa\xcc\xf98\x91%\x0d\xfd\xa8\x12\x81\x7f\xcbV\xfc\xcb\x1d=\x82\x1c\xe2\xbdF\xf6\xc6-.2\xf8pV\xbe\x10Mf_\x9c\xbf\xd4C}s\xc7v\xd8n@\xdbO=\x17\xd1(\xbe\xa1j\xb4\xf7\xf1a\xc8\xc7\xc7\x90\x97\x81,\x91JKl*W\xfc\xd5y\x02\x89\x93K\xb1\x1c}H<?\xbe\xa2\xe7\xb2+%\xdbJ\xef\xe3u\xa8\xd8A \x94u\xac\xc2\xb8H\x08j>\xc6H\x8a\xe1\x80\x0e|W\xfa\xa0C\x97\x82\x1d\xd0\xf9\xf5\xdb\xef\x94a\xcb\xc5\xbd\x11W\xff\x1a\"\x13\xd7\xdf_o7\x8f\xc5\xd3\xed\xe6\xa6x\xb8\xdfl\x0b\x18\x8eW\xba\x91\xc3\xa5\x88\x01u\xa5%\x06\x82\xedN\x00p\xf9\xc9Q\x15\x07\x1e\xe0Y[\xb5\x86\xed\xa4\x92^\xf7\x8e\xbbv}\x015\xd7\x9cD\x07r\xb1*k8|\x83\xa6\xad\xf1\xdb\xf4\xdaC\xf2\xe4\x0e\xda\xee\x97\xd1\x0e\xb2\xfe\xceF\xc98U\xfd\x89\x9bo\xe7)n_	\xe8o\xd9\x19^\xfa\xd5b\xa8\xd7\xd0P@\x85\x01\xf3\xe9\xf2}\x89\x8a\xffv\x8e#\xea\x87b\xf7\xf1z\xfb\x95\xe2%J%\xfdiF\x0fg\xd9\x05\xe4YA\x86\xb6_N^\xfe	\xbds\xa0o\x04dr\xbd\xdd\x15\x8fo\xe9Y\xf4\xb3\xdb\xb9\xb4m\xd2\xdf\x95\xe54\xb8\xbd0?ac\xc4\xef\x03\x00PK\x07\x08qInk\x86\x03\x00\x00T\n\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\x00_\x00\xa0\xffvarReference:\n  - kind: Deployment\n    group: apps\n    path: spec/template/spec/volumes/secret\n\x03\x00PK\x07\x08\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8d\x90\xbfn\xf30\x0c\xc4w?\x05\x87o\xf8:\x18\xee\x9f\x0c\xad\xb6\"\xcd\x984h\x83\xae\x05+_\x13#\x96(Ht\x8a\xbc}\xa1:6l\x84\x93\xf4\xe3\xf1\x8e\x12\x87\xe6\x0315\xe2\x0dq\x08\xa9:\xdd\x15\xc7\xc6\xd7\x86^\x10Z9;x-\x1c\x94kV6\x05\x91g\x07C\x8e=\xef\x11/\xf7\x14\xd8\xc2P:'\x85+R\x80\xcdJ\x85\x0b-+\xf2\x99h\xa0\xb9\xacx\xe5\xc6#\xa6\x81\x94W\xbe}\x05\x89:\x8a\xb2l\x1c\xddJTCO\x8b\xc5\xc3\xd8\x1d\x96\xfb\xc1\xd7A\xe4X&\xc4\xd3\xc4\x8b(DQ\xb1\xd2\x1a\xda-\xb7#?I\xdb9\xac\xa5\xf3\xf3(\x97\xc9\x96\xf5`\xa8R\x17\xaa\xe3c*\xe7\xd6UNh\xfc\xbe\xb4\x88\x9a&A\xfdc2\x9d\xc0\x08\xae_}{6\xa4\xb1\xc3\xa5\xd1\x87_\xfd\xc3l4\xc1F\xe8 \xc9U\xe3\x9b\xbbV\xd7R\xc3\xd0\xe2\xfev\xd2\xea\xc5\x9b?\x97\x7f\xff\xdfW\xcb\xb7\xd5\xees\xf3\xbc^\xdd\xcc\x96o,J\x8b\xa8\xc5\xef\x00PK\x07\x08\x861cw\x07\x01\x00\x00\x01\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8\xac\x90Ao\xe20\x10\x85\xef\xfe\x15O\xf2\x1e\x96\x83A\xdcV\xb9\xa1(+q`\xb5j\x11=V\x93\xd8$\xd3$6\xb2'\xa0\xf6\xd7W\x01\xd4\xd2R\x0e\x95z\xb4\xe6\xcd\xf8\xfb\x9e\xc6\xba\xe1\x84\x1dI\xd5\x80\xac\x05y\x1f\x84\x84\x83\x87\x04\x90\xed9\xa5\xf1qpe\x13B\x8b*\xf8-\xd7 o\x95\x864\x0e{\x8aLe\xe7\x12~\xfd\xce\x8b\xbb\xf5\xf2\xef2_\xac\x8b\xc7\x7f\x8bUq\xff\x7f\x91\x17\x931\xfc\xc5p\x82\x03w\x1dJ\x874\x94IX\x06q\x16\xe53\xda!I\xe8\xf9\xc5M\x95F\xe1\xc7\xe3\x90\x91r\x1b\"V\xc3H\xe7\xeb\x87\x13\x8f\xa2\x1do\\\x1c\x11\xb3w\xda\xe8jN\x12\x8f\x1a\xd3\xf6O\x9ar\x98\xed\xe7\xa5\x13\x9a\xab\x96\xbd\xcd>\x9f\xc9\x8fV\xc3iC\xf5N\xc8\x92P\xa6\xe0\xa9w\x19\xfas\xda\x9c[0\xd5\x87\xfcEi)S\x00*\x17\xc5\xf4\xe4\xa9vq\xfc\x9b\xfd\x93\xab\xc4Td\xb61\xf4\xd9\xcd\xa6f\xd7\x83\x89\xd20\xc6(\x8do\x9bj\x9c\\7\xd4\xb1\xbdm\xabq\xe1\x0b}V\xde\xbf-\xdd\x92\x86\xbe\xf2\x86\xfeY\xf5\xd7\x01\x00PK\x07\x08PeNS!\x01\x00\x00\x9e\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- manager.yaml\n\x03\x00PK\x07\x08\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8\xa4RMk\xdbL\x10\xbe\xebW\x0c&\x87\xbc\x07\xbd\xb2!\x87v!\x87\x92\x84Pp[\x93\x86\\\xc3x5X\x8bww6\xb3#\x15\xf7\xd7\x97MdGjIi\xe9\\$\xed3\xcf\xc7\xec\x08\x93{ \xc9\x8e\xa3\x01L)7\xc3\xaa\xda\xbb\xd8\x1a\xb8\xa6\xe4\xf9\x10(j\x15H\xb1EES\x01D\x0cd `\xc4\x1d\xc9\xf8\x9d\x13Z2\x90\x0fY)T\x00\x1e\xb7\xe4s\xe9\x06\xb0\x1cU\xd8\xd7\xc9c$\x03\x9cHPY\xaa\x9c\xc8\x96\x8eL\x9e\xac\xb2\x94w\x80\x80j\xbb\xf5\x84\xfe\xb6\x00\x80P\xf2\xceb6\xb0\xaa\x00\x94B\xf2\xa84\nM\"\x03\xcc#\xfd.V\xc1\x8e\xd1Je\x92\xc1Y\xfa`-\xf7Q??\x0f\x9fq\x04\xcbl\xe8\"\xc98+@\x0d.\xe0\x8e\x0c<\xf5x\xf8\xdfq\xb3\xef\xb7dQ\xc4\x914\xc7\xe8\xc6cV\xca:r~\xbd\xd3\x97B\xd9\x9dtK\xd5\xb0\xa8kK\xa2u\xeb\xe4\xf2\xec\xfc\xea\xe6\xee\xfe\xf1\xfa\xe3\xdd\x7f\x8b\x9f\x9a\x86\xcb\xb3\xf3\xf5\x97\xdb\xc7\xf5\xcd\xc3\xcdz\x82R\x1c\xe6zew\x06\x8e:\x13\x08`@\xdf\x93\x81E\xa3!5\xfbw\xb9\xfeF\xdb\x8ey_\x97\x0b!i\xca\xc3\xc5\xdds\x9e\xfcj!\x94\xb9\x17K\xb3\xe0\xde\x05\xa7\xb3\x13\x00\x9bz\x03\x8b\xd5+\xb3T\xa0\xc0r0\xb0Z.?\xb9	\"\xf4\xd4S\xfe\x07\x89\xc42g\x1fg\x17\xc2\xd6E\xca\xb9.-\x13\xcb\xc9r7,j\xe0\xfd\xc5\xc5r\x86'ae\xcb\xde\xc0\xfd\xd5\xe6\x84\x9c\x047\xc2\xdb\xf1_|\xa9N5\xdd\x92N\x8f\x00\x12jg\xa0)\xac\xc3\xf79\xf2\xec\xfaF>\xef\x06\xfak\x93\x8e\xd0k\xf7\xc7.J\x12\\Du\x1co\x05-mH\x1c\xb7_\xc9rl\xb3\x81\xd5\xb2\xfa1\x00PK\x07\x08\xe1$a\x8f\xc3\x01\x00\x00<\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00\x1a\x00\xe5\xffresources:\n- monitor.yaml\n\x03\x00PK\x07\x08*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8|\x8e\xc1J\x04A\x0cD\xef\xfd\x15\x05^\xf40\xbbx\xed\x7fX\x10\x04\xef\xb1'8\x8d\xd3IH\xe2\x82\x7f/\xe3\xb6\xe0io\xa1\xa8\xbcW\xe5\x01/\xae\x83s\xe3\xaf\xc0E\xa5\xa7:^\xd9\xaf\xbd1\x1e/\x9c\xde[<\x15\xb2\xfe\xc6\x1e]\xa5b\xdcZ]>NM\x9d5NM\xc7\xf9\xfa\\>\xbb\xac\xf5\xefy\xb2\xca\xe0\xa4\x95\x92j\x01vz\xe7=\x8e\x0bh*\xe9\xba/\xb6\x93p\x85\x1a;\xa5z\x01\x84\xc6\xbf`\x19\xb7\x0d\xcb\xd4\xceB\x185\xae\x88\xefH\x1e%\x8c\xdb\x81eYM\xbb\xe4t,0\xca\xad\xe2<\x19\xbfb\xc0\xd4\xb3b\xcb\xb4#	\xde\xb9\xa5\xfa\xfdY?\x03\x00PK\x07\x08\xcb1r\x07\xb1\x00\x00\x00)\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00k\x00\x94\xffresources:\n- role.yaml\n- role_binding.yaml\n- leader_election_role.yaml\n- leader_election_role_binding.yaml\n\x03\x00PK\x07\x08	\xa8'\x97r\x00\x00\x00k\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8\x94\x8e\xb1N\xc3@\x0c\x86\xf7{\n\xab\xccI\xc5\x86\xf2\x02\xec\x0c\xec\xee\xddO{\xea\xe5|\xb2}A\xe2\xe9QB\xca\x00B\xa2\xdb'\xdb\xff\xf7\xfb\x81\x1at\xcefY\xaa\x91\x0b%\xa1\x02NPBA\xf4,u\x0c\xdc\xf2+t=\x99HO\x1cG\xee~\x11\xcd\x1f\xbc\xed\xafO6f9.\x8f\xe1\x9ak\x9a\xe8E\n\xc2\x0c\xe7\xc4\xceS \xaa<c\xda\xb5\xc3M;\xe8z\xa6\xbd\xc0\xa60\x10\xb7\xfc\xac\xd2\x9b\xad\x81\x81\x0e\x87@\xa40\xe9\x1a\xb1\xcf\xa2\xd4\xb7|\x9e\xb9Y Z\xa0\xa7}~\x86o\x99\x92\xed\x0b\xde\xd9\xe3e\xa3\xa8`\xc7\x86\xbd\xa5\x1b\xb6\xef}B\x81\xe3\xde\xfa\xa39{\xff\xe3\x8b_=\xff\x92cA\xf5\x1f\xc6\xa8`G\xf8\x1c\x00PK\x07\x08\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8|\xcd\xb1\x8e\xc20\x0c\xc6\xf1\xddO\xe1\x17hO\xb7\x9d\xb2\x1d\x0b{\x91\xd8\xdd\xc4\x80ijWN\xd2\x81\xa7G\x95*\xd8\xbay\xf0\xef\xfb\xd3\"W\xf6\"\xa6\x01}\xa4\xd8S\xab\x0fsyQ\x15\xd3~\xfa+\xbd\xd8\xcf\xfa\x0b\x93h\n8X\xe6\x93h\x12\xbd\xc3\xcc\x95\x12U\n\x80\xa84s\xc0\xcc\x94\xd8;\xce\x1c7\xdd\xb9e\x1e\xf7\xef\xed\x1e\xf8\x16\x00\x91\x169\xbb\xb5\xe5\xa0\x08\x88\xdf\xe0\xe1>\x946>9\xd6\x12\xa0\xdb\xcd\x85}\x95\xc8\xff1Z\xd3\xfa\xd1\x85\xe0=\x00PK\x07\x08+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8\xcc\x96\xcdn\xdb:\x10\x85\xf7|\n\"{9\xb8\xbb\x0bm\xbb\xe8\xbe(\xba\x1f\x91cy`\xfeaf\xa8\xb4}\xfa\x82\xb2\x12\x18q\x95\xa2N-gG\x90\x1e\x9f\xef\x9c!)\x9a\xae\xeb\x0c\x14\xfa\x86,\x94Soy\x00\xb7\x83\xaa\x87\xcc\xf4\x13\x94r\xda\x1d\xff\x97\x1d\xe5\xc7\xe9?s\xa4\xe4{\xfb)TQ\xe4/9\xa0\x89\xa8\xe0A\xa17\xd6:\xc6\xb9\xe0+E\x14\x85Xz\x9bj\x08\xc6\xda\x04\x11{\x1b!\xc1\x88\xdcq+\xe4\x1aPz\xd3Y(\xf4\x99s-\xd2\xfe\xa2\xb3\x0f\x0f\xc6ZF\xc9\x95\x1d.s\x82<\x91Cp.\xd7\xa4b\xac\x9d\x90\x87eqV\xc5\xb9\xd6c\xc0e8\xa2\xceS\x81\xe44(\xa0\xee0\x8fj\xf1\xcf\x05O\xf3\xe4\xdf0\xdc^\x1c|$i\xbd`\x1cI\x94\xcf{p\x19M\xac\nJi|\xc2\xe1\x90\xf3\xd1\xe5\xb4\xa7\xb1\x9e\x8a>\x1a\xeb\x04\x81\xfc=i\x0b\xe1w\xc5\xd4\xc2\x95UJWEs|\xde\x81\x1e\xf7\x94h\xa34K\x91\xcb\x0e{,!\xff\x88\xb8\xc5\xc6w\xc8\xda-\xc7\xf4\xb7-l?\xa0=9\xd0\x0dN\xc2\x1fiH\xa4\"\xdf\x1e$\x17d\xd0\xcc\xbbc\x1d\xd0\x013\xad\xc4\x03\x85\xda]\xf5\x16\xd3\xbb\x12\xb9\x02\xe4Q\x14\xb4\xbe\xe2\x19qE\xfbzE\x07\n\xa5\x06]Q\xba\xad\xdd\x17\xf1\xad\xdcb\xc0\xa9\x91\xdd\xc5\xed\x8b\xf8Vn\xf7\xd8\xd6\xee\xe2u\x91\xde\xca\xe9\xd9F\xbb\x8b\xdds\xfdwz^}\xc8]\xbav\xa7\x07]{\x97\x0d\x94<\xa5q\xfd\xfe\xfaWw\xeaux\xaf\xb8\x1a\xed\x1b_\x1f\x14\x07\x016\xa6\xfd\xa8)n\x18\xdf\xaf\x01\x00PK\x07\x080\x8b\xa1\xcd\xa1\x01\x00\x00U\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8|\x8f\xb1j\xc3@\x0c\x86w=\x85^\xe0\\\xba\x95\xdb\xda\x0e\xdd]\xe8.\x9fUG\xb1O2\xba;\x0fy\xfa`\x08!\x10\x93M\xc3\xa7\xef\xe3\xa7U\xfe\xd8\x8b\x98F\xdc\xdea\x16\x1d#\xfe\xb2o\x92\xf83%kZ!s\xa5\x91*E@T\xca\x1c\xb1\x10\x84\x10\xe0\xf1\xd9\x07J\x1d\xb5z2\x97\x0bU1\xed\xe6\x8f\xd2\x89\xbd\xdd\xb5\xdfK+\x95\xbd\xb7\x85\xbfDG\xd1\xe9@\x9dIib\x0fn\x0b\x0f7j\xbf{\xfe\xdf!Z\xe5\xc7\xad\xad/\x82\x80\xf8\xd4;\xd4Ci\xc3\x99S-\x11\x02\x1e.GT\xca\x1c\xb1\x10\\\x07\x00PK\x07\x08\xc0\xe9E\xce\xa0\x00\x00\x00)\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8\x00S\x00\xac\xffresources:\n- manifests.yaml\n- service.yaml\n\nconfigurations:\n- kustomizeconfig.yaml\n\x03\x00PK\x07\x08\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8\xbc\x92?o\xa4@\x0c\xc5\xfb\xf9\x14O\xda\xfa\xe0\xf6\xaa\x13\xedUW\xa4\xc9JI\xed\x05\x03\x160&\x1e\x0f(\xf9\xf4\x11\xa0H\x9b*[mk=\xbd??\xf9\x04\xef\x19\xad\x8e\xa3\xae\x12;\xd4\x1a[\xe9 	\xad\x1a\x9c\xa9\xee\xb7\xf3\x90\x93\xeb$\x1f\x8c\xb5gc\xb8bT\x1d@\xbe\x1d\"R\xbe&\x17\xcf\xbe\x89\x17\xb2T\x84\x13\xfe;\x8c\xdf\xb2\x18\xa7\x1b\x83\xe5Oq.~C\x0d\x91W\xb6\xcdjU\x1b0\x9b\xcel\xe3{\x11\"M\xfc\xcc-\x1b\xc7\x9a\xab\xf0\x0b\x83\xc4\xa6\xc2\x85m\x91\x9a\x03\xb0\xb0%\xd1Xa9\x07\xa0\x15\x1e\x9b\xcb\xccu\xaa\x02\xf0\xa5~\xcaN[\x9bW\xbe\xf6\xaa\xc3\xbf}W6r\xd1\x18\x00\xa03\xcds\x05j&I\x9b\x9bq'\xc9\x0fA1\xfcM\x85\xe8\xae\x9b\xc9\xfb\n\xeba\x93\xcaz\x14\x8e~\xd8\x95\xe9\xa8Tn\x8do\xb2_h\x94\xe6\xc1\xe9;\xb54\xd3-\xb1\x1f\x18\xdcG\xe0\xde\xfd{v\x00jcr\xae\xe0\x969\xdcM\xe4\x01]\xc2B\xf6\xed\xad\x8ee\x13;5\xe4TR\x8c\xea\xe4\xa21\x85\xcf\x01\x00PK\x07\x08w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8\xcc\x91\xbd\x8e\xdb0\x10\x84{>\xc5\xbe\x00}p\x17\x10\xb8\"1.\xa9\x02\x04\xc1!\xa9\xd7\xf4Z^\x88\"\x89%\xa9\xc0o\x1f\xe8\x87\x8a\x82+\xd4\xb2\x93vfgG\x9f\x94\xd6Za\xe4_$\x89\x837\x80\xb7\x81\xd3\xf4(\xd4q\xca\x82\x99\x83?\xf5\x9f\xd2\x89\xc3\xcbx\xbeR\xc6\xb3\xea\xd9\xdf\x0c|/\x193\xfb\xee7]\x1f!\xf4\x97\xe0\xef\xdc\x95eC\x0d\x94\xf1\x86\x19\x8d\x02\xb0B\xf3\xf0\x9d\x07J\x19\x87h\xc0\x17\xe7\x14\x80\xc7\x81\x0c\x0ck\x90\xfe\xb3$i\xfb_\xd4:MFi\xb0\x8e\xc9\xe7\xe5\xd4\x14\x0d`\xf1K\xf17G\x06.\xdd\xeb\xeb<J$#[Z\xf4z\xa4f\xaf\xe2NK\x11-\x19H\xcf\x94iX\xe7\x11\xf3\xc3\xc0\xcb\xdc\x8ct\x88$\x98\x83\xe8\xbe\\\xc9\xa2\x08\x93h\x0ez<\xa3\x8b\x0f<k\x8c<\xe5\x92(\x80;\xb2+B?\x82c\xfb4\xf0\x15y\xf7\xa5\x9b\xf1\xb4\x8b:qP\x00R\x1c\xa5\xa9\xb3\x06\x8c\xfcMB\x89\xf3+\x80\x86Z\xe0\xe3\x16\xc0\xbf\xbf\xb7\xd9k\xafY_v\xf7\xf2\xe5\xe7\xdb\xe7\xf7\xb7Y\x14J\xa1\x88\xa5mu+\x98\xda\xa5m1c,.\x1f\xc1\xae\xbeVY\xd7~\x0d\xa3&G\xe3d8B]}\xad\xa2\xae\xfd\x1aF}'\x91\xe7\x11\xe7\xd9\xd4*\xe4\xa9\x1cS\xc3\x88w\xf3#\xd0;k\xab\xb8w\xb5\x92\xfa;\x00PK\x07\x08\xcc\xfe_\x88`\x01\x00\x00\xc9\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8,\x8b1\xaa\xc3@\x0cD\xfb=\x85.\xe0\xe2\xf3\xddD\xa7\x08\x04\xd2+\xeb!Yl\xaf\x84$\x1cr\xfb`;\xdd\xbc\xc7\xbc\"\xd6\xee\xf0h\xda\x99\xb6\xbf2\xb7>1\xdd\xe0[\xab(+R&I\xe1B\xd4e\x05\xd3\x1b\x8f\x97\xea<\xc4\xefq\xfa0\xa9`\x8aO$\xd6\x12\x86\xba\x17\xa6\x9e\xb1\x0f\xa2\xe1\x00\xa6q\xfc?\x98(\xc5\x9f\xc8\xeba/\xa7\x0e,\xa8\xa9~&U{\xba.\x83-\xd2\xc1\xa4\x06\x97T/\xdf\x01\x00PK\x07\x08\xe4\xdd>\x05\x80\x00\x00\x00\xb2\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc9\x11f\x07\xd3\x01\x00\x00\xbc\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00certmanager/certificate.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa0\xeb\noM\x00\x00\x00F\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80&\x02\x00\x00certmanager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\x17nH\xc4\x00\x00\x00\xcb\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xc8\x02\x00\x00certmanager/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd7\x00\xab\xc4<\x0c\x00\x00?3\x00\x001\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xe3\x03\x00\x00crd/bases/operator.kubecarrier.io_apiservers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x05C\xa5\x1f`\x07\x00\x007\x1b\x00\x000\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x87\x10\x00\x00crd/bases/operator.kubecarrier.io_catapults.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(saiI\xb7\x06\x00\x00\xa9\x18\x00\x000\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80N\x18\x00\x00crd/bases/operator.kubecarrier.io_elevators.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x91\x84\x8c9\x8a\x07\x00\x00\xb8\x17\x00\x00.\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80l\x1f\x00\x00crd/bases/operator.kubecarrier.io_ferries.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xaeEu\xdc>\x0d\x00\x00%;\x00\x003\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80['\x00\x00crd/bases/operator.kubecarrier.io_kubecarriers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd1\x91\xefR\xb9\x01\x00\x00[\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x035\x00\x00crd/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf6]\x02\x05\xef\x00\x00\x00\xda\x01\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80	7\x00\x00crd/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(k\x90O \xf1\x00\x00\x00X\x01\x00\x00,\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80G8\x00\x00crd/patches/cainjection_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(3\xf7a\xe1q\x01\x00\x00t\x02\x00\x00(\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x9b9\x00\x00crd/patches/webhook_in_kubecarriers.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(qInk\x86\x03\x00\x00T\n\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80k;\x00\x00default/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbd\x00\xa3\xc7f\x00\x00\x00_\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80B?\x00\x00default/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x861cw\x07\x01\x00\x00\x01\x02\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xfb?\x00\x00default/manager_webhook_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(PeNS!\x01\x00\x00\x9e\x02\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80[A\x00\x00default/webhookcainjection_patch.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc,\x82b!\x00\x00\x00\x1a\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xd8B\x00\x00manager/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe1$a\x8f\xc3\x01\x00\x00<\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80JC\x00\x00manager/manager.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(*\xdd\xe9\x9c!\x00\x00\x00\x1a\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80XE\x00\x00prometheus/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcb1r\x07\xb1\x00\x00\x00)\x01\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xcdE\x00\x00prometheus/monitor.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(	\xa8'\x97r\x00\x00\x00k\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xccF\x00\x00rbac/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0e\xcb\x95\x88\xc4\x00\x00\x00\xa3\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x8cG\x00\x00rbac/leader_election_role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(+n\xda1\x8f\x00\x00\x00\xee\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\xa5H\x00\x00rbac/leader_election_role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(0\x8b\xa1\xcd\xa1\x01\x00\x00U\x0c\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x91I\x00\x00rbac/role.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xc0\xe9E\xce\xa0\x00\x00\x00)\x01\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80wK\x00\x00rbac/role_binding.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd6\xd7\xcd\xabZ\x00\x00\x00S\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80dL\x00\x00webhook/kustomization.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(w\x1d~\x1e\x1d\x01\x00\x00\x17\x03\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x0fM\x00\x00webhook/kustomizeconfig.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcc\xfe_\x88`\x01\x00\x00\xc9\x07\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80\x7fN\x00\x00webhook/manifests.yamlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xe4\xdd>\x05\x80\x00\x00\x00\xb2\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x80,P\x00\x00webhook/service.yamlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x1d\x00\x1d\x00\xc7	\x00\x00\xf7P\x00\x00\x00\x00"
 	fs.Register(data)
 }
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/yamux"
+)
+
+const (
+	agentDialTimeout       = 30 * time.Second
+	agentMinReconnectDelay = time.Second
+	agentMaxReconnectDelay = time.Minute
+)
+
+// Agent connects to a tunnel Server and serves the requests it receives through the tunnel.
+type Agent struct {
+	Log logr.Logger
+	// URL of the tunnel Server.
+	URL string
+	// Token to authenticate at the tunnel Server.
+	Token string
+	// TLSConfig is used for https URLs.
+	TLSConfig *tls.Config
+	// Handler serves requests received through the tunnel.
+	Handler http.Handler
+}
+
+// Run connects to the tunnel Server and reconnects with an increasing delay until stop is closed.
+func (a *Agent) Run(stop <-chan struct{}) error {
+	u, err := a.parseURL()
+	if err != nil {
+		return err
+	}
+
+	delay := agentMinReconnectDelay
+	for {
+		start := time.Now()
+		err := a.serve(u, stop)
+		select {
+		case <-stop:
+			return nil
+		default:
+		}
+		if time.Since(start) > agentMaxReconnectDelay {
+			// the connection was established for a while, so reconnect quickly.
+			delay = agentMinReconnectDelay
+		}
+		a.Log.Error(err, "tunnel connection lost, reconnecting", "delay", delay.String())
+
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > agentMaxReconnectDelay {
+			delay = agentMaxReconnectDelay
+		}
+	}
+}
+
+func (a *Agent) parseURL() (*url.URL, error) {
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing tunnel url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("tunnel url scheme must be http or https, found: %q", u.Scheme)
+	}
+	return u, nil
+}
+
+func (a *Agent) serve(u *url.URL, stop <-chan struct{}) error {
+	conn, err := a.dial(u)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("creating connect request: %w", err)
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", UpgradeProtocol)
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return fmt.Errorf("writing connect request: %w", err)
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("reading connect response: %w", err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		conn.Close()
+		return fmt.Errorf("connecting to tunnel: unexpected status %s", resp.Status)
+	}
+
+	// the agent accepts streams opened by the tunnel Server, so it's the server side of the session.
+	session, err := yamux.Server(&bufferedConn{Conn: conn, r: br}, nil)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("creating tunnel session: %w", err)
+	}
+	a.Log.Info("connected to tunnel", "url", u.String())
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-stop:
+			session.Close()
+		case <-done:
+		}
+	}()
+
+	server := &http.Server{Handler: a.Handler}
+	if err := server.Serve(session); err != nil {
+		return fmt.Errorf("serving tunnel: %w", err)
+	}
+	return nil
+}
+
+func (a *Agent) dial(u *url.URL) (net.Conn, error) {
+	host := u.Host
+	if u.Port() == "" {
+		port := "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+		host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	dialer := &net.Dialer{Timeout: agentDialTimeout}
+	if u.Scheme == "http" {
+		conn, err := dialer.Dial("tcp", host)
+		if err != nil {
+			return nil, fmt.Errorf("dialing tunnel: %w", err)
+		}
+		return conn, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if a.TLSConfig != nil {
+		tlsConfig = a.TLSConfig.Clone()
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = u.Hostname()
+	}
+	conn, err := tls.DialWithDialer(dialer, "tcp", host, tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("dialing tunnel: %w", err)
+	}
+	return conn, nil
+}
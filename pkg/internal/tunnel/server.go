@@ -0,0 +1,199 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tunnel implements a reverse tunnel between KubeCarrier and an agent running in a ServiceCluster.
+//
+// The agent dials out to the tunnel Server and upgrades the HTTP connection,
+// afterwards the connection is multiplexed and the Server opens a new stream for every connection to the ServiceCluster.
+package tunnel
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/yamux"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// UpgradeProtocol is the protocol requested by the agent via the HTTP Upgrade header.
+	UpgradeProtocol = "kubecarrier-tunnel"
+	// ConnectPath is the path the agent connects to.
+	ConnectPath = "/connect"
+
+	// agentHost is used as host for requests through the tunnel,
+	// the actual destination is determined by the agent.
+	agentHost = "agent"
+)
+
+// ErrAgentNotConnected is returned when no agent is connected to the tunnel.
+var ErrAgentNotConnected = errors.New("agent is not connected")
+
+// Server accepts connections from an agent and provides access to the ServiceCluster through it.
+type Server struct {
+	Log logr.Logger
+	// Token the agent has to present to connect.
+	Token string
+
+	mu      sync.RWMutex
+	session *yamux.Session
+}
+
+var _ http.Handler = (*Server)(nil)
+
+// ServeHTTP handles connection requests of the agent.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.EqualFold(req.Header.Get("Upgrade"), UpgradeProtocol) {
+		http.Error(w, fmt.Sprintf("expected upgrade to %s", UpgradeProtocol), http.StatusBadRequest)
+		return
+	}
+	if !validBearerToken(req, s.Token) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "connection cannot be upgraded", http.StatusInternalServerError)
+		return
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		s.Log.Error(err, "hijacking agent connection")
+		return
+	}
+	if _, err := fmt.Fprintf(rw, "HTTP/1.1 %d %s\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n",
+		http.StatusSwitchingProtocols, http.StatusText(http.StatusSwitchingProtocols), UpgradeProtocol); err != nil {
+		s.Log.Error(err, "writing upgrade response")
+		conn.Close()
+		return
+	}
+	if err := rw.Flush(); err != nil {
+		s.Log.Error(err, "writing upgrade response")
+		conn.Close()
+		return
+	}
+
+	// the Server opens streams to the agent, so it's the client side of the session.
+	session, err := yamux.Client(&bufferedConn{Conn: conn, r: rw.Reader}, nil)
+	if err != nil {
+		s.Log.Error(err, "creating tunnel session")
+		conn.Close()
+		return
+	}
+	s.Log.Info("agent connected", "remoteAddr", conn.RemoteAddr().String())
+
+	s.mu.Lock()
+	if s.session != nil {
+		// only the latest agent connection is used
+		s.session.Close()
+	}
+	s.session = session
+	s.mu.Unlock()
+}
+
+// DialContext opens a new connection to the ServiceCluster through the agent.
+func (s *Server) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	session, err := s.currentSession()
+	if err != nil {
+		return nil, err
+	}
+	return session.Open()
+}
+
+// Healthy checks that an agent is connected and responding.
+func (s *Server) Healthy() error {
+	session, err := s.currentSession()
+	if err != nil {
+		return err
+	}
+	if _, err := session.Ping(); err != nil {
+		return fmt.Errorf("pinging agent: %w", err)
+	}
+	return nil
+}
+
+// RESTConfig returns a rest.Config for the ServiceCluster API Server through the tunnel.
+// The agent authenticates the requests, so no credentials are configured.
+func (s *Server) RESTConfig() *rest.Config {
+	return &rest.Config{
+		Host: "http://" + agentHost,
+		Dial: s.DialContext,
+	}
+}
+
+// ProxyHandler returns a http.Handler that forwards requests through the tunnel.
+// Clients have to present the given token to be allowed to use the proxy.
+func (s *Server) ProxyHandler(token string) http.Handler {
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = "http"
+			req.URL.Host = agentHost
+			req.Header.Del("Authorization")
+		},
+		Transport: &http.Transport{
+			DialContext: s.DialContext,
+		},
+		// flush immediately to support watches.
+		FlushInterval: -1,
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !validBearerToken(req, token) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		proxy.ServeHTTP(w, req)
+	})
+}
+
+func (s *Server) currentSession() (*yamux.Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.session == nil || s.session.IsClosed() {
+		return nil, ErrAgentNotConnected
+	}
+	return s.session, nil
+}
+
+func validBearerToken(req *http.Request, token string) bool {
+	if token == "" {
+		return false
+	}
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(token)) == 1
+}
+
+// bufferedConn reads through the buffer, that may already contain data read from the connection.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
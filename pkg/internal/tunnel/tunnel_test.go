@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"k8c.io/utils/pkg/testutil"
+)
+
+func TestTunnel(t *testing.T) {
+	server := &Server{
+		Log:   testutil.NewLogger(t),
+		Token: "agent-token",
+	}
+	mux := http.NewServeMux()
+	mux.Handle(ConnectPath, server)
+	tunnelServer := httptest.NewServer(mux)
+	defer tunnelServer.Close()
+
+	assert.Equal(t, ErrAgentNotConnected, server.Healthy())
+
+	t.Run("invalid token", func(t *testing.T) {
+		agent := &Agent{
+			Log:   testutil.NewLogger(t),
+			URL:   tunnelServer.URL + ConnectPath,
+			Token: "wrong",
+		}
+		u, err := agent.parseURL()
+		require.NoError(t, err)
+		err = agent.serve(u, make(chan struct{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "401")
+	})
+
+	// the agent serves requests received through the tunnel.
+	agent := &Agent{
+		Log:   testutil.NewLogger(t),
+		URL:   tunnelServer.URL + ConnectPath,
+		Token: "agent-token",
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			fmt.Fprintf(w, "%s %s auth=%q", req.Method, req.URL.Path, req.Header.Get("Authorization"))
+		}),
+	}
+	stop := make(chan struct{})
+	agentDone := make(chan error)
+	go func() {
+		agentDone <- agent.Run(stop)
+	}()
+	require.NoError(t, wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return server.Healthy() == nil, nil
+	}))
+
+	proxy := httptest.NewServer(server.ProxyHandler("proxy-token"))
+	defer proxy.Close()
+
+	t.Run("proxy requires token", func(t *testing.T) {
+		resp, err := http.Get(proxy.URL + "/api")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, proxy.URL+"/api", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer proxy-token")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		// credentials of the proxy are not forwarded to the agent.
+		assert.Equal(t, `GET /api auth=""`, string(body))
+	})
+
+	close(stop)
+	require.NoError(t, <-agentDone)
+	require.NoError(t, wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return server.Healthy() == ErrAgentNotConnected, nil
+	}))
+}
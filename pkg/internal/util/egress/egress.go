@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package egress guards calls to endpoints configured by Providers,
+// so they can't reach the host or the network of the cluster the caller runs in.
+package egress
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+// internalNetworks are networks that are reserved for hosts, cluster and other private networks.
+var internalNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // this network
+	"10.0.0.0/8",     // private
+	"100.64.0.0/10",  // carrier grade NAT
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local, e.g. cloud metadata services
+	"172.16.0.0/12",  // private
+	"192.168.0.0/16", // private
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
+)
+
+// internalHostSuffixes are DNS suffixes of names, that resolve to the host or cluster.
+var internalHostSuffixes = []string{
+	".localhost",
+	".local",
+	".svc",
+	".internal",
+}
+
+// IsInternalIP returns true, if the IP is not a public unicast address.
+func IsInternalIP(ip net.IP) bool {
+	if !ip.IsGlobalUnicast() {
+		return true
+	}
+	for _, network := range internalNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateURL checks that the given URL is a https URL with a public host.
+// IP addresses of the host are checked, when connecting, see DialControl.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%q should be a https URL", rawURL)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if ip := net.ParseIP(host); ip != nil {
+		if IsInternalIP(ip) {
+			return fmt.Errorf("host of %q is an internal address", rawURL)
+		}
+		return nil
+	}
+	// single label names are resolved within the search domains of the cluster
+	if !strings.Contains(host, ".") {
+		return fmt.Errorf("host of %q should be a fully qualified domain name", rawURL)
+	}
+	for _, suffix := range internalHostSuffixes {
+		if strings.HasSuffix("."+host, suffix) {
+			return fmt.Errorf("host of %q is an internal name", rawURL)
+		}
+	}
+	return nil
+}
+
+// DialControl rejects connections to internal IPs, it's used as net.Dialer.Control.
+// Checking the dialed address covers names that resolve to internal IPs.
+func DialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("dialing %s: not an IP address", address)
+	}
+	if IsInternalIP(ip) {
+		return fmt.Errorf("dialing %s: internal addresses are not allowed", address)
+	}
+	return nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package egress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://backup.example.com/hooks/pre", valid: true},
+		{url: "https://203.0.113.10:8443/hook", valid: true},
+		{url: "http://backup.example.com/hooks/pre"},
+		{url: "file:///backup"},
+		{url: "https://"},
+		{url: "https://localhost/hook"},
+		{url: "https://kubernetes/api"},
+		{url: "https://kubernetes.default.svc/api"},
+		{url: "https://backup.team-a.svc.cluster.local/hook"},
+		{url: "https://metadata.google.internal/computeMetadata/v1"},
+		{url: "https://127.0.0.1/hook"},
+		{url: "https://169.254.169.254/latest/meta-data"},
+		{url: "https://10.96.0.1/api"},
+		{url: "https://[::1]/hook"},
+		{url: "https://[fd00::1]/hook"},
+		{url: "https://0.0.0.0/hook"},
+	}
+	for _, test := range tests {
+		t.Run(test.url, func(t *testing.T) {
+			err := ValidateURL(test.url)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDialControl(t *testing.T) {
+	assert.NoError(t, DialControl("tcp", "203.0.113.10:443", nil))
+	assert.Error(t, DialControl("tcp", "127.0.0.1:443", nil))
+	assert.Error(t, DialControl("tcp", "169.254.169.254:80", nil))
+	assert.Error(t, DialControl("tcp", "192.168.1.1:443", nil))
+	assert.Error(t, DialControl("tcp6", "[fe80::1]:443", nil))
+	assert.Error(t, DialControl("tcp6", "[::ffff:10.0.0.1]:443", nil))
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fieldpath implements the JSONPath subset that is used to select fields of DerivedCustomResources.
+//
+// A path is made up of object keys and array selectors, e.g.:
+//   .spec.containers[*].image
+//   .status.endpoints[0].host
+//   .metadata.annotations['kubecarrier.io/some.key']
+package fieldpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SegmentType describes how a Segment selects values.
+type SegmentType int
+
+const (
+	// FieldSegment selects a key of an object.
+	FieldSegment SegmentType = iota
+	// IndexSegment selects a single element of an array.
+	IndexSegment
+	// WildcardSegment selects all elements of an array.
+	WildcardSegment
+)
+
+// Segment is a single step of a Path.
+type Segment struct {
+	Type  SegmentType
+	Field string
+	Index int
+}
+
+// Path is a parsed field path.
+type Path []Segment
+
+// Parse parses a field path like `.spec.containers[*].image`.
+// The leading dot is optional.
+func Parse(jsonPath string) (Path, error) {
+	s := strings.TrimPrefix(jsonPath, ".")
+	if s == "" {
+		return nil, fmt.Errorf("invalid path %q: empty path", jsonPath)
+	}
+
+	var path Path
+	for i := 0; i < len(s); {
+		if s[i] == '[' {
+			end := strings.IndexByte(s[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", jsonPath)
+			}
+			segment, err := parseBracket(s[i+1 : i+end])
+			if err != nil {
+				// quoted keys might contain a ], so look for the closing quote first
+				if len(s) > i+1 && (s[i+1] == '\'' || s[i+1] == '"') {
+					segment, end, err = parseQuoted(s[i:])
+				}
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: %w", jsonPath, err)
+				}
+			}
+			path = append(path, segment)
+			i += end + 1
+		} else {
+			end := strings.IndexAny(s[i:], ".[")
+			if end == -1 {
+				end = len(s) - i
+			}
+			field := s[i : i+end]
+			if field == "" {
+				return nil, fmt.Errorf("invalid path %q: empty field name", jsonPath)
+			}
+			if field == "*" {
+				return nil, fmt.Errorf("invalid path %q: wildcards are only supported for arrays, use [*]", jsonPath)
+			}
+			path = append(path, Segment{Type: FieldSegment, Field: field})
+			i += end
+		}
+
+		if i < len(s) && s[i] == '.' {
+			i++
+			if i == len(s) {
+				// tolerate a trailing dot
+				break
+			}
+		} else if i < len(s) && s[i] != '[' {
+			return nil, fmt.Errorf("invalid path %q: unexpected character %q", jsonPath, s[i])
+		}
+	}
+	return path, nil
+}
+
+func parseBracket(in string) (Segment, error) {
+	if in == "*" {
+		return Segment{Type: WildcardSegment}, nil
+	}
+	if len(in) >= 2 && (in[0] == '\'' || in[0] == '"') && in[len(in)-1] == in[0] {
+		return Segment{Type: FieldSegment, Field: in[1 : len(in)-1]}, nil
+	}
+	index, err := strconv.Atoi(in)
+	if err != nil || index < 0 {
+		return Segment{}, fmt.Errorf("invalid array selector [%s], should be [*] or a non-negative index", in)
+	}
+	return Segment{Type: IndexSegment, Index: index}, nil
+}
+
+// parseQuoted parses a quoted key like ['a]b'] and returns the position of the closing bracket.
+func parseQuoted(s string) (Segment, int, error) {
+	quote := s[1]
+	end := strings.IndexByte(s[2:], quote)
+	if end == -1 || len(s) <= end+3 || s[end+3] != ']' {
+		return Segment{}, 0, fmt.Errorf("unterminated quoted key")
+	}
+	return Segment{Type: FieldSegment, Field: s[2 : end+2]}, end + 3, nil
+}
+
+// String returns the canonical representation of the path.
+func (p Path) String() string {
+	var b strings.Builder
+	for _, segment := range p {
+		switch segment.Type {
+		case FieldSegment:
+			if strings.ContainsAny(segment.Field, ".[]'\"") {
+				quote := "'"
+				if strings.Contains(segment.Field, "'") {
+					quote = `"`
+				}
+				b.WriteString("[" + quote + segment.Field + quote + "]")
+			} else {
+				b.WriteString("." + segment.Field)
+			}
+		case IndexSegment:
+			b.WriteString("[" + strconv.Itoa(segment.Index) + "]")
+		case WildcardSegment:
+			b.WriteString("[*]")
+		}
+	}
+	return b.String()
+}
+
+// Covers returns true if every value selected by other is also selected by p,
+// i.e. p is a prefix of other, while a wildcard in p matches every array selector.
+func (p Path) Covers(other Path) bool {
+	if len(p) > len(other) {
+		return false
+	}
+	for i, segment := range p {
+		o := other[i]
+		switch segment.Type {
+		case FieldSegment:
+			if o.Type != FieldSegment || o.Field != segment.Field {
+				return false
+			}
+		case IndexSegment:
+			if o.Type != IndexSegment || o.Index != segment.Index {
+				return false
+			}
+		case WildcardSegment:
+			if o.Type == FieldSegment {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Copy copies the values selected by the path from src to dest.
+// Missing objects and array elements are created in dest, values that don't exist in src are skipped.
+// A wildcard resizes the array in dest to the length of the array in src.
+func Copy(src, dest map[string]interface{}, path Path) error {
+	_, err := copyValue(src, dest, path, nil)
+	return err
+}
+
+func copyValue(src, dest interface{}, path, parent Path) (interface{}, error) {
+	if len(path) == 0 {
+		return runtime.DeepCopyJSONValue(src), nil
+	}
+	segment, current := path[0], append(parent, path[0])
+	switch segment.Type {
+	case FieldSegment:
+		srcMap, ok := src.(map[string]interface{})
+		if !ok {
+			if src == nil {
+				return dest, nil
+			}
+			return nil, fmt.Errorf("%s: expected object in source, got %T", current, src)
+		}
+		srcValue, ok := srcMap[segment.Field]
+		if !ok {
+			return dest, nil
+		}
+		destMap, ok := dest.(map[string]interface{})
+		if !ok {
+			if dest != nil {
+				return nil, fmt.Errorf("%s: expected object in destination, got %T", current, dest)
+			}
+			destMap = map[string]interface{}{}
+		}
+		value, err := copyValue(srcValue, destMap[segment.Field], path[1:], current)
+		if err != nil {
+			return nil, err
+		}
+		destMap[segment.Field] = value
+		return destMap, nil
+
+	case IndexSegment, WildcardSegment:
+		srcList, ok := src.([]interface{})
+		if !ok {
+			if src == nil {
+				return dest, nil
+			}
+			return nil, fmt.Errorf("%s: expected array in source, got %T", current, src)
+		}
+		destList, ok := dest.([]interface{})
+		if !ok && dest != nil {
+			return nil, fmt.Errorf("%s: expected array in destination, got %T", current, dest)
+		}
+
+		if segment.Type == IndexSegment {
+			if segment.Index >= len(srcList) {
+				return dest, nil
+			}
+			for len(destList) <= segment.Index {
+				destList = append(destList, nil)
+			}
+			value, err := copyValue(srcList[segment.Index], destList[segment.Index], path[1:], current)
+			if err != nil {
+				return nil, err
+			}
+			destList[segment.Index] = value
+			return destList, nil
+		}
+
+		if len(destList) > len(srcList) {
+			destList = destList[:len(srcList)]
+		}
+		for len(destList) < len(srcList) {
+			destList = append(destList, nil)
+		}
+		for i := range srcList {
+			value, err := copyValue(srcList[i], destList[i], path[1:], current)
+			if err != nil {
+				return nil, err
+			}
+			destList[i] = value
+		}
+		return destList, nil
+	}
+	return nil, fmt.Errorf("%s: unknown segment type %d", current, segment.Type)
+}
+
+// HasWildcard returns true if the path contains a wildcard array selector.
+func (p Path) HasWildcard() bool {
+	for _, segment := range p {
+		if segment.Type == WildcardSegment {
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the value selected by the path.
+// Wildcards are not supported, as they select multiple values.
+func Get(obj map[string]interface{}, path Path) (value interface{}, found bool, err error) {
+	value = obj
+	for i, segment := range path {
+		current := path[:i+1]
+		switch segment.Type {
+		case FieldSegment:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				if value == nil {
+					return nil, false, nil
+				}
+				return nil, false, fmt.Errorf("%s: expected object, got %T", current, value)
+			}
+			if value, ok = m[segment.Field]; !ok {
+				return nil, false, nil
+			}
+
+		case IndexSegment:
+			l, ok := value.([]interface{})
+			if !ok {
+				if value == nil {
+					return nil, false, nil
+				}
+				return nil, false, fmt.Errorf("%s: expected array, got %T", current, value)
+			}
+			if segment.Index >= len(l) {
+				return nil, false, nil
+			}
+			value = l[segment.Index]
+
+		default:
+			return nil, false, fmt.Errorf("%s: wildcards are not supported", current)
+		}
+	}
+	return runtime.DeepCopyJSONValue(value), true, nil
+}
+
+// Set sets the value selected by the path.
+// Missing objects and array elements are created, wildcards are not supported.
+func Set(obj map[string]interface{}, path Path, value interface{}) error {
+	if len(path) == 0 {
+		return fmt.Errorf("can't set an empty path")
+	}
+	_, err := setValue(obj, path, nil, value)
+	return err
+}
+
+func setValue(dest interface{}, path, parent Path, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return runtime.DeepCopyJSONValue(value), nil
+	}
+	segment, current := path[0], append(parent, path[0])
+	switch segment.Type {
+	case FieldSegment:
+		destMap, ok := dest.(map[string]interface{})
+		if !ok {
+			if dest != nil {
+				return nil, fmt.Errorf("%s: expected object, got %T", current, dest)
+			}
+			destMap = map[string]interface{}{}
+		}
+		v, err := setValue(destMap[segment.Field], path[1:], current, value)
+		if err != nil {
+			return nil, err
+		}
+		destMap[segment.Field] = v
+		return destMap, nil
+
+	case IndexSegment:
+		destList, ok := dest.([]interface{})
+		if !ok && dest != nil {
+			return nil, fmt.Errorf("%s: expected array, got %T", current, dest)
+		}
+		for len(destList) <= segment.Index {
+			destList = append(destList, nil)
+		}
+		v, err := setValue(destList[segment.Index], path[1:], current, value)
+		if err != nil {
+			return nil, err
+		}
+		destList[segment.Index] = v
+		return destList, nil
+	}
+	return nil, fmt.Errorf("%s: wildcards are not supported", current)
+}
+
+// ValidateSchema checks that the path selects a field that is defined in the given schema.
+func ValidateSchema(path Path, schema *apiextensionsv1.JSONSchemaProps) error {
+	_, err := SchemaAt(path, schema)
+	return err
+}
+
+// SchemaAt returns the schema of the field selected by the path.
+// Fields below a schema that preserves unknown fields get a schema that preserves unknown fields as well.
+func SchemaAt(path Path, schema *apiextensionsv1.JSONSchemaProps) (*apiextensionsv1.JSONSchemaProps, error) {
+	preserveUnknownFields := true
+	unknown := &apiextensionsv1.JSONSchemaProps{XPreserveUnknownFields: &preserveUnknownFields}
+	for i, segment := range path {
+		if schema.XPreserveUnknownFields != nil && *schema.XPreserveUnknownFields {
+			// everything below is allowed
+			return unknown, nil
+		}
+
+		switch segment.Type {
+		case FieldSegment:
+			if properties, ok := schema.Properties[segment.Field]; ok {
+				schema = &properties
+				continue
+			}
+			if schema.AdditionalProperties != nil {
+				if schema.AdditionalProperties.Schema != nil {
+					schema = schema.AdditionalProperties.Schema
+					continue
+				}
+				if schema.AdditionalProperties.Allows {
+					return unknown, nil
+				}
+			}
+			return nil, fmt.Errorf("%s: field is not defined in the schema", path[:i+1])
+
+		case IndexSegment, WildcardSegment:
+			if schema.Type != "array" || schema.Items == nil || schema.Items.Schema == nil {
+				return nil, fmt.Errorf("%s: array selector on a field that is not an array", path[:i+1])
+			}
+			schema = schema.Items.Schema
+		}
+	}
+	return schema, nil
+}
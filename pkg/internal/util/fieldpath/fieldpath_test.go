@@ -0,0 +1,343 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		jsonPath      string
+		expected      Path
+		expectedError bool
+	}{
+		{
+			name:     "fields",
+			jsonPath: ".spec.field",
+			expected: Path{{Field: "spec"}, {Field: "field"}},
+		},
+		{
+			name:     "without leading dot",
+			jsonPath: "status.conditions",
+			expected: Path{{Field: "status"}, {Field: "conditions"}},
+		},
+		{
+			name:     "wildcard",
+			jsonPath: ".spec.containers[*].image",
+			expected: Path{{Field: "spec"}, {Field: "containers"}, {Type: WildcardSegment}, {Field: "image"}},
+		},
+		{
+			name:     "index",
+			jsonPath: ".status.endpoints[0].host",
+			expected: Path{{Field: "status"}, {Field: "endpoints"}, {Type: IndexSegment, Index: 0}, {Field: "host"}},
+		},
+		{
+			name:     "nested arrays",
+			jsonPath: ".spec.matrix[1][*]",
+			expected: Path{{Field: "spec"}, {Field: "matrix"}, {Type: IndexSegment, Index: 1}, {Type: WildcardSegment}},
+		},
+		{
+			name:     "quoted keys",
+			jsonPath: `.metadata.annotations['kubecarrier.io/some.key']["a]b"]`,
+			expected: Path{{Field: "metadata"}, {Field: "annotations"}, {Field: "kubecarrier.io/some.key"}, {Field: "a]b"}},
+		},
+		{
+			name:          "empty",
+			jsonPath:      ".",
+			expectedError: true,
+		},
+		{
+			name:          "empty field",
+			jsonPath:      ".spec..field",
+			expectedError: true,
+		},
+		{
+			name:          "negative index",
+			jsonPath:      ".spec.list[-1]",
+			expectedError: true,
+		},
+		{
+			name:          "unterminated bracket",
+			jsonPath:      ".spec.list[0",
+			expectedError: true,
+		},
+		{
+			name:          "object wildcard",
+			jsonPath:      ".spec.*",
+			expectedError: true,
+		},
+		{
+			name:          "garbage after bracket",
+			jsonPath:      ".spec.list[0]x",
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := Parse(test.jsonPath)
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, path)
+		})
+	}
+}
+
+func TestPathString(t *testing.T) {
+	for _, jsonPath := range []string{
+		".spec.field",
+		".spec.containers[*].image",
+		".status.endpoints[0].host",
+		".metadata.annotations['kubecarrier.io/some.key']",
+	} {
+		path, err := Parse(jsonPath)
+		require.NoError(t, err)
+		assert.Equal(t, jsonPath, path.String())
+	}
+}
+
+func TestCovers(t *testing.T) {
+	tests := []struct {
+		path, other string
+		expected    bool
+	}{
+		{".spec", ".spec.field", true},
+		{".spec.field", ".spec.field", true},
+		{".spec.foo", ".spec.foobar", false},
+		{".spec.list[*]", ".spec.list[3].name", true},
+		{".spec.list[*].name", ".spec.list[*].name", true},
+		{".spec.list[1]", ".spec.list[1].name", true},
+		{".spec.list[1]", ".spec.list[2].name", false},
+		{".spec.list[1]", ".spec.list[*].name", false},
+		{".spec.field.sub", ".spec.field", false},
+	}
+	for _, test := range tests {
+		path, err := Parse(test.path)
+		require.NoError(t, err)
+		other, err := Parse(test.other)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, path.Covers(other), "%s covers %s", test.path, test.other)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonPath string
+		src      map[string]interface{}
+		dest     map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "field",
+			jsonPath: ".spec.field1",
+			src: map[string]interface{}{
+				"spec": map[string]interface{}{"field1": "a", "field2": "b"},
+			},
+			dest: map[string]interface{}{},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"field1": "a"},
+			},
+		},
+		{
+			name:     "missing in source",
+			jsonPath: ".spec.field1",
+			src:      map[string]interface{}{},
+			dest: map[string]interface{}{
+				"spec": map[string]interface{}{"field1": "old"},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"field1": "old"},
+			},
+		},
+		{
+			name:     "wildcard keeps other fields of the elements",
+			jsonPath: ".spec.containers[*].image",
+			src: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"image": "nginx:2", "hidden": "tenant"},
+						map[string]interface{}{"image": "redis:6"},
+					},
+				},
+			},
+			dest: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"image": "nginx:1", "hidden": "provider"},
+						map[string]interface{}{"image": "redis:5"},
+						map[string]interface{}{"image": "removed"},
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"image": "nginx:2", "hidden": "provider"},
+						map[string]interface{}{"image": "redis:6"},
+					},
+				},
+			},
+		},
+		{
+			name:     "index",
+			jsonPath: ".status.endpoints[1].host",
+			src: map[string]interface{}{
+				"status": map[string]interface{}{
+					"endpoints": []interface{}{
+						map[string]interface{}{"host": "a"},
+						map[string]interface{}{"host": "b", "port": int64(80)},
+					},
+				},
+			},
+			dest: map[string]interface{}{},
+			expected: map[string]interface{}{
+				"status": map[string]interface{}{
+					"endpoints": []interface{}{
+						nil,
+						map[string]interface{}{"host": "b"},
+					},
+				},
+			},
+		},
+		{
+			name:     "quoted key",
+			jsonPath: ".metadata.annotations['kubecarrier.io/some.key']",
+			src: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{"kubecarrier.io/some.key": "value", "other": "value"},
+				},
+			},
+			dest: map[string]interface{}{},
+			expected: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{"kubecarrier.io/some.key": "value"},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := Parse(test.jsonPath)
+			require.NoError(t, err)
+			require.NoError(t, Copy(test.src, test.dest, path))
+			assert.Equal(t, test.expected, test.dest)
+		})
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	obj := map[string]interface{}{
+		"spec": map[string]interface{}{"size": int64(10)},
+	}
+
+	src, err := Parse(".spec.size")
+	require.NoError(t, err)
+	dest, err := Parse(".spec.storage.volumes[1].sizeGB")
+	require.NoError(t, err)
+
+	value, found, err := Get(obj, src)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.NoError(t, Set(obj, dest, value))
+	assert.Equal(t, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"size": int64(10),
+			"storage": map[string]interface{}{
+				"volumes": []interface{}{
+					nil,
+					map[string]interface{}{"sizeGB": int64(10)},
+				},
+			},
+		},
+	}, obj)
+
+	_, found, err = Get(obj, Path{{Type: FieldSegment, Field: "status"}})
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	wildcard, err := Parse(".spec.storage.volumes[*].sizeGB")
+	require.NoError(t, err)
+	_, _, err = Get(obj, wildcard)
+	assert.EqualError(t, err, ".spec.storage.volumes[*]: wildcards are not supported")
+	assert.EqualError(t, Set(obj, wildcard, int64(1)), ".spec.storage.volumes[*]: wildcards are not supported")
+
+	invalid, err := Parse(".spec.size.value")
+	require.NoError(t, err)
+	assert.EqualError(t, Set(obj, invalid, int64(1)), ".spec.size.value: expected object, got int64")
+}
+
+func TestValidateSchema(t *testing.T) {
+	schema := &apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec": {
+				Type: "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"containers": {
+						Type: "array",
+						Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+							Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"image": {Type: "string"},
+								},
+							},
+						},
+					},
+					"labels": {
+						Type: "object",
+						AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+							Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+						},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		jsonPath      string
+		expectedError bool
+	}{
+		{jsonPath: ".spec.containers[*].image"},
+		{jsonPath: ".spec.containers[0]"},
+		{jsonPath: ".spec.labels['kubecarrier.io/test']"},
+		{jsonPath: ".spec.containers[*].name", expectedError: true},
+		{jsonPath: ".spec.labels[0]", expectedError: true},
+		{jsonPath: ".status", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.jsonPath, func(t *testing.T) {
+			path, err := Parse(test.jsonPath)
+			require.NoError(t, err)
+			err = ValidateSchema(path, schema)
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectreferences rewrites references between instances of different CatalogEntries,
+// when the instances are copied between Tenant, Provider and ServiceCluster.
+package objectreferences
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+)
+
+// APIGroupMapping maps the API group of a referenced Kind in the source object to the API group in the copy.
+type APIGroupMapping struct {
+	From, To string
+}
+
+// Mapping describes how references are rewritten, when an object is copied.
+type Mapping struct {
+	// APIGroups maps CatalogEntry names to the API groups of their Kind.
+	APIGroups map[string]APIGroupMapping
+	// FromNamespace and ToNamespace are the namespaces of the source object and its copy.
+	FromNamespace, ToNamespace string
+}
+
+// Reverse returns the Mapping to copy objects in the opposite direction.
+func (m Mapping) Reverse() Mapping {
+	reversed := Mapping{
+		APIGroups:     map[string]APIGroupMapping{},
+		FromNamespace: m.ToNamespace,
+		ToNamespace:   m.FromNamespace,
+	}
+	for catalogEntry, groups := range m.APIGroups {
+		reversed.APIGroups[catalogEntry] = APIGroupMapping{From: groups.To, To: groups.From}
+	}
+	return reversed
+}
+
+// Validate checks the object reference configuration.
+func Validate(fields []catalogv1alpha1.ObjectReferenceField) error {
+	for _, field := range fields {
+		if field.CatalogEntry.Name == "" {
+			return fmt.Errorf("object reference %s: catalogEntry name is required", field.JSONPath)
+		}
+		if field.JSONPath == "" {
+			return fmt.Errorf("object reference to %s: jsonPath is required", field.CatalogEntry.Name)
+		}
+		for _, jsonPath := range []string{field.JSONPath, field.NamespaceJSONPath, field.APIGroupJSONPath} {
+			if jsonPath == "" {
+				continue
+			}
+			path, err := fieldpath.Parse(jsonPath)
+			if err != nil {
+				return fmt.Errorf("object reference to %s: %w", field.CatalogEntry.Name, err)
+			}
+			if path.HasWildcard() {
+				return fmt.Errorf("object reference to %s %s: wildcards are not supported", field.CatalogEntry.Name, path)
+			}
+		}
+	}
+	return nil
+}
+
+// Translate rewrites the API group and namespace fields of the references in obj, using the given Mapping.
+// Fields that are not set or contain a different API group or namespace than the source are not changed.
+func Translate(obj *unstructured.Unstructured, fields []catalogv1alpha1.ObjectReferenceField, mapping Mapping) error {
+	for _, field := range fields {
+		if field.APIGroupJSONPath != "" {
+			groups, ok := mapping.APIGroups[field.CatalogEntry.Name]
+			if !ok {
+				return fmt.Errorf("missing API group of CatalogEntry %s", field.CatalogEntry.Name)
+			}
+			if err := translateField(obj, field.APIGroupJSONPath, func(value string) string {
+				if value == groups.From {
+					return groups.To
+				}
+				// apiVersion, e.g. example.com/v1
+				if version := strings.TrimPrefix(value, groups.From+"/"); version != value {
+					return groups.To + "/" + version
+				}
+				return value
+			}); err != nil {
+				return err
+			}
+		}
+
+		if field.NamespaceJSONPath != "" {
+			if err := translateField(obj, field.NamespaceJSONPath, func(value string) string {
+				if value == mapping.FromNamespace {
+					return mapping.ToNamespace
+				}
+				return value
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func translateField(obj *unstructured.Unstructured, jsonPath string, translate func(value string) string) error {
+	path, err := fieldpath.Parse(jsonPath)
+	if err != nil {
+		return fmt.Errorf("object reference: %w", err)
+	}
+	value, found, err := fieldpath.Get(obj.Object, path)
+	if err != nil {
+		return fmt.Errorf("object reference %s: %w", path, err)
+	}
+	s, ok := value.(string)
+	if !found || !ok || s == "" {
+		return nil
+	}
+	if translated := translate(s); translated != s {
+		if err := fieldpath.Set(obj.Object, path, translated); err != nil {
+			return fmt.Errorf("object reference %s: %w", path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectreferences
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		fields        []catalogv1alpha1.ObjectReferenceField
+		expectedError string
+	}{
+		{
+			name: "valid",
+			fields: []catalogv1alpha1.ObjectReferenceField{
+				{
+					CatalogEntry:      catalogv1alpha1.ObjectReference{Name: "db"},
+					JSONPath:          ".spec.dbRef.name",
+					NamespaceJSONPath: ".spec.dbRef.namespace",
+					APIGroupJSONPath:  ".spec.dbRef.apiVersion",
+				},
+				{
+					CatalogEntry: catalogv1alpha1.ObjectReference{Name: "db"},
+					JSONPath:     ".spec.dbName",
+				},
+			},
+		},
+		{
+			name: "missing catalogEntry",
+			fields: []catalogv1alpha1.ObjectReferenceField{
+				{JSONPath: ".spec.dbName"},
+			},
+			expectedError: "object reference .spec.dbName: catalogEntry name is required",
+		},
+		{
+			name: "missing jsonPath",
+			fields: []catalogv1alpha1.ObjectReferenceField{
+				{CatalogEntry: catalogv1alpha1.ObjectReference{Name: "db"}},
+			},
+			expectedError: "object reference to db: jsonPath is required",
+		},
+		{
+			name: "wildcard",
+			fields: []catalogv1alpha1.ObjectReferenceField{
+				{
+					CatalogEntry:     catalogv1alpha1.ObjectReference{Name: "db"},
+					JSONPath:         ".spec.dbName",
+					APIGroupJSONPath: ".spec.dbs[*].apiGroup",
+				},
+			},
+			expectedError: "object reference to db .spec.dbs[*].apiGroup: wildcards are not supported",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Validate(test.fields)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	fields := []catalogv1alpha1.ObjectReferenceField{
+		{
+			CatalogEntry:      catalogv1alpha1.ObjectReference{Name: "db"},
+			JSONPath:          ".spec.dbRef.name",
+			NamespaceJSONPath: ".spec.dbRef.namespace",
+			APIGroupJSONPath:  ".spec.dbRef.apiVersion",
+		},
+		{
+			CatalogEntry:     catalogv1alpha1.ObjectReference{Name: "snapshot"},
+			JSONPath:         ".spec.snapshot.name",
+			APIGroupJSONPath: ".spec.snapshot.apiGroup",
+		},
+		{
+			CatalogEntry:     catalogv1alpha1.ObjectReference{Name: "snapshot"},
+			JSONPath:         ".spec.missing.name",
+			APIGroupJSONPath: ".spec.missing.apiGroup",
+		},
+	}
+	mapping := Mapping{
+		APIGroups: map[string]APIGroupMapping{
+			"db":       {From: "eu-west-1.provider", To: "fake.kubecarrier.io"},
+			"snapshot": {From: "eu-west-1.provider", To: "snapshots.kubecarrier.io"},
+		},
+		FromNamespace: "tenant-a",
+		ToNamespace:   "sc-tenant-a",
+	}
+
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"dbRef": map[string]interface{}{
+					"name":       "db1",
+					"namespace":  "tenant-a",
+					"apiVersion": "eu-west-1.provider/v1",
+				},
+				"snapshot": map[string]interface{}{
+					"name":     "snap1",
+					"apiGroup": "eu-west-1.provider",
+				},
+			},
+		},
+	}
+	require.NoError(t, Translate(obj, fields, mapping))
+	assert.Equal(t, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"dbRef": map[string]interface{}{
+				"name":       "db1",
+				"namespace":  "sc-tenant-a",
+				"apiVersion": "fake.kubecarrier.io/v1",
+			},
+			"snapshot": map[string]interface{}{
+				"name":     "snap1",
+				"apiGroup": "snapshots.kubecarrier.io",
+			},
+		},
+	}, obj.Object)
+
+	t.Run("reverse", func(t *testing.T) {
+		require.NoError(t, Translate(obj, fields[:1], mapping.Reverse()))
+		assert.Equal(t, map[string]interface{}{
+			"name":       "db1",
+			"namespace":  "tenant-a",
+			"apiVersion": "eu-west-1.provider/v1",
+		}, obj.Object["spec"].(map[string]interface{})["dbRef"])
+	})
+
+	t.Run("missing mapping", func(t *testing.T) {
+		err := Translate(obj, fields, Mapping{})
+		assert.EqualError(t, err, "missing API group of CatalogEntry db")
+	})
+}
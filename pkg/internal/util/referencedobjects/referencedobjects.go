@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package referencedobjects resolves the Secrets and ConfigMaps that are referenced by instances,
+// so they can be propagated from ServiceClusters to Tenants.
+package referencedobjects
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+)
+
+// Reference is a resolved reference to a Secret or ConfigMap.
+type Reference struct {
+	Kind catalogv1alpha1.ReferencedObjectKind
+	Name string
+}
+
+// NewObject returns an empty object of the referenced kind.
+func (r Reference) NewObject() (Object, error) {
+	switch r.Kind {
+	case catalogv1alpha1.ReferencedSecret:
+		return &corev1.Secret{}, nil
+	case catalogv1alpha1.ReferencedConfigMap:
+		return &corev1.ConfigMap{}, nil
+	}
+	return nil, fmt.Errorf("unsupported kind %q", r.Kind)
+}
+
+// Object is a Secret or ConfigMap.
+type Object interface {
+	runtime.Object
+	metav1.Object
+}
+
+// CopyData copies the content of a Secret or ConfigMap to another object of the same kind.
+func CopyData(src, dest Object) error {
+	switch s := src.(type) {
+	case *corev1.Secret:
+		d, ok := dest.(*corev1.Secret)
+		if !ok {
+			return fmt.Errorf("can't copy Secret to %T", dest)
+		}
+		d.Type = s.Type
+		d.Data = s.Data
+		return nil
+	case *corev1.ConfigMap:
+		d, ok := dest.(*corev1.ConfigMap)
+		if !ok {
+			return fmt.Errorf("can't copy ConfigMap to %T", dest)
+		}
+		d.Data = s.Data
+		d.BinaryData = s.BinaryData
+		return nil
+	}
+	return fmt.Errorf("unsupported type %T", src)
+}
+
+// Validate checks the referenced object configuration.
+func Validate(refs []catalogv1alpha1.ReferencedObject) error {
+	for _, ref := range refs {
+		switch ref.Kind {
+		case catalogv1alpha1.ReferencedSecret, catalogv1alpha1.ReferencedConfigMap:
+		default:
+			return fmt.Errorf("referenced object kind %q is not supported, should be one of Secret, ConfigMap", ref.Kind)
+		}
+
+		if (ref.Name == "") == (ref.JSONPath == "") {
+			return fmt.Errorf("referenced %s: exactly one of name or jsonPath has to be set", ref.Kind)
+		}
+		if ref.Name != "" {
+			if errs := validation.IsDNS1123Subdomain(ref.Name); len(errs) > 0 {
+				return fmt.Errorf("referenced %s name %s: %v", ref.Kind, ref.Name, errs)
+			}
+			continue
+		}
+		path, err := fieldpath.Parse(ref.JSONPath)
+		if err != nil {
+			return fmt.Errorf("referenced %s: %w", ref.Kind, err)
+		}
+		if path.HasWildcard() {
+			return fmt.Errorf("referenced %s %s: wildcards are not supported", ref.Kind, path)
+		}
+	}
+	return nil
+}
+
+// Resolve returns the Secrets and ConfigMaps referenced by the given object.
+// References to fields that are not set or don't contain a valid name are skipped.
+func Resolve(obj *unstructured.Unstructured, refs []catalogv1alpha1.ReferencedObject) ([]Reference, error) {
+	var (
+		resolved []Reference
+		seen     = map[Reference]struct{}{}
+	)
+	for _, ref := range refs {
+		name := ref.Name
+		if ref.JSONPath != "" {
+			path, err := fieldpath.Parse(ref.JSONPath)
+			if err != nil {
+				return nil, fmt.Errorf("referenced %s: %w", ref.Kind, err)
+			}
+			value, found, err := fieldpath.Get(obj.Object, path)
+			if err != nil {
+				return nil, fmt.Errorf("referenced %s: %w", ref.Kind, err)
+			}
+			if !found {
+				// the field might be populated later
+				continue
+			}
+			if name, _ = value.(string); name == "" {
+				continue
+			}
+		}
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			continue
+		}
+
+		reference := Reference{Kind: ref.Kind, Name: name}
+		if _, ok := seen[reference]; ok {
+			continue
+		}
+		seen[reference] = struct{}{}
+		resolved = append(resolved, reference)
+	}
+	return resolved, nil
+}
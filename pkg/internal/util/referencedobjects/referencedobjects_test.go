@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package referencedobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		refs          []catalogv1alpha1.ReferencedObject
+		expectedError string
+	}{
+		{
+			name: "valid",
+			refs: []catalogv1alpha1.ReferencedObject{
+				{Kind: catalogv1alpha1.ReferencedSecret, JSONPath: ".status.connection.secretName"},
+				{Kind: catalogv1alpha1.ReferencedConfigMap, Name: "config"},
+			},
+		},
+		{
+			name: "unsupported kind",
+			refs: []catalogv1alpha1.ReferencedObject{
+				{Kind: "Pod", Name: "test"},
+			},
+			expectedError: `referenced object kind "Pod" is not supported, should be one of Secret, ConfigMap`,
+		},
+		{
+			name: "name and jsonPath",
+			refs: []catalogv1alpha1.ReferencedObject{
+				{Kind: catalogv1alpha1.ReferencedSecret, Name: "test", JSONPath: ".status.secretName"},
+			},
+			expectedError: "referenced Secret: exactly one of name or jsonPath has to be set",
+		},
+		{
+			name: "wildcard",
+			refs: []catalogv1alpha1.ReferencedObject{
+				{Kind: catalogv1alpha1.ReferencedSecret, JSONPath: ".status.users[*].secretName"},
+			},
+			expectedError: "referenced Secret .status.users[*].secretName: wildcards are not supported",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Validate(test.refs)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"connection": map[string]interface{}{
+					"secretName": "db-credentials",
+				},
+				"caSecretName": "",
+			},
+		},
+	}
+
+	refs, err := Resolve(obj, []catalogv1alpha1.ReferencedObject{
+		{Kind: catalogv1alpha1.ReferencedSecret, JSONPath: ".status.connection.secretName"},
+		{Kind: catalogv1alpha1.ReferencedSecret, JSONPath: ".status.caSecretName"},
+		{Kind: catalogv1alpha1.ReferencedSecret, JSONPath: ".status.missing"},
+		{Kind: catalogv1alpha1.ReferencedConfigMap, Name: "db-config"},
+		{Kind: catalogv1alpha1.ReferencedSecret, Name: "db-credentials"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Reference{
+		{Kind: catalogv1alpha1.ReferencedSecret, Name: "db-credentials"},
+		{Kind: catalogv1alpha1.ReferencedConfigMap, Name: "db-config"},
+	}, refs)
+}
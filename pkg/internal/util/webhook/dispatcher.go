@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net/http"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Dispatcher routes admission requests to webhooks by their URL path.
+// Unlike the webhook.Server of controller-runtime, webhooks can be added and removed at runtime.
+type Dispatcher struct {
+	mu    sync.RWMutex
+	hooks map[string]http.Handler
+}
+
+var _ http.Handler = (*Dispatcher)(nil)
+
+// NewDispatcher creates a new Dispatcher without any webhooks.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		hooks: map[string]http.Handler{},
+	}
+}
+
+// Register serves the webhook at the given path, replacing any webhook already registered for it.
+func (d *Dispatcher) Register(path string, hook http.Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.hooks[path] = hook
+}
+
+// Unregister removes the webhook at the given path.
+func (d *Dispatcher) Unregister(path string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.hooks, path)
+}
+
+// ServeHTTP implements http.Handler.
+func (d *Dispatcher) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	d.mu.RLock()
+	hook, ok := d.hooks[req.URL.Path]
+	d.mu.RUnlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	hook.ServeHTTP(w, req)
+}
+
+// Runnable returns a manager.Runnable, that serves the webhook at the given path while the Manager is running.
+func (d *Dispatcher) Runnable(path string, hook http.Handler) manager.Runnable {
+	return manager.RunnableFunc(func(stop <-chan struct{}) error {
+		d.Register(path, hook)
+		<-stop
+		d.Unregister(path)
+		return nil
+	})
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatcher(t *testing.T) {
+	d := NewDispatcher()
+	serve := func(path string) int {
+		w := httptest.NewRecorder()
+		d.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusNotFound, serve("/mutate-test"))
+
+	d.Register("/mutate-test", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	assert.Equal(t, http.StatusAccepted, serve("/mutate-test"))
+	assert.Equal(t, http.StatusNotFound, serve("/mutate-other"))
+
+	d.Unregister("/mutate-test")
+	assert.Equal(t, http.StatusNotFound, serve("/mutate-test"))
+}
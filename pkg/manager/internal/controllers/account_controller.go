@@ -47,6 +47,7 @@ type AccountReconciler struct {
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=accounts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=tenants,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=apikeys,verbs=get;list;watch
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 
@@ -112,6 +113,7 @@ func (r *AccountReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&catalogv1alpha1.Tenant{}).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
+		Watches(&source.Kind{Type: &catalogv1alpha1.APIKey{}}, enqueueAccountOfAPIKey).
 		Watches(&source.Kind{Type: &catalogv1alpha1.Account{}}, &handler.EnqueueRequestsFromMapFunc{
 			ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) (out []ctrl.Request) {
 				provider := mapObject.Object.(*catalogv1alpha1.Account)
@@ -247,6 +249,7 @@ func (r *AccountReconciler) reconcileRolesAndRoleBindings(ctx context.Context, l
 						"catalogentries",
 						"catalogentrysets",
 						"derivedcustomresources",
+						"quotas",
 					},
 					Verbs: []string{rbacv1.VerbAll},
 				},
@@ -257,16 +260,33 @@ func (r *AccountReconciler) reconcileRolesAndRoleBindings(ctx context.Context, l
 					},
 					Verbs: []string{"get", "list", "watch", "update", "patch"},
 				},
+				{
+					APIGroups: []string{"catalog.kubecarrier.io"},
+					Resources: []string{"apikeys"},
+					Verbs:     []string{"get", "list", "watch", "create", "delete"},
+				},
+				{
+					// UsageRecords are written by the Elevator
+					APIGroups: []string{"catalog.kubecarrier.io"},
+					Resources: []string{
+						"usagerecords",
+					},
+					Verbs: []string{"get", "list", "watch"},
+				},
 			},
 		}
 		roles = append(roles, desiredProviderRole)
 
+		subjects, err := accountRBACSubjects(ctx, r.Client, account, catalogv1alpha1.SubjectRoleViewer)
+		if err != nil {
+			return err
+		}
 		desiredProviderRoleBinding := &rbacv1.RoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "kubecarrier:provider",
 				Namespace: account.Status.Namespace.Name,
 			},
-			Subjects: account.Spec.Subjects,
+			Subjects: subjects,
 			RoleRef: rbacv1.RoleRef{
 				APIGroup: "rbac.authorization.k8s.io",
 				Kind:     "Role",
@@ -276,38 +296,84 @@ func (r *AccountReconciler) reconcileRolesAndRoleBindings(ctx context.Context, l
 		roleBindings = append(roleBindings, desiredProviderRoleBinding)
 	}
 	if account.HasRole(catalogv1alpha1.TenantRole) {
-		desiredTenantRole := &rbacv1.Role{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "kubecarrier:tenant",
-				Namespace: account.Status.Namespace.Name,
-			},
-			Rules: []rbacv1.PolicyRule{
-				{
-					APIGroups: []string{"catalog.kubecarrier.io"},
-					Resources: []string{
-						"providers",
-						"regions",
-						"offerings",
+		// Every subject can read, the Roles of higher levels only add the permissions of the level.
+		for _, level := range []struct {
+			role  catalogv1alpha1.AccountSubjectRole
+			name  string
+			rules []rbacv1.PolicyRule
+		}{
+			{
+				role: catalogv1alpha1.SubjectRoleViewer,
+				name: "kubecarrier:tenant",
+				rules: []rbacv1.PolicyRule{
+					{
+						APIGroups: []string{"catalog.kubecarrier.io"},
+						Resources: []string{
+							"providers",
+							"regions",
+							"offerings",
+							"instancemigrations",
+						},
+						Verbs: []string{"get", "list", "watch"},
 					},
-					Verbs: []string{"get", "list", "watch"},
 				},
 			},
-		}
-		roles = append(roles, desiredTenantRole)
-
-		desiredTenantRoleBinding := &rbacv1.RoleBinding{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "kubecarrier:tenant",
-				Namespace: account.Status.Namespace.Name,
+			{
+				role: catalogv1alpha1.SubjectRoleEditor,
+				name: "kubecarrier:tenant-editor",
+				rules: []rbacv1.PolicyRule{
+					{
+						APIGroups: []string{"catalog.kubecarrier.io"},
+						Resources: []string{"instancemigrations"},
+						Verbs:     []string{"create"},
+					},
+				},
 			},
-			Subjects: account.Spec.Subjects,
-			RoleRef: rbacv1.RoleRef{
-				APIGroup: "rbac.authorization.k8s.io",
-				Kind:     "Role",
-				Name:     desiredTenantRole.Name,
+			{
+				role: catalogv1alpha1.SubjectRoleAdmin,
+				name: "kubecarrier:tenant-admin",
+				rules: []rbacv1.PolicyRule{
+					{
+						APIGroups: []string{"catalog.kubecarrier.io"},
+						Resources: []string{"instancemigrations"},
+						Verbs:     []string{"delete"},
+					},
+					{
+						// APIKeys act with the permissions of the Account, so only Admins may manage them.
+						APIGroups: []string{"catalog.kubecarrier.io"},
+						Resources: []string{"apikeys"},
+						Verbs:     []string{"get", "list", "watch", "create", "delete"},
+					},
+				},
 			},
+		} {
+			desiredTenantRole := &rbacv1.Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      level.name,
+					Namespace: account.Status.Namespace.Name,
+				},
+				Rules: level.rules,
+			}
+			roles = append(roles, desiredTenantRole)
+
+			subjects, err := accountRBACSubjects(ctx, r.Client, account, level.role)
+			if err != nil {
+				return err
+			}
+			desiredTenantRoleBinding := &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      level.name,
+					Namespace: account.Status.Namespace.Name,
+				},
+				Subjects: subjects,
+				RoleRef: rbacv1.RoleRef{
+					APIGroup: "rbac.authorization.k8s.io",
+					Kind:     "Role",
+					Name:     desiredTenantRole.Name,
+				},
+			}
+			roleBindings = append(roleBindings, desiredTenantRoleBinding)
 		}
-		roleBindings = append(roleBindings, desiredTenantRoleBinding)
 	}
 
 	for _, role := range roles {
@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/go-logr/logr"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -54,9 +56,11 @@ type CatalogReconciler struct {
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogs,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=list;watch
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentrysets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=offerings,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=regions,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=providers,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=apikeys,verbs=get;list;watch
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusterassignments,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
@@ -144,17 +148,37 @@ func (r *CatalogReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	)
 	for _, catalogEntry := range readyCatalogEntries {
 		desiredProviderRoles, desiredProviderRoleBindings := r.buildDesiredProviderRolesAndRoleBindings(readyTenants, provider, catalogEntry)
-		desiredTenantRoles, desiredTenantRoleBindings := r.buildDesiredTenantRolesAndRoleBindings(readyTenants, catalogEntry)
+		desiredTenantRoles, desiredTenantRoleBindings, err := r.buildDesiredTenantRolesAndRoleBindings(
+			ctx, readyTenants, catalogEntry.Name, *catalogEntry.Status.TenantCRD)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("building Tenant RolesAndRoleBindings: %w", err)
+		}
 		desiredRoles = append(desiredRoles, desiredTenantRoles...)
 		desiredRoles = append(desiredRoles, desiredProviderRoles...)
 		desiredRoleBindings = append(desiredRoleBindings, desiredProviderRoleBindings...)
 		desiredRoleBindings = append(desiredRoleBindings, desiredTenantRoleBindings...)
 	}
 
+	// Get CatalogEntrySets with an aggregated CRD.
+	schedulingCatalogEntrySets, err := r.listSchedulingCatalogEntrySets(ctx, readyCatalogEntries)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("getting CatalogEntrySets: %w", err)
+	}
+	for _, catalogEntrySet := range schedulingCatalogEntrySets {
+		desiredTenantRoles, desiredTenantRoleBindings, err := r.buildDesiredTenantRolesAndRoleBindings(
+			ctx, readyTenants, catalogEntrySet.Status.AggregatedCRD.Name, *catalogEntrySet.Status.AggregatedCRD)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("building Tenant RolesAndRoleBindings: %w", err)
+		}
+		desiredRoles = append(desiredRoles, desiredTenantRoles...)
+		desiredRoleBindings = append(desiredRoleBindings, desiredTenantRoleBindings...)
+	}
+
 	for _, tenant := range readyTenants {
 
 		desiredProviders = append(desiredProviders, r.buildDesiredProvider(provider, tenant))
 		desiredOfferings = append(desiredOfferings, r.buildDesiredOfferings(provider, tenant, readyCatalogEntries)...)
+		desiredOfferings = append(desiredOfferings, r.buildDesiredAggregatedOfferings(provider, tenant, schedulingCatalogEntrySets)...)
 		desiredRegionsForTenant, desiredServiceClusterAssignmentsForTenant, err := r.buildDesiredRegionsAndAssignments(ctx, log, provider, tenant, readyCatalogEntries)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("building RegionAndAssignment: %w", err)
@@ -219,11 +243,42 @@ func (r *CatalogReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return
 		}),
 	}
+	// ServiceClusterAssignments carry the labels of the tenant Account,
+	// so Catalogs of all Providers of the tenant are requeued when the Account changes.
+	enqueueAllCatalogsOfTenant := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) (out []reconcile.Request) {
+			tenantList := &catalogv1alpha1.TenantList{}
+			if err := r.List(context.Background(), tenantList); err != nil {
+				// This will makes the manager crashes, and it will restart and reconcile all objects again.
+				panic(fmt.Errorf("listting Tenant: %w", err))
+			}
+			for _, tenant := range tenantList.Items {
+				if tenant.Name != mapObject.Meta.GetName() {
+					continue
+				}
+				out = append(out, enqueueAllCatalogsInNamespace.ToRequests.Map(handler.MapObject{
+					Meta:   &tenant,
+					Object: &tenant,
+				})...)
+			}
+			return
+		}),
+	}
 	enqueuerForOwner := multiowner.EnqueueRequestForOwner(&catalogv1alpha1.Catalog{}, mgr.GetScheme())
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&catalogv1alpha1.Catalog{}).
 		Watches(&source.Kind{Type: &catalogv1alpha1.Tenant{}}, enqueueAllCatalogsInNamespace).
+		Watches(&source.Kind{Type: &catalogv1alpha1.Account{}}, enqueueAllCatalogsOfTenant).
+		Watches(&source.Kind{Type: &catalogv1alpha1.APIKey{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) []reconcile.Request {
+				// APIKeys are bound to the Roles of the Tenant, that is named like the Account namespace
+				return enqueueAllCatalogsOfTenant.ToRequests.Map(handler.MapObject{
+					Meta: &metav1.ObjectMeta{Name: mapObject.Meta.GetNamespace()},
+				})
+			}),
+		}).
 		Watches(&source.Kind{Type: &catalogv1alpha1.CatalogEntry{}}, enqueueAllCatalogsInNamespace).
+		Watches(&source.Kind{Type: &catalogv1alpha1.CatalogEntrySet{}}, enqueueAllCatalogsInNamespace).
 		Watches(&source.Kind{Type: &corev1alpha1.ServiceCluster{}}, enqueueAllCatalogsInNamespace).
 		Watches(&source.Kind{Type: &catalogv1alpha1.Offering{}}, enqueuerForOwner).
 		Watches(&source.Kind{Type: &catalogv1alpha1.Provider{}}, enqueuerForOwner).
@@ -325,6 +380,35 @@ func (r *CatalogReconciler) listSelectedReadyCatalogEntries(ctx context.Context,
 	return readyCatalogEntries, nil
 }
 
+// listSchedulingCatalogEntrySets returns the CatalogEntrySets of the given CatalogEntries, that have an aggregated CRD.
+func (r *CatalogReconciler) listSchedulingCatalogEntrySets(ctx context.Context, catalogEntries []catalogv1alpha1.CatalogEntry) ([]catalogv1alpha1.CatalogEntrySet, error) {
+	var catalogEntrySets []catalogv1alpha1.CatalogEntrySet
+	seen := map[types.NamespacedName]struct{}{}
+	for _, catalogEntry := range catalogEntries {
+		name, ok := catalogEntry.Labels[catalogEntriesLabel]
+		if !ok {
+			continue
+		}
+		nn := types.NamespacedName{Name: name, Namespace: catalogEntry.Namespace}
+		if _, ok := seen[nn]; ok {
+			continue
+		}
+		seen[nn] = struct{}{}
+
+		catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{}
+		if err := r.Get(ctx, nn, catalogEntrySet); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("getting CatalogEntrySet: %w", err)
+		}
+		if catalogEntrySet.Spec.Scheduling != nil && catalogEntrySet.Status.AggregatedCRD != nil {
+			catalogEntrySets = append(catalogEntrySets, *catalogEntrySet)
+		}
+	}
+	return catalogEntrySets, nil
+}
+
 func (r *CatalogReconciler) listSelectedReadyTenants(ctx context.Context, log logr.Logger, catalog *catalogv1alpha1.Catalog) ([]*catalogv1alpha1.Account, error) {
 	tenantSelector, err := metav1.LabelSelectorAsSelector(catalog.Spec.TenantSelector)
 	if err != nil {
@@ -355,8 +439,43 @@ func (r *CatalogReconciler) buildDesiredOfferings(
 	tenant *catalogv1alpha1.Account,
 	catalogEntries []catalogv1alpha1.CatalogEntry,
 ) []catalogv1alpha1.Offering {
+	catalogEntriesByName := map[string]catalogv1alpha1.CatalogEntry{}
+	for _, catalogEntry := range catalogEntries {
+		catalogEntriesByName[catalogEntry.Name] = catalogEntry
+	}
+	// bundleOf maps bundled CatalogEntries to their bundle.
+	// The webhook rejects nested bundles and double membership, but to stay deterministic anyway,
+	// CatalogEntries with a Bundle are never bundled themselves and the first bundle by name wins.
+	sortedCatalogEntries := make([]catalogv1alpha1.CatalogEntry, len(catalogEntries))
+	copy(sortedCatalogEntries, catalogEntries)
+	sort.Slice(sortedCatalogEntries, func(i, j int) bool {
+		return sortedCatalogEntries[i].Name < sortedCatalogEntries[j].Name
+	})
+	bundleOf := map[string]string{}
+	for _, catalogEntry := range sortedCatalogEntries {
+		for _, ref := range catalogEntry.Spec.Bundle {
+			bundledCatalogEntry, ok := catalogEntriesByName[ref.Name]
+			if !ok || len(bundledCatalogEntry.Spec.Bundle) > 0 {
+				continue
+			}
+			if _, ok := bundleOf[ref.Name]; !ok {
+				bundleOf[ref.Name] = catalogEntry.Name
+			}
+		}
+	}
+
 	var desiredOfferings []catalogv1alpha1.Offering
 	for _, catalogEntry := range catalogEntries {
+		if _, ok := bundleOf[catalogEntry.Name]; ok {
+			// bundled CatalogEntries are listed in the Offering of the bundle
+			continue
+		}
+		var bundledCRDs []catalogv1alpha1.CRDInformation
+		for _, ref := range catalogEntry.Spec.Bundle {
+			if bundleOf[ref.Name] == catalogEntry.Name {
+				bundledCRDs = append(bundledCRDs, *catalogEntriesByName[ref.Name].Status.TenantCRD)
+			}
+		}
 		desiredOfferings = append(desiredOfferings, catalogv1alpha1.Offering{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      catalogEntry.Status.TenantCRD.Name,
@@ -369,7 +488,35 @@ func (r *CatalogReconciler) buildDesiredOfferings(
 				Provider: catalogv1alpha1.ObjectReference{
 					Name: provider.Name,
 				},
-				CRD: *catalogEntry.Status.TenantCRD,
+				CRD:         *catalogEntry.Status.TenantCRD,
+				BundledCRDs: bundledCRDs,
+				Lifecycle:   catalogEntry.Spec.Lifecycle,
+			},
+		})
+	}
+	return desiredOfferings
+}
+
+func (r *CatalogReconciler) buildDesiredAggregatedOfferings(
+	provider *catalogv1alpha1.Account,
+	tenant *catalogv1alpha1.Account,
+	catalogEntrySets []catalogv1alpha1.CatalogEntrySet,
+) []catalogv1alpha1.Offering {
+	var desiredOfferings []catalogv1alpha1.Offering
+	for _, catalogEntrySet := range catalogEntrySets {
+		desiredOfferings = append(desiredOfferings, catalogv1alpha1.Offering{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      catalogEntrySet.Status.AggregatedCRD.Name,
+				Namespace: tenant.Status.Namespace.Name,
+			},
+			Spec: catalogv1alpha1.OfferingSpec{
+				Metadata: catalogv1alpha1.OfferingMetadata{
+					CommonMetadata: catalogEntrySet.Spec.Metadata.CommonMetadata,
+				},
+				Provider: catalogv1alpha1.ObjectReference{
+					Name: provider.Name,
+				},
+				CRD: *catalogEntrySet.Status.AggregatedCRD,
 			},
 		})
 	}
@@ -394,7 +541,7 @@ func (r *CatalogReconciler) buildDesiredProvider(
 func (r *CatalogReconciler) buildDesiredRegionsAndAssignments(
 	ctx context.Context, log logr.Logger,
 	provider *catalogv1alpha1.Account,
-	tenant *catalogv1alpha1.Account,
+	tenantAccount *catalogv1alpha1.Account,
 	catalogEntries []catalogv1alpha1.CatalogEntry,
 ) ([]catalogv1alpha1.Region, []corev1alpha1.ServiceClusterAssignment, error) {
 	var desiredRegions []catalogv1alpha1.Region
@@ -414,19 +561,22 @@ func (r *CatalogReconciler) buildDesiredRegionsAndAssignments(
 		desiredRegions = append(desiredRegions, catalogv1alpha1.Region{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      serviceClusterName + "." + provider.Name,
-				Namespace: tenant.Status.Namespace.Name,
+				Namespace: tenantAccount.Status.Namespace.Name,
 			},
 			Spec: catalogv1alpha1.RegionSpec{
 				Metadata: serviceCluster.Spec.Metadata,
 				Provider: catalogv1alpha1.ObjectReference{
 					Name: provider.Name,
 				},
+				Health:      regionHealth(serviceCluster),
+				Capacity:    serviceCluster.Status.Capacity,
+				Maintenance: serviceCluster.Spec.Maintenance,
 			},
 		})
 
 		desiredServiceClusterAssignments = append(desiredServiceClusterAssignments, corev1alpha1.ServiceClusterAssignment{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      tenant.Status.Namespace.Name + "." + serviceClusterName,
+				Name:      tenantAccount.Status.Namespace.Name + "." + serviceClusterName,
 				Namespace: provider.Status.Namespace.Name,
 			},
 			Spec: corev1alpha1.ServiceClusterAssignmentSpec{
@@ -434,7 +584,12 @@ func (r *CatalogReconciler) buildDesiredRegionsAndAssignments(
 					Name: serviceClusterName,
 				},
 				ManagementClusterNamespace: corev1alpha1.ObjectReference{
-					Name: tenant.Status.Namespace.Name,
+					Name: tenantAccount.Status.Namespace.Name,
+				},
+				// Tenant objects don't carry labels, templates are rendered with the labels of the Account
+				Tenant: &corev1alpha1.ServiceClusterAssignmentTenant{
+					Name:   tenantAccount.Name,
+					Labels: tenantAccount.Labels,
 				},
 			},
 		})
@@ -442,44 +597,94 @@ func (r *CatalogReconciler) buildDesiredRegionsAndAssignments(
 	return desiredRegions, desiredServiceClusterAssignments, nil
 }
 
+// regionHealth derives the health of a Region from the Ready condition of its ServiceCluster.
+func regionHealth(serviceCluster *corev1alpha1.ServiceCluster) *catalogv1alpha1.RegionHealth {
+	ready, ok := serviceCluster.Status.GetCondition(corev1alpha1.ServiceClusterReady)
+	if !ok || ready.Status == corev1alpha1.ConditionUnknown {
+		return &catalogv1alpha1.RegionHealth{
+			State: catalogv1alpha1.RegionHealthUnknown,
+		}
+	}
+	state := catalogv1alpha1.RegionDegraded
+	if ready.True() {
+		state = catalogv1alpha1.RegionHealthy
+	}
+	return &catalogv1alpha1.RegionHealth{
+		State:   state,
+		Reason:  ready.Reason,
+		Message: ready.Message,
+	}
+}
+
+// tenantRoleLevels are the permissions of the subjects of a tenant Account on the instances of an Offering,
+// each level only adds the permissions of the level, as subjects of higher levels are bound to all lower levels.
+var tenantRoleLevels = []struct {
+	role   catalogv1alpha1.AccountSubjectRole
+	prefix string
+	verbs  []string
+}{
+	{
+		role:   catalogv1alpha1.SubjectRoleViewer,
+		prefix: "kubecarrier:tenant",
+		verbs:  []string{"get", "list", "watch"},
+	},
+	{
+		role:   catalogv1alpha1.SubjectRoleEditor,
+		prefix: "kubecarrier:tenant-editor",
+		verbs:  []string{"create", "update", "patch"},
+	},
+	{
+		role:   catalogv1alpha1.SubjectRoleAdmin,
+		prefix: "kubecarrier:tenant-admin",
+		verbs:  []string{"delete", "deletecollection"},
+	},
+}
+
 func (r *CatalogReconciler) buildDesiredTenantRolesAndRoleBindings(
+	ctx context.Context,
 	tenants []*catalogv1alpha1.Account,
-	catalogEntry catalogv1alpha1.CatalogEntry,
-) ([]rbacv1.Role, []rbacv1.RoleBinding) {
+	name string,
+	tenantCRDInfo catalogv1alpha1.CRDInformation,
+) ([]rbacv1.Role, []rbacv1.RoleBinding, error) {
 	var desiredRoles []rbacv1.Role
 	var desiredRoleBindings []rbacv1.RoleBinding
-	tenantCRDInfo := catalogEntry.Status.TenantCRD
 	for _, tenant := range tenants {
-		role := rbacv1.Role{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      fmt.Sprintf("kubecarrier:tenant:%s", catalogEntry.Name),
-				Namespace: tenant.Status.Namespace.Name,
-			},
-			Rules: []rbacv1.PolicyRule{
-				{
-					APIGroups: []string{tenantCRDInfo.APIGroup},
-					Resources: []string{tenantCRDInfo.Plural},
-					Verbs:     []string{rbacv1.VerbAll},
+		for _, level := range tenantRoleLevels {
+			role := rbacv1.Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s:%s", level.prefix, name),
+					Namespace: tenant.Status.Namespace.Name,
 				},
-			},
-		}
-		desiredRoles = append(desiredRoles, role)
+				Rules: []rbacv1.PolicyRule{
+					{
+						APIGroups: []string{tenantCRDInfo.APIGroup},
+						Resources: []string{tenantCRDInfo.Plural},
+						Verbs:     level.verbs,
+					},
+				},
+			}
+			desiredRoles = append(desiredRoles, role)
 
-		roleBinding := rbacv1.RoleBinding{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      fmt.Sprintf("kubecarrier:tenant:%s", catalogEntry.Name),
-				Namespace: tenant.Status.Namespace.Name,
-			},
-			Subjects: tenant.Spec.Subjects,
-			RoleRef: rbacv1.RoleRef{
-				APIGroup: "rbac.authorization.k8s.io",
-				Kind:     "Role",
-				Name:     role.Name,
-			},
+			subjects, err := accountRBACSubjects(ctx, r.Client, tenant, level.role)
+			if err != nil {
+				return nil, nil, err
+			}
+			roleBinding := rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s:%s", level.prefix, name),
+					Namespace: tenant.Status.Namespace.Name,
+				},
+				Subjects: subjects,
+				RoleRef: rbacv1.RoleRef{
+					APIGroup: "rbac.authorization.k8s.io",
+					Kind:     "Role",
+					Name:     role.Name,
+				},
+			}
+			desiredRoleBindings = append(desiredRoleBindings, roleBinding)
 		}
-		desiredRoleBindings = append(desiredRoleBindings, roleBinding)
 	}
-	return desiredRoles, desiredRoleBindings
+	return desiredRoles, desiredRoleBindings, nil
 }
 
 func (r *CatalogReconciler) buildDesiredProviderRolesAndRoleBindings(
@@ -510,7 +715,7 @@ func (r *CatalogReconciler) buildDesiredProviderRolesAndRoleBindings(
 				Name:      fmt.Sprintf("kubecarrier:provider:%s", catalogEntry.Name),
 				Namespace: tenant.Status.Namespace.Name,
 			},
-			Subjects: provider.Spec.Subjects,
+			Subjects: provider.RBACSubjects(catalogv1alpha1.SubjectRoleViewer),
 			RoleRef: rbacv1.RoleRef{
 				APIGroup: "rbac.authorization.k8s.io",
 				Kind:     "Role",
@@ -644,7 +849,7 @@ func (r *CatalogReconciler) reconcileRegions(
 		if err != nil {
 			return fmt.Errorf("inserting OwnerReference: %w", err)
 		}
-		if !reflect.DeepEqual(desiredRegion.Spec, foundRegion.Spec) || ownerChanged {
+		if !equality.Semantic.DeepEqual(desiredRegion.Spec, foundRegion.Spec) || ownerChanged {
 			foundRegion.Spec = desiredRegion.Spec
 			if err := r.Update(ctx, foundRegion); err != nil {
 				return fmt.Errorf("updaing Region: %w", err)
@@ -48,12 +48,12 @@ func TestCatalogReconciler(t *testing.T) {
 			Roles: []catalogv1alpha1.AccountRole{
 				catalogv1alpha1.ProviderRole,
 			},
-			Subjects: []rbacv1.Subject{
-				{
+			Subjects: []catalogv1alpha1.AccountSubject{
+				{Subject: rbacv1.Subject{
 					Kind:     rbacv1.GroupKind,
 					APIGroup: "rbac.authorization.k8s.io",
 					Name:     "example-provider",
-				},
+				}},
 			},
 		},
 	}
@@ -72,17 +72,20 @@ func TestCatalogReconciler(t *testing.T) {
 	tenantAccount := &catalogv1alpha1.Account{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "example-tenant",
+			Labels: map[string]string{
+				"tier": "gold",
+			},
 		},
 		Spec: catalogv1alpha1.AccountSpec{
 			Roles: []catalogv1alpha1.AccountRole{
 				catalogv1alpha1.TenantRole,
 			},
-			Subjects: []rbacv1.Subject{
-				{
+			Subjects: []catalogv1alpha1.AccountSubject{
+				{Subject: rbacv1.Subject{
 					Kind:     rbacv1.GroupKind,
 					APIGroup: "rbac.authorization.k8s.io",
 					Name:     "example-tenant",
-				},
+				}},
 			},
 		},
 	}
@@ -158,6 +161,24 @@ func TestCatalogReconciler(t *testing.T) {
 				DisplayName: "test-service-cluster",
 				Description: "a service cluster for testing",
 			},
+			Maintenance: &corev1alpha1.ServiceClusterMaintenance{
+				Reason: "Kubernetes upgrade",
+			},
+		},
+		Status: corev1alpha1.ServiceClusterStatus{
+			Conditions: []corev1alpha1.ServiceClusterCondition{
+				{
+					Type:    corev1alpha1.ServiceClusterReady,
+					Status:  corev1alpha1.ConditionFalse,
+					Reason:  "ServiceClusterUnhealthy",
+					Message: "The service cluster is unhealthy: 0/3 nodes are ready, at least 50% are required",
+				},
+			},
+			Capacity: &corev1alpha1.ServiceClusterCapacity{
+				Instances: []corev1alpha1.ServiceClusterInstanceCount{
+					{CRD: corev1alpha1.ObjectReference{Name: "couchdbs.couchdb.io"}, Count: 3},
+				},
+			},
 		},
 	}
 
@@ -246,6 +267,12 @@ func TestCatalogReconciler(t *testing.T) {
 		assert.Equal(t, regionFound.Spec.Provider.Name, provider.Name, "Wrong Region provider name")
 		assert.Equal(t, regionFound.Spec.Metadata.Description, serviceCluster.Spec.Metadata.Description, "Wrong Region description")
 		assert.Equal(t, regionFound.Spec.Metadata.DisplayName, serviceCluster.Spec.Metadata.DisplayName, "Wrong Region display name")
+		if assert.NotNil(t, regionFound.Spec.Health, "Region health missing") {
+			assert.Equal(t, catalogv1alpha1.RegionDegraded, regionFound.Spec.Health.State, "Wrong Region health state")
+			assert.Equal(t, "ServiceClusterUnhealthy", regionFound.Spec.Health.Reason, "Wrong Region health reason")
+		}
+		assert.Equal(t, serviceCluster.Status.Capacity, regionFound.Spec.Capacity, "Wrong Region capacity")
+		assert.Equal(t, serviceCluster.Spec.Maintenance, regionFound.Spec.Maintenance, "Wrong Region maintenance")
 
 		// Check ServiceClusterAssignment
 		require.NoError(t, client.Get(ctx, types.NamespacedName{
@@ -254,6 +281,10 @@ func TestCatalogReconciler(t *testing.T) {
 		}, serviceClusterAssignmentFound), "getting ServiceClusterAssignment error")
 		assert.Equal(t, serviceClusterAssignmentFound.Spec.ServiceCluster.Name, serviceCluster.Name, "Wrong ServiceCluster name")
 		assert.Equal(t, serviceClusterAssignmentFound.Spec.ManagementClusterNamespace.Name, tenantNamespace.Name, "Wrong ManagementCluster Namespace name.")
+		if assert.NotNil(t, serviceClusterAssignmentFound.Spec.Tenant) {
+			assert.Equal(t, tenantAccount.Name, serviceClusterAssignmentFound.Spec.Tenant.Name, "Wrong Tenant name")
+			assert.Equal(t, tenantAccount.Labels, serviceClusterAssignmentFound.Spec.Tenant.Labels, "Tenant labels should be taken from the Account")
+		}
 
 		// Check Provider Role
 		require.NoError(t, client.Get(ctx, types.NamespacedName{
@@ -271,7 +302,7 @@ func TestCatalogReconciler(t *testing.T) {
 			Name:      fmt.Sprintf("kubecarrier:provider:%s", catalogEntry.Name),
 			Namespace: tenantAccount.Status.Namespace.Name,
 		}, providerRoleBindingFound), "getting RoleBinding error")
-		assert.Equal(t, providerRoleBindingFound.Subjects, provider.Spec.Subjects, "Subjects is different")
+		assert.Equal(t, providerRoleBindingFound.Subjects, provider.RBACSubjects(catalogv1alpha1.SubjectRoleViewer), "Subjects is different")
 
 		// Check Tenant Role
 		require.NoError(t, client.Get(ctx, types.NamespacedName{
@@ -279,7 +310,7 @@ func TestCatalogReconciler(t *testing.T) {
 			Namespace: tenantAccount.Status.Namespace.Name,
 		}, tenantRoleFound), "getting Role error")
 		assert.Contains(t, tenantRoleFound.Rules, rbacv1.PolicyRule{
-			Verbs:     []string{rbacv1.VerbAll},
+			Verbs:     []string{"get", "list", "watch"},
 			APIGroups: []string{catalogEntry.Status.TenantCRD.APIGroup},
 			Resources: []string{catalogEntry.Status.TenantCRD.Plural},
 		}, "Missing the PolicyRule")
@@ -289,7 +320,7 @@ func TestCatalogReconciler(t *testing.T) {
 			Name:      fmt.Sprintf("kubecarrier:tenant:%s", catalogEntry.Name),
 			Namespace: tenantAccount.Status.Namespace.Name,
 		}, tenantRoleBindingFound), "getting RoleBinding error")
-		assert.Equal(t, tenantRoleBindingFound.Subjects, tenantAccount.Spec.Subjects, "Subjects is different")
+		assert.Equal(t, tenantRoleBindingFound.Subjects, tenantAccount.RBACSubjects(catalogv1alpha1.SubjectRoleViewer), "Subjects is different")
 	}) {
 		t.FailNow()
 	}
@@ -382,3 +413,165 @@ func TestCatalogReconciler(t *testing.T) {
 		}, tenantRoleBindingCheck)), "tenant RoleBinding should be gone")
 	})
 }
+
+func TestCatalogReconcilerBundledOfferings(t *testing.T) {
+	provider := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-provider"},
+	}
+	tenant := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-tenant"},
+		Status: catalogv1alpha1.AccountStatus{
+			Namespace: &catalogv1alpha1.ObjectReference{Name: "example-tenant"},
+		},
+	}
+	newCatalogEntry := func(name string, bundle ...string) catalogv1alpha1.CatalogEntry {
+		catalogEntry := catalogv1alpha1.CatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: catalogv1alpha1.CatalogEntryStatus{
+				TenantCRD: &catalogv1alpha1.CRDInformation{
+					Name:     name + ".eu-west-1",
+					APIGroup: "eu-west-1",
+					Kind:     name,
+				},
+			},
+		}
+		for _, b := range bundle {
+			catalogEntry.Spec.Bundle = append(catalogEntry.Spec.Bundle, catalogv1alpha1.ObjectReference{Name: b})
+		}
+		return catalogEntry
+	}
+	db := newCatalogEntry("db", "backup", "snapshot")
+	backup := newCatalogEntry("backup")
+	couchDB := newCatalogEntry("couchdb")
+	couchDB.Spec.Lifecycle = &catalogv1alpha1.Lifecycle{
+		State:   catalogv1alpha1.LifecycleStateDeprecated,
+		Message: "use db instead",
+	}
+
+	r := &CatalogReconciler{}
+	// the snapshot CatalogEntry is not ready, so it's not part of the Offering.
+	offerings := r.buildDesiredOfferings(provider, tenant, []catalogv1alpha1.CatalogEntry{db, backup, couchDB})
+	require.Len(t, offerings, 2)
+	assert.Equal(t, "db.eu-west-1", offerings[0].Name)
+	assert.Equal(t, *db.Status.TenantCRD, offerings[0].Spec.CRD)
+	assert.Equal(t, []catalogv1alpha1.CRDInformation{*backup.Status.TenantCRD}, offerings[0].Spec.BundledCRDs)
+	assert.Equal(t, "couchdb.eu-west-1", offerings[1].Name)
+	assert.Empty(t, offerings[1].Spec.BundledCRDs)
+	assert.Nil(t, offerings[0].Spec.Lifecycle)
+	assert.Equal(t, couchDB.Spec.Lifecycle, offerings[1].Spec.Lifecycle)
+}
+
+func TestCatalogReconcilerInvalidBundles(t *testing.T) {
+	provider := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-provider"},
+	}
+	tenant := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-tenant"},
+		Status: catalogv1alpha1.AccountStatus{
+			Namespace: &catalogv1alpha1.ObjectReference{Name: "example-tenant"},
+		},
+	}
+	newCatalogEntry := func(name string, bundle ...string) catalogv1alpha1.CatalogEntry {
+		catalogEntry := catalogv1alpha1.CatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: catalogv1alpha1.CatalogEntryStatus{
+				TenantCRD: &catalogv1alpha1.CRDInformation{Name: name + ".eu-west-1"},
+			},
+		}
+		for _, b := range bundle {
+			catalogEntry.Spec.Bundle = append(catalogEntry.Spec.Bundle, catalogv1alpha1.ObjectReference{Name: b})
+		}
+		return catalogEntry
+	}
+	r := &CatalogReconciler{}
+
+	t.Run("cycle", func(t *testing.T) {
+		offerings := r.buildDesiredOfferings(provider, tenant, []catalogv1alpha1.CatalogEntry{
+			newCatalogEntry("a", "b"),
+			newCatalogEntry("b", "a"),
+		})
+		// bundles are never bundled themselves, so both are offered
+		require.Len(t, offerings, 2)
+		assert.Empty(t, offerings[0].Spec.BundledCRDs)
+		assert.Empty(t, offerings[1].Spec.BundledCRDs)
+	})
+
+	t.Run("double membership", func(t *testing.T) {
+		backup := newCatalogEntry("backup")
+		offerings := r.buildDesiredOfferings(provider, tenant, []catalogv1alpha1.CatalogEntry{
+			newCatalogEntry("db2", "backup"),
+			newCatalogEntry("db1", "backup"),
+			backup,
+		})
+		// the first bundle by name wins
+		require.Len(t, offerings, 2)
+		assert.Equal(t, "db2.eu-west-1", offerings[0].Name)
+		assert.Empty(t, offerings[0].Spec.BundledCRDs)
+		assert.Equal(t, "db1.eu-west-1", offerings[1].Name)
+		assert.Equal(t, []catalogv1alpha1.CRDInformation{*backup.Status.TenantCRD}, offerings[1].Spec.BundledCRDs)
+	})
+}
+
+func TestBuildDesiredTenantRolesAndRoleBindings(t *testing.T) {
+	viewer := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "viewer"}
+	editor := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "editor"}
+	admin := rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "admins"}
+	tenant := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: catalogv1alpha1.AccountSpec{
+			Roles: []catalogv1alpha1.AccountRole{catalogv1alpha1.TenantRole},
+			Subjects: []catalogv1alpha1.AccountSubject{
+				{Subject: viewer, Role: catalogv1alpha1.SubjectRoleViewer},
+				{Subject: editor, Role: catalogv1alpha1.SubjectRoleEditor},
+				// subjects without a role are Admins
+				{Subject: admin},
+			},
+		},
+		Status: catalogv1alpha1.AccountStatus{
+			Namespace: &catalogv1alpha1.ObjectReference{Name: "team-a"},
+		},
+	}
+
+	readOnlyKey := &catalogv1alpha1.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "monitoring", Namespace: "team-a"},
+		Spec:       catalogv1alpha1.APIKeySpec{ReadOnly: true},
+	}
+	readWriteKey := &catalogv1alpha1.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "team-a"},
+	}
+	readOnlyKeyUser := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "kubecarrier:apikey:team-a:monitoring"}
+	readWriteKeyUser := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "kubecarrier:apikey:team-a:ci"}
+
+	r := &CatalogReconciler{
+		Client: fakeclient.NewFakeClientWithScheme(testScheme, readOnlyKey, readWriteKey),
+	}
+	roles, roleBindings, err := r.buildDesiredTenantRolesAndRoleBindings(
+		context.Background(), []*catalogv1alpha1.Account{tenant}, "couchdbs.eu-west-1", catalogv1alpha1.CRDInformation{
+			APIGroup: "eu-west-1.team-a",
+			Plural:   "couchdbs",
+		})
+	require.NoError(t, err)
+
+	verbs := map[string][]string{}
+	for _, role := range roles {
+		require.Len(t, role.Rules, 1)
+		assert.Equal(t, []string{"couchdbs"}, role.Rules[0].Resources)
+		verbs[role.Name] = role.Rules[0].Verbs
+	}
+	assert.Equal(t, map[string][]string{
+		"kubecarrier:tenant:couchdbs.eu-west-1":        {"get", "list", "watch"},
+		"kubecarrier:tenant-editor:couchdbs.eu-west-1": {"create", "update", "patch"},
+		"kubecarrier:tenant-admin:couchdbs.eu-west-1":  {"delete", "deletecollection"},
+	}, verbs)
+
+	subjects := map[string][]rbacv1.Subject{}
+	for _, roleBinding := range roleBindings {
+		assert.Equal(t, roleBinding.Name, roleBinding.RoleRef.Name)
+		subjects[roleBinding.Name] = roleBinding.Subjects
+	}
+	assert.Equal(t, map[string][]rbacv1.Subject{
+		"kubecarrier:tenant:couchdbs.eu-west-1":        {viewer, editor, admin, readWriteKeyUser, readOnlyKeyUser},
+		"kubecarrier:tenant-editor:couchdbs.eu-west-1": {editor, admin, readWriteKeyUser},
+		"kubecarrier:tenant-admin:couchdbs.eu-west-1":  {admin},
+	}, subjects, "APIKeys are bound by their scope and never as Admins")
+}
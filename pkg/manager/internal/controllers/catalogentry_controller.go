@@ -22,11 +22,13 @@ import (
 	"strings"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -48,13 +50,15 @@ const (
 // CatalogEntryReconciler reconciles a CatalogEntry object
 type CatalogEntryReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
 }
 
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile function reconciles the CatalogEntry object which specified by the request. Currently, it does the following:
 // - Fetch the CatalogEntry object.
@@ -269,8 +273,10 @@ func (r *CatalogEntryReconciler) reconcileDerivedCustomResource(ctx context.Cont
 			Namespace: catalogEntry.Namespace,
 		},
 		Spec: catalogv1alpha1.DerivedCustomResourceSpec{
-			BaseCRD: catalogEntry.Spec.BaseCRD,
-			Expose:  catalogEntry.Spec.Derive.Expose,
+			BaseCRD:           catalogEntry.Spec.BaseCRD,
+			Expose:            catalogEntry.Spec.Derive.Expose,
+			ReferencedObjects: catalogEntry.Spec.ReferencedObjects,
+			ObjectReferences:  catalogEntry.Spec.ObjectReferences,
 		},
 	}
 
@@ -379,6 +385,14 @@ func (r *CatalogEntryReconciler) updateStatus(
 ) error {
 	catalogEntry.Status.ObservedGeneration = catalogEntry.Generation
 	if condition != nil {
+		if current, _ := catalogEntry.Status.GetCondition(condition.Type); current.Status != condition.Status ||
+			current.Reason != condition.Reason {
+			eventType := corev1.EventTypeNormal
+			if condition.Status != catalogv1alpha1.ConditionTrue {
+				eventType = corev1.EventTypeWarning
+			}
+			r.Recorder.Event(catalogEntry, eventType, condition.Reason, condition.Message)
+		}
 		catalogEntry.Status.SetCondition(*condition)
 	}
 	if err := r.Status().Update(ctx, catalogEntry); err != nil {
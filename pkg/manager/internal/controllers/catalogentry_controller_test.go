@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -169,10 +170,12 @@ func TestCatalogEntryReconciler(t *testing.T) {
 
 	client := fakeclient.NewFakeClientWithScheme(testScheme, catalogEntry, baseCRD, provider, derivedCRD, providerNS)
 	log := testutil.NewLogger(t)
+	recorder := record.NewFakeRecorder(10)
 	r := &CatalogEntryReconciler{
-		Client: client,
-		Log:    log,
-		Scheme: testScheme,
+		Client:   client,
+		Log:      log,
+		Recorder: recorder,
+		Scheme:   testScheme,
 	}
 	ctx := context.Background()
 	reconcileLoop := func() {
@@ -219,6 +222,10 @@ func TestCatalogEntryReconciler(t *testing.T) {
 		readyCondition, readyConditionExists := catalogEntry.Status.GetCondition(catalogv1alpha1.CatalogEntryReady)
 		assert.True(t, readyConditionExists, "Ready Condition is not set")
 		assert.Equal(t, catalogv1alpha1.ConditionTrue, readyCondition.Status, "Wrong Ready condition.Status")
+		if assert.Len(t, recorder.Events, 2, "expected events for the unready and ready transitions") {
+			assert.Contains(t, <-recorder.Events, "Warning DerivedCustomResourceUnready")
+			assert.Contains(t, <-recorder.Events, "Normal CatalogEntryReady")
+		}
 
 		// Check CatalogEntry Status
 		assert.Equal(t, catalogEntry.Status.TenantCRD.Kind, derivedCRD.Spec.Names.Kind, "CRD Kind is wrong")
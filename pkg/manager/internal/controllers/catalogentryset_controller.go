@@ -20,30 +20,45 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	"k8c.io/utils/pkg/owner"
 	"k8c.io/utils/pkg/util"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/consolidation"
+	"k8c.io/kubecarrier/pkg/internal/constants"
+	internalreconcile "k8c.io/kubecarrier/pkg/internal/reconcile"
 )
 
-const catalogEntriesLabel = "catalogentries.kubecarrier.io/controlled-by"
+const (
+	catalogEntriesLabel                = "catalogentries.kubecarrier.io/controlled-by"
+	catalogEntrySetControllerFinalizer = "catalogentryset.kubecarrier.io/controller"
+)
 
 // CatalogEntrySetReconciler reconciles a CatalogEntrySet object
 type CatalogEntrySetReconciler struct {
 	client.Client
 	Log    logr.Logger
 	Scheme *runtime.Scheme
+
+	// SchedulingRunner runs the scheduling of instances of aggregated CRDs, optional.
+	SchedulingRunner *consolidation.Runner
 }
 
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentrysets,verbs=get;list;watch;update
@@ -51,24 +66,32 @@ type CatalogEntrySetReconciler struct {
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=get;list;watch;update;create;delete
 // +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries/status,verbs=get
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=customresourcediscoverysets,verbs=get;watch;update;create;delete
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;update;create;delete
 
 func (r *CatalogEntrySetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
+	log := r.Log.WithValues("catalogEntrySet", req.NamespacedName)
 
 	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{}
 	if err := r.Get(ctx, req.NamespacedName, catalogEntrySet); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if util.AddFinalizer(catalogEntrySet, metav1.FinalizerDeleteDependents) {
+	if !catalogEntrySet.DeletionTimestamp.IsZero() {
+		// kube controller-manager foregroundDeletion waits until every owned object is deleted,
+		// we only need to cleanup objects that are not covered by the garbage collection.
+		if err := r.handleDeletion(ctx, catalogEntrySet); err != nil {
+			return ctrl.Result{}, fmt.Errorf("handling deletion: %w", err)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	addedDeleteDependents := util.AddFinalizer(catalogEntrySet, metav1.FinalizerDeleteDependents)
+	if util.AddFinalizer(catalogEntrySet, catalogEntrySetControllerFinalizer) || addedDeleteDependents {
 		if err := r.Client.Update(ctx, catalogEntrySet); err != nil {
 			return ctrl.Result{}, fmt.Errorf("updating CatalogEntrySet finalizers: %w", err)
 		}
 	}
-	if !catalogEntrySet.DeletionTimestamp.IsZero() {
-		// nothing to do, let kube controller-manager foregroundDeletion wait until every created object is deleted
-		return ctrl.Result{}, nil
-	}
 
 	// Reconcile CustomResourceDiscoverySet object
 	currentCustomResourceDiscoverySet, err := r.reconcileCustomResourceDiscoverySet(ctx, catalogEntrySet)
@@ -98,7 +121,10 @@ func (r *CatalogEntrySetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, er
 	}
 
 	// Reconcile CatalogEntry objects
-	var unreadyCatalogEntryNames []string
+	var (
+		unreadyCatalogEntryNames []string
+		readyCatalogEntries      []*catalogv1alpha1.CatalogEntry
+	)
 	existingCatalogEntryNames := map[string]struct{}{}
 	for _, crdReference := range currentCustomResourceDiscoverySet.Status.ManagementClusterCRDs {
 		currentCatalogEntry, err := r.reconcileCatalogEntry(ctx, crdReference, catalogEntrySet)
@@ -110,6 +136,8 @@ func (r *CatalogEntrySetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, er
 
 		if !currentCatalogEntry.IsReady() {
 			unreadyCatalogEntryNames = append(unreadyCatalogEntryNames, currentCatalogEntry.Name)
+		} else {
+			readyCatalogEntries = append(readyCatalogEntries, currentCatalogEntry)
 		}
 	}
 
@@ -132,6 +160,11 @@ func (r *CatalogEntrySetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, er
 		}
 	}
 
+	// Reconcile the aggregated CRD for scheduling
+	if err := r.reconcileScheduling(ctx, log, catalogEntrySet, readyCatalogEntries); err != nil {
+		return ctrl.Result{}, fmt.Errorf("reconciling scheduling: %w", err)
+	}
+
 	// Report status
 	if len(unreadyCatalogEntryNames) > 0 {
 		if err := r.updateStatus(ctx, catalogEntrySet, catalogv1alpha1.CatalogEntrySetCondition{
@@ -226,7 +259,10 @@ func (r *CatalogEntrySetReconciler) reconcileCatalogEntry(
 			BaseCRD: catalogv1alpha1.ObjectReference{
 				Name: crdReference.CRD.Name,
 			},
-			Derive: catalogEntrySet.Spec.Derive,
+			Derive:            catalogEntrySet.Spec.Derive,
+			ReferencedObjects: catalogEntrySet.Spec.ReferencedObjects,
+			Metering:          catalogEntrySet.Spec.Metering,
+			Migration:         catalogEntrySet.Spec.Migration,
 		},
 	}
 	err := controllerutil.SetControllerReference(catalogEntrySet, desiredCatalogEntry, r.Scheme)
@@ -261,13 +297,219 @@ func (r *CatalogEntrySetReconciler) reconcileCatalogEntry(
 	return currentCatalogEntry, nil
 }
 
+// reconcileScheduling manages the aggregated CRD of the CatalogEntrySet.
+// The aggregated CRD is derived from the Tenant facing CRD of the first ready CatalogEntry,
+// its instances are scheduled to one of the ServiceClusters by the InstanceSchedulingReconciler.
+func (r *CatalogEntrySetReconciler) reconcileScheduling(
+	ctx context.Context, log logr.Logger,
+	catalogEntrySet *catalogv1alpha1.CatalogEntrySet,
+	readyCatalogEntries []*catalogv1alpha1.CatalogEntry,
+) error {
+	if catalogEntrySet.Spec.Scheduling == nil {
+		catalogEntrySet.Status.AggregatedCRD = nil
+		return r.cleanupScheduling(ctx, catalogEntrySet)
+	}
+	if len(readyCatalogEntries) == 0 {
+		// wait for the first CatalogEntry
+		return nil
+	}
+	sort.Slice(readyCatalogEntries, func(i, j int) bool {
+		return readyCatalogEntries[i].Name < readyCatalogEntries[j].Name
+	})
+
+	tenantCRD := &apiextensionsv1.CustomResourceDefinition{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name: readyCatalogEntries[0].Status.TenantCRD.Name,
+	}, tenantCRD); err != nil {
+		return fmt.Errorf("getting Tenant CRD: %w", err)
+	}
+	provider, err := catalogv1alpha1.GetAccountByAccountNamespace(ctx, r.Client, catalogEntrySet.Namespace)
+	if err != nil {
+		return fmt.Errorf("getting the Provider by Provider Namespace: %w", err)
+	}
+
+	desiredCRD, err := r.buildDesiredAggregatedCRD(catalogEntrySet, provider, tenantCRD)
+	if err != nil {
+		return err
+	}
+	// make sure we don't take over a CRD of another object
+	ownedCRDs := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := r.List(ctx, ownedCRDs, owner.OwnedBy(catalogEntrySet, r.Scheme)); err != nil {
+		return fmt.Errorf("listing owned CRDs: %w", err)
+	}
+	var owned bool
+	for _, crd := range ownedCRDs.Items {
+		if crd.Name == desiredCRD.Name {
+			owned = true
+			break
+		}
+	}
+	if !owned {
+		existingCRD := &apiextensionsv1.CustomResourceDefinition{}
+		err := r.Get(ctx, types.NamespacedName{Name: desiredCRD.Name}, existingCRD)
+		if err == nil {
+			return fmt.Errorf("aggregated CRD %s already exists and is not owned by this CatalogEntrySet", desiredCRD.Name)
+		}
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("getting aggregated CRD: %w", err)
+		}
+	}
+
+	currentCRD, err := internalreconcile.CustomResourceDefinition(ctx, log, r.Client, desiredCRD)
+	if err != nil {
+		return fmt.Errorf("reconciling aggregated CRD: %w", err)
+	}
+
+	if !util.CRDIsEstablished(currentCRD) {
+		// wait for the CRD to be established
+		return nil
+	}
+	catalogEntrySet.Status.AggregatedCRD = &catalogv1alpha1.CRDInformation{
+		Name:     desiredCRD.Name,
+		APIGroup: desiredCRD.Spec.Group,
+		Kind:     desiredCRD.Spec.Names.Kind,
+		Plural:   desiredCRD.Spec.Names.Plural,
+	}
+	for _, version := range desiredCRD.Spec.Versions {
+		catalogEntrySet.Status.AggregatedCRD.Versions = append(catalogEntrySet.Status.AggregatedCRD.Versions, catalogv1alpha1.CRDVersion{
+			Name:    version.Name,
+			Schema:  version.Schema,
+			Storage: version.Storage,
+		})
+	}
+
+	if r.SchedulingRunner == nil {
+		return nil
+	}
+	storageVersion := getStorageVersion(desiredCRD)
+	config := schedulingConfig{
+		AggregatedGVK: schema.GroupVersionKind{
+			Group:   desiredCRD.Spec.Group,
+			Version: storageVersion,
+			Kind:    desiredCRD.Spec.Names.Kind,
+		},
+	}
+	for _, catalogEntry := range readyCatalogEntries {
+		config.RegionalGVKs = append(config.RegionalGVKs, schema.GroupVersionKind{
+			Group:   catalogEntry.Status.TenantCRD.APIGroup,
+			Version: storageVersion,
+			Kind:    catalogEntry.Status.TenantCRD.Kind,
+		})
+	}
+	key := types.NamespacedName{
+		Name:      catalogEntrySet.Name,
+		Namespace: catalogEntrySet.Namespace,
+	}
+	if err := r.SchedulingRunner.Ensure(key, config, func(mgr ctrl.Manager) error {
+		return setupInstanceScheduling(mgr, r.Log.WithName("InstanceScheduling").WithValues("catalogEntrySet", key), key, config)
+	}); err != nil {
+		return fmt.Errorf("starting scheduling: %w", err)
+	}
+	return nil
+}
+
+func (r *CatalogEntrySetReconciler) buildDesiredAggregatedCRD(
+	catalogEntrySet *catalogv1alpha1.CatalogEntrySet,
+	provider *catalogv1alpha1.Account,
+	tenantCRD *apiextensionsv1.CustomResourceDefinition,
+) (*apiextensionsv1.CustomResourceDefinition, error) {
+	group := catalogEntrySet.Name + "." + provider.Name
+	aggregatedCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: tenantCRD.Spec.Names.Plural + "." + group,
+			Labels: map[string]string{
+				OriginNamespaceLabel:         catalogEntrySet.Namespace,
+				constants.AggregatedCRDLabel: "true",
+			},
+		},
+		Spec: *tenantCRD.Spec.DeepCopy(),
+	}
+	aggregatedCRD.Spec.Group = group
+	for i := range aggregatedCRD.Spec.Versions {
+		version := &aggregatedCRD.Spec.Versions[i]
+		// status is reported by KubeCarrier, including the scheduling decision
+		if version.Subresources == nil {
+			version.Subresources = &apiextensionsv1.CustomResourceSubresources{}
+		}
+		version.Subresources.Status = &apiextensionsv1.CustomResourceSubresourceStatus{}
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+		openAPISchema := version.Schema.OpenAPIV3Schema
+		if openAPISchema.Properties == nil {
+			openAPISchema.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+		}
+		status, ok := openAPISchema.Properties["status"]
+		if !ok {
+			status = apiextensionsv1.JSONSchemaProps{Type: "object"}
+		}
+		if status.Properties == nil {
+			status.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+		}
+		preserveUnknownFields := true
+		status.Properties["scheduling"] = apiextensionsv1.JSONSchemaProps{
+			Type:                   "object",
+			XPreserveUnknownFields: &preserveUnknownFields,
+		}
+		openAPISchema.Properties["status"] = status
+	}
+	if _, err := owner.SetOwnerReference(catalogEntrySet, aggregatedCRD, r.Scheme); err != nil {
+		return nil, fmt.Errorf("setting owner reference: %w", err)
+	}
+	return aggregatedCRD, nil
+}
+
+// cleanupScheduling stops the scheduling and deletes the aggregated CRD of the CatalogEntrySet.
+func (r *CatalogEntrySetReconciler) cleanupScheduling(
+	ctx context.Context, catalogEntrySet *catalogv1alpha1.CatalogEntrySet,
+) error {
+	if r.SchedulingRunner != nil {
+		r.SchedulingRunner.Stop(types.NamespacedName{
+			Name:      catalogEntrySet.Name,
+			Namespace: catalogEntrySet.Namespace,
+		})
+	}
+
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := r.List(ctx, crdList, owner.OwnedBy(catalogEntrySet, r.Scheme)); err != nil {
+		return fmt.Errorf("listing owned CRDs: %w", err)
+	}
+	for _, crd := range crdList.Items {
+		if err := r.Delete(ctx, &crd); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("deleting CRD: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CatalogEntrySetReconciler) handleDeletion(
+	ctx context.Context, catalogEntrySet *catalogv1alpha1.CatalogEntrySet,
+) error {
+	if err := r.cleanupScheduling(ctx, catalogEntrySet); err != nil {
+		return err
+	}
+
+	if util.RemoveFinalizer(catalogEntrySet, catalogEntrySetControllerFinalizer) {
+		if err := r.Update(ctx, catalogEntrySet); err != nil {
+			return fmt.Errorf("updating CatalogEntrySet finalizers: %w", err)
+		}
+	}
+	return nil
+}
+
 func (r *CatalogEntrySetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	enqueuer := owner.EnqueueRequestForOwner(&catalogv1alpha1.CatalogEntrySet{}, r.Scheme)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&catalogv1alpha1.CatalogEntrySet{}).
 		Owns(&catalogv1alpha1.CatalogEntry{}).
 		Owns(&corev1alpha1.CustomResourceDiscoverySet{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &apiextensionsv1.CustomResourceDefinition{}}, enqueuer)
+	if r.SchedulingRunner != nil {
+		// restart the scheduling, if it stopped unexpectedly
+		b = b.Watches(r.SchedulingRunner.Source(), &handler.EnqueueRequestForObject{})
+	}
+	return b.Complete(r)
 }
 
 func (r *CatalogEntrySetReconciler) updateStatus(
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,6 +33,7 @@ import (
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/constants"
 )
 
 func TestCatalogEntrySetReconciler(t *testing.T) {
@@ -194,3 +196,104 @@ func TestCatalogEntrySetReconciler(t *testing.T) {
 		assert.Equal(t, catalogv1alpha1.ConditionTrue, ready.Status)
 	}
 }
+
+func TestCatalogEntrySetReconcilerScheduling(t *testing.T) {
+	provider := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "example",
+		},
+	}
+	tenantCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "couchdbs.eu-west-1.example",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "eu-west-1.example",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:     "CouchDB",
+				ListKind: "CouchDBList",
+				Plural:   "couchdbs",
+				Singular: "couchdb",
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1alpha1",
+					Served:  true,
+					Storage: true,
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+						},
+					},
+				},
+			},
+		},
+	}
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdb",
+			Namespace: "example",
+		},
+		Spec: catalogv1alpha1.CatalogEntrySetSpec{
+			Scheduling: &catalogv1alpha1.SchedulingConfig{
+				Policy: catalogv1alpha1.SchedulingPolicyLeastLoaded,
+			},
+		},
+	}
+	catalogEntry := &catalogv1alpha1.CatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdb.eu-west-1",
+			Namespace: "example",
+		},
+		Status: catalogv1alpha1.CatalogEntryStatus{
+			TenantCRD: &catalogv1alpha1.CRDInformation{
+				Name:     tenantCRD.Name,
+				APIGroup: tenantCRD.Spec.Group,
+				Kind:     tenantCRD.Spec.Names.Kind,
+				Plural:   tenantCRD.Spec.Names.Plural,
+				Region:   catalogv1alpha1.ObjectReference{Name: "eu-west-1"},
+			},
+		},
+	}
+	r := &CatalogEntrySetReconciler{
+		Log:    testutil.NewLogger(t),
+		Client: fakeclient.NewFakeClientWithScheme(testScheme, provider, tenantCRD, catalogEntrySet),
+		Scheme: testScheme,
+	}
+	ctx := context.Background()
+	readyCatalogEntries := []*catalogv1alpha1.CatalogEntry{catalogEntry}
+
+	require.NoError(t, r.reconcileScheduling(ctx, r.Log, catalogEntrySet, readyCatalogEntries))
+	assert.Nil(t, catalogEntrySet.Status.AggregatedCRD, "aggregated CRD is not established yet")
+
+	aggregatedCRD := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: "couchdbs.couchdb.example"}, aggregatedCRD))
+	assert.Equal(t, "couchdb.example", aggregatedCRD.Spec.Group)
+	assert.Equal(t, "true", aggregatedCRD.Labels[constants.AggregatedCRDLabel])
+	if assert.Len(t, aggregatedCRD.Spec.Versions, 1) {
+		version := aggregatedCRD.Spec.Versions[0]
+		assert.NotNil(t, version.Subresources.Status)
+		assert.Contains(t, version.Schema.OpenAPIV3Schema.Properties["status"].Properties, "scheduling")
+	}
+
+	aggregatedCRD.Status.Conditions = []apiextensionsv1.CustomResourceDefinitionCondition{
+		{
+			Type:   apiextensionsv1.Established,
+			Status: apiextensionsv1.ConditionTrue,
+		},
+	}
+	require.NoError(t, r.Status().Update(ctx, aggregatedCRD))
+
+	require.NoError(t, r.reconcileScheduling(ctx, r.Log, catalogEntrySet, readyCatalogEntries))
+	if assert.NotNil(t, catalogEntrySet.Status.AggregatedCRD) {
+		assert.Equal(t, "couchdbs.couchdb.example", catalogEntrySet.Status.AggregatedCRD.Name)
+		assert.Equal(t, "CouchDB", catalogEntrySet.Status.AggregatedCRD.Kind)
+	}
+
+	// disabling scheduling removes the aggregated CRD
+	catalogEntrySet.Spec.Scheduling = nil
+	require.NoError(t, r.reconcileScheduling(ctx, r.Log, catalogEntrySet, readyCatalogEntries))
+	assert.Nil(t, catalogEntrySet.Status.AggregatedCRD)
+	assert.True(t, errors.IsNotFound(r.Get(ctx, types.NamespacedName{Name: aggregatedCRD.Name}, aggregatedCRD)))
+}
@@ -26,7 +26,6 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	unstructuredv1 "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -42,6 +41,7 @@ import (
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
 	internalreconcile "k8c.io/kubecarrier/pkg/internal/reconcile"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
 )
 
 const (
@@ -503,10 +503,29 @@ func filterPrinterColumns(
 			continue
 		}
 
+		columnPath, err := fieldpath.Parse(printerColumn.JSONPath)
+		if err != nil {
+			// we can't tell what's displayed by complex expressions, so we omit them
+			continue
+		}
 		for _, exposedField := range exposeConfig.Fields {
-			if strings.HasPrefix(printerColumn.JSONPath, exposedField.JSONPath) {
-				filtered = append(filtered, printerColumn)
+			exposedPath, err := fieldpath.Parse(exposedField.JSONPath)
+			if err != nil {
+				continue
+			}
+			if !exposedPath.Covers(columnPath) {
+				continue
+			}
+			if isRenamed(exposedField) {
+				// renamed fields have no wildcards, so the column path continues below the tenant path
+				tenantPath, err := fieldpath.Parse(exposedField.TenantPath)
+				if err != nil {
+					break
+				}
+				printerColumn.JSONPath = append(tenantPath, columnPath[len(exposedPath):]...).String()
 			}
+			filtered = append(filtered, printerColumn)
+			break
 		}
 	}
 
@@ -526,31 +545,109 @@ func filterSchema(
 		return apiextensionsv1.JSONSchemaProps{}, fmt.Errorf("filter: %w", err)
 	}
 
-	return walkFilterSchema(jsonSchema, obj)
+	filtered, err := walkFilterSchema(jsonSchema, obj)
+	if err != nil {
+		return apiextensionsv1.JSONSchemaProps{}, err
+	}
+	return renameSchemaFields(jsonSchema, filtered, exposeConfig)
 }
 
-type dummyObject map[string]dummyObject
+// isRenamed returns true if the field is presented under a different path to tenants.
+func isRenamed(field catalogv1alpha1.FieldPath) bool {
+	return field.TenantPath != "" && field.TenantPath != field.JSONPath
+}
 
-func dummyObjectToInterface(d dummyObject) map[string]interface{} {
-	m := map[string]interface{}{}
-	for key, subd := range d {
-		m[key] = dummyObjectToInterface(subd)
+// renameSchemaFields adds the schema of renamed fields at their TenantPath.
+func renameSchemaFields(
+	in, out apiextensionsv1.JSONSchemaProps,
+	exposeConfig catalogv1alpha1.VersionExposeConfig,
+) (apiextensionsv1.JSONSchemaProps, error) {
+	for _, field := range exposeConfig.Fields {
+		if !isRenamed(field) {
+			continue
+		}
+		providerPath, err := fieldpath.Parse(field.JSONPath)
+		if err != nil {
+			return out, fmt.Errorf("renaming field %s: %w", field.JSONPath, err)
+		}
+		tenantPath, err := fieldpath.Parse(field.TenantPath)
+		if err != nil {
+			return out, fmt.Errorf("renaming field %s: %w", field.JSONPath, err)
+		}
+
+		fieldSchema, err := fieldpath.SchemaAt(providerPath, &in)
+		if err != nil {
+			return out, fmt.Errorf("renaming field %s: %w", field.JSONPath, err)
+		}
+		parentSchema, err := fieldpath.SchemaAt(providerPath[:len(providerPath)-1], &in)
+		if err != nil {
+			return out, fmt.Errorf("renaming field %s: %w", field.JSONPath, err)
+		}
+		last := providerPath[len(providerPath)-1]
+		required := last.Type == fieldpath.FieldSegment && containsString(parentSchema.Required, last.Field)
+
+		out, err = insertSchema(out, tenantPath, *fieldSchema.DeepCopy(), required)
+		if err != nil {
+			return out, fmt.Errorf("renaming field %s to %s: %w", field.JSONPath, field.TenantPath, err)
+		}
 	}
-	return m
+	return out, nil
 }
 
-func interfaceToDummyObject(m map[string]interface{}) dummyObject {
-	d := dummyObject{}
-	for key, subd := range m {
-		if subd == nil {
-			d[key] = nil
-			continue
+// insertSchema sets the schema of the field at the given path, missing parent objects are created.
+func insertSchema(
+	schema apiextensionsv1.JSONSchemaProps,
+	path fieldpath.Path,
+	fieldSchema apiextensionsv1.JSONSchemaProps,
+	required bool,
+) (apiextensionsv1.JSONSchemaProps, error) {
+	segment := path[0]
+	if segment.Type != fieldpath.FieldSegment {
+		return schema, fmt.Errorf("array selectors are not supported")
+	}
+
+	// copy the properties, as they might be shared with the schema of the base CRD
+	properties := map[string]apiextensionsv1.JSONSchemaProps{}
+	for field, props := range schema.Properties {
+		properties[field] = props
+	}
+	schema.Properties = properties
+
+	if len(path) == 1 {
+		properties[segment.Field] = fieldSchema
+		if required && !containsString(schema.Required, segment.Field) {
+			schema.Required = append(append([]string{}, schema.Required...), segment.Field)
+		}
+		return schema, nil
+	}
+
+	sub, ok := properties[segment.Field]
+	if !ok {
+		sub = apiextensionsv1.JSONSchemaProps{Type: "object"}
+	}
+	sub, err := insertSchema(sub, path[1:], fieldSchema, required)
+	if err != nil {
+		return schema, err
+	}
+	properties[segment.Field] = sub
+	return schema, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
 		}
-		d[key] = interfaceToDummyObject(subd.(map[string]interface{}))
 	}
-	return d
+	return false
 }
 
+// dummyObject mirrors the structure of an object,
+// array elements are represented by the dummyItemsKey, as the schema is the same for all elements.
+type dummyObject map[string]dummyObject
+
+const dummyItemsKey = "[*]"
+
 // walkDummyObject builds an example object from a JSONSchema.
 func walkDummyObject(in apiextensionsv1.JSONSchemaProps, obj dummyObject) {
 	if in.Properties == nil && in.Items == nil {
@@ -568,31 +665,48 @@ func walkDummyObject(in apiextensionsv1.JSONSchemaProps, obj dummyObject) {
 // so walkFilterSchema can remove unselected fields from the schema.
 func markDummyObject(
 	exposeConfig catalogv1alpha1.VersionExposeConfig,
-	d dummyObject,
+	obj dummyObject,
 ) (dummyObject, error) {
-	obj := dummyObjectToInterface(d)
 	for _, field := range exposeConfig.Fields {
-		path := strings.Trim(field.JSONPath, ".") // trim trailing and leading dots
-		err := unstructuredv1.SetNestedField(obj, nil, strings.Split(path, ".")...)
+		if isRenamed(field) {
+			// renamed fields are added by renameSchemaFields
+			continue
+		}
+		path, err := fieldpath.Parse(field.JSONPath)
 		if err != nil {
 			return dummyObject{}, fmt.Errorf("filtering object fields by json path %s: %w", field.JSONPath, err)
 		}
+		markDummyObjectPath(obj, path)
 	}
 	// always allow metadata
-	err := unstructuredv1.SetNestedField(obj, nil, "metadata")
-	if err != nil {
-		return dummyObject{}, fmt.Errorf("adding default fields: %w", err)
-	}
-	err = unstructuredv1.SetNestedField(obj, nil, "apiVersion")
-	if err != nil {
-		return dummyObject{}, fmt.Errorf("adding default fields: %w", err)
-	}
-	err = unstructuredv1.SetNestedField(obj, nil, "kind")
-	if err != nil {
-		return dummyObject{}, fmt.Errorf("adding default fields: %w", err)
+	for _, field := range []string{"metadata", "apiVersion", "kind"} {
+		obj[field] = nil
 	}
+	return obj, nil
+}
 
-	return interfaceToDummyObject(obj), nil
+func markDummyObjectPath(obj dummyObject, path fieldpath.Path) {
+	for i, segment := range path {
+		key := dummyItemsKey
+		if segment.Type == fieldpath.FieldSegment {
+			key = segment.Field
+		}
+		if i == len(path)-1 {
+			obj[key] = nil
+			return
+		}
+
+		sub, ok := obj[key]
+		if ok && sub == nil {
+			// already selected by another path
+			return
+		}
+		if !ok {
+			sub = dummyObject{}
+			obj[key] = sub
+		}
+		obj = sub
+	}
 }
 
 // walkFilterSchema recurses over the apiextensionsv1.JSONSchemaProps and only retains properties that are marked with <nil> in the filterObj.
@@ -607,27 +721,64 @@ func walkFilterSchema(in apiextensionsv1.JSONSchemaProps, filterObj dummyObject)
 	// properties
 	updatedProperties := map[string]apiextensionsv1.JSONSchemaProps{}
 	for field, properties := range inProperties {
-		if obj[field] == nil {
+		subObj, ok := obj[field]
+		if !ok {
+			// not targeted by any selector
+			continue
+		}
+		if subObj == nil {
 			// this field is explicitly included/targeted by a selector
 			updatedProperties[field] = properties
 			continue
 		}
 
 		// check if sub-properties are included by a selector
-		subProperties, err := walkFilterSchema(properties, obj[field])
+		subProperties, err := walkFilterSchema(properties, subObj)
 		if err != nil {
 			return *out, err
 		}
 		if len(subProperties.Properties) == 0 &&
-			subProperties.Items == nil {
-			// subfield has neither fields nor is an array
+			subProperties.Items == nil &&
+			subProperties.AdditionalProperties == nil {
+			// subfield has neither fields nor is an array or map
 			// so we can omit it completely
 			continue
 		}
 		updatedProperties[field] = subProperties
 	}
-
 	out.Properties = updatedProperties
+
+	// hidden fields can't be required
+	var required []string
+	for _, field := range in.Required {
+		if _, ok := updatedProperties[field]; ok {
+			required = append(required, field)
+		}
+	}
+	out.Required = required
+
+	// array items
 	out.Items = nil
+	if subObj, ok := obj[dummyItemsKey]; ok &&
+		in.Items != nil && in.Items.Schema != nil {
+		items := *in.Items.Schema
+		if subObj != nil {
+			var err error
+			items, err = walkFilterSchema(items, subObj)
+			if err != nil {
+				return *out, err
+			}
+		}
+		out.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &items}
+	}
+
+	// map values, keys of maps are not part of the properties
+	out.AdditionalProperties = nil
+	for field := range obj {
+		if _, isProperty := in.Properties[field]; !isProperty && field != dummyItemsKey {
+			out.AdditionalProperties = in.AdditionalProperties
+			break
+		}
+	}
 	return *out, nil
 }
@@ -350,11 +350,16 @@ func Test_filterPrinterColumns(t *testing.T) {
 		{JSONPath: ".status.phase"},
 		{JSONPath: ".spec.test.sub.sub"},
 		{JSONPath: ".spec.field"},
+		{JSONPath: ".spec.testing"},
+		{JSONPath: ".status.conditions[0].type"},
+		{JSONPath: ".status.conditions[*].reason"},
 	}
 	exposeConfig := catalogv1alpha1.VersionExposeConfig{
 		Fields: []catalogv1alpha1.FieldPath{
 			{JSONPath: ".spec.test"},
 			{JSONPath: ".status.phase"},
+			{JSONPath: ".status.conditions[*].type"},
+			{JSONPath: ".spec.field", TenantPath: ".spec.renamed"},
 		},
 	}
 
@@ -365,6 +370,8 @@ func Test_filterPrinterColumns(t *testing.T) {
 		{JSONPath: ".apiVersion"},
 		{JSONPath: ".status.phase"},
 		{JSONPath: ".spec.test.sub.sub"},
+		{JSONPath: ".spec.renamed"},
+		{JSONPath: ".status.conditions[0].type"},
 	}, filtered)
 }
 
@@ -410,6 +417,115 @@ func Test_filterSchema(t *testing.T) {
 `, string(filteredSchameYaml))
 }
 
+func Test_filterSchemaArrays(t *testing.T) {
+	schema := apiextensionsv1.JSONSchemaProps{}
+	require.NoError(t, yaml.Unmarshal([]byte(testObjectSchema), &schema), "unmarshal schema yaml")
+
+	exposeConfig := catalogv1alpha1.VersionExposeConfig{
+		Fields: []catalogv1alpha1.FieldPath{
+			{JSONPath: ".spec.roles[*]"},
+			{JSONPath: ".status.conditions[0].reason"},
+		},
+	}
+
+	filteredSchema, err := filterSchema(schema, exposeConfig)
+	require.NoError(t, err)
+
+	filteredSchameYaml, err := yaml.Marshal(filteredSchema)
+	require.NoError(t, err, "remarshal filtered schema")
+
+	assert.Equal(t, `properties:
+  apiVersion:
+    type: string
+  kind:
+    type: string
+  spec:
+    properties:
+      roles:
+        items:
+          properties:
+            test1:
+              type: string
+        type: array
+    type: object
+  status:
+    properties:
+      conditions:
+        items:
+          properties:
+            reason:
+              type: string
+        type: array
+    type: object
+`, string(filteredSchameYaml))
+}
+
+func Test_filterSchemaRenamed(t *testing.T) {
+	schema := apiextensionsv1.JSONSchemaProps{}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+properties:
+  spec:
+    type: object
+    required:
+    - size
+    - replicas
+    properties:
+      size:
+        type: integer
+      replicas:
+        type: integer
+      engine:
+        type: string
+  status:
+    type: object
+    properties:
+      endpoints:
+        type: array
+        items:
+          properties:
+            host:
+              type: string
+`), &schema), "unmarshal schema yaml")
+
+	exposeConfig := catalogv1alpha1.VersionExposeConfig{
+		Fields: []catalogv1alpha1.FieldPath{
+			{JSONPath: ".spec.size", TenantPath: ".spec.storage.sizeGB"},
+			{JSONPath: ".spec.engine"},
+			{JSONPath: ".status.endpoints[0].host", TenantPath: ".status.host"},
+		},
+	}
+
+	filteredSchema, err := filterSchema(schema, exposeConfig)
+	require.NoError(t, err)
+
+	filteredSchameYaml, err := yaml.Marshal(filteredSchema)
+	require.NoError(t, err, "remarshal filtered schema")
+
+	assert.Equal(t, `properties:
+  spec:
+    properties:
+      engine:
+        type: string
+      storage:
+        properties:
+          sizeGB:
+            type: integer
+        required:
+        - sizeGB
+        type: object
+    type: object
+  status:
+    properties:
+      host:
+        type: string
+    type: object
+`, string(filteredSchameYaml))
+
+	// the schema of the base CRD is not modified
+	assert.Len(t, schema.Properties["spec"].Properties, 3)
+	assert.Equal(t, []string{"size", "replicas"}, schema.Properties["spec"].Required)
+}
+
 func Test_markDummyObject(t *testing.T) {
 	exposeConfig := catalogv1alpha1.VersionExposeConfig{
 		Fields: []catalogv1alpha1.FieldPath{
@@ -0,0 +1,566 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/egress"
+	"k8c.io/kubecarrier/pkg/internal/util/instance"
+)
+
+const (
+	// defaultMigrationReadinessTimeout is the time to wait for migrated instances to become ready.
+	defaultMigrationReadinessTimeout = 30 * time.Minute
+	// defaultMigrationHookTimeout is the timeout of migration hook calls.
+	defaultMigrationHookTimeout = 30 * time.Second
+	// migrationPollInterval is the interval to check the readiness of migrated instances.
+	migrationPollInterval = 10 * time.Second
+)
+
+// migrationHookRequest is the body that is posted to migration hooks.
+type migrationHookRequest struct {
+	// Hook is the name of the hook, either PreHook or PostHook.
+	Hook              catalogv1alpha1.InstanceMigrationPhaseType `json:"hook"`
+	InstanceMigration *catalogv1alpha1.InstanceMigration         `json:"instanceMigration"`
+}
+
+// migrationFailure is a permanent error of an InstanceMigration, that causes the migration to be rolled back.
+type migrationFailure struct {
+	reason  string
+	message string
+}
+
+func (f *migrationFailure) Error() string {
+	return f.message
+}
+
+// InstanceMigrationReconciler reconciles a InstanceMigration object.
+type InstanceMigrationReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+
+	// HTTPClient is used to call the migration hooks of Providers,
+	// defaults to a client that does not follow redirects.
+	HTTPClient *http.Client
+
+	// now is used to get the current time, overridden in tests.
+	now func() metav1.Time
+}
+
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=instancemigrations,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=instancemigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=offerings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=accounts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=catalog.kubecarrier.io,resources=catalogentries,verbs=get;list;watch
+
+// Reconcile moves an InstanceMigration one step forward.
+// Every step ends with a status update, which triggers the next step.
+func (r *InstanceMigrationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	var (
+		ctx    = context.Background()
+		result ctrl.Result
+		log    = r.Log.WithValues("instancemigration", req.NamespacedName)
+	)
+
+	migration := &catalogv1alpha1.InstanceMigration{}
+	if err := r.Get(ctx, req.NamespacedName, migration); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+	if !migration.DeletionTimestamp.IsZero() || migration.Status.IsFinished() {
+		return result, nil
+	}
+
+	if migration.Status.Phase == catalogv1alpha1.InstanceMigrationPhaseRollingBack {
+		return result, r.rollback(ctx, log, migration)
+	}
+
+	result, err := r.migrate(ctx, log, migration)
+	if failure, ok := err.(*migrationFailure); ok {
+		log.Info("migration failed", "reason", failure.reason, "message", failure.message)
+		migration.Status.Phase = catalogv1alpha1.InstanceMigrationPhaseRollingBack
+		migration.Status.SetCondition(catalogv1alpha1.InstanceMigrationCondition{
+			Type:    catalogv1alpha1.InstanceMigrationSucceeded,
+			Status:  catalogv1alpha1.ConditionFalse,
+			Reason:  failure.reason,
+			Message: failure.message,
+		})
+		return ctrl.Result{}, r.updateStatus(ctx, migration)
+	}
+	return result, err
+}
+
+func (r *InstanceMigrationReconciler) migrate(
+	ctx context.Context, log logr.Logger,
+	migration *catalogv1alpha1.InstanceMigration,
+) (ctrl.Result, error) {
+	var result ctrl.Result
+
+	sourceOffering, targetOffering, err := r.getOfferings(ctx, migration)
+	if err != nil {
+		return result, err
+	}
+	version := storageVersion(sourceOffering.Spec.CRD)
+	if !hasVersion(targetOffering.Spec.CRD, version) {
+		return result, &migrationFailure{
+			reason:  "VersionNotFound",
+			message: fmt.Sprintf("Offering %s has no version %s.", targetOffering.Name, version),
+		}
+	}
+	instanceNN := types.NamespacedName{
+		Name:      migration.Spec.Instance.Name,
+		Namespace: migration.Namespace,
+	}
+
+	switch migration.Status.Phase {
+	case "", catalogv1alpha1.InstanceMigrationPhasePending:
+		sourceObj := newInstance(sourceOffering.Spec.CRD, version)
+		if err := r.Get(ctx, instanceNN, sourceObj); err != nil {
+			if errors.IsNotFound(err) {
+				return result, &migrationFailure{
+					reason:  "InstanceNotFound",
+					message: fmt.Sprintf("Instance %s of Offering %s not found.", instanceNN.Name, sourceOffering.Name),
+				}
+			}
+			return result, fmt.Errorf("getting instance: %w", err)
+		}
+		now := r.now()
+		migration.Status.StartTime = &now
+		migration.Status.Phase = catalogv1alpha1.InstanceMigrationPhasePreHook
+		r.setProgressing(migration, "Started", "The migration has been started.")
+		return result, r.updateStatus(ctx, migration)
+
+	case catalogv1alpha1.InstanceMigrationPhasePreHook:
+		config, err := r.getMigrationConfig(ctx, sourceOffering)
+		if err != nil {
+			return result, err
+		}
+		if config != nil && config.PreHook != nil {
+			if err := r.callHook(ctx, config.PreHook, catalogv1alpha1.InstanceMigrationPhasePreHook, migration); err != nil {
+				return result, &migrationFailure{reason: "PreHookFailed", message: err.Error()}
+			}
+		}
+		migration.Status.Phase = catalogv1alpha1.InstanceMigrationPhaseMigrating
+		r.setProgressing(migration, "Migrating", fmt.Sprintf("The instance is migrated to Offering %s.", targetOffering.Name))
+		return result, r.updateStatus(ctx, migration)
+
+	case catalogv1alpha1.InstanceMigrationPhaseMigrating:
+		sourceObj := newInstance(sourceOffering.Spec.CRD, version)
+		if err := r.Get(ctx, instanceNN, sourceObj); err != nil {
+			if errors.IsNotFound(err) {
+				return result, &migrationFailure{
+					reason:  "InstanceNotFound",
+					message: fmt.Sprintf("Instance %s of Offering %s was removed during the migration.", instanceNN.Name, sourceOffering.Name),
+				}
+			}
+			return result, fmt.Errorf("getting instance: %w", err)
+		}
+		targetObj, err := r.ensureTargetInstance(ctx, log, migration, sourceObj, targetOffering.Spec.CRD, version)
+		if err != nil {
+			return result, err
+		}
+		if !instance.IsReady(targetObj) {
+			timeout := defaultMigrationReadinessTimeout
+			if migration.Spec.ReadinessTimeout != nil {
+				timeout = migration.Spec.ReadinessTimeout.Duration
+			}
+			remaining := migration.Status.StartTime.Add(timeout).Sub(r.now().Time)
+			if remaining <= 0 {
+				return result, &migrationFailure{
+					reason:  "ReadinessTimeout",
+					message: fmt.Sprintf("The migrated instance did not become ready within %s.", timeout),
+				}
+			}
+			if remaining > migrationPollInterval {
+				remaining = migrationPollInterval
+			}
+			result.RequeueAfter = remaining
+			return result, nil
+		}
+		migration.Status.Phase = catalogv1alpha1.InstanceMigrationPhasePostHook
+		r.setProgressing(migration, "InstanceReady", "The migrated instance is ready.")
+		return result, r.updateStatus(ctx, migration)
+
+	case catalogv1alpha1.InstanceMigrationPhasePostHook:
+		config, err := r.getMigrationConfig(ctx, sourceOffering)
+		if err != nil {
+			return result, err
+		}
+		if config != nil && config.PostHook != nil {
+			if err := r.callHook(ctx, config.PostHook, catalogv1alpha1.InstanceMigrationPhasePostHook, migration); err != nil {
+				return result, &migrationFailure{reason: "PostHookFailed", message: err.Error()}
+			}
+		}
+		migration.Status.Phase = catalogv1alpha1.InstanceMigrationPhaseSwitchingOver
+		r.setProgressing(migration, "SwitchingOver", "The original instance is replaced by the migrated instance.")
+		return result, r.updateStatus(ctx, migration)
+
+	case catalogv1alpha1.InstanceMigrationPhaseSwitchingOver:
+		sourceObj := newInstance(sourceOffering.Spec.CRD, version)
+		err := r.Get(ctx, instanceNN, sourceObj)
+		if err != nil && !errors.IsNotFound(err) {
+			return result, fmt.Errorf("getting instance: %w", err)
+		}
+		if err == nil {
+			if err := r.switchOverAggregatedInstance(ctx, migration, sourceObj, targetOffering); err != nil {
+				return result, err
+			}
+			if err := r.Delete(ctx, sourceObj); client.IgnoreNotFound(err) != nil {
+				return result, fmt.Errorf("deleting instance: %w", err)
+			}
+		}
+		now := r.now()
+		migration.Status.CompletionTime = &now
+		migration.Status.Phase = catalogv1alpha1.InstanceMigrationPhaseCompleted
+		migration.Status.SetCondition(catalogv1alpha1.InstanceMigrationCondition{
+			Type:    catalogv1alpha1.InstanceMigrationSucceeded,
+			Status:  catalogv1alpha1.ConditionTrue,
+			Reason:  "Completed",
+			Message: fmt.Sprintf("The instance was migrated to Offering %s.", targetOffering.Name),
+		})
+		log.Info("migration completed")
+		return result, r.updateStatus(ctx, migration)
+	}
+	return result, nil
+}
+
+// rollback removes the instance created by the migration.
+func (r *InstanceMigrationReconciler) rollback(
+	ctx context.Context, log logr.Logger,
+	migration *catalogv1alpha1.InstanceMigration,
+) error {
+	targetOffering := &catalogv1alpha1.Offering{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      migration.Spec.TargetOffering.Name,
+		Namespace: migration.Namespace,
+	}, targetOffering)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("getting target Offering: %w", err)
+	}
+	if err == nil {
+		targetObj := newInstance(targetOffering.Spec.CRD, storageVersion(targetOffering.Spec.CRD))
+		err := r.Get(ctx, types.NamespacedName{
+			Name:      migration.Spec.Instance.Name,
+			Namespace: migration.Namespace,
+		}, targetObj)
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("getting migrated instance: %w", err)
+		}
+		// only remove instances that were created by this migration
+		if err == nil && targetObj.GetLabels()[catalogv1alpha1.InstanceMigrationLabel] == migration.Name {
+			if err := r.Delete(ctx, targetObj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("deleting migrated instance: %w", err)
+			}
+			log.Info("removed migrated instance")
+		}
+	}
+
+	now := r.now()
+	migration.Status.CompletionTime = &now
+	migration.Status.Phase = catalogv1alpha1.InstanceMigrationPhaseFailed
+	return r.updateStatus(ctx, migration)
+}
+
+// ensureTargetInstance creates the instance in the target region, if it doesn't exist yet.
+func (r *InstanceMigrationReconciler) ensureTargetInstance(
+	ctx context.Context, log logr.Logger,
+	migration *catalogv1alpha1.InstanceMigration,
+	sourceObj *unstructured.Unstructured,
+	targetCRD catalogv1alpha1.CRDInformation, version string,
+) (*unstructured.Unstructured, error) {
+	targetObj := newInstance(targetCRD, version)
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      sourceObj.GetName(),
+		Namespace: sourceObj.GetNamespace(),
+	}, targetObj)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting migrated instance: %w", err)
+	}
+	if err == nil {
+		if targetObj.GetLabels()[catalogv1alpha1.InstanceMigrationLabel] != migration.Name {
+			return nil, &migrationFailure{
+				reason:  "AlreadyExists",
+				message: fmt.Sprintf("Instance %s of Offering %s already exists.", targetObj.GetName(), targetCRD.Name),
+			}
+		}
+		return targetObj, nil
+	}
+
+	desiredObj := newInstance(targetCRD, version)
+	for key, value := range sourceObj.Object {
+		switch key {
+		case "apiVersion", "kind", "metadata", "status":
+		default:
+			desiredObj.Object[key] = runtime.DeepCopyJSONValue(value)
+		}
+	}
+	desiredObj.SetName(sourceObj.GetName())
+	desiredObj.SetNamespace(sourceObj.GetNamespace())
+	desiredObj.SetAnnotations(sourceObj.GetAnnotations())
+	desiredObj.SetOwnerReferences(sourceObj.GetOwnerReferences())
+	labels := map[string]string{}
+	for k, v := range sourceObj.GetLabels() {
+		labels[k] = v
+	}
+	labels[catalogv1alpha1.InstanceMigrationLabel] = migration.Name
+	desiredObj.SetLabels(labels)
+	if err := r.Create(ctx, desiredObj); err != nil {
+		return nil, fmt.Errorf("creating migrated instance: %w", err)
+	}
+	log.Info("created migrated instance", "offering", targetCRD.Name)
+	return desiredObj, nil
+}
+
+// switchOverAggregatedInstance points the aggregated instance controlling the migrated instance to the new ServiceCluster,
+// so the migrated instance is managed by the aggregated instance from now on.
+func (r *InstanceMigrationReconciler) switchOverAggregatedInstance(
+	ctx context.Context,
+	migration *catalogv1alpha1.InstanceMigration,
+	sourceObj *unstructured.Unstructured,
+	targetOffering *catalogv1alpha1.Offering,
+) error {
+	ownerRef := metav1.GetControllerOf(sourceObj)
+	if ownerRef == nil {
+		return nil
+	}
+	aggregatedObj := &unstructured.Unstructured{}
+	aggregatedObj.SetGroupVersionKind(schema.FromAPIVersionAndKind(ownerRef.APIVersion, ownerRef.Kind))
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      ownerRef.Name,
+		Namespace: sourceObj.GetNamespace(),
+	}, aggregatedObj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	scheduling, err := getInstanceScheduling(aggregatedObj)
+	if err != nil {
+		return err
+	}
+	if !scheduling.IsScheduled() {
+		// not an instance of an aggregated CRD
+		return nil
+	}
+
+	scheduling.ServiceCluster = catalogv1alpha1.ObjectReference{Name: targetOffering.Spec.CRD.Region.Name}
+	scheduling.Offering = catalogv1alpha1.ObjectReference{Name: targetOffering.Name}
+	scheduling.Reason = catalogv1alpha1.InstanceMigrated
+	scheduling.Message = fmt.Sprintf("Instance was migrated by InstanceMigration %s.", migration.Name)
+	schedulingStatus, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&scheduling)
+	if err != nil {
+		return fmt.Errorf("converting scheduling status: %w", err)
+	}
+	if err := unstructured.SetNestedMap(aggregatedObj.Object, schedulingStatus, "status", "scheduling"); err != nil {
+		return fmt.Errorf("setting scheduling status: %w", err)
+	}
+	if err := r.Status().Update(ctx, aggregatedObj); err != nil {
+		return fmt.Errorf("updating %s status: %w", ownerRef.Kind, err)
+	}
+	return nil
+}
+
+// getOfferings returns the Offering of the instance and the target Offering of the migration.
+func (r *InstanceMigrationReconciler) getOfferings(
+	ctx context.Context, migration *catalogv1alpha1.InstanceMigration,
+) (source, target *catalogv1alpha1.Offering, err error) {
+	source = &catalogv1alpha1.Offering{}
+	target = &catalogv1alpha1.Offering{}
+	for _, o := range []struct {
+		name     string
+		offering *catalogv1alpha1.Offering
+	}{
+		{name: migration.Spec.Offering.Name, offering: source},
+		{name: migration.Spec.TargetOffering.Name, offering: target},
+	} {
+		if err := r.Get(ctx, types.NamespacedName{
+			Name:      o.name,
+			Namespace: migration.Namespace,
+		}, o.offering); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, nil, &migrationFailure{
+					reason:  "OfferingNotFound",
+					message: fmt.Sprintf("Offering %s not found.", o.name),
+				}
+			}
+			return nil, nil, fmt.Errorf("getting Offering: %w", err)
+		}
+	}
+	if source.Spec.Provider.Name != target.Spec.Provider.Name {
+		return nil, nil, &migrationFailure{
+			reason:  "ProviderMismatch",
+			message: fmt.Sprintf("Offerings %s and %s belong to different Providers.", source.Name, target.Name),
+		}
+	}
+	return source, target, nil
+}
+
+// getMigrationConfig returns the migration config of the CatalogEntry of the Offering.
+func (r *InstanceMigrationReconciler) getMigrationConfig(
+	ctx context.Context, offering *catalogv1alpha1.Offering,
+) (*catalogv1alpha1.MigrationConfig, error) {
+	provider := &catalogv1alpha1.Account{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name: offering.Spec.Provider.Name,
+	}, provider); err != nil {
+		return nil, fmt.Errorf("getting Provider: %w", err)
+	}
+	if provider.Status.Namespace == nil {
+		return nil, nil
+	}
+	catalogEntryList := &catalogv1alpha1.CatalogEntryList{}
+	if err := r.List(ctx, catalogEntryList, client.InNamespace(provider.Status.Namespace.Name)); err != nil {
+		return nil, fmt.Errorf("listing CatalogEntries: %w", err)
+	}
+	for _, catalogEntry := range catalogEntryList.Items {
+		if catalogEntry.Status.TenantCRD != nil &&
+			catalogEntry.Status.TenantCRD.Name == offering.Name {
+			return catalogEntry.Spec.Migration, nil
+		}
+	}
+	return nil, nil
+}
+
+// callHook posts the InstanceMigration to the migration hook.
+func (r *InstanceMigrationReconciler) callHook(
+	ctx context.Context, hook *catalogv1alpha1.MigrationHook,
+	name catalogv1alpha1.InstanceMigrationPhaseType,
+	migration *catalogv1alpha1.InstanceMigration,
+) error {
+	body, err := json.Marshal(migrationHookRequest{
+		Hook:              name,
+		InstanceMigration: migration,
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling %s request: %w", name, err)
+	}
+	timeout := defaultMigrationHookTimeout
+	if hook.Timeout != nil {
+		timeout = hook.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating %s request: %w", name, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", name, resp.Status)
+	}
+	return nil
+}
+
+func (r *InstanceMigrationReconciler) setProgressing(migration *catalogv1alpha1.InstanceMigration, reason, message string) {
+	migration.Status.SetCondition(catalogv1alpha1.InstanceMigrationCondition{
+		Type:    catalogv1alpha1.InstanceMigrationSucceeded,
+		Status:  catalogv1alpha1.ConditionUnknown,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func (r *InstanceMigrationReconciler) updateStatus(ctx context.Context, migration *catalogv1alpha1.InstanceMigration) error {
+	migration.Status.ObservedGeneration = migration.Generation
+	if err := r.Status().Update(ctx, migration); err != nil {
+		return fmt.Errorf("updating status: %w", err)
+	}
+	return nil
+}
+
+func (r *InstanceMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.now == nil {
+		r.now = metav1.Now
+	}
+	if r.HTTPClient == nil {
+		r.HTTPClient = newMigrationHookClient(egress.DialControl)
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&catalogv1alpha1.InstanceMigration{}).
+		Complete(r)
+}
+
+// newMigrationHookClient returns the http.Client to call migration hooks.
+// Hook URLs are configured by Providers, so every dialed address is checked by the given control function,
+// connections don't go through proxies and redirects are not followed.
+func newMigrationHookClient(control func(network, address string, c syscall.RawConn) error) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   control,
+	}).DialContext
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// newInstance returns an empty instance of the given CRD.
+func newInstance(crd catalogv1alpha1.CRDInformation, version string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   crd.APIGroup,
+		Version: version,
+		Kind:    crd.Kind,
+	})
+	return obj
+}
+
+// storageVersion returns the storage version of the given CRD.
+func storageVersion(crd catalogv1alpha1.CRDInformation) string {
+	for _, version := range crd.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	return ""
+}
+
+func hasVersion(crd catalogv1alpha1.CRDInformation, version string) bool {
+	for _, v := range crd.Versions {
+		if v.Name == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/egress"
+)
+
+func TestInstanceMigrationReconciler(t *testing.T) {
+	newOffering := func(region string) *catalogv1alpha1.Offering {
+		return &catalogv1alpha1.Offering{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "couchdbs." + region + ".example",
+				Namespace: "tenant",
+			},
+			Spec: catalogv1alpha1.OfferingSpec{
+				Provider: catalogv1alpha1.ObjectReference{Name: "example"},
+				CRD: catalogv1alpha1.CRDInformation{
+					Name:     "couchdbs." + region + ".example",
+					APIGroup: region + ".example",
+					Kind:     "CouchDB",
+					Plural:   "couchdbs",
+					Region:   catalogv1alpha1.ObjectReference{Name: region},
+					Versions: []catalogv1alpha1.CRDVersion{
+						{Name: "v1alpha1", Storage: true},
+					},
+				},
+			},
+		}
+	}
+	sourceOffering := newOffering("eu-west-1")
+	targetOffering := newOffering("eu-west-2")
+
+	provider := &catalogv1alpha1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Status: catalogv1alpha1.AccountStatus{
+			Namespace: &catalogv1alpha1.ObjectReference{Name: "example"},
+		},
+	}
+
+	var hooks []catalogv1alpha1.InstanceMigrationPhaseType
+	hookStatus := http.StatusOK
+	hookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hookReq := &migrationHookRequest{}
+		if err := json.NewDecoder(req.Body).Decode(hookReq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		hooks = append(hooks, hookReq.Hook)
+		w.WriteHeader(hookStatus)
+	}))
+	defer hookServer.Close()
+
+	catalogEntry := &catalogv1alpha1.CatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdbs.eu-west-1",
+			Namespace: "example",
+		},
+		Spec: catalogv1alpha1.CatalogEntrySpec{
+			Migration: &catalogv1alpha1.MigrationConfig{
+				PreHook:  &catalogv1alpha1.MigrationHook{URL: hookServer.URL},
+				PostHook: &catalogv1alpha1.MigrationHook{URL: hookServer.URL},
+			},
+		},
+		Status: catalogv1alpha1.CatalogEntryStatus{
+			TenantCRD: &sourceOffering.Spec.CRD,
+		},
+	}
+
+	newSourceInstance := func() *unstructured.Unstructured {
+		obj := newInstance(sourceOffering.Spec.CRD, "v1alpha1")
+		obj.SetName("db1")
+		obj.SetNamespace("tenant")
+		obj.SetLabels(map[string]string{"team": "a"})
+		require.NoError(t, unstructured.SetNestedField(obj.Object, "test", "spec", "username"))
+		return obj
+	}
+	migration := &catalogv1alpha1.InstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db1-migration",
+			Namespace: "tenant",
+		},
+		Spec: catalogv1alpha1.InstanceMigrationSpec{
+			Offering:       catalogv1alpha1.ObjectReference{Name: sourceOffering.Name},
+			Instance:       catalogv1alpha1.ObjectReference{Name: "db1"},
+			TargetOffering: catalogv1alpha1.ObjectReference{Name: targetOffering.Name},
+		},
+	}
+	migrationNN := types.NamespacedName{Name: migration.Name, Namespace: migration.Namespace}
+	instanceNN := types.NamespacedName{Name: "db1", Namespace: "tenant"}
+	ctx := context.Background()
+
+	now := metav1.Now()
+	newReconciler := func() *InstanceMigrationReconciler {
+		hooks = nil
+		return &InstanceMigrationReconciler{
+			Log: testutil.NewLogger(t),
+			Client: fakeclient.NewFakeClientWithScheme(testScheme,
+				sourceOffering, targetOffering, provider, catalogEntry, newSourceInstance(), migration.DeepCopy()),
+			Scheme:     testScheme,
+			HTTPClient: hookServer.Client(),
+			now:        func() metav1.Time { return now },
+		}
+	}
+	reconcileUntil := func(r *InstanceMigrationReconciler, phase catalogv1alpha1.InstanceMigrationPhaseType) *catalogv1alpha1.InstanceMigration {
+		m := &catalogv1alpha1.InstanceMigration{}
+		for i := 0; i < 10; i++ {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: migrationNN})
+			require.NoError(t, err)
+			require.NoError(t, r.Get(ctx, migrationNN, m))
+			if m.Status.Phase == phase {
+				return m
+			}
+		}
+		require.Equal(t, phase, m.Status.Phase)
+		return m
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		r := newReconciler()
+		reconcileUntil(r, catalogv1alpha1.InstanceMigrationPhaseMigrating)
+
+		targetObj := newInstance(targetOffering.Spec.CRD, "v1alpha1")
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: migrationNN})
+		require.NoError(t, err)
+		require.NoError(t, r.Get(ctx, instanceNN, targetObj))
+		username, _, _ := unstructured.NestedString(targetObj.Object, "spec", "username")
+		assert.Equal(t, "test", username)
+		assert.Equal(t, map[string]string{
+			"team":                                 "a",
+			catalogv1alpha1.InstanceMigrationLabel: migration.Name,
+		}, targetObj.GetLabels())
+
+		// the migration waits for the migrated instance
+		result, err := r.Reconcile(ctrl.Request{NamespacedName: migrationNN})
+		require.NoError(t, err)
+		assert.Equal(t, migrationPollInterval, result.RequeueAfter)
+
+		require.NoError(t, unstructured.SetNestedField(targetObj.Object, "Ready", "status", "phase"))
+		require.NoError(t, r.Status().Update(ctx, targetObj))
+
+		m := reconcileUntil(r, catalogv1alpha1.InstanceMigrationPhaseCompleted)
+		succeeded, _ := m.Status.GetCondition(catalogv1alpha1.InstanceMigrationSucceeded)
+		assert.True(t, succeeded.True())
+		assert.NotNil(t, m.Status.CompletionTime)
+		assert.Equal(t, []catalogv1alpha1.InstanceMigrationPhaseType{
+			catalogv1alpha1.InstanceMigrationPhasePreHook,
+			catalogv1alpha1.InstanceMigrationPhasePostHook,
+		}, hooks)
+
+		sourceObj := newInstance(sourceOffering.Spec.CRD, "v1alpha1")
+		assert.True(t, errors.IsNotFound(r.Get(ctx, instanceNN, sourceObj)), "original instance should be removed")
+		require.NoError(t, r.Get(ctx, instanceNN, targetObj))
+	})
+
+	t.Run("rollback on readiness timeout", func(t *testing.T) {
+		r := newReconciler()
+		reconcileUntil(r, catalogv1alpha1.InstanceMigrationPhaseMigrating)
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: migrationNN})
+		require.NoError(t, err)
+
+		r.now = func() metav1.Time { return metav1.NewTime(now.Add(defaultMigrationReadinessTimeout + time.Minute)) }
+		m := reconcileUntil(r, catalogv1alpha1.InstanceMigrationPhaseFailed)
+		succeeded, _ := m.Status.GetCondition(catalogv1alpha1.InstanceMigrationSucceeded)
+		assert.Equal(t, catalogv1alpha1.ConditionFalse, succeeded.Status)
+		assert.Equal(t, "ReadinessTimeout", succeeded.Reason)
+
+		targetObj := newInstance(targetOffering.Spec.CRD, "v1alpha1")
+		assert.True(t, errors.IsNotFound(r.Get(ctx, instanceNN, targetObj)), "migrated instance should be removed")
+		sourceObj := newInstance(sourceOffering.Spec.CRD, "v1alpha1")
+		require.NoError(t, r.Get(ctx, instanceNN, sourceObj))
+	})
+
+	t.Run("rollback on failing hook", func(t *testing.T) {
+		r := newReconciler()
+		hookStatus = http.StatusInternalServerError
+		defer func() { hookStatus = http.StatusOK }()
+
+		m := reconcileUntil(r, catalogv1alpha1.InstanceMigrationPhaseFailed)
+		succeeded, _ := m.Status.GetCondition(catalogv1alpha1.InstanceMigrationSucceeded)
+		assert.Equal(t, "PreHookFailed", succeeded.Reason)
+
+		targetObj := newInstance(targetOffering.Spec.CRD, "v1alpha1")
+		assert.True(t, errors.IsNotFound(r.Get(ctx, instanceNN, targetObj)))
+	})
+}
+
+func TestMigrationHookClient(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	hookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Redirect(w, req, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer hookServer.Close()
+
+	// the test servers listen on loopback, so dialed addresses are not checked here
+	r := &InstanceMigrationReconciler{HTTPClient: newMigrationHookClient(nil)}
+	err := r.callHook(context.Background(), &catalogv1alpha1.MigrationHook{URL: hookServer.URL},
+		catalogv1alpha1.InstanceMigrationPhasePreHook, &catalogv1alpha1.InstanceMigration{})
+	assert.Error(t, err)
+	assert.False(t, redirected, "redirects should not be followed")
+
+	var called bool
+	internalServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		called = true
+	}))
+	defer internalServer.Close()
+	r = &InstanceMigrationReconciler{HTTPClient: newMigrationHookClient(egress.DialControl)}
+	err = r.callHook(context.Background(), &catalogv1alpha1.MigrationHook{URL: internalServer.URL},
+		catalogv1alpha1.InstanceMigrationPhasePreHook, &catalogv1alpha1.InstanceMigration{})
+	assert.Error(t, err)
+	assert.False(t, called, "internal addresses should not be dialed")
+}
@@ -0,0 +1,613 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+// unschedulableRequeueInterval is the interval to retry scheduling of unschedulable instances.
+const unschedulableRequeueInterval = 30 * time.Second
+
+// InstanceSchedulingReconciler schedules instances of the aggregated CRD of a CatalogEntrySet.
+// Every instance is created as an instance of the region specific CRD of the selected ServiceCluster,
+// from there it follows the usual path via the Elevator and Catapult into the ServiceCluster.
+type InstanceSchedulingReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+
+	// CatalogEntrySet that owns the aggregated CRD.
+	CatalogEntrySet types.NamespacedName
+	// AggregatedGVK is the Kind of the aggregated CRD.
+	AggregatedGVK schema.GroupVersionKind
+
+	// counter counts the instances of the region specific CRDs.
+	counter *instanceCounter
+	// now is used to get the current time, overridden in tests.
+	now func() metav1.Time
+}
+
+// schedulingCandidate is a ServiceCluster, instances of an aggregated CRD can be scheduled to.
+type schedulingCandidate struct {
+	CatalogEntry   *catalogv1alpha1.CatalogEntry
+	ServiceCluster *corev1alpha1.ServiceCluster
+	// Instances is the number of instances of the CatalogEntry.
+	Instances int
+	// TenantInstances is the number of instances of the CatalogEntry in the Tenant namespace.
+	TenantInstances int
+}
+
+func (r *InstanceSchedulingReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	var (
+		ctx    = context.Background()
+		result ctrl.Result
+		log    = r.Log.WithValues("instance", req.NamespacedName)
+	)
+
+	aggregatedObj := r.newObject(r.AggregatedGVK)
+	if err := r.Get(ctx, req.NamespacedName, aggregatedObj); err != nil {
+		if errors.IsNotFound(err) {
+			r.counter.Release(req.NamespacedName)
+		}
+		return result, client.IgnoreNotFound(err)
+	}
+	if !aggregatedObj.GetDeletionTimestamp().IsZero() {
+		// the region specific instance is removed by the garbage collection
+		r.counter.Release(req.NamespacedName)
+		return result, nil
+	}
+
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{}
+	if err := r.Get(ctx, r.CatalogEntrySet, catalogEntrySet); err != nil {
+		return result, client.IgnoreNotFound(err)
+	}
+	if catalogEntrySet.Spec.Scheduling == nil {
+		return result, nil
+	}
+
+	scheduling, err := getInstanceScheduling(aggregatedObj)
+	if err != nil {
+		return result, err
+	}
+	catalogEntries, err := r.listCatalogEntries(ctx, catalogEntrySet)
+	if err != nil {
+		return result, err
+	}
+
+	if !scheduling.IsScheduled() {
+		selector, err := serviceClusterSelector(aggregatedObj)
+		if err != nil {
+			// the instance stays unschedulable, until the annotation is fixed
+			return result, r.updateScheduling(ctx, aggregatedObj, catalogv1alpha1.InstanceScheduling{
+				Policy:  catalogEntrySet.Spec.Scheduling.Policy,
+				Reason:  catalogv1alpha1.InstanceUnschedulable,
+				Message: err.Error(),
+			})
+		}
+		candidates, err := r.listCandidates(ctx, catalogEntries, aggregatedObj.GetNamespace(), selector)
+		if err != nil {
+			return result, err
+		}
+		scheduling = selectServiceCluster(*catalogEntrySet.Spec.Scheduling, candidates, aggregatedObj.GetAnnotations())
+		if !scheduling.IsScheduled() {
+			result.RequeueAfter = unschedulableRequeueInterval
+			return result, r.updateScheduling(ctx, aggregatedObj, scheduling)
+		}
+		now := r.now()
+		scheduling.ScheduledAt = &now
+		// persist the decision, before creating the region specific instance
+		if err := r.updateScheduling(ctx, aggregatedObj, scheduling); err != nil {
+			return result, err
+		}
+		// count the instance, until its region specific instance is observed,
+		// so instances scheduled in the meantime see it
+		for _, candidate := range candidates {
+			if candidate.ServiceCluster.Name == scheduling.ServiceCluster.Name {
+				r.counter.Reserve(tenantCRDGroupKind(candidate.CatalogEntry), req.NamespacedName)
+			}
+		}
+		log.Info("scheduled", "serviceCluster", scheduling.ServiceCluster.Name, "reason", scheduling.Reason)
+		return result, nil
+	}
+
+	var catalogEntry *catalogv1alpha1.CatalogEntry
+	for i := range catalogEntries {
+		if catalogEntries[i].Status.TenantCRD.Region.Name == scheduling.ServiceCluster.Name {
+			catalogEntry = &catalogEntries[i]
+			break
+		}
+	}
+	if catalogEntry == nil {
+		// the instance stays on its ServiceCluster, even if it's no longer offered
+		log.Info("CatalogEntry of the scheduled ServiceCluster not found", "serviceCluster", scheduling.ServiceCluster.Name)
+		r.counter.Release(req.NamespacedName)
+		return result, nil
+	}
+
+	regionalObj, err := r.reconcileRegionalObject(ctx, aggregatedObj, catalogEntry)
+	if errors.IsAlreadyExists(err) {
+		r.counter.Release(req.NamespacedName)
+		scheduling.Reason = catalogv1alpha1.InstanceSchedulingConflict
+		scheduling.Message = err.Error()
+		return result, r.updateScheduling(ctx, aggregatedObj, scheduling)
+	}
+	if err != nil {
+		return result, err
+	}
+	if scheduling.Reason == catalogv1alpha1.InstanceSchedulingConflict {
+		scheduling.Reason = catalogv1alpha1.InstanceScheduled
+		scheduling.Message = fmt.Sprintf("Instance was created on ServiceCluster %s.", scheduling.ServiceCluster.Name)
+	}
+
+	// report the status of the region specific instance
+	status, _, err := unstructured.NestedMap(regionalObj.Object, "status")
+	if err != nil {
+		return result, fmt.Errorf("reading status: %w", err)
+	}
+	if status == nil {
+		status = map[string]interface{}{}
+	}
+	schedulingStatus, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&scheduling)
+	if err != nil {
+		return result, fmt.Errorf("converting scheduling status: %w", err)
+	}
+	status["scheduling"] = schedulingStatus
+	currentStatus, _, _ := unstructured.NestedMap(aggregatedObj.Object, "status")
+	if equality.Semantic.DeepEqual(currentStatus, status) {
+		return result, nil
+	}
+	if err := unstructured.SetNestedMap(aggregatedObj.Object, status, "status"); err != nil {
+		return result, fmt.Errorf("setting status: %w", err)
+	}
+	if err := r.Status().Update(ctx, aggregatedObj); err != nil {
+		return result, fmt.Errorf("updating status: %w", err)
+	}
+	return result, nil
+}
+
+// reconcileRegionalObject creates or updates the region specific instance of the aggregated instance.
+func (r *InstanceSchedulingReconciler) reconcileRegionalObject(
+	ctx context.Context, aggregatedObj *unstructured.Unstructured,
+	catalogEntry *catalogv1alpha1.CatalogEntry,
+) (*unstructured.Unstructured, error) {
+	regionalGVK := schema.GroupVersionKind{
+		Group:   catalogEntry.Status.TenantCRD.APIGroup,
+		Version: r.AggregatedGVK.Version,
+		Kind:    catalogEntry.Status.TenantCRD.Kind,
+	}
+	desiredObj := r.newObject(regionalGVK)
+	for key, value := range aggregatedObj.Object {
+		switch key {
+		case "apiVersion", "kind", "metadata", "status":
+		default:
+			desiredObj.Object[key] = runtime.DeepCopyJSONValue(value)
+		}
+	}
+	desiredObj.SetName(aggregatedObj.GetName())
+	desiredObj.SetNamespace(aggregatedObj.GetNamespace())
+	desiredObj.SetLabels(aggregatedObj.GetLabels())
+	if err := controllerutil.SetControllerReference(aggregatedObj, desiredObj, r.Scheme); err != nil {
+		return nil, fmt.Errorf("setting controller reference: %w", err)
+	}
+
+	currentObj := r.newObject(regionalGVK)
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      desiredObj.GetName(),
+		Namespace: desiredObj.GetNamespace(),
+	}, currentObj)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting %s: %w", regionalGVK.Kind, err)
+	}
+	if errors.IsNotFound(err) {
+		if err := r.Create(ctx, desiredObj); err != nil {
+			return nil, fmt.Errorf("creating %s: %w", regionalGVK.Kind, err)
+		}
+		return desiredObj, nil
+	}
+
+	if !metav1.IsControlledBy(currentObj, aggregatedObj) {
+		return nil, errors.NewAlreadyExists(schema.GroupResource{
+			Group:    catalogEntry.Status.TenantCRD.APIGroup,
+			Resource: catalogEntry.Status.TenantCRD.Plural,
+		}, currentObj.GetName())
+	}
+
+	// update existing
+	updatedObj := currentObj.DeepCopy()
+	for key, value := range desiredObj.Object {
+		if key != "metadata" {
+			updatedObj.Object[key] = value
+		}
+	}
+	updatedObj.SetLabels(desiredObj.GetLabels())
+	if equality.Semantic.DeepEqual(currentObj, updatedObj) {
+		return currentObj, nil
+	}
+	if err := r.Update(ctx, updatedObj); err != nil {
+		return nil, fmt.Errorf("updating %s: %w", regionalGVK.Kind, err)
+	}
+	return updatedObj, nil
+}
+
+// listCatalogEntries returns the CatalogEntries of the CatalogEntrySet, that have a Tenant facing CRD.
+func (r *InstanceSchedulingReconciler) listCatalogEntries(
+	ctx context.Context, catalogEntrySet *catalogv1alpha1.CatalogEntrySet,
+) ([]catalogv1alpha1.CatalogEntry, error) {
+	catalogEntryList := &catalogv1alpha1.CatalogEntryList{}
+	if err := r.List(ctx, catalogEntryList, client.MatchingLabels{
+		catalogEntriesLabel: catalogEntrySet.Name,
+	}, client.InNamespace(catalogEntrySet.Namespace)); err != nil {
+		return nil, fmt.Errorf("listing CatalogEntries: %w", err)
+	}
+	var catalogEntries []catalogv1alpha1.CatalogEntry
+	for _, catalogEntry := range catalogEntryList.Items {
+		if catalogEntry.Status.TenantCRD != nil {
+			catalogEntries = append(catalogEntries, catalogEntry)
+		}
+	}
+	return catalogEntries, nil
+}
+
+// listCandidates returns the ServiceClusters, the instance can be scheduled to.
+// The CatalogEntry needs to be ready and offered to the Tenant, the ServiceCluster needs to be ready,
+// not in maintenance and match the ServiceCluster selector of the instance.
+func (r *InstanceSchedulingReconciler) listCandidates(
+	ctx context.Context, catalogEntries []catalogv1alpha1.CatalogEntry,
+	tenantNamespace string, selector labels.Selector,
+) ([]schedulingCandidate, error) {
+	var candidates []schedulingCandidate
+	for i := range catalogEntries {
+		catalogEntry := &catalogEntries[i]
+		if !catalogEntry.IsReady() {
+			continue
+		}
+
+		// the Offering is only present, if the CatalogEntry is available to the Tenant
+		offering := &catalogv1alpha1.Offering{}
+		err := r.Get(ctx, types.NamespacedName{
+			Name:      catalogEntry.Status.TenantCRD.Name,
+			Namespace: tenantNamespace,
+		}, offering)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting Offering: %w", err)
+		}
+
+		serviceCluster := &corev1alpha1.ServiceCluster{}
+		err = r.Get(ctx, types.NamespacedName{
+			Name:      catalogEntry.Status.TenantCRD.Region.Name,
+			Namespace: catalogEntry.Namespace,
+		}, serviceCluster)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting ServiceCluster: %w", err)
+		}
+		if ready, _ := serviceCluster.Status.GetCondition(corev1alpha1.ServiceClusterReady); !ready.True() ||
+			serviceCluster.Spec.Maintenance.InEffect(r.now().Time) ||
+			!selector.Matches(labels.Set(serviceCluster.Labels)) {
+			continue
+		}
+
+		instances, tenantInstances := r.counter.Count(tenantCRDGroupKind(catalogEntry), tenantNamespace)
+		candidates = append(candidates, schedulingCandidate{
+			CatalogEntry:    catalogEntry,
+			ServiceCluster:  serviceCluster,
+			Instances:       instances,
+			TenantInstances: tenantInstances,
+		})
+	}
+	return candidates, nil
+}
+
+// selectServiceCluster selects the ServiceCluster for an instance from the given candidates.
+// A ServiceCluster requested by the instance takes precedence over the SchedulingPolicy.
+func selectServiceCluster(
+	config catalogv1alpha1.SchedulingConfig,
+	candidates []schedulingCandidate,
+	annotations map[string]string,
+) catalogv1alpha1.InstanceScheduling {
+	policy := config.Policy
+	if policy == "" {
+		policy = catalogv1alpha1.SchedulingPolicyLeastLoaded
+	}
+	if len(candidates) == 0 {
+		return catalogv1alpha1.InstanceScheduling{
+			Policy:  policy,
+			Reason:  catalogv1alpha1.InstanceUnschedulable,
+			Message: "No ready ServiceCluster matches the constraints of the instance.",
+		}
+	}
+	scheduled := func(candidate schedulingCandidate, reason catalogv1alpha1.InstanceSchedulingReason, message string) catalogv1alpha1.InstanceScheduling {
+		return catalogv1alpha1.InstanceScheduling{
+			ServiceCluster: catalogv1alpha1.ObjectReference{Name: candidate.ServiceCluster.Name},
+			Offering:       catalogv1alpha1.ObjectReference{Name: candidate.CatalogEntry.Status.TenantCRD.Name},
+			Policy:         policy,
+			Reason:         reason,
+			Message:        message,
+		}
+	}
+
+	if preferred, ok := annotations[catalogv1alpha1.SchedulingPreferredServiceClusterAnnotation]; ok {
+		for _, candidate := range candidates {
+			if candidate.ServiceCluster.Name == preferred {
+				return scheduled(candidate, catalogv1alpha1.InstanceScheduledPreferred,
+					fmt.Sprintf("ServiceCluster %s was requested by the instance.", preferred))
+			}
+		}
+	}
+
+	if policy == catalogv1alpha1.SchedulingPolicyPreferred {
+		for _, preferred := range config.PreferredServiceClusters {
+			for _, candidate := range candidates {
+				if candidate.ServiceCluster.Name == preferred.Name {
+					return scheduled(candidate, catalogv1alpha1.InstanceScheduled,
+						fmt.Sprintf("ServiceCluster %s is the first available preferred ServiceCluster.", preferred.Name))
+				}
+			}
+		}
+	}
+
+	sorted := make([]schedulingCandidate, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if policy == catalogv1alpha1.SchedulingPolicySpread && a.TenantInstances != b.TenantInstances {
+			return a.TenantInstances < b.TenantInstances
+		}
+		if a.Instances != b.Instances {
+			return a.Instances < b.Instances
+		}
+		return a.ServiceCluster.Name < b.ServiceCluster.Name
+	})
+	selected := sorted[0]
+	if policy == catalogv1alpha1.SchedulingPolicySpread {
+		return scheduled(selected, catalogv1alpha1.InstanceScheduled,
+			fmt.Sprintf("ServiceCluster %s has the least instances of the Tenant (%d).", selected.ServiceCluster.Name, selected.TenantInstances))
+	}
+	return scheduled(selected, catalogv1alpha1.InstanceScheduled,
+		fmt.Sprintf("ServiceCluster %s has the least instances (%d).", selected.ServiceCluster.Name, selected.Instances))
+}
+
+// updateScheduling reports the scheduling decision in the status of the instance.
+func (r *InstanceSchedulingReconciler) updateScheduling(
+	ctx context.Context, aggregatedObj *unstructured.Unstructured,
+	scheduling catalogv1alpha1.InstanceScheduling,
+) error {
+	schedulingStatus, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&scheduling)
+	if err != nil {
+		return fmt.Errorf("converting scheduling status: %w", err)
+	}
+	currentStatus, _, _ := unstructured.NestedMap(aggregatedObj.Object, "status", "scheduling")
+	if equality.Semantic.DeepEqual(currentStatus, schedulingStatus) {
+		return nil
+	}
+	if err := unstructured.SetNestedMap(aggregatedObj.Object, schedulingStatus, "status", "scheduling"); err != nil {
+		return fmt.Errorf("setting scheduling status: %w", err)
+	}
+	if err := r.Status().Update(ctx, aggregatedObj); err != nil {
+		return fmt.Errorf("updating status: %w", err)
+	}
+	return nil
+}
+
+func (r *InstanceSchedulingReconciler) newObject(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// tenantCRDGroupKind returns the GroupKind of the region specific CRD of the CatalogEntry.
+func tenantCRDGroupKind(catalogEntry *catalogv1alpha1.CatalogEntry) schema.GroupKind {
+	return schema.GroupKind{
+		Group: catalogEntry.Status.TenantCRD.APIGroup,
+		Kind:  catalogEntry.Status.TenantCRD.Kind,
+	}
+}
+
+// serviceClusterSelector returns the ServiceCluster selector of the instance.
+func serviceClusterSelector(obj *unstructured.Unstructured) (labels.Selector, error) {
+	s, ok := obj.GetAnnotations()[catalogv1alpha1.SchedulingServiceClusterSelectorAnnotation]
+	if !ok {
+		return labels.Everything(), nil
+	}
+	selector, err := labels.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", catalogv1alpha1.SchedulingServiceClusterSelectorAnnotation, err)
+	}
+	return selector, nil
+}
+
+// getInstanceScheduling returns the scheduling decision reported in the status of the instance.
+func getInstanceScheduling(obj *unstructured.Unstructured) (catalogv1alpha1.InstanceScheduling, error) {
+	scheduling := catalogv1alpha1.InstanceScheduling{}
+	schedulingStatus, found, err := unstructured.NestedMap(obj.Object, "status", "scheduling")
+	if err != nil {
+		return scheduling, fmt.Errorf("reading scheduling status: %w", err)
+	}
+	if !found {
+		return scheduling, nil
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(schedulingStatus, &scheduling); err != nil {
+		return scheduling, fmt.Errorf("converting scheduling status: %w", err)
+	}
+	return scheduling, nil
+}
+
+// schedulingConfig holds the settings of the scheduling of a CatalogEntrySet,
+// that require the InstanceSchedulingReconciler to be restarted when changed.
+type schedulingConfig struct {
+	AggregatedGVK schema.GroupVersionKind
+	RegionalGVKs  []schema.GroupVersionKind
+}
+
+// setupInstanceScheduling adds the InstanceSchedulingReconciler of an aggregated CRD to the given Manager.
+// Aggregated CRDs are created at runtime, so every InstanceSchedulingReconciler runs in its own Manager,
+// that is stopped together with the scheduling of the CatalogEntrySet.
+func setupInstanceScheduling(
+	mgr ctrl.Manager, log logr.Logger,
+	catalogEntrySet types.NamespacedName, config schedulingConfig,
+) error {
+	counter := newInstanceCounter()
+	c, err := controller.New("scheduling-"+config.AggregatedGVK.GroupKind().String(), mgr, controller.Options{
+		Reconciler: &InstanceSchedulingReconciler{
+			Client: mgr.GetClient(),
+			Log:    log,
+			Scheme: mgr.GetScheme(),
+
+			CatalogEntrySet: catalogEntrySet,
+			AggregatedGVK:   config.AggregatedGVK,
+
+			counter: counter,
+			now:     metav1.Now,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating controller: %w", err)
+	}
+
+	aggregatedObj := &unstructured.Unstructured{}
+	aggregatedObj.SetGroupVersionKind(config.AggregatedGVK)
+	if err := c.Watch(&source.Kind{Type: aggregatedObj}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("watching %s: %w", config.AggregatedGVK.Kind, err)
+	}
+	// watch instances of the region specific CRDs, that are created for aggregated instances
+	for _, regionalGVK := range config.RegionalGVKs {
+		regionalObj := &unstructured.Unstructured{}
+		regionalObj.SetGroupVersionKind(regionalGVK)
+		if err := c.Watch(&source.Kind{Type: regionalObj}, &handler.EnqueueRequestForOwner{
+			OwnerType:    aggregatedObj,
+			IsController: true,
+		}); err != nil {
+			return fmt.Errorf("watching %s: %w", regionalGVK.Kind, err)
+		}
+		if err := c.Watch(&source.Kind{Type: regionalObj}, counter.Handler(regionalGVK.GroupKind())); err != nil {
+			return fmt.Errorf("counting %s: %w", regionalGVK.Kind, err)
+		}
+	}
+	return nil
+}
+
+// instanceCounter counts the instances of region specific CRDs by namespace,
+// so scheduling decisions don't need to list all instances.
+// Scheduled instances are counted as pending, until their region specific instance is observed.
+type instanceCounter struct {
+	mu      sync.Mutex
+	counts  map[schema.GroupKind]map[string]int
+	pending map[types.NamespacedName]schema.GroupKind
+}
+
+func newInstanceCounter() *instanceCounter {
+	return &instanceCounter{
+		counts:  map[schema.GroupKind]map[string]int{},
+		pending: map[types.NamespacedName]schema.GroupKind{},
+	}
+}
+
+// Count returns the number of instances of the given Kind and the number of them in the given namespace.
+func (c *instanceCounter) Count(gk schema.GroupKind, namespace string) (total, inNamespace int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for ns, n := range c.counts[gk] {
+		total += n
+		if ns == namespace {
+			inNamespace += n
+		}
+	}
+	for nn, pendingGK := range c.pending {
+		if pendingGK != gk {
+			continue
+		}
+		total++
+		if nn.Namespace == namespace {
+			inNamespace++
+		}
+	}
+	return
+}
+
+// Reserve counts the instance as pending instance of the given Kind, until it's observed or released.
+func (c *instanceCounter) Reserve(gk schema.GroupKind, instance types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[instance] = gk
+}
+
+// Release removes the pending instance, if its region specific instance won't be created.
+func (c *instanceCounter) Release(instance types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pending, instance)
+}
+
+// Handler returns a handler.EventHandler, that keeps the counts of the given Kind up to date.
+func (c *instanceCounter) Handler(gk schema.GroupKind) handler.EventHandler {
+	return &handler.Funcs{
+		CreateFunc: func(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			c.add(gk, e.Meta.GetNamespace(), 1)
+			// the region specific instance has the same name as the aggregated instance, that is no longer pending
+			delete(c.pending, types.NamespacedName{Name: e.Meta.GetName(), Namespace: e.Meta.GetNamespace()})
+		},
+		DeleteFunc: func(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			c.add(gk, e.Meta.GetNamespace(), -1)
+		},
+	}
+}
+
+// add changes the count of the given Kind in the namespace, c.mu has to be held.
+func (c *instanceCounter) add(gk schema.GroupKind, namespace string, n int) {
+	if c.counts[gk] == nil {
+		c.counts[gk] = map[string]int{}
+	}
+	c.counts[gk][namespace] += n
+	if c.counts[gk][namespace] <= 0 {
+		delete(c.counts[gk], namespace)
+	}
+}
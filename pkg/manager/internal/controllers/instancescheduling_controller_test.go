@@ -0,0 +1,406 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
+)
+
+func newSchedulingCandidate(serviceCluster string, instances, tenantInstances int) schedulingCandidate {
+	return schedulingCandidate{
+		CatalogEntry: &catalogv1alpha1.CatalogEntry{
+			Status: catalogv1alpha1.CatalogEntryStatus{
+				TenantCRD: &catalogv1alpha1.CRDInformation{
+					Name: "couchdbs." + serviceCluster + ".example",
+				},
+			},
+		},
+		ServiceCluster: &corev1alpha1.ServiceCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceCluster},
+		},
+		Instances:       instances,
+		TenantInstances: tenantInstances,
+	}
+}
+
+func TestSelectServiceCluster(t *testing.T) {
+	candidates := []schedulingCandidate{
+		newSchedulingCandidate("eu-west-1", 3, 0),
+		newSchedulingCandidate("eu-west-2", 1, 2),
+		newSchedulingCandidate("eu-west-3", 1, 1),
+	}
+
+	tests := []struct {
+		name                   string
+		config                 catalogv1alpha1.SchedulingConfig
+		candidates             []schedulingCandidate
+		annotations            map[string]string
+		expectedServiceCluster string
+		expectedReason         catalogv1alpha1.InstanceSchedulingReason
+	}{
+		{
+			name:           "no candidates",
+			config:         catalogv1alpha1.SchedulingConfig{},
+			expectedReason: catalogv1alpha1.InstanceUnschedulable,
+		},
+		{
+			name:                   "least loaded",
+			config:                 catalogv1alpha1.SchedulingConfig{Policy: catalogv1alpha1.SchedulingPolicyLeastLoaded},
+			candidates:             candidates,
+			expectedServiceCluster: "eu-west-2",
+			expectedReason:         catalogv1alpha1.InstanceScheduled,
+		},
+		{
+			name:                   "spread",
+			config:                 catalogv1alpha1.SchedulingConfig{Policy: catalogv1alpha1.SchedulingPolicySpread},
+			candidates:             candidates,
+			expectedServiceCluster: "eu-west-1",
+			expectedReason:         catalogv1alpha1.InstanceScheduled,
+		},
+		{
+			name: "preferred",
+			config: catalogv1alpha1.SchedulingConfig{
+				Policy: catalogv1alpha1.SchedulingPolicyPreferred,
+				PreferredServiceClusters: []catalogv1alpha1.ObjectReference{
+					{Name: "us-east-1"},
+					{Name: "eu-west-3"},
+				},
+			},
+			candidates:             candidates,
+			expectedServiceCluster: "eu-west-3",
+			expectedReason:         catalogv1alpha1.InstanceScheduled,
+		},
+		{
+			name:       "preferred by annotation",
+			config:     catalogv1alpha1.SchedulingConfig{Policy: catalogv1alpha1.SchedulingPolicyLeastLoaded},
+			candidates: candidates,
+			annotations: map[string]string{
+				catalogv1alpha1.SchedulingPreferredServiceClusterAnnotation: "eu-west-1",
+			},
+			expectedServiceCluster: "eu-west-1",
+			expectedReason:         catalogv1alpha1.InstanceScheduledPreferred,
+		},
+		{
+			name:       "unavailable preferred by annotation",
+			config:     catalogv1alpha1.SchedulingConfig{Policy: catalogv1alpha1.SchedulingPolicyLeastLoaded},
+			candidates: candidates,
+			annotations: map[string]string{
+				catalogv1alpha1.SchedulingPreferredServiceClusterAnnotation: "us-east-1",
+			},
+			expectedServiceCluster: "eu-west-2",
+			expectedReason:         catalogv1alpha1.InstanceScheduled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scheduling := selectServiceCluster(test.config, test.candidates, test.annotations)
+			assert.Equal(t, test.expectedServiceCluster, scheduling.ServiceCluster.Name)
+			assert.Equal(t, test.expectedReason, scheduling.Reason)
+		})
+	}
+}
+
+func TestInstanceSchedulingReconciler(t *testing.T) {
+	aggregatedGVK := schema.GroupVersionKind{
+		Group:   "couchdb.example",
+		Version: "v1alpha1",
+		Kind:    "CouchDB",
+	}
+	regionalGVK := schema.GroupVersionKind{
+		Group:   "eu-west-1.example",
+		Version: "v1alpha1",
+		Kind:    "CouchDB",
+	}
+	// required to list instances with the fake client
+	testScheme.AddKnownTypeWithName(
+		regionalGVK.GroupVersion().WithKind(regionalGVK.Kind+"List"), &unstructured.UnstructuredList{})
+
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdb",
+			Namespace: "example",
+		},
+		Spec: catalogv1alpha1.CatalogEntrySetSpec{
+			Scheduling: &catalogv1alpha1.SchedulingConfig{
+				Policy: catalogv1alpha1.SchedulingPolicyLeastLoaded,
+			},
+		},
+	}
+	catalogEntry := &catalogv1alpha1.CatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdb.eu-west-1",
+			Namespace: "example",
+			Labels: map[string]string{
+				catalogEntriesLabel: catalogEntrySet.Name,
+			},
+		},
+		Status: catalogv1alpha1.CatalogEntryStatus{
+			TenantCRD: &catalogv1alpha1.CRDInformation{
+				Name:     "couchdbs.eu-west-1.example",
+				APIGroup: regionalGVK.Group,
+				Kind:     regionalGVK.Kind,
+				Plural:   "couchdbs",
+				Region:   catalogv1alpha1.ObjectReference{Name: "eu-west-1"},
+			},
+			Conditions: []catalogv1alpha1.CatalogEntryCondition{
+				{
+					Type:   catalogv1alpha1.CatalogEntryReady,
+					Status: catalogv1alpha1.ConditionTrue,
+				},
+			},
+		},
+	}
+	serviceCluster := &corev1alpha1.ServiceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "eu-west-1",
+			Namespace: "example",
+		},
+		Status: corev1alpha1.ServiceClusterStatus{
+			Conditions: []corev1alpha1.ServiceClusterCondition{
+				{
+					Type:   corev1alpha1.ServiceClusterReady,
+					Status: corev1alpha1.ConditionTrue,
+				},
+			},
+		},
+	}
+	offering := &catalogv1alpha1.Offering{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdbs.eu-west-1.example",
+			Namespace: "tenant",
+		},
+	}
+	instance := &unstructured.Unstructured{}
+	instance.SetGroupVersionKind(aggregatedGVK)
+	instance.SetName("db1")
+	instance.SetNamespace("tenant")
+	require.NoError(t, unstructured.SetNestedField(instance.Object, "test", "spec", "prop1"))
+
+	now := metav1.Now()
+	r := &InstanceSchedulingReconciler{
+		Log:    testutil.NewLogger(t),
+		Client: fakeclient.NewFakeClientWithScheme(testScheme, catalogEntrySet, catalogEntry, serviceCluster, offering, instance),
+		Scheme: testScheme,
+
+		CatalogEntrySet: types.NamespacedName{Name: catalogEntrySet.Name, Namespace: catalogEntrySet.Namespace},
+		AggregatedGVK:   aggregatedGVK,
+
+		counter: newInstanceCounter(),
+		now:     func() metav1.Time { return now },
+	}
+	ctx := context.Background()
+	instanceNN := types.NamespacedName{Name: "db1", Namespace: "tenant"}
+
+	for i := 0; i < 3; i++ {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: instanceNN})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, r.Get(ctx, instanceNN, instance))
+	scheduling, err := getInstanceScheduling(instance)
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", scheduling.ServiceCluster.Name)
+	assert.Equal(t, "couchdbs.eu-west-1.example", scheduling.Offering.Name)
+	assert.Equal(t, catalogv1alpha1.InstanceScheduled, scheduling.Reason)
+
+	regionalInstance := &unstructured.Unstructured{}
+	regionalInstance.SetGroupVersionKind(regionalGVK)
+	require.NoError(t, r.Get(ctx, instanceNN, regionalInstance))
+	assert.True(t, metav1.IsControlledBy(regionalInstance, instance))
+	prop1, _, _ := unstructured.NestedString(regionalInstance.Object, "spec", "prop1")
+	assert.Equal(t, "test", prop1)
+
+	// status of the region specific instance is reported
+	require.NoError(t, unstructured.SetNestedField(regionalInstance.Object, "Ready", "status", "phase"))
+	require.NoError(t, r.Status().Update(ctx, regionalInstance))
+	_, err = r.Reconcile(ctrl.Request{NamespacedName: instanceNN})
+	require.NoError(t, err)
+	require.NoError(t, r.Get(ctx, instanceNN, instance))
+	phase, _, _ := unstructured.NestedString(instance.Object, "status", "phase")
+	assert.Equal(t, "Ready", phase)
+}
+
+func TestInstanceSchedulingReconcilerBurst(t *testing.T) {
+	aggregatedGVK := schema.GroupVersionKind{
+		Group:   "couchdb.example",
+		Version: "v1alpha1",
+		Kind:    "CouchDB",
+	}
+	catalogEntrySet := &catalogv1alpha1.CatalogEntrySet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "couchdb",
+			Namespace: "example",
+		},
+		Spec: catalogv1alpha1.CatalogEntrySetSpec{
+			Scheduling: &catalogv1alpha1.SchedulingConfig{
+				Policy: catalogv1alpha1.SchedulingPolicyLeastLoaded,
+			},
+		},
+	}
+	now := metav1.NewTime(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC))
+	objs := []runtime.Object{catalogEntrySet}
+	for _, region := range []string{"eu-central-1", "eu-west-1", "eu-west-2"} {
+		serviceCluster := &corev1alpha1.ServiceCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      region,
+				Namespace: "example",
+			},
+			Status: corev1alpha1.ServiceClusterStatus{
+				Conditions: []corev1alpha1.ServiceClusterCondition{
+					{
+						Type:   corev1alpha1.ServiceClusterReady,
+						Status: corev1alpha1.ConditionTrue,
+					},
+				},
+			},
+		}
+		if region == "eu-central-1" {
+			// the maintenance window is only in effect at the time of the reconciler
+			serviceCluster.Spec.Maintenance = &corev1alpha1.ServiceClusterMaintenance{
+				Start: &metav1.Time{Time: now.Add(-time.Hour)},
+				End:   &metav1.Time{Time: now.Add(time.Hour)},
+			}
+		}
+		objs = append(objs,
+			serviceCluster,
+			&catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "couchdb." + region,
+					Namespace: "example",
+					Labels: map[string]string{
+						catalogEntriesLabel: catalogEntrySet.Name,
+					},
+				},
+				Status: catalogv1alpha1.CatalogEntryStatus{
+					TenantCRD: &catalogv1alpha1.CRDInformation{
+						Name:     "couchdbs." + region + ".example",
+						APIGroup: region + ".example",
+						Kind:     "CouchDB",
+						Plural:   "couchdbs",
+						Region:   catalogv1alpha1.ObjectReference{Name: region},
+					},
+					Conditions: []catalogv1alpha1.CatalogEntryCondition{
+						{
+							Type:   catalogv1alpha1.CatalogEntryReady,
+							Status: catalogv1alpha1.ConditionTrue,
+						},
+					},
+				},
+			},
+			&catalogv1alpha1.Offering{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "couchdbs." + region + ".example",
+					Namespace: "tenant",
+				},
+			},
+		)
+	}
+	instanceNames := []string{"db1", "db2", "db3"}
+	for _, name := range instanceNames {
+		instance := &unstructured.Unstructured{}
+		instance.SetGroupVersionKind(aggregatedGVK)
+		instance.SetName(name)
+		instance.SetNamespace("tenant")
+		objs = append(objs, instance)
+	}
+
+	r := &InstanceSchedulingReconciler{
+		Log:    testutil.NewLogger(t),
+		Client: fakeclient.NewFakeClientWithScheme(testScheme, objs...),
+		Scheme: testScheme,
+
+		CatalogEntrySet: types.NamespacedName{Name: catalogEntrySet.Name, Namespace: catalogEntrySet.Namespace},
+		AggregatedGVK:   aggregatedGVK,
+
+		counter: newInstanceCounter(),
+		now:     func() metav1.Time { return now },
+	}
+	ctx := context.Background()
+
+	// the decisions are persisted, before any region specific instance is created
+	scheduledTo := map[string]string{}
+	for _, name := range instanceNames {
+		instanceNN := types.NamespacedName{Name: name, Namespace: "tenant"}
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: instanceNN})
+		require.NoError(t, err)
+
+		instance := &unstructured.Unstructured{}
+		instance.SetGroupVersionKind(aggregatedGVK)
+		require.NoError(t, r.Get(ctx, instanceNN, instance))
+		scheduling, err := getInstanceScheduling(instance)
+		require.NoError(t, err)
+		scheduledTo[name] = scheduling.ServiceCluster.Name
+	}
+	assert.Equal(t, map[string]string{
+		"db1": "eu-west-1",
+		"db2": "eu-west-2",
+		"db3": "eu-west-1",
+	}, scheduledTo)
+}
+
+func TestInstanceCounter(t *testing.T) {
+	gk := schema.GroupKind{Group: "eu-west-1.example.provider", Kind: "CouchDB"}
+	counter := newInstanceCounter()
+	h := counter.Handler(gk)
+
+	for _, namespace := range []string{"tenant-a", "tenant-a", "tenant-b"} {
+		h.Create(event.CreateEvent{Meta: &metav1.ObjectMeta{Namespace: namespace}}, nil)
+	}
+	total, inNamespace := counter.Count(gk, "tenant-a")
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 2, inNamespace)
+
+	h.Delete(event.DeleteEvent{Meta: &metav1.ObjectMeta{Namespace: "tenant-a"}}, nil)
+	total, inNamespace = counter.Count(gk, "tenant-a")
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 1, inNamespace)
+
+	total, inNamespace = counter.Count(schema.GroupKind{Kind: "Other"}, "tenant-a")
+	assert.Equal(t, 0, total)
+	assert.Equal(t, 0, inNamespace)
+
+	// scheduled instances are counted, until their region specific instance is observed
+	counter.Reserve(gk, types.NamespacedName{Name: "db1", Namespace: "tenant-a"})
+	counter.Reserve(gk, types.NamespacedName{Name: "db2", Namespace: "tenant-a"})
+	total, inNamespace = counter.Count(gk, "tenant-a")
+	assert.Equal(t, 4, total)
+	assert.Equal(t, 3, inNamespace)
+
+	h.Create(event.CreateEvent{Meta: &metav1.ObjectMeta{Name: "db1", Namespace: "tenant-a"}}, nil)
+	counter.Release(types.NamespacedName{Name: "db2", Namespace: "tenant-a"})
+	total, inNamespace = counter.Count(gk, "tenant-a")
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 2, inNamespace)
+}
@@ -18,20 +18,55 @@ package controllers
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
 	"fmt"
+	"math"
+	"math/big"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
+	resourceferry "k8c.io/kubecarrier/pkg/internal/resources/ferry"
+)
+
+const (
+	// agentTokenKey holds the token the agent uses to connect to the Ferry.
+	agentTokenKey = "token"
+	// agentProxyTokenKey holds the token to access the ServiceCluster through the Ferry proxy.
+	agentProxyTokenKey = "proxy-token"
+	// agentKubeconfigKey holds the Kubeconfig for other KubeCarrier components to access the ServiceCluster through the Ferry proxy.
+	agentKubeconfigKey = "kubeconfig"
+	// agentCACertKey and agentCAKeyKey hold the CA signing the serving certificate of the Ferry tunnel and proxy.
+	// The agent has to trust the CA certificate.
+	agentCACertKey = "ca.crt"
+	agentCAKeyKey  = "ca.key"
+	// agentTLSCertKey and agentTLSKeyKey hold the serving certificate of the Ferry tunnel and proxy.
+	agentTLSCertKey = corev1.TLSCertKey
+	agentTLSKeyKey  = corev1.TLSPrivateKeyKey
+
+	// agentServingCertValidity is the validity of the serving certificate, it is renewed after half of it.
+	agentServingCertValidity = 365 * 24 * time.Hour
 )
 
 type ServiceClusterReconciler struct {
@@ -45,6 +80,7 @@ type ServiceClusterReconciler struct {
 // +kubebuilder:rbac:groups=kubecarrier.io,resources=serviceclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=ferries,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=ferries/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update
 
 func (r *ServiceClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 
@@ -65,7 +101,29 @@ func (r *ServiceClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, err
 		return ctrl.Result{}, nil
 	}
 
-	currentFerry, err := r.reconcileFerry(ctx, serviceCluster)
+	var kubeconfigSecretName string
+	if serviceCluster.Spec.KubeconfigSecret != nil {
+		kubeconfigSecretName = serviceCluster.Spec.KubeconfigSecret.Name
+	}
+	ferryConnection := operatorv1alpha1.FerryConnectionKubeconfig
+	if serviceCluster.Spec.ConnectionOrDefault() == corev1alpha1.ServiceClusterConnectionAgent {
+		agentSecret, err := r.reconcileAgentSecret(ctx, serviceCluster)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("reconciling agent Secret: %w", err)
+		}
+		kubeconfigSecretName = agentSecret.Name
+		ferryConnection = operatorv1alpha1.FerryConnectionAgent
+
+		if serviceCluster.Status.AgentSecret == nil ||
+			serviceCluster.Status.AgentSecret.Name != agentSecret.Name {
+			serviceCluster.Status.AgentSecret = &corev1alpha1.ObjectReference{Name: agentSecret.Name}
+			if err := r.Status().Update(ctx, serviceCluster); err != nil {
+				return ctrl.Result{}, fmt.Errorf("updating status: %w", err)
+			}
+		}
+	}
+
+	currentFerry, err := r.reconcileFerry(ctx, serviceCluster, kubeconfigSecretName, ferryConnection)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("reconciling Ferry: %w", err)
 	}
@@ -100,12 +158,15 @@ func (r *ServiceClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1alpha1.ServiceCluster{}).
 		Owns(&operatorv1alpha1.Ferry{}).
+		Owns(&corev1.Secret{}).
 		Complete(r)
 }
 
 func (r *ServiceClusterReconciler) reconcileFerry(
 	ctx context.Context,
-	serviceCluster *corev1alpha1.ServiceCluster) (*operatorv1alpha1.Ferry, error) {
+	serviceCluster *corev1alpha1.ServiceCluster,
+	kubeconfigSecretName string,
+	connection operatorv1alpha1.FerryConnectionType) (*operatorv1alpha1.Ferry, error) {
 	desiredFerry := &operatorv1alpha1.Ferry{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      serviceCluster.Name,
@@ -113,8 +174,9 @@ func (r *ServiceClusterReconciler) reconcileFerry(
 		},
 		Spec: operatorv1alpha1.FerrySpec{
 			KubeconfigSecret: operatorv1alpha1.ObjectReference{
-				Name: serviceCluster.Spec.KubeconfigSecret.Name,
+				Name: kubeconfigSecretName,
 			},
+			Connection: connection,
 		},
 	}
 
@@ -152,6 +214,178 @@ func (r *ServiceClusterReconciler) reconcileFerry(
 	return currentFerry, nil
 }
 
+// reconcileAgentSecret ensures the Secret holding the agent tokens and the Kubeconfig for the Ferry proxy.
+// Existing tokens are kept, so the agent does not need to be reconfigured.
+func (r *ServiceClusterReconciler) reconcileAgentSecret(
+	ctx context.Context, serviceCluster *corev1alpha1.ServiceCluster) (*corev1.Secret, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceCluster.Name + "-agent",
+			Namespace: serviceCluster.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		for _, key := range []string{agentTokenKey, agentProxyTokenKey} {
+			if len(secret.Data[key]) > 0 {
+				continue
+			}
+			token, err := generateToken()
+			if err != nil {
+				return err
+			}
+			secret.Data[key] = []byte(token)
+		}
+
+		if err := ensureAgentCertificates(serviceCluster, secret.Data); err != nil {
+			return err
+		}
+
+		kubeconfig, err := agentProxyKubeconfig(
+			serviceCluster, string(secret.Data[agentProxyTokenKey]), secret.Data[agentCACertKey])
+		if err != nil {
+			return err
+		}
+		secret.Data[agentKubeconfigKey] = kubeconfig
+		return controllerutil.SetControllerReference(serviceCluster, secret, r.Scheme)
+	}); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// agentProxyKubeconfig returns a Kubeconfig to access the ServiceCluster through the Ferry proxy.
+func agentProxyKubeconfig(serviceCluster *corev1alpha1.ServiceCluster, token string, caCert []byte) ([]byte, error) {
+	const name = "service-cluster"
+	config := clientcmdapiv1.Config{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters: []clientcmdapiv1.NamedCluster{{
+			Name: name,
+			Cluster: clientcmdapiv1.Cluster{
+				Server: fmt.Sprintf("https://%s.%s.svc:%d",
+					resourceferry.ProxyServiceName(serviceCluster.Name), serviceCluster.Namespace, resourceferry.ProxyPort),
+				CertificateAuthorityData: caCert,
+			},
+		}},
+		AuthInfos: []clientcmdapiv1.NamedAuthInfo{{
+			Name:     name,
+			AuthInfo: clientcmdapiv1.AuthInfo{Token: token},
+		}},
+		Contexts: []clientcmdapiv1.NamedContext{{
+			Name:    name,
+			Context: clientcmdapiv1.Context{Cluster: name, AuthInfo: name},
+		}},
+		CurrentContext: name,
+	}
+	kubeconfig, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling kubeconfig: %w", err)
+	}
+	return kubeconfig, nil
+}
+
+// ensureAgentCertificates ensures the CA and the serving certificate of the Ferry tunnel and proxy in the given Secret data.
+// The CA is kept, so the agent does not need to be reconfigured, the serving certificate is renewed before it expires.
+func ensureAgentCertificates(serviceCluster *corev1alpha1.ServiceCluster, data map[string][]byte) error {
+	caCert, caKey, err := parseCertKey(data[agentCACertKey], data[agentCAKeyKey])
+	if err != nil {
+		caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return fmt.Errorf("generating CA key: %w", err)
+		}
+		caCert, err = cert.NewSelfSignedCACert(cert.Config{
+			CommonName: fmt.Sprintf("kubecarrier-agent-ca@%s.%s", serviceCluster.Name, serviceCluster.Namespace),
+		}, caKey)
+		if err != nil {
+			return fmt.Errorf("generating CA certificate: %w", err)
+		}
+		caKeyPEM, err := keyutil.MarshalPrivateKeyToPEM(caKey)
+		if err != nil {
+			return fmt.Errorf("encoding CA key: %w", err)
+		}
+		data[agentCACertKey] = pem.EncodeToMemory(&pem.Block{Type: cert.CertificateBlockType, Bytes: caCert.Raw})
+		data[agentCAKeyKey] = caKeyPEM
+		// the serving certificate has to be signed by the new CA
+		delete(data, agentTLSCertKey)
+	}
+
+	servingCert, _, err := parseCertKey(data[agentTLSCertKey], data[agentTLSKeyKey])
+	if err == nil &&
+		servingCert.CheckSignatureFrom(caCert) == nil &&
+		time.Until(servingCert.NotAfter) > agentServingCertValidity/2 {
+		return nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("generating serving key: %w", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	if err != nil {
+		return fmt.Errorf("generating serial number: %w", err)
+	}
+	now := time.Now()
+	var dnsNames []string
+	for _, service := range []string{
+		resourceferry.TunnelServiceName(serviceCluster.Name),
+		resourceferry.ProxyServiceName(serviceCluster.Name),
+	} {
+		dnsNames = append(dnsNames,
+			service,
+			service+"."+serviceCluster.Namespace,
+			service+"."+serviceCluster.Namespace+".svc",
+		)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(agentServingCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	if err != nil {
+		return fmt.Errorf("signing serving certificate: %w", err)
+	}
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(key)
+	if err != nil {
+		return fmt.Errorf("encoding serving key: %w", err)
+	}
+	data[agentTLSCertKey] = pem.EncodeToMemory(&pem.Block{Type: cert.CertificateBlockType, Bytes: certDER})
+	data[agentTLSKeyKey] = keyPEM
+	return nil
+}
+
+// parseCertKey parses the first PEM encoded certificate and its private key.
+func parseCertKey(certPEM, keyPEM []byte) (*x509.Certificate, crypto.Signer, error) {
+	certs, err := cert.ParseCertsPEM(certPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := keyutil.ParsePrivateKeyPEM(keyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return certs[0], signer, nil
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
 func (r *ServiceClusterReconciler) handleDeletion(ctx context.Context, log logr.Logger, serviceCluster *corev1alpha1.ServiceCluster) error {
 	// Update the Catalog Status to Terminating.
 	readyCondition, _ := serviceCluster.Status.GetCondition(corev1alpha1.ServiceClusterReady)
@@ -193,7 +427,12 @@ func (r *ServiceClusterReconciler) updateStatus(
 		serviceClusterReachable, _ = serviceCluster.Status.GetCondition(corev1alpha1.ServiceClusterReachable)
 	}
 
-	if controllerReady.True() && serviceClusterReachable.True() {
+	// The Healthy condition is only reported by Ferry versions running health probes.
+	serviceClusterHealthy, healthProbed := serviceCluster.Status.GetCondition(
+		corev1alpha1.ServiceClusterHealthy)
+	serviceClusterUnhealthy := healthProbed && serviceClusterHealthy.Status == corev1alpha1.ConditionFalse
+
+	if controllerReady.True() && serviceClusterReachable.True() && !serviceClusterUnhealthy {
 		serviceCluster.Status.SetCondition(corev1alpha1.ServiceClusterCondition{
 			Type:    corev1alpha1.ServiceClusterReady,
 			Status:  corev1alpha1.ConditionTrue,
@@ -214,6 +453,13 @@ func (r *ServiceClusterReconciler) updateStatus(
 			Reason:  "ServiceClusterUnreachable",
 			Message: "The service cluster is unreachable.",
 		})
+	} else if serviceClusterUnhealthy {
+		serviceCluster.Status.SetCondition(corev1alpha1.ServiceClusterCondition{
+			Type:    corev1alpha1.ServiceClusterReady,
+			Status:  corev1alpha1.ConditionFalse,
+			Reason:  "ServiceClusterUnhealthy",
+			Message: "The service cluster is unhealthy: " + serviceClusterHealthy.Message,
+		})
 	}
 
 	if err := r.Status().Update(ctx, serviceCluster); err != nil {
@@ -18,13 +18,16 @@ package controllers
 
 import (
 	"context"
+	"crypto/x509"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -117,6 +120,26 @@ func TestServiceClusterReconciler(t *testing.T) {
 		t.FailNow()
 	}
 
+	if !t.Run("service cluster unhealthy", func(t *testing.T) {
+		serviceCluster.Status.SetCondition(corev1alpha1.ServiceClusterCondition{
+			Type:    corev1alpha1.ServiceClusterHealthy,
+			Status:  corev1alpha1.ConditionFalse,
+			Reason:  "NodesNotReady",
+			Message: "0/3 nodes are ready, at least 50% are required",
+		})
+		require.NoError(t, scc.Client.Status().Update(ctx, serviceCluster))
+
+		reconcileLoop()
+		cond, present := serviceCluster.Status.GetCondition(corev1alpha1.ServiceClusterReady)
+		if assert.True(t, present, "service cluster ready condition missing") {
+			assert.Equal(t, corev1alpha1.ConditionFalse, cond.Status)
+			assert.Equal(t, "ServiceClusterUnhealthy", cond.Reason)
+			assert.Equal(t, "The service cluster is unhealthy: 0/3 nodes are ready, at least 50% are required", cond.Message)
+		}
+	}) {
+		t.FailNow()
+	}
+
 	if !t.Run("monitor grace period", func(t *testing.T) {
 		minuteAgo := metav1.Time{Time: time.Now().Add(-time.Minute)}
 		serviceCluster.Status.SetCondition(corev1alpha1.ServiceClusterCondition{
@@ -136,3 +159,82 @@ func TestServiceClusterReconciler(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestServiceClusterReconcilerAgent(t *testing.T) {
+	serviceCluster := &corev1alpha1.ServiceCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "edge-1",
+			Namespace: "my-provider",
+		},
+		Spec: corev1alpha1.ServiceClusterSpec{
+			Connection: corev1alpha1.ServiceClusterConnectionAgent,
+		},
+	}
+	scc := &ServiceClusterReconciler{
+		Log:                testutil.NewLogger(t),
+		Client:             fakeclient.NewFakeClientWithScheme(testScheme, serviceCluster),
+		Scheme:             testScheme,
+		MonitorGracePeriod: 40 * time.Second,
+	}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{
+		Name:      serviceCluster.Name,
+		Namespace: serviceCluster.Namespace,
+	}}
+
+	_, err := scc.Reconcile(req)
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, scc.Client.Get(ctx, types.NamespacedName{
+		Name:      "edge-1-agent",
+		Namespace: serviceCluster.Namespace,
+	}, secret))
+	assert.NotEmpty(t, secret.Data[agentTokenKey])
+	assert.NotEqual(t, secret.Data[agentTokenKey], secret.Data[agentProxyTokenKey])
+
+	kubeconfig, err := clientcmd.Load(secret.Data[agentKubeconfigKey])
+	require.NoError(t, err)
+	assert.Equal(t, "https://edge-1-ferry-proxy.my-provider.svc:8444", kubeconfig.Clusters["service-cluster"].Server)
+	assert.Equal(t, secret.Data[agentCACertKey], kubeconfig.Clusters["service-cluster"].CertificateAuthorityData)
+	assert.Equal(t, string(secret.Data[agentProxyTokenKey]), kubeconfig.AuthInfos["service-cluster"].Token)
+
+	// the serving certificate is valid for the tunnel and proxy Services
+	caCert, _, err := parseCertKey(secret.Data[agentCACertKey], secret.Data[agentCAKeyKey])
+	require.NoError(t, err)
+	servingCert, _, err := parseCertKey(secret.Data[agentTLSCertKey], secret.Data[agentTLSKeyKey])
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	for _, dnsName := range []string{
+		"edge-1-ferry-tunnel.my-provider.svc",
+		"edge-1-ferry-proxy.my-provider.svc",
+	} {
+		_, err := servingCert.Verify(x509.VerifyOptions{DNSName: dnsName, Roots: roots})
+		assert.NoError(t, err, dnsName)
+	}
+
+	ferry := &operatorv1alpha1.Ferry{}
+	require.NoError(t, scc.Client.Get(ctx, req.NamespacedName, ferry))
+	assert.Equal(t, operatorv1alpha1.FerryConnectionAgent, ferry.Spec.Connection)
+	assert.Equal(t, "edge-1-agent", ferry.Spec.KubeconfigSecret.Name)
+
+	require.NoError(t, scc.Client.Get(ctx, req.NamespacedName, serviceCluster))
+	if assert.NotNil(t, serviceCluster.Status.AgentSecret) {
+		assert.Equal(t, "edge-1-agent", serviceCluster.Status.AgentSecret.Name)
+	}
+
+	// tokens and certificates are kept
+	token := secret.Data[agentTokenKey]
+	caCertPEM := secret.Data[agentCACertKey]
+	servingCertPEM := secret.Data[agentTLSCertKey]
+	_, err = scc.Reconcile(req)
+	require.NoError(t, err)
+	require.NoError(t, scc.Client.Get(ctx, types.NamespacedName{
+		Name:      "edge-1-agent",
+		Namespace: serviceCluster.Namespace,
+	}, secret))
+	assert.Equal(t, token, secret.Data[agentTokenKey])
+	assert.Equal(t, caCertPEM, secret.Data[agentCACertKey])
+	assert.Equal(t, servingCertPEM, secret.Data[agentTLSCertKey])
+}
@@ -17,9 +17,20 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
+	"fmt"
+	"sort"
+
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 )
 
 const (
@@ -41,3 +52,38 @@ type object interface {
 	runtime.Object
 	metav1.Object
 }
+
+// accountRBACSubjects returns the subjects of the Account with the given role,
+// including the users of the APIKeys of the Account.
+func accountRBACSubjects(
+	ctx context.Context, c client.Client,
+	account *catalogv1alpha1.Account, role catalogv1alpha1.AccountSubjectRole,
+) ([]rbacv1.Subject, error) {
+	subjects := account.RBACSubjects(role)
+	apiKeyList := &catalogv1alpha1.APIKeyList{}
+	if err := c.List(ctx, apiKeyList, client.InNamespace(account.Status.Namespace.Name)); err != nil {
+		return nil, fmt.Errorf("listing APIKeys: %w", err)
+	}
+	// keep the order stable, so RoleBindings are not updated needlessly
+	sort.Slice(apiKeyList.Items, func(i, j int) bool {
+		return apiKeyList.Items[i].Name < apiKeyList.Items[j].Name
+	})
+	for _, apiKey := range apiKeyList.Items {
+		if apiKey.SubjectRole().Includes(role) {
+			subjects = append(subjects, rbacv1.Subject{
+				Kind:     rbacv1.UserKind,
+				APIGroup: rbacv1.GroupName,
+				Name:     apiKey.UserName(),
+			})
+		}
+	}
+	return subjects, nil
+}
+
+// enqueueAccountOfAPIKey enqueues the Account an APIKey belongs to.
+var enqueueAccountOfAPIKey = &handler.EnqueueRequestsFromMapFunc{
+	ToRequests: handler.ToRequestsFunc(func(mapObject handler.MapObject) []reconcile.Request {
+		// the Account namespace is named like the Account
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: mapObject.Meta.GetNamespace()}}}
+	}),
+}
@@ -20,23 +20,30 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/go-logr/logr"
 	adminv1beta1 "k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/egress"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+	"k8c.io/kubecarrier/pkg/internal/util/objectreferences"
+	"k8c.io/kubecarrier/pkg/internal/util/referencedobjects"
 )
 
 // CatalogEntryWebhookHandler handles validating of CatalogEntries.
 type CatalogEntryWebhookHandler struct {
 	decoder *admission.Decoder
 	Log     logr.Logger
+	client.Client
 }
 
 var _ admission.Handler = (*CatalogEntryWebhookHandler)(nil)
 
-// +kubebuilder:webhook:path=/validate-catalog-kubecarrier-io-v1alpha1-catalogentry,mutating=false,failurePolicy=fail,groups=catalog.kubecarrier.io,resources=catalogentries,verbs=update,versions=v1alpha1,name=vcatalogentry.kubecarrier.io
+// +kubebuilder:webhook:path=/validate-catalog-kubecarrier-io-v1alpha1-catalogentry,mutating=false,failurePolicy=fail,groups=catalog.kubecarrier.io,resources=catalogentries,verbs=create;update,versions=v1alpha1,name=vcatalogentry.kubecarrier.io
 
 // Handle is the function to handle validating requests of CatalogEntries.
 func (r *CatalogEntryWebhookHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
@@ -46,6 +53,13 @@ func (r *CatalogEntryWebhookHandler) Handle(ctx context.Context, req admission.R
 	}
 
 	switch req.Operation {
+	case adminv1beta1.Create:
+		if err := r.validateCreate(obj); err != nil {
+			return admission.Denied(err.Error())
+		}
+		if err := r.validateBundleMembership(ctx, obj); err != nil {
+			return admission.Denied(err.Error())
+		}
 	case adminv1beta1.Update:
 		oldObj := &catalogv1alpha1.CatalogEntry{}
 		if err := r.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
@@ -54,6 +68,9 @@ func (r *CatalogEntryWebhookHandler) Handle(ctx context.Context, req admission.R
 		if err := r.validateUpdate(oldObj, obj); err != nil {
 			return admission.Denied(err.Error())
 		}
+		if err := r.validateBundleMembership(ctx, obj); err != nil {
+			return admission.Denied(err.Error())
+		}
 	}
 	return admission.Allowed("allowed to commit the request")
 }
@@ -66,10 +83,176 @@ func (r *CatalogEntryWebhookHandler) InjectDecoder(d *admission.Decoder) error {
 	return nil
 }
 
+func (r *CatalogEntryWebhookHandler) validateCreate(obj *catalogv1alpha1.CatalogEntry) error {
+	r.Log.Info("validate create", "name", obj.Name)
+	if err := validateMeteringConfig(obj.Spec.Metering); err != nil {
+		return err
+	}
+	if err := validateMigrationConfig(obj.Spec.Migration); err != nil {
+		return err
+	}
+	if err := validateLifecycle(obj.Spec.Lifecycle); err != nil {
+		return err
+	}
+	if err := validateBundle(obj); err != nil {
+		return err
+	}
+	if err := objectreferences.Validate(obj.Spec.ObjectReferences); err != nil {
+		return err
+	}
+	return referencedobjects.Validate(obj.Spec.ReferencedObjects)
+}
+
 func (r *CatalogEntryWebhookHandler) validateUpdate(oldObj, newObj *catalogv1alpha1.CatalogEntry) error {
 	r.Log.Info("validate update", "name", newObj.Name)
 	if newObj.Spec.BaseCRD.Name != oldObj.Spec.BaseCRD.Name {
 		return fmt.Errorf("the Referenced CRD of CatalogEntry is immutable")
 	}
+	if err := validateMeteringConfig(newObj.Spec.Metering); err != nil {
+		return err
+	}
+	if err := validateMigrationConfig(newObj.Spec.Migration); err != nil {
+		return err
+	}
+	if err := validateLifecycle(newObj.Spec.Lifecycle); err != nil {
+		return err
+	}
+	if err := validateBundle(newObj); err != nil {
+		return err
+	}
+	if err := objectreferences.Validate(newObj.Spec.ObjectReferences); err != nil {
+		return err
+	}
+	return referencedobjects.Validate(newObj.Spec.ReferencedObjects)
+}
+
+func validateBundle(obj *catalogv1alpha1.CatalogEntry) error {
+	names := map[string]struct{}{}
+	for _, ref := range obj.Spec.Bundle {
+		if ref.Name == "" {
+			return fmt.Errorf("bundle: CatalogEntry name cannot be empty")
+		}
+		if ref.Name == obj.Name {
+			return fmt.Errorf("bundle: CatalogEntry cannot bundle itself")
+		}
+		if _, ok := names[ref.Name]; ok {
+			return fmt.Errorf("bundle: duplicate CatalogEntry %s", ref.Name)
+		}
+		names[ref.Name] = struct{}{}
+	}
+	return nil
+}
+
+// validateBundleMembership checks the Bundle against the other CatalogEntries in the namespace:
+// bundles can't be nested, which also rules out cycles, and every CatalogEntry can only be part of a single bundle.
+func (r *CatalogEntryWebhookHandler) validateBundleMembership(ctx context.Context, obj *catalogv1alpha1.CatalogEntry) error {
+	catalogEntryList := &catalogv1alpha1.CatalogEntryList{}
+	if err := r.List(ctx, catalogEntryList, client.InNamespace(obj.Namespace)); err != nil {
+		return fmt.Errorf("listing CatalogEntries: %w", err)
+	}
+
+	bundled := map[string]struct{}{}
+	for _, ref := range obj.Spec.Bundle {
+		bundled[ref.Name] = struct{}{}
+	}
+	for _, catalogEntry := range catalogEntryList.Items {
+		if catalogEntry.Name == obj.Name {
+			continue
+		}
+		if _, ok := bundled[catalogEntry.Name]; ok && len(catalogEntry.Spec.Bundle) > 0 {
+			return fmt.Errorf("bundle: CatalogEntry %s is a bundle itself", catalogEntry.Name)
+		}
+		for _, ref := range catalogEntry.Spec.Bundle {
+			if ref.Name == obj.Name && len(obj.Spec.Bundle) > 0 {
+				return fmt.Errorf("bundle: CatalogEntry is bundled by %s and can't be a bundle itself", catalogEntry.Name)
+			}
+			if _, ok := bundled[ref.Name]; ok {
+				return fmt.Errorf("bundle: CatalogEntry %s is already bundled by %s", ref.Name, catalogEntry.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func validateMeteringConfig(metering *catalogv1alpha1.MeteringConfig) error {
+	if metering == nil {
+		return nil
+	}
+	names := map[string]struct{}{}
+	for _, dimension := range metering.Dimensions {
+		if dimension.Name == "" {
+			return fmt.Errorf("metering: dimension name cannot be empty")
+		}
+		if _, ok := names[dimension.Name]; ok {
+			return fmt.Errorf("metering: duplicate dimension %s", dimension.Name)
+		}
+		names[dimension.Name] = struct{}{}
+
+		path, err := fieldpath.Parse(dimension.JSONPath)
+		if err != nil {
+			return fmt.Errorf("metering: dimension %s: %w", dimension.Name, err)
+		}
+		if path.HasWildcard() {
+			return fmt.Errorf("metering: dimension %s: wildcards are not supported", dimension.Name)
+		}
+	}
+
+	if metering.Sink == "" {
+		return nil
+	}
+	sinkURL, err := url.Parse(metering.Sink)
+	if err != nil {
+		return fmt.Errorf("metering: invalid sink: %w", err)
+	}
+	switch sinkURL.Scheme {
+	case "http", "https":
+		if sinkURL.Host == "" {
+			return fmt.Errorf("metering: sink %s is missing a host", metering.Sink)
+		}
+	case "file":
+		if sinkURL.Path == "" {
+			return fmt.Errorf("metering: sink %s is missing a path", metering.Sink)
+		}
+	default:
+		return fmt.Errorf("metering: unsupported sink scheme %q, should be one of http, https, file", sinkURL.Scheme)
+	}
+	return nil
+}
+
+func validateLifecycle(lifecycle *catalogv1alpha1.Lifecycle) error {
+	if lifecycle == nil {
+		return nil
+	}
+	switch lifecycle.State {
+	case "", catalogv1alpha1.LifecycleStateActive:
+		if lifecycle.SunsetDate != nil {
+			return fmt.Errorf("lifecycle: sunsetDate cannot be set for Active CatalogEntries")
+		}
+	case catalogv1alpha1.LifecycleStateDeprecated, catalogv1alpha1.LifecycleStateRetired:
+	default:
+		return fmt.Errorf("lifecycle: unknown state %q", lifecycle.State)
+	}
+	return nil
+}
+
+func validateMigrationConfig(migration *catalogv1alpha1.MigrationConfig) error {
+	if migration == nil {
+		return nil
+	}
+	for name, hook := range map[string]*catalogv1alpha1.MigrationHook{
+		"preHook":  migration.PreHook,
+		"postHook": migration.PostHook,
+	} {
+		if hook == nil {
+			continue
+		}
+		// hooks are called by the manager, so they must not point to the cluster network
+		if err := egress.ValidateURL(hook.URL); err != nil {
+			return fmt.Errorf("migration: invalid %s url: %w", name, err)
+		}
+		if hook.Timeout != nil && hook.Timeout.Duration <= 0 {
+			return fmt.Errorf("migration: %s timeout should be positive", name)
+		}
+	}
 	return nil
 }
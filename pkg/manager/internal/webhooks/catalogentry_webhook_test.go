@@ -17,10 +17,14 @@ limitations under the License.
 package webhooks
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"k8c.io/utils/pkg/testutil"
 
@@ -97,11 +101,343 @@ func TestCatalogEntryValidatingUpdate(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "invalid referenced objects",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					ReferencedObjects: []catalogv1alpha1.ReferencedObject{
+						{Kind: catalogv1alpha1.ReferencedSecret, Name: "test-secret", JSONPath: ".status.secretName"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "bundle itself",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Bundle: []catalogv1alpha1.ObjectReference{
+						{Name: "test-catalogEntry"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid object references",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					ObjectReferences: []catalogv1alpha1.ObjectReferenceField{
+						{CatalogEntry: catalogv1alpha1.ObjectReference{Name: "db"}, JSONPath: ".spec.dbs[*].name"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid metering sink",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Metering: &catalogv1alpha1.MeteringConfig{
+						Dimensions: []catalogv1alpha1.MeteringDimension{
+							{Name: "storage", JSONPath: ".spec.storage"},
+						},
+						Sink: "ftp://billing.example.com",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid metering dimension",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Metering: &catalogv1alpha1.MeteringConfig{
+						Dimensions: []catalogv1alpha1.MeteringDimension{
+							{Name: "storage", JSONPath: ".spec.disks[*].size"},
+						},
+						Sink: "https://billing.example.com/events",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid migration hook",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Migration: &catalogv1alpha1.MigrationConfig{
+						PreHook: &catalogv1alpha1.MigrationHook{URL: "file:///backup"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "internal migration hook",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Migration: &catalogv1alpha1.MigrationConfig{
+						PostHook: &catalogv1alpha1.MigrationHook{URL: "https://kubernetes.default.svc/api"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "migration hook",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Migration: &catalogv1alpha1.MigrationConfig{
+						PostHook: &catalogv1alpha1.MigrationHook{URL: "https://backup.example.com/hooks/post"},
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "deprecated with sunset date",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Lifecycle: &catalogv1alpha1.Lifecycle{
+						State:      catalogv1alpha1.LifecycleStateDeprecated,
+						Message:    "use test-crd-v2 instead",
+						SunsetDate: &metav1.Time{Time: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "active with sunset date",
+			object: &catalogv1alpha1.CatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-catalogEntry",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: catalogv1alpha1.CatalogEntrySpec{
+					Metadata: catalogv1alpha1.CatalogEntryMetadata{
+						CommonMetadata: catalogv1alpha1.CommonMetadata{
+							Description: "test CatalogEntry",
+							DisplayName: "test CatalogEntry",
+						},
+					},
+					BaseCRD: catalogv1alpha1.ObjectReference{
+						Name: "test-crd",
+					},
+					Lifecycle: &catalogv1alpha1.Lifecycle{
+						State:      catalogv1alpha1.LifecycleStateActive,
+						SunsetDate: &metav1.Time{Time: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedError, catalogEntryWebhookHandler.validateUpdate(oldObj, test.object) != nil)
+		})
+	}
+}
+
+func TestCatalogEntryValidatingBundleMembership(t *testing.T) {
+	newCatalogEntry := func(name string, bundle ...string) *catalogv1alpha1.CatalogEntry {
+		catalogEntry := &catalogv1alpha1.CatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-provider-namespace",
+			},
+		}
+		for _, b := range bundle {
+			catalogEntry.Spec.Bundle = append(catalogEntry.Spec.Bundle, catalogv1alpha1.ObjectReference{Name: b})
+		}
+		return catalogEntry
+	}
+
+	tests := []struct {
+		name            string
+		object          *catalogv1alpha1.CatalogEntry
+		existingObjects []runtime.Object
+		expectedError   bool
+	}{
+		{
+			name:   "can pass bundle",
+			object: newCatalogEntry("db", "backup"),
+			existingObjects: []runtime.Object{
+				newCatalogEntry("backup"),
+				newCatalogEntry("couchdb", "snapshot"),
+			},
+			expectedError: false,
+		},
+		{
+			name:   "update of existing bundle",
+			object: newCatalogEntry("db", "backup", "snapshot"),
+			existingObjects: []runtime.Object{
+				newCatalogEntry("db", "backup"),
+			},
+			expectedError: false,
+		},
+		{
+			name:   "bundled CatalogEntry is a bundle",
+			object: newCatalogEntry("db", "backup"),
+			existingObjects: []runtime.Object{
+				newCatalogEntry("backup", "snapshot"),
+			},
+			expectedError: true,
+		},
+		{
+			name:   "cycle",
+			object: newCatalogEntry("db", "backup"),
+			existingObjects: []runtime.Object{
+				newCatalogEntry("backup", "db"),
+			},
+			expectedError: true,
+		},
+		{
+			name:   "bundle is bundled",
+			object: newCatalogEntry("backup", "snapshot"),
+			existingObjects: []runtime.Object{
+				newCatalogEntry("db", "backup"),
+			},
+			expectedError: true,
+		},
+		{
+			name:   "double membership",
+			object: newCatalogEntry("couchdb", "backup"),
+			existingObjects: []runtime.Object{
+				newCatalogEntry("db", "backup"),
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.expectedError, catalogEntryWebhookHandler.validateUpdate(test.object, oldObj) != nil)
+			catalogEntryWebhookHandler := CatalogEntryWebhookHandler{
+				Log:    testutil.NewLogger(t),
+				Client: fakeclient.NewFakeClientWithScheme(testScheme, test.existingObjects...),
+			}
+			err := catalogEntryWebhookHandler.validateBundleMembership(context.Background(), test.object)
+			assert.Equal(t, test.expectedError, err != nil, err)
 		})
 	}
 }
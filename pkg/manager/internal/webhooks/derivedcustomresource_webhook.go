@@ -25,6 +25,7 @@ import (
 	"github.com/go-logr/logr"
 	adminv1beta1 "k8s.io/api/admission/v1beta1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -35,6 +36,9 @@ import (
 	"k8c.io/utils/pkg/util"
 
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+	"k8c.io/kubecarrier/pkg/internal/util/objectreferences"
+	"k8c.io/kubecarrier/pkg/internal/util/referencedobjects"
 )
 
 // DerivedCustomResourceWebhookHandler handles mutating/validating of DerivedCustomResources.
@@ -42,16 +46,27 @@ type DerivedCustomResourceWebhookHandler struct {
 	decoder *admission.Decoder
 	Log     logr.Logger
 	client.Client
-	Scheme *runtime.Scheme
+	// APIReader reads directly from the API server,
+	// so we can see BaseCRDs that are not yet in the cache.
+	APIReader client.Reader
+	Scheme    *runtime.Scheme
 }
 
 var _ admission.Handler = (*DerivedCustomResourceWebhookHandler)(nil)
 
-// +kubebuilder:webhook:path=/validate-catalog-kubecarrier-io-v1alpha1-derivedcustomresource,mutating=false,failurePolicy=fail,groups=catalog.kubecarrier.io,resources=derivedcustomresources,verbs=update;delete,versions=v1alpha1,name=vderivedcustomresource.kubecarrier.io
+// +kubebuilder:webhook:path=/validate-catalog-kubecarrier-io-v1alpha1-derivedcustomresource,mutating=false,failurePolicy=fail,groups=catalog.kubecarrier.io,resources=derivedcustomresources,verbs=create;update;delete,versions=v1alpha1,name=vderivedcustomresource.kubecarrier.io
 
 // Handle is the function to handle create/update requests of DerivedCustomResources.
 func (r *DerivedCustomResourceWebhookHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
 	switch req.Operation {
+	case adminv1beta1.Create:
+		obj := &catalogv1alpha1.DerivedCustomResource{}
+		if err := r.decoder.Decode(req, obj); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err := r.validateExpose(ctx, obj); err != nil {
+			return admission.Denied(err.Error())
+		}
 	case adminv1beta1.Update:
 		obj := &catalogv1alpha1.DerivedCustomResource{}
 		if err := r.decoder.Decode(req, obj); err != nil {
@@ -64,6 +79,12 @@ func (r *DerivedCustomResourceWebhookHandler) Handle(ctx context.Context, req ad
 		if err := r.validateUpdate(oldObj, obj); err != nil {
 			return admission.Denied(err.Error())
 		}
+		// finalizers have to be removable, even when the BaseCRD is already gone
+		if obj.DeletionTimestamp.IsZero() && !equality.Semantic.DeepEqual(oldObj.Spec, obj.Spec) {
+			if err := r.validateExpose(ctx, obj); err != nil {
+				return admission.Denied(err.Error())
+			}
+		}
 	case adminv1beta1.Delete:
 		oldObj := &catalogv1alpha1.DerivedCustomResource{}
 		if err := r.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
@@ -94,6 +115,145 @@ func (r *DerivedCustomResourceWebhookHandler) validateUpdate(oldObj, newObj *cat
 	return nil
 }
 
+// validateExpose checks that all exposed fields are valid paths that exist in the schema of the BaseCRD.
+func (r *DerivedCustomResourceWebhookHandler) validateExpose(ctx context.Context, obj *catalogv1alpha1.DerivedCustomResource) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{
+		Name: obj.Spec.BaseCRD.Name,
+	}, crd); err != nil {
+		return fmt.Errorf("getting BaseCRD: %w", err)
+	}
+	if err := validateExposeConfigs(obj.Spec.Expose, crd); err != nil {
+		return err
+	}
+	if err := objectreferences.Validate(obj.Spec.ObjectReferences); err != nil {
+		return err
+	}
+	return referencedobjects.Validate(obj.Spec.ReferencedObjects)
+}
+
+func validateExposeConfigs(exposeConfigs []catalogv1alpha1.VersionExposeConfig, crd *apiextensionsv1.CustomResourceDefinition) error {
+	crdVersions := map[string]apiextensionsv1.CustomResourceDefinitionVersion{}
+	for _, crdVersion := range crd.Spec.Versions {
+		crdVersions[crdVersion.Name] = crdVersion
+	}
+
+	for _, exposeConfig := range exposeConfigs {
+		paths, err := validateFieldPaths(exposeConfig)
+		if err != nil {
+			return err
+		}
+		for _, version := range exposeConfig.Versions {
+			crdVersion, ok := crdVersions[version]
+			if !ok {
+				return fmt.Errorf("version %s is not defined in the BaseCRD %s", version, crd.Name)
+			}
+			if crdVersion.Schema == nil || crdVersion.Schema.OpenAPIV3Schema == nil {
+				// this version has no schema to check
+				continue
+			}
+			for _, path := range paths {
+				if err := fieldpath.ValidateSchema(path, crdVersion.Schema.OpenAPIV3Schema); err != nil {
+					return fmt.Errorf("version %s: %w", version, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateFieldPaths validates the fields and defaults of the VersionExposeConfig
+// and returns all paths of the base CRD that are referenced.
+func validateFieldPaths(exposeConfig catalogv1alpha1.VersionExposeConfig) ([]fieldpath.Path, error) {
+	var (
+		providerPaths []fieldpath.Path
+		tenantPaths   []fieldpath.Path
+		renamedPaths  []fieldpath.Path
+	)
+	for _, field := range exposeConfig.Fields {
+		path, err := fieldpath.Parse(field.JSONPath)
+		if err != nil {
+			return nil, err
+		}
+		providerPaths = append(providerPaths, path)
+		if field.TenantPath == "" {
+			tenantPaths = append(tenantPaths, path)
+			continue
+		}
+
+		tenantPath, err := fieldpath.Parse(field.TenantPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, segment := range tenantPath {
+			if segment.Type != fieldpath.FieldSegment {
+				return nil, fmt.Errorf("tenantPath %s: array selectors are not supported", tenantPath)
+			}
+		}
+		if path.HasWildcard() {
+			return nil, fmt.Errorf("%s: wildcards are not supported for fields with a tenantPath", path)
+		}
+		if isStatusPath(path) != isStatusPath(tenantPath) {
+			return nil, fmt.Errorf("tenantPath %s: status fields can only be renamed within .status", tenantPath)
+		}
+		if isMetadataPath(tenantPath) {
+			return nil, fmt.Errorf("tenantPath %s: metadata fields can't be renamed", tenantPath)
+		}
+		tenantPaths = append(tenantPaths, tenantPath)
+		renamedPaths = append(renamedPaths, tenantPath)
+	}
+
+	for _, renamedPath := range renamedPaths {
+		overlaps := 0
+		for _, tenantPath := range tenantPaths {
+			if renamedPath.Covers(tenantPath) || tenantPath.Covers(renamedPath) {
+				overlaps++
+			}
+		}
+		if overlaps > 1 {
+			return nil, fmt.Errorf("tenantPath %s: overlaps with another exposed field", renamedPath)
+		}
+	}
+
+	for _, fieldDefault := range exposeConfig.Defaults {
+		path, err := fieldpath.Parse(fieldDefault.JSONPath)
+		if err != nil {
+			return nil, err
+		}
+		if path.HasWildcard() {
+			return nil, fmt.Errorf("default %s: wildcards are not supported", path)
+		}
+		if isStatusPath(path) || isMetadataPath(path) {
+			return nil, fmt.Errorf("default %s: defaults can only be set for spec fields", path)
+		}
+		for _, providerPath := range providerPaths {
+			if providerPath.Covers(path) || path.Covers(providerPath) {
+				return nil, fmt.Errorf("default %s: field is exposed by %s, defaults can only be set for hidden fields", path, providerPath)
+			}
+		}
+		if len(fieldDefault.Value.Raw) == 0 {
+			return nil, fmt.Errorf("default %s: missing value", path)
+		}
+		providerPaths = append(providerPaths, path)
+	}
+	return providerPaths, nil
+}
+
+func isStatusPath(path fieldpath.Path) bool {
+	return path[0].Type == fieldpath.FieldSegment && path[0].Field == "status"
+}
+
+func isMetadataPath(path fieldpath.Path) bool {
+	if path[0].Type != fieldpath.FieldSegment {
+		return false
+	}
+	switch path[0].Field {
+	case "metadata", "apiVersion", "kind":
+		return true
+	}
+	return false
+}
+
 func (r *DerivedCustomResourceWebhookHandler) validateDelete(ctx context.Context, obj *catalogv1alpha1.DerivedCustomResource) error {
 	if obj.Status.DerivedCR == nil {
 		return nil
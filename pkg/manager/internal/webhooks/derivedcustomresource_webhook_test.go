@@ -17,10 +17,18 @@ limitations under the License.
 package webhooks
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	adminv1beta1 "k8s.io/api/admission/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"k8c.io/utils/pkg/testutil"
 
@@ -118,3 +126,235 @@ func TestDerivedCustomResourceValidatingUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestDerivedCustomResourceHandleUpdateWithoutBaseCRD(t *testing.T) {
+	decoder, err := admission.NewDecoder(testScheme)
+	require.NoError(t, err)
+	derivedCustomResourceWebhookHandler := DerivedCustomResourceWebhookHandler{
+		Log: testutil.NewLogger(t),
+		// the BaseCRD is already gone
+		APIReader: fakeclient.NewFakeClientWithScheme(testScheme),
+	}
+	require.NoError(t, derivedCustomResourceWebhookHandler.InjectDecoder(decoder))
+
+	now := metav1.Now()
+	oldObject := &catalogv1alpha1.DerivedCustomResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-derivedCustomResource",
+			Namespace:  "test-namespace",
+			Finalizers: []string{"kubecarrier.io/test"},
+		},
+		Spec: catalogv1alpha1.DerivedCustomResourceSpec{
+			BaseCRD: catalogv1alpha1.ObjectReference{
+				Name: "BaseCRD",
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mutate          func(obj *catalogv1alpha1.DerivedCustomResource)
+		expectedAllowed bool
+	}{
+		{
+			name: "finalizer removal",
+			mutate: func(obj *catalogv1alpha1.DerivedCustomResource) {
+				obj.DeletionTimestamp = &now
+				obj.Finalizers = nil
+			},
+			expectedAllowed: true,
+		},
+		{
+			name: "labels change",
+			mutate: func(obj *catalogv1alpha1.DerivedCustomResource) {
+				obj.Labels = map[string]string{"test": "test"}
+			},
+			expectedAllowed: true,
+		},
+		{
+			name: "expose change",
+			mutate: func(obj *catalogv1alpha1.DerivedCustomResource) {
+				obj.Spec.Expose = []catalogv1alpha1.VersionExposeConfig{
+					{
+						Versions: []string{"v1alpha1"},
+						Fields: []catalogv1alpha1.FieldPath{
+							{JSONPath: ".spec.prop1"},
+						},
+					},
+				}
+			},
+			expectedAllowed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			newObject := oldObject.DeepCopy()
+			test.mutate(newObject)
+			oldRaw, err := json.Marshal(oldObject)
+			require.NoError(t, err)
+			newRaw, err := json.Marshal(newObject)
+			require.NoError(t, err)
+
+			resp := derivedCustomResourceWebhookHandler.Handle(context.Background(), admission.Request{
+				AdmissionRequest: adminv1beta1.AdmissionRequest{
+					Operation: adminv1beta1.Update,
+					Object:    runtime.RawExtension{Raw: newRaw},
+					OldObject: runtime.RawExtension{Raw: oldRaw},
+				},
+			})
+			assert.Equal(t, test.expectedAllowed, resp.Allowed, resp.Result)
+		})
+	}
+}
+
+func TestDerivedCustomResourceValidatingExposeConfigs(t *testing.T) {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "couchdbs.eu-west-1.provider",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name: "v1alpha1",
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"spec": {
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"containers": {
+											Type: "array",
+											Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+												Schema: &apiextensionsv1.JSONSchemaProps{
+													Type: "object",
+													Properties: map[string]apiextensionsv1.JSONSchemaProps{
+														"image": {Type: "string"},
+													},
+												},
+											},
+										},
+										"replicas": {Type: "integer"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		fields        []catalogv1alpha1.FieldPath
+		defaults      []catalogv1alpha1.FieldDefault
+		versions      []string
+		expectedError bool
+	}{
+		{
+			name:     "valid paths",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers[*].image"},
+				{JSONPath: ".spec.containers[0]"},
+			},
+		},
+		{
+			name:     "field not in schema",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers[*].name"},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "invalid syntax",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers[x]"},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "renamed field",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers[0].image", TenantPath: ".spec.image"},
+			},
+			defaults: []catalogv1alpha1.FieldDefault{
+				{JSONPath: ".spec.replicas", Value: apiextensionsv1.JSON{Raw: []byte(`3`)}},
+			},
+		},
+		{
+			name:     "renamed field with wildcard",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers[*].image", TenantPath: ".spec.images"},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "renamed field with array selector",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.replicas", TenantPath: ".spec.sizes[0]"},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "renamed field into status",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.replicas", TenantPath: ".status.replicas"},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "renamed field overlapping another field",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers"},
+				{JSONPath: ".spec.replicas", TenantPath: ".spec.containers"},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "default for exposed field",
+			versions: []string{"v1alpha1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.replicas"},
+			},
+			defaults: []catalogv1alpha1.FieldDefault{
+				{JSONPath: ".spec.replicas", Value: apiextensionsv1.JSON{Raw: []byte(`3`)}},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "default not in schema",
+			versions: []string{"v1alpha1"},
+			defaults: []catalogv1alpha1.FieldDefault{
+				{JSONPath: ".spec.storageClass", Value: apiextensionsv1.JSON{Raw: []byte(`"fast"`)}},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "unknown version",
+			versions: []string{"v1"},
+			fields: []catalogv1alpha1.FieldPath{
+				{JSONPath: ".spec.containers"},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateExposeConfigs([]catalogv1alpha1.VersionExposeConfig{
+				{Versions: test.versions, Fields: test.fields, Defaults: test.defaults},
+			}, crd)
+			assert.Equal(t, test.expectedError, err != nil, "error: %v", err)
+		})
+	}
+}
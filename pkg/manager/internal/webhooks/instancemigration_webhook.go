@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	adminv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+// InstanceMigrationWebhookHandler handles validating of InstanceMigrations.
+type InstanceMigrationWebhookHandler struct {
+	decoder *admission.Decoder
+	Log     logr.Logger
+}
+
+var _ admission.Handler = (*InstanceMigrationWebhookHandler)(nil)
+
+// +kubebuilder:webhook:path=/validate-catalog-kubecarrier-io-v1alpha1-instancemigration,mutating=false,failurePolicy=fail,groups=catalog.kubecarrier.io,resources=instancemigrations,verbs=create;update,versions=v1alpha1,name=vinstancemigration.kubecarrier.io
+
+// Handle is the function to handle validating requests of InstanceMigrations.
+func (r *InstanceMigrationWebhookHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj := &catalogv1alpha1.InstanceMigration{}
+	if err := r.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	switch req.Operation {
+	case adminv1beta1.Create:
+		if err := r.validateCreate(obj); err != nil {
+			return admission.Denied(err.Error())
+		}
+	case adminv1beta1.Update:
+		oldObj := &catalogv1alpha1.InstanceMigration{}
+		if err := r.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err := r.validateUpdate(oldObj, obj); err != nil {
+			return admission.Denied(err.Error())
+		}
+	}
+	return admission.Allowed("allowed to commit the request")
+}
+
+// InstanceMigrationWebhookHandler implements admission.DecoderInjector.
+// A decoder will be automatically injected.
+// InjectDecoder injects the decoder.
+func (r *InstanceMigrationWebhookHandler) InjectDecoder(d *admission.Decoder) error {
+	r.decoder = d
+	return nil
+}
+
+func (r *InstanceMigrationWebhookHandler) validateCreate(obj *catalogv1alpha1.InstanceMigration) error {
+	r.Log.Info("validate create", "name", obj.Name)
+	if obj.Spec.Offering.Name == "" {
+		return fmt.Errorf("offering name cannot be empty")
+	}
+	if obj.Spec.Instance.Name == "" {
+		return fmt.Errorf("instance name cannot be empty")
+	}
+	if obj.Spec.TargetOffering.Name == "" {
+		return fmt.Errorf("targetOffering name cannot be empty")
+	}
+	if obj.Spec.Offering.Name == obj.Spec.TargetOffering.Name {
+		return fmt.Errorf("offering and targetOffering cannot be the same")
+	}
+	if obj.Spec.ReadinessTimeout != nil && obj.Spec.ReadinessTimeout.Duration <= 0 {
+		return fmt.Errorf("readinessTimeout should be positive")
+	}
+	return nil
+}
+
+func (r *InstanceMigrationWebhookHandler) validateUpdate(oldObj, newObj *catalogv1alpha1.InstanceMigration) error {
+	r.Log.Info("validate update", "name", newObj.Name)
+	if !equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return fmt.Errorf("the spec of InstanceMigration is immutable")
+	}
+	return nil
+}
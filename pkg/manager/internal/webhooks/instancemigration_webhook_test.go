@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+func TestInstanceMigrationValidatingCreate(t *testing.T) {
+	instanceMigrationWebhookHandler := InstanceMigrationWebhookHandler{
+		Log: testutil.NewLogger(t),
+	}
+
+	tests := []struct {
+		name          string
+		object        *catalogv1alpha1.InstanceMigration
+		expectedError bool
+	}{
+		{
+			name: "missing instance",
+			object: &catalogv1alpha1.InstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db1-migration",
+					Namespace: "test-tenant-namespace",
+				},
+				Spec: catalogv1alpha1.InstanceMigrationSpec{
+					Offering:       catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1.example"},
+					TargetOffering: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-2.example"},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "same target offering",
+			object: &catalogv1alpha1.InstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db1-migration",
+					Namespace: "test-tenant-namespace",
+				},
+				Spec: catalogv1alpha1.InstanceMigrationSpec{
+					Offering:       catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1.example"},
+					Instance:       catalogv1alpha1.ObjectReference{Name: "db1"},
+					TargetOffering: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1.example"},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "can pass validating create",
+			object: &catalogv1alpha1.InstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db1-migration",
+					Namespace: "test-tenant-namespace",
+				},
+				Spec: catalogv1alpha1.InstanceMigrationSpec{
+					Offering:       catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1.example"},
+					Instance:       catalogv1alpha1.ObjectReference{Name: "db1"},
+					TargetOffering: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-2.example"},
+				},
+			},
+			expectedError: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedError, instanceMigrationWebhookHandler.validateCreate(test.object) != nil)
+		})
+	}
+}
+
+func TestInstanceMigrationValidatingUpdate(t *testing.T) {
+	instanceMigrationWebhookHandler := InstanceMigrationWebhookHandler{
+		Log: testutil.NewLogger(t),
+	}
+	oldObj := &catalogv1alpha1.InstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db1-migration",
+			Namespace: "test-tenant-namespace",
+		},
+		Spec: catalogv1alpha1.InstanceMigrationSpec{
+			Offering:       catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1.example"},
+			Instance:       catalogv1alpha1.ObjectReference{Name: "db1"},
+			TargetOffering: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-2.example"},
+		},
+	}
+	newObj := oldObj.DeepCopy()
+	newObj.Spec.TargetOffering.Name = "couchdbs.eu-west-3.example"
+	assert.Error(t, instanceMigrationWebhookHandler.validateUpdate(oldObj, newObj), "spec is immutable")
+	assert.NoError(t, instanceMigrationWebhookHandler.validateUpdate(oldObj, oldObj.DeepCopy()))
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/util/fieldpath"
+)
+
+// QuotaWebhookHandler handles validating of Quotas.
+type QuotaWebhookHandler struct {
+	decoder *admission.Decoder
+	Log     logr.Logger
+}
+
+var _ admission.Handler = (*QuotaWebhookHandler)(nil)
+
+// +kubebuilder:webhook:path=/validate-catalog-kubecarrier-io-v1alpha1-quota,mutating=false,failurePolicy=fail,groups=catalog.kubecarrier.io,resources=quotas,verbs=create;update,versions=v1alpha1,name=vquota.kubecarrier.io
+
+// Handle is the function to handle validating requests of Quotas.
+func (r *QuotaWebhookHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj := &catalogv1alpha1.Quota{}
+	if err := r.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := r.validate(obj); err != nil {
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("allowed to commit the request")
+}
+
+// QuotaWebhookHandler implements admission.DecoderInjector.
+// A decoder will be automatically injected.
+// InjectDecoder injects the decoder.
+func (r *QuotaWebhookHandler) InjectDecoder(d *admission.Decoder) error {
+	r.decoder = d
+	return nil
+}
+
+func (r *QuotaWebhookHandler) validate(quota *catalogv1alpha1.Quota) error {
+	r.Log.Info("validate", "name", quota.Name)
+	if quota.Spec.Catalog.Name == "" {
+		return fmt.Errorf("catalog name cannot be empty")
+	}
+	if quota.Spec.Tenant != nil && quota.Spec.Tenant.Name == "" {
+		return fmt.Errorf("tenant name cannot be empty")
+	}
+
+	catalogEntries := map[string]struct{}{}
+	for _, limit := range quota.Spec.Limits {
+		catalogEntry := limit.CatalogEntry.Name
+		if catalogEntry == "" {
+			return fmt.Errorf("catalogEntry name cannot be empty")
+		}
+		if _, ok := catalogEntries[catalogEntry]; ok {
+			return fmt.Errorf("duplicate limits for catalogEntry %s", catalogEntry)
+		}
+		catalogEntries[catalogEntry] = struct{}{}
+
+		jsonPaths := map[string]struct{}{}
+		for _, fieldLimit := range limit.Fields {
+			path, err := fieldpath.Parse(fieldLimit.JSONPath)
+			if err != nil {
+				return fmt.Errorf("catalogEntry %s: %w", catalogEntry, err)
+			}
+			if path.HasWildcard() {
+				return fmt.Errorf("catalogEntry %s: %s: wildcards are not supported", catalogEntry, fieldLimit.JSONPath)
+			}
+			if _, ok := jsonPaths[path.String()]; ok {
+				return fmt.Errorf("catalogEntry %s: duplicate limits for %s", catalogEntry, fieldLimit.JSONPath)
+			}
+			jsonPaths[path.String()] = struct{}{}
+			if fieldLimit.Max.Sign() < 0 {
+				return fmt.Errorf("catalogEntry %s: %s: max cannot be negative", catalogEntry, fieldLimit.JSONPath)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8c.io/utils/pkg/testutil"
+
+	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
+)
+
+func TestQuotaValidating(t *testing.T) {
+	quotaWebhookHandler := QuotaWebhookHandler{
+		Log: testutil.NewLogger(t),
+	}
+
+	maxInstances := int64(2)
+	tests := []struct {
+		name          string
+		spec          catalogv1alpha1.QuotaSpec
+		expectedError bool
+	}{
+		{
+			name: "can pass validation",
+			spec: catalogv1alpha1.QuotaSpec{
+				Catalog: catalogv1alpha1.ObjectReference{Name: "free-tier"},
+				Limits: []catalogv1alpha1.CatalogEntryLimit{
+					{
+						CatalogEntry: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"},
+						MaxInstances: &maxInstances,
+						Fields: []catalogv1alpha1.FieldLimit{
+							{JSONPath: ".spec.storage", Max: resource.MustParse("10Gi")},
+						},
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "missing catalog",
+			spec: catalogv1alpha1.QuotaSpec{
+				Limits: []catalogv1alpha1.CatalogEntryLimit{
+					{CatalogEntry: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"}},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "duplicate catalogEntry",
+			spec: catalogv1alpha1.QuotaSpec{
+				Catalog: catalogv1alpha1.ObjectReference{Name: "free-tier"},
+				Limits: []catalogv1alpha1.CatalogEntryLimit{
+					{CatalogEntry: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"}},
+					{CatalogEntry: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"}},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid jsonPath",
+			spec: catalogv1alpha1.QuotaSpec{
+				Catalog: catalogv1alpha1.ObjectReference{Name: "free-tier"},
+				Limits: []catalogv1alpha1.CatalogEntryLimit{
+					{
+						CatalogEntry: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"},
+						Fields: []catalogv1alpha1.FieldLimit{
+							{JSONPath: ".spec.volumes[*].size", Max: resource.MustParse("10Gi")},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "negative max",
+			spec: catalogv1alpha1.QuotaSpec{
+				Catalog: catalogv1alpha1.ObjectReference{Name: "free-tier"},
+				Limits: []catalogv1alpha1.CatalogEntryLimit{
+					{
+						CatalogEntry: catalogv1alpha1.ObjectReference{Name: "couchdbs.eu-west-1"},
+						Fields: []catalogv1alpha1.FieldLimit{
+							{JSONPath: ".spec.storage", Max: resource.MustParse("-1")},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			quota := &catalogv1alpha1.Quota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-quota",
+					Namespace: "test-provider-namespace",
+				},
+				Spec: test.spec,
+			}
+			assert.Equal(t, test.expectedError, quotaWebhookHandler.validate(quota) != nil)
+		})
+	}
+}
@@ -37,7 +37,7 @@ type ServiceClusterWebhookHandler struct {
 
 var _ admission.Handler = (*ServiceClusterWebhookHandler)(nil)
 
-// +kubebuilder:webhook:path=/validate-kubecarrier-io-v1alpha1-servicecluster,mutating=false,failurePolicy=fail,groups=kubecarrier.io,resources=serviceclusters,verbs=create,versions=v1alpha1,name=vservicecluster.kubecarrier.io
+// +kubebuilder:webhook:path=/validate-kubecarrier-io-v1alpha1-servicecluster,mutating=false,failurePolicy=fail,groups=kubecarrier.io,resources=serviceclusters,verbs=create;update,versions=v1alpha1,name=vservicecluster.kubecarrier.io
 
 // Handle is the function to handle create/update requests of ServiceClusters.
 func (r *ServiceClusterWebhookHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
@@ -51,6 +51,12 @@ func (r *ServiceClusterWebhookHandler) Handle(ctx context.Context, req admission
 			return admission.Denied(err.Error())
 		}
 	}
+
+	if req.Operation == adminv1beta1.Update {
+		if err := r.validateUpdate(obj); err != nil {
+			return admission.Denied(err.Error())
+		}
+	}
 	return admission.Allowed("allowed to commit the request")
 }
 
@@ -68,5 +74,26 @@ func (r *ServiceClusterWebhookHandler) validateCreate(serviceCluster *corev1alph
 	if !webhook.IsDNS1123Label(serviceCluster.Name) {
 		return fmt.Errorf("serviceCluster name: %s is not a valid DNS 1123 Label, %s", serviceCluster.Name, webhook.DNS1123LabelDescription)
 	}
+	return r.validateConnection(serviceCluster)
+}
+
+func (r *ServiceClusterWebhookHandler) validateUpdate(serviceCluster *corev1alpha1.ServiceCluster) error {
+	r.Log.Info("validate update", "name", serviceCluster.Name)
+	return r.validateConnection(serviceCluster)
+}
+
+func (r *ServiceClusterWebhookHandler) validateConnection(serviceCluster *corev1alpha1.ServiceCluster) error {
+	switch serviceCluster.Spec.ConnectionOrDefault() {
+	case corev1alpha1.ServiceClusterConnectionKubeconfig:
+		if serviceCluster.Spec.KubeconfigSecret == nil {
+			return fmt.Errorf("the KubeconfigSecret is required for the %s connection", corev1alpha1.ServiceClusterConnectionKubeconfig)
+		}
+	case corev1alpha1.ServiceClusterConnectionAgent:
+		if serviceCluster.Spec.KubeconfigSecret != nil {
+			return fmt.Errorf("the KubeconfigSecret cannot be used with the %s connection", corev1alpha1.ServiceClusterConnectionAgent)
+		}
+	default:
+		return fmt.Errorf("unknown connection %q", serviceCluster.Spec.Connection)
+	}
 	return nil
 }
@@ -47,6 +47,30 @@ func TestServiceClusterValidatingCreate(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name: "missing kubeconfig secret",
+			object: &covev1alpha1.ServiceCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-service-cluster",
+					Namespace: "test-serviceCluster-namespace",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "kubeconfig secret with agent connection",
+			object: &covev1alpha1.ServiceCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-service-cluster",
+					Namespace: "test-serviceCluster-namespace",
+				},
+				Spec: covev1alpha1.ServiceClusterSpec{
+					Connection:       covev1alpha1.ServiceClusterConnectionAgent,
+					KubeconfigSecret: &covev1alpha1.ObjectReference{Name: "test-kubeconfig"},
+				},
+			},
+			expectedError: true,
+		},
 		{
 			name: "can pass validate create",
 			object: &covev1alpha1.ServiceCluster{
@@ -54,6 +78,22 @@ func TestServiceClusterValidatingCreate(t *testing.T) {
 					Name:      "test-service-cluster",
 					Namespace: "test-serviceCluster-namespace",
 				},
+				Spec: covev1alpha1.ServiceClusterSpec{
+					KubeconfigSecret: &covev1alpha1.ObjectReference{Name: "test-kubeconfig"},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "can pass validate create with agent connection",
+			object: &covev1alpha1.ServiceCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-service-cluster",
+					Namespace: "test-serviceCluster-namespace",
+				},
+				Spec: covev1alpha1.ServiceClusterSpec{
+					Connection: covev1alpha1.ServiceClusterConnectionAgent,
+				},
 			},
 			expectedError: false,
 		},
@@ -38,6 +38,7 @@ import (
 	catalogv1alpha1 "k8c.io/kubecarrier/pkg/apis/catalog/v1alpha1"
 	corev1alpha1 "k8c.io/kubecarrier/pkg/apis/core/v1alpha1"
 	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/consolidation"
 	utilwebhook "k8c.io/kubecarrier/pkg/internal/util/webhook"
 	"k8c.io/kubecarrier/pkg/manager/internal/controllers"
 	"k8c.io/kubecarrier/pkg/manager/internal/webhooks"
@@ -90,7 +91,8 @@ func NewManagerCommand() *cobra.Command {
 }
 
 func run(flags *flags, log logr.Logger) error {
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	cfg := ctrl.GetConfigOrDie()
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                  scheme,
 		MetricsBindAddress:      flags.metricsAddr,
 		LeaderElection:          flags.enableLeaderElection,
@@ -165,17 +167,30 @@ func run(flags *flags, log logr.Logger) error {
 	}
 
 	if err = (&controllers.CatalogEntryReconciler{
-		Client: mgr.GetClient(),
-		Log:    log.WithName("controllers").WithName("CatalogEntry"),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Log:      log.WithName("controllers").WithName("CatalogEntry"),
+		Recorder: mgr.GetEventRecorderFor("manager"),
+		Scheme:   mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("creating CatalogEntry controller: %w", err)
 	}
 
+	// every aggregated CRD is scheduled by its own Manager, so it can be stopped when the CRD is removed
+	schedulingRunner := consolidation.NewRunner(log.WithName("scheduling"), func() (ctrl.Manager, error) {
+		return ctrl.NewManager(cfg, ctrl.Options{
+			Scheme:             scheme,
+			MetricsBindAddress: "0",
+		})
+	})
+	if err := mgr.Add(schedulingRunner); err != nil {
+		return fmt.Errorf("add scheduling runner to manager: %w", err)
+	}
+
 	if err = (&controllers.CatalogEntrySetReconciler{
-		Client: mgr.GetClient(),
-		Log:    log.WithName("controllers").WithName("CatalogEntrySet"),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Log:              log.WithName("controllers").WithName("CatalogEntrySet"),
+		Scheme:           mgr.GetScheme(),
+		SchedulingRunner: schedulingRunner,
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("creating CatalogEntrySet controller: %w", err)
 	}
@@ -188,6 +203,14 @@ func run(flags *flags, log logr.Logger) error {
 		return fmt.Errorf("creating Catalog controller: %w", err)
 	}
 
+	if err = (&controllers.InstanceMigrationReconciler{
+		Client: mgr.GetClient(),
+		Log:    log.WithName("controllers").WithName("InstanceMigration"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("creating InstanceMigration controller: %w", err)
+	}
+
 	if err = (&controllers.DerivedCustomResourceReconciler{
 		Client: mgr.GetClient(),
 		Log:    log.WithName("controllers").WithName("DerivedCustomResource"),
@@ -211,15 +234,21 @@ func run(flags *flags, log logr.Logger) error {
 	// validating webhooks
 	wbh.Register(utilwebhook.GenerateValidateWebhookPath(&catalogv1alpha1.CatalogEntry{}, mgr.GetScheme()),
 		&webhook.Admission{Handler: &webhooks.CatalogEntryWebhookHandler{
-			Log: log.WithName("validating webhooks").WithName("CatalogEntry"),
+			Client: mgr.GetClient(),
+			Log:    log.WithName("validating webhooks").WithName("CatalogEntry"),
 		}})
 	wbh.Register(utilwebhook.GenerateValidateWebhookPath(&catalogv1alpha1.DerivedCustomResource{}, mgr.GetScheme()),
 		&webhook.Admission{
 			Handler: &webhooks.DerivedCustomResourceWebhookHandler{
-				Log:    log.WithName("validating webhooks").WithName("DerivedCustomResource"),
-				Scheme: mgr.GetScheme(),
-				Client: mgr.GetClient(),
+				Log:       log.WithName("validating webhooks").WithName("DerivedCustomResource"),
+				Scheme:    mgr.GetScheme(),
+				Client:    mgr.GetClient(),
+				APIReader: mgr.GetAPIReader(),
 			}})
+	wbh.Register(utilwebhook.GenerateValidateWebhookPath(&catalogv1alpha1.InstanceMigration{}, mgr.GetScheme()),
+		&webhook.Admission{Handler: &webhooks.InstanceMigrationWebhookHandler{
+			Log: log.WithName("validating webhooks").WithName("InstanceMigration"),
+		}})
 	wbh.Register(utilwebhook.GenerateValidateWebhookPath(&catalogv1alpha1.Offering{}, mgr.GetScheme()),
 		&webhook.Admission{Handler: &webhooks.OfferingWebhookHandler{
 			Log: log.WithName("validating webhooks").WithName("Offering"),
@@ -234,6 +263,10 @@ func run(flags *flags, log logr.Logger) error {
 		&webhook.Admission{Handler: &webhooks.ProviderWebhookHandler{
 			Log: log.WithName("validating webhooks").WithName("Provider"),
 		}})
+	wbh.Register(utilwebhook.GenerateValidateWebhookPath(&catalogv1alpha1.Quota{}, mgr.GetScheme()),
+		&webhook.Admission{Handler: &webhooks.QuotaWebhookHandler{
+			Log: log.WithName("validating webhooks").WithName("Quota"),
+		}})
 	wbh.Register(utilwebhook.GenerateValidateWebhookPath(&catalogv1alpha1.Region{}, mgr.GetScheme()),
 		&webhook.Admission{Handler: &webhooks.RegionWebhookHandler{
 			Log: log.WithName("validating webhooks").WithName("Region"),
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The KubeCarrier Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"k8c.io/utils/pkg/util"
+
+	"k8c.io/kubecarrier/pkg/internal/constants"
+	"k8c.io/kubecarrier/pkg/internal/reconcile"
+)
+
+// AggregatedCRDReconciler grants the KubeCarrier manager access to the CRDs it aggregates for scheduling.
+// The manager is not allowed to manage RBAC itself, so it cannot escalate its own permissions.
+type AggregatedCRDReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
+
+func (r *AggregatedCRDReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("customresourcedefinition", req.Name)
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := r.Get(ctx, req.NamespacedName, crd); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !crd.DeletionTimestamp.IsZero() {
+		// the ClusterRole is garbage collected together with the CRD
+		return ctrl.Result{}, nil
+	}
+
+	desiredClusterRole, err := r.buildDesiredClusterRole(crd)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if _, err := reconcile.ClusterRole(ctx, log, r.Client, desiredClusterRole); err != nil {
+		return ctrl.Result{}, fmt.Errorf("reconciling ClusterRole: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// buildDesiredClusterRole returns the ClusterRole that is aggregated into the role of the KubeCarrier manager,
+// so it can schedule instances of the aggregated CRD.
+func (r *AggregatedCRDReconciler) buildDesiredClusterRole(
+	crd *apiextensionsv1.CustomResourceDefinition,
+) (*rbacv1.ClusterRole, error) {
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "kubecarrier:manager:scheduling:" + crd.Name,
+			Labels: map[string]string{
+				"kubecarrier.io/manager": "true",
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{crd.Spec.Group},
+				Resources: []string{crd.Spec.Names.Plural},
+				Verbs:     []string{"get", "list", "watch", "update", "patch"},
+			},
+			{
+				APIGroups: []string{crd.Spec.Group},
+				Resources: []string{crd.Spec.Names.Plural + "/status"},
+				Verbs:     []string{"get", "update", "patch"},
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(crd, clusterRole, r.Scheme); err != nil {
+		return nil, fmt.Errorf("setting controller reference: %w", err)
+	}
+	return clusterRole, nil
+}
+
+func (r *AggregatedCRDReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apiextensionsv1.CustomResourceDefinition{}).
+		Owns(&rbacv1.ClusterRole{}).
+		WithEventFilter(util.PredicateFn(func(obj runtime.Object) bool {
+			crd, ok := obj.(*apiextensionsv1.CustomResourceDefinition)
+			if !ok {
+				// owned ClusterRoles are mapped to their CRD
+				return true
+			}
+			return crd.Labels[constants.AggregatedCRDLabel] == "true"
+		})).
+		Complete(r)
+}
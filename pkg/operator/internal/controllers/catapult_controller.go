@@ -31,6 +31,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"k8c.io/utils/pkg/owner"
@@ -38,6 +40,7 @@ import (
 
 	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
 	resourcescatapult "k8c.io/kubecarrier/pkg/internal/resources/catapult"
+	resourcesconstants "k8c.io/kubecarrier/pkg/internal/resources/constants"
 )
 
 const (
@@ -54,6 +57,7 @@ type CatapultReconciler struct {
 
 // +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=catapults,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=catapults/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=kubecarriers,verbs=get;list;watch
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
@@ -106,33 +110,83 @@ func (r *CatapultReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, fmt.Errorf("getting Ferry: %w", err)
 	}
 
-	objects, err := resourcescatapult.Manifests(
-		resourcescatapult.Config{
-			Name:      catapult.Name,
-			Namespace: catapult.Namespace,
+	kubeCarrier, err := consolidatedKubeCarrier(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	config := resourcescatapult.Config{
+		Name:      catapult.Name,
+		Namespace: catapult.Namespace,
 
-			ManagementClusterKind:    catapult.Spec.ManagementClusterCRD.Kind,
-			ManagementClusterVersion: catapult.Spec.ManagementClusterCRD.Version,
-			ManagementClusterGroup:   catapult.Spec.ManagementClusterCRD.Group,
-			ManagementClusterPlural:  catapult.Spec.ManagementClusterCRD.Plural,
+		ManagementClusterKind:    catapult.Spec.ManagementClusterCRD.Kind,
+		ManagementClusterVersion: catapult.Spec.ManagementClusterCRD.Version,
+		ManagementClusterGroup:   catapult.Spec.ManagementClusterCRD.Group,
+		ManagementClusterPlural:  catapult.Spec.ManagementClusterCRD.Plural,
 
-			ServiceClusterKind:    catapult.Spec.ServiceClusterCRD.Kind,
-			ServiceClusterVersion: catapult.Spec.ServiceClusterCRD.Version,
-			ServiceClusterGroup:   catapult.Spec.ServiceClusterCRD.Group,
-			ServiceClusterPlural:  catapult.Spec.ServiceClusterCRD.Plural,
+		ServiceClusterKind:    catapult.Spec.ServiceClusterCRD.Kind,
+		ServiceClusterVersion: catapult.Spec.ServiceClusterCRD.Version,
+		ServiceClusterGroup:   catapult.Spec.ServiceClusterCRD.Group,
+		ServiceClusterPlural:  catapult.Spec.ServiceClusterCRD.Plural,
 
-			ServiceClusterName:   catapult.Spec.ServiceCluster.Name,
-			ServiceClusterSecret: ferry.Spec.KubeconfigSecret.Name,
-			WebhookStrategy:      string(catapult.Spec.WebhookStrategy),
-			LogLevel:             catapult.Spec.LogLevel,
-		})
+		ServiceClusterName:   catapult.Spec.ServiceCluster.Name,
+		ServiceClusterSecret: ferry.Spec.KubeconfigSecret.Name,
+		WebhookStrategy:      string(catapult.Spec.WebhookStrategy),
+		LogLevel:             catapult.Spec.LogLevel,
+	}
+	perCRDObjects, err := resourcescatapult.Manifests(config)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("creating Catapult manifests: %w", err)
 	}
+	consolidatedConfig := resourcescatapult.ConsolidatedConfig{
+		Name:      resourcescatapult.ConsolidatedName(catapult.Spec.ServiceCluster.Name),
+		Namespace: catapult.Namespace,
 
-	deploymentIsReady, err := reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, catapult, objects)
+		ServiceClusterName:   catapult.Spec.ServiceCluster.Name,
+		ServiceClusterSecret: ferry.Spec.KubeconfigSecret.Name,
+	}
+	if kubeCarrier != nil {
+		consolidatedConfig.LogLevel = &kubeCarrier.Spec.LogLevel
+	}
+	consolidatedObjects, err := resourcescatapult.ConsolidatedManifests(consolidatedConfig)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, fmt.Errorf("creating consolidated Catapult manifests: %w", err)
+	}
+
+	var deploymentIsReady bool
+	if kubeCarrier == nil {
+		// PerCRD mode
+		if err := deleteObsoleteObjects(ctx, r.Client, consolidatedObjects, nil); err != nil {
+			return ctrl.Result{}, fmt.Errorf("deleting consolidated Catapult: %w", err)
+		}
+		if err := deleteRetargetedObjects(ctx, r.Client, perCRDObjects); err != nil {
+			return ctrl.Result{}, err
+		}
+		deploymentIsReady, err = reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, catapult, perCRDObjects)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// Consolidated mode
+		config.ConsolidatedName = consolidatedConfig.Name
+		boundObjects, err := resourcescatapult.Manifests(config)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("creating Catapult manifests: %w", err)
+		}
+		if err := deleteObsoleteObjects(ctx, r.Client, perCRDObjects, boundObjects); err != nil {
+			return ctrl.Result{}, fmt.Errorf("deleting per CRD Catapult: %w", err)
+		}
+		if err := deleteRetargetedObjects(ctx, r.Client, boundObjects); err != nil {
+			return ctrl.Result{}, err
+		}
+		if _, err := reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, catapult, boundObjects); err != nil {
+			return ctrl.Result{}, err
+		}
+		// the consolidated Catapult is shared by all Catapults of the ServiceCluster and cleaned up with the last of them
+		deploymentIsReady, err = reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, nil, consolidatedObjects)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 
 	// 4. Update the status of the Catapult object.
@@ -158,9 +212,49 @@ func (r *CatapultReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&source.Kind{Type: &rbacv1.ClusterRole{}}, enqueuer).
 		Watches(&source.Kind{Type: &rbacv1.ClusterRoleBinding{}}, enqueuer).
 		Watches(&source.Kind{Type: &adminv1beta1.MutatingWebhookConfiguration{}}, enqueuer).
+		Watches(&source.Kind{Type: &appsv1.Deployment{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.enqueueConsolidated),
+		}).
+		Watches(&source.Kind{Type: &operatorv1alpha1.KubeCarrier{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(handler.MapObject) []reconcile.Request {
+				return r.enqueueCatapults("", func(operatorv1alpha1.Catapult) bool { return true })
+			}),
+		}).
 		Complete(r)
 }
 
+// enqueueConsolidated enqueues all Catapults served by a consolidated Catapult Deployment.
+func (r *CatapultReconciler) enqueueConsolidated(mapObject handler.MapObject) []reconcile.Request {
+	labels := mapObject.Meta.GetLabels()
+	if labels[resourcesconstants.NameLabel] != "catapult" {
+		return nil
+	}
+	return r.enqueueCatapults(mapObject.Meta.GetNamespace(), func(catapult operatorv1alpha1.Catapult) bool {
+		return resourcescatapult.ConsolidatedName(catapult.Spec.ServiceCluster.Name) == labels[resourcesconstants.InstanceLabel]
+	})
+}
+
+// enqueueCatapults enqueues all Catapults in the given namespace, that match the given filter.
+func (r *CatapultReconciler) enqueueCatapults(namespace string, filter func(catapult operatorv1alpha1.Catapult) bool) (out []reconcile.Request) {
+	catapultList := &operatorv1alpha1.CatapultList{}
+	if err := r.List(context.Background(), catapultList, client.InNamespace(namespace)); err != nil {
+		// This will makes the manager crashes, and it will restart and reconcile all objects again.
+		panic(fmt.Errorf("listing Catapults: %w", err))
+	}
+	for _, catapult := range catapultList.Items {
+		if !filter(catapult) {
+			continue
+		}
+		out = append(out, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      catapult.Name,
+				Namespace: catapult.Namespace,
+			},
+		})
+	}
+	return
+}
+
 func (r *CatapultReconciler) handleDeletion(ctx context.Context, catapult *operatorv1alpha1.Catapult) error {
 	if catapult.SetTerminatingCondition() {
 		if err := r.Client.Status().Update(ctx, catapult); err != nil {
@@ -177,6 +271,26 @@ func (r *CatapultReconciler) handleDeletion(ctx context.Context, catapult *opera
 		return fmt.Errorf("DeleteObjects: %w", err)
 	}
 
+	// cleanup the consolidated Catapult, when no other Catapult of the ServiceCluster is left
+	if remaining := r.enqueueCatapults(catapult.Namespace, func(other operatorv1alpha1.Catapult) bool {
+		return other.Name != catapult.Name &&
+			other.DeletionTimestamp.IsZero() &&
+			other.Spec.ServiceCluster.Name == catapult.Spec.ServiceCluster.Name
+	}); len(remaining) == 0 {
+		consolidatedObjects, err := resourcescatapult.ConsolidatedManifests(resourcescatapult.ConsolidatedConfig{
+			Name:      resourcescatapult.ConsolidatedName(catapult.Spec.ServiceCluster.Name),
+			Namespace: catapult.Namespace,
+
+			ServiceClusterName: catapult.Spec.ServiceCluster.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("creating consolidated Catapult manifests: %w", err)
+		}
+		if err := deleteObsoleteObjects(ctx, r.Client, consolidatedObjects, nil); err != nil {
+			return fmt.Errorf("deleting consolidated Catapult: %w", err)
+		}
+	}
+
 	if cleanedUp && util.RemoveFinalizer(catapult, catapultControllerFinalizer) {
 		if err := r.Update(ctx, catapult); err != nil {
 			return fmt.Errorf("updating Catapult finalizers: %w", err)
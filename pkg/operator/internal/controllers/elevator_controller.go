@@ -28,14 +28,18 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"k8c.io/utils/pkg/owner"
 	"k8c.io/utils/pkg/util"
 
 	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
+	resourcesconstants "k8c.io/kubecarrier/pkg/internal/resources/constants"
 	resourceselevator "k8c.io/kubecarrier/pkg/internal/resources/elevator"
 )
 
@@ -53,6 +57,7 @@ type ElevatorReconciler struct {
 
 // +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=elevators,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=elevators/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.kubecarrier.io,resources=kubecarriers,verbs=get;list;watch
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
@@ -92,31 +97,78 @@ func (r *ElevatorReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		}
 	}
 
-	objects, err := resourceselevator.Manifests(
-		resourceselevator.Config{
-			Name:      elevator.Name,
-			Namespace: elevator.Namespace,
+	kubeCarrier, err := consolidatedKubeCarrier(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
-			ProviderKind:    elevator.Spec.ProviderCRD.Kind,
-			ProviderVersion: elevator.Spec.ProviderCRD.Version,
-			ProviderGroup:   elevator.Spec.ProviderCRD.Group,
-			ProviderPlural:  elevator.Spec.ProviderCRD.Plural,
+	config := resourceselevator.Config{
+		Name:      elevator.Name,
+		Namespace: elevator.Namespace,
 
-			TenantKind:    elevator.Spec.TenantCRD.Kind,
-			TenantVersion: elevator.Spec.TenantCRD.Version,
-			TenantGroup:   elevator.Spec.TenantCRD.Group,
-			TenantPlural:  elevator.Spec.TenantCRD.Plural,
+		ProviderKind:    elevator.Spec.ProviderCRD.Kind,
+		ProviderVersion: elevator.Spec.ProviderCRD.Version,
+		ProviderGroup:   elevator.Spec.ProviderCRD.Group,
+		ProviderPlural:  elevator.Spec.ProviderCRD.Plural,
 
-			DerivedCRName: elevator.Spec.DerivedCR.Name,
-			LogLevel:      elevator.Spec.LogLevel,
-		})
+		TenantKind:    elevator.Spec.TenantCRD.Kind,
+		TenantVersion: elevator.Spec.TenantCRD.Version,
+		TenantGroup:   elevator.Spec.TenantCRD.Group,
+		TenantPlural:  elevator.Spec.TenantCRD.Plural,
+
+		DerivedCRName: elevator.Spec.DerivedCR.Name,
+		LogLevel:      elevator.Spec.LogLevel,
+	}
+	perCRDObjects, err := resourceselevator.Manifests(config)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("creating Elevator manifests: %w", err)
 	}
-
-	deploymentIsReady, err := reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, elevator, objects)
+	consolidatedConfig := resourceselevator.ConsolidatedConfig{
+		Name:      resourceselevator.ConsolidatedName,
+		Namespace: elevator.Namespace,
+	}
+	if kubeCarrier != nil {
+		consolidatedConfig.LogLevel = &kubeCarrier.Spec.LogLevel
+	}
+	consolidatedObjects, err := resourceselevator.ConsolidatedManifests(consolidatedConfig)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, fmt.Errorf("creating consolidated Elevator manifests: %w", err)
+	}
+
+	var deploymentIsReady bool
+	if kubeCarrier == nil {
+		// PerCRD mode
+		if err := deleteObsoleteObjects(ctx, r.Client, consolidatedObjects, nil); err != nil {
+			return ctrl.Result{}, fmt.Errorf("deleting consolidated Elevator: %w", err)
+		}
+		if err := deleteRetargetedObjects(ctx, r.Client, perCRDObjects); err != nil {
+			return ctrl.Result{}, err
+		}
+		deploymentIsReady, err = reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, elevator, perCRDObjects)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// Consolidated mode
+		config.ConsolidatedName = consolidatedConfig.Name
+		boundObjects, err := resourceselevator.Manifests(config)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("creating Elevator manifests: %w", err)
+		}
+		if err := deleteObsoleteObjects(ctx, r.Client, perCRDObjects, boundObjects); err != nil {
+			return ctrl.Result{}, fmt.Errorf("deleting per CRD Elevator: %w", err)
+		}
+		if err := deleteRetargetedObjects(ctx, r.Client, boundObjects); err != nil {
+			return ctrl.Result{}, err
+		}
+		if _, err := reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, elevator, boundObjects); err != nil {
+			return ctrl.Result{}, err
+		}
+		// the consolidated Elevator is shared by all Elevators of the provider namespace and cleaned up with the last of them
+		deploymentIsReady, err = reconcileOwnedObjectsForNamespacedOwner(ctx, log, r.Scheme, r.RESTMapper, r.Client, nil, consolidatedObjects)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 
 	// 4. Update the status of the Elevator object.
@@ -142,9 +194,48 @@ func (r *ElevatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&source.Kind{Type: &rbacv1.ClusterRole{}}, enqueuer).
 		Watches(&source.Kind{Type: &rbacv1.ClusterRoleBinding{}}, enqueuer).
 		Watches(&source.Kind{Type: &adminv1beta1.MutatingWebhookConfiguration{}}, enqueuer).
+		Watches(&source.Kind{Type: &appsv1.Deployment{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.enqueueConsolidated),
+		}).
+		Watches(&source.Kind{Type: &operatorv1alpha1.KubeCarrier{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(handler.MapObject) []reconcile.Request {
+				return r.enqueueElevators("", func(operatorv1alpha1.Elevator) bool { return true })
+			}),
+		}).
 		Complete(r)
 }
 
+// enqueueConsolidated enqueues all Elevators served by a consolidated Elevator Deployment.
+func (r *ElevatorReconciler) enqueueConsolidated(mapObject handler.MapObject) []reconcile.Request {
+	labels := mapObject.Meta.GetLabels()
+	if labels[resourcesconstants.NameLabel] != "elevator" ||
+		labels[resourcesconstants.InstanceLabel] != resourceselevator.ConsolidatedName {
+		return nil
+	}
+	return r.enqueueElevators(mapObject.Meta.GetNamespace(), func(operatorv1alpha1.Elevator) bool { return true })
+}
+
+// enqueueElevators enqueues all Elevators in the given namespace, that match the given filter.
+func (r *ElevatorReconciler) enqueueElevators(namespace string, filter func(elevator operatorv1alpha1.Elevator) bool) (out []reconcile.Request) {
+	elevatorList := &operatorv1alpha1.ElevatorList{}
+	if err := r.List(context.Background(), elevatorList, client.InNamespace(namespace)); err != nil {
+		// This will makes the manager crashes, and it will restart and reconcile all objects again.
+		panic(fmt.Errorf("listing Elevators: %w", err))
+	}
+	for _, elevator := range elevatorList.Items {
+		if !filter(elevator) {
+			continue
+		}
+		out = append(out, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      elevator.Name,
+				Namespace: elevator.Namespace,
+			},
+		})
+	}
+	return
+}
+
 func (r *ElevatorReconciler) handleDeletion(ctx context.Context, elevator *operatorv1alpha1.Elevator) error {
 	if elevator.SetTerminatingCondition() {
 		if err := r.Client.Status().Update(ctx, elevator); err != nil {
@@ -161,6 +252,22 @@ func (r *ElevatorReconciler) handleDeletion(ctx context.Context, elevator *opera
 		return fmt.Errorf("DeleteObjects: %w", err)
 	}
 
+	// cleanup the consolidated Elevator, when no other Elevator of the provider namespace is left
+	if remaining := r.enqueueElevators(elevator.Namespace, func(other operatorv1alpha1.Elevator) bool {
+		return other.Name != elevator.Name && other.DeletionTimestamp.IsZero()
+	}); len(remaining) == 0 {
+		consolidatedObjects, err := resourceselevator.ConsolidatedManifests(resourceselevator.ConsolidatedConfig{
+			Name:      resourceselevator.ConsolidatedName,
+			Namespace: elevator.Namespace,
+		})
+		if err != nil {
+			return fmt.Errorf("creating consolidated Elevator manifests: %w", err)
+		}
+		if err := deleteObsoleteObjects(ctx, r.Client, consolidatedObjects, nil); err != nil {
+			return fmt.Errorf("deleting consolidated Elevator: %w", err)
+		}
+	}
+
 	if cleanedUp && util.RemoveFinalizer(elevator, elevatorControllerFinalizer) {
 		if err := r.Update(ctx, elevator); err != nil {
 			return fmt.Errorf("updating Elevator finalizers: %w", err)
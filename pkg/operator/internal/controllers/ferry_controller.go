@@ -80,6 +80,7 @@ func (r *FerryReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			ProviderNamespace:    ferry.Namespace,
 			Name:                 ferry.Name,
 			KubeconfigSecretName: ferry.Spec.KubeconfigSecret.Name,
+			Connection:           ferry.Spec.Connection,
 			LogLevel:             ferry.Spec.LogLevel,
 		})
 	if err != nil {
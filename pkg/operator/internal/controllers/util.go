@@ -19,16 +19,22 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync/atomic"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/sync/errgroup"
+	adminv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -36,6 +42,8 @@ import (
 	"k8c.io/utils/pkg/owner"
 	"k8c.io/utils/pkg/util"
 
+	operatorv1alpha1 "k8c.io/kubecarrier/pkg/apis/operator/v1alpha1"
+	"k8c.io/kubecarrier/pkg/internal/constants"
 	"k8c.io/kubecarrier/pkg/internal/reconcile"
 )
 
@@ -51,6 +59,7 @@ type object interface {
 // `controllerutil.SetControllerReference()` to do the owner handling.
 // If owned object is cluster-scoped or in another namespace, it will use KubeCarrier customize owner package to perform
 // owner handling.
+// If ownerObj is nil, objects are reconciled without owner handling.
 func reconcileOwnedObjectsForNamespacedOwner(
 	ctx context.Context,
 	log logr.Logger,
@@ -64,29 +73,10 @@ func reconcileOwnedObjectsForNamespacedOwner(
 	for _, object := range objects {
 		object := object
 		g.Go(func() error {
-			gvk, err := apiutil.GVKForObject(&object, scheme)
-			if err != nil {
-				return err
-			}
-			restMapping, err := restMapper.RESTMapping(schema.GroupKind{
-				Group: gvk.Group,
-				Kind:  gvk.Kind,
-			}, gvk.Version)
-			if err != nil {
-				return err
-			}
-
-			switch restMapping.Scope.Name() {
-			case meta.RESTScopeNameNamespace:
-				if err := controllerutil.SetControllerReference(ownerObj, &object, scheme); err != nil {
-					return err
-				}
-			case meta.RESTScopeNameRoot:
-				if _, err := owner.SetOwnerReference(ownerObj, &object, scheme); err != nil {
+			if ownerObj != nil {
+				if err := setOwner(scheme, restMapper, ownerObj, &object); err != nil {
 					return err
 				}
-			default:
-				return fmt.Errorf("unknown REST scope: %s", restMapping.Scope.Name())
 			}
 			curObj, err := reconcile.Unstructured(ctx, log, client, &object)
 			if err != nil {
@@ -108,3 +98,151 @@ func reconcileOwnedObjectsForNamespacedOwner(
 	}
 	return deploymentIsReady > 0, nil
 }
+
+func setOwner(scheme *runtime.Scheme, restMapper meta.RESTMapper, ownerObj object, object *unstructured.Unstructured) error {
+	gvk, err := apiutil.GVKForObject(object, scheme)
+	if err != nil {
+		return err
+	}
+	restMapping, err := restMapper.RESTMapping(schema.GroupKind{
+		Group: gvk.Group,
+		Kind:  gvk.Kind,
+	}, gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	switch restMapping.Scope.Name() {
+	case meta.RESTScopeNameNamespace:
+		return controllerutil.SetControllerReference(ownerObj, object, scheme)
+	case meta.RESTScopeNameRoot:
+		_, err := owner.SetOwnerReference(ownerObj, object, scheme)
+		return err
+	default:
+		return fmt.Errorf("unknown REST scope: %s", restMapping.Scope.Name())
+	}
+}
+
+// consolidatedKubeCarrier returns the KubeCarrier object, if it configures Catapults and Elevators to be deployed consolidated.
+// nil is returned for the default PerCRD mode.
+func consolidatedKubeCarrier(ctx context.Context, c client.Client) (*operatorv1alpha1.KubeCarrier, error) {
+	kubeCarrier := &operatorv1alpha1.KubeCarrier{}
+	err := c.Get(ctx, types.NamespacedName{Name: constants.KubeCarrierDefaultName}, kubeCarrier)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting KubeCarrier: %w", err)
+	}
+	if kubeCarrier.Spec.Components.Mode != operatorv1alpha1.ComponentDeploymentModeConsolidated {
+		return nil, nil
+	}
+	return kubeCarrier, nil
+}
+
+// deleteObsoleteObjects deletes the objects of another deployment mode, that are not part of the desired objects.
+// Obsolete objects are only deleted while their Deployment exists, so no API calls are wasted after the cleanup.
+// The Deployment is deleted last, so an interrupted cleanup is continued on the next reconcile.
+func deleteObsoleteObjects(ctx context.Context, c client.Client, obsolete, desired []unstructured.Unstructured) error {
+	var deployment *unstructured.Unstructured
+	for i := range obsolete {
+		if obsolete[i].GetKind() == "Deployment" {
+			deployment = &obsolete[i]
+		}
+	}
+	if deployment == nil {
+		return nil
+	}
+	err := c.Get(ctx, types.NamespacedName{
+		Name:      deployment.GetName(),
+		Namespace: deployment.GetNamespace(),
+	}, &appsv1.Deployment{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting Deployment: %w", err)
+	}
+
+	isDesired := map[string]bool{}
+	for _, obj := range desired {
+		isDesired[objectKey(obj)] = true
+	}
+	for i := range obsolete {
+		obj := &obsolete[i]
+		if obj == deployment || isDesired[objectKey(*obj)] {
+			continue
+		}
+		if err := c.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("deleting %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	if err := c.Delete(ctx, deployment); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("deleting Deployment %s: %w", deployment.GetName(), err)
+	}
+	return nil
+}
+
+func objectKey(obj unstructured.Unstructured) string {
+	return obj.GetKind() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// deleteRetargetedObjects deletes ClusterRoleBindings and MutatingWebhookConfigurations,
+// that point to another Deployment than desired, e.g. after switching between PerCRD and Consolidated mode.
+// These objects are only created, but never updated by reconcile.Unstructured,
+// so they need to be recreated.
+func deleteRetargetedObjects(ctx context.Context, c client.Client, desired []unstructured.Unstructured) error {
+	for _, obj := range desired {
+		nn := types.NamespacedName{Name: obj.GetName()}
+		switch obj.GetKind() {
+		case "ClusterRoleBinding":
+			desiredBinding := &rbacv1.ClusterRoleBinding{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, desiredBinding); err != nil {
+				return fmt.Errorf("converting ClusterRoleBinding: %w", err)
+			}
+			currentBinding := &rbacv1.ClusterRoleBinding{}
+			if err := c.Get(ctx, nn, currentBinding); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("getting ClusterRoleBinding: %w", err)
+			}
+			if equality.Semantic.DeepEqual(currentBinding.Subjects, desiredBinding.Subjects) {
+				continue
+			}
+			if err := c.Delete(ctx, currentBinding); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("deleting ClusterRoleBinding: %w", err)
+			}
+
+		case "MutatingWebhookConfiguration":
+			desiredConfig := &adminv1beta1.MutatingWebhookConfiguration{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, desiredConfig); err != nil {
+				return fmt.Errorf("converting MutatingWebhookConfiguration: %w", err)
+			}
+			currentConfig := &adminv1beta1.MutatingWebhookConfiguration{}
+			if err := c.Get(ctx, nn, currentConfig); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("getting MutatingWebhookConfiguration: %w", err)
+			}
+			if webhookServiceNames(currentConfig) == webhookServiceNames(desiredConfig) {
+				continue
+			}
+			if err := c.Delete(ctx, currentConfig); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("deleting MutatingWebhookConfiguration: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func webhookServiceNames(config *adminv1beta1.MutatingWebhookConfiguration) string {
+	var names []string
+	for _, webhook := range config.Webhooks {
+		if service := webhook.ClientConfig.Service; service != nil {
+			names = append(names, service.Namespace+"/"+service.Name)
+		}
+	}
+	return strings.Join(names, ",")
+}
@@ -83,6 +83,27 @@ func TestKubeCarrierValidatingCreate(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("Authentication should have one and only one configuration"),
 		},
+		{
+			name: "invalid KubeCarrier API rate limit",
+			object: &operatorv1alpha1.KubeCarrier{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "kubecarrier",
+				},
+				Spec: operatorv1alpha1.KubeCarrierSpec{
+					API: operatorv1alpha1.APIServerSpec{
+						Authentication: operatorv1alpha1.Authentication{
+							operatorv1alpha1.AuthenticationConfig{
+								Anonymous: &operatorv1alpha1.Anonymous{},
+							},
+						},
+						RateLimit: &operatorv1alpha1.APIServerRateLimit{
+							QPS: -1,
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf("RateLimit qps, burst and maxConcurrentStreams should not be negative"),
+		},
 		{
 			name: "can pass validate create",
 			object: &operatorv1alpha1.KubeCarrier{
@@ -132,6 +132,14 @@ func run(flags *flags, log logr.Logger) error {
 		return fmt.Errorf("creating KubeCarrier controller: %w", err)
 	}
 
+	if err = (&controllers.AggregatedCRDReconciler{
+		Client: mgr.GetClient(),
+		Log:    log.WithName("controllers").WithName("AggregatedCRD"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("creating AggregatedCRD controller: %w", err)
+	}
+
 	if err = (&controllers.APIServerReconciler{
 		Client:     mgr.GetClient(),
 		Log:        log.WithName("controllers").WithName("APIServer"),
@@ -239,7 +239,7 @@ func NewProviderAccount(name string, subjects ...rbacv1.Subject) *catalogv1alpha
 			Roles: []catalogv1alpha1.AccountRole{
 				catalogv1alpha1.ProviderRole,
 			},
-			Subjects: subjects,
+			Subjects: catalogv1alpha1.NewAccountSubjects(subjects...),
 		},
 	}
 }
@@ -259,7 +259,7 @@ func NewTenantAccount(name string, subjects ...rbacv1.Subject) *catalogv1alpha1.
 			Roles: []catalogv1alpha1.AccountRole{
 				catalogv1alpha1.TenantRole,
 			},
-			Subjects: subjects,
+			Subjects: catalogv1alpha1.NewAccountSubjects(subjects...),
 		},
 	}
 }
@@ -290,7 +290,7 @@ func NewServiceCluster(name, namespace, secret string) *corev1alpha1.ServiceClus
 				DisplayName: name,
 				Description: fmt.Sprintf("%s service cluster", name),
 			},
-			KubeconfigSecret: corev1alpha1.ObjectReference{
+			KubeconfigSecret: &corev1alpha1.ObjectReference{
 				Name: secret,
 			},
 		},
@@ -74,13 +74,11 @@ func newAccount(f *testutil.Framework) func(t *testing.T) {
 						catalogv1alpha1.TenantRole,
 						catalogv1alpha1.ProviderRole,
 					},
-					Subjects: []rbacv1.Subject{
-						{
-							Kind:     rbacv1.GroupKind,
-							APIGroup: "rbac.authorization.k8s.io",
-							Name:     "tenantprovider",
-						},
-					},
+					Subjects: catalogv1alpha1.NewAccountSubjects(rbacv1.Subject{
+						Kind:     rbacv1.GroupKind,
+						APIGroup: "rbac.authorization.k8s.io",
+						Name:     "tenantprovider",
+					}),
 				},
 			}
 		)
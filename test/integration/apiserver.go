@@ -463,6 +463,36 @@ func instanceService(ctx context.Context, conn *grpc.ClientConn, tenantAccount *
 		require.NoError(t, err, "listing instance")
 		assert.Len(t, instances.Items, 1)
 
+		patchReq := &apiserverv1.InstancePatchRequest{
+			Offering:  offering.Name,
+			Version:   "v1",
+			Name:      "fakedb",
+			Account:   tenantAccount.Status.Namespace.Name,
+			PatchType: apiserverv1.PatchTypeJSONMerge,
+			Patch:     apiserverv1.NewJSONRawObject([]byte("{\"spec\":{\"databaseUser\":\"patched-username\"}}")),
+		}
+		_, err = client.Patch(ctx, patchReq)
+		require.NoError(t, err, "patching instance")
+		require.NoError(t, serviceClient.WaitUntil(ctx, fakeDB, func() (bool, error) {
+			return fakeDB.Spec.DatabaseUser == "patched-username", nil
+		}), "instance patch not propagated to the service cluster")
+
+		instance, err := client.Get(ctx, getReq)
+		require.NoError(t, err, "getting instance")
+		instance.Spec = apiserverv1.NewJSONRawObject([]byte("{\"databaseName\":\"coolDB\",\"databaseUser\":\"updated-username\"}"))
+		updateReq := &apiserverv1.InstanceUpdateRequest{
+			Offering: offering.Name,
+			Version:  "v1",
+			Name:     "fakedb",
+			Account:  tenantAccount.Status.Namespace.Name,
+			Spec:     instance,
+		}
+		_, err = client.Update(ctx, updateReq)
+		require.NoError(t, err, "updating instance")
+		require.NoError(t, serviceClient.WaitUntil(ctx, fakeDB, func() (bool, error) {
+			return fakeDB.Spec.DatabaseUser == "updated-username", nil
+		}), "instance update not propagated to the service cluster")
+
 		delReq := &apiserverv1.InstanceDeleteRequest{
 			Offering: offering.Name,
 			Version:  "v1",
@@ -578,6 +608,7 @@ func providerService(ctx context.Context, conn *grpc.ClientConn, account *catalo
 				return false, err
 			}
 			assert.Len(t, providers.Items, 1)
+			assert.Equal(t, int64(2), providers.Metadata.TotalCount)
 			providers, err = client.List(providerCtx, &apiserverv1.ListRequest{
 				Account:  account.Name,
 				Limit:    1,
@@ -590,6 +621,18 @@ func providerService(ctx context.Context, conn *grpc.ClientConn, account *catalo
 			return true, nil
 		}, providerCtx.Done()))
 
+		// list providers with field selector and ordering.
+		providers, err := client.List(providerCtx, &apiserverv1.ListRequest{
+			Account:       account.Name,
+			FieldSelector: "metadata.name!=test-provider-3",
+			OrderBy:       "-metadata.name",
+		})
+		require.NoError(t, err)
+		if assert.Len(t, providers.Items, 2) {
+			assert.Equal(t, "test-provider-2", providers.Items[0].Metadata.Name)
+			assert.Equal(t, "test-provider-1", providers.Items[1].Metadata.Name)
+		}
+
 		// get provider
 		require.NoError(t, wait.PollUntil(time.Second, func() (done bool, err error) {
 			provider, err := client.Get(providerCtx, &apiserverv1.GetRequest{
@@ -799,6 +842,7 @@ func offeringService(ctx context.Context, conn *grpc.ClientConn, account *catalo
 					Provider: &apiserverv1.ObjectReference{
 						Name: "test-provider",
 					},
+					Lifecycle: &apiserverv1.OfferingLifecycle{State: "Active"},
 					Crd: &apiserverv1.CRDInformation{
 						Name:     "test-crd",
 						ApiGroup: "test-crd-group",
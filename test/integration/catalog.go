@@ -218,7 +218,7 @@ func newCatalogSuite(
 			},
 		}
 		require.NoError(t, kubermatictestutil.WaitUntilFound(ctx, managementClient, providerRoleBindingFound), "getting Provider RoleBinding error")
-		assert.Equal(t, providerRoleBindingFound.Subjects, provider.Spec.Subjects, "Subjects is different")
+		assert.Equal(t, providerRoleBindingFound.Subjects, provider.RBACSubjects(catalogv1alpha1.SubjectRoleViewer), "Subjects is different")
 
 		// Check Tenant Role
 		tenantRoleFound := &rbacv1.Role{
@@ -229,7 +229,7 @@ func newCatalogSuite(
 		}
 		require.NoError(t, kubermatictestutil.WaitUntilFound(ctx, managementClient, tenantRoleFound), "getting Tenant Role error")
 		assert.Contains(t, tenantRoleFound.Rules, rbacv1.PolicyRule{
-			Verbs:     []string{rbacv1.VerbAll},
+			Verbs:     []string{"get", "list", "watch"},
 			APIGroups: []string{catalogEntry.Status.TenantCRD.APIGroup},
 			Resources: []string{catalogEntry.Status.TenantCRD.Plural},
 		}, "Missing the PolicyRule")
@@ -242,7 +242,7 @@ func newCatalogSuite(
 			},
 		}
 		require.NoError(t, kubermatictestutil.WaitUntilFound(ctx, managementClient, tenantRoleBindingFound), "getting Tenant RoleBinding error")
-		assert.Equal(t, tenantRoleBindingFound.Subjects, tenantAccount.Spec.Subjects, "Subjects is different")
+		assert.Equal(t, tenantRoleBindingFound.Subjects, tenantAccount.RBACSubjects(catalogv1alpha1.SubjectRoleViewer), "Subjects is different")
 
 		// Check if the status will be updated when tenant is removed.
 		t.Log("===== Catalog status updates when adding and removing Tenant =====")